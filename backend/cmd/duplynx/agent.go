@@ -0,0 +1,113 @@
+package main
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mcmx/duplynx/internal/agent"
+	"github.com/mcmx/duplynx/internal/observability"
+)
+
+// agentSecretEnv supplies the machine's secret without putting it on the command line.
+const agentSecretEnv = "DUPLYNX_AGENT_SECRET"
+
+func newAgentCommand() *cobra.Command {
+	var (
+		client agent.Client
+		daemon agent.Agent
+	)
+	cmd := &cobra.Command{
+		Use:   "agent",
+		Short: "Run the scan agent daemon for one machine",
+		Long: "Run the scan agent daemon for one machine. The agent long-polls the server for scan and action jobs, " +
+			"runs them with a concurrency limit, sends heartbeats and reconnects with backoff. " +
+			"Action jobs delete, hardlink or quarantine the machine's copies of a duplicate, " +
+			"leaving any copy whose content changed since the scan in place. " +
+			"It signs requests with the machine's own secret, issued by `duplynx secrets rotate <tenant> --machine <id>` " +
+			"and read from --secret or " + agentSecretEnv + ".",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) (err error) {
+			if client.Secret == "" {
+				client.Secret = strings.TrimSpace(os.Getenv(agentSecretEnv))
+			}
+			if client.Tenant == "" || daemon.MachineID == "" || client.Secret == "" {
+				return errors.New("agent requires --tenant, --machine and the machine's secret")
+			}
+			daemon.Client = &client
+
+			actor := resolveActor()
+			writer := observability.NewEventWriter(nil)
+			metadata := map[string]any{
+				"server":      client.BaseURL,
+				"tenant":      client.Tenant,
+				"machine":     daemon.MachineID,
+				"concurrency": daemon.Concurrency,
+			}
+			start := time.Now()
+			writer.Write(observability.Event{Action: "agent_start", Actor: actor, Outcome: "starting", Metadata: metadata})
+			defer func() {
+				writer.Write(observability.Event{
+					Action:   "agent_stop",
+					Actor:    actor,
+					Outcome:  outcome(err),
+					Duration: time.Since(start),
+					Metadata: metadata,
+					Error:    err,
+				})
+			}()
+
+			daemon.OnJob = func(result agent.JobResult) {
+				event := observability.Event{
+					Action:  "agent_job",
+					Actor:   actor,
+					Outcome: result.Outcome,
+					Metadata: map[string]any{
+						"tenant":       client.Tenant,
+						"machine":      daemon.MachineID,
+						"scan":         result.ScanID,
+						"files_seen":   result.Progress.FilesSeen,
+						"bytes_hashed": result.Progress.BytesHashed,
+						"groups":       result.Groups,
+					},
+					Error: result.Err,
+				}
+				if result.JobID != "" {
+					event.Action = "agent_action"
+					event.Metadata = map[string]any{
+						"tenant":  client.Tenant,
+						"machine": daemon.MachineID,
+						"job":     result.JobID,
+						"action":  result.Action,
+						"done":    result.Done,
+					}
+				}
+				writer.Write(event)
+			}
+			daemon.OnDisconnect = func(pollErr error, retryIn time.Duration) {
+				writer.Write(observability.Event{
+					Action:   "agent_reconnect",
+					Actor:    actor,
+					Outcome:  "failure",
+					Metadata: map[string]any{"tenant": client.Tenant, "machine": daemon.MachineID, "retry_in": retryIn.String()},
+					Error:    pollErr,
+				})
+			}
+			return daemon.Run(cmd.Context())
+		},
+	}
+	cmd.Flags().StringVar(&client.BaseURL, "server", "http://localhost:8080", "DupLynx server URL")
+	cmd.Flags().StringVar(&client.Tenant, "tenant", "", "Tenant slug the machine belongs to")
+	cmd.Flags().StringVar(&daemon.MachineID, "machine", "", "ID of the machine this agent scans")
+	cmd.Flags().StringVar(&client.Secret, "secret", "", "Machine secret (defaults to "+agentSecretEnv+")")
+	cmd.Flags().StringVar(&client.KeyID, "key-id", "", "Secret version the signature uses (optional)")
+	cmd.Flags().IntVar(&daemon.Concurrency, "concurrency", agent.DefaultConcurrency, "Jobs to run at once")
+	cmd.Flags().DurationVar(&daemon.PollWait, "poll-wait", agent.DefaultPollWait, "How long each job poll waits for work")
+	cmd.Flags().DurationVar(&daemon.HeartbeatInterval, "heartbeat-interval", agent.DefaultHeartbeatInterval, "How often running jobs report progress")
+	cmd.Flags().StringVar(&daemon.QuarantineDir, "quarantine-dir", "", "Directory quarantined copies move into (default: "+agent.QuarantineDirName+" beside each copy)")
+	cmd.Flags().DurationVar(&daemon.MaxBackoff, "max-backoff", agent.DefaultMaxBackoff, "Longest delay between reconnect attempts")
+	return cmd
+}
@@ -0,0 +1,259 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mcmx/duplynx/ent"
+	"github.com/mcmx/duplynx/internal/backup"
+	"github.com/mcmx/duplynx/internal/config"
+	"github.com/mcmx/duplynx/internal/data"
+	"github.com/mcmx/duplynx/internal/observability"
+	"github.com/mcmx/duplynx/internal/search"
+)
+
+func newDBCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "db",
+		Short: "Inspect and maintain the DupLynx database",
+	}
+	cmd.AddCommand(
+		newDBMigrateCommand(),
+		newDBBackupCommand(),
+		newDBRestoreCommand(),
+	)
+	return cmd
+}
+
+func newDBBackupCommand() *cobra.Command {
+	var out string
+	var opts backup.Options
+	cmd := &cobra.Command{
+		Use:   "backup",
+		Short: "Write a consistent copy of the SQLite database; safe while serve is running",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return withDatabase(cmd, func(client *ent.Client) error {
+				result, err := backup.Backup(cmd.Context(), client, out, opts)
+				writeBackupEvent("db_backup", result, nil, err)
+				if err != nil {
+					return err
+				}
+				_, err = fmt.Fprintf(cmd.OutOrStdout(), "Backed up schema %s to %s (%d bytes)\n", result.SchemaVersion, result.Path, result.Bytes)
+				return err
+			})
+		},
+	}
+	cmd.Flags().StringVar(&out, "out", "", "Backup file to create; a .gz suffix implies --compress")
+	cmd.Flags().BoolVar(&opts.Compress, "compress", false, "Gzip the backup")
+	cmd.Flags().BoolVar(&opts.Verify, "verify", true, "Run PRAGMA integrity_check on the copy")
+	_ = cmd.MarkFlagRequired("out")
+	return cmd
+}
+
+func newDBRestoreCommand() *cobra.Command {
+	var opts backup.RestoreOptions
+	cmd := &cobra.Command{
+		Use:   "restore <backup-file>",
+		Short: "Replace the SQLite database with a checked backup; stop serve first",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, ok := config.FromContext(cmd.Context())
+			if !ok {
+				cfg = runtimeCfg
+			}
+			if driver := strings.ToLower(strings.TrimSpace(cfg.DBDriver)); driver != "" && driver != data.DriverSQLite {
+				return backup.ErrUnsupportedDriver
+			}
+			result, err := backup.Restore(cmd.Context(), args[0], cfg.DBFile, opts)
+			observability.NewEventWriter(nil).Write(observability.Event{
+				Action:   "db_restore",
+				Actor:    resolveActor(),
+				Outcome:  outcome(err),
+				Metadata: map[string]any{"source": args[0], "db_file": cfg.DBFile, "schema_version": result.SchemaVersion, "previous": result.Previous},
+				Error:    err,
+			})
+			if err != nil {
+				return err
+			}
+			out := cmd.OutOrStdout()
+			fmt.Fprintf(out, "Restored schema %s into %s\n", result.SchemaVersion, cfg.DBFile)
+			if result.Previous != "" {
+				fmt.Fprintf(out, "The replaced database was kept as %s\n", result.Previous)
+			}
+			if result.Behind {
+				fmt.Fprintln(out, "The backup predates this build; run `duplynx db migrate up` before serving it.")
+			}
+			return nil
+		},
+	}
+	cmd.Flags().BoolVar(&opts.KeepPrevious, "keep-previous", true, "Keep the replaced database as <db>.pre-restore-<timestamp>")
+	return cmd
+}
+
+func newDBMigrateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Apply, revert, and inspect versioned schema migrations",
+	}
+	cmd.AddCommand(
+		newDBMigrateUpCommand(),
+		newDBMigrateDownCommand(),
+		newDBMigrateStatusCommand(),
+	)
+	return cmd
+}
+
+func newDBMigrateUpCommand() *cobra.Command {
+	var steps int
+	var baseline string
+	cmd := &cobra.Command{
+		Use:   "up",
+		Short: "Apply pending migrations",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return withDatabase(cmd, func(client *ent.Client) error {
+				ctx := cmd.Context()
+				if baseline != "" {
+					recorded, err := data.BaselineMigrations(ctx, client, baseline)
+					if err != nil {
+						return err
+					}
+					for _, m := range recorded {
+						fmt.Fprintf(cmd.OutOrStdout(), "Baselined %s_%s\n", m.Version, m.Name)
+					}
+				}
+				applied, err := data.MigrateUp(ctx, client, steps)
+				for _, m := range applied {
+					fmt.Fprintf(cmd.OutOrStdout(), "Applied %s_%s\n", m.Version, m.Name)
+				}
+				writeMigrationEvent("db_migrate_up", applied, err)
+				if err != nil {
+					return err
+				}
+				if len(applied) == 0 && baseline == "" {
+					fmt.Fprintln(cmd.OutOrStdout(), "Database schema is up to date")
+				}
+				_, err = search.EnsureIndex(ctx, client)
+				return err
+			})
+		},
+	}
+	cmd.Flags().IntVar(&steps, "steps", 0, "Apply at most this many migrations (0 applies all)")
+	cmd.Flags().StringVar(&baseline, "baseline", "", "Record migrations up to this version as applied without running them, for databases created before versioned migrations")
+	return cmd
+}
+
+func newDBMigrateDownCommand() *cobra.Command {
+	var steps int
+	cmd := &cobra.Command{
+		Use:   "down",
+		Short: "Revert the most recently applied migrations",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return withDatabase(cmd, func(client *ent.Client) error {
+				reverted, err := data.MigrateDown(cmd.Context(), client, steps)
+				for _, m := range reverted {
+					fmt.Fprintf(cmd.OutOrStdout(), "Reverted %s_%s\n", m.Version, m.Name)
+				}
+				writeMigrationEvent("db_migrate_down", reverted, err)
+				if err == nil && len(reverted) == 0 {
+					fmt.Fprintln(cmd.OutOrStdout(), "No migrations to revert")
+				}
+				return err
+			})
+		},
+	}
+	cmd.Flags().IntVar(&steps, "steps", 1, "Number of migrations to revert")
+	return cmd
+}
+
+func newDBMigrateStatusCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "status",
+		Short: "Show applied and pending migrations; exits non-zero unless the schema is current",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return withDatabase(cmd, func(client *ent.Client) error {
+				status, err := data.SchemaStatus(cmd.Context(), client)
+				if err != nil {
+					return err
+				}
+				tw := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 4, 2, ' ', 0)
+				fmt.Fprintln(tw, "VERSION\tNAME\tSTATE\tAPPLIED")
+				modified := make(map[string]bool, len(status.Modified))
+				for _, row := range status.Modified {
+					modified[row.Version] = true
+				}
+				unknown := make(map[string]bool, len(status.Unknown))
+				for _, row := range status.Unknown {
+					unknown[row.Version] = true
+				}
+				for _, row := range status.Applied {
+					state := "applied"
+					switch {
+					case modified[row.Version]:
+						state = "checksum mismatch"
+					case unknown[row.Version]:
+						state = "unknown to this build"
+					}
+					fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", row.Version, row.Name, state, formatTime(row.AppliedAt))
+				}
+				for _, m := range status.Pending {
+					fmt.Fprintf(tw, "%s\t%s\tpending\t-\n", m.Version, m.Name)
+				}
+				if err := tw.Flush(); err != nil {
+					return err
+				}
+				current := status.Current()
+				if current == "" {
+					current = "none"
+				}
+				fmt.Fprintf(cmd.OutOrStdout(), "Dialect %s, current %s, latest %s\n", status.Dialect, current, status.Latest)
+				return status.Err()
+			})
+		},
+	}
+}
+
+func writeMigrationEvent(action string, migrations []data.Migration, err error) {
+	versions := make([]string, 0, len(migrations))
+	for _, m := range migrations {
+		versions = append(versions, m.Version)
+	}
+	observability.NewEventWriter(nil).Write(observability.Event{
+		Action:   action,
+		Actor:    resolveActor(),
+		Outcome:  outcome(err),
+		Metadata: map[string]any{"versions": versions},
+		Error:    err,
+	})
+}
+
+func writeBackupEvent(action string, result backup.Result, pruned []string, err error) {
+	observability.NewEventWriter(nil).Write(observability.Event{
+		Action:   action,
+		Actor:    resolveActor(),
+		Outcome:  outcome(err),
+		Duration: result.Duration,
+		Metadata: map[string]any{
+			"path":           result.Path,
+			"bytes":          result.Bytes,
+			"schema_version": result.SchemaVersion,
+			"compressed":     result.Compressed,
+			"verified":       result.Verified,
+			"pruned":         pruned,
+		},
+		Error: err,
+	})
+}
+
+func outcome(err error) string {
+	if err != nil {
+		return "failure"
+	}
+	return "success"
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mcmx/duplynx/ent"
+	"github.com/mcmx/duplynx/internal/identity"
+	"github.com/mcmx/duplynx/internal/observability"
+)
+
+func newIdentityCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "identity",
+		Short: "Manage content identities that link duplicate groups across scans",
+	}
+	cmd.AddCommand(newIdentityLinkCommand())
+	return cmd
+}
+
+func newIdentityLinkCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "link",
+		Short: "Link every unlinked scan's groups to content identities, oldest scan first",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return withClient(cmd, func(client *ent.Client) error {
+				reports, err := identity.NewRepositoryFromClient(client).LinkPending(cmd.Context())
+				var total identity.LinkReport
+				for _, report := range reports {
+					total.Linked += report.Linked
+					total.Created += report.Created
+					total.Appeared += report.Appeared
+					total.Disappeared += report.Disappeared
+					total.KeepersCarried += report.KeepersCarried
+					total.ResolvedCarried += report.ResolvedCarried
+				}
+				observability.NewEventWriter(nil).Write(observability.Event{
+					Action:  "identity_link",
+					Actor:   resolveActor(),
+					Outcome: outcome(err),
+					Metadata: map[string]any{
+						"scans":            len(reports),
+						"groups":           total.Linked,
+						"identities":       total.Created,
+						"keepers_carried":  total.KeepersCarried,
+						"resolved_carried": total.ResolvedCarried,
+					},
+					Error: err,
+				})
+				if err != nil {
+					return err
+				}
+				_, err = fmt.Fprintf(cmd.OutOrStdout(),
+					"Linked %d groups from %d scans: %d new identities, %d copies appeared, %d disappeared, %d keepers and %d resolutions carried forward\n",
+					total.Linked, len(reports), total.Created, total.Appeared, total.Disappeared, total.KeepersCarried, total.ResolvedCarried)
+				return err
+			})
+		},
+	}
+}
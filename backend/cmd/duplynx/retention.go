@@ -0,0 +1,171 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mcmx/duplynx/ent"
+	"github.com/mcmx/duplynx/internal/observability"
+	"github.com/mcmx/duplynx/internal/retention"
+)
+
+func newRetentionCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retention",
+		Short: "Prune superseded scans and archive stale groups per tenant policy",
+	}
+
+	cmd.AddCommand(
+		newRetentionRunCommand(),
+		newRetentionShowCommand(),
+		newRetentionSetCommand(),
+	)
+	return cmd
+}
+
+func newRetentionRunCommand() *cobra.Command {
+	var opts retention.Options
+	cmd := &cobra.Command{
+		Use:   "run",
+		Short: "Apply retention now; --dry-run only reports what would be pruned",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return withPruner(cmd, func(pruner *retention.Pruner) error {
+				report, err := pruner.Run(cmd.Context(), opts)
+				writeRetentionEvent("retention_run", report, err)
+				if err != nil {
+					return err
+				}
+				return printRetentionReport(cmd.OutOrStdout(), report)
+			})
+		},
+	}
+	cmd.Flags().StringVar(&opts.TenantSlug, "tenant", "", "Limit the run to one tenant")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Report what would be pruned without changing anything")
+	return cmd
+}
+
+func newRetentionShowCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "show <tenant-slug>",
+		Short: "Show a tenant's effective retention policy",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withPruner(cmd, func(pruner *retention.Pruner) error {
+				overrides, err := pruner.Overrides(cmd.Context(), args[0])
+				if err != nil {
+					return err
+				}
+				printRetentionPolicy(cmd.OutOrStdout(), overrides, pruner.Defaults())
+				return nil
+			})
+		},
+	}
+}
+
+func newRetentionSetCommand() *cobra.Command {
+	var keepScans, archiveAfterDays int
+	var inherit bool
+	cmd := &cobra.Command{
+		Use:   "set <tenant-slug>",
+		Short: "Override a tenant's retention policy; unset rules keep their current value",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withPruner(cmd, func(pruner *retention.Pruner) error {
+				ctx := cmd.Context()
+				overrides, err := pruner.Overrides(ctx, args[0])
+				if err != nil {
+					return err
+				}
+				if inherit {
+					overrides = retention.Overrides{}
+				}
+				if cmd.Flags().Changed("keep-scans") {
+					overrides.KeepScans = &keepScans
+				}
+				if cmd.Flags().Changed("archive-after-days") {
+					overrides.ArchiveAfterDays = &archiveAfterDays
+				}
+				policy, err := pruner.SetOverrides(ctx, args[0], overrides)
+				if err != nil {
+					return err
+				}
+				observability.NewEventWriter(nil).Write(observability.Event{
+					Action:  "retention_set",
+					Actor:   resolveActor(),
+					Outcome: "success",
+					Metadata: map[string]any{
+						"tenant":             args[0],
+						"keep_scans":         policy.KeepScans,
+						"archive_after_days": policy.ArchiveAfterDays,
+					},
+				})
+				printRetentionPolicy(cmd.OutOrStdout(), overrides, pruner.Defaults())
+				return nil
+			})
+		},
+	}
+	cmd.Flags().IntVar(&keepScans, "keep-scans", 0, "Completed scans per machine that keep their file instances (0 disables pruning)")
+	cmd.Flags().IntVar(&archiveAfterDays, "archive-after-days", 0, "Archive resolved groups unchanged for this many days (0 disables archiving)")
+	cmd.Flags().BoolVar(&inherit, "inherit", false, "Clear the tenant's overrides first so unset rules follow the server defaults")
+	return cmd
+}
+
+func withPruner(cmd *cobra.Command, fn func(*retention.Pruner) error) error {
+	return withClient(cmd, func(client *ent.Client) error {
+		return fn(retention.NewPrunerFromClient(client, retention.DefaultPolicy()))
+	})
+}
+
+func printRetentionPolicy(w io.Writer, overrides retention.Overrides, defaults retention.Policy) {
+	policy := overrides.Apply(defaults)
+	source := func(override *int) string {
+		if override == nil {
+			return "default"
+		}
+		return "override"
+	}
+	fmt.Fprintf(w, "keep-scans=%d (%s) archive-after-days=%d (%s)\n",
+		policy.KeepScans, source(overrides.KeepScans),
+		policy.ArchiveAfterDays, source(overrides.ArchiveAfterDays))
+}
+
+func printRetentionReport(w io.Writer, report retention.Report) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "TENANT\tSCAN\tSTARTED\tGROUPS\tFILES")
+	for _, tenant := range report.Tenants {
+		for _, scan := range tenant.Scans {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%d\t%d\n", tenant.TenantSlug, scan.Name, formatTime(scan.StartedAt), scan.Groups, scan.Files)
+		}
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	scans, files, groups := report.Totals()
+	format := "Pruned %d file instances from %d superseded scans and archived %d resolved groups\n"
+	if report.DryRun {
+		format = "Would prune %d file instances from %d superseded scans and archive %d resolved groups\n"
+	}
+	_, err := fmt.Fprintf(w, format, files, scans, groups)
+	return err
+}
+
+func writeRetentionEvent(action string, report retention.Report, err error) {
+	scans, files, groups := report.Totals()
+	observability.NewEventWriter(nil).Write(observability.Event{
+		Action:  action,
+		Actor:   resolveActor(),
+		Outcome: outcome(err),
+		Metadata: map[string]any{
+			"dry_run":         report.DryRun,
+			"tenants":         len(report.Tenants),
+			"scans":           scans,
+			"file_instances":  files,
+			"archived_groups": groups,
+		},
+		Error: err,
+	})
+}
@@ -36,7 +36,14 @@ func newRootCommand() *cobra.Command {
 
 	cmd.AddCommand(
 		newServeCommand(),
+		newAgentCommand(),
 		newSeedCommand(),
+		newSecretsCommand(),
+		newTenantCommand(),
+		newDBCommand(),
+		newRetentionCommand(),
+		newIdentityCommand(),
+		newScheduleCommand(),
 	)
 
 	cmd.SetContext(context.Background())
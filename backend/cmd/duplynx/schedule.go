@@ -0,0 +1,272 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	"github.com/mcmx/duplynx/ent"
+	"github.com/mcmx/duplynx/internal/observability"
+	"github.com/mcmx/duplynx/internal/schedule"
+)
+
+func newScheduleCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule",
+		Short: "Manage recurring multi-machine scans",
+	}
+
+	cmd.AddCommand(
+		newScheduleListCommand(),
+		newScheduleShowCommand(),
+		newScheduleCreateCommand(),
+		newScheduleUpdateCommand(),
+		newScheduleDeleteCommand(),
+	)
+	return cmd
+}
+
+// scheduleFlags are the settings shared by schedule create and update.
+type scheduleFlags struct {
+	name       string
+	cron       string
+	timezone   string
+	machines   []string
+	roots      []string
+	exclusions []string
+	deadline   time.Duration
+	disabled   bool
+}
+
+func (f *scheduleFlags) register(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&f.name, "name", "", "Schedule name; each scan is named after it")
+	cmd.Flags().StringVar(&f.cron, "cron", "", `Five-field cron expression, e.g. "0 2 * * *" or @daily`)
+	cmd.Flags().StringVar(&f.timezone, "timezone", "UTC", "IANA timezone the cron expression is evaluated in")
+	cmd.Flags().StringSliceVar(&f.machines, "machine", nil, "Target machine ID (repeatable)")
+	cmd.Flags().StringSliceVar(&f.roots, "root", nil, "Root path each machine scans (repeatable)")
+	cmd.Flags().StringSliceVar(&f.exclusions, "exclude", nil, "Path pattern each machine skips (repeatable)")
+	cmd.Flags().DurationVar(&f.deadline, "deadline", 0, "End each scan this long after it starts (0 disables)")
+	cmd.Flags().BoolVar(&f.disabled, "disabled", false, "Store the schedule without running it")
+}
+
+// apply overlays the flags the user set onto in.
+func (f *scheduleFlags) apply(cmd *cobra.Command, in *schedule.Input) error {
+	changed := cmd.Flags().Changed
+	if changed("name") {
+		in.Name = f.name
+	}
+	if changed("cron") {
+		in.Cron = f.cron
+	}
+	if changed("timezone") || in.Timezone == "" {
+		in.Timezone = f.timezone
+	}
+	if changed("machine") {
+		in.MachineIDs = nil
+		for _, raw := range f.machines {
+			id, err := uuid.Parse(raw)
+			if err != nil {
+				return fmt.Errorf("--machine %q is not a machine ID", raw)
+			}
+			in.MachineIDs = append(in.MachineIDs, id)
+		}
+	}
+	if changed("root") {
+		in.Roots = f.roots
+	}
+	if changed("exclude") {
+		in.Exclusions = f.exclusions
+	}
+	if changed("deadline") {
+		in.Deadline = f.deadline
+	}
+	if changed("disabled") {
+		in.Enabled = !f.disabled
+	}
+	return nil
+}
+
+func newScheduleListCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list <tenant-slug>",
+		Short: "List a tenant's scan schedules",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withScheduleRepository(cmd, func(repo *schedule.Repository) error {
+				schedules, err := repo.List(cmd.Context(), args[0])
+				if err != nil {
+					return err
+				}
+				tw := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 4, 2, ' ', 0)
+				fmt.Fprintln(tw, "ID\tNAME\tCRON\tTIMEZONE\tMACHINES\tNEXT RUN\tLAST RUN\tSTATE")
+				for _, s := range schedules {
+					state := "enabled"
+					if !s.Enabled {
+						state = "disabled"
+					}
+					fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%d\t%s\t%s\t%s\n",
+						s.ID, s.Name, s.Cron, s.Timezone, len(s.MachineIDs),
+						formatTime(s.NextRunAt), formatTime(s.LastRunAt), state)
+				}
+				return tw.Flush()
+			})
+		},
+	}
+}
+
+func newScheduleShowCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "show <tenant-slug> <schedule-id>",
+		Short: "Show a scan schedule and its recent runs",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withScheduleRepository(cmd, func(repo *schedule.Repository) error {
+				s, err := repo.Get(cmd.Context(), args[0], args[1])
+				if err != nil {
+					return err
+				}
+				out := cmd.OutOrStdout()
+				fmt.Fprintf(out, "id=%s\nname=%s\ncron=%s\ntimezone=%s\nenabled=%t\n", s.ID, s.Name, s.Cron, s.Timezone, s.Enabled)
+				fmt.Fprintf(out, "machines=%s\nroots=%s\n", strings.Join(s.MachineIDs, ","), strings.Join(s.Roots, ","))
+				if len(s.Exclusions) > 0 {
+					fmt.Fprintf(out, "exclusions=%s\n", strings.Join(s.Exclusions, ","))
+				}
+				if s.Deadline > 0 {
+					fmt.Fprintf(out, "deadline=%s\n", s.Deadline)
+				}
+				fmt.Fprintf(out, "next_run=%s\nlast_run=%s\n\n", formatTime(s.NextRunAt), formatTime(s.LastRunAt))
+
+				tw := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+				fmt.Fprintln(tw, "SCHEDULED FOR\tSTATUS\tSCAN\tREASON")
+				for _, run := range s.Runs {
+					scanID := run.ScanID
+					if scanID == "" {
+						scanID = "-"
+					}
+					fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", formatTime(run.ScheduledFor), run.Status, scanID, run.Reason)
+				}
+				return tw.Flush()
+			})
+		},
+	}
+}
+
+func newScheduleCreateCommand() *cobra.Command {
+	var flags scheduleFlags
+	cmd := &cobra.Command{
+		Use:   "create <tenant-slug>",
+		Short: "Create a recurring scan of one or more machines",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			in := schedule.Input{Enabled: true}
+			if err := flags.apply(cmd, &in); err != nil {
+				return err
+			}
+			return withScheduleRepository(cmd, func(repo *schedule.Repository) error {
+				created, err := repo.Create(cmd.Context(), args[0], in)
+				writeScheduleEvent("schedule_create", args[0], created.ID, err)
+				if err != nil {
+					return err
+				}
+				_, err = fmt.Fprintf(cmd.OutOrStdout(), "Created schedule %s (%s); next run %s\n",
+					created.ID, created.Name, formatTime(created.NextRunAt))
+				return err
+			})
+		},
+	}
+	flags.register(cmd)
+	return cmd
+}
+
+func newScheduleUpdateCommand() *cobra.Command {
+	var flags scheduleFlags
+	cmd := &cobra.Command{
+		Use:   "update <tenant-slug> <schedule-id>",
+		Short: "Change a scan schedule; flags that are not set keep their value",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withScheduleRepository(cmd, func(repo *schedule.Repository) error {
+				current, err := repo.Get(cmd.Context(), args[0], args[1])
+				if err != nil {
+					return err
+				}
+				in := schedule.Input{
+					Name:       current.Name,
+					Cron:       current.Cron,
+					Timezone:   current.Timezone,
+					Roots:      current.Roots,
+					Exclusions: current.Exclusions,
+					Deadline:   current.Deadline,
+					Enabled:    current.Enabled,
+				}
+				for _, raw := range current.MachineIDs {
+					id, err := uuid.Parse(raw)
+					if err != nil {
+						return fmt.Errorf("schedule %s has a bad machine ID %q", current.ID, raw)
+					}
+					in.MachineIDs = append(in.MachineIDs, id)
+				}
+				if err := flags.apply(cmd, &in); err != nil {
+					return err
+				}
+				updated, err := repo.Update(cmd.Context(), args[0], args[1], in)
+				writeScheduleEvent("schedule_update", args[0], args[1], err)
+				if err != nil {
+					return err
+				}
+				_, err = fmt.Fprintf(cmd.OutOrStdout(), "Updated schedule %s (%s); next run %s\n",
+					updated.ID, updated.Name, formatTime(updated.NextRunAt))
+				return err
+			})
+		},
+	}
+	flags.register(cmd)
+	return cmd
+}
+
+func newScheduleDeleteCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <tenant-slug> <schedule-id>",
+		Short: "Delete a scan schedule and its run history; scans it started are kept",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withScheduleRepository(cmd, func(repo *schedule.Repository) error {
+				err := repo.Delete(cmd.Context(), args[0], args[1])
+				writeScheduleEvent("schedule_delete", args[0], args[1], err)
+				if err != nil {
+					return err
+				}
+				_, err = fmt.Fprintf(cmd.OutOrStdout(), "Deleted schedule %s\n", args[1])
+				return err
+			})
+		},
+	}
+}
+
+func withScheduleRepository(cmd *cobra.Command, fn func(*schedule.Repository) error) error {
+	return withClient(cmd, func(client *ent.Client) error {
+		return fn(schedule.NewRepositoryFromClient(client))
+	})
+}
+
+// writeScheduleEvent records a schedule change; validation errors are not logged.
+func writeScheduleEvent(action, tenantSlug, scheduleID string, err error) {
+	if errors.Is(err, schedule.ErrInvalidSchedule) {
+		return
+	}
+	observability.NewEventWriter(nil).Write(observability.Event{
+		Action:  action,
+		Actor:   resolveActor(),
+		Outcome: outcome(err),
+		Metadata: map[string]any{
+			"tenant":   tenantSlug,
+			"schedule": scheduleID,
+		},
+		Error: err,
+	})
+}
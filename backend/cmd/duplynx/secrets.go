@@ -0,0 +1,190 @@
+package main
+
+import (
+	"fmt"
+	"text/tabwriter"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	"github.com/mcmx/duplynx/ent"
+	"github.com/mcmx/duplynx/internal/config"
+	"github.com/mcmx/duplynx/internal/data"
+	"github.com/mcmx/duplynx/internal/ingestion"
+	"github.com/mcmx/duplynx/internal/observability"
+	"github.com/mcmx/duplynx/internal/tenancy/isolation"
+)
+
+func newSecretsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "secrets",
+		Short: "Manage versioned tenant ingestion secrets",
+	}
+
+	cmd.AddCommand(
+		newSecretsListCommand(),
+		newSecretsRotateCommand(),
+		newSecretsRevokeCommand(),
+	)
+	return cmd
+}
+
+func newSecretsListCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list <tenant-slug>",
+		Short: "List secret versions for a tenant",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withSecretRepository(cmd, func(repo *ingestion.SecretRepository) error {
+				versions, err := repo.List(cmd.Context(), args[0])
+				if err != nil {
+					return err
+				}
+				now := time.Now()
+				tw := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 4, 2, ' ', 0)
+				fmt.Fprintln(tw, "KEY ID\tMACHINE\tNOT BEFORE\tEXPIRES\tSTATE")
+				for _, version := range versions {
+					machine := "-"
+					if version.MachineID != uuid.Nil {
+						machine = version.MachineID.String()
+					}
+					fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n",
+						version.KeyID,
+						machine,
+						formatTime(version.NotBefore),
+						formatTime(version.ExpiresAt),
+						secretState(version, now),
+					)
+				}
+				return tw.Flush()
+			})
+		},
+	}
+}
+
+func newSecretsRotateCommand() *cobra.Command {
+	var (
+		overlap, validFor time.Duration
+		machine           string
+	)
+	cmd := &cobra.Command{
+		Use:   "rotate <tenant-slug>",
+		Short: "Issue a new secret version and schedule expiry of the current ones",
+		Long: "Issue a new secret version and schedule expiry of the current ones. With --machine the version " +
+			"is bound to that machine, which agents need; only the machine's own versions are replaced.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts := ingestion.RotateOptions{Overlap: overlap, ValidFor: validFor}
+			if machine != "" {
+				machineID, err := uuid.Parse(machine)
+				if err != nil {
+					return fmt.Errorf("--machine must be a machine ID: %w", err)
+				}
+				opts.MachineID = machineID
+			}
+			return withSecretRepository(cmd, func(repo *ingestion.SecretRepository) error {
+				version, err := repo.Rotate(cmd.Context(), args[0], opts)
+				if err != nil {
+					return err
+				}
+				observability.NewEventWriter(nil).Write(observability.Event{
+					Action:  "secret_rotate",
+					Actor:   resolveActor(),
+					Outcome: "success",
+					Metadata: map[string]any{
+						"tenant":  args[0],
+						"key_id":  version.KeyID,
+						"machine": machine,
+					},
+				})
+				_, err = fmt.Fprintf(cmd.OutOrStdout(),
+					"key_id=%s\nsecret=%s\nPrevious versions remain valid until %s.\n",
+					version.KeyID, version.Secret, formatTime(version.NotBefore.Add(overlap)))
+				return err
+			})
+		},
+	}
+	cmd.Flags().DurationVar(&overlap, "overlap", ingestion.DefaultRotationOverlap, "How long previous secret versions stay valid")
+	cmd.Flags().DurationVar(&validFor, "valid-for", 0, "Schedule expiry of the new version after this duration (0 disables)")
+	cmd.Flags().StringVar(&machine, "machine", "", "Bind the new version to this machine for its agent")
+	return cmd
+}
+
+func newSecretsRevokeCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke <tenant-slug> <key-id>",
+		Short: "Immediately invalidate a secret version",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withSecretRepository(cmd, func(repo *ingestion.SecretRepository) error {
+				if err := repo.Revoke(cmd.Context(), args[0], args[1]); err != nil {
+					return err
+				}
+				_, err := fmt.Fprintf(cmd.OutOrStdout(), "Revoked %s for %s\n", args[1], args[0])
+				return err
+			})
+		},
+	}
+}
+
+func withSecretRepository(cmd *cobra.Command, fn func(*ingestion.SecretRepository) error) error {
+	return withClient(cmd, func(client *ent.Client) error {
+		return fn(ingestion.NewSecretRepositoryFromClient(client))
+	})
+}
+
+// withClient opens the configured database, checks that its schema matches this
+// build, and hands the client to fn.
+// Maintenance commands span tenants, so the command context is marked as system.
+func withClient(cmd *cobra.Command, fn func(*ent.Client) error) error {
+	return withDatabase(cmd, func(client *ent.Client) error {
+		if err := data.CheckSchema(cmd.Context(), client); err != nil {
+			return err
+		}
+		return fn(client)
+	})
+}
+
+// withDatabase opens the configured database without checking its schema, for
+// the db commands that inspect or change it.
+func withDatabase(cmd *cobra.Command, fn func(*ent.Client) error) (err error) {
+	ctx := isolation.WithSystem(cmd.Context())
+	cmd.SetContext(ctx)
+	cfg, ok := config.FromContext(ctx)
+	if !ok {
+		cfg = runtimeCfg
+	}
+
+	client, err := data.Open(ctx, cfg.DBDriver, cfg.DatabaseDSN())
+	if err != nil {
+		return err
+	}
+	defer func() {
+		closeErr := data.Close(client)
+		if err == nil {
+			err = closeErr
+		}
+	}()
+	return fn(client)
+}
+
+func secretState(version ingestion.SecretVersion, now time.Time) string {
+	switch {
+	case !version.RevokedAt.IsZero():
+		return "revoked"
+	case now.Before(version.NotBefore):
+		return "scheduled"
+	case version.ActiveAt(now):
+		return "active"
+	default:
+		return "expired"
+	}
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -30,6 +30,7 @@ func runSeed(cmd *cobra.Command, _ []string) (err error) {
 
 	actor := resolveActor()
 	metadata := map[string]any{
+		"db_driver":  cfg.DBDriver,
 		"db_file":    cfg.DBFile,
 		"assets_dir": cfg.AssetsDir,
 	}
@@ -58,7 +59,7 @@ func runSeed(cmd *cobra.Command, _ []string) (err error) {
 		})
 	}()
 
-	client, err := data.OpenSQLite(ctx, cfg.SQLiteDSN())
+	client, err := data.Open(ctx, cfg.DBDriver, cfg.DatabaseDSN())
 	if err != nil {
 		return err
 	}
@@ -9,29 +9,81 @@ import (
 	"runtime"
 	"time"
 
+	"entgo.io/ent/dialect"
 	"github.com/spf13/cobra"
 
 	"github.com/mcmx/duplynx/internal/actions"
 	"github.com/mcmx/duplynx/internal/app"
+	"github.com/mcmx/duplynx/internal/backup"
 	"github.com/mcmx/duplynx/internal/config"
 	"github.com/mcmx/duplynx/internal/data"
+	"github.com/mcmx/duplynx/internal/events"
 	apphttp "github.com/mcmx/duplynx/internal/http"
+	"github.com/mcmx/duplynx/internal/http/session"
+	"github.com/mcmx/duplynx/internal/identity"
+	"github.com/mcmx/duplynx/internal/ingestion"
 	"github.com/mcmx/duplynx/internal/observability"
+	"github.com/mcmx/duplynx/internal/quota"
+	"github.com/mcmx/duplynx/internal/reclaim"
+	"github.com/mcmx/duplynx/internal/retention"
+	"github.com/mcmx/duplynx/internal/scandiff"
 	"github.com/mcmx/duplynx/internal/scans"
+	"github.com/mcmx/duplynx/internal/schedule"
+	"github.com/mcmx/duplynx/internal/search"
+	"github.com/mcmx/duplynx/internal/templ"
 	"github.com/mcmx/duplynx/internal/tenancy"
 )
 
+// backupSchedule configures the backups serve takes while it runs.
+type backupSchedule struct {
+	dir      string
+	interval time.Duration
+	keep     int
+	compress bool
+}
+
+// serveOptions holds the serve-only flags.
+type serveOptions struct {
+	backups           backupSchedule
+	retentionInterval time.Duration
+	heartbeatTimeout  time.Duration
+	deadlineInterval  time.Duration
+	scheduleInterval  time.Duration
+	stubActions       bool
+}
+
+const (
+	// defaultHeartbeatTimeout is how long an active scan may go without an agent heartbeat.
+	defaultHeartbeatTimeout = 10 * time.Minute
+	// defaultDeadlineInterval is how often multi-machine scans are checked for passed deadlines.
+	defaultDeadlineInterval = time.Minute
+)
+
 func newServeCommand() *cobra.Command {
+	var opts serveOptions
+	backups := &opts.backups
 	cmd := &cobra.Command{
 		Use:   "serve",
 		Short: "Start the DupLynx demo web server",
-		RunE:  runServe,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runServe(cmd, args, opts)
+		},
 	}
+	cmd.Flags().StringVar(&backups.dir, "backup-dir", "", "Directory for scheduled SQLite backups (requires --backup-interval)")
+	cmd.Flags().DurationVar(&backups.interval, "backup-interval", 0, "Take a backup this often, e.g. 6h (0 disables)")
+	cmd.Flags().IntVar(&backups.keep, "backup-keep", backup.DefaultKeep, "Number of scheduled backups to retain")
+	cmd.Flags().BoolVar(&backups.compress, "backup-compress", true, "Gzip scheduled backups")
+	cmd.Flags().DurationVar(&opts.retentionInterval, "retention-interval", retention.DefaultInterval, "Apply tenant retention policies this often (0 disables)")
+	cmd.Flags().DurationVar(&opts.heartbeatTimeout, "scan-heartbeat-timeout", defaultHeartbeatTimeout, "Fail active scans without an agent heartbeat for this long (0 disables)")
+	cmd.Flags().DurationVar(&opts.deadlineInterval, "scan-deadline-interval", defaultDeadlineInterval, "End multi-machine scans past their deadline, checking this often (0 disables)")
+	cmd.Flags().DurationVar(&opts.scheduleInterval, "schedule-interval", schedule.DefaultInterval, "Start due scheduled scans, checking this often (0 disables)")
+	cmd.Flags().BoolVar(&opts.stubActions, "stub-actions", false, "Only record duplicate actions instead of queueing them for the agents holding the copies")
 
 	return cmd
 }
 
-func runServe(cmd *cobra.Command, _ []string) (err error) {
+func runServe(cmd *cobra.Command, _ []string, opts serveOptions) (err error) {
+	backups := opts.backups
 	ctx := cmd.Context()
 	cfg, ok := config.FromContext(ctx)
 	if !ok {
@@ -41,6 +93,7 @@ func runServe(cmd *cobra.Command, _ []string) (err error) {
 	actor := resolveActor()
 	metadata := map[string]any{
 		"addr":       cfg.Addr,
+		"db_driver":  cfg.DBDriver,
 		"db_file":    cfg.DBFile,
 		"assets_dir": cfg.AssetsDir,
 		"pid":        os.Getpid(),
@@ -70,11 +123,24 @@ func runServe(cmd *cobra.Command, _ []string) (err error) {
 		})
 	}()
 
-	if err = app.ValidateAssetDirectory(cfg.AssetsDir); err != nil {
+	appCfg := app.LoadConfig()
+	bundle, err := app.ResolveAssets(cfg.AssetsDir, appCfg.EmbedStatic)
+	if err != nil {
 		return err
 	}
+	metadata["assets_source"] = bundle.Source()
+	templ.UseAssets(bundle)
 
-	client, err := data.OpenSQLite(ctx, cfg.SQLiteDSN())
+	// The shared lock makes `db restore` refuse to swap the file out from under us.
+	if dbFile, ok := cfg.SQLiteFile(); ok {
+		lock, lockErr := data.LockDatabase(dbFile, false)
+		if lockErr != nil {
+			return lockErr
+		}
+		defer lock.Release()
+	}
+
+	client, err := data.Open(ctx, cfg.DBDriver, cfg.DatabaseDSN())
 	if err != nil {
 		return err
 	}
@@ -85,25 +151,129 @@ func runServe(cmd *cobra.Command, _ []string) (err error) {
 		}
 	}()
 
-	if err = data.Migrate(ctx, client); err != nil {
+	// Schema changes are applied with `duplynx db migrate up`, never implicitly.
+	if err = data.CheckSchema(ctx, client); err != nil {
 		return err
 	}
+	if _, err = search.EnsureIndex(ctx, client); err != nil {
+		return err
+	}
+
+	if backups.interval > 0 {
+		if backups.dir == "" {
+			return errors.New("--backup-interval needs --backup-dir")
+		}
+		if client.Dialect() != dialect.SQLite {
+			return backup.ErrUnsupportedDriver
+		}
+		scheduler := &backup.Scheduler{
+			Client:   client,
+			Dir:      backups.dir,
+			Interval: backups.interval,
+			Keep:     backups.keep,
+			Options:  backup.Options{Compress: backups.compress, Verify: true},
+			OnBackup: func(result backup.Result, pruned []string, err error) {
+				writeBackupEvent("scheduled_backup", result, pruned, err)
+			},
+		}
+		metadata["backup_dir"] = backups.dir
+		metadata["backup_interval"] = backups.interval.String()
+		go scheduler.Run(ctx)
+	}
+
+	if opts.retentionInterval > 0 {
+		scheduler := &retention.Scheduler{
+			Pruner:   retention.NewPrunerFromClient(client, retention.DefaultPolicy()),
+			Interval: opts.retentionInterval,
+			OnRun: func(report retention.Report, err error) {
+				writeRetentionEvent("scheduled_retention", report, err)
+			},
+		}
+		metadata["retention_interval"] = opts.retentionInterval.String()
+		go scheduler.Run(ctx)
+	}
 
 	tenancyRepo := tenancy.NewRepositoryFromClient(client, &tenancy.AuditLogger{})
 	scanRepo := scans.NewRepositoryFromClient(client)
 	actionsRepo := actions.NewRepositoryFromClient(client)
+	quotas := quota.NewEnforcerFromClient(client, quota.DefaultLimits())
 	dispatcher := actions.NewDispatcher(actionsRepo, &actions.AuditLogger{})
+	dispatcher.Quotas = quotas
+	bus := events.NewBus(events.DefaultBuffer)
+	dispatcher.Events = bus
+	dispatcher.RunOnAgents = !opts.stubActions
+	metadata["stub_actions"] = opts.stubActions
+	secretRepo := ingestion.NewSecretRepositoryFromClient(client)
+
+	if opts.heartbeatTimeout > 0 {
+		watcher := &scans.StaleWatcher{
+			Repo:    scanRepo,
+			Timeout: opts.heartbeatTimeout,
+			OnFail: func(failed []scans.ScanSummary, err error) {
+				writeScanWatchEvent("scan_stale", failed, err)
+				for _, summary := range failed {
+					bus.Publish(events.Event{Type: events.TypeScanProgress, TenantSlug: summary.TenantSlug, ScanID: summary.ID})
+				}
+			},
+		}
+		metadata["scan_heartbeat_timeout"] = opts.heartbeatTimeout.String()
+		go watcher.Run(ctx)
+	}
+	if opts.deadlineInterval > 0 {
+		watcher := &scans.DeadlineWatcher{
+			Repo:     scanRepo,
+			Interval: opts.deadlineInterval,
+			OnExpire: func(expired []scans.ScanSummary, err error) {
+				writeScanWatchEvent("scan_deadline", expired, err)
+				for _, summary := range expired {
+					bus.Publish(events.Event{Type: events.TypeScanProgress, TenantSlug: summary.TenantSlug, ScanID: summary.ID})
+				}
+			},
+		}
+		metadata["scan_deadline_interval"] = opts.deadlineInterval.String()
+		go watcher.Run(ctx)
+	}
+
+	schedules := schedule.NewRepositoryFromClient(client)
+	if opts.scheduleInterval > 0 {
+		scheduler := &schedule.Scheduler{
+			Repo:     schedules,
+			Interval: opts.scheduleInterval,
+			OnRun: func(runs []schedule.Run, err error) {
+				writeScheduledScansEvent(runs, err)
+				for _, run := range runs {
+					if run.ScanID != "" {
+						bus.Publish(events.Event{Type: events.TypeScanProgress, TenantSlug: run.TenantSlug, ScanID: run.ScanID})
+					}
+				}
+			},
+		}
+		metadata["schedule_interval"] = opts.scheduleInterval.String()
+		go scheduler.Run(ctx)
+	}
 
 	server := app.NewHTTPServer(app.ServerOptions{
 		Addr: cfg.Addr,
 		Handler: apphttp.NewRouter(apphttp.Dependencies{
-			TenancyRepo:       tenancyRepo,
-			ScanRepo:          scanRepo,
-			ActionsRepo:       actionsRepo,
-			ActionsDispatcher: dispatcher,
-			StaticFS:          http.Dir(cfg.AssetsDir),
+			TenancyRepo:         tenancyRepo,
+			ScanRepo:            scanRepo,
+			ActionsRepo:         actionsRepo,
+			ActionsDispatcher:   dispatcher,
+			Assets:              bundle,
+			SecretRepo:          secretRepo,
+			LegacyTenantSecrets: appCfg.TenantSecrets,
+			Quotas:              quotas,
+			Sessions:            session.NewStore(session.DefaultTTL),
+			Events:              bus,
+			Reclaim:             reclaim.NewRepositoryFromClient(client),
+			Search:              search.NewRepositoryFromClient(client),
+			Identities:          identity.NewRepositoryFromClient(client),
+			ScanDiff:            scandiff.NewRepositoryFromClient(client),
+			Schedules:           schedules,
 		}),
 	})
+	// Open board event streams would otherwise hold graceful shutdown until its timeout.
+	server.RegisterOnShutdown(bus.Close)
 
 	errCh := make(chan error, 1)
 	go func() {
@@ -128,6 +298,45 @@ func runServe(cmd *cobra.Command, _ []string) (err error) {
 	return err
 }
 
+// writeScanWatchEvent logs scans ended by a serve watcher.
+func writeScanWatchEvent(action string, ended []scans.ScanSummary, err error) {
+	ids := make([]string, 0, len(ended))
+	for _, summary := range ended {
+		ids = append(ids, summary.ID)
+	}
+	writer := observability.NewEventWriter(nil)
+	writer.Write(observability.Event{
+		Action:   action,
+		Actor:    resolveActor(),
+		Outcome:  outcome(err),
+		Metadata: map[string]any{"scans": ids},
+		Error:    err,
+	})
+}
+
+// writeScheduledScansEvent logs the runs recorded by the serve scheduler.
+func writeScheduledScansEvent(runs []schedule.Run, err error) {
+	recorded := make([]map[string]any, 0, len(runs))
+	for _, run := range runs {
+		recorded = append(recorded, map[string]any{
+			"tenant":        run.TenantSlug,
+			"schedule":      run.ScheduleID,
+			"scheduled_for": run.ScheduledFor.UTC().Format(time.RFC3339),
+			"status":        run.Status,
+			"scan":          run.ScanID,
+			"reason":        run.Reason,
+		})
+	}
+	writer := observability.NewEventWriter(nil)
+	writer.Write(observability.Event{
+		Action:   "scheduled_scans",
+		Actor:    resolveActor(),
+		Outcome:  outcome(err),
+		Metadata: map[string]any{"runs": recorded},
+		Error:    err,
+	})
+}
+
 func resolveActor() string {
 	if v := os.Getenv("CI"); v != "" {
 		return "ci"
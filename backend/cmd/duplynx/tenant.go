@@ -0,0 +1,140 @@
+package main
+
+import (
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mcmx/duplynx/ent"
+	"github.com/mcmx/duplynx/internal/data"
+	"github.com/mcmx/duplynx/internal/observability"
+)
+
+func newTenantCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tenant",
+		Short: "Export or purge a tenant's data",
+	}
+
+	cmd.AddCommand(
+		newTenantExportCommand(),
+		newTenantPurgeCommand(),
+	)
+	return cmd
+}
+
+func newTenantExportCommand() *cobra.Command {
+	var format, out string
+	cmd := &cobra.Command{
+		Use:   "export <tenant-slug>",
+		Short: "Write a portable archive of a tenant's machines, scans, groups, files, and audits",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withClient(cmd, func(client *ent.Client) (err error) {
+				var w io.Writer = cmd.OutOrStdout()
+				if out != "" && out != "-" {
+					file, createErr := os.Create(out)
+					if createErr != nil {
+						return fmt.Errorf("create export file: %w", createErr)
+					}
+					defer func() {
+						if closeErr := file.Close(); err == nil {
+							err = closeErr
+						}
+					}()
+					w = file
+					if strings.HasSuffix(out, ".gz") {
+						gz := gzip.NewWriter(file)
+						defer func() {
+							if closeErr := gz.Close(); err == nil {
+								err = closeErr
+							}
+						}()
+						w = gz
+					}
+				}
+
+				counts, err := data.ExportTenant(cmd.Context(), client, args[0], format, w)
+				if err != nil {
+					return err
+				}
+				// The archive may be going to stdout, so the event goes to stderr.
+				logger := slog.New(slog.NewTextHandler(cmd.ErrOrStderr(), nil))
+				observability.NewEventWriter(logger).Write(observability.Event{
+					Action:  "tenant_export",
+					Actor:   resolveActor(),
+					Outcome: "success",
+					Metadata: map[string]any{
+						"tenant": args[0],
+						"format": format,
+						"out":    out,
+						"rows":   counts.Total(),
+					},
+				})
+				return nil
+			})
+		},
+	}
+	cmd.Flags().StringVar(&format, "format", data.ExportJSON, "Archive format (json or ndjson)")
+	cmd.Flags().StringVar(&out, "out", "", "Write the archive to this file instead of stdout (.gz compresses)")
+	return cmd
+}
+
+func newTenantPurgeCommand() *cobra.Command {
+	var confirm bool
+	cmd := &cobra.Command{
+		Use:   "purge <tenant-slug>",
+		Short: "Permanently delete every row belonging to a tenant",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withClient(cmd, func(client *ent.Client) error {
+				if !confirm {
+					counts, err := data.CountTenantRows(cmd.Context(), client, args[0])
+					if err != nil {
+						return err
+					}
+					printRowCounts(cmd.OutOrStdout(), counts)
+					return errors.New("refusing to purge without --yes; export the tenant first if its data must be kept")
+				}
+
+				actor := resolveActor()
+				report, err := data.PurgeTenant(cmd.Context(), client, args[0], actor)
+				outcome := "success"
+				if err != nil {
+					outcome = "failure"
+				}
+				observability.NewEventWriter(nil).Write(observability.Event{
+					Action:  "tenant_purge",
+					Actor:   actor,
+					Outcome: outcome,
+					Metadata: map[string]any{
+						"tenant":    args[0],
+						"rows":      report.Deleted.Total(),
+						"tombstone": report.TombstoneID.String(),
+					},
+					Error: err,
+				})
+				if err != nil {
+					return err
+				}
+				printRowCounts(cmd.OutOrStdout(), report.Deleted)
+				_, err = fmt.Fprintf(cmd.OutOrStdout(), "Purged tenant %s (tombstone %s)\n", report.TenantSlug, report.TombstoneID)
+				return err
+			})
+		},
+	}
+	cmd.Flags().BoolVar(&confirm, "yes", false, "Confirm the purge; without it the command only reports row counts")
+	return cmd
+}
+
+func printRowCounts(w io.Writer, counts data.RowCounts) {
+	fmt.Fprintf(w, "machines=%d scans=%d duplicate_groups=%d file_instances=%d action_audits=%d secrets=%d content_identities=%d content_events=%d scan_targets=%d scan_schedules=%d scan_schedule_runs=%d action_jobs=%d\n",
+		counts.Machines, counts.Scans, counts.DuplicateGroups, counts.FileInstances, counts.ActionAudits, counts.Secrets,
+		counts.ContentIdentities, counts.ContentEvents, counts.ScanTargets, counts.ScanSchedules, counts.ScanScheduleRuns, counts.ActionJobs)
+}
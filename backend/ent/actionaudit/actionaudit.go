@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
@@ -80,7 +81,14 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/mcmx/duplynx/ent/runtime"
 var (
+	Hooks        [1]ent.Hook
+	Interceptors [1]ent.Interceptor
 	// DefaultCreateTime holds the default value on creation for the "create_time" field.
 	DefaultCreateTime func() time.Time
 	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
@@ -108,6 +116,8 @@ const (
 	ActionTypeQuarantine      ActionType = "quarantine"
 	ActionTypeRetry           ActionType = "retry"
 	ActionTypeNote            ActionType = "note"
+	ActionTypeActionQueued    ActionType = "action_queued"
+	ActionTypeActionFailed    ActionType = "action_failed"
 )
 
 func (at ActionType) String() string {
@@ -117,7 +127,7 @@ func (at ActionType) String() string {
 // ActionTypeValidator is a validator for the "action_type" field enum values. It is called by the builders before save.
 func ActionTypeValidator(at ActionType) error {
 	switch at {
-	case ActionTypeAssignKeeper, ActionTypeDeleteCopies, ActionTypeCreateHardlinks, ActionTypeQuarantine, ActionTypeRetry, ActionTypeNote:
+	case ActionTypeAssignKeeper, ActionTypeDeleteCopies, ActionTypeCreateHardlinks, ActionTypeQuarantine, ActionTypeRetry, ActionTypeNote, ActionTypeActionQueued, ActionTypeActionFailed:
 		return nil
 	default:
 		return fmt.Errorf("actionaudit: invalid enum value for action_type field: %q", at)
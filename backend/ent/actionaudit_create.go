@@ -148,7 +148,9 @@ func (_c *ActionAuditCreate) Mutation() *ActionAuditMutation {
 
 // Save creates the ActionAudit in the database.
 func (_c *ActionAuditCreate) Save(ctx context.Context) (*ActionAudit, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -175,12 +177,18 @@ func (_c *ActionAuditCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *ActionAuditCreate) defaults() {
+func (_c *ActionAuditCreate) defaults() error {
 	if _, ok := _c.mutation.CreateTime(); !ok {
+		if actionaudit.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized actionaudit.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
 		v := actionaudit.DefaultCreateTime()
 		_c.mutation.SetCreateTime(v)
 	}
 	if _, ok := _c.mutation.UpdateTime(); !ok {
+		if actionaudit.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized actionaudit.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
 		v := actionaudit.DefaultUpdateTime()
 		_c.mutation.SetUpdateTime(v)
 	}
@@ -189,6 +197,9 @@ func (_c *ActionAuditCreate) defaults() {
 		_c.mutation.SetActor(v)
 	}
 	if _, ok := _c.mutation.PerformedAt(); !ok {
+		if actionaudit.DefaultPerformedAt == nil {
+			return fmt.Errorf("ent: uninitialized actionaudit.DefaultPerformedAt (forgotten import ent/runtime?)")
+		}
 		v := actionaudit.DefaultPerformedAt()
 		_c.mutation.SetPerformedAt(v)
 	}
@@ -197,9 +208,13 @@ func (_c *ActionAuditCreate) defaults() {
 		_c.mutation.SetStubbed(v)
 	}
 	if _, ok := _c.mutation.ID(); !ok {
+		if actionaudit.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized actionaudit.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := actionaudit.DefaultID()
 		_c.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -162,7 +162,9 @@ func (_u *ActionAuditUpdate) ClearDuplicateGroup() *ActionAuditUpdate {
 
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *ActionAuditUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -189,11 +191,15 @@ func (_u *ActionAuditUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *ActionAuditUpdate) defaults() {
+func (_u *ActionAuditUpdate) defaults() error {
 	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if actionaudit.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized actionaudit.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
 		v := actionaudit.UpdateDefaultUpdateTime()
 		_u.mutation.SetUpdateTime(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -467,7 +473,9 @@ func (_u *ActionAuditUpdateOne) Select(field string, fields ...string) *ActionAu
 
 // Save executes the query and returns the updated ActionAudit entity.
 func (_u *ActionAuditUpdateOne) Save(ctx context.Context) (*ActionAudit, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -494,11 +502,15 @@ func (_u *ActionAuditUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *ActionAuditUpdateOne) defaults() {
+func (_u *ActionAuditUpdateOne) defaults() error {
 	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if actionaudit.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized actionaudit.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
 		v := actionaudit.UpdateDefaultUpdateTime()
 		_u.mutation.SetUpdateTime(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -0,0 +1,346 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/mcmx/duplynx/ent/actionjob"
+	"github.com/mcmx/duplynx/ent/duplicategroup"
+	"github.com/mcmx/duplynx/ent/machine"
+	"github.com/mcmx/duplynx/ent/tenant"
+)
+
+// ActionJob is the model entity for the ActionJob schema.
+type ActionJob struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// ActionID holds the value of the "action_id" field.
+	ActionID uuid.UUID `json:"action_id,omitempty"`
+	// DuplicateGroupID holds the value of the "duplicate_group_id" field.
+	DuplicateGroupID uuid.UUID `json:"duplicate_group_id,omitempty"`
+	// MachineID holds the value of the "machine_id" field.
+	MachineID uuid.UUID `json:"machine_id,omitempty"`
+	// Action holds the value of the "action" field.
+	Action actionjob.Action `json:"action,omitempty"`
+	// Hash holds the value of the "hash" field.
+	Hash string `json:"hash,omitempty"`
+	// KeepPath holds the value of the "keep_path" field.
+	KeepPath string `json:"keep_path,omitempty"`
+	// Paths holds the value of the "paths" field.
+	Paths []string `json:"paths,omitempty"`
+	// Actor holds the value of the "actor" field.
+	Actor string `json:"actor,omitempty"`
+	// Status holds the value of the "status" field.
+	Status actionjob.Status `json:"status,omitempty"`
+	// StatusMessage holds the value of the "status_message" field.
+	StatusMessage string `json:"status_message,omitempty"`
+	// DonePaths holds the value of the "done_paths" field.
+	DonePaths []string `json:"done_paths,omitempty"`
+	// ClaimedAt holds the value of the "claimed_at" field.
+	ClaimedAt time.Time `json:"claimed_at,omitempty"`
+	// FinishedAt holds the value of the "finished_at" field.
+	FinishedAt time.Time `json:"finished_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ActionJobQuery when eager-loading is set.
+	Edges        ActionJobEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ActionJobEdges holds the relations/edges for other nodes in the graph.
+type ActionJobEdges struct {
+	// Tenant holds the value of the tenant edge.
+	Tenant *Tenant `json:"tenant,omitempty"`
+	// DuplicateGroup holds the value of the duplicate_group edge.
+	DuplicateGroup *DuplicateGroup `json:"duplicate_group,omitempty"`
+	// Machine holds the value of the machine edge.
+	Machine *Machine `json:"machine,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// TenantOrErr returns the Tenant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ActionJobEdges) TenantOrErr() (*Tenant, error) {
+	if e.Tenant != nil {
+		return e.Tenant, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: tenant.Label}
+	}
+	return nil, &NotLoadedError{edge: "tenant"}
+}
+
+// DuplicateGroupOrErr returns the DuplicateGroup value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ActionJobEdges) DuplicateGroupOrErr() (*DuplicateGroup, error) {
+	if e.DuplicateGroup != nil {
+		return e.DuplicateGroup, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: duplicategroup.Label}
+	}
+	return nil, &NotLoadedError{edge: "duplicate_group"}
+}
+
+// MachineOrErr returns the Machine value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ActionJobEdges) MachineOrErr() (*Machine, error) {
+	if e.Machine != nil {
+		return e.Machine, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: machine.Label}
+	}
+	return nil, &NotLoadedError{edge: "machine"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ActionJob) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case actionjob.FieldPaths, actionjob.FieldDonePaths:
+			values[i] = new([]byte)
+		case actionjob.FieldAction, actionjob.FieldHash, actionjob.FieldKeepPath, actionjob.FieldActor, actionjob.FieldStatus, actionjob.FieldStatusMessage:
+			values[i] = new(sql.NullString)
+		case actionjob.FieldCreateTime, actionjob.FieldUpdateTime, actionjob.FieldClaimedAt, actionjob.FieldFinishedAt:
+			values[i] = new(sql.NullTime)
+		case actionjob.FieldID, actionjob.FieldTenantID, actionjob.FieldActionID, actionjob.FieldDuplicateGroupID, actionjob.FieldMachineID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ActionJob fields.
+func (_m *ActionJob) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case actionjob.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case actionjob.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case actionjob.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = value.Time
+			}
+		case actionjob.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case actionjob.FieldActionID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field action_id", values[i])
+			} else if value != nil {
+				_m.ActionID = *value
+			}
+		case actionjob.FieldDuplicateGroupID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field duplicate_group_id", values[i])
+			} else if value != nil {
+				_m.DuplicateGroupID = *value
+			}
+		case actionjob.FieldMachineID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field machine_id", values[i])
+			} else if value != nil {
+				_m.MachineID = *value
+			}
+		case actionjob.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				_m.Action = actionjob.Action(value.String)
+			}
+		case actionjob.FieldHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hash", values[i])
+			} else if value.Valid {
+				_m.Hash = value.String
+			}
+		case actionjob.FieldKeepPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field keep_path", values[i])
+			} else if value.Valid {
+				_m.KeepPath = value.String
+			}
+		case actionjob.FieldPaths:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field paths", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Paths); err != nil {
+					return fmt.Errorf("unmarshal field paths: %w", err)
+				}
+			}
+		case actionjob.FieldActor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field actor", values[i])
+			} else if value.Valid {
+				_m.Actor = value.String
+			}
+		case actionjob.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = actionjob.Status(value.String)
+			}
+		case actionjob.FieldStatusMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status_message", values[i])
+			} else if value.Valid {
+				_m.StatusMessage = value.String
+			}
+		case actionjob.FieldDonePaths:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field done_paths", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.DonePaths); err != nil {
+					return fmt.Errorf("unmarshal field done_paths: %w", err)
+				}
+			}
+		case actionjob.FieldClaimedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field claimed_at", values[i])
+			} else if value.Valid {
+				_m.ClaimedAt = value.Time
+			}
+		case actionjob.FieldFinishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field finished_at", values[i])
+			} else if value.Valid {
+				_m.FinishedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ActionJob.
+// This includes values selected through modifiers, order, etc.
+func (_m *ActionJob) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTenant queries the "tenant" edge of the ActionJob entity.
+func (_m *ActionJob) QueryTenant() *TenantQuery {
+	return NewActionJobClient(_m.config).QueryTenant(_m)
+}
+
+// QueryDuplicateGroup queries the "duplicate_group" edge of the ActionJob entity.
+func (_m *ActionJob) QueryDuplicateGroup() *DuplicateGroupQuery {
+	return NewActionJobClient(_m.config).QueryDuplicateGroup(_m)
+}
+
+// QueryMachine queries the "machine" edge of the ActionJob entity.
+func (_m *ActionJob) QueryMachine() *MachineQuery {
+	return NewActionJobClient(_m.config).QueryMachine(_m)
+}
+
+// Update returns a builder for updating this ActionJob.
+// Note that you need to call ActionJob.Unwrap() before calling this method if this ActionJob
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ActionJob) Update() *ActionJobUpdateOne {
+	return NewActionJobClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ActionJob entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ActionJob) Unwrap() *ActionJob {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ActionJob is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ActionJob) String() string {
+	var builder strings.Builder
+	builder.WriteString("ActionJob(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("action_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ActionID))
+	builder.WriteString(", ")
+	builder.WriteString("duplicate_group_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DuplicateGroupID))
+	builder.WriteString(", ")
+	builder.WriteString("machine_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MachineID))
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Action))
+	builder.WriteString(", ")
+	builder.WriteString("hash=")
+	builder.WriteString(_m.Hash)
+	builder.WriteString(", ")
+	builder.WriteString("keep_path=")
+	builder.WriteString(_m.KeepPath)
+	builder.WriteString(", ")
+	builder.WriteString("paths=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Paths))
+	builder.WriteString(", ")
+	builder.WriteString("actor=")
+	builder.WriteString(_m.Actor)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("status_message=")
+	builder.WriteString(_m.StatusMessage)
+	builder.WriteString(", ")
+	builder.WriteString("done_paths=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DonePaths))
+	builder.WriteString(", ")
+	builder.WriteString("claimed_at=")
+	builder.WriteString(_m.ClaimedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("finished_at=")
+	builder.WriteString(_m.FinishedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ActionJobs is a parsable slice of ActionJob.
+type ActionJobs []*ActionJob
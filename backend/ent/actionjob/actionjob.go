@@ -0,0 +1,304 @@
+// Code generated by ent, DO NOT EDIT.
+
+package actionjob
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the actionjob type in the database.
+	Label = "action_job"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldActionID holds the string denoting the action_id field in the database.
+	FieldActionID = "action_id"
+	// FieldDuplicateGroupID holds the string denoting the duplicate_group_id field in the database.
+	FieldDuplicateGroupID = "duplicate_group_id"
+	// FieldMachineID holds the string denoting the machine_id field in the database.
+	FieldMachineID = "machine_id"
+	// FieldAction holds the string denoting the action field in the database.
+	FieldAction = "action"
+	// FieldHash holds the string denoting the hash field in the database.
+	FieldHash = "hash"
+	// FieldKeepPath holds the string denoting the keep_path field in the database.
+	FieldKeepPath = "keep_path"
+	// FieldPaths holds the string denoting the paths field in the database.
+	FieldPaths = "paths"
+	// FieldActor holds the string denoting the actor field in the database.
+	FieldActor = "actor"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldStatusMessage holds the string denoting the status_message field in the database.
+	FieldStatusMessage = "status_message"
+	// FieldDonePaths holds the string denoting the done_paths field in the database.
+	FieldDonePaths = "done_paths"
+	// FieldClaimedAt holds the string denoting the claimed_at field in the database.
+	FieldClaimedAt = "claimed_at"
+	// FieldFinishedAt holds the string denoting the finished_at field in the database.
+	FieldFinishedAt = "finished_at"
+	// EdgeTenant holds the string denoting the tenant edge name in mutations.
+	EdgeTenant = "tenant"
+	// EdgeDuplicateGroup holds the string denoting the duplicate_group edge name in mutations.
+	EdgeDuplicateGroup = "duplicate_group"
+	// EdgeMachine holds the string denoting the machine edge name in mutations.
+	EdgeMachine = "machine"
+	// Table holds the table name of the actionjob in the database.
+	Table = "action_jobs"
+	// TenantTable is the table that holds the tenant relation/edge.
+	TenantTable = "action_jobs"
+	// TenantInverseTable is the table name for the Tenant entity.
+	// It exists in this package in order to avoid circular dependency with the "tenant" package.
+	TenantInverseTable = "tenants"
+	// TenantColumn is the table column denoting the tenant relation/edge.
+	TenantColumn = "tenant_id"
+	// DuplicateGroupTable is the table that holds the duplicate_group relation/edge.
+	DuplicateGroupTable = "action_jobs"
+	// DuplicateGroupInverseTable is the table name for the DuplicateGroup entity.
+	// It exists in this package in order to avoid circular dependency with the "duplicategroup" package.
+	DuplicateGroupInverseTable = "duplicate_groups"
+	// DuplicateGroupColumn is the table column denoting the duplicate_group relation/edge.
+	DuplicateGroupColumn = "duplicate_group_id"
+	// MachineTable is the table that holds the machine relation/edge.
+	MachineTable = "action_jobs"
+	// MachineInverseTable is the table name for the Machine entity.
+	// It exists in this package in order to avoid circular dependency with the "machine" package.
+	MachineInverseTable = "machines"
+	// MachineColumn is the table column denoting the machine relation/edge.
+	MachineColumn = "machine_id"
+)
+
+// Columns holds all SQL columns for actionjob fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldTenantID,
+	FieldActionID,
+	FieldDuplicateGroupID,
+	FieldMachineID,
+	FieldAction,
+	FieldHash,
+	FieldKeepPath,
+	FieldPaths,
+	FieldActor,
+	FieldStatus,
+	FieldStatusMessage,
+	FieldDonePaths,
+	FieldClaimedAt,
+	FieldFinishedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/mcmx/duplynx/ent/runtime"
+var (
+	Hooks        [1]ent.Hook
+	Interceptors [1]ent.Interceptor
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
+	DefaultUpdateTime func() time.Time
+	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
+	UpdateDefaultUpdateTime func() time.Time
+	// DefaultActor holds the default value on creation for the "actor" field.
+	DefaultActor string
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Action defines the type for the "action" enum field.
+type Action string
+
+// Action values.
+const (
+	ActionDeleteCopies    Action = "delete_copies"
+	ActionCreateHardlinks Action = "create_hardlinks"
+	ActionQuarantine      Action = "quarantine"
+)
+
+func (a Action) String() string {
+	return string(a)
+}
+
+// ActionValidator is a validator for the "action" field enum values. It is called by the builders before save.
+func ActionValidator(a Action) error {
+	switch a {
+	case ActionDeleteCopies, ActionCreateHardlinks, ActionQuarantine:
+		return nil
+	default:
+		return fmt.Errorf("actionjob: invalid enum value for action field: %q", a)
+	}
+}
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPending is the default value of the Status enum.
+const DefaultStatus = StatusPending
+
+// Status values.
+const (
+	StatusPending   Status = "pending"
+	StatusClaimed   Status = "claimed"
+	StatusSucceeded Status = "succeeded"
+	StatusFailed    Status = "failed"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusClaimed, StatusSucceeded, StatusFailed:
+		return nil
+	default:
+		return fmt.Errorf("actionjob: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the ActionJob queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByActionID orders the results by the action_id field.
+func ByActionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActionID, opts...).ToFunc()
+}
+
+// ByDuplicateGroupID orders the results by the duplicate_group_id field.
+func ByDuplicateGroupID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDuplicateGroupID, opts...).ToFunc()
+}
+
+// ByMachineID orders the results by the machine_id field.
+func ByMachineID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMachineID, opts...).ToFunc()
+}
+
+// ByAction orders the results by the action field.
+func ByAction(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAction, opts...).ToFunc()
+}
+
+// ByHash orders the results by the hash field.
+func ByHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHash, opts...).ToFunc()
+}
+
+// ByKeepPath orders the results by the keep_path field.
+func ByKeepPath(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKeepPath, opts...).ToFunc()
+}
+
+// ByActor orders the results by the actor field.
+func ByActor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActor, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByStatusMessage orders the results by the status_message field.
+func ByStatusMessage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatusMessage, opts...).ToFunc()
+}
+
+// ByClaimedAt orders the results by the claimed_at field.
+func ByClaimedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClaimedAt, opts...).ToFunc()
+}
+
+// ByFinishedAt orders the results by the finished_at field.
+func ByFinishedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFinishedAt, opts...).ToFunc()
+}
+
+// ByTenantField orders the results by tenant field.
+func ByTenantField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTenantStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByDuplicateGroupField orders the results by duplicate_group field.
+func ByDuplicateGroupField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDuplicateGroupStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByMachineField orders the results by machine field.
+func ByMachineField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMachineStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newTenantStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TenantInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TenantTable, TenantColumn),
+	)
+}
+func newDuplicateGroupStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DuplicateGroupInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, DuplicateGroupTable, DuplicateGroupColumn),
+	)
+}
+func newMachineStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MachineInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, MachineTable, MachineColumn),
+	)
+}
@@ -0,0 +1,811 @@
+// Code generated by ent, DO NOT EDIT.
+
+package actionjob
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/mcmx/duplynx/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldEQ(FieldTenantID, v))
+}
+
+// ActionID applies equality check predicate on the "action_id" field. It's identical to ActionIDEQ.
+func ActionID(v uuid.UUID) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldEQ(FieldActionID, v))
+}
+
+// DuplicateGroupID applies equality check predicate on the "duplicate_group_id" field. It's identical to DuplicateGroupIDEQ.
+func DuplicateGroupID(v uuid.UUID) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldEQ(FieldDuplicateGroupID, v))
+}
+
+// MachineID applies equality check predicate on the "machine_id" field. It's identical to MachineIDEQ.
+func MachineID(v uuid.UUID) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldEQ(FieldMachineID, v))
+}
+
+// Hash applies equality check predicate on the "hash" field. It's identical to HashEQ.
+func Hash(v string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldEQ(FieldHash, v))
+}
+
+// KeepPath applies equality check predicate on the "keep_path" field. It's identical to KeepPathEQ.
+func KeepPath(v string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldEQ(FieldKeepPath, v))
+}
+
+// Actor applies equality check predicate on the "actor" field. It's identical to ActorEQ.
+func Actor(v string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldEQ(FieldActor, v))
+}
+
+// StatusMessage applies equality check predicate on the "status_message" field. It's identical to StatusMessageEQ.
+func StatusMessage(v string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldEQ(FieldStatusMessage, v))
+}
+
+// ClaimedAt applies equality check predicate on the "claimed_at" field. It's identical to ClaimedAtEQ.
+func ClaimedAt(v time.Time) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldEQ(FieldClaimedAt, v))
+}
+
+// FinishedAt applies equality check predicate on the "finished_at" field. It's identical to FinishedAtEQ.
+func FinishedAt(v time.Time) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// ActionIDEQ applies the EQ predicate on the "action_id" field.
+func ActionIDEQ(v uuid.UUID) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldEQ(FieldActionID, v))
+}
+
+// ActionIDNEQ applies the NEQ predicate on the "action_id" field.
+func ActionIDNEQ(v uuid.UUID) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldNEQ(FieldActionID, v))
+}
+
+// ActionIDIn applies the In predicate on the "action_id" field.
+func ActionIDIn(vs ...uuid.UUID) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldIn(FieldActionID, vs...))
+}
+
+// ActionIDNotIn applies the NotIn predicate on the "action_id" field.
+func ActionIDNotIn(vs ...uuid.UUID) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldNotIn(FieldActionID, vs...))
+}
+
+// ActionIDGT applies the GT predicate on the "action_id" field.
+func ActionIDGT(v uuid.UUID) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldGT(FieldActionID, v))
+}
+
+// ActionIDGTE applies the GTE predicate on the "action_id" field.
+func ActionIDGTE(v uuid.UUID) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldGTE(FieldActionID, v))
+}
+
+// ActionIDLT applies the LT predicate on the "action_id" field.
+func ActionIDLT(v uuid.UUID) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldLT(FieldActionID, v))
+}
+
+// ActionIDLTE applies the LTE predicate on the "action_id" field.
+func ActionIDLTE(v uuid.UUID) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldLTE(FieldActionID, v))
+}
+
+// DuplicateGroupIDEQ applies the EQ predicate on the "duplicate_group_id" field.
+func DuplicateGroupIDEQ(v uuid.UUID) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldEQ(FieldDuplicateGroupID, v))
+}
+
+// DuplicateGroupIDNEQ applies the NEQ predicate on the "duplicate_group_id" field.
+func DuplicateGroupIDNEQ(v uuid.UUID) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldNEQ(FieldDuplicateGroupID, v))
+}
+
+// DuplicateGroupIDIn applies the In predicate on the "duplicate_group_id" field.
+func DuplicateGroupIDIn(vs ...uuid.UUID) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldIn(FieldDuplicateGroupID, vs...))
+}
+
+// DuplicateGroupIDNotIn applies the NotIn predicate on the "duplicate_group_id" field.
+func DuplicateGroupIDNotIn(vs ...uuid.UUID) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldNotIn(FieldDuplicateGroupID, vs...))
+}
+
+// MachineIDEQ applies the EQ predicate on the "machine_id" field.
+func MachineIDEQ(v uuid.UUID) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldEQ(FieldMachineID, v))
+}
+
+// MachineIDNEQ applies the NEQ predicate on the "machine_id" field.
+func MachineIDNEQ(v uuid.UUID) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldNEQ(FieldMachineID, v))
+}
+
+// MachineIDIn applies the In predicate on the "machine_id" field.
+func MachineIDIn(vs ...uuid.UUID) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldIn(FieldMachineID, vs...))
+}
+
+// MachineIDNotIn applies the NotIn predicate on the "machine_id" field.
+func MachineIDNotIn(vs ...uuid.UUID) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldNotIn(FieldMachineID, vs...))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v Action) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v Action) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...Action) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...Action) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// HashEQ applies the EQ predicate on the "hash" field.
+func HashEQ(v string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldEQ(FieldHash, v))
+}
+
+// HashNEQ applies the NEQ predicate on the "hash" field.
+func HashNEQ(v string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldNEQ(FieldHash, v))
+}
+
+// HashIn applies the In predicate on the "hash" field.
+func HashIn(vs ...string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldIn(FieldHash, vs...))
+}
+
+// HashNotIn applies the NotIn predicate on the "hash" field.
+func HashNotIn(vs ...string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldNotIn(FieldHash, vs...))
+}
+
+// HashGT applies the GT predicate on the "hash" field.
+func HashGT(v string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldGT(FieldHash, v))
+}
+
+// HashGTE applies the GTE predicate on the "hash" field.
+func HashGTE(v string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldGTE(FieldHash, v))
+}
+
+// HashLT applies the LT predicate on the "hash" field.
+func HashLT(v string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldLT(FieldHash, v))
+}
+
+// HashLTE applies the LTE predicate on the "hash" field.
+func HashLTE(v string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldLTE(FieldHash, v))
+}
+
+// HashContains applies the Contains predicate on the "hash" field.
+func HashContains(v string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldContains(FieldHash, v))
+}
+
+// HashHasPrefix applies the HasPrefix predicate on the "hash" field.
+func HashHasPrefix(v string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldHasPrefix(FieldHash, v))
+}
+
+// HashHasSuffix applies the HasSuffix predicate on the "hash" field.
+func HashHasSuffix(v string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldHasSuffix(FieldHash, v))
+}
+
+// HashEqualFold applies the EqualFold predicate on the "hash" field.
+func HashEqualFold(v string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldEqualFold(FieldHash, v))
+}
+
+// HashContainsFold applies the ContainsFold predicate on the "hash" field.
+func HashContainsFold(v string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldContainsFold(FieldHash, v))
+}
+
+// KeepPathEQ applies the EQ predicate on the "keep_path" field.
+func KeepPathEQ(v string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldEQ(FieldKeepPath, v))
+}
+
+// KeepPathNEQ applies the NEQ predicate on the "keep_path" field.
+func KeepPathNEQ(v string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldNEQ(FieldKeepPath, v))
+}
+
+// KeepPathIn applies the In predicate on the "keep_path" field.
+func KeepPathIn(vs ...string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldIn(FieldKeepPath, vs...))
+}
+
+// KeepPathNotIn applies the NotIn predicate on the "keep_path" field.
+func KeepPathNotIn(vs ...string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldNotIn(FieldKeepPath, vs...))
+}
+
+// KeepPathGT applies the GT predicate on the "keep_path" field.
+func KeepPathGT(v string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldGT(FieldKeepPath, v))
+}
+
+// KeepPathGTE applies the GTE predicate on the "keep_path" field.
+func KeepPathGTE(v string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldGTE(FieldKeepPath, v))
+}
+
+// KeepPathLT applies the LT predicate on the "keep_path" field.
+func KeepPathLT(v string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldLT(FieldKeepPath, v))
+}
+
+// KeepPathLTE applies the LTE predicate on the "keep_path" field.
+func KeepPathLTE(v string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldLTE(FieldKeepPath, v))
+}
+
+// KeepPathContains applies the Contains predicate on the "keep_path" field.
+func KeepPathContains(v string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldContains(FieldKeepPath, v))
+}
+
+// KeepPathHasPrefix applies the HasPrefix predicate on the "keep_path" field.
+func KeepPathHasPrefix(v string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldHasPrefix(FieldKeepPath, v))
+}
+
+// KeepPathHasSuffix applies the HasSuffix predicate on the "keep_path" field.
+func KeepPathHasSuffix(v string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldHasSuffix(FieldKeepPath, v))
+}
+
+// KeepPathIsNil applies the IsNil predicate on the "keep_path" field.
+func KeepPathIsNil() predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldIsNull(FieldKeepPath))
+}
+
+// KeepPathNotNil applies the NotNil predicate on the "keep_path" field.
+func KeepPathNotNil() predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldNotNull(FieldKeepPath))
+}
+
+// KeepPathEqualFold applies the EqualFold predicate on the "keep_path" field.
+func KeepPathEqualFold(v string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldEqualFold(FieldKeepPath, v))
+}
+
+// KeepPathContainsFold applies the ContainsFold predicate on the "keep_path" field.
+func KeepPathContainsFold(v string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldContainsFold(FieldKeepPath, v))
+}
+
+// ActorEQ applies the EQ predicate on the "actor" field.
+func ActorEQ(v string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldEQ(FieldActor, v))
+}
+
+// ActorNEQ applies the NEQ predicate on the "actor" field.
+func ActorNEQ(v string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldNEQ(FieldActor, v))
+}
+
+// ActorIn applies the In predicate on the "actor" field.
+func ActorIn(vs ...string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldIn(FieldActor, vs...))
+}
+
+// ActorNotIn applies the NotIn predicate on the "actor" field.
+func ActorNotIn(vs ...string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldNotIn(FieldActor, vs...))
+}
+
+// ActorGT applies the GT predicate on the "actor" field.
+func ActorGT(v string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldGT(FieldActor, v))
+}
+
+// ActorGTE applies the GTE predicate on the "actor" field.
+func ActorGTE(v string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldGTE(FieldActor, v))
+}
+
+// ActorLT applies the LT predicate on the "actor" field.
+func ActorLT(v string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldLT(FieldActor, v))
+}
+
+// ActorLTE applies the LTE predicate on the "actor" field.
+func ActorLTE(v string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldLTE(FieldActor, v))
+}
+
+// ActorContains applies the Contains predicate on the "actor" field.
+func ActorContains(v string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldContains(FieldActor, v))
+}
+
+// ActorHasPrefix applies the HasPrefix predicate on the "actor" field.
+func ActorHasPrefix(v string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldHasPrefix(FieldActor, v))
+}
+
+// ActorHasSuffix applies the HasSuffix predicate on the "actor" field.
+func ActorHasSuffix(v string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldHasSuffix(FieldActor, v))
+}
+
+// ActorEqualFold applies the EqualFold predicate on the "actor" field.
+func ActorEqualFold(v string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldEqualFold(FieldActor, v))
+}
+
+// ActorContainsFold applies the ContainsFold predicate on the "actor" field.
+func ActorContainsFold(v string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldContainsFold(FieldActor, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusMessageEQ applies the EQ predicate on the "status_message" field.
+func StatusMessageEQ(v string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldEQ(FieldStatusMessage, v))
+}
+
+// StatusMessageNEQ applies the NEQ predicate on the "status_message" field.
+func StatusMessageNEQ(v string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldNEQ(FieldStatusMessage, v))
+}
+
+// StatusMessageIn applies the In predicate on the "status_message" field.
+func StatusMessageIn(vs ...string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldIn(FieldStatusMessage, vs...))
+}
+
+// StatusMessageNotIn applies the NotIn predicate on the "status_message" field.
+func StatusMessageNotIn(vs ...string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldNotIn(FieldStatusMessage, vs...))
+}
+
+// StatusMessageGT applies the GT predicate on the "status_message" field.
+func StatusMessageGT(v string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldGT(FieldStatusMessage, v))
+}
+
+// StatusMessageGTE applies the GTE predicate on the "status_message" field.
+func StatusMessageGTE(v string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldGTE(FieldStatusMessage, v))
+}
+
+// StatusMessageLT applies the LT predicate on the "status_message" field.
+func StatusMessageLT(v string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldLT(FieldStatusMessage, v))
+}
+
+// StatusMessageLTE applies the LTE predicate on the "status_message" field.
+func StatusMessageLTE(v string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldLTE(FieldStatusMessage, v))
+}
+
+// StatusMessageContains applies the Contains predicate on the "status_message" field.
+func StatusMessageContains(v string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldContains(FieldStatusMessage, v))
+}
+
+// StatusMessageHasPrefix applies the HasPrefix predicate on the "status_message" field.
+func StatusMessageHasPrefix(v string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldHasPrefix(FieldStatusMessage, v))
+}
+
+// StatusMessageHasSuffix applies the HasSuffix predicate on the "status_message" field.
+func StatusMessageHasSuffix(v string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldHasSuffix(FieldStatusMessage, v))
+}
+
+// StatusMessageIsNil applies the IsNil predicate on the "status_message" field.
+func StatusMessageIsNil() predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldIsNull(FieldStatusMessage))
+}
+
+// StatusMessageNotNil applies the NotNil predicate on the "status_message" field.
+func StatusMessageNotNil() predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldNotNull(FieldStatusMessage))
+}
+
+// StatusMessageEqualFold applies the EqualFold predicate on the "status_message" field.
+func StatusMessageEqualFold(v string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldEqualFold(FieldStatusMessage, v))
+}
+
+// StatusMessageContainsFold applies the ContainsFold predicate on the "status_message" field.
+func StatusMessageContainsFold(v string) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldContainsFold(FieldStatusMessage, v))
+}
+
+// DonePathsIsNil applies the IsNil predicate on the "done_paths" field.
+func DonePathsIsNil() predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldIsNull(FieldDonePaths))
+}
+
+// DonePathsNotNil applies the NotNil predicate on the "done_paths" field.
+func DonePathsNotNil() predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldNotNull(FieldDonePaths))
+}
+
+// ClaimedAtEQ applies the EQ predicate on the "claimed_at" field.
+func ClaimedAtEQ(v time.Time) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldEQ(FieldClaimedAt, v))
+}
+
+// ClaimedAtNEQ applies the NEQ predicate on the "claimed_at" field.
+func ClaimedAtNEQ(v time.Time) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldNEQ(FieldClaimedAt, v))
+}
+
+// ClaimedAtIn applies the In predicate on the "claimed_at" field.
+func ClaimedAtIn(vs ...time.Time) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldIn(FieldClaimedAt, vs...))
+}
+
+// ClaimedAtNotIn applies the NotIn predicate on the "claimed_at" field.
+func ClaimedAtNotIn(vs ...time.Time) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldNotIn(FieldClaimedAt, vs...))
+}
+
+// ClaimedAtGT applies the GT predicate on the "claimed_at" field.
+func ClaimedAtGT(v time.Time) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldGT(FieldClaimedAt, v))
+}
+
+// ClaimedAtGTE applies the GTE predicate on the "claimed_at" field.
+func ClaimedAtGTE(v time.Time) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldGTE(FieldClaimedAt, v))
+}
+
+// ClaimedAtLT applies the LT predicate on the "claimed_at" field.
+func ClaimedAtLT(v time.Time) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldLT(FieldClaimedAt, v))
+}
+
+// ClaimedAtLTE applies the LTE predicate on the "claimed_at" field.
+func ClaimedAtLTE(v time.Time) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldLTE(FieldClaimedAt, v))
+}
+
+// ClaimedAtIsNil applies the IsNil predicate on the "claimed_at" field.
+func ClaimedAtIsNil() predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldIsNull(FieldClaimedAt))
+}
+
+// ClaimedAtNotNil applies the NotNil predicate on the "claimed_at" field.
+func ClaimedAtNotNil() predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldNotNull(FieldClaimedAt))
+}
+
+// FinishedAtEQ applies the EQ predicate on the "finished_at" field.
+func FinishedAtEQ(v time.Time) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtNEQ applies the NEQ predicate on the "finished_at" field.
+func FinishedAtNEQ(v time.Time) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldNEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtIn applies the In predicate on the "finished_at" field.
+func FinishedAtIn(vs ...time.Time) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtNotIn applies the NotIn predicate on the "finished_at" field.
+func FinishedAtNotIn(vs ...time.Time) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldNotIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtGT applies the GT predicate on the "finished_at" field.
+func FinishedAtGT(v time.Time) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldGT(FieldFinishedAt, v))
+}
+
+// FinishedAtGTE applies the GTE predicate on the "finished_at" field.
+func FinishedAtGTE(v time.Time) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldGTE(FieldFinishedAt, v))
+}
+
+// FinishedAtLT applies the LT predicate on the "finished_at" field.
+func FinishedAtLT(v time.Time) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldLT(FieldFinishedAt, v))
+}
+
+// FinishedAtLTE applies the LTE predicate on the "finished_at" field.
+func FinishedAtLTE(v time.Time) predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldLTE(FieldFinishedAt, v))
+}
+
+// FinishedAtIsNil applies the IsNil predicate on the "finished_at" field.
+func FinishedAtIsNil() predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldIsNull(FieldFinishedAt))
+}
+
+// FinishedAtNotNil applies the NotNil predicate on the "finished_at" field.
+func FinishedAtNotNil() predicate.ActionJob {
+	return predicate.ActionJob(sql.FieldNotNull(FieldFinishedAt))
+}
+
+// HasTenant applies the HasEdge predicate on the "tenant" edge.
+func HasTenant() predicate.ActionJob {
+	return predicate.ActionJob(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TenantTable, TenantColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTenantWith applies the HasEdge predicate on the "tenant" edge with a given conditions (other predicates).
+func HasTenantWith(preds ...predicate.Tenant) predicate.ActionJob {
+	return predicate.ActionJob(func(s *sql.Selector) {
+		step := newTenantStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDuplicateGroup applies the HasEdge predicate on the "duplicate_group" edge.
+func HasDuplicateGroup() predicate.ActionJob {
+	return predicate.ActionJob(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, DuplicateGroupTable, DuplicateGroupColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDuplicateGroupWith applies the HasEdge predicate on the "duplicate_group" edge with a given conditions (other predicates).
+func HasDuplicateGroupWith(preds ...predicate.DuplicateGroup) predicate.ActionJob {
+	return predicate.ActionJob(func(s *sql.Selector) {
+		step := newDuplicateGroupStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMachine applies the HasEdge predicate on the "machine" edge.
+func HasMachine() predicate.ActionJob {
+	return predicate.ActionJob(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, MachineTable, MachineColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMachineWith applies the HasEdge predicate on the "machine" edge with a given conditions (other predicates).
+func HasMachineWith(preds ...predicate.Machine) predicate.ActionJob {
+	return predicate.ActionJob(func(s *sql.Selector) {
+		step := newMachineStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ActionJob) predicate.ActionJob {
+	return predicate.ActionJob(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ActionJob) predicate.ActionJob {
+	return predicate.ActionJob(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ActionJob) predicate.ActionJob {
+	return predicate.ActionJob(sql.NotPredicates(p))
+}
@@ -0,0 +1,562 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/mcmx/duplynx/ent/actionjob"
+	"github.com/mcmx/duplynx/ent/duplicategroup"
+	"github.com/mcmx/duplynx/ent/machine"
+	"github.com/mcmx/duplynx/ent/tenant"
+)
+
+// ActionJobCreate is the builder for creating a ActionJob entity.
+type ActionJobCreate struct {
+	config
+	mutation *ActionJobMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *ActionJobCreate) SetCreateTime(v time.Time) *ActionJobCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *ActionJobCreate) SetNillableCreateTime(v *time.Time) *ActionJobCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *ActionJobCreate) SetUpdateTime(v time.Time) *ActionJobCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *ActionJobCreate) SetNillableUpdateTime(v *time.Time) *ActionJobCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *ActionJobCreate) SetTenantID(v uuid.UUID) *ActionJobCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetActionID sets the "action_id" field.
+func (_c *ActionJobCreate) SetActionID(v uuid.UUID) *ActionJobCreate {
+	_c.mutation.SetActionID(v)
+	return _c
+}
+
+// SetDuplicateGroupID sets the "duplicate_group_id" field.
+func (_c *ActionJobCreate) SetDuplicateGroupID(v uuid.UUID) *ActionJobCreate {
+	_c.mutation.SetDuplicateGroupID(v)
+	return _c
+}
+
+// SetMachineID sets the "machine_id" field.
+func (_c *ActionJobCreate) SetMachineID(v uuid.UUID) *ActionJobCreate {
+	_c.mutation.SetMachineID(v)
+	return _c
+}
+
+// SetAction sets the "action" field.
+func (_c *ActionJobCreate) SetAction(v actionjob.Action) *ActionJobCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetHash sets the "hash" field.
+func (_c *ActionJobCreate) SetHash(v string) *ActionJobCreate {
+	_c.mutation.SetHash(v)
+	return _c
+}
+
+// SetKeepPath sets the "keep_path" field.
+func (_c *ActionJobCreate) SetKeepPath(v string) *ActionJobCreate {
+	_c.mutation.SetKeepPath(v)
+	return _c
+}
+
+// SetNillableKeepPath sets the "keep_path" field if the given value is not nil.
+func (_c *ActionJobCreate) SetNillableKeepPath(v *string) *ActionJobCreate {
+	if v != nil {
+		_c.SetKeepPath(*v)
+	}
+	return _c
+}
+
+// SetPaths sets the "paths" field.
+func (_c *ActionJobCreate) SetPaths(v []string) *ActionJobCreate {
+	_c.mutation.SetPaths(v)
+	return _c
+}
+
+// SetActor sets the "actor" field.
+func (_c *ActionJobCreate) SetActor(v string) *ActionJobCreate {
+	_c.mutation.SetActor(v)
+	return _c
+}
+
+// SetNillableActor sets the "actor" field if the given value is not nil.
+func (_c *ActionJobCreate) SetNillableActor(v *string) *ActionJobCreate {
+	if v != nil {
+		_c.SetActor(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ActionJobCreate) SetStatus(v actionjob.Status) *ActionJobCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ActionJobCreate) SetNillableStatus(v *actionjob.Status) *ActionJobCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetStatusMessage sets the "status_message" field.
+func (_c *ActionJobCreate) SetStatusMessage(v string) *ActionJobCreate {
+	_c.mutation.SetStatusMessage(v)
+	return _c
+}
+
+// SetNillableStatusMessage sets the "status_message" field if the given value is not nil.
+func (_c *ActionJobCreate) SetNillableStatusMessage(v *string) *ActionJobCreate {
+	if v != nil {
+		_c.SetStatusMessage(*v)
+	}
+	return _c
+}
+
+// SetDonePaths sets the "done_paths" field.
+func (_c *ActionJobCreate) SetDonePaths(v []string) *ActionJobCreate {
+	_c.mutation.SetDonePaths(v)
+	return _c
+}
+
+// SetClaimedAt sets the "claimed_at" field.
+func (_c *ActionJobCreate) SetClaimedAt(v time.Time) *ActionJobCreate {
+	_c.mutation.SetClaimedAt(v)
+	return _c
+}
+
+// SetNillableClaimedAt sets the "claimed_at" field if the given value is not nil.
+func (_c *ActionJobCreate) SetNillableClaimedAt(v *time.Time) *ActionJobCreate {
+	if v != nil {
+		_c.SetClaimedAt(*v)
+	}
+	return _c
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_c *ActionJobCreate) SetFinishedAt(v time.Time) *ActionJobCreate {
+	_c.mutation.SetFinishedAt(v)
+	return _c
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_c *ActionJobCreate) SetNillableFinishedAt(v *time.Time) *ActionJobCreate {
+	if v != nil {
+		_c.SetFinishedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ActionJobCreate) SetID(v uuid.UUID) *ActionJobCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ActionJobCreate) SetNillableID(v *uuid.UUID) *ActionJobCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_c *ActionJobCreate) SetTenant(v *Tenant) *ActionJobCreate {
+	return _c.SetTenantID(v.ID)
+}
+
+// SetDuplicateGroup sets the "duplicate_group" edge to the DuplicateGroup entity.
+func (_c *ActionJobCreate) SetDuplicateGroup(v *DuplicateGroup) *ActionJobCreate {
+	return _c.SetDuplicateGroupID(v.ID)
+}
+
+// SetMachine sets the "machine" edge to the Machine entity.
+func (_c *ActionJobCreate) SetMachine(v *Machine) *ActionJobCreate {
+	return _c.SetMachineID(v.ID)
+}
+
+// Mutation returns the ActionJobMutation object of the builder.
+func (_c *ActionJobCreate) Mutation() *ActionJobMutation {
+	return _c.mutation
+}
+
+// Save creates the ActionJob in the database.
+func (_c *ActionJobCreate) Save(ctx context.Context) (*ActionJob, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ActionJobCreate) SaveX(ctx context.Context) *ActionJob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ActionJobCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ActionJobCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ActionJobCreate) defaults() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		if actionjob.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized actionjob.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
+		v := actionjob.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		if actionjob.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized actionjob.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := actionjob.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.Actor(); !ok {
+		v := actionjob.DefaultActor
+		_c.mutation.SetActor(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := actionjob.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if actionjob.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized actionjob.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := actionjob.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ActionJobCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "ActionJob.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "ActionJob.update_time"`)}
+	}
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "ActionJob.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.ActionID(); !ok {
+		return &ValidationError{Name: "action_id", err: errors.New(`ent: missing required field "ActionJob.action_id"`)}
+	}
+	if _, ok := _c.mutation.DuplicateGroupID(); !ok {
+		return &ValidationError{Name: "duplicate_group_id", err: errors.New(`ent: missing required field "ActionJob.duplicate_group_id"`)}
+	}
+	if _, ok := _c.mutation.MachineID(); !ok {
+		return &ValidationError{Name: "machine_id", err: errors.New(`ent: missing required field "ActionJob.machine_id"`)}
+	}
+	if _, ok := _c.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "ActionJob.action"`)}
+	}
+	if v, ok := _c.mutation.Action(); ok {
+		if err := actionjob.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "ActionJob.action": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Hash(); !ok {
+		return &ValidationError{Name: "hash", err: errors.New(`ent: missing required field "ActionJob.hash"`)}
+	}
+	if _, ok := _c.mutation.Paths(); !ok {
+		return &ValidationError{Name: "paths", err: errors.New(`ent: missing required field "ActionJob.paths"`)}
+	}
+	if _, ok := _c.mutation.Actor(); !ok {
+		return &ValidationError{Name: "actor", err: errors.New(`ent: missing required field "ActionJob.actor"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ActionJob.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := actionjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ActionJob.status": %w`, err)}
+		}
+	}
+	if len(_c.mutation.TenantIDs()) == 0 {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required edge "ActionJob.tenant"`)}
+	}
+	if len(_c.mutation.DuplicateGroupIDs()) == 0 {
+		return &ValidationError{Name: "duplicate_group", err: errors.New(`ent: missing required edge "ActionJob.duplicate_group"`)}
+	}
+	if len(_c.mutation.MachineIDs()) == 0 {
+		return &ValidationError{Name: "machine", err: errors.New(`ent: missing required edge "ActionJob.machine"`)}
+	}
+	return nil
+}
+
+func (_c *ActionJobCreate) sqlSave(ctx context.Context) (*ActionJob, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ActionJobCreate) createSpec() (*ActionJob, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ActionJob{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(actionjob.Table, sqlgraph.NewFieldSpec(actionjob.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(actionjob.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(actionjob.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.ActionID(); ok {
+		_spec.SetField(actionjob.FieldActionID, field.TypeUUID, value)
+		_node.ActionID = value
+	}
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(actionjob.FieldAction, field.TypeEnum, value)
+		_node.Action = value
+	}
+	if value, ok := _c.mutation.Hash(); ok {
+		_spec.SetField(actionjob.FieldHash, field.TypeString, value)
+		_node.Hash = value
+	}
+	if value, ok := _c.mutation.KeepPath(); ok {
+		_spec.SetField(actionjob.FieldKeepPath, field.TypeString, value)
+		_node.KeepPath = value
+	}
+	if value, ok := _c.mutation.Paths(); ok {
+		_spec.SetField(actionjob.FieldPaths, field.TypeJSON, value)
+		_node.Paths = value
+	}
+	if value, ok := _c.mutation.Actor(); ok {
+		_spec.SetField(actionjob.FieldActor, field.TypeString, value)
+		_node.Actor = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(actionjob.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.StatusMessage(); ok {
+		_spec.SetField(actionjob.FieldStatusMessage, field.TypeString, value)
+		_node.StatusMessage = value
+	}
+	if value, ok := _c.mutation.DonePaths(); ok {
+		_spec.SetField(actionjob.FieldDonePaths, field.TypeJSON, value)
+		_node.DonePaths = value
+	}
+	if value, ok := _c.mutation.ClaimedAt(); ok {
+		_spec.SetField(actionjob.FieldClaimedAt, field.TypeTime, value)
+		_node.ClaimedAt = value
+	}
+	if value, ok := _c.mutation.FinishedAt(); ok {
+		_spec.SetField(actionjob.FieldFinishedAt, field.TypeTime, value)
+		_node.FinishedAt = value
+	}
+	if nodes := _c.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   actionjob.TenantTable,
+			Columns: []string{actionjob.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TenantID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.DuplicateGroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   actionjob.DuplicateGroupTable,
+			Columns: []string{actionjob.DuplicateGroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(duplicategroup.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.DuplicateGroupID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MachineIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   actionjob.MachineTable,
+			Columns: []string{actionjob.MachineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(machine.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MachineID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ActionJobCreateBulk is the builder for creating many ActionJob entities in bulk.
+type ActionJobCreateBulk struct {
+	config
+	err      error
+	builders []*ActionJobCreate
+}
+
+// Save creates the ActionJob entities in the database.
+func (_c *ActionJobCreateBulk) Save(ctx context.Context) ([]*ActionJob, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ActionJob, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ActionJobMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ActionJobCreateBulk) SaveX(ctx context.Context) []*ActionJob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ActionJobCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ActionJobCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1083 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/mcmx/duplynx/ent/actionjob"
+	"github.com/mcmx/duplynx/ent/duplicategroup"
+	"github.com/mcmx/duplynx/ent/machine"
+	"github.com/mcmx/duplynx/ent/predicate"
+	"github.com/mcmx/duplynx/ent/tenant"
+)
+
+// ActionJobUpdate is the builder for updating ActionJob entities.
+type ActionJobUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ActionJobMutation
+}
+
+// Where appends a list predicates to the ActionJobUpdate builder.
+func (_u *ActionJobUpdate) Where(ps ...predicate.ActionJob) *ActionJobUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ActionJobUpdate) SetUpdateTime(v time.Time) *ActionJobUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *ActionJobUpdate) SetTenantID(v uuid.UUID) *ActionJobUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *ActionJobUpdate) SetNillableTenantID(v *uuid.UUID) *ActionJobUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetActionID sets the "action_id" field.
+func (_u *ActionJobUpdate) SetActionID(v uuid.UUID) *ActionJobUpdate {
+	_u.mutation.SetActionID(v)
+	return _u
+}
+
+// SetNillableActionID sets the "action_id" field if the given value is not nil.
+func (_u *ActionJobUpdate) SetNillableActionID(v *uuid.UUID) *ActionJobUpdate {
+	if v != nil {
+		_u.SetActionID(*v)
+	}
+	return _u
+}
+
+// SetDuplicateGroupID sets the "duplicate_group_id" field.
+func (_u *ActionJobUpdate) SetDuplicateGroupID(v uuid.UUID) *ActionJobUpdate {
+	_u.mutation.SetDuplicateGroupID(v)
+	return _u
+}
+
+// SetNillableDuplicateGroupID sets the "duplicate_group_id" field if the given value is not nil.
+func (_u *ActionJobUpdate) SetNillableDuplicateGroupID(v *uuid.UUID) *ActionJobUpdate {
+	if v != nil {
+		_u.SetDuplicateGroupID(*v)
+	}
+	return _u
+}
+
+// SetMachineID sets the "machine_id" field.
+func (_u *ActionJobUpdate) SetMachineID(v uuid.UUID) *ActionJobUpdate {
+	_u.mutation.SetMachineID(v)
+	return _u
+}
+
+// SetNillableMachineID sets the "machine_id" field if the given value is not nil.
+func (_u *ActionJobUpdate) SetNillableMachineID(v *uuid.UUID) *ActionJobUpdate {
+	if v != nil {
+		_u.SetMachineID(*v)
+	}
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *ActionJobUpdate) SetAction(v actionjob.Action) *ActionJobUpdate {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *ActionJobUpdate) SetNillableAction(v *actionjob.Action) *ActionJobUpdate {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetHash sets the "hash" field.
+func (_u *ActionJobUpdate) SetHash(v string) *ActionJobUpdate {
+	_u.mutation.SetHash(v)
+	return _u
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (_u *ActionJobUpdate) SetNillableHash(v *string) *ActionJobUpdate {
+	if v != nil {
+		_u.SetHash(*v)
+	}
+	return _u
+}
+
+// SetKeepPath sets the "keep_path" field.
+func (_u *ActionJobUpdate) SetKeepPath(v string) *ActionJobUpdate {
+	_u.mutation.SetKeepPath(v)
+	return _u
+}
+
+// SetNillableKeepPath sets the "keep_path" field if the given value is not nil.
+func (_u *ActionJobUpdate) SetNillableKeepPath(v *string) *ActionJobUpdate {
+	if v != nil {
+		_u.SetKeepPath(*v)
+	}
+	return _u
+}
+
+// ClearKeepPath clears the value of the "keep_path" field.
+func (_u *ActionJobUpdate) ClearKeepPath() *ActionJobUpdate {
+	_u.mutation.ClearKeepPath()
+	return _u
+}
+
+// SetPaths sets the "paths" field.
+func (_u *ActionJobUpdate) SetPaths(v []string) *ActionJobUpdate {
+	_u.mutation.SetPaths(v)
+	return _u
+}
+
+// AppendPaths appends value to the "paths" field.
+func (_u *ActionJobUpdate) AppendPaths(v []string) *ActionJobUpdate {
+	_u.mutation.AppendPaths(v)
+	return _u
+}
+
+// SetActor sets the "actor" field.
+func (_u *ActionJobUpdate) SetActor(v string) *ActionJobUpdate {
+	_u.mutation.SetActor(v)
+	return _u
+}
+
+// SetNillableActor sets the "actor" field if the given value is not nil.
+func (_u *ActionJobUpdate) SetNillableActor(v *string) *ActionJobUpdate {
+	if v != nil {
+		_u.SetActor(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ActionJobUpdate) SetStatus(v actionjob.Status) *ActionJobUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ActionJobUpdate) SetNillableStatus(v *actionjob.Status) *ActionJobUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetStatusMessage sets the "status_message" field.
+func (_u *ActionJobUpdate) SetStatusMessage(v string) *ActionJobUpdate {
+	_u.mutation.SetStatusMessage(v)
+	return _u
+}
+
+// SetNillableStatusMessage sets the "status_message" field if the given value is not nil.
+func (_u *ActionJobUpdate) SetNillableStatusMessage(v *string) *ActionJobUpdate {
+	if v != nil {
+		_u.SetStatusMessage(*v)
+	}
+	return _u
+}
+
+// ClearStatusMessage clears the value of the "status_message" field.
+func (_u *ActionJobUpdate) ClearStatusMessage() *ActionJobUpdate {
+	_u.mutation.ClearStatusMessage()
+	return _u
+}
+
+// SetDonePaths sets the "done_paths" field.
+func (_u *ActionJobUpdate) SetDonePaths(v []string) *ActionJobUpdate {
+	_u.mutation.SetDonePaths(v)
+	return _u
+}
+
+// AppendDonePaths appends value to the "done_paths" field.
+func (_u *ActionJobUpdate) AppendDonePaths(v []string) *ActionJobUpdate {
+	_u.mutation.AppendDonePaths(v)
+	return _u
+}
+
+// ClearDonePaths clears the value of the "done_paths" field.
+func (_u *ActionJobUpdate) ClearDonePaths() *ActionJobUpdate {
+	_u.mutation.ClearDonePaths()
+	return _u
+}
+
+// SetClaimedAt sets the "claimed_at" field.
+func (_u *ActionJobUpdate) SetClaimedAt(v time.Time) *ActionJobUpdate {
+	_u.mutation.SetClaimedAt(v)
+	return _u
+}
+
+// SetNillableClaimedAt sets the "claimed_at" field if the given value is not nil.
+func (_u *ActionJobUpdate) SetNillableClaimedAt(v *time.Time) *ActionJobUpdate {
+	if v != nil {
+		_u.SetClaimedAt(*v)
+	}
+	return _u
+}
+
+// ClearClaimedAt clears the value of the "claimed_at" field.
+func (_u *ActionJobUpdate) ClearClaimedAt() *ActionJobUpdate {
+	_u.mutation.ClearClaimedAt()
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *ActionJobUpdate) SetFinishedAt(v time.Time) *ActionJobUpdate {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *ActionJobUpdate) SetNillableFinishedAt(v *time.Time) *ActionJobUpdate {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *ActionJobUpdate) ClearFinishedAt() *ActionJobUpdate {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *ActionJobUpdate) SetTenant(v *Tenant) *ActionJobUpdate {
+	return _u.SetTenantID(v.ID)
+}
+
+// SetDuplicateGroup sets the "duplicate_group" edge to the DuplicateGroup entity.
+func (_u *ActionJobUpdate) SetDuplicateGroup(v *DuplicateGroup) *ActionJobUpdate {
+	return _u.SetDuplicateGroupID(v.ID)
+}
+
+// SetMachine sets the "machine" edge to the Machine entity.
+func (_u *ActionJobUpdate) SetMachine(v *Machine) *ActionJobUpdate {
+	return _u.SetMachineID(v.ID)
+}
+
+// Mutation returns the ActionJobMutation object of the builder.
+func (_u *ActionJobUpdate) Mutation() *ActionJobMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *ActionJobUpdate) ClearTenant() *ActionJobUpdate {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearDuplicateGroup clears the "duplicate_group" edge to the DuplicateGroup entity.
+func (_u *ActionJobUpdate) ClearDuplicateGroup() *ActionJobUpdate {
+	_u.mutation.ClearDuplicateGroup()
+	return _u
+}
+
+// ClearMachine clears the "machine" edge to the Machine entity.
+func (_u *ActionJobUpdate) ClearMachine() *ActionJobUpdate {
+	_u.mutation.ClearMachine()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ActionJobUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ActionJobUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ActionJobUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ActionJobUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ActionJobUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if actionjob.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized actionjob.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := actionjob.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ActionJobUpdate) check() error {
+	if v, ok := _u.mutation.Action(); ok {
+		if err := actionjob.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "ActionJob.action": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := actionjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ActionJob.status": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ActionJob.tenant"`)
+	}
+	if _u.mutation.DuplicateGroupCleared() && len(_u.mutation.DuplicateGroupIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ActionJob.duplicate_group"`)
+	}
+	if _u.mutation.MachineCleared() && len(_u.mutation.MachineIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ActionJob.machine"`)
+	}
+	return nil
+}
+
+func (_u *ActionJobUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(actionjob.Table, actionjob.Columns, sqlgraph.NewFieldSpec(actionjob.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(actionjob.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ActionID(); ok {
+		_spec.SetField(actionjob.FieldActionID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(actionjob.FieldAction, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Hash(); ok {
+		_spec.SetField(actionjob.FieldHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.KeepPath(); ok {
+		_spec.SetField(actionjob.FieldKeepPath, field.TypeString, value)
+	}
+	if _u.mutation.KeepPathCleared() {
+		_spec.ClearField(actionjob.FieldKeepPath, field.TypeString)
+	}
+	if value, ok := _u.mutation.Paths(); ok {
+		_spec.SetField(actionjob.FieldPaths, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPaths(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, actionjob.FieldPaths, value)
+		})
+	}
+	if value, ok := _u.mutation.Actor(); ok {
+		_spec.SetField(actionjob.FieldActor, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(actionjob.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.StatusMessage(); ok {
+		_spec.SetField(actionjob.FieldStatusMessage, field.TypeString, value)
+	}
+	if _u.mutation.StatusMessageCleared() {
+		_spec.ClearField(actionjob.FieldStatusMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.DonePaths(); ok {
+		_spec.SetField(actionjob.FieldDonePaths, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedDonePaths(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, actionjob.FieldDonePaths, value)
+		})
+	}
+	if _u.mutation.DonePathsCleared() {
+		_spec.ClearField(actionjob.FieldDonePaths, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ClaimedAt(); ok {
+		_spec.SetField(actionjob.FieldClaimedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClaimedAtCleared() {
+		_spec.ClearField(actionjob.FieldClaimedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(actionjob.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(actionjob.FieldFinishedAt, field.TypeTime)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   actionjob.TenantTable,
+			Columns: []string{actionjob.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   actionjob.TenantTable,
+			Columns: []string{actionjob.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DuplicateGroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   actionjob.DuplicateGroupTable,
+			Columns: []string{actionjob.DuplicateGroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(duplicategroup.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DuplicateGroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   actionjob.DuplicateGroupTable,
+			Columns: []string{actionjob.DuplicateGroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(duplicategroup.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MachineCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   actionjob.MachineTable,
+			Columns: []string{actionjob.MachineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(machine.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MachineIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   actionjob.MachineTable,
+			Columns: []string{actionjob.MachineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(machine.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{actionjob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ActionJobUpdateOne is the builder for updating a single ActionJob entity.
+type ActionJobUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ActionJobMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ActionJobUpdateOne) SetUpdateTime(v time.Time) *ActionJobUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *ActionJobUpdateOne) SetTenantID(v uuid.UUID) *ActionJobUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *ActionJobUpdateOne) SetNillableTenantID(v *uuid.UUID) *ActionJobUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetActionID sets the "action_id" field.
+func (_u *ActionJobUpdateOne) SetActionID(v uuid.UUID) *ActionJobUpdateOne {
+	_u.mutation.SetActionID(v)
+	return _u
+}
+
+// SetNillableActionID sets the "action_id" field if the given value is not nil.
+func (_u *ActionJobUpdateOne) SetNillableActionID(v *uuid.UUID) *ActionJobUpdateOne {
+	if v != nil {
+		_u.SetActionID(*v)
+	}
+	return _u
+}
+
+// SetDuplicateGroupID sets the "duplicate_group_id" field.
+func (_u *ActionJobUpdateOne) SetDuplicateGroupID(v uuid.UUID) *ActionJobUpdateOne {
+	_u.mutation.SetDuplicateGroupID(v)
+	return _u
+}
+
+// SetNillableDuplicateGroupID sets the "duplicate_group_id" field if the given value is not nil.
+func (_u *ActionJobUpdateOne) SetNillableDuplicateGroupID(v *uuid.UUID) *ActionJobUpdateOne {
+	if v != nil {
+		_u.SetDuplicateGroupID(*v)
+	}
+	return _u
+}
+
+// SetMachineID sets the "machine_id" field.
+func (_u *ActionJobUpdateOne) SetMachineID(v uuid.UUID) *ActionJobUpdateOne {
+	_u.mutation.SetMachineID(v)
+	return _u
+}
+
+// SetNillableMachineID sets the "machine_id" field if the given value is not nil.
+func (_u *ActionJobUpdateOne) SetNillableMachineID(v *uuid.UUID) *ActionJobUpdateOne {
+	if v != nil {
+		_u.SetMachineID(*v)
+	}
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *ActionJobUpdateOne) SetAction(v actionjob.Action) *ActionJobUpdateOne {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *ActionJobUpdateOne) SetNillableAction(v *actionjob.Action) *ActionJobUpdateOne {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetHash sets the "hash" field.
+func (_u *ActionJobUpdateOne) SetHash(v string) *ActionJobUpdateOne {
+	_u.mutation.SetHash(v)
+	return _u
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (_u *ActionJobUpdateOne) SetNillableHash(v *string) *ActionJobUpdateOne {
+	if v != nil {
+		_u.SetHash(*v)
+	}
+	return _u
+}
+
+// SetKeepPath sets the "keep_path" field.
+func (_u *ActionJobUpdateOne) SetKeepPath(v string) *ActionJobUpdateOne {
+	_u.mutation.SetKeepPath(v)
+	return _u
+}
+
+// SetNillableKeepPath sets the "keep_path" field if the given value is not nil.
+func (_u *ActionJobUpdateOne) SetNillableKeepPath(v *string) *ActionJobUpdateOne {
+	if v != nil {
+		_u.SetKeepPath(*v)
+	}
+	return _u
+}
+
+// ClearKeepPath clears the value of the "keep_path" field.
+func (_u *ActionJobUpdateOne) ClearKeepPath() *ActionJobUpdateOne {
+	_u.mutation.ClearKeepPath()
+	return _u
+}
+
+// SetPaths sets the "paths" field.
+func (_u *ActionJobUpdateOne) SetPaths(v []string) *ActionJobUpdateOne {
+	_u.mutation.SetPaths(v)
+	return _u
+}
+
+// AppendPaths appends value to the "paths" field.
+func (_u *ActionJobUpdateOne) AppendPaths(v []string) *ActionJobUpdateOne {
+	_u.mutation.AppendPaths(v)
+	return _u
+}
+
+// SetActor sets the "actor" field.
+func (_u *ActionJobUpdateOne) SetActor(v string) *ActionJobUpdateOne {
+	_u.mutation.SetActor(v)
+	return _u
+}
+
+// SetNillableActor sets the "actor" field if the given value is not nil.
+func (_u *ActionJobUpdateOne) SetNillableActor(v *string) *ActionJobUpdateOne {
+	if v != nil {
+		_u.SetActor(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ActionJobUpdateOne) SetStatus(v actionjob.Status) *ActionJobUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ActionJobUpdateOne) SetNillableStatus(v *actionjob.Status) *ActionJobUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetStatusMessage sets the "status_message" field.
+func (_u *ActionJobUpdateOne) SetStatusMessage(v string) *ActionJobUpdateOne {
+	_u.mutation.SetStatusMessage(v)
+	return _u
+}
+
+// SetNillableStatusMessage sets the "status_message" field if the given value is not nil.
+func (_u *ActionJobUpdateOne) SetNillableStatusMessage(v *string) *ActionJobUpdateOne {
+	if v != nil {
+		_u.SetStatusMessage(*v)
+	}
+	return _u
+}
+
+// ClearStatusMessage clears the value of the "status_message" field.
+func (_u *ActionJobUpdateOne) ClearStatusMessage() *ActionJobUpdateOne {
+	_u.mutation.ClearStatusMessage()
+	return _u
+}
+
+// SetDonePaths sets the "done_paths" field.
+func (_u *ActionJobUpdateOne) SetDonePaths(v []string) *ActionJobUpdateOne {
+	_u.mutation.SetDonePaths(v)
+	return _u
+}
+
+// AppendDonePaths appends value to the "done_paths" field.
+func (_u *ActionJobUpdateOne) AppendDonePaths(v []string) *ActionJobUpdateOne {
+	_u.mutation.AppendDonePaths(v)
+	return _u
+}
+
+// ClearDonePaths clears the value of the "done_paths" field.
+func (_u *ActionJobUpdateOne) ClearDonePaths() *ActionJobUpdateOne {
+	_u.mutation.ClearDonePaths()
+	return _u
+}
+
+// SetClaimedAt sets the "claimed_at" field.
+func (_u *ActionJobUpdateOne) SetClaimedAt(v time.Time) *ActionJobUpdateOne {
+	_u.mutation.SetClaimedAt(v)
+	return _u
+}
+
+// SetNillableClaimedAt sets the "claimed_at" field if the given value is not nil.
+func (_u *ActionJobUpdateOne) SetNillableClaimedAt(v *time.Time) *ActionJobUpdateOne {
+	if v != nil {
+		_u.SetClaimedAt(*v)
+	}
+	return _u
+}
+
+// ClearClaimedAt clears the value of the "claimed_at" field.
+func (_u *ActionJobUpdateOne) ClearClaimedAt() *ActionJobUpdateOne {
+	_u.mutation.ClearClaimedAt()
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *ActionJobUpdateOne) SetFinishedAt(v time.Time) *ActionJobUpdateOne {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *ActionJobUpdateOne) SetNillableFinishedAt(v *time.Time) *ActionJobUpdateOne {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *ActionJobUpdateOne) ClearFinishedAt() *ActionJobUpdateOne {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *ActionJobUpdateOne) SetTenant(v *Tenant) *ActionJobUpdateOne {
+	return _u.SetTenantID(v.ID)
+}
+
+// SetDuplicateGroup sets the "duplicate_group" edge to the DuplicateGroup entity.
+func (_u *ActionJobUpdateOne) SetDuplicateGroup(v *DuplicateGroup) *ActionJobUpdateOne {
+	return _u.SetDuplicateGroupID(v.ID)
+}
+
+// SetMachine sets the "machine" edge to the Machine entity.
+func (_u *ActionJobUpdateOne) SetMachine(v *Machine) *ActionJobUpdateOne {
+	return _u.SetMachineID(v.ID)
+}
+
+// Mutation returns the ActionJobMutation object of the builder.
+func (_u *ActionJobUpdateOne) Mutation() *ActionJobMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *ActionJobUpdateOne) ClearTenant() *ActionJobUpdateOne {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearDuplicateGroup clears the "duplicate_group" edge to the DuplicateGroup entity.
+func (_u *ActionJobUpdateOne) ClearDuplicateGroup() *ActionJobUpdateOne {
+	_u.mutation.ClearDuplicateGroup()
+	return _u
+}
+
+// ClearMachine clears the "machine" edge to the Machine entity.
+func (_u *ActionJobUpdateOne) ClearMachine() *ActionJobUpdateOne {
+	_u.mutation.ClearMachine()
+	return _u
+}
+
+// Where appends a list predicates to the ActionJobUpdate builder.
+func (_u *ActionJobUpdateOne) Where(ps ...predicate.ActionJob) *ActionJobUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ActionJobUpdateOne) Select(field string, fields ...string) *ActionJobUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ActionJob entity.
+func (_u *ActionJobUpdateOne) Save(ctx context.Context) (*ActionJob, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ActionJobUpdateOne) SaveX(ctx context.Context) *ActionJob {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ActionJobUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ActionJobUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ActionJobUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if actionjob.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized actionjob.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := actionjob.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ActionJobUpdateOne) check() error {
+	if v, ok := _u.mutation.Action(); ok {
+		if err := actionjob.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "ActionJob.action": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := actionjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ActionJob.status": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ActionJob.tenant"`)
+	}
+	if _u.mutation.DuplicateGroupCleared() && len(_u.mutation.DuplicateGroupIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ActionJob.duplicate_group"`)
+	}
+	if _u.mutation.MachineCleared() && len(_u.mutation.MachineIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ActionJob.machine"`)
+	}
+	return nil
+}
+
+func (_u *ActionJobUpdateOne) sqlSave(ctx context.Context) (_node *ActionJob, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(actionjob.Table, actionjob.Columns, sqlgraph.NewFieldSpec(actionjob.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ActionJob.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, actionjob.FieldID)
+		for _, f := range fields {
+			if !actionjob.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != actionjob.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(actionjob.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ActionID(); ok {
+		_spec.SetField(actionjob.FieldActionID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(actionjob.FieldAction, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Hash(); ok {
+		_spec.SetField(actionjob.FieldHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.KeepPath(); ok {
+		_spec.SetField(actionjob.FieldKeepPath, field.TypeString, value)
+	}
+	if _u.mutation.KeepPathCleared() {
+		_spec.ClearField(actionjob.FieldKeepPath, field.TypeString)
+	}
+	if value, ok := _u.mutation.Paths(); ok {
+		_spec.SetField(actionjob.FieldPaths, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPaths(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, actionjob.FieldPaths, value)
+		})
+	}
+	if value, ok := _u.mutation.Actor(); ok {
+		_spec.SetField(actionjob.FieldActor, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(actionjob.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.StatusMessage(); ok {
+		_spec.SetField(actionjob.FieldStatusMessage, field.TypeString, value)
+	}
+	if _u.mutation.StatusMessageCleared() {
+		_spec.ClearField(actionjob.FieldStatusMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.DonePaths(); ok {
+		_spec.SetField(actionjob.FieldDonePaths, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedDonePaths(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, actionjob.FieldDonePaths, value)
+		})
+	}
+	if _u.mutation.DonePathsCleared() {
+		_spec.ClearField(actionjob.FieldDonePaths, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ClaimedAt(); ok {
+		_spec.SetField(actionjob.FieldClaimedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClaimedAtCleared() {
+		_spec.ClearField(actionjob.FieldClaimedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(actionjob.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(actionjob.FieldFinishedAt, field.TypeTime)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   actionjob.TenantTable,
+			Columns: []string{actionjob.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   actionjob.TenantTable,
+			Columns: []string{actionjob.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DuplicateGroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   actionjob.DuplicateGroupTable,
+			Columns: []string{actionjob.DuplicateGroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(duplicategroup.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DuplicateGroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   actionjob.DuplicateGroupTable,
+			Columns: []string{actionjob.DuplicateGroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(duplicategroup.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MachineCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   actionjob.MachineTable,
+			Columns: []string{actionjob.MachineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(machine.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MachineIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   actionjob.MachineTable,
+			Columns: []string{actionjob.MachineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(machine.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ActionJob{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{actionjob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
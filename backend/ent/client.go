@@ -17,11 +17,21 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/mcmx/duplynx/ent/actionaudit"
+	"github.com/mcmx/duplynx/ent/actionjob"
+	"github.com/mcmx/duplynx/ent/contentevent"
+	"github.com/mcmx/duplynx/ent/contentidentity"
 	"github.com/mcmx/duplynx/ent/duplicategroup"
 	"github.com/mcmx/duplynx/ent/fileinstance"
 	"github.com/mcmx/duplynx/ent/machine"
 	"github.com/mcmx/duplynx/ent/scan"
+	"github.com/mcmx/duplynx/ent/scanschedule"
+	"github.com/mcmx/duplynx/ent/scanschedulerun"
+	"github.com/mcmx/duplynx/ent/scantarget"
 	"github.com/mcmx/duplynx/ent/tenant"
+	"github.com/mcmx/duplynx/ent/tenantsecret"
+	"github.com/mcmx/duplynx/ent/tenanttombstone"
+
+	stdsql "database/sql"
 )
 
 // Client is the client that holds all ent builders.
@@ -31,6 +41,12 @@ type Client struct {
 	Schema *migrate.Schema
 	// ActionAudit is the client for interacting with the ActionAudit builders.
 	ActionAudit *ActionAuditClient
+	// ActionJob is the client for interacting with the ActionJob builders.
+	ActionJob *ActionJobClient
+	// ContentEvent is the client for interacting with the ContentEvent builders.
+	ContentEvent *ContentEventClient
+	// ContentIdentity is the client for interacting with the ContentIdentity builders.
+	ContentIdentity *ContentIdentityClient
 	// DuplicateGroup is the client for interacting with the DuplicateGroup builders.
 	DuplicateGroup *DuplicateGroupClient
 	// FileInstance is the client for interacting with the FileInstance builders.
@@ -39,8 +55,18 @@ type Client struct {
 	Machine *MachineClient
 	// Scan is the client for interacting with the Scan builders.
 	Scan *ScanClient
+	// ScanSchedule is the client for interacting with the ScanSchedule builders.
+	ScanSchedule *ScanScheduleClient
+	// ScanScheduleRun is the client for interacting with the ScanScheduleRun builders.
+	ScanScheduleRun *ScanScheduleRunClient
+	// ScanTarget is the client for interacting with the ScanTarget builders.
+	ScanTarget *ScanTargetClient
 	// Tenant is the client for interacting with the Tenant builders.
 	Tenant *TenantClient
+	// TenantSecret is the client for interacting with the TenantSecret builders.
+	TenantSecret *TenantSecretClient
+	// TenantTombstone is the client for interacting with the TenantTombstone builders.
+	TenantTombstone *TenantTombstoneClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -53,11 +79,19 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.ActionAudit = NewActionAuditClient(c.config)
+	c.ActionJob = NewActionJobClient(c.config)
+	c.ContentEvent = NewContentEventClient(c.config)
+	c.ContentIdentity = NewContentIdentityClient(c.config)
 	c.DuplicateGroup = NewDuplicateGroupClient(c.config)
 	c.FileInstance = NewFileInstanceClient(c.config)
 	c.Machine = NewMachineClient(c.config)
 	c.Scan = NewScanClient(c.config)
+	c.ScanSchedule = NewScanScheduleClient(c.config)
+	c.ScanScheduleRun = NewScanScheduleRunClient(c.config)
+	c.ScanTarget = NewScanTargetClient(c.config)
 	c.Tenant = NewTenantClient(c.config)
+	c.TenantSecret = NewTenantSecretClient(c.config)
+	c.TenantTombstone = NewTenantTombstoneClient(c.config)
 }
 
 type (
@@ -148,14 +182,22 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:            ctx,
-		config:         cfg,
-		ActionAudit:    NewActionAuditClient(cfg),
-		DuplicateGroup: NewDuplicateGroupClient(cfg),
-		FileInstance:   NewFileInstanceClient(cfg),
-		Machine:        NewMachineClient(cfg),
-		Scan:           NewScanClient(cfg),
-		Tenant:         NewTenantClient(cfg),
+		ctx:             ctx,
+		config:          cfg,
+		ActionAudit:     NewActionAuditClient(cfg),
+		ActionJob:       NewActionJobClient(cfg),
+		ContentEvent:    NewContentEventClient(cfg),
+		ContentIdentity: NewContentIdentityClient(cfg),
+		DuplicateGroup:  NewDuplicateGroupClient(cfg),
+		FileInstance:    NewFileInstanceClient(cfg),
+		Machine:         NewMachineClient(cfg),
+		Scan:            NewScanClient(cfg),
+		ScanSchedule:    NewScanScheduleClient(cfg),
+		ScanScheduleRun: NewScanScheduleRunClient(cfg),
+		ScanTarget:      NewScanTargetClient(cfg),
+		Tenant:          NewTenantClient(cfg),
+		TenantSecret:    NewTenantSecretClient(cfg),
+		TenantTombstone: NewTenantTombstoneClient(cfg),
 	}, nil
 }
 
@@ -173,14 +215,22 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:            ctx,
-		config:         cfg,
-		ActionAudit:    NewActionAuditClient(cfg),
-		DuplicateGroup: NewDuplicateGroupClient(cfg),
-		FileInstance:   NewFileInstanceClient(cfg),
-		Machine:        NewMachineClient(cfg),
-		Scan:           NewScanClient(cfg),
-		Tenant:         NewTenantClient(cfg),
+		ctx:             ctx,
+		config:          cfg,
+		ActionAudit:     NewActionAuditClient(cfg),
+		ActionJob:       NewActionJobClient(cfg),
+		ContentEvent:    NewContentEventClient(cfg),
+		ContentIdentity: NewContentIdentityClient(cfg),
+		DuplicateGroup:  NewDuplicateGroupClient(cfg),
+		FileInstance:    NewFileInstanceClient(cfg),
+		Machine:         NewMachineClient(cfg),
+		Scan:            NewScanClient(cfg),
+		ScanSchedule:    NewScanScheduleClient(cfg),
+		ScanScheduleRun: NewScanScheduleRunClient(cfg),
+		ScanTarget:      NewScanTargetClient(cfg),
+		Tenant:          NewTenantClient(cfg),
+		TenantSecret:    NewTenantSecretClient(cfg),
+		TenantTombstone: NewTenantTombstoneClient(cfg),
 	}, nil
 }
 
@@ -210,7 +260,9 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.ActionAudit, c.DuplicateGroup, c.FileInstance, c.Machine, c.Scan, c.Tenant,
+		c.ActionAudit, c.ActionJob, c.ContentEvent, c.ContentIdentity, c.DuplicateGroup,
+		c.FileInstance, c.Machine, c.Scan, c.ScanSchedule, c.ScanScheduleRun,
+		c.ScanTarget, c.Tenant, c.TenantSecret, c.TenantTombstone,
 	} {
 		n.Use(hooks...)
 	}
@@ -220,7 +272,9 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.ActionAudit, c.DuplicateGroup, c.FileInstance, c.Machine, c.Scan, c.Tenant,
+		c.ActionAudit, c.ActionJob, c.ContentEvent, c.ContentIdentity, c.DuplicateGroup,
+		c.FileInstance, c.Machine, c.Scan, c.ScanSchedule, c.ScanScheduleRun,
+		c.ScanTarget, c.Tenant, c.TenantSecret, c.TenantTombstone,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -231,6 +285,12 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *ActionAuditMutation:
 		return c.ActionAudit.mutate(ctx, m)
+	case *ActionJobMutation:
+		return c.ActionJob.mutate(ctx, m)
+	case *ContentEventMutation:
+		return c.ContentEvent.mutate(ctx, m)
+	case *ContentIdentityMutation:
+		return c.ContentIdentity.mutate(ctx, m)
 	case *DuplicateGroupMutation:
 		return c.DuplicateGroup.mutate(ctx, m)
 	case *FileInstanceMutation:
@@ -239,8 +299,18 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Machine.mutate(ctx, m)
 	case *ScanMutation:
 		return c.Scan.mutate(ctx, m)
+	case *ScanScheduleMutation:
+		return c.ScanSchedule.mutate(ctx, m)
+	case *ScanScheduleRunMutation:
+		return c.ScanScheduleRun.mutate(ctx, m)
+	case *ScanTargetMutation:
+		return c.ScanTarget.mutate(ctx, m)
 	case *TenantMutation:
 		return c.Tenant.mutate(ctx, m)
+	case *TenantSecretMutation:
+		return c.TenantSecret.mutate(ctx, m)
+	case *TenantTombstoneMutation:
+		return c.TenantTombstone.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
@@ -388,12 +458,14 @@ func (c *ActionAuditClient) QueryDuplicateGroup(_m *ActionAudit) *DuplicateGroup
 
 // Hooks returns the client hooks.
 func (c *ActionAuditClient) Hooks() []Hook {
-	return c.hooks.ActionAudit
+	hooks := c.hooks.ActionAudit
+	return append(hooks[:len(hooks):len(hooks)], actionaudit.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
 func (c *ActionAuditClient) Interceptors() []Interceptor {
-	return c.inters.ActionAudit
+	inters := c.inters.ActionAudit
+	return append(inters[:len(inters):len(inters)], actionaudit.Interceptors[:]...)
 }
 
 func (c *ActionAuditClient) mutate(ctx context.Context, m *ActionAuditMutation) (Value, error) {
@@ -411,107 +483,107 @@ func (c *ActionAuditClient) mutate(ctx context.Context, m *ActionAuditMutation)
 	}
 }
 
-// DuplicateGroupClient is a client for the DuplicateGroup schema.
-type DuplicateGroupClient struct {
+// ActionJobClient is a client for the ActionJob schema.
+type ActionJobClient struct {
 	config
 }
 
-// NewDuplicateGroupClient returns a client for the DuplicateGroup from the given config.
-func NewDuplicateGroupClient(c config) *DuplicateGroupClient {
-	return &DuplicateGroupClient{config: c}
+// NewActionJobClient returns a client for the ActionJob from the given config.
+func NewActionJobClient(c config) *ActionJobClient {
+	return &ActionJobClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `duplicategroup.Hooks(f(g(h())))`.
-func (c *DuplicateGroupClient) Use(hooks ...Hook) {
-	c.hooks.DuplicateGroup = append(c.hooks.DuplicateGroup, hooks...)
+// A call to `Use(f, g, h)` equals to `actionjob.Hooks(f(g(h())))`.
+func (c *ActionJobClient) Use(hooks ...Hook) {
+	c.hooks.ActionJob = append(c.hooks.ActionJob, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `duplicategroup.Intercept(f(g(h())))`.
-func (c *DuplicateGroupClient) Intercept(interceptors ...Interceptor) {
-	c.inters.DuplicateGroup = append(c.inters.DuplicateGroup, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `actionjob.Intercept(f(g(h())))`.
+func (c *ActionJobClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ActionJob = append(c.inters.ActionJob, interceptors...)
 }
 
-// Create returns a builder for creating a DuplicateGroup entity.
-func (c *DuplicateGroupClient) Create() *DuplicateGroupCreate {
-	mutation := newDuplicateGroupMutation(c.config, OpCreate)
-	return &DuplicateGroupCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ActionJob entity.
+func (c *ActionJobClient) Create() *ActionJobCreate {
+	mutation := newActionJobMutation(c.config, OpCreate)
+	return &ActionJobCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of DuplicateGroup entities.
-func (c *DuplicateGroupClient) CreateBulk(builders ...*DuplicateGroupCreate) *DuplicateGroupCreateBulk {
-	return &DuplicateGroupCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ActionJob entities.
+func (c *ActionJobClient) CreateBulk(builders ...*ActionJobCreate) *ActionJobCreateBulk {
+	return &ActionJobCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *DuplicateGroupClient) MapCreateBulk(slice any, setFunc func(*DuplicateGroupCreate, int)) *DuplicateGroupCreateBulk {
+func (c *ActionJobClient) MapCreateBulk(slice any, setFunc func(*ActionJobCreate, int)) *ActionJobCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &DuplicateGroupCreateBulk{err: fmt.Errorf("calling to DuplicateGroupClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ActionJobCreateBulk{err: fmt.Errorf("calling to ActionJobClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*DuplicateGroupCreate, rv.Len())
+	builders := make([]*ActionJobCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &DuplicateGroupCreateBulk{config: c.config, builders: builders}
+	return &ActionJobCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for DuplicateGroup.
-func (c *DuplicateGroupClient) Update() *DuplicateGroupUpdate {
-	mutation := newDuplicateGroupMutation(c.config, OpUpdate)
-	return &DuplicateGroupUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ActionJob.
+func (c *ActionJobClient) Update() *ActionJobUpdate {
+	mutation := newActionJobMutation(c.config, OpUpdate)
+	return &ActionJobUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *DuplicateGroupClient) UpdateOne(_m *DuplicateGroup) *DuplicateGroupUpdateOne {
-	mutation := newDuplicateGroupMutation(c.config, OpUpdateOne, withDuplicateGroup(_m))
-	return &DuplicateGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ActionJobClient) UpdateOne(_m *ActionJob) *ActionJobUpdateOne {
+	mutation := newActionJobMutation(c.config, OpUpdateOne, withActionJob(_m))
+	return &ActionJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *DuplicateGroupClient) UpdateOneID(id uuid.UUID) *DuplicateGroupUpdateOne {
-	mutation := newDuplicateGroupMutation(c.config, OpUpdateOne, withDuplicateGroupID(id))
-	return &DuplicateGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ActionJobClient) UpdateOneID(id uuid.UUID) *ActionJobUpdateOne {
+	mutation := newActionJobMutation(c.config, OpUpdateOne, withActionJobID(id))
+	return &ActionJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for DuplicateGroup.
-func (c *DuplicateGroupClient) Delete() *DuplicateGroupDelete {
-	mutation := newDuplicateGroupMutation(c.config, OpDelete)
-	return &DuplicateGroupDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ActionJob.
+func (c *ActionJobClient) Delete() *ActionJobDelete {
+	mutation := newActionJobMutation(c.config, OpDelete)
+	return &ActionJobDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *DuplicateGroupClient) DeleteOne(_m *DuplicateGroup) *DuplicateGroupDeleteOne {
+func (c *ActionJobClient) DeleteOne(_m *ActionJob) *ActionJobDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *DuplicateGroupClient) DeleteOneID(id uuid.UUID) *DuplicateGroupDeleteOne {
-	builder := c.Delete().Where(duplicategroup.ID(id))
+func (c *ActionJobClient) DeleteOneID(id uuid.UUID) *ActionJobDeleteOne {
+	builder := c.Delete().Where(actionjob.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &DuplicateGroupDeleteOne{builder}
+	return &ActionJobDeleteOne{builder}
 }
 
-// Query returns a query builder for DuplicateGroup.
-func (c *DuplicateGroupClient) Query() *DuplicateGroupQuery {
-	return &DuplicateGroupQuery{
+// Query returns a query builder for ActionJob.
+func (c *ActionJobClient) Query() *ActionJobQuery {
+	return &ActionJobQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeDuplicateGroup},
+		ctx:    &QueryContext{Type: TypeActionJob},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a DuplicateGroup entity by its id.
-func (c *DuplicateGroupClient) Get(ctx context.Context, id uuid.UUID) (*DuplicateGroup, error) {
-	return c.Query().Where(duplicategroup.ID(id)).Only(ctx)
+// Get returns a ActionJob entity by its id.
+func (c *ActionJobClient) Get(ctx context.Context, id uuid.UUID) (*ActionJob, error) {
+	return c.Query().Where(actionjob.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *DuplicateGroupClient) GetX(ctx context.Context, id uuid.UUID) *DuplicateGroup {
+func (c *ActionJobClient) GetX(ctx context.Context, id uuid.UUID) *ActionJob {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -519,15 +591,15 @@ func (c *DuplicateGroupClient) GetX(ctx context.Context, id uuid.UUID) *Duplicat
 	return obj
 }
 
-// QueryTenant queries the tenant edge of a DuplicateGroup.
-func (c *DuplicateGroupClient) QueryTenant(_m *DuplicateGroup) *TenantQuery {
+// QueryTenant queries the tenant edge of a ActionJob.
+func (c *ActionJobClient) QueryTenant(_m *ActionJob) *TenantQuery {
 	query := (&TenantClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(duplicategroup.Table, duplicategroup.FieldID, id),
+			sqlgraph.From(actionjob.Table, actionjob.FieldID, id),
 			sqlgraph.To(tenant.Table, tenant.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, duplicategroup.TenantTable, duplicategroup.TenantColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, actionjob.TenantTable, actionjob.TenantColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -535,15 +607,15 @@ func (c *DuplicateGroupClient) QueryTenant(_m *DuplicateGroup) *TenantQuery {
 	return query
 }
 
-// QueryScan queries the scan edge of a DuplicateGroup.
-func (c *DuplicateGroupClient) QueryScan(_m *DuplicateGroup) *ScanQuery {
-	query := (&ScanClient{config: c.config}).Query()
+// QueryDuplicateGroup queries the duplicate_group edge of a ActionJob.
+func (c *ActionJobClient) QueryDuplicateGroup(_m *ActionJob) *DuplicateGroupQuery {
+	query := (&DuplicateGroupClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(duplicategroup.Table, duplicategroup.FieldID, id),
-			sqlgraph.To(scan.Table, scan.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, duplicategroup.ScanTable, duplicategroup.ScanColumn),
+			sqlgraph.From(actionjob.Table, actionjob.FieldID, id),
+			sqlgraph.To(duplicategroup.Table, duplicategroup.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, actionjob.DuplicateGroupTable, actionjob.DuplicateGroupColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -551,47 +623,15 @@ func (c *DuplicateGroupClient) QueryScan(_m *DuplicateGroup) *ScanQuery {
 	return query
 }
 
-// QueryKeeperMachine queries the keeper_machine edge of a DuplicateGroup.
-func (c *DuplicateGroupClient) QueryKeeperMachine(_m *DuplicateGroup) *MachineQuery {
+// QueryMachine queries the machine edge of a ActionJob.
+func (c *ActionJobClient) QueryMachine(_m *ActionJob) *MachineQuery {
 	query := (&MachineClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(duplicategroup.Table, duplicategroup.FieldID, id),
+			sqlgraph.From(actionjob.Table, actionjob.FieldID, id),
 			sqlgraph.To(machine.Table, machine.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, duplicategroup.KeeperMachineTable, duplicategroup.KeeperMachineColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryFileInstances queries the file_instances edge of a DuplicateGroup.
-func (c *DuplicateGroupClient) QueryFileInstances(_m *DuplicateGroup) *FileInstanceQuery {
-	query := (&FileInstanceClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(duplicategroup.Table, duplicategroup.FieldID, id),
-			sqlgraph.To(fileinstance.Table, fileinstance.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, duplicategroup.FileInstancesTable, duplicategroup.FileInstancesColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryActionAudits queries the action_audits edge of a DuplicateGroup.
-func (c *DuplicateGroupClient) QueryActionAudits(_m *DuplicateGroup) *ActionAuditQuery {
-	query := (&ActionAuditClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(duplicategroup.Table, duplicategroup.FieldID, id),
-			sqlgraph.To(actionaudit.Table, actionaudit.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, duplicategroup.ActionAuditsTable, duplicategroup.ActionAuditsColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, actionjob.MachineTable, actionjob.MachineColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -600,131 +640,133 @@ func (c *DuplicateGroupClient) QueryActionAudits(_m *DuplicateGroup) *ActionAudi
 }
 
 // Hooks returns the client hooks.
-func (c *DuplicateGroupClient) Hooks() []Hook {
-	return c.hooks.DuplicateGroup
+func (c *ActionJobClient) Hooks() []Hook {
+	hooks := c.hooks.ActionJob
+	return append(hooks[:len(hooks):len(hooks)], actionjob.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *DuplicateGroupClient) Interceptors() []Interceptor {
-	return c.inters.DuplicateGroup
+func (c *ActionJobClient) Interceptors() []Interceptor {
+	inters := c.inters.ActionJob
+	return append(inters[:len(inters):len(inters)], actionjob.Interceptors[:]...)
 }
 
-func (c *DuplicateGroupClient) mutate(ctx context.Context, m *DuplicateGroupMutation) (Value, error) {
+func (c *ActionJobClient) mutate(ctx context.Context, m *ActionJobMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&DuplicateGroupCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ActionJobCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&DuplicateGroupUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ActionJobUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&DuplicateGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ActionJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&DuplicateGroupDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ActionJobDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown DuplicateGroup mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ActionJob mutation op: %q", m.Op())
 	}
 }
 
-// FileInstanceClient is a client for the FileInstance schema.
-type FileInstanceClient struct {
+// ContentEventClient is a client for the ContentEvent schema.
+type ContentEventClient struct {
 	config
 }
 
-// NewFileInstanceClient returns a client for the FileInstance from the given config.
-func NewFileInstanceClient(c config) *FileInstanceClient {
-	return &FileInstanceClient{config: c}
+// NewContentEventClient returns a client for the ContentEvent from the given config.
+func NewContentEventClient(c config) *ContentEventClient {
+	return &ContentEventClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `fileinstance.Hooks(f(g(h())))`.
-func (c *FileInstanceClient) Use(hooks ...Hook) {
-	c.hooks.FileInstance = append(c.hooks.FileInstance, hooks...)
+// A call to `Use(f, g, h)` equals to `contentevent.Hooks(f(g(h())))`.
+func (c *ContentEventClient) Use(hooks ...Hook) {
+	c.hooks.ContentEvent = append(c.hooks.ContentEvent, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `fileinstance.Intercept(f(g(h())))`.
-func (c *FileInstanceClient) Intercept(interceptors ...Interceptor) {
-	c.inters.FileInstance = append(c.inters.FileInstance, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `contentevent.Intercept(f(g(h())))`.
+func (c *ContentEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ContentEvent = append(c.inters.ContentEvent, interceptors...)
 }
 
-// Create returns a builder for creating a FileInstance entity.
-func (c *FileInstanceClient) Create() *FileInstanceCreate {
-	mutation := newFileInstanceMutation(c.config, OpCreate)
-	return &FileInstanceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ContentEvent entity.
+func (c *ContentEventClient) Create() *ContentEventCreate {
+	mutation := newContentEventMutation(c.config, OpCreate)
+	return &ContentEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of FileInstance entities.
-func (c *FileInstanceClient) CreateBulk(builders ...*FileInstanceCreate) *FileInstanceCreateBulk {
-	return &FileInstanceCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ContentEvent entities.
+func (c *ContentEventClient) CreateBulk(builders ...*ContentEventCreate) *ContentEventCreateBulk {
+	return &ContentEventCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *FileInstanceClient) MapCreateBulk(slice any, setFunc func(*FileInstanceCreate, int)) *FileInstanceCreateBulk {
+func (c *ContentEventClient) MapCreateBulk(slice any, setFunc func(*ContentEventCreate, int)) *ContentEventCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &FileInstanceCreateBulk{err: fmt.Errorf("calling to FileInstanceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ContentEventCreateBulk{err: fmt.Errorf("calling to ContentEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*FileInstanceCreate, rv.Len())
+	builders := make([]*ContentEventCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &FileInstanceCreateBulk{config: c.config, builders: builders}
+	return &ContentEventCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for FileInstance.
-func (c *FileInstanceClient) Update() *FileInstanceUpdate {
-	mutation := newFileInstanceMutation(c.config, OpUpdate)
-	return &FileInstanceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ContentEvent.
+func (c *ContentEventClient) Update() *ContentEventUpdate {
+	mutation := newContentEventMutation(c.config, OpUpdate)
+	return &ContentEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *FileInstanceClient) UpdateOne(_m *FileInstance) *FileInstanceUpdateOne {
-	mutation := newFileInstanceMutation(c.config, OpUpdateOne, withFileInstance(_m))
-	return &FileInstanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ContentEventClient) UpdateOne(_m *ContentEvent) *ContentEventUpdateOne {
+	mutation := newContentEventMutation(c.config, OpUpdateOne, withContentEvent(_m))
+	return &ContentEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *FileInstanceClient) UpdateOneID(id uuid.UUID) *FileInstanceUpdateOne {
-	mutation := newFileInstanceMutation(c.config, OpUpdateOne, withFileInstanceID(id))
-	return &FileInstanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ContentEventClient) UpdateOneID(id uuid.UUID) *ContentEventUpdateOne {
+	mutation := newContentEventMutation(c.config, OpUpdateOne, withContentEventID(id))
+	return &ContentEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for FileInstance.
-func (c *FileInstanceClient) Delete() *FileInstanceDelete {
-	mutation := newFileInstanceMutation(c.config, OpDelete)
-	return &FileInstanceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ContentEvent.
+func (c *ContentEventClient) Delete() *ContentEventDelete {
+	mutation := newContentEventMutation(c.config, OpDelete)
+	return &ContentEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *FileInstanceClient) DeleteOne(_m *FileInstance) *FileInstanceDeleteOne {
+func (c *ContentEventClient) DeleteOne(_m *ContentEvent) *ContentEventDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *FileInstanceClient) DeleteOneID(id uuid.UUID) *FileInstanceDeleteOne {
-	builder := c.Delete().Where(fileinstance.ID(id))
+func (c *ContentEventClient) DeleteOneID(id uuid.UUID) *ContentEventDeleteOne {
+	builder := c.Delete().Where(contentevent.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &FileInstanceDeleteOne{builder}
+	return &ContentEventDeleteOne{builder}
 }
 
-// Query returns a query builder for FileInstance.
-func (c *FileInstanceClient) Query() *FileInstanceQuery {
-	return &FileInstanceQuery{
+// Query returns a query builder for ContentEvent.
+func (c *ContentEventClient) Query() *ContentEventQuery {
+	return &ContentEventQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeFileInstance},
+		ctx:    &QueryContext{Type: TypeContentEvent},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a FileInstance entity by its id.
-func (c *FileInstanceClient) Get(ctx context.Context, id uuid.UUID) (*FileInstance, error) {
-	return c.Query().Where(fileinstance.ID(id)).Only(ctx)
+// Get returns a ContentEvent entity by its id.
+func (c *ContentEventClient) Get(ctx context.Context, id uuid.UUID) (*ContentEvent, error) {
+	return c.Query().Where(contentevent.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *FileInstanceClient) GetX(ctx context.Context, id uuid.UUID) *FileInstance {
+func (c *ContentEventClient) GetX(ctx context.Context, id uuid.UUID) *ContentEvent {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -732,15 +774,15 @@ func (c *FileInstanceClient) GetX(ctx context.Context, id uuid.UUID) *FileInstan
 	return obj
 }
 
-// QueryDuplicateGroup queries the duplicate_group edge of a FileInstance.
-func (c *FileInstanceClient) QueryDuplicateGroup(_m *FileInstance) *DuplicateGroupQuery {
-	query := (&DuplicateGroupClient{config: c.config}).Query()
+// QueryTenant queries the tenant edge of a ContentEvent.
+func (c *ContentEventClient) QueryTenant(_m *ContentEvent) *TenantQuery {
+	query := (&TenantClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(fileinstance.Table, fileinstance.FieldID, id),
-			sqlgraph.To(duplicategroup.Table, duplicategroup.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, fileinstance.DuplicateGroupTable, fileinstance.DuplicateGroupColumn),
+			sqlgraph.From(contentevent.Table, contentevent.FieldID, id),
+			sqlgraph.To(tenant.Table, tenant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, contentevent.TenantTable, contentevent.TenantColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -748,15 +790,47 @@ func (c *FileInstanceClient) QueryDuplicateGroup(_m *FileInstance) *DuplicateGro
 	return query
 }
 
-// QueryMachine queries the machine edge of a FileInstance.
-func (c *FileInstanceClient) QueryMachine(_m *FileInstance) *MachineQuery {
+// QueryContentIdentity queries the content_identity edge of a ContentEvent.
+func (c *ContentEventClient) QueryContentIdentity(_m *ContentEvent) *ContentIdentityQuery {
+	query := (&ContentIdentityClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(contentevent.Table, contentevent.FieldID, id),
+			sqlgraph.To(contentidentity.Table, contentidentity.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, contentevent.ContentIdentityTable, contentevent.ContentIdentityColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryScan queries the scan edge of a ContentEvent.
+func (c *ContentEventClient) QueryScan(_m *ContentEvent) *ScanQuery {
+	query := (&ScanClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(contentevent.Table, contentevent.FieldID, id),
+			sqlgraph.To(scan.Table, scan.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, contentevent.ScanTable, contentevent.ScanColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMachine queries the machine edge of a ContentEvent.
+func (c *ContentEventClient) QueryMachine(_m *ContentEvent) *MachineQuery {
 	query := (&MachineClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(fileinstance.Table, fileinstance.FieldID, id),
+			sqlgraph.From(contentevent.Table, contentevent.FieldID, id),
 			sqlgraph.To(machine.Table, machine.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, fileinstance.MachineTable, fileinstance.MachineColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, contentevent.MachineTable, contentevent.MachineColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -765,131 +839,133 @@ func (c *FileInstanceClient) QueryMachine(_m *FileInstance) *MachineQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *FileInstanceClient) Hooks() []Hook {
-	return c.hooks.FileInstance
+func (c *ContentEventClient) Hooks() []Hook {
+	hooks := c.hooks.ContentEvent
+	return append(hooks[:len(hooks):len(hooks)], contentevent.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *FileInstanceClient) Interceptors() []Interceptor {
-	return c.inters.FileInstance
+func (c *ContentEventClient) Interceptors() []Interceptor {
+	inters := c.inters.ContentEvent
+	return append(inters[:len(inters):len(inters)], contentevent.Interceptors[:]...)
 }
 
-func (c *FileInstanceClient) mutate(ctx context.Context, m *FileInstanceMutation) (Value, error) {
+func (c *ContentEventClient) mutate(ctx context.Context, m *ContentEventMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&FileInstanceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ContentEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&FileInstanceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ContentEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&FileInstanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ContentEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&FileInstanceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ContentEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown FileInstance mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ContentEvent mutation op: %q", m.Op())
 	}
 }
 
-// MachineClient is a client for the Machine schema.
-type MachineClient struct {
+// ContentIdentityClient is a client for the ContentIdentity schema.
+type ContentIdentityClient struct {
 	config
 }
 
-// NewMachineClient returns a client for the Machine from the given config.
-func NewMachineClient(c config) *MachineClient {
-	return &MachineClient{config: c}
+// NewContentIdentityClient returns a client for the ContentIdentity from the given config.
+func NewContentIdentityClient(c config) *ContentIdentityClient {
+	return &ContentIdentityClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `machine.Hooks(f(g(h())))`.
-func (c *MachineClient) Use(hooks ...Hook) {
-	c.hooks.Machine = append(c.hooks.Machine, hooks...)
+// A call to `Use(f, g, h)` equals to `contentidentity.Hooks(f(g(h())))`.
+func (c *ContentIdentityClient) Use(hooks ...Hook) {
+	c.hooks.ContentIdentity = append(c.hooks.ContentIdentity, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `machine.Intercept(f(g(h())))`.
-func (c *MachineClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Machine = append(c.inters.Machine, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `contentidentity.Intercept(f(g(h())))`.
+func (c *ContentIdentityClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ContentIdentity = append(c.inters.ContentIdentity, interceptors...)
 }
 
-// Create returns a builder for creating a Machine entity.
-func (c *MachineClient) Create() *MachineCreate {
-	mutation := newMachineMutation(c.config, OpCreate)
-	return &MachineCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ContentIdentity entity.
+func (c *ContentIdentityClient) Create() *ContentIdentityCreate {
+	mutation := newContentIdentityMutation(c.config, OpCreate)
+	return &ContentIdentityCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Machine entities.
-func (c *MachineClient) CreateBulk(builders ...*MachineCreate) *MachineCreateBulk {
-	return &MachineCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ContentIdentity entities.
+func (c *ContentIdentityClient) CreateBulk(builders ...*ContentIdentityCreate) *ContentIdentityCreateBulk {
+	return &ContentIdentityCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *MachineClient) MapCreateBulk(slice any, setFunc func(*MachineCreate, int)) *MachineCreateBulk {
+func (c *ContentIdentityClient) MapCreateBulk(slice any, setFunc func(*ContentIdentityCreate, int)) *ContentIdentityCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &MachineCreateBulk{err: fmt.Errorf("calling to MachineClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ContentIdentityCreateBulk{err: fmt.Errorf("calling to ContentIdentityClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*MachineCreate, rv.Len())
+	builders := make([]*ContentIdentityCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &MachineCreateBulk{config: c.config, builders: builders}
+	return &ContentIdentityCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Machine.
-func (c *MachineClient) Update() *MachineUpdate {
-	mutation := newMachineMutation(c.config, OpUpdate)
-	return &MachineUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ContentIdentity.
+func (c *ContentIdentityClient) Update() *ContentIdentityUpdate {
+	mutation := newContentIdentityMutation(c.config, OpUpdate)
+	return &ContentIdentityUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *MachineClient) UpdateOne(_m *Machine) *MachineUpdateOne {
-	mutation := newMachineMutation(c.config, OpUpdateOne, withMachine(_m))
-	return &MachineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ContentIdentityClient) UpdateOne(_m *ContentIdentity) *ContentIdentityUpdateOne {
+	mutation := newContentIdentityMutation(c.config, OpUpdateOne, withContentIdentity(_m))
+	return &ContentIdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *MachineClient) UpdateOneID(id uuid.UUID) *MachineUpdateOne {
-	mutation := newMachineMutation(c.config, OpUpdateOne, withMachineID(id))
-	return &MachineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ContentIdentityClient) UpdateOneID(id uuid.UUID) *ContentIdentityUpdateOne {
+	mutation := newContentIdentityMutation(c.config, OpUpdateOne, withContentIdentityID(id))
+	return &ContentIdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Machine.
-func (c *MachineClient) Delete() *MachineDelete {
-	mutation := newMachineMutation(c.config, OpDelete)
-	return &MachineDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ContentIdentity.
+func (c *ContentIdentityClient) Delete() *ContentIdentityDelete {
+	mutation := newContentIdentityMutation(c.config, OpDelete)
+	return &ContentIdentityDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *MachineClient) DeleteOne(_m *Machine) *MachineDeleteOne {
+func (c *ContentIdentityClient) DeleteOne(_m *ContentIdentity) *ContentIdentityDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *MachineClient) DeleteOneID(id uuid.UUID) *MachineDeleteOne {
-	builder := c.Delete().Where(machine.ID(id))
+func (c *ContentIdentityClient) DeleteOneID(id uuid.UUID) *ContentIdentityDeleteOne {
+	builder := c.Delete().Where(contentidentity.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &MachineDeleteOne{builder}
+	return &ContentIdentityDeleteOne{builder}
 }
 
-// Query returns a query builder for Machine.
-func (c *MachineClient) Query() *MachineQuery {
-	return &MachineQuery{
+// Query returns a query builder for ContentIdentity.
+func (c *ContentIdentityClient) Query() *ContentIdentityQuery {
+	return &ContentIdentityQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeMachine},
+		ctx:    &QueryContext{Type: TypeContentIdentity},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Machine entity by its id.
-func (c *MachineClient) Get(ctx context.Context, id uuid.UUID) (*Machine, error) {
-	return c.Query().Where(machine.ID(id)).Only(ctx)
+// Get returns a ContentIdentity entity by its id.
+func (c *ContentIdentityClient) Get(ctx context.Context, id uuid.UUID) (*ContentIdentity, error) {
+	return c.Query().Where(contentidentity.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *MachineClient) GetX(ctx context.Context, id uuid.UUID) *Machine {
+func (c *ContentIdentityClient) GetX(ctx context.Context, id uuid.UUID) *ContentIdentity {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -897,15 +973,15 @@ func (c *MachineClient) GetX(ctx context.Context, id uuid.UUID) *Machine {
 	return obj
 }
 
-// QueryTenant queries the tenant edge of a Machine.
-func (c *MachineClient) QueryTenant(_m *Machine) *TenantQuery {
+// QueryTenant queries the tenant edge of a ContentIdentity.
+func (c *ContentIdentityClient) QueryTenant(_m *ContentIdentity) *TenantQuery {
 	query := (&TenantClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(machine.Table, machine.FieldID, id),
+			sqlgraph.From(contentidentity.Table, contentidentity.FieldID, id),
 			sqlgraph.To(tenant.Table, tenant.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, machine.TenantTable, machine.TenantColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, contentidentity.TenantTable, contentidentity.TenantColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -913,15 +989,15 @@ func (c *MachineClient) QueryTenant(_m *Machine) *TenantQuery {
 	return query
 }
 
-// QueryKeeperGroups queries the keeper_groups edge of a Machine.
-func (c *MachineClient) QueryKeeperGroups(_m *Machine) *DuplicateGroupQuery {
-	query := (&DuplicateGroupClient{config: c.config}).Query()
+// QueryKeeperMachine queries the keeper_machine edge of a ContentIdentity.
+func (c *ContentIdentityClient) QueryKeeperMachine(_m *ContentIdentity) *MachineQuery {
+	query := (&MachineClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(machine.Table, machine.FieldID, id),
-			sqlgraph.To(duplicategroup.Table, duplicategroup.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, machine.KeeperGroupsTable, machine.KeeperGroupsColumn),
+			sqlgraph.From(contentidentity.Table, contentidentity.FieldID, id),
+			sqlgraph.To(machine.Table, machine.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, contentidentity.KeeperMachineTable, contentidentity.KeeperMachineColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -929,15 +1005,1751 @@ func (c *MachineClient) QueryKeeperGroups(_m *Machine) *DuplicateGroupQuery {
 	return query
 }
 
-// QueryFileInstances queries the file_instances edge of a Machine.
+// QueryDuplicateGroups queries the duplicate_groups edge of a ContentIdentity.
+func (c *ContentIdentityClient) QueryDuplicateGroups(_m *ContentIdentity) *DuplicateGroupQuery {
+	query := (&DuplicateGroupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(contentidentity.Table, contentidentity.FieldID, id),
+			sqlgraph.To(duplicategroup.Table, duplicategroup.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, contentidentity.DuplicateGroupsTable, contentidentity.DuplicateGroupsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEvents queries the events edge of a ContentIdentity.
+func (c *ContentIdentityClient) QueryEvents(_m *ContentIdentity) *ContentEventQuery {
+	query := (&ContentEventClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(contentidentity.Table, contentidentity.FieldID, id),
+			sqlgraph.To(contentevent.Table, contentevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, contentidentity.EventsTable, contentidentity.EventsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ContentIdentityClient) Hooks() []Hook {
+	hooks := c.hooks.ContentIdentity
+	return append(hooks[:len(hooks):len(hooks)], contentidentity.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ContentIdentityClient) Interceptors() []Interceptor {
+	inters := c.inters.ContentIdentity
+	return append(inters[:len(inters):len(inters)], contentidentity.Interceptors[:]...)
+}
+
+func (c *ContentIdentityClient) mutate(ctx context.Context, m *ContentIdentityMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ContentIdentityCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ContentIdentityUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ContentIdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ContentIdentityDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ContentIdentity mutation op: %q", m.Op())
+	}
+}
+
+// DuplicateGroupClient is a client for the DuplicateGroup schema.
+type DuplicateGroupClient struct {
+	config
+}
+
+// NewDuplicateGroupClient returns a client for the DuplicateGroup from the given config.
+func NewDuplicateGroupClient(c config) *DuplicateGroupClient {
+	return &DuplicateGroupClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `duplicategroup.Hooks(f(g(h())))`.
+func (c *DuplicateGroupClient) Use(hooks ...Hook) {
+	c.hooks.DuplicateGroup = append(c.hooks.DuplicateGroup, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `duplicategroup.Intercept(f(g(h())))`.
+func (c *DuplicateGroupClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DuplicateGroup = append(c.inters.DuplicateGroup, interceptors...)
+}
+
+// Create returns a builder for creating a DuplicateGroup entity.
+func (c *DuplicateGroupClient) Create() *DuplicateGroupCreate {
+	mutation := newDuplicateGroupMutation(c.config, OpCreate)
+	return &DuplicateGroupCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DuplicateGroup entities.
+func (c *DuplicateGroupClient) CreateBulk(builders ...*DuplicateGroupCreate) *DuplicateGroupCreateBulk {
+	return &DuplicateGroupCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DuplicateGroupClient) MapCreateBulk(slice any, setFunc func(*DuplicateGroupCreate, int)) *DuplicateGroupCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DuplicateGroupCreateBulk{err: fmt.Errorf("calling to DuplicateGroupClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DuplicateGroupCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DuplicateGroupCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DuplicateGroup.
+func (c *DuplicateGroupClient) Update() *DuplicateGroupUpdate {
+	mutation := newDuplicateGroupMutation(c.config, OpUpdate)
+	return &DuplicateGroupUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DuplicateGroupClient) UpdateOne(_m *DuplicateGroup) *DuplicateGroupUpdateOne {
+	mutation := newDuplicateGroupMutation(c.config, OpUpdateOne, withDuplicateGroup(_m))
+	return &DuplicateGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DuplicateGroupClient) UpdateOneID(id uuid.UUID) *DuplicateGroupUpdateOne {
+	mutation := newDuplicateGroupMutation(c.config, OpUpdateOne, withDuplicateGroupID(id))
+	return &DuplicateGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DuplicateGroup.
+func (c *DuplicateGroupClient) Delete() *DuplicateGroupDelete {
+	mutation := newDuplicateGroupMutation(c.config, OpDelete)
+	return &DuplicateGroupDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DuplicateGroupClient) DeleteOne(_m *DuplicateGroup) *DuplicateGroupDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DuplicateGroupClient) DeleteOneID(id uuid.UUID) *DuplicateGroupDeleteOne {
+	builder := c.Delete().Where(duplicategroup.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DuplicateGroupDeleteOne{builder}
+}
+
+// Query returns a query builder for DuplicateGroup.
+func (c *DuplicateGroupClient) Query() *DuplicateGroupQuery {
+	return &DuplicateGroupQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDuplicateGroup},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DuplicateGroup entity by its id.
+func (c *DuplicateGroupClient) Get(ctx context.Context, id uuid.UUID) (*DuplicateGroup, error) {
+	return c.Query().Where(duplicategroup.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DuplicateGroupClient) GetX(ctx context.Context, id uuid.UUID) *DuplicateGroup {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTenant queries the tenant edge of a DuplicateGroup.
+func (c *DuplicateGroupClient) QueryTenant(_m *DuplicateGroup) *TenantQuery {
+	query := (&TenantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(duplicategroup.Table, duplicategroup.FieldID, id),
+			sqlgraph.To(tenant.Table, tenant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, duplicategroup.TenantTable, duplicategroup.TenantColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryScan queries the scan edge of a DuplicateGroup.
+func (c *DuplicateGroupClient) QueryScan(_m *DuplicateGroup) *ScanQuery {
+	query := (&ScanClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(duplicategroup.Table, duplicategroup.FieldID, id),
+			sqlgraph.To(scan.Table, scan.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, duplicategroup.ScanTable, duplicategroup.ScanColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryKeeperMachine queries the keeper_machine edge of a DuplicateGroup.
+func (c *DuplicateGroupClient) QueryKeeperMachine(_m *DuplicateGroup) *MachineQuery {
+	query := (&MachineClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(duplicategroup.Table, duplicategroup.FieldID, id),
+			sqlgraph.To(machine.Table, machine.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, duplicategroup.KeeperMachineTable, duplicategroup.KeeperMachineColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryContentIdentity queries the content_identity edge of a DuplicateGroup.
+func (c *DuplicateGroupClient) QueryContentIdentity(_m *DuplicateGroup) *ContentIdentityQuery {
+	query := (&ContentIdentityClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(duplicategroup.Table, duplicategroup.FieldID, id),
+			sqlgraph.To(contentidentity.Table, contentidentity.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, duplicategroup.ContentIdentityTable, duplicategroup.ContentIdentityColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFileInstances queries the file_instances edge of a DuplicateGroup.
+func (c *DuplicateGroupClient) QueryFileInstances(_m *DuplicateGroup) *FileInstanceQuery {
+	query := (&FileInstanceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(duplicategroup.Table, duplicategroup.FieldID, id),
+			sqlgraph.To(fileinstance.Table, fileinstance.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, duplicategroup.FileInstancesTable, duplicategroup.FileInstancesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryActionAudits queries the action_audits edge of a DuplicateGroup.
+func (c *DuplicateGroupClient) QueryActionAudits(_m *DuplicateGroup) *ActionAuditQuery {
+	query := (&ActionAuditClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(duplicategroup.Table, duplicategroup.FieldID, id),
+			sqlgraph.To(actionaudit.Table, actionaudit.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, duplicategroup.ActionAuditsTable, duplicategroup.ActionAuditsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryActionJobs queries the action_jobs edge of a DuplicateGroup.
+func (c *DuplicateGroupClient) QueryActionJobs(_m *DuplicateGroup) *ActionJobQuery {
+	query := (&ActionJobClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(duplicategroup.Table, duplicategroup.FieldID, id),
+			sqlgraph.To(actionjob.Table, actionjob.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, duplicategroup.ActionJobsTable, duplicategroup.ActionJobsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DuplicateGroupClient) Hooks() []Hook {
+	hooks := c.hooks.DuplicateGroup
+	return append(hooks[:len(hooks):len(hooks)], duplicategroup.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *DuplicateGroupClient) Interceptors() []Interceptor {
+	inters := c.inters.DuplicateGroup
+	return append(inters[:len(inters):len(inters)], duplicategroup.Interceptors[:]...)
+}
+
+func (c *DuplicateGroupClient) mutate(ctx context.Context, m *DuplicateGroupMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DuplicateGroupCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DuplicateGroupUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DuplicateGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DuplicateGroupDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DuplicateGroup mutation op: %q", m.Op())
+	}
+}
+
+// FileInstanceClient is a client for the FileInstance schema.
+type FileInstanceClient struct {
+	config
+}
+
+// NewFileInstanceClient returns a client for the FileInstance from the given config.
+func NewFileInstanceClient(c config) *FileInstanceClient {
+	return &FileInstanceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `fileinstance.Hooks(f(g(h())))`.
+func (c *FileInstanceClient) Use(hooks ...Hook) {
+	c.hooks.FileInstance = append(c.hooks.FileInstance, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `fileinstance.Intercept(f(g(h())))`.
+func (c *FileInstanceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FileInstance = append(c.inters.FileInstance, interceptors...)
+}
+
+// Create returns a builder for creating a FileInstance entity.
+func (c *FileInstanceClient) Create() *FileInstanceCreate {
+	mutation := newFileInstanceMutation(c.config, OpCreate)
+	return &FileInstanceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of FileInstance entities.
+func (c *FileInstanceClient) CreateBulk(builders ...*FileInstanceCreate) *FileInstanceCreateBulk {
+	return &FileInstanceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FileInstanceClient) MapCreateBulk(slice any, setFunc func(*FileInstanceCreate, int)) *FileInstanceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FileInstanceCreateBulk{err: fmt.Errorf("calling to FileInstanceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FileInstanceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FileInstanceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for FileInstance.
+func (c *FileInstanceClient) Update() *FileInstanceUpdate {
+	mutation := newFileInstanceMutation(c.config, OpUpdate)
+	return &FileInstanceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FileInstanceClient) UpdateOne(_m *FileInstance) *FileInstanceUpdateOne {
+	mutation := newFileInstanceMutation(c.config, OpUpdateOne, withFileInstance(_m))
+	return &FileInstanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FileInstanceClient) UpdateOneID(id uuid.UUID) *FileInstanceUpdateOne {
+	mutation := newFileInstanceMutation(c.config, OpUpdateOne, withFileInstanceID(id))
+	return &FileInstanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for FileInstance.
+func (c *FileInstanceClient) Delete() *FileInstanceDelete {
+	mutation := newFileInstanceMutation(c.config, OpDelete)
+	return &FileInstanceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FileInstanceClient) DeleteOne(_m *FileInstance) *FileInstanceDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FileInstanceClient) DeleteOneID(id uuid.UUID) *FileInstanceDeleteOne {
+	builder := c.Delete().Where(fileinstance.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FileInstanceDeleteOne{builder}
+}
+
+// Query returns a query builder for FileInstance.
+func (c *FileInstanceClient) Query() *FileInstanceQuery {
+	return &FileInstanceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFileInstance},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a FileInstance entity by its id.
+func (c *FileInstanceClient) Get(ctx context.Context, id uuid.UUID) (*FileInstance, error) {
+	return c.Query().Where(fileinstance.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FileInstanceClient) GetX(ctx context.Context, id uuid.UUID) *FileInstance {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTenant queries the tenant edge of a FileInstance.
+func (c *FileInstanceClient) QueryTenant(_m *FileInstance) *TenantQuery {
+	query := (&TenantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(fileinstance.Table, fileinstance.FieldID, id),
+			sqlgraph.To(tenant.Table, tenant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, fileinstance.TenantTable, fileinstance.TenantColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDuplicateGroup queries the duplicate_group edge of a FileInstance.
+func (c *FileInstanceClient) QueryDuplicateGroup(_m *FileInstance) *DuplicateGroupQuery {
+	query := (&DuplicateGroupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(fileinstance.Table, fileinstance.FieldID, id),
+			sqlgraph.To(duplicategroup.Table, duplicategroup.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, fileinstance.DuplicateGroupTable, fileinstance.DuplicateGroupColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMachine queries the machine edge of a FileInstance.
+func (c *FileInstanceClient) QueryMachine(_m *FileInstance) *MachineQuery {
+	query := (&MachineClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(fileinstance.Table, fileinstance.FieldID, id),
+			sqlgraph.To(machine.Table, machine.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, fileinstance.MachineTable, fileinstance.MachineColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *FileInstanceClient) Hooks() []Hook {
+	hooks := c.hooks.FileInstance
+	return append(hooks[:len(hooks):len(hooks)], fileinstance.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *FileInstanceClient) Interceptors() []Interceptor {
+	inters := c.inters.FileInstance
+	return append(inters[:len(inters):len(inters)], fileinstance.Interceptors[:]...)
+}
+
+func (c *FileInstanceClient) mutate(ctx context.Context, m *FileInstanceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FileInstanceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FileInstanceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FileInstanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FileInstanceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown FileInstance mutation op: %q", m.Op())
+	}
+}
+
+// MachineClient is a client for the Machine schema.
+type MachineClient struct {
+	config
+}
+
+// NewMachineClient returns a client for the Machine from the given config.
+func NewMachineClient(c config) *MachineClient {
+	return &MachineClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `machine.Hooks(f(g(h())))`.
+func (c *MachineClient) Use(hooks ...Hook) {
+	c.hooks.Machine = append(c.hooks.Machine, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `machine.Intercept(f(g(h())))`.
+func (c *MachineClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Machine = append(c.inters.Machine, interceptors...)
+}
+
+// Create returns a builder for creating a Machine entity.
+func (c *MachineClient) Create() *MachineCreate {
+	mutation := newMachineMutation(c.config, OpCreate)
+	return &MachineCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Machine entities.
+func (c *MachineClient) CreateBulk(builders ...*MachineCreate) *MachineCreateBulk {
+	return &MachineCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MachineClient) MapCreateBulk(slice any, setFunc func(*MachineCreate, int)) *MachineCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MachineCreateBulk{err: fmt.Errorf("calling to MachineClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MachineCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MachineCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Machine.
+func (c *MachineClient) Update() *MachineUpdate {
+	mutation := newMachineMutation(c.config, OpUpdate)
+	return &MachineUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MachineClient) UpdateOne(_m *Machine) *MachineUpdateOne {
+	mutation := newMachineMutation(c.config, OpUpdateOne, withMachine(_m))
+	return &MachineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MachineClient) UpdateOneID(id uuid.UUID) *MachineUpdateOne {
+	mutation := newMachineMutation(c.config, OpUpdateOne, withMachineID(id))
+	return &MachineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Machine.
+func (c *MachineClient) Delete() *MachineDelete {
+	mutation := newMachineMutation(c.config, OpDelete)
+	return &MachineDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MachineClient) DeleteOne(_m *Machine) *MachineDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MachineClient) DeleteOneID(id uuid.UUID) *MachineDeleteOne {
+	builder := c.Delete().Where(machine.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MachineDeleteOne{builder}
+}
+
+// Query returns a query builder for Machine.
+func (c *MachineClient) Query() *MachineQuery {
+	return &MachineQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMachine},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Machine entity by its id.
+func (c *MachineClient) Get(ctx context.Context, id uuid.UUID) (*Machine, error) {
+	return c.Query().Where(machine.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MachineClient) GetX(ctx context.Context, id uuid.UUID) *Machine {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTenant queries the tenant edge of a Machine.
+func (c *MachineClient) QueryTenant(_m *Machine) *TenantQuery {
+	query := (&TenantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(machine.Table, machine.FieldID, id),
+			sqlgraph.To(tenant.Table, tenant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, machine.TenantTable, machine.TenantColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryKeeperGroups queries the keeper_groups edge of a Machine.
+func (c *MachineClient) QueryKeeperGroups(_m *Machine) *DuplicateGroupQuery {
+	query := (&DuplicateGroupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(machine.Table, machine.FieldID, id),
+			sqlgraph.To(duplicategroup.Table, duplicategroup.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, machine.KeeperGroupsTable, machine.KeeperGroupsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFileInstances queries the file_instances edge of a Machine.
 func (c *MachineClient) QueryFileInstances(_m *Machine) *FileInstanceQuery {
 	query := (&FileInstanceClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(machine.Table, machine.FieldID, id),
-			sqlgraph.To(fileinstance.Table, fileinstance.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, machine.FileInstancesTable, machine.FileInstancesColumn),
+			sqlgraph.From(machine.Table, machine.FieldID, id),
+			sqlgraph.To(fileinstance.Table, fileinstance.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, machine.FileInstancesTable, machine.FileInstancesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryInitiatedScans queries the initiated_scans edge of a Machine.
+func (c *MachineClient) QueryInitiatedScans(_m *Machine) *ScanQuery {
+	query := (&ScanClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(machine.Table, machine.FieldID, id),
+			sqlgraph.To(scan.Table, scan.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, machine.InitiatedScansTable, machine.InitiatedScansColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryKeptIdentities queries the kept_identities edge of a Machine.
+func (c *MachineClient) QueryKeptIdentities(_m *Machine) *ContentIdentityQuery {
+	query := (&ContentIdentityClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(machine.Table, machine.FieldID, id),
+			sqlgraph.To(contentidentity.Table, contentidentity.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, machine.KeptIdentitiesTable, machine.KeptIdentitiesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryContentEvents queries the content_events edge of a Machine.
+func (c *MachineClient) QueryContentEvents(_m *Machine) *ContentEventQuery {
+	query := (&ContentEventClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(machine.Table, machine.FieldID, id),
+			sqlgraph.To(contentevent.Table, contentevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, machine.ContentEventsTable, machine.ContentEventsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryScanTargets queries the scan_targets edge of a Machine.
+func (c *MachineClient) QueryScanTargets(_m *Machine) *ScanTargetQuery {
+	query := (&ScanTargetClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(machine.Table, machine.FieldID, id),
+			sqlgraph.To(scantarget.Table, scantarget.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, machine.ScanTargetsTable, machine.ScanTargetsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySecrets queries the secrets edge of a Machine.
+func (c *MachineClient) QuerySecrets(_m *Machine) *TenantSecretQuery {
+	query := (&TenantSecretClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(machine.Table, machine.FieldID, id),
+			sqlgraph.To(tenantsecret.Table, tenantsecret.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, machine.SecretsTable, machine.SecretsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryActionJobs queries the action_jobs edge of a Machine.
+func (c *MachineClient) QueryActionJobs(_m *Machine) *ActionJobQuery {
+	query := (&ActionJobClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(machine.Table, machine.FieldID, id),
+			sqlgraph.To(actionjob.Table, actionjob.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, machine.ActionJobsTable, machine.ActionJobsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MachineClient) Hooks() []Hook {
+	hooks := c.hooks.Machine
+	return append(hooks[:len(hooks):len(hooks)], machine.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *MachineClient) Interceptors() []Interceptor {
+	inters := c.inters.Machine
+	return append(inters[:len(inters):len(inters)], machine.Interceptors[:]...)
+}
+
+func (c *MachineClient) mutate(ctx context.Context, m *MachineMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MachineCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MachineUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MachineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MachineDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Machine mutation op: %q", m.Op())
+	}
+}
+
+// ScanClient is a client for the Scan schema.
+type ScanClient struct {
+	config
+}
+
+// NewScanClient returns a client for the Scan from the given config.
+func NewScanClient(c config) *ScanClient {
+	return &ScanClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `scan.Hooks(f(g(h())))`.
+func (c *ScanClient) Use(hooks ...Hook) {
+	c.hooks.Scan = append(c.hooks.Scan, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `scan.Intercept(f(g(h())))`.
+func (c *ScanClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Scan = append(c.inters.Scan, interceptors...)
+}
+
+// Create returns a builder for creating a Scan entity.
+func (c *ScanClient) Create() *ScanCreate {
+	mutation := newScanMutation(c.config, OpCreate)
+	return &ScanCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Scan entities.
+func (c *ScanClient) CreateBulk(builders ...*ScanCreate) *ScanCreateBulk {
+	return &ScanCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ScanClient) MapCreateBulk(slice any, setFunc func(*ScanCreate, int)) *ScanCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ScanCreateBulk{err: fmt.Errorf("calling to ScanClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ScanCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ScanCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Scan.
+func (c *ScanClient) Update() *ScanUpdate {
+	mutation := newScanMutation(c.config, OpUpdate)
+	return &ScanUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ScanClient) UpdateOne(_m *Scan) *ScanUpdateOne {
+	mutation := newScanMutation(c.config, OpUpdateOne, withScan(_m))
+	return &ScanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ScanClient) UpdateOneID(id uuid.UUID) *ScanUpdateOne {
+	mutation := newScanMutation(c.config, OpUpdateOne, withScanID(id))
+	return &ScanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Scan.
+func (c *ScanClient) Delete() *ScanDelete {
+	mutation := newScanMutation(c.config, OpDelete)
+	return &ScanDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ScanClient) DeleteOne(_m *Scan) *ScanDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ScanClient) DeleteOneID(id uuid.UUID) *ScanDeleteOne {
+	builder := c.Delete().Where(scan.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ScanDeleteOne{builder}
+}
+
+// Query returns a query builder for Scan.
+func (c *ScanClient) Query() *ScanQuery {
+	return &ScanQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeScan},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Scan entity by its id.
+func (c *ScanClient) Get(ctx context.Context, id uuid.UUID) (*Scan, error) {
+	return c.Query().Where(scan.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ScanClient) GetX(ctx context.Context, id uuid.UUID) *Scan {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTenant queries the tenant edge of a Scan.
+func (c *ScanClient) QueryTenant(_m *Scan) *TenantQuery {
+	query := (&TenantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(scan.Table, scan.FieldID, id),
+			sqlgraph.To(tenant.Table, tenant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, scan.TenantTable, scan.TenantColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryInitiatedMachine queries the initiated_machine edge of a Scan.
+func (c *ScanClient) QueryInitiatedMachine(_m *Scan) *MachineQuery {
+	query := (&MachineClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(scan.Table, scan.FieldID, id),
+			sqlgraph.To(machine.Table, machine.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, scan.InitiatedMachineTable, scan.InitiatedMachineColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDuplicateGroups queries the duplicate_groups edge of a Scan.
+func (c *ScanClient) QueryDuplicateGroups(_m *Scan) *DuplicateGroupQuery {
+	query := (&DuplicateGroupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(scan.Table, scan.FieldID, id),
+			sqlgraph.To(duplicategroup.Table, duplicategroup.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, scan.DuplicateGroupsTable, scan.DuplicateGroupsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryContentEvents queries the content_events edge of a Scan.
+func (c *ScanClient) QueryContentEvents(_m *Scan) *ContentEventQuery {
+	query := (&ContentEventClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(scan.Table, scan.FieldID, id),
+			sqlgraph.To(contentevent.Table, contentevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, scan.ContentEventsTable, scan.ContentEventsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTargets queries the targets edge of a Scan.
+func (c *ScanClient) QueryTargets(_m *Scan) *ScanTargetQuery {
+	query := (&ScanTargetClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(scan.Table, scan.FieldID, id),
+			sqlgraph.To(scantarget.Table, scantarget.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, scan.TargetsTable, scan.TargetsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ScanClient) Hooks() []Hook {
+	hooks := c.hooks.Scan
+	return append(hooks[:len(hooks):len(hooks)], scan.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ScanClient) Interceptors() []Interceptor {
+	inters := c.inters.Scan
+	return append(inters[:len(inters):len(inters)], scan.Interceptors[:]...)
+}
+
+func (c *ScanClient) mutate(ctx context.Context, m *ScanMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ScanCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ScanUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ScanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ScanDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Scan mutation op: %q", m.Op())
+	}
+}
+
+// ScanScheduleClient is a client for the ScanSchedule schema.
+type ScanScheduleClient struct {
+	config
+}
+
+// NewScanScheduleClient returns a client for the ScanSchedule from the given config.
+func NewScanScheduleClient(c config) *ScanScheduleClient {
+	return &ScanScheduleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `scanschedule.Hooks(f(g(h())))`.
+func (c *ScanScheduleClient) Use(hooks ...Hook) {
+	c.hooks.ScanSchedule = append(c.hooks.ScanSchedule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `scanschedule.Intercept(f(g(h())))`.
+func (c *ScanScheduleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ScanSchedule = append(c.inters.ScanSchedule, interceptors...)
+}
+
+// Create returns a builder for creating a ScanSchedule entity.
+func (c *ScanScheduleClient) Create() *ScanScheduleCreate {
+	mutation := newScanScheduleMutation(c.config, OpCreate)
+	return &ScanScheduleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ScanSchedule entities.
+func (c *ScanScheduleClient) CreateBulk(builders ...*ScanScheduleCreate) *ScanScheduleCreateBulk {
+	return &ScanScheduleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ScanScheduleClient) MapCreateBulk(slice any, setFunc func(*ScanScheduleCreate, int)) *ScanScheduleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ScanScheduleCreateBulk{err: fmt.Errorf("calling to ScanScheduleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ScanScheduleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ScanScheduleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ScanSchedule.
+func (c *ScanScheduleClient) Update() *ScanScheduleUpdate {
+	mutation := newScanScheduleMutation(c.config, OpUpdate)
+	return &ScanScheduleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ScanScheduleClient) UpdateOne(_m *ScanSchedule) *ScanScheduleUpdateOne {
+	mutation := newScanScheduleMutation(c.config, OpUpdateOne, withScanSchedule(_m))
+	return &ScanScheduleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ScanScheduleClient) UpdateOneID(id uuid.UUID) *ScanScheduleUpdateOne {
+	mutation := newScanScheduleMutation(c.config, OpUpdateOne, withScanScheduleID(id))
+	return &ScanScheduleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ScanSchedule.
+func (c *ScanScheduleClient) Delete() *ScanScheduleDelete {
+	mutation := newScanScheduleMutation(c.config, OpDelete)
+	return &ScanScheduleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ScanScheduleClient) DeleteOne(_m *ScanSchedule) *ScanScheduleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ScanScheduleClient) DeleteOneID(id uuid.UUID) *ScanScheduleDeleteOne {
+	builder := c.Delete().Where(scanschedule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ScanScheduleDeleteOne{builder}
+}
+
+// Query returns a query builder for ScanSchedule.
+func (c *ScanScheduleClient) Query() *ScanScheduleQuery {
+	return &ScanScheduleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeScanSchedule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ScanSchedule entity by its id.
+func (c *ScanScheduleClient) Get(ctx context.Context, id uuid.UUID) (*ScanSchedule, error) {
+	return c.Query().Where(scanschedule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ScanScheduleClient) GetX(ctx context.Context, id uuid.UUID) *ScanSchedule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTenant queries the tenant edge of a ScanSchedule.
+func (c *ScanScheduleClient) QueryTenant(_m *ScanSchedule) *TenantQuery {
+	query := (&TenantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(scanschedule.Table, scanschedule.FieldID, id),
+			sqlgraph.To(tenant.Table, tenant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, scanschedule.TenantTable, scanschedule.TenantColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRuns queries the runs edge of a ScanSchedule.
+func (c *ScanScheduleClient) QueryRuns(_m *ScanSchedule) *ScanScheduleRunQuery {
+	query := (&ScanScheduleRunClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(scanschedule.Table, scanschedule.FieldID, id),
+			sqlgraph.To(scanschedulerun.Table, scanschedulerun.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, scanschedule.RunsTable, scanschedule.RunsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ScanScheduleClient) Hooks() []Hook {
+	hooks := c.hooks.ScanSchedule
+	return append(hooks[:len(hooks):len(hooks)], scanschedule.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ScanScheduleClient) Interceptors() []Interceptor {
+	inters := c.inters.ScanSchedule
+	return append(inters[:len(inters):len(inters)], scanschedule.Interceptors[:]...)
+}
+
+func (c *ScanScheduleClient) mutate(ctx context.Context, m *ScanScheduleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ScanScheduleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ScanScheduleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ScanScheduleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ScanScheduleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ScanSchedule mutation op: %q", m.Op())
+	}
+}
+
+// ScanScheduleRunClient is a client for the ScanScheduleRun schema.
+type ScanScheduleRunClient struct {
+	config
+}
+
+// NewScanScheduleRunClient returns a client for the ScanScheduleRun from the given config.
+func NewScanScheduleRunClient(c config) *ScanScheduleRunClient {
+	return &ScanScheduleRunClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `scanschedulerun.Hooks(f(g(h())))`.
+func (c *ScanScheduleRunClient) Use(hooks ...Hook) {
+	c.hooks.ScanScheduleRun = append(c.hooks.ScanScheduleRun, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `scanschedulerun.Intercept(f(g(h())))`.
+func (c *ScanScheduleRunClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ScanScheduleRun = append(c.inters.ScanScheduleRun, interceptors...)
+}
+
+// Create returns a builder for creating a ScanScheduleRun entity.
+func (c *ScanScheduleRunClient) Create() *ScanScheduleRunCreate {
+	mutation := newScanScheduleRunMutation(c.config, OpCreate)
+	return &ScanScheduleRunCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ScanScheduleRun entities.
+func (c *ScanScheduleRunClient) CreateBulk(builders ...*ScanScheduleRunCreate) *ScanScheduleRunCreateBulk {
+	return &ScanScheduleRunCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ScanScheduleRunClient) MapCreateBulk(slice any, setFunc func(*ScanScheduleRunCreate, int)) *ScanScheduleRunCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ScanScheduleRunCreateBulk{err: fmt.Errorf("calling to ScanScheduleRunClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ScanScheduleRunCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ScanScheduleRunCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ScanScheduleRun.
+func (c *ScanScheduleRunClient) Update() *ScanScheduleRunUpdate {
+	mutation := newScanScheduleRunMutation(c.config, OpUpdate)
+	return &ScanScheduleRunUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ScanScheduleRunClient) UpdateOne(_m *ScanScheduleRun) *ScanScheduleRunUpdateOne {
+	mutation := newScanScheduleRunMutation(c.config, OpUpdateOne, withScanScheduleRun(_m))
+	return &ScanScheduleRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ScanScheduleRunClient) UpdateOneID(id uuid.UUID) *ScanScheduleRunUpdateOne {
+	mutation := newScanScheduleRunMutation(c.config, OpUpdateOne, withScanScheduleRunID(id))
+	return &ScanScheduleRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ScanScheduleRun.
+func (c *ScanScheduleRunClient) Delete() *ScanScheduleRunDelete {
+	mutation := newScanScheduleRunMutation(c.config, OpDelete)
+	return &ScanScheduleRunDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ScanScheduleRunClient) DeleteOne(_m *ScanScheduleRun) *ScanScheduleRunDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ScanScheduleRunClient) DeleteOneID(id uuid.UUID) *ScanScheduleRunDeleteOne {
+	builder := c.Delete().Where(scanschedulerun.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ScanScheduleRunDeleteOne{builder}
+}
+
+// Query returns a query builder for ScanScheduleRun.
+func (c *ScanScheduleRunClient) Query() *ScanScheduleRunQuery {
+	return &ScanScheduleRunQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeScanScheduleRun},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ScanScheduleRun entity by its id.
+func (c *ScanScheduleRunClient) Get(ctx context.Context, id uuid.UUID) (*ScanScheduleRun, error) {
+	return c.Query().Where(scanschedulerun.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ScanScheduleRunClient) GetX(ctx context.Context, id uuid.UUID) *ScanScheduleRun {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTenant queries the tenant edge of a ScanScheduleRun.
+func (c *ScanScheduleRunClient) QueryTenant(_m *ScanScheduleRun) *TenantQuery {
+	query := (&TenantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(scanschedulerun.Table, scanschedulerun.FieldID, id),
+			sqlgraph.To(tenant.Table, tenant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, scanschedulerun.TenantTable, scanschedulerun.TenantColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySchedule queries the schedule edge of a ScanScheduleRun.
+func (c *ScanScheduleRunClient) QuerySchedule(_m *ScanScheduleRun) *ScanScheduleQuery {
+	query := (&ScanScheduleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(scanschedulerun.Table, scanschedulerun.FieldID, id),
+			sqlgraph.To(scanschedule.Table, scanschedule.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, scanschedulerun.ScheduleTable, scanschedulerun.ScheduleColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ScanScheduleRunClient) Hooks() []Hook {
+	hooks := c.hooks.ScanScheduleRun
+	return append(hooks[:len(hooks):len(hooks)], scanschedulerun.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ScanScheduleRunClient) Interceptors() []Interceptor {
+	inters := c.inters.ScanScheduleRun
+	return append(inters[:len(inters):len(inters)], scanschedulerun.Interceptors[:]...)
+}
+
+func (c *ScanScheduleRunClient) mutate(ctx context.Context, m *ScanScheduleRunMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ScanScheduleRunCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ScanScheduleRunUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ScanScheduleRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ScanScheduleRunDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ScanScheduleRun mutation op: %q", m.Op())
+	}
+}
+
+// ScanTargetClient is a client for the ScanTarget schema.
+type ScanTargetClient struct {
+	config
+}
+
+// NewScanTargetClient returns a client for the ScanTarget from the given config.
+func NewScanTargetClient(c config) *ScanTargetClient {
+	return &ScanTargetClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `scantarget.Hooks(f(g(h())))`.
+func (c *ScanTargetClient) Use(hooks ...Hook) {
+	c.hooks.ScanTarget = append(c.hooks.ScanTarget, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `scantarget.Intercept(f(g(h())))`.
+func (c *ScanTargetClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ScanTarget = append(c.inters.ScanTarget, interceptors...)
+}
+
+// Create returns a builder for creating a ScanTarget entity.
+func (c *ScanTargetClient) Create() *ScanTargetCreate {
+	mutation := newScanTargetMutation(c.config, OpCreate)
+	return &ScanTargetCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ScanTarget entities.
+func (c *ScanTargetClient) CreateBulk(builders ...*ScanTargetCreate) *ScanTargetCreateBulk {
+	return &ScanTargetCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ScanTargetClient) MapCreateBulk(slice any, setFunc func(*ScanTargetCreate, int)) *ScanTargetCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ScanTargetCreateBulk{err: fmt.Errorf("calling to ScanTargetClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ScanTargetCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ScanTargetCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ScanTarget.
+func (c *ScanTargetClient) Update() *ScanTargetUpdate {
+	mutation := newScanTargetMutation(c.config, OpUpdate)
+	return &ScanTargetUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ScanTargetClient) UpdateOne(_m *ScanTarget) *ScanTargetUpdateOne {
+	mutation := newScanTargetMutation(c.config, OpUpdateOne, withScanTarget(_m))
+	return &ScanTargetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ScanTargetClient) UpdateOneID(id uuid.UUID) *ScanTargetUpdateOne {
+	mutation := newScanTargetMutation(c.config, OpUpdateOne, withScanTargetID(id))
+	return &ScanTargetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ScanTarget.
+func (c *ScanTargetClient) Delete() *ScanTargetDelete {
+	mutation := newScanTargetMutation(c.config, OpDelete)
+	return &ScanTargetDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ScanTargetClient) DeleteOne(_m *ScanTarget) *ScanTargetDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ScanTargetClient) DeleteOneID(id uuid.UUID) *ScanTargetDeleteOne {
+	builder := c.Delete().Where(scantarget.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ScanTargetDeleteOne{builder}
+}
+
+// Query returns a query builder for ScanTarget.
+func (c *ScanTargetClient) Query() *ScanTargetQuery {
+	return &ScanTargetQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeScanTarget},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ScanTarget entity by its id.
+func (c *ScanTargetClient) Get(ctx context.Context, id uuid.UUID) (*ScanTarget, error) {
+	return c.Query().Where(scantarget.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ScanTargetClient) GetX(ctx context.Context, id uuid.UUID) *ScanTarget {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTenant queries the tenant edge of a ScanTarget.
+func (c *ScanTargetClient) QueryTenant(_m *ScanTarget) *TenantQuery {
+	query := (&TenantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(scantarget.Table, scantarget.FieldID, id),
+			sqlgraph.To(tenant.Table, tenant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, scantarget.TenantTable, scantarget.TenantColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryScan queries the scan edge of a ScanTarget.
+func (c *ScanTargetClient) QueryScan(_m *ScanTarget) *ScanQuery {
+	query := (&ScanClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(scantarget.Table, scantarget.FieldID, id),
+			sqlgraph.To(scan.Table, scan.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, scantarget.ScanTable, scantarget.ScanColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMachine queries the machine edge of a ScanTarget.
+func (c *ScanTargetClient) QueryMachine(_m *ScanTarget) *MachineQuery {
+	query := (&MachineClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(scantarget.Table, scantarget.FieldID, id),
+			sqlgraph.To(machine.Table, machine.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, scantarget.MachineTable, scantarget.MachineColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ScanTargetClient) Hooks() []Hook {
+	hooks := c.hooks.ScanTarget
+	return append(hooks[:len(hooks):len(hooks)], scantarget.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ScanTargetClient) Interceptors() []Interceptor {
+	inters := c.inters.ScanTarget
+	return append(inters[:len(inters):len(inters)], scantarget.Interceptors[:]...)
+}
+
+func (c *ScanTargetClient) mutate(ctx context.Context, m *ScanTargetMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ScanTargetCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ScanTargetUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ScanTargetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ScanTargetDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ScanTarget mutation op: %q", m.Op())
+	}
+}
+
+// TenantClient is a client for the Tenant schema.
+type TenantClient struct {
+	config
+}
+
+// NewTenantClient returns a client for the Tenant from the given config.
+func NewTenantClient(c config) *TenantClient {
+	return &TenantClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `tenant.Hooks(f(g(h())))`.
+func (c *TenantClient) Use(hooks ...Hook) {
+	c.hooks.Tenant = append(c.hooks.Tenant, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tenant.Intercept(f(g(h())))`.
+func (c *TenantClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Tenant = append(c.inters.Tenant, interceptors...)
+}
+
+// Create returns a builder for creating a Tenant entity.
+func (c *TenantClient) Create() *TenantCreate {
+	mutation := newTenantMutation(c.config, OpCreate)
+	return &TenantCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Tenant entities.
+func (c *TenantClient) CreateBulk(builders ...*TenantCreate) *TenantCreateBulk {
+	return &TenantCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TenantClient) MapCreateBulk(slice any, setFunc func(*TenantCreate, int)) *TenantCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TenantCreateBulk{err: fmt.Errorf("calling to TenantClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TenantCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TenantCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Tenant.
+func (c *TenantClient) Update() *TenantUpdate {
+	mutation := newTenantMutation(c.config, OpUpdate)
+	return &TenantUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TenantClient) UpdateOne(_m *Tenant) *TenantUpdateOne {
+	mutation := newTenantMutation(c.config, OpUpdateOne, withTenant(_m))
+	return &TenantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TenantClient) UpdateOneID(id uuid.UUID) *TenantUpdateOne {
+	mutation := newTenantMutation(c.config, OpUpdateOne, withTenantID(id))
+	return &TenantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Tenant.
+func (c *TenantClient) Delete() *TenantDelete {
+	mutation := newTenantMutation(c.config, OpDelete)
+	return &TenantDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TenantClient) DeleteOne(_m *Tenant) *TenantDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TenantClient) DeleteOneID(id uuid.UUID) *TenantDeleteOne {
+	builder := c.Delete().Where(tenant.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TenantDeleteOne{builder}
+}
+
+// Query returns a query builder for Tenant.
+func (c *TenantClient) Query() *TenantQuery {
+	return &TenantQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTenant},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Tenant entity by its id.
+func (c *TenantClient) Get(ctx context.Context, id uuid.UUID) (*Tenant, error) {
+	return c.Query().Where(tenant.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TenantClient) GetX(ctx context.Context, id uuid.UUID) *Tenant {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMachines queries the machines edge of a Tenant.
+func (c *TenantClient) QueryMachines(_m *Tenant) *MachineQuery {
+	query := (&MachineClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, id),
+			sqlgraph.To(machine.Table, machine.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.MachinesTable, tenant.MachinesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryScans queries the scans edge of a Tenant.
+func (c *TenantClient) QueryScans(_m *Tenant) *ScanQuery {
+	query := (&ScanClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, id),
+			sqlgraph.To(scan.Table, scan.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.ScansTable, tenant.ScansColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDuplicateGroups queries the duplicate_groups edge of a Tenant.
+func (c *TenantClient) QueryDuplicateGroups(_m *Tenant) *DuplicateGroupQuery {
+	query := (&DuplicateGroupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, id),
+			sqlgraph.To(duplicategroup.Table, duplicategroup.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.DuplicateGroupsTable, tenant.DuplicateGroupsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryActionAudits queries the action_audits edge of a Tenant.
+func (c *TenantClient) QueryActionAudits(_m *Tenant) *ActionAuditQuery {
+	query := (&ActionAuditClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, id),
+			sqlgraph.To(actionaudit.Table, actionaudit.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.ActionAuditsTable, tenant.ActionAuditsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySecrets queries the secrets edge of a Tenant.
+func (c *TenantClient) QuerySecrets(_m *Tenant) *TenantSecretQuery {
+	query := (&TenantSecretClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, id),
+			sqlgraph.To(tenantsecret.Table, tenantsecret.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.SecretsTable, tenant.SecretsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFileInstances queries the file_instances edge of a Tenant.
+func (c *TenantClient) QueryFileInstances(_m *Tenant) *FileInstanceQuery {
+	query := (&FileInstanceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, id),
+			sqlgraph.To(fileinstance.Table, fileinstance.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.FileInstancesTable, tenant.FileInstancesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryContentIdentities queries the content_identities edge of a Tenant.
+func (c *TenantClient) QueryContentIdentities(_m *Tenant) *ContentIdentityQuery {
+	query := (&ContentIdentityClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, id),
+			sqlgraph.To(contentidentity.Table, contentidentity.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.ContentIdentitiesTable, tenant.ContentIdentitiesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryContentEvents queries the content_events edge of a Tenant.
+func (c *TenantClient) QueryContentEvents(_m *Tenant) *ContentEventQuery {
+	query := (&ContentEventClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, id),
+			sqlgraph.To(contentevent.Table, contentevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.ContentEventsTable, tenant.ContentEventsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryScanTargets queries the scan_targets edge of a Tenant.
+func (c *TenantClient) QueryScanTargets(_m *Tenant) *ScanTargetQuery {
+	query := (&ScanTargetClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, id),
+			sqlgraph.To(scantarget.Table, scantarget.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.ScanTargetsTable, tenant.ScanTargetsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -945,15 +2757,47 @@ func (c *MachineClient) QueryFileInstances(_m *Machine) *FileInstanceQuery {
 	return query
 }
 
-// QueryInitiatedScans queries the initiated_scans edge of a Machine.
-func (c *MachineClient) QueryInitiatedScans(_m *Machine) *ScanQuery {
-	query := (&ScanClient{config: c.config}).Query()
+// QueryScanSchedules queries the scan_schedules edge of a Tenant.
+func (c *TenantClient) QueryScanSchedules(_m *Tenant) *ScanScheduleQuery {
+	query := (&ScanScheduleClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(machine.Table, machine.FieldID, id),
-			sqlgraph.To(scan.Table, scan.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, machine.InitiatedScansTable, machine.InitiatedScansColumn),
+			sqlgraph.From(tenant.Table, tenant.FieldID, id),
+			sqlgraph.To(scanschedule.Table, scanschedule.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.ScanSchedulesTable, tenant.ScanSchedulesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryScanScheduleRuns queries the scan_schedule_runs edge of a Tenant.
+func (c *TenantClient) QueryScanScheduleRuns(_m *Tenant) *ScanScheduleRunQuery {
+	query := (&ScanScheduleRunClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, id),
+			sqlgraph.To(scanschedulerun.Table, scanschedulerun.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.ScanScheduleRunsTable, tenant.ScanScheduleRunsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryActionJobs queries the action_jobs edge of a Tenant.
+func (c *TenantClient) QueryActionJobs(_m *Tenant) *ActionJobQuery {
+	query := (&ActionJobClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, id),
+			sqlgraph.To(actionjob.Table, actionjob.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.ActionJobsTable, tenant.ActionJobsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -962,131 +2806,133 @@ func (c *MachineClient) QueryInitiatedScans(_m *Machine) *ScanQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *MachineClient) Hooks() []Hook {
-	return c.hooks.Machine
+func (c *TenantClient) Hooks() []Hook {
+	hooks := c.hooks.Tenant
+	return append(hooks[:len(hooks):len(hooks)], tenant.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *MachineClient) Interceptors() []Interceptor {
-	return c.inters.Machine
+func (c *TenantClient) Interceptors() []Interceptor {
+	inters := c.inters.Tenant
+	return append(inters[:len(inters):len(inters)], tenant.Interceptors[:]...)
 }
 
-func (c *MachineClient) mutate(ctx context.Context, m *MachineMutation) (Value, error) {
+func (c *TenantClient) mutate(ctx context.Context, m *TenantMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&MachineCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TenantCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&MachineUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TenantUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&MachineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TenantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&MachineDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&TenantDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Machine mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Tenant mutation op: %q", m.Op())
 	}
 }
 
-// ScanClient is a client for the Scan schema.
-type ScanClient struct {
+// TenantSecretClient is a client for the TenantSecret schema.
+type TenantSecretClient struct {
 	config
 }
 
-// NewScanClient returns a client for the Scan from the given config.
-func NewScanClient(c config) *ScanClient {
-	return &ScanClient{config: c}
+// NewTenantSecretClient returns a client for the TenantSecret from the given config.
+func NewTenantSecretClient(c config) *TenantSecretClient {
+	return &TenantSecretClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `scan.Hooks(f(g(h())))`.
-func (c *ScanClient) Use(hooks ...Hook) {
-	c.hooks.Scan = append(c.hooks.Scan, hooks...)
+// A call to `Use(f, g, h)` equals to `tenantsecret.Hooks(f(g(h())))`.
+func (c *TenantSecretClient) Use(hooks ...Hook) {
+	c.hooks.TenantSecret = append(c.hooks.TenantSecret, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `scan.Intercept(f(g(h())))`.
-func (c *ScanClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Scan = append(c.inters.Scan, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `tenantsecret.Intercept(f(g(h())))`.
+func (c *TenantSecretClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TenantSecret = append(c.inters.TenantSecret, interceptors...)
 }
 
-// Create returns a builder for creating a Scan entity.
-func (c *ScanClient) Create() *ScanCreate {
-	mutation := newScanMutation(c.config, OpCreate)
-	return &ScanCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a TenantSecret entity.
+func (c *TenantSecretClient) Create() *TenantSecretCreate {
+	mutation := newTenantSecretMutation(c.config, OpCreate)
+	return &TenantSecretCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Scan entities.
-func (c *ScanClient) CreateBulk(builders ...*ScanCreate) *ScanCreateBulk {
-	return &ScanCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of TenantSecret entities.
+func (c *TenantSecretClient) CreateBulk(builders ...*TenantSecretCreate) *TenantSecretCreateBulk {
+	return &TenantSecretCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ScanClient) MapCreateBulk(slice any, setFunc func(*ScanCreate, int)) *ScanCreateBulk {
+func (c *TenantSecretClient) MapCreateBulk(slice any, setFunc func(*TenantSecretCreate, int)) *TenantSecretCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ScanCreateBulk{err: fmt.Errorf("calling to ScanClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &TenantSecretCreateBulk{err: fmt.Errorf("calling to TenantSecretClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ScanCreate, rv.Len())
+	builders := make([]*TenantSecretCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ScanCreateBulk{config: c.config, builders: builders}
+	return &TenantSecretCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Scan.
-func (c *ScanClient) Update() *ScanUpdate {
-	mutation := newScanMutation(c.config, OpUpdate)
-	return &ScanUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for TenantSecret.
+func (c *TenantSecretClient) Update() *TenantSecretUpdate {
+	mutation := newTenantSecretMutation(c.config, OpUpdate)
+	return &TenantSecretUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ScanClient) UpdateOne(_m *Scan) *ScanUpdateOne {
-	mutation := newScanMutation(c.config, OpUpdateOne, withScan(_m))
-	return &ScanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TenantSecretClient) UpdateOne(_m *TenantSecret) *TenantSecretUpdateOne {
+	mutation := newTenantSecretMutation(c.config, OpUpdateOne, withTenantSecret(_m))
+	return &TenantSecretUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ScanClient) UpdateOneID(id uuid.UUID) *ScanUpdateOne {
-	mutation := newScanMutation(c.config, OpUpdateOne, withScanID(id))
-	return &ScanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TenantSecretClient) UpdateOneID(id uuid.UUID) *TenantSecretUpdateOne {
+	mutation := newTenantSecretMutation(c.config, OpUpdateOne, withTenantSecretID(id))
+	return &TenantSecretUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Scan.
-func (c *ScanClient) Delete() *ScanDelete {
-	mutation := newScanMutation(c.config, OpDelete)
-	return &ScanDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for TenantSecret.
+func (c *TenantSecretClient) Delete() *TenantSecretDelete {
+	mutation := newTenantSecretMutation(c.config, OpDelete)
+	return &TenantSecretDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ScanClient) DeleteOne(_m *Scan) *ScanDeleteOne {
+func (c *TenantSecretClient) DeleteOne(_m *TenantSecret) *TenantSecretDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ScanClient) DeleteOneID(id uuid.UUID) *ScanDeleteOne {
-	builder := c.Delete().Where(scan.ID(id))
+func (c *TenantSecretClient) DeleteOneID(id uuid.UUID) *TenantSecretDeleteOne {
+	builder := c.Delete().Where(tenantsecret.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ScanDeleteOne{builder}
+	return &TenantSecretDeleteOne{builder}
 }
 
-// Query returns a query builder for Scan.
-func (c *ScanClient) Query() *ScanQuery {
-	return &ScanQuery{
+// Query returns a query builder for TenantSecret.
+func (c *TenantSecretClient) Query() *TenantSecretQuery {
+	return &TenantSecretQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeScan},
+		ctx:    &QueryContext{Type: TypeTenantSecret},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Scan entity by its id.
-func (c *ScanClient) Get(ctx context.Context, id uuid.UUID) (*Scan, error) {
-	return c.Query().Where(scan.ID(id)).Only(ctx)
+// Get returns a TenantSecret entity by its id.
+func (c *TenantSecretClient) Get(ctx context.Context, id uuid.UUID) (*TenantSecret, error) {
+	return c.Query().Where(tenantsecret.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ScanClient) GetX(ctx context.Context, id uuid.UUID) *Scan {
+func (c *TenantSecretClient) GetX(ctx context.Context, id uuid.UUID) *TenantSecret {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1094,15 +2940,15 @@ func (c *ScanClient) GetX(ctx context.Context, id uuid.UUID) *Scan {
 	return obj
 }
 
-// QueryTenant queries the tenant edge of a Scan.
-func (c *ScanClient) QueryTenant(_m *Scan) *TenantQuery {
+// QueryTenant queries the tenant edge of a TenantSecret.
+func (c *TenantSecretClient) QueryTenant(_m *TenantSecret) *TenantQuery {
 	query := (&TenantClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(scan.Table, scan.FieldID, id),
+			sqlgraph.From(tenantsecret.Table, tenantsecret.FieldID, id),
 			sqlgraph.To(tenant.Table, tenant.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, scan.TenantTable, scan.TenantColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, tenantsecret.TenantTable, tenantsecret.TenantColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1110,31 +2956,15 @@ func (c *ScanClient) QueryTenant(_m *Scan) *TenantQuery {
 	return query
 }
 
-// QueryInitiatedMachine queries the initiated_machine edge of a Scan.
-func (c *ScanClient) QueryInitiatedMachine(_m *Scan) *MachineQuery {
+// QueryMachine queries the machine edge of a TenantSecret.
+func (c *TenantSecretClient) QueryMachine(_m *TenantSecret) *MachineQuery {
 	query := (&MachineClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(scan.Table, scan.FieldID, id),
+			sqlgraph.From(tenantsecret.Table, tenantsecret.FieldID, id),
 			sqlgraph.To(machine.Table, machine.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, scan.InitiatedMachineTable, scan.InitiatedMachineColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryDuplicateGroups queries the duplicate_groups edge of a Scan.
-func (c *ScanClient) QueryDuplicateGroups(_m *Scan) *DuplicateGroupQuery {
-	query := (&DuplicateGroupClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(scan.Table, scan.FieldID, id),
-			sqlgraph.To(duplicategroup.Table, duplicategroup.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, scan.DuplicateGroupsTable, scan.DuplicateGroupsColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, tenantsecret.MachineTable, tenantsecret.MachineColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1143,131 +2973,133 @@ func (c *ScanClient) QueryDuplicateGroups(_m *Scan) *DuplicateGroupQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *ScanClient) Hooks() []Hook {
-	return c.hooks.Scan
+func (c *TenantSecretClient) Hooks() []Hook {
+	hooks := c.hooks.TenantSecret
+	return append(hooks[:len(hooks):len(hooks)], tenantsecret.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *ScanClient) Interceptors() []Interceptor {
-	return c.inters.Scan
+func (c *TenantSecretClient) Interceptors() []Interceptor {
+	inters := c.inters.TenantSecret
+	return append(inters[:len(inters):len(inters)], tenantsecret.Interceptors[:]...)
 }
 
-func (c *ScanClient) mutate(ctx context.Context, m *ScanMutation) (Value, error) {
+func (c *TenantSecretClient) mutate(ctx context.Context, m *TenantSecretMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ScanCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TenantSecretCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ScanUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TenantSecretUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ScanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TenantSecretUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ScanDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&TenantSecretDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Scan mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown TenantSecret mutation op: %q", m.Op())
 	}
 }
 
-// TenantClient is a client for the Tenant schema.
-type TenantClient struct {
+// TenantTombstoneClient is a client for the TenantTombstone schema.
+type TenantTombstoneClient struct {
 	config
 }
 
-// NewTenantClient returns a client for the Tenant from the given config.
-func NewTenantClient(c config) *TenantClient {
-	return &TenantClient{config: c}
+// NewTenantTombstoneClient returns a client for the TenantTombstone from the given config.
+func NewTenantTombstoneClient(c config) *TenantTombstoneClient {
+	return &TenantTombstoneClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `tenant.Hooks(f(g(h())))`.
-func (c *TenantClient) Use(hooks ...Hook) {
-	c.hooks.Tenant = append(c.hooks.Tenant, hooks...)
+// A call to `Use(f, g, h)` equals to `tenanttombstone.Hooks(f(g(h())))`.
+func (c *TenantTombstoneClient) Use(hooks ...Hook) {
+	c.hooks.TenantTombstone = append(c.hooks.TenantTombstone, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `tenant.Intercept(f(g(h())))`.
-func (c *TenantClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Tenant = append(c.inters.Tenant, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `tenanttombstone.Intercept(f(g(h())))`.
+func (c *TenantTombstoneClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TenantTombstone = append(c.inters.TenantTombstone, interceptors...)
 }
 
-// Create returns a builder for creating a Tenant entity.
-func (c *TenantClient) Create() *TenantCreate {
-	mutation := newTenantMutation(c.config, OpCreate)
-	return &TenantCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a TenantTombstone entity.
+func (c *TenantTombstoneClient) Create() *TenantTombstoneCreate {
+	mutation := newTenantTombstoneMutation(c.config, OpCreate)
+	return &TenantTombstoneCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Tenant entities.
-func (c *TenantClient) CreateBulk(builders ...*TenantCreate) *TenantCreateBulk {
-	return &TenantCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of TenantTombstone entities.
+func (c *TenantTombstoneClient) CreateBulk(builders ...*TenantTombstoneCreate) *TenantTombstoneCreateBulk {
+	return &TenantTombstoneCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *TenantClient) MapCreateBulk(slice any, setFunc func(*TenantCreate, int)) *TenantCreateBulk {
+func (c *TenantTombstoneClient) MapCreateBulk(slice any, setFunc func(*TenantTombstoneCreate, int)) *TenantTombstoneCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &TenantCreateBulk{err: fmt.Errorf("calling to TenantClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &TenantTombstoneCreateBulk{err: fmt.Errorf("calling to TenantTombstoneClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*TenantCreate, rv.Len())
+	builders := make([]*TenantTombstoneCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &TenantCreateBulk{config: c.config, builders: builders}
+	return &TenantTombstoneCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Tenant.
-func (c *TenantClient) Update() *TenantUpdate {
-	mutation := newTenantMutation(c.config, OpUpdate)
-	return &TenantUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for TenantTombstone.
+func (c *TenantTombstoneClient) Update() *TenantTombstoneUpdate {
+	mutation := newTenantTombstoneMutation(c.config, OpUpdate)
+	return &TenantTombstoneUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *TenantClient) UpdateOne(_m *Tenant) *TenantUpdateOne {
-	mutation := newTenantMutation(c.config, OpUpdateOne, withTenant(_m))
-	return &TenantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TenantTombstoneClient) UpdateOne(_m *TenantTombstone) *TenantTombstoneUpdateOne {
+	mutation := newTenantTombstoneMutation(c.config, OpUpdateOne, withTenantTombstone(_m))
+	return &TenantTombstoneUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *TenantClient) UpdateOneID(id uuid.UUID) *TenantUpdateOne {
-	mutation := newTenantMutation(c.config, OpUpdateOne, withTenantID(id))
-	return &TenantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TenantTombstoneClient) UpdateOneID(id uuid.UUID) *TenantTombstoneUpdateOne {
+	mutation := newTenantTombstoneMutation(c.config, OpUpdateOne, withTenantTombstoneID(id))
+	return &TenantTombstoneUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Tenant.
-func (c *TenantClient) Delete() *TenantDelete {
-	mutation := newTenantMutation(c.config, OpDelete)
-	return &TenantDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for TenantTombstone.
+func (c *TenantTombstoneClient) Delete() *TenantTombstoneDelete {
+	mutation := newTenantTombstoneMutation(c.config, OpDelete)
+	return &TenantTombstoneDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *TenantClient) DeleteOne(_m *Tenant) *TenantDeleteOne {
+func (c *TenantTombstoneClient) DeleteOne(_m *TenantTombstone) *TenantTombstoneDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *TenantClient) DeleteOneID(id uuid.UUID) *TenantDeleteOne {
-	builder := c.Delete().Where(tenant.ID(id))
+func (c *TenantTombstoneClient) DeleteOneID(id uuid.UUID) *TenantTombstoneDeleteOne {
+	builder := c.Delete().Where(tenanttombstone.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &TenantDeleteOne{builder}
+	return &TenantTombstoneDeleteOne{builder}
 }
 
-// Query returns a query builder for Tenant.
-func (c *TenantClient) Query() *TenantQuery {
-	return &TenantQuery{
+// Query returns a query builder for TenantTombstone.
+func (c *TenantTombstoneClient) Query() *TenantTombstoneQuery {
+	return &TenantTombstoneQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeTenant},
+		ctx:    &QueryContext{Type: TypeTenantTombstone},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Tenant entity by its id.
-func (c *TenantClient) Get(ctx context.Context, id uuid.UUID) (*Tenant, error) {
-	return c.Query().Where(tenant.ID(id)).Only(ctx)
+// Get returns a TenantTombstone entity by its id.
+func (c *TenantTombstoneClient) Get(ctx context.Context, id uuid.UUID) (*TenantTombstone, error) {
+	return c.Query().Where(tenanttombstone.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *TenantClient) GetX(ctx context.Context, id uuid.UUID) *Tenant {
+func (c *TenantTombstoneClient) GetX(ctx context.Context, id uuid.UUID) *TenantTombstone {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1275,102 +3107,65 @@ func (c *TenantClient) GetX(ctx context.Context, id uuid.UUID) *Tenant {
 	return obj
 }
 
-// QueryMachines queries the machines edge of a Tenant.
-func (c *TenantClient) QueryMachines(_m *Tenant) *MachineQuery {
-	query := (&MachineClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(tenant.Table, tenant.FieldID, id),
-			sqlgraph.To(machine.Table, machine.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, tenant.MachinesTable, tenant.MachinesColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryScans queries the scans edge of a Tenant.
-func (c *TenantClient) QueryScans(_m *Tenant) *ScanQuery {
-	query := (&ScanClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(tenant.Table, tenant.FieldID, id),
-			sqlgraph.To(scan.Table, scan.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, tenant.ScansTable, tenant.ScansColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryDuplicateGroups queries the duplicate_groups edge of a Tenant.
-func (c *TenantClient) QueryDuplicateGroups(_m *Tenant) *DuplicateGroupQuery {
-	query := (&DuplicateGroupClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(tenant.Table, tenant.FieldID, id),
-			sqlgraph.To(duplicategroup.Table, duplicategroup.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, tenant.DuplicateGroupsTable, tenant.DuplicateGroupsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryActionAudits queries the action_audits edge of a Tenant.
-func (c *TenantClient) QueryActionAudits(_m *Tenant) *ActionAuditQuery {
-	query := (&ActionAuditClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(tenant.Table, tenant.FieldID, id),
-			sqlgraph.To(actionaudit.Table, actionaudit.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, tenant.ActionAuditsTable, tenant.ActionAuditsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *TenantClient) Hooks() []Hook {
-	return c.hooks.Tenant
+func (c *TenantTombstoneClient) Hooks() []Hook {
+	return c.hooks.TenantTombstone
 }
 
 // Interceptors returns the client interceptors.
-func (c *TenantClient) Interceptors() []Interceptor {
-	return c.inters.Tenant
+func (c *TenantTombstoneClient) Interceptors() []Interceptor {
+	return c.inters.TenantTombstone
 }
 
-func (c *TenantClient) mutate(ctx context.Context, m *TenantMutation) (Value, error) {
+func (c *TenantTombstoneClient) mutate(ctx context.Context, m *TenantTombstoneMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&TenantCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TenantTombstoneCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&TenantUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TenantTombstoneUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&TenantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TenantTombstoneUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&TenantDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&TenantTombstoneDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Tenant mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown TenantTombstone mutation op: %q", m.Op())
 	}
 }
 
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		ActionAudit, DuplicateGroup, FileInstance, Machine, Scan, Tenant []ent.Hook
+		ActionAudit, ActionJob, ContentEvent, ContentIdentity, DuplicateGroup,
+		FileInstance, Machine, Scan, ScanSchedule, ScanScheduleRun, ScanTarget, Tenant,
+		TenantSecret, TenantTombstone []ent.Hook
 	}
 	inters struct {
-		ActionAudit, DuplicateGroup, FileInstance, Machine, Scan,
-		Tenant []ent.Interceptor
+		ActionAudit, ActionJob, ContentEvent, ContentIdentity, DuplicateGroup,
+		FileInstance, Machine, Scan, ScanSchedule, ScanScheduleRun, ScanTarget, Tenant,
+		TenantSecret, TenantTombstone []ent.Interceptor
 	}
 )
+
+// ExecContext allows calling the underlying ExecContext method of the driver if it is supported by it.
+// See, database/sql#DB.ExecContext for more information.
+func (c *config) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := c.driver.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the driver if it is supported by it.
+// See, database/sql#DB.QueryContext for more information.
+func (c *config) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := c.driver.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
@@ -0,0 +1,281 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/mcmx/duplynx/ent/contentevent"
+	"github.com/mcmx/duplynx/ent/contentidentity"
+	"github.com/mcmx/duplynx/ent/machine"
+	"github.com/mcmx/duplynx/ent/scan"
+	"github.com/mcmx/duplynx/ent/tenant"
+)
+
+// ContentEvent is the model entity for the ContentEvent schema.
+type ContentEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// ContentIdentityID holds the value of the "content_identity_id" field.
+	ContentIdentityID uuid.UUID `json:"content_identity_id,omitempty"`
+	// ScanID holds the value of the "scan_id" field.
+	ScanID uuid.UUID `json:"scan_id,omitempty"`
+	// MachineID holds the value of the "machine_id" field.
+	MachineID uuid.UUID `json:"machine_id,omitempty"`
+	// Path holds the value of the "path" field.
+	Path string `json:"path,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind contentevent.Kind `json:"kind,omitempty"`
+	// OccurredAt holds the value of the "occurred_at" field.
+	OccurredAt time.Time `json:"occurred_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ContentEventQuery when eager-loading is set.
+	Edges        ContentEventEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ContentEventEdges holds the relations/edges for other nodes in the graph.
+type ContentEventEdges struct {
+	// Tenant holds the value of the tenant edge.
+	Tenant *Tenant `json:"tenant,omitempty"`
+	// ContentIdentity holds the value of the content_identity edge.
+	ContentIdentity *ContentIdentity `json:"content_identity,omitempty"`
+	// Scan holds the value of the scan edge.
+	Scan *Scan `json:"scan,omitempty"`
+	// Machine holds the value of the machine edge.
+	Machine *Machine `json:"machine,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// TenantOrErr returns the Tenant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ContentEventEdges) TenantOrErr() (*Tenant, error) {
+	if e.Tenant != nil {
+		return e.Tenant, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: tenant.Label}
+	}
+	return nil, &NotLoadedError{edge: "tenant"}
+}
+
+// ContentIdentityOrErr returns the ContentIdentity value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ContentEventEdges) ContentIdentityOrErr() (*ContentIdentity, error) {
+	if e.ContentIdentity != nil {
+		return e.ContentIdentity, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: contentidentity.Label}
+	}
+	return nil, &NotLoadedError{edge: "content_identity"}
+}
+
+// ScanOrErr returns the Scan value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ContentEventEdges) ScanOrErr() (*Scan, error) {
+	if e.Scan != nil {
+		return e.Scan, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: scan.Label}
+	}
+	return nil, &NotLoadedError{edge: "scan"}
+}
+
+// MachineOrErr returns the Machine value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ContentEventEdges) MachineOrErr() (*Machine, error) {
+	if e.Machine != nil {
+		return e.Machine, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: machine.Label}
+	}
+	return nil, &NotLoadedError{edge: "machine"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ContentEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case contentevent.FieldPath, contentevent.FieldKind:
+			values[i] = new(sql.NullString)
+		case contentevent.FieldCreateTime, contentevent.FieldUpdateTime, contentevent.FieldOccurredAt:
+			values[i] = new(sql.NullTime)
+		case contentevent.FieldID, contentevent.FieldTenantID, contentevent.FieldContentIdentityID, contentevent.FieldScanID, contentevent.FieldMachineID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ContentEvent fields.
+func (_m *ContentEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case contentevent.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case contentevent.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case contentevent.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = value.Time
+			}
+		case contentevent.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case contentevent.FieldContentIdentityID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field content_identity_id", values[i])
+			} else if value != nil {
+				_m.ContentIdentityID = *value
+			}
+		case contentevent.FieldScanID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field scan_id", values[i])
+			} else if value != nil {
+				_m.ScanID = *value
+			}
+		case contentevent.FieldMachineID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field machine_id", values[i])
+			} else if value != nil {
+				_m.MachineID = *value
+			}
+		case contentevent.FieldPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field path", values[i])
+			} else if value.Valid {
+				_m.Path = value.String
+			}
+		case contentevent.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = contentevent.Kind(value.String)
+			}
+		case contentevent.FieldOccurredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field occurred_at", values[i])
+			} else if value.Valid {
+				_m.OccurredAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ContentEvent.
+// This includes values selected through modifiers, order, etc.
+func (_m *ContentEvent) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTenant queries the "tenant" edge of the ContentEvent entity.
+func (_m *ContentEvent) QueryTenant() *TenantQuery {
+	return NewContentEventClient(_m.config).QueryTenant(_m)
+}
+
+// QueryContentIdentity queries the "content_identity" edge of the ContentEvent entity.
+func (_m *ContentEvent) QueryContentIdentity() *ContentIdentityQuery {
+	return NewContentEventClient(_m.config).QueryContentIdentity(_m)
+}
+
+// QueryScan queries the "scan" edge of the ContentEvent entity.
+func (_m *ContentEvent) QueryScan() *ScanQuery {
+	return NewContentEventClient(_m.config).QueryScan(_m)
+}
+
+// QueryMachine queries the "machine" edge of the ContentEvent entity.
+func (_m *ContentEvent) QueryMachine() *MachineQuery {
+	return NewContentEventClient(_m.config).QueryMachine(_m)
+}
+
+// Update returns a builder for updating this ContentEvent.
+// Note that you need to call ContentEvent.Unwrap() before calling this method if this ContentEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ContentEvent) Update() *ContentEventUpdateOne {
+	return NewContentEventClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ContentEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ContentEvent) Unwrap() *ContentEvent {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ContentEvent is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ContentEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("ContentEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("content_identity_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ContentIdentityID))
+	builder.WriteString(", ")
+	builder.WriteString("scan_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ScanID))
+	builder.WriteString(", ")
+	builder.WriteString("machine_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MachineID))
+	builder.WriteString(", ")
+	builder.WriteString("path=")
+	builder.WriteString(_m.Path)
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("occurred_at=")
+	builder.WriteString(_m.OccurredAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ContentEvents is a parsable slice of ContentEvent.
+type ContentEvents []*ContentEvent
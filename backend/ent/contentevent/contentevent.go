@@ -0,0 +1,250 @@
+// Code generated by ent, DO NOT EDIT.
+
+package contentevent
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the contentevent type in the database.
+	Label = "content_event"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldContentIdentityID holds the string denoting the content_identity_id field in the database.
+	FieldContentIdentityID = "content_identity_id"
+	// FieldScanID holds the string denoting the scan_id field in the database.
+	FieldScanID = "scan_id"
+	// FieldMachineID holds the string denoting the machine_id field in the database.
+	FieldMachineID = "machine_id"
+	// FieldPath holds the string denoting the path field in the database.
+	FieldPath = "path"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldOccurredAt holds the string denoting the occurred_at field in the database.
+	FieldOccurredAt = "occurred_at"
+	// EdgeTenant holds the string denoting the tenant edge name in mutations.
+	EdgeTenant = "tenant"
+	// EdgeContentIdentity holds the string denoting the content_identity edge name in mutations.
+	EdgeContentIdentity = "content_identity"
+	// EdgeScan holds the string denoting the scan edge name in mutations.
+	EdgeScan = "scan"
+	// EdgeMachine holds the string denoting the machine edge name in mutations.
+	EdgeMachine = "machine"
+	// Table holds the table name of the contentevent in the database.
+	Table = "content_events"
+	// TenantTable is the table that holds the tenant relation/edge.
+	TenantTable = "content_events"
+	// TenantInverseTable is the table name for the Tenant entity.
+	// It exists in this package in order to avoid circular dependency with the "tenant" package.
+	TenantInverseTable = "tenants"
+	// TenantColumn is the table column denoting the tenant relation/edge.
+	TenantColumn = "tenant_id"
+	// ContentIdentityTable is the table that holds the content_identity relation/edge.
+	ContentIdentityTable = "content_events"
+	// ContentIdentityInverseTable is the table name for the ContentIdentity entity.
+	// It exists in this package in order to avoid circular dependency with the "contentidentity" package.
+	ContentIdentityInverseTable = "content_identities"
+	// ContentIdentityColumn is the table column denoting the content_identity relation/edge.
+	ContentIdentityColumn = "content_identity_id"
+	// ScanTable is the table that holds the scan relation/edge.
+	ScanTable = "content_events"
+	// ScanInverseTable is the table name for the Scan entity.
+	// It exists in this package in order to avoid circular dependency with the "scan" package.
+	ScanInverseTable = "scans"
+	// ScanColumn is the table column denoting the scan relation/edge.
+	ScanColumn = "scan_id"
+	// MachineTable is the table that holds the machine relation/edge.
+	MachineTable = "content_events"
+	// MachineInverseTable is the table name for the Machine entity.
+	// It exists in this package in order to avoid circular dependency with the "machine" package.
+	MachineInverseTable = "machines"
+	// MachineColumn is the table column denoting the machine relation/edge.
+	MachineColumn = "machine_id"
+)
+
+// Columns holds all SQL columns for contentevent fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldTenantID,
+	FieldContentIdentityID,
+	FieldScanID,
+	FieldMachineID,
+	FieldPath,
+	FieldKind,
+	FieldOccurredAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/mcmx/duplynx/ent/runtime"
+var (
+	Hooks        [1]ent.Hook
+	Interceptors [1]ent.Interceptor
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
+	DefaultUpdateTime func() time.Time
+	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
+	UpdateDefaultUpdateTime func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Kind defines the type for the "kind" enum field.
+type Kind string
+
+// Kind values.
+const (
+	KindAppeared    Kind = "appeared"
+	KindDisappeared Kind = "disappeared"
+)
+
+func (k Kind) String() string {
+	return string(k)
+}
+
+// KindValidator is a validator for the "kind" field enum values. It is called by the builders before save.
+func KindValidator(k Kind) error {
+	switch k {
+	case KindAppeared, KindDisappeared:
+		return nil
+	default:
+		return fmt.Errorf("contentevent: invalid enum value for kind field: %q", k)
+	}
+}
+
+// OrderOption defines the ordering options for the ContentEvent queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByContentIdentityID orders the results by the content_identity_id field.
+func ByContentIdentityID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContentIdentityID, opts...).ToFunc()
+}
+
+// ByScanID orders the results by the scan_id field.
+func ByScanID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScanID, opts...).ToFunc()
+}
+
+// ByMachineID orders the results by the machine_id field.
+func ByMachineID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMachineID, opts...).ToFunc()
+}
+
+// ByPath orders the results by the path field.
+func ByPath(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPath, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByOccurredAt orders the results by the occurred_at field.
+func ByOccurredAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOccurredAt, opts...).ToFunc()
+}
+
+// ByTenantField orders the results by tenant field.
+func ByTenantField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTenantStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByContentIdentityField orders the results by content_identity field.
+func ByContentIdentityField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newContentIdentityStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByScanField orders the results by scan field.
+func ByScanField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newScanStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByMachineField orders the results by machine field.
+func ByMachineField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMachineStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newTenantStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TenantInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TenantTable, TenantColumn),
+	)
+}
+func newContentIdentityStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ContentIdentityInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ContentIdentityTable, ContentIdentityColumn),
+	)
+}
+func newScanStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ScanInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ScanTable, ScanColumn),
+	)
+}
+func newMachineStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MachineInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, MachineTable, MachineColumn),
+	)
+}
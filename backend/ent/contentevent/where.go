@@ -0,0 +1,489 @@
+// Code generated by ent, DO NOT EDIT.
+
+package contentevent
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/mcmx/duplynx/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldEQ(FieldTenantID, v))
+}
+
+// ContentIdentityID applies equality check predicate on the "content_identity_id" field. It's identical to ContentIdentityIDEQ.
+func ContentIdentityID(v uuid.UUID) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldEQ(FieldContentIdentityID, v))
+}
+
+// ScanID applies equality check predicate on the "scan_id" field. It's identical to ScanIDEQ.
+func ScanID(v uuid.UUID) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldEQ(FieldScanID, v))
+}
+
+// MachineID applies equality check predicate on the "machine_id" field. It's identical to MachineIDEQ.
+func MachineID(v uuid.UUID) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldEQ(FieldMachineID, v))
+}
+
+// Path applies equality check predicate on the "path" field. It's identical to PathEQ.
+func Path(v string) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldEQ(FieldPath, v))
+}
+
+// OccurredAt applies equality check predicate on the "occurred_at" field. It's identical to OccurredAtEQ.
+func OccurredAt(v time.Time) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldEQ(FieldOccurredAt, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// ContentIdentityIDEQ applies the EQ predicate on the "content_identity_id" field.
+func ContentIdentityIDEQ(v uuid.UUID) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldEQ(FieldContentIdentityID, v))
+}
+
+// ContentIdentityIDNEQ applies the NEQ predicate on the "content_identity_id" field.
+func ContentIdentityIDNEQ(v uuid.UUID) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldNEQ(FieldContentIdentityID, v))
+}
+
+// ContentIdentityIDIn applies the In predicate on the "content_identity_id" field.
+func ContentIdentityIDIn(vs ...uuid.UUID) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldIn(FieldContentIdentityID, vs...))
+}
+
+// ContentIdentityIDNotIn applies the NotIn predicate on the "content_identity_id" field.
+func ContentIdentityIDNotIn(vs ...uuid.UUID) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldNotIn(FieldContentIdentityID, vs...))
+}
+
+// ScanIDEQ applies the EQ predicate on the "scan_id" field.
+func ScanIDEQ(v uuid.UUID) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldEQ(FieldScanID, v))
+}
+
+// ScanIDNEQ applies the NEQ predicate on the "scan_id" field.
+func ScanIDNEQ(v uuid.UUID) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldNEQ(FieldScanID, v))
+}
+
+// ScanIDIn applies the In predicate on the "scan_id" field.
+func ScanIDIn(vs ...uuid.UUID) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldIn(FieldScanID, vs...))
+}
+
+// ScanIDNotIn applies the NotIn predicate on the "scan_id" field.
+func ScanIDNotIn(vs ...uuid.UUID) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldNotIn(FieldScanID, vs...))
+}
+
+// MachineIDEQ applies the EQ predicate on the "machine_id" field.
+func MachineIDEQ(v uuid.UUID) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldEQ(FieldMachineID, v))
+}
+
+// MachineIDNEQ applies the NEQ predicate on the "machine_id" field.
+func MachineIDNEQ(v uuid.UUID) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldNEQ(FieldMachineID, v))
+}
+
+// MachineIDIn applies the In predicate on the "machine_id" field.
+func MachineIDIn(vs ...uuid.UUID) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldIn(FieldMachineID, vs...))
+}
+
+// MachineIDNotIn applies the NotIn predicate on the "machine_id" field.
+func MachineIDNotIn(vs ...uuid.UUID) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldNotIn(FieldMachineID, vs...))
+}
+
+// PathEQ applies the EQ predicate on the "path" field.
+func PathEQ(v string) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldEQ(FieldPath, v))
+}
+
+// PathNEQ applies the NEQ predicate on the "path" field.
+func PathNEQ(v string) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldNEQ(FieldPath, v))
+}
+
+// PathIn applies the In predicate on the "path" field.
+func PathIn(vs ...string) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldIn(FieldPath, vs...))
+}
+
+// PathNotIn applies the NotIn predicate on the "path" field.
+func PathNotIn(vs ...string) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldNotIn(FieldPath, vs...))
+}
+
+// PathGT applies the GT predicate on the "path" field.
+func PathGT(v string) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldGT(FieldPath, v))
+}
+
+// PathGTE applies the GTE predicate on the "path" field.
+func PathGTE(v string) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldGTE(FieldPath, v))
+}
+
+// PathLT applies the LT predicate on the "path" field.
+func PathLT(v string) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldLT(FieldPath, v))
+}
+
+// PathLTE applies the LTE predicate on the "path" field.
+func PathLTE(v string) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldLTE(FieldPath, v))
+}
+
+// PathContains applies the Contains predicate on the "path" field.
+func PathContains(v string) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldContains(FieldPath, v))
+}
+
+// PathHasPrefix applies the HasPrefix predicate on the "path" field.
+func PathHasPrefix(v string) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldHasPrefix(FieldPath, v))
+}
+
+// PathHasSuffix applies the HasSuffix predicate on the "path" field.
+func PathHasSuffix(v string) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldHasSuffix(FieldPath, v))
+}
+
+// PathEqualFold applies the EqualFold predicate on the "path" field.
+func PathEqualFold(v string) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldEqualFold(FieldPath, v))
+}
+
+// PathContainsFold applies the ContainsFold predicate on the "path" field.
+func PathContainsFold(v string) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldContainsFold(FieldPath, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v Kind) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v Kind) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...Kind) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...Kind) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// OccurredAtEQ applies the EQ predicate on the "occurred_at" field.
+func OccurredAtEQ(v time.Time) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldEQ(FieldOccurredAt, v))
+}
+
+// OccurredAtNEQ applies the NEQ predicate on the "occurred_at" field.
+func OccurredAtNEQ(v time.Time) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldNEQ(FieldOccurredAt, v))
+}
+
+// OccurredAtIn applies the In predicate on the "occurred_at" field.
+func OccurredAtIn(vs ...time.Time) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldIn(FieldOccurredAt, vs...))
+}
+
+// OccurredAtNotIn applies the NotIn predicate on the "occurred_at" field.
+func OccurredAtNotIn(vs ...time.Time) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldNotIn(FieldOccurredAt, vs...))
+}
+
+// OccurredAtGT applies the GT predicate on the "occurred_at" field.
+func OccurredAtGT(v time.Time) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldGT(FieldOccurredAt, v))
+}
+
+// OccurredAtGTE applies the GTE predicate on the "occurred_at" field.
+func OccurredAtGTE(v time.Time) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldGTE(FieldOccurredAt, v))
+}
+
+// OccurredAtLT applies the LT predicate on the "occurred_at" field.
+func OccurredAtLT(v time.Time) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldLT(FieldOccurredAt, v))
+}
+
+// OccurredAtLTE applies the LTE predicate on the "occurred_at" field.
+func OccurredAtLTE(v time.Time) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.FieldLTE(FieldOccurredAt, v))
+}
+
+// HasTenant applies the HasEdge predicate on the "tenant" edge.
+func HasTenant() predicate.ContentEvent {
+	return predicate.ContentEvent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TenantTable, TenantColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTenantWith applies the HasEdge predicate on the "tenant" edge with a given conditions (other predicates).
+func HasTenantWith(preds ...predicate.Tenant) predicate.ContentEvent {
+	return predicate.ContentEvent(func(s *sql.Selector) {
+		step := newTenantStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasContentIdentity applies the HasEdge predicate on the "content_identity" edge.
+func HasContentIdentity() predicate.ContentEvent {
+	return predicate.ContentEvent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ContentIdentityTable, ContentIdentityColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasContentIdentityWith applies the HasEdge predicate on the "content_identity" edge with a given conditions (other predicates).
+func HasContentIdentityWith(preds ...predicate.ContentIdentity) predicate.ContentEvent {
+	return predicate.ContentEvent(func(s *sql.Selector) {
+		step := newContentIdentityStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasScan applies the HasEdge predicate on the "scan" edge.
+func HasScan() predicate.ContentEvent {
+	return predicate.ContentEvent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ScanTable, ScanColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasScanWith applies the HasEdge predicate on the "scan" edge with a given conditions (other predicates).
+func HasScanWith(preds ...predicate.Scan) predicate.ContentEvent {
+	return predicate.ContentEvent(func(s *sql.Selector) {
+		step := newScanStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMachine applies the HasEdge predicate on the "machine" edge.
+func HasMachine() predicate.ContentEvent {
+	return predicate.ContentEvent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, MachineTable, MachineColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMachineWith applies the HasEdge predicate on the "machine" edge with a given conditions (other predicates).
+func HasMachineWith(preds ...predicate.Machine) predicate.ContentEvent {
+	return predicate.ContentEvent(func(s *sql.Selector) {
+		step := newMachineStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ContentEvent) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ContentEvent) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ContentEvent) predicate.ContentEvent {
+	return predicate.ContentEvent(sql.NotPredicates(p))
+}
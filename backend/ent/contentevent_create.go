@@ -0,0 +1,447 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/mcmx/duplynx/ent/contentevent"
+	"github.com/mcmx/duplynx/ent/contentidentity"
+	"github.com/mcmx/duplynx/ent/machine"
+	"github.com/mcmx/duplynx/ent/scan"
+	"github.com/mcmx/duplynx/ent/tenant"
+)
+
+// ContentEventCreate is the builder for creating a ContentEvent entity.
+type ContentEventCreate struct {
+	config
+	mutation *ContentEventMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *ContentEventCreate) SetCreateTime(v time.Time) *ContentEventCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *ContentEventCreate) SetNillableCreateTime(v *time.Time) *ContentEventCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *ContentEventCreate) SetUpdateTime(v time.Time) *ContentEventCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *ContentEventCreate) SetNillableUpdateTime(v *time.Time) *ContentEventCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *ContentEventCreate) SetTenantID(v uuid.UUID) *ContentEventCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetContentIdentityID sets the "content_identity_id" field.
+func (_c *ContentEventCreate) SetContentIdentityID(v uuid.UUID) *ContentEventCreate {
+	_c.mutation.SetContentIdentityID(v)
+	return _c
+}
+
+// SetScanID sets the "scan_id" field.
+func (_c *ContentEventCreate) SetScanID(v uuid.UUID) *ContentEventCreate {
+	_c.mutation.SetScanID(v)
+	return _c
+}
+
+// SetMachineID sets the "machine_id" field.
+func (_c *ContentEventCreate) SetMachineID(v uuid.UUID) *ContentEventCreate {
+	_c.mutation.SetMachineID(v)
+	return _c
+}
+
+// SetPath sets the "path" field.
+func (_c *ContentEventCreate) SetPath(v string) *ContentEventCreate {
+	_c.mutation.SetPath(v)
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *ContentEventCreate) SetKind(v contentevent.Kind) *ContentEventCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetOccurredAt sets the "occurred_at" field.
+func (_c *ContentEventCreate) SetOccurredAt(v time.Time) *ContentEventCreate {
+	_c.mutation.SetOccurredAt(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ContentEventCreate) SetID(v uuid.UUID) *ContentEventCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ContentEventCreate) SetNillableID(v *uuid.UUID) *ContentEventCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_c *ContentEventCreate) SetTenant(v *Tenant) *ContentEventCreate {
+	return _c.SetTenantID(v.ID)
+}
+
+// SetContentIdentity sets the "content_identity" edge to the ContentIdentity entity.
+func (_c *ContentEventCreate) SetContentIdentity(v *ContentIdentity) *ContentEventCreate {
+	return _c.SetContentIdentityID(v.ID)
+}
+
+// SetScan sets the "scan" edge to the Scan entity.
+func (_c *ContentEventCreate) SetScan(v *Scan) *ContentEventCreate {
+	return _c.SetScanID(v.ID)
+}
+
+// SetMachine sets the "machine" edge to the Machine entity.
+func (_c *ContentEventCreate) SetMachine(v *Machine) *ContentEventCreate {
+	return _c.SetMachineID(v.ID)
+}
+
+// Mutation returns the ContentEventMutation object of the builder.
+func (_c *ContentEventCreate) Mutation() *ContentEventMutation {
+	return _c.mutation
+}
+
+// Save creates the ContentEvent in the database.
+func (_c *ContentEventCreate) Save(ctx context.Context) (*ContentEvent, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ContentEventCreate) SaveX(ctx context.Context) *ContentEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ContentEventCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ContentEventCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ContentEventCreate) defaults() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		if contentevent.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized contentevent.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
+		v := contentevent.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		if contentevent.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized contentevent.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := contentevent.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if contentevent.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized contentevent.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := contentevent.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ContentEventCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "ContentEvent.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "ContentEvent.update_time"`)}
+	}
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "ContentEvent.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.ContentIdentityID(); !ok {
+		return &ValidationError{Name: "content_identity_id", err: errors.New(`ent: missing required field "ContentEvent.content_identity_id"`)}
+	}
+	if _, ok := _c.mutation.ScanID(); !ok {
+		return &ValidationError{Name: "scan_id", err: errors.New(`ent: missing required field "ContentEvent.scan_id"`)}
+	}
+	if _, ok := _c.mutation.MachineID(); !ok {
+		return &ValidationError{Name: "machine_id", err: errors.New(`ent: missing required field "ContentEvent.machine_id"`)}
+	}
+	if _, ok := _c.mutation.Path(); !ok {
+		return &ValidationError{Name: "path", err: errors.New(`ent: missing required field "ContentEvent.path"`)}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "ContentEvent.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := contentevent.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "ContentEvent.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.OccurredAt(); !ok {
+		return &ValidationError{Name: "occurred_at", err: errors.New(`ent: missing required field "ContentEvent.occurred_at"`)}
+	}
+	if len(_c.mutation.TenantIDs()) == 0 {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required edge "ContentEvent.tenant"`)}
+	}
+	if len(_c.mutation.ContentIdentityIDs()) == 0 {
+		return &ValidationError{Name: "content_identity", err: errors.New(`ent: missing required edge "ContentEvent.content_identity"`)}
+	}
+	if len(_c.mutation.ScanIDs()) == 0 {
+		return &ValidationError{Name: "scan", err: errors.New(`ent: missing required edge "ContentEvent.scan"`)}
+	}
+	if len(_c.mutation.MachineIDs()) == 0 {
+		return &ValidationError{Name: "machine", err: errors.New(`ent: missing required edge "ContentEvent.machine"`)}
+	}
+	return nil
+}
+
+func (_c *ContentEventCreate) sqlSave(ctx context.Context) (*ContentEvent, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ContentEventCreate) createSpec() (*ContentEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ContentEvent{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(contentevent.Table, sqlgraph.NewFieldSpec(contentevent.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(contentevent.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(contentevent.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.Path(); ok {
+		_spec.SetField(contentevent.FieldPath, field.TypeString, value)
+		_node.Path = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(contentevent.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.OccurredAt(); ok {
+		_spec.SetField(contentevent.FieldOccurredAt, field.TypeTime, value)
+		_node.OccurredAt = value
+	}
+	if nodes := _c.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contentevent.TenantTable,
+			Columns: []string{contentevent.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TenantID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ContentIdentityIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contentevent.ContentIdentityTable,
+			Columns: []string{contentevent.ContentIdentityColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentidentity.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ContentIdentityID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ScanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contentevent.ScanTable,
+			Columns: []string{contentevent.ScanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scan.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ScanID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MachineIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contentevent.MachineTable,
+			Columns: []string{contentevent.MachineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(machine.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MachineID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ContentEventCreateBulk is the builder for creating many ContentEvent entities in bulk.
+type ContentEventCreateBulk struct {
+	config
+	err      error
+	builders []*ContentEventCreate
+}
+
+// Save creates the ContentEvent entities in the database.
+func (_c *ContentEventCreateBulk) Save(ctx context.Context) ([]*ContentEvent, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ContentEvent, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ContentEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ContentEventCreateBulk) SaveX(ctx context.Context) []*ContentEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ContentEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ContentEventCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
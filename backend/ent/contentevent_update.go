@@ -0,0 +1,813 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/mcmx/duplynx/ent/contentevent"
+	"github.com/mcmx/duplynx/ent/contentidentity"
+	"github.com/mcmx/duplynx/ent/machine"
+	"github.com/mcmx/duplynx/ent/predicate"
+	"github.com/mcmx/duplynx/ent/scan"
+	"github.com/mcmx/duplynx/ent/tenant"
+)
+
+// ContentEventUpdate is the builder for updating ContentEvent entities.
+type ContentEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ContentEventMutation
+}
+
+// Where appends a list predicates to the ContentEventUpdate builder.
+func (_u *ContentEventUpdate) Where(ps ...predicate.ContentEvent) *ContentEventUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ContentEventUpdate) SetUpdateTime(v time.Time) *ContentEventUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *ContentEventUpdate) SetTenantID(v uuid.UUID) *ContentEventUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *ContentEventUpdate) SetNillableTenantID(v *uuid.UUID) *ContentEventUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetContentIdentityID sets the "content_identity_id" field.
+func (_u *ContentEventUpdate) SetContentIdentityID(v uuid.UUID) *ContentEventUpdate {
+	_u.mutation.SetContentIdentityID(v)
+	return _u
+}
+
+// SetNillableContentIdentityID sets the "content_identity_id" field if the given value is not nil.
+func (_u *ContentEventUpdate) SetNillableContentIdentityID(v *uuid.UUID) *ContentEventUpdate {
+	if v != nil {
+		_u.SetContentIdentityID(*v)
+	}
+	return _u
+}
+
+// SetScanID sets the "scan_id" field.
+func (_u *ContentEventUpdate) SetScanID(v uuid.UUID) *ContentEventUpdate {
+	_u.mutation.SetScanID(v)
+	return _u
+}
+
+// SetNillableScanID sets the "scan_id" field if the given value is not nil.
+func (_u *ContentEventUpdate) SetNillableScanID(v *uuid.UUID) *ContentEventUpdate {
+	if v != nil {
+		_u.SetScanID(*v)
+	}
+	return _u
+}
+
+// SetMachineID sets the "machine_id" field.
+func (_u *ContentEventUpdate) SetMachineID(v uuid.UUID) *ContentEventUpdate {
+	_u.mutation.SetMachineID(v)
+	return _u
+}
+
+// SetNillableMachineID sets the "machine_id" field if the given value is not nil.
+func (_u *ContentEventUpdate) SetNillableMachineID(v *uuid.UUID) *ContentEventUpdate {
+	if v != nil {
+		_u.SetMachineID(*v)
+	}
+	return _u
+}
+
+// SetPath sets the "path" field.
+func (_u *ContentEventUpdate) SetPath(v string) *ContentEventUpdate {
+	_u.mutation.SetPath(v)
+	return _u
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (_u *ContentEventUpdate) SetNillablePath(v *string) *ContentEventUpdate {
+	if v != nil {
+		_u.SetPath(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *ContentEventUpdate) SetKind(v contentevent.Kind) *ContentEventUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *ContentEventUpdate) SetNillableKind(v *contentevent.Kind) *ContentEventUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetOccurredAt sets the "occurred_at" field.
+func (_u *ContentEventUpdate) SetOccurredAt(v time.Time) *ContentEventUpdate {
+	_u.mutation.SetOccurredAt(v)
+	return _u
+}
+
+// SetNillableOccurredAt sets the "occurred_at" field if the given value is not nil.
+func (_u *ContentEventUpdate) SetNillableOccurredAt(v *time.Time) *ContentEventUpdate {
+	if v != nil {
+		_u.SetOccurredAt(*v)
+	}
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *ContentEventUpdate) SetTenant(v *Tenant) *ContentEventUpdate {
+	return _u.SetTenantID(v.ID)
+}
+
+// SetContentIdentity sets the "content_identity" edge to the ContentIdentity entity.
+func (_u *ContentEventUpdate) SetContentIdentity(v *ContentIdentity) *ContentEventUpdate {
+	return _u.SetContentIdentityID(v.ID)
+}
+
+// SetScan sets the "scan" edge to the Scan entity.
+func (_u *ContentEventUpdate) SetScan(v *Scan) *ContentEventUpdate {
+	return _u.SetScanID(v.ID)
+}
+
+// SetMachine sets the "machine" edge to the Machine entity.
+func (_u *ContentEventUpdate) SetMachine(v *Machine) *ContentEventUpdate {
+	return _u.SetMachineID(v.ID)
+}
+
+// Mutation returns the ContentEventMutation object of the builder.
+func (_u *ContentEventUpdate) Mutation() *ContentEventMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *ContentEventUpdate) ClearTenant() *ContentEventUpdate {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearContentIdentity clears the "content_identity" edge to the ContentIdentity entity.
+func (_u *ContentEventUpdate) ClearContentIdentity() *ContentEventUpdate {
+	_u.mutation.ClearContentIdentity()
+	return _u
+}
+
+// ClearScan clears the "scan" edge to the Scan entity.
+func (_u *ContentEventUpdate) ClearScan() *ContentEventUpdate {
+	_u.mutation.ClearScan()
+	return _u
+}
+
+// ClearMachine clears the "machine" edge to the Machine entity.
+func (_u *ContentEventUpdate) ClearMachine() *ContentEventUpdate {
+	_u.mutation.ClearMachine()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ContentEventUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ContentEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ContentEventUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ContentEventUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ContentEventUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if contentevent.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized contentevent.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := contentevent.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ContentEventUpdate) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := contentevent.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "ContentEvent.kind": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ContentEvent.tenant"`)
+	}
+	if _u.mutation.ContentIdentityCleared() && len(_u.mutation.ContentIdentityIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ContentEvent.content_identity"`)
+	}
+	if _u.mutation.ScanCleared() && len(_u.mutation.ScanIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ContentEvent.scan"`)
+	}
+	if _u.mutation.MachineCleared() && len(_u.mutation.MachineIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ContentEvent.machine"`)
+	}
+	return nil
+}
+
+func (_u *ContentEventUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(contentevent.Table, contentevent.Columns, sqlgraph.NewFieldSpec(contentevent.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(contentevent.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Path(); ok {
+		_spec.SetField(contentevent.FieldPath, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(contentevent.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.OccurredAt(); ok {
+		_spec.SetField(contentevent.FieldOccurredAt, field.TypeTime, value)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contentevent.TenantTable,
+			Columns: []string{contentevent.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contentevent.TenantTable,
+			Columns: []string{contentevent.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ContentIdentityCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contentevent.ContentIdentityTable,
+			Columns: []string{contentevent.ContentIdentityColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentidentity.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ContentIdentityIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contentevent.ContentIdentityTable,
+			Columns: []string{contentevent.ContentIdentityColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentidentity.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ScanCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contentevent.ScanTable,
+			Columns: []string{contentevent.ScanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scan.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ScanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contentevent.ScanTable,
+			Columns: []string{contentevent.ScanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scan.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MachineCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contentevent.MachineTable,
+			Columns: []string{contentevent.MachineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(machine.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MachineIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contentevent.MachineTable,
+			Columns: []string{contentevent.MachineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(machine.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{contentevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ContentEventUpdateOne is the builder for updating a single ContentEvent entity.
+type ContentEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ContentEventMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ContentEventUpdateOne) SetUpdateTime(v time.Time) *ContentEventUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *ContentEventUpdateOne) SetTenantID(v uuid.UUID) *ContentEventUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *ContentEventUpdateOne) SetNillableTenantID(v *uuid.UUID) *ContentEventUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetContentIdentityID sets the "content_identity_id" field.
+func (_u *ContentEventUpdateOne) SetContentIdentityID(v uuid.UUID) *ContentEventUpdateOne {
+	_u.mutation.SetContentIdentityID(v)
+	return _u
+}
+
+// SetNillableContentIdentityID sets the "content_identity_id" field if the given value is not nil.
+func (_u *ContentEventUpdateOne) SetNillableContentIdentityID(v *uuid.UUID) *ContentEventUpdateOne {
+	if v != nil {
+		_u.SetContentIdentityID(*v)
+	}
+	return _u
+}
+
+// SetScanID sets the "scan_id" field.
+func (_u *ContentEventUpdateOne) SetScanID(v uuid.UUID) *ContentEventUpdateOne {
+	_u.mutation.SetScanID(v)
+	return _u
+}
+
+// SetNillableScanID sets the "scan_id" field if the given value is not nil.
+func (_u *ContentEventUpdateOne) SetNillableScanID(v *uuid.UUID) *ContentEventUpdateOne {
+	if v != nil {
+		_u.SetScanID(*v)
+	}
+	return _u
+}
+
+// SetMachineID sets the "machine_id" field.
+func (_u *ContentEventUpdateOne) SetMachineID(v uuid.UUID) *ContentEventUpdateOne {
+	_u.mutation.SetMachineID(v)
+	return _u
+}
+
+// SetNillableMachineID sets the "machine_id" field if the given value is not nil.
+func (_u *ContentEventUpdateOne) SetNillableMachineID(v *uuid.UUID) *ContentEventUpdateOne {
+	if v != nil {
+		_u.SetMachineID(*v)
+	}
+	return _u
+}
+
+// SetPath sets the "path" field.
+func (_u *ContentEventUpdateOne) SetPath(v string) *ContentEventUpdateOne {
+	_u.mutation.SetPath(v)
+	return _u
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (_u *ContentEventUpdateOne) SetNillablePath(v *string) *ContentEventUpdateOne {
+	if v != nil {
+		_u.SetPath(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *ContentEventUpdateOne) SetKind(v contentevent.Kind) *ContentEventUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *ContentEventUpdateOne) SetNillableKind(v *contentevent.Kind) *ContentEventUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetOccurredAt sets the "occurred_at" field.
+func (_u *ContentEventUpdateOne) SetOccurredAt(v time.Time) *ContentEventUpdateOne {
+	_u.mutation.SetOccurredAt(v)
+	return _u
+}
+
+// SetNillableOccurredAt sets the "occurred_at" field if the given value is not nil.
+func (_u *ContentEventUpdateOne) SetNillableOccurredAt(v *time.Time) *ContentEventUpdateOne {
+	if v != nil {
+		_u.SetOccurredAt(*v)
+	}
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *ContentEventUpdateOne) SetTenant(v *Tenant) *ContentEventUpdateOne {
+	return _u.SetTenantID(v.ID)
+}
+
+// SetContentIdentity sets the "content_identity" edge to the ContentIdentity entity.
+func (_u *ContentEventUpdateOne) SetContentIdentity(v *ContentIdentity) *ContentEventUpdateOne {
+	return _u.SetContentIdentityID(v.ID)
+}
+
+// SetScan sets the "scan" edge to the Scan entity.
+func (_u *ContentEventUpdateOne) SetScan(v *Scan) *ContentEventUpdateOne {
+	return _u.SetScanID(v.ID)
+}
+
+// SetMachine sets the "machine" edge to the Machine entity.
+func (_u *ContentEventUpdateOne) SetMachine(v *Machine) *ContentEventUpdateOne {
+	return _u.SetMachineID(v.ID)
+}
+
+// Mutation returns the ContentEventMutation object of the builder.
+func (_u *ContentEventUpdateOne) Mutation() *ContentEventMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *ContentEventUpdateOne) ClearTenant() *ContentEventUpdateOne {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearContentIdentity clears the "content_identity" edge to the ContentIdentity entity.
+func (_u *ContentEventUpdateOne) ClearContentIdentity() *ContentEventUpdateOne {
+	_u.mutation.ClearContentIdentity()
+	return _u
+}
+
+// ClearScan clears the "scan" edge to the Scan entity.
+func (_u *ContentEventUpdateOne) ClearScan() *ContentEventUpdateOne {
+	_u.mutation.ClearScan()
+	return _u
+}
+
+// ClearMachine clears the "machine" edge to the Machine entity.
+func (_u *ContentEventUpdateOne) ClearMachine() *ContentEventUpdateOne {
+	_u.mutation.ClearMachine()
+	return _u
+}
+
+// Where appends a list predicates to the ContentEventUpdate builder.
+func (_u *ContentEventUpdateOne) Where(ps ...predicate.ContentEvent) *ContentEventUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ContentEventUpdateOne) Select(field string, fields ...string) *ContentEventUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ContentEvent entity.
+func (_u *ContentEventUpdateOne) Save(ctx context.Context) (*ContentEvent, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ContentEventUpdateOne) SaveX(ctx context.Context) *ContentEvent {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ContentEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ContentEventUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ContentEventUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if contentevent.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized contentevent.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := contentevent.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ContentEventUpdateOne) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := contentevent.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "ContentEvent.kind": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ContentEvent.tenant"`)
+	}
+	if _u.mutation.ContentIdentityCleared() && len(_u.mutation.ContentIdentityIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ContentEvent.content_identity"`)
+	}
+	if _u.mutation.ScanCleared() && len(_u.mutation.ScanIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ContentEvent.scan"`)
+	}
+	if _u.mutation.MachineCleared() && len(_u.mutation.MachineIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ContentEvent.machine"`)
+	}
+	return nil
+}
+
+func (_u *ContentEventUpdateOne) sqlSave(ctx context.Context) (_node *ContentEvent, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(contentevent.Table, contentevent.Columns, sqlgraph.NewFieldSpec(contentevent.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ContentEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, contentevent.FieldID)
+		for _, f := range fields {
+			if !contentevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != contentevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(contentevent.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Path(); ok {
+		_spec.SetField(contentevent.FieldPath, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(contentevent.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.OccurredAt(); ok {
+		_spec.SetField(contentevent.FieldOccurredAt, field.TypeTime, value)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contentevent.TenantTable,
+			Columns: []string{contentevent.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contentevent.TenantTable,
+			Columns: []string{contentevent.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ContentIdentityCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contentevent.ContentIdentityTable,
+			Columns: []string{contentevent.ContentIdentityColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentidentity.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ContentIdentityIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contentevent.ContentIdentityTable,
+			Columns: []string{contentevent.ContentIdentityColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentidentity.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ScanCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contentevent.ScanTable,
+			Columns: []string{contentevent.ScanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scan.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ScanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contentevent.ScanTable,
+			Columns: []string{contentevent.ScanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scan.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MachineCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contentevent.MachineTable,
+			Columns: []string{contentevent.MachineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(machine.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MachineIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contentevent.MachineTable,
+			Columns: []string{contentevent.MachineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(machine.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ContentEvent{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{contentevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,264 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/mcmx/duplynx/ent/contentidentity"
+	"github.com/mcmx/duplynx/ent/machine"
+	"github.com/mcmx/duplynx/ent/tenant"
+)
+
+// ContentIdentity is the model entity for the ContentIdentity schema.
+type ContentIdentity struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// Hash holds the value of the "hash" field.
+	Hash string `json:"hash,omitempty"`
+	// KeeperMachineID holds the value of the "keeper_machine_id" field.
+	KeeperMachineID uuid.UUID `json:"keeper_machine_id,omitempty"`
+	// ResolvedAt holds the value of the "resolved_at" field.
+	ResolvedAt time.Time `json:"resolved_at,omitempty"`
+	// FirstSeenAt holds the value of the "first_seen_at" field.
+	FirstSeenAt time.Time `json:"first_seen_at,omitempty"`
+	// LastSeenAt holds the value of the "last_seen_at" field.
+	LastSeenAt time.Time `json:"last_seen_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ContentIdentityQuery when eager-loading is set.
+	Edges        ContentIdentityEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ContentIdentityEdges holds the relations/edges for other nodes in the graph.
+type ContentIdentityEdges struct {
+	// Tenant holds the value of the tenant edge.
+	Tenant *Tenant `json:"tenant,omitempty"`
+	// KeeperMachine holds the value of the keeper_machine edge.
+	KeeperMachine *Machine `json:"keeper_machine,omitempty"`
+	// DuplicateGroups holds the value of the duplicate_groups edge.
+	DuplicateGroups []*DuplicateGroup `json:"duplicate_groups,omitempty"`
+	// Events holds the value of the events edge.
+	Events []*ContentEvent `json:"events,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// TenantOrErr returns the Tenant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ContentIdentityEdges) TenantOrErr() (*Tenant, error) {
+	if e.Tenant != nil {
+		return e.Tenant, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: tenant.Label}
+	}
+	return nil, &NotLoadedError{edge: "tenant"}
+}
+
+// KeeperMachineOrErr returns the KeeperMachine value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ContentIdentityEdges) KeeperMachineOrErr() (*Machine, error) {
+	if e.KeeperMachine != nil {
+		return e.KeeperMachine, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: machine.Label}
+	}
+	return nil, &NotLoadedError{edge: "keeper_machine"}
+}
+
+// DuplicateGroupsOrErr returns the DuplicateGroups value or an error if the edge
+// was not loaded in eager-loading.
+func (e ContentIdentityEdges) DuplicateGroupsOrErr() ([]*DuplicateGroup, error) {
+	if e.loadedTypes[2] {
+		return e.DuplicateGroups, nil
+	}
+	return nil, &NotLoadedError{edge: "duplicate_groups"}
+}
+
+// EventsOrErr returns the Events value or an error if the edge
+// was not loaded in eager-loading.
+func (e ContentIdentityEdges) EventsOrErr() ([]*ContentEvent, error) {
+	if e.loadedTypes[3] {
+		return e.Events, nil
+	}
+	return nil, &NotLoadedError{edge: "events"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ContentIdentity) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case contentidentity.FieldHash:
+			values[i] = new(sql.NullString)
+		case contentidentity.FieldCreateTime, contentidentity.FieldUpdateTime, contentidentity.FieldResolvedAt, contentidentity.FieldFirstSeenAt, contentidentity.FieldLastSeenAt:
+			values[i] = new(sql.NullTime)
+		case contentidentity.FieldID, contentidentity.FieldTenantID, contentidentity.FieldKeeperMachineID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ContentIdentity fields.
+func (_m *ContentIdentity) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case contentidentity.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case contentidentity.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case contentidentity.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = value.Time
+			}
+		case contentidentity.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case contentidentity.FieldHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hash", values[i])
+			} else if value.Valid {
+				_m.Hash = value.String
+			}
+		case contentidentity.FieldKeeperMachineID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field keeper_machine_id", values[i])
+			} else if value != nil {
+				_m.KeeperMachineID = *value
+			}
+		case contentidentity.FieldResolvedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field resolved_at", values[i])
+			} else if value.Valid {
+				_m.ResolvedAt = value.Time
+			}
+		case contentidentity.FieldFirstSeenAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_seen_at", values[i])
+			} else if value.Valid {
+				_m.FirstSeenAt = value.Time
+			}
+		case contentidentity.FieldLastSeenAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_seen_at", values[i])
+			} else if value.Valid {
+				_m.LastSeenAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ContentIdentity.
+// This includes values selected through modifiers, order, etc.
+func (_m *ContentIdentity) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTenant queries the "tenant" edge of the ContentIdentity entity.
+func (_m *ContentIdentity) QueryTenant() *TenantQuery {
+	return NewContentIdentityClient(_m.config).QueryTenant(_m)
+}
+
+// QueryKeeperMachine queries the "keeper_machine" edge of the ContentIdentity entity.
+func (_m *ContentIdentity) QueryKeeperMachine() *MachineQuery {
+	return NewContentIdentityClient(_m.config).QueryKeeperMachine(_m)
+}
+
+// QueryDuplicateGroups queries the "duplicate_groups" edge of the ContentIdentity entity.
+func (_m *ContentIdentity) QueryDuplicateGroups() *DuplicateGroupQuery {
+	return NewContentIdentityClient(_m.config).QueryDuplicateGroups(_m)
+}
+
+// QueryEvents queries the "events" edge of the ContentIdentity entity.
+func (_m *ContentIdentity) QueryEvents() *ContentEventQuery {
+	return NewContentIdentityClient(_m.config).QueryEvents(_m)
+}
+
+// Update returns a builder for updating this ContentIdentity.
+// Note that you need to call ContentIdentity.Unwrap() before calling this method if this ContentIdentity
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ContentIdentity) Update() *ContentIdentityUpdateOne {
+	return NewContentIdentityClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ContentIdentity entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ContentIdentity) Unwrap() *ContentIdentity {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ContentIdentity is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ContentIdentity) String() string {
+	var builder strings.Builder
+	builder.WriteString("ContentIdentity(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("hash=")
+	builder.WriteString(_m.Hash)
+	builder.WriteString(", ")
+	builder.WriteString("keeper_machine_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.KeeperMachineID))
+	builder.WriteString(", ")
+	builder.WriteString("resolved_at=")
+	builder.WriteString(_m.ResolvedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("first_seen_at=")
+	builder.WriteString(_m.FirstSeenAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("last_seen_at=")
+	builder.WriteString(_m.LastSeenAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ContentIdentities is a parsable slice of ContentIdentity.
+type ContentIdentities []*ContentIdentity
@@ -0,0 +1,529 @@
+// Code generated by ent, DO NOT EDIT.
+
+package contentidentity
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/mcmx/duplynx/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldEQ(FieldTenantID, v))
+}
+
+// Hash applies equality check predicate on the "hash" field. It's identical to HashEQ.
+func Hash(v string) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldEQ(FieldHash, v))
+}
+
+// KeeperMachineID applies equality check predicate on the "keeper_machine_id" field. It's identical to KeeperMachineIDEQ.
+func KeeperMachineID(v uuid.UUID) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldEQ(FieldKeeperMachineID, v))
+}
+
+// ResolvedAt applies equality check predicate on the "resolved_at" field. It's identical to ResolvedAtEQ.
+func ResolvedAt(v time.Time) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// FirstSeenAt applies equality check predicate on the "first_seen_at" field. It's identical to FirstSeenAtEQ.
+func FirstSeenAt(v time.Time) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldEQ(FieldFirstSeenAt, v))
+}
+
+// LastSeenAt applies equality check predicate on the "last_seen_at" field. It's identical to LastSeenAtEQ.
+func LastSeenAt(v time.Time) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldEQ(FieldLastSeenAt, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// HashEQ applies the EQ predicate on the "hash" field.
+func HashEQ(v string) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldEQ(FieldHash, v))
+}
+
+// HashNEQ applies the NEQ predicate on the "hash" field.
+func HashNEQ(v string) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldNEQ(FieldHash, v))
+}
+
+// HashIn applies the In predicate on the "hash" field.
+func HashIn(vs ...string) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldIn(FieldHash, vs...))
+}
+
+// HashNotIn applies the NotIn predicate on the "hash" field.
+func HashNotIn(vs ...string) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldNotIn(FieldHash, vs...))
+}
+
+// HashGT applies the GT predicate on the "hash" field.
+func HashGT(v string) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldGT(FieldHash, v))
+}
+
+// HashGTE applies the GTE predicate on the "hash" field.
+func HashGTE(v string) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldGTE(FieldHash, v))
+}
+
+// HashLT applies the LT predicate on the "hash" field.
+func HashLT(v string) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldLT(FieldHash, v))
+}
+
+// HashLTE applies the LTE predicate on the "hash" field.
+func HashLTE(v string) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldLTE(FieldHash, v))
+}
+
+// HashContains applies the Contains predicate on the "hash" field.
+func HashContains(v string) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldContains(FieldHash, v))
+}
+
+// HashHasPrefix applies the HasPrefix predicate on the "hash" field.
+func HashHasPrefix(v string) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldHasPrefix(FieldHash, v))
+}
+
+// HashHasSuffix applies the HasSuffix predicate on the "hash" field.
+func HashHasSuffix(v string) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldHasSuffix(FieldHash, v))
+}
+
+// HashEqualFold applies the EqualFold predicate on the "hash" field.
+func HashEqualFold(v string) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldEqualFold(FieldHash, v))
+}
+
+// HashContainsFold applies the ContainsFold predicate on the "hash" field.
+func HashContainsFold(v string) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldContainsFold(FieldHash, v))
+}
+
+// KeeperMachineIDEQ applies the EQ predicate on the "keeper_machine_id" field.
+func KeeperMachineIDEQ(v uuid.UUID) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldEQ(FieldKeeperMachineID, v))
+}
+
+// KeeperMachineIDNEQ applies the NEQ predicate on the "keeper_machine_id" field.
+func KeeperMachineIDNEQ(v uuid.UUID) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldNEQ(FieldKeeperMachineID, v))
+}
+
+// KeeperMachineIDIn applies the In predicate on the "keeper_machine_id" field.
+func KeeperMachineIDIn(vs ...uuid.UUID) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldIn(FieldKeeperMachineID, vs...))
+}
+
+// KeeperMachineIDNotIn applies the NotIn predicate on the "keeper_machine_id" field.
+func KeeperMachineIDNotIn(vs ...uuid.UUID) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldNotIn(FieldKeeperMachineID, vs...))
+}
+
+// KeeperMachineIDIsNil applies the IsNil predicate on the "keeper_machine_id" field.
+func KeeperMachineIDIsNil() predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldIsNull(FieldKeeperMachineID))
+}
+
+// KeeperMachineIDNotNil applies the NotNil predicate on the "keeper_machine_id" field.
+func KeeperMachineIDNotNil() predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldNotNull(FieldKeeperMachineID))
+}
+
+// ResolvedAtEQ applies the EQ predicate on the "resolved_at" field.
+func ResolvedAtEQ(v time.Time) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtNEQ applies the NEQ predicate on the "resolved_at" field.
+func ResolvedAtNEQ(v time.Time) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldNEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtIn applies the In predicate on the "resolved_at" field.
+func ResolvedAtIn(vs ...time.Time) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtNotIn applies the NotIn predicate on the "resolved_at" field.
+func ResolvedAtNotIn(vs ...time.Time) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldNotIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtGT applies the GT predicate on the "resolved_at" field.
+func ResolvedAtGT(v time.Time) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldGT(FieldResolvedAt, v))
+}
+
+// ResolvedAtGTE applies the GTE predicate on the "resolved_at" field.
+func ResolvedAtGTE(v time.Time) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldGTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtLT applies the LT predicate on the "resolved_at" field.
+func ResolvedAtLT(v time.Time) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldLT(FieldResolvedAt, v))
+}
+
+// ResolvedAtLTE applies the LTE predicate on the "resolved_at" field.
+func ResolvedAtLTE(v time.Time) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldLTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtIsNil applies the IsNil predicate on the "resolved_at" field.
+func ResolvedAtIsNil() predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldIsNull(FieldResolvedAt))
+}
+
+// ResolvedAtNotNil applies the NotNil predicate on the "resolved_at" field.
+func ResolvedAtNotNil() predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldNotNull(FieldResolvedAt))
+}
+
+// FirstSeenAtEQ applies the EQ predicate on the "first_seen_at" field.
+func FirstSeenAtEQ(v time.Time) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldEQ(FieldFirstSeenAt, v))
+}
+
+// FirstSeenAtNEQ applies the NEQ predicate on the "first_seen_at" field.
+func FirstSeenAtNEQ(v time.Time) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldNEQ(FieldFirstSeenAt, v))
+}
+
+// FirstSeenAtIn applies the In predicate on the "first_seen_at" field.
+func FirstSeenAtIn(vs ...time.Time) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldIn(FieldFirstSeenAt, vs...))
+}
+
+// FirstSeenAtNotIn applies the NotIn predicate on the "first_seen_at" field.
+func FirstSeenAtNotIn(vs ...time.Time) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldNotIn(FieldFirstSeenAt, vs...))
+}
+
+// FirstSeenAtGT applies the GT predicate on the "first_seen_at" field.
+func FirstSeenAtGT(v time.Time) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldGT(FieldFirstSeenAt, v))
+}
+
+// FirstSeenAtGTE applies the GTE predicate on the "first_seen_at" field.
+func FirstSeenAtGTE(v time.Time) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldGTE(FieldFirstSeenAt, v))
+}
+
+// FirstSeenAtLT applies the LT predicate on the "first_seen_at" field.
+func FirstSeenAtLT(v time.Time) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldLT(FieldFirstSeenAt, v))
+}
+
+// FirstSeenAtLTE applies the LTE predicate on the "first_seen_at" field.
+func FirstSeenAtLTE(v time.Time) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldLTE(FieldFirstSeenAt, v))
+}
+
+// LastSeenAtEQ applies the EQ predicate on the "last_seen_at" field.
+func LastSeenAtEQ(v time.Time) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldEQ(FieldLastSeenAt, v))
+}
+
+// LastSeenAtNEQ applies the NEQ predicate on the "last_seen_at" field.
+func LastSeenAtNEQ(v time.Time) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldNEQ(FieldLastSeenAt, v))
+}
+
+// LastSeenAtIn applies the In predicate on the "last_seen_at" field.
+func LastSeenAtIn(vs ...time.Time) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldIn(FieldLastSeenAt, vs...))
+}
+
+// LastSeenAtNotIn applies the NotIn predicate on the "last_seen_at" field.
+func LastSeenAtNotIn(vs ...time.Time) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldNotIn(FieldLastSeenAt, vs...))
+}
+
+// LastSeenAtGT applies the GT predicate on the "last_seen_at" field.
+func LastSeenAtGT(v time.Time) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldGT(FieldLastSeenAt, v))
+}
+
+// LastSeenAtGTE applies the GTE predicate on the "last_seen_at" field.
+func LastSeenAtGTE(v time.Time) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldGTE(FieldLastSeenAt, v))
+}
+
+// LastSeenAtLT applies the LT predicate on the "last_seen_at" field.
+func LastSeenAtLT(v time.Time) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldLT(FieldLastSeenAt, v))
+}
+
+// LastSeenAtLTE applies the LTE predicate on the "last_seen_at" field.
+func LastSeenAtLTE(v time.Time) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.FieldLTE(FieldLastSeenAt, v))
+}
+
+// HasTenant applies the HasEdge predicate on the "tenant" edge.
+func HasTenant() predicate.ContentIdentity {
+	return predicate.ContentIdentity(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TenantTable, TenantColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTenantWith applies the HasEdge predicate on the "tenant" edge with a given conditions (other predicates).
+func HasTenantWith(preds ...predicate.Tenant) predicate.ContentIdentity {
+	return predicate.ContentIdentity(func(s *sql.Selector) {
+		step := newTenantStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasKeeperMachine applies the HasEdge predicate on the "keeper_machine" edge.
+func HasKeeperMachine() predicate.ContentIdentity {
+	return predicate.ContentIdentity(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, KeeperMachineTable, KeeperMachineColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasKeeperMachineWith applies the HasEdge predicate on the "keeper_machine" edge with a given conditions (other predicates).
+func HasKeeperMachineWith(preds ...predicate.Machine) predicate.ContentIdentity {
+	return predicate.ContentIdentity(func(s *sql.Selector) {
+		step := newKeeperMachineStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDuplicateGroups applies the HasEdge predicate on the "duplicate_groups" edge.
+func HasDuplicateGroups() predicate.ContentIdentity {
+	return predicate.ContentIdentity(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DuplicateGroupsTable, DuplicateGroupsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDuplicateGroupsWith applies the HasEdge predicate on the "duplicate_groups" edge with a given conditions (other predicates).
+func HasDuplicateGroupsWith(preds ...predicate.DuplicateGroup) predicate.ContentIdentity {
+	return predicate.ContentIdentity(func(s *sql.Selector) {
+		step := newDuplicateGroupsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEvents applies the HasEdge predicate on the "events" edge.
+func HasEvents() predicate.ContentIdentity {
+	return predicate.ContentIdentity(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, EventsTable, EventsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEventsWith applies the HasEdge predicate on the "events" edge with a given conditions (other predicates).
+func HasEventsWith(preds ...predicate.ContentEvent) predicate.ContentIdentity {
+	return predicate.ContentIdentity(func(s *sql.Selector) {
+		step := newEventsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ContentIdentity) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ContentIdentity) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ContentIdentity) predicate.ContentIdentity {
+	return predicate.ContentIdentity(sql.NotPredicates(p))
+}
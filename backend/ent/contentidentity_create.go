@@ -0,0 +1,461 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/mcmx/duplynx/ent/contentevent"
+	"github.com/mcmx/duplynx/ent/contentidentity"
+	"github.com/mcmx/duplynx/ent/duplicategroup"
+	"github.com/mcmx/duplynx/ent/machine"
+	"github.com/mcmx/duplynx/ent/tenant"
+)
+
+// ContentIdentityCreate is the builder for creating a ContentIdentity entity.
+type ContentIdentityCreate struct {
+	config
+	mutation *ContentIdentityMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *ContentIdentityCreate) SetCreateTime(v time.Time) *ContentIdentityCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *ContentIdentityCreate) SetNillableCreateTime(v *time.Time) *ContentIdentityCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *ContentIdentityCreate) SetUpdateTime(v time.Time) *ContentIdentityCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *ContentIdentityCreate) SetNillableUpdateTime(v *time.Time) *ContentIdentityCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *ContentIdentityCreate) SetTenantID(v uuid.UUID) *ContentIdentityCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetHash sets the "hash" field.
+func (_c *ContentIdentityCreate) SetHash(v string) *ContentIdentityCreate {
+	_c.mutation.SetHash(v)
+	return _c
+}
+
+// SetKeeperMachineID sets the "keeper_machine_id" field.
+func (_c *ContentIdentityCreate) SetKeeperMachineID(v uuid.UUID) *ContentIdentityCreate {
+	_c.mutation.SetKeeperMachineID(v)
+	return _c
+}
+
+// SetNillableKeeperMachineID sets the "keeper_machine_id" field if the given value is not nil.
+func (_c *ContentIdentityCreate) SetNillableKeeperMachineID(v *uuid.UUID) *ContentIdentityCreate {
+	if v != nil {
+		_c.SetKeeperMachineID(*v)
+	}
+	return _c
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_c *ContentIdentityCreate) SetResolvedAt(v time.Time) *ContentIdentityCreate {
+	_c.mutation.SetResolvedAt(v)
+	return _c
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_c *ContentIdentityCreate) SetNillableResolvedAt(v *time.Time) *ContentIdentityCreate {
+	if v != nil {
+		_c.SetResolvedAt(*v)
+	}
+	return _c
+}
+
+// SetFirstSeenAt sets the "first_seen_at" field.
+func (_c *ContentIdentityCreate) SetFirstSeenAt(v time.Time) *ContentIdentityCreate {
+	_c.mutation.SetFirstSeenAt(v)
+	return _c
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_c *ContentIdentityCreate) SetLastSeenAt(v time.Time) *ContentIdentityCreate {
+	_c.mutation.SetLastSeenAt(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ContentIdentityCreate) SetID(v uuid.UUID) *ContentIdentityCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ContentIdentityCreate) SetNillableID(v *uuid.UUID) *ContentIdentityCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_c *ContentIdentityCreate) SetTenant(v *Tenant) *ContentIdentityCreate {
+	return _c.SetTenantID(v.ID)
+}
+
+// SetKeeperMachine sets the "keeper_machine" edge to the Machine entity.
+func (_c *ContentIdentityCreate) SetKeeperMachine(v *Machine) *ContentIdentityCreate {
+	return _c.SetKeeperMachineID(v.ID)
+}
+
+// AddDuplicateGroupIDs adds the "duplicate_groups" edge to the DuplicateGroup entity by IDs.
+func (_c *ContentIdentityCreate) AddDuplicateGroupIDs(ids ...uuid.UUID) *ContentIdentityCreate {
+	_c.mutation.AddDuplicateGroupIDs(ids...)
+	return _c
+}
+
+// AddDuplicateGroups adds the "duplicate_groups" edges to the DuplicateGroup entity.
+func (_c *ContentIdentityCreate) AddDuplicateGroups(v ...*DuplicateGroup) *ContentIdentityCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddDuplicateGroupIDs(ids...)
+}
+
+// AddEventIDs adds the "events" edge to the ContentEvent entity by IDs.
+func (_c *ContentIdentityCreate) AddEventIDs(ids ...uuid.UUID) *ContentIdentityCreate {
+	_c.mutation.AddEventIDs(ids...)
+	return _c
+}
+
+// AddEvents adds the "events" edges to the ContentEvent entity.
+func (_c *ContentIdentityCreate) AddEvents(v ...*ContentEvent) *ContentIdentityCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddEventIDs(ids...)
+}
+
+// Mutation returns the ContentIdentityMutation object of the builder.
+func (_c *ContentIdentityCreate) Mutation() *ContentIdentityMutation {
+	return _c.mutation
+}
+
+// Save creates the ContentIdentity in the database.
+func (_c *ContentIdentityCreate) Save(ctx context.Context) (*ContentIdentity, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ContentIdentityCreate) SaveX(ctx context.Context) *ContentIdentity {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ContentIdentityCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ContentIdentityCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ContentIdentityCreate) defaults() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		if contentidentity.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized contentidentity.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
+		v := contentidentity.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		if contentidentity.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized contentidentity.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := contentidentity.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if contentidentity.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized contentidentity.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := contentidentity.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ContentIdentityCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "ContentIdentity.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "ContentIdentity.update_time"`)}
+	}
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "ContentIdentity.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.Hash(); !ok {
+		return &ValidationError{Name: "hash", err: errors.New(`ent: missing required field "ContentIdentity.hash"`)}
+	}
+	if v, ok := _c.mutation.Hash(); ok {
+		if err := contentidentity.HashValidator(v); err != nil {
+			return &ValidationError{Name: "hash", err: fmt.Errorf(`ent: validator failed for field "ContentIdentity.hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.FirstSeenAt(); !ok {
+		return &ValidationError{Name: "first_seen_at", err: errors.New(`ent: missing required field "ContentIdentity.first_seen_at"`)}
+	}
+	if _, ok := _c.mutation.LastSeenAt(); !ok {
+		return &ValidationError{Name: "last_seen_at", err: errors.New(`ent: missing required field "ContentIdentity.last_seen_at"`)}
+	}
+	if len(_c.mutation.TenantIDs()) == 0 {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required edge "ContentIdentity.tenant"`)}
+	}
+	return nil
+}
+
+func (_c *ContentIdentityCreate) sqlSave(ctx context.Context) (*ContentIdentity, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ContentIdentityCreate) createSpec() (*ContentIdentity, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ContentIdentity{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(contentidentity.Table, sqlgraph.NewFieldSpec(contentidentity.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(contentidentity.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(contentidentity.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.Hash(); ok {
+		_spec.SetField(contentidentity.FieldHash, field.TypeString, value)
+		_node.Hash = value
+	}
+	if value, ok := _c.mutation.ResolvedAt(); ok {
+		_spec.SetField(contentidentity.FieldResolvedAt, field.TypeTime, value)
+		_node.ResolvedAt = value
+	}
+	if value, ok := _c.mutation.FirstSeenAt(); ok {
+		_spec.SetField(contentidentity.FieldFirstSeenAt, field.TypeTime, value)
+		_node.FirstSeenAt = value
+	}
+	if value, ok := _c.mutation.LastSeenAt(); ok {
+		_spec.SetField(contentidentity.FieldLastSeenAt, field.TypeTime, value)
+		_node.LastSeenAt = value
+	}
+	if nodes := _c.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contentidentity.TenantTable,
+			Columns: []string{contentidentity.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TenantID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.KeeperMachineIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contentidentity.KeeperMachineTable,
+			Columns: []string{contentidentity.KeeperMachineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(machine.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.KeeperMachineID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.DuplicateGroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   contentidentity.DuplicateGroupsTable,
+			Columns: []string{contentidentity.DuplicateGroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(duplicategroup.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.EventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   contentidentity.EventsTable,
+			Columns: []string{contentidentity.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ContentIdentityCreateBulk is the builder for creating many ContentIdentity entities in bulk.
+type ContentIdentityCreateBulk struct {
+	config
+	err      error
+	builders []*ContentIdentityCreate
+}
+
+// Save creates the ContentIdentity entities in the database.
+func (_c *ContentIdentityCreateBulk) Save(ctx context.Context) ([]*ContentIdentity, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ContentIdentity, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ContentIdentityMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ContentIdentityCreateBulk) SaveX(ctx context.Context) []*ContentIdentity {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ContentIdentityCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ContentIdentityCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,967 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/mcmx/duplynx/ent/contentevent"
+	"github.com/mcmx/duplynx/ent/contentidentity"
+	"github.com/mcmx/duplynx/ent/duplicategroup"
+	"github.com/mcmx/duplynx/ent/machine"
+	"github.com/mcmx/duplynx/ent/predicate"
+	"github.com/mcmx/duplynx/ent/tenant"
+)
+
+// ContentIdentityUpdate is the builder for updating ContentIdentity entities.
+type ContentIdentityUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ContentIdentityMutation
+}
+
+// Where appends a list predicates to the ContentIdentityUpdate builder.
+func (_u *ContentIdentityUpdate) Where(ps ...predicate.ContentIdentity) *ContentIdentityUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ContentIdentityUpdate) SetUpdateTime(v time.Time) *ContentIdentityUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *ContentIdentityUpdate) SetTenantID(v uuid.UUID) *ContentIdentityUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *ContentIdentityUpdate) SetNillableTenantID(v *uuid.UUID) *ContentIdentityUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetHash sets the "hash" field.
+func (_u *ContentIdentityUpdate) SetHash(v string) *ContentIdentityUpdate {
+	_u.mutation.SetHash(v)
+	return _u
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (_u *ContentIdentityUpdate) SetNillableHash(v *string) *ContentIdentityUpdate {
+	if v != nil {
+		_u.SetHash(*v)
+	}
+	return _u
+}
+
+// SetKeeperMachineID sets the "keeper_machine_id" field.
+func (_u *ContentIdentityUpdate) SetKeeperMachineID(v uuid.UUID) *ContentIdentityUpdate {
+	_u.mutation.SetKeeperMachineID(v)
+	return _u
+}
+
+// SetNillableKeeperMachineID sets the "keeper_machine_id" field if the given value is not nil.
+func (_u *ContentIdentityUpdate) SetNillableKeeperMachineID(v *uuid.UUID) *ContentIdentityUpdate {
+	if v != nil {
+		_u.SetKeeperMachineID(*v)
+	}
+	return _u
+}
+
+// ClearKeeperMachineID clears the value of the "keeper_machine_id" field.
+func (_u *ContentIdentityUpdate) ClearKeeperMachineID() *ContentIdentityUpdate {
+	_u.mutation.ClearKeeperMachineID()
+	return _u
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_u *ContentIdentityUpdate) SetResolvedAt(v time.Time) *ContentIdentityUpdate {
+	_u.mutation.SetResolvedAt(v)
+	return _u
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_u *ContentIdentityUpdate) SetNillableResolvedAt(v *time.Time) *ContentIdentityUpdate {
+	if v != nil {
+		_u.SetResolvedAt(*v)
+	}
+	return _u
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (_u *ContentIdentityUpdate) ClearResolvedAt() *ContentIdentityUpdate {
+	_u.mutation.ClearResolvedAt()
+	return _u
+}
+
+// SetFirstSeenAt sets the "first_seen_at" field.
+func (_u *ContentIdentityUpdate) SetFirstSeenAt(v time.Time) *ContentIdentityUpdate {
+	_u.mutation.SetFirstSeenAt(v)
+	return _u
+}
+
+// SetNillableFirstSeenAt sets the "first_seen_at" field if the given value is not nil.
+func (_u *ContentIdentityUpdate) SetNillableFirstSeenAt(v *time.Time) *ContentIdentityUpdate {
+	if v != nil {
+		_u.SetFirstSeenAt(*v)
+	}
+	return _u
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_u *ContentIdentityUpdate) SetLastSeenAt(v time.Time) *ContentIdentityUpdate {
+	_u.mutation.SetLastSeenAt(v)
+	return _u
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (_u *ContentIdentityUpdate) SetNillableLastSeenAt(v *time.Time) *ContentIdentityUpdate {
+	if v != nil {
+		_u.SetLastSeenAt(*v)
+	}
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *ContentIdentityUpdate) SetTenant(v *Tenant) *ContentIdentityUpdate {
+	return _u.SetTenantID(v.ID)
+}
+
+// SetKeeperMachine sets the "keeper_machine" edge to the Machine entity.
+func (_u *ContentIdentityUpdate) SetKeeperMachine(v *Machine) *ContentIdentityUpdate {
+	return _u.SetKeeperMachineID(v.ID)
+}
+
+// AddDuplicateGroupIDs adds the "duplicate_groups" edge to the DuplicateGroup entity by IDs.
+func (_u *ContentIdentityUpdate) AddDuplicateGroupIDs(ids ...uuid.UUID) *ContentIdentityUpdate {
+	_u.mutation.AddDuplicateGroupIDs(ids...)
+	return _u
+}
+
+// AddDuplicateGroups adds the "duplicate_groups" edges to the DuplicateGroup entity.
+func (_u *ContentIdentityUpdate) AddDuplicateGroups(v ...*DuplicateGroup) *ContentIdentityUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDuplicateGroupIDs(ids...)
+}
+
+// AddEventIDs adds the "events" edge to the ContentEvent entity by IDs.
+func (_u *ContentIdentityUpdate) AddEventIDs(ids ...uuid.UUID) *ContentIdentityUpdate {
+	_u.mutation.AddEventIDs(ids...)
+	return _u
+}
+
+// AddEvents adds the "events" edges to the ContentEvent entity.
+func (_u *ContentIdentityUpdate) AddEvents(v ...*ContentEvent) *ContentIdentityUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEventIDs(ids...)
+}
+
+// Mutation returns the ContentIdentityMutation object of the builder.
+func (_u *ContentIdentityUpdate) Mutation() *ContentIdentityMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *ContentIdentityUpdate) ClearTenant() *ContentIdentityUpdate {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearKeeperMachine clears the "keeper_machine" edge to the Machine entity.
+func (_u *ContentIdentityUpdate) ClearKeeperMachine() *ContentIdentityUpdate {
+	_u.mutation.ClearKeeperMachine()
+	return _u
+}
+
+// ClearDuplicateGroups clears all "duplicate_groups" edges to the DuplicateGroup entity.
+func (_u *ContentIdentityUpdate) ClearDuplicateGroups() *ContentIdentityUpdate {
+	_u.mutation.ClearDuplicateGroups()
+	return _u
+}
+
+// RemoveDuplicateGroupIDs removes the "duplicate_groups" edge to DuplicateGroup entities by IDs.
+func (_u *ContentIdentityUpdate) RemoveDuplicateGroupIDs(ids ...uuid.UUID) *ContentIdentityUpdate {
+	_u.mutation.RemoveDuplicateGroupIDs(ids...)
+	return _u
+}
+
+// RemoveDuplicateGroups removes "duplicate_groups" edges to DuplicateGroup entities.
+func (_u *ContentIdentityUpdate) RemoveDuplicateGroups(v ...*DuplicateGroup) *ContentIdentityUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDuplicateGroupIDs(ids...)
+}
+
+// ClearEvents clears all "events" edges to the ContentEvent entity.
+func (_u *ContentIdentityUpdate) ClearEvents() *ContentIdentityUpdate {
+	_u.mutation.ClearEvents()
+	return _u
+}
+
+// RemoveEventIDs removes the "events" edge to ContentEvent entities by IDs.
+func (_u *ContentIdentityUpdate) RemoveEventIDs(ids ...uuid.UUID) *ContentIdentityUpdate {
+	_u.mutation.RemoveEventIDs(ids...)
+	return _u
+}
+
+// RemoveEvents removes "events" edges to ContentEvent entities.
+func (_u *ContentIdentityUpdate) RemoveEvents(v ...*ContentEvent) *ContentIdentityUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEventIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ContentIdentityUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ContentIdentityUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ContentIdentityUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ContentIdentityUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ContentIdentityUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if contentidentity.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized contentidentity.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := contentidentity.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ContentIdentityUpdate) check() error {
+	if v, ok := _u.mutation.Hash(); ok {
+		if err := contentidentity.HashValidator(v); err != nil {
+			return &ValidationError{Name: "hash", err: fmt.Errorf(`ent: validator failed for field "ContentIdentity.hash": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ContentIdentity.tenant"`)
+	}
+	return nil
+}
+
+func (_u *ContentIdentityUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(contentidentity.Table, contentidentity.Columns, sqlgraph.NewFieldSpec(contentidentity.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(contentidentity.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Hash(); ok {
+		_spec.SetField(contentidentity.FieldHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ResolvedAt(); ok {
+		_spec.SetField(contentidentity.FieldResolvedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ResolvedAtCleared() {
+		_spec.ClearField(contentidentity.FieldResolvedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FirstSeenAt(); ok {
+		_spec.SetField(contentidentity.FieldFirstSeenAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LastSeenAt(); ok {
+		_spec.SetField(contentidentity.FieldLastSeenAt, field.TypeTime, value)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contentidentity.TenantTable,
+			Columns: []string{contentidentity.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contentidentity.TenantTable,
+			Columns: []string{contentidentity.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.KeeperMachineCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contentidentity.KeeperMachineTable,
+			Columns: []string{contentidentity.KeeperMachineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(machine.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.KeeperMachineIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contentidentity.KeeperMachineTable,
+			Columns: []string{contentidentity.KeeperMachineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(machine.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DuplicateGroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   contentidentity.DuplicateGroupsTable,
+			Columns: []string{contentidentity.DuplicateGroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(duplicategroup.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDuplicateGroupsIDs(); len(nodes) > 0 && !_u.mutation.DuplicateGroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   contentidentity.DuplicateGroupsTable,
+			Columns: []string{contentidentity.DuplicateGroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(duplicategroup.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DuplicateGroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   contentidentity.DuplicateGroupsTable,
+			Columns: []string{contentidentity.DuplicateGroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(duplicategroup.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   contentidentity.EventsTable,
+			Columns: []string{contentidentity.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentevent.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedEventsIDs(); len(nodes) > 0 && !_u.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   contentidentity.EventsTable,
+			Columns: []string{contentidentity.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   contentidentity.EventsTable,
+			Columns: []string{contentidentity.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{contentidentity.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ContentIdentityUpdateOne is the builder for updating a single ContentIdentity entity.
+type ContentIdentityUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ContentIdentityMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ContentIdentityUpdateOne) SetUpdateTime(v time.Time) *ContentIdentityUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *ContentIdentityUpdateOne) SetTenantID(v uuid.UUID) *ContentIdentityUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *ContentIdentityUpdateOne) SetNillableTenantID(v *uuid.UUID) *ContentIdentityUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetHash sets the "hash" field.
+func (_u *ContentIdentityUpdateOne) SetHash(v string) *ContentIdentityUpdateOne {
+	_u.mutation.SetHash(v)
+	return _u
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (_u *ContentIdentityUpdateOne) SetNillableHash(v *string) *ContentIdentityUpdateOne {
+	if v != nil {
+		_u.SetHash(*v)
+	}
+	return _u
+}
+
+// SetKeeperMachineID sets the "keeper_machine_id" field.
+func (_u *ContentIdentityUpdateOne) SetKeeperMachineID(v uuid.UUID) *ContentIdentityUpdateOne {
+	_u.mutation.SetKeeperMachineID(v)
+	return _u
+}
+
+// SetNillableKeeperMachineID sets the "keeper_machine_id" field if the given value is not nil.
+func (_u *ContentIdentityUpdateOne) SetNillableKeeperMachineID(v *uuid.UUID) *ContentIdentityUpdateOne {
+	if v != nil {
+		_u.SetKeeperMachineID(*v)
+	}
+	return _u
+}
+
+// ClearKeeperMachineID clears the value of the "keeper_machine_id" field.
+func (_u *ContentIdentityUpdateOne) ClearKeeperMachineID() *ContentIdentityUpdateOne {
+	_u.mutation.ClearKeeperMachineID()
+	return _u
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_u *ContentIdentityUpdateOne) SetResolvedAt(v time.Time) *ContentIdentityUpdateOne {
+	_u.mutation.SetResolvedAt(v)
+	return _u
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_u *ContentIdentityUpdateOne) SetNillableResolvedAt(v *time.Time) *ContentIdentityUpdateOne {
+	if v != nil {
+		_u.SetResolvedAt(*v)
+	}
+	return _u
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (_u *ContentIdentityUpdateOne) ClearResolvedAt() *ContentIdentityUpdateOne {
+	_u.mutation.ClearResolvedAt()
+	return _u
+}
+
+// SetFirstSeenAt sets the "first_seen_at" field.
+func (_u *ContentIdentityUpdateOne) SetFirstSeenAt(v time.Time) *ContentIdentityUpdateOne {
+	_u.mutation.SetFirstSeenAt(v)
+	return _u
+}
+
+// SetNillableFirstSeenAt sets the "first_seen_at" field if the given value is not nil.
+func (_u *ContentIdentityUpdateOne) SetNillableFirstSeenAt(v *time.Time) *ContentIdentityUpdateOne {
+	if v != nil {
+		_u.SetFirstSeenAt(*v)
+	}
+	return _u
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_u *ContentIdentityUpdateOne) SetLastSeenAt(v time.Time) *ContentIdentityUpdateOne {
+	_u.mutation.SetLastSeenAt(v)
+	return _u
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (_u *ContentIdentityUpdateOne) SetNillableLastSeenAt(v *time.Time) *ContentIdentityUpdateOne {
+	if v != nil {
+		_u.SetLastSeenAt(*v)
+	}
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *ContentIdentityUpdateOne) SetTenant(v *Tenant) *ContentIdentityUpdateOne {
+	return _u.SetTenantID(v.ID)
+}
+
+// SetKeeperMachine sets the "keeper_machine" edge to the Machine entity.
+func (_u *ContentIdentityUpdateOne) SetKeeperMachine(v *Machine) *ContentIdentityUpdateOne {
+	return _u.SetKeeperMachineID(v.ID)
+}
+
+// AddDuplicateGroupIDs adds the "duplicate_groups" edge to the DuplicateGroup entity by IDs.
+func (_u *ContentIdentityUpdateOne) AddDuplicateGroupIDs(ids ...uuid.UUID) *ContentIdentityUpdateOne {
+	_u.mutation.AddDuplicateGroupIDs(ids...)
+	return _u
+}
+
+// AddDuplicateGroups adds the "duplicate_groups" edges to the DuplicateGroup entity.
+func (_u *ContentIdentityUpdateOne) AddDuplicateGroups(v ...*DuplicateGroup) *ContentIdentityUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDuplicateGroupIDs(ids...)
+}
+
+// AddEventIDs adds the "events" edge to the ContentEvent entity by IDs.
+func (_u *ContentIdentityUpdateOne) AddEventIDs(ids ...uuid.UUID) *ContentIdentityUpdateOne {
+	_u.mutation.AddEventIDs(ids...)
+	return _u
+}
+
+// AddEvents adds the "events" edges to the ContentEvent entity.
+func (_u *ContentIdentityUpdateOne) AddEvents(v ...*ContentEvent) *ContentIdentityUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEventIDs(ids...)
+}
+
+// Mutation returns the ContentIdentityMutation object of the builder.
+func (_u *ContentIdentityUpdateOne) Mutation() *ContentIdentityMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *ContentIdentityUpdateOne) ClearTenant() *ContentIdentityUpdateOne {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearKeeperMachine clears the "keeper_machine" edge to the Machine entity.
+func (_u *ContentIdentityUpdateOne) ClearKeeperMachine() *ContentIdentityUpdateOne {
+	_u.mutation.ClearKeeperMachine()
+	return _u
+}
+
+// ClearDuplicateGroups clears all "duplicate_groups" edges to the DuplicateGroup entity.
+func (_u *ContentIdentityUpdateOne) ClearDuplicateGroups() *ContentIdentityUpdateOne {
+	_u.mutation.ClearDuplicateGroups()
+	return _u
+}
+
+// RemoveDuplicateGroupIDs removes the "duplicate_groups" edge to DuplicateGroup entities by IDs.
+func (_u *ContentIdentityUpdateOne) RemoveDuplicateGroupIDs(ids ...uuid.UUID) *ContentIdentityUpdateOne {
+	_u.mutation.RemoveDuplicateGroupIDs(ids...)
+	return _u
+}
+
+// RemoveDuplicateGroups removes "duplicate_groups" edges to DuplicateGroup entities.
+func (_u *ContentIdentityUpdateOne) RemoveDuplicateGroups(v ...*DuplicateGroup) *ContentIdentityUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDuplicateGroupIDs(ids...)
+}
+
+// ClearEvents clears all "events" edges to the ContentEvent entity.
+func (_u *ContentIdentityUpdateOne) ClearEvents() *ContentIdentityUpdateOne {
+	_u.mutation.ClearEvents()
+	return _u
+}
+
+// RemoveEventIDs removes the "events" edge to ContentEvent entities by IDs.
+func (_u *ContentIdentityUpdateOne) RemoveEventIDs(ids ...uuid.UUID) *ContentIdentityUpdateOne {
+	_u.mutation.RemoveEventIDs(ids...)
+	return _u
+}
+
+// RemoveEvents removes "events" edges to ContentEvent entities.
+func (_u *ContentIdentityUpdateOne) RemoveEvents(v ...*ContentEvent) *ContentIdentityUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEventIDs(ids...)
+}
+
+// Where appends a list predicates to the ContentIdentityUpdate builder.
+func (_u *ContentIdentityUpdateOne) Where(ps ...predicate.ContentIdentity) *ContentIdentityUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ContentIdentityUpdateOne) Select(field string, fields ...string) *ContentIdentityUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ContentIdentity entity.
+func (_u *ContentIdentityUpdateOne) Save(ctx context.Context) (*ContentIdentity, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ContentIdentityUpdateOne) SaveX(ctx context.Context) *ContentIdentity {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ContentIdentityUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ContentIdentityUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ContentIdentityUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if contentidentity.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized contentidentity.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := contentidentity.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ContentIdentityUpdateOne) check() error {
+	if v, ok := _u.mutation.Hash(); ok {
+		if err := contentidentity.HashValidator(v); err != nil {
+			return &ValidationError{Name: "hash", err: fmt.Errorf(`ent: validator failed for field "ContentIdentity.hash": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ContentIdentity.tenant"`)
+	}
+	return nil
+}
+
+func (_u *ContentIdentityUpdateOne) sqlSave(ctx context.Context) (_node *ContentIdentity, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(contentidentity.Table, contentidentity.Columns, sqlgraph.NewFieldSpec(contentidentity.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ContentIdentity.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, contentidentity.FieldID)
+		for _, f := range fields {
+			if !contentidentity.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != contentidentity.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(contentidentity.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Hash(); ok {
+		_spec.SetField(contentidentity.FieldHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ResolvedAt(); ok {
+		_spec.SetField(contentidentity.FieldResolvedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ResolvedAtCleared() {
+		_spec.ClearField(contentidentity.FieldResolvedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FirstSeenAt(); ok {
+		_spec.SetField(contentidentity.FieldFirstSeenAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LastSeenAt(); ok {
+		_spec.SetField(contentidentity.FieldLastSeenAt, field.TypeTime, value)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contentidentity.TenantTable,
+			Columns: []string{contentidentity.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contentidentity.TenantTable,
+			Columns: []string{contentidentity.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.KeeperMachineCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contentidentity.KeeperMachineTable,
+			Columns: []string{contentidentity.KeeperMachineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(machine.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.KeeperMachineIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contentidentity.KeeperMachineTable,
+			Columns: []string{contentidentity.KeeperMachineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(machine.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DuplicateGroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   contentidentity.DuplicateGroupsTable,
+			Columns: []string{contentidentity.DuplicateGroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(duplicategroup.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDuplicateGroupsIDs(); len(nodes) > 0 && !_u.mutation.DuplicateGroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   contentidentity.DuplicateGroupsTable,
+			Columns: []string{contentidentity.DuplicateGroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(duplicategroup.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DuplicateGroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   contentidentity.DuplicateGroupsTable,
+			Columns: []string{contentidentity.DuplicateGroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(duplicategroup.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   contentidentity.EventsTable,
+			Columns: []string{contentidentity.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentevent.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedEventsIDs(); len(nodes) > 0 && !_u.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   contentidentity.EventsTable,
+			Columns: []string{contentidentity.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   contentidentity.EventsTable,
+			Columns: []string{contentidentity.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ContentIdentity{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{contentidentity.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
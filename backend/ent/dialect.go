@@ -0,0 +1,7 @@
+package ent
+
+// Dialect reports the SQL dialect of the client's driver, e.g. dialect.SQLite
+// or dialect.Postgres, for code issuing raw SQL through ExecContext and QueryContext.
+func (c *Client) Dialect() string {
+	return c.driver.Dialect()
+}
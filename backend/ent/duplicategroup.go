@@ -10,6 +10,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
+	"github.com/mcmx/duplynx/ent/contentidentity"
 	"github.com/mcmx/duplynx/ent/duplicategroup"
 	"github.com/mcmx/duplynx/ent/machine"
 	"github.com/mcmx/duplynx/ent/scan"
@@ -31,6 +32,8 @@ type DuplicateGroup struct {
 	ScanID uuid.UUID `json:"scan_id,omitempty"`
 	// KeeperMachineID holds the value of the "keeper_machine_id" field.
 	KeeperMachineID uuid.UUID `json:"keeper_machine_id,omitempty"`
+	// ContentIdentityID holds the value of the "content_identity_id" field.
+	ContentIdentityID uuid.UUID `json:"content_identity_id,omitempty"`
 	// Hash holds the value of the "hash" field.
 	Hash string `json:"hash,omitempty"`
 	// Status holds the value of the "status" field.
@@ -53,13 +56,17 @@ type DuplicateGroupEdges struct {
 	Scan *Scan `json:"scan,omitempty"`
 	// KeeperMachine holds the value of the keeper_machine edge.
 	KeeperMachine *Machine `json:"keeper_machine,omitempty"`
+	// ContentIdentity holds the value of the content_identity edge.
+	ContentIdentity *ContentIdentity `json:"content_identity,omitempty"`
 	// FileInstances holds the value of the file_instances edge.
 	FileInstances []*FileInstance `json:"file_instances,omitempty"`
 	// ActionAudits holds the value of the action_audits edge.
 	ActionAudits []*ActionAudit `json:"action_audits,omitempty"`
+	// ActionJobs holds the value of the action_jobs edge.
+	ActionJobs []*ActionJob `json:"action_jobs,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [5]bool
+	loadedTypes [7]bool
 }
 
 // TenantOrErr returns the Tenant value or an error if the edge
@@ -95,10 +102,21 @@ func (e DuplicateGroupEdges) KeeperMachineOrErr() (*Machine, error) {
 	return nil, &NotLoadedError{edge: "keeper_machine"}
 }
 
+// ContentIdentityOrErr returns the ContentIdentity value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DuplicateGroupEdges) ContentIdentityOrErr() (*ContentIdentity, error) {
+	if e.ContentIdentity != nil {
+		return e.ContentIdentity, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: contentidentity.Label}
+	}
+	return nil, &NotLoadedError{edge: "content_identity"}
+}
+
 // FileInstancesOrErr returns the FileInstances value or an error if the edge
 // was not loaded in eager-loading.
 func (e DuplicateGroupEdges) FileInstancesOrErr() ([]*FileInstance, error) {
-	if e.loadedTypes[3] {
+	if e.loadedTypes[4] {
 		return e.FileInstances, nil
 	}
 	return nil, &NotLoadedError{edge: "file_instances"}
@@ -107,12 +125,21 @@ func (e DuplicateGroupEdges) FileInstancesOrErr() ([]*FileInstance, error) {
 // ActionAuditsOrErr returns the ActionAudits value or an error if the edge
 // was not loaded in eager-loading.
 func (e DuplicateGroupEdges) ActionAuditsOrErr() ([]*ActionAudit, error) {
-	if e.loadedTypes[4] {
+	if e.loadedTypes[5] {
 		return e.ActionAudits, nil
 	}
 	return nil, &NotLoadedError{edge: "action_audits"}
 }
 
+// ActionJobsOrErr returns the ActionJobs value or an error if the edge
+// was not loaded in eager-loading.
+func (e DuplicateGroupEdges) ActionJobsOrErr() ([]*ActionJob, error) {
+	if e.loadedTypes[6] {
+		return e.ActionJobs, nil
+	}
+	return nil, &NotLoadedError{edge: "action_jobs"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*DuplicateGroup) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -124,7 +151,7 @@ func (*DuplicateGroup) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullString)
 		case duplicategroup.FieldCreateTime, duplicategroup.FieldUpdateTime:
 			values[i] = new(sql.NullTime)
-		case duplicategroup.FieldID, duplicategroup.FieldTenantID, duplicategroup.FieldScanID, duplicategroup.FieldKeeperMachineID:
+		case duplicategroup.FieldID, duplicategroup.FieldTenantID, duplicategroup.FieldScanID, duplicategroup.FieldKeeperMachineID, duplicategroup.FieldContentIdentityID:
 			values[i] = new(uuid.UUID)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -177,6 +204,12 @@ func (_m *DuplicateGroup) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.KeeperMachineID = *value
 			}
+		case duplicategroup.FieldContentIdentityID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field content_identity_id", values[i])
+			} else if value != nil {
+				_m.ContentIdentityID = *value
+			}
 		case duplicategroup.FieldHash:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field hash", values[i])
@@ -229,6 +262,11 @@ func (_m *DuplicateGroup) QueryKeeperMachine() *MachineQuery {
 	return NewDuplicateGroupClient(_m.config).QueryKeeperMachine(_m)
 }
 
+// QueryContentIdentity queries the "content_identity" edge of the DuplicateGroup entity.
+func (_m *DuplicateGroup) QueryContentIdentity() *ContentIdentityQuery {
+	return NewDuplicateGroupClient(_m.config).QueryContentIdentity(_m)
+}
+
 // QueryFileInstances queries the "file_instances" edge of the DuplicateGroup entity.
 func (_m *DuplicateGroup) QueryFileInstances() *FileInstanceQuery {
 	return NewDuplicateGroupClient(_m.config).QueryFileInstances(_m)
@@ -239,6 +277,11 @@ func (_m *DuplicateGroup) QueryActionAudits() *ActionAuditQuery {
 	return NewDuplicateGroupClient(_m.config).QueryActionAudits(_m)
 }
 
+// QueryActionJobs queries the "action_jobs" edge of the DuplicateGroup entity.
+func (_m *DuplicateGroup) QueryActionJobs() *ActionJobQuery {
+	return NewDuplicateGroupClient(_m.config).QueryActionJobs(_m)
+}
+
 // Update returns a builder for updating this DuplicateGroup.
 // Note that you need to call DuplicateGroup.Unwrap() before calling this method if this DuplicateGroup
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -277,6 +320,9 @@ func (_m *DuplicateGroup) String() string {
 	builder.WriteString("keeper_machine_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.KeeperMachineID))
 	builder.WriteString(", ")
+	builder.WriteString("content_identity_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ContentIdentityID))
+	builder.WriteString(", ")
 	builder.WriteString("hash=")
 	builder.WriteString(_m.Hash)
 	builder.WriteString(", ")
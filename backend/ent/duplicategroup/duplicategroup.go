@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
@@ -26,6 +27,8 @@ const (
 	FieldScanID = "scan_id"
 	// FieldKeeperMachineID holds the string denoting the keeper_machine_id field in the database.
 	FieldKeeperMachineID = "keeper_machine_id"
+	// FieldContentIdentityID holds the string denoting the content_identity_id field in the database.
+	FieldContentIdentityID = "content_identity_id"
 	// FieldHash holds the string denoting the hash field in the database.
 	FieldHash = "hash"
 	// FieldStatus holds the string denoting the status field in the database.
@@ -40,10 +43,14 @@ const (
 	EdgeScan = "scan"
 	// EdgeKeeperMachine holds the string denoting the keeper_machine edge name in mutations.
 	EdgeKeeperMachine = "keeper_machine"
+	// EdgeContentIdentity holds the string denoting the content_identity edge name in mutations.
+	EdgeContentIdentity = "content_identity"
 	// EdgeFileInstances holds the string denoting the file_instances edge name in mutations.
 	EdgeFileInstances = "file_instances"
 	// EdgeActionAudits holds the string denoting the action_audits edge name in mutations.
 	EdgeActionAudits = "action_audits"
+	// EdgeActionJobs holds the string denoting the action_jobs edge name in mutations.
+	EdgeActionJobs = "action_jobs"
 	// Table holds the table name of the duplicategroup in the database.
 	Table = "duplicate_groups"
 	// TenantTable is the table that holds the tenant relation/edge.
@@ -67,6 +74,13 @@ const (
 	KeeperMachineInverseTable = "machines"
 	// KeeperMachineColumn is the table column denoting the keeper_machine relation/edge.
 	KeeperMachineColumn = "keeper_machine_id"
+	// ContentIdentityTable is the table that holds the content_identity relation/edge.
+	ContentIdentityTable = "duplicate_groups"
+	// ContentIdentityInverseTable is the table name for the ContentIdentity entity.
+	// It exists in this package in order to avoid circular dependency with the "contentidentity" package.
+	ContentIdentityInverseTable = "content_identities"
+	// ContentIdentityColumn is the table column denoting the content_identity relation/edge.
+	ContentIdentityColumn = "content_identity_id"
 	// FileInstancesTable is the table that holds the file_instances relation/edge.
 	FileInstancesTable = "file_instances"
 	// FileInstancesInverseTable is the table name for the FileInstance entity.
@@ -81,6 +95,13 @@ const (
 	ActionAuditsInverseTable = "action_audits"
 	// ActionAuditsColumn is the table column denoting the action_audits relation/edge.
 	ActionAuditsColumn = "duplicate_group_id"
+	// ActionJobsTable is the table that holds the action_jobs relation/edge.
+	ActionJobsTable = "action_jobs"
+	// ActionJobsInverseTable is the table name for the ActionJob entity.
+	// It exists in this package in order to avoid circular dependency with the "actionjob" package.
+	ActionJobsInverseTable = "action_jobs"
+	// ActionJobsColumn is the table column denoting the action_jobs relation/edge.
+	ActionJobsColumn = "duplicate_group_id"
 )
 
 // Columns holds all SQL columns for duplicategroup fields.
@@ -91,6 +112,7 @@ var Columns = []string{
 	FieldTenantID,
 	FieldScanID,
 	FieldKeeperMachineID,
+	FieldContentIdentityID,
 	FieldHash,
 	FieldStatus,
 	FieldFileCount,
@@ -107,7 +129,14 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/mcmx/duplynx/ent/runtime"
 var (
+	Hooks        [1]ent.Hook
+	Interceptors [1]ent.Interceptor
 	// DefaultCreateTime holds the default value on creation for the "create_time" field.
 	DefaultCreateTime func() time.Time
 	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
@@ -183,6 +212,11 @@ func ByKeeperMachineID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldKeeperMachineID, opts...).ToFunc()
 }
 
+// ByContentIdentityID orders the results by the content_identity_id field.
+func ByContentIdentityID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContentIdentityID, opts...).ToFunc()
+}
+
 // ByHash orders the results by the hash field.
 func ByHash(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldHash, opts...).ToFunc()
@@ -224,6 +258,13 @@ func ByKeeperMachineField(field string, opts ...sql.OrderTermOption) OrderOption
 	}
 }
 
+// ByContentIdentityField orders the results by content_identity field.
+func ByContentIdentityField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newContentIdentityStep(), sql.OrderByField(field, opts...))
+	}
+}
+
 // ByFileInstancesCount orders the results by file_instances count.
 func ByFileInstancesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -251,6 +292,20 @@ func ByActionAudits(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newActionAuditsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByActionJobsCount orders the results by action_jobs count.
+func ByActionJobsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newActionJobsStep(), opts...)
+	}
+}
+
+// ByActionJobs orders the results by action_jobs terms.
+func ByActionJobs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newActionJobsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newTenantStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -272,6 +327,13 @@ func newKeeperMachineStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, KeeperMachineTable, KeeperMachineColumn),
 	)
 }
+func newContentIdentityStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ContentIdentityInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ContentIdentityTable, ContentIdentityColumn),
+	)
+}
 func newFileInstancesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -286,3 +348,10 @@ func newActionAuditsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ActionAuditsTable, ActionAuditsColumn),
 	)
 }
+func newActionJobsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ActionJobsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ActionJobsTable, ActionJobsColumn),
+	)
+}
@@ -81,6 +81,11 @@ func KeeperMachineID(v uuid.UUID) predicate.DuplicateGroup {
 	return predicate.DuplicateGroup(sql.FieldEQ(FieldKeeperMachineID, v))
 }
 
+// ContentIdentityID applies equality check predicate on the "content_identity_id" field. It's identical to ContentIdentityIDEQ.
+func ContentIdentityID(v uuid.UUID) predicate.DuplicateGroup {
+	return predicate.DuplicateGroup(sql.FieldEQ(FieldContentIdentityID, v))
+}
+
 // Hash applies equality check predicate on the "hash" field. It's identical to HashEQ.
 func Hash(v string) predicate.DuplicateGroup {
 	return predicate.DuplicateGroup(sql.FieldEQ(FieldHash, v))
@@ -246,6 +251,36 @@ func KeeperMachineIDNotNil() predicate.DuplicateGroup {
 	return predicate.DuplicateGroup(sql.FieldNotNull(FieldKeeperMachineID))
 }
 
+// ContentIdentityIDEQ applies the EQ predicate on the "content_identity_id" field.
+func ContentIdentityIDEQ(v uuid.UUID) predicate.DuplicateGroup {
+	return predicate.DuplicateGroup(sql.FieldEQ(FieldContentIdentityID, v))
+}
+
+// ContentIdentityIDNEQ applies the NEQ predicate on the "content_identity_id" field.
+func ContentIdentityIDNEQ(v uuid.UUID) predicate.DuplicateGroup {
+	return predicate.DuplicateGroup(sql.FieldNEQ(FieldContentIdentityID, v))
+}
+
+// ContentIdentityIDIn applies the In predicate on the "content_identity_id" field.
+func ContentIdentityIDIn(vs ...uuid.UUID) predicate.DuplicateGroup {
+	return predicate.DuplicateGroup(sql.FieldIn(FieldContentIdentityID, vs...))
+}
+
+// ContentIdentityIDNotIn applies the NotIn predicate on the "content_identity_id" field.
+func ContentIdentityIDNotIn(vs ...uuid.UUID) predicate.DuplicateGroup {
+	return predicate.DuplicateGroup(sql.FieldNotIn(FieldContentIdentityID, vs...))
+}
+
+// ContentIdentityIDIsNil applies the IsNil predicate on the "content_identity_id" field.
+func ContentIdentityIDIsNil() predicate.DuplicateGroup {
+	return predicate.DuplicateGroup(sql.FieldIsNull(FieldContentIdentityID))
+}
+
+// ContentIdentityIDNotNil applies the NotNil predicate on the "content_identity_id" field.
+func ContentIdentityIDNotNil() predicate.DuplicateGroup {
+	return predicate.DuplicateGroup(sql.FieldNotNull(FieldContentIdentityID))
+}
+
 // HashEQ applies the EQ predicate on the "hash" field.
 func HashEQ(v string) predicate.DuplicateGroup {
 	return predicate.DuplicateGroup(sql.FieldEQ(FieldHash, v))
@@ -480,6 +515,29 @@ func HasKeeperMachineWith(preds ...predicate.Machine) predicate.DuplicateGroup {
 	})
 }
 
+// HasContentIdentity applies the HasEdge predicate on the "content_identity" edge.
+func HasContentIdentity() predicate.DuplicateGroup {
+	return predicate.DuplicateGroup(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ContentIdentityTable, ContentIdentityColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasContentIdentityWith applies the HasEdge predicate on the "content_identity" edge with a given conditions (other predicates).
+func HasContentIdentityWith(preds ...predicate.ContentIdentity) predicate.DuplicateGroup {
+	return predicate.DuplicateGroup(func(s *sql.Selector) {
+		step := newContentIdentityStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasFileInstances applies the HasEdge predicate on the "file_instances" edge.
 func HasFileInstances() predicate.DuplicateGroup {
 	return predicate.DuplicateGroup(func(s *sql.Selector) {
@@ -526,6 +584,29 @@ func HasActionAuditsWith(preds ...predicate.ActionAudit) predicate.DuplicateGrou
 	})
 }
 
+// HasActionJobs applies the HasEdge predicate on the "action_jobs" edge.
+func HasActionJobs() predicate.DuplicateGroup {
+	return predicate.DuplicateGroup(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ActionJobsTable, ActionJobsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasActionJobsWith applies the HasEdge predicate on the "action_jobs" edge with a given conditions (other predicates).
+func HasActionJobsWith(preds ...predicate.ActionJob) predicate.DuplicateGroup {
+	return predicate.DuplicateGroup(func(s *sql.Selector) {
+		step := newActionJobsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.DuplicateGroup) predicate.DuplicateGroup {
 	return predicate.DuplicateGroup(sql.AndPredicates(predicates...))
@@ -12,6 +12,8 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/mcmx/duplynx/ent/actionaudit"
+	"github.com/mcmx/duplynx/ent/actionjob"
+	"github.com/mcmx/duplynx/ent/contentidentity"
 	"github.com/mcmx/duplynx/ent/duplicategroup"
 	"github.com/mcmx/duplynx/ent/fileinstance"
 	"github.com/mcmx/duplynx/ent/machine"
@@ -80,6 +82,20 @@ func (_c *DuplicateGroupCreate) SetNillableKeeperMachineID(v *uuid.UUID) *Duplic
 	return _c
 }
 
+// SetContentIdentityID sets the "content_identity_id" field.
+func (_c *DuplicateGroupCreate) SetContentIdentityID(v uuid.UUID) *DuplicateGroupCreate {
+	_c.mutation.SetContentIdentityID(v)
+	return _c
+}
+
+// SetNillableContentIdentityID sets the "content_identity_id" field if the given value is not nil.
+func (_c *DuplicateGroupCreate) SetNillableContentIdentityID(v *uuid.UUID) *DuplicateGroupCreate {
+	if v != nil {
+		_c.SetContentIdentityID(*v)
+	}
+	return _c
+}
+
 // SetHash sets the "hash" field.
 func (_c *DuplicateGroupCreate) SetHash(v string) *DuplicateGroupCreate {
 	_c.mutation.SetHash(v)
@@ -141,6 +157,11 @@ func (_c *DuplicateGroupCreate) SetKeeperMachine(v *Machine) *DuplicateGroupCrea
 	return _c.SetKeeperMachineID(v.ID)
 }
 
+// SetContentIdentity sets the "content_identity" edge to the ContentIdentity entity.
+func (_c *DuplicateGroupCreate) SetContentIdentity(v *ContentIdentity) *DuplicateGroupCreate {
+	return _c.SetContentIdentityID(v.ID)
+}
+
 // AddFileInstanceIDs adds the "file_instances" edge to the FileInstance entity by IDs.
 func (_c *DuplicateGroupCreate) AddFileInstanceIDs(ids ...uuid.UUID) *DuplicateGroupCreate {
 	_c.mutation.AddFileInstanceIDs(ids...)
@@ -171,6 +192,21 @@ func (_c *DuplicateGroupCreate) AddActionAudits(v ...*ActionAudit) *DuplicateGro
 	return _c.AddActionAuditIDs(ids...)
 }
 
+// AddActionJobIDs adds the "action_jobs" edge to the ActionJob entity by IDs.
+func (_c *DuplicateGroupCreate) AddActionJobIDs(ids ...uuid.UUID) *DuplicateGroupCreate {
+	_c.mutation.AddActionJobIDs(ids...)
+	return _c
+}
+
+// AddActionJobs adds the "action_jobs" edges to the ActionJob entity.
+func (_c *DuplicateGroupCreate) AddActionJobs(v ...*ActionJob) *DuplicateGroupCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddActionJobIDs(ids...)
+}
+
 // Mutation returns the DuplicateGroupMutation object of the builder.
 func (_c *DuplicateGroupCreate) Mutation() *DuplicateGroupMutation {
 	return _c.mutation
@@ -178,7 +214,9 @@ func (_c *DuplicateGroupCreate) Mutation() *DuplicateGroupMutation {
 
 // Save creates the DuplicateGroup in the database.
 func (_c *DuplicateGroupCreate) Save(ctx context.Context) (*DuplicateGroup, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -205,12 +243,18 @@ func (_c *DuplicateGroupCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *DuplicateGroupCreate) defaults() {
+func (_c *DuplicateGroupCreate) defaults() error {
 	if _, ok := _c.mutation.CreateTime(); !ok {
+		if duplicategroup.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized duplicategroup.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
 		v := duplicategroup.DefaultCreateTime()
 		_c.mutation.SetCreateTime(v)
 	}
 	if _, ok := _c.mutation.UpdateTime(); !ok {
+		if duplicategroup.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized duplicategroup.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
 		v := duplicategroup.DefaultUpdateTime()
 		_c.mutation.SetUpdateTime(v)
 	}
@@ -219,9 +263,13 @@ func (_c *DuplicateGroupCreate) defaults() {
 		_c.mutation.SetStatus(v)
 	}
 	if _, ok := _c.mutation.ID(); !ok {
+		if duplicategroup.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized duplicategroup.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := duplicategroup.DefaultID()
 		_c.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -381,6 +429,23 @@ func (_c *DuplicateGroupCreate) createSpec() (*DuplicateGroup, *sqlgraph.CreateS
 		_node.KeeperMachineID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.ContentIdentityIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   duplicategroup.ContentIdentityTable,
+			Columns: []string{duplicategroup.ContentIdentityColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentidentity.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ContentIdentityID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.FileInstancesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -413,6 +478,22 @@ func (_c *DuplicateGroupCreate) createSpec() (*DuplicateGroup, *sqlgraph.CreateS
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.ActionJobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   duplicategroup.ActionJobsTable,
+			Columns: []string{duplicategroup.ActionJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(actionjob.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -14,6 +14,8 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/mcmx/duplynx/ent/actionaudit"
+	"github.com/mcmx/duplynx/ent/actionjob"
+	"github.com/mcmx/duplynx/ent/contentidentity"
 	"github.com/mcmx/duplynx/ent/duplicategroup"
 	"github.com/mcmx/duplynx/ent/fileinstance"
 	"github.com/mcmx/duplynx/ent/machine"
@@ -25,15 +27,17 @@ import (
 // DuplicateGroupQuery is the builder for querying DuplicateGroup entities.
 type DuplicateGroupQuery struct {
 	config
-	ctx               *QueryContext
-	order             []duplicategroup.OrderOption
-	inters            []Interceptor
-	predicates        []predicate.DuplicateGroup
-	withTenant        *TenantQuery
-	withScan          *ScanQuery
-	withKeeperMachine *MachineQuery
-	withFileInstances *FileInstanceQuery
-	withActionAudits  *ActionAuditQuery
+	ctx                 *QueryContext
+	order               []duplicategroup.OrderOption
+	inters              []Interceptor
+	predicates          []predicate.DuplicateGroup
+	withTenant          *TenantQuery
+	withScan            *ScanQuery
+	withKeeperMachine   *MachineQuery
+	withContentIdentity *ContentIdentityQuery
+	withFileInstances   *FileInstanceQuery
+	withActionAudits    *ActionAuditQuery
+	withActionJobs      *ActionJobQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -136,6 +140,28 @@ func (_q *DuplicateGroupQuery) QueryKeeperMachine() *MachineQuery {
 	return query
 }
 
+// QueryContentIdentity chains the current query on the "content_identity" edge.
+func (_q *DuplicateGroupQuery) QueryContentIdentity() *ContentIdentityQuery {
+	query := (&ContentIdentityClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(duplicategroup.Table, duplicategroup.FieldID, selector),
+			sqlgraph.To(contentidentity.Table, contentidentity.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, duplicategroup.ContentIdentityTable, duplicategroup.ContentIdentityColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryFileInstances chains the current query on the "file_instances" edge.
 func (_q *DuplicateGroupQuery) QueryFileInstances() *FileInstanceQuery {
 	query := (&FileInstanceClient{config: _q.config}).Query()
@@ -180,6 +206,28 @@ func (_q *DuplicateGroupQuery) QueryActionAudits() *ActionAuditQuery {
 	return query
 }
 
+// QueryActionJobs chains the current query on the "action_jobs" edge.
+func (_q *DuplicateGroupQuery) QueryActionJobs() *ActionJobQuery {
+	query := (&ActionJobClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(duplicategroup.Table, duplicategroup.FieldID, selector),
+			sqlgraph.To(actionjob.Table, actionjob.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, duplicategroup.ActionJobsTable, duplicategroup.ActionJobsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first DuplicateGroup entity from the query.
 // Returns a *NotFoundError when no DuplicateGroup was found.
 func (_q *DuplicateGroupQuery) First(ctx context.Context) (*DuplicateGroup, error) {
@@ -367,16 +415,18 @@ func (_q *DuplicateGroupQuery) Clone() *DuplicateGroupQuery {
 		return nil
 	}
 	return &DuplicateGroupQuery{
-		config:            _q.config,
-		ctx:               _q.ctx.Clone(),
-		order:             append([]duplicategroup.OrderOption{}, _q.order...),
-		inters:            append([]Interceptor{}, _q.inters...),
-		predicates:        append([]predicate.DuplicateGroup{}, _q.predicates...),
-		withTenant:        _q.withTenant.Clone(),
-		withScan:          _q.withScan.Clone(),
-		withKeeperMachine: _q.withKeeperMachine.Clone(),
-		withFileInstances: _q.withFileInstances.Clone(),
-		withActionAudits:  _q.withActionAudits.Clone(),
+		config:              _q.config,
+		ctx:                 _q.ctx.Clone(),
+		order:               append([]duplicategroup.OrderOption{}, _q.order...),
+		inters:              append([]Interceptor{}, _q.inters...),
+		predicates:          append([]predicate.DuplicateGroup{}, _q.predicates...),
+		withTenant:          _q.withTenant.Clone(),
+		withScan:            _q.withScan.Clone(),
+		withKeeperMachine:   _q.withKeeperMachine.Clone(),
+		withContentIdentity: _q.withContentIdentity.Clone(),
+		withFileInstances:   _q.withFileInstances.Clone(),
+		withActionAudits:    _q.withActionAudits.Clone(),
+		withActionJobs:      _q.withActionJobs.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -416,6 +466,17 @@ func (_q *DuplicateGroupQuery) WithKeeperMachine(opts ...func(*MachineQuery)) *D
 	return _q
 }
 
+// WithContentIdentity tells the query-builder to eager-load the nodes that are connected to
+// the "content_identity" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *DuplicateGroupQuery) WithContentIdentity(opts ...func(*ContentIdentityQuery)) *DuplicateGroupQuery {
+	query := (&ContentIdentityClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withContentIdentity = query
+	return _q
+}
+
 // WithFileInstances tells the query-builder to eager-load the nodes that are connected to
 // the "file_instances" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *DuplicateGroupQuery) WithFileInstances(opts ...func(*FileInstanceQuery)) *DuplicateGroupQuery {
@@ -438,6 +499,17 @@ func (_q *DuplicateGroupQuery) WithActionAudits(opts ...func(*ActionAuditQuery))
 	return _q
 }
 
+// WithActionJobs tells the query-builder to eager-load the nodes that are connected to
+// the "action_jobs" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *DuplicateGroupQuery) WithActionJobs(opts ...func(*ActionJobQuery)) *DuplicateGroupQuery {
+	query := (&ActionJobClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withActionJobs = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -516,12 +588,14 @@ func (_q *DuplicateGroupQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 	var (
 		nodes       = []*DuplicateGroup{}
 		_spec       = _q.querySpec()
-		loadedTypes = [5]bool{
+		loadedTypes = [7]bool{
 			_q.withTenant != nil,
 			_q.withScan != nil,
 			_q.withKeeperMachine != nil,
+			_q.withContentIdentity != nil,
 			_q.withFileInstances != nil,
 			_q.withActionAudits != nil,
+			_q.withActionJobs != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -560,6 +634,12 @@ func (_q *DuplicateGroupQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 			return nil, err
 		}
 	}
+	if query := _q.withContentIdentity; query != nil {
+		if err := _q.loadContentIdentity(ctx, query, nodes, nil,
+			func(n *DuplicateGroup, e *ContentIdentity) { n.Edges.ContentIdentity = e }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withFileInstances; query != nil {
 		if err := _q.loadFileInstances(ctx, query, nodes,
 			func(n *DuplicateGroup) { n.Edges.FileInstances = []*FileInstance{} },
@@ -574,6 +654,13 @@ func (_q *DuplicateGroupQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 			return nil, err
 		}
 	}
+	if query := _q.withActionJobs; query != nil {
+		if err := _q.loadActionJobs(ctx, query, nodes,
+			func(n *DuplicateGroup) { n.Edges.ActionJobs = []*ActionJob{} },
+			func(n *DuplicateGroup, e *ActionJob) { n.Edges.ActionJobs = append(n.Edges.ActionJobs, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -664,6 +751,35 @@ func (_q *DuplicateGroupQuery) loadKeeperMachine(ctx context.Context, query *Mac
 	}
 	return nil
 }
+func (_q *DuplicateGroupQuery) loadContentIdentity(ctx context.Context, query *ContentIdentityQuery, nodes []*DuplicateGroup, init func(*DuplicateGroup), assign func(*DuplicateGroup, *ContentIdentity)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*DuplicateGroup)
+	for i := range nodes {
+		fk := nodes[i].ContentIdentityID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(contentidentity.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "content_identity_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 func (_q *DuplicateGroupQuery) loadFileInstances(ctx context.Context, query *FileInstanceQuery, nodes []*DuplicateGroup, init func(*DuplicateGroup), assign func(*DuplicateGroup, *FileInstance)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*DuplicateGroup)
@@ -724,6 +840,36 @@ func (_q *DuplicateGroupQuery) loadActionAudits(ctx context.Context, query *Acti
 	}
 	return nil
 }
+func (_q *DuplicateGroupQuery) loadActionJobs(ctx context.Context, query *ActionJobQuery, nodes []*DuplicateGroup, init func(*DuplicateGroup), assign func(*DuplicateGroup, *ActionJob)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*DuplicateGroup)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(actionjob.FieldDuplicateGroupID)
+	}
+	query.Where(predicate.ActionJob(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(duplicategroup.ActionJobsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.DuplicateGroupID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "duplicate_group_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *DuplicateGroupQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -759,6 +905,9 @@ func (_q *DuplicateGroupQuery) querySpec() *sqlgraph.QuerySpec {
 		if _q.withKeeperMachine != nil {
 			_spec.Node.AddColumnOnce(duplicategroup.FieldKeeperMachineID)
 		}
+		if _q.withContentIdentity != nil {
+			_spec.Node.AddColumnOnce(duplicategroup.FieldContentIdentityID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -13,6 +13,8 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/mcmx/duplynx/ent/actionaudit"
+	"github.com/mcmx/duplynx/ent/actionjob"
+	"github.com/mcmx/duplynx/ent/contentidentity"
 	"github.com/mcmx/duplynx/ent/duplicategroup"
 	"github.com/mcmx/duplynx/ent/fileinstance"
 	"github.com/mcmx/duplynx/ent/machine"
@@ -88,6 +90,26 @@ func (_u *DuplicateGroupUpdate) ClearKeeperMachineID() *DuplicateGroupUpdate {
 	return _u
 }
 
+// SetContentIdentityID sets the "content_identity_id" field.
+func (_u *DuplicateGroupUpdate) SetContentIdentityID(v uuid.UUID) *DuplicateGroupUpdate {
+	_u.mutation.SetContentIdentityID(v)
+	return _u
+}
+
+// SetNillableContentIdentityID sets the "content_identity_id" field if the given value is not nil.
+func (_u *DuplicateGroupUpdate) SetNillableContentIdentityID(v *uuid.UUID) *DuplicateGroupUpdate {
+	if v != nil {
+		_u.SetContentIdentityID(*v)
+	}
+	return _u
+}
+
+// ClearContentIdentityID clears the value of the "content_identity_id" field.
+func (_u *DuplicateGroupUpdate) ClearContentIdentityID() *DuplicateGroupUpdate {
+	_u.mutation.ClearContentIdentityID()
+	return _u
+}
+
 // SetHash sets the "hash" field.
 func (_u *DuplicateGroupUpdate) SetHash(v string) *DuplicateGroupUpdate {
 	_u.mutation.SetHash(v)
@@ -173,6 +195,11 @@ func (_u *DuplicateGroupUpdate) SetKeeperMachine(v *Machine) *DuplicateGroupUpda
 	return _u.SetKeeperMachineID(v.ID)
 }
 
+// SetContentIdentity sets the "content_identity" edge to the ContentIdentity entity.
+func (_u *DuplicateGroupUpdate) SetContentIdentity(v *ContentIdentity) *DuplicateGroupUpdate {
+	return _u.SetContentIdentityID(v.ID)
+}
+
 // AddFileInstanceIDs adds the "file_instances" edge to the FileInstance entity by IDs.
 func (_u *DuplicateGroupUpdate) AddFileInstanceIDs(ids ...uuid.UUID) *DuplicateGroupUpdate {
 	_u.mutation.AddFileInstanceIDs(ids...)
@@ -203,6 +230,21 @@ func (_u *DuplicateGroupUpdate) AddActionAudits(v ...*ActionAudit) *DuplicateGro
 	return _u.AddActionAuditIDs(ids...)
 }
 
+// AddActionJobIDs adds the "action_jobs" edge to the ActionJob entity by IDs.
+func (_u *DuplicateGroupUpdate) AddActionJobIDs(ids ...uuid.UUID) *DuplicateGroupUpdate {
+	_u.mutation.AddActionJobIDs(ids...)
+	return _u
+}
+
+// AddActionJobs adds the "action_jobs" edges to the ActionJob entity.
+func (_u *DuplicateGroupUpdate) AddActionJobs(v ...*ActionJob) *DuplicateGroupUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddActionJobIDs(ids...)
+}
+
 // Mutation returns the DuplicateGroupMutation object of the builder.
 func (_u *DuplicateGroupUpdate) Mutation() *DuplicateGroupMutation {
 	return _u.mutation
@@ -226,6 +268,12 @@ func (_u *DuplicateGroupUpdate) ClearKeeperMachine() *DuplicateGroupUpdate {
 	return _u
 }
 
+// ClearContentIdentity clears the "content_identity" edge to the ContentIdentity entity.
+func (_u *DuplicateGroupUpdate) ClearContentIdentity() *DuplicateGroupUpdate {
+	_u.mutation.ClearContentIdentity()
+	return _u
+}
+
 // ClearFileInstances clears all "file_instances" edges to the FileInstance entity.
 func (_u *DuplicateGroupUpdate) ClearFileInstances() *DuplicateGroupUpdate {
 	_u.mutation.ClearFileInstances()
@@ -268,9 +316,32 @@ func (_u *DuplicateGroupUpdate) RemoveActionAudits(v ...*ActionAudit) *Duplicate
 	return _u.RemoveActionAuditIDs(ids...)
 }
 
+// ClearActionJobs clears all "action_jobs" edges to the ActionJob entity.
+func (_u *DuplicateGroupUpdate) ClearActionJobs() *DuplicateGroupUpdate {
+	_u.mutation.ClearActionJobs()
+	return _u
+}
+
+// RemoveActionJobIDs removes the "action_jobs" edge to ActionJob entities by IDs.
+func (_u *DuplicateGroupUpdate) RemoveActionJobIDs(ids ...uuid.UUID) *DuplicateGroupUpdate {
+	_u.mutation.RemoveActionJobIDs(ids...)
+	return _u
+}
+
+// RemoveActionJobs removes "action_jobs" edges to ActionJob entities.
+func (_u *DuplicateGroupUpdate) RemoveActionJobs(v ...*ActionJob) *DuplicateGroupUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveActionJobIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *DuplicateGroupUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -297,11 +368,15 @@ func (_u *DuplicateGroupUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *DuplicateGroupUpdate) defaults() {
+func (_u *DuplicateGroupUpdate) defaults() error {
 	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if duplicategroup.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized duplicategroup.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
 		v := duplicategroup.UpdateDefaultUpdateTime()
 		_u.mutation.SetUpdateTime(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -450,6 +525,35 @@ func (_u *DuplicateGroupUpdate) sqlSave(ctx context.Context) (_node int, err err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ContentIdentityCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   duplicategroup.ContentIdentityTable,
+			Columns: []string{duplicategroup.ContentIdentityColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentidentity.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ContentIdentityIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   duplicategroup.ContentIdentityTable,
+			Columns: []string{duplicategroup.ContentIdentityColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentidentity.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.FileInstancesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -540,6 +644,51 @@ func (_u *DuplicateGroupUpdate) sqlSave(ctx context.Context) (_node int, err err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ActionJobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   duplicategroup.ActionJobsTable,
+			Columns: []string{duplicategroup.ActionJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(actionjob.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedActionJobsIDs(); len(nodes) > 0 && !_u.mutation.ActionJobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   duplicategroup.ActionJobsTable,
+			Columns: []string{duplicategroup.ActionJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(actionjob.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ActionJobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   duplicategroup.ActionJobsTable,
+			Columns: []string{duplicategroup.ActionJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(actionjob.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{duplicategroup.Label}
@@ -614,6 +763,26 @@ func (_u *DuplicateGroupUpdateOne) ClearKeeperMachineID() *DuplicateGroupUpdateO
 	return _u
 }
 
+// SetContentIdentityID sets the "content_identity_id" field.
+func (_u *DuplicateGroupUpdateOne) SetContentIdentityID(v uuid.UUID) *DuplicateGroupUpdateOne {
+	_u.mutation.SetContentIdentityID(v)
+	return _u
+}
+
+// SetNillableContentIdentityID sets the "content_identity_id" field if the given value is not nil.
+func (_u *DuplicateGroupUpdateOne) SetNillableContentIdentityID(v *uuid.UUID) *DuplicateGroupUpdateOne {
+	if v != nil {
+		_u.SetContentIdentityID(*v)
+	}
+	return _u
+}
+
+// ClearContentIdentityID clears the value of the "content_identity_id" field.
+func (_u *DuplicateGroupUpdateOne) ClearContentIdentityID() *DuplicateGroupUpdateOne {
+	_u.mutation.ClearContentIdentityID()
+	return _u
+}
+
 // SetHash sets the "hash" field.
 func (_u *DuplicateGroupUpdateOne) SetHash(v string) *DuplicateGroupUpdateOne {
 	_u.mutation.SetHash(v)
@@ -699,6 +868,11 @@ func (_u *DuplicateGroupUpdateOne) SetKeeperMachine(v *Machine) *DuplicateGroupU
 	return _u.SetKeeperMachineID(v.ID)
 }
 
+// SetContentIdentity sets the "content_identity" edge to the ContentIdentity entity.
+func (_u *DuplicateGroupUpdateOne) SetContentIdentity(v *ContentIdentity) *DuplicateGroupUpdateOne {
+	return _u.SetContentIdentityID(v.ID)
+}
+
 // AddFileInstanceIDs adds the "file_instances" edge to the FileInstance entity by IDs.
 func (_u *DuplicateGroupUpdateOne) AddFileInstanceIDs(ids ...uuid.UUID) *DuplicateGroupUpdateOne {
 	_u.mutation.AddFileInstanceIDs(ids...)
@@ -729,6 +903,21 @@ func (_u *DuplicateGroupUpdateOne) AddActionAudits(v ...*ActionAudit) *Duplicate
 	return _u.AddActionAuditIDs(ids...)
 }
 
+// AddActionJobIDs adds the "action_jobs" edge to the ActionJob entity by IDs.
+func (_u *DuplicateGroupUpdateOne) AddActionJobIDs(ids ...uuid.UUID) *DuplicateGroupUpdateOne {
+	_u.mutation.AddActionJobIDs(ids...)
+	return _u
+}
+
+// AddActionJobs adds the "action_jobs" edges to the ActionJob entity.
+func (_u *DuplicateGroupUpdateOne) AddActionJobs(v ...*ActionJob) *DuplicateGroupUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddActionJobIDs(ids...)
+}
+
 // Mutation returns the DuplicateGroupMutation object of the builder.
 func (_u *DuplicateGroupUpdateOne) Mutation() *DuplicateGroupMutation {
 	return _u.mutation
@@ -752,6 +941,12 @@ func (_u *DuplicateGroupUpdateOne) ClearKeeperMachine() *DuplicateGroupUpdateOne
 	return _u
 }
 
+// ClearContentIdentity clears the "content_identity" edge to the ContentIdentity entity.
+func (_u *DuplicateGroupUpdateOne) ClearContentIdentity() *DuplicateGroupUpdateOne {
+	_u.mutation.ClearContentIdentity()
+	return _u
+}
+
 // ClearFileInstances clears all "file_instances" edges to the FileInstance entity.
 func (_u *DuplicateGroupUpdateOne) ClearFileInstances() *DuplicateGroupUpdateOne {
 	_u.mutation.ClearFileInstances()
@@ -794,6 +989,27 @@ func (_u *DuplicateGroupUpdateOne) RemoveActionAudits(v ...*ActionAudit) *Duplic
 	return _u.RemoveActionAuditIDs(ids...)
 }
 
+// ClearActionJobs clears all "action_jobs" edges to the ActionJob entity.
+func (_u *DuplicateGroupUpdateOne) ClearActionJobs() *DuplicateGroupUpdateOne {
+	_u.mutation.ClearActionJobs()
+	return _u
+}
+
+// RemoveActionJobIDs removes the "action_jobs" edge to ActionJob entities by IDs.
+func (_u *DuplicateGroupUpdateOne) RemoveActionJobIDs(ids ...uuid.UUID) *DuplicateGroupUpdateOne {
+	_u.mutation.RemoveActionJobIDs(ids...)
+	return _u
+}
+
+// RemoveActionJobs removes "action_jobs" edges to ActionJob entities.
+func (_u *DuplicateGroupUpdateOne) RemoveActionJobs(v ...*ActionJob) *DuplicateGroupUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveActionJobIDs(ids...)
+}
+
 // Where appends a list predicates to the DuplicateGroupUpdate builder.
 func (_u *DuplicateGroupUpdateOne) Where(ps ...predicate.DuplicateGroup) *DuplicateGroupUpdateOne {
 	_u.mutation.Where(ps...)
@@ -809,7 +1025,9 @@ func (_u *DuplicateGroupUpdateOne) Select(field string, fields ...string) *Dupli
 
 // Save executes the query and returns the updated DuplicateGroup entity.
 func (_u *DuplicateGroupUpdateOne) Save(ctx context.Context) (*DuplicateGroup, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -836,11 +1054,15 @@ func (_u *DuplicateGroupUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *DuplicateGroupUpdateOne) defaults() {
+func (_u *DuplicateGroupUpdateOne) defaults() error {
 	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if duplicategroup.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized duplicategroup.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
 		v := duplicategroup.UpdateDefaultUpdateTime()
 		_u.mutation.SetUpdateTime(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -1006,6 +1228,35 @@ func (_u *DuplicateGroupUpdateOne) sqlSave(ctx context.Context) (_node *Duplicat
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ContentIdentityCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   duplicategroup.ContentIdentityTable,
+			Columns: []string{duplicategroup.ContentIdentityColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentidentity.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ContentIdentityIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   duplicategroup.ContentIdentityTable,
+			Columns: []string{duplicategroup.ContentIdentityColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentidentity.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.FileInstancesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1096,6 +1347,51 @@ func (_u *DuplicateGroupUpdateOne) sqlSave(ctx context.Context) (_node *Duplicat
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ActionJobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   duplicategroup.ActionJobsTable,
+			Columns: []string{duplicategroup.ActionJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(actionjob.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedActionJobsIDs(); len(nodes) > 0 && !_u.mutation.ActionJobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   duplicategroup.ActionJobsTable,
+			Columns: []string{duplicategroup.ActionJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(actionjob.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ActionJobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   duplicategroup.ActionJobsTable,
+			Columns: []string{duplicategroup.ActionJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(actionjob.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &DuplicateGroup{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -13,11 +13,19 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/mcmx/duplynx/ent/actionaudit"
+	"github.com/mcmx/duplynx/ent/actionjob"
+	"github.com/mcmx/duplynx/ent/contentevent"
+	"github.com/mcmx/duplynx/ent/contentidentity"
 	"github.com/mcmx/duplynx/ent/duplicategroup"
 	"github.com/mcmx/duplynx/ent/fileinstance"
 	"github.com/mcmx/duplynx/ent/machine"
 	"github.com/mcmx/duplynx/ent/scan"
+	"github.com/mcmx/duplynx/ent/scanschedule"
+	"github.com/mcmx/duplynx/ent/scanschedulerun"
+	"github.com/mcmx/duplynx/ent/scantarget"
 	"github.com/mcmx/duplynx/ent/tenant"
+	"github.com/mcmx/duplynx/ent/tenantsecret"
+	"github.com/mcmx/duplynx/ent/tenanttombstone"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -78,12 +86,20 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			actionaudit.Table:    actionaudit.ValidColumn,
-			duplicategroup.Table: duplicategroup.ValidColumn,
-			fileinstance.Table:   fileinstance.ValidColumn,
-			machine.Table:        machine.ValidColumn,
-			scan.Table:           scan.ValidColumn,
-			tenant.Table:         tenant.ValidColumn,
+			actionaudit.Table:     actionaudit.ValidColumn,
+			actionjob.Table:       actionjob.ValidColumn,
+			contentevent.Table:    contentevent.ValidColumn,
+			contentidentity.Table: contentidentity.ValidColumn,
+			duplicategroup.Table:  duplicategroup.ValidColumn,
+			fileinstance.Table:    fileinstance.ValidColumn,
+			machine.Table:         machine.ValidColumn,
+			scan.Table:            scan.ValidColumn,
+			scanschedule.Table:    scanschedule.ValidColumn,
+			scanschedulerun.Table: scanschedulerun.ValidColumn,
+			scantarget.Table:      scantarget.ValidColumn,
+			tenant.Table:          tenant.ValidColumn,
+			tenantsecret.Table:    tenantsecret.ValidColumn,
+			tenanttombstone.Table: tenanttombstone.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -13,6 +13,7 @@ import (
 	"github.com/mcmx/duplynx/ent/duplicategroup"
 	"github.com/mcmx/duplynx/ent/fileinstance"
 	"github.com/mcmx/duplynx/ent/machine"
+	"github.com/mcmx/duplynx/ent/tenant"
 )
 
 // FileInstance is the model entity for the FileInstance schema.
@@ -24,6 +25,8 @@ type FileInstance struct {
 	CreateTime time.Time `json:"create_time,omitempty"`
 	// UpdateTime holds the value of the "update_time" field.
 	UpdateTime time.Time `json:"update_time,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
 	// DuplicateGroupID holds the value of the "duplicate_group_id" field.
 	DuplicateGroupID uuid.UUID `json:"duplicate_group_id,omitempty"`
 	// MachineID holds the value of the "machine_id" field.
@@ -46,13 +49,26 @@ type FileInstance struct {
 
 // FileInstanceEdges holds the relations/edges for other nodes in the graph.
 type FileInstanceEdges struct {
+	// Tenant holds the value of the tenant edge.
+	Tenant *Tenant `json:"tenant,omitempty"`
 	// DuplicateGroup holds the value of the duplicate_group edge.
 	DuplicateGroup *DuplicateGroup `json:"duplicate_group,omitempty"`
 	// Machine holds the value of the machine edge.
 	Machine *Machine `json:"machine,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
+}
+
+// TenantOrErr returns the Tenant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FileInstanceEdges) TenantOrErr() (*Tenant, error) {
+	if e.Tenant != nil {
+		return e.Tenant, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: tenant.Label}
+	}
+	return nil, &NotLoadedError{edge: "tenant"}
 }
 
 // DuplicateGroupOrErr returns the DuplicateGroup value or an error if the edge
@@ -60,7 +76,7 @@ type FileInstanceEdges struct {
 func (e FileInstanceEdges) DuplicateGroupOrErr() (*DuplicateGroup, error) {
 	if e.DuplicateGroup != nil {
 		return e.DuplicateGroup, nil
-	} else if e.loadedTypes[0] {
+	} else if e.loadedTypes[1] {
 		return nil, &NotFoundError{label: duplicategroup.Label}
 	}
 	return nil, &NotLoadedError{edge: "duplicate_group"}
@@ -71,7 +87,7 @@ func (e FileInstanceEdges) DuplicateGroupOrErr() (*DuplicateGroup, error) {
 func (e FileInstanceEdges) MachineOrErr() (*Machine, error) {
 	if e.Machine != nil {
 		return e.Machine, nil
-	} else if e.loadedTypes[1] {
+	} else if e.loadedTypes[2] {
 		return nil, &NotFoundError{label: machine.Label}
 	}
 	return nil, &NotLoadedError{edge: "machine"}
@@ -90,7 +106,7 @@ func (*FileInstance) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullString)
 		case fileinstance.FieldCreateTime, fileinstance.FieldUpdateTime, fileinstance.FieldLastSeenAt:
 			values[i] = new(sql.NullTime)
-		case fileinstance.FieldID, fileinstance.FieldDuplicateGroupID, fileinstance.FieldMachineID:
+		case fileinstance.FieldID, fileinstance.FieldTenantID, fileinstance.FieldDuplicateGroupID, fileinstance.FieldMachineID:
 			values[i] = new(uuid.UUID)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -125,6 +141,12 @@ func (_m *FileInstance) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UpdateTime = value.Time
 			}
+		case fileinstance.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
 		case fileinstance.FieldDuplicateGroupID:
 			if value, ok := values[i].(*uuid.UUID); !ok {
 				return fmt.Errorf("unexpected type %T for field duplicate_group_id", values[i])
@@ -180,6 +202,11 @@ func (_m *FileInstance) Value(name string) (ent.Value, error) {
 	return _m.selectValues.Get(name)
 }
 
+// QueryTenant queries the "tenant" edge of the FileInstance entity.
+func (_m *FileInstance) QueryTenant() *TenantQuery {
+	return NewFileInstanceClient(_m.config).QueryTenant(_m)
+}
+
 // QueryDuplicateGroup queries the "duplicate_group" edge of the FileInstance entity.
 func (_m *FileInstance) QueryDuplicateGroup() *DuplicateGroupQuery {
 	return NewFileInstanceClient(_m.config).QueryDuplicateGroup(_m)
@@ -219,6 +246,9 @@ func (_m *FileInstance) String() string {
 	builder.WriteString("update_time=")
 	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
 	builder.WriteString(", ")
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
 	builder.WriteString("duplicate_group_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.DuplicateGroupID))
 	builder.WriteString(", ")
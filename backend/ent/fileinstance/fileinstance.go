@@ -5,6 +5,7 @@ package fileinstance
 import (
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
@@ -19,6 +20,8 @@ const (
 	FieldCreateTime = "create_time"
 	// FieldUpdateTime holds the string denoting the update_time field in the database.
 	FieldUpdateTime = "update_time"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
 	// FieldDuplicateGroupID holds the string denoting the duplicate_group_id field in the database.
 	FieldDuplicateGroupID = "duplicate_group_id"
 	// FieldMachineID holds the string denoting the machine_id field in the database.
@@ -33,12 +36,21 @@ const (
 	FieldLastSeenAt = "last_seen_at"
 	// FieldQuarantined holds the string denoting the quarantined field in the database.
 	FieldQuarantined = "quarantined"
+	// EdgeTenant holds the string denoting the tenant edge name in mutations.
+	EdgeTenant = "tenant"
 	// EdgeDuplicateGroup holds the string denoting the duplicate_group edge name in mutations.
 	EdgeDuplicateGroup = "duplicate_group"
 	// EdgeMachine holds the string denoting the machine edge name in mutations.
 	EdgeMachine = "machine"
 	// Table holds the table name of the fileinstance in the database.
 	Table = "file_instances"
+	// TenantTable is the table that holds the tenant relation/edge.
+	TenantTable = "file_instances"
+	// TenantInverseTable is the table name for the Tenant entity.
+	// It exists in this package in order to avoid circular dependency with the "tenant" package.
+	TenantInverseTable = "tenants"
+	// TenantColumn is the table column denoting the tenant relation/edge.
+	TenantColumn = "tenant_id"
 	// DuplicateGroupTable is the table that holds the duplicate_group relation/edge.
 	DuplicateGroupTable = "file_instances"
 	// DuplicateGroupInverseTable is the table name for the DuplicateGroup entity.
@@ -60,6 +72,7 @@ var Columns = []string{
 	FieldID,
 	FieldCreateTime,
 	FieldUpdateTime,
+	FieldTenantID,
 	FieldDuplicateGroupID,
 	FieldMachineID,
 	FieldPath,
@@ -79,7 +92,14 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/mcmx/duplynx/ent/runtime"
 var (
+	Hooks        [1]ent.Hook
+	Interceptors [1]ent.Interceptor
 	// DefaultCreateTime holds the default value on creation for the "create_time" field.
 	DefaultCreateTime func() time.Time
 	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
@@ -114,6 +134,11 @@ func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
 }
 
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
 // ByDuplicateGroupID orders the results by the duplicate_group_id field.
 func ByDuplicateGroupID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDuplicateGroupID, opts...).ToFunc()
@@ -149,6 +174,13 @@ func ByQuarantined(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldQuarantined, opts...).ToFunc()
 }
 
+// ByTenantField orders the results by tenant field.
+func ByTenantField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTenantStep(), sql.OrderByField(field, opts...))
+	}
+}
+
 // ByDuplicateGroupField orders the results by duplicate_group field.
 func ByDuplicateGroupField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -162,6 +194,13 @@ func ByMachineField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newMachineStep(), sql.OrderByField(field, opts...))
 	}
 }
+func newTenantStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TenantInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TenantTable, TenantColumn),
+	)
+}
 func newDuplicateGroupStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
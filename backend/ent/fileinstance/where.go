@@ -66,6 +66,11 @@ func UpdateTime(v time.Time) predicate.FileInstance {
 	return predicate.FileInstance(sql.FieldEQ(FieldUpdateTime, v))
 }
 
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.FileInstance {
+	return predicate.FileInstance(sql.FieldEQ(FieldTenantID, v))
+}
+
 // DuplicateGroupID applies equality check predicate on the "duplicate_group_id" field. It's identical to DuplicateGroupIDEQ.
 func DuplicateGroupID(v uuid.UUID) predicate.FileInstance {
 	return predicate.FileInstance(sql.FieldEQ(FieldDuplicateGroupID, v))
@@ -181,6 +186,26 @@ func UpdateTimeLTE(v time.Time) predicate.FileInstance {
 	return predicate.FileInstance(sql.FieldLTE(FieldUpdateTime, v))
 }
 
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.FileInstance {
+	return predicate.FileInstance(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.FileInstance {
+	return predicate.FileInstance(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.FileInstance {
+	return predicate.FileInstance(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.FileInstance {
+	return predicate.FileInstance(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
 // DuplicateGroupIDEQ applies the EQ predicate on the "duplicate_group_id" field.
 func DuplicateGroupIDEQ(v uuid.UUID) predicate.FileInstance {
 	return predicate.FileInstance(sql.FieldEQ(FieldDuplicateGroupID, v))
@@ -441,6 +466,29 @@ func QuarantinedNEQ(v bool) predicate.FileInstance {
 	return predicate.FileInstance(sql.FieldNEQ(FieldQuarantined, v))
 }
 
+// HasTenant applies the HasEdge predicate on the "tenant" edge.
+func HasTenant() predicate.FileInstance {
+	return predicate.FileInstance(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TenantTable, TenantColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTenantWith applies the HasEdge predicate on the "tenant" edge with a given conditions (other predicates).
+func HasTenantWith(preds ...predicate.Tenant) predicate.FileInstance {
+	return predicate.FileInstance(func(s *sql.Selector) {
+		step := newTenantStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasDuplicateGroup applies the HasEdge predicate on the "duplicate_group" edge.
 func HasDuplicateGroup() predicate.FileInstance {
 	return predicate.FileInstance(func(s *sql.Selector) {
@@ -14,6 +14,7 @@ import (
 	"github.com/mcmx/duplynx/ent/duplicategroup"
 	"github.com/mcmx/duplynx/ent/fileinstance"
 	"github.com/mcmx/duplynx/ent/machine"
+	"github.com/mcmx/duplynx/ent/tenant"
 )
 
 // FileInstanceCreate is the builder for creating a FileInstance entity.
@@ -51,6 +52,12 @@ func (_c *FileInstanceCreate) SetNillableUpdateTime(v *time.Time) *FileInstanceC
 	return _c
 }
 
+// SetTenantID sets the "tenant_id" field.
+func (_c *FileInstanceCreate) SetTenantID(v uuid.UUID) *FileInstanceCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
 // SetDuplicateGroupID sets the "duplicate_group_id" field.
 func (_c *FileInstanceCreate) SetDuplicateGroupID(v uuid.UUID) *FileInstanceCreate {
 	_c.mutation.SetDuplicateGroupID(v)
@@ -123,6 +130,11 @@ func (_c *FileInstanceCreate) SetNillableID(v *uuid.UUID) *FileInstanceCreate {
 	return _c
 }
 
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_c *FileInstanceCreate) SetTenant(v *Tenant) *FileInstanceCreate {
+	return _c.SetTenantID(v.ID)
+}
+
 // SetDuplicateGroup sets the "duplicate_group" edge to the DuplicateGroup entity.
 func (_c *FileInstanceCreate) SetDuplicateGroup(v *DuplicateGroup) *FileInstanceCreate {
 	return _c.SetDuplicateGroupID(v.ID)
@@ -140,7 +152,9 @@ func (_c *FileInstanceCreate) Mutation() *FileInstanceMutation {
 
 // Save creates the FileInstance in the database.
 func (_c *FileInstanceCreate) Save(ctx context.Context) (*FileInstance, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -167,16 +181,25 @@ func (_c *FileInstanceCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *FileInstanceCreate) defaults() {
+func (_c *FileInstanceCreate) defaults() error {
 	if _, ok := _c.mutation.CreateTime(); !ok {
+		if fileinstance.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized fileinstance.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
 		v := fileinstance.DefaultCreateTime()
 		_c.mutation.SetCreateTime(v)
 	}
 	if _, ok := _c.mutation.UpdateTime(); !ok {
+		if fileinstance.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized fileinstance.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
 		v := fileinstance.DefaultUpdateTime()
 		_c.mutation.SetUpdateTime(v)
 	}
 	if _, ok := _c.mutation.LastSeenAt(); !ok {
+		if fileinstance.DefaultLastSeenAt == nil {
+			return fmt.Errorf("ent: uninitialized fileinstance.DefaultLastSeenAt (forgotten import ent/runtime?)")
+		}
 		v := fileinstance.DefaultLastSeenAt()
 		_c.mutation.SetLastSeenAt(v)
 	}
@@ -185,9 +208,13 @@ func (_c *FileInstanceCreate) defaults() {
 		_c.mutation.SetQuarantined(v)
 	}
 	if _, ok := _c.mutation.ID(); !ok {
+		if fileinstance.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized fileinstance.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := fileinstance.DefaultID()
 		_c.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -198,6 +225,9 @@ func (_c *FileInstanceCreate) check() error {
 	if _, ok := _c.mutation.UpdateTime(); !ok {
 		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "FileInstance.update_time"`)}
 	}
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "FileInstance.tenant_id"`)}
+	}
 	if _, ok := _c.mutation.DuplicateGroupID(); !ok {
 		return &ValidationError{Name: "duplicate_group_id", err: errors.New(`ent: missing required field "FileInstance.duplicate_group_id"`)}
 	}
@@ -224,6 +254,9 @@ func (_c *FileInstanceCreate) check() error {
 	if _, ok := _c.mutation.Quarantined(); !ok {
 		return &ValidationError{Name: "quarantined", err: errors.New(`ent: missing required field "FileInstance.quarantined"`)}
 	}
+	if len(_c.mutation.TenantIDs()) == 0 {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required edge "FileInstance.tenant"`)}
+	}
 	if len(_c.mutation.DuplicateGroupIDs()) == 0 {
 		return &ValidationError{Name: "duplicate_group", err: errors.New(`ent: missing required edge "FileInstance.duplicate_group"`)}
 	}
@@ -293,6 +326,23 @@ func (_c *FileInstanceCreate) createSpec() (*FileInstance, *sqlgraph.CreateSpec)
 		_spec.SetField(fileinstance.FieldQuarantined, field.TypeBool, value)
 		_node.Quarantined = value
 	}
+	if nodes := _c.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   fileinstance.TenantTable,
+			Columns: []string{fileinstance.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TenantID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.DuplicateGroupIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
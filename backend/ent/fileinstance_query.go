@@ -16,6 +16,7 @@ import (
 	"github.com/mcmx/duplynx/ent/fileinstance"
 	"github.com/mcmx/duplynx/ent/machine"
 	"github.com/mcmx/duplynx/ent/predicate"
+	"github.com/mcmx/duplynx/ent/tenant"
 )
 
 // FileInstanceQuery is the builder for querying FileInstance entities.
@@ -25,6 +26,7 @@ type FileInstanceQuery struct {
 	order              []fileinstance.OrderOption
 	inters             []Interceptor
 	predicates         []predicate.FileInstance
+	withTenant         *TenantQuery
 	withDuplicateGroup *DuplicateGroupQuery
 	withMachine        *MachineQuery
 	// intermediate query (i.e. traversal path).
@@ -63,6 +65,28 @@ func (_q *FileInstanceQuery) Order(o ...fileinstance.OrderOption) *FileInstanceQ
 	return _q
 }
 
+// QueryTenant chains the current query on the "tenant" edge.
+func (_q *FileInstanceQuery) QueryTenant() *TenantQuery {
+	query := (&TenantClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(fileinstance.Table, fileinstance.FieldID, selector),
+			sqlgraph.To(tenant.Table, tenant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, fileinstance.TenantTable, fileinstance.TenantColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryDuplicateGroup chains the current query on the "duplicate_group" edge.
 func (_q *FileInstanceQuery) QueryDuplicateGroup() *DuplicateGroupQuery {
 	query := (&DuplicateGroupClient{config: _q.config}).Query()
@@ -299,6 +323,7 @@ func (_q *FileInstanceQuery) Clone() *FileInstanceQuery {
 		order:              append([]fileinstance.OrderOption{}, _q.order...),
 		inters:             append([]Interceptor{}, _q.inters...),
 		predicates:         append([]predicate.FileInstance{}, _q.predicates...),
+		withTenant:         _q.withTenant.Clone(),
 		withDuplicateGroup: _q.withDuplicateGroup.Clone(),
 		withMachine:        _q.withMachine.Clone(),
 		// clone intermediate query.
@@ -307,6 +332,17 @@ func (_q *FileInstanceQuery) Clone() *FileInstanceQuery {
 	}
 }
 
+// WithTenant tells the query-builder to eager-load the nodes that are connected to
+// the "tenant" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *FileInstanceQuery) WithTenant(opts ...func(*TenantQuery)) *FileInstanceQuery {
+	query := (&TenantClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTenant = query
+	return _q
+}
+
 // WithDuplicateGroup tells the query-builder to eager-load the nodes that are connected to
 // the "duplicate_group" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *FileInstanceQuery) WithDuplicateGroup(opts ...func(*DuplicateGroupQuery)) *FileInstanceQuery {
@@ -407,7 +443,8 @@ func (_q *FileInstanceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 	var (
 		nodes       = []*FileInstance{}
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
+			_q.withTenant != nil,
 			_q.withDuplicateGroup != nil,
 			_q.withMachine != nil,
 		}
@@ -430,6 +467,12 @@ func (_q *FileInstanceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := _q.withTenant; query != nil {
+		if err := _q.loadTenant(ctx, query, nodes, nil,
+			func(n *FileInstance, e *Tenant) { n.Edges.Tenant = e }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withDuplicateGroup; query != nil {
 		if err := _q.loadDuplicateGroup(ctx, query, nodes, nil,
 			func(n *FileInstance, e *DuplicateGroup) { n.Edges.DuplicateGroup = e }); err != nil {
@@ -445,6 +488,35 @@ func (_q *FileInstanceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 	return nodes, nil
 }
 
+func (_q *FileInstanceQuery) loadTenant(ctx context.Context, query *TenantQuery, nodes []*FileInstance, init func(*FileInstance), assign func(*FileInstance, *Tenant)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*FileInstance)
+	for i := range nodes {
+		fk := nodes[i].TenantID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(tenant.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "tenant_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 func (_q *FileInstanceQuery) loadDuplicateGroup(ctx context.Context, query *DuplicateGroupQuery, nodes []*FileInstance, init func(*FileInstance), assign func(*FileInstance, *DuplicateGroup)) error {
 	ids := make([]uuid.UUID, 0, len(nodes))
 	nodeids := make(map[uuid.UUID][]*FileInstance)
@@ -529,6 +601,9 @@ func (_q *FileInstanceQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if _q.withTenant != nil {
+			_spec.Node.AddColumnOnce(fileinstance.FieldTenantID)
+		}
 		if _q.withDuplicateGroup != nil {
 			_spec.Node.AddColumnOnce(fileinstance.FieldDuplicateGroupID)
 		}
@@ -16,6 +16,7 @@ import (
 	"github.com/mcmx/duplynx/ent/fileinstance"
 	"github.com/mcmx/duplynx/ent/machine"
 	"github.com/mcmx/duplynx/ent/predicate"
+	"github.com/mcmx/duplynx/ent/tenant"
 )
 
 // FileInstanceUpdate is the builder for updating FileInstance entities.
@@ -37,6 +38,20 @@ func (_u *FileInstanceUpdate) SetUpdateTime(v time.Time) *FileInstanceUpdate {
 	return _u
 }
 
+// SetTenantID sets the "tenant_id" field.
+func (_u *FileInstanceUpdate) SetTenantID(v uuid.UUID) *FileInstanceUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *FileInstanceUpdate) SetNillableTenantID(v *uuid.UUID) *FileInstanceUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
 // SetDuplicateGroupID sets the "duplicate_group_id" field.
 func (_u *FileInstanceUpdate) SetDuplicateGroupID(v uuid.UUID) *FileInstanceUpdate {
 	_u.mutation.SetDuplicateGroupID(v)
@@ -142,6 +157,11 @@ func (_u *FileInstanceUpdate) SetNillableQuarantined(v *bool) *FileInstanceUpdat
 	return _u
 }
 
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *FileInstanceUpdate) SetTenant(v *Tenant) *FileInstanceUpdate {
+	return _u.SetTenantID(v.ID)
+}
+
 // SetDuplicateGroup sets the "duplicate_group" edge to the DuplicateGroup entity.
 func (_u *FileInstanceUpdate) SetDuplicateGroup(v *DuplicateGroup) *FileInstanceUpdate {
 	return _u.SetDuplicateGroupID(v.ID)
@@ -157,6 +177,12 @@ func (_u *FileInstanceUpdate) Mutation() *FileInstanceMutation {
 	return _u.mutation
 }
 
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *FileInstanceUpdate) ClearTenant() *FileInstanceUpdate {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
 // ClearDuplicateGroup clears the "duplicate_group" edge to the DuplicateGroup entity.
 func (_u *FileInstanceUpdate) ClearDuplicateGroup() *FileInstanceUpdate {
 	_u.mutation.ClearDuplicateGroup()
@@ -171,7 +197,9 @@ func (_u *FileInstanceUpdate) ClearMachine() *FileInstanceUpdate {
 
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *FileInstanceUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -198,11 +226,15 @@ func (_u *FileInstanceUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *FileInstanceUpdate) defaults() {
+func (_u *FileInstanceUpdate) defaults() error {
 	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if fileinstance.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized fileinstance.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
 		v := fileinstance.UpdateDefaultUpdateTime()
 		_u.mutation.SetUpdateTime(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -212,6 +244,9 @@ func (_u *FileInstanceUpdate) check() error {
 			return &ValidationError{Name: "size_bytes", err: fmt.Errorf(`ent: validator failed for field "FileInstance.size_bytes": %w`, err)}
 		}
 	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "FileInstance.tenant"`)
+	}
 	if _u.mutation.DuplicateGroupCleared() && len(_u.mutation.DuplicateGroupIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "FileInstance.duplicate_group"`)
 	}
@@ -254,6 +289,35 @@ func (_u *FileInstanceUpdate) sqlSave(ctx context.Context) (_node int, err error
 	if value, ok := _u.mutation.Quarantined(); ok {
 		_spec.SetField(fileinstance.FieldQuarantined, field.TypeBool, value)
 	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   fileinstance.TenantTable,
+			Columns: []string{fileinstance.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   fileinstance.TenantTable,
+			Columns: []string{fileinstance.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.DuplicateGroupCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -338,6 +402,20 @@ func (_u *FileInstanceUpdateOne) SetUpdateTime(v time.Time) *FileInstanceUpdateO
 	return _u
 }
 
+// SetTenantID sets the "tenant_id" field.
+func (_u *FileInstanceUpdateOne) SetTenantID(v uuid.UUID) *FileInstanceUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *FileInstanceUpdateOne) SetNillableTenantID(v *uuid.UUID) *FileInstanceUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
 // SetDuplicateGroupID sets the "duplicate_group_id" field.
 func (_u *FileInstanceUpdateOne) SetDuplicateGroupID(v uuid.UUID) *FileInstanceUpdateOne {
 	_u.mutation.SetDuplicateGroupID(v)
@@ -443,6 +521,11 @@ func (_u *FileInstanceUpdateOne) SetNillableQuarantined(v *bool) *FileInstanceUp
 	return _u
 }
 
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *FileInstanceUpdateOne) SetTenant(v *Tenant) *FileInstanceUpdateOne {
+	return _u.SetTenantID(v.ID)
+}
+
 // SetDuplicateGroup sets the "duplicate_group" edge to the DuplicateGroup entity.
 func (_u *FileInstanceUpdateOne) SetDuplicateGroup(v *DuplicateGroup) *FileInstanceUpdateOne {
 	return _u.SetDuplicateGroupID(v.ID)
@@ -458,6 +541,12 @@ func (_u *FileInstanceUpdateOne) Mutation() *FileInstanceMutation {
 	return _u.mutation
 }
 
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *FileInstanceUpdateOne) ClearTenant() *FileInstanceUpdateOne {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
 // ClearDuplicateGroup clears the "duplicate_group" edge to the DuplicateGroup entity.
 func (_u *FileInstanceUpdateOne) ClearDuplicateGroup() *FileInstanceUpdateOne {
 	_u.mutation.ClearDuplicateGroup()
@@ -485,7 +574,9 @@ func (_u *FileInstanceUpdateOne) Select(field string, fields ...string) *FileIns
 
 // Save executes the query and returns the updated FileInstance entity.
 func (_u *FileInstanceUpdateOne) Save(ctx context.Context) (*FileInstance, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -512,11 +603,15 @@ func (_u *FileInstanceUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *FileInstanceUpdateOne) defaults() {
+func (_u *FileInstanceUpdateOne) defaults() error {
 	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if fileinstance.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized fileinstance.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
 		v := fileinstance.UpdateDefaultUpdateTime()
 		_u.mutation.SetUpdateTime(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -526,6 +621,9 @@ func (_u *FileInstanceUpdateOne) check() error {
 			return &ValidationError{Name: "size_bytes", err: fmt.Errorf(`ent: validator failed for field "FileInstance.size_bytes": %w`, err)}
 		}
 	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "FileInstance.tenant"`)
+	}
 	if _u.mutation.DuplicateGroupCleared() && len(_u.mutation.DuplicateGroupIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "FileInstance.duplicate_group"`)
 	}
@@ -585,6 +683,35 @@ func (_u *FileInstanceUpdateOne) sqlSave(ctx context.Context) (_node *FileInstan
 	if value, ok := _u.mutation.Quarantined(); ok {
 		_spec.SetField(fileinstance.FieldQuarantined, field.TypeBool, value)
 	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   fileinstance.TenantTable,
+			Columns: []string{fileinstance.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   fileinstance.TenantTable,
+			Columns: []string{fileinstance.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.DuplicateGroupCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
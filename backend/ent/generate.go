@@ -1,3 +1,3 @@
 package ent
 
-//go:generate go run entgo.io/ent/cmd/ent generate ./schema
+//go:generate go run entgo.io/ent/cmd/ent generate --feature intercept,sql/execquery,sql/versioned-migration ./schema
@@ -21,6 +21,42 @@ func (f ActionAuditFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ActionAuditMutation", m)
 }
 
+// The ActionJobFunc type is an adapter to allow the use of ordinary
+// function as ActionJob mutator.
+type ActionJobFunc func(context.Context, *ent.ActionJobMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ActionJobFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ActionJobMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ActionJobMutation", m)
+}
+
+// The ContentEventFunc type is an adapter to allow the use of ordinary
+// function as ContentEvent mutator.
+type ContentEventFunc func(context.Context, *ent.ContentEventMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ContentEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ContentEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ContentEventMutation", m)
+}
+
+// The ContentIdentityFunc type is an adapter to allow the use of ordinary
+// function as ContentIdentity mutator.
+type ContentIdentityFunc func(context.Context, *ent.ContentIdentityMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ContentIdentityFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ContentIdentityMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ContentIdentityMutation", m)
+}
+
 // The DuplicateGroupFunc type is an adapter to allow the use of ordinary
 // function as DuplicateGroup mutator.
 type DuplicateGroupFunc func(context.Context, *ent.DuplicateGroupMutation) (ent.Value, error)
@@ -69,6 +105,42 @@ func (f ScanFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ScanMutation", m)
 }
 
+// The ScanScheduleFunc type is an adapter to allow the use of ordinary
+// function as ScanSchedule mutator.
+type ScanScheduleFunc func(context.Context, *ent.ScanScheduleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ScanScheduleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ScanScheduleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ScanScheduleMutation", m)
+}
+
+// The ScanScheduleRunFunc type is an adapter to allow the use of ordinary
+// function as ScanScheduleRun mutator.
+type ScanScheduleRunFunc func(context.Context, *ent.ScanScheduleRunMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ScanScheduleRunFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ScanScheduleRunMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ScanScheduleRunMutation", m)
+}
+
+// The ScanTargetFunc type is an adapter to allow the use of ordinary
+// function as ScanTarget mutator.
+type ScanTargetFunc func(context.Context, *ent.ScanTargetMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ScanTargetFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ScanTargetMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ScanTargetMutation", m)
+}
+
 // The TenantFunc type is an adapter to allow the use of ordinary
 // function as Tenant mutator.
 type TenantFunc func(context.Context, *ent.TenantMutation) (ent.Value, error)
@@ -81,6 +153,30 @@ func (f TenantFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, erro
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TenantMutation", m)
 }
 
+// The TenantSecretFunc type is an adapter to allow the use of ordinary
+// function as TenantSecret mutator.
+type TenantSecretFunc func(context.Context, *ent.TenantSecretMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TenantSecretFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TenantSecretMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TenantSecretMutation", m)
+}
+
+// The TenantTombstoneFunc type is an adapter to allow the use of ordinary
+// function as TenantTombstone mutator.
+type TenantTombstoneFunc func(context.Context, *ent.TenantTombstoneMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TenantTombstoneFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TenantTombstoneMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TenantTombstoneMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
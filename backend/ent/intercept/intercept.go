@@ -0,0 +1,539 @@
+// Code generated by ent, DO NOT EDIT.
+
+package intercept
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/mcmx/duplynx/ent"
+	"github.com/mcmx/duplynx/ent/actionaudit"
+	"github.com/mcmx/duplynx/ent/actionjob"
+	"github.com/mcmx/duplynx/ent/contentevent"
+	"github.com/mcmx/duplynx/ent/contentidentity"
+	"github.com/mcmx/duplynx/ent/duplicategroup"
+	"github.com/mcmx/duplynx/ent/fileinstance"
+	"github.com/mcmx/duplynx/ent/machine"
+	"github.com/mcmx/duplynx/ent/predicate"
+	"github.com/mcmx/duplynx/ent/scan"
+	"github.com/mcmx/duplynx/ent/scanschedule"
+	"github.com/mcmx/duplynx/ent/scanschedulerun"
+	"github.com/mcmx/duplynx/ent/scantarget"
+	"github.com/mcmx/duplynx/ent/tenant"
+	"github.com/mcmx/duplynx/ent/tenantsecret"
+	"github.com/mcmx/duplynx/ent/tenanttombstone"
+)
+
+// The Query interface represents an operation that queries a graph.
+// By using this interface, users can write generic code that manipulates
+// query builders of different types.
+type Query interface {
+	// Type returns the string representation of the query type.
+	Type() string
+	// Limit the number of records to be returned by this query.
+	Limit(int)
+	// Offset to start from.
+	Offset(int)
+	// Unique configures the query builder to filter duplicate records.
+	Unique(bool)
+	// Order specifies how the records should be ordered.
+	Order(...func(*sql.Selector))
+	// WhereP appends storage-level predicates to the query builder. Using this method, users
+	// can use type-assertion to append predicates that do not depend on any generated package.
+	WhereP(...func(*sql.Selector))
+}
+
+// The Func type is an adapter that allows ordinary functions to be used as interceptors.
+// Unlike traversal functions, interceptors are skipped during graph traversals. Note that the
+// implementation of Func is different from the one defined in entgo.io/ent.InterceptFunc.
+type Func func(context.Context, Query) error
+
+// Intercept calls f(ctx, q) and then applied the next Querier.
+func (f Func) Intercept(next ent.Querier) ent.Querier {
+	return ent.QuerierFunc(func(ctx context.Context, q ent.Query) (ent.Value, error) {
+		query, err := NewQuery(q)
+		if err != nil {
+			return nil, err
+		}
+		if err := f(ctx, query); err != nil {
+			return nil, err
+		}
+		return next.Query(ctx, q)
+	})
+}
+
+// The TraverseFunc type is an adapter to allow the use of ordinary function as Traverser.
+// If f is a function with the appropriate signature, TraverseFunc(f) is a Traverser that calls f.
+type TraverseFunc func(context.Context, Query) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseFunc) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseFunc) Traverse(ctx context.Context, q ent.Query) error {
+	query, err := NewQuery(q)
+	if err != nil {
+		return err
+	}
+	return f(ctx, query)
+}
+
+// The ActionAuditFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ActionAuditFunc func(context.Context, *ent.ActionAuditQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ActionAuditFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ActionAuditQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ActionAuditQuery", q)
+}
+
+// The TraverseActionAudit type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseActionAudit func(context.Context, *ent.ActionAuditQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseActionAudit) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseActionAudit) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ActionAuditQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ActionAuditQuery", q)
+}
+
+// The ActionJobFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ActionJobFunc func(context.Context, *ent.ActionJobQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ActionJobFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ActionJobQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ActionJobQuery", q)
+}
+
+// The TraverseActionJob type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseActionJob func(context.Context, *ent.ActionJobQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseActionJob) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseActionJob) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ActionJobQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ActionJobQuery", q)
+}
+
+// The ContentEventFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ContentEventFunc func(context.Context, *ent.ContentEventQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ContentEventFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ContentEventQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ContentEventQuery", q)
+}
+
+// The TraverseContentEvent type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseContentEvent func(context.Context, *ent.ContentEventQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseContentEvent) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseContentEvent) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ContentEventQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ContentEventQuery", q)
+}
+
+// The ContentIdentityFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ContentIdentityFunc func(context.Context, *ent.ContentIdentityQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ContentIdentityFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ContentIdentityQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ContentIdentityQuery", q)
+}
+
+// The TraverseContentIdentity type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseContentIdentity func(context.Context, *ent.ContentIdentityQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseContentIdentity) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseContentIdentity) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ContentIdentityQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ContentIdentityQuery", q)
+}
+
+// The DuplicateGroupFunc type is an adapter to allow the use of ordinary function as a Querier.
+type DuplicateGroupFunc func(context.Context, *ent.DuplicateGroupQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f DuplicateGroupFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.DuplicateGroupQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.DuplicateGroupQuery", q)
+}
+
+// The TraverseDuplicateGroup type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseDuplicateGroup func(context.Context, *ent.DuplicateGroupQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseDuplicateGroup) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseDuplicateGroup) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.DuplicateGroupQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.DuplicateGroupQuery", q)
+}
+
+// The FileInstanceFunc type is an adapter to allow the use of ordinary function as a Querier.
+type FileInstanceFunc func(context.Context, *ent.FileInstanceQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f FileInstanceFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.FileInstanceQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.FileInstanceQuery", q)
+}
+
+// The TraverseFileInstance type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseFileInstance func(context.Context, *ent.FileInstanceQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseFileInstance) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseFileInstance) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.FileInstanceQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.FileInstanceQuery", q)
+}
+
+// The MachineFunc type is an adapter to allow the use of ordinary function as a Querier.
+type MachineFunc func(context.Context, *ent.MachineQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f MachineFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.MachineQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.MachineQuery", q)
+}
+
+// The TraverseMachine type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseMachine func(context.Context, *ent.MachineQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseMachine) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseMachine) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.MachineQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.MachineQuery", q)
+}
+
+// The ScanFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ScanFunc func(context.Context, *ent.ScanQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ScanFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ScanQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ScanQuery", q)
+}
+
+// The TraverseScan type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseScan func(context.Context, *ent.ScanQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseScan) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseScan) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ScanQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ScanQuery", q)
+}
+
+// The ScanScheduleFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ScanScheduleFunc func(context.Context, *ent.ScanScheduleQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ScanScheduleFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ScanScheduleQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ScanScheduleQuery", q)
+}
+
+// The TraverseScanSchedule type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseScanSchedule func(context.Context, *ent.ScanScheduleQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseScanSchedule) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseScanSchedule) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ScanScheduleQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ScanScheduleQuery", q)
+}
+
+// The ScanScheduleRunFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ScanScheduleRunFunc func(context.Context, *ent.ScanScheduleRunQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ScanScheduleRunFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ScanScheduleRunQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ScanScheduleRunQuery", q)
+}
+
+// The TraverseScanScheduleRun type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseScanScheduleRun func(context.Context, *ent.ScanScheduleRunQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseScanScheduleRun) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseScanScheduleRun) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ScanScheduleRunQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ScanScheduleRunQuery", q)
+}
+
+// The ScanTargetFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ScanTargetFunc func(context.Context, *ent.ScanTargetQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ScanTargetFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ScanTargetQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ScanTargetQuery", q)
+}
+
+// The TraverseScanTarget type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseScanTarget func(context.Context, *ent.ScanTargetQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseScanTarget) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseScanTarget) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ScanTargetQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ScanTargetQuery", q)
+}
+
+// The TenantFunc type is an adapter to allow the use of ordinary function as a Querier.
+type TenantFunc func(context.Context, *ent.TenantQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f TenantFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.TenantQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.TenantQuery", q)
+}
+
+// The TraverseTenant type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseTenant func(context.Context, *ent.TenantQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseTenant) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseTenant) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.TenantQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.TenantQuery", q)
+}
+
+// The TenantSecretFunc type is an adapter to allow the use of ordinary function as a Querier.
+type TenantSecretFunc func(context.Context, *ent.TenantSecretQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f TenantSecretFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.TenantSecretQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.TenantSecretQuery", q)
+}
+
+// The TraverseTenantSecret type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseTenantSecret func(context.Context, *ent.TenantSecretQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseTenantSecret) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseTenantSecret) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.TenantSecretQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.TenantSecretQuery", q)
+}
+
+// The TenantTombstoneFunc type is an adapter to allow the use of ordinary function as a Querier.
+type TenantTombstoneFunc func(context.Context, *ent.TenantTombstoneQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f TenantTombstoneFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.TenantTombstoneQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.TenantTombstoneQuery", q)
+}
+
+// The TraverseTenantTombstone type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseTenantTombstone func(context.Context, *ent.TenantTombstoneQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseTenantTombstone) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseTenantTombstone) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.TenantTombstoneQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.TenantTombstoneQuery", q)
+}
+
+// NewQuery returns the generic Query interface for the given typed query.
+func NewQuery(q ent.Query) (Query, error) {
+	switch q := q.(type) {
+	case *ent.ActionAuditQuery:
+		return &query[*ent.ActionAuditQuery, predicate.ActionAudit, actionaudit.OrderOption]{typ: ent.TypeActionAudit, tq: q}, nil
+	case *ent.ActionJobQuery:
+		return &query[*ent.ActionJobQuery, predicate.ActionJob, actionjob.OrderOption]{typ: ent.TypeActionJob, tq: q}, nil
+	case *ent.ContentEventQuery:
+		return &query[*ent.ContentEventQuery, predicate.ContentEvent, contentevent.OrderOption]{typ: ent.TypeContentEvent, tq: q}, nil
+	case *ent.ContentIdentityQuery:
+		return &query[*ent.ContentIdentityQuery, predicate.ContentIdentity, contentidentity.OrderOption]{typ: ent.TypeContentIdentity, tq: q}, nil
+	case *ent.DuplicateGroupQuery:
+		return &query[*ent.DuplicateGroupQuery, predicate.DuplicateGroup, duplicategroup.OrderOption]{typ: ent.TypeDuplicateGroup, tq: q}, nil
+	case *ent.FileInstanceQuery:
+		return &query[*ent.FileInstanceQuery, predicate.FileInstance, fileinstance.OrderOption]{typ: ent.TypeFileInstance, tq: q}, nil
+	case *ent.MachineQuery:
+		return &query[*ent.MachineQuery, predicate.Machine, machine.OrderOption]{typ: ent.TypeMachine, tq: q}, nil
+	case *ent.ScanQuery:
+		return &query[*ent.ScanQuery, predicate.Scan, scan.OrderOption]{typ: ent.TypeScan, tq: q}, nil
+	case *ent.ScanScheduleQuery:
+		return &query[*ent.ScanScheduleQuery, predicate.ScanSchedule, scanschedule.OrderOption]{typ: ent.TypeScanSchedule, tq: q}, nil
+	case *ent.ScanScheduleRunQuery:
+		return &query[*ent.ScanScheduleRunQuery, predicate.ScanScheduleRun, scanschedulerun.OrderOption]{typ: ent.TypeScanScheduleRun, tq: q}, nil
+	case *ent.ScanTargetQuery:
+		return &query[*ent.ScanTargetQuery, predicate.ScanTarget, scantarget.OrderOption]{typ: ent.TypeScanTarget, tq: q}, nil
+	case *ent.TenantQuery:
+		return &query[*ent.TenantQuery, predicate.Tenant, tenant.OrderOption]{typ: ent.TypeTenant, tq: q}, nil
+	case *ent.TenantSecretQuery:
+		return &query[*ent.TenantSecretQuery, predicate.TenantSecret, tenantsecret.OrderOption]{typ: ent.TypeTenantSecret, tq: q}, nil
+	case *ent.TenantTombstoneQuery:
+		return &query[*ent.TenantTombstoneQuery, predicate.TenantTombstone, tenanttombstone.OrderOption]{typ: ent.TypeTenantTombstone, tq: q}, nil
+	default:
+		return nil, fmt.Errorf("unknown query type %T", q)
+	}
+}
+
+type query[T any, P ~func(*sql.Selector), R ~func(*sql.Selector)] struct {
+	typ string
+	tq  interface {
+		Limit(int) T
+		Offset(int) T
+		Unique(bool) T
+		Order(...R) T
+		Where(...P) T
+	}
+}
+
+func (q query[T, P, R]) Type() string {
+	return q.typ
+}
+
+func (q query[T, P, R]) Limit(limit int) {
+	q.tq.Limit(limit)
+}
+
+func (q query[T, P, R]) Offset(offset int) {
+	q.tq.Offset(offset)
+}
+
+func (q query[T, P, R]) Unique(unique bool) {
+	q.tq.Unique(unique)
+}
+
+func (q query[T, P, R]) Order(orders ...func(*sql.Selector)) {
+	rs := make([]R, len(orders))
+	for i := range orders {
+		rs[i] = orders[i]
+	}
+	q.tq.Order(rs...)
+}
+
+func (q query[T, P, R]) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]P, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	q.tq.Where(p...)
+}
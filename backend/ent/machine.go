@@ -35,6 +35,10 @@ type Machine struct {
 	Role string `json:"role,omitempty"`
 	// LastScanAt holds the value of the "last_scan_at" field.
 	LastScanAt time.Time `json:"last_scan_at,omitempty"`
+	// LastSeenAt holds the value of the "last_seen_at" field.
+	LastSeenAt time.Time `json:"last_seen_at,omitempty"`
+	// ArchivedAt holds the value of the "archived_at" field.
+	ArchivedAt time.Time `json:"archived_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the MachineQuery when eager-loading is set.
 	Edges        MachineEdges `json:"edges"`
@@ -51,9 +55,19 @@ type MachineEdges struct {
 	FileInstances []*FileInstance `json:"file_instances,omitempty"`
 	// InitiatedScans holds the value of the initiated_scans edge.
 	InitiatedScans []*Scan `json:"initiated_scans,omitempty"`
+	// KeptIdentities holds the value of the kept_identities edge.
+	KeptIdentities []*ContentIdentity `json:"kept_identities,omitempty"`
+	// ContentEvents holds the value of the content_events edge.
+	ContentEvents []*ContentEvent `json:"content_events,omitempty"`
+	// ScanTargets holds the value of the scan_targets edge.
+	ScanTargets []*ScanTarget `json:"scan_targets,omitempty"`
+	// Secrets holds the value of the secrets edge.
+	Secrets []*TenantSecret `json:"secrets,omitempty"`
+	// ActionJobs holds the value of the action_jobs edge.
+	ActionJobs []*ActionJob `json:"action_jobs,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [9]bool
 }
 
 // TenantOrErr returns the Tenant value or an error if the edge
@@ -94,6 +108,51 @@ func (e MachineEdges) InitiatedScansOrErr() ([]*Scan, error) {
 	return nil, &NotLoadedError{edge: "initiated_scans"}
 }
 
+// KeptIdentitiesOrErr returns the KeptIdentities value or an error if the edge
+// was not loaded in eager-loading.
+func (e MachineEdges) KeptIdentitiesOrErr() ([]*ContentIdentity, error) {
+	if e.loadedTypes[4] {
+		return e.KeptIdentities, nil
+	}
+	return nil, &NotLoadedError{edge: "kept_identities"}
+}
+
+// ContentEventsOrErr returns the ContentEvents value or an error if the edge
+// was not loaded in eager-loading.
+func (e MachineEdges) ContentEventsOrErr() ([]*ContentEvent, error) {
+	if e.loadedTypes[5] {
+		return e.ContentEvents, nil
+	}
+	return nil, &NotLoadedError{edge: "content_events"}
+}
+
+// ScanTargetsOrErr returns the ScanTargets value or an error if the edge
+// was not loaded in eager-loading.
+func (e MachineEdges) ScanTargetsOrErr() ([]*ScanTarget, error) {
+	if e.loadedTypes[6] {
+		return e.ScanTargets, nil
+	}
+	return nil, &NotLoadedError{edge: "scan_targets"}
+}
+
+// SecretsOrErr returns the Secrets value or an error if the edge
+// was not loaded in eager-loading.
+func (e MachineEdges) SecretsOrErr() ([]*TenantSecret, error) {
+	if e.loadedTypes[7] {
+		return e.Secrets, nil
+	}
+	return nil, &NotLoadedError{edge: "secrets"}
+}
+
+// ActionJobsOrErr returns the ActionJobs value or an error if the edge
+// was not loaded in eager-loading.
+func (e MachineEdges) ActionJobsOrErr() ([]*ActionJob, error) {
+	if e.loadedTypes[8] {
+		return e.ActionJobs, nil
+	}
+	return nil, &NotLoadedError{edge: "action_jobs"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Machine) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -101,7 +160,7 @@ func (*Machine) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case machine.FieldName, machine.FieldCategory, machine.FieldHostname, machine.FieldRole:
 			values[i] = new(sql.NullString)
-		case machine.FieldCreateTime, machine.FieldUpdateTime, machine.FieldLastScanAt:
+		case machine.FieldCreateTime, machine.FieldUpdateTime, machine.FieldLastScanAt, machine.FieldLastSeenAt, machine.FieldArchivedAt:
 			values[i] = new(sql.NullTime)
 		case machine.FieldID, machine.FieldTenantID:
 			values[i] = new(uuid.UUID)
@@ -174,6 +233,18 @@ func (_m *Machine) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.LastScanAt = value.Time
 			}
+		case machine.FieldLastSeenAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_seen_at", values[i])
+			} else if value.Valid {
+				_m.LastSeenAt = value.Time
+			}
+		case machine.FieldArchivedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field archived_at", values[i])
+			} else if value.Valid {
+				_m.ArchivedAt = value.Time
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -207,6 +278,31 @@ func (_m *Machine) QueryInitiatedScans() *ScanQuery {
 	return NewMachineClient(_m.config).QueryInitiatedScans(_m)
 }
 
+// QueryKeptIdentities queries the "kept_identities" edge of the Machine entity.
+func (_m *Machine) QueryKeptIdentities() *ContentIdentityQuery {
+	return NewMachineClient(_m.config).QueryKeptIdentities(_m)
+}
+
+// QueryContentEvents queries the "content_events" edge of the Machine entity.
+func (_m *Machine) QueryContentEvents() *ContentEventQuery {
+	return NewMachineClient(_m.config).QueryContentEvents(_m)
+}
+
+// QueryScanTargets queries the "scan_targets" edge of the Machine entity.
+func (_m *Machine) QueryScanTargets() *ScanTargetQuery {
+	return NewMachineClient(_m.config).QueryScanTargets(_m)
+}
+
+// QuerySecrets queries the "secrets" edge of the Machine entity.
+func (_m *Machine) QuerySecrets() *TenantSecretQuery {
+	return NewMachineClient(_m.config).QuerySecrets(_m)
+}
+
+// QueryActionJobs queries the "action_jobs" edge of the Machine entity.
+func (_m *Machine) QueryActionJobs() *ActionJobQuery {
+	return NewMachineClient(_m.config).QueryActionJobs(_m)
+}
+
 // Update returns a builder for updating this Machine.
 // Note that you need to call Machine.Unwrap() before calling this method if this Machine
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -253,6 +349,12 @@ func (_m *Machine) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("last_scan_at=")
 	builder.WriteString(_m.LastScanAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("last_seen_at=")
+	builder.WriteString(_m.LastSeenAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("archived_at=")
+	builder.WriteString(_m.ArchivedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
 	return builder.String()
 }
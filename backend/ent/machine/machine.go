@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
@@ -32,6 +33,10 @@ const (
 	FieldRole = "role"
 	// FieldLastScanAt holds the string denoting the last_scan_at field in the database.
 	FieldLastScanAt = "last_scan_at"
+	// FieldLastSeenAt holds the string denoting the last_seen_at field in the database.
+	FieldLastSeenAt = "last_seen_at"
+	// FieldArchivedAt holds the string denoting the archived_at field in the database.
+	FieldArchivedAt = "archived_at"
 	// EdgeTenant holds the string denoting the tenant edge name in mutations.
 	EdgeTenant = "tenant"
 	// EdgeKeeperGroups holds the string denoting the keeper_groups edge name in mutations.
@@ -40,6 +45,16 @@ const (
 	EdgeFileInstances = "file_instances"
 	// EdgeInitiatedScans holds the string denoting the initiated_scans edge name in mutations.
 	EdgeInitiatedScans = "initiated_scans"
+	// EdgeKeptIdentities holds the string denoting the kept_identities edge name in mutations.
+	EdgeKeptIdentities = "kept_identities"
+	// EdgeContentEvents holds the string denoting the content_events edge name in mutations.
+	EdgeContentEvents = "content_events"
+	// EdgeScanTargets holds the string denoting the scan_targets edge name in mutations.
+	EdgeScanTargets = "scan_targets"
+	// EdgeSecrets holds the string denoting the secrets edge name in mutations.
+	EdgeSecrets = "secrets"
+	// EdgeActionJobs holds the string denoting the action_jobs edge name in mutations.
+	EdgeActionJobs = "action_jobs"
 	// Table holds the table name of the machine in the database.
 	Table = "machines"
 	// TenantTable is the table that holds the tenant relation/edge.
@@ -70,6 +85,41 @@ const (
 	InitiatedScansInverseTable = "scans"
 	// InitiatedScansColumn is the table column denoting the initiated_scans relation/edge.
 	InitiatedScansColumn = "initiated_machine_id"
+	// KeptIdentitiesTable is the table that holds the kept_identities relation/edge.
+	KeptIdentitiesTable = "content_identities"
+	// KeptIdentitiesInverseTable is the table name for the ContentIdentity entity.
+	// It exists in this package in order to avoid circular dependency with the "contentidentity" package.
+	KeptIdentitiesInverseTable = "content_identities"
+	// KeptIdentitiesColumn is the table column denoting the kept_identities relation/edge.
+	KeptIdentitiesColumn = "keeper_machine_id"
+	// ContentEventsTable is the table that holds the content_events relation/edge.
+	ContentEventsTable = "content_events"
+	// ContentEventsInverseTable is the table name for the ContentEvent entity.
+	// It exists in this package in order to avoid circular dependency with the "contentevent" package.
+	ContentEventsInverseTable = "content_events"
+	// ContentEventsColumn is the table column denoting the content_events relation/edge.
+	ContentEventsColumn = "machine_id"
+	// ScanTargetsTable is the table that holds the scan_targets relation/edge.
+	ScanTargetsTable = "scan_targets"
+	// ScanTargetsInverseTable is the table name for the ScanTarget entity.
+	// It exists in this package in order to avoid circular dependency with the "scantarget" package.
+	ScanTargetsInverseTable = "scan_targets"
+	// ScanTargetsColumn is the table column denoting the scan_targets relation/edge.
+	ScanTargetsColumn = "machine_id"
+	// SecretsTable is the table that holds the secrets relation/edge.
+	SecretsTable = "tenant_secrets"
+	// SecretsInverseTable is the table name for the TenantSecret entity.
+	// It exists in this package in order to avoid circular dependency with the "tenantsecret" package.
+	SecretsInverseTable = "tenant_secrets"
+	// SecretsColumn is the table column denoting the secrets relation/edge.
+	SecretsColumn = "machine_id"
+	// ActionJobsTable is the table that holds the action_jobs relation/edge.
+	ActionJobsTable = "action_jobs"
+	// ActionJobsInverseTable is the table name for the ActionJob entity.
+	// It exists in this package in order to avoid circular dependency with the "actionjob" package.
+	ActionJobsInverseTable = "action_jobs"
+	// ActionJobsColumn is the table column denoting the action_jobs relation/edge.
+	ActionJobsColumn = "machine_id"
 )
 
 // Columns holds all SQL columns for machine fields.
@@ -83,6 +133,8 @@ var Columns = []string{
 	FieldHostname,
 	FieldRole,
 	FieldLastScanAt,
+	FieldLastSeenAt,
+	FieldArchivedAt,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -95,7 +147,14 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/mcmx/duplynx/ent/runtime"
 var (
+	Hooks        [1]ent.Hook
+	Interceptors [1]ent.Interceptor
 	// DefaultCreateTime holds the default value on creation for the "create_time" field.
 	DefaultCreateTime func() time.Time
 	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
@@ -113,6 +172,11 @@ type Category string
 const (
 	CategoryPersonalLaptop Category = "personal_laptop"
 	CategoryServer         Category = "server"
+	CategoryWorkstation    Category = "workstation"
+	CategoryNas            Category = "nas"
+	CategoryVM             Category = "vm"
+	CategoryContainer      Category = "container"
+	CategoryCloudBucket    Category = "cloud_bucket"
 )
 
 func (c Category) String() string {
@@ -122,7 +186,7 @@ func (c Category) String() string {
 // CategoryValidator is a validator for the "category" field enum values. It is called by the builders before save.
 func CategoryValidator(c Category) error {
 	switch c {
-	case CategoryPersonalLaptop, CategoryServer:
+	case CategoryPersonalLaptop, CategoryServer, CategoryWorkstation, CategoryNas, CategoryVM, CategoryContainer, CategoryCloudBucket:
 		return nil
 	default:
 		return fmt.Errorf("machine: invalid enum value for category field: %q", c)
@@ -177,6 +241,16 @@ func ByLastScanAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLastScanAt, opts...).ToFunc()
 }
 
+// ByLastSeenAt orders the results by the last_seen_at field.
+func ByLastSeenAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastSeenAt, opts...).ToFunc()
+}
+
+// ByArchivedAt orders the results by the archived_at field.
+func ByArchivedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldArchivedAt, opts...).ToFunc()
+}
+
 // ByTenantField orders the results by tenant field.
 func ByTenantField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -225,6 +299,76 @@ func ByInitiatedScans(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newInitiatedScansStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByKeptIdentitiesCount orders the results by kept_identities count.
+func ByKeptIdentitiesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newKeptIdentitiesStep(), opts...)
+	}
+}
+
+// ByKeptIdentities orders the results by kept_identities terms.
+func ByKeptIdentities(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newKeptIdentitiesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByContentEventsCount orders the results by content_events count.
+func ByContentEventsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newContentEventsStep(), opts...)
+	}
+}
+
+// ByContentEvents orders the results by content_events terms.
+func ByContentEvents(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newContentEventsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByScanTargetsCount orders the results by scan_targets count.
+func ByScanTargetsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newScanTargetsStep(), opts...)
+	}
+}
+
+// ByScanTargets orders the results by scan_targets terms.
+func ByScanTargets(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newScanTargetsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySecretsCount orders the results by secrets count.
+func BySecretsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSecretsStep(), opts...)
+	}
+}
+
+// BySecrets orders the results by secrets terms.
+func BySecrets(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSecretsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByActionJobsCount orders the results by action_jobs count.
+func ByActionJobsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newActionJobsStep(), opts...)
+	}
+}
+
+// ByActionJobs orders the results by action_jobs terms.
+func ByActionJobs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newActionJobsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newTenantStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -253,3 +397,38 @@ func newInitiatedScansStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, InitiatedScansTable, InitiatedScansColumn),
 	)
 }
+func newKeptIdentitiesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(KeptIdentitiesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, KeptIdentitiesTable, KeptIdentitiesColumn),
+	)
+}
+func newContentEventsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ContentEventsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ContentEventsTable, ContentEventsColumn),
+	)
+}
+func newScanTargetsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ScanTargetsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ScanTargetsTable, ScanTargetsColumn),
+	)
+}
+func newSecretsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SecretsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SecretsTable, SecretsColumn),
+	)
+}
+func newActionJobsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ActionJobsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ActionJobsTable, ActionJobsColumn),
+	)
+}
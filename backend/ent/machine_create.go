@@ -11,11 +11,16 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/mcmx/duplynx/ent/actionjob"
+	"github.com/mcmx/duplynx/ent/contentevent"
+	"github.com/mcmx/duplynx/ent/contentidentity"
 	"github.com/mcmx/duplynx/ent/duplicategroup"
 	"github.com/mcmx/duplynx/ent/fileinstance"
 	"github.com/mcmx/duplynx/ent/machine"
 	"github.com/mcmx/duplynx/ent/scan"
+	"github.com/mcmx/duplynx/ent/scantarget"
 	"github.com/mcmx/duplynx/ent/tenant"
+	"github.com/mcmx/duplynx/ent/tenantsecret"
 )
 
 // MachineCreate is the builder for creating a Machine entity.
@@ -113,6 +118,34 @@ func (_c *MachineCreate) SetNillableLastScanAt(v *time.Time) *MachineCreate {
 	return _c
 }
 
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_c *MachineCreate) SetLastSeenAt(v time.Time) *MachineCreate {
+	_c.mutation.SetLastSeenAt(v)
+	return _c
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (_c *MachineCreate) SetNillableLastSeenAt(v *time.Time) *MachineCreate {
+	if v != nil {
+		_c.SetLastSeenAt(*v)
+	}
+	return _c
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (_c *MachineCreate) SetArchivedAt(v time.Time) *MachineCreate {
+	_c.mutation.SetArchivedAt(v)
+	return _c
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (_c *MachineCreate) SetNillableArchivedAt(v *time.Time) *MachineCreate {
+	if v != nil {
+		_c.SetArchivedAt(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *MachineCreate) SetID(v uuid.UUID) *MachineCreate {
 	_c.mutation.SetID(v)
@@ -177,6 +210,81 @@ func (_c *MachineCreate) AddInitiatedScans(v ...*Scan) *MachineCreate {
 	return _c.AddInitiatedScanIDs(ids...)
 }
 
+// AddKeptIdentityIDs adds the "kept_identities" edge to the ContentIdentity entity by IDs.
+func (_c *MachineCreate) AddKeptIdentityIDs(ids ...uuid.UUID) *MachineCreate {
+	_c.mutation.AddKeptIdentityIDs(ids...)
+	return _c
+}
+
+// AddKeptIdentities adds the "kept_identities" edges to the ContentIdentity entity.
+func (_c *MachineCreate) AddKeptIdentities(v ...*ContentIdentity) *MachineCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddKeptIdentityIDs(ids...)
+}
+
+// AddContentEventIDs adds the "content_events" edge to the ContentEvent entity by IDs.
+func (_c *MachineCreate) AddContentEventIDs(ids ...uuid.UUID) *MachineCreate {
+	_c.mutation.AddContentEventIDs(ids...)
+	return _c
+}
+
+// AddContentEvents adds the "content_events" edges to the ContentEvent entity.
+func (_c *MachineCreate) AddContentEvents(v ...*ContentEvent) *MachineCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddContentEventIDs(ids...)
+}
+
+// AddScanTargetIDs adds the "scan_targets" edge to the ScanTarget entity by IDs.
+func (_c *MachineCreate) AddScanTargetIDs(ids ...uuid.UUID) *MachineCreate {
+	_c.mutation.AddScanTargetIDs(ids...)
+	return _c
+}
+
+// AddScanTargets adds the "scan_targets" edges to the ScanTarget entity.
+func (_c *MachineCreate) AddScanTargets(v ...*ScanTarget) *MachineCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddScanTargetIDs(ids...)
+}
+
+// AddSecretIDs adds the "secrets" edge to the TenantSecret entity by IDs.
+func (_c *MachineCreate) AddSecretIDs(ids ...uuid.UUID) *MachineCreate {
+	_c.mutation.AddSecretIDs(ids...)
+	return _c
+}
+
+// AddSecrets adds the "secrets" edges to the TenantSecret entity.
+func (_c *MachineCreate) AddSecrets(v ...*TenantSecret) *MachineCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSecretIDs(ids...)
+}
+
+// AddActionJobIDs adds the "action_jobs" edge to the ActionJob entity by IDs.
+func (_c *MachineCreate) AddActionJobIDs(ids ...uuid.UUID) *MachineCreate {
+	_c.mutation.AddActionJobIDs(ids...)
+	return _c
+}
+
+// AddActionJobs adds the "action_jobs" edges to the ActionJob entity.
+func (_c *MachineCreate) AddActionJobs(v ...*ActionJob) *MachineCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddActionJobIDs(ids...)
+}
+
 // Mutation returns the MachineMutation object of the builder.
 func (_c *MachineCreate) Mutation() *MachineMutation {
 	return _c.mutation
@@ -184,7 +292,9 @@ func (_c *MachineCreate) Mutation() *MachineMutation {
 
 // Save creates the Machine in the database.
 func (_c *MachineCreate) Save(ctx context.Context) (*Machine, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -211,19 +321,29 @@ func (_c *MachineCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *MachineCreate) defaults() {
+func (_c *MachineCreate) defaults() error {
 	if _, ok := _c.mutation.CreateTime(); !ok {
+		if machine.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized machine.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
 		v := machine.DefaultCreateTime()
 		_c.mutation.SetCreateTime(v)
 	}
 	if _, ok := _c.mutation.UpdateTime(); !ok {
+		if machine.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized machine.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
 		v := machine.DefaultUpdateTime()
 		_c.mutation.SetUpdateTime(v)
 	}
 	if _, ok := _c.mutation.ID(); !ok {
+		if machine.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized machine.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := machine.DefaultID()
 		_c.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -314,6 +434,14 @@ func (_c *MachineCreate) createSpec() (*Machine, *sqlgraph.CreateSpec) {
 		_spec.SetField(machine.FieldLastScanAt, field.TypeTime, value)
 		_node.LastScanAt = value
 	}
+	if value, ok := _c.mutation.LastSeenAt(); ok {
+		_spec.SetField(machine.FieldLastSeenAt, field.TypeTime, value)
+		_node.LastSeenAt = value
+	}
+	if value, ok := _c.mutation.ArchivedAt(); ok {
+		_spec.SetField(machine.FieldArchivedAt, field.TypeTime, value)
+		_node.ArchivedAt = value
+	}
 	if nodes := _c.mutation.TenantIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -379,6 +507,86 @@ func (_c *MachineCreate) createSpec() (*Machine, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.KeptIdentitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   machine.KeptIdentitiesTable,
+			Columns: []string{machine.KeptIdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentidentity.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ContentEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   machine.ContentEventsTable,
+			Columns: []string{machine.ContentEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ScanTargetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   machine.ScanTargetsTable,
+			Columns: []string{machine.ScanTargetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scantarget.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SecretsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   machine.SecretsTable,
+			Columns: []string{machine.SecretsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenantsecret.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ActionJobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   machine.ActionJobsTable,
+			Columns: []string{machine.ActionJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(actionjob.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -13,12 +13,17 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/mcmx/duplynx/ent/actionjob"
+	"github.com/mcmx/duplynx/ent/contentevent"
+	"github.com/mcmx/duplynx/ent/contentidentity"
 	"github.com/mcmx/duplynx/ent/duplicategroup"
 	"github.com/mcmx/duplynx/ent/fileinstance"
 	"github.com/mcmx/duplynx/ent/machine"
 	"github.com/mcmx/duplynx/ent/predicate"
 	"github.com/mcmx/duplynx/ent/scan"
+	"github.com/mcmx/duplynx/ent/scantarget"
 	"github.com/mcmx/duplynx/ent/tenant"
+	"github.com/mcmx/duplynx/ent/tenantsecret"
 )
 
 // MachineQuery is the builder for querying Machine entities.
@@ -32,6 +37,11 @@ type MachineQuery struct {
 	withKeeperGroups   *DuplicateGroupQuery
 	withFileInstances  *FileInstanceQuery
 	withInitiatedScans *ScanQuery
+	withKeptIdentities *ContentIdentityQuery
+	withContentEvents  *ContentEventQuery
+	withScanTargets    *ScanTargetQuery
+	withSecrets        *TenantSecretQuery
+	withActionJobs     *ActionJobQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -156,6 +166,116 @@ func (_q *MachineQuery) QueryInitiatedScans() *ScanQuery {
 	return query
 }
 
+// QueryKeptIdentities chains the current query on the "kept_identities" edge.
+func (_q *MachineQuery) QueryKeptIdentities() *ContentIdentityQuery {
+	query := (&ContentIdentityClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(machine.Table, machine.FieldID, selector),
+			sqlgraph.To(contentidentity.Table, contentidentity.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, machine.KeptIdentitiesTable, machine.KeptIdentitiesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryContentEvents chains the current query on the "content_events" edge.
+func (_q *MachineQuery) QueryContentEvents() *ContentEventQuery {
+	query := (&ContentEventClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(machine.Table, machine.FieldID, selector),
+			sqlgraph.To(contentevent.Table, contentevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, machine.ContentEventsTable, machine.ContentEventsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryScanTargets chains the current query on the "scan_targets" edge.
+func (_q *MachineQuery) QueryScanTargets() *ScanTargetQuery {
+	query := (&ScanTargetClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(machine.Table, machine.FieldID, selector),
+			sqlgraph.To(scantarget.Table, scantarget.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, machine.ScanTargetsTable, machine.ScanTargetsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySecrets chains the current query on the "secrets" edge.
+func (_q *MachineQuery) QuerySecrets() *TenantSecretQuery {
+	query := (&TenantSecretClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(machine.Table, machine.FieldID, selector),
+			sqlgraph.To(tenantsecret.Table, tenantsecret.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, machine.SecretsTable, machine.SecretsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryActionJobs chains the current query on the "action_jobs" edge.
+func (_q *MachineQuery) QueryActionJobs() *ActionJobQuery {
+	query := (&ActionJobClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(machine.Table, machine.FieldID, selector),
+			sqlgraph.To(actionjob.Table, actionjob.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, machine.ActionJobsTable, machine.ActionJobsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Machine entity from the query.
 // Returns a *NotFoundError when no Machine was found.
 func (_q *MachineQuery) First(ctx context.Context) (*Machine, error) {
@@ -352,6 +472,11 @@ func (_q *MachineQuery) Clone() *MachineQuery {
 		withKeeperGroups:   _q.withKeeperGroups.Clone(),
 		withFileInstances:  _q.withFileInstances.Clone(),
 		withInitiatedScans: _q.withInitiatedScans.Clone(),
+		withKeptIdentities: _q.withKeptIdentities.Clone(),
+		withContentEvents:  _q.withContentEvents.Clone(),
+		withScanTargets:    _q.withScanTargets.Clone(),
+		withSecrets:        _q.withSecrets.Clone(),
+		withActionJobs:     _q.withActionJobs.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -402,6 +527,61 @@ func (_q *MachineQuery) WithInitiatedScans(opts ...func(*ScanQuery)) *MachineQue
 	return _q
 }
 
+// WithKeptIdentities tells the query-builder to eager-load the nodes that are connected to
+// the "kept_identities" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *MachineQuery) WithKeptIdentities(opts ...func(*ContentIdentityQuery)) *MachineQuery {
+	query := (&ContentIdentityClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withKeptIdentities = query
+	return _q
+}
+
+// WithContentEvents tells the query-builder to eager-load the nodes that are connected to
+// the "content_events" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *MachineQuery) WithContentEvents(opts ...func(*ContentEventQuery)) *MachineQuery {
+	query := (&ContentEventClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withContentEvents = query
+	return _q
+}
+
+// WithScanTargets tells the query-builder to eager-load the nodes that are connected to
+// the "scan_targets" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *MachineQuery) WithScanTargets(opts ...func(*ScanTargetQuery)) *MachineQuery {
+	query := (&ScanTargetClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withScanTargets = query
+	return _q
+}
+
+// WithSecrets tells the query-builder to eager-load the nodes that are connected to
+// the "secrets" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *MachineQuery) WithSecrets(opts ...func(*TenantSecretQuery)) *MachineQuery {
+	query := (&TenantSecretClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSecrets = query
+	return _q
+}
+
+// WithActionJobs tells the query-builder to eager-load the nodes that are connected to
+// the "action_jobs" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *MachineQuery) WithActionJobs(opts ...func(*ActionJobQuery)) *MachineQuery {
+	query := (&ActionJobClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withActionJobs = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -480,11 +660,16 @@ func (_q *MachineQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Mach
 	var (
 		nodes       = []*Machine{}
 		_spec       = _q.querySpec()
-		loadedTypes = [4]bool{
+		loadedTypes = [9]bool{
 			_q.withTenant != nil,
 			_q.withKeeperGroups != nil,
 			_q.withFileInstances != nil,
 			_q.withInitiatedScans != nil,
+			_q.withKeptIdentities != nil,
+			_q.withContentEvents != nil,
+			_q.withScanTargets != nil,
+			_q.withSecrets != nil,
+			_q.withActionJobs != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -532,6 +717,41 @@ func (_q *MachineQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Mach
 			return nil, err
 		}
 	}
+	if query := _q.withKeptIdentities; query != nil {
+		if err := _q.loadKeptIdentities(ctx, query, nodes,
+			func(n *Machine) { n.Edges.KeptIdentities = []*ContentIdentity{} },
+			func(n *Machine, e *ContentIdentity) { n.Edges.KeptIdentities = append(n.Edges.KeptIdentities, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withContentEvents; query != nil {
+		if err := _q.loadContentEvents(ctx, query, nodes,
+			func(n *Machine) { n.Edges.ContentEvents = []*ContentEvent{} },
+			func(n *Machine, e *ContentEvent) { n.Edges.ContentEvents = append(n.Edges.ContentEvents, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withScanTargets; query != nil {
+		if err := _q.loadScanTargets(ctx, query, nodes,
+			func(n *Machine) { n.Edges.ScanTargets = []*ScanTarget{} },
+			func(n *Machine, e *ScanTarget) { n.Edges.ScanTargets = append(n.Edges.ScanTargets, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withSecrets; query != nil {
+		if err := _q.loadSecrets(ctx, query, nodes,
+			func(n *Machine) { n.Edges.Secrets = []*TenantSecret{} },
+			func(n *Machine, e *TenantSecret) { n.Edges.Secrets = append(n.Edges.Secrets, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withActionJobs; query != nil {
+		if err := _q.loadActionJobs(ctx, query, nodes,
+			func(n *Machine) { n.Edges.ActionJobs = []*ActionJob{} },
+			func(n *Machine, e *ActionJob) { n.Edges.ActionJobs = append(n.Edges.ActionJobs, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -654,6 +874,156 @@ func (_q *MachineQuery) loadInitiatedScans(ctx context.Context, query *ScanQuery
 	}
 	return nil
 }
+func (_q *MachineQuery) loadKeptIdentities(ctx context.Context, query *ContentIdentityQuery, nodes []*Machine, init func(*Machine), assign func(*Machine, *ContentIdentity)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Machine)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(contentidentity.FieldKeeperMachineID)
+	}
+	query.Where(predicate.ContentIdentity(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(machine.KeptIdentitiesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.KeeperMachineID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "keeper_machine_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *MachineQuery) loadContentEvents(ctx context.Context, query *ContentEventQuery, nodes []*Machine, init func(*Machine), assign func(*Machine, *ContentEvent)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Machine)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(contentevent.FieldMachineID)
+	}
+	query.Where(predicate.ContentEvent(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(machine.ContentEventsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.MachineID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "machine_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *MachineQuery) loadScanTargets(ctx context.Context, query *ScanTargetQuery, nodes []*Machine, init func(*Machine), assign func(*Machine, *ScanTarget)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Machine)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(scantarget.FieldMachineID)
+	}
+	query.Where(predicate.ScanTarget(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(machine.ScanTargetsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.MachineID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "machine_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *MachineQuery) loadSecrets(ctx context.Context, query *TenantSecretQuery, nodes []*Machine, init func(*Machine), assign func(*Machine, *TenantSecret)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Machine)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(tenantsecret.FieldMachineID)
+	}
+	query.Where(predicate.TenantSecret(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(machine.SecretsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.MachineID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "machine_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *MachineQuery) loadActionJobs(ctx context.Context, query *ActionJobQuery, nodes []*Machine, init func(*Machine), assign func(*Machine, *ActionJob)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Machine)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(actionjob.FieldMachineID)
+	}
+	query.Where(predicate.ActionJob(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(machine.ActionJobsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.MachineID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "machine_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *MachineQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
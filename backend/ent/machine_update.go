@@ -12,12 +12,17 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/mcmx/duplynx/ent/actionjob"
+	"github.com/mcmx/duplynx/ent/contentevent"
+	"github.com/mcmx/duplynx/ent/contentidentity"
 	"github.com/mcmx/duplynx/ent/duplicategroup"
 	"github.com/mcmx/duplynx/ent/fileinstance"
 	"github.com/mcmx/duplynx/ent/machine"
 	"github.com/mcmx/duplynx/ent/predicate"
 	"github.com/mcmx/duplynx/ent/scan"
+	"github.com/mcmx/duplynx/ent/scantarget"
 	"github.com/mcmx/duplynx/ent/tenant"
+	"github.com/mcmx/duplynx/ent/tenantsecret"
 )
 
 // MachineUpdate is the builder for updating Machine entities.
@@ -141,6 +146,46 @@ func (_u *MachineUpdate) ClearLastScanAt() *MachineUpdate {
 	return _u
 }
 
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_u *MachineUpdate) SetLastSeenAt(v time.Time) *MachineUpdate {
+	_u.mutation.SetLastSeenAt(v)
+	return _u
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (_u *MachineUpdate) SetNillableLastSeenAt(v *time.Time) *MachineUpdate {
+	if v != nil {
+		_u.SetLastSeenAt(*v)
+	}
+	return _u
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (_u *MachineUpdate) ClearLastSeenAt() *MachineUpdate {
+	_u.mutation.ClearLastSeenAt()
+	return _u
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (_u *MachineUpdate) SetArchivedAt(v time.Time) *MachineUpdate {
+	_u.mutation.SetArchivedAt(v)
+	return _u
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (_u *MachineUpdate) SetNillableArchivedAt(v *time.Time) *MachineUpdate {
+	if v != nil {
+		_u.SetArchivedAt(*v)
+	}
+	return _u
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (_u *MachineUpdate) ClearArchivedAt() *MachineUpdate {
+	_u.mutation.ClearArchivedAt()
+	return _u
+}
+
 // SetTenant sets the "tenant" edge to the Tenant entity.
 func (_u *MachineUpdate) SetTenant(v *Tenant) *MachineUpdate {
 	return _u.SetTenantID(v.ID)
@@ -191,6 +236,81 @@ func (_u *MachineUpdate) AddInitiatedScans(v ...*Scan) *MachineUpdate {
 	return _u.AddInitiatedScanIDs(ids...)
 }
 
+// AddKeptIdentityIDs adds the "kept_identities" edge to the ContentIdentity entity by IDs.
+func (_u *MachineUpdate) AddKeptIdentityIDs(ids ...uuid.UUID) *MachineUpdate {
+	_u.mutation.AddKeptIdentityIDs(ids...)
+	return _u
+}
+
+// AddKeptIdentities adds the "kept_identities" edges to the ContentIdentity entity.
+func (_u *MachineUpdate) AddKeptIdentities(v ...*ContentIdentity) *MachineUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddKeptIdentityIDs(ids...)
+}
+
+// AddContentEventIDs adds the "content_events" edge to the ContentEvent entity by IDs.
+func (_u *MachineUpdate) AddContentEventIDs(ids ...uuid.UUID) *MachineUpdate {
+	_u.mutation.AddContentEventIDs(ids...)
+	return _u
+}
+
+// AddContentEvents adds the "content_events" edges to the ContentEvent entity.
+func (_u *MachineUpdate) AddContentEvents(v ...*ContentEvent) *MachineUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddContentEventIDs(ids...)
+}
+
+// AddScanTargetIDs adds the "scan_targets" edge to the ScanTarget entity by IDs.
+func (_u *MachineUpdate) AddScanTargetIDs(ids ...uuid.UUID) *MachineUpdate {
+	_u.mutation.AddScanTargetIDs(ids...)
+	return _u
+}
+
+// AddScanTargets adds the "scan_targets" edges to the ScanTarget entity.
+func (_u *MachineUpdate) AddScanTargets(v ...*ScanTarget) *MachineUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddScanTargetIDs(ids...)
+}
+
+// AddSecretIDs adds the "secrets" edge to the TenantSecret entity by IDs.
+func (_u *MachineUpdate) AddSecretIDs(ids ...uuid.UUID) *MachineUpdate {
+	_u.mutation.AddSecretIDs(ids...)
+	return _u
+}
+
+// AddSecrets adds the "secrets" edges to the TenantSecret entity.
+func (_u *MachineUpdate) AddSecrets(v ...*TenantSecret) *MachineUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSecretIDs(ids...)
+}
+
+// AddActionJobIDs adds the "action_jobs" edge to the ActionJob entity by IDs.
+func (_u *MachineUpdate) AddActionJobIDs(ids ...uuid.UUID) *MachineUpdate {
+	_u.mutation.AddActionJobIDs(ids...)
+	return _u
+}
+
+// AddActionJobs adds the "action_jobs" edges to the ActionJob entity.
+func (_u *MachineUpdate) AddActionJobs(v ...*ActionJob) *MachineUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddActionJobIDs(ids...)
+}
+
 // Mutation returns the MachineMutation object of the builder.
 func (_u *MachineUpdate) Mutation() *MachineMutation {
 	return _u.mutation
@@ -265,9 +385,116 @@ func (_u *MachineUpdate) RemoveInitiatedScans(v ...*Scan) *MachineUpdate {
 	return _u.RemoveInitiatedScanIDs(ids...)
 }
 
+// ClearKeptIdentities clears all "kept_identities" edges to the ContentIdentity entity.
+func (_u *MachineUpdate) ClearKeptIdentities() *MachineUpdate {
+	_u.mutation.ClearKeptIdentities()
+	return _u
+}
+
+// RemoveKeptIdentityIDs removes the "kept_identities" edge to ContentIdentity entities by IDs.
+func (_u *MachineUpdate) RemoveKeptIdentityIDs(ids ...uuid.UUID) *MachineUpdate {
+	_u.mutation.RemoveKeptIdentityIDs(ids...)
+	return _u
+}
+
+// RemoveKeptIdentities removes "kept_identities" edges to ContentIdentity entities.
+func (_u *MachineUpdate) RemoveKeptIdentities(v ...*ContentIdentity) *MachineUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveKeptIdentityIDs(ids...)
+}
+
+// ClearContentEvents clears all "content_events" edges to the ContentEvent entity.
+func (_u *MachineUpdate) ClearContentEvents() *MachineUpdate {
+	_u.mutation.ClearContentEvents()
+	return _u
+}
+
+// RemoveContentEventIDs removes the "content_events" edge to ContentEvent entities by IDs.
+func (_u *MachineUpdate) RemoveContentEventIDs(ids ...uuid.UUID) *MachineUpdate {
+	_u.mutation.RemoveContentEventIDs(ids...)
+	return _u
+}
+
+// RemoveContentEvents removes "content_events" edges to ContentEvent entities.
+func (_u *MachineUpdate) RemoveContentEvents(v ...*ContentEvent) *MachineUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveContentEventIDs(ids...)
+}
+
+// ClearScanTargets clears all "scan_targets" edges to the ScanTarget entity.
+func (_u *MachineUpdate) ClearScanTargets() *MachineUpdate {
+	_u.mutation.ClearScanTargets()
+	return _u
+}
+
+// RemoveScanTargetIDs removes the "scan_targets" edge to ScanTarget entities by IDs.
+func (_u *MachineUpdate) RemoveScanTargetIDs(ids ...uuid.UUID) *MachineUpdate {
+	_u.mutation.RemoveScanTargetIDs(ids...)
+	return _u
+}
+
+// RemoveScanTargets removes "scan_targets" edges to ScanTarget entities.
+func (_u *MachineUpdate) RemoveScanTargets(v ...*ScanTarget) *MachineUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveScanTargetIDs(ids...)
+}
+
+// ClearSecrets clears all "secrets" edges to the TenantSecret entity.
+func (_u *MachineUpdate) ClearSecrets() *MachineUpdate {
+	_u.mutation.ClearSecrets()
+	return _u
+}
+
+// RemoveSecretIDs removes the "secrets" edge to TenantSecret entities by IDs.
+func (_u *MachineUpdate) RemoveSecretIDs(ids ...uuid.UUID) *MachineUpdate {
+	_u.mutation.RemoveSecretIDs(ids...)
+	return _u
+}
+
+// RemoveSecrets removes "secrets" edges to TenantSecret entities.
+func (_u *MachineUpdate) RemoveSecrets(v ...*TenantSecret) *MachineUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSecretIDs(ids...)
+}
+
+// ClearActionJobs clears all "action_jobs" edges to the ActionJob entity.
+func (_u *MachineUpdate) ClearActionJobs() *MachineUpdate {
+	_u.mutation.ClearActionJobs()
+	return _u
+}
+
+// RemoveActionJobIDs removes the "action_jobs" edge to ActionJob entities by IDs.
+func (_u *MachineUpdate) RemoveActionJobIDs(ids ...uuid.UUID) *MachineUpdate {
+	_u.mutation.RemoveActionJobIDs(ids...)
+	return _u
+}
+
+// RemoveActionJobs removes "action_jobs" edges to ActionJob entities.
+func (_u *MachineUpdate) RemoveActionJobs(v ...*ActionJob) *MachineUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveActionJobIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *MachineUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -294,11 +521,15 @@ func (_u *MachineUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *MachineUpdate) defaults() {
+func (_u *MachineUpdate) defaults() error {
 	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if machine.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized machine.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
 		v := machine.UpdateDefaultUpdateTime()
 		_u.mutation.SetUpdateTime(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -353,6 +584,18 @@ func (_u *MachineUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.LastScanAtCleared() {
 		_spec.ClearField(machine.FieldLastScanAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.LastSeenAt(); ok {
+		_spec.SetField(machine.FieldLastSeenAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastSeenAtCleared() {
+		_spec.ClearField(machine.FieldLastSeenAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ArchivedAt(); ok {
+		_spec.SetField(machine.FieldArchivedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ArchivedAtCleared() {
+		_spec.ClearField(machine.FieldArchivedAt, field.TypeTime)
+	}
 	if _u.mutation.TenantCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -517,84 +760,309 @@ func (_u *MachineUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{machine.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if _u.mutation.KeptIdentitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   machine.KeptIdentitiesTable,
+			Columns: []string{machine.KeptIdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentidentity.FieldID, field.TypeUUID),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	_u.mutation.done = true
-	return _node, nil
-}
-
-// MachineUpdateOne is the builder for updating a single Machine entity.
-type MachineUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *MachineMutation
-}
-
-// SetUpdateTime sets the "update_time" field.
-func (_u *MachineUpdateOne) SetUpdateTime(v time.Time) *MachineUpdateOne {
-	_u.mutation.SetUpdateTime(v)
-	return _u
-}
-
-// SetTenantID sets the "tenant_id" field.
-func (_u *MachineUpdateOne) SetTenantID(v uuid.UUID) *MachineUpdateOne {
-	_u.mutation.SetTenantID(v)
-	return _u
-}
-
-// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
-func (_u *MachineUpdateOne) SetNillableTenantID(v *uuid.UUID) *MachineUpdateOne {
-	if v != nil {
-		_u.SetTenantID(*v)
+	if nodes := _u.mutation.RemovedKeptIdentitiesIDs(); len(nodes) > 0 && !_u.mutation.KeptIdentitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   machine.KeptIdentitiesTable,
+			Columns: []string{machine.KeptIdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentidentity.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// SetName sets the "name" field.
-func (_u *MachineUpdateOne) SetName(v string) *MachineUpdateOne {
-	_u.mutation.SetName(v)
-	return _u
-}
-
-// SetNillableName sets the "name" field if the given value is not nil.
-func (_u *MachineUpdateOne) SetNillableName(v *string) *MachineUpdateOne {
-	if v != nil {
-		_u.SetName(*v)
+	if nodes := _u.mutation.KeptIdentitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   machine.KeptIdentitiesTable,
+			Columns: []string{machine.KeptIdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentidentity.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u
-}
-
-// SetCategory sets the "category" field.
-func (_u *MachineUpdateOne) SetCategory(v machine.Category) *MachineUpdateOne {
-	_u.mutation.SetCategory(v)
-	return _u
-}
-
-// SetNillableCategory sets the "category" field if the given value is not nil.
-func (_u *MachineUpdateOne) SetNillableCategory(v *machine.Category) *MachineUpdateOne {
-	if v != nil {
-		_u.SetCategory(*v)
+	if _u.mutation.ContentEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   machine.ContentEventsTable,
+			Columns: []string{machine.ContentEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentevent.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// SetHostname sets the "hostname" field.
-func (_u *MachineUpdateOne) SetHostname(v string) *MachineUpdateOne {
-	_u.mutation.SetHostname(v)
-	return _u
-}
-
-// SetNillableHostname sets the "hostname" field if the given value is not nil.
-func (_u *MachineUpdateOne) SetNillableHostname(v *string) *MachineUpdateOne {
-	if v != nil {
-		_u.SetHostname(*v)
+	if nodes := _u.mutation.RemovedContentEventsIDs(); len(nodes) > 0 && !_u.mutation.ContentEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   machine.ContentEventsTable,
+			Columns: []string{machine.ContentEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ContentEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   machine.ContentEventsTable,
+			Columns: []string{machine.ContentEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ScanTargetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   machine.ScanTargetsTable,
+			Columns: []string{machine.ScanTargetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scantarget.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedScanTargetsIDs(); len(nodes) > 0 && !_u.mutation.ScanTargetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   machine.ScanTargetsTable,
+			Columns: []string{machine.ScanTargetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scantarget.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ScanTargetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   machine.ScanTargetsTable,
+			Columns: []string{machine.ScanTargetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scantarget.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SecretsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   machine.SecretsTable,
+			Columns: []string{machine.SecretsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenantsecret.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSecretsIDs(); len(nodes) > 0 && !_u.mutation.SecretsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   machine.SecretsTable,
+			Columns: []string{machine.SecretsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenantsecret.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SecretsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   machine.SecretsTable,
+			Columns: []string{machine.SecretsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenantsecret.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ActionJobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   machine.ActionJobsTable,
+			Columns: []string{machine.ActionJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(actionjob.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedActionJobsIDs(); len(nodes) > 0 && !_u.mutation.ActionJobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   machine.ActionJobsTable,
+			Columns: []string{machine.ActionJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(actionjob.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ActionJobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   machine.ActionJobsTable,
+			Columns: []string{machine.ActionJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(actionjob.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{machine.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// MachineUpdateOne is the builder for updating a single Machine entity.
+type MachineUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MachineMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *MachineUpdateOne) SetUpdateTime(v time.Time) *MachineUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *MachineUpdateOne) SetTenantID(v uuid.UUID) *MachineUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *MachineUpdateOne) SetNillableTenantID(v *uuid.UUID) *MachineUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *MachineUpdateOne) SetName(v string) *MachineUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *MachineUpdateOne) SetNillableName(v *string) *MachineUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetCategory sets the "category" field.
+func (_u *MachineUpdateOne) SetCategory(v machine.Category) *MachineUpdateOne {
+	_u.mutation.SetCategory(v)
+	return _u
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (_u *MachineUpdateOne) SetNillableCategory(v *machine.Category) *MachineUpdateOne {
+	if v != nil {
+		_u.SetCategory(*v)
+	}
+	return _u
+}
+
+// SetHostname sets the "hostname" field.
+func (_u *MachineUpdateOne) SetHostname(v string) *MachineUpdateOne {
+	_u.mutation.SetHostname(v)
+	return _u
+}
+
+// SetNillableHostname sets the "hostname" field if the given value is not nil.
+func (_u *MachineUpdateOne) SetNillableHostname(v *string) *MachineUpdateOne {
+	if v != nil {
+		_u.SetHostname(*v)
 	}
 	return _u
 }
@@ -645,6 +1113,46 @@ func (_u *MachineUpdateOne) ClearLastScanAt() *MachineUpdateOne {
 	return _u
 }
 
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_u *MachineUpdateOne) SetLastSeenAt(v time.Time) *MachineUpdateOne {
+	_u.mutation.SetLastSeenAt(v)
+	return _u
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (_u *MachineUpdateOne) SetNillableLastSeenAt(v *time.Time) *MachineUpdateOne {
+	if v != nil {
+		_u.SetLastSeenAt(*v)
+	}
+	return _u
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (_u *MachineUpdateOne) ClearLastSeenAt() *MachineUpdateOne {
+	_u.mutation.ClearLastSeenAt()
+	return _u
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (_u *MachineUpdateOne) SetArchivedAt(v time.Time) *MachineUpdateOne {
+	_u.mutation.SetArchivedAt(v)
+	return _u
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (_u *MachineUpdateOne) SetNillableArchivedAt(v *time.Time) *MachineUpdateOne {
+	if v != nil {
+		_u.SetArchivedAt(*v)
+	}
+	return _u
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (_u *MachineUpdateOne) ClearArchivedAt() *MachineUpdateOne {
+	_u.mutation.ClearArchivedAt()
+	return _u
+}
+
 // SetTenant sets the "tenant" edge to the Tenant entity.
 func (_u *MachineUpdateOne) SetTenant(v *Tenant) *MachineUpdateOne {
 	return _u.SetTenantID(v.ID)
@@ -695,6 +1203,81 @@ func (_u *MachineUpdateOne) AddInitiatedScans(v ...*Scan) *MachineUpdateOne {
 	return _u.AddInitiatedScanIDs(ids...)
 }
 
+// AddKeptIdentityIDs adds the "kept_identities" edge to the ContentIdentity entity by IDs.
+func (_u *MachineUpdateOne) AddKeptIdentityIDs(ids ...uuid.UUID) *MachineUpdateOne {
+	_u.mutation.AddKeptIdentityIDs(ids...)
+	return _u
+}
+
+// AddKeptIdentities adds the "kept_identities" edges to the ContentIdentity entity.
+func (_u *MachineUpdateOne) AddKeptIdentities(v ...*ContentIdentity) *MachineUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddKeptIdentityIDs(ids...)
+}
+
+// AddContentEventIDs adds the "content_events" edge to the ContentEvent entity by IDs.
+func (_u *MachineUpdateOne) AddContentEventIDs(ids ...uuid.UUID) *MachineUpdateOne {
+	_u.mutation.AddContentEventIDs(ids...)
+	return _u
+}
+
+// AddContentEvents adds the "content_events" edges to the ContentEvent entity.
+func (_u *MachineUpdateOne) AddContentEvents(v ...*ContentEvent) *MachineUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddContentEventIDs(ids...)
+}
+
+// AddScanTargetIDs adds the "scan_targets" edge to the ScanTarget entity by IDs.
+func (_u *MachineUpdateOne) AddScanTargetIDs(ids ...uuid.UUID) *MachineUpdateOne {
+	_u.mutation.AddScanTargetIDs(ids...)
+	return _u
+}
+
+// AddScanTargets adds the "scan_targets" edges to the ScanTarget entity.
+func (_u *MachineUpdateOne) AddScanTargets(v ...*ScanTarget) *MachineUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddScanTargetIDs(ids...)
+}
+
+// AddSecretIDs adds the "secrets" edge to the TenantSecret entity by IDs.
+func (_u *MachineUpdateOne) AddSecretIDs(ids ...uuid.UUID) *MachineUpdateOne {
+	_u.mutation.AddSecretIDs(ids...)
+	return _u
+}
+
+// AddSecrets adds the "secrets" edges to the TenantSecret entity.
+func (_u *MachineUpdateOne) AddSecrets(v ...*TenantSecret) *MachineUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSecretIDs(ids...)
+}
+
+// AddActionJobIDs adds the "action_jobs" edge to the ActionJob entity by IDs.
+func (_u *MachineUpdateOne) AddActionJobIDs(ids ...uuid.UUID) *MachineUpdateOne {
+	_u.mutation.AddActionJobIDs(ids...)
+	return _u
+}
+
+// AddActionJobs adds the "action_jobs" edges to the ActionJob entity.
+func (_u *MachineUpdateOne) AddActionJobs(v ...*ActionJob) *MachineUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddActionJobIDs(ids...)
+}
+
 // Mutation returns the MachineMutation object of the builder.
 func (_u *MachineUpdateOne) Mutation() *MachineMutation {
 	return _u.mutation
@@ -769,6 +1352,111 @@ func (_u *MachineUpdateOne) RemoveInitiatedScans(v ...*Scan) *MachineUpdateOne {
 	return _u.RemoveInitiatedScanIDs(ids...)
 }
 
+// ClearKeptIdentities clears all "kept_identities" edges to the ContentIdentity entity.
+func (_u *MachineUpdateOne) ClearKeptIdentities() *MachineUpdateOne {
+	_u.mutation.ClearKeptIdentities()
+	return _u
+}
+
+// RemoveKeptIdentityIDs removes the "kept_identities" edge to ContentIdentity entities by IDs.
+func (_u *MachineUpdateOne) RemoveKeptIdentityIDs(ids ...uuid.UUID) *MachineUpdateOne {
+	_u.mutation.RemoveKeptIdentityIDs(ids...)
+	return _u
+}
+
+// RemoveKeptIdentities removes "kept_identities" edges to ContentIdentity entities.
+func (_u *MachineUpdateOne) RemoveKeptIdentities(v ...*ContentIdentity) *MachineUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveKeptIdentityIDs(ids...)
+}
+
+// ClearContentEvents clears all "content_events" edges to the ContentEvent entity.
+func (_u *MachineUpdateOne) ClearContentEvents() *MachineUpdateOne {
+	_u.mutation.ClearContentEvents()
+	return _u
+}
+
+// RemoveContentEventIDs removes the "content_events" edge to ContentEvent entities by IDs.
+func (_u *MachineUpdateOne) RemoveContentEventIDs(ids ...uuid.UUID) *MachineUpdateOne {
+	_u.mutation.RemoveContentEventIDs(ids...)
+	return _u
+}
+
+// RemoveContentEvents removes "content_events" edges to ContentEvent entities.
+func (_u *MachineUpdateOne) RemoveContentEvents(v ...*ContentEvent) *MachineUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveContentEventIDs(ids...)
+}
+
+// ClearScanTargets clears all "scan_targets" edges to the ScanTarget entity.
+func (_u *MachineUpdateOne) ClearScanTargets() *MachineUpdateOne {
+	_u.mutation.ClearScanTargets()
+	return _u
+}
+
+// RemoveScanTargetIDs removes the "scan_targets" edge to ScanTarget entities by IDs.
+func (_u *MachineUpdateOne) RemoveScanTargetIDs(ids ...uuid.UUID) *MachineUpdateOne {
+	_u.mutation.RemoveScanTargetIDs(ids...)
+	return _u
+}
+
+// RemoveScanTargets removes "scan_targets" edges to ScanTarget entities.
+func (_u *MachineUpdateOne) RemoveScanTargets(v ...*ScanTarget) *MachineUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveScanTargetIDs(ids...)
+}
+
+// ClearSecrets clears all "secrets" edges to the TenantSecret entity.
+func (_u *MachineUpdateOne) ClearSecrets() *MachineUpdateOne {
+	_u.mutation.ClearSecrets()
+	return _u
+}
+
+// RemoveSecretIDs removes the "secrets" edge to TenantSecret entities by IDs.
+func (_u *MachineUpdateOne) RemoveSecretIDs(ids ...uuid.UUID) *MachineUpdateOne {
+	_u.mutation.RemoveSecretIDs(ids...)
+	return _u
+}
+
+// RemoveSecrets removes "secrets" edges to TenantSecret entities.
+func (_u *MachineUpdateOne) RemoveSecrets(v ...*TenantSecret) *MachineUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSecretIDs(ids...)
+}
+
+// ClearActionJobs clears all "action_jobs" edges to the ActionJob entity.
+func (_u *MachineUpdateOne) ClearActionJobs() *MachineUpdateOne {
+	_u.mutation.ClearActionJobs()
+	return _u
+}
+
+// RemoveActionJobIDs removes the "action_jobs" edge to ActionJob entities by IDs.
+func (_u *MachineUpdateOne) RemoveActionJobIDs(ids ...uuid.UUID) *MachineUpdateOne {
+	_u.mutation.RemoveActionJobIDs(ids...)
+	return _u
+}
+
+// RemoveActionJobs removes "action_jobs" edges to ActionJob entities.
+func (_u *MachineUpdateOne) RemoveActionJobs(v ...*ActionJob) *MachineUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveActionJobIDs(ids...)
+}
+
 // Where appends a list predicates to the MachineUpdate builder.
 func (_u *MachineUpdateOne) Where(ps ...predicate.Machine) *MachineUpdateOne {
 	_u.mutation.Where(ps...)
@@ -784,7 +1472,9 @@ func (_u *MachineUpdateOne) Select(field string, fields ...string) *MachineUpdat
 
 // Save executes the query and returns the updated Machine entity.
 func (_u *MachineUpdateOne) Save(ctx context.Context) (*Machine, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -811,11 +1501,15 @@ func (_u *MachineUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *MachineUpdateOne) defaults() {
+func (_u *MachineUpdateOne) defaults() error {
 	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if machine.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized machine.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
 		v := machine.UpdateDefaultUpdateTime()
 		_u.mutation.SetUpdateTime(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -887,6 +1581,18 @@ func (_u *MachineUpdateOne) sqlSave(ctx context.Context) (_node *Machine, err er
 	if _u.mutation.LastScanAtCleared() {
 		_spec.ClearField(machine.FieldLastScanAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.LastSeenAt(); ok {
+		_spec.SetField(machine.FieldLastSeenAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastSeenAtCleared() {
+		_spec.ClearField(machine.FieldLastSeenAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ArchivedAt(); ok {
+		_spec.SetField(machine.FieldArchivedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ArchivedAtCleared() {
+		_spec.ClearField(machine.FieldArchivedAt, field.TypeTime)
+	}
 	if _u.mutation.TenantCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1051,6 +1757,231 @@ func (_u *MachineUpdateOne) sqlSave(ctx context.Context) (_node *Machine, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.KeptIdentitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   machine.KeptIdentitiesTable,
+			Columns: []string{machine.KeptIdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentidentity.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedKeptIdentitiesIDs(); len(nodes) > 0 && !_u.mutation.KeptIdentitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   machine.KeptIdentitiesTable,
+			Columns: []string{machine.KeptIdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentidentity.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.KeptIdentitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   machine.KeptIdentitiesTable,
+			Columns: []string{machine.KeptIdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentidentity.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ContentEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   machine.ContentEventsTable,
+			Columns: []string{machine.ContentEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentevent.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedContentEventsIDs(); len(nodes) > 0 && !_u.mutation.ContentEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   machine.ContentEventsTable,
+			Columns: []string{machine.ContentEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ContentEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   machine.ContentEventsTable,
+			Columns: []string{machine.ContentEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ScanTargetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   machine.ScanTargetsTable,
+			Columns: []string{machine.ScanTargetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scantarget.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedScanTargetsIDs(); len(nodes) > 0 && !_u.mutation.ScanTargetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   machine.ScanTargetsTable,
+			Columns: []string{machine.ScanTargetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scantarget.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ScanTargetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   machine.ScanTargetsTable,
+			Columns: []string{machine.ScanTargetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scantarget.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SecretsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   machine.SecretsTable,
+			Columns: []string{machine.SecretsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenantsecret.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSecretsIDs(); len(nodes) > 0 && !_u.mutation.SecretsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   machine.SecretsTable,
+			Columns: []string{machine.SecretsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenantsecret.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SecretsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   machine.SecretsTable,
+			Columns: []string{machine.SecretsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenantsecret.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ActionJobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   machine.ActionJobsTable,
+			Columns: []string{machine.ActionJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(actionjob.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedActionJobsIDs(); len(nodes) > 0 && !_u.mutation.ActionJobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   machine.ActionJobsTable,
+			Columns: []string{machine.ActionJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(actionjob.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ActionJobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   machine.ActionJobsTable,
+			Columns: []string{machine.ActionJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(actionjob.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Machine{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
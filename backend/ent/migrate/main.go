@@ -0,0 +1,70 @@
+//go:build ignore
+
+// Command main writes a versioned migration for the difference between the
+// migration directory and the Ent schema:
+//
+//	go run -mod=mod ./ent/migrate/main.go -dialect sqlite add_widgets
+//	go run -mod=mod ./ent/migrate/main.go -dialect postgres -dev-url "postgres://localhost/dev?sslmode=disable" add_widgets
+//
+// The dev database must be empty; the existing migrations are replayed on it
+// to compute the current state. SQLite defaults to an in-memory database.
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"path/filepath"
+
+	atlas "ariga.io/atlas/sql/migrate"
+	"ariga.io/atlas/sql/sqltool"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql/schema"
+	_ "github.com/lib/pq"
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/mcmx/duplynx/ent/migrate"
+)
+
+func main() {
+	store := flag.String("dialect", "sqlite", "sqlite or postgres")
+	devURL := flag.String("dev-url", "", "URL of an empty dev database (default: in-memory SQLite)")
+	flag.Parse()
+	if flag.NArg() != 1 {
+		log.Fatalln("usage: main [-dialect sqlite|postgres] [-dev-url URL] <name>")
+	}
+	var dialectName string
+	switch *store {
+	case "sqlite":
+		dialectName = dialect.SQLite
+		if *devURL == "" {
+			*devURL = "sqlite://dev?mode=memory&_fk=1"
+		}
+	case "postgres":
+		dialectName = dialect.Postgres
+		if *devURL == "" {
+			log.Fatalln("postgres migrations need -dev-url")
+		}
+	default:
+		log.Fatalf("unsupported dialect %q", *store)
+	}
+
+	dir, err := sqltool.NewGolangMigrateDir(filepath.Join("internal", "data", "migrations", *store))
+	if err != nil {
+		log.Fatalf("open migration directory: %v", err)
+	}
+	opts := []schema.MigrateOption{
+		schema.WithDir(dir),
+		schema.WithMigrationMode(schema.ModeReplay),
+		schema.WithDialect(dialectName),
+		schema.WithFormatter(sqltool.GolangMigrateFormatter),
+		schema.WithDropIndex(true),
+		schema.WithDropColumn(true),
+	}
+	if err := migrate.NamedDiff(context.Background(), *devURL, flag.Arg(0), opts...); err != nil {
+		log.Fatalf("generate migration: %v", err)
+	}
+	if err := atlas.Validate(dir); err != nil {
+		log.Fatalf("validate migration directory: %v", err)
+	}
+}
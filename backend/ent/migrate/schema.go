@@ -14,7 +14,7 @@ var (
 		{Name: "create_time", Type: field.TypeTime},
 		{Name: "update_time", Type: field.TypeTime},
 		{Name: "actor", Type: field.TypeString, Default: "system"},
-		{Name: "action_type", Type: field.TypeEnum, Enums: []string{"assign_keeper", "delete_copies", "create_hardlinks", "quarantine", "retry", "note"}},
+		{Name: "action_type", Type: field.TypeEnum, Enums: []string{"assign_keeper", "delete_copies", "create_hardlinks", "quarantine", "retry", "note", "action_queued", "action_failed"}},
 		{Name: "payload", Type: field.TypeJSON, Nullable: true},
 		{Name: "performed_at", Type: field.TypeTime},
 		{Name: "stubbed", Type: field.TypeBool, Default: false},
@@ -41,6 +41,155 @@ var (
 			},
 		},
 	}
+	// ActionJobsColumns holds the columns for the "action_jobs" table.
+	ActionJobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "action_id", Type: field.TypeUUID},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"delete_copies", "create_hardlinks", "quarantine"}},
+		{Name: "hash", Type: field.TypeString},
+		{Name: "keep_path", Type: field.TypeString, Nullable: true},
+		{Name: "paths", Type: field.TypeJSON},
+		{Name: "actor", Type: field.TypeString, Default: "system"},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "claimed", "succeeded", "failed"}, Default: "pending"},
+		{Name: "status_message", Type: field.TypeString, Nullable: true},
+		{Name: "done_paths", Type: field.TypeJSON, Nullable: true},
+		{Name: "claimed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
+		{Name: "duplicate_group_id", Type: field.TypeUUID},
+		{Name: "machine_id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+	}
+	// ActionJobsTable holds the schema information for the "action_jobs" table.
+	ActionJobsTable = &schema.Table{
+		Name:       "action_jobs",
+		Columns:    ActionJobsColumns,
+		PrimaryKey: []*schema.Column{ActionJobsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "action_jobs_duplicate_groups_action_jobs",
+				Columns:    []*schema.Column{ActionJobsColumns[14]},
+				RefColumns: []*schema.Column{DuplicateGroupsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "action_jobs_machines_action_jobs",
+				Columns:    []*schema.Column{ActionJobsColumns[15]},
+				RefColumns: []*schema.Column{MachinesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "action_jobs_tenants_action_jobs",
+				Columns:    []*schema.Column{ActionJobsColumns[16]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "actionjob_machine_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{ActionJobsColumns[15], ActionJobsColumns[9]},
+			},
+			{
+				Name:    "actionjob_action_id",
+				Unique:  false,
+				Columns: []*schema.Column{ActionJobsColumns[3]},
+			},
+		},
+	}
+	// ContentEventsColumns holds the columns for the "content_events" table.
+	ContentEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "path", Type: field.TypeString},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"appeared", "disappeared"}},
+		{Name: "occurred_at", Type: field.TypeTime},
+		{Name: "content_identity_id", Type: field.TypeUUID},
+		{Name: "machine_id", Type: field.TypeUUID},
+		{Name: "scan_id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+	}
+	// ContentEventsTable holds the schema information for the "content_events" table.
+	ContentEventsTable = &schema.Table{
+		Name:       "content_events",
+		Columns:    ContentEventsColumns,
+		PrimaryKey: []*schema.Column{ContentEventsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "content_events_content_identities_events",
+				Columns:    []*schema.Column{ContentEventsColumns[6]},
+				RefColumns: []*schema.Column{ContentIdentitiesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "content_events_machines_content_events",
+				Columns:    []*schema.Column{ContentEventsColumns[7]},
+				RefColumns: []*schema.Column{MachinesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "content_events_scans_content_events",
+				Columns:    []*schema.Column{ContentEventsColumns[8]},
+				RefColumns: []*schema.Column{ScansColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "content_events_tenants_content_events",
+				Columns:    []*schema.Column{ContentEventsColumns[9]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "contentevent_content_identity_id_occurred_at",
+				Unique:  false,
+				Columns: []*schema.Column{ContentEventsColumns[6], ContentEventsColumns[5]},
+			},
+		},
+	}
+	// ContentIdentitiesColumns holds the columns for the "content_identities" table.
+	ContentIdentitiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "hash", Type: field.TypeString},
+		{Name: "resolved_at", Type: field.TypeTime, Nullable: true},
+		{Name: "first_seen_at", Type: field.TypeTime},
+		{Name: "last_seen_at", Type: field.TypeTime},
+		{Name: "keeper_machine_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "tenant_id", Type: field.TypeUUID},
+	}
+	// ContentIdentitiesTable holds the schema information for the "content_identities" table.
+	ContentIdentitiesTable = &schema.Table{
+		Name:       "content_identities",
+		Columns:    ContentIdentitiesColumns,
+		PrimaryKey: []*schema.Column{ContentIdentitiesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "content_identities_machines_kept_identities",
+				Columns:    []*schema.Column{ContentIdentitiesColumns[7]},
+				RefColumns: []*schema.Column{MachinesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "content_identities_tenants_content_identities",
+				Columns:    []*schema.Column{ContentIdentitiesColumns[8]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "contentidentity_tenant_id_hash",
+				Unique:  true,
+				Columns: []*schema.Column{ContentIdentitiesColumns[8], ContentIdentitiesColumns[3]},
+			},
+		},
+	}
 	// DuplicateGroupsColumns holds the columns for the "duplicate_groups" table.
 	DuplicateGroupsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -50,6 +199,7 @@ var (
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"review", "action_needed", "resolved", "archived"}, Default: "review"},
 		{Name: "file_count", Type: field.TypeInt},
 		{Name: "total_size_bytes", Type: field.TypeInt64},
+		{Name: "content_identity_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "keeper_machine_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "scan_id", Type: field.TypeUUID},
 		{Name: "tenant_id", Type: field.TypeUUID},
@@ -61,20 +211,26 @@ var (
 		PrimaryKey: []*schema.Column{DuplicateGroupsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
 			{
-				Symbol:     "duplicate_groups_machines_keeper_groups",
+				Symbol:     "duplicate_groups_content_identities_duplicate_groups",
 				Columns:    []*schema.Column{DuplicateGroupsColumns[7]},
+				RefColumns: []*schema.Column{ContentIdentitiesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "duplicate_groups_machines_keeper_groups",
+				Columns:    []*schema.Column{DuplicateGroupsColumns[8]},
 				RefColumns: []*schema.Column{MachinesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "duplicate_groups_scans_duplicate_groups",
-				Columns:    []*schema.Column{DuplicateGroupsColumns[8]},
+				Columns:    []*schema.Column{DuplicateGroupsColumns[9]},
 				RefColumns: []*schema.Column{ScansColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "duplicate_groups_tenants_duplicate_groups",
-				Columns:    []*schema.Column{DuplicateGroupsColumns[9]},
+				Columns:    []*schema.Column{DuplicateGroupsColumns[10]},
 				RefColumns: []*schema.Column{TenantsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -92,6 +248,7 @@ var (
 		{Name: "quarantined", Type: field.TypeBool, Default: false},
 		{Name: "duplicate_group_id", Type: field.TypeUUID},
 		{Name: "machine_id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
 	}
 	// FileInstancesTable holds the schema information for the "file_instances" table.
 	FileInstancesTable = &schema.Table{
@@ -111,6 +268,12 @@ var (
 				RefColumns: []*schema.Column{MachinesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
+			{
+				Symbol:     "file_instances_tenants_file_instances",
+				Columns:    []*schema.Column{FileInstancesColumns[10]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
 		},
 	}
 	// MachinesColumns holds the columns for the "machines" table.
@@ -119,10 +282,12 @@ var (
 		{Name: "create_time", Type: field.TypeTime},
 		{Name: "update_time", Type: field.TypeTime},
 		{Name: "name", Type: field.TypeString},
-		{Name: "category", Type: field.TypeEnum, Enums: []string{"personal_laptop", "server"}},
+		{Name: "category", Type: field.TypeEnum, Enums: []string{"personal_laptop", "server", "workstation", "nas", "vm", "container", "cloud_bucket"}},
 		{Name: "hostname", Type: field.TypeString, Nullable: true},
 		{Name: "role", Type: field.TypeString, Nullable: true},
 		{Name: "last_scan_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_seen_at", Type: field.TypeTime, Nullable: true},
+		{Name: "archived_at", Type: field.TypeTime, Nullable: true},
 		{Name: "tenant_id", Type: field.TypeUUID},
 	}
 	// MachinesTable holds the schema information for the "machines" table.
@@ -133,7 +298,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "machines_tenants_machines",
-				Columns:    []*schema.Column{MachinesColumns[8]},
+				Columns:    []*schema.Column{MachinesColumns[10]},
 				RefColumns: []*schema.Column{TenantsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -149,6 +314,14 @@ var (
 		{Name: "started_at", Type: field.TypeTime},
 		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
 		{Name: "duplicate_group_count", Type: field.TypeInt},
+		{Name: "files_pruned_at", Type: field.TypeTime, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "running", "ingesting", "grouping", "completed", "partial", "failed", "cancelled"}, Default: "pending"},
+		{Name: "status_message", Type: field.TypeString, Nullable: true},
+		{Name: "files_seen", Type: field.TypeInt, Default: 0},
+		{Name: "bytes_hashed", Type: field.TypeInt64, Default: 0},
+		{Name: "machines_reported", Type: field.TypeInt, Default: 0},
+		{Name: "last_heartbeat_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deadline", Type: field.TypeTime, Nullable: true},
 		{Name: "initiated_machine_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "tenant_id", Type: field.TypeUUID},
 	}
@@ -160,17 +333,148 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "scans_machines_initiated_scans",
-				Columns:    []*schema.Column{ScansColumns[8]},
+				Columns:    []*schema.Column{ScansColumns[16]},
 				RefColumns: []*schema.Column{MachinesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "scans_tenants_scans",
-				Columns:    []*schema.Column{ScansColumns[9]},
+				Columns:    []*schema.Column{ScansColumns[17]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// ScanSchedulesColumns holds the columns for the "scan_schedules" table.
+	ScanSchedulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString},
+		{Name: "cron", Type: field.TypeString},
+		{Name: "timezone", Type: field.TypeString, Default: "UTC"},
+		{Name: "machine_ids", Type: field.TypeJSON},
+		{Name: "roots", Type: field.TypeJSON},
+		{Name: "exclusions", Type: field.TypeJSON, Nullable: true},
+		{Name: "deadline_seconds", Type: field.TypeInt, Default: 0},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "next_run_at", Type: field.TypeTime},
+		{Name: "last_run_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_scan_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "tenant_id", Type: field.TypeUUID},
+	}
+	// ScanSchedulesTable holds the schema information for the "scan_schedules" table.
+	ScanSchedulesTable = &schema.Table{
+		Name:       "scan_schedules",
+		Columns:    ScanSchedulesColumns,
+		PrimaryKey: []*schema.Column{ScanSchedulesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "scan_schedules_tenants_scan_schedules",
+				Columns:    []*schema.Column{ScanSchedulesColumns[14]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "scanschedule_enabled_next_run_at",
+				Unique:  false,
+				Columns: []*schema.Column{ScanSchedulesColumns[10], ScanSchedulesColumns[11]},
+			},
+		},
+	}
+	// ScanScheduleRunsColumns holds the columns for the "scan_schedule_runs" table.
+	ScanScheduleRunsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "scheduled_for", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"started", "skipped", "missed", "failed"}},
+		{Name: "scan_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "reason", Type: field.TypeString, Nullable: true},
+		{Name: "schedule_id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+	}
+	// ScanScheduleRunsTable holds the schema information for the "scan_schedule_runs" table.
+	ScanScheduleRunsTable = &schema.Table{
+		Name:       "scan_schedule_runs",
+		Columns:    ScanScheduleRunsColumns,
+		PrimaryKey: []*schema.Column{ScanScheduleRunsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "scan_schedule_runs_scan_schedules_runs",
+				Columns:    []*schema.Column{ScanScheduleRunsColumns[7]},
+				RefColumns: []*schema.Column{ScanSchedulesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "scan_schedule_runs_tenants_scan_schedule_runs",
+				Columns:    []*schema.Column{ScanScheduleRunsColumns[8]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "scanschedulerun_schedule_id_scheduled_for",
+				Unique:  false,
+				Columns: []*schema.Column{ScanScheduleRunsColumns[7], ScanScheduleRunsColumns[3]},
+			},
+		},
+	}
+	// ScanTargetsColumns holds the columns for the "scan_targets" table.
+	ScanTargetsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "roots", Type: field.TypeJSON},
+		{Name: "exclusions", Type: field.TypeJSON, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "claimed", "reported", "failed", "missed"}, Default: "pending"},
+		{Name: "status_message", Type: field.TypeString, Nullable: true},
+		{Name: "claimed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "reported_at", Type: field.TypeTime, Nullable: true},
+		{Name: "machine_id", Type: field.TypeUUID},
+		{Name: "scan_id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+	}
+	// ScanTargetsTable holds the schema information for the "scan_targets" table.
+	ScanTargetsTable = &schema.Table{
+		Name:       "scan_targets",
+		Columns:    ScanTargetsColumns,
+		PrimaryKey: []*schema.Column{ScanTargetsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "scan_targets_machines_scan_targets",
+				Columns:    []*schema.Column{ScanTargetsColumns[9]},
+				RefColumns: []*schema.Column{MachinesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "scan_targets_scans_targets",
+				Columns:    []*schema.Column{ScanTargetsColumns[10]},
+				RefColumns: []*schema.Column{ScansColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "scan_targets_tenants_scan_targets",
+				Columns:    []*schema.Column{ScanTargetsColumns[11]},
 				RefColumns: []*schema.Column{TenantsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "scantarget_scan_id_machine_id",
+				Unique:  true,
+				Columns: []*schema.Column{ScanTargetsColumns[10], ScanTargetsColumns[9]},
+			},
+			{
+				Name:    "scantarget_machine_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{ScanTargetsColumns[9], ScanTargetsColumns[5]},
+			},
+		},
 	}
 	// TenantsColumns holds the columns for the "tenants" table.
 	TenantsColumns = []*schema.Column{
@@ -181,6 +485,14 @@ var (
 		{Name: "name", Type: field.TypeString},
 		{Name: "description", Type: field.TypeString, Nullable: true},
 		{Name: "primary_contact", Type: field.TypeString, Nullable: true},
+		{Name: "archived_at", Type: field.TypeTime, Nullable: true},
+		{Name: "quota_manifest_bytes", Type: field.TypeInt64, Nullable: true},
+		{Name: "quota_ingest_per_minute", Type: field.TypeInt, Nullable: true},
+		{Name: "quota_machines", Type: field.TypeInt, Nullable: true},
+		{Name: "quota_retained_scans", Type: field.TypeInt, Nullable: true},
+		{Name: "quota_concurrent_actions", Type: field.TypeInt, Nullable: true},
+		{Name: "retention_keep_scans", Type: field.TypeInt, Nullable: true},
+		{Name: "retention_archive_after_days", Type: field.TypeInt, Nullable: true},
 	}
 	// TenantsTable holds the schema information for the "tenants" table.
 	TenantsTable = &schema.Table{
@@ -188,26 +500,111 @@ var (
 		Columns:    TenantsColumns,
 		PrimaryKey: []*schema.Column{TenantsColumns[0]},
 	}
+	// TenantSecretsColumns holds the columns for the "tenant_secrets" table.
+	TenantSecretsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "key_id", Type: field.TypeString},
+		{Name: "secret", Type: field.TypeString},
+		{Name: "not_before", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "machine_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "tenant_id", Type: field.TypeUUID},
+	}
+	// TenantSecretsTable holds the schema information for the "tenant_secrets" table.
+	TenantSecretsTable = &schema.Table{
+		Name:       "tenant_secrets",
+		Columns:    TenantSecretsColumns,
+		PrimaryKey: []*schema.Column{TenantSecretsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tenant_secrets_machines_secrets",
+				Columns:    []*schema.Column{TenantSecretsColumns[8]},
+				RefColumns: []*schema.Column{MachinesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "tenant_secrets_tenants_secrets",
+				Columns:    []*schema.Column{TenantSecretsColumns[9]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tenantsecret_tenant_id_key_id",
+				Unique:  true,
+				Columns: []*schema.Column{TenantSecretsColumns[9], TenantSecretsColumns[3]},
+			},
+		},
+	}
+	// TenantTombstonesColumns holds the columns for the "tenant_tombstones" table.
+	TenantTombstonesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "tenant_slug", Type: field.TypeString},
+		{Name: "tenant_name", Type: field.TypeString},
+		{Name: "actor", Type: field.TypeString, Default: "system"},
+		{Name: "purged_at", Type: field.TypeTime},
+		{Name: "deleted_rows", Type: field.TypeJSON, Nullable: true},
+	}
+	// TenantTombstonesTable holds the schema information for the "tenant_tombstones" table.
+	TenantTombstonesTable = &schema.Table{
+		Name:       "tenant_tombstones",
+		Columns:    TenantTombstonesColumns,
+		PrimaryKey: []*schema.Column{TenantTombstonesColumns[0]},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		ActionAuditsTable,
+		ActionJobsTable,
+		ContentEventsTable,
+		ContentIdentitiesTable,
 		DuplicateGroupsTable,
 		FileInstancesTable,
 		MachinesTable,
 		ScansTable,
+		ScanSchedulesTable,
+		ScanScheduleRunsTable,
+		ScanTargetsTable,
 		TenantsTable,
+		TenantSecretsTable,
+		TenantTombstonesTable,
 	}
 )
 
 func init() {
 	ActionAuditsTable.ForeignKeys[0].RefTable = DuplicateGroupsTable
 	ActionAuditsTable.ForeignKeys[1].RefTable = TenantsTable
-	DuplicateGroupsTable.ForeignKeys[0].RefTable = MachinesTable
-	DuplicateGroupsTable.ForeignKeys[1].RefTable = ScansTable
-	DuplicateGroupsTable.ForeignKeys[2].RefTable = TenantsTable
+	ActionJobsTable.ForeignKeys[0].RefTable = DuplicateGroupsTable
+	ActionJobsTable.ForeignKeys[1].RefTable = MachinesTable
+	ActionJobsTable.ForeignKeys[2].RefTable = TenantsTable
+	ContentEventsTable.ForeignKeys[0].RefTable = ContentIdentitiesTable
+	ContentEventsTable.ForeignKeys[1].RefTable = MachinesTable
+	ContentEventsTable.ForeignKeys[2].RefTable = ScansTable
+	ContentEventsTable.ForeignKeys[3].RefTable = TenantsTable
+	ContentIdentitiesTable.ForeignKeys[0].RefTable = MachinesTable
+	ContentIdentitiesTable.ForeignKeys[1].RefTable = TenantsTable
+	DuplicateGroupsTable.ForeignKeys[0].RefTable = ContentIdentitiesTable
+	DuplicateGroupsTable.ForeignKeys[1].RefTable = MachinesTable
+	DuplicateGroupsTable.ForeignKeys[2].RefTable = ScansTable
+	DuplicateGroupsTable.ForeignKeys[3].RefTable = TenantsTable
 	FileInstancesTable.ForeignKeys[0].RefTable = DuplicateGroupsTable
 	FileInstancesTable.ForeignKeys[1].RefTable = MachinesTable
+	FileInstancesTable.ForeignKeys[2].RefTable = TenantsTable
 	MachinesTable.ForeignKeys[0].RefTable = TenantsTable
 	ScansTable.ForeignKeys[0].RefTable = MachinesTable
 	ScansTable.ForeignKeys[1].RefTable = TenantsTable
+	ScanSchedulesTable.ForeignKeys[0].RefTable = TenantsTable
+	ScanScheduleRunsTable.ForeignKeys[0].RefTable = ScanSchedulesTable
+	ScanScheduleRunsTable.ForeignKeys[1].RefTable = TenantsTable
+	ScanTargetsTable.ForeignKeys[0].RefTable = MachinesTable
+	ScanTargetsTable.ForeignKeys[1].RefTable = ScansTable
+	ScanTargetsTable.ForeignKeys[2].RefTable = TenantsTable
+	TenantSecretsTable.ForeignKeys[0].RefTable = MachinesTable
+	TenantSecretsTable.ForeignKeys[1].RefTable = TenantsTable
 }
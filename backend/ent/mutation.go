@@ -13,12 +13,20 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/mcmx/duplynx/ent/actionaudit"
+	"github.com/mcmx/duplynx/ent/actionjob"
+	"github.com/mcmx/duplynx/ent/contentevent"
+	"github.com/mcmx/duplynx/ent/contentidentity"
 	"github.com/mcmx/duplynx/ent/duplicategroup"
 	"github.com/mcmx/duplynx/ent/fileinstance"
 	"github.com/mcmx/duplynx/ent/machine"
 	"github.com/mcmx/duplynx/ent/predicate"
 	"github.com/mcmx/duplynx/ent/scan"
+	"github.com/mcmx/duplynx/ent/scanschedule"
+	"github.com/mcmx/duplynx/ent/scanschedulerun"
+	"github.com/mcmx/duplynx/ent/scantarget"
 	"github.com/mcmx/duplynx/ent/tenant"
+	"github.com/mcmx/duplynx/ent/tenantsecret"
+	"github.com/mcmx/duplynx/ent/tenanttombstone"
 )
 
 const (
@@ -30,12 +38,20 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeActionAudit    = "ActionAudit"
-	TypeDuplicateGroup = "DuplicateGroup"
-	TypeFileInstance   = "FileInstance"
-	TypeMachine        = "Machine"
-	TypeScan           = "Scan"
-	TypeTenant         = "Tenant"
+	TypeActionAudit     = "ActionAudit"
+	TypeActionJob       = "ActionJob"
+	TypeContentEvent    = "ContentEvent"
+	TypeContentIdentity = "ContentIdentity"
+	TypeDuplicateGroup  = "DuplicateGroup"
+	TypeFileInstance    = "FileInstance"
+	TypeMachine         = "Machine"
+	TypeScan            = "Scan"
+	TypeScanSchedule    = "ScanSchedule"
+	TypeScanScheduleRun = "ScanScheduleRun"
+	TypeScanTarget      = "ScanTarget"
+	TypeTenant          = "Tenant"
+	TypeTenantSecret    = "TenantSecret"
+	TypeTenantTombstone = "TenantTombstone"
 )
 
 // ActionAuditMutation represents an operation that mutates the ActionAudit nodes in the graph.
@@ -924,49 +940,50 @@ func (m *ActionAuditMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown ActionAudit edge %s", name)
 }
 
-// DuplicateGroupMutation represents an operation that mutates the DuplicateGroup nodes in the graph.
-type DuplicateGroupMutation struct {
+// ActionJobMutation represents an operation that mutates the ActionJob nodes in the graph.
+type ActionJobMutation struct {
 	config
-	op                    Op
-	typ                   string
-	id                    *uuid.UUID
-	create_time           *time.Time
-	update_time           *time.Time
-	hash                  *string
-	status                *duplicategroup.Status
-	file_count            *int
-	addfile_count         *int
-	total_size_bytes      *int64
-	addtotal_size_bytes   *int64
-	clearedFields         map[string]struct{}
-	tenant                *uuid.UUID
-	clearedtenant         bool
-	scan                  *uuid.UUID
-	clearedscan           bool
-	keeper_machine        *uuid.UUID
-	clearedkeeper_machine bool
-	file_instances        map[uuid.UUID]struct{}
-	removedfile_instances map[uuid.UUID]struct{}
-	clearedfile_instances bool
-	action_audits         map[uuid.UUID]struct{}
-	removedaction_audits  map[uuid.UUID]struct{}
-	clearedaction_audits  bool
-	done                  bool
-	oldValue              func(context.Context) (*DuplicateGroup, error)
-	predicates            []predicate.DuplicateGroup
+	op                     Op
+	typ                    string
+	id                     *uuid.UUID
+	create_time            *time.Time
+	update_time            *time.Time
+	action_id              *uuid.UUID
+	action                 *actionjob.Action
+	hash                   *string
+	keep_path              *string
+	paths                  *[]string
+	appendpaths            []string
+	actor                  *string
+	status                 *actionjob.Status
+	status_message         *string
+	done_paths             *[]string
+	appenddone_paths       []string
+	claimed_at             *time.Time
+	finished_at            *time.Time
+	clearedFields          map[string]struct{}
+	tenant                 *uuid.UUID
+	clearedtenant          bool
+	duplicate_group        *uuid.UUID
+	clearedduplicate_group bool
+	machine                *uuid.UUID
+	clearedmachine         bool
+	done                   bool
+	oldValue               func(context.Context) (*ActionJob, error)
+	predicates             []predicate.ActionJob
 }
 
-var _ ent.Mutation = (*DuplicateGroupMutation)(nil)
+var _ ent.Mutation = (*ActionJobMutation)(nil)
 
-// duplicategroupOption allows management of the mutation configuration using functional options.
-type duplicategroupOption func(*DuplicateGroupMutation)
+// actionjobOption allows management of the mutation configuration using functional options.
+type actionjobOption func(*ActionJobMutation)
 
-// newDuplicateGroupMutation creates new mutation for the DuplicateGroup entity.
-func newDuplicateGroupMutation(c config, op Op, opts ...duplicategroupOption) *DuplicateGroupMutation {
-	m := &DuplicateGroupMutation{
+// newActionJobMutation creates new mutation for the ActionJob entity.
+func newActionJobMutation(c config, op Op, opts ...actionjobOption) *ActionJobMutation {
+	m := &ActionJobMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDuplicateGroup,
+		typ:           TypeActionJob,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -975,20 +992,20 @@ func newDuplicateGroupMutation(c config, op Op, opts ...duplicategroupOption) *D
 	return m
 }
 
-// withDuplicateGroupID sets the ID field of the mutation.
-func withDuplicateGroupID(id uuid.UUID) duplicategroupOption {
-	return func(m *DuplicateGroupMutation) {
+// withActionJobID sets the ID field of the mutation.
+func withActionJobID(id uuid.UUID) actionjobOption {
+	return func(m *ActionJobMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *DuplicateGroup
+			value *ActionJob
 		)
-		m.oldValue = func(ctx context.Context) (*DuplicateGroup, error) {
+		m.oldValue = func(ctx context.Context) (*ActionJob, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().DuplicateGroup.Get(ctx, id)
+					value, err = m.Client().ActionJob.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -997,10 +1014,10 @@ func withDuplicateGroupID(id uuid.UUID) duplicategroupOption {
 	}
 }
 
-// withDuplicateGroup sets the old DuplicateGroup of the mutation.
-func withDuplicateGroup(node *DuplicateGroup) duplicategroupOption {
-	return func(m *DuplicateGroupMutation) {
-		m.oldValue = func(context.Context) (*DuplicateGroup, error) {
+// withActionJob sets the old ActionJob of the mutation.
+func withActionJob(node *ActionJob) actionjobOption {
+	return func(m *ActionJobMutation) {
+		m.oldValue = func(context.Context) (*ActionJob, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1009,7 +1026,7 @@ func withDuplicateGroup(node *DuplicateGroup) duplicategroupOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DuplicateGroupMutation) Client() *Client {
+func (m ActionJobMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1017,7 +1034,7 @@ func (m DuplicateGroupMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DuplicateGroupMutation) Tx() (*Tx, error) {
+func (m ActionJobMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1027,14 +1044,14 @@ func (m DuplicateGroupMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of DuplicateGroup entities.
-func (m *DuplicateGroupMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of ActionJob entities.
+func (m *ActionJobMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DuplicateGroupMutation) ID() (id uuid.UUID, exists bool) {
+func (m *ActionJobMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1045,7 +1062,7 @@ func (m *DuplicateGroupMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DuplicateGroupMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *ActionJobMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1054,19 +1071,19 @@ func (m *DuplicateGroupMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().DuplicateGroup.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ActionJob.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *DuplicateGroupMutation) SetCreateTime(t time.Time) {
+func (m *ActionJobMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *DuplicateGroupMutation) CreateTime() (r time.Time, exists bool) {
+func (m *ActionJobMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -1074,10 +1091,10 @@ func (m *DuplicateGroupMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the DuplicateGroup entity.
-// If the DuplicateGroup object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the ActionJob entity.
+// If the ActionJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DuplicateGroupMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *ActionJobMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -1092,17 +1109,17 @@ func (m *DuplicateGroupMutation) OldCreateTime(ctx context.Context) (v time.Time
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *DuplicateGroupMutation) ResetCreateTime() {
+func (m *ActionJobMutation) ResetCreateTime() {
 	m.create_time = nil
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *DuplicateGroupMutation) SetUpdateTime(t time.Time) {
+func (m *ActionJobMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *DuplicateGroupMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *ActionJobMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -1110,10 +1127,10 @@ func (m *DuplicateGroupMutation) UpdateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the DuplicateGroup entity.
-// If the DuplicateGroup object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the ActionJob entity.
+// If the ActionJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DuplicateGroupMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *ActionJobMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -1128,17 +1145,17 @@ func (m *DuplicateGroupMutation) OldUpdateTime(ctx context.Context) (v time.Time
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *DuplicateGroupMutation) ResetUpdateTime() {
+func (m *ActionJobMutation) ResetUpdateTime() {
 	m.update_time = nil
 }
 
 // SetTenantID sets the "tenant_id" field.
-func (m *DuplicateGroupMutation) SetTenantID(u uuid.UUID) {
+func (m *ActionJobMutation) SetTenantID(u uuid.UUID) {
 	m.tenant = &u
 }
 
 // TenantID returns the value of the "tenant_id" field in the mutation.
-func (m *DuplicateGroupMutation) TenantID() (r uuid.UUID, exists bool) {
+func (m *ActionJobMutation) TenantID() (r uuid.UUID, exists bool) {
 	v := m.tenant
 	if v == nil {
 		return
@@ -1146,10 +1163,10 @@ func (m *DuplicateGroupMutation) TenantID() (r uuid.UUID, exists bool) {
 	return *v, true
 }
 
-// OldTenantID returns the old "tenant_id" field's value of the DuplicateGroup entity.
-// If the DuplicateGroup object wasn't provided to the builder, the object is fetched from the database.
+// OldTenantID returns the old "tenant_id" field's value of the ActionJob entity.
+// If the ActionJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DuplicateGroupMutation) OldTenantID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *ActionJobMutation) OldTenantID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
 	}
@@ -1164,102 +1181,161 @@ func (m *DuplicateGroupMutation) OldTenantID(ctx context.Context) (v uuid.UUID,
 }
 
 // ResetTenantID resets all changes to the "tenant_id" field.
-func (m *DuplicateGroupMutation) ResetTenantID() {
+func (m *ActionJobMutation) ResetTenantID() {
 	m.tenant = nil
 }
 
-// SetScanID sets the "scan_id" field.
-func (m *DuplicateGroupMutation) SetScanID(u uuid.UUID) {
-	m.scan = &u
+// SetActionID sets the "action_id" field.
+func (m *ActionJobMutation) SetActionID(u uuid.UUID) {
+	m.action_id = &u
 }
 
-// ScanID returns the value of the "scan_id" field in the mutation.
-func (m *DuplicateGroupMutation) ScanID() (r uuid.UUID, exists bool) {
-	v := m.scan
+// ActionID returns the value of the "action_id" field in the mutation.
+func (m *ActionJobMutation) ActionID() (r uuid.UUID, exists bool) {
+	v := m.action_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldScanID returns the old "scan_id" field's value of the DuplicateGroup entity.
-// If the DuplicateGroup object wasn't provided to the builder, the object is fetched from the database.
+// OldActionID returns the old "action_id" field's value of the ActionJob entity.
+// If the ActionJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DuplicateGroupMutation) OldScanID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *ActionJobMutation) OldActionID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldScanID is only allowed on UpdateOne operations")
+		return v, errors.New("OldActionID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldScanID requires an ID field in the mutation")
+		return v, errors.New("OldActionID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldScanID: %w", err)
+		return v, fmt.Errorf("querying old value for OldActionID: %w", err)
 	}
-	return oldValue.ScanID, nil
+	return oldValue.ActionID, nil
 }
 
-// ResetScanID resets all changes to the "scan_id" field.
-func (m *DuplicateGroupMutation) ResetScanID() {
-	m.scan = nil
+// ResetActionID resets all changes to the "action_id" field.
+func (m *ActionJobMutation) ResetActionID() {
+	m.action_id = nil
 }
 
-// SetKeeperMachineID sets the "keeper_machine_id" field.
-func (m *DuplicateGroupMutation) SetKeeperMachineID(u uuid.UUID) {
-	m.keeper_machine = &u
+// SetDuplicateGroupID sets the "duplicate_group_id" field.
+func (m *ActionJobMutation) SetDuplicateGroupID(u uuid.UUID) {
+	m.duplicate_group = &u
 }
 
-// KeeperMachineID returns the value of the "keeper_machine_id" field in the mutation.
-func (m *DuplicateGroupMutation) KeeperMachineID() (r uuid.UUID, exists bool) {
-	v := m.keeper_machine
+// DuplicateGroupID returns the value of the "duplicate_group_id" field in the mutation.
+func (m *ActionJobMutation) DuplicateGroupID() (r uuid.UUID, exists bool) {
+	v := m.duplicate_group
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldKeeperMachineID returns the old "keeper_machine_id" field's value of the DuplicateGroup entity.
-// If the DuplicateGroup object wasn't provided to the builder, the object is fetched from the database.
+// OldDuplicateGroupID returns the old "duplicate_group_id" field's value of the ActionJob entity.
+// If the ActionJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DuplicateGroupMutation) OldKeeperMachineID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *ActionJobMutation) OldDuplicateGroupID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldKeeperMachineID is only allowed on UpdateOne operations")
+		return v, errors.New("OldDuplicateGroupID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldKeeperMachineID requires an ID field in the mutation")
+		return v, errors.New("OldDuplicateGroupID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldKeeperMachineID: %w", err)
+		return v, fmt.Errorf("querying old value for OldDuplicateGroupID: %w", err)
 	}
-	return oldValue.KeeperMachineID, nil
+	return oldValue.DuplicateGroupID, nil
 }
 
-// ClearKeeperMachineID clears the value of the "keeper_machine_id" field.
-func (m *DuplicateGroupMutation) ClearKeeperMachineID() {
-	m.keeper_machine = nil
-	m.clearedFields[duplicategroup.FieldKeeperMachineID] = struct{}{}
+// ResetDuplicateGroupID resets all changes to the "duplicate_group_id" field.
+func (m *ActionJobMutation) ResetDuplicateGroupID() {
+	m.duplicate_group = nil
 }
 
-// KeeperMachineIDCleared returns if the "keeper_machine_id" field was cleared in this mutation.
-func (m *DuplicateGroupMutation) KeeperMachineIDCleared() bool {
-	_, ok := m.clearedFields[duplicategroup.FieldKeeperMachineID]
-	return ok
+// SetMachineID sets the "machine_id" field.
+func (m *ActionJobMutation) SetMachineID(u uuid.UUID) {
+	m.machine = &u
 }
 
-// ResetKeeperMachineID resets all changes to the "keeper_machine_id" field.
-func (m *DuplicateGroupMutation) ResetKeeperMachineID() {
-	m.keeper_machine = nil
-	delete(m.clearedFields, duplicategroup.FieldKeeperMachineID)
+// MachineID returns the value of the "machine_id" field in the mutation.
+func (m *ActionJobMutation) MachineID() (r uuid.UUID, exists bool) {
+	v := m.machine
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMachineID returns the old "machine_id" field's value of the ActionJob entity.
+// If the ActionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActionJobMutation) OldMachineID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMachineID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMachineID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMachineID: %w", err)
+	}
+	return oldValue.MachineID, nil
+}
+
+// ResetMachineID resets all changes to the "machine_id" field.
+func (m *ActionJobMutation) ResetMachineID() {
+	m.machine = nil
+}
+
+// SetAction sets the "action" field.
+func (m *ActionJobMutation) SetAction(a actionjob.Action) {
+	m.action = &a
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *ActionJobMutation) Action() (r actionjob.Action, exists bool) {
+	v := m.action
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAction returns the old "action" field's value of the ActionJob entity.
+// If the ActionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActionJobMutation) OldAction(ctx context.Context) (v actionjob.Action, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
+}
+
+// ResetAction resets all changes to the "action" field.
+func (m *ActionJobMutation) ResetAction() {
+	m.action = nil
 }
 
 // SetHash sets the "hash" field.
-func (m *DuplicateGroupMutation) SetHash(s string) {
+func (m *ActionJobMutation) SetHash(s string) {
 	m.hash = &s
 }
 
 // Hash returns the value of the "hash" field in the mutation.
-func (m *DuplicateGroupMutation) Hash() (r string, exists bool) {
+func (m *ActionJobMutation) Hash() (r string, exists bool) {
 	v := m.hash
 	if v == nil {
 		return
@@ -1267,10 +1343,10 @@ func (m *DuplicateGroupMutation) Hash() (r string, exists bool) {
 	return *v, true
 }
 
-// OldHash returns the old "hash" field's value of the DuplicateGroup entity.
-// If the DuplicateGroup object wasn't provided to the builder, the object is fetched from the database.
+// OldHash returns the old "hash" field's value of the ActionJob entity.
+// If the ActionJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DuplicateGroupMutation) OldHash(ctx context.Context) (v string, err error) {
+func (m *ActionJobMutation) OldHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldHash is only allowed on UpdateOne operations")
 	}
@@ -1285,356 +1361,484 @@ func (m *DuplicateGroupMutation) OldHash(ctx context.Context) (v string, err err
 }
 
 // ResetHash resets all changes to the "hash" field.
-func (m *DuplicateGroupMutation) ResetHash() {
+func (m *ActionJobMutation) ResetHash() {
 	m.hash = nil
 }
 
-// SetStatus sets the "status" field.
-func (m *DuplicateGroupMutation) SetStatus(d duplicategroup.Status) {
-	m.status = &d
+// SetKeepPath sets the "keep_path" field.
+func (m *ActionJobMutation) SetKeepPath(s string) {
+	m.keep_path = &s
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *DuplicateGroupMutation) Status() (r duplicategroup.Status, exists bool) {
-	v := m.status
+// KeepPath returns the value of the "keep_path" field in the mutation.
+func (m *ActionJobMutation) KeepPath() (r string, exists bool) {
+	v := m.keep_path
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the DuplicateGroup entity.
-// If the DuplicateGroup object wasn't provided to the builder, the object is fetched from the database.
+// OldKeepPath returns the old "keep_path" field's value of the ActionJob entity.
+// If the ActionJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DuplicateGroupMutation) OldStatus(ctx context.Context) (v duplicategroup.Status, err error) {
+func (m *ActionJobMutation) OldKeepPath(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldKeepPath is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldKeepPath requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldKeepPath: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.KeepPath, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *DuplicateGroupMutation) ResetStatus() {
-	m.status = nil
+// ClearKeepPath clears the value of the "keep_path" field.
+func (m *ActionJobMutation) ClearKeepPath() {
+	m.keep_path = nil
+	m.clearedFields[actionjob.FieldKeepPath] = struct{}{}
 }
 
-// SetFileCount sets the "file_count" field.
-func (m *DuplicateGroupMutation) SetFileCount(i int) {
-	m.file_count = &i
-	m.addfile_count = nil
+// KeepPathCleared returns if the "keep_path" field was cleared in this mutation.
+func (m *ActionJobMutation) KeepPathCleared() bool {
+	_, ok := m.clearedFields[actionjob.FieldKeepPath]
+	return ok
 }
 
-// FileCount returns the value of the "file_count" field in the mutation.
-func (m *DuplicateGroupMutation) FileCount() (r int, exists bool) {
-	v := m.file_count
+// ResetKeepPath resets all changes to the "keep_path" field.
+func (m *ActionJobMutation) ResetKeepPath() {
+	m.keep_path = nil
+	delete(m.clearedFields, actionjob.FieldKeepPath)
+}
+
+// SetPaths sets the "paths" field.
+func (m *ActionJobMutation) SetPaths(s []string) {
+	m.paths = &s
+	m.appendpaths = nil
+}
+
+// Paths returns the value of the "paths" field in the mutation.
+func (m *ActionJobMutation) Paths() (r []string, exists bool) {
+	v := m.paths
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFileCount returns the old "file_count" field's value of the DuplicateGroup entity.
-// If the DuplicateGroup object wasn't provided to the builder, the object is fetched from the database.
+// OldPaths returns the old "paths" field's value of the ActionJob entity.
+// If the ActionJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DuplicateGroupMutation) OldFileCount(ctx context.Context) (v int, err error) {
+func (m *ActionJobMutation) OldPaths(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFileCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldPaths is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFileCount requires an ID field in the mutation")
+		return v, errors.New("OldPaths requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFileCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldPaths: %w", err)
 	}
-	return oldValue.FileCount, nil
+	return oldValue.Paths, nil
 }
 
-// AddFileCount adds i to the "file_count" field.
-func (m *DuplicateGroupMutation) AddFileCount(i int) {
-	if m.addfile_count != nil {
-		*m.addfile_count += i
-	} else {
-		m.addfile_count = &i
-	}
+// AppendPaths adds s to the "paths" field.
+func (m *ActionJobMutation) AppendPaths(s []string) {
+	m.appendpaths = append(m.appendpaths, s...)
 }
 
-// AddedFileCount returns the value that was added to the "file_count" field in this mutation.
-func (m *DuplicateGroupMutation) AddedFileCount() (r int, exists bool) {
-	v := m.addfile_count
-	if v == nil {
-		return
+// AppendedPaths returns the list of values that were appended to the "paths" field in this mutation.
+func (m *ActionJobMutation) AppendedPaths() ([]string, bool) {
+	if len(m.appendpaths) == 0 {
+		return nil, false
 	}
-	return *v, true
+	return m.appendpaths, true
 }
 
-// ResetFileCount resets all changes to the "file_count" field.
-func (m *DuplicateGroupMutation) ResetFileCount() {
-	m.file_count = nil
-	m.addfile_count = nil
+// ResetPaths resets all changes to the "paths" field.
+func (m *ActionJobMutation) ResetPaths() {
+	m.paths = nil
+	m.appendpaths = nil
 }
 
-// SetTotalSizeBytes sets the "total_size_bytes" field.
-func (m *DuplicateGroupMutation) SetTotalSizeBytes(i int64) {
-	m.total_size_bytes = &i
-	m.addtotal_size_bytes = nil
+// SetActor sets the "actor" field.
+func (m *ActionJobMutation) SetActor(s string) {
+	m.actor = &s
 }
 
-// TotalSizeBytes returns the value of the "total_size_bytes" field in the mutation.
-func (m *DuplicateGroupMutation) TotalSizeBytes() (r int64, exists bool) {
-	v := m.total_size_bytes
+// Actor returns the value of the "actor" field in the mutation.
+func (m *ActionJobMutation) Actor() (r string, exists bool) {
+	v := m.actor
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTotalSizeBytes returns the old "total_size_bytes" field's value of the DuplicateGroup entity.
-// If the DuplicateGroup object wasn't provided to the builder, the object is fetched from the database.
+// OldActor returns the old "actor" field's value of the ActionJob entity.
+// If the ActionJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DuplicateGroupMutation) OldTotalSizeBytes(ctx context.Context) (v int64, err error) {
+func (m *ActionJobMutation) OldActor(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTotalSizeBytes is only allowed on UpdateOne operations")
+		return v, errors.New("OldActor is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTotalSizeBytes requires an ID field in the mutation")
+		return v, errors.New("OldActor requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTotalSizeBytes: %w", err)
+		return v, fmt.Errorf("querying old value for OldActor: %w", err)
 	}
-	return oldValue.TotalSizeBytes, nil
+	return oldValue.Actor, nil
 }
 
-// AddTotalSizeBytes adds i to the "total_size_bytes" field.
-func (m *DuplicateGroupMutation) AddTotalSizeBytes(i int64) {
-	if m.addtotal_size_bytes != nil {
-		*m.addtotal_size_bytes += i
-	} else {
-		m.addtotal_size_bytes = &i
-	}
+// ResetActor resets all changes to the "actor" field.
+func (m *ActionJobMutation) ResetActor() {
+	m.actor = nil
 }
 
-// AddedTotalSizeBytes returns the value that was added to the "total_size_bytes" field in this mutation.
-func (m *DuplicateGroupMutation) AddedTotalSizeBytes() (r int64, exists bool) {
-	v := m.addtotal_size_bytes
+// SetStatus sets the "status" field.
+func (m *ActionJobMutation) SetStatus(a actionjob.Status) {
+	m.status = &a
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ActionJobMutation) Status() (r actionjob.Status, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetTotalSizeBytes resets all changes to the "total_size_bytes" field.
-func (m *DuplicateGroupMutation) ResetTotalSizeBytes() {
-	m.total_size_bytes = nil
-	m.addtotal_size_bytes = nil
+// OldStatus returns the old "status" field's value of the ActionJob entity.
+// If the ActionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActionJobMutation) OldStatus(ctx context.Context) (v actionjob.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
 }
 
-// ClearTenant clears the "tenant" edge to the Tenant entity.
-func (m *DuplicateGroupMutation) ClearTenant() {
-	m.clearedtenant = true
-	m.clearedFields[duplicategroup.FieldTenantID] = struct{}{}
+// ResetStatus resets all changes to the "status" field.
+func (m *ActionJobMutation) ResetStatus() {
+	m.status = nil
 }
 
-// TenantCleared reports if the "tenant" edge to the Tenant entity was cleared.
-func (m *DuplicateGroupMutation) TenantCleared() bool {
-	return m.clearedtenant
+// SetStatusMessage sets the "status_message" field.
+func (m *ActionJobMutation) SetStatusMessage(s string) {
+	m.status_message = &s
 }
 
-// TenantIDs returns the "tenant" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TenantID instead. It exists only for internal usage by the builders.
-func (m *DuplicateGroupMutation) TenantIDs() (ids []uuid.UUID) {
-	if id := m.tenant; id != nil {
-		ids = append(ids, *id)
+// StatusMessage returns the value of the "status_message" field in the mutation.
+func (m *ActionJobMutation) StatusMessage() (r string, exists bool) {
+	v := m.status_message
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetTenant resets all changes to the "tenant" edge.
-func (m *DuplicateGroupMutation) ResetTenant() {
-	m.tenant = nil
-	m.clearedtenant = false
+// OldStatusMessage returns the old "status_message" field's value of the ActionJob entity.
+// If the ActionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActionJobMutation) OldStatusMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatusMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatusMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatusMessage: %w", err)
+	}
+	return oldValue.StatusMessage, nil
 }
 
-// ClearScan clears the "scan" edge to the Scan entity.
-func (m *DuplicateGroupMutation) ClearScan() {
-	m.clearedscan = true
-	m.clearedFields[duplicategroup.FieldScanID] = struct{}{}
+// ClearStatusMessage clears the value of the "status_message" field.
+func (m *ActionJobMutation) ClearStatusMessage() {
+	m.status_message = nil
+	m.clearedFields[actionjob.FieldStatusMessage] = struct{}{}
 }
 
-// ScanCleared reports if the "scan" edge to the Scan entity was cleared.
-func (m *DuplicateGroupMutation) ScanCleared() bool {
-	return m.clearedscan
+// StatusMessageCleared returns if the "status_message" field was cleared in this mutation.
+func (m *ActionJobMutation) StatusMessageCleared() bool {
+	_, ok := m.clearedFields[actionjob.FieldStatusMessage]
+	return ok
 }
 
-// ScanIDs returns the "scan" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ScanID instead. It exists only for internal usage by the builders.
-func (m *DuplicateGroupMutation) ScanIDs() (ids []uuid.UUID) {
-	if id := m.scan; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// ResetStatusMessage resets all changes to the "status_message" field.
+func (m *ActionJobMutation) ResetStatusMessage() {
+	m.status_message = nil
+	delete(m.clearedFields, actionjob.FieldStatusMessage)
 }
 
-// ResetScan resets all changes to the "scan" edge.
-func (m *DuplicateGroupMutation) ResetScan() {
-	m.scan = nil
-	m.clearedscan = false
+// SetDonePaths sets the "done_paths" field.
+func (m *ActionJobMutation) SetDonePaths(s []string) {
+	m.done_paths = &s
+	m.appenddone_paths = nil
 }
 
-// ClearKeeperMachine clears the "keeper_machine" edge to the Machine entity.
-func (m *DuplicateGroupMutation) ClearKeeperMachine() {
-	m.clearedkeeper_machine = true
-	m.clearedFields[duplicategroup.FieldKeeperMachineID] = struct{}{}
+// DonePaths returns the value of the "done_paths" field in the mutation.
+func (m *ActionJobMutation) DonePaths() (r []string, exists bool) {
+	v := m.done_paths
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// KeeperMachineCleared reports if the "keeper_machine" edge to the Machine entity was cleared.
-func (m *DuplicateGroupMutation) KeeperMachineCleared() bool {
-	return m.KeeperMachineIDCleared() || m.clearedkeeper_machine
+// OldDonePaths returns the old "done_paths" field's value of the ActionJob entity.
+// If the ActionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActionJobMutation) OldDonePaths(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDonePaths is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDonePaths requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDonePaths: %w", err)
+	}
+	return oldValue.DonePaths, nil
 }
 
-// KeeperMachineIDs returns the "keeper_machine" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// KeeperMachineID instead. It exists only for internal usage by the builders.
-func (m *DuplicateGroupMutation) KeeperMachineIDs() (ids []uuid.UUID) {
-	if id := m.keeper_machine; id != nil {
-		ids = append(ids, *id)
+// AppendDonePaths adds s to the "done_paths" field.
+func (m *ActionJobMutation) AppendDonePaths(s []string) {
+	m.appenddone_paths = append(m.appenddone_paths, s...)
+}
+
+// AppendedDonePaths returns the list of values that were appended to the "done_paths" field in this mutation.
+func (m *ActionJobMutation) AppendedDonePaths() ([]string, bool) {
+	if len(m.appenddone_paths) == 0 {
+		return nil, false
 	}
-	return
+	return m.appenddone_paths, true
 }
 
-// ResetKeeperMachine resets all changes to the "keeper_machine" edge.
-func (m *DuplicateGroupMutation) ResetKeeperMachine() {
-	m.keeper_machine = nil
-	m.clearedkeeper_machine = false
+// ClearDonePaths clears the value of the "done_paths" field.
+func (m *ActionJobMutation) ClearDonePaths() {
+	m.done_paths = nil
+	m.appenddone_paths = nil
+	m.clearedFields[actionjob.FieldDonePaths] = struct{}{}
 }
 
-// AddFileInstanceIDs adds the "file_instances" edge to the FileInstance entity by ids.
-func (m *DuplicateGroupMutation) AddFileInstanceIDs(ids ...uuid.UUID) {
-	if m.file_instances == nil {
-		m.file_instances = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.file_instances[ids[i]] = struct{}{}
-	}
+// DonePathsCleared returns if the "done_paths" field was cleared in this mutation.
+func (m *ActionJobMutation) DonePathsCleared() bool {
+	_, ok := m.clearedFields[actionjob.FieldDonePaths]
+	return ok
 }
 
-// ClearFileInstances clears the "file_instances" edge to the FileInstance entity.
-func (m *DuplicateGroupMutation) ClearFileInstances() {
-	m.clearedfile_instances = true
+// ResetDonePaths resets all changes to the "done_paths" field.
+func (m *ActionJobMutation) ResetDonePaths() {
+	m.done_paths = nil
+	m.appenddone_paths = nil
+	delete(m.clearedFields, actionjob.FieldDonePaths)
 }
 
-// FileInstancesCleared reports if the "file_instances" edge to the FileInstance entity was cleared.
-func (m *DuplicateGroupMutation) FileInstancesCleared() bool {
-	return m.clearedfile_instances
+// SetClaimedAt sets the "claimed_at" field.
+func (m *ActionJobMutation) SetClaimedAt(t time.Time) {
+	m.claimed_at = &t
 }
 
-// RemoveFileInstanceIDs removes the "file_instances" edge to the FileInstance entity by IDs.
-func (m *DuplicateGroupMutation) RemoveFileInstanceIDs(ids ...uuid.UUID) {
-	if m.removedfile_instances == nil {
-		m.removedfile_instances = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.file_instances, ids[i])
-		m.removedfile_instances[ids[i]] = struct{}{}
+// ClaimedAt returns the value of the "claimed_at" field in the mutation.
+func (m *ActionJobMutation) ClaimedAt() (r time.Time, exists bool) {
+	v := m.claimed_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedFileInstances returns the removed IDs of the "file_instances" edge to the FileInstance entity.
-func (m *DuplicateGroupMutation) RemovedFileInstancesIDs() (ids []uuid.UUID) {
-	for id := range m.removedfile_instances {
-		ids = append(ids, id)
+// OldClaimedAt returns the old "claimed_at" field's value of the ActionJob entity.
+// If the ActionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActionJobMutation) OldClaimedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClaimedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClaimedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClaimedAt: %w", err)
+	}
+	return oldValue.ClaimedAt, nil
 }
 
-// FileInstancesIDs returns the "file_instances" edge IDs in the mutation.
-func (m *DuplicateGroupMutation) FileInstancesIDs() (ids []uuid.UUID) {
-	for id := range m.file_instances {
-		ids = append(ids, id)
-	}
-	return
+// ClearClaimedAt clears the value of the "claimed_at" field.
+func (m *ActionJobMutation) ClearClaimedAt() {
+	m.claimed_at = nil
+	m.clearedFields[actionjob.FieldClaimedAt] = struct{}{}
 }
 
-// ResetFileInstances resets all changes to the "file_instances" edge.
-func (m *DuplicateGroupMutation) ResetFileInstances() {
-	m.file_instances = nil
-	m.clearedfile_instances = false
-	m.removedfile_instances = nil
+// ClaimedAtCleared returns if the "claimed_at" field was cleared in this mutation.
+func (m *ActionJobMutation) ClaimedAtCleared() bool {
+	_, ok := m.clearedFields[actionjob.FieldClaimedAt]
+	return ok
 }
 
-// AddActionAuditIDs adds the "action_audits" edge to the ActionAudit entity by ids.
-func (m *DuplicateGroupMutation) AddActionAuditIDs(ids ...uuid.UUID) {
-	if m.action_audits == nil {
-		m.action_audits = make(map[uuid.UUID]struct{})
+// ResetClaimedAt resets all changes to the "claimed_at" field.
+func (m *ActionJobMutation) ResetClaimedAt() {
+	m.claimed_at = nil
+	delete(m.clearedFields, actionjob.FieldClaimedAt)
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (m *ActionJobMutation) SetFinishedAt(t time.Time) {
+	m.finished_at = &t
+}
+
+// FinishedAt returns the value of the "finished_at" field in the mutation.
+func (m *ActionJobMutation) FinishedAt() (r time.Time, exists bool) {
+	v := m.finished_at
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.action_audits[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldFinishedAt returns the old "finished_at" field's value of the ActionJob entity.
+// If the ActionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActionJobMutation) OldFinishedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFinishedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFinishedAt requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
+	}
+	return oldValue.FinishedAt, nil
 }
 
-// ClearActionAudits clears the "action_audits" edge to the ActionAudit entity.
-func (m *DuplicateGroupMutation) ClearActionAudits() {
-	m.clearedaction_audits = true
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (m *ActionJobMutation) ClearFinishedAt() {
+	m.finished_at = nil
+	m.clearedFields[actionjob.FieldFinishedAt] = struct{}{}
 }
 
-// ActionAuditsCleared reports if the "action_audits" edge to the ActionAudit entity was cleared.
-func (m *DuplicateGroupMutation) ActionAuditsCleared() bool {
-	return m.clearedaction_audits
+// FinishedAtCleared returns if the "finished_at" field was cleared in this mutation.
+func (m *ActionJobMutation) FinishedAtCleared() bool {
+	_, ok := m.clearedFields[actionjob.FieldFinishedAt]
+	return ok
 }
 
-// RemoveActionAuditIDs removes the "action_audits" edge to the ActionAudit entity by IDs.
-func (m *DuplicateGroupMutation) RemoveActionAuditIDs(ids ...uuid.UUID) {
-	if m.removedaction_audits == nil {
-		m.removedaction_audits = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.action_audits, ids[i])
-		m.removedaction_audits[ids[i]] = struct{}{}
+// ResetFinishedAt resets all changes to the "finished_at" field.
+func (m *ActionJobMutation) ResetFinishedAt() {
+	m.finished_at = nil
+	delete(m.clearedFields, actionjob.FieldFinishedAt)
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (m *ActionJobMutation) ClearTenant() {
+	m.clearedtenant = true
+	m.clearedFields[actionjob.FieldTenantID] = struct{}{}
+}
+
+// TenantCleared reports if the "tenant" edge to the Tenant entity was cleared.
+func (m *ActionJobMutation) TenantCleared() bool {
+	return m.clearedtenant
+}
+
+// TenantIDs returns the "tenant" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TenantID instead. It exists only for internal usage by the builders.
+func (m *ActionJobMutation) TenantIDs() (ids []uuid.UUID) {
+	if id := m.tenant; id != nil {
+		ids = append(ids, *id)
 	}
+	return
 }
 
-// RemovedActionAudits returns the removed IDs of the "action_audits" edge to the ActionAudit entity.
-func (m *DuplicateGroupMutation) RemovedActionAuditsIDs() (ids []uuid.UUID) {
-	for id := range m.removedaction_audits {
-		ids = append(ids, id)
+// ResetTenant resets all changes to the "tenant" edge.
+func (m *ActionJobMutation) ResetTenant() {
+	m.tenant = nil
+	m.clearedtenant = false
+}
+
+// ClearDuplicateGroup clears the "duplicate_group" edge to the DuplicateGroup entity.
+func (m *ActionJobMutation) ClearDuplicateGroup() {
+	m.clearedduplicate_group = true
+	m.clearedFields[actionjob.FieldDuplicateGroupID] = struct{}{}
+}
+
+// DuplicateGroupCleared reports if the "duplicate_group" edge to the DuplicateGroup entity was cleared.
+func (m *ActionJobMutation) DuplicateGroupCleared() bool {
+	return m.clearedduplicate_group
+}
+
+// DuplicateGroupIDs returns the "duplicate_group" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DuplicateGroupID instead. It exists only for internal usage by the builders.
+func (m *ActionJobMutation) DuplicateGroupIDs() (ids []uuid.UUID) {
+	if id := m.duplicate_group; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ActionAuditsIDs returns the "action_audits" edge IDs in the mutation.
-func (m *DuplicateGroupMutation) ActionAuditsIDs() (ids []uuid.UUID) {
-	for id := range m.action_audits {
-		ids = append(ids, id)
+// ResetDuplicateGroup resets all changes to the "duplicate_group" edge.
+func (m *ActionJobMutation) ResetDuplicateGroup() {
+	m.duplicate_group = nil
+	m.clearedduplicate_group = false
+}
+
+// ClearMachine clears the "machine" edge to the Machine entity.
+func (m *ActionJobMutation) ClearMachine() {
+	m.clearedmachine = true
+	m.clearedFields[actionjob.FieldMachineID] = struct{}{}
+}
+
+// MachineCleared reports if the "machine" edge to the Machine entity was cleared.
+func (m *ActionJobMutation) MachineCleared() bool {
+	return m.clearedmachine
+}
+
+// MachineIDs returns the "machine" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MachineID instead. It exists only for internal usage by the builders.
+func (m *ActionJobMutation) MachineIDs() (ids []uuid.UUID) {
+	if id := m.machine; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetActionAudits resets all changes to the "action_audits" edge.
-func (m *DuplicateGroupMutation) ResetActionAudits() {
-	m.action_audits = nil
-	m.clearedaction_audits = false
-	m.removedaction_audits = nil
+// ResetMachine resets all changes to the "machine" edge.
+func (m *ActionJobMutation) ResetMachine() {
+	m.machine = nil
+	m.clearedmachine = false
 }
 
-// Where appends a list predicates to the DuplicateGroupMutation builder.
-func (m *DuplicateGroupMutation) Where(ps ...predicate.DuplicateGroup) {
+// Where appends a list predicates to the ActionJobMutation builder.
+func (m *ActionJobMutation) Where(ps ...predicate.ActionJob) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the DuplicateGroupMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ActionJobMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DuplicateGroupMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.DuplicateGroup, len(ps))
+func (m *ActionJobMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ActionJob, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1642,51 +1846,72 @@ func (m *DuplicateGroupMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *DuplicateGroupMutation) Op() Op {
+func (m *ActionJobMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *DuplicateGroupMutation) SetOp(op Op) {
+func (m *ActionJobMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (DuplicateGroup).
-func (m *DuplicateGroupMutation) Type() string {
+// Type returns the node type of this mutation (ActionJob).
+func (m *ActionJobMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DuplicateGroupMutation) Fields() []string {
-	fields := make([]string, 0, 9)
+func (m *ActionJobMutation) Fields() []string {
+	fields := make([]string, 0, 16)
 	if m.create_time != nil {
-		fields = append(fields, duplicategroup.FieldCreateTime)
+		fields = append(fields, actionjob.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, duplicategroup.FieldUpdateTime)
+		fields = append(fields, actionjob.FieldUpdateTime)
 	}
 	if m.tenant != nil {
-		fields = append(fields, duplicategroup.FieldTenantID)
+		fields = append(fields, actionjob.FieldTenantID)
 	}
-	if m.scan != nil {
-		fields = append(fields, duplicategroup.FieldScanID)
+	if m.action_id != nil {
+		fields = append(fields, actionjob.FieldActionID)
 	}
-	if m.keeper_machine != nil {
-		fields = append(fields, duplicategroup.FieldKeeperMachineID)
+	if m.duplicate_group != nil {
+		fields = append(fields, actionjob.FieldDuplicateGroupID)
+	}
+	if m.machine != nil {
+		fields = append(fields, actionjob.FieldMachineID)
+	}
+	if m.action != nil {
+		fields = append(fields, actionjob.FieldAction)
 	}
 	if m.hash != nil {
-		fields = append(fields, duplicategroup.FieldHash)
+		fields = append(fields, actionjob.FieldHash)
+	}
+	if m.keep_path != nil {
+		fields = append(fields, actionjob.FieldKeepPath)
+	}
+	if m.paths != nil {
+		fields = append(fields, actionjob.FieldPaths)
+	}
+	if m.actor != nil {
+		fields = append(fields, actionjob.FieldActor)
 	}
 	if m.status != nil {
-		fields = append(fields, duplicategroup.FieldStatus)
+		fields = append(fields, actionjob.FieldStatus)
 	}
-	if m.file_count != nil {
-		fields = append(fields, duplicategroup.FieldFileCount)
+	if m.status_message != nil {
+		fields = append(fields, actionjob.FieldStatusMessage)
 	}
-	if m.total_size_bytes != nil {
-		fields = append(fields, duplicategroup.FieldTotalSizeBytes)
+	if m.done_paths != nil {
+		fields = append(fields, actionjob.FieldDonePaths)
+	}
+	if m.claimed_at != nil {
+		fields = append(fields, actionjob.FieldClaimedAt)
+	}
+	if m.finished_at != nil {
+		fields = append(fields, actionjob.FieldFinishedAt)
 	}
 	return fields
 }
@@ -1694,26 +1919,40 @@ func (m *DuplicateGroupMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DuplicateGroupMutation) Field(name string) (ent.Value, bool) {
+func (m *ActionJobMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case duplicategroup.FieldCreateTime:
+	case actionjob.FieldCreateTime:
 		return m.CreateTime()
-	case duplicategroup.FieldUpdateTime:
+	case actionjob.FieldUpdateTime:
 		return m.UpdateTime()
-	case duplicategroup.FieldTenantID:
+	case actionjob.FieldTenantID:
 		return m.TenantID()
-	case duplicategroup.FieldScanID:
-		return m.ScanID()
-	case duplicategroup.FieldKeeperMachineID:
-		return m.KeeperMachineID()
-	case duplicategroup.FieldHash:
+	case actionjob.FieldActionID:
+		return m.ActionID()
+	case actionjob.FieldDuplicateGroupID:
+		return m.DuplicateGroupID()
+	case actionjob.FieldMachineID:
+		return m.MachineID()
+	case actionjob.FieldAction:
+		return m.Action()
+	case actionjob.FieldHash:
 		return m.Hash()
-	case duplicategroup.FieldStatus:
+	case actionjob.FieldKeepPath:
+		return m.KeepPath()
+	case actionjob.FieldPaths:
+		return m.Paths()
+	case actionjob.FieldActor:
+		return m.Actor()
+	case actionjob.FieldStatus:
 		return m.Status()
-	case duplicategroup.FieldFileCount:
-		return m.FileCount()
-	case duplicategroup.FieldTotalSizeBytes:
-		return m.TotalSizeBytes()
+	case actionjob.FieldStatusMessage:
+		return m.StatusMessage()
+	case actionjob.FieldDonePaths:
+		return m.DonePaths()
+	case actionjob.FieldClaimedAt:
+		return m.ClaimedAt()
+	case actionjob.FieldFinishedAt:
+		return m.FinishedAt()
 	}
 	return nil, false
 }
@@ -1721,413 +1960,441 @@ func (m *DuplicateGroupMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DuplicateGroupMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ActionJobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case duplicategroup.FieldCreateTime:
+	case actionjob.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case duplicategroup.FieldUpdateTime:
+	case actionjob.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case duplicategroup.FieldTenantID:
+	case actionjob.FieldTenantID:
 		return m.OldTenantID(ctx)
-	case duplicategroup.FieldScanID:
-		return m.OldScanID(ctx)
-	case duplicategroup.FieldKeeperMachineID:
-		return m.OldKeeperMachineID(ctx)
-	case duplicategroup.FieldHash:
+	case actionjob.FieldActionID:
+		return m.OldActionID(ctx)
+	case actionjob.FieldDuplicateGroupID:
+		return m.OldDuplicateGroupID(ctx)
+	case actionjob.FieldMachineID:
+		return m.OldMachineID(ctx)
+	case actionjob.FieldAction:
+		return m.OldAction(ctx)
+	case actionjob.FieldHash:
 		return m.OldHash(ctx)
-	case duplicategroup.FieldStatus:
+	case actionjob.FieldKeepPath:
+		return m.OldKeepPath(ctx)
+	case actionjob.FieldPaths:
+		return m.OldPaths(ctx)
+	case actionjob.FieldActor:
+		return m.OldActor(ctx)
+	case actionjob.FieldStatus:
 		return m.OldStatus(ctx)
-	case duplicategroup.FieldFileCount:
-		return m.OldFileCount(ctx)
-	case duplicategroup.FieldTotalSizeBytes:
-		return m.OldTotalSizeBytes(ctx)
+	case actionjob.FieldStatusMessage:
+		return m.OldStatusMessage(ctx)
+	case actionjob.FieldDonePaths:
+		return m.OldDonePaths(ctx)
+	case actionjob.FieldClaimedAt:
+		return m.OldClaimedAt(ctx)
+	case actionjob.FieldFinishedAt:
+		return m.OldFinishedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown DuplicateGroup field %s", name)
+	return nil, fmt.Errorf("unknown ActionJob field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DuplicateGroupMutation) SetField(name string, value ent.Value) error {
+func (m *ActionJobMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case duplicategroup.FieldCreateTime:
+	case actionjob.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case duplicategroup.FieldUpdateTime:
+	case actionjob.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case duplicategroup.FieldTenantID:
+	case actionjob.FieldTenantID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetTenantID(v)
 		return nil
-	case duplicategroup.FieldScanID:
+	case actionjob.FieldActionID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetScanID(v)
+		m.SetActionID(v)
 		return nil
-	case duplicategroup.FieldKeeperMachineID:
+	case actionjob.FieldDuplicateGroupID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetKeeperMachineID(v)
+		m.SetDuplicateGroupID(v)
 		return nil
-	case duplicategroup.FieldHash:
+	case actionjob.FieldMachineID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMachineID(v)
+		return nil
+	case actionjob.FieldAction:
+		v, ok := value.(actionjob.Action)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case actionjob.FieldHash:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetHash(v)
 		return nil
-	case duplicategroup.FieldStatus:
-		v, ok := value.(duplicategroup.Status)
+	case actionjob.FieldKeepPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeepPath(v)
+		return nil
+	case actionjob.FieldPaths:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPaths(v)
+		return nil
+	case actionjob.FieldActor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActor(v)
+		return nil
+	case actionjob.FieldStatus:
+		v, ok := value.(actionjob.Status)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetStatus(v)
 		return nil
-	case duplicategroup.FieldFileCount:
-		v, ok := value.(int)
+	case actionjob.FieldStatusMessage:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFileCount(v)
+		m.SetStatusMessage(v)
 		return nil
-	case duplicategroup.FieldTotalSizeBytes:
-		v, ok := value.(int64)
+	case actionjob.FieldDonePaths:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTotalSizeBytes(v)
+		m.SetDonePaths(v)
+		return nil
+	case actionjob.FieldClaimedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClaimedAt(v)
+		return nil
+	case actionjob.FieldFinishedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinishedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown DuplicateGroup field %s", name)
+	return fmt.Errorf("unknown ActionJob field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DuplicateGroupMutation) AddedFields() []string {
-	var fields []string
-	if m.addfile_count != nil {
-		fields = append(fields, duplicategroup.FieldFileCount)
-	}
-	if m.addtotal_size_bytes != nil {
-		fields = append(fields, duplicategroup.FieldTotalSizeBytes)
-	}
-	return fields
+func (m *ActionJobMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DuplicateGroupMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case duplicategroup.FieldFileCount:
-		return m.AddedFileCount()
-	case duplicategroup.FieldTotalSizeBytes:
-		return m.AddedTotalSizeBytes()
-	}
+func (m *ActionJobMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DuplicateGroupMutation) AddField(name string, value ent.Value) error {
+func (m *ActionJobMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case duplicategroup.FieldFileCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddFileCount(v)
-		return nil
-	case duplicategroup.FieldTotalSizeBytes:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddTotalSizeBytes(v)
-		return nil
 	}
-	return fmt.Errorf("unknown DuplicateGroup numeric field %s", name)
+	return fmt.Errorf("unknown ActionJob numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DuplicateGroupMutation) ClearedFields() []string {
+func (m *ActionJobMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(duplicategroup.FieldKeeperMachineID) {
-		fields = append(fields, duplicategroup.FieldKeeperMachineID)
+	if m.FieldCleared(actionjob.FieldKeepPath) {
+		fields = append(fields, actionjob.FieldKeepPath)
+	}
+	if m.FieldCleared(actionjob.FieldStatusMessage) {
+		fields = append(fields, actionjob.FieldStatusMessage)
+	}
+	if m.FieldCleared(actionjob.FieldDonePaths) {
+		fields = append(fields, actionjob.FieldDonePaths)
+	}
+	if m.FieldCleared(actionjob.FieldClaimedAt) {
+		fields = append(fields, actionjob.FieldClaimedAt)
+	}
+	if m.FieldCleared(actionjob.FieldFinishedAt) {
+		fields = append(fields, actionjob.FieldFinishedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DuplicateGroupMutation) FieldCleared(name string) bool {
+func (m *ActionJobMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DuplicateGroupMutation) ClearField(name string) error {
+func (m *ActionJobMutation) ClearField(name string) error {
 	switch name {
-	case duplicategroup.FieldKeeperMachineID:
-		m.ClearKeeperMachineID()
+	case actionjob.FieldKeepPath:
+		m.ClearKeepPath()
+		return nil
+	case actionjob.FieldStatusMessage:
+		m.ClearStatusMessage()
+		return nil
+	case actionjob.FieldDonePaths:
+		m.ClearDonePaths()
+		return nil
+	case actionjob.FieldClaimedAt:
+		m.ClearClaimedAt()
+		return nil
+	case actionjob.FieldFinishedAt:
+		m.ClearFinishedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown DuplicateGroup nullable field %s", name)
+	return fmt.Errorf("unknown ActionJob nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DuplicateGroupMutation) ResetField(name string) error {
+func (m *ActionJobMutation) ResetField(name string) error {
 	switch name {
-	case duplicategroup.FieldCreateTime:
+	case actionjob.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case duplicategroup.FieldUpdateTime:
+	case actionjob.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case duplicategroup.FieldTenantID:
+	case actionjob.FieldTenantID:
 		m.ResetTenantID()
 		return nil
-	case duplicategroup.FieldScanID:
-		m.ResetScanID()
+	case actionjob.FieldActionID:
+		m.ResetActionID()
 		return nil
-	case duplicategroup.FieldKeeperMachineID:
-		m.ResetKeeperMachineID()
+	case actionjob.FieldDuplicateGroupID:
+		m.ResetDuplicateGroupID()
 		return nil
-	case duplicategroup.FieldHash:
+	case actionjob.FieldMachineID:
+		m.ResetMachineID()
+		return nil
+	case actionjob.FieldAction:
+		m.ResetAction()
+		return nil
+	case actionjob.FieldHash:
 		m.ResetHash()
 		return nil
-	case duplicategroup.FieldStatus:
+	case actionjob.FieldKeepPath:
+		m.ResetKeepPath()
+		return nil
+	case actionjob.FieldPaths:
+		m.ResetPaths()
+		return nil
+	case actionjob.FieldActor:
+		m.ResetActor()
+		return nil
+	case actionjob.FieldStatus:
 		m.ResetStatus()
 		return nil
-	case duplicategroup.FieldFileCount:
-		m.ResetFileCount()
+	case actionjob.FieldStatusMessage:
+		m.ResetStatusMessage()
 		return nil
-	case duplicategroup.FieldTotalSizeBytes:
-		m.ResetTotalSizeBytes()
+	case actionjob.FieldDonePaths:
+		m.ResetDonePaths()
+		return nil
+	case actionjob.FieldClaimedAt:
+		m.ResetClaimedAt()
+		return nil
+	case actionjob.FieldFinishedAt:
+		m.ResetFinishedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown DuplicateGroup field %s", name)
+	return fmt.Errorf("unknown ActionJob field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DuplicateGroupMutation) AddedEdges() []string {
-	edges := make([]string, 0, 5)
+func (m *ActionJobMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
 	if m.tenant != nil {
-		edges = append(edges, duplicategroup.EdgeTenant)
-	}
-	if m.scan != nil {
-		edges = append(edges, duplicategroup.EdgeScan)
-	}
-	if m.keeper_machine != nil {
-		edges = append(edges, duplicategroup.EdgeKeeperMachine)
+		edges = append(edges, actionjob.EdgeTenant)
 	}
-	if m.file_instances != nil {
-		edges = append(edges, duplicategroup.EdgeFileInstances)
+	if m.duplicate_group != nil {
+		edges = append(edges, actionjob.EdgeDuplicateGroup)
 	}
-	if m.action_audits != nil {
-		edges = append(edges, duplicategroup.EdgeActionAudits)
+	if m.machine != nil {
+		edges = append(edges, actionjob.EdgeMachine)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DuplicateGroupMutation) AddedIDs(name string) []ent.Value {
+func (m *ActionJobMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case duplicategroup.EdgeTenant:
+	case actionjob.EdgeTenant:
 		if id := m.tenant; id != nil {
 			return []ent.Value{*id}
 		}
-	case duplicategroup.EdgeScan:
-		if id := m.scan; id != nil {
+	case actionjob.EdgeDuplicateGroup:
+		if id := m.duplicate_group; id != nil {
 			return []ent.Value{*id}
 		}
-	case duplicategroup.EdgeKeeperMachine:
-		if id := m.keeper_machine; id != nil {
+	case actionjob.EdgeMachine:
+		if id := m.machine; id != nil {
 			return []ent.Value{*id}
 		}
-	case duplicategroup.EdgeFileInstances:
-		ids := make([]ent.Value, 0, len(m.file_instances))
-		for id := range m.file_instances {
-			ids = append(ids, id)
-		}
-		return ids
-	case duplicategroup.EdgeActionAudits:
-		ids := make([]ent.Value, 0, len(m.action_audits))
-		for id := range m.action_audits {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DuplicateGroupMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.removedfile_instances != nil {
-		edges = append(edges, duplicategroup.EdgeFileInstances)
-	}
-	if m.removedaction_audits != nil {
-		edges = append(edges, duplicategroup.EdgeActionAudits)
-	}
+func (m *ActionJobMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DuplicateGroupMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case duplicategroup.EdgeFileInstances:
-		ids := make([]ent.Value, 0, len(m.removedfile_instances))
-		for id := range m.removedfile_instances {
-			ids = append(ids, id)
-		}
-		return ids
-	case duplicategroup.EdgeActionAudits:
-		ids := make([]ent.Value, 0, len(m.removedaction_audits))
-		for id := range m.removedaction_audits {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *ActionJobMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DuplicateGroupMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 5)
+func (m *ActionJobMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
 	if m.clearedtenant {
-		edges = append(edges, duplicategroup.EdgeTenant)
+		edges = append(edges, actionjob.EdgeTenant)
 	}
-	if m.clearedscan {
-		edges = append(edges, duplicategroup.EdgeScan)
-	}
-	if m.clearedkeeper_machine {
-		edges = append(edges, duplicategroup.EdgeKeeperMachine)
-	}
-	if m.clearedfile_instances {
-		edges = append(edges, duplicategroup.EdgeFileInstances)
+	if m.clearedduplicate_group {
+		edges = append(edges, actionjob.EdgeDuplicateGroup)
 	}
-	if m.clearedaction_audits {
-		edges = append(edges, duplicategroup.EdgeActionAudits)
+	if m.clearedmachine {
+		edges = append(edges, actionjob.EdgeMachine)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DuplicateGroupMutation) EdgeCleared(name string) bool {
+func (m *ActionJobMutation) EdgeCleared(name string) bool {
 	switch name {
-	case duplicategroup.EdgeTenant:
+	case actionjob.EdgeTenant:
 		return m.clearedtenant
-	case duplicategroup.EdgeScan:
-		return m.clearedscan
-	case duplicategroup.EdgeKeeperMachine:
-		return m.clearedkeeper_machine
-	case duplicategroup.EdgeFileInstances:
-		return m.clearedfile_instances
-	case duplicategroup.EdgeActionAudits:
-		return m.clearedaction_audits
+	case actionjob.EdgeDuplicateGroup:
+		return m.clearedduplicate_group
+	case actionjob.EdgeMachine:
+		return m.clearedmachine
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DuplicateGroupMutation) ClearEdge(name string) error {
+func (m *ActionJobMutation) ClearEdge(name string) error {
 	switch name {
-	case duplicategroup.EdgeTenant:
+	case actionjob.EdgeTenant:
 		m.ClearTenant()
 		return nil
-	case duplicategroup.EdgeScan:
-		m.ClearScan()
+	case actionjob.EdgeDuplicateGroup:
+		m.ClearDuplicateGroup()
 		return nil
-	case duplicategroup.EdgeKeeperMachine:
-		m.ClearKeeperMachine()
+	case actionjob.EdgeMachine:
+		m.ClearMachine()
 		return nil
 	}
-	return fmt.Errorf("unknown DuplicateGroup unique edge %s", name)
+	return fmt.Errorf("unknown ActionJob unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DuplicateGroupMutation) ResetEdge(name string) error {
+func (m *ActionJobMutation) ResetEdge(name string) error {
 	switch name {
-	case duplicategroup.EdgeTenant:
+	case actionjob.EdgeTenant:
 		m.ResetTenant()
 		return nil
-	case duplicategroup.EdgeScan:
-		m.ResetScan()
-		return nil
-	case duplicategroup.EdgeKeeperMachine:
-		m.ResetKeeperMachine()
-		return nil
-	case duplicategroup.EdgeFileInstances:
-		m.ResetFileInstances()
+	case actionjob.EdgeDuplicateGroup:
+		m.ResetDuplicateGroup()
 		return nil
-	case duplicategroup.EdgeActionAudits:
-		m.ResetActionAudits()
+	case actionjob.EdgeMachine:
+		m.ResetMachine()
 		return nil
 	}
-	return fmt.Errorf("unknown DuplicateGroup edge %s", name)
+	return fmt.Errorf("unknown ActionJob edge %s", name)
 }
 
-// FileInstanceMutation represents an operation that mutates the FileInstance nodes in the graph.
-type FileInstanceMutation struct {
+// ContentEventMutation represents an operation that mutates the ContentEvent nodes in the graph.
+type ContentEventMutation struct {
 	config
-	op                     Op
-	typ                    string
-	id                     *uuid.UUID
-	create_time            *time.Time
-	update_time            *time.Time
-	_path                  *string
-	size_bytes             *int64
-	addsize_bytes          *int64
-	checksum               *string
-	last_seen_at           *time.Time
-	quarantined            *bool
-	clearedFields          map[string]struct{}
-	duplicate_group        *uuid.UUID
-	clearedduplicate_group bool
-	machine                *uuid.UUID
-	clearedmachine         bool
-	done                   bool
-	oldValue               func(context.Context) (*FileInstance, error)
-	predicates             []predicate.FileInstance
+	op                      Op
+	typ                     string
+	id                      *uuid.UUID
+	create_time             *time.Time
+	update_time             *time.Time
+	_path                   *string
+	kind                    *contentevent.Kind
+	occurred_at             *time.Time
+	clearedFields           map[string]struct{}
+	tenant                  *uuid.UUID
+	clearedtenant           bool
+	content_identity        *uuid.UUID
+	clearedcontent_identity bool
+	scan                    *uuid.UUID
+	clearedscan             bool
+	machine                 *uuid.UUID
+	clearedmachine          bool
+	done                    bool
+	oldValue                func(context.Context) (*ContentEvent, error)
+	predicates              []predicate.ContentEvent
 }
 
-var _ ent.Mutation = (*FileInstanceMutation)(nil)
+var _ ent.Mutation = (*ContentEventMutation)(nil)
 
-// fileinstanceOption allows management of the mutation configuration using functional options.
-type fileinstanceOption func(*FileInstanceMutation)
+// contenteventOption allows management of the mutation configuration using functional options.
+type contenteventOption func(*ContentEventMutation)
 
-// newFileInstanceMutation creates new mutation for the FileInstance entity.
-func newFileInstanceMutation(c config, op Op, opts ...fileinstanceOption) *FileInstanceMutation {
-	m := &FileInstanceMutation{
+// newContentEventMutation creates new mutation for the ContentEvent entity.
+func newContentEventMutation(c config, op Op, opts ...contenteventOption) *ContentEventMutation {
+	m := &ContentEventMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeFileInstance,
+		typ:           TypeContentEvent,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2136,20 +2403,20 @@ func newFileInstanceMutation(c config, op Op, opts ...fileinstanceOption) *FileI
 	return m
 }
 
-// withFileInstanceID sets the ID field of the mutation.
-func withFileInstanceID(id uuid.UUID) fileinstanceOption {
-	return func(m *FileInstanceMutation) {
+// withContentEventID sets the ID field of the mutation.
+func withContentEventID(id uuid.UUID) contenteventOption {
+	return func(m *ContentEventMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *FileInstance
+			value *ContentEvent
 		)
-		m.oldValue = func(ctx context.Context) (*FileInstance, error) {
+		m.oldValue = func(ctx context.Context) (*ContentEvent, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().FileInstance.Get(ctx, id)
+					value, err = m.Client().ContentEvent.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2158,10 +2425,10 @@ func withFileInstanceID(id uuid.UUID) fileinstanceOption {
 	}
 }
 
-// withFileInstance sets the old FileInstance of the mutation.
-func withFileInstance(node *FileInstance) fileinstanceOption {
-	return func(m *FileInstanceMutation) {
-		m.oldValue = func(context.Context) (*FileInstance, error) {
+// withContentEvent sets the old ContentEvent of the mutation.
+func withContentEvent(node *ContentEvent) contenteventOption {
+	return func(m *ContentEventMutation) {
+		m.oldValue = func(context.Context) (*ContentEvent, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2170,7 +2437,7 @@ func withFileInstance(node *FileInstance) fileinstanceOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m FileInstanceMutation) Client() *Client {
+func (m ContentEventMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2178,7 +2445,7 @@ func (m FileInstanceMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m FileInstanceMutation) Tx() (*Tx, error) {
+func (m ContentEventMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2188,14 +2455,14 @@ func (m FileInstanceMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of FileInstance entities.
-func (m *FileInstanceMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of ContentEvent entities.
+func (m *ContentEventMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *FileInstanceMutation) ID() (id uuid.UUID, exists bool) {
+func (m *ContentEventMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2206,7 +2473,7 @@ func (m *FileInstanceMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *FileInstanceMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *ContentEventMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2215,19 +2482,19 @@ func (m *FileInstanceMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().FileInstance.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ContentEvent.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *FileInstanceMutation) SetCreateTime(t time.Time) {
+func (m *ContentEventMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *FileInstanceMutation) CreateTime() (r time.Time, exists bool) {
+func (m *ContentEventMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -2235,10 +2502,10 @@ func (m *FileInstanceMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the FileInstance entity.
-// If the FileInstance object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the ContentEvent entity.
+// If the ContentEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileInstanceMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *ContentEventMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -2253,17 +2520,17 @@ func (m *FileInstanceMutation) OldCreateTime(ctx context.Context) (v time.Time,
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *FileInstanceMutation) ResetCreateTime() {
+func (m *ContentEventMutation) ResetCreateTime() {
 	m.create_time = nil
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *FileInstanceMutation) SetUpdateTime(t time.Time) {
+func (m *ContentEventMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *FileInstanceMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *ContentEventMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -2271,10 +2538,10 @@ func (m *FileInstanceMutation) UpdateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the FileInstance entity.
-// If the FileInstance object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the ContentEvent entity.
+// If the ContentEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileInstanceMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *ContentEventMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -2289,53 +2556,125 @@ func (m *FileInstanceMutation) OldUpdateTime(ctx context.Context) (v time.Time,
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *FileInstanceMutation) ResetUpdateTime() {
+func (m *ContentEventMutation) ResetUpdateTime() {
 	m.update_time = nil
 }
 
-// SetDuplicateGroupID sets the "duplicate_group_id" field.
-func (m *FileInstanceMutation) SetDuplicateGroupID(u uuid.UUID) {
-	m.duplicate_group = &u
+// SetTenantID sets the "tenant_id" field.
+func (m *ContentEventMutation) SetTenantID(u uuid.UUID) {
+	m.tenant = &u
 }
 
-// DuplicateGroupID returns the value of the "duplicate_group_id" field in the mutation.
-func (m *FileInstanceMutation) DuplicateGroupID() (r uuid.UUID, exists bool) {
-	v := m.duplicate_group
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *ContentEventMutation) TenantID() (r uuid.UUID, exists bool) {
+	v := m.tenant
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDuplicateGroupID returns the old "duplicate_group_id" field's value of the FileInstance entity.
-// If the FileInstance object wasn't provided to the builder, the object is fetched from the database.
+// OldTenantID returns the old "tenant_id" field's value of the ContentEvent entity.
+// If the ContentEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileInstanceMutation) OldDuplicateGroupID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *ContentEventMutation) OldTenantID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDuplicateGroupID is only allowed on UpdateOne operations")
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDuplicateGroupID requires an ID field in the mutation")
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDuplicateGroupID: %w", err)
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
 	}
-	return oldValue.DuplicateGroupID, nil
+	return oldValue.TenantID, nil
 }
 
-// ResetDuplicateGroupID resets all changes to the "duplicate_group_id" field.
-func (m *FileInstanceMutation) ResetDuplicateGroupID() {
-	m.duplicate_group = nil
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *ContentEventMutation) ResetTenantID() {
+	m.tenant = nil
+}
+
+// SetContentIdentityID sets the "content_identity_id" field.
+func (m *ContentEventMutation) SetContentIdentityID(u uuid.UUID) {
+	m.content_identity = &u
+}
+
+// ContentIdentityID returns the value of the "content_identity_id" field in the mutation.
+func (m *ContentEventMutation) ContentIdentityID() (r uuid.UUID, exists bool) {
+	v := m.content_identity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContentIdentityID returns the old "content_identity_id" field's value of the ContentEvent entity.
+// If the ContentEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ContentEventMutation) OldContentIdentityID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContentIdentityID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContentIdentityID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContentIdentityID: %w", err)
+	}
+	return oldValue.ContentIdentityID, nil
+}
+
+// ResetContentIdentityID resets all changes to the "content_identity_id" field.
+func (m *ContentEventMutation) ResetContentIdentityID() {
+	m.content_identity = nil
+}
+
+// SetScanID sets the "scan_id" field.
+func (m *ContentEventMutation) SetScanID(u uuid.UUID) {
+	m.scan = &u
+}
+
+// ScanID returns the value of the "scan_id" field in the mutation.
+func (m *ContentEventMutation) ScanID() (r uuid.UUID, exists bool) {
+	v := m.scan
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScanID returns the old "scan_id" field's value of the ContentEvent entity.
+// If the ContentEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ContentEventMutation) OldScanID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScanID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScanID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScanID: %w", err)
+	}
+	return oldValue.ScanID, nil
+}
+
+// ResetScanID resets all changes to the "scan_id" field.
+func (m *ContentEventMutation) ResetScanID() {
+	m.scan = nil
 }
 
 // SetMachineID sets the "machine_id" field.
-func (m *FileInstanceMutation) SetMachineID(u uuid.UUID) {
+func (m *ContentEventMutation) SetMachineID(u uuid.UUID) {
 	m.machine = &u
 }
 
 // MachineID returns the value of the "machine_id" field in the mutation.
-func (m *FileInstanceMutation) MachineID() (r uuid.UUID, exists bool) {
+func (m *ContentEventMutation) MachineID() (r uuid.UUID, exists bool) {
 	v := m.machine
 	if v == nil {
 		return
@@ -2343,10 +2682,10 @@ func (m *FileInstanceMutation) MachineID() (r uuid.UUID, exists bool) {
 	return *v, true
 }
 
-// OldMachineID returns the old "machine_id" field's value of the FileInstance entity.
-// If the FileInstance object wasn't provided to the builder, the object is fetched from the database.
+// OldMachineID returns the old "machine_id" field's value of the ContentEvent entity.
+// If the ContentEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileInstanceMutation) OldMachineID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *ContentEventMutation) OldMachineID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldMachineID is only allowed on UpdateOne operations")
 	}
@@ -2361,17 +2700,17 @@ func (m *FileInstanceMutation) OldMachineID(ctx context.Context) (v uuid.UUID, e
 }
 
 // ResetMachineID resets all changes to the "machine_id" field.
-func (m *FileInstanceMutation) ResetMachineID() {
+func (m *ContentEventMutation) ResetMachineID() {
 	m.machine = nil
 }
 
 // SetPath sets the "path" field.
-func (m *FileInstanceMutation) SetPath(s string) {
+func (m *ContentEventMutation) SetPath(s string) {
 	m._path = &s
 }
 
 // Path returns the value of the "path" field in the mutation.
-func (m *FileInstanceMutation) Path() (r string, exists bool) {
+func (m *ContentEventMutation) Path() (r string, exists bool) {
 	v := m._path
 	if v == nil {
 		return
@@ -2379,10 +2718,10 @@ func (m *FileInstanceMutation) Path() (r string, exists bool) {
 	return *v, true
 }
 
-// OldPath returns the old "path" field's value of the FileInstance entity.
-// If the FileInstance object wasn't provided to the builder, the object is fetched from the database.
+// OldPath returns the old "path" field's value of the ContentEvent entity.
+// If the ContentEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileInstanceMutation) OldPath(ctx context.Context) (v string, err error) {
+func (m *ContentEventMutation) OldPath(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldPath is only allowed on UpdateOne operations")
 	}
@@ -2397,216 +2736,178 @@ func (m *FileInstanceMutation) OldPath(ctx context.Context) (v string, err error
 }
 
 // ResetPath resets all changes to the "path" field.
-func (m *FileInstanceMutation) ResetPath() {
+func (m *ContentEventMutation) ResetPath() {
 	m._path = nil
 }
 
-// SetSizeBytes sets the "size_bytes" field.
-func (m *FileInstanceMutation) SetSizeBytes(i int64) {
-	m.size_bytes = &i
-	m.addsize_bytes = nil
+// SetKind sets the "kind" field.
+func (m *ContentEventMutation) SetKind(c contentevent.Kind) {
+	m.kind = &c
 }
 
-// SizeBytes returns the value of the "size_bytes" field in the mutation.
-func (m *FileInstanceMutation) SizeBytes() (r int64, exists bool) {
-	v := m.size_bytes
+// Kind returns the value of the "kind" field in the mutation.
+func (m *ContentEventMutation) Kind() (r contentevent.Kind, exists bool) {
+	v := m.kind
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSizeBytes returns the old "size_bytes" field's value of the FileInstance entity.
-// If the FileInstance object wasn't provided to the builder, the object is fetched from the database.
+// OldKind returns the old "kind" field's value of the ContentEvent entity.
+// If the ContentEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileInstanceMutation) OldSizeBytes(ctx context.Context) (v int64, err error) {
+func (m *ContentEventMutation) OldKind(ctx context.Context) (v contentevent.Kind, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSizeBytes is only allowed on UpdateOne operations")
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSizeBytes requires an ID field in the mutation")
+		return v, errors.New("OldKind requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSizeBytes: %w", err)
-	}
-	return oldValue.SizeBytes, nil
-}
-
-// AddSizeBytes adds i to the "size_bytes" field.
-func (m *FileInstanceMutation) AddSizeBytes(i int64) {
-	if m.addsize_bytes != nil {
-		*m.addsize_bytes += i
-	} else {
-		m.addsize_bytes = &i
-	}
-}
-
-// AddedSizeBytes returns the value that was added to the "size_bytes" field in this mutation.
-func (m *FileInstanceMutation) AddedSizeBytes() (r int64, exists bool) {
-	v := m.addsize_bytes
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
 	}
-	return *v, true
+	return oldValue.Kind, nil
 }
 
-// ResetSizeBytes resets all changes to the "size_bytes" field.
-func (m *FileInstanceMutation) ResetSizeBytes() {
-	m.size_bytes = nil
-	m.addsize_bytes = nil
+// ResetKind resets all changes to the "kind" field.
+func (m *ContentEventMutation) ResetKind() {
+	m.kind = nil
 }
 
-// SetChecksum sets the "checksum" field.
-func (m *FileInstanceMutation) SetChecksum(s string) {
-	m.checksum = &s
+// SetOccurredAt sets the "occurred_at" field.
+func (m *ContentEventMutation) SetOccurredAt(t time.Time) {
+	m.occurred_at = &t
 }
 
-// Checksum returns the value of the "checksum" field in the mutation.
-func (m *FileInstanceMutation) Checksum() (r string, exists bool) {
-	v := m.checksum
+// OccurredAt returns the value of the "occurred_at" field in the mutation.
+func (m *ContentEventMutation) OccurredAt() (r time.Time, exists bool) {
+	v := m.occurred_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldChecksum returns the old "checksum" field's value of the FileInstance entity.
-// If the FileInstance object wasn't provided to the builder, the object is fetched from the database.
+// OldOccurredAt returns the old "occurred_at" field's value of the ContentEvent entity.
+// If the ContentEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileInstanceMutation) OldChecksum(ctx context.Context) (v string, err error) {
+func (m *ContentEventMutation) OldOccurredAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldChecksum is only allowed on UpdateOne operations")
+		return v, errors.New("OldOccurredAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldChecksum requires an ID field in the mutation")
+		return v, errors.New("OldOccurredAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldChecksum: %w", err)
+		return v, fmt.Errorf("querying old value for OldOccurredAt: %w", err)
 	}
-	return oldValue.Checksum, nil
+	return oldValue.OccurredAt, nil
 }
 
-// ResetChecksum resets all changes to the "checksum" field.
-func (m *FileInstanceMutation) ResetChecksum() {
-	m.checksum = nil
+// ResetOccurredAt resets all changes to the "occurred_at" field.
+func (m *ContentEventMutation) ResetOccurredAt() {
+	m.occurred_at = nil
 }
 
-// SetLastSeenAt sets the "last_seen_at" field.
-func (m *FileInstanceMutation) SetLastSeenAt(t time.Time) {
-	m.last_seen_at = &t
-}
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (m *ContentEventMutation) ClearTenant() {
+	m.clearedtenant = true
+	m.clearedFields[contentevent.FieldTenantID] = struct{}{}
+}
 
-// LastSeenAt returns the value of the "last_seen_at" field in the mutation.
-func (m *FileInstanceMutation) LastSeenAt() (r time.Time, exists bool) {
-	v := m.last_seen_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// TenantCleared reports if the "tenant" edge to the Tenant entity was cleared.
+func (m *ContentEventMutation) TenantCleared() bool {
+	return m.clearedtenant
 }
 
-// OldLastSeenAt returns the old "last_seen_at" field's value of the FileInstance entity.
-// If the FileInstance object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileInstanceMutation) OldLastSeenAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastSeenAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastSeenAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastSeenAt: %w", err)
+// TenantIDs returns the "tenant" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TenantID instead. It exists only for internal usage by the builders.
+func (m *ContentEventMutation) TenantIDs() (ids []uuid.UUID) {
+	if id := m.tenant; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.LastSeenAt, nil
+	return
 }
 
-// ResetLastSeenAt resets all changes to the "last_seen_at" field.
-func (m *FileInstanceMutation) ResetLastSeenAt() {
-	m.last_seen_at = nil
+// ResetTenant resets all changes to the "tenant" edge.
+func (m *ContentEventMutation) ResetTenant() {
+	m.tenant = nil
+	m.clearedtenant = false
 }
 
-// SetQuarantined sets the "quarantined" field.
-func (m *FileInstanceMutation) SetQuarantined(b bool) {
-	m.quarantined = &b
+// ClearContentIdentity clears the "content_identity" edge to the ContentIdentity entity.
+func (m *ContentEventMutation) ClearContentIdentity() {
+	m.clearedcontent_identity = true
+	m.clearedFields[contentevent.FieldContentIdentityID] = struct{}{}
 }
 
-// Quarantined returns the value of the "quarantined" field in the mutation.
-func (m *FileInstanceMutation) Quarantined() (r bool, exists bool) {
-	v := m.quarantined
-	if v == nil {
-		return
-	}
-	return *v, true
+// ContentIdentityCleared reports if the "content_identity" edge to the ContentIdentity entity was cleared.
+func (m *ContentEventMutation) ContentIdentityCleared() bool {
+	return m.clearedcontent_identity
 }
 
-// OldQuarantined returns the old "quarantined" field's value of the FileInstance entity.
-// If the FileInstance object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileInstanceMutation) OldQuarantined(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldQuarantined is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldQuarantined requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldQuarantined: %w", err)
+// ContentIdentityIDs returns the "content_identity" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ContentIdentityID instead. It exists only for internal usage by the builders.
+func (m *ContentEventMutation) ContentIdentityIDs() (ids []uuid.UUID) {
+	if id := m.content_identity; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.Quarantined, nil
+	return
 }
 
-// ResetQuarantined resets all changes to the "quarantined" field.
-func (m *FileInstanceMutation) ResetQuarantined() {
-	m.quarantined = nil
+// ResetContentIdentity resets all changes to the "content_identity" edge.
+func (m *ContentEventMutation) ResetContentIdentity() {
+	m.content_identity = nil
+	m.clearedcontent_identity = false
 }
 
-// ClearDuplicateGroup clears the "duplicate_group" edge to the DuplicateGroup entity.
-func (m *FileInstanceMutation) ClearDuplicateGroup() {
-	m.clearedduplicate_group = true
-	m.clearedFields[fileinstance.FieldDuplicateGroupID] = struct{}{}
+// ClearScan clears the "scan" edge to the Scan entity.
+func (m *ContentEventMutation) ClearScan() {
+	m.clearedscan = true
+	m.clearedFields[contentevent.FieldScanID] = struct{}{}
 }
 
-// DuplicateGroupCleared reports if the "duplicate_group" edge to the DuplicateGroup entity was cleared.
-func (m *FileInstanceMutation) DuplicateGroupCleared() bool {
-	return m.clearedduplicate_group
+// ScanCleared reports if the "scan" edge to the Scan entity was cleared.
+func (m *ContentEventMutation) ScanCleared() bool {
+	return m.clearedscan
 }
 
-// DuplicateGroupIDs returns the "duplicate_group" edge IDs in the mutation.
+// ScanIDs returns the "scan" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// DuplicateGroupID instead. It exists only for internal usage by the builders.
-func (m *FileInstanceMutation) DuplicateGroupIDs() (ids []uuid.UUID) {
-	if id := m.duplicate_group; id != nil {
+// ScanID instead. It exists only for internal usage by the builders.
+func (m *ContentEventMutation) ScanIDs() (ids []uuid.UUID) {
+	if id := m.scan; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetDuplicateGroup resets all changes to the "duplicate_group" edge.
-func (m *FileInstanceMutation) ResetDuplicateGroup() {
-	m.duplicate_group = nil
-	m.clearedduplicate_group = false
+// ResetScan resets all changes to the "scan" edge.
+func (m *ContentEventMutation) ResetScan() {
+	m.scan = nil
+	m.clearedscan = false
 }
 
 // ClearMachine clears the "machine" edge to the Machine entity.
-func (m *FileInstanceMutation) ClearMachine() {
+func (m *ContentEventMutation) ClearMachine() {
 	m.clearedmachine = true
-	m.clearedFields[fileinstance.FieldMachineID] = struct{}{}
+	m.clearedFields[contentevent.FieldMachineID] = struct{}{}
 }
 
 // MachineCleared reports if the "machine" edge to the Machine entity was cleared.
-func (m *FileInstanceMutation) MachineCleared() bool {
+func (m *ContentEventMutation) MachineCleared() bool {
 	return m.clearedmachine
 }
 
 // MachineIDs returns the "machine" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // MachineID instead. It exists only for internal usage by the builders.
-func (m *FileInstanceMutation) MachineIDs() (ids []uuid.UUID) {
+func (m *ContentEventMutation) MachineIDs() (ids []uuid.UUID) {
 	if id := m.machine; id != nil {
 		ids = append(ids, *id)
 	}
@@ -2614,20 +2915,20 @@ func (m *FileInstanceMutation) MachineIDs() (ids []uuid.UUID) {
 }
 
 // ResetMachine resets all changes to the "machine" edge.
-func (m *FileInstanceMutation) ResetMachine() {
+func (m *ContentEventMutation) ResetMachine() {
 	m.machine = nil
 	m.clearedmachine = false
 }
 
-// Where appends a list predicates to the FileInstanceMutation builder.
-func (m *FileInstanceMutation) Where(ps ...predicate.FileInstance) {
+// Where appends a list predicates to the ContentEventMutation builder.
+func (m *ContentEventMutation) Where(ps ...predicate.ContentEvent) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the FileInstanceMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ContentEventMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *FileInstanceMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.FileInstance, len(ps))
+func (m *ContentEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ContentEvent, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2635,51 +2936,51 @@ func (m *FileInstanceMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *FileInstanceMutation) Op() Op {
+func (m *ContentEventMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *FileInstanceMutation) SetOp(op Op) {
+func (m *ContentEventMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (FileInstance).
-func (m *FileInstanceMutation) Type() string {
+// Type returns the node type of this mutation (ContentEvent).
+func (m *ContentEventMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *FileInstanceMutation) Fields() []string {
+func (m *ContentEventMutation) Fields() []string {
 	fields := make([]string, 0, 9)
 	if m.create_time != nil {
-		fields = append(fields, fileinstance.FieldCreateTime)
+		fields = append(fields, contentevent.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, fileinstance.FieldUpdateTime)
+		fields = append(fields, contentevent.FieldUpdateTime)
 	}
-	if m.duplicate_group != nil {
-		fields = append(fields, fileinstance.FieldDuplicateGroupID)
+	if m.tenant != nil {
+		fields = append(fields, contentevent.FieldTenantID)
 	}
-	if m.machine != nil {
-		fields = append(fields, fileinstance.FieldMachineID)
+	if m.content_identity != nil {
+		fields = append(fields, contentevent.FieldContentIdentityID)
 	}
-	if m._path != nil {
-		fields = append(fields, fileinstance.FieldPath)
+	if m.scan != nil {
+		fields = append(fields, contentevent.FieldScanID)
 	}
-	if m.size_bytes != nil {
-		fields = append(fields, fileinstance.FieldSizeBytes)
+	if m.machine != nil {
+		fields = append(fields, contentevent.FieldMachineID)
 	}
-	if m.checksum != nil {
-		fields = append(fields, fileinstance.FieldChecksum)
+	if m._path != nil {
+		fields = append(fields, contentevent.FieldPath)
 	}
-	if m.last_seen_at != nil {
-		fields = append(fields, fileinstance.FieldLastSeenAt)
+	if m.kind != nil {
+		fields = append(fields, contentevent.FieldKind)
 	}
-	if m.quarantined != nil {
-		fields = append(fields, fileinstance.FieldQuarantined)
+	if m.occurred_at != nil {
+		fields = append(fields, contentevent.FieldOccurredAt)
 	}
 	return fields
 }
@@ -2687,26 +2988,26 @@ func (m *FileInstanceMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *FileInstanceMutation) Field(name string) (ent.Value, bool) {
+func (m *ContentEventMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case fileinstance.FieldCreateTime:
+	case contentevent.FieldCreateTime:
 		return m.CreateTime()
-	case fileinstance.FieldUpdateTime:
+	case contentevent.FieldUpdateTime:
 		return m.UpdateTime()
-	case fileinstance.FieldDuplicateGroupID:
-		return m.DuplicateGroupID()
-	case fileinstance.FieldMachineID:
+	case contentevent.FieldTenantID:
+		return m.TenantID()
+	case contentevent.FieldContentIdentityID:
+		return m.ContentIdentityID()
+	case contentevent.FieldScanID:
+		return m.ScanID()
+	case contentevent.FieldMachineID:
 		return m.MachineID()
-	case fileinstance.FieldPath:
+	case contentevent.FieldPath:
 		return m.Path()
-	case fileinstance.FieldSizeBytes:
-		return m.SizeBytes()
-	case fileinstance.FieldChecksum:
-		return m.Checksum()
-	case fileinstance.FieldLastSeenAt:
-		return m.LastSeenAt()
-	case fileinstance.FieldQuarantined:
-		return m.Quarantined()
+	case contentevent.FieldKind:
+		return m.Kind()
+	case contentevent.FieldOccurredAt:
+		return m.OccurredAt()
 	}
 	return nil, false
 }
@@ -2714,252 +3015,261 @@ func (m *FileInstanceMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *FileInstanceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ContentEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case fileinstance.FieldCreateTime:
+	case contentevent.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case fileinstance.FieldUpdateTime:
+	case contentevent.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case fileinstance.FieldDuplicateGroupID:
-		return m.OldDuplicateGroupID(ctx)
-	case fileinstance.FieldMachineID:
+	case contentevent.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case contentevent.FieldContentIdentityID:
+		return m.OldContentIdentityID(ctx)
+	case contentevent.FieldScanID:
+		return m.OldScanID(ctx)
+	case contentevent.FieldMachineID:
 		return m.OldMachineID(ctx)
-	case fileinstance.FieldPath:
+	case contentevent.FieldPath:
 		return m.OldPath(ctx)
-	case fileinstance.FieldSizeBytes:
-		return m.OldSizeBytes(ctx)
-	case fileinstance.FieldChecksum:
-		return m.OldChecksum(ctx)
-	case fileinstance.FieldLastSeenAt:
-		return m.OldLastSeenAt(ctx)
-	case fileinstance.FieldQuarantined:
-		return m.OldQuarantined(ctx)
+	case contentevent.FieldKind:
+		return m.OldKind(ctx)
+	case contentevent.FieldOccurredAt:
+		return m.OldOccurredAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown FileInstance field %s", name)
+	return nil, fmt.Errorf("unknown ContentEvent field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FileInstanceMutation) SetField(name string, value ent.Value) error {
+func (m *ContentEventMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case fileinstance.FieldCreateTime:
+	case contentevent.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case fileinstance.FieldUpdateTime:
+	case contentevent.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case fileinstance.FieldDuplicateGroupID:
+	case contentevent.FieldTenantID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDuplicateGroupID(v)
+		m.SetTenantID(v)
 		return nil
-	case fileinstance.FieldMachineID:
+	case contentevent.FieldContentIdentityID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMachineID(v)
+		m.SetContentIdentityID(v)
 		return nil
-	case fileinstance.FieldPath:
-		v, ok := value.(string)
+	case contentevent.FieldScanID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPath(v)
+		m.SetScanID(v)
 		return nil
-	case fileinstance.FieldSizeBytes:
-		v, ok := value.(int64)
+	case contentevent.FieldMachineID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSizeBytes(v)
+		m.SetMachineID(v)
 		return nil
-	case fileinstance.FieldChecksum:
+	case contentevent.FieldPath:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetChecksum(v)
+		m.SetPath(v)
 		return nil
-	case fileinstance.FieldLastSeenAt:
-		v, ok := value.(time.Time)
+	case contentevent.FieldKind:
+		v, ok := value.(contentevent.Kind)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLastSeenAt(v)
+		m.SetKind(v)
 		return nil
-	case fileinstance.FieldQuarantined:
-		v, ok := value.(bool)
+	case contentevent.FieldOccurredAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetQuarantined(v)
+		m.SetOccurredAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown FileInstance field %s", name)
+	return fmt.Errorf("unknown ContentEvent field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *FileInstanceMutation) AddedFields() []string {
-	var fields []string
-	if m.addsize_bytes != nil {
-		fields = append(fields, fileinstance.FieldSizeBytes)
-	}
-	return fields
+func (m *ContentEventMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *FileInstanceMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case fileinstance.FieldSizeBytes:
-		return m.AddedSizeBytes()
-	}
+func (m *ContentEventMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FileInstanceMutation) AddField(name string, value ent.Value) error {
+func (m *ContentEventMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case fileinstance.FieldSizeBytes:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSizeBytes(v)
-		return nil
 	}
-	return fmt.Errorf("unknown FileInstance numeric field %s", name)
+	return fmt.Errorf("unknown ContentEvent numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *FileInstanceMutation) ClearedFields() []string {
+func (m *ContentEventMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *FileInstanceMutation) FieldCleared(name string) bool {
+func (m *ContentEventMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *FileInstanceMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown FileInstance nullable field %s", name)
+func (m *ContentEventMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ContentEvent nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *FileInstanceMutation) ResetField(name string) error {
+func (m *ContentEventMutation) ResetField(name string) error {
 	switch name {
-	case fileinstance.FieldCreateTime:
+	case contentevent.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case fileinstance.FieldUpdateTime:
+	case contentevent.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case fileinstance.FieldDuplicateGroupID:
-		m.ResetDuplicateGroupID()
+	case contentevent.FieldTenantID:
+		m.ResetTenantID()
 		return nil
-	case fileinstance.FieldMachineID:
-		m.ResetMachineID()
+	case contentevent.FieldContentIdentityID:
+		m.ResetContentIdentityID()
 		return nil
-	case fileinstance.FieldPath:
-		m.ResetPath()
+	case contentevent.FieldScanID:
+		m.ResetScanID()
 		return nil
-	case fileinstance.FieldSizeBytes:
-		m.ResetSizeBytes()
+	case contentevent.FieldMachineID:
+		m.ResetMachineID()
 		return nil
-	case fileinstance.FieldChecksum:
-		m.ResetChecksum()
+	case contentevent.FieldPath:
+		m.ResetPath()
 		return nil
-	case fileinstance.FieldLastSeenAt:
-		m.ResetLastSeenAt()
+	case contentevent.FieldKind:
+		m.ResetKind()
 		return nil
-	case fileinstance.FieldQuarantined:
-		m.ResetQuarantined()
+	case contentevent.FieldOccurredAt:
+		m.ResetOccurredAt()
 		return nil
 	}
-	return fmt.Errorf("unknown FileInstance field %s", name)
+	return fmt.Errorf("unknown ContentEvent field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *FileInstanceMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.duplicate_group != nil {
-		edges = append(edges, fileinstance.EdgeDuplicateGroup)
+func (m *ContentEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.tenant != nil {
+		edges = append(edges, contentevent.EdgeTenant)
+	}
+	if m.content_identity != nil {
+		edges = append(edges, contentevent.EdgeContentIdentity)
+	}
+	if m.scan != nil {
+		edges = append(edges, contentevent.EdgeScan)
 	}
 	if m.machine != nil {
-		edges = append(edges, fileinstance.EdgeMachine)
+		edges = append(edges, contentevent.EdgeMachine)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *FileInstanceMutation) AddedIDs(name string) []ent.Value {
+func (m *ContentEventMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case fileinstance.EdgeDuplicateGroup:
-		if id := m.duplicate_group; id != nil {
+	case contentevent.EdgeTenant:
+		if id := m.tenant; id != nil {
 			return []ent.Value{*id}
 		}
-	case fileinstance.EdgeMachine:
-		if id := m.machine; id != nil {
+	case contentevent.EdgeContentIdentity:
+		if id := m.content_identity; id != nil {
 			return []ent.Value{*id}
 		}
-	}
+	case contentevent.EdgeScan:
+		if id := m.scan; id != nil {
+			return []ent.Value{*id}
+		}
+	case contentevent.EdgeMachine:
+		if id := m.machine; id != nil {
+			return []ent.Value{*id}
+		}
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *FileInstanceMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *ContentEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *FileInstanceMutation) RemovedIDs(name string) []ent.Value {
+func (m *ContentEventMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *FileInstanceMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedduplicate_group {
-		edges = append(edges, fileinstance.EdgeDuplicateGroup)
+func (m *ContentEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedtenant {
+		edges = append(edges, contentevent.EdgeTenant)
+	}
+	if m.clearedcontent_identity {
+		edges = append(edges, contentevent.EdgeContentIdentity)
+	}
+	if m.clearedscan {
+		edges = append(edges, contentevent.EdgeScan)
 	}
 	if m.clearedmachine {
-		edges = append(edges, fileinstance.EdgeMachine)
+		edges = append(edges, contentevent.EdgeMachine)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *FileInstanceMutation) EdgeCleared(name string) bool {
+func (m *ContentEventMutation) EdgeCleared(name string) bool {
 	switch name {
-	case fileinstance.EdgeDuplicateGroup:
-		return m.clearedduplicate_group
-	case fileinstance.EdgeMachine:
+	case contentevent.EdgeTenant:
+		return m.clearedtenant
+	case contentevent.EdgeContentIdentity:
+		return m.clearedcontent_identity
+	case contentevent.EdgeScan:
+		return m.clearedscan
+	case contentevent.EdgeMachine:
 		return m.clearedmachine
 	}
 	return false
@@ -2967,73 +3277,83 @@ func (m *FileInstanceMutation) EdgeCleared(name string) bool {
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *FileInstanceMutation) ClearEdge(name string) error {
+func (m *ContentEventMutation) ClearEdge(name string) error {
 	switch name {
-	case fileinstance.EdgeDuplicateGroup:
-		m.ClearDuplicateGroup()
+	case contentevent.EdgeTenant:
+		m.ClearTenant()
 		return nil
-	case fileinstance.EdgeMachine:
+	case contentevent.EdgeContentIdentity:
+		m.ClearContentIdentity()
+		return nil
+	case contentevent.EdgeScan:
+		m.ClearScan()
+		return nil
+	case contentevent.EdgeMachine:
 		m.ClearMachine()
 		return nil
 	}
-	return fmt.Errorf("unknown FileInstance unique edge %s", name)
+	return fmt.Errorf("unknown ContentEvent unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *FileInstanceMutation) ResetEdge(name string) error {
+func (m *ContentEventMutation) ResetEdge(name string) error {
 	switch name {
-	case fileinstance.EdgeDuplicateGroup:
-		m.ResetDuplicateGroup()
+	case contentevent.EdgeTenant:
+		m.ResetTenant()
 		return nil
-	case fileinstance.EdgeMachine:
+	case contentevent.EdgeContentIdentity:
+		m.ResetContentIdentity()
+		return nil
+	case contentevent.EdgeScan:
+		m.ResetScan()
+		return nil
+	case contentevent.EdgeMachine:
 		m.ResetMachine()
 		return nil
 	}
-	return fmt.Errorf("unknown FileInstance edge %s", name)
+	return fmt.Errorf("unknown ContentEvent edge %s", name)
 }
 
-// MachineMutation represents an operation that mutates the Machine nodes in the graph.
-type MachineMutation struct {
+// ContentIdentityMutation represents an operation that mutates the ContentIdentity nodes in the graph.
+type ContentIdentityMutation struct {
 	config
-	op                     Op
-	typ                    string
-	id                     *uuid.UUID
-	create_time            *time.Time
-	update_time            *time.Time
-	name                   *string
-	category               *machine.Category
-	hostname               *string
-	role                   *string
-	last_scan_at           *time.Time
-	clearedFields          map[string]struct{}
-	tenant                 *uuid.UUID
-	clearedtenant          bool
-	keeper_groups          map[uuid.UUID]struct{}
-	removedkeeper_groups   map[uuid.UUID]struct{}
-	clearedkeeper_groups   bool
-	file_instances         map[uuid.UUID]struct{}
-	removedfile_instances  map[uuid.UUID]struct{}
-	clearedfile_instances  bool
-	initiated_scans        map[uuid.UUID]struct{}
-	removedinitiated_scans map[uuid.UUID]struct{}
-	clearedinitiated_scans bool
-	done                   bool
-	oldValue               func(context.Context) (*Machine, error)
-	predicates             []predicate.Machine
+	op                      Op
+	typ                     string
+	id                      *uuid.UUID
+	create_time             *time.Time
+	update_time             *time.Time
+	hash                    *string
+	resolved_at             *time.Time
+	first_seen_at           *time.Time
+	last_seen_at            *time.Time
+	clearedFields           map[string]struct{}
+	tenant                  *uuid.UUID
+	clearedtenant           bool
+	keeper_machine          *uuid.UUID
+	clearedkeeper_machine   bool
+	duplicate_groups        map[uuid.UUID]struct{}
+	removedduplicate_groups map[uuid.UUID]struct{}
+	clearedduplicate_groups bool
+	events                  map[uuid.UUID]struct{}
+	removedevents           map[uuid.UUID]struct{}
+	clearedevents           bool
+	done                    bool
+	oldValue                func(context.Context) (*ContentIdentity, error)
+	predicates              []predicate.ContentIdentity
 }
 
-var _ ent.Mutation = (*MachineMutation)(nil)
+var _ ent.Mutation = (*ContentIdentityMutation)(nil)
 
-// machineOption allows management of the mutation configuration using functional options.
-type machineOption func(*MachineMutation)
+// contentidentityOption allows management of the mutation configuration using functional options.
+type contentidentityOption func(*ContentIdentityMutation)
 
-// newMachineMutation creates new mutation for the Machine entity.
-func newMachineMutation(c config, op Op, opts ...machineOption) *MachineMutation {
-	m := &MachineMutation{
+// newContentIdentityMutation creates new mutation for the ContentIdentity entity.
+func newContentIdentityMutation(c config, op Op, opts ...contentidentityOption) *ContentIdentityMutation {
+	m := &ContentIdentityMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMachine,
+		typ:           TypeContentIdentity,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3042,20 +3362,20 @@ func newMachineMutation(c config, op Op, opts ...machineOption) *MachineMutation
 	return m
 }
 
-// withMachineID sets the ID field of the mutation.
-func withMachineID(id uuid.UUID) machineOption {
-	return func(m *MachineMutation) {
+// withContentIdentityID sets the ID field of the mutation.
+func withContentIdentityID(id uuid.UUID) contentidentityOption {
+	return func(m *ContentIdentityMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Machine
+			value *ContentIdentity
 		)
-		m.oldValue = func(ctx context.Context) (*Machine, error) {
+		m.oldValue = func(ctx context.Context) (*ContentIdentity, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Machine.Get(ctx, id)
+					value, err = m.Client().ContentIdentity.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3064,10 +3384,10 @@ func withMachineID(id uuid.UUID) machineOption {
 	}
 }
 
-// withMachine sets the old Machine of the mutation.
-func withMachine(node *Machine) machineOption {
-	return func(m *MachineMutation) {
-		m.oldValue = func(context.Context) (*Machine, error) {
+// withContentIdentity sets the old ContentIdentity of the mutation.
+func withContentIdentity(node *ContentIdentity) contentidentityOption {
+	return func(m *ContentIdentityMutation) {
+		m.oldValue = func(context.Context) (*ContentIdentity, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3076,7 +3396,7 @@ func withMachine(node *Machine) machineOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MachineMutation) Client() *Client {
+func (m ContentIdentityMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3084,7 +3404,7 @@ func (m MachineMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MachineMutation) Tx() (*Tx, error) {
+func (m ContentIdentityMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3094,14 +3414,14 @@ func (m MachineMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Machine entities.
-func (m *MachineMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of ContentIdentity entities.
+func (m *ContentIdentityMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MachineMutation) ID() (id uuid.UUID, exists bool) {
+func (m *ContentIdentityMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3112,7 +3432,7 @@ func (m *MachineMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MachineMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *ContentIdentityMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3121,19 +3441,19 @@ func (m *MachineMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Machine.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ContentIdentity.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *MachineMutation) SetCreateTime(t time.Time) {
+func (m *ContentIdentityMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *MachineMutation) CreateTime() (r time.Time, exists bool) {
+func (m *ContentIdentityMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -3141,10 +3461,10 @@ func (m *MachineMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the Machine entity.
-// If the Machine object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the ContentIdentity entity.
+// If the ContentIdentity object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MachineMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *ContentIdentityMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -3159,17 +3479,17 @@ func (m *MachineMutation) OldCreateTime(ctx context.Context) (v time.Time, err e
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *MachineMutation) ResetCreateTime() {
+func (m *ContentIdentityMutation) ResetCreateTime() {
 	m.create_time = nil
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *MachineMutation) SetUpdateTime(t time.Time) {
+func (m *ContentIdentityMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *MachineMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *ContentIdentityMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -3177,10 +3497,10 @@ func (m *MachineMutation) UpdateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the Machine entity.
-// If the Machine object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the ContentIdentity entity.
+// If the ContentIdentity object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MachineMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *ContentIdentityMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -3195,17 +3515,17 @@ func (m *MachineMutation) OldUpdateTime(ctx context.Context) (v time.Time, err e
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *MachineMutation) ResetUpdateTime() {
+func (m *ContentIdentityMutation) ResetUpdateTime() {
 	m.update_time = nil
 }
 
 // SetTenantID sets the "tenant_id" field.
-func (m *MachineMutation) SetTenantID(u uuid.UUID) {
+func (m *ContentIdentityMutation) SetTenantID(u uuid.UUID) {
 	m.tenant = &u
 }
 
 // TenantID returns the value of the "tenant_id" field in the mutation.
-func (m *MachineMutation) TenantID() (r uuid.UUID, exists bool) {
+func (m *ContentIdentityMutation) TenantID() (r uuid.UUID, exists bool) {
 	v := m.tenant
 	if v == nil {
 		return
@@ -3213,10 +3533,10 @@ func (m *MachineMutation) TenantID() (r uuid.UUID, exists bool) {
 	return *v, true
 }
 
-// OldTenantID returns the old "tenant_id" field's value of the Machine entity.
-// If the Machine object wasn't provided to the builder, the object is fetched from the database.
+// OldTenantID returns the old "tenant_id" field's value of the ContentIdentity entity.
+// If the ContentIdentity object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MachineMutation) OldTenantID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *ContentIdentityMutation) OldTenantID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
 	}
@@ -3231,427 +3551,11524 @@ func (m *MachineMutation) OldTenantID(ctx context.Context) (v uuid.UUID, err err
 }
 
 // ResetTenantID resets all changes to the "tenant_id" field.
-func (m *MachineMutation) ResetTenantID() {
+func (m *ContentIdentityMutation) ResetTenantID() {
 	m.tenant = nil
 }
 
-// SetName sets the "name" field.
-func (m *MachineMutation) SetName(s string) {
-	m.name = &s
+// SetHash sets the "hash" field.
+func (m *ContentIdentityMutation) SetHash(s string) {
+	m.hash = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *MachineMutation) Name() (r string, exists bool) {
-	v := m.name
+// Hash returns the value of the "hash" field in the mutation.
+func (m *ContentIdentityMutation) Hash() (r string, exists bool) {
+	v := m.hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Machine entity.
-// If the Machine object wasn't provided to the builder, the object is fetched from the database.
+// OldHash returns the old "hash" field's value of the ContentIdentity entity.
+// If the ContentIdentity object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MachineMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *ContentIdentityMutation) OldHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldHash: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Hash, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *MachineMutation) ResetName() {
-	m.name = nil
+// ResetHash resets all changes to the "hash" field.
+func (m *ContentIdentityMutation) ResetHash() {
+	m.hash = nil
 }
 
-// SetCategory sets the "category" field.
-func (m *MachineMutation) SetCategory(value machine.Category) {
-	m.category = &value
+// SetKeeperMachineID sets the "keeper_machine_id" field.
+func (m *ContentIdentityMutation) SetKeeperMachineID(u uuid.UUID) {
+	m.keeper_machine = &u
 }
 
-// Category returns the value of the "category" field in the mutation.
-func (m *MachineMutation) Category() (r machine.Category, exists bool) {
-	v := m.category
+// KeeperMachineID returns the value of the "keeper_machine_id" field in the mutation.
+func (m *ContentIdentityMutation) KeeperMachineID() (r uuid.UUID, exists bool) {
+	v := m.keeper_machine
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCategory returns the old "category" field's value of the Machine entity.
-// If the Machine object wasn't provided to the builder, the object is fetched from the database.
+// OldKeeperMachineID returns the old "keeper_machine_id" field's value of the ContentIdentity entity.
+// If the ContentIdentity object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MachineMutation) OldCategory(ctx context.Context) (v machine.Category, err error) {
+func (m *ContentIdentityMutation) OldKeeperMachineID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
+		return v, errors.New("OldKeeperMachineID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCategory requires an ID field in the mutation")
+		return v, errors.New("OldKeeperMachineID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
+		return v, fmt.Errorf("querying old value for OldKeeperMachineID: %w", err)
 	}
-	return oldValue.Category, nil
+	return oldValue.KeeperMachineID, nil
 }
 
-// ResetCategory resets all changes to the "category" field.
-func (m *MachineMutation) ResetCategory() {
-	m.category = nil
+// ClearKeeperMachineID clears the value of the "keeper_machine_id" field.
+func (m *ContentIdentityMutation) ClearKeeperMachineID() {
+	m.keeper_machine = nil
+	m.clearedFields[contentidentity.FieldKeeperMachineID] = struct{}{}
 }
 
-// SetHostname sets the "hostname" field.
-func (m *MachineMutation) SetHostname(s string) {
-	m.hostname = &s
+// KeeperMachineIDCleared returns if the "keeper_machine_id" field was cleared in this mutation.
+func (m *ContentIdentityMutation) KeeperMachineIDCleared() bool {
+	_, ok := m.clearedFields[contentidentity.FieldKeeperMachineID]
+	return ok
 }
 
-// Hostname returns the value of the "hostname" field in the mutation.
-func (m *MachineMutation) Hostname() (r string, exists bool) {
-	v := m.hostname
+// ResetKeeperMachineID resets all changes to the "keeper_machine_id" field.
+func (m *ContentIdentityMutation) ResetKeeperMachineID() {
+	m.keeper_machine = nil
+	delete(m.clearedFields, contentidentity.FieldKeeperMachineID)
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (m *ContentIdentityMutation) SetResolvedAt(t time.Time) {
+	m.resolved_at = &t
+}
+
+// ResolvedAt returns the value of the "resolved_at" field in the mutation.
+func (m *ContentIdentityMutation) ResolvedAt() (r time.Time, exists bool) {
+	v := m.resolved_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldHostname returns the old "hostname" field's value of the Machine entity.
-// If the Machine object wasn't provided to the builder, the object is fetched from the database.
+// OldResolvedAt returns the old "resolved_at" field's value of the ContentIdentity entity.
+// If the ContentIdentity object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MachineMutation) OldHostname(ctx context.Context) (v string, err error) {
+func (m *ContentIdentityMutation) OldResolvedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHostname is only allowed on UpdateOne operations")
+		return v, errors.New("OldResolvedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHostname requires an ID field in the mutation")
+		return v, errors.New("OldResolvedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHostname: %w", err)
+		return v, fmt.Errorf("querying old value for OldResolvedAt: %w", err)
 	}
-	return oldValue.Hostname, nil
+	return oldValue.ResolvedAt, nil
 }
 
-// ClearHostname clears the value of the "hostname" field.
-func (m *MachineMutation) ClearHostname() {
-	m.hostname = nil
-	m.clearedFields[machine.FieldHostname] = struct{}{}
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (m *ContentIdentityMutation) ClearResolvedAt() {
+	m.resolved_at = nil
+	m.clearedFields[contentidentity.FieldResolvedAt] = struct{}{}
 }
 
-// HostnameCleared returns if the "hostname" field was cleared in this mutation.
-func (m *MachineMutation) HostnameCleared() bool {
-	_, ok := m.clearedFields[machine.FieldHostname]
+// ResolvedAtCleared returns if the "resolved_at" field was cleared in this mutation.
+func (m *ContentIdentityMutation) ResolvedAtCleared() bool {
+	_, ok := m.clearedFields[contentidentity.FieldResolvedAt]
 	return ok
 }
 
-// ResetHostname resets all changes to the "hostname" field.
-func (m *MachineMutation) ResetHostname() {
-	m.hostname = nil
-	delete(m.clearedFields, machine.FieldHostname)
+// ResetResolvedAt resets all changes to the "resolved_at" field.
+func (m *ContentIdentityMutation) ResetResolvedAt() {
+	m.resolved_at = nil
+	delete(m.clearedFields, contentidentity.FieldResolvedAt)
 }
 
-// SetRole sets the "role" field.
-func (m *MachineMutation) SetRole(s string) {
-	m.role = &s
+// SetFirstSeenAt sets the "first_seen_at" field.
+func (m *ContentIdentityMutation) SetFirstSeenAt(t time.Time) {
+	m.first_seen_at = &t
 }
 
-// Role returns the value of the "role" field in the mutation.
-func (m *MachineMutation) Role() (r string, exists bool) {
-	v := m.role
+// FirstSeenAt returns the value of the "first_seen_at" field in the mutation.
+func (m *ContentIdentityMutation) FirstSeenAt() (r time.Time, exists bool) {
+	v := m.first_seen_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRole returns the old "role" field's value of the Machine entity.
-// If the Machine object wasn't provided to the builder, the object is fetched from the database.
+// OldFirstSeenAt returns the old "first_seen_at" field's value of the ContentIdentity entity.
+// If the ContentIdentity object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MachineMutation) OldRole(ctx context.Context) (v string, err error) {
+func (m *ContentIdentityMutation) OldFirstSeenAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+		return v, errors.New("OldFirstSeenAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRole requires an ID field in the mutation")
+		return v, errors.New("OldFirstSeenAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+		return v, fmt.Errorf("querying old value for OldFirstSeenAt: %w", err)
 	}
-	return oldValue.Role, nil
-}
-
-// ClearRole clears the value of the "role" field.
-func (m *MachineMutation) ClearRole() {
-	m.role = nil
-	m.clearedFields[machine.FieldRole] = struct{}{}
-}
-
-// RoleCleared returns if the "role" field was cleared in this mutation.
-func (m *MachineMutation) RoleCleared() bool {
-	_, ok := m.clearedFields[machine.FieldRole]
-	return ok
+	return oldValue.FirstSeenAt, nil
 }
 
-// ResetRole resets all changes to the "role" field.
-func (m *MachineMutation) ResetRole() {
-	m.role = nil
-	delete(m.clearedFields, machine.FieldRole)
+// ResetFirstSeenAt resets all changes to the "first_seen_at" field.
+func (m *ContentIdentityMutation) ResetFirstSeenAt() {
+	m.first_seen_at = nil
 }
 
-// SetLastScanAt sets the "last_scan_at" field.
-func (m *MachineMutation) SetLastScanAt(t time.Time) {
-	m.last_scan_at = &t
+// SetLastSeenAt sets the "last_seen_at" field.
+func (m *ContentIdentityMutation) SetLastSeenAt(t time.Time) {
+	m.last_seen_at = &t
 }
 
-// LastScanAt returns the value of the "last_scan_at" field in the mutation.
-func (m *MachineMutation) LastScanAt() (r time.Time, exists bool) {
-	v := m.last_scan_at
+// LastSeenAt returns the value of the "last_seen_at" field in the mutation.
+func (m *ContentIdentityMutation) LastSeenAt() (r time.Time, exists bool) {
+	v := m.last_seen_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastScanAt returns the old "last_scan_at" field's value of the Machine entity.
-// If the Machine object wasn't provided to the builder, the object is fetched from the database.
+// OldLastSeenAt returns the old "last_seen_at" field's value of the ContentIdentity entity.
+// If the ContentIdentity object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MachineMutation) OldLastScanAt(ctx context.Context) (v time.Time, err error) {
+func (m *ContentIdentityMutation) OldLastSeenAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastScanAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastSeenAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastScanAt requires an ID field in the mutation")
+		return v, errors.New("OldLastSeenAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastScanAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastSeenAt: %w", err)
+	}
+	return oldValue.LastSeenAt, nil
+}
+
+// ResetLastSeenAt resets all changes to the "last_seen_at" field.
+func (m *ContentIdentityMutation) ResetLastSeenAt() {
+	m.last_seen_at = nil
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (m *ContentIdentityMutation) ClearTenant() {
+	m.clearedtenant = true
+	m.clearedFields[contentidentity.FieldTenantID] = struct{}{}
+}
+
+// TenantCleared reports if the "tenant" edge to the Tenant entity was cleared.
+func (m *ContentIdentityMutation) TenantCleared() bool {
+	return m.clearedtenant
+}
+
+// TenantIDs returns the "tenant" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TenantID instead. It exists only for internal usage by the builders.
+func (m *ContentIdentityMutation) TenantIDs() (ids []uuid.UUID) {
+	if id := m.tenant; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTenant resets all changes to the "tenant" edge.
+func (m *ContentIdentityMutation) ResetTenant() {
+	m.tenant = nil
+	m.clearedtenant = false
+}
+
+// ClearKeeperMachine clears the "keeper_machine" edge to the Machine entity.
+func (m *ContentIdentityMutation) ClearKeeperMachine() {
+	m.clearedkeeper_machine = true
+	m.clearedFields[contentidentity.FieldKeeperMachineID] = struct{}{}
+}
+
+// KeeperMachineCleared reports if the "keeper_machine" edge to the Machine entity was cleared.
+func (m *ContentIdentityMutation) KeeperMachineCleared() bool {
+	return m.KeeperMachineIDCleared() || m.clearedkeeper_machine
+}
+
+// KeeperMachineIDs returns the "keeper_machine" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// KeeperMachineID instead. It exists only for internal usage by the builders.
+func (m *ContentIdentityMutation) KeeperMachineIDs() (ids []uuid.UUID) {
+	if id := m.keeper_machine; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetKeeperMachine resets all changes to the "keeper_machine" edge.
+func (m *ContentIdentityMutation) ResetKeeperMachine() {
+	m.keeper_machine = nil
+	m.clearedkeeper_machine = false
+}
+
+// AddDuplicateGroupIDs adds the "duplicate_groups" edge to the DuplicateGroup entity by ids.
+func (m *ContentIdentityMutation) AddDuplicateGroupIDs(ids ...uuid.UUID) {
+	if m.duplicate_groups == nil {
+		m.duplicate_groups = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.duplicate_groups[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDuplicateGroups clears the "duplicate_groups" edge to the DuplicateGroup entity.
+func (m *ContentIdentityMutation) ClearDuplicateGroups() {
+	m.clearedduplicate_groups = true
+}
+
+// DuplicateGroupsCleared reports if the "duplicate_groups" edge to the DuplicateGroup entity was cleared.
+func (m *ContentIdentityMutation) DuplicateGroupsCleared() bool {
+	return m.clearedduplicate_groups
+}
+
+// RemoveDuplicateGroupIDs removes the "duplicate_groups" edge to the DuplicateGroup entity by IDs.
+func (m *ContentIdentityMutation) RemoveDuplicateGroupIDs(ids ...uuid.UUID) {
+	if m.removedduplicate_groups == nil {
+		m.removedduplicate_groups = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.duplicate_groups, ids[i])
+		m.removedduplicate_groups[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDuplicateGroups returns the removed IDs of the "duplicate_groups" edge to the DuplicateGroup entity.
+func (m *ContentIdentityMutation) RemovedDuplicateGroupsIDs() (ids []uuid.UUID) {
+	for id := range m.removedduplicate_groups {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DuplicateGroupsIDs returns the "duplicate_groups" edge IDs in the mutation.
+func (m *ContentIdentityMutation) DuplicateGroupsIDs() (ids []uuid.UUID) {
+	for id := range m.duplicate_groups {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDuplicateGroups resets all changes to the "duplicate_groups" edge.
+func (m *ContentIdentityMutation) ResetDuplicateGroups() {
+	m.duplicate_groups = nil
+	m.clearedduplicate_groups = false
+	m.removedduplicate_groups = nil
+}
+
+// AddEventIDs adds the "events" edge to the ContentEvent entity by ids.
+func (m *ContentIdentityMutation) AddEventIDs(ids ...uuid.UUID) {
+	if m.events == nil {
+		m.events = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.events[ids[i]] = struct{}{}
+	}
+}
+
+// ClearEvents clears the "events" edge to the ContentEvent entity.
+func (m *ContentIdentityMutation) ClearEvents() {
+	m.clearedevents = true
+}
+
+// EventsCleared reports if the "events" edge to the ContentEvent entity was cleared.
+func (m *ContentIdentityMutation) EventsCleared() bool {
+	return m.clearedevents
+}
+
+// RemoveEventIDs removes the "events" edge to the ContentEvent entity by IDs.
+func (m *ContentIdentityMutation) RemoveEventIDs(ids ...uuid.UUID) {
+	if m.removedevents == nil {
+		m.removedevents = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.events, ids[i])
+		m.removedevents[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedEvents returns the removed IDs of the "events" edge to the ContentEvent entity.
+func (m *ContentIdentityMutation) RemovedEventsIDs() (ids []uuid.UUID) {
+	for id := range m.removedevents {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// EventsIDs returns the "events" edge IDs in the mutation.
+func (m *ContentIdentityMutation) EventsIDs() (ids []uuid.UUID) {
+	for id := range m.events {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetEvents resets all changes to the "events" edge.
+func (m *ContentIdentityMutation) ResetEvents() {
+	m.events = nil
+	m.clearedevents = false
+	m.removedevents = nil
+}
+
+// Where appends a list predicates to the ContentIdentityMutation builder.
+func (m *ContentIdentityMutation) Where(ps ...predicate.ContentIdentity) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ContentIdentityMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ContentIdentityMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ContentIdentity, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ContentIdentityMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ContentIdentityMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ContentIdentity).
+func (m *ContentIdentityMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ContentIdentityMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.create_time != nil {
+		fields = append(fields, contentidentity.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, contentidentity.FieldUpdateTime)
+	}
+	if m.tenant != nil {
+		fields = append(fields, contentidentity.FieldTenantID)
+	}
+	if m.hash != nil {
+		fields = append(fields, contentidentity.FieldHash)
+	}
+	if m.keeper_machine != nil {
+		fields = append(fields, contentidentity.FieldKeeperMachineID)
+	}
+	if m.resolved_at != nil {
+		fields = append(fields, contentidentity.FieldResolvedAt)
+	}
+	if m.first_seen_at != nil {
+		fields = append(fields, contentidentity.FieldFirstSeenAt)
+	}
+	if m.last_seen_at != nil {
+		fields = append(fields, contentidentity.FieldLastSeenAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ContentIdentityMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case contentidentity.FieldCreateTime:
+		return m.CreateTime()
+	case contentidentity.FieldUpdateTime:
+		return m.UpdateTime()
+	case contentidentity.FieldTenantID:
+		return m.TenantID()
+	case contentidentity.FieldHash:
+		return m.Hash()
+	case contentidentity.FieldKeeperMachineID:
+		return m.KeeperMachineID()
+	case contentidentity.FieldResolvedAt:
+		return m.ResolvedAt()
+	case contentidentity.FieldFirstSeenAt:
+		return m.FirstSeenAt()
+	case contentidentity.FieldLastSeenAt:
+		return m.LastSeenAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ContentIdentityMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case contentidentity.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case contentidentity.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case contentidentity.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case contentidentity.FieldHash:
+		return m.OldHash(ctx)
+	case contentidentity.FieldKeeperMachineID:
+		return m.OldKeeperMachineID(ctx)
+	case contentidentity.FieldResolvedAt:
+		return m.OldResolvedAt(ctx)
+	case contentidentity.FieldFirstSeenAt:
+		return m.OldFirstSeenAt(ctx)
+	case contentidentity.FieldLastSeenAt:
+		return m.OldLastSeenAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ContentIdentity field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ContentIdentityMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case contentidentity.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case contentidentity.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case contentidentity.FieldTenantID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case contentidentity.FieldHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHash(v)
+		return nil
+	case contentidentity.FieldKeeperMachineID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeeperMachineID(v)
+		return nil
+	case contentidentity.FieldResolvedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResolvedAt(v)
+		return nil
+	case contentidentity.FieldFirstSeenAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstSeenAt(v)
+		return nil
+	case contentidentity.FieldLastSeenAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastSeenAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ContentIdentity field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ContentIdentityMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ContentIdentityMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ContentIdentityMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ContentIdentity numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ContentIdentityMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(contentidentity.FieldKeeperMachineID) {
+		fields = append(fields, contentidentity.FieldKeeperMachineID)
+	}
+	if m.FieldCleared(contentidentity.FieldResolvedAt) {
+		fields = append(fields, contentidentity.FieldResolvedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ContentIdentityMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ContentIdentityMutation) ClearField(name string) error {
+	switch name {
+	case contentidentity.FieldKeeperMachineID:
+		m.ClearKeeperMachineID()
+		return nil
+	case contentidentity.FieldResolvedAt:
+		m.ClearResolvedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ContentIdentity nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ContentIdentityMutation) ResetField(name string) error {
+	switch name {
+	case contentidentity.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case contentidentity.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case contentidentity.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case contentidentity.FieldHash:
+		m.ResetHash()
+		return nil
+	case contentidentity.FieldKeeperMachineID:
+		m.ResetKeeperMachineID()
+		return nil
+	case contentidentity.FieldResolvedAt:
+		m.ResetResolvedAt()
+		return nil
+	case contentidentity.FieldFirstSeenAt:
+		m.ResetFirstSeenAt()
+		return nil
+	case contentidentity.FieldLastSeenAt:
+		m.ResetLastSeenAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ContentIdentity field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ContentIdentityMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.tenant != nil {
+		edges = append(edges, contentidentity.EdgeTenant)
+	}
+	if m.keeper_machine != nil {
+		edges = append(edges, contentidentity.EdgeKeeperMachine)
+	}
+	if m.duplicate_groups != nil {
+		edges = append(edges, contentidentity.EdgeDuplicateGroups)
+	}
+	if m.events != nil {
+		edges = append(edges, contentidentity.EdgeEvents)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ContentIdentityMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case contentidentity.EdgeTenant:
+		if id := m.tenant; id != nil {
+			return []ent.Value{*id}
+		}
+	case contentidentity.EdgeKeeperMachine:
+		if id := m.keeper_machine; id != nil {
+			return []ent.Value{*id}
+		}
+	case contentidentity.EdgeDuplicateGroups:
+		ids := make([]ent.Value, 0, len(m.duplicate_groups))
+		for id := range m.duplicate_groups {
+			ids = append(ids, id)
+		}
+		return ids
+	case contentidentity.EdgeEvents:
+		ids := make([]ent.Value, 0, len(m.events))
+		for id := range m.events {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ContentIdentityMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedduplicate_groups != nil {
+		edges = append(edges, contentidentity.EdgeDuplicateGroups)
+	}
+	if m.removedevents != nil {
+		edges = append(edges, contentidentity.EdgeEvents)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ContentIdentityMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case contentidentity.EdgeDuplicateGroups:
+		ids := make([]ent.Value, 0, len(m.removedduplicate_groups))
+		for id := range m.removedduplicate_groups {
+			ids = append(ids, id)
+		}
+		return ids
+	case contentidentity.EdgeEvents:
+		ids := make([]ent.Value, 0, len(m.removedevents))
+		for id := range m.removedevents {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ContentIdentityMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedtenant {
+		edges = append(edges, contentidentity.EdgeTenant)
+	}
+	if m.clearedkeeper_machine {
+		edges = append(edges, contentidentity.EdgeKeeperMachine)
+	}
+	if m.clearedduplicate_groups {
+		edges = append(edges, contentidentity.EdgeDuplicateGroups)
+	}
+	if m.clearedevents {
+		edges = append(edges, contentidentity.EdgeEvents)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ContentIdentityMutation) EdgeCleared(name string) bool {
+	switch name {
+	case contentidentity.EdgeTenant:
+		return m.clearedtenant
+	case contentidentity.EdgeKeeperMachine:
+		return m.clearedkeeper_machine
+	case contentidentity.EdgeDuplicateGroups:
+		return m.clearedduplicate_groups
+	case contentidentity.EdgeEvents:
+		return m.clearedevents
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ContentIdentityMutation) ClearEdge(name string) error {
+	switch name {
+	case contentidentity.EdgeTenant:
+		m.ClearTenant()
+		return nil
+	case contentidentity.EdgeKeeperMachine:
+		m.ClearKeeperMachine()
+		return nil
+	}
+	return fmt.Errorf("unknown ContentIdentity unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ContentIdentityMutation) ResetEdge(name string) error {
+	switch name {
+	case contentidentity.EdgeTenant:
+		m.ResetTenant()
+		return nil
+	case contentidentity.EdgeKeeperMachine:
+		m.ResetKeeperMachine()
+		return nil
+	case contentidentity.EdgeDuplicateGroups:
+		m.ResetDuplicateGroups()
+		return nil
+	case contentidentity.EdgeEvents:
+		m.ResetEvents()
+		return nil
+	}
+	return fmt.Errorf("unknown ContentIdentity edge %s", name)
+}
+
+// DuplicateGroupMutation represents an operation that mutates the DuplicateGroup nodes in the graph.
+type DuplicateGroupMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *uuid.UUID
+	create_time             *time.Time
+	update_time             *time.Time
+	hash                    *string
+	status                  *duplicategroup.Status
+	file_count              *int
+	addfile_count           *int
+	total_size_bytes        *int64
+	addtotal_size_bytes     *int64
+	clearedFields           map[string]struct{}
+	tenant                  *uuid.UUID
+	clearedtenant           bool
+	scan                    *uuid.UUID
+	clearedscan             bool
+	keeper_machine          *uuid.UUID
+	clearedkeeper_machine   bool
+	content_identity        *uuid.UUID
+	clearedcontent_identity bool
+	file_instances          map[uuid.UUID]struct{}
+	removedfile_instances   map[uuid.UUID]struct{}
+	clearedfile_instances   bool
+	action_audits           map[uuid.UUID]struct{}
+	removedaction_audits    map[uuid.UUID]struct{}
+	clearedaction_audits    bool
+	action_jobs             map[uuid.UUID]struct{}
+	removedaction_jobs      map[uuid.UUID]struct{}
+	clearedaction_jobs      bool
+	done                    bool
+	oldValue                func(context.Context) (*DuplicateGroup, error)
+	predicates              []predicate.DuplicateGroup
+}
+
+var _ ent.Mutation = (*DuplicateGroupMutation)(nil)
+
+// duplicategroupOption allows management of the mutation configuration using functional options.
+type duplicategroupOption func(*DuplicateGroupMutation)
+
+// newDuplicateGroupMutation creates new mutation for the DuplicateGroup entity.
+func newDuplicateGroupMutation(c config, op Op, opts ...duplicategroupOption) *DuplicateGroupMutation {
+	m := &DuplicateGroupMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDuplicateGroup,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDuplicateGroupID sets the ID field of the mutation.
+func withDuplicateGroupID(id uuid.UUID) duplicategroupOption {
+	return func(m *DuplicateGroupMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DuplicateGroup
+		)
+		m.oldValue = func(ctx context.Context) (*DuplicateGroup, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DuplicateGroup.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDuplicateGroup sets the old DuplicateGroup of the mutation.
+func withDuplicateGroup(node *DuplicateGroup) duplicategroupOption {
+	return func(m *DuplicateGroupMutation) {
+		m.oldValue = func(context.Context) (*DuplicateGroup, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DuplicateGroupMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DuplicateGroupMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of DuplicateGroup entities.
+func (m *DuplicateGroupMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DuplicateGroupMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DuplicateGroupMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DuplicateGroup.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *DuplicateGroupMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *DuplicateGroupMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the DuplicateGroup entity.
+// If the DuplicateGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DuplicateGroupMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *DuplicateGroupMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *DuplicateGroupMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *DuplicateGroupMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the DuplicateGroup entity.
+// If the DuplicateGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DuplicateGroupMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *DuplicateGroupMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *DuplicateGroupMutation) SetTenantID(u uuid.UUID) {
+	m.tenant = &u
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *DuplicateGroupMutation) TenantID() (r uuid.UUID, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the DuplicateGroup entity.
+// If the DuplicateGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DuplicateGroupMutation) OldTenantID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *DuplicateGroupMutation) ResetTenantID() {
+	m.tenant = nil
+}
+
+// SetScanID sets the "scan_id" field.
+func (m *DuplicateGroupMutation) SetScanID(u uuid.UUID) {
+	m.scan = &u
+}
+
+// ScanID returns the value of the "scan_id" field in the mutation.
+func (m *DuplicateGroupMutation) ScanID() (r uuid.UUID, exists bool) {
+	v := m.scan
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScanID returns the old "scan_id" field's value of the DuplicateGroup entity.
+// If the DuplicateGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DuplicateGroupMutation) OldScanID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScanID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScanID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScanID: %w", err)
+	}
+	return oldValue.ScanID, nil
+}
+
+// ResetScanID resets all changes to the "scan_id" field.
+func (m *DuplicateGroupMutation) ResetScanID() {
+	m.scan = nil
+}
+
+// SetKeeperMachineID sets the "keeper_machine_id" field.
+func (m *DuplicateGroupMutation) SetKeeperMachineID(u uuid.UUID) {
+	m.keeper_machine = &u
+}
+
+// KeeperMachineID returns the value of the "keeper_machine_id" field in the mutation.
+func (m *DuplicateGroupMutation) KeeperMachineID() (r uuid.UUID, exists bool) {
+	v := m.keeper_machine
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeeperMachineID returns the old "keeper_machine_id" field's value of the DuplicateGroup entity.
+// If the DuplicateGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DuplicateGroupMutation) OldKeeperMachineID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeeperMachineID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeeperMachineID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeeperMachineID: %w", err)
+	}
+	return oldValue.KeeperMachineID, nil
+}
+
+// ClearKeeperMachineID clears the value of the "keeper_machine_id" field.
+func (m *DuplicateGroupMutation) ClearKeeperMachineID() {
+	m.keeper_machine = nil
+	m.clearedFields[duplicategroup.FieldKeeperMachineID] = struct{}{}
+}
+
+// KeeperMachineIDCleared returns if the "keeper_machine_id" field was cleared in this mutation.
+func (m *DuplicateGroupMutation) KeeperMachineIDCleared() bool {
+	_, ok := m.clearedFields[duplicategroup.FieldKeeperMachineID]
+	return ok
+}
+
+// ResetKeeperMachineID resets all changes to the "keeper_machine_id" field.
+func (m *DuplicateGroupMutation) ResetKeeperMachineID() {
+	m.keeper_machine = nil
+	delete(m.clearedFields, duplicategroup.FieldKeeperMachineID)
+}
+
+// SetContentIdentityID sets the "content_identity_id" field.
+func (m *DuplicateGroupMutation) SetContentIdentityID(u uuid.UUID) {
+	m.content_identity = &u
+}
+
+// ContentIdentityID returns the value of the "content_identity_id" field in the mutation.
+func (m *DuplicateGroupMutation) ContentIdentityID() (r uuid.UUID, exists bool) {
+	v := m.content_identity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContentIdentityID returns the old "content_identity_id" field's value of the DuplicateGroup entity.
+// If the DuplicateGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DuplicateGroupMutation) OldContentIdentityID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContentIdentityID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContentIdentityID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContentIdentityID: %w", err)
+	}
+	return oldValue.ContentIdentityID, nil
+}
+
+// ClearContentIdentityID clears the value of the "content_identity_id" field.
+func (m *DuplicateGroupMutation) ClearContentIdentityID() {
+	m.content_identity = nil
+	m.clearedFields[duplicategroup.FieldContentIdentityID] = struct{}{}
+}
+
+// ContentIdentityIDCleared returns if the "content_identity_id" field was cleared in this mutation.
+func (m *DuplicateGroupMutation) ContentIdentityIDCleared() bool {
+	_, ok := m.clearedFields[duplicategroup.FieldContentIdentityID]
+	return ok
+}
+
+// ResetContentIdentityID resets all changes to the "content_identity_id" field.
+func (m *DuplicateGroupMutation) ResetContentIdentityID() {
+	m.content_identity = nil
+	delete(m.clearedFields, duplicategroup.FieldContentIdentityID)
+}
+
+// SetHash sets the "hash" field.
+func (m *DuplicateGroupMutation) SetHash(s string) {
+	m.hash = &s
+}
+
+// Hash returns the value of the "hash" field in the mutation.
+func (m *DuplicateGroupMutation) Hash() (r string, exists bool) {
+	v := m.hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHash returns the old "hash" field's value of the DuplicateGroup entity.
+// If the DuplicateGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DuplicateGroupMutation) OldHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHash: %w", err)
+	}
+	return oldValue.Hash, nil
+}
+
+// ResetHash resets all changes to the "hash" field.
+func (m *DuplicateGroupMutation) ResetHash() {
+	m.hash = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *DuplicateGroupMutation) SetStatus(d duplicategroup.Status) {
+	m.status = &d
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *DuplicateGroupMutation) Status() (r duplicategroup.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the DuplicateGroup entity.
+// If the DuplicateGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DuplicateGroupMutation) OldStatus(ctx context.Context) (v duplicategroup.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *DuplicateGroupMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetFileCount sets the "file_count" field.
+func (m *DuplicateGroupMutation) SetFileCount(i int) {
+	m.file_count = &i
+	m.addfile_count = nil
+}
+
+// FileCount returns the value of the "file_count" field in the mutation.
+func (m *DuplicateGroupMutation) FileCount() (r int, exists bool) {
+	v := m.file_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFileCount returns the old "file_count" field's value of the DuplicateGroup entity.
+// If the DuplicateGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DuplicateGroupMutation) OldFileCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFileCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFileCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFileCount: %w", err)
+	}
+	return oldValue.FileCount, nil
+}
+
+// AddFileCount adds i to the "file_count" field.
+func (m *DuplicateGroupMutation) AddFileCount(i int) {
+	if m.addfile_count != nil {
+		*m.addfile_count += i
+	} else {
+		m.addfile_count = &i
+	}
+}
+
+// AddedFileCount returns the value that was added to the "file_count" field in this mutation.
+func (m *DuplicateGroupMutation) AddedFileCount() (r int, exists bool) {
+	v := m.addfile_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFileCount resets all changes to the "file_count" field.
+func (m *DuplicateGroupMutation) ResetFileCount() {
+	m.file_count = nil
+	m.addfile_count = nil
+}
+
+// SetTotalSizeBytes sets the "total_size_bytes" field.
+func (m *DuplicateGroupMutation) SetTotalSizeBytes(i int64) {
+	m.total_size_bytes = &i
+	m.addtotal_size_bytes = nil
+}
+
+// TotalSizeBytes returns the value of the "total_size_bytes" field in the mutation.
+func (m *DuplicateGroupMutation) TotalSizeBytes() (r int64, exists bool) {
+	v := m.total_size_bytes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalSizeBytes returns the old "total_size_bytes" field's value of the DuplicateGroup entity.
+// If the DuplicateGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DuplicateGroupMutation) OldTotalSizeBytes(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalSizeBytes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalSizeBytes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalSizeBytes: %w", err)
+	}
+	return oldValue.TotalSizeBytes, nil
+}
+
+// AddTotalSizeBytes adds i to the "total_size_bytes" field.
+func (m *DuplicateGroupMutation) AddTotalSizeBytes(i int64) {
+	if m.addtotal_size_bytes != nil {
+		*m.addtotal_size_bytes += i
+	} else {
+		m.addtotal_size_bytes = &i
+	}
+}
+
+// AddedTotalSizeBytes returns the value that was added to the "total_size_bytes" field in this mutation.
+func (m *DuplicateGroupMutation) AddedTotalSizeBytes() (r int64, exists bool) {
+	v := m.addtotal_size_bytes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotalSizeBytes resets all changes to the "total_size_bytes" field.
+func (m *DuplicateGroupMutation) ResetTotalSizeBytes() {
+	m.total_size_bytes = nil
+	m.addtotal_size_bytes = nil
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (m *DuplicateGroupMutation) ClearTenant() {
+	m.clearedtenant = true
+	m.clearedFields[duplicategroup.FieldTenantID] = struct{}{}
+}
+
+// TenantCleared reports if the "tenant" edge to the Tenant entity was cleared.
+func (m *DuplicateGroupMutation) TenantCleared() bool {
+	return m.clearedtenant
+}
+
+// TenantIDs returns the "tenant" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TenantID instead. It exists only for internal usage by the builders.
+func (m *DuplicateGroupMutation) TenantIDs() (ids []uuid.UUID) {
+	if id := m.tenant; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTenant resets all changes to the "tenant" edge.
+func (m *DuplicateGroupMutation) ResetTenant() {
+	m.tenant = nil
+	m.clearedtenant = false
+}
+
+// ClearScan clears the "scan" edge to the Scan entity.
+func (m *DuplicateGroupMutation) ClearScan() {
+	m.clearedscan = true
+	m.clearedFields[duplicategroup.FieldScanID] = struct{}{}
+}
+
+// ScanCleared reports if the "scan" edge to the Scan entity was cleared.
+func (m *DuplicateGroupMutation) ScanCleared() bool {
+	return m.clearedscan
+}
+
+// ScanIDs returns the "scan" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ScanID instead. It exists only for internal usage by the builders.
+func (m *DuplicateGroupMutation) ScanIDs() (ids []uuid.UUID) {
+	if id := m.scan; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetScan resets all changes to the "scan" edge.
+func (m *DuplicateGroupMutation) ResetScan() {
+	m.scan = nil
+	m.clearedscan = false
+}
+
+// ClearKeeperMachine clears the "keeper_machine" edge to the Machine entity.
+func (m *DuplicateGroupMutation) ClearKeeperMachine() {
+	m.clearedkeeper_machine = true
+	m.clearedFields[duplicategroup.FieldKeeperMachineID] = struct{}{}
+}
+
+// KeeperMachineCleared reports if the "keeper_machine" edge to the Machine entity was cleared.
+func (m *DuplicateGroupMutation) KeeperMachineCleared() bool {
+	return m.KeeperMachineIDCleared() || m.clearedkeeper_machine
+}
+
+// KeeperMachineIDs returns the "keeper_machine" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// KeeperMachineID instead. It exists only for internal usage by the builders.
+func (m *DuplicateGroupMutation) KeeperMachineIDs() (ids []uuid.UUID) {
+	if id := m.keeper_machine; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetKeeperMachine resets all changes to the "keeper_machine" edge.
+func (m *DuplicateGroupMutation) ResetKeeperMachine() {
+	m.keeper_machine = nil
+	m.clearedkeeper_machine = false
+}
+
+// ClearContentIdentity clears the "content_identity" edge to the ContentIdentity entity.
+func (m *DuplicateGroupMutation) ClearContentIdentity() {
+	m.clearedcontent_identity = true
+	m.clearedFields[duplicategroup.FieldContentIdentityID] = struct{}{}
+}
+
+// ContentIdentityCleared reports if the "content_identity" edge to the ContentIdentity entity was cleared.
+func (m *DuplicateGroupMutation) ContentIdentityCleared() bool {
+	return m.ContentIdentityIDCleared() || m.clearedcontent_identity
+}
+
+// ContentIdentityIDs returns the "content_identity" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ContentIdentityID instead. It exists only for internal usage by the builders.
+func (m *DuplicateGroupMutation) ContentIdentityIDs() (ids []uuid.UUID) {
+	if id := m.content_identity; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetContentIdentity resets all changes to the "content_identity" edge.
+func (m *DuplicateGroupMutation) ResetContentIdentity() {
+	m.content_identity = nil
+	m.clearedcontent_identity = false
+}
+
+// AddFileInstanceIDs adds the "file_instances" edge to the FileInstance entity by ids.
+func (m *DuplicateGroupMutation) AddFileInstanceIDs(ids ...uuid.UUID) {
+	if m.file_instances == nil {
+		m.file_instances = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.file_instances[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFileInstances clears the "file_instances" edge to the FileInstance entity.
+func (m *DuplicateGroupMutation) ClearFileInstances() {
+	m.clearedfile_instances = true
+}
+
+// FileInstancesCleared reports if the "file_instances" edge to the FileInstance entity was cleared.
+func (m *DuplicateGroupMutation) FileInstancesCleared() bool {
+	return m.clearedfile_instances
+}
+
+// RemoveFileInstanceIDs removes the "file_instances" edge to the FileInstance entity by IDs.
+func (m *DuplicateGroupMutation) RemoveFileInstanceIDs(ids ...uuid.UUID) {
+	if m.removedfile_instances == nil {
+		m.removedfile_instances = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.file_instances, ids[i])
+		m.removedfile_instances[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFileInstances returns the removed IDs of the "file_instances" edge to the FileInstance entity.
+func (m *DuplicateGroupMutation) RemovedFileInstancesIDs() (ids []uuid.UUID) {
+	for id := range m.removedfile_instances {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FileInstancesIDs returns the "file_instances" edge IDs in the mutation.
+func (m *DuplicateGroupMutation) FileInstancesIDs() (ids []uuid.UUID) {
+	for id := range m.file_instances {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFileInstances resets all changes to the "file_instances" edge.
+func (m *DuplicateGroupMutation) ResetFileInstances() {
+	m.file_instances = nil
+	m.clearedfile_instances = false
+	m.removedfile_instances = nil
+}
+
+// AddActionAuditIDs adds the "action_audits" edge to the ActionAudit entity by ids.
+func (m *DuplicateGroupMutation) AddActionAuditIDs(ids ...uuid.UUID) {
+	if m.action_audits == nil {
+		m.action_audits = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.action_audits[ids[i]] = struct{}{}
+	}
+}
+
+// ClearActionAudits clears the "action_audits" edge to the ActionAudit entity.
+func (m *DuplicateGroupMutation) ClearActionAudits() {
+	m.clearedaction_audits = true
+}
+
+// ActionAuditsCleared reports if the "action_audits" edge to the ActionAudit entity was cleared.
+func (m *DuplicateGroupMutation) ActionAuditsCleared() bool {
+	return m.clearedaction_audits
+}
+
+// RemoveActionAuditIDs removes the "action_audits" edge to the ActionAudit entity by IDs.
+func (m *DuplicateGroupMutation) RemoveActionAuditIDs(ids ...uuid.UUID) {
+	if m.removedaction_audits == nil {
+		m.removedaction_audits = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.action_audits, ids[i])
+		m.removedaction_audits[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedActionAudits returns the removed IDs of the "action_audits" edge to the ActionAudit entity.
+func (m *DuplicateGroupMutation) RemovedActionAuditsIDs() (ids []uuid.UUID) {
+	for id := range m.removedaction_audits {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ActionAuditsIDs returns the "action_audits" edge IDs in the mutation.
+func (m *DuplicateGroupMutation) ActionAuditsIDs() (ids []uuid.UUID) {
+	for id := range m.action_audits {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetActionAudits resets all changes to the "action_audits" edge.
+func (m *DuplicateGroupMutation) ResetActionAudits() {
+	m.action_audits = nil
+	m.clearedaction_audits = false
+	m.removedaction_audits = nil
+}
+
+// AddActionJobIDs adds the "action_jobs" edge to the ActionJob entity by ids.
+func (m *DuplicateGroupMutation) AddActionJobIDs(ids ...uuid.UUID) {
+	if m.action_jobs == nil {
+		m.action_jobs = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.action_jobs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearActionJobs clears the "action_jobs" edge to the ActionJob entity.
+func (m *DuplicateGroupMutation) ClearActionJobs() {
+	m.clearedaction_jobs = true
+}
+
+// ActionJobsCleared reports if the "action_jobs" edge to the ActionJob entity was cleared.
+func (m *DuplicateGroupMutation) ActionJobsCleared() bool {
+	return m.clearedaction_jobs
+}
+
+// RemoveActionJobIDs removes the "action_jobs" edge to the ActionJob entity by IDs.
+func (m *DuplicateGroupMutation) RemoveActionJobIDs(ids ...uuid.UUID) {
+	if m.removedaction_jobs == nil {
+		m.removedaction_jobs = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.action_jobs, ids[i])
+		m.removedaction_jobs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedActionJobs returns the removed IDs of the "action_jobs" edge to the ActionJob entity.
+func (m *DuplicateGroupMutation) RemovedActionJobsIDs() (ids []uuid.UUID) {
+	for id := range m.removedaction_jobs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ActionJobsIDs returns the "action_jobs" edge IDs in the mutation.
+func (m *DuplicateGroupMutation) ActionJobsIDs() (ids []uuid.UUID) {
+	for id := range m.action_jobs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetActionJobs resets all changes to the "action_jobs" edge.
+func (m *DuplicateGroupMutation) ResetActionJobs() {
+	m.action_jobs = nil
+	m.clearedaction_jobs = false
+	m.removedaction_jobs = nil
+}
+
+// Where appends a list predicates to the DuplicateGroupMutation builder.
+func (m *DuplicateGroupMutation) Where(ps ...predicate.DuplicateGroup) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DuplicateGroupMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DuplicateGroupMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DuplicateGroup, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DuplicateGroupMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DuplicateGroupMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DuplicateGroup).
+func (m *DuplicateGroupMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DuplicateGroupMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.create_time != nil {
+		fields = append(fields, duplicategroup.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, duplicategroup.FieldUpdateTime)
+	}
+	if m.tenant != nil {
+		fields = append(fields, duplicategroup.FieldTenantID)
+	}
+	if m.scan != nil {
+		fields = append(fields, duplicategroup.FieldScanID)
+	}
+	if m.keeper_machine != nil {
+		fields = append(fields, duplicategroup.FieldKeeperMachineID)
+	}
+	if m.content_identity != nil {
+		fields = append(fields, duplicategroup.FieldContentIdentityID)
+	}
+	if m.hash != nil {
+		fields = append(fields, duplicategroup.FieldHash)
+	}
+	if m.status != nil {
+		fields = append(fields, duplicategroup.FieldStatus)
+	}
+	if m.file_count != nil {
+		fields = append(fields, duplicategroup.FieldFileCount)
+	}
+	if m.total_size_bytes != nil {
+		fields = append(fields, duplicategroup.FieldTotalSizeBytes)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DuplicateGroupMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case duplicategroup.FieldCreateTime:
+		return m.CreateTime()
+	case duplicategroup.FieldUpdateTime:
+		return m.UpdateTime()
+	case duplicategroup.FieldTenantID:
+		return m.TenantID()
+	case duplicategroup.FieldScanID:
+		return m.ScanID()
+	case duplicategroup.FieldKeeperMachineID:
+		return m.KeeperMachineID()
+	case duplicategroup.FieldContentIdentityID:
+		return m.ContentIdentityID()
+	case duplicategroup.FieldHash:
+		return m.Hash()
+	case duplicategroup.FieldStatus:
+		return m.Status()
+	case duplicategroup.FieldFileCount:
+		return m.FileCount()
+	case duplicategroup.FieldTotalSizeBytes:
+		return m.TotalSizeBytes()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DuplicateGroupMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case duplicategroup.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case duplicategroup.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case duplicategroup.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case duplicategroup.FieldScanID:
+		return m.OldScanID(ctx)
+	case duplicategroup.FieldKeeperMachineID:
+		return m.OldKeeperMachineID(ctx)
+	case duplicategroup.FieldContentIdentityID:
+		return m.OldContentIdentityID(ctx)
+	case duplicategroup.FieldHash:
+		return m.OldHash(ctx)
+	case duplicategroup.FieldStatus:
+		return m.OldStatus(ctx)
+	case duplicategroup.FieldFileCount:
+		return m.OldFileCount(ctx)
+	case duplicategroup.FieldTotalSizeBytes:
+		return m.OldTotalSizeBytes(ctx)
+	}
+	return nil, fmt.Errorf("unknown DuplicateGroup field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DuplicateGroupMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case duplicategroup.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case duplicategroup.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case duplicategroup.FieldTenantID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case duplicategroup.FieldScanID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScanID(v)
+		return nil
+	case duplicategroup.FieldKeeperMachineID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeeperMachineID(v)
+		return nil
+	case duplicategroup.FieldContentIdentityID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentIdentityID(v)
+		return nil
+	case duplicategroup.FieldHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHash(v)
+		return nil
+	case duplicategroup.FieldStatus:
+		v, ok := value.(duplicategroup.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case duplicategroup.FieldFileCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFileCount(v)
+		return nil
+	case duplicategroup.FieldTotalSizeBytes:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalSizeBytes(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DuplicateGroup field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DuplicateGroupMutation) AddedFields() []string {
+	var fields []string
+	if m.addfile_count != nil {
+		fields = append(fields, duplicategroup.FieldFileCount)
+	}
+	if m.addtotal_size_bytes != nil {
+		fields = append(fields, duplicategroup.FieldTotalSizeBytes)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DuplicateGroupMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case duplicategroup.FieldFileCount:
+		return m.AddedFileCount()
+	case duplicategroup.FieldTotalSizeBytes:
+		return m.AddedTotalSizeBytes()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DuplicateGroupMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case duplicategroup.FieldFileCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFileCount(v)
+		return nil
+	case duplicategroup.FieldTotalSizeBytes:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalSizeBytes(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DuplicateGroup numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DuplicateGroupMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(duplicategroup.FieldKeeperMachineID) {
+		fields = append(fields, duplicategroup.FieldKeeperMachineID)
+	}
+	if m.FieldCleared(duplicategroup.FieldContentIdentityID) {
+		fields = append(fields, duplicategroup.FieldContentIdentityID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DuplicateGroupMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DuplicateGroupMutation) ClearField(name string) error {
+	switch name {
+	case duplicategroup.FieldKeeperMachineID:
+		m.ClearKeeperMachineID()
+		return nil
+	case duplicategroup.FieldContentIdentityID:
+		m.ClearContentIdentityID()
+		return nil
+	}
+	return fmt.Errorf("unknown DuplicateGroup nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DuplicateGroupMutation) ResetField(name string) error {
+	switch name {
+	case duplicategroup.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case duplicategroup.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case duplicategroup.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case duplicategroup.FieldScanID:
+		m.ResetScanID()
+		return nil
+	case duplicategroup.FieldKeeperMachineID:
+		m.ResetKeeperMachineID()
+		return nil
+	case duplicategroup.FieldContentIdentityID:
+		m.ResetContentIdentityID()
+		return nil
+	case duplicategroup.FieldHash:
+		m.ResetHash()
+		return nil
+	case duplicategroup.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case duplicategroup.FieldFileCount:
+		m.ResetFileCount()
+		return nil
+	case duplicategroup.FieldTotalSizeBytes:
+		m.ResetTotalSizeBytes()
+		return nil
+	}
+	return fmt.Errorf("unknown DuplicateGroup field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DuplicateGroupMutation) AddedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.tenant != nil {
+		edges = append(edges, duplicategroup.EdgeTenant)
+	}
+	if m.scan != nil {
+		edges = append(edges, duplicategroup.EdgeScan)
+	}
+	if m.keeper_machine != nil {
+		edges = append(edges, duplicategroup.EdgeKeeperMachine)
+	}
+	if m.content_identity != nil {
+		edges = append(edges, duplicategroup.EdgeContentIdentity)
+	}
+	if m.file_instances != nil {
+		edges = append(edges, duplicategroup.EdgeFileInstances)
+	}
+	if m.action_audits != nil {
+		edges = append(edges, duplicategroup.EdgeActionAudits)
+	}
+	if m.action_jobs != nil {
+		edges = append(edges, duplicategroup.EdgeActionJobs)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DuplicateGroupMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case duplicategroup.EdgeTenant:
+		if id := m.tenant; id != nil {
+			return []ent.Value{*id}
+		}
+	case duplicategroup.EdgeScan:
+		if id := m.scan; id != nil {
+			return []ent.Value{*id}
+		}
+	case duplicategroup.EdgeKeeperMachine:
+		if id := m.keeper_machine; id != nil {
+			return []ent.Value{*id}
+		}
+	case duplicategroup.EdgeContentIdentity:
+		if id := m.content_identity; id != nil {
+			return []ent.Value{*id}
+		}
+	case duplicategroup.EdgeFileInstances:
+		ids := make([]ent.Value, 0, len(m.file_instances))
+		for id := range m.file_instances {
+			ids = append(ids, id)
+		}
+		return ids
+	case duplicategroup.EdgeActionAudits:
+		ids := make([]ent.Value, 0, len(m.action_audits))
+		for id := range m.action_audits {
+			ids = append(ids, id)
+		}
+		return ids
+	case duplicategroup.EdgeActionJobs:
+		ids := make([]ent.Value, 0, len(m.action_jobs))
+		for id := range m.action_jobs {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DuplicateGroupMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.removedfile_instances != nil {
+		edges = append(edges, duplicategroup.EdgeFileInstances)
+	}
+	if m.removedaction_audits != nil {
+		edges = append(edges, duplicategroup.EdgeActionAudits)
+	}
+	if m.removedaction_jobs != nil {
+		edges = append(edges, duplicategroup.EdgeActionJobs)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DuplicateGroupMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case duplicategroup.EdgeFileInstances:
+		ids := make([]ent.Value, 0, len(m.removedfile_instances))
+		for id := range m.removedfile_instances {
+			ids = append(ids, id)
+		}
+		return ids
+	case duplicategroup.EdgeActionAudits:
+		ids := make([]ent.Value, 0, len(m.removedaction_audits))
+		for id := range m.removedaction_audits {
+			ids = append(ids, id)
+		}
+		return ids
+	case duplicategroup.EdgeActionJobs:
+		ids := make([]ent.Value, 0, len(m.removedaction_jobs))
+		for id := range m.removedaction_jobs {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DuplicateGroupMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.clearedtenant {
+		edges = append(edges, duplicategroup.EdgeTenant)
+	}
+	if m.clearedscan {
+		edges = append(edges, duplicategroup.EdgeScan)
+	}
+	if m.clearedkeeper_machine {
+		edges = append(edges, duplicategroup.EdgeKeeperMachine)
+	}
+	if m.clearedcontent_identity {
+		edges = append(edges, duplicategroup.EdgeContentIdentity)
+	}
+	if m.clearedfile_instances {
+		edges = append(edges, duplicategroup.EdgeFileInstances)
+	}
+	if m.clearedaction_audits {
+		edges = append(edges, duplicategroup.EdgeActionAudits)
+	}
+	if m.clearedaction_jobs {
+		edges = append(edges, duplicategroup.EdgeActionJobs)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DuplicateGroupMutation) EdgeCleared(name string) bool {
+	switch name {
+	case duplicategroup.EdgeTenant:
+		return m.clearedtenant
+	case duplicategroup.EdgeScan:
+		return m.clearedscan
+	case duplicategroup.EdgeKeeperMachine:
+		return m.clearedkeeper_machine
+	case duplicategroup.EdgeContentIdentity:
+		return m.clearedcontent_identity
+	case duplicategroup.EdgeFileInstances:
+		return m.clearedfile_instances
+	case duplicategroup.EdgeActionAudits:
+		return m.clearedaction_audits
+	case duplicategroup.EdgeActionJobs:
+		return m.clearedaction_jobs
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DuplicateGroupMutation) ClearEdge(name string) error {
+	switch name {
+	case duplicategroup.EdgeTenant:
+		m.ClearTenant()
+		return nil
+	case duplicategroup.EdgeScan:
+		m.ClearScan()
+		return nil
+	case duplicategroup.EdgeKeeperMachine:
+		m.ClearKeeperMachine()
+		return nil
+	case duplicategroup.EdgeContentIdentity:
+		m.ClearContentIdentity()
+		return nil
+	}
+	return fmt.Errorf("unknown DuplicateGroup unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DuplicateGroupMutation) ResetEdge(name string) error {
+	switch name {
+	case duplicategroup.EdgeTenant:
+		m.ResetTenant()
+		return nil
+	case duplicategroup.EdgeScan:
+		m.ResetScan()
+		return nil
+	case duplicategroup.EdgeKeeperMachine:
+		m.ResetKeeperMachine()
+		return nil
+	case duplicategroup.EdgeContentIdentity:
+		m.ResetContentIdentity()
+		return nil
+	case duplicategroup.EdgeFileInstances:
+		m.ResetFileInstances()
+		return nil
+	case duplicategroup.EdgeActionAudits:
+		m.ResetActionAudits()
+		return nil
+	case duplicategroup.EdgeActionJobs:
+		m.ResetActionJobs()
+		return nil
+	}
+	return fmt.Errorf("unknown DuplicateGroup edge %s", name)
+}
+
+// FileInstanceMutation represents an operation that mutates the FileInstance nodes in the graph.
+type FileInstanceMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *uuid.UUID
+	create_time            *time.Time
+	update_time            *time.Time
+	_path                  *string
+	size_bytes             *int64
+	addsize_bytes          *int64
+	checksum               *string
+	last_seen_at           *time.Time
+	quarantined            *bool
+	clearedFields          map[string]struct{}
+	tenant                 *uuid.UUID
+	clearedtenant          bool
+	duplicate_group        *uuid.UUID
+	clearedduplicate_group bool
+	machine                *uuid.UUID
+	clearedmachine         bool
+	done                   bool
+	oldValue               func(context.Context) (*FileInstance, error)
+	predicates             []predicate.FileInstance
+}
+
+var _ ent.Mutation = (*FileInstanceMutation)(nil)
+
+// fileinstanceOption allows management of the mutation configuration using functional options.
+type fileinstanceOption func(*FileInstanceMutation)
+
+// newFileInstanceMutation creates new mutation for the FileInstance entity.
+func newFileInstanceMutation(c config, op Op, opts ...fileinstanceOption) *FileInstanceMutation {
+	m := &FileInstanceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFileInstance,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withFileInstanceID sets the ID field of the mutation.
+func withFileInstanceID(id uuid.UUID) fileinstanceOption {
+	return func(m *FileInstanceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *FileInstance
+		)
+		m.oldValue = func(ctx context.Context) (*FileInstance, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().FileInstance.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withFileInstance sets the old FileInstance of the mutation.
+func withFileInstance(node *FileInstance) fileinstanceOption {
+	return func(m *FileInstanceMutation) {
+		m.oldValue = func(context.Context) (*FileInstance, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FileInstanceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FileInstanceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of FileInstance entities.
+func (m *FileInstanceMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FileInstanceMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FileInstanceMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().FileInstance.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *FileInstanceMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *FileInstanceMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the FileInstance entity.
+// If the FileInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileInstanceMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *FileInstanceMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *FileInstanceMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *FileInstanceMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the FileInstance entity.
+// If the FileInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileInstanceMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *FileInstanceMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *FileInstanceMutation) SetTenantID(u uuid.UUID) {
+	m.tenant = &u
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *FileInstanceMutation) TenantID() (r uuid.UUID, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the FileInstance entity.
+// If the FileInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileInstanceMutation) OldTenantID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *FileInstanceMutation) ResetTenantID() {
+	m.tenant = nil
+}
+
+// SetDuplicateGroupID sets the "duplicate_group_id" field.
+func (m *FileInstanceMutation) SetDuplicateGroupID(u uuid.UUID) {
+	m.duplicate_group = &u
+}
+
+// DuplicateGroupID returns the value of the "duplicate_group_id" field in the mutation.
+func (m *FileInstanceMutation) DuplicateGroupID() (r uuid.UUID, exists bool) {
+	v := m.duplicate_group
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDuplicateGroupID returns the old "duplicate_group_id" field's value of the FileInstance entity.
+// If the FileInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileInstanceMutation) OldDuplicateGroupID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDuplicateGroupID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDuplicateGroupID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDuplicateGroupID: %w", err)
+	}
+	return oldValue.DuplicateGroupID, nil
+}
+
+// ResetDuplicateGroupID resets all changes to the "duplicate_group_id" field.
+func (m *FileInstanceMutation) ResetDuplicateGroupID() {
+	m.duplicate_group = nil
+}
+
+// SetMachineID sets the "machine_id" field.
+func (m *FileInstanceMutation) SetMachineID(u uuid.UUID) {
+	m.machine = &u
+}
+
+// MachineID returns the value of the "machine_id" field in the mutation.
+func (m *FileInstanceMutation) MachineID() (r uuid.UUID, exists bool) {
+	v := m.machine
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMachineID returns the old "machine_id" field's value of the FileInstance entity.
+// If the FileInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileInstanceMutation) OldMachineID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMachineID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMachineID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMachineID: %w", err)
+	}
+	return oldValue.MachineID, nil
+}
+
+// ResetMachineID resets all changes to the "machine_id" field.
+func (m *FileInstanceMutation) ResetMachineID() {
+	m.machine = nil
+}
+
+// SetPath sets the "path" field.
+func (m *FileInstanceMutation) SetPath(s string) {
+	m._path = &s
+}
+
+// Path returns the value of the "path" field in the mutation.
+func (m *FileInstanceMutation) Path() (r string, exists bool) {
+	v := m._path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPath returns the old "path" field's value of the FileInstance entity.
+// If the FileInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileInstanceMutation) OldPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
+	}
+	return oldValue.Path, nil
+}
+
+// ResetPath resets all changes to the "path" field.
+func (m *FileInstanceMutation) ResetPath() {
+	m._path = nil
+}
+
+// SetSizeBytes sets the "size_bytes" field.
+func (m *FileInstanceMutation) SetSizeBytes(i int64) {
+	m.size_bytes = &i
+	m.addsize_bytes = nil
+}
+
+// SizeBytes returns the value of the "size_bytes" field in the mutation.
+func (m *FileInstanceMutation) SizeBytes() (r int64, exists bool) {
+	v := m.size_bytes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSizeBytes returns the old "size_bytes" field's value of the FileInstance entity.
+// If the FileInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileInstanceMutation) OldSizeBytes(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSizeBytes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSizeBytes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSizeBytes: %w", err)
+	}
+	return oldValue.SizeBytes, nil
+}
+
+// AddSizeBytes adds i to the "size_bytes" field.
+func (m *FileInstanceMutation) AddSizeBytes(i int64) {
+	if m.addsize_bytes != nil {
+		*m.addsize_bytes += i
+	} else {
+		m.addsize_bytes = &i
+	}
+}
+
+// AddedSizeBytes returns the value that was added to the "size_bytes" field in this mutation.
+func (m *FileInstanceMutation) AddedSizeBytes() (r int64, exists bool) {
+	v := m.addsize_bytes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSizeBytes resets all changes to the "size_bytes" field.
+func (m *FileInstanceMutation) ResetSizeBytes() {
+	m.size_bytes = nil
+	m.addsize_bytes = nil
+}
+
+// SetChecksum sets the "checksum" field.
+func (m *FileInstanceMutation) SetChecksum(s string) {
+	m.checksum = &s
+}
+
+// Checksum returns the value of the "checksum" field in the mutation.
+func (m *FileInstanceMutation) Checksum() (r string, exists bool) {
+	v := m.checksum
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChecksum returns the old "checksum" field's value of the FileInstance entity.
+// If the FileInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileInstanceMutation) OldChecksum(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChecksum is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChecksum requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChecksum: %w", err)
+	}
+	return oldValue.Checksum, nil
+}
+
+// ResetChecksum resets all changes to the "checksum" field.
+func (m *FileInstanceMutation) ResetChecksum() {
+	m.checksum = nil
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (m *FileInstanceMutation) SetLastSeenAt(t time.Time) {
+	m.last_seen_at = &t
+}
+
+// LastSeenAt returns the value of the "last_seen_at" field in the mutation.
+func (m *FileInstanceMutation) LastSeenAt() (r time.Time, exists bool) {
+	v := m.last_seen_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastSeenAt returns the old "last_seen_at" field's value of the FileInstance entity.
+// If the FileInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileInstanceMutation) OldLastSeenAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastSeenAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastSeenAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastSeenAt: %w", err)
+	}
+	return oldValue.LastSeenAt, nil
+}
+
+// ResetLastSeenAt resets all changes to the "last_seen_at" field.
+func (m *FileInstanceMutation) ResetLastSeenAt() {
+	m.last_seen_at = nil
+}
+
+// SetQuarantined sets the "quarantined" field.
+func (m *FileInstanceMutation) SetQuarantined(b bool) {
+	m.quarantined = &b
+}
+
+// Quarantined returns the value of the "quarantined" field in the mutation.
+func (m *FileInstanceMutation) Quarantined() (r bool, exists bool) {
+	v := m.quarantined
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuarantined returns the old "quarantined" field's value of the FileInstance entity.
+// If the FileInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileInstanceMutation) OldQuarantined(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuarantined is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuarantined requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuarantined: %w", err)
+	}
+	return oldValue.Quarantined, nil
+}
+
+// ResetQuarantined resets all changes to the "quarantined" field.
+func (m *FileInstanceMutation) ResetQuarantined() {
+	m.quarantined = nil
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (m *FileInstanceMutation) ClearTenant() {
+	m.clearedtenant = true
+	m.clearedFields[fileinstance.FieldTenantID] = struct{}{}
+}
+
+// TenantCleared reports if the "tenant" edge to the Tenant entity was cleared.
+func (m *FileInstanceMutation) TenantCleared() bool {
+	return m.clearedtenant
+}
+
+// TenantIDs returns the "tenant" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TenantID instead. It exists only for internal usage by the builders.
+func (m *FileInstanceMutation) TenantIDs() (ids []uuid.UUID) {
+	if id := m.tenant; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTenant resets all changes to the "tenant" edge.
+func (m *FileInstanceMutation) ResetTenant() {
+	m.tenant = nil
+	m.clearedtenant = false
+}
+
+// ClearDuplicateGroup clears the "duplicate_group" edge to the DuplicateGroup entity.
+func (m *FileInstanceMutation) ClearDuplicateGroup() {
+	m.clearedduplicate_group = true
+	m.clearedFields[fileinstance.FieldDuplicateGroupID] = struct{}{}
+}
+
+// DuplicateGroupCleared reports if the "duplicate_group" edge to the DuplicateGroup entity was cleared.
+func (m *FileInstanceMutation) DuplicateGroupCleared() bool {
+	return m.clearedduplicate_group
+}
+
+// DuplicateGroupIDs returns the "duplicate_group" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DuplicateGroupID instead. It exists only for internal usage by the builders.
+func (m *FileInstanceMutation) DuplicateGroupIDs() (ids []uuid.UUID) {
+	if id := m.duplicate_group; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDuplicateGroup resets all changes to the "duplicate_group" edge.
+func (m *FileInstanceMutation) ResetDuplicateGroup() {
+	m.duplicate_group = nil
+	m.clearedduplicate_group = false
+}
+
+// ClearMachine clears the "machine" edge to the Machine entity.
+func (m *FileInstanceMutation) ClearMachine() {
+	m.clearedmachine = true
+	m.clearedFields[fileinstance.FieldMachineID] = struct{}{}
+}
+
+// MachineCleared reports if the "machine" edge to the Machine entity was cleared.
+func (m *FileInstanceMutation) MachineCleared() bool {
+	return m.clearedmachine
+}
+
+// MachineIDs returns the "machine" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MachineID instead. It exists only for internal usage by the builders.
+func (m *FileInstanceMutation) MachineIDs() (ids []uuid.UUID) {
+	if id := m.machine; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMachine resets all changes to the "machine" edge.
+func (m *FileInstanceMutation) ResetMachine() {
+	m.machine = nil
+	m.clearedmachine = false
+}
+
+// Where appends a list predicates to the FileInstanceMutation builder.
+func (m *FileInstanceMutation) Where(ps ...predicate.FileInstance) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FileInstanceMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FileInstanceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.FileInstance, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FileInstanceMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FileInstanceMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (FileInstance).
+func (m *FileInstanceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FileInstanceMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.create_time != nil {
+		fields = append(fields, fileinstance.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, fileinstance.FieldUpdateTime)
+	}
+	if m.tenant != nil {
+		fields = append(fields, fileinstance.FieldTenantID)
+	}
+	if m.duplicate_group != nil {
+		fields = append(fields, fileinstance.FieldDuplicateGroupID)
+	}
+	if m.machine != nil {
+		fields = append(fields, fileinstance.FieldMachineID)
+	}
+	if m._path != nil {
+		fields = append(fields, fileinstance.FieldPath)
+	}
+	if m.size_bytes != nil {
+		fields = append(fields, fileinstance.FieldSizeBytes)
+	}
+	if m.checksum != nil {
+		fields = append(fields, fileinstance.FieldChecksum)
+	}
+	if m.last_seen_at != nil {
+		fields = append(fields, fileinstance.FieldLastSeenAt)
+	}
+	if m.quarantined != nil {
+		fields = append(fields, fileinstance.FieldQuarantined)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FileInstanceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case fileinstance.FieldCreateTime:
+		return m.CreateTime()
+	case fileinstance.FieldUpdateTime:
+		return m.UpdateTime()
+	case fileinstance.FieldTenantID:
+		return m.TenantID()
+	case fileinstance.FieldDuplicateGroupID:
+		return m.DuplicateGroupID()
+	case fileinstance.FieldMachineID:
+		return m.MachineID()
+	case fileinstance.FieldPath:
+		return m.Path()
+	case fileinstance.FieldSizeBytes:
+		return m.SizeBytes()
+	case fileinstance.FieldChecksum:
+		return m.Checksum()
+	case fileinstance.FieldLastSeenAt:
+		return m.LastSeenAt()
+	case fileinstance.FieldQuarantined:
+		return m.Quarantined()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FileInstanceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case fileinstance.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case fileinstance.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case fileinstance.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case fileinstance.FieldDuplicateGroupID:
+		return m.OldDuplicateGroupID(ctx)
+	case fileinstance.FieldMachineID:
+		return m.OldMachineID(ctx)
+	case fileinstance.FieldPath:
+		return m.OldPath(ctx)
+	case fileinstance.FieldSizeBytes:
+		return m.OldSizeBytes(ctx)
+	case fileinstance.FieldChecksum:
+		return m.OldChecksum(ctx)
+	case fileinstance.FieldLastSeenAt:
+		return m.OldLastSeenAt(ctx)
+	case fileinstance.FieldQuarantined:
+		return m.OldQuarantined(ctx)
+	}
+	return nil, fmt.Errorf("unknown FileInstance field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FileInstanceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case fileinstance.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case fileinstance.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case fileinstance.FieldTenantID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case fileinstance.FieldDuplicateGroupID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDuplicateGroupID(v)
+		return nil
+	case fileinstance.FieldMachineID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMachineID(v)
+		return nil
+	case fileinstance.FieldPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPath(v)
+		return nil
+	case fileinstance.FieldSizeBytes:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSizeBytes(v)
+		return nil
+	case fileinstance.FieldChecksum:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChecksum(v)
+		return nil
+	case fileinstance.FieldLastSeenAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastSeenAt(v)
+		return nil
+	case fileinstance.FieldQuarantined:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuarantined(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FileInstance field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FileInstanceMutation) AddedFields() []string {
+	var fields []string
+	if m.addsize_bytes != nil {
+		fields = append(fields, fileinstance.FieldSizeBytes)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FileInstanceMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case fileinstance.FieldSizeBytes:
+		return m.AddedSizeBytes()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FileInstanceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case fileinstance.FieldSizeBytes:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSizeBytes(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FileInstance numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FileInstanceMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FileInstanceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FileInstanceMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown FileInstance nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FileInstanceMutation) ResetField(name string) error {
+	switch name {
+	case fileinstance.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case fileinstance.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case fileinstance.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case fileinstance.FieldDuplicateGroupID:
+		m.ResetDuplicateGroupID()
+		return nil
+	case fileinstance.FieldMachineID:
+		m.ResetMachineID()
+		return nil
+	case fileinstance.FieldPath:
+		m.ResetPath()
+		return nil
+	case fileinstance.FieldSizeBytes:
+		m.ResetSizeBytes()
+		return nil
+	case fileinstance.FieldChecksum:
+		m.ResetChecksum()
+		return nil
+	case fileinstance.FieldLastSeenAt:
+		m.ResetLastSeenAt()
+		return nil
+	case fileinstance.FieldQuarantined:
+		m.ResetQuarantined()
+		return nil
+	}
+	return fmt.Errorf("unknown FileInstance field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FileInstanceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.tenant != nil {
+		edges = append(edges, fileinstance.EdgeTenant)
+	}
+	if m.duplicate_group != nil {
+		edges = append(edges, fileinstance.EdgeDuplicateGroup)
+	}
+	if m.machine != nil {
+		edges = append(edges, fileinstance.EdgeMachine)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FileInstanceMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case fileinstance.EdgeTenant:
+		if id := m.tenant; id != nil {
+			return []ent.Value{*id}
+		}
+	case fileinstance.EdgeDuplicateGroup:
+		if id := m.duplicate_group; id != nil {
+			return []ent.Value{*id}
+		}
+	case fileinstance.EdgeMachine:
+		if id := m.machine; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FileInstanceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FileInstanceMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FileInstanceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedtenant {
+		edges = append(edges, fileinstance.EdgeTenant)
+	}
+	if m.clearedduplicate_group {
+		edges = append(edges, fileinstance.EdgeDuplicateGroup)
+	}
+	if m.clearedmachine {
+		edges = append(edges, fileinstance.EdgeMachine)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FileInstanceMutation) EdgeCleared(name string) bool {
+	switch name {
+	case fileinstance.EdgeTenant:
+		return m.clearedtenant
+	case fileinstance.EdgeDuplicateGroup:
+		return m.clearedduplicate_group
+	case fileinstance.EdgeMachine:
+		return m.clearedmachine
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FileInstanceMutation) ClearEdge(name string) error {
+	switch name {
+	case fileinstance.EdgeTenant:
+		m.ClearTenant()
+		return nil
+	case fileinstance.EdgeDuplicateGroup:
+		m.ClearDuplicateGroup()
+		return nil
+	case fileinstance.EdgeMachine:
+		m.ClearMachine()
+		return nil
+	}
+	return fmt.Errorf("unknown FileInstance unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FileInstanceMutation) ResetEdge(name string) error {
+	switch name {
+	case fileinstance.EdgeTenant:
+		m.ResetTenant()
+		return nil
+	case fileinstance.EdgeDuplicateGroup:
+		m.ResetDuplicateGroup()
+		return nil
+	case fileinstance.EdgeMachine:
+		m.ResetMachine()
+		return nil
+	}
+	return fmt.Errorf("unknown FileInstance edge %s", name)
+}
+
+// MachineMutation represents an operation that mutates the Machine nodes in the graph.
+type MachineMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *uuid.UUID
+	create_time            *time.Time
+	update_time            *time.Time
+	name                   *string
+	category               *machine.Category
+	hostname               *string
+	role                   *string
+	last_scan_at           *time.Time
+	last_seen_at           *time.Time
+	archived_at            *time.Time
+	clearedFields          map[string]struct{}
+	tenant                 *uuid.UUID
+	clearedtenant          bool
+	keeper_groups          map[uuid.UUID]struct{}
+	removedkeeper_groups   map[uuid.UUID]struct{}
+	clearedkeeper_groups   bool
+	file_instances         map[uuid.UUID]struct{}
+	removedfile_instances  map[uuid.UUID]struct{}
+	clearedfile_instances  bool
+	initiated_scans        map[uuid.UUID]struct{}
+	removedinitiated_scans map[uuid.UUID]struct{}
+	clearedinitiated_scans bool
+	kept_identities        map[uuid.UUID]struct{}
+	removedkept_identities map[uuid.UUID]struct{}
+	clearedkept_identities bool
+	content_events         map[uuid.UUID]struct{}
+	removedcontent_events  map[uuid.UUID]struct{}
+	clearedcontent_events  bool
+	scan_targets           map[uuid.UUID]struct{}
+	removedscan_targets    map[uuid.UUID]struct{}
+	clearedscan_targets    bool
+	secrets                map[uuid.UUID]struct{}
+	removedsecrets         map[uuid.UUID]struct{}
+	clearedsecrets         bool
+	action_jobs            map[uuid.UUID]struct{}
+	removedaction_jobs     map[uuid.UUID]struct{}
+	clearedaction_jobs     bool
+	done                   bool
+	oldValue               func(context.Context) (*Machine, error)
+	predicates             []predicate.Machine
+}
+
+var _ ent.Mutation = (*MachineMutation)(nil)
+
+// machineOption allows management of the mutation configuration using functional options.
+type machineOption func(*MachineMutation)
+
+// newMachineMutation creates new mutation for the Machine entity.
+func newMachineMutation(c config, op Op, opts ...machineOption) *MachineMutation {
+	m := &MachineMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMachine,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMachineID sets the ID field of the mutation.
+func withMachineID(id uuid.UUID) machineOption {
+	return func(m *MachineMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Machine
+		)
+		m.oldValue = func(ctx context.Context) (*Machine, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Machine.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMachine sets the old Machine of the mutation.
+func withMachine(node *Machine) machineOption {
+	return func(m *MachineMutation) {
+		m.oldValue = func(context.Context) (*Machine, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MachineMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MachineMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Machine entities.
+func (m *MachineMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MachineMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MachineMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Machine.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *MachineMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *MachineMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Machine entity.
+// If the Machine object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MachineMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *MachineMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *MachineMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *MachineMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Machine entity.
+// If the Machine object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MachineMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *MachineMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *MachineMutation) SetTenantID(u uuid.UUID) {
+	m.tenant = &u
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *MachineMutation) TenantID() (r uuid.UUID, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the Machine entity.
+// If the Machine object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MachineMutation) OldTenantID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *MachineMutation) ResetTenantID() {
+	m.tenant = nil
+}
+
+// SetName sets the "name" field.
+func (m *MachineMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *MachineMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Machine entity.
+// If the Machine object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MachineMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *MachineMutation) ResetName() {
+	m.name = nil
+}
+
+// SetCategory sets the "category" field.
+func (m *MachineMutation) SetCategory(value machine.Category) {
+	m.category = &value
+}
+
+// Category returns the value of the "category" field in the mutation.
+func (m *MachineMutation) Category() (r machine.Category, exists bool) {
+	v := m.category
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCategory returns the old "category" field's value of the Machine entity.
+// If the Machine object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MachineMutation) OldCategory(ctx context.Context) (v machine.Category, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCategory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
+	}
+	return oldValue.Category, nil
+}
+
+// ResetCategory resets all changes to the "category" field.
+func (m *MachineMutation) ResetCategory() {
+	m.category = nil
+}
+
+// SetHostname sets the "hostname" field.
+func (m *MachineMutation) SetHostname(s string) {
+	m.hostname = &s
+}
+
+// Hostname returns the value of the "hostname" field in the mutation.
+func (m *MachineMutation) Hostname() (r string, exists bool) {
+	v := m.hostname
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHostname returns the old "hostname" field's value of the Machine entity.
+// If the Machine object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MachineMutation) OldHostname(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHostname is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHostname requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHostname: %w", err)
+	}
+	return oldValue.Hostname, nil
+}
+
+// ClearHostname clears the value of the "hostname" field.
+func (m *MachineMutation) ClearHostname() {
+	m.hostname = nil
+	m.clearedFields[machine.FieldHostname] = struct{}{}
+}
+
+// HostnameCleared returns if the "hostname" field was cleared in this mutation.
+func (m *MachineMutation) HostnameCleared() bool {
+	_, ok := m.clearedFields[machine.FieldHostname]
+	return ok
+}
+
+// ResetHostname resets all changes to the "hostname" field.
+func (m *MachineMutation) ResetHostname() {
+	m.hostname = nil
+	delete(m.clearedFields, machine.FieldHostname)
+}
+
+// SetRole sets the "role" field.
+func (m *MachineMutation) SetRole(s string) {
+	m.role = &s
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *MachineMutation) Role() (r string, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRole returns the old "role" field's value of the Machine entity.
+// If the Machine object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MachineMutation) OldRole(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	}
+	return oldValue.Role, nil
+}
+
+// ClearRole clears the value of the "role" field.
+func (m *MachineMutation) ClearRole() {
+	m.role = nil
+	m.clearedFields[machine.FieldRole] = struct{}{}
+}
+
+// RoleCleared returns if the "role" field was cleared in this mutation.
+func (m *MachineMutation) RoleCleared() bool {
+	_, ok := m.clearedFields[machine.FieldRole]
+	return ok
+}
+
+// ResetRole resets all changes to the "role" field.
+func (m *MachineMutation) ResetRole() {
+	m.role = nil
+	delete(m.clearedFields, machine.FieldRole)
+}
+
+// SetLastScanAt sets the "last_scan_at" field.
+func (m *MachineMutation) SetLastScanAt(t time.Time) {
+	m.last_scan_at = &t
+}
+
+// LastScanAt returns the value of the "last_scan_at" field in the mutation.
+func (m *MachineMutation) LastScanAt() (r time.Time, exists bool) {
+	v := m.last_scan_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastScanAt returns the old "last_scan_at" field's value of the Machine entity.
+// If the Machine object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MachineMutation) OldLastScanAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastScanAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastScanAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastScanAt: %w", err)
 	}
 	return oldValue.LastScanAt, nil
 }
 
-// ClearLastScanAt clears the value of the "last_scan_at" field.
-func (m *MachineMutation) ClearLastScanAt() {
-	m.last_scan_at = nil
-	m.clearedFields[machine.FieldLastScanAt] = struct{}{}
+// ClearLastScanAt clears the value of the "last_scan_at" field.
+func (m *MachineMutation) ClearLastScanAt() {
+	m.last_scan_at = nil
+	m.clearedFields[machine.FieldLastScanAt] = struct{}{}
+}
+
+// LastScanAtCleared returns if the "last_scan_at" field was cleared in this mutation.
+func (m *MachineMutation) LastScanAtCleared() bool {
+	_, ok := m.clearedFields[machine.FieldLastScanAt]
+	return ok
+}
+
+// ResetLastScanAt resets all changes to the "last_scan_at" field.
+func (m *MachineMutation) ResetLastScanAt() {
+	m.last_scan_at = nil
+	delete(m.clearedFields, machine.FieldLastScanAt)
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (m *MachineMutation) SetLastSeenAt(t time.Time) {
+	m.last_seen_at = &t
+}
+
+// LastSeenAt returns the value of the "last_seen_at" field in the mutation.
+func (m *MachineMutation) LastSeenAt() (r time.Time, exists bool) {
+	v := m.last_seen_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastSeenAt returns the old "last_seen_at" field's value of the Machine entity.
+// If the Machine object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MachineMutation) OldLastSeenAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastSeenAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastSeenAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastSeenAt: %w", err)
+	}
+	return oldValue.LastSeenAt, nil
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (m *MachineMutation) ClearLastSeenAt() {
+	m.last_seen_at = nil
+	m.clearedFields[machine.FieldLastSeenAt] = struct{}{}
+}
+
+// LastSeenAtCleared returns if the "last_seen_at" field was cleared in this mutation.
+func (m *MachineMutation) LastSeenAtCleared() bool {
+	_, ok := m.clearedFields[machine.FieldLastSeenAt]
+	return ok
+}
+
+// ResetLastSeenAt resets all changes to the "last_seen_at" field.
+func (m *MachineMutation) ResetLastSeenAt() {
+	m.last_seen_at = nil
+	delete(m.clearedFields, machine.FieldLastSeenAt)
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (m *MachineMutation) SetArchivedAt(t time.Time) {
+	m.archived_at = &t
+}
+
+// ArchivedAt returns the value of the "archived_at" field in the mutation.
+func (m *MachineMutation) ArchivedAt() (r time.Time, exists bool) {
+	v := m.archived_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArchivedAt returns the old "archived_at" field's value of the Machine entity.
+// If the Machine object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MachineMutation) OldArchivedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArchivedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArchivedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArchivedAt: %w", err)
+	}
+	return oldValue.ArchivedAt, nil
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (m *MachineMutation) ClearArchivedAt() {
+	m.archived_at = nil
+	m.clearedFields[machine.FieldArchivedAt] = struct{}{}
+}
+
+// ArchivedAtCleared returns if the "archived_at" field was cleared in this mutation.
+func (m *MachineMutation) ArchivedAtCleared() bool {
+	_, ok := m.clearedFields[machine.FieldArchivedAt]
+	return ok
+}
+
+// ResetArchivedAt resets all changes to the "archived_at" field.
+func (m *MachineMutation) ResetArchivedAt() {
+	m.archived_at = nil
+	delete(m.clearedFields, machine.FieldArchivedAt)
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (m *MachineMutation) ClearTenant() {
+	m.clearedtenant = true
+	m.clearedFields[machine.FieldTenantID] = struct{}{}
+}
+
+// TenantCleared reports if the "tenant" edge to the Tenant entity was cleared.
+func (m *MachineMutation) TenantCleared() bool {
+	return m.clearedtenant
+}
+
+// TenantIDs returns the "tenant" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TenantID instead. It exists only for internal usage by the builders.
+func (m *MachineMutation) TenantIDs() (ids []uuid.UUID) {
+	if id := m.tenant; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTenant resets all changes to the "tenant" edge.
+func (m *MachineMutation) ResetTenant() {
+	m.tenant = nil
+	m.clearedtenant = false
+}
+
+// AddKeeperGroupIDs adds the "keeper_groups" edge to the DuplicateGroup entity by ids.
+func (m *MachineMutation) AddKeeperGroupIDs(ids ...uuid.UUID) {
+	if m.keeper_groups == nil {
+		m.keeper_groups = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.keeper_groups[ids[i]] = struct{}{}
+	}
+}
+
+// ClearKeeperGroups clears the "keeper_groups" edge to the DuplicateGroup entity.
+func (m *MachineMutation) ClearKeeperGroups() {
+	m.clearedkeeper_groups = true
+}
+
+// KeeperGroupsCleared reports if the "keeper_groups" edge to the DuplicateGroup entity was cleared.
+func (m *MachineMutation) KeeperGroupsCleared() bool {
+	return m.clearedkeeper_groups
+}
+
+// RemoveKeeperGroupIDs removes the "keeper_groups" edge to the DuplicateGroup entity by IDs.
+func (m *MachineMutation) RemoveKeeperGroupIDs(ids ...uuid.UUID) {
+	if m.removedkeeper_groups == nil {
+		m.removedkeeper_groups = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.keeper_groups, ids[i])
+		m.removedkeeper_groups[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedKeeperGroups returns the removed IDs of the "keeper_groups" edge to the DuplicateGroup entity.
+func (m *MachineMutation) RemovedKeeperGroupsIDs() (ids []uuid.UUID) {
+	for id := range m.removedkeeper_groups {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// KeeperGroupsIDs returns the "keeper_groups" edge IDs in the mutation.
+func (m *MachineMutation) KeeperGroupsIDs() (ids []uuid.UUID) {
+	for id := range m.keeper_groups {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetKeeperGroups resets all changes to the "keeper_groups" edge.
+func (m *MachineMutation) ResetKeeperGroups() {
+	m.keeper_groups = nil
+	m.clearedkeeper_groups = false
+	m.removedkeeper_groups = nil
+}
+
+// AddFileInstanceIDs adds the "file_instances" edge to the FileInstance entity by ids.
+func (m *MachineMutation) AddFileInstanceIDs(ids ...uuid.UUID) {
+	if m.file_instances == nil {
+		m.file_instances = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.file_instances[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFileInstances clears the "file_instances" edge to the FileInstance entity.
+func (m *MachineMutation) ClearFileInstances() {
+	m.clearedfile_instances = true
+}
+
+// FileInstancesCleared reports if the "file_instances" edge to the FileInstance entity was cleared.
+func (m *MachineMutation) FileInstancesCleared() bool {
+	return m.clearedfile_instances
+}
+
+// RemoveFileInstanceIDs removes the "file_instances" edge to the FileInstance entity by IDs.
+func (m *MachineMutation) RemoveFileInstanceIDs(ids ...uuid.UUID) {
+	if m.removedfile_instances == nil {
+		m.removedfile_instances = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.file_instances, ids[i])
+		m.removedfile_instances[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFileInstances returns the removed IDs of the "file_instances" edge to the FileInstance entity.
+func (m *MachineMutation) RemovedFileInstancesIDs() (ids []uuid.UUID) {
+	for id := range m.removedfile_instances {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FileInstancesIDs returns the "file_instances" edge IDs in the mutation.
+func (m *MachineMutation) FileInstancesIDs() (ids []uuid.UUID) {
+	for id := range m.file_instances {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFileInstances resets all changes to the "file_instances" edge.
+func (m *MachineMutation) ResetFileInstances() {
+	m.file_instances = nil
+	m.clearedfile_instances = false
+	m.removedfile_instances = nil
+}
+
+// AddInitiatedScanIDs adds the "initiated_scans" edge to the Scan entity by ids.
+func (m *MachineMutation) AddInitiatedScanIDs(ids ...uuid.UUID) {
+	if m.initiated_scans == nil {
+		m.initiated_scans = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.initiated_scans[ids[i]] = struct{}{}
+	}
+}
+
+// ClearInitiatedScans clears the "initiated_scans" edge to the Scan entity.
+func (m *MachineMutation) ClearInitiatedScans() {
+	m.clearedinitiated_scans = true
+}
+
+// InitiatedScansCleared reports if the "initiated_scans" edge to the Scan entity was cleared.
+func (m *MachineMutation) InitiatedScansCleared() bool {
+	return m.clearedinitiated_scans
+}
+
+// RemoveInitiatedScanIDs removes the "initiated_scans" edge to the Scan entity by IDs.
+func (m *MachineMutation) RemoveInitiatedScanIDs(ids ...uuid.UUID) {
+	if m.removedinitiated_scans == nil {
+		m.removedinitiated_scans = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.initiated_scans, ids[i])
+		m.removedinitiated_scans[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedInitiatedScans returns the removed IDs of the "initiated_scans" edge to the Scan entity.
+func (m *MachineMutation) RemovedInitiatedScansIDs() (ids []uuid.UUID) {
+	for id := range m.removedinitiated_scans {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// InitiatedScansIDs returns the "initiated_scans" edge IDs in the mutation.
+func (m *MachineMutation) InitiatedScansIDs() (ids []uuid.UUID) {
+	for id := range m.initiated_scans {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetInitiatedScans resets all changes to the "initiated_scans" edge.
+func (m *MachineMutation) ResetInitiatedScans() {
+	m.initiated_scans = nil
+	m.clearedinitiated_scans = false
+	m.removedinitiated_scans = nil
+}
+
+// AddKeptIdentityIDs adds the "kept_identities" edge to the ContentIdentity entity by ids.
+func (m *MachineMutation) AddKeptIdentityIDs(ids ...uuid.UUID) {
+	if m.kept_identities == nil {
+		m.kept_identities = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.kept_identities[ids[i]] = struct{}{}
+	}
+}
+
+// ClearKeptIdentities clears the "kept_identities" edge to the ContentIdentity entity.
+func (m *MachineMutation) ClearKeptIdentities() {
+	m.clearedkept_identities = true
+}
+
+// KeptIdentitiesCleared reports if the "kept_identities" edge to the ContentIdentity entity was cleared.
+func (m *MachineMutation) KeptIdentitiesCleared() bool {
+	return m.clearedkept_identities
+}
+
+// RemoveKeptIdentityIDs removes the "kept_identities" edge to the ContentIdentity entity by IDs.
+func (m *MachineMutation) RemoveKeptIdentityIDs(ids ...uuid.UUID) {
+	if m.removedkept_identities == nil {
+		m.removedkept_identities = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.kept_identities, ids[i])
+		m.removedkept_identities[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedKeptIdentities returns the removed IDs of the "kept_identities" edge to the ContentIdentity entity.
+func (m *MachineMutation) RemovedKeptIdentitiesIDs() (ids []uuid.UUID) {
+	for id := range m.removedkept_identities {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// KeptIdentitiesIDs returns the "kept_identities" edge IDs in the mutation.
+func (m *MachineMutation) KeptIdentitiesIDs() (ids []uuid.UUID) {
+	for id := range m.kept_identities {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetKeptIdentities resets all changes to the "kept_identities" edge.
+func (m *MachineMutation) ResetKeptIdentities() {
+	m.kept_identities = nil
+	m.clearedkept_identities = false
+	m.removedkept_identities = nil
+}
+
+// AddContentEventIDs adds the "content_events" edge to the ContentEvent entity by ids.
+func (m *MachineMutation) AddContentEventIDs(ids ...uuid.UUID) {
+	if m.content_events == nil {
+		m.content_events = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.content_events[ids[i]] = struct{}{}
+	}
+}
+
+// ClearContentEvents clears the "content_events" edge to the ContentEvent entity.
+func (m *MachineMutation) ClearContentEvents() {
+	m.clearedcontent_events = true
+}
+
+// ContentEventsCleared reports if the "content_events" edge to the ContentEvent entity was cleared.
+func (m *MachineMutation) ContentEventsCleared() bool {
+	return m.clearedcontent_events
+}
+
+// RemoveContentEventIDs removes the "content_events" edge to the ContentEvent entity by IDs.
+func (m *MachineMutation) RemoveContentEventIDs(ids ...uuid.UUID) {
+	if m.removedcontent_events == nil {
+		m.removedcontent_events = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.content_events, ids[i])
+		m.removedcontent_events[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedContentEvents returns the removed IDs of the "content_events" edge to the ContentEvent entity.
+func (m *MachineMutation) RemovedContentEventsIDs() (ids []uuid.UUID) {
+	for id := range m.removedcontent_events {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ContentEventsIDs returns the "content_events" edge IDs in the mutation.
+func (m *MachineMutation) ContentEventsIDs() (ids []uuid.UUID) {
+	for id := range m.content_events {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetContentEvents resets all changes to the "content_events" edge.
+func (m *MachineMutation) ResetContentEvents() {
+	m.content_events = nil
+	m.clearedcontent_events = false
+	m.removedcontent_events = nil
+}
+
+// AddScanTargetIDs adds the "scan_targets" edge to the ScanTarget entity by ids.
+func (m *MachineMutation) AddScanTargetIDs(ids ...uuid.UUID) {
+	if m.scan_targets == nil {
+		m.scan_targets = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.scan_targets[ids[i]] = struct{}{}
+	}
+}
+
+// ClearScanTargets clears the "scan_targets" edge to the ScanTarget entity.
+func (m *MachineMutation) ClearScanTargets() {
+	m.clearedscan_targets = true
+}
+
+// ScanTargetsCleared reports if the "scan_targets" edge to the ScanTarget entity was cleared.
+func (m *MachineMutation) ScanTargetsCleared() bool {
+	return m.clearedscan_targets
+}
+
+// RemoveScanTargetIDs removes the "scan_targets" edge to the ScanTarget entity by IDs.
+func (m *MachineMutation) RemoveScanTargetIDs(ids ...uuid.UUID) {
+	if m.removedscan_targets == nil {
+		m.removedscan_targets = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.scan_targets, ids[i])
+		m.removedscan_targets[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedScanTargets returns the removed IDs of the "scan_targets" edge to the ScanTarget entity.
+func (m *MachineMutation) RemovedScanTargetsIDs() (ids []uuid.UUID) {
+	for id := range m.removedscan_targets {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ScanTargetsIDs returns the "scan_targets" edge IDs in the mutation.
+func (m *MachineMutation) ScanTargetsIDs() (ids []uuid.UUID) {
+	for id := range m.scan_targets {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetScanTargets resets all changes to the "scan_targets" edge.
+func (m *MachineMutation) ResetScanTargets() {
+	m.scan_targets = nil
+	m.clearedscan_targets = false
+	m.removedscan_targets = nil
+}
+
+// AddSecretIDs adds the "secrets" edge to the TenantSecret entity by ids.
+func (m *MachineMutation) AddSecretIDs(ids ...uuid.UUID) {
+	if m.secrets == nil {
+		m.secrets = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.secrets[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSecrets clears the "secrets" edge to the TenantSecret entity.
+func (m *MachineMutation) ClearSecrets() {
+	m.clearedsecrets = true
+}
+
+// SecretsCleared reports if the "secrets" edge to the TenantSecret entity was cleared.
+func (m *MachineMutation) SecretsCleared() bool {
+	return m.clearedsecrets
+}
+
+// RemoveSecretIDs removes the "secrets" edge to the TenantSecret entity by IDs.
+func (m *MachineMutation) RemoveSecretIDs(ids ...uuid.UUID) {
+	if m.removedsecrets == nil {
+		m.removedsecrets = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.secrets, ids[i])
+		m.removedsecrets[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSecrets returns the removed IDs of the "secrets" edge to the TenantSecret entity.
+func (m *MachineMutation) RemovedSecretsIDs() (ids []uuid.UUID) {
+	for id := range m.removedsecrets {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SecretsIDs returns the "secrets" edge IDs in the mutation.
+func (m *MachineMutation) SecretsIDs() (ids []uuid.UUID) {
+	for id := range m.secrets {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSecrets resets all changes to the "secrets" edge.
+func (m *MachineMutation) ResetSecrets() {
+	m.secrets = nil
+	m.clearedsecrets = false
+	m.removedsecrets = nil
+}
+
+// AddActionJobIDs adds the "action_jobs" edge to the ActionJob entity by ids.
+func (m *MachineMutation) AddActionJobIDs(ids ...uuid.UUID) {
+	if m.action_jobs == nil {
+		m.action_jobs = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.action_jobs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearActionJobs clears the "action_jobs" edge to the ActionJob entity.
+func (m *MachineMutation) ClearActionJobs() {
+	m.clearedaction_jobs = true
+}
+
+// ActionJobsCleared reports if the "action_jobs" edge to the ActionJob entity was cleared.
+func (m *MachineMutation) ActionJobsCleared() bool {
+	return m.clearedaction_jobs
+}
+
+// RemoveActionJobIDs removes the "action_jobs" edge to the ActionJob entity by IDs.
+func (m *MachineMutation) RemoveActionJobIDs(ids ...uuid.UUID) {
+	if m.removedaction_jobs == nil {
+		m.removedaction_jobs = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.action_jobs, ids[i])
+		m.removedaction_jobs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedActionJobs returns the removed IDs of the "action_jobs" edge to the ActionJob entity.
+func (m *MachineMutation) RemovedActionJobsIDs() (ids []uuid.UUID) {
+	for id := range m.removedaction_jobs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ActionJobsIDs returns the "action_jobs" edge IDs in the mutation.
+func (m *MachineMutation) ActionJobsIDs() (ids []uuid.UUID) {
+	for id := range m.action_jobs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetActionJobs resets all changes to the "action_jobs" edge.
+func (m *MachineMutation) ResetActionJobs() {
+	m.action_jobs = nil
+	m.clearedaction_jobs = false
+	m.removedaction_jobs = nil
+}
+
+// Where appends a list predicates to the MachineMutation builder.
+func (m *MachineMutation) Where(ps ...predicate.Machine) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MachineMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MachineMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Machine, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MachineMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MachineMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Machine).
+func (m *MachineMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MachineMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.create_time != nil {
+		fields = append(fields, machine.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, machine.FieldUpdateTime)
+	}
+	if m.tenant != nil {
+		fields = append(fields, machine.FieldTenantID)
+	}
+	if m.name != nil {
+		fields = append(fields, machine.FieldName)
+	}
+	if m.category != nil {
+		fields = append(fields, machine.FieldCategory)
+	}
+	if m.hostname != nil {
+		fields = append(fields, machine.FieldHostname)
+	}
+	if m.role != nil {
+		fields = append(fields, machine.FieldRole)
+	}
+	if m.last_scan_at != nil {
+		fields = append(fields, machine.FieldLastScanAt)
+	}
+	if m.last_seen_at != nil {
+		fields = append(fields, machine.FieldLastSeenAt)
+	}
+	if m.archived_at != nil {
+		fields = append(fields, machine.FieldArchivedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MachineMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case machine.FieldCreateTime:
+		return m.CreateTime()
+	case machine.FieldUpdateTime:
+		return m.UpdateTime()
+	case machine.FieldTenantID:
+		return m.TenantID()
+	case machine.FieldName:
+		return m.Name()
+	case machine.FieldCategory:
+		return m.Category()
+	case machine.FieldHostname:
+		return m.Hostname()
+	case machine.FieldRole:
+		return m.Role()
+	case machine.FieldLastScanAt:
+		return m.LastScanAt()
+	case machine.FieldLastSeenAt:
+		return m.LastSeenAt()
+	case machine.FieldArchivedAt:
+		return m.ArchivedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MachineMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case machine.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case machine.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case machine.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case machine.FieldName:
+		return m.OldName(ctx)
+	case machine.FieldCategory:
+		return m.OldCategory(ctx)
+	case machine.FieldHostname:
+		return m.OldHostname(ctx)
+	case machine.FieldRole:
+		return m.OldRole(ctx)
+	case machine.FieldLastScanAt:
+		return m.OldLastScanAt(ctx)
+	case machine.FieldLastSeenAt:
+		return m.OldLastSeenAt(ctx)
+	case machine.FieldArchivedAt:
+		return m.OldArchivedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Machine field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MachineMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case machine.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case machine.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case machine.FieldTenantID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case machine.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case machine.FieldCategory:
+		v, ok := value.(machine.Category)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCategory(v)
+		return nil
+	case machine.FieldHostname:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHostname(v)
+		return nil
+	case machine.FieldRole:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
+	case machine.FieldLastScanAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastScanAt(v)
+		return nil
+	case machine.FieldLastSeenAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastSeenAt(v)
+		return nil
+	case machine.FieldArchivedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArchivedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Machine field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MachineMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MachineMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MachineMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Machine numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MachineMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(machine.FieldHostname) {
+		fields = append(fields, machine.FieldHostname)
+	}
+	if m.FieldCleared(machine.FieldRole) {
+		fields = append(fields, machine.FieldRole)
+	}
+	if m.FieldCleared(machine.FieldLastScanAt) {
+		fields = append(fields, machine.FieldLastScanAt)
+	}
+	if m.FieldCleared(machine.FieldLastSeenAt) {
+		fields = append(fields, machine.FieldLastSeenAt)
+	}
+	if m.FieldCleared(machine.FieldArchivedAt) {
+		fields = append(fields, machine.FieldArchivedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MachineMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MachineMutation) ClearField(name string) error {
+	switch name {
+	case machine.FieldHostname:
+		m.ClearHostname()
+		return nil
+	case machine.FieldRole:
+		m.ClearRole()
+		return nil
+	case machine.FieldLastScanAt:
+		m.ClearLastScanAt()
+		return nil
+	case machine.FieldLastSeenAt:
+		m.ClearLastSeenAt()
+		return nil
+	case machine.FieldArchivedAt:
+		m.ClearArchivedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Machine nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MachineMutation) ResetField(name string) error {
+	switch name {
+	case machine.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case machine.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case machine.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case machine.FieldName:
+		m.ResetName()
+		return nil
+	case machine.FieldCategory:
+		m.ResetCategory()
+		return nil
+	case machine.FieldHostname:
+		m.ResetHostname()
+		return nil
+	case machine.FieldRole:
+		m.ResetRole()
+		return nil
+	case machine.FieldLastScanAt:
+		m.ResetLastScanAt()
+		return nil
+	case machine.FieldLastSeenAt:
+		m.ResetLastSeenAt()
+		return nil
+	case machine.FieldArchivedAt:
+		m.ResetArchivedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Machine field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MachineMutation) AddedEdges() []string {
+	edges := make([]string, 0, 9)
+	if m.tenant != nil {
+		edges = append(edges, machine.EdgeTenant)
+	}
+	if m.keeper_groups != nil {
+		edges = append(edges, machine.EdgeKeeperGroups)
+	}
+	if m.file_instances != nil {
+		edges = append(edges, machine.EdgeFileInstances)
+	}
+	if m.initiated_scans != nil {
+		edges = append(edges, machine.EdgeInitiatedScans)
+	}
+	if m.kept_identities != nil {
+		edges = append(edges, machine.EdgeKeptIdentities)
+	}
+	if m.content_events != nil {
+		edges = append(edges, machine.EdgeContentEvents)
+	}
+	if m.scan_targets != nil {
+		edges = append(edges, machine.EdgeScanTargets)
+	}
+	if m.secrets != nil {
+		edges = append(edges, machine.EdgeSecrets)
+	}
+	if m.action_jobs != nil {
+		edges = append(edges, machine.EdgeActionJobs)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MachineMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case machine.EdgeTenant:
+		if id := m.tenant; id != nil {
+			return []ent.Value{*id}
+		}
+	case machine.EdgeKeeperGroups:
+		ids := make([]ent.Value, 0, len(m.keeper_groups))
+		for id := range m.keeper_groups {
+			ids = append(ids, id)
+		}
+		return ids
+	case machine.EdgeFileInstances:
+		ids := make([]ent.Value, 0, len(m.file_instances))
+		for id := range m.file_instances {
+			ids = append(ids, id)
+		}
+		return ids
+	case machine.EdgeInitiatedScans:
+		ids := make([]ent.Value, 0, len(m.initiated_scans))
+		for id := range m.initiated_scans {
+			ids = append(ids, id)
+		}
+		return ids
+	case machine.EdgeKeptIdentities:
+		ids := make([]ent.Value, 0, len(m.kept_identities))
+		for id := range m.kept_identities {
+			ids = append(ids, id)
+		}
+		return ids
+	case machine.EdgeContentEvents:
+		ids := make([]ent.Value, 0, len(m.content_events))
+		for id := range m.content_events {
+			ids = append(ids, id)
+		}
+		return ids
+	case machine.EdgeScanTargets:
+		ids := make([]ent.Value, 0, len(m.scan_targets))
+		for id := range m.scan_targets {
+			ids = append(ids, id)
+		}
+		return ids
+	case machine.EdgeSecrets:
+		ids := make([]ent.Value, 0, len(m.secrets))
+		for id := range m.secrets {
+			ids = append(ids, id)
+		}
+		return ids
+	case machine.EdgeActionJobs:
+		ids := make([]ent.Value, 0, len(m.action_jobs))
+		for id := range m.action_jobs {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MachineMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 9)
+	if m.removedkeeper_groups != nil {
+		edges = append(edges, machine.EdgeKeeperGroups)
+	}
+	if m.removedfile_instances != nil {
+		edges = append(edges, machine.EdgeFileInstances)
+	}
+	if m.removedinitiated_scans != nil {
+		edges = append(edges, machine.EdgeInitiatedScans)
+	}
+	if m.removedkept_identities != nil {
+		edges = append(edges, machine.EdgeKeptIdentities)
+	}
+	if m.removedcontent_events != nil {
+		edges = append(edges, machine.EdgeContentEvents)
+	}
+	if m.removedscan_targets != nil {
+		edges = append(edges, machine.EdgeScanTargets)
+	}
+	if m.removedsecrets != nil {
+		edges = append(edges, machine.EdgeSecrets)
+	}
+	if m.removedaction_jobs != nil {
+		edges = append(edges, machine.EdgeActionJobs)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MachineMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case machine.EdgeKeeperGroups:
+		ids := make([]ent.Value, 0, len(m.removedkeeper_groups))
+		for id := range m.removedkeeper_groups {
+			ids = append(ids, id)
+		}
+		return ids
+	case machine.EdgeFileInstances:
+		ids := make([]ent.Value, 0, len(m.removedfile_instances))
+		for id := range m.removedfile_instances {
+			ids = append(ids, id)
+		}
+		return ids
+	case machine.EdgeInitiatedScans:
+		ids := make([]ent.Value, 0, len(m.removedinitiated_scans))
+		for id := range m.removedinitiated_scans {
+			ids = append(ids, id)
+		}
+		return ids
+	case machine.EdgeKeptIdentities:
+		ids := make([]ent.Value, 0, len(m.removedkept_identities))
+		for id := range m.removedkept_identities {
+			ids = append(ids, id)
+		}
+		return ids
+	case machine.EdgeContentEvents:
+		ids := make([]ent.Value, 0, len(m.removedcontent_events))
+		for id := range m.removedcontent_events {
+			ids = append(ids, id)
+		}
+		return ids
+	case machine.EdgeScanTargets:
+		ids := make([]ent.Value, 0, len(m.removedscan_targets))
+		for id := range m.removedscan_targets {
+			ids = append(ids, id)
+		}
+		return ids
+	case machine.EdgeSecrets:
+		ids := make([]ent.Value, 0, len(m.removedsecrets))
+		for id := range m.removedsecrets {
+			ids = append(ids, id)
+		}
+		return ids
+	case machine.EdgeActionJobs:
+		ids := make([]ent.Value, 0, len(m.removedaction_jobs))
+		for id := range m.removedaction_jobs {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MachineMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 9)
+	if m.clearedtenant {
+		edges = append(edges, machine.EdgeTenant)
+	}
+	if m.clearedkeeper_groups {
+		edges = append(edges, machine.EdgeKeeperGroups)
+	}
+	if m.clearedfile_instances {
+		edges = append(edges, machine.EdgeFileInstances)
+	}
+	if m.clearedinitiated_scans {
+		edges = append(edges, machine.EdgeInitiatedScans)
+	}
+	if m.clearedkept_identities {
+		edges = append(edges, machine.EdgeKeptIdentities)
+	}
+	if m.clearedcontent_events {
+		edges = append(edges, machine.EdgeContentEvents)
+	}
+	if m.clearedscan_targets {
+		edges = append(edges, machine.EdgeScanTargets)
+	}
+	if m.clearedsecrets {
+		edges = append(edges, machine.EdgeSecrets)
+	}
+	if m.clearedaction_jobs {
+		edges = append(edges, machine.EdgeActionJobs)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MachineMutation) EdgeCleared(name string) bool {
+	switch name {
+	case machine.EdgeTenant:
+		return m.clearedtenant
+	case machine.EdgeKeeperGroups:
+		return m.clearedkeeper_groups
+	case machine.EdgeFileInstances:
+		return m.clearedfile_instances
+	case machine.EdgeInitiatedScans:
+		return m.clearedinitiated_scans
+	case machine.EdgeKeptIdentities:
+		return m.clearedkept_identities
+	case machine.EdgeContentEvents:
+		return m.clearedcontent_events
+	case machine.EdgeScanTargets:
+		return m.clearedscan_targets
+	case machine.EdgeSecrets:
+		return m.clearedsecrets
+	case machine.EdgeActionJobs:
+		return m.clearedaction_jobs
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MachineMutation) ClearEdge(name string) error {
+	switch name {
+	case machine.EdgeTenant:
+		m.ClearTenant()
+		return nil
+	}
+	return fmt.Errorf("unknown Machine unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MachineMutation) ResetEdge(name string) error {
+	switch name {
+	case machine.EdgeTenant:
+		m.ResetTenant()
+		return nil
+	case machine.EdgeKeeperGroups:
+		m.ResetKeeperGroups()
+		return nil
+	case machine.EdgeFileInstances:
+		m.ResetFileInstances()
+		return nil
+	case machine.EdgeInitiatedScans:
+		m.ResetInitiatedScans()
+		return nil
+	case machine.EdgeKeptIdentities:
+		m.ResetKeptIdentities()
+		return nil
+	case machine.EdgeContentEvents:
+		m.ResetContentEvents()
+		return nil
+	case machine.EdgeScanTargets:
+		m.ResetScanTargets()
+		return nil
+	case machine.EdgeSecrets:
+		m.ResetSecrets()
+		return nil
+	case machine.EdgeActionJobs:
+		m.ResetActionJobs()
+		return nil
+	}
+	return fmt.Errorf("unknown Machine edge %s", name)
+}
+
+// ScanMutation represents an operation that mutates the Scan nodes in the graph.
+type ScanMutation struct {
+	config
+	op                       Op
+	typ                      string
+	id                       *uuid.UUID
+	create_time              *time.Time
+	update_time              *time.Time
+	name                     *string
+	description              *string
+	started_at               *time.Time
+	completed_at             *time.Time
+	duplicate_group_count    *int
+	addduplicate_group_count *int
+	files_pruned_at          *time.Time
+	status                   *scan.Status
+	status_message           *string
+	files_seen               *int
+	addfiles_seen            *int
+	bytes_hashed             *int64
+	addbytes_hashed          *int64
+	machines_reported        *int
+	addmachines_reported     *int
+	last_heartbeat_at        *time.Time
+	deadline                 *time.Time
+	clearedFields            map[string]struct{}
+	tenant                   *uuid.UUID
+	clearedtenant            bool
+	initiated_machine        *uuid.UUID
+	clearedinitiated_machine bool
+	duplicate_groups         map[uuid.UUID]struct{}
+	removedduplicate_groups  map[uuid.UUID]struct{}
+	clearedduplicate_groups  bool
+	content_events           map[uuid.UUID]struct{}
+	removedcontent_events    map[uuid.UUID]struct{}
+	clearedcontent_events    bool
+	targets                  map[uuid.UUID]struct{}
+	removedtargets           map[uuid.UUID]struct{}
+	clearedtargets           bool
+	done                     bool
+	oldValue                 func(context.Context) (*Scan, error)
+	predicates               []predicate.Scan
+}
+
+var _ ent.Mutation = (*ScanMutation)(nil)
+
+// scanOption allows management of the mutation configuration using functional options.
+type scanOption func(*ScanMutation)
+
+// newScanMutation creates new mutation for the Scan entity.
+func newScanMutation(c config, op Op, opts ...scanOption) *ScanMutation {
+	m := &ScanMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeScan,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withScanID sets the ID field of the mutation.
+func withScanID(id uuid.UUID) scanOption {
+	return func(m *ScanMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Scan
+		)
+		m.oldValue = func(ctx context.Context) (*Scan, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Scan.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withScan sets the old Scan of the mutation.
+func withScan(node *Scan) scanOption {
+	return func(m *ScanMutation) {
+		m.oldValue = func(context.Context) (*Scan, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ScanMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ScanMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Scan entities.
+func (m *ScanMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ScanMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ScanMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Scan.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *ScanMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *ScanMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Scan entity.
+// If the Scan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScanMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *ScanMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *ScanMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *ScanMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Scan entity.
+// If the Scan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScanMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *ScanMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *ScanMutation) SetTenantID(u uuid.UUID) {
+	m.tenant = &u
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *ScanMutation) TenantID() (r uuid.UUID, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the Scan entity.
+// If the Scan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScanMutation) OldTenantID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *ScanMutation) ResetTenantID() {
+	m.tenant = nil
+}
+
+// SetInitiatedMachineID sets the "initiated_machine_id" field.
+func (m *ScanMutation) SetInitiatedMachineID(u uuid.UUID) {
+	m.initiated_machine = &u
+}
+
+// InitiatedMachineID returns the value of the "initiated_machine_id" field in the mutation.
+func (m *ScanMutation) InitiatedMachineID() (r uuid.UUID, exists bool) {
+	v := m.initiated_machine
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInitiatedMachineID returns the old "initiated_machine_id" field's value of the Scan entity.
+// If the Scan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScanMutation) OldInitiatedMachineID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInitiatedMachineID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInitiatedMachineID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInitiatedMachineID: %w", err)
+	}
+	return oldValue.InitiatedMachineID, nil
+}
+
+// ClearInitiatedMachineID clears the value of the "initiated_machine_id" field.
+func (m *ScanMutation) ClearInitiatedMachineID() {
+	m.initiated_machine = nil
+	m.clearedFields[scan.FieldInitiatedMachineID] = struct{}{}
+}
+
+// InitiatedMachineIDCleared returns if the "initiated_machine_id" field was cleared in this mutation.
+func (m *ScanMutation) InitiatedMachineIDCleared() bool {
+	_, ok := m.clearedFields[scan.FieldInitiatedMachineID]
+	return ok
+}
+
+// ResetInitiatedMachineID resets all changes to the "initiated_machine_id" field.
+func (m *ScanMutation) ResetInitiatedMachineID() {
+	m.initiated_machine = nil
+	delete(m.clearedFields, scan.FieldInitiatedMachineID)
+}
+
+// SetName sets the "name" field.
+func (m *ScanMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ScanMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Scan entity.
+// If the Scan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScanMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ScanMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *ScanMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *ScanMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Scan entity.
+// If the Scan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScanMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *ScanMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[scan.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *ScanMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[scan.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *ScanMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, scan.FieldDescription)
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *ScanMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *ScanMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedAt returns the old "started_at" field's value of the Scan entity.
+// If the Scan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScanMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *ScanMutation) ResetStartedAt() {
+	m.started_at = nil
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (m *ScanMutation) SetCompletedAt(t time.Time) {
+	m.completed_at = &t
+}
+
+// CompletedAt returns the value of the "completed_at" field in the mutation.
+func (m *ScanMutation) CompletedAt() (r time.Time, exists bool) {
+	v := m.completed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompletedAt returns the old "completed_at" field's value of the Scan entity.
+// If the Scan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScanMutation) OldCompletedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
+	}
+	return oldValue.CompletedAt, nil
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (m *ScanMutation) ClearCompletedAt() {
+	m.completed_at = nil
+	m.clearedFields[scan.FieldCompletedAt] = struct{}{}
+}
+
+// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
+func (m *ScanMutation) CompletedAtCleared() bool {
+	_, ok := m.clearedFields[scan.FieldCompletedAt]
+	return ok
+}
+
+// ResetCompletedAt resets all changes to the "completed_at" field.
+func (m *ScanMutation) ResetCompletedAt() {
+	m.completed_at = nil
+	delete(m.clearedFields, scan.FieldCompletedAt)
+}
+
+// SetDuplicateGroupCount sets the "duplicate_group_count" field.
+func (m *ScanMutation) SetDuplicateGroupCount(i int) {
+	m.duplicate_group_count = &i
+	m.addduplicate_group_count = nil
+}
+
+// DuplicateGroupCount returns the value of the "duplicate_group_count" field in the mutation.
+func (m *ScanMutation) DuplicateGroupCount() (r int, exists bool) {
+	v := m.duplicate_group_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDuplicateGroupCount returns the old "duplicate_group_count" field's value of the Scan entity.
+// If the Scan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScanMutation) OldDuplicateGroupCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDuplicateGroupCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDuplicateGroupCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDuplicateGroupCount: %w", err)
+	}
+	return oldValue.DuplicateGroupCount, nil
+}
+
+// AddDuplicateGroupCount adds i to the "duplicate_group_count" field.
+func (m *ScanMutation) AddDuplicateGroupCount(i int) {
+	if m.addduplicate_group_count != nil {
+		*m.addduplicate_group_count += i
+	} else {
+		m.addduplicate_group_count = &i
+	}
+}
+
+// AddedDuplicateGroupCount returns the value that was added to the "duplicate_group_count" field in this mutation.
+func (m *ScanMutation) AddedDuplicateGroupCount() (r int, exists bool) {
+	v := m.addduplicate_group_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDuplicateGroupCount resets all changes to the "duplicate_group_count" field.
+func (m *ScanMutation) ResetDuplicateGroupCount() {
+	m.duplicate_group_count = nil
+	m.addduplicate_group_count = nil
+}
+
+// SetFilesPrunedAt sets the "files_pruned_at" field.
+func (m *ScanMutation) SetFilesPrunedAt(t time.Time) {
+	m.files_pruned_at = &t
+}
+
+// FilesPrunedAt returns the value of the "files_pruned_at" field in the mutation.
+func (m *ScanMutation) FilesPrunedAt() (r time.Time, exists bool) {
+	v := m.files_pruned_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFilesPrunedAt returns the old "files_pruned_at" field's value of the Scan entity.
+// If the Scan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScanMutation) OldFilesPrunedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFilesPrunedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFilesPrunedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFilesPrunedAt: %w", err)
+	}
+	return oldValue.FilesPrunedAt, nil
+}
+
+// ClearFilesPrunedAt clears the value of the "files_pruned_at" field.
+func (m *ScanMutation) ClearFilesPrunedAt() {
+	m.files_pruned_at = nil
+	m.clearedFields[scan.FieldFilesPrunedAt] = struct{}{}
+}
+
+// FilesPrunedAtCleared returns if the "files_pruned_at" field was cleared in this mutation.
+func (m *ScanMutation) FilesPrunedAtCleared() bool {
+	_, ok := m.clearedFields[scan.FieldFilesPrunedAt]
+	return ok
+}
+
+// ResetFilesPrunedAt resets all changes to the "files_pruned_at" field.
+func (m *ScanMutation) ResetFilesPrunedAt() {
+	m.files_pruned_at = nil
+	delete(m.clearedFields, scan.FieldFilesPrunedAt)
+}
+
+// SetStatus sets the "status" field.
+func (m *ScanMutation) SetStatus(s scan.Status) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ScanMutation) Status() (r scan.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Scan entity.
+// If the Scan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScanMutation) OldStatus(ctx context.Context) (v scan.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ScanMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetStatusMessage sets the "status_message" field.
+func (m *ScanMutation) SetStatusMessage(s string) {
+	m.status_message = &s
+}
+
+// StatusMessage returns the value of the "status_message" field in the mutation.
+func (m *ScanMutation) StatusMessage() (r string, exists bool) {
+	v := m.status_message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatusMessage returns the old "status_message" field's value of the Scan entity.
+// If the Scan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScanMutation) OldStatusMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatusMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatusMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatusMessage: %w", err)
+	}
+	return oldValue.StatusMessage, nil
+}
+
+// ClearStatusMessage clears the value of the "status_message" field.
+func (m *ScanMutation) ClearStatusMessage() {
+	m.status_message = nil
+	m.clearedFields[scan.FieldStatusMessage] = struct{}{}
+}
+
+// StatusMessageCleared returns if the "status_message" field was cleared in this mutation.
+func (m *ScanMutation) StatusMessageCleared() bool {
+	_, ok := m.clearedFields[scan.FieldStatusMessage]
+	return ok
+}
+
+// ResetStatusMessage resets all changes to the "status_message" field.
+func (m *ScanMutation) ResetStatusMessage() {
+	m.status_message = nil
+	delete(m.clearedFields, scan.FieldStatusMessage)
+}
+
+// SetFilesSeen sets the "files_seen" field.
+func (m *ScanMutation) SetFilesSeen(i int) {
+	m.files_seen = &i
+	m.addfiles_seen = nil
+}
+
+// FilesSeen returns the value of the "files_seen" field in the mutation.
+func (m *ScanMutation) FilesSeen() (r int, exists bool) {
+	v := m.files_seen
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFilesSeen returns the old "files_seen" field's value of the Scan entity.
+// If the Scan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScanMutation) OldFilesSeen(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFilesSeen is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFilesSeen requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFilesSeen: %w", err)
+	}
+	return oldValue.FilesSeen, nil
+}
+
+// AddFilesSeen adds i to the "files_seen" field.
+func (m *ScanMutation) AddFilesSeen(i int) {
+	if m.addfiles_seen != nil {
+		*m.addfiles_seen += i
+	} else {
+		m.addfiles_seen = &i
+	}
+}
+
+// AddedFilesSeen returns the value that was added to the "files_seen" field in this mutation.
+func (m *ScanMutation) AddedFilesSeen() (r int, exists bool) {
+	v := m.addfiles_seen
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFilesSeen resets all changes to the "files_seen" field.
+func (m *ScanMutation) ResetFilesSeen() {
+	m.files_seen = nil
+	m.addfiles_seen = nil
+}
+
+// SetBytesHashed sets the "bytes_hashed" field.
+func (m *ScanMutation) SetBytesHashed(i int64) {
+	m.bytes_hashed = &i
+	m.addbytes_hashed = nil
+}
+
+// BytesHashed returns the value of the "bytes_hashed" field in the mutation.
+func (m *ScanMutation) BytesHashed() (r int64, exists bool) {
+	v := m.bytes_hashed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBytesHashed returns the old "bytes_hashed" field's value of the Scan entity.
+// If the Scan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScanMutation) OldBytesHashed(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBytesHashed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBytesHashed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBytesHashed: %w", err)
+	}
+	return oldValue.BytesHashed, nil
+}
+
+// AddBytesHashed adds i to the "bytes_hashed" field.
+func (m *ScanMutation) AddBytesHashed(i int64) {
+	if m.addbytes_hashed != nil {
+		*m.addbytes_hashed += i
+	} else {
+		m.addbytes_hashed = &i
+	}
+}
+
+// AddedBytesHashed returns the value that was added to the "bytes_hashed" field in this mutation.
+func (m *ScanMutation) AddedBytesHashed() (r int64, exists bool) {
+	v := m.addbytes_hashed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetBytesHashed resets all changes to the "bytes_hashed" field.
+func (m *ScanMutation) ResetBytesHashed() {
+	m.bytes_hashed = nil
+	m.addbytes_hashed = nil
+}
+
+// SetMachinesReported sets the "machines_reported" field.
+func (m *ScanMutation) SetMachinesReported(i int) {
+	m.machines_reported = &i
+	m.addmachines_reported = nil
+}
+
+// MachinesReported returns the value of the "machines_reported" field in the mutation.
+func (m *ScanMutation) MachinesReported() (r int, exists bool) {
+	v := m.machines_reported
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMachinesReported returns the old "machines_reported" field's value of the Scan entity.
+// If the Scan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScanMutation) OldMachinesReported(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMachinesReported is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMachinesReported requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMachinesReported: %w", err)
+	}
+	return oldValue.MachinesReported, nil
+}
+
+// AddMachinesReported adds i to the "machines_reported" field.
+func (m *ScanMutation) AddMachinesReported(i int) {
+	if m.addmachines_reported != nil {
+		*m.addmachines_reported += i
+	} else {
+		m.addmachines_reported = &i
+	}
+}
+
+// AddedMachinesReported returns the value that was added to the "machines_reported" field in this mutation.
+func (m *ScanMutation) AddedMachinesReported() (r int, exists bool) {
+	v := m.addmachines_reported
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMachinesReported resets all changes to the "machines_reported" field.
+func (m *ScanMutation) ResetMachinesReported() {
+	m.machines_reported = nil
+	m.addmachines_reported = nil
+}
+
+// SetLastHeartbeatAt sets the "last_heartbeat_at" field.
+func (m *ScanMutation) SetLastHeartbeatAt(t time.Time) {
+	m.last_heartbeat_at = &t
+}
+
+// LastHeartbeatAt returns the value of the "last_heartbeat_at" field in the mutation.
+func (m *ScanMutation) LastHeartbeatAt() (r time.Time, exists bool) {
+	v := m.last_heartbeat_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastHeartbeatAt returns the old "last_heartbeat_at" field's value of the Scan entity.
+// If the Scan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScanMutation) OldLastHeartbeatAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastHeartbeatAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastHeartbeatAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastHeartbeatAt: %w", err)
+	}
+	return oldValue.LastHeartbeatAt, nil
+}
+
+// ClearLastHeartbeatAt clears the value of the "last_heartbeat_at" field.
+func (m *ScanMutation) ClearLastHeartbeatAt() {
+	m.last_heartbeat_at = nil
+	m.clearedFields[scan.FieldLastHeartbeatAt] = struct{}{}
+}
+
+// LastHeartbeatAtCleared returns if the "last_heartbeat_at" field was cleared in this mutation.
+func (m *ScanMutation) LastHeartbeatAtCleared() bool {
+	_, ok := m.clearedFields[scan.FieldLastHeartbeatAt]
+	return ok
+}
+
+// ResetLastHeartbeatAt resets all changes to the "last_heartbeat_at" field.
+func (m *ScanMutation) ResetLastHeartbeatAt() {
+	m.last_heartbeat_at = nil
+	delete(m.clearedFields, scan.FieldLastHeartbeatAt)
+}
+
+// SetDeadline sets the "deadline" field.
+func (m *ScanMutation) SetDeadline(t time.Time) {
+	m.deadline = &t
+}
+
+// Deadline returns the value of the "deadline" field in the mutation.
+func (m *ScanMutation) Deadline() (r time.Time, exists bool) {
+	v := m.deadline
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeadline returns the old "deadline" field's value of the Scan entity.
+// If the Scan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScanMutation) OldDeadline(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeadline is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeadline requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeadline: %w", err)
+	}
+	return oldValue.Deadline, nil
+}
+
+// ClearDeadline clears the value of the "deadline" field.
+func (m *ScanMutation) ClearDeadline() {
+	m.deadline = nil
+	m.clearedFields[scan.FieldDeadline] = struct{}{}
+}
+
+// DeadlineCleared returns if the "deadline" field was cleared in this mutation.
+func (m *ScanMutation) DeadlineCleared() bool {
+	_, ok := m.clearedFields[scan.FieldDeadline]
+	return ok
+}
+
+// ResetDeadline resets all changes to the "deadline" field.
+func (m *ScanMutation) ResetDeadline() {
+	m.deadline = nil
+	delete(m.clearedFields, scan.FieldDeadline)
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (m *ScanMutation) ClearTenant() {
+	m.clearedtenant = true
+	m.clearedFields[scan.FieldTenantID] = struct{}{}
+}
+
+// TenantCleared reports if the "tenant" edge to the Tenant entity was cleared.
+func (m *ScanMutation) TenantCleared() bool {
+	return m.clearedtenant
+}
+
+// TenantIDs returns the "tenant" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TenantID instead. It exists only for internal usage by the builders.
+func (m *ScanMutation) TenantIDs() (ids []uuid.UUID) {
+	if id := m.tenant; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTenant resets all changes to the "tenant" edge.
+func (m *ScanMutation) ResetTenant() {
+	m.tenant = nil
+	m.clearedtenant = false
+}
+
+// ClearInitiatedMachine clears the "initiated_machine" edge to the Machine entity.
+func (m *ScanMutation) ClearInitiatedMachine() {
+	m.clearedinitiated_machine = true
+	m.clearedFields[scan.FieldInitiatedMachineID] = struct{}{}
+}
+
+// InitiatedMachineCleared reports if the "initiated_machine" edge to the Machine entity was cleared.
+func (m *ScanMutation) InitiatedMachineCleared() bool {
+	return m.InitiatedMachineIDCleared() || m.clearedinitiated_machine
+}
+
+// InitiatedMachineIDs returns the "initiated_machine" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// InitiatedMachineID instead. It exists only for internal usage by the builders.
+func (m *ScanMutation) InitiatedMachineIDs() (ids []uuid.UUID) {
+	if id := m.initiated_machine; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetInitiatedMachine resets all changes to the "initiated_machine" edge.
+func (m *ScanMutation) ResetInitiatedMachine() {
+	m.initiated_machine = nil
+	m.clearedinitiated_machine = false
+}
+
+// AddDuplicateGroupIDs adds the "duplicate_groups" edge to the DuplicateGroup entity by ids.
+func (m *ScanMutation) AddDuplicateGroupIDs(ids ...uuid.UUID) {
+	if m.duplicate_groups == nil {
+		m.duplicate_groups = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.duplicate_groups[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDuplicateGroups clears the "duplicate_groups" edge to the DuplicateGroup entity.
+func (m *ScanMutation) ClearDuplicateGroups() {
+	m.clearedduplicate_groups = true
+}
+
+// DuplicateGroupsCleared reports if the "duplicate_groups" edge to the DuplicateGroup entity was cleared.
+func (m *ScanMutation) DuplicateGroupsCleared() bool {
+	return m.clearedduplicate_groups
+}
+
+// RemoveDuplicateGroupIDs removes the "duplicate_groups" edge to the DuplicateGroup entity by IDs.
+func (m *ScanMutation) RemoveDuplicateGroupIDs(ids ...uuid.UUID) {
+	if m.removedduplicate_groups == nil {
+		m.removedduplicate_groups = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.duplicate_groups, ids[i])
+		m.removedduplicate_groups[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDuplicateGroups returns the removed IDs of the "duplicate_groups" edge to the DuplicateGroup entity.
+func (m *ScanMutation) RemovedDuplicateGroupsIDs() (ids []uuid.UUID) {
+	for id := range m.removedduplicate_groups {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DuplicateGroupsIDs returns the "duplicate_groups" edge IDs in the mutation.
+func (m *ScanMutation) DuplicateGroupsIDs() (ids []uuid.UUID) {
+	for id := range m.duplicate_groups {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDuplicateGroups resets all changes to the "duplicate_groups" edge.
+func (m *ScanMutation) ResetDuplicateGroups() {
+	m.duplicate_groups = nil
+	m.clearedduplicate_groups = false
+	m.removedduplicate_groups = nil
+}
+
+// AddContentEventIDs adds the "content_events" edge to the ContentEvent entity by ids.
+func (m *ScanMutation) AddContentEventIDs(ids ...uuid.UUID) {
+	if m.content_events == nil {
+		m.content_events = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.content_events[ids[i]] = struct{}{}
+	}
+}
+
+// ClearContentEvents clears the "content_events" edge to the ContentEvent entity.
+func (m *ScanMutation) ClearContentEvents() {
+	m.clearedcontent_events = true
+}
+
+// ContentEventsCleared reports if the "content_events" edge to the ContentEvent entity was cleared.
+func (m *ScanMutation) ContentEventsCleared() bool {
+	return m.clearedcontent_events
+}
+
+// RemoveContentEventIDs removes the "content_events" edge to the ContentEvent entity by IDs.
+func (m *ScanMutation) RemoveContentEventIDs(ids ...uuid.UUID) {
+	if m.removedcontent_events == nil {
+		m.removedcontent_events = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.content_events, ids[i])
+		m.removedcontent_events[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedContentEvents returns the removed IDs of the "content_events" edge to the ContentEvent entity.
+func (m *ScanMutation) RemovedContentEventsIDs() (ids []uuid.UUID) {
+	for id := range m.removedcontent_events {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ContentEventsIDs returns the "content_events" edge IDs in the mutation.
+func (m *ScanMutation) ContentEventsIDs() (ids []uuid.UUID) {
+	for id := range m.content_events {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetContentEvents resets all changes to the "content_events" edge.
+func (m *ScanMutation) ResetContentEvents() {
+	m.content_events = nil
+	m.clearedcontent_events = false
+	m.removedcontent_events = nil
+}
+
+// AddTargetIDs adds the "targets" edge to the ScanTarget entity by ids.
+func (m *ScanMutation) AddTargetIDs(ids ...uuid.UUID) {
+	if m.targets == nil {
+		m.targets = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.targets[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTargets clears the "targets" edge to the ScanTarget entity.
+func (m *ScanMutation) ClearTargets() {
+	m.clearedtargets = true
+}
+
+// TargetsCleared reports if the "targets" edge to the ScanTarget entity was cleared.
+func (m *ScanMutation) TargetsCleared() bool {
+	return m.clearedtargets
+}
+
+// RemoveTargetIDs removes the "targets" edge to the ScanTarget entity by IDs.
+func (m *ScanMutation) RemoveTargetIDs(ids ...uuid.UUID) {
+	if m.removedtargets == nil {
+		m.removedtargets = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.targets, ids[i])
+		m.removedtargets[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTargets returns the removed IDs of the "targets" edge to the ScanTarget entity.
+func (m *ScanMutation) RemovedTargetsIDs() (ids []uuid.UUID) {
+	for id := range m.removedtargets {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TargetsIDs returns the "targets" edge IDs in the mutation.
+func (m *ScanMutation) TargetsIDs() (ids []uuid.UUID) {
+	for id := range m.targets {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTargets resets all changes to the "targets" edge.
+func (m *ScanMutation) ResetTargets() {
+	m.targets = nil
+	m.clearedtargets = false
+	m.removedtargets = nil
+}
+
+// Where appends a list predicates to the ScanMutation builder.
+func (m *ScanMutation) Where(ps ...predicate.Scan) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ScanMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ScanMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Scan, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ScanMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ScanMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Scan).
+func (m *ScanMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ScanMutation) Fields() []string {
+	fields := make([]string, 0, 17)
+	if m.create_time != nil {
+		fields = append(fields, scan.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, scan.FieldUpdateTime)
+	}
+	if m.tenant != nil {
+		fields = append(fields, scan.FieldTenantID)
+	}
+	if m.initiated_machine != nil {
+		fields = append(fields, scan.FieldInitiatedMachineID)
+	}
+	if m.name != nil {
+		fields = append(fields, scan.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, scan.FieldDescription)
+	}
+	if m.started_at != nil {
+		fields = append(fields, scan.FieldStartedAt)
+	}
+	if m.completed_at != nil {
+		fields = append(fields, scan.FieldCompletedAt)
+	}
+	if m.duplicate_group_count != nil {
+		fields = append(fields, scan.FieldDuplicateGroupCount)
+	}
+	if m.files_pruned_at != nil {
+		fields = append(fields, scan.FieldFilesPrunedAt)
+	}
+	if m.status != nil {
+		fields = append(fields, scan.FieldStatus)
+	}
+	if m.status_message != nil {
+		fields = append(fields, scan.FieldStatusMessage)
+	}
+	if m.files_seen != nil {
+		fields = append(fields, scan.FieldFilesSeen)
+	}
+	if m.bytes_hashed != nil {
+		fields = append(fields, scan.FieldBytesHashed)
+	}
+	if m.machines_reported != nil {
+		fields = append(fields, scan.FieldMachinesReported)
+	}
+	if m.last_heartbeat_at != nil {
+		fields = append(fields, scan.FieldLastHeartbeatAt)
+	}
+	if m.deadline != nil {
+		fields = append(fields, scan.FieldDeadline)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ScanMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case scan.FieldCreateTime:
+		return m.CreateTime()
+	case scan.FieldUpdateTime:
+		return m.UpdateTime()
+	case scan.FieldTenantID:
+		return m.TenantID()
+	case scan.FieldInitiatedMachineID:
+		return m.InitiatedMachineID()
+	case scan.FieldName:
+		return m.Name()
+	case scan.FieldDescription:
+		return m.Description()
+	case scan.FieldStartedAt:
+		return m.StartedAt()
+	case scan.FieldCompletedAt:
+		return m.CompletedAt()
+	case scan.FieldDuplicateGroupCount:
+		return m.DuplicateGroupCount()
+	case scan.FieldFilesPrunedAt:
+		return m.FilesPrunedAt()
+	case scan.FieldStatus:
+		return m.Status()
+	case scan.FieldStatusMessage:
+		return m.StatusMessage()
+	case scan.FieldFilesSeen:
+		return m.FilesSeen()
+	case scan.FieldBytesHashed:
+		return m.BytesHashed()
+	case scan.FieldMachinesReported:
+		return m.MachinesReported()
+	case scan.FieldLastHeartbeatAt:
+		return m.LastHeartbeatAt()
+	case scan.FieldDeadline:
+		return m.Deadline()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ScanMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case scan.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case scan.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case scan.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case scan.FieldInitiatedMachineID:
+		return m.OldInitiatedMachineID(ctx)
+	case scan.FieldName:
+		return m.OldName(ctx)
+	case scan.FieldDescription:
+		return m.OldDescription(ctx)
+	case scan.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case scan.FieldCompletedAt:
+		return m.OldCompletedAt(ctx)
+	case scan.FieldDuplicateGroupCount:
+		return m.OldDuplicateGroupCount(ctx)
+	case scan.FieldFilesPrunedAt:
+		return m.OldFilesPrunedAt(ctx)
+	case scan.FieldStatus:
+		return m.OldStatus(ctx)
+	case scan.FieldStatusMessage:
+		return m.OldStatusMessage(ctx)
+	case scan.FieldFilesSeen:
+		return m.OldFilesSeen(ctx)
+	case scan.FieldBytesHashed:
+		return m.OldBytesHashed(ctx)
+	case scan.FieldMachinesReported:
+		return m.OldMachinesReported(ctx)
+	case scan.FieldLastHeartbeatAt:
+		return m.OldLastHeartbeatAt(ctx)
+	case scan.FieldDeadline:
+		return m.OldDeadline(ctx)
+	}
+	return nil, fmt.Errorf("unknown Scan field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ScanMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case scan.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case scan.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case scan.FieldTenantID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case scan.FieldInitiatedMachineID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInitiatedMachineID(v)
+		return nil
+	case scan.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case scan.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case scan.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case scan.FieldCompletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletedAt(v)
+		return nil
+	case scan.FieldDuplicateGroupCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDuplicateGroupCount(v)
+		return nil
+	case scan.FieldFilesPrunedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFilesPrunedAt(v)
+		return nil
+	case scan.FieldStatus:
+		v, ok := value.(scan.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case scan.FieldStatusMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatusMessage(v)
+		return nil
+	case scan.FieldFilesSeen:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFilesSeen(v)
+		return nil
+	case scan.FieldBytesHashed:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBytesHashed(v)
+		return nil
+	case scan.FieldMachinesReported:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMachinesReported(v)
+		return nil
+	case scan.FieldLastHeartbeatAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastHeartbeatAt(v)
+		return nil
+	case scan.FieldDeadline:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeadline(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Scan field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ScanMutation) AddedFields() []string {
+	var fields []string
+	if m.addduplicate_group_count != nil {
+		fields = append(fields, scan.FieldDuplicateGroupCount)
+	}
+	if m.addfiles_seen != nil {
+		fields = append(fields, scan.FieldFilesSeen)
+	}
+	if m.addbytes_hashed != nil {
+		fields = append(fields, scan.FieldBytesHashed)
+	}
+	if m.addmachines_reported != nil {
+		fields = append(fields, scan.FieldMachinesReported)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ScanMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case scan.FieldDuplicateGroupCount:
+		return m.AddedDuplicateGroupCount()
+	case scan.FieldFilesSeen:
+		return m.AddedFilesSeen()
+	case scan.FieldBytesHashed:
+		return m.AddedBytesHashed()
+	case scan.FieldMachinesReported:
+		return m.AddedMachinesReported()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ScanMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case scan.FieldDuplicateGroupCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDuplicateGroupCount(v)
+		return nil
+	case scan.FieldFilesSeen:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFilesSeen(v)
+		return nil
+	case scan.FieldBytesHashed:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBytesHashed(v)
+		return nil
+	case scan.FieldMachinesReported:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMachinesReported(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Scan numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ScanMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(scan.FieldInitiatedMachineID) {
+		fields = append(fields, scan.FieldInitiatedMachineID)
+	}
+	if m.FieldCleared(scan.FieldDescription) {
+		fields = append(fields, scan.FieldDescription)
+	}
+	if m.FieldCleared(scan.FieldCompletedAt) {
+		fields = append(fields, scan.FieldCompletedAt)
+	}
+	if m.FieldCleared(scan.FieldFilesPrunedAt) {
+		fields = append(fields, scan.FieldFilesPrunedAt)
+	}
+	if m.FieldCleared(scan.FieldStatusMessage) {
+		fields = append(fields, scan.FieldStatusMessage)
+	}
+	if m.FieldCleared(scan.FieldLastHeartbeatAt) {
+		fields = append(fields, scan.FieldLastHeartbeatAt)
+	}
+	if m.FieldCleared(scan.FieldDeadline) {
+		fields = append(fields, scan.FieldDeadline)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ScanMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ScanMutation) ClearField(name string) error {
+	switch name {
+	case scan.FieldInitiatedMachineID:
+		m.ClearInitiatedMachineID()
+		return nil
+	case scan.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case scan.FieldCompletedAt:
+		m.ClearCompletedAt()
+		return nil
+	case scan.FieldFilesPrunedAt:
+		m.ClearFilesPrunedAt()
+		return nil
+	case scan.FieldStatusMessage:
+		m.ClearStatusMessage()
+		return nil
+	case scan.FieldLastHeartbeatAt:
+		m.ClearLastHeartbeatAt()
+		return nil
+	case scan.FieldDeadline:
+		m.ClearDeadline()
+		return nil
+	}
+	return fmt.Errorf("unknown Scan nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ScanMutation) ResetField(name string) error {
+	switch name {
+	case scan.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case scan.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case scan.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case scan.FieldInitiatedMachineID:
+		m.ResetInitiatedMachineID()
+		return nil
+	case scan.FieldName:
+		m.ResetName()
+		return nil
+	case scan.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case scan.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case scan.FieldCompletedAt:
+		m.ResetCompletedAt()
+		return nil
+	case scan.FieldDuplicateGroupCount:
+		m.ResetDuplicateGroupCount()
+		return nil
+	case scan.FieldFilesPrunedAt:
+		m.ResetFilesPrunedAt()
+		return nil
+	case scan.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case scan.FieldStatusMessage:
+		m.ResetStatusMessage()
+		return nil
+	case scan.FieldFilesSeen:
+		m.ResetFilesSeen()
+		return nil
+	case scan.FieldBytesHashed:
+		m.ResetBytesHashed()
+		return nil
+	case scan.FieldMachinesReported:
+		m.ResetMachinesReported()
+		return nil
+	case scan.FieldLastHeartbeatAt:
+		m.ResetLastHeartbeatAt()
+		return nil
+	case scan.FieldDeadline:
+		m.ResetDeadline()
+		return nil
+	}
+	return fmt.Errorf("unknown Scan field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ScanMutation) AddedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.tenant != nil {
+		edges = append(edges, scan.EdgeTenant)
+	}
+	if m.initiated_machine != nil {
+		edges = append(edges, scan.EdgeInitiatedMachine)
+	}
+	if m.duplicate_groups != nil {
+		edges = append(edges, scan.EdgeDuplicateGroups)
+	}
+	if m.content_events != nil {
+		edges = append(edges, scan.EdgeContentEvents)
+	}
+	if m.targets != nil {
+		edges = append(edges, scan.EdgeTargets)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ScanMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case scan.EdgeTenant:
+		if id := m.tenant; id != nil {
+			return []ent.Value{*id}
+		}
+	case scan.EdgeInitiatedMachine:
+		if id := m.initiated_machine; id != nil {
+			return []ent.Value{*id}
+		}
+	case scan.EdgeDuplicateGroups:
+		ids := make([]ent.Value, 0, len(m.duplicate_groups))
+		for id := range m.duplicate_groups {
+			ids = append(ids, id)
+		}
+		return ids
+	case scan.EdgeContentEvents:
+		ids := make([]ent.Value, 0, len(m.content_events))
+		for id := range m.content_events {
+			ids = append(ids, id)
+		}
+		return ids
+	case scan.EdgeTargets:
+		ids := make([]ent.Value, 0, len(m.targets))
+		for id := range m.targets {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ScanMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.removedduplicate_groups != nil {
+		edges = append(edges, scan.EdgeDuplicateGroups)
+	}
+	if m.removedcontent_events != nil {
+		edges = append(edges, scan.EdgeContentEvents)
+	}
+	if m.removedtargets != nil {
+		edges = append(edges, scan.EdgeTargets)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ScanMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case scan.EdgeDuplicateGroups:
+		ids := make([]ent.Value, 0, len(m.removedduplicate_groups))
+		for id := range m.removedduplicate_groups {
+			ids = append(ids, id)
+		}
+		return ids
+	case scan.EdgeContentEvents:
+		ids := make([]ent.Value, 0, len(m.removedcontent_events))
+		for id := range m.removedcontent_events {
+			ids = append(ids, id)
+		}
+		return ids
+	case scan.EdgeTargets:
+		ids := make([]ent.Value, 0, len(m.removedtargets))
+		for id := range m.removedtargets {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ScanMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.clearedtenant {
+		edges = append(edges, scan.EdgeTenant)
+	}
+	if m.clearedinitiated_machine {
+		edges = append(edges, scan.EdgeInitiatedMachine)
+	}
+	if m.clearedduplicate_groups {
+		edges = append(edges, scan.EdgeDuplicateGroups)
+	}
+	if m.clearedcontent_events {
+		edges = append(edges, scan.EdgeContentEvents)
+	}
+	if m.clearedtargets {
+		edges = append(edges, scan.EdgeTargets)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ScanMutation) EdgeCleared(name string) bool {
+	switch name {
+	case scan.EdgeTenant:
+		return m.clearedtenant
+	case scan.EdgeInitiatedMachine:
+		return m.clearedinitiated_machine
+	case scan.EdgeDuplicateGroups:
+		return m.clearedduplicate_groups
+	case scan.EdgeContentEvents:
+		return m.clearedcontent_events
+	case scan.EdgeTargets:
+		return m.clearedtargets
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ScanMutation) ClearEdge(name string) error {
+	switch name {
+	case scan.EdgeTenant:
+		m.ClearTenant()
+		return nil
+	case scan.EdgeInitiatedMachine:
+		m.ClearInitiatedMachine()
+		return nil
+	}
+	return fmt.Errorf("unknown Scan unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ScanMutation) ResetEdge(name string) error {
+	switch name {
+	case scan.EdgeTenant:
+		m.ResetTenant()
+		return nil
+	case scan.EdgeInitiatedMachine:
+		m.ResetInitiatedMachine()
+		return nil
+	case scan.EdgeDuplicateGroups:
+		m.ResetDuplicateGroups()
+		return nil
+	case scan.EdgeContentEvents:
+		m.ResetContentEvents()
+		return nil
+	case scan.EdgeTargets:
+		m.ResetTargets()
+		return nil
+	}
+	return fmt.Errorf("unknown Scan edge %s", name)
+}
+
+// ScanScheduleMutation represents an operation that mutates the ScanSchedule nodes in the graph.
+type ScanScheduleMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	create_time         *time.Time
+	update_time         *time.Time
+	name                *string
+	cron                *string
+	timezone            *string
+	machine_ids         *[]string
+	appendmachine_ids   []string
+	roots               *[]string
+	appendroots         []string
+	exclusions          *[]string
+	appendexclusions    []string
+	deadline_seconds    *int
+	adddeadline_seconds *int
+	enabled             *bool
+	next_run_at         *time.Time
+	last_run_at         *time.Time
+	last_scan_id        *uuid.UUID
+	clearedFields       map[string]struct{}
+	tenant              *uuid.UUID
+	clearedtenant       bool
+	runs                map[uuid.UUID]struct{}
+	removedruns         map[uuid.UUID]struct{}
+	clearedruns         bool
+	done                bool
+	oldValue            func(context.Context) (*ScanSchedule, error)
+	predicates          []predicate.ScanSchedule
+}
+
+var _ ent.Mutation = (*ScanScheduleMutation)(nil)
+
+// scanscheduleOption allows management of the mutation configuration using functional options.
+type scanscheduleOption func(*ScanScheduleMutation)
+
+// newScanScheduleMutation creates new mutation for the ScanSchedule entity.
+func newScanScheduleMutation(c config, op Op, opts ...scanscheduleOption) *ScanScheduleMutation {
+	m := &ScanScheduleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeScanSchedule,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withScanScheduleID sets the ID field of the mutation.
+func withScanScheduleID(id uuid.UUID) scanscheduleOption {
+	return func(m *ScanScheduleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ScanSchedule
+		)
+		m.oldValue = func(ctx context.Context) (*ScanSchedule, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ScanSchedule.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withScanSchedule sets the old ScanSchedule of the mutation.
+func withScanSchedule(node *ScanSchedule) scanscheduleOption {
+	return func(m *ScanScheduleMutation) {
+		m.oldValue = func(context.Context) (*ScanSchedule, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ScanScheduleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ScanScheduleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ScanSchedule entities.
+func (m *ScanScheduleMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ScanScheduleMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ScanScheduleMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ScanSchedule.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *ScanScheduleMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *ScanScheduleMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the ScanSchedule entity.
+// If the ScanSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScanScheduleMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *ScanScheduleMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *ScanScheduleMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *ScanScheduleMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the ScanSchedule entity.
+// If the ScanSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScanScheduleMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *ScanScheduleMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *ScanScheduleMutation) SetTenantID(u uuid.UUID) {
+	m.tenant = &u
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *ScanScheduleMutation) TenantID() (r uuid.UUID, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the ScanSchedule entity.
+// If the ScanSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScanScheduleMutation) OldTenantID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *ScanScheduleMutation) ResetTenantID() {
+	m.tenant = nil
+}
+
+// SetName sets the "name" field.
+func (m *ScanScheduleMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ScanScheduleMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the ScanSchedule entity.
+// If the ScanSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScanScheduleMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ScanScheduleMutation) ResetName() {
+	m.name = nil
+}
+
+// SetCron sets the "cron" field.
+func (m *ScanScheduleMutation) SetCron(s string) {
+	m.cron = &s
+}
+
+// Cron returns the value of the "cron" field in the mutation.
+func (m *ScanScheduleMutation) Cron() (r string, exists bool) {
+	v := m.cron
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCron returns the old "cron" field's value of the ScanSchedule entity.
+// If the ScanSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScanScheduleMutation) OldCron(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCron is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCron requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCron: %w", err)
+	}
+	return oldValue.Cron, nil
+}
+
+// ResetCron resets all changes to the "cron" field.
+func (m *ScanScheduleMutation) ResetCron() {
+	m.cron = nil
+}
+
+// SetTimezone sets the "timezone" field.
+func (m *ScanScheduleMutation) SetTimezone(s string) {
+	m.timezone = &s
+}
+
+// Timezone returns the value of the "timezone" field in the mutation.
+func (m *ScanScheduleMutation) Timezone() (r string, exists bool) {
+	v := m.timezone
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimezone returns the old "timezone" field's value of the ScanSchedule entity.
+// If the ScanSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScanScheduleMutation) OldTimezone(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimezone is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimezone requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimezone: %w", err)
+	}
+	return oldValue.Timezone, nil
+}
+
+// ResetTimezone resets all changes to the "timezone" field.
+func (m *ScanScheduleMutation) ResetTimezone() {
+	m.timezone = nil
+}
+
+// SetMachineIds sets the "machine_ids" field.
+func (m *ScanScheduleMutation) SetMachineIds(s []string) {
+	m.machine_ids = &s
+	m.appendmachine_ids = nil
+}
+
+// MachineIds returns the value of the "machine_ids" field in the mutation.
+func (m *ScanScheduleMutation) MachineIds() (r []string, exists bool) {
+	v := m.machine_ids
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMachineIds returns the old "machine_ids" field's value of the ScanSchedule entity.
+// If the ScanSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScanScheduleMutation) OldMachineIds(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMachineIds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMachineIds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMachineIds: %w", err)
+	}
+	return oldValue.MachineIds, nil
+}
+
+// AppendMachineIds adds s to the "machine_ids" field.
+func (m *ScanScheduleMutation) AppendMachineIds(s []string) {
+	m.appendmachine_ids = append(m.appendmachine_ids, s...)
+}
+
+// AppendedMachineIds returns the list of values that were appended to the "machine_ids" field in this mutation.
+func (m *ScanScheduleMutation) AppendedMachineIds() ([]string, bool) {
+	if len(m.appendmachine_ids) == 0 {
+		return nil, false
+	}
+	return m.appendmachine_ids, true
+}
+
+// ResetMachineIds resets all changes to the "machine_ids" field.
+func (m *ScanScheduleMutation) ResetMachineIds() {
+	m.machine_ids = nil
+	m.appendmachine_ids = nil
+}
+
+// SetRoots sets the "roots" field.
+func (m *ScanScheduleMutation) SetRoots(s []string) {
+	m.roots = &s
+	m.appendroots = nil
+}
+
+// Roots returns the value of the "roots" field in the mutation.
+func (m *ScanScheduleMutation) Roots() (r []string, exists bool) {
+	v := m.roots
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoots returns the old "roots" field's value of the ScanSchedule entity.
+// If the ScanSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScanScheduleMutation) OldRoots(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoots is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoots requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoots: %w", err)
+	}
+	return oldValue.Roots, nil
+}
+
+// AppendRoots adds s to the "roots" field.
+func (m *ScanScheduleMutation) AppendRoots(s []string) {
+	m.appendroots = append(m.appendroots, s...)
+}
+
+// AppendedRoots returns the list of values that were appended to the "roots" field in this mutation.
+func (m *ScanScheduleMutation) AppendedRoots() ([]string, bool) {
+	if len(m.appendroots) == 0 {
+		return nil, false
+	}
+	return m.appendroots, true
+}
+
+// ResetRoots resets all changes to the "roots" field.
+func (m *ScanScheduleMutation) ResetRoots() {
+	m.roots = nil
+	m.appendroots = nil
+}
+
+// SetExclusions sets the "exclusions" field.
+func (m *ScanScheduleMutation) SetExclusions(s []string) {
+	m.exclusions = &s
+	m.appendexclusions = nil
+}
+
+// Exclusions returns the value of the "exclusions" field in the mutation.
+func (m *ScanScheduleMutation) Exclusions() (r []string, exists bool) {
+	v := m.exclusions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExclusions returns the old "exclusions" field's value of the ScanSchedule entity.
+// If the ScanSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScanScheduleMutation) OldExclusions(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExclusions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExclusions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExclusions: %w", err)
+	}
+	return oldValue.Exclusions, nil
+}
+
+// AppendExclusions adds s to the "exclusions" field.
+func (m *ScanScheduleMutation) AppendExclusions(s []string) {
+	m.appendexclusions = append(m.appendexclusions, s...)
+}
+
+// AppendedExclusions returns the list of values that were appended to the "exclusions" field in this mutation.
+func (m *ScanScheduleMutation) AppendedExclusions() ([]string, bool) {
+	if len(m.appendexclusions) == 0 {
+		return nil, false
+	}
+	return m.appendexclusions, true
+}
+
+// ClearExclusions clears the value of the "exclusions" field.
+func (m *ScanScheduleMutation) ClearExclusions() {
+	m.exclusions = nil
+	m.appendexclusions = nil
+	m.clearedFields[scanschedule.FieldExclusions] = struct{}{}
+}
+
+// ExclusionsCleared returns if the "exclusions" field was cleared in this mutation.
+func (m *ScanScheduleMutation) ExclusionsCleared() bool {
+	_, ok := m.clearedFields[scanschedule.FieldExclusions]
+	return ok
+}
+
+// ResetExclusions resets all changes to the "exclusions" field.
+func (m *ScanScheduleMutation) ResetExclusions() {
+	m.exclusions = nil
+	m.appendexclusions = nil
+	delete(m.clearedFields, scanschedule.FieldExclusions)
+}
+
+// SetDeadlineSeconds sets the "deadline_seconds" field.
+func (m *ScanScheduleMutation) SetDeadlineSeconds(i int) {
+	m.deadline_seconds = &i
+	m.adddeadline_seconds = nil
+}
+
+// DeadlineSeconds returns the value of the "deadline_seconds" field in the mutation.
+func (m *ScanScheduleMutation) DeadlineSeconds() (r int, exists bool) {
+	v := m.deadline_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeadlineSeconds returns the old "deadline_seconds" field's value of the ScanSchedule entity.
+// If the ScanSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScanScheduleMutation) OldDeadlineSeconds(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeadlineSeconds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeadlineSeconds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeadlineSeconds: %w", err)
+	}
+	return oldValue.DeadlineSeconds, nil
+}
+
+// AddDeadlineSeconds adds i to the "deadline_seconds" field.
+func (m *ScanScheduleMutation) AddDeadlineSeconds(i int) {
+	if m.adddeadline_seconds != nil {
+		*m.adddeadline_seconds += i
+	} else {
+		m.adddeadline_seconds = &i
+	}
+}
+
+// AddedDeadlineSeconds returns the value that was added to the "deadline_seconds" field in this mutation.
+func (m *ScanScheduleMutation) AddedDeadlineSeconds() (r int, exists bool) {
+	v := m.adddeadline_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDeadlineSeconds resets all changes to the "deadline_seconds" field.
+func (m *ScanScheduleMutation) ResetDeadlineSeconds() {
+	m.deadline_seconds = nil
+	m.adddeadline_seconds = nil
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *ScanScheduleMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *ScanScheduleMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the ScanSchedule entity.
+// If the ScanSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScanScheduleMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *ScanScheduleMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (m *ScanScheduleMutation) SetNextRunAt(t time.Time) {
+	m.next_run_at = &t
+}
+
+// NextRunAt returns the value of the "next_run_at" field in the mutation.
+func (m *ScanScheduleMutation) NextRunAt() (r time.Time, exists bool) {
+	v := m.next_run_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextRunAt returns the old "next_run_at" field's value of the ScanSchedule entity.
+// If the ScanSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScanScheduleMutation) OldNextRunAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextRunAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextRunAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextRunAt: %w", err)
+	}
+	return oldValue.NextRunAt, nil
+}
+
+// ResetNextRunAt resets all changes to the "next_run_at" field.
+func (m *ScanScheduleMutation) ResetNextRunAt() {
+	m.next_run_at = nil
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (m *ScanScheduleMutation) SetLastRunAt(t time.Time) {
+	m.last_run_at = &t
+}
+
+// LastRunAt returns the value of the "last_run_at" field in the mutation.
+func (m *ScanScheduleMutation) LastRunAt() (r time.Time, exists bool) {
+	v := m.last_run_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastRunAt returns the old "last_run_at" field's value of the ScanSchedule entity.
+// If the ScanSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScanScheduleMutation) OldLastRunAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastRunAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastRunAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastRunAt: %w", err)
+	}
+	return oldValue.LastRunAt, nil
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (m *ScanScheduleMutation) ClearLastRunAt() {
+	m.last_run_at = nil
+	m.clearedFields[scanschedule.FieldLastRunAt] = struct{}{}
+}
+
+// LastRunAtCleared returns if the "last_run_at" field was cleared in this mutation.
+func (m *ScanScheduleMutation) LastRunAtCleared() bool {
+	_, ok := m.clearedFields[scanschedule.FieldLastRunAt]
+	return ok
+}
+
+// ResetLastRunAt resets all changes to the "last_run_at" field.
+func (m *ScanScheduleMutation) ResetLastRunAt() {
+	m.last_run_at = nil
+	delete(m.clearedFields, scanschedule.FieldLastRunAt)
+}
+
+// SetLastScanID sets the "last_scan_id" field.
+func (m *ScanScheduleMutation) SetLastScanID(u uuid.UUID) {
+	m.last_scan_id = &u
+}
+
+// LastScanID returns the value of the "last_scan_id" field in the mutation.
+func (m *ScanScheduleMutation) LastScanID() (r uuid.UUID, exists bool) {
+	v := m.last_scan_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastScanID returns the old "last_scan_id" field's value of the ScanSchedule entity.
+// If the ScanSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScanScheduleMutation) OldLastScanID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastScanID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastScanID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastScanID: %w", err)
+	}
+	return oldValue.LastScanID, nil
+}
+
+// ClearLastScanID clears the value of the "last_scan_id" field.
+func (m *ScanScheduleMutation) ClearLastScanID() {
+	m.last_scan_id = nil
+	m.clearedFields[scanschedule.FieldLastScanID] = struct{}{}
+}
+
+// LastScanIDCleared returns if the "last_scan_id" field was cleared in this mutation.
+func (m *ScanScheduleMutation) LastScanIDCleared() bool {
+	_, ok := m.clearedFields[scanschedule.FieldLastScanID]
+	return ok
+}
+
+// ResetLastScanID resets all changes to the "last_scan_id" field.
+func (m *ScanScheduleMutation) ResetLastScanID() {
+	m.last_scan_id = nil
+	delete(m.clearedFields, scanschedule.FieldLastScanID)
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (m *ScanScheduleMutation) ClearTenant() {
+	m.clearedtenant = true
+	m.clearedFields[scanschedule.FieldTenantID] = struct{}{}
+}
+
+// TenantCleared reports if the "tenant" edge to the Tenant entity was cleared.
+func (m *ScanScheduleMutation) TenantCleared() bool {
+	return m.clearedtenant
+}
+
+// TenantIDs returns the "tenant" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TenantID instead. It exists only for internal usage by the builders.
+func (m *ScanScheduleMutation) TenantIDs() (ids []uuid.UUID) {
+	if id := m.tenant; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTenant resets all changes to the "tenant" edge.
+func (m *ScanScheduleMutation) ResetTenant() {
+	m.tenant = nil
+	m.clearedtenant = false
+}
+
+// AddRunIDs adds the "runs" edge to the ScanScheduleRun entity by ids.
+func (m *ScanScheduleMutation) AddRunIDs(ids ...uuid.UUID) {
+	if m.runs == nil {
+		m.runs = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.runs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRuns clears the "runs" edge to the ScanScheduleRun entity.
+func (m *ScanScheduleMutation) ClearRuns() {
+	m.clearedruns = true
+}
+
+// RunsCleared reports if the "runs" edge to the ScanScheduleRun entity was cleared.
+func (m *ScanScheduleMutation) RunsCleared() bool {
+	return m.clearedruns
+}
+
+// RemoveRunIDs removes the "runs" edge to the ScanScheduleRun entity by IDs.
+func (m *ScanScheduleMutation) RemoveRunIDs(ids ...uuid.UUID) {
+	if m.removedruns == nil {
+		m.removedruns = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.runs, ids[i])
+		m.removedruns[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRuns returns the removed IDs of the "runs" edge to the ScanScheduleRun entity.
+func (m *ScanScheduleMutation) RemovedRunsIDs() (ids []uuid.UUID) {
+	for id := range m.removedruns {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RunsIDs returns the "runs" edge IDs in the mutation.
+func (m *ScanScheduleMutation) RunsIDs() (ids []uuid.UUID) {
+	for id := range m.runs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRuns resets all changes to the "runs" edge.
+func (m *ScanScheduleMutation) ResetRuns() {
+	m.runs = nil
+	m.clearedruns = false
+	m.removedruns = nil
+}
+
+// Where appends a list predicates to the ScanScheduleMutation builder.
+func (m *ScanScheduleMutation) Where(ps ...predicate.ScanSchedule) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ScanScheduleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ScanScheduleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ScanSchedule, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ScanScheduleMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ScanScheduleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ScanSchedule).
+func (m *ScanScheduleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ScanScheduleMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.create_time != nil {
+		fields = append(fields, scanschedule.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, scanschedule.FieldUpdateTime)
+	}
+	if m.tenant != nil {
+		fields = append(fields, scanschedule.FieldTenantID)
+	}
+	if m.name != nil {
+		fields = append(fields, scanschedule.FieldName)
+	}
+	if m.cron != nil {
+		fields = append(fields, scanschedule.FieldCron)
+	}
+	if m.timezone != nil {
+		fields = append(fields, scanschedule.FieldTimezone)
+	}
+	if m.machine_ids != nil {
+		fields = append(fields, scanschedule.FieldMachineIds)
+	}
+	if m.roots != nil {
+		fields = append(fields, scanschedule.FieldRoots)
+	}
+	if m.exclusions != nil {
+		fields = append(fields, scanschedule.FieldExclusions)
+	}
+	if m.deadline_seconds != nil {
+		fields = append(fields, scanschedule.FieldDeadlineSeconds)
+	}
+	if m.enabled != nil {
+		fields = append(fields, scanschedule.FieldEnabled)
+	}
+	if m.next_run_at != nil {
+		fields = append(fields, scanschedule.FieldNextRunAt)
+	}
+	if m.last_run_at != nil {
+		fields = append(fields, scanschedule.FieldLastRunAt)
+	}
+	if m.last_scan_id != nil {
+		fields = append(fields, scanschedule.FieldLastScanID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ScanScheduleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case scanschedule.FieldCreateTime:
+		return m.CreateTime()
+	case scanschedule.FieldUpdateTime:
+		return m.UpdateTime()
+	case scanschedule.FieldTenantID:
+		return m.TenantID()
+	case scanschedule.FieldName:
+		return m.Name()
+	case scanschedule.FieldCron:
+		return m.Cron()
+	case scanschedule.FieldTimezone:
+		return m.Timezone()
+	case scanschedule.FieldMachineIds:
+		return m.MachineIds()
+	case scanschedule.FieldRoots:
+		return m.Roots()
+	case scanschedule.FieldExclusions:
+		return m.Exclusions()
+	case scanschedule.FieldDeadlineSeconds:
+		return m.DeadlineSeconds()
+	case scanschedule.FieldEnabled:
+		return m.Enabled()
+	case scanschedule.FieldNextRunAt:
+		return m.NextRunAt()
+	case scanschedule.FieldLastRunAt:
+		return m.LastRunAt()
+	case scanschedule.FieldLastScanID:
+		return m.LastScanID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ScanScheduleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case scanschedule.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case scanschedule.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case scanschedule.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case scanschedule.FieldName:
+		return m.OldName(ctx)
+	case scanschedule.FieldCron:
+		return m.OldCron(ctx)
+	case scanschedule.FieldTimezone:
+		return m.OldTimezone(ctx)
+	case scanschedule.FieldMachineIds:
+		return m.OldMachineIds(ctx)
+	case scanschedule.FieldRoots:
+		return m.OldRoots(ctx)
+	case scanschedule.FieldExclusions:
+		return m.OldExclusions(ctx)
+	case scanschedule.FieldDeadlineSeconds:
+		return m.OldDeadlineSeconds(ctx)
+	case scanschedule.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case scanschedule.FieldNextRunAt:
+		return m.OldNextRunAt(ctx)
+	case scanschedule.FieldLastRunAt:
+		return m.OldLastRunAt(ctx)
+	case scanschedule.FieldLastScanID:
+		return m.OldLastScanID(ctx)
+	}
+	return nil, fmt.Errorf("unknown ScanSchedule field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ScanScheduleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case scanschedule.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case scanschedule.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case scanschedule.FieldTenantID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case scanschedule.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case scanschedule.FieldCron:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCron(v)
+		return nil
+	case scanschedule.FieldTimezone:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimezone(v)
+		return nil
+	case scanschedule.FieldMachineIds:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMachineIds(v)
+		return nil
+	case scanschedule.FieldRoots:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoots(v)
+		return nil
+	case scanschedule.FieldExclusions:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExclusions(v)
+		return nil
+	case scanschedule.FieldDeadlineSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeadlineSeconds(v)
+		return nil
+	case scanschedule.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case scanschedule.FieldNextRunAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextRunAt(v)
+		return nil
+	case scanschedule.FieldLastRunAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastRunAt(v)
+		return nil
+	case scanschedule.FieldLastScanID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastScanID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ScanSchedule field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ScanScheduleMutation) AddedFields() []string {
+	var fields []string
+	if m.adddeadline_seconds != nil {
+		fields = append(fields, scanschedule.FieldDeadlineSeconds)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ScanScheduleMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case scanschedule.FieldDeadlineSeconds:
+		return m.AddedDeadlineSeconds()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ScanScheduleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case scanschedule.FieldDeadlineSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDeadlineSeconds(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ScanSchedule numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ScanScheduleMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(scanschedule.FieldExclusions) {
+		fields = append(fields, scanschedule.FieldExclusions)
+	}
+	if m.FieldCleared(scanschedule.FieldLastRunAt) {
+		fields = append(fields, scanschedule.FieldLastRunAt)
+	}
+	if m.FieldCleared(scanschedule.FieldLastScanID) {
+		fields = append(fields, scanschedule.FieldLastScanID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ScanScheduleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ScanScheduleMutation) ClearField(name string) error {
+	switch name {
+	case scanschedule.FieldExclusions:
+		m.ClearExclusions()
+		return nil
+	case scanschedule.FieldLastRunAt:
+		m.ClearLastRunAt()
+		return nil
+	case scanschedule.FieldLastScanID:
+		m.ClearLastScanID()
+		return nil
+	}
+	return fmt.Errorf("unknown ScanSchedule nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ScanScheduleMutation) ResetField(name string) error {
+	switch name {
+	case scanschedule.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case scanschedule.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case scanschedule.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case scanschedule.FieldName:
+		m.ResetName()
+		return nil
+	case scanschedule.FieldCron:
+		m.ResetCron()
+		return nil
+	case scanschedule.FieldTimezone:
+		m.ResetTimezone()
+		return nil
+	case scanschedule.FieldMachineIds:
+		m.ResetMachineIds()
+		return nil
+	case scanschedule.FieldRoots:
+		m.ResetRoots()
+		return nil
+	case scanschedule.FieldExclusions:
+		m.ResetExclusions()
+		return nil
+	case scanschedule.FieldDeadlineSeconds:
+		m.ResetDeadlineSeconds()
+		return nil
+	case scanschedule.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case scanschedule.FieldNextRunAt:
+		m.ResetNextRunAt()
+		return nil
+	case scanschedule.FieldLastRunAt:
+		m.ResetLastRunAt()
+		return nil
+	case scanschedule.FieldLastScanID:
+		m.ResetLastScanID()
+		return nil
+	}
+	return fmt.Errorf("unknown ScanSchedule field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ScanScheduleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.tenant != nil {
+		edges = append(edges, scanschedule.EdgeTenant)
+	}
+	if m.runs != nil {
+		edges = append(edges, scanschedule.EdgeRuns)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ScanScheduleMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case scanschedule.EdgeTenant:
+		if id := m.tenant; id != nil {
+			return []ent.Value{*id}
+		}
+	case scanschedule.EdgeRuns:
+		ids := make([]ent.Value, 0, len(m.runs))
+		for id := range m.runs {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ScanScheduleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedruns != nil {
+		edges = append(edges, scanschedule.EdgeRuns)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ScanScheduleMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case scanschedule.EdgeRuns:
+		ids := make([]ent.Value, 0, len(m.removedruns))
+		for id := range m.removedruns {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ScanScheduleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedtenant {
+		edges = append(edges, scanschedule.EdgeTenant)
+	}
+	if m.clearedruns {
+		edges = append(edges, scanschedule.EdgeRuns)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ScanScheduleMutation) EdgeCleared(name string) bool {
+	switch name {
+	case scanschedule.EdgeTenant:
+		return m.clearedtenant
+	case scanschedule.EdgeRuns:
+		return m.clearedruns
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ScanScheduleMutation) ClearEdge(name string) error {
+	switch name {
+	case scanschedule.EdgeTenant:
+		m.ClearTenant()
+		return nil
+	}
+	return fmt.Errorf("unknown ScanSchedule unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ScanScheduleMutation) ResetEdge(name string) error {
+	switch name {
+	case scanschedule.EdgeTenant:
+		m.ResetTenant()
+		return nil
+	case scanschedule.EdgeRuns:
+		m.ResetRuns()
+		return nil
+	}
+	return fmt.Errorf("unknown ScanSchedule edge %s", name)
+}
+
+// ScanScheduleRunMutation represents an operation that mutates the ScanScheduleRun nodes in the graph.
+type ScanScheduleRunMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	create_time     *time.Time
+	update_time     *time.Time
+	scheduled_for   *time.Time
+	status          *scanschedulerun.Status
+	scan_id         *uuid.UUID
+	reason          *string
+	clearedFields   map[string]struct{}
+	tenant          *uuid.UUID
+	clearedtenant   bool
+	schedule        *uuid.UUID
+	clearedschedule bool
+	done            bool
+	oldValue        func(context.Context) (*ScanScheduleRun, error)
+	predicates      []predicate.ScanScheduleRun
+}
+
+var _ ent.Mutation = (*ScanScheduleRunMutation)(nil)
+
+// scanschedulerunOption allows management of the mutation configuration using functional options.
+type scanschedulerunOption func(*ScanScheduleRunMutation)
+
+// newScanScheduleRunMutation creates new mutation for the ScanScheduleRun entity.
+func newScanScheduleRunMutation(c config, op Op, opts ...scanschedulerunOption) *ScanScheduleRunMutation {
+	m := &ScanScheduleRunMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeScanScheduleRun,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withScanScheduleRunID sets the ID field of the mutation.
+func withScanScheduleRunID(id uuid.UUID) scanschedulerunOption {
+	return func(m *ScanScheduleRunMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ScanScheduleRun
+		)
+		m.oldValue = func(ctx context.Context) (*ScanScheduleRun, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ScanScheduleRun.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withScanScheduleRun sets the old ScanScheduleRun of the mutation.
+func withScanScheduleRun(node *ScanScheduleRun) scanschedulerunOption {
+	return func(m *ScanScheduleRunMutation) {
+		m.oldValue = func(context.Context) (*ScanScheduleRun, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ScanScheduleRunMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ScanScheduleRunMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ScanScheduleRun entities.
+func (m *ScanScheduleRunMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ScanScheduleRunMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ScanScheduleRunMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ScanScheduleRun.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *ScanScheduleRunMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *ScanScheduleRunMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the ScanScheduleRun entity.
+// If the ScanScheduleRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScanScheduleRunMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *ScanScheduleRunMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *ScanScheduleRunMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *ScanScheduleRunMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the ScanScheduleRun entity.
+// If the ScanScheduleRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScanScheduleRunMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *ScanScheduleRunMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *ScanScheduleRunMutation) SetTenantID(u uuid.UUID) {
+	m.tenant = &u
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *ScanScheduleRunMutation) TenantID() (r uuid.UUID, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the ScanScheduleRun entity.
+// If the ScanScheduleRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScanScheduleRunMutation) OldTenantID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *ScanScheduleRunMutation) ResetTenantID() {
+	m.tenant = nil
+}
+
+// SetScheduleID sets the "schedule_id" field.
+func (m *ScanScheduleRunMutation) SetScheduleID(u uuid.UUID) {
+	m.schedule = &u
+}
+
+// ScheduleID returns the value of the "schedule_id" field in the mutation.
+func (m *ScanScheduleRunMutation) ScheduleID() (r uuid.UUID, exists bool) {
+	v := m.schedule
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScheduleID returns the old "schedule_id" field's value of the ScanScheduleRun entity.
+// If the ScanScheduleRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScanScheduleRunMutation) OldScheduleID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScheduleID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScheduleID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScheduleID: %w", err)
+	}
+	return oldValue.ScheduleID, nil
+}
+
+// ResetScheduleID resets all changes to the "schedule_id" field.
+func (m *ScanScheduleRunMutation) ResetScheduleID() {
+	m.schedule = nil
+}
+
+// SetScheduledFor sets the "scheduled_for" field.
+func (m *ScanScheduleRunMutation) SetScheduledFor(t time.Time) {
+	m.scheduled_for = &t
+}
+
+// ScheduledFor returns the value of the "scheduled_for" field in the mutation.
+func (m *ScanScheduleRunMutation) ScheduledFor() (r time.Time, exists bool) {
+	v := m.scheduled_for
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScheduledFor returns the old "scheduled_for" field's value of the ScanScheduleRun entity.
+// If the ScanScheduleRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScanScheduleRunMutation) OldScheduledFor(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScheduledFor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScheduledFor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScheduledFor: %w", err)
+	}
+	return oldValue.ScheduledFor, nil
+}
+
+// ResetScheduledFor resets all changes to the "scheduled_for" field.
+func (m *ScanScheduleRunMutation) ResetScheduledFor() {
+	m.scheduled_for = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *ScanScheduleRunMutation) SetStatus(s scanschedulerun.Status) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ScanScheduleRunMutation) Status() (r scanschedulerun.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the ScanScheduleRun entity.
+// If the ScanScheduleRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScanScheduleRunMutation) OldStatus(ctx context.Context) (v scanschedulerun.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ScanScheduleRunMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetScanID sets the "scan_id" field.
+func (m *ScanScheduleRunMutation) SetScanID(u uuid.UUID) {
+	m.scan_id = &u
+}
+
+// ScanID returns the value of the "scan_id" field in the mutation.
+func (m *ScanScheduleRunMutation) ScanID() (r uuid.UUID, exists bool) {
+	v := m.scan_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScanID returns the old "scan_id" field's value of the ScanScheduleRun entity.
+// If the ScanScheduleRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScanScheduleRunMutation) OldScanID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScanID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScanID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScanID: %w", err)
+	}
+	return oldValue.ScanID, nil
+}
+
+// ClearScanID clears the value of the "scan_id" field.
+func (m *ScanScheduleRunMutation) ClearScanID() {
+	m.scan_id = nil
+	m.clearedFields[scanschedulerun.FieldScanID] = struct{}{}
+}
+
+// ScanIDCleared returns if the "scan_id" field was cleared in this mutation.
+func (m *ScanScheduleRunMutation) ScanIDCleared() bool {
+	_, ok := m.clearedFields[scanschedulerun.FieldScanID]
+	return ok
+}
+
+// ResetScanID resets all changes to the "scan_id" field.
+func (m *ScanScheduleRunMutation) ResetScanID() {
+	m.scan_id = nil
+	delete(m.clearedFields, scanschedulerun.FieldScanID)
+}
+
+// SetReason sets the "reason" field.
+func (m *ScanScheduleRunMutation) SetReason(s string) {
+	m.reason = &s
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *ScanScheduleRunMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the ScanScheduleRun entity.
+// If the ScanScheduleRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScanScheduleRunMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ClearReason clears the value of the "reason" field.
+func (m *ScanScheduleRunMutation) ClearReason() {
+	m.reason = nil
+	m.clearedFields[scanschedulerun.FieldReason] = struct{}{}
+}
+
+// ReasonCleared returns if the "reason" field was cleared in this mutation.
+func (m *ScanScheduleRunMutation) ReasonCleared() bool {
+	_, ok := m.clearedFields[scanschedulerun.FieldReason]
+	return ok
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *ScanScheduleRunMutation) ResetReason() {
+	m.reason = nil
+	delete(m.clearedFields, scanschedulerun.FieldReason)
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (m *ScanScheduleRunMutation) ClearTenant() {
+	m.clearedtenant = true
+	m.clearedFields[scanschedulerun.FieldTenantID] = struct{}{}
+}
+
+// TenantCleared reports if the "tenant" edge to the Tenant entity was cleared.
+func (m *ScanScheduleRunMutation) TenantCleared() bool {
+	return m.clearedtenant
+}
+
+// TenantIDs returns the "tenant" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TenantID instead. It exists only for internal usage by the builders.
+func (m *ScanScheduleRunMutation) TenantIDs() (ids []uuid.UUID) {
+	if id := m.tenant; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTenant resets all changes to the "tenant" edge.
+func (m *ScanScheduleRunMutation) ResetTenant() {
+	m.tenant = nil
+	m.clearedtenant = false
+}
+
+// ClearSchedule clears the "schedule" edge to the ScanSchedule entity.
+func (m *ScanScheduleRunMutation) ClearSchedule() {
+	m.clearedschedule = true
+	m.clearedFields[scanschedulerun.FieldScheduleID] = struct{}{}
+}
+
+// ScheduleCleared reports if the "schedule" edge to the ScanSchedule entity was cleared.
+func (m *ScanScheduleRunMutation) ScheduleCleared() bool {
+	return m.clearedschedule
+}
+
+// ScheduleIDs returns the "schedule" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ScheduleID instead. It exists only for internal usage by the builders.
+func (m *ScanScheduleRunMutation) ScheduleIDs() (ids []uuid.UUID) {
+	if id := m.schedule; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSchedule resets all changes to the "schedule" edge.
+func (m *ScanScheduleRunMutation) ResetSchedule() {
+	m.schedule = nil
+	m.clearedschedule = false
+}
+
+// Where appends a list predicates to the ScanScheduleRunMutation builder.
+func (m *ScanScheduleRunMutation) Where(ps ...predicate.ScanScheduleRun) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ScanScheduleRunMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ScanScheduleRunMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ScanScheduleRun, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ScanScheduleRunMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ScanScheduleRunMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ScanScheduleRun).
+func (m *ScanScheduleRunMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ScanScheduleRunMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.create_time != nil {
+		fields = append(fields, scanschedulerun.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, scanschedulerun.FieldUpdateTime)
+	}
+	if m.tenant != nil {
+		fields = append(fields, scanschedulerun.FieldTenantID)
+	}
+	if m.schedule != nil {
+		fields = append(fields, scanschedulerun.FieldScheduleID)
+	}
+	if m.scheduled_for != nil {
+		fields = append(fields, scanschedulerun.FieldScheduledFor)
+	}
+	if m.status != nil {
+		fields = append(fields, scanschedulerun.FieldStatus)
+	}
+	if m.scan_id != nil {
+		fields = append(fields, scanschedulerun.FieldScanID)
+	}
+	if m.reason != nil {
+		fields = append(fields, scanschedulerun.FieldReason)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ScanScheduleRunMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case scanschedulerun.FieldCreateTime:
+		return m.CreateTime()
+	case scanschedulerun.FieldUpdateTime:
+		return m.UpdateTime()
+	case scanschedulerun.FieldTenantID:
+		return m.TenantID()
+	case scanschedulerun.FieldScheduleID:
+		return m.ScheduleID()
+	case scanschedulerun.FieldScheduledFor:
+		return m.ScheduledFor()
+	case scanschedulerun.FieldStatus:
+		return m.Status()
+	case scanschedulerun.FieldScanID:
+		return m.ScanID()
+	case scanschedulerun.FieldReason:
+		return m.Reason()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ScanScheduleRunMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case scanschedulerun.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case scanschedulerun.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case scanschedulerun.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case scanschedulerun.FieldScheduleID:
+		return m.OldScheduleID(ctx)
+	case scanschedulerun.FieldScheduledFor:
+		return m.OldScheduledFor(ctx)
+	case scanschedulerun.FieldStatus:
+		return m.OldStatus(ctx)
+	case scanschedulerun.FieldScanID:
+		return m.OldScanID(ctx)
+	case scanschedulerun.FieldReason:
+		return m.OldReason(ctx)
+	}
+	return nil, fmt.Errorf("unknown ScanScheduleRun field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ScanScheduleRunMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case scanschedulerun.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case scanschedulerun.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case scanschedulerun.FieldTenantID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case scanschedulerun.FieldScheduleID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScheduleID(v)
+		return nil
+	case scanschedulerun.FieldScheduledFor:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScheduledFor(v)
+		return nil
+	case scanschedulerun.FieldStatus:
+		v, ok := value.(scanschedulerun.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case scanschedulerun.FieldScanID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScanID(v)
+		return nil
+	case scanschedulerun.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ScanScheduleRun field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ScanScheduleRunMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ScanScheduleRunMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ScanScheduleRunMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ScanScheduleRun numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ScanScheduleRunMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(scanschedulerun.FieldScanID) {
+		fields = append(fields, scanschedulerun.FieldScanID)
+	}
+	if m.FieldCleared(scanschedulerun.FieldReason) {
+		fields = append(fields, scanschedulerun.FieldReason)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ScanScheduleRunMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ScanScheduleRunMutation) ClearField(name string) error {
+	switch name {
+	case scanschedulerun.FieldScanID:
+		m.ClearScanID()
+		return nil
+	case scanschedulerun.FieldReason:
+		m.ClearReason()
+		return nil
+	}
+	return fmt.Errorf("unknown ScanScheduleRun nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ScanScheduleRunMutation) ResetField(name string) error {
+	switch name {
+	case scanschedulerun.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case scanschedulerun.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case scanschedulerun.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case scanschedulerun.FieldScheduleID:
+		m.ResetScheduleID()
+		return nil
+	case scanschedulerun.FieldScheduledFor:
+		m.ResetScheduledFor()
+		return nil
+	case scanschedulerun.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case scanschedulerun.FieldScanID:
+		m.ResetScanID()
+		return nil
+	case scanschedulerun.FieldReason:
+		m.ResetReason()
+		return nil
+	}
+	return fmt.Errorf("unknown ScanScheduleRun field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ScanScheduleRunMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.tenant != nil {
+		edges = append(edges, scanschedulerun.EdgeTenant)
+	}
+	if m.schedule != nil {
+		edges = append(edges, scanschedulerun.EdgeSchedule)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ScanScheduleRunMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case scanschedulerun.EdgeTenant:
+		if id := m.tenant; id != nil {
+			return []ent.Value{*id}
+		}
+	case scanschedulerun.EdgeSchedule:
+		if id := m.schedule; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ScanScheduleRunMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ScanScheduleRunMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ScanScheduleRunMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedtenant {
+		edges = append(edges, scanschedulerun.EdgeTenant)
+	}
+	if m.clearedschedule {
+		edges = append(edges, scanschedulerun.EdgeSchedule)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ScanScheduleRunMutation) EdgeCleared(name string) bool {
+	switch name {
+	case scanschedulerun.EdgeTenant:
+		return m.clearedtenant
+	case scanschedulerun.EdgeSchedule:
+		return m.clearedschedule
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ScanScheduleRunMutation) ClearEdge(name string) error {
+	switch name {
+	case scanschedulerun.EdgeTenant:
+		m.ClearTenant()
+		return nil
+	case scanschedulerun.EdgeSchedule:
+		m.ClearSchedule()
+		return nil
+	}
+	return fmt.Errorf("unknown ScanScheduleRun unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ScanScheduleRunMutation) ResetEdge(name string) error {
+	switch name {
+	case scanschedulerun.EdgeTenant:
+		m.ResetTenant()
+		return nil
+	case scanschedulerun.EdgeSchedule:
+		m.ResetSchedule()
+		return nil
+	}
+	return fmt.Errorf("unknown ScanScheduleRun edge %s", name)
+}
+
+// ScanTargetMutation represents an operation that mutates the ScanTarget nodes in the graph.
+type ScanTargetMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	create_time      *time.Time
+	update_time      *time.Time
+	roots            *[]string
+	appendroots      []string
+	exclusions       *[]string
+	appendexclusions []string
+	status           *scantarget.Status
+	status_message   *string
+	claimed_at       *time.Time
+	reported_at      *time.Time
+	clearedFields    map[string]struct{}
+	tenant           *uuid.UUID
+	clearedtenant    bool
+	scan             *uuid.UUID
+	clearedscan      bool
+	machine          *uuid.UUID
+	clearedmachine   bool
+	done             bool
+	oldValue         func(context.Context) (*ScanTarget, error)
+	predicates       []predicate.ScanTarget
+}
+
+var _ ent.Mutation = (*ScanTargetMutation)(nil)
+
+// scantargetOption allows management of the mutation configuration using functional options.
+type scantargetOption func(*ScanTargetMutation)
+
+// newScanTargetMutation creates new mutation for the ScanTarget entity.
+func newScanTargetMutation(c config, op Op, opts ...scantargetOption) *ScanTargetMutation {
+	m := &ScanTargetMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeScanTarget,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withScanTargetID sets the ID field of the mutation.
+func withScanTargetID(id uuid.UUID) scantargetOption {
+	return func(m *ScanTargetMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ScanTarget
+		)
+		m.oldValue = func(ctx context.Context) (*ScanTarget, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ScanTarget.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withScanTarget sets the old ScanTarget of the mutation.
+func withScanTarget(node *ScanTarget) scantargetOption {
+	return func(m *ScanTargetMutation) {
+		m.oldValue = func(context.Context) (*ScanTarget, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ScanTargetMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ScanTargetMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ScanTarget entities.
+func (m *ScanTargetMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ScanTargetMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ScanTargetMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ScanTarget.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *ScanTargetMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *ScanTargetMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the ScanTarget entity.
+// If the ScanTarget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScanTargetMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *ScanTargetMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *ScanTargetMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *ScanTargetMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the ScanTarget entity.
+// If the ScanTarget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScanTargetMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *ScanTargetMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *ScanTargetMutation) SetTenantID(u uuid.UUID) {
+	m.tenant = &u
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *ScanTargetMutation) TenantID() (r uuid.UUID, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the ScanTarget entity.
+// If the ScanTarget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScanTargetMutation) OldTenantID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *ScanTargetMutation) ResetTenantID() {
+	m.tenant = nil
+}
+
+// SetScanID sets the "scan_id" field.
+func (m *ScanTargetMutation) SetScanID(u uuid.UUID) {
+	m.scan = &u
+}
+
+// ScanID returns the value of the "scan_id" field in the mutation.
+func (m *ScanTargetMutation) ScanID() (r uuid.UUID, exists bool) {
+	v := m.scan
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScanID returns the old "scan_id" field's value of the ScanTarget entity.
+// If the ScanTarget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScanTargetMutation) OldScanID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScanID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScanID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScanID: %w", err)
+	}
+	return oldValue.ScanID, nil
+}
+
+// ResetScanID resets all changes to the "scan_id" field.
+func (m *ScanTargetMutation) ResetScanID() {
+	m.scan = nil
+}
+
+// SetMachineID sets the "machine_id" field.
+func (m *ScanTargetMutation) SetMachineID(u uuid.UUID) {
+	m.machine = &u
+}
+
+// MachineID returns the value of the "machine_id" field in the mutation.
+func (m *ScanTargetMutation) MachineID() (r uuid.UUID, exists bool) {
+	v := m.machine
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMachineID returns the old "machine_id" field's value of the ScanTarget entity.
+// If the ScanTarget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScanTargetMutation) OldMachineID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMachineID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMachineID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMachineID: %w", err)
+	}
+	return oldValue.MachineID, nil
+}
+
+// ResetMachineID resets all changes to the "machine_id" field.
+func (m *ScanTargetMutation) ResetMachineID() {
+	m.machine = nil
+}
+
+// SetRoots sets the "roots" field.
+func (m *ScanTargetMutation) SetRoots(s []string) {
+	m.roots = &s
+	m.appendroots = nil
+}
+
+// Roots returns the value of the "roots" field in the mutation.
+func (m *ScanTargetMutation) Roots() (r []string, exists bool) {
+	v := m.roots
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoots returns the old "roots" field's value of the ScanTarget entity.
+// If the ScanTarget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScanTargetMutation) OldRoots(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoots is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoots requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoots: %w", err)
+	}
+	return oldValue.Roots, nil
+}
+
+// AppendRoots adds s to the "roots" field.
+func (m *ScanTargetMutation) AppendRoots(s []string) {
+	m.appendroots = append(m.appendroots, s...)
+}
+
+// AppendedRoots returns the list of values that were appended to the "roots" field in this mutation.
+func (m *ScanTargetMutation) AppendedRoots() ([]string, bool) {
+	if len(m.appendroots) == 0 {
+		return nil, false
+	}
+	return m.appendroots, true
+}
+
+// ResetRoots resets all changes to the "roots" field.
+func (m *ScanTargetMutation) ResetRoots() {
+	m.roots = nil
+	m.appendroots = nil
+}
+
+// SetExclusions sets the "exclusions" field.
+func (m *ScanTargetMutation) SetExclusions(s []string) {
+	m.exclusions = &s
+	m.appendexclusions = nil
+}
+
+// Exclusions returns the value of the "exclusions" field in the mutation.
+func (m *ScanTargetMutation) Exclusions() (r []string, exists bool) {
+	v := m.exclusions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExclusions returns the old "exclusions" field's value of the ScanTarget entity.
+// If the ScanTarget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScanTargetMutation) OldExclusions(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExclusions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExclusions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExclusions: %w", err)
+	}
+	return oldValue.Exclusions, nil
+}
+
+// AppendExclusions adds s to the "exclusions" field.
+func (m *ScanTargetMutation) AppendExclusions(s []string) {
+	m.appendexclusions = append(m.appendexclusions, s...)
+}
+
+// AppendedExclusions returns the list of values that were appended to the "exclusions" field in this mutation.
+func (m *ScanTargetMutation) AppendedExclusions() ([]string, bool) {
+	if len(m.appendexclusions) == 0 {
+		return nil, false
+	}
+	return m.appendexclusions, true
+}
+
+// ClearExclusions clears the value of the "exclusions" field.
+func (m *ScanTargetMutation) ClearExclusions() {
+	m.exclusions = nil
+	m.appendexclusions = nil
+	m.clearedFields[scantarget.FieldExclusions] = struct{}{}
+}
+
+// ExclusionsCleared returns if the "exclusions" field was cleared in this mutation.
+func (m *ScanTargetMutation) ExclusionsCleared() bool {
+	_, ok := m.clearedFields[scantarget.FieldExclusions]
+	return ok
+}
+
+// ResetExclusions resets all changes to the "exclusions" field.
+func (m *ScanTargetMutation) ResetExclusions() {
+	m.exclusions = nil
+	m.appendexclusions = nil
+	delete(m.clearedFields, scantarget.FieldExclusions)
+}
+
+// SetStatus sets the "status" field.
+func (m *ScanTargetMutation) SetStatus(s scantarget.Status) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ScanTargetMutation) Status() (r scantarget.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the ScanTarget entity.
+// If the ScanTarget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScanTargetMutation) OldStatus(ctx context.Context) (v scantarget.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ScanTargetMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetStatusMessage sets the "status_message" field.
+func (m *ScanTargetMutation) SetStatusMessage(s string) {
+	m.status_message = &s
+}
+
+// StatusMessage returns the value of the "status_message" field in the mutation.
+func (m *ScanTargetMutation) StatusMessage() (r string, exists bool) {
+	v := m.status_message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatusMessage returns the old "status_message" field's value of the ScanTarget entity.
+// If the ScanTarget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScanTargetMutation) OldStatusMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatusMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatusMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatusMessage: %w", err)
+	}
+	return oldValue.StatusMessage, nil
+}
+
+// ClearStatusMessage clears the value of the "status_message" field.
+func (m *ScanTargetMutation) ClearStatusMessage() {
+	m.status_message = nil
+	m.clearedFields[scantarget.FieldStatusMessage] = struct{}{}
+}
+
+// StatusMessageCleared returns if the "status_message" field was cleared in this mutation.
+func (m *ScanTargetMutation) StatusMessageCleared() bool {
+	_, ok := m.clearedFields[scantarget.FieldStatusMessage]
+	return ok
+}
+
+// ResetStatusMessage resets all changes to the "status_message" field.
+func (m *ScanTargetMutation) ResetStatusMessage() {
+	m.status_message = nil
+	delete(m.clearedFields, scantarget.FieldStatusMessage)
+}
+
+// SetClaimedAt sets the "claimed_at" field.
+func (m *ScanTargetMutation) SetClaimedAt(t time.Time) {
+	m.claimed_at = &t
+}
+
+// ClaimedAt returns the value of the "claimed_at" field in the mutation.
+func (m *ScanTargetMutation) ClaimedAt() (r time.Time, exists bool) {
+	v := m.claimed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClaimedAt returns the old "claimed_at" field's value of the ScanTarget entity.
+// If the ScanTarget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScanTargetMutation) OldClaimedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClaimedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClaimedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClaimedAt: %w", err)
+	}
+	return oldValue.ClaimedAt, nil
+}
+
+// ClearClaimedAt clears the value of the "claimed_at" field.
+func (m *ScanTargetMutation) ClearClaimedAt() {
+	m.claimed_at = nil
+	m.clearedFields[scantarget.FieldClaimedAt] = struct{}{}
+}
+
+// ClaimedAtCleared returns if the "claimed_at" field was cleared in this mutation.
+func (m *ScanTargetMutation) ClaimedAtCleared() bool {
+	_, ok := m.clearedFields[scantarget.FieldClaimedAt]
+	return ok
+}
+
+// ResetClaimedAt resets all changes to the "claimed_at" field.
+func (m *ScanTargetMutation) ResetClaimedAt() {
+	m.claimed_at = nil
+	delete(m.clearedFields, scantarget.FieldClaimedAt)
+}
+
+// SetReportedAt sets the "reported_at" field.
+func (m *ScanTargetMutation) SetReportedAt(t time.Time) {
+	m.reported_at = &t
+}
+
+// ReportedAt returns the value of the "reported_at" field in the mutation.
+func (m *ScanTargetMutation) ReportedAt() (r time.Time, exists bool) {
+	v := m.reported_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReportedAt returns the old "reported_at" field's value of the ScanTarget entity.
+// If the ScanTarget object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScanTargetMutation) OldReportedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReportedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReportedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReportedAt: %w", err)
+	}
+	return oldValue.ReportedAt, nil
+}
+
+// ClearReportedAt clears the value of the "reported_at" field.
+func (m *ScanTargetMutation) ClearReportedAt() {
+	m.reported_at = nil
+	m.clearedFields[scantarget.FieldReportedAt] = struct{}{}
+}
+
+// ReportedAtCleared returns if the "reported_at" field was cleared in this mutation.
+func (m *ScanTargetMutation) ReportedAtCleared() bool {
+	_, ok := m.clearedFields[scantarget.FieldReportedAt]
+	return ok
+}
+
+// ResetReportedAt resets all changes to the "reported_at" field.
+func (m *ScanTargetMutation) ResetReportedAt() {
+	m.reported_at = nil
+	delete(m.clearedFields, scantarget.FieldReportedAt)
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (m *ScanTargetMutation) ClearTenant() {
+	m.clearedtenant = true
+	m.clearedFields[scantarget.FieldTenantID] = struct{}{}
+}
+
+// TenantCleared reports if the "tenant" edge to the Tenant entity was cleared.
+func (m *ScanTargetMutation) TenantCleared() bool {
+	return m.clearedtenant
+}
+
+// TenantIDs returns the "tenant" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TenantID instead. It exists only for internal usage by the builders.
+func (m *ScanTargetMutation) TenantIDs() (ids []uuid.UUID) {
+	if id := m.tenant; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTenant resets all changes to the "tenant" edge.
+func (m *ScanTargetMutation) ResetTenant() {
+	m.tenant = nil
+	m.clearedtenant = false
+}
+
+// ClearScan clears the "scan" edge to the Scan entity.
+func (m *ScanTargetMutation) ClearScan() {
+	m.clearedscan = true
+	m.clearedFields[scantarget.FieldScanID] = struct{}{}
+}
+
+// ScanCleared reports if the "scan" edge to the Scan entity was cleared.
+func (m *ScanTargetMutation) ScanCleared() bool {
+	return m.clearedscan
+}
+
+// ScanIDs returns the "scan" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ScanID instead. It exists only for internal usage by the builders.
+func (m *ScanTargetMutation) ScanIDs() (ids []uuid.UUID) {
+	if id := m.scan; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetScan resets all changes to the "scan" edge.
+func (m *ScanTargetMutation) ResetScan() {
+	m.scan = nil
+	m.clearedscan = false
+}
+
+// ClearMachine clears the "machine" edge to the Machine entity.
+func (m *ScanTargetMutation) ClearMachine() {
+	m.clearedmachine = true
+	m.clearedFields[scantarget.FieldMachineID] = struct{}{}
+}
+
+// MachineCleared reports if the "machine" edge to the Machine entity was cleared.
+func (m *ScanTargetMutation) MachineCleared() bool {
+	return m.clearedmachine
+}
+
+// MachineIDs returns the "machine" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MachineID instead. It exists only for internal usage by the builders.
+func (m *ScanTargetMutation) MachineIDs() (ids []uuid.UUID) {
+	if id := m.machine; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMachine resets all changes to the "machine" edge.
+func (m *ScanTargetMutation) ResetMachine() {
+	m.machine = nil
+	m.clearedmachine = false
+}
+
+// Where appends a list predicates to the ScanTargetMutation builder.
+func (m *ScanTargetMutation) Where(ps ...predicate.ScanTarget) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ScanTargetMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ScanTargetMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ScanTarget, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ScanTargetMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ScanTargetMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ScanTarget).
+func (m *ScanTargetMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ScanTargetMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.create_time != nil {
+		fields = append(fields, scantarget.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, scantarget.FieldUpdateTime)
+	}
+	if m.tenant != nil {
+		fields = append(fields, scantarget.FieldTenantID)
+	}
+	if m.scan != nil {
+		fields = append(fields, scantarget.FieldScanID)
+	}
+	if m.machine != nil {
+		fields = append(fields, scantarget.FieldMachineID)
+	}
+	if m.roots != nil {
+		fields = append(fields, scantarget.FieldRoots)
+	}
+	if m.exclusions != nil {
+		fields = append(fields, scantarget.FieldExclusions)
+	}
+	if m.status != nil {
+		fields = append(fields, scantarget.FieldStatus)
+	}
+	if m.status_message != nil {
+		fields = append(fields, scantarget.FieldStatusMessage)
+	}
+	if m.claimed_at != nil {
+		fields = append(fields, scantarget.FieldClaimedAt)
+	}
+	if m.reported_at != nil {
+		fields = append(fields, scantarget.FieldReportedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ScanTargetMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case scantarget.FieldCreateTime:
+		return m.CreateTime()
+	case scantarget.FieldUpdateTime:
+		return m.UpdateTime()
+	case scantarget.FieldTenantID:
+		return m.TenantID()
+	case scantarget.FieldScanID:
+		return m.ScanID()
+	case scantarget.FieldMachineID:
+		return m.MachineID()
+	case scantarget.FieldRoots:
+		return m.Roots()
+	case scantarget.FieldExclusions:
+		return m.Exclusions()
+	case scantarget.FieldStatus:
+		return m.Status()
+	case scantarget.FieldStatusMessage:
+		return m.StatusMessage()
+	case scantarget.FieldClaimedAt:
+		return m.ClaimedAt()
+	case scantarget.FieldReportedAt:
+		return m.ReportedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ScanTargetMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case scantarget.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case scantarget.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case scantarget.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case scantarget.FieldScanID:
+		return m.OldScanID(ctx)
+	case scantarget.FieldMachineID:
+		return m.OldMachineID(ctx)
+	case scantarget.FieldRoots:
+		return m.OldRoots(ctx)
+	case scantarget.FieldExclusions:
+		return m.OldExclusions(ctx)
+	case scantarget.FieldStatus:
+		return m.OldStatus(ctx)
+	case scantarget.FieldStatusMessage:
+		return m.OldStatusMessage(ctx)
+	case scantarget.FieldClaimedAt:
+		return m.OldClaimedAt(ctx)
+	case scantarget.FieldReportedAt:
+		return m.OldReportedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ScanTarget field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ScanTargetMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case scantarget.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case scantarget.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case scantarget.FieldTenantID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case scantarget.FieldScanID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScanID(v)
+		return nil
+	case scantarget.FieldMachineID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMachineID(v)
+		return nil
+	case scantarget.FieldRoots:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoots(v)
+		return nil
+	case scantarget.FieldExclusions:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExclusions(v)
+		return nil
+	case scantarget.FieldStatus:
+		v, ok := value.(scantarget.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case scantarget.FieldStatusMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatusMessage(v)
+		return nil
+	case scantarget.FieldClaimedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClaimedAt(v)
+		return nil
+	case scantarget.FieldReportedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReportedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ScanTarget field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ScanTargetMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ScanTargetMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ScanTargetMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ScanTarget numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ScanTargetMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(scantarget.FieldExclusions) {
+		fields = append(fields, scantarget.FieldExclusions)
+	}
+	if m.FieldCleared(scantarget.FieldStatusMessage) {
+		fields = append(fields, scantarget.FieldStatusMessage)
+	}
+	if m.FieldCleared(scantarget.FieldClaimedAt) {
+		fields = append(fields, scantarget.FieldClaimedAt)
+	}
+	if m.FieldCleared(scantarget.FieldReportedAt) {
+		fields = append(fields, scantarget.FieldReportedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ScanTargetMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ScanTargetMutation) ClearField(name string) error {
+	switch name {
+	case scantarget.FieldExclusions:
+		m.ClearExclusions()
+		return nil
+	case scantarget.FieldStatusMessage:
+		m.ClearStatusMessage()
+		return nil
+	case scantarget.FieldClaimedAt:
+		m.ClearClaimedAt()
+		return nil
+	case scantarget.FieldReportedAt:
+		m.ClearReportedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ScanTarget nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ScanTargetMutation) ResetField(name string) error {
+	switch name {
+	case scantarget.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case scantarget.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case scantarget.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case scantarget.FieldScanID:
+		m.ResetScanID()
+		return nil
+	case scantarget.FieldMachineID:
+		m.ResetMachineID()
+		return nil
+	case scantarget.FieldRoots:
+		m.ResetRoots()
+		return nil
+	case scantarget.FieldExclusions:
+		m.ResetExclusions()
+		return nil
+	case scantarget.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case scantarget.FieldStatusMessage:
+		m.ResetStatusMessage()
+		return nil
+	case scantarget.FieldClaimedAt:
+		m.ResetClaimedAt()
+		return nil
+	case scantarget.FieldReportedAt:
+		m.ResetReportedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ScanTarget field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ScanTargetMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.tenant != nil {
+		edges = append(edges, scantarget.EdgeTenant)
+	}
+	if m.scan != nil {
+		edges = append(edges, scantarget.EdgeScan)
+	}
+	if m.machine != nil {
+		edges = append(edges, scantarget.EdgeMachine)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ScanTargetMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case scantarget.EdgeTenant:
+		if id := m.tenant; id != nil {
+			return []ent.Value{*id}
+		}
+	case scantarget.EdgeScan:
+		if id := m.scan; id != nil {
+			return []ent.Value{*id}
+		}
+	case scantarget.EdgeMachine:
+		if id := m.machine; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ScanTargetMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ScanTargetMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ScanTargetMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedtenant {
+		edges = append(edges, scantarget.EdgeTenant)
+	}
+	if m.clearedscan {
+		edges = append(edges, scantarget.EdgeScan)
+	}
+	if m.clearedmachine {
+		edges = append(edges, scantarget.EdgeMachine)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ScanTargetMutation) EdgeCleared(name string) bool {
+	switch name {
+	case scantarget.EdgeTenant:
+		return m.clearedtenant
+	case scantarget.EdgeScan:
+		return m.clearedscan
+	case scantarget.EdgeMachine:
+		return m.clearedmachine
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ScanTargetMutation) ClearEdge(name string) error {
+	switch name {
+	case scantarget.EdgeTenant:
+		m.ClearTenant()
+		return nil
+	case scantarget.EdgeScan:
+		m.ClearScan()
+		return nil
+	case scantarget.EdgeMachine:
+		m.ClearMachine()
+		return nil
+	}
+	return fmt.Errorf("unknown ScanTarget unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ScanTargetMutation) ResetEdge(name string) error {
+	switch name {
+	case scantarget.EdgeTenant:
+		m.ResetTenant()
+		return nil
+	case scantarget.EdgeScan:
+		m.ResetScan()
+		return nil
+	case scantarget.EdgeMachine:
+		m.ResetMachine()
+		return nil
+	}
+	return fmt.Errorf("unknown ScanTarget edge %s", name)
+}
+
+// TenantMutation represents an operation that mutates the Tenant nodes in the graph.
+type TenantMutation struct {
+	config
+	op                              Op
+	typ                             string
+	id                              *uuid.UUID
+	create_time                     *time.Time
+	update_time                     *time.Time
+	slug                            *string
+	name                            *string
+	description                     *string
+	primary_contact                 *string
+	archived_at                     *time.Time
+	quota_manifest_bytes            *int64
+	addquota_manifest_bytes         *int64
+	quota_ingest_per_minute         *int
+	addquota_ingest_per_minute      *int
+	quota_machines                  *int
+	addquota_machines               *int
+	quota_retained_scans            *int
+	addquota_retained_scans         *int
+	quota_concurrent_actions        *int
+	addquota_concurrent_actions     *int
+	retention_keep_scans            *int
+	addretention_keep_scans         *int
+	retention_archive_after_days    *int
+	addretention_archive_after_days *int
+	clearedFields                   map[string]struct{}
+	machines                        map[uuid.UUID]struct{}
+	removedmachines                 map[uuid.UUID]struct{}
+	clearedmachines                 bool
+	scans                           map[uuid.UUID]struct{}
+	removedscans                    map[uuid.UUID]struct{}
+	clearedscans                    bool
+	duplicate_groups                map[uuid.UUID]struct{}
+	removedduplicate_groups         map[uuid.UUID]struct{}
+	clearedduplicate_groups         bool
+	action_audits                   map[uuid.UUID]struct{}
+	removedaction_audits            map[uuid.UUID]struct{}
+	clearedaction_audits            bool
+	secrets                         map[uuid.UUID]struct{}
+	removedsecrets                  map[uuid.UUID]struct{}
+	clearedsecrets                  bool
+	file_instances                  map[uuid.UUID]struct{}
+	removedfile_instances           map[uuid.UUID]struct{}
+	clearedfile_instances           bool
+	content_identities              map[uuid.UUID]struct{}
+	removedcontent_identities       map[uuid.UUID]struct{}
+	clearedcontent_identities       bool
+	content_events                  map[uuid.UUID]struct{}
+	removedcontent_events           map[uuid.UUID]struct{}
+	clearedcontent_events           bool
+	scan_targets                    map[uuid.UUID]struct{}
+	removedscan_targets             map[uuid.UUID]struct{}
+	clearedscan_targets             bool
+	scan_schedules                  map[uuid.UUID]struct{}
+	removedscan_schedules           map[uuid.UUID]struct{}
+	clearedscan_schedules           bool
+	scan_schedule_runs              map[uuid.UUID]struct{}
+	removedscan_schedule_runs       map[uuid.UUID]struct{}
+	clearedscan_schedule_runs       bool
+	action_jobs                     map[uuid.UUID]struct{}
+	removedaction_jobs              map[uuid.UUID]struct{}
+	clearedaction_jobs              bool
+	done                            bool
+	oldValue                        func(context.Context) (*Tenant, error)
+	predicates                      []predicate.Tenant
+}
+
+var _ ent.Mutation = (*TenantMutation)(nil)
+
+// tenantOption allows management of the mutation configuration using functional options.
+type tenantOption func(*TenantMutation)
+
+// newTenantMutation creates new mutation for the Tenant entity.
+func newTenantMutation(c config, op Op, opts ...tenantOption) *TenantMutation {
+	m := &TenantMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTenant,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTenantID sets the ID field of the mutation.
+func withTenantID(id uuid.UUID) tenantOption {
+	return func(m *TenantMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Tenant
+		)
+		m.oldValue = func(ctx context.Context) (*Tenant, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Tenant.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTenant sets the old Tenant of the mutation.
+func withTenant(node *Tenant) tenantOption {
+	return func(m *TenantMutation) {
+		m.oldValue = func(context.Context) (*Tenant, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TenantMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TenantMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Tenant entities.
+func (m *TenantMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TenantMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TenantMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Tenant.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *TenantMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *TenantMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Tenant entity.
+// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *TenantMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *TenantMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *TenantMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Tenant entity.
+// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *TenantMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetSlug sets the "slug" field.
+func (m *TenantMutation) SetSlug(s string) {
+	m.slug = &s
+}
+
+// Slug returns the value of the "slug" field in the mutation.
+func (m *TenantMutation) Slug() (r string, exists bool) {
+	v := m.slug
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSlug returns the old "slug" field's value of the Tenant entity.
+// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantMutation) OldSlug(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSlug requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
+	}
+	return oldValue.Slug, nil
+}
+
+// ResetSlug resets all changes to the "slug" field.
+func (m *TenantMutation) ResetSlug() {
+	m.slug = nil
+}
+
+// SetName sets the "name" field.
+func (m *TenantMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *TenantMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Tenant entity.
+// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *TenantMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *TenantMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *TenantMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Tenant entity.
+// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *TenantMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[tenant.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *TenantMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[tenant.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *TenantMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, tenant.FieldDescription)
+}
+
+// SetPrimaryContact sets the "primary_contact" field.
+func (m *TenantMutation) SetPrimaryContact(s string) {
+	m.primary_contact = &s
+}
+
+// PrimaryContact returns the value of the "primary_contact" field in the mutation.
+func (m *TenantMutation) PrimaryContact() (r string, exists bool) {
+	v := m.primary_contact
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrimaryContact returns the old "primary_contact" field's value of the Tenant entity.
+// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantMutation) OldPrimaryContact(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrimaryContact is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrimaryContact requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrimaryContact: %w", err)
+	}
+	return oldValue.PrimaryContact, nil
+}
+
+// ClearPrimaryContact clears the value of the "primary_contact" field.
+func (m *TenantMutation) ClearPrimaryContact() {
+	m.primary_contact = nil
+	m.clearedFields[tenant.FieldPrimaryContact] = struct{}{}
+}
+
+// PrimaryContactCleared returns if the "primary_contact" field was cleared in this mutation.
+func (m *TenantMutation) PrimaryContactCleared() bool {
+	_, ok := m.clearedFields[tenant.FieldPrimaryContact]
+	return ok
+}
+
+// ResetPrimaryContact resets all changes to the "primary_contact" field.
+func (m *TenantMutation) ResetPrimaryContact() {
+	m.primary_contact = nil
+	delete(m.clearedFields, tenant.FieldPrimaryContact)
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (m *TenantMutation) SetArchivedAt(t time.Time) {
+	m.archived_at = &t
+}
+
+// ArchivedAt returns the value of the "archived_at" field in the mutation.
+func (m *TenantMutation) ArchivedAt() (r time.Time, exists bool) {
+	v := m.archived_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArchivedAt returns the old "archived_at" field's value of the Tenant entity.
+// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantMutation) OldArchivedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArchivedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArchivedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArchivedAt: %w", err)
+	}
+	return oldValue.ArchivedAt, nil
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (m *TenantMutation) ClearArchivedAt() {
+	m.archived_at = nil
+	m.clearedFields[tenant.FieldArchivedAt] = struct{}{}
+}
+
+// ArchivedAtCleared returns if the "archived_at" field was cleared in this mutation.
+func (m *TenantMutation) ArchivedAtCleared() bool {
+	_, ok := m.clearedFields[tenant.FieldArchivedAt]
+	return ok
+}
+
+// ResetArchivedAt resets all changes to the "archived_at" field.
+func (m *TenantMutation) ResetArchivedAt() {
+	m.archived_at = nil
+	delete(m.clearedFields, tenant.FieldArchivedAt)
+}
+
+// SetQuotaManifestBytes sets the "quota_manifest_bytes" field.
+func (m *TenantMutation) SetQuotaManifestBytes(i int64) {
+	m.quota_manifest_bytes = &i
+	m.addquota_manifest_bytes = nil
+}
+
+// QuotaManifestBytes returns the value of the "quota_manifest_bytes" field in the mutation.
+func (m *TenantMutation) QuotaManifestBytes() (r int64, exists bool) {
+	v := m.quota_manifest_bytes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuotaManifestBytes returns the old "quota_manifest_bytes" field's value of the Tenant entity.
+// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantMutation) OldQuotaManifestBytes(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuotaManifestBytes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuotaManifestBytes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuotaManifestBytes: %w", err)
+	}
+	return oldValue.QuotaManifestBytes, nil
+}
+
+// AddQuotaManifestBytes adds i to the "quota_manifest_bytes" field.
+func (m *TenantMutation) AddQuotaManifestBytes(i int64) {
+	if m.addquota_manifest_bytes != nil {
+		*m.addquota_manifest_bytes += i
+	} else {
+		m.addquota_manifest_bytes = &i
+	}
+}
+
+// AddedQuotaManifestBytes returns the value that was added to the "quota_manifest_bytes" field in this mutation.
+func (m *TenantMutation) AddedQuotaManifestBytes() (r int64, exists bool) {
+	v := m.addquota_manifest_bytes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearQuotaManifestBytes clears the value of the "quota_manifest_bytes" field.
+func (m *TenantMutation) ClearQuotaManifestBytes() {
+	m.quota_manifest_bytes = nil
+	m.addquota_manifest_bytes = nil
+	m.clearedFields[tenant.FieldQuotaManifestBytes] = struct{}{}
+}
+
+// QuotaManifestBytesCleared returns if the "quota_manifest_bytes" field was cleared in this mutation.
+func (m *TenantMutation) QuotaManifestBytesCleared() bool {
+	_, ok := m.clearedFields[tenant.FieldQuotaManifestBytes]
+	return ok
+}
+
+// ResetQuotaManifestBytes resets all changes to the "quota_manifest_bytes" field.
+func (m *TenantMutation) ResetQuotaManifestBytes() {
+	m.quota_manifest_bytes = nil
+	m.addquota_manifest_bytes = nil
+	delete(m.clearedFields, tenant.FieldQuotaManifestBytes)
+}
+
+// SetQuotaIngestPerMinute sets the "quota_ingest_per_minute" field.
+func (m *TenantMutation) SetQuotaIngestPerMinute(i int) {
+	m.quota_ingest_per_minute = &i
+	m.addquota_ingest_per_minute = nil
+}
+
+// QuotaIngestPerMinute returns the value of the "quota_ingest_per_minute" field in the mutation.
+func (m *TenantMutation) QuotaIngestPerMinute() (r int, exists bool) {
+	v := m.quota_ingest_per_minute
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuotaIngestPerMinute returns the old "quota_ingest_per_minute" field's value of the Tenant entity.
+// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantMutation) OldQuotaIngestPerMinute(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuotaIngestPerMinute is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuotaIngestPerMinute requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuotaIngestPerMinute: %w", err)
+	}
+	return oldValue.QuotaIngestPerMinute, nil
+}
+
+// AddQuotaIngestPerMinute adds i to the "quota_ingest_per_minute" field.
+func (m *TenantMutation) AddQuotaIngestPerMinute(i int) {
+	if m.addquota_ingest_per_minute != nil {
+		*m.addquota_ingest_per_minute += i
+	} else {
+		m.addquota_ingest_per_minute = &i
+	}
+}
+
+// AddedQuotaIngestPerMinute returns the value that was added to the "quota_ingest_per_minute" field in this mutation.
+func (m *TenantMutation) AddedQuotaIngestPerMinute() (r int, exists bool) {
+	v := m.addquota_ingest_per_minute
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearQuotaIngestPerMinute clears the value of the "quota_ingest_per_minute" field.
+func (m *TenantMutation) ClearQuotaIngestPerMinute() {
+	m.quota_ingest_per_minute = nil
+	m.addquota_ingest_per_minute = nil
+	m.clearedFields[tenant.FieldQuotaIngestPerMinute] = struct{}{}
+}
+
+// QuotaIngestPerMinuteCleared returns if the "quota_ingest_per_minute" field was cleared in this mutation.
+func (m *TenantMutation) QuotaIngestPerMinuteCleared() bool {
+	_, ok := m.clearedFields[tenant.FieldQuotaIngestPerMinute]
+	return ok
+}
+
+// ResetQuotaIngestPerMinute resets all changes to the "quota_ingest_per_minute" field.
+func (m *TenantMutation) ResetQuotaIngestPerMinute() {
+	m.quota_ingest_per_minute = nil
+	m.addquota_ingest_per_minute = nil
+	delete(m.clearedFields, tenant.FieldQuotaIngestPerMinute)
+}
+
+// SetQuotaMachines sets the "quota_machines" field.
+func (m *TenantMutation) SetQuotaMachines(i int) {
+	m.quota_machines = &i
+	m.addquota_machines = nil
+}
+
+// QuotaMachines returns the value of the "quota_machines" field in the mutation.
+func (m *TenantMutation) QuotaMachines() (r int, exists bool) {
+	v := m.quota_machines
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuotaMachines returns the old "quota_machines" field's value of the Tenant entity.
+// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantMutation) OldQuotaMachines(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuotaMachines is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuotaMachines requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuotaMachines: %w", err)
+	}
+	return oldValue.QuotaMachines, nil
+}
+
+// AddQuotaMachines adds i to the "quota_machines" field.
+func (m *TenantMutation) AddQuotaMachines(i int) {
+	if m.addquota_machines != nil {
+		*m.addquota_machines += i
+	} else {
+		m.addquota_machines = &i
+	}
+}
+
+// AddedQuotaMachines returns the value that was added to the "quota_machines" field in this mutation.
+func (m *TenantMutation) AddedQuotaMachines() (r int, exists bool) {
+	v := m.addquota_machines
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearQuotaMachines clears the value of the "quota_machines" field.
+func (m *TenantMutation) ClearQuotaMachines() {
+	m.quota_machines = nil
+	m.addquota_machines = nil
+	m.clearedFields[tenant.FieldQuotaMachines] = struct{}{}
+}
+
+// QuotaMachinesCleared returns if the "quota_machines" field was cleared in this mutation.
+func (m *TenantMutation) QuotaMachinesCleared() bool {
+	_, ok := m.clearedFields[tenant.FieldQuotaMachines]
+	return ok
+}
+
+// ResetQuotaMachines resets all changes to the "quota_machines" field.
+func (m *TenantMutation) ResetQuotaMachines() {
+	m.quota_machines = nil
+	m.addquota_machines = nil
+	delete(m.clearedFields, tenant.FieldQuotaMachines)
+}
+
+// SetQuotaRetainedScans sets the "quota_retained_scans" field.
+func (m *TenantMutation) SetQuotaRetainedScans(i int) {
+	m.quota_retained_scans = &i
+	m.addquota_retained_scans = nil
+}
+
+// QuotaRetainedScans returns the value of the "quota_retained_scans" field in the mutation.
+func (m *TenantMutation) QuotaRetainedScans() (r int, exists bool) {
+	v := m.quota_retained_scans
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuotaRetainedScans returns the old "quota_retained_scans" field's value of the Tenant entity.
+// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantMutation) OldQuotaRetainedScans(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuotaRetainedScans is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuotaRetainedScans requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuotaRetainedScans: %w", err)
+	}
+	return oldValue.QuotaRetainedScans, nil
+}
+
+// AddQuotaRetainedScans adds i to the "quota_retained_scans" field.
+func (m *TenantMutation) AddQuotaRetainedScans(i int) {
+	if m.addquota_retained_scans != nil {
+		*m.addquota_retained_scans += i
+	} else {
+		m.addquota_retained_scans = &i
+	}
+}
+
+// AddedQuotaRetainedScans returns the value that was added to the "quota_retained_scans" field in this mutation.
+func (m *TenantMutation) AddedQuotaRetainedScans() (r int, exists bool) {
+	v := m.addquota_retained_scans
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearQuotaRetainedScans clears the value of the "quota_retained_scans" field.
+func (m *TenantMutation) ClearQuotaRetainedScans() {
+	m.quota_retained_scans = nil
+	m.addquota_retained_scans = nil
+	m.clearedFields[tenant.FieldQuotaRetainedScans] = struct{}{}
+}
+
+// QuotaRetainedScansCleared returns if the "quota_retained_scans" field was cleared in this mutation.
+func (m *TenantMutation) QuotaRetainedScansCleared() bool {
+	_, ok := m.clearedFields[tenant.FieldQuotaRetainedScans]
+	return ok
+}
+
+// ResetQuotaRetainedScans resets all changes to the "quota_retained_scans" field.
+func (m *TenantMutation) ResetQuotaRetainedScans() {
+	m.quota_retained_scans = nil
+	m.addquota_retained_scans = nil
+	delete(m.clearedFields, tenant.FieldQuotaRetainedScans)
+}
+
+// SetQuotaConcurrentActions sets the "quota_concurrent_actions" field.
+func (m *TenantMutation) SetQuotaConcurrentActions(i int) {
+	m.quota_concurrent_actions = &i
+	m.addquota_concurrent_actions = nil
+}
+
+// QuotaConcurrentActions returns the value of the "quota_concurrent_actions" field in the mutation.
+func (m *TenantMutation) QuotaConcurrentActions() (r int, exists bool) {
+	v := m.quota_concurrent_actions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuotaConcurrentActions returns the old "quota_concurrent_actions" field's value of the Tenant entity.
+// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantMutation) OldQuotaConcurrentActions(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuotaConcurrentActions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuotaConcurrentActions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuotaConcurrentActions: %w", err)
+	}
+	return oldValue.QuotaConcurrentActions, nil
+}
+
+// AddQuotaConcurrentActions adds i to the "quota_concurrent_actions" field.
+func (m *TenantMutation) AddQuotaConcurrentActions(i int) {
+	if m.addquota_concurrent_actions != nil {
+		*m.addquota_concurrent_actions += i
+	} else {
+		m.addquota_concurrent_actions = &i
+	}
+}
+
+// AddedQuotaConcurrentActions returns the value that was added to the "quota_concurrent_actions" field in this mutation.
+func (m *TenantMutation) AddedQuotaConcurrentActions() (r int, exists bool) {
+	v := m.addquota_concurrent_actions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearQuotaConcurrentActions clears the value of the "quota_concurrent_actions" field.
+func (m *TenantMutation) ClearQuotaConcurrentActions() {
+	m.quota_concurrent_actions = nil
+	m.addquota_concurrent_actions = nil
+	m.clearedFields[tenant.FieldQuotaConcurrentActions] = struct{}{}
+}
+
+// QuotaConcurrentActionsCleared returns if the "quota_concurrent_actions" field was cleared in this mutation.
+func (m *TenantMutation) QuotaConcurrentActionsCleared() bool {
+	_, ok := m.clearedFields[tenant.FieldQuotaConcurrentActions]
+	return ok
+}
+
+// ResetQuotaConcurrentActions resets all changes to the "quota_concurrent_actions" field.
+func (m *TenantMutation) ResetQuotaConcurrentActions() {
+	m.quota_concurrent_actions = nil
+	m.addquota_concurrent_actions = nil
+	delete(m.clearedFields, tenant.FieldQuotaConcurrentActions)
+}
+
+// SetRetentionKeepScans sets the "retention_keep_scans" field.
+func (m *TenantMutation) SetRetentionKeepScans(i int) {
+	m.retention_keep_scans = &i
+	m.addretention_keep_scans = nil
+}
+
+// RetentionKeepScans returns the value of the "retention_keep_scans" field in the mutation.
+func (m *TenantMutation) RetentionKeepScans() (r int, exists bool) {
+	v := m.retention_keep_scans
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRetentionKeepScans returns the old "retention_keep_scans" field's value of the Tenant entity.
+// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantMutation) OldRetentionKeepScans(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRetentionKeepScans is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRetentionKeepScans requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRetentionKeepScans: %w", err)
+	}
+	return oldValue.RetentionKeepScans, nil
+}
+
+// AddRetentionKeepScans adds i to the "retention_keep_scans" field.
+func (m *TenantMutation) AddRetentionKeepScans(i int) {
+	if m.addretention_keep_scans != nil {
+		*m.addretention_keep_scans += i
+	} else {
+		m.addretention_keep_scans = &i
+	}
+}
+
+// AddedRetentionKeepScans returns the value that was added to the "retention_keep_scans" field in this mutation.
+func (m *TenantMutation) AddedRetentionKeepScans() (r int, exists bool) {
+	v := m.addretention_keep_scans
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearRetentionKeepScans clears the value of the "retention_keep_scans" field.
+func (m *TenantMutation) ClearRetentionKeepScans() {
+	m.retention_keep_scans = nil
+	m.addretention_keep_scans = nil
+	m.clearedFields[tenant.FieldRetentionKeepScans] = struct{}{}
+}
+
+// RetentionKeepScansCleared returns if the "retention_keep_scans" field was cleared in this mutation.
+func (m *TenantMutation) RetentionKeepScansCleared() bool {
+	_, ok := m.clearedFields[tenant.FieldRetentionKeepScans]
+	return ok
+}
+
+// ResetRetentionKeepScans resets all changes to the "retention_keep_scans" field.
+func (m *TenantMutation) ResetRetentionKeepScans() {
+	m.retention_keep_scans = nil
+	m.addretention_keep_scans = nil
+	delete(m.clearedFields, tenant.FieldRetentionKeepScans)
+}
+
+// SetRetentionArchiveAfterDays sets the "retention_archive_after_days" field.
+func (m *TenantMutation) SetRetentionArchiveAfterDays(i int) {
+	m.retention_archive_after_days = &i
+	m.addretention_archive_after_days = nil
+}
+
+// RetentionArchiveAfterDays returns the value of the "retention_archive_after_days" field in the mutation.
+func (m *TenantMutation) RetentionArchiveAfterDays() (r int, exists bool) {
+	v := m.retention_archive_after_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRetentionArchiveAfterDays returns the old "retention_archive_after_days" field's value of the Tenant entity.
+// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantMutation) OldRetentionArchiveAfterDays(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRetentionArchiveAfterDays is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRetentionArchiveAfterDays requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRetentionArchiveAfterDays: %w", err)
+	}
+	return oldValue.RetentionArchiveAfterDays, nil
+}
+
+// AddRetentionArchiveAfterDays adds i to the "retention_archive_after_days" field.
+func (m *TenantMutation) AddRetentionArchiveAfterDays(i int) {
+	if m.addretention_archive_after_days != nil {
+		*m.addretention_archive_after_days += i
+	} else {
+		m.addretention_archive_after_days = &i
+	}
+}
+
+// AddedRetentionArchiveAfterDays returns the value that was added to the "retention_archive_after_days" field in this mutation.
+func (m *TenantMutation) AddedRetentionArchiveAfterDays() (r int, exists bool) {
+	v := m.addretention_archive_after_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearRetentionArchiveAfterDays clears the value of the "retention_archive_after_days" field.
+func (m *TenantMutation) ClearRetentionArchiveAfterDays() {
+	m.retention_archive_after_days = nil
+	m.addretention_archive_after_days = nil
+	m.clearedFields[tenant.FieldRetentionArchiveAfterDays] = struct{}{}
+}
+
+// RetentionArchiveAfterDaysCleared returns if the "retention_archive_after_days" field was cleared in this mutation.
+func (m *TenantMutation) RetentionArchiveAfterDaysCleared() bool {
+	_, ok := m.clearedFields[tenant.FieldRetentionArchiveAfterDays]
+	return ok
+}
+
+// ResetRetentionArchiveAfterDays resets all changes to the "retention_archive_after_days" field.
+func (m *TenantMutation) ResetRetentionArchiveAfterDays() {
+	m.retention_archive_after_days = nil
+	m.addretention_archive_after_days = nil
+	delete(m.clearedFields, tenant.FieldRetentionArchiveAfterDays)
+}
+
+// AddMachineIDs adds the "machines" edge to the Machine entity by ids.
+func (m *TenantMutation) AddMachineIDs(ids ...uuid.UUID) {
+	if m.machines == nil {
+		m.machines = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.machines[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMachines clears the "machines" edge to the Machine entity.
+func (m *TenantMutation) ClearMachines() {
+	m.clearedmachines = true
+}
+
+// MachinesCleared reports if the "machines" edge to the Machine entity was cleared.
+func (m *TenantMutation) MachinesCleared() bool {
+	return m.clearedmachines
+}
+
+// RemoveMachineIDs removes the "machines" edge to the Machine entity by IDs.
+func (m *TenantMutation) RemoveMachineIDs(ids ...uuid.UUID) {
+	if m.removedmachines == nil {
+		m.removedmachines = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.machines, ids[i])
+		m.removedmachines[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMachines returns the removed IDs of the "machines" edge to the Machine entity.
+func (m *TenantMutation) RemovedMachinesIDs() (ids []uuid.UUID) {
+	for id := range m.removedmachines {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MachinesIDs returns the "machines" edge IDs in the mutation.
+func (m *TenantMutation) MachinesIDs() (ids []uuid.UUID) {
+	for id := range m.machines {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMachines resets all changes to the "machines" edge.
+func (m *TenantMutation) ResetMachines() {
+	m.machines = nil
+	m.clearedmachines = false
+	m.removedmachines = nil
+}
+
+// AddScanIDs adds the "scans" edge to the Scan entity by ids.
+func (m *TenantMutation) AddScanIDs(ids ...uuid.UUID) {
+	if m.scans == nil {
+		m.scans = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.scans[ids[i]] = struct{}{}
+	}
+}
+
+// ClearScans clears the "scans" edge to the Scan entity.
+func (m *TenantMutation) ClearScans() {
+	m.clearedscans = true
+}
+
+// ScansCleared reports if the "scans" edge to the Scan entity was cleared.
+func (m *TenantMutation) ScansCleared() bool {
+	return m.clearedscans
+}
+
+// RemoveScanIDs removes the "scans" edge to the Scan entity by IDs.
+func (m *TenantMutation) RemoveScanIDs(ids ...uuid.UUID) {
+	if m.removedscans == nil {
+		m.removedscans = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.scans, ids[i])
+		m.removedscans[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedScans returns the removed IDs of the "scans" edge to the Scan entity.
+func (m *TenantMutation) RemovedScansIDs() (ids []uuid.UUID) {
+	for id := range m.removedscans {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ScansIDs returns the "scans" edge IDs in the mutation.
+func (m *TenantMutation) ScansIDs() (ids []uuid.UUID) {
+	for id := range m.scans {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetScans resets all changes to the "scans" edge.
+func (m *TenantMutation) ResetScans() {
+	m.scans = nil
+	m.clearedscans = false
+	m.removedscans = nil
 }
 
-// LastScanAtCleared returns if the "last_scan_at" field was cleared in this mutation.
-func (m *MachineMutation) LastScanAtCleared() bool {
-	_, ok := m.clearedFields[machine.FieldLastScanAt]
-	return ok
+// AddDuplicateGroupIDs adds the "duplicate_groups" edge to the DuplicateGroup entity by ids.
+func (m *TenantMutation) AddDuplicateGroupIDs(ids ...uuid.UUID) {
+	if m.duplicate_groups == nil {
+		m.duplicate_groups = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.duplicate_groups[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDuplicateGroups clears the "duplicate_groups" edge to the DuplicateGroup entity.
+func (m *TenantMutation) ClearDuplicateGroups() {
+	m.clearedduplicate_groups = true
+}
+
+// DuplicateGroupsCleared reports if the "duplicate_groups" edge to the DuplicateGroup entity was cleared.
+func (m *TenantMutation) DuplicateGroupsCleared() bool {
+	return m.clearedduplicate_groups
+}
+
+// RemoveDuplicateGroupIDs removes the "duplicate_groups" edge to the DuplicateGroup entity by IDs.
+func (m *TenantMutation) RemoveDuplicateGroupIDs(ids ...uuid.UUID) {
+	if m.removedduplicate_groups == nil {
+		m.removedduplicate_groups = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.duplicate_groups, ids[i])
+		m.removedduplicate_groups[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDuplicateGroups returns the removed IDs of the "duplicate_groups" edge to the DuplicateGroup entity.
+func (m *TenantMutation) RemovedDuplicateGroupsIDs() (ids []uuid.UUID) {
+	for id := range m.removedduplicate_groups {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DuplicateGroupsIDs returns the "duplicate_groups" edge IDs in the mutation.
+func (m *TenantMutation) DuplicateGroupsIDs() (ids []uuid.UUID) {
+	for id := range m.duplicate_groups {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDuplicateGroups resets all changes to the "duplicate_groups" edge.
+func (m *TenantMutation) ResetDuplicateGroups() {
+	m.duplicate_groups = nil
+	m.clearedduplicate_groups = false
+	m.removedduplicate_groups = nil
+}
+
+// AddActionAuditIDs adds the "action_audits" edge to the ActionAudit entity by ids.
+func (m *TenantMutation) AddActionAuditIDs(ids ...uuid.UUID) {
+	if m.action_audits == nil {
+		m.action_audits = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.action_audits[ids[i]] = struct{}{}
+	}
+}
+
+// ClearActionAudits clears the "action_audits" edge to the ActionAudit entity.
+func (m *TenantMutation) ClearActionAudits() {
+	m.clearedaction_audits = true
+}
+
+// ActionAuditsCleared reports if the "action_audits" edge to the ActionAudit entity was cleared.
+func (m *TenantMutation) ActionAuditsCleared() bool {
+	return m.clearedaction_audits
+}
+
+// RemoveActionAuditIDs removes the "action_audits" edge to the ActionAudit entity by IDs.
+func (m *TenantMutation) RemoveActionAuditIDs(ids ...uuid.UUID) {
+	if m.removedaction_audits == nil {
+		m.removedaction_audits = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.action_audits, ids[i])
+		m.removedaction_audits[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedActionAudits returns the removed IDs of the "action_audits" edge to the ActionAudit entity.
+func (m *TenantMutation) RemovedActionAuditsIDs() (ids []uuid.UUID) {
+	for id := range m.removedaction_audits {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ActionAuditsIDs returns the "action_audits" edge IDs in the mutation.
+func (m *TenantMutation) ActionAuditsIDs() (ids []uuid.UUID) {
+	for id := range m.action_audits {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetActionAudits resets all changes to the "action_audits" edge.
+func (m *TenantMutation) ResetActionAudits() {
+	m.action_audits = nil
+	m.clearedaction_audits = false
+	m.removedaction_audits = nil
+}
+
+// AddSecretIDs adds the "secrets" edge to the TenantSecret entity by ids.
+func (m *TenantMutation) AddSecretIDs(ids ...uuid.UUID) {
+	if m.secrets == nil {
+		m.secrets = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.secrets[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSecrets clears the "secrets" edge to the TenantSecret entity.
+func (m *TenantMutation) ClearSecrets() {
+	m.clearedsecrets = true
+}
+
+// SecretsCleared reports if the "secrets" edge to the TenantSecret entity was cleared.
+func (m *TenantMutation) SecretsCleared() bool {
+	return m.clearedsecrets
+}
+
+// RemoveSecretIDs removes the "secrets" edge to the TenantSecret entity by IDs.
+func (m *TenantMutation) RemoveSecretIDs(ids ...uuid.UUID) {
+	if m.removedsecrets == nil {
+		m.removedsecrets = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.secrets, ids[i])
+		m.removedsecrets[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSecrets returns the removed IDs of the "secrets" edge to the TenantSecret entity.
+func (m *TenantMutation) RemovedSecretsIDs() (ids []uuid.UUID) {
+	for id := range m.removedsecrets {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SecretsIDs returns the "secrets" edge IDs in the mutation.
+func (m *TenantMutation) SecretsIDs() (ids []uuid.UUID) {
+	for id := range m.secrets {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSecrets resets all changes to the "secrets" edge.
+func (m *TenantMutation) ResetSecrets() {
+	m.secrets = nil
+	m.clearedsecrets = false
+	m.removedsecrets = nil
+}
+
+// AddFileInstanceIDs adds the "file_instances" edge to the FileInstance entity by ids.
+func (m *TenantMutation) AddFileInstanceIDs(ids ...uuid.UUID) {
+	if m.file_instances == nil {
+		m.file_instances = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.file_instances[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFileInstances clears the "file_instances" edge to the FileInstance entity.
+func (m *TenantMutation) ClearFileInstances() {
+	m.clearedfile_instances = true
+}
+
+// FileInstancesCleared reports if the "file_instances" edge to the FileInstance entity was cleared.
+func (m *TenantMutation) FileInstancesCleared() bool {
+	return m.clearedfile_instances
+}
+
+// RemoveFileInstanceIDs removes the "file_instances" edge to the FileInstance entity by IDs.
+func (m *TenantMutation) RemoveFileInstanceIDs(ids ...uuid.UUID) {
+	if m.removedfile_instances == nil {
+		m.removedfile_instances = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.file_instances, ids[i])
+		m.removedfile_instances[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFileInstances returns the removed IDs of the "file_instances" edge to the FileInstance entity.
+func (m *TenantMutation) RemovedFileInstancesIDs() (ids []uuid.UUID) {
+	for id := range m.removedfile_instances {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FileInstancesIDs returns the "file_instances" edge IDs in the mutation.
+func (m *TenantMutation) FileInstancesIDs() (ids []uuid.UUID) {
+	for id := range m.file_instances {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFileInstances resets all changes to the "file_instances" edge.
+func (m *TenantMutation) ResetFileInstances() {
+	m.file_instances = nil
+	m.clearedfile_instances = false
+	m.removedfile_instances = nil
+}
+
+// AddContentIdentityIDs adds the "content_identities" edge to the ContentIdentity entity by ids.
+func (m *TenantMutation) AddContentIdentityIDs(ids ...uuid.UUID) {
+	if m.content_identities == nil {
+		m.content_identities = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.content_identities[ids[i]] = struct{}{}
+	}
+}
+
+// ClearContentIdentities clears the "content_identities" edge to the ContentIdentity entity.
+func (m *TenantMutation) ClearContentIdentities() {
+	m.clearedcontent_identities = true
+}
+
+// ContentIdentitiesCleared reports if the "content_identities" edge to the ContentIdentity entity was cleared.
+func (m *TenantMutation) ContentIdentitiesCleared() bool {
+	return m.clearedcontent_identities
+}
+
+// RemoveContentIdentityIDs removes the "content_identities" edge to the ContentIdentity entity by IDs.
+func (m *TenantMutation) RemoveContentIdentityIDs(ids ...uuid.UUID) {
+	if m.removedcontent_identities == nil {
+		m.removedcontent_identities = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.content_identities, ids[i])
+		m.removedcontent_identities[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedContentIdentities returns the removed IDs of the "content_identities" edge to the ContentIdentity entity.
+func (m *TenantMutation) RemovedContentIdentitiesIDs() (ids []uuid.UUID) {
+	for id := range m.removedcontent_identities {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ContentIdentitiesIDs returns the "content_identities" edge IDs in the mutation.
+func (m *TenantMutation) ContentIdentitiesIDs() (ids []uuid.UUID) {
+	for id := range m.content_identities {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetContentIdentities resets all changes to the "content_identities" edge.
+func (m *TenantMutation) ResetContentIdentities() {
+	m.content_identities = nil
+	m.clearedcontent_identities = false
+	m.removedcontent_identities = nil
+}
+
+// AddContentEventIDs adds the "content_events" edge to the ContentEvent entity by ids.
+func (m *TenantMutation) AddContentEventIDs(ids ...uuid.UUID) {
+	if m.content_events == nil {
+		m.content_events = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.content_events[ids[i]] = struct{}{}
+	}
+}
+
+// ClearContentEvents clears the "content_events" edge to the ContentEvent entity.
+func (m *TenantMutation) ClearContentEvents() {
+	m.clearedcontent_events = true
+}
+
+// ContentEventsCleared reports if the "content_events" edge to the ContentEvent entity was cleared.
+func (m *TenantMutation) ContentEventsCleared() bool {
+	return m.clearedcontent_events
+}
+
+// RemoveContentEventIDs removes the "content_events" edge to the ContentEvent entity by IDs.
+func (m *TenantMutation) RemoveContentEventIDs(ids ...uuid.UUID) {
+	if m.removedcontent_events == nil {
+		m.removedcontent_events = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.content_events, ids[i])
+		m.removedcontent_events[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedContentEvents returns the removed IDs of the "content_events" edge to the ContentEvent entity.
+func (m *TenantMutation) RemovedContentEventsIDs() (ids []uuid.UUID) {
+	for id := range m.removedcontent_events {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ContentEventsIDs returns the "content_events" edge IDs in the mutation.
+func (m *TenantMutation) ContentEventsIDs() (ids []uuid.UUID) {
+	for id := range m.content_events {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetContentEvents resets all changes to the "content_events" edge.
+func (m *TenantMutation) ResetContentEvents() {
+	m.content_events = nil
+	m.clearedcontent_events = false
+	m.removedcontent_events = nil
+}
+
+// AddScanTargetIDs adds the "scan_targets" edge to the ScanTarget entity by ids.
+func (m *TenantMutation) AddScanTargetIDs(ids ...uuid.UUID) {
+	if m.scan_targets == nil {
+		m.scan_targets = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.scan_targets[ids[i]] = struct{}{}
+	}
 }
 
-// ResetLastScanAt resets all changes to the "last_scan_at" field.
-func (m *MachineMutation) ResetLastScanAt() {
-	m.last_scan_at = nil
-	delete(m.clearedFields, machine.FieldLastScanAt)
+// ClearScanTargets clears the "scan_targets" edge to the ScanTarget entity.
+func (m *TenantMutation) ClearScanTargets() {
+	m.clearedscan_targets = true
 }
 
-// ClearTenant clears the "tenant" edge to the Tenant entity.
-func (m *MachineMutation) ClearTenant() {
-	m.clearedtenant = true
-	m.clearedFields[machine.FieldTenantID] = struct{}{}
+// ScanTargetsCleared reports if the "scan_targets" edge to the ScanTarget entity was cleared.
+func (m *TenantMutation) ScanTargetsCleared() bool {
+	return m.clearedscan_targets
 }
 
-// TenantCleared reports if the "tenant" edge to the Tenant entity was cleared.
-func (m *MachineMutation) TenantCleared() bool {
-	return m.clearedtenant
+// RemoveScanTargetIDs removes the "scan_targets" edge to the ScanTarget entity by IDs.
+func (m *TenantMutation) RemoveScanTargetIDs(ids ...uuid.UUID) {
+	if m.removedscan_targets == nil {
+		m.removedscan_targets = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.scan_targets, ids[i])
+		m.removedscan_targets[ids[i]] = struct{}{}
+	}
 }
 
-// TenantIDs returns the "tenant" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TenantID instead. It exists only for internal usage by the builders.
-func (m *MachineMutation) TenantIDs() (ids []uuid.UUID) {
-	if id := m.tenant; id != nil {
-		ids = append(ids, *id)
+// RemovedScanTargets returns the removed IDs of the "scan_targets" edge to the ScanTarget entity.
+func (m *TenantMutation) RemovedScanTargetsIDs() (ids []uuid.UUID) {
+	for id := range m.removedscan_targets {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetTenant resets all changes to the "tenant" edge.
-func (m *MachineMutation) ResetTenant() {
-	m.tenant = nil
-	m.clearedtenant = false
+// ScanTargetsIDs returns the "scan_targets" edge IDs in the mutation.
+func (m *TenantMutation) ScanTargetsIDs() (ids []uuid.UUID) {
+	for id := range m.scan_targets {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// AddKeeperGroupIDs adds the "keeper_groups" edge to the DuplicateGroup entity by ids.
-func (m *MachineMutation) AddKeeperGroupIDs(ids ...uuid.UUID) {
-	if m.keeper_groups == nil {
-		m.keeper_groups = make(map[uuid.UUID]struct{})
+// ResetScanTargets resets all changes to the "scan_targets" edge.
+func (m *TenantMutation) ResetScanTargets() {
+	m.scan_targets = nil
+	m.clearedscan_targets = false
+	m.removedscan_targets = nil
+}
+
+// AddScanScheduleIDs adds the "scan_schedules" edge to the ScanSchedule entity by ids.
+func (m *TenantMutation) AddScanScheduleIDs(ids ...uuid.UUID) {
+	if m.scan_schedules == nil {
+		m.scan_schedules = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		m.keeper_groups[ids[i]] = struct{}{}
+		m.scan_schedules[ids[i]] = struct{}{}
 	}
 }
 
-// ClearKeeperGroups clears the "keeper_groups" edge to the DuplicateGroup entity.
-func (m *MachineMutation) ClearKeeperGroups() {
-	m.clearedkeeper_groups = true
+// ClearScanSchedules clears the "scan_schedules" edge to the ScanSchedule entity.
+func (m *TenantMutation) ClearScanSchedules() {
+	m.clearedscan_schedules = true
 }
 
-// KeeperGroupsCleared reports if the "keeper_groups" edge to the DuplicateGroup entity was cleared.
-func (m *MachineMutation) KeeperGroupsCleared() bool {
-	return m.clearedkeeper_groups
+// ScanSchedulesCleared reports if the "scan_schedules" edge to the ScanSchedule entity was cleared.
+func (m *TenantMutation) ScanSchedulesCleared() bool {
+	return m.clearedscan_schedules
 }
 
-// RemoveKeeperGroupIDs removes the "keeper_groups" edge to the DuplicateGroup entity by IDs.
-func (m *MachineMutation) RemoveKeeperGroupIDs(ids ...uuid.UUID) {
-	if m.removedkeeper_groups == nil {
-		m.removedkeeper_groups = make(map[uuid.UUID]struct{})
+// RemoveScanScheduleIDs removes the "scan_schedules" edge to the ScanSchedule entity by IDs.
+func (m *TenantMutation) RemoveScanScheduleIDs(ids ...uuid.UUID) {
+	if m.removedscan_schedules == nil {
+		m.removedscan_schedules = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		delete(m.keeper_groups, ids[i])
-		m.removedkeeper_groups[ids[i]] = struct{}{}
+		delete(m.scan_schedules, ids[i])
+		m.removedscan_schedules[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedKeeperGroups returns the removed IDs of the "keeper_groups" edge to the DuplicateGroup entity.
-func (m *MachineMutation) RemovedKeeperGroupsIDs() (ids []uuid.UUID) {
-	for id := range m.removedkeeper_groups {
+// RemovedScanSchedules returns the removed IDs of the "scan_schedules" edge to the ScanSchedule entity.
+func (m *TenantMutation) RemovedScanSchedulesIDs() (ids []uuid.UUID) {
+	for id := range m.removedscan_schedules {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// KeeperGroupsIDs returns the "keeper_groups" edge IDs in the mutation.
-func (m *MachineMutation) KeeperGroupsIDs() (ids []uuid.UUID) {
-	for id := range m.keeper_groups {
+// ScanSchedulesIDs returns the "scan_schedules" edge IDs in the mutation.
+func (m *TenantMutation) ScanSchedulesIDs() (ids []uuid.UUID) {
+	for id := range m.scan_schedules {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetKeeperGroups resets all changes to the "keeper_groups" edge.
-func (m *MachineMutation) ResetKeeperGroups() {
-	m.keeper_groups = nil
-	m.clearedkeeper_groups = false
-	m.removedkeeper_groups = nil
+// ResetScanSchedules resets all changes to the "scan_schedules" edge.
+func (m *TenantMutation) ResetScanSchedules() {
+	m.scan_schedules = nil
+	m.clearedscan_schedules = false
+	m.removedscan_schedules = nil
 }
 
-// AddFileInstanceIDs adds the "file_instances" edge to the FileInstance entity by ids.
-func (m *MachineMutation) AddFileInstanceIDs(ids ...uuid.UUID) {
-	if m.file_instances == nil {
-		m.file_instances = make(map[uuid.UUID]struct{})
+// AddScanScheduleRunIDs adds the "scan_schedule_runs" edge to the ScanScheduleRun entity by ids.
+func (m *TenantMutation) AddScanScheduleRunIDs(ids ...uuid.UUID) {
+	if m.scan_schedule_runs == nil {
+		m.scan_schedule_runs = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		m.file_instances[ids[i]] = struct{}{}
+		m.scan_schedule_runs[ids[i]] = struct{}{}
 	}
 }
 
-// ClearFileInstances clears the "file_instances" edge to the FileInstance entity.
-func (m *MachineMutation) ClearFileInstances() {
-	m.clearedfile_instances = true
+// ClearScanScheduleRuns clears the "scan_schedule_runs" edge to the ScanScheduleRun entity.
+func (m *TenantMutation) ClearScanScheduleRuns() {
+	m.clearedscan_schedule_runs = true
 }
 
-// FileInstancesCleared reports if the "file_instances" edge to the FileInstance entity was cleared.
-func (m *MachineMutation) FileInstancesCleared() bool {
-	return m.clearedfile_instances
+// ScanScheduleRunsCleared reports if the "scan_schedule_runs" edge to the ScanScheduleRun entity was cleared.
+func (m *TenantMutation) ScanScheduleRunsCleared() bool {
+	return m.clearedscan_schedule_runs
 }
 
-// RemoveFileInstanceIDs removes the "file_instances" edge to the FileInstance entity by IDs.
-func (m *MachineMutation) RemoveFileInstanceIDs(ids ...uuid.UUID) {
-	if m.removedfile_instances == nil {
-		m.removedfile_instances = make(map[uuid.UUID]struct{})
+// RemoveScanScheduleRunIDs removes the "scan_schedule_runs" edge to the ScanScheduleRun entity by IDs.
+func (m *TenantMutation) RemoveScanScheduleRunIDs(ids ...uuid.UUID) {
+	if m.removedscan_schedule_runs == nil {
+		m.removedscan_schedule_runs = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		delete(m.file_instances, ids[i])
-		m.removedfile_instances[ids[i]] = struct{}{}
+		delete(m.scan_schedule_runs, ids[i])
+		m.removedscan_schedule_runs[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedFileInstances returns the removed IDs of the "file_instances" edge to the FileInstance entity.
-func (m *MachineMutation) RemovedFileInstancesIDs() (ids []uuid.UUID) {
-	for id := range m.removedfile_instances {
+// RemovedScanScheduleRuns returns the removed IDs of the "scan_schedule_runs" edge to the ScanScheduleRun entity.
+func (m *TenantMutation) RemovedScanScheduleRunsIDs() (ids []uuid.UUID) {
+	for id := range m.removedscan_schedule_runs {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// FileInstancesIDs returns the "file_instances" edge IDs in the mutation.
-func (m *MachineMutation) FileInstancesIDs() (ids []uuid.UUID) {
-	for id := range m.file_instances {
+// ScanScheduleRunsIDs returns the "scan_schedule_runs" edge IDs in the mutation.
+func (m *TenantMutation) ScanScheduleRunsIDs() (ids []uuid.UUID) {
+	for id := range m.scan_schedule_runs {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetFileInstances resets all changes to the "file_instances" edge.
-func (m *MachineMutation) ResetFileInstances() {
-	m.file_instances = nil
-	m.clearedfile_instances = false
-	m.removedfile_instances = nil
+// ResetScanScheduleRuns resets all changes to the "scan_schedule_runs" edge.
+func (m *TenantMutation) ResetScanScheduleRuns() {
+	m.scan_schedule_runs = nil
+	m.clearedscan_schedule_runs = false
+	m.removedscan_schedule_runs = nil
 }
 
-// AddInitiatedScanIDs adds the "initiated_scans" edge to the Scan entity by ids.
-func (m *MachineMutation) AddInitiatedScanIDs(ids ...uuid.UUID) {
-	if m.initiated_scans == nil {
-		m.initiated_scans = make(map[uuid.UUID]struct{})
+// AddActionJobIDs adds the "action_jobs" edge to the ActionJob entity by ids.
+func (m *TenantMutation) AddActionJobIDs(ids ...uuid.UUID) {
+	if m.action_jobs == nil {
+		m.action_jobs = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		m.initiated_scans[ids[i]] = struct{}{}
+		m.action_jobs[ids[i]] = struct{}{}
 	}
 }
 
-// ClearInitiatedScans clears the "initiated_scans" edge to the Scan entity.
-func (m *MachineMutation) ClearInitiatedScans() {
-	m.clearedinitiated_scans = true
+// ClearActionJobs clears the "action_jobs" edge to the ActionJob entity.
+func (m *TenantMutation) ClearActionJobs() {
+	m.clearedaction_jobs = true
 }
 
-// InitiatedScansCleared reports if the "initiated_scans" edge to the Scan entity was cleared.
-func (m *MachineMutation) InitiatedScansCleared() bool {
-	return m.clearedinitiated_scans
+// ActionJobsCleared reports if the "action_jobs" edge to the ActionJob entity was cleared.
+func (m *TenantMutation) ActionJobsCleared() bool {
+	return m.clearedaction_jobs
 }
 
-// RemoveInitiatedScanIDs removes the "initiated_scans" edge to the Scan entity by IDs.
-func (m *MachineMutation) RemoveInitiatedScanIDs(ids ...uuid.UUID) {
-	if m.removedinitiated_scans == nil {
-		m.removedinitiated_scans = make(map[uuid.UUID]struct{})
+// RemoveActionJobIDs removes the "action_jobs" edge to the ActionJob entity by IDs.
+func (m *TenantMutation) RemoveActionJobIDs(ids ...uuid.UUID) {
+	if m.removedaction_jobs == nil {
+		m.removedaction_jobs = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		delete(m.initiated_scans, ids[i])
-		m.removedinitiated_scans[ids[i]] = struct{}{}
+		delete(m.action_jobs, ids[i])
+		m.removedaction_jobs[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedInitiatedScans returns the removed IDs of the "initiated_scans" edge to the Scan entity.
-func (m *MachineMutation) RemovedInitiatedScansIDs() (ids []uuid.UUID) {
-	for id := range m.removedinitiated_scans {
+// RemovedActionJobs returns the removed IDs of the "action_jobs" edge to the ActionJob entity.
+func (m *TenantMutation) RemovedActionJobsIDs() (ids []uuid.UUID) {
+	for id := range m.removedaction_jobs {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// InitiatedScansIDs returns the "initiated_scans" edge IDs in the mutation.
-func (m *MachineMutation) InitiatedScansIDs() (ids []uuid.UUID) {
-	for id := range m.initiated_scans {
+// ActionJobsIDs returns the "action_jobs" edge IDs in the mutation.
+func (m *TenantMutation) ActionJobsIDs() (ids []uuid.UUID) {
+	for id := range m.action_jobs {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetInitiatedScans resets all changes to the "initiated_scans" edge.
-func (m *MachineMutation) ResetInitiatedScans() {
-	m.initiated_scans = nil
-	m.clearedinitiated_scans = false
-	m.removedinitiated_scans = nil
+// ResetActionJobs resets all changes to the "action_jobs" edge.
+func (m *TenantMutation) ResetActionJobs() {
+	m.action_jobs = nil
+	m.clearedaction_jobs = false
+	m.removedaction_jobs = nil
 }
 
-// Where appends a list predicates to the MachineMutation builder.
-func (m *MachineMutation) Where(ps ...predicate.Machine) {
+// Where appends a list predicates to the TenantMutation builder.
+func (m *TenantMutation) Where(ps ...predicate.Tenant) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MachineMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TenantMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MachineMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Machine, len(ps))
+func (m *TenantMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Tenant, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3659,48 +15076,66 @@ func (m *MachineMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MachineMutation) Op() Op {
+func (m *TenantMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MachineMutation) SetOp(op Op) {
+func (m *TenantMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Machine).
-func (m *MachineMutation) Type() string {
+// Type returns the node type of this mutation (Tenant).
+func (m *TenantMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MachineMutation) Fields() []string {
-	fields := make([]string, 0, 8)
+func (m *TenantMutation) Fields() []string {
+	fields := make([]string, 0, 14)
 	if m.create_time != nil {
-		fields = append(fields, machine.FieldCreateTime)
+		fields = append(fields, tenant.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, machine.FieldUpdateTime)
+		fields = append(fields, tenant.FieldUpdateTime)
 	}
-	if m.tenant != nil {
-		fields = append(fields, machine.FieldTenantID)
+	if m.slug != nil {
+		fields = append(fields, tenant.FieldSlug)
 	}
 	if m.name != nil {
-		fields = append(fields, machine.FieldName)
+		fields = append(fields, tenant.FieldName)
 	}
-	if m.category != nil {
-		fields = append(fields, machine.FieldCategory)
+	if m.description != nil {
+		fields = append(fields, tenant.FieldDescription)
 	}
-	if m.hostname != nil {
-		fields = append(fields, machine.FieldHostname)
+	if m.primary_contact != nil {
+		fields = append(fields, tenant.FieldPrimaryContact)
 	}
-	if m.role != nil {
-		fields = append(fields, machine.FieldRole)
+	if m.archived_at != nil {
+		fields = append(fields, tenant.FieldArchivedAt)
 	}
-	if m.last_scan_at != nil {
-		fields = append(fields, machine.FieldLastScanAt)
+	if m.quota_manifest_bytes != nil {
+		fields = append(fields, tenant.FieldQuotaManifestBytes)
+	}
+	if m.quota_ingest_per_minute != nil {
+		fields = append(fields, tenant.FieldQuotaIngestPerMinute)
+	}
+	if m.quota_machines != nil {
+		fields = append(fields, tenant.FieldQuotaMachines)
+	}
+	if m.quota_retained_scans != nil {
+		fields = append(fields, tenant.FieldQuotaRetainedScans)
+	}
+	if m.quota_concurrent_actions != nil {
+		fields = append(fields, tenant.FieldQuotaConcurrentActions)
+	}
+	if m.retention_keep_scans != nil {
+		fields = append(fields, tenant.FieldRetentionKeepScans)
+	}
+	if m.retention_archive_after_days != nil {
+		fields = append(fields, tenant.FieldRetentionArchiveAfterDays)
 	}
 	return fields
 }
@@ -3708,24 +15143,36 @@ func (m *MachineMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MachineMutation) Field(name string) (ent.Value, bool) {
+func (m *TenantMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case machine.FieldCreateTime:
+	case tenant.FieldCreateTime:
 		return m.CreateTime()
-	case machine.FieldUpdateTime:
+	case tenant.FieldUpdateTime:
 		return m.UpdateTime()
-	case machine.FieldTenantID:
-		return m.TenantID()
-	case machine.FieldName:
+	case tenant.FieldSlug:
+		return m.Slug()
+	case tenant.FieldName:
 		return m.Name()
-	case machine.FieldCategory:
-		return m.Category()
-	case machine.FieldHostname:
-		return m.Hostname()
-	case machine.FieldRole:
-		return m.Role()
-	case machine.FieldLastScanAt:
-		return m.LastScanAt()
+	case tenant.FieldDescription:
+		return m.Description()
+	case tenant.FieldPrimaryContact:
+		return m.PrimaryContact()
+	case tenant.FieldArchivedAt:
+		return m.ArchivedAt()
+	case tenant.FieldQuotaManifestBytes:
+		return m.QuotaManifestBytes()
+	case tenant.FieldQuotaIngestPerMinute:
+		return m.QuotaIngestPerMinute()
+	case tenant.FieldQuotaMachines:
+		return m.QuotaMachines()
+	case tenant.FieldQuotaRetainedScans:
+		return m.QuotaRetainedScans()
+	case tenant.FieldQuotaConcurrentActions:
+		return m.QuotaConcurrentActions()
+	case tenant.FieldRetentionKeepScans:
+		return m.RetentionKeepScans()
+	case tenant.FieldRetentionArchiveAfterDays:
+		return m.RetentionArchiveAfterDays()
 	}
 	return nil, false
 }
@@ -3733,228 +15180,503 @@ func (m *MachineMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MachineMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TenantMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case machine.FieldCreateTime:
+	case tenant.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case machine.FieldUpdateTime:
+	case tenant.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case machine.FieldTenantID:
-		return m.OldTenantID(ctx)
-	case machine.FieldName:
+	case tenant.FieldSlug:
+		return m.OldSlug(ctx)
+	case tenant.FieldName:
 		return m.OldName(ctx)
-	case machine.FieldCategory:
-		return m.OldCategory(ctx)
-	case machine.FieldHostname:
-		return m.OldHostname(ctx)
-	case machine.FieldRole:
-		return m.OldRole(ctx)
-	case machine.FieldLastScanAt:
-		return m.OldLastScanAt(ctx)
+	case tenant.FieldDescription:
+		return m.OldDescription(ctx)
+	case tenant.FieldPrimaryContact:
+		return m.OldPrimaryContact(ctx)
+	case tenant.FieldArchivedAt:
+		return m.OldArchivedAt(ctx)
+	case tenant.FieldQuotaManifestBytes:
+		return m.OldQuotaManifestBytes(ctx)
+	case tenant.FieldQuotaIngestPerMinute:
+		return m.OldQuotaIngestPerMinute(ctx)
+	case tenant.FieldQuotaMachines:
+		return m.OldQuotaMachines(ctx)
+	case tenant.FieldQuotaRetainedScans:
+		return m.OldQuotaRetainedScans(ctx)
+	case tenant.FieldQuotaConcurrentActions:
+		return m.OldQuotaConcurrentActions(ctx)
+	case tenant.FieldRetentionKeepScans:
+		return m.OldRetentionKeepScans(ctx)
+	case tenant.FieldRetentionArchiveAfterDays:
+		return m.OldRetentionArchiveAfterDays(ctx)
 	}
-	return nil, fmt.Errorf("unknown Machine field %s", name)
+	return nil, fmt.Errorf("unknown Tenant field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MachineMutation) SetField(name string, value ent.Value) error {
+func (m *TenantMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case machine.FieldCreateTime:
+	case tenant.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case machine.FieldUpdateTime:
+	case tenant.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case tenant.FieldSlug:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSlug(v)
+		return nil
+	case tenant.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case tenant.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case tenant.FieldPrimaryContact:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrimaryContact(v)
+		return nil
+	case tenant.FieldArchivedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdateTime(v)
+		m.SetArchivedAt(v)
+		return nil
+	case tenant.FieldQuotaManifestBytes:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuotaManifestBytes(v)
 		return nil
-	case machine.FieldTenantID:
-		v, ok := value.(uuid.UUID)
+	case tenant.FieldQuotaIngestPerMinute:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTenantID(v)
+		m.SetQuotaIngestPerMinute(v)
 		return nil
-	case machine.FieldName:
-		v, ok := value.(string)
+	case tenant.FieldQuotaMachines:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetQuotaMachines(v)
 		return nil
-	case machine.FieldCategory:
-		v, ok := value.(machine.Category)
+	case tenant.FieldQuotaRetainedScans:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCategory(v)
+		m.SetQuotaRetainedScans(v)
 		return nil
-	case machine.FieldHostname:
-		v, ok := value.(string)
+	case tenant.FieldQuotaConcurrentActions:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetHostname(v)
+		m.SetQuotaConcurrentActions(v)
 		return nil
-	case machine.FieldRole:
-		v, ok := value.(string)
+	case tenant.FieldRetentionKeepScans:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRole(v)
+		m.SetRetentionKeepScans(v)
 		return nil
-	case machine.FieldLastScanAt:
-		v, ok := value.(time.Time)
+	case tenant.FieldRetentionArchiveAfterDays:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLastScanAt(v)
+		m.SetRetentionArchiveAfterDays(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Machine field %s", name)
+	return fmt.Errorf("unknown Tenant field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MachineMutation) AddedFields() []string {
-	return nil
+func (m *TenantMutation) AddedFields() []string {
+	var fields []string
+	if m.addquota_manifest_bytes != nil {
+		fields = append(fields, tenant.FieldQuotaManifestBytes)
+	}
+	if m.addquota_ingest_per_minute != nil {
+		fields = append(fields, tenant.FieldQuotaIngestPerMinute)
+	}
+	if m.addquota_machines != nil {
+		fields = append(fields, tenant.FieldQuotaMachines)
+	}
+	if m.addquota_retained_scans != nil {
+		fields = append(fields, tenant.FieldQuotaRetainedScans)
+	}
+	if m.addquota_concurrent_actions != nil {
+		fields = append(fields, tenant.FieldQuotaConcurrentActions)
+	}
+	if m.addretention_keep_scans != nil {
+		fields = append(fields, tenant.FieldRetentionKeepScans)
+	}
+	if m.addretention_archive_after_days != nil {
+		fields = append(fields, tenant.FieldRetentionArchiveAfterDays)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MachineMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TenantMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case tenant.FieldQuotaManifestBytes:
+		return m.AddedQuotaManifestBytes()
+	case tenant.FieldQuotaIngestPerMinute:
+		return m.AddedQuotaIngestPerMinute()
+	case tenant.FieldQuotaMachines:
+		return m.AddedQuotaMachines()
+	case tenant.FieldQuotaRetainedScans:
+		return m.AddedQuotaRetainedScans()
+	case tenant.FieldQuotaConcurrentActions:
+		return m.AddedQuotaConcurrentActions()
+	case tenant.FieldRetentionKeepScans:
+		return m.AddedRetentionKeepScans()
+	case tenant.FieldRetentionArchiveAfterDays:
+		return m.AddedRetentionArchiveAfterDays()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MachineMutation) AddField(name string, value ent.Value) error {
+func (m *TenantMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case tenant.FieldQuotaManifestBytes:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuotaManifestBytes(v)
+		return nil
+	case tenant.FieldQuotaIngestPerMinute:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuotaIngestPerMinute(v)
+		return nil
+	case tenant.FieldQuotaMachines:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuotaMachines(v)
+		return nil
+	case tenant.FieldQuotaRetainedScans:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuotaRetainedScans(v)
+		return nil
+	case tenant.FieldQuotaConcurrentActions:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuotaConcurrentActions(v)
+		return nil
+	case tenant.FieldRetentionKeepScans:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRetentionKeepScans(v)
+		return nil
+	case tenant.FieldRetentionArchiveAfterDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRetentionArchiveAfterDays(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Machine numeric field %s", name)
+	return fmt.Errorf("unknown Tenant numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MachineMutation) ClearedFields() []string {
+func (m *TenantMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(machine.FieldHostname) {
-		fields = append(fields, machine.FieldHostname)
+	if m.FieldCleared(tenant.FieldDescription) {
+		fields = append(fields, tenant.FieldDescription)
 	}
-	if m.FieldCleared(machine.FieldRole) {
-		fields = append(fields, machine.FieldRole)
+	if m.FieldCleared(tenant.FieldPrimaryContact) {
+		fields = append(fields, tenant.FieldPrimaryContact)
 	}
-	if m.FieldCleared(machine.FieldLastScanAt) {
-		fields = append(fields, machine.FieldLastScanAt)
+	if m.FieldCleared(tenant.FieldArchivedAt) {
+		fields = append(fields, tenant.FieldArchivedAt)
+	}
+	if m.FieldCleared(tenant.FieldQuotaManifestBytes) {
+		fields = append(fields, tenant.FieldQuotaManifestBytes)
+	}
+	if m.FieldCleared(tenant.FieldQuotaIngestPerMinute) {
+		fields = append(fields, tenant.FieldQuotaIngestPerMinute)
+	}
+	if m.FieldCleared(tenant.FieldQuotaMachines) {
+		fields = append(fields, tenant.FieldQuotaMachines)
+	}
+	if m.FieldCleared(tenant.FieldQuotaRetainedScans) {
+		fields = append(fields, tenant.FieldQuotaRetainedScans)
+	}
+	if m.FieldCleared(tenant.FieldQuotaConcurrentActions) {
+		fields = append(fields, tenant.FieldQuotaConcurrentActions)
+	}
+	if m.FieldCleared(tenant.FieldRetentionKeepScans) {
+		fields = append(fields, tenant.FieldRetentionKeepScans)
+	}
+	if m.FieldCleared(tenant.FieldRetentionArchiveAfterDays) {
+		fields = append(fields, tenant.FieldRetentionArchiveAfterDays)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MachineMutation) FieldCleared(name string) bool {
+func (m *TenantMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MachineMutation) ClearField(name string) error {
+func (m *TenantMutation) ClearField(name string) error {
 	switch name {
-	case machine.FieldHostname:
-		m.ClearHostname()
+	case tenant.FieldDescription:
+		m.ClearDescription()
 		return nil
-	case machine.FieldRole:
-		m.ClearRole()
+	case tenant.FieldPrimaryContact:
+		m.ClearPrimaryContact()
 		return nil
-	case machine.FieldLastScanAt:
-		m.ClearLastScanAt()
+	case tenant.FieldArchivedAt:
+		m.ClearArchivedAt()
+		return nil
+	case tenant.FieldQuotaManifestBytes:
+		m.ClearQuotaManifestBytes()
+		return nil
+	case tenant.FieldQuotaIngestPerMinute:
+		m.ClearQuotaIngestPerMinute()
+		return nil
+	case tenant.FieldQuotaMachines:
+		m.ClearQuotaMachines()
+		return nil
+	case tenant.FieldQuotaRetainedScans:
+		m.ClearQuotaRetainedScans()
+		return nil
+	case tenant.FieldQuotaConcurrentActions:
+		m.ClearQuotaConcurrentActions()
+		return nil
+	case tenant.FieldRetentionKeepScans:
+		m.ClearRetentionKeepScans()
+		return nil
+	case tenant.FieldRetentionArchiveAfterDays:
+		m.ClearRetentionArchiveAfterDays()
 		return nil
 	}
-	return fmt.Errorf("unknown Machine nullable field %s", name)
+	return fmt.Errorf("unknown Tenant nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MachineMutation) ResetField(name string) error {
+func (m *TenantMutation) ResetField(name string) error {
 	switch name {
-	case machine.FieldCreateTime:
+	case tenant.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case machine.FieldUpdateTime:
+	case tenant.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case machine.FieldTenantID:
-		m.ResetTenantID()
+	case tenant.FieldSlug:
+		m.ResetSlug()
 		return nil
-	case machine.FieldName:
+	case tenant.FieldName:
 		m.ResetName()
 		return nil
-	case machine.FieldCategory:
-		m.ResetCategory()
+	case tenant.FieldDescription:
+		m.ResetDescription()
 		return nil
-	case machine.FieldHostname:
-		m.ResetHostname()
+	case tenant.FieldPrimaryContact:
+		m.ResetPrimaryContact()
 		return nil
-	case machine.FieldRole:
-		m.ResetRole()
+	case tenant.FieldArchivedAt:
+		m.ResetArchivedAt()
 		return nil
-	case machine.FieldLastScanAt:
-		m.ResetLastScanAt()
+	case tenant.FieldQuotaManifestBytes:
+		m.ResetQuotaManifestBytes()
+		return nil
+	case tenant.FieldQuotaIngestPerMinute:
+		m.ResetQuotaIngestPerMinute()
+		return nil
+	case tenant.FieldQuotaMachines:
+		m.ResetQuotaMachines()
+		return nil
+	case tenant.FieldQuotaRetainedScans:
+		m.ResetQuotaRetainedScans()
+		return nil
+	case tenant.FieldQuotaConcurrentActions:
+		m.ResetQuotaConcurrentActions()
+		return nil
+	case tenant.FieldRetentionKeepScans:
+		m.ResetRetentionKeepScans()
+		return nil
+	case tenant.FieldRetentionArchiveAfterDays:
+		m.ResetRetentionArchiveAfterDays()
 		return nil
 	}
-	return fmt.Errorf("unknown Machine field %s", name)
+	return fmt.Errorf("unknown Tenant field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MachineMutation) AddedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.tenant != nil {
-		edges = append(edges, machine.EdgeTenant)
+func (m *TenantMutation) AddedEdges() []string {
+	edges := make([]string, 0, 12)
+	if m.machines != nil {
+		edges = append(edges, tenant.EdgeMachines)
 	}
-	if m.keeper_groups != nil {
-		edges = append(edges, machine.EdgeKeeperGroups)
+	if m.scans != nil {
+		edges = append(edges, tenant.EdgeScans)
+	}
+	if m.duplicate_groups != nil {
+		edges = append(edges, tenant.EdgeDuplicateGroups)
+	}
+	if m.action_audits != nil {
+		edges = append(edges, tenant.EdgeActionAudits)
+	}
+	if m.secrets != nil {
+		edges = append(edges, tenant.EdgeSecrets)
 	}
 	if m.file_instances != nil {
-		edges = append(edges, machine.EdgeFileInstances)
+		edges = append(edges, tenant.EdgeFileInstances)
 	}
-	if m.initiated_scans != nil {
-		edges = append(edges, machine.EdgeInitiatedScans)
+	if m.content_identities != nil {
+		edges = append(edges, tenant.EdgeContentIdentities)
+	}
+	if m.content_events != nil {
+		edges = append(edges, tenant.EdgeContentEvents)
+	}
+	if m.scan_targets != nil {
+		edges = append(edges, tenant.EdgeScanTargets)
+	}
+	if m.scan_schedules != nil {
+		edges = append(edges, tenant.EdgeScanSchedules)
+	}
+	if m.scan_schedule_runs != nil {
+		edges = append(edges, tenant.EdgeScanScheduleRuns)
+	}
+	if m.action_jobs != nil {
+		edges = append(edges, tenant.EdgeActionJobs)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MachineMutation) AddedIDs(name string) []ent.Value {
+func (m *TenantMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case machine.EdgeTenant:
-		if id := m.tenant; id != nil {
-			return []ent.Value{*id}
+	case tenant.EdgeMachines:
+		ids := make([]ent.Value, 0, len(m.machines))
+		for id := range m.machines {
+			ids = append(ids, id)
 		}
-	case machine.EdgeKeeperGroups:
-		ids := make([]ent.Value, 0, len(m.keeper_groups))
-		for id := range m.keeper_groups {
+		return ids
+	case tenant.EdgeScans:
+		ids := make([]ent.Value, 0, len(m.scans))
+		for id := range m.scans {
 			ids = append(ids, id)
 		}
 		return ids
-	case machine.EdgeFileInstances:
+	case tenant.EdgeDuplicateGroups:
+		ids := make([]ent.Value, 0, len(m.duplicate_groups))
+		for id := range m.duplicate_groups {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeActionAudits:
+		ids := make([]ent.Value, 0, len(m.action_audits))
+		for id := range m.action_audits {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeSecrets:
+		ids := make([]ent.Value, 0, len(m.secrets))
+		for id := range m.secrets {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeFileInstances:
 		ids := make([]ent.Value, 0, len(m.file_instances))
 		for id := range m.file_instances {
 			ids = append(ids, id)
 		}
 		return ids
-	case machine.EdgeInitiatedScans:
-		ids := make([]ent.Value, 0, len(m.initiated_scans))
-		for id := range m.initiated_scans {
+	case tenant.EdgeContentIdentities:
+		ids := make([]ent.Value, 0, len(m.content_identities))
+		for id := range m.content_identities {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeContentEvents:
+		ids := make([]ent.Value, 0, len(m.content_events))
+		for id := range m.content_events {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeScanTargets:
+		ids := make([]ent.Value, 0, len(m.scan_targets))
+		for id := range m.scan_targets {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeScanSchedules:
+		ids := make([]ent.Value, 0, len(m.scan_schedules))
+		for id := range m.scan_schedules {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeScanScheduleRuns:
+		ids := make([]ent.Value, 0, len(m.scan_schedule_runs))
+		for id := range m.scan_schedule_runs {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeActionJobs:
+		ids := make([]ent.Value, 0, len(m.action_jobs))
+		for id := range m.action_jobs {
 			ids = append(ids, id)
 		}
 		return ids
@@ -3963,39 +15685,120 @@ func (m *MachineMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MachineMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.removedkeeper_groups != nil {
-		edges = append(edges, machine.EdgeKeeperGroups)
+func (m *TenantMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 12)
+	if m.removedmachines != nil {
+		edges = append(edges, tenant.EdgeMachines)
+	}
+	if m.removedscans != nil {
+		edges = append(edges, tenant.EdgeScans)
+	}
+	if m.removedduplicate_groups != nil {
+		edges = append(edges, tenant.EdgeDuplicateGroups)
+	}
+	if m.removedaction_audits != nil {
+		edges = append(edges, tenant.EdgeActionAudits)
+	}
+	if m.removedsecrets != nil {
+		edges = append(edges, tenant.EdgeSecrets)
 	}
 	if m.removedfile_instances != nil {
-		edges = append(edges, machine.EdgeFileInstances)
+		edges = append(edges, tenant.EdgeFileInstances)
+	}
+	if m.removedcontent_identities != nil {
+		edges = append(edges, tenant.EdgeContentIdentities)
+	}
+	if m.removedcontent_events != nil {
+		edges = append(edges, tenant.EdgeContentEvents)
+	}
+	if m.removedscan_targets != nil {
+		edges = append(edges, tenant.EdgeScanTargets)
+	}
+	if m.removedscan_schedules != nil {
+		edges = append(edges, tenant.EdgeScanSchedules)
 	}
-	if m.removedinitiated_scans != nil {
-		edges = append(edges, machine.EdgeInitiatedScans)
+	if m.removedscan_schedule_runs != nil {
+		edges = append(edges, tenant.EdgeScanScheduleRuns)
+	}
+	if m.removedaction_jobs != nil {
+		edges = append(edges, tenant.EdgeActionJobs)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MachineMutation) RemovedIDs(name string) []ent.Value {
+func (m *TenantMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case machine.EdgeKeeperGroups:
-		ids := make([]ent.Value, 0, len(m.removedkeeper_groups))
-		for id := range m.removedkeeper_groups {
+	case tenant.EdgeMachines:
+		ids := make([]ent.Value, 0, len(m.removedmachines))
+		for id := range m.removedmachines {
 			ids = append(ids, id)
 		}
 		return ids
-	case machine.EdgeFileInstances:
+	case tenant.EdgeScans:
+		ids := make([]ent.Value, 0, len(m.removedscans))
+		for id := range m.removedscans {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeDuplicateGroups:
+		ids := make([]ent.Value, 0, len(m.removedduplicate_groups))
+		for id := range m.removedduplicate_groups {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeActionAudits:
+		ids := make([]ent.Value, 0, len(m.removedaction_audits))
+		for id := range m.removedaction_audits {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeSecrets:
+		ids := make([]ent.Value, 0, len(m.removedsecrets))
+		for id := range m.removedsecrets {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeFileInstances:
 		ids := make([]ent.Value, 0, len(m.removedfile_instances))
 		for id := range m.removedfile_instances {
 			ids = append(ids, id)
 		}
 		return ids
-	case machine.EdgeInitiatedScans:
-		ids := make([]ent.Value, 0, len(m.removedinitiated_scans))
-		for id := range m.removedinitiated_scans {
+	case tenant.EdgeContentIdentities:
+		ids := make([]ent.Value, 0, len(m.removedcontent_identities))
+		for id := range m.removedcontent_identities {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeContentEvents:
+		ids := make([]ent.Value, 0, len(m.removedcontent_events))
+		for id := range m.removedcontent_events {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeScanTargets:
+		ids := make([]ent.Value, 0, len(m.removedscan_targets))
+		for id := range m.removedscan_targets {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeScanSchedules:
+		ids := make([]ent.Value, 0, len(m.removedscan_schedules))
+		for id := range m.removedscan_schedules {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeScanScheduleRuns:
+		ids := make([]ent.Value, 0, len(m.removedscan_schedule_runs))
+		for id := range m.removedscan_schedule_runs {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeActionJobs:
+		ids := make([]ent.Value, 0, len(m.removedaction_jobs))
+		for id := range m.removedaction_jobs {
 			ids = append(ids, id)
 		}
 		return ids
@@ -4004,108 +15807,165 @@ func (m *MachineMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MachineMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.clearedtenant {
-		edges = append(edges, machine.EdgeTenant)
+func (m *TenantMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 12)
+	if m.clearedmachines {
+		edges = append(edges, tenant.EdgeMachines)
 	}
-	if m.clearedkeeper_groups {
-		edges = append(edges, machine.EdgeKeeperGroups)
+	if m.clearedscans {
+		edges = append(edges, tenant.EdgeScans)
+	}
+	if m.clearedduplicate_groups {
+		edges = append(edges, tenant.EdgeDuplicateGroups)
+	}
+	if m.clearedaction_audits {
+		edges = append(edges, tenant.EdgeActionAudits)
+	}
+	if m.clearedsecrets {
+		edges = append(edges, tenant.EdgeSecrets)
 	}
 	if m.clearedfile_instances {
-		edges = append(edges, machine.EdgeFileInstances)
+		edges = append(edges, tenant.EdgeFileInstances)
 	}
-	if m.clearedinitiated_scans {
-		edges = append(edges, machine.EdgeInitiatedScans)
+	if m.clearedcontent_identities {
+		edges = append(edges, tenant.EdgeContentIdentities)
+	}
+	if m.clearedcontent_events {
+		edges = append(edges, tenant.EdgeContentEvents)
+	}
+	if m.clearedscan_targets {
+		edges = append(edges, tenant.EdgeScanTargets)
+	}
+	if m.clearedscan_schedules {
+		edges = append(edges, tenant.EdgeScanSchedules)
+	}
+	if m.clearedscan_schedule_runs {
+		edges = append(edges, tenant.EdgeScanScheduleRuns)
+	}
+	if m.clearedaction_jobs {
+		edges = append(edges, tenant.EdgeActionJobs)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MachineMutation) EdgeCleared(name string) bool {
+func (m *TenantMutation) EdgeCleared(name string) bool {
 	switch name {
-	case machine.EdgeTenant:
-		return m.clearedtenant
-	case machine.EdgeKeeperGroups:
-		return m.clearedkeeper_groups
-	case machine.EdgeFileInstances:
+	case tenant.EdgeMachines:
+		return m.clearedmachines
+	case tenant.EdgeScans:
+		return m.clearedscans
+	case tenant.EdgeDuplicateGroups:
+		return m.clearedduplicate_groups
+	case tenant.EdgeActionAudits:
+		return m.clearedaction_audits
+	case tenant.EdgeSecrets:
+		return m.clearedsecrets
+	case tenant.EdgeFileInstances:
 		return m.clearedfile_instances
-	case machine.EdgeInitiatedScans:
-		return m.clearedinitiated_scans
+	case tenant.EdgeContentIdentities:
+		return m.clearedcontent_identities
+	case tenant.EdgeContentEvents:
+		return m.clearedcontent_events
+	case tenant.EdgeScanTargets:
+		return m.clearedscan_targets
+	case tenant.EdgeScanSchedules:
+		return m.clearedscan_schedules
+	case tenant.EdgeScanScheduleRuns:
+		return m.clearedscan_schedule_runs
+	case tenant.EdgeActionJobs:
+		return m.clearedaction_jobs
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MachineMutation) ClearEdge(name string) error {
+func (m *TenantMutation) ClearEdge(name string) error {
 	switch name {
-	case machine.EdgeTenant:
-		m.ClearTenant()
-		return nil
 	}
-	return fmt.Errorf("unknown Machine unique edge %s", name)
+	return fmt.Errorf("unknown Tenant unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MachineMutation) ResetEdge(name string) error {
+func (m *TenantMutation) ResetEdge(name string) error {
 	switch name {
-	case machine.EdgeTenant:
-		m.ResetTenant()
+	case tenant.EdgeMachines:
+		m.ResetMachines()
 		return nil
-	case machine.EdgeKeeperGroups:
-		m.ResetKeeperGroups()
+	case tenant.EdgeScans:
+		m.ResetScans()
 		return nil
-	case machine.EdgeFileInstances:
+	case tenant.EdgeDuplicateGroups:
+		m.ResetDuplicateGroups()
+		return nil
+	case tenant.EdgeActionAudits:
+		m.ResetActionAudits()
+		return nil
+	case tenant.EdgeSecrets:
+		m.ResetSecrets()
+		return nil
+	case tenant.EdgeFileInstances:
 		m.ResetFileInstances()
 		return nil
-	case machine.EdgeInitiatedScans:
-		m.ResetInitiatedScans()
+	case tenant.EdgeContentIdentities:
+		m.ResetContentIdentities()
+		return nil
+	case tenant.EdgeContentEvents:
+		m.ResetContentEvents()
+		return nil
+	case tenant.EdgeScanTargets:
+		m.ResetScanTargets()
+		return nil
+	case tenant.EdgeScanSchedules:
+		m.ResetScanSchedules()
+		return nil
+	case tenant.EdgeScanScheduleRuns:
+		m.ResetScanScheduleRuns()
+		return nil
+	case tenant.EdgeActionJobs:
+		m.ResetActionJobs()
 		return nil
 	}
-	return fmt.Errorf("unknown Machine edge %s", name)
+	return fmt.Errorf("unknown Tenant edge %s", name)
 }
 
-// ScanMutation represents an operation that mutates the Scan nodes in the graph.
-type ScanMutation struct {
+// TenantSecretMutation represents an operation that mutates the TenantSecret nodes in the graph.
+type TenantSecretMutation struct {
 	config
-	op                       Op
-	typ                      string
-	id                       *uuid.UUID
-	create_time              *time.Time
-	update_time              *time.Time
-	name                     *string
-	description              *string
-	started_at               *time.Time
-	completed_at             *time.Time
-	duplicate_group_count    *int
-	addduplicate_group_count *int
-	clearedFields            map[string]struct{}
-	tenant                   *uuid.UUID
-	clearedtenant            bool
-	initiated_machine        *uuid.UUID
-	clearedinitiated_machine bool
-	duplicate_groups         map[uuid.UUID]struct{}
-	removedduplicate_groups  map[uuid.UUID]struct{}
-	clearedduplicate_groups  bool
-	done                     bool
-	oldValue                 func(context.Context) (*Scan, error)
-	predicates               []predicate.Scan
-}
-
-var _ ent.Mutation = (*ScanMutation)(nil)
-
-// scanOption allows management of the mutation configuration using functional options.
-type scanOption func(*ScanMutation)
-
-// newScanMutation creates new mutation for the Scan entity.
-func newScanMutation(c config, op Op, opts ...scanOption) *ScanMutation {
-	m := &ScanMutation{
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	create_time    *time.Time
+	update_time    *time.Time
+	key_id         *string
+	secret         *string
+	not_before     *time.Time
+	expires_at     *time.Time
+	revoked_at     *time.Time
+	clearedFields  map[string]struct{}
+	tenant         *uuid.UUID
+	clearedtenant  bool
+	machine        *uuid.UUID
+	clearedmachine bool
+	done           bool
+	oldValue       func(context.Context) (*TenantSecret, error)
+	predicates     []predicate.TenantSecret
+}
+
+var _ ent.Mutation = (*TenantSecretMutation)(nil)
+
+// tenantsecretOption allows management of the mutation configuration using functional options.
+type tenantsecretOption func(*TenantSecretMutation)
+
+// newTenantSecretMutation creates new mutation for the TenantSecret entity.
+func newTenantSecretMutation(c config, op Op, opts ...tenantsecretOption) *TenantSecretMutation {
+	m := &TenantSecretMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeScan,
+		typ:           TypeTenantSecret,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4114,20 +15974,20 @@ func newScanMutation(c config, op Op, opts ...scanOption) *ScanMutation {
 	return m
 }
 
-// withScanID sets the ID field of the mutation.
-func withScanID(id uuid.UUID) scanOption {
-	return func(m *ScanMutation) {
+// withTenantSecretID sets the ID field of the mutation.
+func withTenantSecretID(id uuid.UUID) tenantsecretOption {
+	return func(m *TenantSecretMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Scan
+			value *TenantSecret
 		)
-		m.oldValue = func(ctx context.Context) (*Scan, error) {
+		m.oldValue = func(ctx context.Context) (*TenantSecret, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Scan.Get(ctx, id)
+					value, err = m.Client().TenantSecret.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4136,10 +15996,10 @@ func withScanID(id uuid.UUID) scanOption {
 	}
 }
 
-// withScan sets the old Scan of the mutation.
-func withScan(node *Scan) scanOption {
-	return func(m *ScanMutation) {
-		m.oldValue = func(context.Context) (*Scan, error) {
+// withTenantSecret sets the old TenantSecret of the mutation.
+func withTenantSecret(node *TenantSecret) tenantsecretOption {
+	return func(m *TenantSecretMutation) {
+		m.oldValue = func(context.Context) (*TenantSecret, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4148,7 +16008,7 @@ func withScan(node *Scan) scanOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ScanMutation) Client() *Client {
+func (m TenantSecretMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4156,7 +16016,7 @@ func (m ScanMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ScanMutation) Tx() (*Tx, error) {
+func (m TenantSecretMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4166,14 +16026,14 @@ func (m ScanMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Scan entities.
-func (m *ScanMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of TenantSecret entities.
+func (m *TenantSecretMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ScanMutation) ID() (id uuid.UUID, exists bool) {
+func (m *TenantSecretMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4184,7 +16044,7 @@ func (m *ScanMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ScanMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *TenantSecretMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4193,19 +16053,19 @@ func (m *ScanMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Scan.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().TenantSecret.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *ScanMutation) SetCreateTime(t time.Time) {
+func (m *TenantSecretMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *ScanMutation) CreateTime() (r time.Time, exists bool) {
+func (m *TenantSecretMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -4213,10 +16073,10 @@ func (m *ScanMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the Scan entity.
-// If the Scan object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the TenantSecret entity.
+// If the TenantSecret object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ScanMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *TenantSecretMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -4231,17 +16091,17 @@ func (m *ScanMutation) OldCreateTime(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *ScanMutation) ResetCreateTime() {
+func (m *TenantSecretMutation) ResetCreateTime() {
 	m.create_time = nil
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *ScanMutation) SetUpdateTime(t time.Time) {
+func (m *TenantSecretMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *ScanMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *TenantSecretMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -4249,10 +16109,10 @@ func (m *ScanMutation) UpdateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the Scan entity.
-// If the Scan object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the TenantSecret entity.
+// If the TenantSecret object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ScanMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *TenantSecretMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -4267,17 +16127,17 @@ func (m *ScanMutation) OldUpdateTime(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *ScanMutation) ResetUpdateTime() {
+func (m *TenantSecretMutation) ResetUpdateTime() {
 	m.update_time = nil
 }
 
 // SetTenantID sets the "tenant_id" field.
-func (m *ScanMutation) SetTenantID(u uuid.UUID) {
+func (m *TenantSecretMutation) SetTenantID(u uuid.UUID) {
 	m.tenant = &u
 }
 
 // TenantID returns the value of the "tenant_id" field in the mutation.
-func (m *ScanMutation) TenantID() (r uuid.UUID, exists bool) {
+func (m *TenantSecretMutation) TenantID() (r uuid.UUID, exists bool) {
 	v := m.tenant
 	if v == nil {
 		return
@@ -4285,10 +16145,10 @@ func (m *ScanMutation) TenantID() (r uuid.UUID, exists bool) {
 	return *v, true
 }
 
-// OldTenantID returns the old "tenant_id" field's value of the Scan entity.
-// If the Scan object wasn't provided to the builder, the object is fetched from the database.
+// OldTenantID returns the old "tenant_id" field's value of the TenantSecret entity.
+// If the TenantSecret object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ScanMutation) OldTenantID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *TenantSecretMutation) OldTenantID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
 	}
@@ -4303,300 +16163,280 @@ func (m *ScanMutation) OldTenantID(ctx context.Context) (v uuid.UUID, err error)
 }
 
 // ResetTenantID resets all changes to the "tenant_id" field.
-func (m *ScanMutation) ResetTenantID() {
+func (m *TenantSecretMutation) ResetTenantID() {
 	m.tenant = nil
 }
 
-// SetInitiatedMachineID sets the "initiated_machine_id" field.
-func (m *ScanMutation) SetInitiatedMachineID(u uuid.UUID) {
-	m.initiated_machine = &u
+// SetMachineID sets the "machine_id" field.
+func (m *TenantSecretMutation) SetMachineID(u uuid.UUID) {
+	m.machine = &u
 }
 
-// InitiatedMachineID returns the value of the "initiated_machine_id" field in the mutation.
-func (m *ScanMutation) InitiatedMachineID() (r uuid.UUID, exists bool) {
-	v := m.initiated_machine
+// MachineID returns the value of the "machine_id" field in the mutation.
+func (m *TenantSecretMutation) MachineID() (r uuid.UUID, exists bool) {
+	v := m.machine
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldInitiatedMachineID returns the old "initiated_machine_id" field's value of the Scan entity.
-// If the Scan object wasn't provided to the builder, the object is fetched from the database.
+// OldMachineID returns the old "machine_id" field's value of the TenantSecret entity.
+// If the TenantSecret object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ScanMutation) OldInitiatedMachineID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *TenantSecretMutation) OldMachineID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldInitiatedMachineID is only allowed on UpdateOne operations")
+		return v, errors.New("OldMachineID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldInitiatedMachineID requires an ID field in the mutation")
+		return v, errors.New("OldMachineID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldInitiatedMachineID: %w", err)
+		return v, fmt.Errorf("querying old value for OldMachineID: %w", err)
 	}
-	return oldValue.InitiatedMachineID, nil
+	return oldValue.MachineID, nil
 }
 
-// ClearInitiatedMachineID clears the value of the "initiated_machine_id" field.
-func (m *ScanMutation) ClearInitiatedMachineID() {
-	m.initiated_machine = nil
-	m.clearedFields[scan.FieldInitiatedMachineID] = struct{}{}
+// ClearMachineID clears the value of the "machine_id" field.
+func (m *TenantSecretMutation) ClearMachineID() {
+	m.machine = nil
+	m.clearedFields[tenantsecret.FieldMachineID] = struct{}{}
 }
 
-// InitiatedMachineIDCleared returns if the "initiated_machine_id" field was cleared in this mutation.
-func (m *ScanMutation) InitiatedMachineIDCleared() bool {
-	_, ok := m.clearedFields[scan.FieldInitiatedMachineID]
+// MachineIDCleared returns if the "machine_id" field was cleared in this mutation.
+func (m *TenantSecretMutation) MachineIDCleared() bool {
+	_, ok := m.clearedFields[tenantsecret.FieldMachineID]
 	return ok
 }
 
-// ResetInitiatedMachineID resets all changes to the "initiated_machine_id" field.
-func (m *ScanMutation) ResetInitiatedMachineID() {
-	m.initiated_machine = nil
-	delete(m.clearedFields, scan.FieldInitiatedMachineID)
+// ResetMachineID resets all changes to the "machine_id" field.
+func (m *TenantSecretMutation) ResetMachineID() {
+	m.machine = nil
+	delete(m.clearedFields, tenantsecret.FieldMachineID)
 }
 
-// SetName sets the "name" field.
-func (m *ScanMutation) SetName(s string) {
-	m.name = &s
+// SetKeyID sets the "key_id" field.
+func (m *TenantSecretMutation) SetKeyID(s string) {
+	m.key_id = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *ScanMutation) Name() (r string, exists bool) {
-	v := m.name
+// KeyID returns the value of the "key_id" field in the mutation.
+func (m *TenantSecretMutation) KeyID() (r string, exists bool) {
+	v := m.key_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Scan entity.
-// If the Scan object wasn't provided to the builder, the object is fetched from the database.
+// OldKeyID returns the old "key_id" field's value of the TenantSecret entity.
+// If the TenantSecret object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ScanMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *TenantSecretMutation) OldKeyID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldKeyID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldKeyID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldKeyID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.KeyID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *ScanMutation) ResetName() {
-	m.name = nil
+// ResetKeyID resets all changes to the "key_id" field.
+func (m *TenantSecretMutation) ResetKeyID() {
+	m.key_id = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *ScanMutation) SetDescription(s string) {
-	m.description = &s
+// SetSecret sets the "secret" field.
+func (m *TenantSecretMutation) SetSecret(s string) {
+	m.secret = &s
 }
-
-// Description returns the value of the "description" field in the mutation.
-func (m *ScanMutation) Description() (r string, exists bool) {
-	v := m.description
+
+// Secret returns the value of the "secret" field in the mutation.
+func (m *TenantSecretMutation) Secret() (r string, exists bool) {
+	v := m.secret
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Scan entity.
-// If the Scan object wasn't provided to the builder, the object is fetched from the database.
+// OldSecret returns the old "secret" field's value of the TenantSecret entity.
+// If the TenantSecret object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ScanMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *TenantSecretMutation) OldSecret(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldSecret requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
 	}
-	return oldValue.Description, nil
-}
-
-// ClearDescription clears the value of the "description" field.
-func (m *ScanMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[scan.FieldDescription] = struct{}{}
-}
-
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *ScanMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[scan.FieldDescription]
-	return ok
+	return oldValue.Secret, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *ScanMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, scan.FieldDescription)
+// ResetSecret resets all changes to the "secret" field.
+func (m *TenantSecretMutation) ResetSecret() {
+	m.secret = nil
 }
 
-// SetStartedAt sets the "started_at" field.
-func (m *ScanMutation) SetStartedAt(t time.Time) {
-	m.started_at = &t
+// SetNotBefore sets the "not_before" field.
+func (m *TenantSecretMutation) SetNotBefore(t time.Time) {
+	m.not_before = &t
 }
 
-// StartedAt returns the value of the "started_at" field in the mutation.
-func (m *ScanMutation) StartedAt() (r time.Time, exists bool) {
-	v := m.started_at
+// NotBefore returns the value of the "not_before" field in the mutation.
+func (m *TenantSecretMutation) NotBefore() (r time.Time, exists bool) {
+	v := m.not_before
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStartedAt returns the old "started_at" field's value of the Scan entity.
-// If the Scan object wasn't provided to the builder, the object is fetched from the database.
+// OldNotBefore returns the old "not_before" field's value of the TenantSecret entity.
+// If the TenantSecret object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ScanMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
+func (m *TenantSecretMutation) OldNotBefore(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldNotBefore is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+		return v, errors.New("OldNotBefore requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldNotBefore: %w", err)
 	}
-	return oldValue.StartedAt, nil
+	return oldValue.NotBefore, nil
 }
 
-// ResetStartedAt resets all changes to the "started_at" field.
-func (m *ScanMutation) ResetStartedAt() {
-	m.started_at = nil
+// ResetNotBefore resets all changes to the "not_before" field.
+func (m *TenantSecretMutation) ResetNotBefore() {
+	m.not_before = nil
 }
 
-// SetCompletedAt sets the "completed_at" field.
-func (m *ScanMutation) SetCompletedAt(t time.Time) {
-	m.completed_at = &t
+// SetExpiresAt sets the "expires_at" field.
+func (m *TenantSecretMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
 }
 
-// CompletedAt returns the value of the "completed_at" field in the mutation.
-func (m *ScanMutation) CompletedAt() (r time.Time, exists bool) {
-	v := m.completed_at
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *TenantSecretMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCompletedAt returns the old "completed_at" field's value of the Scan entity.
-// If the Scan object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiresAt returns the old "expires_at" field's value of the TenantSecret entity.
+// If the TenantSecret object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ScanMutation) OldCompletedAt(ctx context.Context) (v time.Time, err error) {
+func (m *TenantSecretMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
-	return oldValue.CompletedAt, nil
+	return oldValue.ExpiresAt, nil
 }
 
-// ClearCompletedAt clears the value of the "completed_at" field.
-func (m *ScanMutation) ClearCompletedAt() {
-	m.completed_at = nil
-	m.clearedFields[scan.FieldCompletedAt] = struct{}{}
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *TenantSecretMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[tenantsecret.FieldExpiresAt] = struct{}{}
 }
 
-// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
-func (m *ScanMutation) CompletedAtCleared() bool {
-	_, ok := m.clearedFields[scan.FieldCompletedAt]
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *TenantSecretMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[tenantsecret.FieldExpiresAt]
 	return ok
 }
 
-// ResetCompletedAt resets all changes to the "completed_at" field.
-func (m *ScanMutation) ResetCompletedAt() {
-	m.completed_at = nil
-	delete(m.clearedFields, scan.FieldCompletedAt)
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *TenantSecretMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, tenantsecret.FieldExpiresAt)
 }
 
-// SetDuplicateGroupCount sets the "duplicate_group_count" field.
-func (m *ScanMutation) SetDuplicateGroupCount(i int) {
-	m.duplicate_group_count = &i
-	m.addduplicate_group_count = nil
+// SetRevokedAt sets the "revoked_at" field.
+func (m *TenantSecretMutation) SetRevokedAt(t time.Time) {
+	m.revoked_at = &t
 }
 
-// DuplicateGroupCount returns the value of the "duplicate_group_count" field in the mutation.
-func (m *ScanMutation) DuplicateGroupCount() (r int, exists bool) {
-	v := m.duplicate_group_count
+// RevokedAt returns the value of the "revoked_at" field in the mutation.
+func (m *TenantSecretMutation) RevokedAt() (r time.Time, exists bool) {
+	v := m.revoked_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDuplicateGroupCount returns the old "duplicate_group_count" field's value of the Scan entity.
-// If the Scan object wasn't provided to the builder, the object is fetched from the database.
+// OldRevokedAt returns the old "revoked_at" field's value of the TenantSecret entity.
+// If the TenantSecret object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ScanMutation) OldDuplicateGroupCount(ctx context.Context) (v int, err error) {
+func (m *TenantSecretMutation) OldRevokedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDuplicateGroupCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldRevokedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDuplicateGroupCount requires an ID field in the mutation")
+		return v, errors.New("OldRevokedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDuplicateGroupCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldRevokedAt: %w", err)
 	}
-	return oldValue.DuplicateGroupCount, nil
+	return oldValue.RevokedAt, nil
 }
 
-// AddDuplicateGroupCount adds i to the "duplicate_group_count" field.
-func (m *ScanMutation) AddDuplicateGroupCount(i int) {
-	if m.addduplicate_group_count != nil {
-		*m.addduplicate_group_count += i
-	} else {
-		m.addduplicate_group_count = &i
-	}
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (m *TenantSecretMutation) ClearRevokedAt() {
+	m.revoked_at = nil
+	m.clearedFields[tenantsecret.FieldRevokedAt] = struct{}{}
 }
 
-// AddedDuplicateGroupCount returns the value that was added to the "duplicate_group_count" field in this mutation.
-func (m *ScanMutation) AddedDuplicateGroupCount() (r int, exists bool) {
-	v := m.addduplicate_group_count
-	if v == nil {
-		return
-	}
-	return *v, true
+// RevokedAtCleared returns if the "revoked_at" field was cleared in this mutation.
+func (m *TenantSecretMutation) RevokedAtCleared() bool {
+	_, ok := m.clearedFields[tenantsecret.FieldRevokedAt]
+	return ok
 }
 
-// ResetDuplicateGroupCount resets all changes to the "duplicate_group_count" field.
-func (m *ScanMutation) ResetDuplicateGroupCount() {
-	m.duplicate_group_count = nil
-	m.addduplicate_group_count = nil
+// ResetRevokedAt resets all changes to the "revoked_at" field.
+func (m *TenantSecretMutation) ResetRevokedAt() {
+	m.revoked_at = nil
+	delete(m.clearedFields, tenantsecret.FieldRevokedAt)
 }
 
 // ClearTenant clears the "tenant" edge to the Tenant entity.
-func (m *ScanMutation) ClearTenant() {
+func (m *TenantSecretMutation) ClearTenant() {
 	m.clearedtenant = true
-	m.clearedFields[scan.FieldTenantID] = struct{}{}
+	m.clearedFields[tenantsecret.FieldTenantID] = struct{}{}
 }
 
 // TenantCleared reports if the "tenant" edge to the Tenant entity was cleared.
-func (m *ScanMutation) TenantCleared() bool {
+func (m *TenantSecretMutation) TenantCleared() bool {
 	return m.clearedtenant
 }
 
 // TenantIDs returns the "tenant" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // TenantID instead. It exists only for internal usage by the builders.
-func (m *ScanMutation) TenantIDs() (ids []uuid.UUID) {
+func (m *TenantSecretMutation) TenantIDs() (ids []uuid.UUID) {
 	if id := m.tenant; id != nil {
 		ids = append(ids, *id)
 	}
@@ -4604,101 +16444,47 @@ func (m *ScanMutation) TenantIDs() (ids []uuid.UUID) {
 }
 
 // ResetTenant resets all changes to the "tenant" edge.
-func (m *ScanMutation) ResetTenant() {
+func (m *TenantSecretMutation) ResetTenant() {
 	m.tenant = nil
 	m.clearedtenant = false
 }
 
-// ClearInitiatedMachine clears the "initiated_machine" edge to the Machine entity.
-func (m *ScanMutation) ClearInitiatedMachine() {
-	m.clearedinitiated_machine = true
-	m.clearedFields[scan.FieldInitiatedMachineID] = struct{}{}
+// ClearMachine clears the "machine" edge to the Machine entity.
+func (m *TenantSecretMutation) ClearMachine() {
+	m.clearedmachine = true
+	m.clearedFields[tenantsecret.FieldMachineID] = struct{}{}
 }
 
-// InitiatedMachineCleared reports if the "initiated_machine" edge to the Machine entity was cleared.
-func (m *ScanMutation) InitiatedMachineCleared() bool {
-	return m.InitiatedMachineIDCleared() || m.clearedinitiated_machine
+// MachineCleared reports if the "machine" edge to the Machine entity was cleared.
+func (m *TenantSecretMutation) MachineCleared() bool {
+	return m.MachineIDCleared() || m.clearedmachine
 }
 
-// InitiatedMachineIDs returns the "initiated_machine" edge IDs in the mutation.
+// MachineIDs returns the "machine" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// InitiatedMachineID instead. It exists only for internal usage by the builders.
-func (m *ScanMutation) InitiatedMachineIDs() (ids []uuid.UUID) {
-	if id := m.initiated_machine; id != nil {
+// MachineID instead. It exists only for internal usage by the builders.
+func (m *TenantSecretMutation) MachineIDs() (ids []uuid.UUID) {
+	if id := m.machine; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetInitiatedMachine resets all changes to the "initiated_machine" edge.
-func (m *ScanMutation) ResetInitiatedMachine() {
-	m.initiated_machine = nil
-	m.clearedinitiated_machine = false
-}
-
-// AddDuplicateGroupIDs adds the "duplicate_groups" edge to the DuplicateGroup entity by ids.
-func (m *ScanMutation) AddDuplicateGroupIDs(ids ...uuid.UUID) {
-	if m.duplicate_groups == nil {
-		m.duplicate_groups = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.duplicate_groups[ids[i]] = struct{}{}
-	}
-}
-
-// ClearDuplicateGroups clears the "duplicate_groups" edge to the DuplicateGroup entity.
-func (m *ScanMutation) ClearDuplicateGroups() {
-	m.clearedduplicate_groups = true
-}
-
-// DuplicateGroupsCleared reports if the "duplicate_groups" edge to the DuplicateGroup entity was cleared.
-func (m *ScanMutation) DuplicateGroupsCleared() bool {
-	return m.clearedduplicate_groups
-}
-
-// RemoveDuplicateGroupIDs removes the "duplicate_groups" edge to the DuplicateGroup entity by IDs.
-func (m *ScanMutation) RemoveDuplicateGroupIDs(ids ...uuid.UUID) {
-	if m.removedduplicate_groups == nil {
-		m.removedduplicate_groups = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.duplicate_groups, ids[i])
-		m.removedduplicate_groups[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedDuplicateGroups returns the removed IDs of the "duplicate_groups" edge to the DuplicateGroup entity.
-func (m *ScanMutation) RemovedDuplicateGroupsIDs() (ids []uuid.UUID) {
-	for id := range m.removedduplicate_groups {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// DuplicateGroupsIDs returns the "duplicate_groups" edge IDs in the mutation.
-func (m *ScanMutation) DuplicateGroupsIDs() (ids []uuid.UUID) {
-	for id := range m.duplicate_groups {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetDuplicateGroups resets all changes to the "duplicate_groups" edge.
-func (m *ScanMutation) ResetDuplicateGroups() {
-	m.duplicate_groups = nil
-	m.clearedduplicate_groups = false
-	m.removedduplicate_groups = nil
+// ResetMachine resets all changes to the "machine" edge.
+func (m *TenantSecretMutation) ResetMachine() {
+	m.machine = nil
+	m.clearedmachine = false
 }
 
-// Where appends a list predicates to the ScanMutation builder.
-func (m *ScanMutation) Where(ps ...predicate.Scan) {
+// Where appends a list predicates to the TenantSecretMutation builder.
+func (m *TenantSecretMutation) Where(ps ...predicate.TenantSecret) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ScanMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TenantSecretMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ScanMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Scan, len(ps))
+func (m *TenantSecretMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TenantSecret, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4706,51 +16492,51 @@ func (m *ScanMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ScanMutation) Op() Op {
+func (m *TenantSecretMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ScanMutation) SetOp(op Op) {
+func (m *TenantSecretMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Scan).
-func (m *ScanMutation) Type() string {
+// Type returns the node type of this mutation (TenantSecret).
+func (m *TenantSecretMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ScanMutation) Fields() []string {
+func (m *TenantSecretMutation) Fields() []string {
 	fields := make([]string, 0, 9)
 	if m.create_time != nil {
-		fields = append(fields, scan.FieldCreateTime)
+		fields = append(fields, tenantsecret.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, scan.FieldUpdateTime)
+		fields = append(fields, tenantsecret.FieldUpdateTime)
 	}
 	if m.tenant != nil {
-		fields = append(fields, scan.FieldTenantID)
+		fields = append(fields, tenantsecret.FieldTenantID)
 	}
-	if m.initiated_machine != nil {
-		fields = append(fields, scan.FieldInitiatedMachineID)
+	if m.machine != nil {
+		fields = append(fields, tenantsecret.FieldMachineID)
 	}
-	if m.name != nil {
-		fields = append(fields, scan.FieldName)
+	if m.key_id != nil {
+		fields = append(fields, tenantsecret.FieldKeyID)
 	}
-	if m.description != nil {
-		fields = append(fields, scan.FieldDescription)
+	if m.secret != nil {
+		fields = append(fields, tenantsecret.FieldSecret)
 	}
-	if m.started_at != nil {
-		fields = append(fields, scan.FieldStartedAt)
+	if m.not_before != nil {
+		fields = append(fields, tenantsecret.FieldNotBefore)
 	}
-	if m.completed_at != nil {
-		fields = append(fields, scan.FieldCompletedAt)
+	if m.expires_at != nil {
+		fields = append(fields, tenantsecret.FieldExpiresAt)
 	}
-	if m.duplicate_group_count != nil {
-		fields = append(fields, scan.FieldDuplicateGroupCount)
+	if m.revoked_at != nil {
+		fields = append(fields, tenantsecret.FieldRevokedAt)
 	}
 	return fields
 }
@@ -4758,26 +16544,26 @@ func (m *ScanMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ScanMutation) Field(name string) (ent.Value, bool) {
+func (m *TenantSecretMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case scan.FieldCreateTime:
+	case tenantsecret.FieldCreateTime:
 		return m.CreateTime()
-	case scan.FieldUpdateTime:
+	case tenantsecret.FieldUpdateTime:
 		return m.UpdateTime()
-	case scan.FieldTenantID:
+	case tenantsecret.FieldTenantID:
 		return m.TenantID()
-	case scan.FieldInitiatedMachineID:
-		return m.InitiatedMachineID()
-	case scan.FieldName:
-		return m.Name()
-	case scan.FieldDescription:
-		return m.Description()
-	case scan.FieldStartedAt:
-		return m.StartedAt()
-	case scan.FieldCompletedAt:
-		return m.CompletedAt()
-	case scan.FieldDuplicateGroupCount:
-		return m.DuplicateGroupCount()
+	case tenantsecret.FieldMachineID:
+		return m.MachineID()
+	case tenantsecret.FieldKeyID:
+		return m.KeyID()
+	case tenantsecret.FieldSecret:
+		return m.Secret()
+	case tenantsecret.FieldNotBefore:
+		return m.NotBefore()
+	case tenantsecret.FieldExpiresAt:
+		return m.ExpiresAt()
+	case tenantsecret.FieldRevokedAt:
+		return m.RevokedAt()
 	}
 	return nil, false
 }
@@ -4785,375 +16571,322 @@ func (m *ScanMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ScanMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TenantSecretMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case scan.FieldCreateTime:
+	case tenantsecret.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case scan.FieldUpdateTime:
+	case tenantsecret.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case scan.FieldTenantID:
+	case tenantsecret.FieldTenantID:
 		return m.OldTenantID(ctx)
-	case scan.FieldInitiatedMachineID:
-		return m.OldInitiatedMachineID(ctx)
-	case scan.FieldName:
-		return m.OldName(ctx)
-	case scan.FieldDescription:
-		return m.OldDescription(ctx)
-	case scan.FieldStartedAt:
-		return m.OldStartedAt(ctx)
-	case scan.FieldCompletedAt:
-		return m.OldCompletedAt(ctx)
-	case scan.FieldDuplicateGroupCount:
-		return m.OldDuplicateGroupCount(ctx)
+	case tenantsecret.FieldMachineID:
+		return m.OldMachineID(ctx)
+	case tenantsecret.FieldKeyID:
+		return m.OldKeyID(ctx)
+	case tenantsecret.FieldSecret:
+		return m.OldSecret(ctx)
+	case tenantsecret.FieldNotBefore:
+		return m.OldNotBefore(ctx)
+	case tenantsecret.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case tenantsecret.FieldRevokedAt:
+		return m.OldRevokedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Scan field %s", name)
+	return nil, fmt.Errorf("unknown TenantSecret field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ScanMutation) SetField(name string, value ent.Value) error {
+func (m *TenantSecretMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case scan.FieldCreateTime:
+	case tenantsecret.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case scan.FieldUpdateTime:
+	case tenantsecret.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case scan.FieldTenantID:
+	case tenantsecret.FieldTenantID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetTenantID(v)
 		return nil
-	case scan.FieldInitiatedMachineID:
+	case tenantsecret.FieldMachineID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetInitiatedMachineID(v)
+		m.SetMachineID(v)
 		return nil
-	case scan.FieldName:
+	case tenantsecret.FieldKeyID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetKeyID(v)
 		return nil
-	case scan.FieldDescription:
+	case tenantsecret.FieldSecret:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetSecret(v)
 		return nil
-	case scan.FieldStartedAt:
+	case tenantsecret.FieldNotBefore:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStartedAt(v)
+		m.SetNotBefore(v)
 		return nil
-	case scan.FieldCompletedAt:
+	case tenantsecret.FieldExpiresAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCompletedAt(v)
+		m.SetExpiresAt(v)
 		return nil
-	case scan.FieldDuplicateGroupCount:
-		v, ok := value.(int)
+	case tenantsecret.FieldRevokedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDuplicateGroupCount(v)
+		m.SetRevokedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Scan field %s", name)
+	return fmt.Errorf("unknown TenantSecret field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ScanMutation) AddedFields() []string {
-	var fields []string
-	if m.addduplicate_group_count != nil {
-		fields = append(fields, scan.FieldDuplicateGroupCount)
-	}
-	return fields
+func (m *TenantSecretMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ScanMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case scan.FieldDuplicateGroupCount:
-		return m.AddedDuplicateGroupCount()
-	}
+func (m *TenantSecretMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ScanMutation) AddField(name string, value ent.Value) error {
+func (m *TenantSecretMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case scan.FieldDuplicateGroupCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddDuplicateGroupCount(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Scan numeric field %s", name)
+	return fmt.Errorf("unknown TenantSecret numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ScanMutation) ClearedFields() []string {
+func (m *TenantSecretMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(scan.FieldInitiatedMachineID) {
-		fields = append(fields, scan.FieldInitiatedMachineID)
+	if m.FieldCleared(tenantsecret.FieldMachineID) {
+		fields = append(fields, tenantsecret.FieldMachineID)
 	}
-	if m.FieldCleared(scan.FieldDescription) {
-		fields = append(fields, scan.FieldDescription)
+	if m.FieldCleared(tenantsecret.FieldExpiresAt) {
+		fields = append(fields, tenantsecret.FieldExpiresAt)
 	}
-	if m.FieldCleared(scan.FieldCompletedAt) {
-		fields = append(fields, scan.FieldCompletedAt)
+	if m.FieldCleared(tenantsecret.FieldRevokedAt) {
+		fields = append(fields, tenantsecret.FieldRevokedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ScanMutation) FieldCleared(name string) bool {
+func (m *TenantSecretMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ScanMutation) ClearField(name string) error {
+func (m *TenantSecretMutation) ClearField(name string) error {
 	switch name {
-	case scan.FieldInitiatedMachineID:
-		m.ClearInitiatedMachineID()
+	case tenantsecret.FieldMachineID:
+		m.ClearMachineID()
 		return nil
-	case scan.FieldDescription:
-		m.ClearDescription()
+	case tenantsecret.FieldExpiresAt:
+		m.ClearExpiresAt()
 		return nil
-	case scan.FieldCompletedAt:
-		m.ClearCompletedAt()
+	case tenantsecret.FieldRevokedAt:
+		m.ClearRevokedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Scan nullable field %s", name)
+	return fmt.Errorf("unknown TenantSecret nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ScanMutation) ResetField(name string) error {
+func (m *TenantSecretMutation) ResetField(name string) error {
 	switch name {
-	case scan.FieldCreateTime:
+	case tenantsecret.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case scan.FieldUpdateTime:
+	case tenantsecret.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case scan.FieldTenantID:
+	case tenantsecret.FieldTenantID:
 		m.ResetTenantID()
 		return nil
-	case scan.FieldInitiatedMachineID:
-		m.ResetInitiatedMachineID()
+	case tenantsecret.FieldMachineID:
+		m.ResetMachineID()
 		return nil
-	case scan.FieldName:
-		m.ResetName()
+	case tenantsecret.FieldKeyID:
+		m.ResetKeyID()
 		return nil
-	case scan.FieldDescription:
-		m.ResetDescription()
+	case tenantsecret.FieldSecret:
+		m.ResetSecret()
 		return nil
-	case scan.FieldStartedAt:
-		m.ResetStartedAt()
+	case tenantsecret.FieldNotBefore:
+		m.ResetNotBefore()
 		return nil
-	case scan.FieldCompletedAt:
-		m.ResetCompletedAt()
+	case tenantsecret.FieldExpiresAt:
+		m.ResetExpiresAt()
 		return nil
-	case scan.FieldDuplicateGroupCount:
-		m.ResetDuplicateGroupCount()
+	case tenantsecret.FieldRevokedAt:
+		m.ResetRevokedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Scan field %s", name)
+	return fmt.Errorf("unknown TenantSecret field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ScanMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
+func (m *TenantSecretMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
 	if m.tenant != nil {
-		edges = append(edges, scan.EdgeTenant)
+		edges = append(edges, tenantsecret.EdgeTenant)
 	}
-	if m.initiated_machine != nil {
-		edges = append(edges, scan.EdgeInitiatedMachine)
-	}
-	if m.duplicate_groups != nil {
-		edges = append(edges, scan.EdgeDuplicateGroups)
+	if m.machine != nil {
+		edges = append(edges, tenantsecret.EdgeMachine)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ScanMutation) AddedIDs(name string) []ent.Value {
+func (m *TenantSecretMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case scan.EdgeTenant:
+	case tenantsecret.EdgeTenant:
 		if id := m.tenant; id != nil {
 			return []ent.Value{*id}
 		}
-	case scan.EdgeInitiatedMachine:
-		if id := m.initiated_machine; id != nil {
+	case tenantsecret.EdgeMachine:
+		if id := m.machine; id != nil {
 			return []ent.Value{*id}
 		}
-	case scan.EdgeDuplicateGroups:
-		ids := make([]ent.Value, 0, len(m.duplicate_groups))
-		for id := range m.duplicate_groups {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ScanMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedduplicate_groups != nil {
-		edges = append(edges, scan.EdgeDuplicateGroups)
-	}
+func (m *TenantSecretMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ScanMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case scan.EdgeDuplicateGroups:
-		ids := make([]ent.Value, 0, len(m.removedduplicate_groups))
-		for id := range m.removedduplicate_groups {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *TenantSecretMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ScanMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
+func (m *TenantSecretMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
 	if m.clearedtenant {
-		edges = append(edges, scan.EdgeTenant)
+		edges = append(edges, tenantsecret.EdgeTenant)
 	}
-	if m.clearedinitiated_machine {
-		edges = append(edges, scan.EdgeInitiatedMachine)
-	}
-	if m.clearedduplicate_groups {
-		edges = append(edges, scan.EdgeDuplicateGroups)
+	if m.clearedmachine {
+		edges = append(edges, tenantsecret.EdgeMachine)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ScanMutation) EdgeCleared(name string) bool {
+func (m *TenantSecretMutation) EdgeCleared(name string) bool {
 	switch name {
-	case scan.EdgeTenant:
+	case tenantsecret.EdgeTenant:
 		return m.clearedtenant
-	case scan.EdgeInitiatedMachine:
-		return m.clearedinitiated_machine
-	case scan.EdgeDuplicateGroups:
-		return m.clearedduplicate_groups
+	case tenantsecret.EdgeMachine:
+		return m.clearedmachine
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ScanMutation) ClearEdge(name string) error {
+func (m *TenantSecretMutation) ClearEdge(name string) error {
 	switch name {
-	case scan.EdgeTenant:
+	case tenantsecret.EdgeTenant:
 		m.ClearTenant()
 		return nil
-	case scan.EdgeInitiatedMachine:
-		m.ClearInitiatedMachine()
+	case tenantsecret.EdgeMachine:
+		m.ClearMachine()
 		return nil
 	}
-	return fmt.Errorf("unknown Scan unique edge %s", name)
+	return fmt.Errorf("unknown TenantSecret unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ScanMutation) ResetEdge(name string) error {
+func (m *TenantSecretMutation) ResetEdge(name string) error {
 	switch name {
-	case scan.EdgeTenant:
+	case tenantsecret.EdgeTenant:
 		m.ResetTenant()
 		return nil
-	case scan.EdgeInitiatedMachine:
-		m.ResetInitiatedMachine()
-		return nil
-	case scan.EdgeDuplicateGroups:
-		m.ResetDuplicateGroups()
+	case tenantsecret.EdgeMachine:
+		m.ResetMachine()
 		return nil
 	}
-	return fmt.Errorf("unknown Scan edge %s", name)
+	return fmt.Errorf("unknown TenantSecret edge %s", name)
 }
 
-// TenantMutation represents an operation that mutates the Tenant nodes in the graph.
-type TenantMutation struct {
+// TenantTombstoneMutation represents an operation that mutates the TenantTombstone nodes in the graph.
+type TenantTombstoneMutation struct {
 	config
-	op                      Op
-	typ                     string
-	id                      *uuid.UUID
-	create_time             *time.Time
-	update_time             *time.Time
-	slug                    *string
-	name                    *string
-	description             *string
-	primary_contact         *string
-	clearedFields           map[string]struct{}
-	machines                map[uuid.UUID]struct{}
-	removedmachines         map[uuid.UUID]struct{}
-	clearedmachines         bool
-	scans                   map[uuid.UUID]struct{}
-	removedscans            map[uuid.UUID]struct{}
-	clearedscans            bool
-	duplicate_groups        map[uuid.UUID]struct{}
-	removedduplicate_groups map[uuid.UUID]struct{}
-	clearedduplicate_groups bool
-	action_audits           map[uuid.UUID]struct{}
-	removedaction_audits    map[uuid.UUID]struct{}
-	clearedaction_audits    bool
-	done                    bool
-	oldValue                func(context.Context) (*Tenant, error)
-	predicates              []predicate.Tenant
-}
-
-var _ ent.Mutation = (*TenantMutation)(nil)
-
-// tenantOption allows management of the mutation configuration using functional options.
-type tenantOption func(*TenantMutation)
-
-// newTenantMutation creates new mutation for the Tenant entity.
-func newTenantMutation(c config, op Op, opts ...tenantOption) *TenantMutation {
-	m := &TenantMutation{
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	create_time   *time.Time
+	update_time   *time.Time
+	tenant_id     *uuid.UUID
+	tenant_slug   *string
+	tenant_name   *string
+	actor         *string
+	purged_at     *time.Time
+	deleted_rows  *map[string]int
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*TenantTombstone, error)
+	predicates    []predicate.TenantTombstone
+}
+
+var _ ent.Mutation = (*TenantTombstoneMutation)(nil)
+
+// tenanttombstoneOption allows management of the mutation configuration using functional options.
+type tenanttombstoneOption func(*TenantTombstoneMutation)
+
+// newTenantTombstoneMutation creates new mutation for the TenantTombstone entity.
+func newTenantTombstoneMutation(c config, op Op, opts ...tenanttombstoneOption) *TenantTombstoneMutation {
+	m := &TenantTombstoneMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTenant,
+		typ:           TypeTenantTombstone,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5162,20 +16895,20 @@ func newTenantMutation(c config, op Op, opts ...tenantOption) *TenantMutation {
 	return m
 }
 
-// withTenantID sets the ID field of the mutation.
-func withTenantID(id uuid.UUID) tenantOption {
-	return func(m *TenantMutation) {
+// withTenantTombstoneID sets the ID field of the mutation.
+func withTenantTombstoneID(id uuid.UUID) tenanttombstoneOption {
+	return func(m *TenantTombstoneMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Tenant
+			value *TenantTombstone
 		)
-		m.oldValue = func(ctx context.Context) (*Tenant, error) {
+		m.oldValue = func(ctx context.Context) (*TenantTombstone, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Tenant.Get(ctx, id)
+					value, err = m.Client().TenantTombstone.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5184,10 +16917,10 @@ func withTenantID(id uuid.UUID) tenantOption {
 	}
 }
 
-// withTenant sets the old Tenant of the mutation.
-func withTenant(node *Tenant) tenantOption {
-	return func(m *TenantMutation) {
-		m.oldValue = func(context.Context) (*Tenant, error) {
+// withTenantTombstone sets the old TenantTombstone of the mutation.
+func withTenantTombstone(node *TenantTombstone) tenanttombstoneOption {
+	return func(m *TenantTombstoneMutation) {
+		m.oldValue = func(context.Context) (*TenantTombstone, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5196,7 +16929,7 @@ func withTenant(node *Tenant) tenantOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TenantMutation) Client() *Client {
+func (m TenantTombstoneMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -5204,7 +16937,7 @@ func (m TenantMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TenantMutation) Tx() (*Tx, error) {
+func (m TenantTombstoneMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -5214,14 +16947,14 @@ func (m TenantMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Tenant entities.
-func (m *TenantMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of TenantTombstone entities.
+func (m *TenantTombstoneMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TenantMutation) ID() (id uuid.UUID, exists bool) {
+func (m *TenantTombstoneMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -5232,7 +16965,7 @@ func (m *TenantMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TenantMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *TenantTombstoneMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -5241,19 +16974,19 @@ func (m *TenantMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Tenant.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().TenantTombstone.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *TenantMutation) SetCreateTime(t time.Time) {
+func (m *TenantTombstoneMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *TenantMutation) CreateTime() (r time.Time, exists bool) {
+func (m *TenantTombstoneMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -5261,10 +16994,10 @@ func (m *TenantMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the Tenant entity.
-// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the TenantTombstone entity.
+// If the TenantTombstone object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TenantMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *TenantTombstoneMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -5279,17 +17012,17 @@ func (m *TenantMutation) OldCreateTime(ctx context.Context) (v time.Time, err er
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *TenantMutation) ResetCreateTime() {
+func (m *TenantTombstoneMutation) ResetCreateTime() {
 	m.create_time = nil
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *TenantMutation) SetUpdateTime(t time.Time) {
+func (m *TenantTombstoneMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *TenantMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *TenantTombstoneMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -5297,10 +17030,10 @@ func (m *TenantMutation) UpdateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the Tenant entity.
-// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the TenantTombstone entity.
+// If the TenantTombstone object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TenantMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *TenantTombstoneMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -5315,405 +17048,248 @@ func (m *TenantMutation) OldUpdateTime(ctx context.Context) (v time.Time, err er
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *TenantMutation) ResetUpdateTime() {
-	m.update_time = nil
-}
-
-// SetSlug sets the "slug" field.
-func (m *TenantMutation) SetSlug(s string) {
-	m.slug = &s
-}
-
-// Slug returns the value of the "slug" field in the mutation.
-func (m *TenantMutation) Slug() (r string, exists bool) {
-	v := m.slug
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldSlug returns the old "slug" field's value of the Tenant entity.
-// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TenantMutation) OldSlug(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSlug requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
-	}
-	return oldValue.Slug, nil
-}
-
-// ResetSlug resets all changes to the "slug" field.
-func (m *TenantMutation) ResetSlug() {
-	m.slug = nil
-}
-
-// SetName sets the "name" field.
-func (m *TenantMutation) SetName(s string) {
-	m.name = &s
-}
-
-// Name returns the value of the "name" field in the mutation.
-func (m *TenantMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldName returns the old "name" field's value of the Tenant entity.
-// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TenantMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
-	}
-	return oldValue.Name, nil
-}
-
-// ResetName resets all changes to the "name" field.
-func (m *TenantMutation) ResetName() {
-	m.name = nil
+func (m *TenantTombstoneMutation) ResetUpdateTime() {
+	m.update_time = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *TenantMutation) SetDescription(s string) {
-	m.description = &s
+// SetTenantID sets the "tenant_id" field.
+func (m *TenantTombstoneMutation) SetTenantID(u uuid.UUID) {
+	m.tenant_id = &u
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *TenantMutation) Description() (r string, exists bool) {
-	v := m.description
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *TenantTombstoneMutation) TenantID() (r uuid.UUID, exists bool) {
+	v := m.tenant_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Tenant entity.
-// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// OldTenantID returns the old "tenant_id" field's value of the TenantTombstone entity.
+// If the TenantTombstone object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TenantMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *TenantTombstoneMutation) OldTenantID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
 	}
-	return oldValue.Description, nil
-}
-
-// ClearDescription clears the value of the "description" field.
-func (m *TenantMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[tenant.FieldDescription] = struct{}{}
-}
-
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *TenantMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[tenant.FieldDescription]
-	return ok
+	return oldValue.TenantID, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *TenantMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, tenant.FieldDescription)
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *TenantTombstoneMutation) ResetTenantID() {
+	m.tenant_id = nil
 }
 
-// SetPrimaryContact sets the "primary_contact" field.
-func (m *TenantMutation) SetPrimaryContact(s string) {
-	m.primary_contact = &s
+// SetTenantSlug sets the "tenant_slug" field.
+func (m *TenantTombstoneMutation) SetTenantSlug(s string) {
+	m.tenant_slug = &s
 }
 
-// PrimaryContact returns the value of the "primary_contact" field in the mutation.
-func (m *TenantMutation) PrimaryContact() (r string, exists bool) {
-	v := m.primary_contact
+// TenantSlug returns the value of the "tenant_slug" field in the mutation.
+func (m *TenantTombstoneMutation) TenantSlug() (r string, exists bool) {
+	v := m.tenant_slug
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPrimaryContact returns the old "primary_contact" field's value of the Tenant entity.
-// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// OldTenantSlug returns the old "tenant_slug" field's value of the TenantTombstone entity.
+// If the TenantTombstone object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TenantMutation) OldPrimaryContact(ctx context.Context) (v string, err error) {
+func (m *TenantTombstoneMutation) OldTenantSlug(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPrimaryContact is only allowed on UpdateOne operations")
+		return v, errors.New("OldTenantSlug is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPrimaryContact requires an ID field in the mutation")
+		return v, errors.New("OldTenantSlug requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPrimaryContact: %w", err)
+		return v, fmt.Errorf("querying old value for OldTenantSlug: %w", err)
 	}
-	return oldValue.PrimaryContact, nil
-}
-
-// ClearPrimaryContact clears the value of the "primary_contact" field.
-func (m *TenantMutation) ClearPrimaryContact() {
-	m.primary_contact = nil
-	m.clearedFields[tenant.FieldPrimaryContact] = struct{}{}
+	return oldValue.TenantSlug, nil
 }
 
-// PrimaryContactCleared returns if the "primary_contact" field was cleared in this mutation.
-func (m *TenantMutation) PrimaryContactCleared() bool {
-	_, ok := m.clearedFields[tenant.FieldPrimaryContact]
-	return ok
+// ResetTenantSlug resets all changes to the "tenant_slug" field.
+func (m *TenantTombstoneMutation) ResetTenantSlug() {
+	m.tenant_slug = nil
 }
 
-// ResetPrimaryContact resets all changes to the "primary_contact" field.
-func (m *TenantMutation) ResetPrimaryContact() {
-	m.primary_contact = nil
-	delete(m.clearedFields, tenant.FieldPrimaryContact)
+// SetTenantName sets the "tenant_name" field.
+func (m *TenantTombstoneMutation) SetTenantName(s string) {
+	m.tenant_name = &s
 }
 
-// AddMachineIDs adds the "machines" edge to the Machine entity by ids.
-func (m *TenantMutation) AddMachineIDs(ids ...uuid.UUID) {
-	if m.machines == nil {
-		m.machines = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.machines[ids[i]] = struct{}{}
+// TenantName returns the value of the "tenant_name" field in the mutation.
+func (m *TenantTombstoneMutation) TenantName() (r string, exists bool) {
+	v := m.tenant_name
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearMachines clears the "machines" edge to the Machine entity.
-func (m *TenantMutation) ClearMachines() {
-	m.clearedmachines = true
-}
-
-// MachinesCleared reports if the "machines" edge to the Machine entity was cleared.
-func (m *TenantMutation) MachinesCleared() bool {
-	return m.clearedmachines
-}
-
-// RemoveMachineIDs removes the "machines" edge to the Machine entity by IDs.
-func (m *TenantMutation) RemoveMachineIDs(ids ...uuid.UUID) {
-	if m.removedmachines == nil {
-		m.removedmachines = make(map[uuid.UUID]struct{})
+// OldTenantName returns the old "tenant_name" field's value of the TenantTombstone entity.
+// If the TenantTombstone object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantTombstoneMutation) OldTenantName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantName is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.machines, ids[i])
-		m.removedmachines[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantName requires an ID field in the mutation")
 	}
-}
-
-// RemovedMachines returns the removed IDs of the "machines" edge to the Machine entity.
-func (m *TenantMutation) RemovedMachinesIDs() (ids []uuid.UUID) {
-	for id := range m.removedmachines {
-		ids = append(ids, id)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantName: %w", err)
 	}
-	return
+	return oldValue.TenantName, nil
 }
 
-// MachinesIDs returns the "machines" edge IDs in the mutation.
-func (m *TenantMutation) MachinesIDs() (ids []uuid.UUID) {
-	for id := range m.machines {
-		ids = append(ids, id)
-	}
-	return
+// ResetTenantName resets all changes to the "tenant_name" field.
+func (m *TenantTombstoneMutation) ResetTenantName() {
+	m.tenant_name = nil
 }
 
-// ResetMachines resets all changes to the "machines" edge.
-func (m *TenantMutation) ResetMachines() {
-	m.machines = nil
-	m.clearedmachines = false
-	m.removedmachines = nil
+// SetActor sets the "actor" field.
+func (m *TenantTombstoneMutation) SetActor(s string) {
+	m.actor = &s
 }
 
-// AddScanIDs adds the "scans" edge to the Scan entity by ids.
-func (m *TenantMutation) AddScanIDs(ids ...uuid.UUID) {
-	if m.scans == nil {
-		m.scans = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.scans[ids[i]] = struct{}{}
+// Actor returns the value of the "actor" field in the mutation.
+func (m *TenantTombstoneMutation) Actor() (r string, exists bool) {
+	v := m.actor
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearScans clears the "scans" edge to the Scan entity.
-func (m *TenantMutation) ClearScans() {
-	m.clearedscans = true
-}
-
-// ScansCleared reports if the "scans" edge to the Scan entity was cleared.
-func (m *TenantMutation) ScansCleared() bool {
-	return m.clearedscans
-}
-
-// RemoveScanIDs removes the "scans" edge to the Scan entity by IDs.
-func (m *TenantMutation) RemoveScanIDs(ids ...uuid.UUID) {
-	if m.removedscans == nil {
-		m.removedscans = make(map[uuid.UUID]struct{})
+// OldActor returns the old "actor" field's value of the TenantTombstone entity.
+// If the TenantTombstone object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantTombstoneMutation) OldActor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActor is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.scans, ids[i])
-		m.removedscans[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActor requires an ID field in the mutation")
 	}
-}
-
-// RemovedScans returns the removed IDs of the "scans" edge to the Scan entity.
-func (m *TenantMutation) RemovedScansIDs() (ids []uuid.UUID) {
-	for id := range m.removedscans {
-		ids = append(ids, id)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActor: %w", err)
 	}
-	return
+	return oldValue.Actor, nil
 }
 
-// ScansIDs returns the "scans" edge IDs in the mutation.
-func (m *TenantMutation) ScansIDs() (ids []uuid.UUID) {
-	for id := range m.scans {
-		ids = append(ids, id)
-	}
-	return
+// ResetActor resets all changes to the "actor" field.
+func (m *TenantTombstoneMutation) ResetActor() {
+	m.actor = nil
 }
 
-// ResetScans resets all changes to the "scans" edge.
-func (m *TenantMutation) ResetScans() {
-	m.scans = nil
-	m.clearedscans = false
-	m.removedscans = nil
+// SetPurgedAt sets the "purged_at" field.
+func (m *TenantTombstoneMutation) SetPurgedAt(t time.Time) {
+	m.purged_at = &t
 }
 
-// AddDuplicateGroupIDs adds the "duplicate_groups" edge to the DuplicateGroup entity by ids.
-func (m *TenantMutation) AddDuplicateGroupIDs(ids ...uuid.UUID) {
-	if m.duplicate_groups == nil {
-		m.duplicate_groups = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.duplicate_groups[ids[i]] = struct{}{}
+// PurgedAt returns the value of the "purged_at" field in the mutation.
+func (m *TenantTombstoneMutation) PurgedAt() (r time.Time, exists bool) {
+	v := m.purged_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearDuplicateGroups clears the "duplicate_groups" edge to the DuplicateGroup entity.
-func (m *TenantMutation) ClearDuplicateGroups() {
-	m.clearedduplicate_groups = true
-}
-
-// DuplicateGroupsCleared reports if the "duplicate_groups" edge to the DuplicateGroup entity was cleared.
-func (m *TenantMutation) DuplicateGroupsCleared() bool {
-	return m.clearedduplicate_groups
-}
-
-// RemoveDuplicateGroupIDs removes the "duplicate_groups" edge to the DuplicateGroup entity by IDs.
-func (m *TenantMutation) RemoveDuplicateGroupIDs(ids ...uuid.UUID) {
-	if m.removedduplicate_groups == nil {
-		m.removedduplicate_groups = make(map[uuid.UUID]struct{})
+// OldPurgedAt returns the old "purged_at" field's value of the TenantTombstone entity.
+// If the TenantTombstone object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantTombstoneMutation) OldPurgedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPurgedAt is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.duplicate_groups, ids[i])
-		m.removedduplicate_groups[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPurgedAt requires an ID field in the mutation")
 	}
-}
-
-// RemovedDuplicateGroups returns the removed IDs of the "duplicate_groups" edge to the DuplicateGroup entity.
-func (m *TenantMutation) RemovedDuplicateGroupsIDs() (ids []uuid.UUID) {
-	for id := range m.removedduplicate_groups {
-		ids = append(ids, id)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPurgedAt: %w", err)
 	}
-	return
+	return oldValue.PurgedAt, nil
 }
 
-// DuplicateGroupsIDs returns the "duplicate_groups" edge IDs in the mutation.
-func (m *TenantMutation) DuplicateGroupsIDs() (ids []uuid.UUID) {
-	for id := range m.duplicate_groups {
-		ids = append(ids, id)
-	}
-	return
+// ResetPurgedAt resets all changes to the "purged_at" field.
+func (m *TenantTombstoneMutation) ResetPurgedAt() {
+	m.purged_at = nil
 }
 
-// ResetDuplicateGroups resets all changes to the "duplicate_groups" edge.
-func (m *TenantMutation) ResetDuplicateGroups() {
-	m.duplicate_groups = nil
-	m.clearedduplicate_groups = false
-	m.removedduplicate_groups = nil
+// SetDeletedRows sets the "deleted_rows" field.
+func (m *TenantTombstoneMutation) SetDeletedRows(value map[string]int) {
+	m.deleted_rows = &value
 }
 
-// AddActionAuditIDs adds the "action_audits" edge to the ActionAudit entity by ids.
-func (m *TenantMutation) AddActionAuditIDs(ids ...uuid.UUID) {
-	if m.action_audits == nil {
-		m.action_audits = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.action_audits[ids[i]] = struct{}{}
+// DeletedRows returns the value of the "deleted_rows" field in the mutation.
+func (m *TenantTombstoneMutation) DeletedRows() (r map[string]int, exists bool) {
+	v := m.deleted_rows
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearActionAudits clears the "action_audits" edge to the ActionAudit entity.
-func (m *TenantMutation) ClearActionAudits() {
-	m.clearedaction_audits = true
-}
-
-// ActionAuditsCleared reports if the "action_audits" edge to the ActionAudit entity was cleared.
-func (m *TenantMutation) ActionAuditsCleared() bool {
-	return m.clearedaction_audits
-}
-
-// RemoveActionAuditIDs removes the "action_audits" edge to the ActionAudit entity by IDs.
-func (m *TenantMutation) RemoveActionAuditIDs(ids ...uuid.UUID) {
-	if m.removedaction_audits == nil {
-		m.removedaction_audits = make(map[uuid.UUID]struct{})
+// OldDeletedRows returns the old "deleted_rows" field's value of the TenantTombstone entity.
+// If the TenantTombstone object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantTombstoneMutation) OldDeletedRows(ctx context.Context) (v map[string]int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedRows is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.action_audits, ids[i])
-		m.removedaction_audits[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedRows requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedRows: %w", err)
 	}
+	return oldValue.DeletedRows, nil
 }
 
-// RemovedActionAudits returns the removed IDs of the "action_audits" edge to the ActionAudit entity.
-func (m *TenantMutation) RemovedActionAuditsIDs() (ids []uuid.UUID) {
-	for id := range m.removedaction_audits {
-		ids = append(ids, id)
-	}
-	return
+// ClearDeletedRows clears the value of the "deleted_rows" field.
+func (m *TenantTombstoneMutation) ClearDeletedRows() {
+	m.deleted_rows = nil
+	m.clearedFields[tenanttombstone.FieldDeletedRows] = struct{}{}
 }
 
-// ActionAuditsIDs returns the "action_audits" edge IDs in the mutation.
-func (m *TenantMutation) ActionAuditsIDs() (ids []uuid.UUID) {
-	for id := range m.action_audits {
-		ids = append(ids, id)
-	}
-	return
+// DeletedRowsCleared returns if the "deleted_rows" field was cleared in this mutation.
+func (m *TenantTombstoneMutation) DeletedRowsCleared() bool {
+	_, ok := m.clearedFields[tenanttombstone.FieldDeletedRows]
+	return ok
 }
 
-// ResetActionAudits resets all changes to the "action_audits" edge.
-func (m *TenantMutation) ResetActionAudits() {
-	m.action_audits = nil
-	m.clearedaction_audits = false
-	m.removedaction_audits = nil
+// ResetDeletedRows resets all changes to the "deleted_rows" field.
+func (m *TenantTombstoneMutation) ResetDeletedRows() {
+	m.deleted_rows = nil
+	delete(m.clearedFields, tenanttombstone.FieldDeletedRows)
 }
 
-// Where appends a list predicates to the TenantMutation builder.
-func (m *TenantMutation) Where(ps ...predicate.Tenant) {
+// Where appends a list predicates to the TenantTombstoneMutation builder.
+func (m *TenantTombstoneMutation) Where(ps ...predicate.TenantTombstone) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TenantMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TenantTombstoneMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TenantMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Tenant, len(ps))
+func (m *TenantTombstoneMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TenantTombstone, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5721,42 +17297,48 @@ func (m *TenantMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TenantMutation) Op() Op {
+func (m *TenantTombstoneMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TenantMutation) SetOp(op Op) {
+func (m *TenantTombstoneMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Tenant).
-func (m *TenantMutation) Type() string {
+// Type returns the node type of this mutation (TenantTombstone).
+func (m *TenantTombstoneMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TenantMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+func (m *TenantTombstoneMutation) Fields() []string {
+	fields := make([]string, 0, 8)
 	if m.create_time != nil {
-		fields = append(fields, tenant.FieldCreateTime)
+		fields = append(fields, tenanttombstone.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, tenant.FieldUpdateTime)
+		fields = append(fields, tenanttombstone.FieldUpdateTime)
 	}
-	if m.slug != nil {
-		fields = append(fields, tenant.FieldSlug)
+	if m.tenant_id != nil {
+		fields = append(fields, tenanttombstone.FieldTenantID)
 	}
-	if m.name != nil {
-		fields = append(fields, tenant.FieldName)
+	if m.tenant_slug != nil {
+		fields = append(fields, tenanttombstone.FieldTenantSlug)
 	}
-	if m.description != nil {
-		fields = append(fields, tenant.FieldDescription)
+	if m.tenant_name != nil {
+		fields = append(fields, tenanttombstone.FieldTenantName)
 	}
-	if m.primary_contact != nil {
-		fields = append(fields, tenant.FieldPrimaryContact)
+	if m.actor != nil {
+		fields = append(fields, tenanttombstone.FieldActor)
+	}
+	if m.purged_at != nil {
+		fields = append(fields, tenanttombstone.FieldPurgedAt)
+	}
+	if m.deleted_rows != nil {
+		fields = append(fields, tenanttombstone.FieldDeletedRows)
 	}
 	return fields
 }
@@ -5764,20 +17346,24 @@ func (m *TenantMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TenantMutation) Field(name string) (ent.Value, bool) {
+func (m *TenantTombstoneMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case tenant.FieldCreateTime:
+	case tenanttombstone.FieldCreateTime:
 		return m.CreateTime()
-	case tenant.FieldUpdateTime:
+	case tenanttombstone.FieldUpdateTime:
 		return m.UpdateTime()
-	case tenant.FieldSlug:
-		return m.Slug()
-	case tenant.FieldName:
-		return m.Name()
-	case tenant.FieldDescription:
-		return m.Description()
-	case tenant.FieldPrimaryContact:
-		return m.PrimaryContact()
+	case tenanttombstone.FieldTenantID:
+		return m.TenantID()
+	case tenanttombstone.FieldTenantSlug:
+		return m.TenantSlug()
+	case tenanttombstone.FieldTenantName:
+		return m.TenantName()
+	case tenanttombstone.FieldActor:
+		return m.Actor()
+	case tenanttombstone.FieldPurgedAt:
+		return m.PurgedAt()
+	case tenanttombstone.FieldDeletedRows:
+		return m.DeletedRows()
 	}
 	return nil, false
 }
@@ -5785,315 +17371,219 @@ func (m *TenantMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TenantMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TenantTombstoneMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case tenant.FieldCreateTime:
+	case tenanttombstone.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case tenant.FieldUpdateTime:
+	case tenanttombstone.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case tenant.FieldSlug:
-		return m.OldSlug(ctx)
-	case tenant.FieldName:
-		return m.OldName(ctx)
-	case tenant.FieldDescription:
-		return m.OldDescription(ctx)
-	case tenant.FieldPrimaryContact:
-		return m.OldPrimaryContact(ctx)
+	case tenanttombstone.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case tenanttombstone.FieldTenantSlug:
+		return m.OldTenantSlug(ctx)
+	case tenanttombstone.FieldTenantName:
+		return m.OldTenantName(ctx)
+	case tenanttombstone.FieldActor:
+		return m.OldActor(ctx)
+	case tenanttombstone.FieldPurgedAt:
+		return m.OldPurgedAt(ctx)
+	case tenanttombstone.FieldDeletedRows:
+		return m.OldDeletedRows(ctx)
 	}
-	return nil, fmt.Errorf("unknown Tenant field %s", name)
+	return nil, fmt.Errorf("unknown TenantTombstone field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TenantMutation) SetField(name string, value ent.Value) error {
+func (m *TenantTombstoneMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case tenant.FieldCreateTime:
+	case tenanttombstone.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case tenant.FieldUpdateTime:
+	case tenanttombstone.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case tenant.FieldSlug:
-		v, ok := value.(string)
+	case tenanttombstone.FieldTenantID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSlug(v)
+		m.SetTenantID(v)
 		return nil
-	case tenant.FieldName:
+	case tenanttombstone.FieldTenantSlug:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetTenantSlug(v)
 		return nil
-	case tenant.FieldDescription:
+	case tenanttombstone.FieldTenantName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetTenantName(v)
 		return nil
-	case tenant.FieldPrimaryContact:
+	case tenanttombstone.FieldActor:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPrimaryContact(v)
+		m.SetActor(v)
+		return nil
+	case tenanttombstone.FieldPurgedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPurgedAt(v)
+		return nil
+	case tenanttombstone.FieldDeletedRows:
+		v, ok := value.(map[string]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedRows(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Tenant field %s", name)
+	return fmt.Errorf("unknown TenantTombstone field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TenantMutation) AddedFields() []string {
+func (m *TenantTombstoneMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TenantMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TenantTombstoneMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TenantMutation) AddField(name string, value ent.Value) error {
+func (m *TenantTombstoneMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Tenant numeric field %s", name)
+	return fmt.Errorf("unknown TenantTombstone numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TenantMutation) ClearedFields() []string {
+func (m *TenantTombstoneMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(tenant.FieldDescription) {
-		fields = append(fields, tenant.FieldDescription)
-	}
-	if m.FieldCleared(tenant.FieldPrimaryContact) {
-		fields = append(fields, tenant.FieldPrimaryContact)
+	if m.FieldCleared(tenanttombstone.FieldDeletedRows) {
+		fields = append(fields, tenanttombstone.FieldDeletedRows)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TenantMutation) FieldCleared(name string) bool {
+func (m *TenantTombstoneMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TenantMutation) ClearField(name string) error {
+func (m *TenantTombstoneMutation) ClearField(name string) error {
 	switch name {
-	case tenant.FieldDescription:
-		m.ClearDescription()
-		return nil
-	case tenant.FieldPrimaryContact:
-		m.ClearPrimaryContact()
+	case tenanttombstone.FieldDeletedRows:
+		m.ClearDeletedRows()
 		return nil
 	}
-	return fmt.Errorf("unknown Tenant nullable field %s", name)
+	return fmt.Errorf("unknown TenantTombstone nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TenantMutation) ResetField(name string) error {
+func (m *TenantTombstoneMutation) ResetField(name string) error {
 	switch name {
-	case tenant.FieldCreateTime:
+	case tenanttombstone.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case tenant.FieldUpdateTime:
+	case tenanttombstone.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case tenant.FieldSlug:
-		m.ResetSlug()
+	case tenanttombstone.FieldTenantID:
+		m.ResetTenantID()
 		return nil
-	case tenant.FieldName:
-		m.ResetName()
+	case tenanttombstone.FieldTenantSlug:
+		m.ResetTenantSlug()
 		return nil
-	case tenant.FieldDescription:
-		m.ResetDescription()
+	case tenanttombstone.FieldTenantName:
+		m.ResetTenantName()
 		return nil
-	case tenant.FieldPrimaryContact:
-		m.ResetPrimaryContact()
+	case tenanttombstone.FieldActor:
+		m.ResetActor()
+		return nil
+	case tenanttombstone.FieldPurgedAt:
+		m.ResetPurgedAt()
+		return nil
+	case tenanttombstone.FieldDeletedRows:
+		m.ResetDeletedRows()
 		return nil
 	}
-	return fmt.Errorf("unknown Tenant field %s", name)
+	return fmt.Errorf("unknown TenantTombstone field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TenantMutation) AddedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.machines != nil {
-		edges = append(edges, tenant.EdgeMachines)
-	}
-	if m.scans != nil {
-		edges = append(edges, tenant.EdgeScans)
-	}
-	if m.duplicate_groups != nil {
-		edges = append(edges, tenant.EdgeDuplicateGroups)
-	}
-	if m.action_audits != nil {
-		edges = append(edges, tenant.EdgeActionAudits)
-	}
+func (m *TenantTombstoneMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TenantMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case tenant.EdgeMachines:
-		ids := make([]ent.Value, 0, len(m.machines))
-		for id := range m.machines {
-			ids = append(ids, id)
-		}
-		return ids
-	case tenant.EdgeScans:
-		ids := make([]ent.Value, 0, len(m.scans))
-		for id := range m.scans {
-			ids = append(ids, id)
-		}
-		return ids
-	case tenant.EdgeDuplicateGroups:
-		ids := make([]ent.Value, 0, len(m.duplicate_groups))
-		for id := range m.duplicate_groups {
-			ids = append(ids, id)
-		}
-		return ids
-	case tenant.EdgeActionAudits:
-		ids := make([]ent.Value, 0, len(m.action_audits))
-		for id := range m.action_audits {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *TenantTombstoneMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TenantMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.removedmachines != nil {
-		edges = append(edges, tenant.EdgeMachines)
-	}
-	if m.removedscans != nil {
-		edges = append(edges, tenant.EdgeScans)
-	}
-	if m.removedduplicate_groups != nil {
-		edges = append(edges, tenant.EdgeDuplicateGroups)
-	}
-	if m.removedaction_audits != nil {
-		edges = append(edges, tenant.EdgeActionAudits)
-	}
+func (m *TenantTombstoneMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TenantMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case tenant.EdgeMachines:
-		ids := make([]ent.Value, 0, len(m.removedmachines))
-		for id := range m.removedmachines {
-			ids = append(ids, id)
-		}
-		return ids
-	case tenant.EdgeScans:
-		ids := make([]ent.Value, 0, len(m.removedscans))
-		for id := range m.removedscans {
-			ids = append(ids, id)
-		}
-		return ids
-	case tenant.EdgeDuplicateGroups:
-		ids := make([]ent.Value, 0, len(m.removedduplicate_groups))
-		for id := range m.removedduplicate_groups {
-			ids = append(ids, id)
-		}
-		return ids
-	case tenant.EdgeActionAudits:
-		ids := make([]ent.Value, 0, len(m.removedaction_audits))
-		for id := range m.removedaction_audits {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *TenantTombstoneMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TenantMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.clearedmachines {
-		edges = append(edges, tenant.EdgeMachines)
-	}
-	if m.clearedscans {
-		edges = append(edges, tenant.EdgeScans)
-	}
-	if m.clearedduplicate_groups {
-		edges = append(edges, tenant.EdgeDuplicateGroups)
-	}
-	if m.clearedaction_audits {
-		edges = append(edges, tenant.EdgeActionAudits)
-	}
+func (m *TenantTombstoneMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TenantMutation) EdgeCleared(name string) bool {
-	switch name {
-	case tenant.EdgeMachines:
-		return m.clearedmachines
-	case tenant.EdgeScans:
-		return m.clearedscans
-	case tenant.EdgeDuplicateGroups:
-		return m.clearedduplicate_groups
-	case tenant.EdgeActionAudits:
-		return m.clearedaction_audits
-	}
+func (m *TenantTombstoneMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TenantMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown Tenant unique edge %s", name)
+func (m *TenantTombstoneMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TenantTombstone unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TenantMutation) ResetEdge(name string) error {
-	switch name {
-	case tenant.EdgeMachines:
-		m.ResetMachines()
-		return nil
-	case tenant.EdgeScans:
-		m.ResetScans()
-		return nil
-	case tenant.EdgeDuplicateGroups:
-		m.ResetDuplicateGroups()
-		return nil
-	case tenant.EdgeActionAudits:
-		m.ResetActionAudits()
-		return nil
-	}
-	return fmt.Errorf("unknown Tenant edge %s", name)
+func (m *TenantTombstoneMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TenantTombstone edge %s", name)
 }
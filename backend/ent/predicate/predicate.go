@@ -9,6 +9,15 @@ import (
 // ActionAudit is the predicate function for actionaudit builders.
 type ActionAudit func(*sql.Selector)
 
+// ActionJob is the predicate function for actionjob builders.
+type ActionJob func(*sql.Selector)
+
+// ContentEvent is the predicate function for contentevent builders.
+type ContentEvent func(*sql.Selector)
+
+// ContentIdentity is the predicate function for contentidentity builders.
+type ContentIdentity func(*sql.Selector)
+
 // DuplicateGroup is the predicate function for duplicategroup builders.
 type DuplicateGroup func(*sql.Selector)
 
@@ -21,5 +30,20 @@ type Machine func(*sql.Selector)
 // Scan is the predicate function for scan builders.
 type Scan func(*sql.Selector)
 
+// ScanSchedule is the predicate function for scanschedule builders.
+type ScanSchedule func(*sql.Selector)
+
+// ScanScheduleRun is the predicate function for scanschedulerun builders.
+type ScanScheduleRun func(*sql.Selector)
+
+// ScanTarget is the predicate function for scantarget builders.
+type ScanTarget func(*sql.Selector)
+
 // Tenant is the predicate function for tenant builders.
 type Tenant func(*sql.Selector)
+
+// TenantSecret is the predicate function for tenantsecret builders.
+type TenantSecret func(*sql.Selector)
+
+// TenantTombstone is the predicate function for tenanttombstone builders.
+type TenantTombstone func(*sql.Selector)
@@ -2,171 +2,4 @@
 
 package ent
 
-import (
-	"time"
-
-	"github.com/google/uuid"
-	"github.com/mcmx/duplynx/ent/actionaudit"
-	"github.com/mcmx/duplynx/ent/duplicategroup"
-	"github.com/mcmx/duplynx/ent/fileinstance"
-	"github.com/mcmx/duplynx/ent/machine"
-	"github.com/mcmx/duplynx/ent/scan"
-	"github.com/mcmx/duplynx/ent/schema"
-	"github.com/mcmx/duplynx/ent/tenant"
-)
-
-// The init function reads all schema descriptors with runtime code
-// (default values, validators, hooks and policies) and stitches it
-// to their package variables.
-func init() {
-	actionauditMixin := schema.ActionAudit{}.Mixin()
-	actionauditMixinFields0 := actionauditMixin[0].Fields()
-	_ = actionauditMixinFields0
-	actionauditFields := schema.ActionAudit{}.Fields()
-	_ = actionauditFields
-	// actionauditDescCreateTime is the schema descriptor for create_time field.
-	actionauditDescCreateTime := actionauditMixinFields0[0].Descriptor()
-	// actionaudit.DefaultCreateTime holds the default value on creation for the create_time field.
-	actionaudit.DefaultCreateTime = actionauditDescCreateTime.Default.(func() time.Time)
-	// actionauditDescUpdateTime is the schema descriptor for update_time field.
-	actionauditDescUpdateTime := actionauditMixinFields0[1].Descriptor()
-	// actionaudit.DefaultUpdateTime holds the default value on creation for the update_time field.
-	actionaudit.DefaultUpdateTime = actionauditDescUpdateTime.Default.(func() time.Time)
-	// actionaudit.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
-	actionaudit.UpdateDefaultUpdateTime = actionauditDescUpdateTime.UpdateDefault.(func() time.Time)
-	// actionauditDescActor is the schema descriptor for actor field.
-	actionauditDescActor := actionauditFields[3].Descriptor()
-	// actionaudit.DefaultActor holds the default value on creation for the actor field.
-	actionaudit.DefaultActor = actionauditDescActor.Default.(string)
-	// actionauditDescPerformedAt is the schema descriptor for performed_at field.
-	actionauditDescPerformedAt := actionauditFields[6].Descriptor()
-	// actionaudit.DefaultPerformedAt holds the default value on creation for the performed_at field.
-	actionaudit.DefaultPerformedAt = actionauditDescPerformedAt.Default.(func() time.Time)
-	// actionauditDescStubbed is the schema descriptor for stubbed field.
-	actionauditDescStubbed := actionauditFields[7].Descriptor()
-	// actionaudit.DefaultStubbed holds the default value on creation for the stubbed field.
-	actionaudit.DefaultStubbed = actionauditDescStubbed.Default.(bool)
-	// actionauditDescID is the schema descriptor for id field.
-	actionauditDescID := actionauditFields[0].Descriptor()
-	// actionaudit.DefaultID holds the default value on creation for the id field.
-	actionaudit.DefaultID = actionauditDescID.Default.(func() uuid.UUID)
-	duplicategroupMixin := schema.DuplicateGroup{}.Mixin()
-	duplicategroupMixinFields0 := duplicategroupMixin[0].Fields()
-	_ = duplicategroupMixinFields0
-	duplicategroupFields := schema.DuplicateGroup{}.Fields()
-	_ = duplicategroupFields
-	// duplicategroupDescCreateTime is the schema descriptor for create_time field.
-	duplicategroupDescCreateTime := duplicategroupMixinFields0[0].Descriptor()
-	// duplicategroup.DefaultCreateTime holds the default value on creation for the create_time field.
-	duplicategroup.DefaultCreateTime = duplicategroupDescCreateTime.Default.(func() time.Time)
-	// duplicategroupDescUpdateTime is the schema descriptor for update_time field.
-	duplicategroupDescUpdateTime := duplicategroupMixinFields0[1].Descriptor()
-	// duplicategroup.DefaultUpdateTime holds the default value on creation for the update_time field.
-	duplicategroup.DefaultUpdateTime = duplicategroupDescUpdateTime.Default.(func() time.Time)
-	// duplicategroup.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
-	duplicategroup.UpdateDefaultUpdateTime = duplicategroupDescUpdateTime.UpdateDefault.(func() time.Time)
-	// duplicategroupDescFileCount is the schema descriptor for file_count field.
-	duplicategroupDescFileCount := duplicategroupFields[6].Descriptor()
-	// duplicategroup.FileCountValidator is a validator for the "file_count" field. It is called by the builders before save.
-	duplicategroup.FileCountValidator = duplicategroupDescFileCount.Validators[0].(func(int) error)
-	// duplicategroupDescTotalSizeBytes is the schema descriptor for total_size_bytes field.
-	duplicategroupDescTotalSizeBytes := duplicategroupFields[7].Descriptor()
-	// duplicategroup.TotalSizeBytesValidator is a validator for the "total_size_bytes" field. It is called by the builders before save.
-	duplicategroup.TotalSizeBytesValidator = duplicategroupDescTotalSizeBytes.Validators[0].(func(int64) error)
-	// duplicategroupDescID is the schema descriptor for id field.
-	duplicategroupDescID := duplicategroupFields[0].Descriptor()
-	// duplicategroup.DefaultID holds the default value on creation for the id field.
-	duplicategroup.DefaultID = duplicategroupDescID.Default.(func() uuid.UUID)
-	fileinstanceMixin := schema.FileInstance{}.Mixin()
-	fileinstanceMixinFields0 := fileinstanceMixin[0].Fields()
-	_ = fileinstanceMixinFields0
-	fileinstanceFields := schema.FileInstance{}.Fields()
-	_ = fileinstanceFields
-	// fileinstanceDescCreateTime is the schema descriptor for create_time field.
-	fileinstanceDescCreateTime := fileinstanceMixinFields0[0].Descriptor()
-	// fileinstance.DefaultCreateTime holds the default value on creation for the create_time field.
-	fileinstance.DefaultCreateTime = fileinstanceDescCreateTime.Default.(func() time.Time)
-	// fileinstanceDescUpdateTime is the schema descriptor for update_time field.
-	fileinstanceDescUpdateTime := fileinstanceMixinFields0[1].Descriptor()
-	// fileinstance.DefaultUpdateTime holds the default value on creation for the update_time field.
-	fileinstance.DefaultUpdateTime = fileinstanceDescUpdateTime.Default.(func() time.Time)
-	// fileinstance.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
-	fileinstance.UpdateDefaultUpdateTime = fileinstanceDescUpdateTime.UpdateDefault.(func() time.Time)
-	// fileinstanceDescSizeBytes is the schema descriptor for size_bytes field.
-	fileinstanceDescSizeBytes := fileinstanceFields[4].Descriptor()
-	// fileinstance.SizeBytesValidator is a validator for the "size_bytes" field. It is called by the builders before save.
-	fileinstance.SizeBytesValidator = fileinstanceDescSizeBytes.Validators[0].(func(int64) error)
-	// fileinstanceDescLastSeenAt is the schema descriptor for last_seen_at field.
-	fileinstanceDescLastSeenAt := fileinstanceFields[6].Descriptor()
-	// fileinstance.DefaultLastSeenAt holds the default value on creation for the last_seen_at field.
-	fileinstance.DefaultLastSeenAt = fileinstanceDescLastSeenAt.Default.(func() time.Time)
-	// fileinstanceDescQuarantined is the schema descriptor for quarantined field.
-	fileinstanceDescQuarantined := fileinstanceFields[7].Descriptor()
-	// fileinstance.DefaultQuarantined holds the default value on creation for the quarantined field.
-	fileinstance.DefaultQuarantined = fileinstanceDescQuarantined.Default.(bool)
-	// fileinstanceDescID is the schema descriptor for id field.
-	fileinstanceDescID := fileinstanceFields[0].Descriptor()
-	// fileinstance.DefaultID holds the default value on creation for the id field.
-	fileinstance.DefaultID = fileinstanceDescID.Default.(func() uuid.UUID)
-	machineMixin := schema.Machine{}.Mixin()
-	machineMixinFields0 := machineMixin[0].Fields()
-	_ = machineMixinFields0
-	machineFields := schema.Machine{}.Fields()
-	_ = machineFields
-	// machineDescCreateTime is the schema descriptor for create_time field.
-	machineDescCreateTime := machineMixinFields0[0].Descriptor()
-	// machine.DefaultCreateTime holds the default value on creation for the create_time field.
-	machine.DefaultCreateTime = machineDescCreateTime.Default.(func() time.Time)
-	// machineDescUpdateTime is the schema descriptor for update_time field.
-	machineDescUpdateTime := machineMixinFields0[1].Descriptor()
-	// machine.DefaultUpdateTime holds the default value on creation for the update_time field.
-	machine.DefaultUpdateTime = machineDescUpdateTime.Default.(func() time.Time)
-	// machine.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
-	machine.UpdateDefaultUpdateTime = machineDescUpdateTime.UpdateDefault.(func() time.Time)
-	// machineDescID is the schema descriptor for id field.
-	machineDescID := machineFields[0].Descriptor()
-	// machine.DefaultID holds the default value on creation for the id field.
-	machine.DefaultID = machineDescID.Default.(func() uuid.UUID)
-	scanMixin := schema.Scan{}.Mixin()
-	scanMixinFields0 := scanMixin[0].Fields()
-	_ = scanMixinFields0
-	scanFields := schema.Scan{}.Fields()
-	_ = scanFields
-	// scanDescCreateTime is the schema descriptor for create_time field.
-	scanDescCreateTime := scanMixinFields0[0].Descriptor()
-	// scan.DefaultCreateTime holds the default value on creation for the create_time field.
-	scan.DefaultCreateTime = scanDescCreateTime.Default.(func() time.Time)
-	// scanDescUpdateTime is the schema descriptor for update_time field.
-	scanDescUpdateTime := scanMixinFields0[1].Descriptor()
-	// scan.DefaultUpdateTime holds the default value on creation for the update_time field.
-	scan.DefaultUpdateTime = scanDescUpdateTime.Default.(func() time.Time)
-	// scan.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
-	scan.UpdateDefaultUpdateTime = scanDescUpdateTime.UpdateDefault.(func() time.Time)
-	// scanDescDuplicateGroupCount is the schema descriptor for duplicate_group_count field.
-	scanDescDuplicateGroupCount := scanFields[7].Descriptor()
-	// scan.DuplicateGroupCountValidator is a validator for the "duplicate_group_count" field. It is called by the builders before save.
-	scan.DuplicateGroupCountValidator = scanDescDuplicateGroupCount.Validators[0].(func(int) error)
-	// scanDescID is the schema descriptor for id field.
-	scanDescID := scanFields[0].Descriptor()
-	// scan.DefaultID holds the default value on creation for the id field.
-	scan.DefaultID = scanDescID.Default.(func() uuid.UUID)
-	tenantMixin := schema.Tenant{}.Mixin()
-	tenantMixinFields0 := tenantMixin[0].Fields()
-	_ = tenantMixinFields0
-	tenantFields := schema.Tenant{}.Fields()
-	_ = tenantFields
-	// tenantDescCreateTime is the schema descriptor for create_time field.
-	tenantDescCreateTime := tenantMixinFields0[0].Descriptor()
-	// tenant.DefaultCreateTime holds the default value on creation for the create_time field.
-	tenant.DefaultCreateTime = tenantDescCreateTime.Default.(func() time.Time)
-	// tenantDescUpdateTime is the schema descriptor for update_time field.
-	tenantDescUpdateTime := tenantMixinFields0[1].Descriptor()
-	// tenant.DefaultUpdateTime holds the default value on creation for the update_time field.
-	tenant.DefaultUpdateTime = tenantDescUpdateTime.Default.(func() time.Time)
-	// tenant.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
-	tenant.UpdateDefaultUpdateTime = tenantDescUpdateTime.UpdateDefault.(func() time.Time)
-	// tenantDescID is the schema descriptor for id field.
-	tenantDescID := tenantFields[0].Descriptor()
-	// tenant.DefaultID holds the default value on creation for the id field.
-	tenant.DefaultID = tenantDescID.Default.(func() uuid.UUID)
-}
+// The schema-stitching logic is generated in github.com/mcmx/duplynx/ent/runtime/runtime.go
@@ -2,7 +2,446 @@
 
 package runtime
 
-// The schema-stitching logic is generated in github.com/mcmx/duplynx/ent/runtime.go
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mcmx/duplynx/ent/actionaudit"
+	"github.com/mcmx/duplynx/ent/actionjob"
+	"github.com/mcmx/duplynx/ent/contentevent"
+	"github.com/mcmx/duplynx/ent/contentidentity"
+	"github.com/mcmx/duplynx/ent/duplicategroup"
+	"github.com/mcmx/duplynx/ent/fileinstance"
+	"github.com/mcmx/duplynx/ent/machine"
+	"github.com/mcmx/duplynx/ent/scan"
+	"github.com/mcmx/duplynx/ent/scanschedule"
+	"github.com/mcmx/duplynx/ent/scanschedulerun"
+	"github.com/mcmx/duplynx/ent/scantarget"
+	"github.com/mcmx/duplynx/ent/schema"
+	"github.com/mcmx/duplynx/ent/tenant"
+	"github.com/mcmx/duplynx/ent/tenantsecret"
+	"github.com/mcmx/duplynx/ent/tenanttombstone"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	actionauditMixin := schema.ActionAudit{}.Mixin()
+	actionauditMixinHooks1 := actionauditMixin[1].Hooks()
+	actionaudit.Hooks[0] = actionauditMixinHooks1[0]
+	actionauditMixinInters1 := actionauditMixin[1].Interceptors()
+	actionaudit.Interceptors[0] = actionauditMixinInters1[0]
+	actionauditMixinFields0 := actionauditMixin[0].Fields()
+	_ = actionauditMixinFields0
+	actionauditFields := schema.ActionAudit{}.Fields()
+	_ = actionauditFields
+	// actionauditDescCreateTime is the schema descriptor for create_time field.
+	actionauditDescCreateTime := actionauditMixinFields0[0].Descriptor()
+	// actionaudit.DefaultCreateTime holds the default value on creation for the create_time field.
+	actionaudit.DefaultCreateTime = actionauditDescCreateTime.Default.(func() time.Time)
+	// actionauditDescUpdateTime is the schema descriptor for update_time field.
+	actionauditDescUpdateTime := actionauditMixinFields0[1].Descriptor()
+	// actionaudit.DefaultUpdateTime holds the default value on creation for the update_time field.
+	actionaudit.DefaultUpdateTime = actionauditDescUpdateTime.Default.(func() time.Time)
+	// actionaudit.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	actionaudit.UpdateDefaultUpdateTime = actionauditDescUpdateTime.UpdateDefault.(func() time.Time)
+	// actionauditDescActor is the schema descriptor for actor field.
+	actionauditDescActor := actionauditFields[3].Descriptor()
+	// actionaudit.DefaultActor holds the default value on creation for the actor field.
+	actionaudit.DefaultActor = actionauditDescActor.Default.(string)
+	// actionauditDescPerformedAt is the schema descriptor for performed_at field.
+	actionauditDescPerformedAt := actionauditFields[6].Descriptor()
+	// actionaudit.DefaultPerformedAt holds the default value on creation for the performed_at field.
+	actionaudit.DefaultPerformedAt = actionauditDescPerformedAt.Default.(func() time.Time)
+	// actionauditDescStubbed is the schema descriptor for stubbed field.
+	actionauditDescStubbed := actionauditFields[7].Descriptor()
+	// actionaudit.DefaultStubbed holds the default value on creation for the stubbed field.
+	actionaudit.DefaultStubbed = actionauditDescStubbed.Default.(bool)
+	// actionauditDescID is the schema descriptor for id field.
+	actionauditDescID := actionauditFields[0].Descriptor()
+	// actionaudit.DefaultID holds the default value on creation for the id field.
+	actionaudit.DefaultID = actionauditDescID.Default.(func() uuid.UUID)
+	actionjobMixin := schema.ActionJob{}.Mixin()
+	actionjobMixinHooks1 := actionjobMixin[1].Hooks()
+	actionjob.Hooks[0] = actionjobMixinHooks1[0]
+	actionjobMixinInters1 := actionjobMixin[1].Interceptors()
+	actionjob.Interceptors[0] = actionjobMixinInters1[0]
+	actionjobMixinFields0 := actionjobMixin[0].Fields()
+	_ = actionjobMixinFields0
+	actionjobFields := schema.ActionJob{}.Fields()
+	_ = actionjobFields
+	// actionjobDescCreateTime is the schema descriptor for create_time field.
+	actionjobDescCreateTime := actionjobMixinFields0[0].Descriptor()
+	// actionjob.DefaultCreateTime holds the default value on creation for the create_time field.
+	actionjob.DefaultCreateTime = actionjobDescCreateTime.Default.(func() time.Time)
+	// actionjobDescUpdateTime is the schema descriptor for update_time field.
+	actionjobDescUpdateTime := actionjobMixinFields0[1].Descriptor()
+	// actionjob.DefaultUpdateTime holds the default value on creation for the update_time field.
+	actionjob.DefaultUpdateTime = actionjobDescUpdateTime.Default.(func() time.Time)
+	// actionjob.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	actionjob.UpdateDefaultUpdateTime = actionjobDescUpdateTime.UpdateDefault.(func() time.Time)
+	// actionjobDescActor is the schema descriptor for actor field.
+	actionjobDescActor := actionjobFields[9].Descriptor()
+	// actionjob.DefaultActor holds the default value on creation for the actor field.
+	actionjob.DefaultActor = actionjobDescActor.Default.(string)
+	// actionjobDescID is the schema descriptor for id field.
+	actionjobDescID := actionjobFields[0].Descriptor()
+	// actionjob.DefaultID holds the default value on creation for the id field.
+	actionjob.DefaultID = actionjobDescID.Default.(func() uuid.UUID)
+	contenteventMixin := schema.ContentEvent{}.Mixin()
+	contenteventMixinHooks1 := contenteventMixin[1].Hooks()
+	contentevent.Hooks[0] = contenteventMixinHooks1[0]
+	contenteventMixinInters1 := contenteventMixin[1].Interceptors()
+	contentevent.Interceptors[0] = contenteventMixinInters1[0]
+	contenteventMixinFields0 := contenteventMixin[0].Fields()
+	_ = contenteventMixinFields0
+	contenteventFields := schema.ContentEvent{}.Fields()
+	_ = contenteventFields
+	// contenteventDescCreateTime is the schema descriptor for create_time field.
+	contenteventDescCreateTime := contenteventMixinFields0[0].Descriptor()
+	// contentevent.DefaultCreateTime holds the default value on creation for the create_time field.
+	contentevent.DefaultCreateTime = contenteventDescCreateTime.Default.(func() time.Time)
+	// contenteventDescUpdateTime is the schema descriptor for update_time field.
+	contenteventDescUpdateTime := contenteventMixinFields0[1].Descriptor()
+	// contentevent.DefaultUpdateTime holds the default value on creation for the update_time field.
+	contentevent.DefaultUpdateTime = contenteventDescUpdateTime.Default.(func() time.Time)
+	// contentevent.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	contentevent.UpdateDefaultUpdateTime = contenteventDescUpdateTime.UpdateDefault.(func() time.Time)
+	// contenteventDescID is the schema descriptor for id field.
+	contenteventDescID := contenteventFields[0].Descriptor()
+	// contentevent.DefaultID holds the default value on creation for the id field.
+	contentevent.DefaultID = contenteventDescID.Default.(func() uuid.UUID)
+	contentidentityMixin := schema.ContentIdentity{}.Mixin()
+	contentidentityMixinHooks1 := contentidentityMixin[1].Hooks()
+	contentidentity.Hooks[0] = contentidentityMixinHooks1[0]
+	contentidentityMixinInters1 := contentidentityMixin[1].Interceptors()
+	contentidentity.Interceptors[0] = contentidentityMixinInters1[0]
+	contentidentityMixinFields0 := contentidentityMixin[0].Fields()
+	_ = contentidentityMixinFields0
+	contentidentityFields := schema.ContentIdentity{}.Fields()
+	_ = contentidentityFields
+	// contentidentityDescCreateTime is the schema descriptor for create_time field.
+	contentidentityDescCreateTime := contentidentityMixinFields0[0].Descriptor()
+	// contentidentity.DefaultCreateTime holds the default value on creation for the create_time field.
+	contentidentity.DefaultCreateTime = contentidentityDescCreateTime.Default.(func() time.Time)
+	// contentidentityDescUpdateTime is the schema descriptor for update_time field.
+	contentidentityDescUpdateTime := contentidentityMixinFields0[1].Descriptor()
+	// contentidentity.DefaultUpdateTime holds the default value on creation for the update_time field.
+	contentidentity.DefaultUpdateTime = contentidentityDescUpdateTime.Default.(func() time.Time)
+	// contentidentity.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	contentidentity.UpdateDefaultUpdateTime = contentidentityDescUpdateTime.UpdateDefault.(func() time.Time)
+	// contentidentityDescHash is the schema descriptor for hash field.
+	contentidentityDescHash := contentidentityFields[2].Descriptor()
+	// contentidentity.HashValidator is a validator for the "hash" field. It is called by the builders before save.
+	contentidentity.HashValidator = contentidentityDescHash.Validators[0].(func(string) error)
+	// contentidentityDescID is the schema descriptor for id field.
+	contentidentityDescID := contentidentityFields[0].Descriptor()
+	// contentidentity.DefaultID holds the default value on creation for the id field.
+	contentidentity.DefaultID = contentidentityDescID.Default.(func() uuid.UUID)
+	duplicategroupMixin := schema.DuplicateGroup{}.Mixin()
+	duplicategroupMixinHooks1 := duplicategroupMixin[1].Hooks()
+	duplicategroup.Hooks[0] = duplicategroupMixinHooks1[0]
+	duplicategroupMixinInters1 := duplicategroupMixin[1].Interceptors()
+	duplicategroup.Interceptors[0] = duplicategroupMixinInters1[0]
+	duplicategroupMixinFields0 := duplicategroupMixin[0].Fields()
+	_ = duplicategroupMixinFields0
+	duplicategroupFields := schema.DuplicateGroup{}.Fields()
+	_ = duplicategroupFields
+	// duplicategroupDescCreateTime is the schema descriptor for create_time field.
+	duplicategroupDescCreateTime := duplicategroupMixinFields0[0].Descriptor()
+	// duplicategroup.DefaultCreateTime holds the default value on creation for the create_time field.
+	duplicategroup.DefaultCreateTime = duplicategroupDescCreateTime.Default.(func() time.Time)
+	// duplicategroupDescUpdateTime is the schema descriptor for update_time field.
+	duplicategroupDescUpdateTime := duplicategroupMixinFields0[1].Descriptor()
+	// duplicategroup.DefaultUpdateTime holds the default value on creation for the update_time field.
+	duplicategroup.DefaultUpdateTime = duplicategroupDescUpdateTime.Default.(func() time.Time)
+	// duplicategroup.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	duplicategroup.UpdateDefaultUpdateTime = duplicategroupDescUpdateTime.UpdateDefault.(func() time.Time)
+	// duplicategroupDescFileCount is the schema descriptor for file_count field.
+	duplicategroupDescFileCount := duplicategroupFields[7].Descriptor()
+	// duplicategroup.FileCountValidator is a validator for the "file_count" field. It is called by the builders before save.
+	duplicategroup.FileCountValidator = duplicategroupDescFileCount.Validators[0].(func(int) error)
+	// duplicategroupDescTotalSizeBytes is the schema descriptor for total_size_bytes field.
+	duplicategroupDescTotalSizeBytes := duplicategroupFields[8].Descriptor()
+	// duplicategroup.TotalSizeBytesValidator is a validator for the "total_size_bytes" field. It is called by the builders before save.
+	duplicategroup.TotalSizeBytesValidator = duplicategroupDescTotalSizeBytes.Validators[0].(func(int64) error)
+	// duplicategroupDescID is the schema descriptor for id field.
+	duplicategroupDescID := duplicategroupFields[0].Descriptor()
+	// duplicategroup.DefaultID holds the default value on creation for the id field.
+	duplicategroup.DefaultID = duplicategroupDescID.Default.(func() uuid.UUID)
+	fileinstanceMixin := schema.FileInstance{}.Mixin()
+	fileinstanceMixinHooks1 := fileinstanceMixin[1].Hooks()
+	fileinstance.Hooks[0] = fileinstanceMixinHooks1[0]
+	fileinstanceMixinInters1 := fileinstanceMixin[1].Interceptors()
+	fileinstance.Interceptors[0] = fileinstanceMixinInters1[0]
+	fileinstanceMixinFields0 := fileinstanceMixin[0].Fields()
+	_ = fileinstanceMixinFields0
+	fileinstanceFields := schema.FileInstance{}.Fields()
+	_ = fileinstanceFields
+	// fileinstanceDescCreateTime is the schema descriptor for create_time field.
+	fileinstanceDescCreateTime := fileinstanceMixinFields0[0].Descriptor()
+	// fileinstance.DefaultCreateTime holds the default value on creation for the create_time field.
+	fileinstance.DefaultCreateTime = fileinstanceDescCreateTime.Default.(func() time.Time)
+	// fileinstanceDescUpdateTime is the schema descriptor for update_time field.
+	fileinstanceDescUpdateTime := fileinstanceMixinFields0[1].Descriptor()
+	// fileinstance.DefaultUpdateTime holds the default value on creation for the update_time field.
+	fileinstance.DefaultUpdateTime = fileinstanceDescUpdateTime.Default.(func() time.Time)
+	// fileinstance.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	fileinstance.UpdateDefaultUpdateTime = fileinstanceDescUpdateTime.UpdateDefault.(func() time.Time)
+	// fileinstanceDescSizeBytes is the schema descriptor for size_bytes field.
+	fileinstanceDescSizeBytes := fileinstanceFields[5].Descriptor()
+	// fileinstance.SizeBytesValidator is a validator for the "size_bytes" field. It is called by the builders before save.
+	fileinstance.SizeBytesValidator = fileinstanceDescSizeBytes.Validators[0].(func(int64) error)
+	// fileinstanceDescLastSeenAt is the schema descriptor for last_seen_at field.
+	fileinstanceDescLastSeenAt := fileinstanceFields[7].Descriptor()
+	// fileinstance.DefaultLastSeenAt holds the default value on creation for the last_seen_at field.
+	fileinstance.DefaultLastSeenAt = fileinstanceDescLastSeenAt.Default.(func() time.Time)
+	// fileinstanceDescQuarantined is the schema descriptor for quarantined field.
+	fileinstanceDescQuarantined := fileinstanceFields[8].Descriptor()
+	// fileinstance.DefaultQuarantined holds the default value on creation for the quarantined field.
+	fileinstance.DefaultQuarantined = fileinstanceDescQuarantined.Default.(bool)
+	// fileinstanceDescID is the schema descriptor for id field.
+	fileinstanceDescID := fileinstanceFields[0].Descriptor()
+	// fileinstance.DefaultID holds the default value on creation for the id field.
+	fileinstance.DefaultID = fileinstanceDescID.Default.(func() uuid.UUID)
+	machineMixin := schema.Machine{}.Mixin()
+	machineMixinHooks1 := machineMixin[1].Hooks()
+	machine.Hooks[0] = machineMixinHooks1[0]
+	machineMixinInters1 := machineMixin[1].Interceptors()
+	machine.Interceptors[0] = machineMixinInters1[0]
+	machineMixinFields0 := machineMixin[0].Fields()
+	_ = machineMixinFields0
+	machineFields := schema.Machine{}.Fields()
+	_ = machineFields
+	// machineDescCreateTime is the schema descriptor for create_time field.
+	machineDescCreateTime := machineMixinFields0[0].Descriptor()
+	// machine.DefaultCreateTime holds the default value on creation for the create_time field.
+	machine.DefaultCreateTime = machineDescCreateTime.Default.(func() time.Time)
+	// machineDescUpdateTime is the schema descriptor for update_time field.
+	machineDescUpdateTime := machineMixinFields0[1].Descriptor()
+	// machine.DefaultUpdateTime holds the default value on creation for the update_time field.
+	machine.DefaultUpdateTime = machineDescUpdateTime.Default.(func() time.Time)
+	// machine.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	machine.UpdateDefaultUpdateTime = machineDescUpdateTime.UpdateDefault.(func() time.Time)
+	// machineDescID is the schema descriptor for id field.
+	machineDescID := machineFields[0].Descriptor()
+	// machine.DefaultID holds the default value on creation for the id field.
+	machine.DefaultID = machineDescID.Default.(func() uuid.UUID)
+	scanMixin := schema.Scan{}.Mixin()
+	scanMixinHooks1 := scanMixin[1].Hooks()
+	scan.Hooks[0] = scanMixinHooks1[0]
+	scanMixinInters1 := scanMixin[1].Interceptors()
+	scan.Interceptors[0] = scanMixinInters1[0]
+	scanMixinFields0 := scanMixin[0].Fields()
+	_ = scanMixinFields0
+	scanFields := schema.Scan{}.Fields()
+	_ = scanFields
+	// scanDescCreateTime is the schema descriptor for create_time field.
+	scanDescCreateTime := scanMixinFields0[0].Descriptor()
+	// scan.DefaultCreateTime holds the default value on creation for the create_time field.
+	scan.DefaultCreateTime = scanDescCreateTime.Default.(func() time.Time)
+	// scanDescUpdateTime is the schema descriptor for update_time field.
+	scanDescUpdateTime := scanMixinFields0[1].Descriptor()
+	// scan.DefaultUpdateTime holds the default value on creation for the update_time field.
+	scan.DefaultUpdateTime = scanDescUpdateTime.Default.(func() time.Time)
+	// scan.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	scan.UpdateDefaultUpdateTime = scanDescUpdateTime.UpdateDefault.(func() time.Time)
+	// scanDescDuplicateGroupCount is the schema descriptor for duplicate_group_count field.
+	scanDescDuplicateGroupCount := scanFields[7].Descriptor()
+	// scan.DuplicateGroupCountValidator is a validator for the "duplicate_group_count" field. It is called by the builders before save.
+	scan.DuplicateGroupCountValidator = scanDescDuplicateGroupCount.Validators[0].(func(int) error)
+	// scanDescFilesSeen is the schema descriptor for files_seen field.
+	scanDescFilesSeen := scanFields[11].Descriptor()
+	// scan.DefaultFilesSeen holds the default value on creation for the files_seen field.
+	scan.DefaultFilesSeen = scanDescFilesSeen.Default.(int)
+	// scan.FilesSeenValidator is a validator for the "files_seen" field. It is called by the builders before save.
+	scan.FilesSeenValidator = scanDescFilesSeen.Validators[0].(func(int) error)
+	// scanDescBytesHashed is the schema descriptor for bytes_hashed field.
+	scanDescBytesHashed := scanFields[12].Descriptor()
+	// scan.DefaultBytesHashed holds the default value on creation for the bytes_hashed field.
+	scan.DefaultBytesHashed = scanDescBytesHashed.Default.(int64)
+	// scan.BytesHashedValidator is a validator for the "bytes_hashed" field. It is called by the builders before save.
+	scan.BytesHashedValidator = scanDescBytesHashed.Validators[0].(func(int64) error)
+	// scanDescMachinesReported is the schema descriptor for machines_reported field.
+	scanDescMachinesReported := scanFields[13].Descriptor()
+	// scan.DefaultMachinesReported holds the default value on creation for the machines_reported field.
+	scan.DefaultMachinesReported = scanDescMachinesReported.Default.(int)
+	// scan.MachinesReportedValidator is a validator for the "machines_reported" field. It is called by the builders before save.
+	scan.MachinesReportedValidator = scanDescMachinesReported.Validators[0].(func(int) error)
+	// scanDescID is the schema descriptor for id field.
+	scanDescID := scanFields[0].Descriptor()
+	// scan.DefaultID holds the default value on creation for the id field.
+	scan.DefaultID = scanDescID.Default.(func() uuid.UUID)
+	scanscheduleMixin := schema.ScanSchedule{}.Mixin()
+	scanscheduleMixinHooks1 := scanscheduleMixin[1].Hooks()
+	scanschedule.Hooks[0] = scanscheduleMixinHooks1[0]
+	scanscheduleMixinInters1 := scanscheduleMixin[1].Interceptors()
+	scanschedule.Interceptors[0] = scanscheduleMixinInters1[0]
+	scanscheduleMixinFields0 := scanscheduleMixin[0].Fields()
+	_ = scanscheduleMixinFields0
+	scanscheduleFields := schema.ScanSchedule{}.Fields()
+	_ = scanscheduleFields
+	// scanscheduleDescCreateTime is the schema descriptor for create_time field.
+	scanscheduleDescCreateTime := scanscheduleMixinFields0[0].Descriptor()
+	// scanschedule.DefaultCreateTime holds the default value on creation for the create_time field.
+	scanschedule.DefaultCreateTime = scanscheduleDescCreateTime.Default.(func() time.Time)
+	// scanscheduleDescUpdateTime is the schema descriptor for update_time field.
+	scanscheduleDescUpdateTime := scanscheduleMixinFields0[1].Descriptor()
+	// scanschedule.DefaultUpdateTime holds the default value on creation for the update_time field.
+	scanschedule.DefaultUpdateTime = scanscheduleDescUpdateTime.Default.(func() time.Time)
+	// scanschedule.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	scanschedule.UpdateDefaultUpdateTime = scanscheduleDescUpdateTime.UpdateDefault.(func() time.Time)
+	// scanscheduleDescTimezone is the schema descriptor for timezone field.
+	scanscheduleDescTimezone := scanscheduleFields[4].Descriptor()
+	// scanschedule.DefaultTimezone holds the default value on creation for the timezone field.
+	scanschedule.DefaultTimezone = scanscheduleDescTimezone.Default.(string)
+	// scanscheduleDescDeadlineSeconds is the schema descriptor for deadline_seconds field.
+	scanscheduleDescDeadlineSeconds := scanscheduleFields[8].Descriptor()
+	// scanschedule.DefaultDeadlineSeconds holds the default value on creation for the deadline_seconds field.
+	scanschedule.DefaultDeadlineSeconds = scanscheduleDescDeadlineSeconds.Default.(int)
+	// scanschedule.DeadlineSecondsValidator is a validator for the "deadline_seconds" field. It is called by the builders before save.
+	scanschedule.DeadlineSecondsValidator = scanscheduleDescDeadlineSeconds.Validators[0].(func(int) error)
+	// scanscheduleDescEnabled is the schema descriptor for enabled field.
+	scanscheduleDescEnabled := scanscheduleFields[9].Descriptor()
+	// scanschedule.DefaultEnabled holds the default value on creation for the enabled field.
+	scanschedule.DefaultEnabled = scanscheduleDescEnabled.Default.(bool)
+	// scanscheduleDescID is the schema descriptor for id field.
+	scanscheduleDescID := scanscheduleFields[0].Descriptor()
+	// scanschedule.DefaultID holds the default value on creation for the id field.
+	scanschedule.DefaultID = scanscheduleDescID.Default.(func() uuid.UUID)
+	scanschedulerunMixin := schema.ScanScheduleRun{}.Mixin()
+	scanschedulerunMixinHooks1 := scanschedulerunMixin[1].Hooks()
+	scanschedulerun.Hooks[0] = scanschedulerunMixinHooks1[0]
+	scanschedulerunMixinInters1 := scanschedulerunMixin[1].Interceptors()
+	scanschedulerun.Interceptors[0] = scanschedulerunMixinInters1[0]
+	scanschedulerunMixinFields0 := scanschedulerunMixin[0].Fields()
+	_ = scanschedulerunMixinFields0
+	scanschedulerunFields := schema.ScanScheduleRun{}.Fields()
+	_ = scanschedulerunFields
+	// scanschedulerunDescCreateTime is the schema descriptor for create_time field.
+	scanschedulerunDescCreateTime := scanschedulerunMixinFields0[0].Descriptor()
+	// scanschedulerun.DefaultCreateTime holds the default value on creation for the create_time field.
+	scanschedulerun.DefaultCreateTime = scanschedulerunDescCreateTime.Default.(func() time.Time)
+	// scanschedulerunDescUpdateTime is the schema descriptor for update_time field.
+	scanschedulerunDescUpdateTime := scanschedulerunMixinFields0[1].Descriptor()
+	// scanschedulerun.DefaultUpdateTime holds the default value on creation for the update_time field.
+	scanschedulerun.DefaultUpdateTime = scanschedulerunDescUpdateTime.Default.(func() time.Time)
+	// scanschedulerun.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	scanschedulerun.UpdateDefaultUpdateTime = scanschedulerunDescUpdateTime.UpdateDefault.(func() time.Time)
+	// scanschedulerunDescID is the schema descriptor for id field.
+	scanschedulerunDescID := scanschedulerunFields[0].Descriptor()
+	// scanschedulerun.DefaultID holds the default value on creation for the id field.
+	scanschedulerun.DefaultID = scanschedulerunDescID.Default.(func() uuid.UUID)
+	scantargetMixin := schema.ScanTarget{}.Mixin()
+	scantargetMixinHooks1 := scantargetMixin[1].Hooks()
+	scantarget.Hooks[0] = scantargetMixinHooks1[0]
+	scantargetMixinInters1 := scantargetMixin[1].Interceptors()
+	scantarget.Interceptors[0] = scantargetMixinInters1[0]
+	scantargetMixinFields0 := scantargetMixin[0].Fields()
+	_ = scantargetMixinFields0
+	scantargetFields := schema.ScanTarget{}.Fields()
+	_ = scantargetFields
+	// scantargetDescCreateTime is the schema descriptor for create_time field.
+	scantargetDescCreateTime := scantargetMixinFields0[0].Descriptor()
+	// scantarget.DefaultCreateTime holds the default value on creation for the create_time field.
+	scantarget.DefaultCreateTime = scantargetDescCreateTime.Default.(func() time.Time)
+	// scantargetDescUpdateTime is the schema descriptor for update_time field.
+	scantargetDescUpdateTime := scantargetMixinFields0[1].Descriptor()
+	// scantarget.DefaultUpdateTime holds the default value on creation for the update_time field.
+	scantarget.DefaultUpdateTime = scantargetDescUpdateTime.Default.(func() time.Time)
+	// scantarget.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	scantarget.UpdateDefaultUpdateTime = scantargetDescUpdateTime.UpdateDefault.(func() time.Time)
+	// scantargetDescID is the schema descriptor for id field.
+	scantargetDescID := scantargetFields[0].Descriptor()
+	// scantarget.DefaultID holds the default value on creation for the id field.
+	scantarget.DefaultID = scantargetDescID.Default.(func() uuid.UUID)
+	tenantMixin := schema.Tenant{}.Mixin()
+	tenantMixinHooks1 := tenantMixin[1].Hooks()
+	tenant.Hooks[0] = tenantMixinHooks1[0]
+	tenantMixinInters1 := tenantMixin[1].Interceptors()
+	tenant.Interceptors[0] = tenantMixinInters1[0]
+	tenantMixinFields0 := tenantMixin[0].Fields()
+	_ = tenantMixinFields0
+	tenantFields := schema.Tenant{}.Fields()
+	_ = tenantFields
+	// tenantDescCreateTime is the schema descriptor for create_time field.
+	tenantDescCreateTime := tenantMixinFields0[0].Descriptor()
+	// tenant.DefaultCreateTime holds the default value on creation for the create_time field.
+	tenant.DefaultCreateTime = tenantDescCreateTime.Default.(func() time.Time)
+	// tenantDescUpdateTime is the schema descriptor for update_time field.
+	tenantDescUpdateTime := tenantMixinFields0[1].Descriptor()
+	// tenant.DefaultUpdateTime holds the default value on creation for the update_time field.
+	tenant.DefaultUpdateTime = tenantDescUpdateTime.Default.(func() time.Time)
+	// tenant.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	tenant.UpdateDefaultUpdateTime = tenantDescUpdateTime.UpdateDefault.(func() time.Time)
+	// tenantDescID is the schema descriptor for id field.
+	tenantDescID := tenantFields[0].Descriptor()
+	// tenant.DefaultID holds the default value on creation for the id field.
+	tenant.DefaultID = tenantDescID.Default.(func() uuid.UUID)
+	tenantsecretMixin := schema.TenantSecret{}.Mixin()
+	tenantsecretMixinHooks1 := tenantsecretMixin[1].Hooks()
+	tenantsecret.Hooks[0] = tenantsecretMixinHooks1[0]
+	tenantsecretMixinInters1 := tenantsecretMixin[1].Interceptors()
+	tenantsecret.Interceptors[0] = tenantsecretMixinInters1[0]
+	tenantsecretMixinFields0 := tenantsecretMixin[0].Fields()
+	_ = tenantsecretMixinFields0
+	tenantsecretFields := schema.TenantSecret{}.Fields()
+	_ = tenantsecretFields
+	// tenantsecretDescCreateTime is the schema descriptor for create_time field.
+	tenantsecretDescCreateTime := tenantsecretMixinFields0[0].Descriptor()
+	// tenantsecret.DefaultCreateTime holds the default value on creation for the create_time field.
+	tenantsecret.DefaultCreateTime = tenantsecretDescCreateTime.Default.(func() time.Time)
+	// tenantsecretDescUpdateTime is the schema descriptor for update_time field.
+	tenantsecretDescUpdateTime := tenantsecretMixinFields0[1].Descriptor()
+	// tenantsecret.DefaultUpdateTime holds the default value on creation for the update_time field.
+	tenantsecret.DefaultUpdateTime = tenantsecretDescUpdateTime.Default.(func() time.Time)
+	// tenantsecret.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	tenantsecret.UpdateDefaultUpdateTime = tenantsecretDescUpdateTime.UpdateDefault.(func() time.Time)
+	// tenantsecretDescKeyID is the schema descriptor for key_id field.
+	tenantsecretDescKeyID := tenantsecretFields[3].Descriptor()
+	// tenantsecret.KeyIDValidator is a validator for the "key_id" field. It is called by the builders before save.
+	tenantsecret.KeyIDValidator = tenantsecretDescKeyID.Validators[0].(func(string) error)
+	// tenantsecretDescSecret is the schema descriptor for secret field.
+	tenantsecretDescSecret := tenantsecretFields[4].Descriptor()
+	// tenantsecret.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	tenantsecret.SecretValidator = tenantsecretDescSecret.Validators[0].(func(string) error)
+	// tenantsecretDescNotBefore is the schema descriptor for not_before field.
+	tenantsecretDescNotBefore := tenantsecretFields[5].Descriptor()
+	// tenantsecret.DefaultNotBefore holds the default value on creation for the not_before field.
+	tenantsecret.DefaultNotBefore = tenantsecretDescNotBefore.Default.(func() time.Time)
+	// tenantsecretDescID is the schema descriptor for id field.
+	tenantsecretDescID := tenantsecretFields[0].Descriptor()
+	// tenantsecret.DefaultID holds the default value on creation for the id field.
+	tenantsecret.DefaultID = tenantsecretDescID.Default.(func() uuid.UUID)
+	tenanttombstoneMixin := schema.TenantTombstone{}.Mixin()
+	tenanttombstoneMixinFields0 := tenanttombstoneMixin[0].Fields()
+	_ = tenanttombstoneMixinFields0
+	tenanttombstoneFields := schema.TenantTombstone{}.Fields()
+	_ = tenanttombstoneFields
+	// tenanttombstoneDescCreateTime is the schema descriptor for create_time field.
+	tenanttombstoneDescCreateTime := tenanttombstoneMixinFields0[0].Descriptor()
+	// tenanttombstone.DefaultCreateTime holds the default value on creation for the create_time field.
+	tenanttombstone.DefaultCreateTime = tenanttombstoneDescCreateTime.Default.(func() time.Time)
+	// tenanttombstoneDescUpdateTime is the schema descriptor for update_time field.
+	tenanttombstoneDescUpdateTime := tenanttombstoneMixinFields0[1].Descriptor()
+	// tenanttombstone.DefaultUpdateTime holds the default value on creation for the update_time field.
+	tenanttombstone.DefaultUpdateTime = tenanttombstoneDescUpdateTime.Default.(func() time.Time)
+	// tenanttombstone.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	tenanttombstone.UpdateDefaultUpdateTime = tenanttombstoneDescUpdateTime.UpdateDefault.(func() time.Time)
+	// tenanttombstoneDescActor is the schema descriptor for actor field.
+	tenanttombstoneDescActor := tenanttombstoneFields[4].Descriptor()
+	// tenanttombstone.DefaultActor holds the default value on creation for the actor field.
+	tenanttombstone.DefaultActor = tenanttombstoneDescActor.Default.(string)
+	// tenanttombstoneDescPurgedAt is the schema descriptor for purged_at field.
+	tenanttombstoneDescPurgedAt := tenanttombstoneFields[5].Descriptor()
+	// tenanttombstone.DefaultPurgedAt holds the default value on creation for the purged_at field.
+	tenanttombstone.DefaultPurgedAt = tenanttombstoneDescPurgedAt.Default.(func() time.Time)
+	// tenanttombstoneDescID is the schema descriptor for id field.
+	tenanttombstoneDescID := tenanttombstoneFields[0].Descriptor()
+	// tenanttombstone.DefaultID holds the default value on creation for the id field.
+	tenanttombstone.DefaultID = tenanttombstoneDescID.Default.(func() uuid.UUID)
+}
 
 const (
 	Version = "v0.14.5"                                         // Version of ent codegen.
@@ -38,6 +38,22 @@ type Scan struct {
 	CompletedAt time.Time `json:"completed_at,omitempty"`
 	// DuplicateGroupCount holds the value of the "duplicate_group_count" field.
 	DuplicateGroupCount int `json:"duplicate_group_count,omitempty"`
+	// FilesPrunedAt holds the value of the "files_pruned_at" field.
+	FilesPrunedAt time.Time `json:"files_pruned_at,omitempty"`
+	// Status holds the value of the "status" field.
+	Status scan.Status `json:"status,omitempty"`
+	// StatusMessage holds the value of the "status_message" field.
+	StatusMessage string `json:"status_message,omitempty"`
+	// FilesSeen holds the value of the "files_seen" field.
+	FilesSeen int `json:"files_seen,omitempty"`
+	// BytesHashed holds the value of the "bytes_hashed" field.
+	BytesHashed int64 `json:"bytes_hashed,omitempty"`
+	// MachinesReported holds the value of the "machines_reported" field.
+	MachinesReported int `json:"machines_reported,omitempty"`
+	// LastHeartbeatAt holds the value of the "last_heartbeat_at" field.
+	LastHeartbeatAt time.Time `json:"last_heartbeat_at,omitempty"`
+	// Deadline holds the value of the "deadline" field.
+	Deadline time.Time `json:"deadline,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ScanQuery when eager-loading is set.
 	Edges        ScanEdges `json:"edges"`
@@ -52,9 +68,13 @@ type ScanEdges struct {
 	InitiatedMachine *Machine `json:"initiated_machine,omitempty"`
 	// DuplicateGroups holds the value of the duplicate_groups edge.
 	DuplicateGroups []*DuplicateGroup `json:"duplicate_groups,omitempty"`
+	// ContentEvents holds the value of the content_events edge.
+	ContentEvents []*ContentEvent `json:"content_events,omitempty"`
+	// Targets holds the value of the targets edge.
+	Targets []*ScanTarget `json:"targets,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [5]bool
 }
 
 // TenantOrErr returns the Tenant value or an error if the edge
@@ -88,16 +108,34 @@ func (e ScanEdges) DuplicateGroupsOrErr() ([]*DuplicateGroup, error) {
 	return nil, &NotLoadedError{edge: "duplicate_groups"}
 }
 
+// ContentEventsOrErr returns the ContentEvents value or an error if the edge
+// was not loaded in eager-loading.
+func (e ScanEdges) ContentEventsOrErr() ([]*ContentEvent, error) {
+	if e.loadedTypes[3] {
+		return e.ContentEvents, nil
+	}
+	return nil, &NotLoadedError{edge: "content_events"}
+}
+
+// TargetsOrErr returns the Targets value or an error if the edge
+// was not loaded in eager-loading.
+func (e ScanEdges) TargetsOrErr() ([]*ScanTarget, error) {
+	if e.loadedTypes[4] {
+		return e.Targets, nil
+	}
+	return nil, &NotLoadedError{edge: "targets"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Scan) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case scan.FieldDuplicateGroupCount:
+		case scan.FieldDuplicateGroupCount, scan.FieldFilesSeen, scan.FieldBytesHashed, scan.FieldMachinesReported:
 			values[i] = new(sql.NullInt64)
-		case scan.FieldName, scan.FieldDescription:
+		case scan.FieldName, scan.FieldDescription, scan.FieldStatus, scan.FieldStatusMessage:
 			values[i] = new(sql.NullString)
-		case scan.FieldCreateTime, scan.FieldUpdateTime, scan.FieldStartedAt, scan.FieldCompletedAt:
+		case scan.FieldCreateTime, scan.FieldUpdateTime, scan.FieldStartedAt, scan.FieldCompletedAt, scan.FieldFilesPrunedAt, scan.FieldLastHeartbeatAt, scan.FieldDeadline:
 			values[i] = new(sql.NullTime)
 		case scan.FieldID, scan.FieldTenantID, scan.FieldInitiatedMachineID:
 			values[i] = new(uuid.UUID)
@@ -176,6 +214,54 @@ func (_m *Scan) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.DuplicateGroupCount = int(value.Int64)
 			}
+		case scan.FieldFilesPrunedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field files_pruned_at", values[i])
+			} else if value.Valid {
+				_m.FilesPrunedAt = value.Time
+			}
+		case scan.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = scan.Status(value.String)
+			}
+		case scan.FieldStatusMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status_message", values[i])
+			} else if value.Valid {
+				_m.StatusMessage = value.String
+			}
+		case scan.FieldFilesSeen:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field files_seen", values[i])
+			} else if value.Valid {
+				_m.FilesSeen = int(value.Int64)
+			}
+		case scan.FieldBytesHashed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field bytes_hashed", values[i])
+			} else if value.Valid {
+				_m.BytesHashed = value.Int64
+			}
+		case scan.FieldMachinesReported:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field machines_reported", values[i])
+			} else if value.Valid {
+				_m.MachinesReported = int(value.Int64)
+			}
+		case scan.FieldLastHeartbeatAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_heartbeat_at", values[i])
+			} else if value.Valid {
+				_m.LastHeartbeatAt = value.Time
+			}
+		case scan.FieldDeadline:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deadline", values[i])
+			} else if value.Valid {
+				_m.Deadline = value.Time
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -204,6 +290,16 @@ func (_m *Scan) QueryDuplicateGroups() *DuplicateGroupQuery {
 	return NewScanClient(_m.config).QueryDuplicateGroups(_m)
 }
 
+// QueryContentEvents queries the "content_events" edge of the Scan entity.
+func (_m *Scan) QueryContentEvents() *ContentEventQuery {
+	return NewScanClient(_m.config).QueryContentEvents(_m)
+}
+
+// QueryTargets queries the "targets" edge of the Scan entity.
+func (_m *Scan) QueryTargets() *ScanTargetQuery {
+	return NewScanClient(_m.config).QueryTargets(_m)
+}
+
 // Update returns a builder for updating this Scan.
 // Note that you need to call Scan.Unwrap() before calling this method if this Scan
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -253,6 +349,30 @@ func (_m *Scan) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("duplicate_group_count=")
 	builder.WriteString(fmt.Sprintf("%v", _m.DuplicateGroupCount))
+	builder.WriteString(", ")
+	builder.WriteString("files_pruned_at=")
+	builder.WriteString(_m.FilesPrunedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("status_message=")
+	builder.WriteString(_m.StatusMessage)
+	builder.WriteString(", ")
+	builder.WriteString("files_seen=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FilesSeen))
+	builder.WriteString(", ")
+	builder.WriteString("bytes_hashed=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BytesHashed))
+	builder.WriteString(", ")
+	builder.WriteString("machines_reported=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MachinesReported))
+	builder.WriteString(", ")
+	builder.WriteString("last_heartbeat_at=")
+	builder.WriteString(_m.LastHeartbeatAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deadline=")
+	builder.WriteString(_m.Deadline.Format(time.ANSIC))
 	builder.WriteByte(')')
 	return builder.String()
 }
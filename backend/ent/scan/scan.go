@@ -3,8 +3,10 @@
 package scan
 
 import (
+	"fmt"
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
@@ -33,12 +35,32 @@ const (
 	FieldCompletedAt = "completed_at"
 	// FieldDuplicateGroupCount holds the string denoting the duplicate_group_count field in the database.
 	FieldDuplicateGroupCount = "duplicate_group_count"
+	// FieldFilesPrunedAt holds the string denoting the files_pruned_at field in the database.
+	FieldFilesPrunedAt = "files_pruned_at"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldStatusMessage holds the string denoting the status_message field in the database.
+	FieldStatusMessage = "status_message"
+	// FieldFilesSeen holds the string denoting the files_seen field in the database.
+	FieldFilesSeen = "files_seen"
+	// FieldBytesHashed holds the string denoting the bytes_hashed field in the database.
+	FieldBytesHashed = "bytes_hashed"
+	// FieldMachinesReported holds the string denoting the machines_reported field in the database.
+	FieldMachinesReported = "machines_reported"
+	// FieldLastHeartbeatAt holds the string denoting the last_heartbeat_at field in the database.
+	FieldLastHeartbeatAt = "last_heartbeat_at"
+	// FieldDeadline holds the string denoting the deadline field in the database.
+	FieldDeadline = "deadline"
 	// EdgeTenant holds the string denoting the tenant edge name in mutations.
 	EdgeTenant = "tenant"
 	// EdgeInitiatedMachine holds the string denoting the initiated_machine edge name in mutations.
 	EdgeInitiatedMachine = "initiated_machine"
 	// EdgeDuplicateGroups holds the string denoting the duplicate_groups edge name in mutations.
 	EdgeDuplicateGroups = "duplicate_groups"
+	// EdgeContentEvents holds the string denoting the content_events edge name in mutations.
+	EdgeContentEvents = "content_events"
+	// EdgeTargets holds the string denoting the targets edge name in mutations.
+	EdgeTargets = "targets"
 	// Table holds the table name of the scan in the database.
 	Table = "scans"
 	// TenantTable is the table that holds the tenant relation/edge.
@@ -62,6 +84,20 @@ const (
 	DuplicateGroupsInverseTable = "duplicate_groups"
 	// DuplicateGroupsColumn is the table column denoting the duplicate_groups relation/edge.
 	DuplicateGroupsColumn = "scan_id"
+	// ContentEventsTable is the table that holds the content_events relation/edge.
+	ContentEventsTable = "content_events"
+	// ContentEventsInverseTable is the table name for the ContentEvent entity.
+	// It exists in this package in order to avoid circular dependency with the "contentevent" package.
+	ContentEventsInverseTable = "content_events"
+	// ContentEventsColumn is the table column denoting the content_events relation/edge.
+	ContentEventsColumn = "scan_id"
+	// TargetsTable is the table that holds the targets relation/edge.
+	TargetsTable = "scan_targets"
+	// TargetsInverseTable is the table name for the ScanTarget entity.
+	// It exists in this package in order to avoid circular dependency with the "scantarget" package.
+	TargetsInverseTable = "scan_targets"
+	// TargetsColumn is the table column denoting the targets relation/edge.
+	TargetsColumn = "scan_id"
 )
 
 // Columns holds all SQL columns for scan fields.
@@ -76,6 +112,14 @@ var Columns = []string{
 	FieldStartedAt,
 	FieldCompletedAt,
 	FieldDuplicateGroupCount,
+	FieldFilesPrunedAt,
+	FieldStatus,
+	FieldStatusMessage,
+	FieldFilesSeen,
+	FieldBytesHashed,
+	FieldMachinesReported,
+	FieldLastHeartbeatAt,
+	FieldDeadline,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -88,7 +132,14 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/mcmx/duplynx/ent/runtime"
 var (
+	Hooks        [1]ent.Hook
+	Interceptors [1]ent.Interceptor
 	// DefaultCreateTime holds the default value on creation for the "create_time" field.
 	DefaultCreateTime func() time.Time
 	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
@@ -97,10 +148,54 @@ var (
 	UpdateDefaultUpdateTime func() time.Time
 	// DuplicateGroupCountValidator is a validator for the "duplicate_group_count" field. It is called by the builders before save.
 	DuplicateGroupCountValidator func(int) error
+	// DefaultFilesSeen holds the default value on creation for the "files_seen" field.
+	DefaultFilesSeen int
+	// FilesSeenValidator is a validator for the "files_seen" field. It is called by the builders before save.
+	FilesSeenValidator func(int) error
+	// DefaultBytesHashed holds the default value on creation for the "bytes_hashed" field.
+	DefaultBytesHashed int64
+	// BytesHashedValidator is a validator for the "bytes_hashed" field. It is called by the builders before save.
+	BytesHashedValidator func(int64) error
+	// DefaultMachinesReported holds the default value on creation for the "machines_reported" field.
+	DefaultMachinesReported int
+	// MachinesReportedValidator is a validator for the "machines_reported" field. It is called by the builders before save.
+	MachinesReportedValidator func(int) error
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
 
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPending is the default value of the Status enum.
+const DefaultStatus = StatusPending
+
+// Status values.
+const (
+	StatusPending   Status = "pending"
+	StatusRunning   Status = "running"
+	StatusIngesting Status = "ingesting"
+	StatusGrouping  Status = "grouping"
+	StatusCompleted Status = "completed"
+	StatusPartial   Status = "partial"
+	StatusFailed    Status = "failed"
+	StatusCancelled Status = "cancelled"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusRunning, StatusIngesting, StatusGrouping, StatusCompleted, StatusPartial, StatusFailed, StatusCancelled:
+		return nil
+	default:
+		return fmt.Errorf("scan: invalid enum value for status field: %q", s)
+	}
+}
+
 // OrderOption defines the ordering options for the Scan queries.
 type OrderOption func(*sql.Selector)
 
@@ -154,6 +249,46 @@ func ByDuplicateGroupCount(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDuplicateGroupCount, opts...).ToFunc()
 }
 
+// ByFilesPrunedAt orders the results by the files_pruned_at field.
+func ByFilesPrunedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFilesPrunedAt, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByStatusMessage orders the results by the status_message field.
+func ByStatusMessage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatusMessage, opts...).ToFunc()
+}
+
+// ByFilesSeen orders the results by the files_seen field.
+func ByFilesSeen(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFilesSeen, opts...).ToFunc()
+}
+
+// ByBytesHashed orders the results by the bytes_hashed field.
+func ByBytesHashed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBytesHashed, opts...).ToFunc()
+}
+
+// ByMachinesReported orders the results by the machines_reported field.
+func ByMachinesReported(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMachinesReported, opts...).ToFunc()
+}
+
+// ByLastHeartbeatAt orders the results by the last_heartbeat_at field.
+func ByLastHeartbeatAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastHeartbeatAt, opts...).ToFunc()
+}
+
+// ByDeadline orders the results by the deadline field.
+func ByDeadline(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeadline, opts...).ToFunc()
+}
+
 // ByTenantField orders the results by tenant field.
 func ByTenantField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -181,6 +316,34 @@ func ByDuplicateGroups(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newDuplicateGroupsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByContentEventsCount orders the results by content_events count.
+func ByContentEventsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newContentEventsStep(), opts...)
+	}
+}
+
+// ByContentEvents orders the results by content_events terms.
+func ByContentEvents(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newContentEventsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTargetsCount orders the results by targets count.
+func ByTargetsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTargetsStep(), opts...)
+	}
+}
+
+// ByTargets orders the results by targets terms.
+func ByTargets(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTargetsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newTenantStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -202,3 +365,17 @@ func newDuplicateGroupsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, DuplicateGroupsTable, DuplicateGroupsColumn),
 	)
 }
+func newContentEventsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ContentEventsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ContentEventsTable, ContentEventsColumn),
+	)
+}
+func newTargetsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TargetsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TargetsTable, TargetsColumn),
+	)
+}
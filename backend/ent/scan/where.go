@@ -101,6 +101,41 @@ func DuplicateGroupCount(v int) predicate.Scan {
 	return predicate.Scan(sql.FieldEQ(FieldDuplicateGroupCount, v))
 }
 
+// FilesPrunedAt applies equality check predicate on the "files_pruned_at" field. It's identical to FilesPrunedAtEQ.
+func FilesPrunedAt(v time.Time) predicate.Scan {
+	return predicate.Scan(sql.FieldEQ(FieldFilesPrunedAt, v))
+}
+
+// StatusMessage applies equality check predicate on the "status_message" field. It's identical to StatusMessageEQ.
+func StatusMessage(v string) predicate.Scan {
+	return predicate.Scan(sql.FieldEQ(FieldStatusMessage, v))
+}
+
+// FilesSeen applies equality check predicate on the "files_seen" field. It's identical to FilesSeenEQ.
+func FilesSeen(v int) predicate.Scan {
+	return predicate.Scan(sql.FieldEQ(FieldFilesSeen, v))
+}
+
+// BytesHashed applies equality check predicate on the "bytes_hashed" field. It's identical to BytesHashedEQ.
+func BytesHashed(v int64) predicate.Scan {
+	return predicate.Scan(sql.FieldEQ(FieldBytesHashed, v))
+}
+
+// MachinesReported applies equality check predicate on the "machines_reported" field. It's identical to MachinesReportedEQ.
+func MachinesReported(v int) predicate.Scan {
+	return predicate.Scan(sql.FieldEQ(FieldMachinesReported, v))
+}
+
+// LastHeartbeatAt applies equality check predicate on the "last_heartbeat_at" field. It's identical to LastHeartbeatAtEQ.
+func LastHeartbeatAt(v time.Time) predicate.Scan {
+	return predicate.Scan(sql.FieldEQ(FieldLastHeartbeatAt, v))
+}
+
+// Deadline applies equality check predicate on the "deadline" field. It's identical to DeadlineEQ.
+func Deadline(v time.Time) predicate.Scan {
+	return predicate.Scan(sql.FieldEQ(FieldDeadline, v))
+}
+
 // CreateTimeEQ applies the EQ predicate on the "create_time" field.
 func CreateTimeEQ(v time.Time) predicate.Scan {
 	return predicate.Scan(sql.FieldEQ(FieldCreateTime, v))
@@ -501,6 +536,371 @@ func DuplicateGroupCountLTE(v int) predicate.Scan {
 	return predicate.Scan(sql.FieldLTE(FieldDuplicateGroupCount, v))
 }
 
+// FilesPrunedAtEQ applies the EQ predicate on the "files_pruned_at" field.
+func FilesPrunedAtEQ(v time.Time) predicate.Scan {
+	return predicate.Scan(sql.FieldEQ(FieldFilesPrunedAt, v))
+}
+
+// FilesPrunedAtNEQ applies the NEQ predicate on the "files_pruned_at" field.
+func FilesPrunedAtNEQ(v time.Time) predicate.Scan {
+	return predicate.Scan(sql.FieldNEQ(FieldFilesPrunedAt, v))
+}
+
+// FilesPrunedAtIn applies the In predicate on the "files_pruned_at" field.
+func FilesPrunedAtIn(vs ...time.Time) predicate.Scan {
+	return predicate.Scan(sql.FieldIn(FieldFilesPrunedAt, vs...))
+}
+
+// FilesPrunedAtNotIn applies the NotIn predicate on the "files_pruned_at" field.
+func FilesPrunedAtNotIn(vs ...time.Time) predicate.Scan {
+	return predicate.Scan(sql.FieldNotIn(FieldFilesPrunedAt, vs...))
+}
+
+// FilesPrunedAtGT applies the GT predicate on the "files_pruned_at" field.
+func FilesPrunedAtGT(v time.Time) predicate.Scan {
+	return predicate.Scan(sql.FieldGT(FieldFilesPrunedAt, v))
+}
+
+// FilesPrunedAtGTE applies the GTE predicate on the "files_pruned_at" field.
+func FilesPrunedAtGTE(v time.Time) predicate.Scan {
+	return predicate.Scan(sql.FieldGTE(FieldFilesPrunedAt, v))
+}
+
+// FilesPrunedAtLT applies the LT predicate on the "files_pruned_at" field.
+func FilesPrunedAtLT(v time.Time) predicate.Scan {
+	return predicate.Scan(sql.FieldLT(FieldFilesPrunedAt, v))
+}
+
+// FilesPrunedAtLTE applies the LTE predicate on the "files_pruned_at" field.
+func FilesPrunedAtLTE(v time.Time) predicate.Scan {
+	return predicate.Scan(sql.FieldLTE(FieldFilesPrunedAt, v))
+}
+
+// FilesPrunedAtIsNil applies the IsNil predicate on the "files_pruned_at" field.
+func FilesPrunedAtIsNil() predicate.Scan {
+	return predicate.Scan(sql.FieldIsNull(FieldFilesPrunedAt))
+}
+
+// FilesPrunedAtNotNil applies the NotNil predicate on the "files_pruned_at" field.
+func FilesPrunedAtNotNil() predicate.Scan {
+	return predicate.Scan(sql.FieldNotNull(FieldFilesPrunedAt))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Scan {
+	return predicate.Scan(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Scan {
+	return predicate.Scan(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Scan {
+	return predicate.Scan(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Scan {
+	return predicate.Scan(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusMessageEQ applies the EQ predicate on the "status_message" field.
+func StatusMessageEQ(v string) predicate.Scan {
+	return predicate.Scan(sql.FieldEQ(FieldStatusMessage, v))
+}
+
+// StatusMessageNEQ applies the NEQ predicate on the "status_message" field.
+func StatusMessageNEQ(v string) predicate.Scan {
+	return predicate.Scan(sql.FieldNEQ(FieldStatusMessage, v))
+}
+
+// StatusMessageIn applies the In predicate on the "status_message" field.
+func StatusMessageIn(vs ...string) predicate.Scan {
+	return predicate.Scan(sql.FieldIn(FieldStatusMessage, vs...))
+}
+
+// StatusMessageNotIn applies the NotIn predicate on the "status_message" field.
+func StatusMessageNotIn(vs ...string) predicate.Scan {
+	return predicate.Scan(sql.FieldNotIn(FieldStatusMessage, vs...))
+}
+
+// StatusMessageGT applies the GT predicate on the "status_message" field.
+func StatusMessageGT(v string) predicate.Scan {
+	return predicate.Scan(sql.FieldGT(FieldStatusMessage, v))
+}
+
+// StatusMessageGTE applies the GTE predicate on the "status_message" field.
+func StatusMessageGTE(v string) predicate.Scan {
+	return predicate.Scan(sql.FieldGTE(FieldStatusMessage, v))
+}
+
+// StatusMessageLT applies the LT predicate on the "status_message" field.
+func StatusMessageLT(v string) predicate.Scan {
+	return predicate.Scan(sql.FieldLT(FieldStatusMessage, v))
+}
+
+// StatusMessageLTE applies the LTE predicate on the "status_message" field.
+func StatusMessageLTE(v string) predicate.Scan {
+	return predicate.Scan(sql.FieldLTE(FieldStatusMessage, v))
+}
+
+// StatusMessageContains applies the Contains predicate on the "status_message" field.
+func StatusMessageContains(v string) predicate.Scan {
+	return predicate.Scan(sql.FieldContains(FieldStatusMessage, v))
+}
+
+// StatusMessageHasPrefix applies the HasPrefix predicate on the "status_message" field.
+func StatusMessageHasPrefix(v string) predicate.Scan {
+	return predicate.Scan(sql.FieldHasPrefix(FieldStatusMessage, v))
+}
+
+// StatusMessageHasSuffix applies the HasSuffix predicate on the "status_message" field.
+func StatusMessageHasSuffix(v string) predicate.Scan {
+	return predicate.Scan(sql.FieldHasSuffix(FieldStatusMessage, v))
+}
+
+// StatusMessageIsNil applies the IsNil predicate on the "status_message" field.
+func StatusMessageIsNil() predicate.Scan {
+	return predicate.Scan(sql.FieldIsNull(FieldStatusMessage))
+}
+
+// StatusMessageNotNil applies the NotNil predicate on the "status_message" field.
+func StatusMessageNotNil() predicate.Scan {
+	return predicate.Scan(sql.FieldNotNull(FieldStatusMessage))
+}
+
+// StatusMessageEqualFold applies the EqualFold predicate on the "status_message" field.
+func StatusMessageEqualFold(v string) predicate.Scan {
+	return predicate.Scan(sql.FieldEqualFold(FieldStatusMessage, v))
+}
+
+// StatusMessageContainsFold applies the ContainsFold predicate on the "status_message" field.
+func StatusMessageContainsFold(v string) predicate.Scan {
+	return predicate.Scan(sql.FieldContainsFold(FieldStatusMessage, v))
+}
+
+// FilesSeenEQ applies the EQ predicate on the "files_seen" field.
+func FilesSeenEQ(v int) predicate.Scan {
+	return predicate.Scan(sql.FieldEQ(FieldFilesSeen, v))
+}
+
+// FilesSeenNEQ applies the NEQ predicate on the "files_seen" field.
+func FilesSeenNEQ(v int) predicate.Scan {
+	return predicate.Scan(sql.FieldNEQ(FieldFilesSeen, v))
+}
+
+// FilesSeenIn applies the In predicate on the "files_seen" field.
+func FilesSeenIn(vs ...int) predicate.Scan {
+	return predicate.Scan(sql.FieldIn(FieldFilesSeen, vs...))
+}
+
+// FilesSeenNotIn applies the NotIn predicate on the "files_seen" field.
+func FilesSeenNotIn(vs ...int) predicate.Scan {
+	return predicate.Scan(sql.FieldNotIn(FieldFilesSeen, vs...))
+}
+
+// FilesSeenGT applies the GT predicate on the "files_seen" field.
+func FilesSeenGT(v int) predicate.Scan {
+	return predicate.Scan(sql.FieldGT(FieldFilesSeen, v))
+}
+
+// FilesSeenGTE applies the GTE predicate on the "files_seen" field.
+func FilesSeenGTE(v int) predicate.Scan {
+	return predicate.Scan(sql.FieldGTE(FieldFilesSeen, v))
+}
+
+// FilesSeenLT applies the LT predicate on the "files_seen" field.
+func FilesSeenLT(v int) predicate.Scan {
+	return predicate.Scan(sql.FieldLT(FieldFilesSeen, v))
+}
+
+// FilesSeenLTE applies the LTE predicate on the "files_seen" field.
+func FilesSeenLTE(v int) predicate.Scan {
+	return predicate.Scan(sql.FieldLTE(FieldFilesSeen, v))
+}
+
+// BytesHashedEQ applies the EQ predicate on the "bytes_hashed" field.
+func BytesHashedEQ(v int64) predicate.Scan {
+	return predicate.Scan(sql.FieldEQ(FieldBytesHashed, v))
+}
+
+// BytesHashedNEQ applies the NEQ predicate on the "bytes_hashed" field.
+func BytesHashedNEQ(v int64) predicate.Scan {
+	return predicate.Scan(sql.FieldNEQ(FieldBytesHashed, v))
+}
+
+// BytesHashedIn applies the In predicate on the "bytes_hashed" field.
+func BytesHashedIn(vs ...int64) predicate.Scan {
+	return predicate.Scan(sql.FieldIn(FieldBytesHashed, vs...))
+}
+
+// BytesHashedNotIn applies the NotIn predicate on the "bytes_hashed" field.
+func BytesHashedNotIn(vs ...int64) predicate.Scan {
+	return predicate.Scan(sql.FieldNotIn(FieldBytesHashed, vs...))
+}
+
+// BytesHashedGT applies the GT predicate on the "bytes_hashed" field.
+func BytesHashedGT(v int64) predicate.Scan {
+	return predicate.Scan(sql.FieldGT(FieldBytesHashed, v))
+}
+
+// BytesHashedGTE applies the GTE predicate on the "bytes_hashed" field.
+func BytesHashedGTE(v int64) predicate.Scan {
+	return predicate.Scan(sql.FieldGTE(FieldBytesHashed, v))
+}
+
+// BytesHashedLT applies the LT predicate on the "bytes_hashed" field.
+func BytesHashedLT(v int64) predicate.Scan {
+	return predicate.Scan(sql.FieldLT(FieldBytesHashed, v))
+}
+
+// BytesHashedLTE applies the LTE predicate on the "bytes_hashed" field.
+func BytesHashedLTE(v int64) predicate.Scan {
+	return predicate.Scan(sql.FieldLTE(FieldBytesHashed, v))
+}
+
+// MachinesReportedEQ applies the EQ predicate on the "machines_reported" field.
+func MachinesReportedEQ(v int) predicate.Scan {
+	return predicate.Scan(sql.FieldEQ(FieldMachinesReported, v))
+}
+
+// MachinesReportedNEQ applies the NEQ predicate on the "machines_reported" field.
+func MachinesReportedNEQ(v int) predicate.Scan {
+	return predicate.Scan(sql.FieldNEQ(FieldMachinesReported, v))
+}
+
+// MachinesReportedIn applies the In predicate on the "machines_reported" field.
+func MachinesReportedIn(vs ...int) predicate.Scan {
+	return predicate.Scan(sql.FieldIn(FieldMachinesReported, vs...))
+}
+
+// MachinesReportedNotIn applies the NotIn predicate on the "machines_reported" field.
+func MachinesReportedNotIn(vs ...int) predicate.Scan {
+	return predicate.Scan(sql.FieldNotIn(FieldMachinesReported, vs...))
+}
+
+// MachinesReportedGT applies the GT predicate on the "machines_reported" field.
+func MachinesReportedGT(v int) predicate.Scan {
+	return predicate.Scan(sql.FieldGT(FieldMachinesReported, v))
+}
+
+// MachinesReportedGTE applies the GTE predicate on the "machines_reported" field.
+func MachinesReportedGTE(v int) predicate.Scan {
+	return predicate.Scan(sql.FieldGTE(FieldMachinesReported, v))
+}
+
+// MachinesReportedLT applies the LT predicate on the "machines_reported" field.
+func MachinesReportedLT(v int) predicate.Scan {
+	return predicate.Scan(sql.FieldLT(FieldMachinesReported, v))
+}
+
+// MachinesReportedLTE applies the LTE predicate on the "machines_reported" field.
+func MachinesReportedLTE(v int) predicate.Scan {
+	return predicate.Scan(sql.FieldLTE(FieldMachinesReported, v))
+}
+
+// LastHeartbeatAtEQ applies the EQ predicate on the "last_heartbeat_at" field.
+func LastHeartbeatAtEQ(v time.Time) predicate.Scan {
+	return predicate.Scan(sql.FieldEQ(FieldLastHeartbeatAt, v))
+}
+
+// LastHeartbeatAtNEQ applies the NEQ predicate on the "last_heartbeat_at" field.
+func LastHeartbeatAtNEQ(v time.Time) predicate.Scan {
+	return predicate.Scan(sql.FieldNEQ(FieldLastHeartbeatAt, v))
+}
+
+// LastHeartbeatAtIn applies the In predicate on the "last_heartbeat_at" field.
+func LastHeartbeatAtIn(vs ...time.Time) predicate.Scan {
+	return predicate.Scan(sql.FieldIn(FieldLastHeartbeatAt, vs...))
+}
+
+// LastHeartbeatAtNotIn applies the NotIn predicate on the "last_heartbeat_at" field.
+func LastHeartbeatAtNotIn(vs ...time.Time) predicate.Scan {
+	return predicate.Scan(sql.FieldNotIn(FieldLastHeartbeatAt, vs...))
+}
+
+// LastHeartbeatAtGT applies the GT predicate on the "last_heartbeat_at" field.
+func LastHeartbeatAtGT(v time.Time) predicate.Scan {
+	return predicate.Scan(sql.FieldGT(FieldLastHeartbeatAt, v))
+}
+
+// LastHeartbeatAtGTE applies the GTE predicate on the "last_heartbeat_at" field.
+func LastHeartbeatAtGTE(v time.Time) predicate.Scan {
+	return predicate.Scan(sql.FieldGTE(FieldLastHeartbeatAt, v))
+}
+
+// LastHeartbeatAtLT applies the LT predicate on the "last_heartbeat_at" field.
+func LastHeartbeatAtLT(v time.Time) predicate.Scan {
+	return predicate.Scan(sql.FieldLT(FieldLastHeartbeatAt, v))
+}
+
+// LastHeartbeatAtLTE applies the LTE predicate on the "last_heartbeat_at" field.
+func LastHeartbeatAtLTE(v time.Time) predicate.Scan {
+	return predicate.Scan(sql.FieldLTE(FieldLastHeartbeatAt, v))
+}
+
+// LastHeartbeatAtIsNil applies the IsNil predicate on the "last_heartbeat_at" field.
+func LastHeartbeatAtIsNil() predicate.Scan {
+	return predicate.Scan(sql.FieldIsNull(FieldLastHeartbeatAt))
+}
+
+// LastHeartbeatAtNotNil applies the NotNil predicate on the "last_heartbeat_at" field.
+func LastHeartbeatAtNotNil() predicate.Scan {
+	return predicate.Scan(sql.FieldNotNull(FieldLastHeartbeatAt))
+}
+
+// DeadlineEQ applies the EQ predicate on the "deadline" field.
+func DeadlineEQ(v time.Time) predicate.Scan {
+	return predicate.Scan(sql.FieldEQ(FieldDeadline, v))
+}
+
+// DeadlineNEQ applies the NEQ predicate on the "deadline" field.
+func DeadlineNEQ(v time.Time) predicate.Scan {
+	return predicate.Scan(sql.FieldNEQ(FieldDeadline, v))
+}
+
+// DeadlineIn applies the In predicate on the "deadline" field.
+func DeadlineIn(vs ...time.Time) predicate.Scan {
+	return predicate.Scan(sql.FieldIn(FieldDeadline, vs...))
+}
+
+// DeadlineNotIn applies the NotIn predicate on the "deadline" field.
+func DeadlineNotIn(vs ...time.Time) predicate.Scan {
+	return predicate.Scan(sql.FieldNotIn(FieldDeadline, vs...))
+}
+
+// DeadlineGT applies the GT predicate on the "deadline" field.
+func DeadlineGT(v time.Time) predicate.Scan {
+	return predicate.Scan(sql.FieldGT(FieldDeadline, v))
+}
+
+// DeadlineGTE applies the GTE predicate on the "deadline" field.
+func DeadlineGTE(v time.Time) predicate.Scan {
+	return predicate.Scan(sql.FieldGTE(FieldDeadline, v))
+}
+
+// DeadlineLT applies the LT predicate on the "deadline" field.
+func DeadlineLT(v time.Time) predicate.Scan {
+	return predicate.Scan(sql.FieldLT(FieldDeadline, v))
+}
+
+// DeadlineLTE applies the LTE predicate on the "deadline" field.
+func DeadlineLTE(v time.Time) predicate.Scan {
+	return predicate.Scan(sql.FieldLTE(FieldDeadline, v))
+}
+
+// DeadlineIsNil applies the IsNil predicate on the "deadline" field.
+func DeadlineIsNil() predicate.Scan {
+	return predicate.Scan(sql.FieldIsNull(FieldDeadline))
+}
+
+// DeadlineNotNil applies the NotNil predicate on the "deadline" field.
+func DeadlineNotNil() predicate.Scan {
+	return predicate.Scan(sql.FieldNotNull(FieldDeadline))
+}
+
 // HasTenant applies the HasEdge predicate on the "tenant" edge.
 func HasTenant() predicate.Scan {
 	return predicate.Scan(func(s *sql.Selector) {
@@ -570,6 +970,52 @@ func HasDuplicateGroupsWith(preds ...predicate.DuplicateGroup) predicate.Scan {
 	})
 }
 
+// HasContentEvents applies the HasEdge predicate on the "content_events" edge.
+func HasContentEvents() predicate.Scan {
+	return predicate.Scan(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ContentEventsTable, ContentEventsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasContentEventsWith applies the HasEdge predicate on the "content_events" edge with a given conditions (other predicates).
+func HasContentEventsWith(preds ...predicate.ContentEvent) predicate.Scan {
+	return predicate.Scan(func(s *sql.Selector) {
+		step := newContentEventsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTargets applies the HasEdge predicate on the "targets" edge.
+func HasTargets() predicate.Scan {
+	return predicate.Scan(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TargetsTable, TargetsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTargetsWith applies the HasEdge predicate on the "targets" edge with a given conditions (other predicates).
+func HasTargetsWith(preds ...predicate.ScanTarget) predicate.Scan {
+	return predicate.Scan(func(s *sql.Selector) {
+		step := newTargetsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Scan) predicate.Scan {
 	return predicate.Scan(sql.AndPredicates(predicates...))
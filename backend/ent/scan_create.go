@@ -11,9 +11,11 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/mcmx/duplynx/ent/contentevent"
 	"github.com/mcmx/duplynx/ent/duplicategroup"
 	"github.com/mcmx/duplynx/ent/machine"
 	"github.com/mcmx/duplynx/ent/scan"
+	"github.com/mcmx/duplynx/ent/scantarget"
 	"github.com/mcmx/duplynx/ent/tenant"
 )
 
@@ -118,6 +120,118 @@ func (_c *ScanCreate) SetDuplicateGroupCount(v int) *ScanCreate {
 	return _c
 }
 
+// SetFilesPrunedAt sets the "files_pruned_at" field.
+func (_c *ScanCreate) SetFilesPrunedAt(v time.Time) *ScanCreate {
+	_c.mutation.SetFilesPrunedAt(v)
+	return _c
+}
+
+// SetNillableFilesPrunedAt sets the "files_pruned_at" field if the given value is not nil.
+func (_c *ScanCreate) SetNillableFilesPrunedAt(v *time.Time) *ScanCreate {
+	if v != nil {
+		_c.SetFilesPrunedAt(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ScanCreate) SetStatus(v scan.Status) *ScanCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ScanCreate) SetNillableStatus(v *scan.Status) *ScanCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetStatusMessage sets the "status_message" field.
+func (_c *ScanCreate) SetStatusMessage(v string) *ScanCreate {
+	_c.mutation.SetStatusMessage(v)
+	return _c
+}
+
+// SetNillableStatusMessage sets the "status_message" field if the given value is not nil.
+func (_c *ScanCreate) SetNillableStatusMessage(v *string) *ScanCreate {
+	if v != nil {
+		_c.SetStatusMessage(*v)
+	}
+	return _c
+}
+
+// SetFilesSeen sets the "files_seen" field.
+func (_c *ScanCreate) SetFilesSeen(v int) *ScanCreate {
+	_c.mutation.SetFilesSeen(v)
+	return _c
+}
+
+// SetNillableFilesSeen sets the "files_seen" field if the given value is not nil.
+func (_c *ScanCreate) SetNillableFilesSeen(v *int) *ScanCreate {
+	if v != nil {
+		_c.SetFilesSeen(*v)
+	}
+	return _c
+}
+
+// SetBytesHashed sets the "bytes_hashed" field.
+func (_c *ScanCreate) SetBytesHashed(v int64) *ScanCreate {
+	_c.mutation.SetBytesHashed(v)
+	return _c
+}
+
+// SetNillableBytesHashed sets the "bytes_hashed" field if the given value is not nil.
+func (_c *ScanCreate) SetNillableBytesHashed(v *int64) *ScanCreate {
+	if v != nil {
+		_c.SetBytesHashed(*v)
+	}
+	return _c
+}
+
+// SetMachinesReported sets the "machines_reported" field.
+func (_c *ScanCreate) SetMachinesReported(v int) *ScanCreate {
+	_c.mutation.SetMachinesReported(v)
+	return _c
+}
+
+// SetNillableMachinesReported sets the "machines_reported" field if the given value is not nil.
+func (_c *ScanCreate) SetNillableMachinesReported(v *int) *ScanCreate {
+	if v != nil {
+		_c.SetMachinesReported(*v)
+	}
+	return _c
+}
+
+// SetLastHeartbeatAt sets the "last_heartbeat_at" field.
+func (_c *ScanCreate) SetLastHeartbeatAt(v time.Time) *ScanCreate {
+	_c.mutation.SetLastHeartbeatAt(v)
+	return _c
+}
+
+// SetNillableLastHeartbeatAt sets the "last_heartbeat_at" field if the given value is not nil.
+func (_c *ScanCreate) SetNillableLastHeartbeatAt(v *time.Time) *ScanCreate {
+	if v != nil {
+		_c.SetLastHeartbeatAt(*v)
+	}
+	return _c
+}
+
+// SetDeadline sets the "deadline" field.
+func (_c *ScanCreate) SetDeadline(v time.Time) *ScanCreate {
+	_c.mutation.SetDeadline(v)
+	return _c
+}
+
+// SetNillableDeadline sets the "deadline" field if the given value is not nil.
+func (_c *ScanCreate) SetNillableDeadline(v *time.Time) *ScanCreate {
+	if v != nil {
+		_c.SetDeadline(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *ScanCreate) SetID(v uuid.UUID) *ScanCreate {
 	_c.mutation.SetID(v)
@@ -157,6 +271,36 @@ func (_c *ScanCreate) AddDuplicateGroups(v ...*DuplicateGroup) *ScanCreate {
 	return _c.AddDuplicateGroupIDs(ids...)
 }
 
+// AddContentEventIDs adds the "content_events" edge to the ContentEvent entity by IDs.
+func (_c *ScanCreate) AddContentEventIDs(ids ...uuid.UUID) *ScanCreate {
+	_c.mutation.AddContentEventIDs(ids...)
+	return _c
+}
+
+// AddContentEvents adds the "content_events" edges to the ContentEvent entity.
+func (_c *ScanCreate) AddContentEvents(v ...*ContentEvent) *ScanCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddContentEventIDs(ids...)
+}
+
+// AddTargetIDs adds the "targets" edge to the ScanTarget entity by IDs.
+func (_c *ScanCreate) AddTargetIDs(ids ...uuid.UUID) *ScanCreate {
+	_c.mutation.AddTargetIDs(ids...)
+	return _c
+}
+
+// AddTargets adds the "targets" edges to the ScanTarget entity.
+func (_c *ScanCreate) AddTargets(v ...*ScanTarget) *ScanCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTargetIDs(ids...)
+}
+
 // Mutation returns the ScanMutation object of the builder.
 func (_c *ScanCreate) Mutation() *ScanMutation {
 	return _c.mutation
@@ -164,7 +308,9 @@ func (_c *ScanCreate) Mutation() *ScanMutation {
 
 // Save creates the Scan in the database.
 func (_c *ScanCreate) Save(ctx context.Context) (*Scan, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -191,19 +337,45 @@ func (_c *ScanCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *ScanCreate) defaults() {
+func (_c *ScanCreate) defaults() error {
 	if _, ok := _c.mutation.CreateTime(); !ok {
+		if scan.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized scan.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
 		v := scan.DefaultCreateTime()
 		_c.mutation.SetCreateTime(v)
 	}
 	if _, ok := _c.mutation.UpdateTime(); !ok {
+		if scan.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized scan.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
 		v := scan.DefaultUpdateTime()
 		_c.mutation.SetUpdateTime(v)
 	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := scan.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.FilesSeen(); !ok {
+		v := scan.DefaultFilesSeen
+		_c.mutation.SetFilesSeen(v)
+	}
+	if _, ok := _c.mutation.BytesHashed(); !ok {
+		v := scan.DefaultBytesHashed
+		_c.mutation.SetBytesHashed(v)
+	}
+	if _, ok := _c.mutation.MachinesReported(); !ok {
+		v := scan.DefaultMachinesReported
+		_c.mutation.SetMachinesReported(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
+		if scan.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized scan.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := scan.DefaultID()
 		_c.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -231,6 +403,38 @@ func (_c *ScanCreate) check() error {
 			return &ValidationError{Name: "duplicate_group_count", err: fmt.Errorf(`ent: validator failed for field "Scan.duplicate_group_count": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Scan.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := scan.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Scan.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.FilesSeen(); !ok {
+		return &ValidationError{Name: "files_seen", err: errors.New(`ent: missing required field "Scan.files_seen"`)}
+	}
+	if v, ok := _c.mutation.FilesSeen(); ok {
+		if err := scan.FilesSeenValidator(v); err != nil {
+			return &ValidationError{Name: "files_seen", err: fmt.Errorf(`ent: validator failed for field "Scan.files_seen": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.BytesHashed(); !ok {
+		return &ValidationError{Name: "bytes_hashed", err: errors.New(`ent: missing required field "Scan.bytes_hashed"`)}
+	}
+	if v, ok := _c.mutation.BytesHashed(); ok {
+		if err := scan.BytesHashedValidator(v); err != nil {
+			return &ValidationError{Name: "bytes_hashed", err: fmt.Errorf(`ent: validator failed for field "Scan.bytes_hashed": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MachinesReported(); !ok {
+		return &ValidationError{Name: "machines_reported", err: errors.New(`ent: missing required field "Scan.machines_reported"`)}
+	}
+	if v, ok := _c.mutation.MachinesReported(); ok {
+		if err := scan.MachinesReportedValidator(v); err != nil {
+			return &ValidationError{Name: "machines_reported", err: fmt.Errorf(`ent: validator failed for field "Scan.machines_reported": %w`, err)}
+		}
+	}
 	if len(_c.mutation.TenantIDs()) == 0 {
 		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required edge "Scan.tenant"`)}
 	}
@@ -297,6 +501,38 @@ func (_c *ScanCreate) createSpec() (*Scan, *sqlgraph.CreateSpec) {
 		_spec.SetField(scan.FieldDuplicateGroupCount, field.TypeInt, value)
 		_node.DuplicateGroupCount = value
 	}
+	if value, ok := _c.mutation.FilesPrunedAt(); ok {
+		_spec.SetField(scan.FieldFilesPrunedAt, field.TypeTime, value)
+		_node.FilesPrunedAt = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(scan.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.StatusMessage(); ok {
+		_spec.SetField(scan.FieldStatusMessage, field.TypeString, value)
+		_node.StatusMessage = value
+	}
+	if value, ok := _c.mutation.FilesSeen(); ok {
+		_spec.SetField(scan.FieldFilesSeen, field.TypeInt, value)
+		_node.FilesSeen = value
+	}
+	if value, ok := _c.mutation.BytesHashed(); ok {
+		_spec.SetField(scan.FieldBytesHashed, field.TypeInt64, value)
+		_node.BytesHashed = value
+	}
+	if value, ok := _c.mutation.MachinesReported(); ok {
+		_spec.SetField(scan.FieldMachinesReported, field.TypeInt, value)
+		_node.MachinesReported = value
+	}
+	if value, ok := _c.mutation.LastHeartbeatAt(); ok {
+		_spec.SetField(scan.FieldLastHeartbeatAt, field.TypeTime, value)
+		_node.LastHeartbeatAt = value
+	}
+	if value, ok := _c.mutation.Deadline(); ok {
+		_spec.SetField(scan.FieldDeadline, field.TypeTime, value)
+		_node.Deadline = value
+	}
 	if nodes := _c.mutation.TenantIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -347,6 +583,38 @@ func (_c *ScanCreate) createSpec() (*Scan, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.ContentEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   scan.ContentEventsTable,
+			Columns: []string{scan.ContentEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TargetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   scan.TargetsTable,
+			Columns: []string{scan.TargetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scantarget.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -13,10 +13,12 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/mcmx/duplynx/ent/contentevent"
 	"github.com/mcmx/duplynx/ent/duplicategroup"
 	"github.com/mcmx/duplynx/ent/machine"
 	"github.com/mcmx/duplynx/ent/predicate"
 	"github.com/mcmx/duplynx/ent/scan"
+	"github.com/mcmx/duplynx/ent/scantarget"
 	"github.com/mcmx/duplynx/ent/tenant"
 )
 
@@ -30,6 +32,8 @@ type ScanQuery struct {
 	withTenant           *TenantQuery
 	withInitiatedMachine *MachineQuery
 	withDuplicateGroups  *DuplicateGroupQuery
+	withContentEvents    *ContentEventQuery
+	withTargets          *ScanTargetQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -132,6 +136,50 @@ func (_q *ScanQuery) QueryDuplicateGroups() *DuplicateGroupQuery {
 	return query
 }
 
+// QueryContentEvents chains the current query on the "content_events" edge.
+func (_q *ScanQuery) QueryContentEvents() *ContentEventQuery {
+	query := (&ContentEventClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(scan.Table, scan.FieldID, selector),
+			sqlgraph.To(contentevent.Table, contentevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, scan.ContentEventsTable, scan.ContentEventsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTargets chains the current query on the "targets" edge.
+func (_q *ScanQuery) QueryTargets() *ScanTargetQuery {
+	query := (&ScanTargetClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(scan.Table, scan.FieldID, selector),
+			sqlgraph.To(scantarget.Table, scantarget.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, scan.TargetsTable, scan.TargetsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Scan entity from the query.
 // Returns a *NotFoundError when no Scan was found.
 func (_q *ScanQuery) First(ctx context.Context) (*Scan, error) {
@@ -327,6 +375,8 @@ func (_q *ScanQuery) Clone() *ScanQuery {
 		withTenant:           _q.withTenant.Clone(),
 		withInitiatedMachine: _q.withInitiatedMachine.Clone(),
 		withDuplicateGroups:  _q.withDuplicateGroups.Clone(),
+		withContentEvents:    _q.withContentEvents.Clone(),
+		withTargets:          _q.withTargets.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -366,6 +416,28 @@ func (_q *ScanQuery) WithDuplicateGroups(opts ...func(*DuplicateGroupQuery)) *Sc
 	return _q
 }
 
+// WithContentEvents tells the query-builder to eager-load the nodes that are connected to
+// the "content_events" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ScanQuery) WithContentEvents(opts ...func(*ContentEventQuery)) *ScanQuery {
+	query := (&ContentEventClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withContentEvents = query
+	return _q
+}
+
+// WithTargets tells the query-builder to eager-load the nodes that are connected to
+// the "targets" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ScanQuery) WithTargets(opts ...func(*ScanTargetQuery)) *ScanQuery {
+	query := (&ScanTargetClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTargets = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -444,10 +516,12 @@ func (_q *ScanQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Scan, e
 	var (
 		nodes       = []*Scan{}
 		_spec       = _q.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [5]bool{
 			_q.withTenant != nil,
 			_q.withInitiatedMachine != nil,
 			_q.withDuplicateGroups != nil,
+			_q.withContentEvents != nil,
+			_q.withTargets != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -487,6 +561,20 @@ func (_q *ScanQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Scan, e
 			return nil, err
 		}
 	}
+	if query := _q.withContentEvents; query != nil {
+		if err := _q.loadContentEvents(ctx, query, nodes,
+			func(n *Scan) { n.Edges.ContentEvents = []*ContentEvent{} },
+			func(n *Scan, e *ContentEvent) { n.Edges.ContentEvents = append(n.Edges.ContentEvents, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withTargets; query != nil {
+		if err := _q.loadTargets(ctx, query, nodes,
+			func(n *Scan) { n.Edges.Targets = []*ScanTarget{} },
+			func(n *Scan, e *ScanTarget) { n.Edges.Targets = append(n.Edges.Targets, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -578,6 +666,66 @@ func (_q *ScanQuery) loadDuplicateGroups(ctx context.Context, query *DuplicateGr
 	}
 	return nil
 }
+func (_q *ScanQuery) loadContentEvents(ctx context.Context, query *ContentEventQuery, nodes []*Scan, init func(*Scan), assign func(*Scan, *ContentEvent)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Scan)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(contentevent.FieldScanID)
+	}
+	query.Where(predicate.ContentEvent(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(scan.ContentEventsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ScanID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "scan_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *ScanQuery) loadTargets(ctx context.Context, query *ScanTargetQuery, nodes []*Scan, init func(*Scan), assign func(*Scan, *ScanTarget)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Scan)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(scantarget.FieldScanID)
+	}
+	query.Where(predicate.ScanTarget(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(scan.TargetsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ScanID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "scan_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *ScanQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
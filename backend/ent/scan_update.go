@@ -12,10 +12,12 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/mcmx/duplynx/ent/contentevent"
 	"github.com/mcmx/duplynx/ent/duplicategroup"
 	"github.com/mcmx/duplynx/ent/machine"
 	"github.com/mcmx/duplynx/ent/predicate"
 	"github.com/mcmx/duplynx/ent/scan"
+	"github.com/mcmx/duplynx/ent/scantarget"
 	"github.com/mcmx/duplynx/ent/tenant"
 )
 
@@ -161,6 +163,163 @@ func (_u *ScanUpdate) AddDuplicateGroupCount(v int) *ScanUpdate {
 	return _u
 }
 
+// SetFilesPrunedAt sets the "files_pruned_at" field.
+func (_u *ScanUpdate) SetFilesPrunedAt(v time.Time) *ScanUpdate {
+	_u.mutation.SetFilesPrunedAt(v)
+	return _u
+}
+
+// SetNillableFilesPrunedAt sets the "files_pruned_at" field if the given value is not nil.
+func (_u *ScanUpdate) SetNillableFilesPrunedAt(v *time.Time) *ScanUpdate {
+	if v != nil {
+		_u.SetFilesPrunedAt(*v)
+	}
+	return _u
+}
+
+// ClearFilesPrunedAt clears the value of the "files_pruned_at" field.
+func (_u *ScanUpdate) ClearFilesPrunedAt() *ScanUpdate {
+	_u.mutation.ClearFilesPrunedAt()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ScanUpdate) SetStatus(v scan.Status) *ScanUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ScanUpdate) SetNillableStatus(v *scan.Status) *ScanUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetStatusMessage sets the "status_message" field.
+func (_u *ScanUpdate) SetStatusMessage(v string) *ScanUpdate {
+	_u.mutation.SetStatusMessage(v)
+	return _u
+}
+
+// SetNillableStatusMessage sets the "status_message" field if the given value is not nil.
+func (_u *ScanUpdate) SetNillableStatusMessage(v *string) *ScanUpdate {
+	if v != nil {
+		_u.SetStatusMessage(*v)
+	}
+	return _u
+}
+
+// ClearStatusMessage clears the value of the "status_message" field.
+func (_u *ScanUpdate) ClearStatusMessage() *ScanUpdate {
+	_u.mutation.ClearStatusMessage()
+	return _u
+}
+
+// SetFilesSeen sets the "files_seen" field.
+func (_u *ScanUpdate) SetFilesSeen(v int) *ScanUpdate {
+	_u.mutation.ResetFilesSeen()
+	_u.mutation.SetFilesSeen(v)
+	return _u
+}
+
+// SetNillableFilesSeen sets the "files_seen" field if the given value is not nil.
+func (_u *ScanUpdate) SetNillableFilesSeen(v *int) *ScanUpdate {
+	if v != nil {
+		_u.SetFilesSeen(*v)
+	}
+	return _u
+}
+
+// AddFilesSeen adds value to the "files_seen" field.
+func (_u *ScanUpdate) AddFilesSeen(v int) *ScanUpdate {
+	_u.mutation.AddFilesSeen(v)
+	return _u
+}
+
+// SetBytesHashed sets the "bytes_hashed" field.
+func (_u *ScanUpdate) SetBytesHashed(v int64) *ScanUpdate {
+	_u.mutation.ResetBytesHashed()
+	_u.mutation.SetBytesHashed(v)
+	return _u
+}
+
+// SetNillableBytesHashed sets the "bytes_hashed" field if the given value is not nil.
+func (_u *ScanUpdate) SetNillableBytesHashed(v *int64) *ScanUpdate {
+	if v != nil {
+		_u.SetBytesHashed(*v)
+	}
+	return _u
+}
+
+// AddBytesHashed adds value to the "bytes_hashed" field.
+func (_u *ScanUpdate) AddBytesHashed(v int64) *ScanUpdate {
+	_u.mutation.AddBytesHashed(v)
+	return _u
+}
+
+// SetMachinesReported sets the "machines_reported" field.
+func (_u *ScanUpdate) SetMachinesReported(v int) *ScanUpdate {
+	_u.mutation.ResetMachinesReported()
+	_u.mutation.SetMachinesReported(v)
+	return _u
+}
+
+// SetNillableMachinesReported sets the "machines_reported" field if the given value is not nil.
+func (_u *ScanUpdate) SetNillableMachinesReported(v *int) *ScanUpdate {
+	if v != nil {
+		_u.SetMachinesReported(*v)
+	}
+	return _u
+}
+
+// AddMachinesReported adds value to the "machines_reported" field.
+func (_u *ScanUpdate) AddMachinesReported(v int) *ScanUpdate {
+	_u.mutation.AddMachinesReported(v)
+	return _u
+}
+
+// SetLastHeartbeatAt sets the "last_heartbeat_at" field.
+func (_u *ScanUpdate) SetLastHeartbeatAt(v time.Time) *ScanUpdate {
+	_u.mutation.SetLastHeartbeatAt(v)
+	return _u
+}
+
+// SetNillableLastHeartbeatAt sets the "last_heartbeat_at" field if the given value is not nil.
+func (_u *ScanUpdate) SetNillableLastHeartbeatAt(v *time.Time) *ScanUpdate {
+	if v != nil {
+		_u.SetLastHeartbeatAt(*v)
+	}
+	return _u
+}
+
+// ClearLastHeartbeatAt clears the value of the "last_heartbeat_at" field.
+func (_u *ScanUpdate) ClearLastHeartbeatAt() *ScanUpdate {
+	_u.mutation.ClearLastHeartbeatAt()
+	return _u
+}
+
+// SetDeadline sets the "deadline" field.
+func (_u *ScanUpdate) SetDeadline(v time.Time) *ScanUpdate {
+	_u.mutation.SetDeadline(v)
+	return _u
+}
+
+// SetNillableDeadline sets the "deadline" field if the given value is not nil.
+func (_u *ScanUpdate) SetNillableDeadline(v *time.Time) *ScanUpdate {
+	if v != nil {
+		_u.SetDeadline(*v)
+	}
+	return _u
+}
+
+// ClearDeadline clears the value of the "deadline" field.
+func (_u *ScanUpdate) ClearDeadline() *ScanUpdate {
+	_u.mutation.ClearDeadline()
+	return _u
+}
+
 // SetTenant sets the "tenant" edge to the Tenant entity.
 func (_u *ScanUpdate) SetTenant(v *Tenant) *ScanUpdate {
 	return _u.SetTenantID(v.ID)
@@ -186,6 +345,36 @@ func (_u *ScanUpdate) AddDuplicateGroups(v ...*DuplicateGroup) *ScanUpdate {
 	return _u.AddDuplicateGroupIDs(ids...)
 }
 
+// AddContentEventIDs adds the "content_events" edge to the ContentEvent entity by IDs.
+func (_u *ScanUpdate) AddContentEventIDs(ids ...uuid.UUID) *ScanUpdate {
+	_u.mutation.AddContentEventIDs(ids...)
+	return _u
+}
+
+// AddContentEvents adds the "content_events" edges to the ContentEvent entity.
+func (_u *ScanUpdate) AddContentEvents(v ...*ContentEvent) *ScanUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddContentEventIDs(ids...)
+}
+
+// AddTargetIDs adds the "targets" edge to the ScanTarget entity by IDs.
+func (_u *ScanUpdate) AddTargetIDs(ids ...uuid.UUID) *ScanUpdate {
+	_u.mutation.AddTargetIDs(ids...)
+	return _u
+}
+
+// AddTargets adds the "targets" edges to the ScanTarget entity.
+func (_u *ScanUpdate) AddTargets(v ...*ScanTarget) *ScanUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTargetIDs(ids...)
+}
+
 // Mutation returns the ScanMutation object of the builder.
 func (_u *ScanUpdate) Mutation() *ScanMutation {
 	return _u.mutation
@@ -224,9 +413,53 @@ func (_u *ScanUpdate) RemoveDuplicateGroups(v ...*DuplicateGroup) *ScanUpdate {
 	return _u.RemoveDuplicateGroupIDs(ids...)
 }
 
+// ClearContentEvents clears all "content_events" edges to the ContentEvent entity.
+func (_u *ScanUpdate) ClearContentEvents() *ScanUpdate {
+	_u.mutation.ClearContentEvents()
+	return _u
+}
+
+// RemoveContentEventIDs removes the "content_events" edge to ContentEvent entities by IDs.
+func (_u *ScanUpdate) RemoveContentEventIDs(ids ...uuid.UUID) *ScanUpdate {
+	_u.mutation.RemoveContentEventIDs(ids...)
+	return _u
+}
+
+// RemoveContentEvents removes "content_events" edges to ContentEvent entities.
+func (_u *ScanUpdate) RemoveContentEvents(v ...*ContentEvent) *ScanUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveContentEventIDs(ids...)
+}
+
+// ClearTargets clears all "targets" edges to the ScanTarget entity.
+func (_u *ScanUpdate) ClearTargets() *ScanUpdate {
+	_u.mutation.ClearTargets()
+	return _u
+}
+
+// RemoveTargetIDs removes the "targets" edge to ScanTarget entities by IDs.
+func (_u *ScanUpdate) RemoveTargetIDs(ids ...uuid.UUID) *ScanUpdate {
+	_u.mutation.RemoveTargetIDs(ids...)
+	return _u
+}
+
+// RemoveTargets removes "targets" edges to ScanTarget entities.
+func (_u *ScanUpdate) RemoveTargets(v ...*ScanTarget) *ScanUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTargetIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *ScanUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -253,11 +486,15 @@ func (_u *ScanUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *ScanUpdate) defaults() {
+func (_u *ScanUpdate) defaults() error {
 	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if scan.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized scan.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
 		v := scan.UpdateDefaultUpdateTime()
 		_u.mutation.SetUpdateTime(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -267,6 +504,26 @@ func (_u *ScanUpdate) check() error {
 			return &ValidationError{Name: "duplicate_group_count", err: fmt.Errorf(`ent: validator failed for field "Scan.duplicate_group_count": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := scan.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Scan.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FilesSeen(); ok {
+		if err := scan.FilesSeenValidator(v); err != nil {
+			return &ValidationError{Name: "files_seen", err: fmt.Errorf(`ent: validator failed for field "Scan.files_seen": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BytesHashed(); ok {
+		if err := scan.BytesHashedValidator(v); err != nil {
+			return &ValidationError{Name: "bytes_hashed", err: fmt.Errorf(`ent: validator failed for field "Scan.bytes_hashed": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MachinesReported(); ok {
+		if err := scan.MachinesReportedValidator(v); err != nil {
+			return &ValidationError{Name: "machines_reported", err: fmt.Errorf(`ent: validator failed for field "Scan.machines_reported": %w`, err)}
+		}
+	}
 	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Scan.tenant"`)
 	}
@@ -312,6 +569,51 @@ func (_u *ScanUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.AddedDuplicateGroupCount(); ok {
 		_spec.AddField(scan.FieldDuplicateGroupCount, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.FilesPrunedAt(); ok {
+		_spec.SetField(scan.FieldFilesPrunedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FilesPrunedAtCleared() {
+		_spec.ClearField(scan.FieldFilesPrunedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(scan.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.StatusMessage(); ok {
+		_spec.SetField(scan.FieldStatusMessage, field.TypeString, value)
+	}
+	if _u.mutation.StatusMessageCleared() {
+		_spec.ClearField(scan.FieldStatusMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.FilesSeen(); ok {
+		_spec.SetField(scan.FieldFilesSeen, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFilesSeen(); ok {
+		_spec.AddField(scan.FieldFilesSeen, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BytesHashed(); ok {
+		_spec.SetField(scan.FieldBytesHashed, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedBytesHashed(); ok {
+		_spec.AddField(scan.FieldBytesHashed, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.MachinesReported(); ok {
+		_spec.SetField(scan.FieldMachinesReported, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMachinesReported(); ok {
+		_spec.AddField(scan.FieldMachinesReported, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastHeartbeatAt(); ok {
+		_spec.SetField(scan.FieldLastHeartbeatAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastHeartbeatAtCleared() {
+		_spec.ClearField(scan.FieldLastHeartbeatAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Deadline(); ok {
+		_spec.SetField(scan.FieldDeadline, field.TypeTime, value)
+	}
+	if _u.mutation.DeadlineCleared() {
+		_spec.ClearField(scan.FieldDeadline, field.TypeTime)
+	}
 	if _u.mutation.TenantCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -415,6 +717,96 @@ func (_u *ScanUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ContentEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   scan.ContentEventsTable,
+			Columns: []string{scan.ContentEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentevent.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedContentEventsIDs(); len(nodes) > 0 && !_u.mutation.ContentEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   scan.ContentEventsTable,
+			Columns: []string{scan.ContentEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ContentEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   scan.ContentEventsTable,
+			Columns: []string{scan.ContentEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TargetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   scan.TargetsTable,
+			Columns: []string{scan.TargetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scantarget.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTargetsIDs(); len(nodes) > 0 && !_u.mutation.TargetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   scan.TargetsTable,
+			Columns: []string{scan.TargetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scantarget.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TargetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   scan.TargetsTable,
+			Columns: []string{scan.TargetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scantarget.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{scan.Label}
@@ -564,6 +956,163 @@ func (_u *ScanUpdateOne) AddDuplicateGroupCount(v int) *ScanUpdateOne {
 	return _u
 }
 
+// SetFilesPrunedAt sets the "files_pruned_at" field.
+func (_u *ScanUpdateOne) SetFilesPrunedAt(v time.Time) *ScanUpdateOne {
+	_u.mutation.SetFilesPrunedAt(v)
+	return _u
+}
+
+// SetNillableFilesPrunedAt sets the "files_pruned_at" field if the given value is not nil.
+func (_u *ScanUpdateOne) SetNillableFilesPrunedAt(v *time.Time) *ScanUpdateOne {
+	if v != nil {
+		_u.SetFilesPrunedAt(*v)
+	}
+	return _u
+}
+
+// ClearFilesPrunedAt clears the value of the "files_pruned_at" field.
+func (_u *ScanUpdateOne) ClearFilesPrunedAt() *ScanUpdateOne {
+	_u.mutation.ClearFilesPrunedAt()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ScanUpdateOne) SetStatus(v scan.Status) *ScanUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ScanUpdateOne) SetNillableStatus(v *scan.Status) *ScanUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetStatusMessage sets the "status_message" field.
+func (_u *ScanUpdateOne) SetStatusMessage(v string) *ScanUpdateOne {
+	_u.mutation.SetStatusMessage(v)
+	return _u
+}
+
+// SetNillableStatusMessage sets the "status_message" field if the given value is not nil.
+func (_u *ScanUpdateOne) SetNillableStatusMessage(v *string) *ScanUpdateOne {
+	if v != nil {
+		_u.SetStatusMessage(*v)
+	}
+	return _u
+}
+
+// ClearStatusMessage clears the value of the "status_message" field.
+func (_u *ScanUpdateOne) ClearStatusMessage() *ScanUpdateOne {
+	_u.mutation.ClearStatusMessage()
+	return _u
+}
+
+// SetFilesSeen sets the "files_seen" field.
+func (_u *ScanUpdateOne) SetFilesSeen(v int) *ScanUpdateOne {
+	_u.mutation.ResetFilesSeen()
+	_u.mutation.SetFilesSeen(v)
+	return _u
+}
+
+// SetNillableFilesSeen sets the "files_seen" field if the given value is not nil.
+func (_u *ScanUpdateOne) SetNillableFilesSeen(v *int) *ScanUpdateOne {
+	if v != nil {
+		_u.SetFilesSeen(*v)
+	}
+	return _u
+}
+
+// AddFilesSeen adds value to the "files_seen" field.
+func (_u *ScanUpdateOne) AddFilesSeen(v int) *ScanUpdateOne {
+	_u.mutation.AddFilesSeen(v)
+	return _u
+}
+
+// SetBytesHashed sets the "bytes_hashed" field.
+func (_u *ScanUpdateOne) SetBytesHashed(v int64) *ScanUpdateOne {
+	_u.mutation.ResetBytesHashed()
+	_u.mutation.SetBytesHashed(v)
+	return _u
+}
+
+// SetNillableBytesHashed sets the "bytes_hashed" field if the given value is not nil.
+func (_u *ScanUpdateOne) SetNillableBytesHashed(v *int64) *ScanUpdateOne {
+	if v != nil {
+		_u.SetBytesHashed(*v)
+	}
+	return _u
+}
+
+// AddBytesHashed adds value to the "bytes_hashed" field.
+func (_u *ScanUpdateOne) AddBytesHashed(v int64) *ScanUpdateOne {
+	_u.mutation.AddBytesHashed(v)
+	return _u
+}
+
+// SetMachinesReported sets the "machines_reported" field.
+func (_u *ScanUpdateOne) SetMachinesReported(v int) *ScanUpdateOne {
+	_u.mutation.ResetMachinesReported()
+	_u.mutation.SetMachinesReported(v)
+	return _u
+}
+
+// SetNillableMachinesReported sets the "machines_reported" field if the given value is not nil.
+func (_u *ScanUpdateOne) SetNillableMachinesReported(v *int) *ScanUpdateOne {
+	if v != nil {
+		_u.SetMachinesReported(*v)
+	}
+	return _u
+}
+
+// AddMachinesReported adds value to the "machines_reported" field.
+func (_u *ScanUpdateOne) AddMachinesReported(v int) *ScanUpdateOne {
+	_u.mutation.AddMachinesReported(v)
+	return _u
+}
+
+// SetLastHeartbeatAt sets the "last_heartbeat_at" field.
+func (_u *ScanUpdateOne) SetLastHeartbeatAt(v time.Time) *ScanUpdateOne {
+	_u.mutation.SetLastHeartbeatAt(v)
+	return _u
+}
+
+// SetNillableLastHeartbeatAt sets the "last_heartbeat_at" field if the given value is not nil.
+func (_u *ScanUpdateOne) SetNillableLastHeartbeatAt(v *time.Time) *ScanUpdateOne {
+	if v != nil {
+		_u.SetLastHeartbeatAt(*v)
+	}
+	return _u
+}
+
+// ClearLastHeartbeatAt clears the value of the "last_heartbeat_at" field.
+func (_u *ScanUpdateOne) ClearLastHeartbeatAt() *ScanUpdateOne {
+	_u.mutation.ClearLastHeartbeatAt()
+	return _u
+}
+
+// SetDeadline sets the "deadline" field.
+func (_u *ScanUpdateOne) SetDeadline(v time.Time) *ScanUpdateOne {
+	_u.mutation.SetDeadline(v)
+	return _u
+}
+
+// SetNillableDeadline sets the "deadline" field if the given value is not nil.
+func (_u *ScanUpdateOne) SetNillableDeadline(v *time.Time) *ScanUpdateOne {
+	if v != nil {
+		_u.SetDeadline(*v)
+	}
+	return _u
+}
+
+// ClearDeadline clears the value of the "deadline" field.
+func (_u *ScanUpdateOne) ClearDeadline() *ScanUpdateOne {
+	_u.mutation.ClearDeadline()
+	return _u
+}
+
 // SetTenant sets the "tenant" edge to the Tenant entity.
 func (_u *ScanUpdateOne) SetTenant(v *Tenant) *ScanUpdateOne {
 	return _u.SetTenantID(v.ID)
@@ -589,6 +1138,36 @@ func (_u *ScanUpdateOne) AddDuplicateGroups(v ...*DuplicateGroup) *ScanUpdateOne
 	return _u.AddDuplicateGroupIDs(ids...)
 }
 
+// AddContentEventIDs adds the "content_events" edge to the ContentEvent entity by IDs.
+func (_u *ScanUpdateOne) AddContentEventIDs(ids ...uuid.UUID) *ScanUpdateOne {
+	_u.mutation.AddContentEventIDs(ids...)
+	return _u
+}
+
+// AddContentEvents adds the "content_events" edges to the ContentEvent entity.
+func (_u *ScanUpdateOne) AddContentEvents(v ...*ContentEvent) *ScanUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddContentEventIDs(ids...)
+}
+
+// AddTargetIDs adds the "targets" edge to the ScanTarget entity by IDs.
+func (_u *ScanUpdateOne) AddTargetIDs(ids ...uuid.UUID) *ScanUpdateOne {
+	_u.mutation.AddTargetIDs(ids...)
+	return _u
+}
+
+// AddTargets adds the "targets" edges to the ScanTarget entity.
+func (_u *ScanUpdateOne) AddTargets(v ...*ScanTarget) *ScanUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTargetIDs(ids...)
+}
+
 // Mutation returns the ScanMutation object of the builder.
 func (_u *ScanUpdateOne) Mutation() *ScanMutation {
 	return _u.mutation
@@ -627,6 +1206,48 @@ func (_u *ScanUpdateOne) RemoveDuplicateGroups(v ...*DuplicateGroup) *ScanUpdate
 	return _u.RemoveDuplicateGroupIDs(ids...)
 }
 
+// ClearContentEvents clears all "content_events" edges to the ContentEvent entity.
+func (_u *ScanUpdateOne) ClearContentEvents() *ScanUpdateOne {
+	_u.mutation.ClearContentEvents()
+	return _u
+}
+
+// RemoveContentEventIDs removes the "content_events" edge to ContentEvent entities by IDs.
+func (_u *ScanUpdateOne) RemoveContentEventIDs(ids ...uuid.UUID) *ScanUpdateOne {
+	_u.mutation.RemoveContentEventIDs(ids...)
+	return _u
+}
+
+// RemoveContentEvents removes "content_events" edges to ContentEvent entities.
+func (_u *ScanUpdateOne) RemoveContentEvents(v ...*ContentEvent) *ScanUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveContentEventIDs(ids...)
+}
+
+// ClearTargets clears all "targets" edges to the ScanTarget entity.
+func (_u *ScanUpdateOne) ClearTargets() *ScanUpdateOne {
+	_u.mutation.ClearTargets()
+	return _u
+}
+
+// RemoveTargetIDs removes the "targets" edge to ScanTarget entities by IDs.
+func (_u *ScanUpdateOne) RemoveTargetIDs(ids ...uuid.UUID) *ScanUpdateOne {
+	_u.mutation.RemoveTargetIDs(ids...)
+	return _u
+}
+
+// RemoveTargets removes "targets" edges to ScanTarget entities.
+func (_u *ScanUpdateOne) RemoveTargets(v ...*ScanTarget) *ScanUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTargetIDs(ids...)
+}
+
 // Where appends a list predicates to the ScanUpdate builder.
 func (_u *ScanUpdateOne) Where(ps ...predicate.Scan) *ScanUpdateOne {
 	_u.mutation.Where(ps...)
@@ -642,7 +1263,9 @@ func (_u *ScanUpdateOne) Select(field string, fields ...string) *ScanUpdateOne {
 
 // Save executes the query and returns the updated Scan entity.
 func (_u *ScanUpdateOne) Save(ctx context.Context) (*Scan, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -669,11 +1292,15 @@ func (_u *ScanUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *ScanUpdateOne) defaults() {
+func (_u *ScanUpdateOne) defaults() error {
 	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if scan.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized scan.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
 		v := scan.UpdateDefaultUpdateTime()
 		_u.mutation.SetUpdateTime(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -683,6 +1310,26 @@ func (_u *ScanUpdateOne) check() error {
 			return &ValidationError{Name: "duplicate_group_count", err: fmt.Errorf(`ent: validator failed for field "Scan.duplicate_group_count": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := scan.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Scan.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FilesSeen(); ok {
+		if err := scan.FilesSeenValidator(v); err != nil {
+			return &ValidationError{Name: "files_seen", err: fmt.Errorf(`ent: validator failed for field "Scan.files_seen": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BytesHashed(); ok {
+		if err := scan.BytesHashedValidator(v); err != nil {
+			return &ValidationError{Name: "bytes_hashed", err: fmt.Errorf(`ent: validator failed for field "Scan.bytes_hashed": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MachinesReported(); ok {
+		if err := scan.MachinesReportedValidator(v); err != nil {
+			return &ValidationError{Name: "machines_reported", err: fmt.Errorf(`ent: validator failed for field "Scan.machines_reported": %w`, err)}
+		}
+	}
 	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Scan.tenant"`)
 	}
@@ -745,6 +1392,51 @@ func (_u *ScanUpdateOne) sqlSave(ctx context.Context) (_node *Scan, err error) {
 	if value, ok := _u.mutation.AddedDuplicateGroupCount(); ok {
 		_spec.AddField(scan.FieldDuplicateGroupCount, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.FilesPrunedAt(); ok {
+		_spec.SetField(scan.FieldFilesPrunedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FilesPrunedAtCleared() {
+		_spec.ClearField(scan.FieldFilesPrunedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(scan.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.StatusMessage(); ok {
+		_spec.SetField(scan.FieldStatusMessage, field.TypeString, value)
+	}
+	if _u.mutation.StatusMessageCleared() {
+		_spec.ClearField(scan.FieldStatusMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.FilesSeen(); ok {
+		_spec.SetField(scan.FieldFilesSeen, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFilesSeen(); ok {
+		_spec.AddField(scan.FieldFilesSeen, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BytesHashed(); ok {
+		_spec.SetField(scan.FieldBytesHashed, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedBytesHashed(); ok {
+		_spec.AddField(scan.FieldBytesHashed, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.MachinesReported(); ok {
+		_spec.SetField(scan.FieldMachinesReported, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMachinesReported(); ok {
+		_spec.AddField(scan.FieldMachinesReported, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastHeartbeatAt(); ok {
+		_spec.SetField(scan.FieldLastHeartbeatAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastHeartbeatAtCleared() {
+		_spec.ClearField(scan.FieldLastHeartbeatAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Deadline(); ok {
+		_spec.SetField(scan.FieldDeadline, field.TypeTime, value)
+	}
+	if _u.mutation.DeadlineCleared() {
+		_spec.ClearField(scan.FieldDeadline, field.TypeTime)
+	}
 	if _u.mutation.TenantCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -848,6 +1540,96 @@ func (_u *ScanUpdateOne) sqlSave(ctx context.Context) (_node *Scan, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ContentEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   scan.ContentEventsTable,
+			Columns: []string{scan.ContentEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentevent.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedContentEventsIDs(); len(nodes) > 0 && !_u.mutation.ContentEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   scan.ContentEventsTable,
+			Columns: []string{scan.ContentEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ContentEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   scan.ContentEventsTable,
+			Columns: []string{scan.ContentEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TargetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   scan.TargetsTable,
+			Columns: []string{scan.TargetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scantarget.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTargetsIDs(); len(nodes) > 0 && !_u.mutation.TargetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   scan.TargetsTable,
+			Columns: []string{scan.TargetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scantarget.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TargetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   scan.TargetsTable,
+			Columns: []string{scan.TargetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scantarget.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Scan{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
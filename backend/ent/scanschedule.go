@@ -0,0 +1,308 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/mcmx/duplynx/ent/scanschedule"
+	"github.com/mcmx/duplynx/ent/tenant"
+)
+
+// ScanSchedule is the model entity for the ScanSchedule schema.
+type ScanSchedule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Cron holds the value of the "cron" field.
+	Cron string `json:"cron,omitempty"`
+	// Timezone holds the value of the "timezone" field.
+	Timezone string `json:"timezone,omitempty"`
+	// MachineIds holds the value of the "machine_ids" field.
+	MachineIds []string `json:"machine_ids,omitempty"`
+	// Roots holds the value of the "roots" field.
+	Roots []string `json:"roots,omitempty"`
+	// Exclusions holds the value of the "exclusions" field.
+	Exclusions []string `json:"exclusions,omitempty"`
+	// DeadlineSeconds holds the value of the "deadline_seconds" field.
+	DeadlineSeconds int `json:"deadline_seconds,omitempty"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+	// NextRunAt holds the value of the "next_run_at" field.
+	NextRunAt time.Time `json:"next_run_at,omitempty"`
+	// LastRunAt holds the value of the "last_run_at" field.
+	LastRunAt time.Time `json:"last_run_at,omitempty"`
+	// LastScanID holds the value of the "last_scan_id" field.
+	LastScanID uuid.UUID `json:"last_scan_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ScanScheduleQuery when eager-loading is set.
+	Edges        ScanScheduleEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ScanScheduleEdges holds the relations/edges for other nodes in the graph.
+type ScanScheduleEdges struct {
+	// Tenant holds the value of the tenant edge.
+	Tenant *Tenant `json:"tenant,omitempty"`
+	// Runs holds the value of the runs edge.
+	Runs []*ScanScheduleRun `json:"runs,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// TenantOrErr returns the Tenant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ScanScheduleEdges) TenantOrErr() (*Tenant, error) {
+	if e.Tenant != nil {
+		return e.Tenant, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: tenant.Label}
+	}
+	return nil, &NotLoadedError{edge: "tenant"}
+}
+
+// RunsOrErr returns the Runs value or an error if the edge
+// was not loaded in eager-loading.
+func (e ScanScheduleEdges) RunsOrErr() ([]*ScanScheduleRun, error) {
+	if e.loadedTypes[1] {
+		return e.Runs, nil
+	}
+	return nil, &NotLoadedError{edge: "runs"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ScanSchedule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case scanschedule.FieldMachineIds, scanschedule.FieldRoots, scanschedule.FieldExclusions:
+			values[i] = new([]byte)
+		case scanschedule.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case scanschedule.FieldDeadlineSeconds:
+			values[i] = new(sql.NullInt64)
+		case scanschedule.FieldName, scanschedule.FieldCron, scanschedule.FieldTimezone:
+			values[i] = new(sql.NullString)
+		case scanschedule.FieldCreateTime, scanschedule.FieldUpdateTime, scanschedule.FieldNextRunAt, scanschedule.FieldLastRunAt:
+			values[i] = new(sql.NullTime)
+		case scanschedule.FieldID, scanschedule.FieldTenantID, scanschedule.FieldLastScanID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ScanSchedule fields.
+func (_m *ScanSchedule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case scanschedule.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case scanschedule.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case scanschedule.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = value.Time
+			}
+		case scanschedule.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case scanschedule.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case scanschedule.FieldCron:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cron", values[i])
+			} else if value.Valid {
+				_m.Cron = value.String
+			}
+		case scanschedule.FieldTimezone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field timezone", values[i])
+			} else if value.Valid {
+				_m.Timezone = value.String
+			}
+		case scanschedule.FieldMachineIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field machine_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.MachineIds); err != nil {
+					return fmt.Errorf("unmarshal field machine_ids: %w", err)
+				}
+			}
+		case scanschedule.FieldRoots:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field roots", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Roots); err != nil {
+					return fmt.Errorf("unmarshal field roots: %w", err)
+				}
+			}
+		case scanschedule.FieldExclusions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field exclusions", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Exclusions); err != nil {
+					return fmt.Errorf("unmarshal field exclusions: %w", err)
+				}
+			}
+		case scanschedule.FieldDeadlineSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deadline_seconds", values[i])
+			} else if value.Valid {
+				_m.DeadlineSeconds = int(value.Int64)
+			}
+		case scanschedule.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				_m.Enabled = value.Bool
+			}
+		case scanschedule.FieldNextRunAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_run_at", values[i])
+			} else if value.Valid {
+				_m.NextRunAt = value.Time
+			}
+		case scanschedule.FieldLastRunAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_run_at", values[i])
+			} else if value.Valid {
+				_m.LastRunAt = value.Time
+			}
+		case scanschedule.FieldLastScanID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field last_scan_id", values[i])
+			} else if value != nil {
+				_m.LastScanID = *value
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ScanSchedule.
+// This includes values selected through modifiers, order, etc.
+func (_m *ScanSchedule) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTenant queries the "tenant" edge of the ScanSchedule entity.
+func (_m *ScanSchedule) QueryTenant() *TenantQuery {
+	return NewScanScheduleClient(_m.config).QueryTenant(_m)
+}
+
+// QueryRuns queries the "runs" edge of the ScanSchedule entity.
+func (_m *ScanSchedule) QueryRuns() *ScanScheduleRunQuery {
+	return NewScanScheduleClient(_m.config).QueryRuns(_m)
+}
+
+// Update returns a builder for updating this ScanSchedule.
+// Note that you need to call ScanSchedule.Unwrap() before calling this method if this ScanSchedule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ScanSchedule) Update() *ScanScheduleUpdateOne {
+	return NewScanScheduleClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ScanSchedule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ScanSchedule) Unwrap() *ScanSchedule {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ScanSchedule is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ScanSchedule) String() string {
+	var builder strings.Builder
+	builder.WriteString("ScanSchedule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("cron=")
+	builder.WriteString(_m.Cron)
+	builder.WriteString(", ")
+	builder.WriteString("timezone=")
+	builder.WriteString(_m.Timezone)
+	builder.WriteString(", ")
+	builder.WriteString("machine_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MachineIds))
+	builder.WriteString(", ")
+	builder.WriteString("roots=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Roots))
+	builder.WriteString(", ")
+	builder.WriteString("exclusions=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Exclusions))
+	builder.WriteString(", ")
+	builder.WriteString("deadline_seconds=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DeadlineSeconds))
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("next_run_at=")
+	builder.WriteString(_m.NextRunAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("last_run_at=")
+	builder.WriteString(_m.LastRunAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("last_scan_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LastScanID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ScanSchedules is a parsable slice of ScanSchedule.
+type ScanSchedules []*ScanSchedule
@@ -0,0 +1,668 @@
+// Code generated by ent, DO NOT EDIT.
+
+package scanschedule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/mcmx/duplynx/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldEQ(FieldTenantID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldEQ(FieldName, v))
+}
+
+// Cron applies equality check predicate on the "cron" field. It's identical to CronEQ.
+func Cron(v string) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldEQ(FieldCron, v))
+}
+
+// Timezone applies equality check predicate on the "timezone" field. It's identical to TimezoneEQ.
+func Timezone(v string) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldEQ(FieldTimezone, v))
+}
+
+// DeadlineSeconds applies equality check predicate on the "deadline_seconds" field. It's identical to DeadlineSecondsEQ.
+func DeadlineSeconds(v int) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldEQ(FieldDeadlineSeconds, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// NextRunAt applies equality check predicate on the "next_run_at" field. It's identical to NextRunAtEQ.
+func NextRunAt(v time.Time) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldEQ(FieldNextRunAt, v))
+}
+
+// LastRunAt applies equality check predicate on the "last_run_at" field. It's identical to LastRunAtEQ.
+func LastRunAt(v time.Time) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldEQ(FieldLastRunAt, v))
+}
+
+// LastScanID applies equality check predicate on the "last_scan_id" field. It's identical to LastScanIDEQ.
+func LastScanID(v uuid.UUID) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldEQ(FieldLastScanID, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldContainsFold(FieldName, v))
+}
+
+// CronEQ applies the EQ predicate on the "cron" field.
+func CronEQ(v string) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldEQ(FieldCron, v))
+}
+
+// CronNEQ applies the NEQ predicate on the "cron" field.
+func CronNEQ(v string) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldNEQ(FieldCron, v))
+}
+
+// CronIn applies the In predicate on the "cron" field.
+func CronIn(vs ...string) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldIn(FieldCron, vs...))
+}
+
+// CronNotIn applies the NotIn predicate on the "cron" field.
+func CronNotIn(vs ...string) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldNotIn(FieldCron, vs...))
+}
+
+// CronGT applies the GT predicate on the "cron" field.
+func CronGT(v string) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldGT(FieldCron, v))
+}
+
+// CronGTE applies the GTE predicate on the "cron" field.
+func CronGTE(v string) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldGTE(FieldCron, v))
+}
+
+// CronLT applies the LT predicate on the "cron" field.
+func CronLT(v string) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldLT(FieldCron, v))
+}
+
+// CronLTE applies the LTE predicate on the "cron" field.
+func CronLTE(v string) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldLTE(FieldCron, v))
+}
+
+// CronContains applies the Contains predicate on the "cron" field.
+func CronContains(v string) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldContains(FieldCron, v))
+}
+
+// CronHasPrefix applies the HasPrefix predicate on the "cron" field.
+func CronHasPrefix(v string) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldHasPrefix(FieldCron, v))
+}
+
+// CronHasSuffix applies the HasSuffix predicate on the "cron" field.
+func CronHasSuffix(v string) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldHasSuffix(FieldCron, v))
+}
+
+// CronEqualFold applies the EqualFold predicate on the "cron" field.
+func CronEqualFold(v string) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldEqualFold(FieldCron, v))
+}
+
+// CronContainsFold applies the ContainsFold predicate on the "cron" field.
+func CronContainsFold(v string) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldContainsFold(FieldCron, v))
+}
+
+// TimezoneEQ applies the EQ predicate on the "timezone" field.
+func TimezoneEQ(v string) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldEQ(FieldTimezone, v))
+}
+
+// TimezoneNEQ applies the NEQ predicate on the "timezone" field.
+func TimezoneNEQ(v string) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldNEQ(FieldTimezone, v))
+}
+
+// TimezoneIn applies the In predicate on the "timezone" field.
+func TimezoneIn(vs ...string) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldIn(FieldTimezone, vs...))
+}
+
+// TimezoneNotIn applies the NotIn predicate on the "timezone" field.
+func TimezoneNotIn(vs ...string) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldNotIn(FieldTimezone, vs...))
+}
+
+// TimezoneGT applies the GT predicate on the "timezone" field.
+func TimezoneGT(v string) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldGT(FieldTimezone, v))
+}
+
+// TimezoneGTE applies the GTE predicate on the "timezone" field.
+func TimezoneGTE(v string) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldGTE(FieldTimezone, v))
+}
+
+// TimezoneLT applies the LT predicate on the "timezone" field.
+func TimezoneLT(v string) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldLT(FieldTimezone, v))
+}
+
+// TimezoneLTE applies the LTE predicate on the "timezone" field.
+func TimezoneLTE(v string) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldLTE(FieldTimezone, v))
+}
+
+// TimezoneContains applies the Contains predicate on the "timezone" field.
+func TimezoneContains(v string) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldContains(FieldTimezone, v))
+}
+
+// TimezoneHasPrefix applies the HasPrefix predicate on the "timezone" field.
+func TimezoneHasPrefix(v string) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldHasPrefix(FieldTimezone, v))
+}
+
+// TimezoneHasSuffix applies the HasSuffix predicate on the "timezone" field.
+func TimezoneHasSuffix(v string) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldHasSuffix(FieldTimezone, v))
+}
+
+// TimezoneEqualFold applies the EqualFold predicate on the "timezone" field.
+func TimezoneEqualFold(v string) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldEqualFold(FieldTimezone, v))
+}
+
+// TimezoneContainsFold applies the ContainsFold predicate on the "timezone" field.
+func TimezoneContainsFold(v string) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldContainsFold(FieldTimezone, v))
+}
+
+// ExclusionsIsNil applies the IsNil predicate on the "exclusions" field.
+func ExclusionsIsNil() predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldIsNull(FieldExclusions))
+}
+
+// ExclusionsNotNil applies the NotNil predicate on the "exclusions" field.
+func ExclusionsNotNil() predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldNotNull(FieldExclusions))
+}
+
+// DeadlineSecondsEQ applies the EQ predicate on the "deadline_seconds" field.
+func DeadlineSecondsEQ(v int) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldEQ(FieldDeadlineSeconds, v))
+}
+
+// DeadlineSecondsNEQ applies the NEQ predicate on the "deadline_seconds" field.
+func DeadlineSecondsNEQ(v int) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldNEQ(FieldDeadlineSeconds, v))
+}
+
+// DeadlineSecondsIn applies the In predicate on the "deadline_seconds" field.
+func DeadlineSecondsIn(vs ...int) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldIn(FieldDeadlineSeconds, vs...))
+}
+
+// DeadlineSecondsNotIn applies the NotIn predicate on the "deadline_seconds" field.
+func DeadlineSecondsNotIn(vs ...int) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldNotIn(FieldDeadlineSeconds, vs...))
+}
+
+// DeadlineSecondsGT applies the GT predicate on the "deadline_seconds" field.
+func DeadlineSecondsGT(v int) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldGT(FieldDeadlineSeconds, v))
+}
+
+// DeadlineSecondsGTE applies the GTE predicate on the "deadline_seconds" field.
+func DeadlineSecondsGTE(v int) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldGTE(FieldDeadlineSeconds, v))
+}
+
+// DeadlineSecondsLT applies the LT predicate on the "deadline_seconds" field.
+func DeadlineSecondsLT(v int) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldLT(FieldDeadlineSeconds, v))
+}
+
+// DeadlineSecondsLTE applies the LTE predicate on the "deadline_seconds" field.
+func DeadlineSecondsLTE(v int) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldLTE(FieldDeadlineSeconds, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// NextRunAtEQ applies the EQ predicate on the "next_run_at" field.
+func NextRunAtEQ(v time.Time) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldEQ(FieldNextRunAt, v))
+}
+
+// NextRunAtNEQ applies the NEQ predicate on the "next_run_at" field.
+func NextRunAtNEQ(v time.Time) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldNEQ(FieldNextRunAt, v))
+}
+
+// NextRunAtIn applies the In predicate on the "next_run_at" field.
+func NextRunAtIn(vs ...time.Time) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldIn(FieldNextRunAt, vs...))
+}
+
+// NextRunAtNotIn applies the NotIn predicate on the "next_run_at" field.
+func NextRunAtNotIn(vs ...time.Time) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldNotIn(FieldNextRunAt, vs...))
+}
+
+// NextRunAtGT applies the GT predicate on the "next_run_at" field.
+func NextRunAtGT(v time.Time) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldGT(FieldNextRunAt, v))
+}
+
+// NextRunAtGTE applies the GTE predicate on the "next_run_at" field.
+func NextRunAtGTE(v time.Time) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldGTE(FieldNextRunAt, v))
+}
+
+// NextRunAtLT applies the LT predicate on the "next_run_at" field.
+func NextRunAtLT(v time.Time) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldLT(FieldNextRunAt, v))
+}
+
+// NextRunAtLTE applies the LTE predicate on the "next_run_at" field.
+func NextRunAtLTE(v time.Time) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldLTE(FieldNextRunAt, v))
+}
+
+// LastRunAtEQ applies the EQ predicate on the "last_run_at" field.
+func LastRunAtEQ(v time.Time) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldEQ(FieldLastRunAt, v))
+}
+
+// LastRunAtNEQ applies the NEQ predicate on the "last_run_at" field.
+func LastRunAtNEQ(v time.Time) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldNEQ(FieldLastRunAt, v))
+}
+
+// LastRunAtIn applies the In predicate on the "last_run_at" field.
+func LastRunAtIn(vs ...time.Time) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldIn(FieldLastRunAt, vs...))
+}
+
+// LastRunAtNotIn applies the NotIn predicate on the "last_run_at" field.
+func LastRunAtNotIn(vs ...time.Time) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldNotIn(FieldLastRunAt, vs...))
+}
+
+// LastRunAtGT applies the GT predicate on the "last_run_at" field.
+func LastRunAtGT(v time.Time) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldGT(FieldLastRunAt, v))
+}
+
+// LastRunAtGTE applies the GTE predicate on the "last_run_at" field.
+func LastRunAtGTE(v time.Time) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldGTE(FieldLastRunAt, v))
+}
+
+// LastRunAtLT applies the LT predicate on the "last_run_at" field.
+func LastRunAtLT(v time.Time) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldLT(FieldLastRunAt, v))
+}
+
+// LastRunAtLTE applies the LTE predicate on the "last_run_at" field.
+func LastRunAtLTE(v time.Time) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldLTE(FieldLastRunAt, v))
+}
+
+// LastRunAtIsNil applies the IsNil predicate on the "last_run_at" field.
+func LastRunAtIsNil() predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldIsNull(FieldLastRunAt))
+}
+
+// LastRunAtNotNil applies the NotNil predicate on the "last_run_at" field.
+func LastRunAtNotNil() predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldNotNull(FieldLastRunAt))
+}
+
+// LastScanIDEQ applies the EQ predicate on the "last_scan_id" field.
+func LastScanIDEQ(v uuid.UUID) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldEQ(FieldLastScanID, v))
+}
+
+// LastScanIDNEQ applies the NEQ predicate on the "last_scan_id" field.
+func LastScanIDNEQ(v uuid.UUID) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldNEQ(FieldLastScanID, v))
+}
+
+// LastScanIDIn applies the In predicate on the "last_scan_id" field.
+func LastScanIDIn(vs ...uuid.UUID) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldIn(FieldLastScanID, vs...))
+}
+
+// LastScanIDNotIn applies the NotIn predicate on the "last_scan_id" field.
+func LastScanIDNotIn(vs ...uuid.UUID) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldNotIn(FieldLastScanID, vs...))
+}
+
+// LastScanIDGT applies the GT predicate on the "last_scan_id" field.
+func LastScanIDGT(v uuid.UUID) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldGT(FieldLastScanID, v))
+}
+
+// LastScanIDGTE applies the GTE predicate on the "last_scan_id" field.
+func LastScanIDGTE(v uuid.UUID) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldGTE(FieldLastScanID, v))
+}
+
+// LastScanIDLT applies the LT predicate on the "last_scan_id" field.
+func LastScanIDLT(v uuid.UUID) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldLT(FieldLastScanID, v))
+}
+
+// LastScanIDLTE applies the LTE predicate on the "last_scan_id" field.
+func LastScanIDLTE(v uuid.UUID) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldLTE(FieldLastScanID, v))
+}
+
+// LastScanIDIsNil applies the IsNil predicate on the "last_scan_id" field.
+func LastScanIDIsNil() predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldIsNull(FieldLastScanID))
+}
+
+// LastScanIDNotNil applies the NotNil predicate on the "last_scan_id" field.
+func LastScanIDNotNil() predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.FieldNotNull(FieldLastScanID))
+}
+
+// HasTenant applies the HasEdge predicate on the "tenant" edge.
+func HasTenant() predicate.ScanSchedule {
+	return predicate.ScanSchedule(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TenantTable, TenantColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTenantWith applies the HasEdge predicate on the "tenant" edge with a given conditions (other predicates).
+func HasTenantWith(preds ...predicate.Tenant) predicate.ScanSchedule {
+	return predicate.ScanSchedule(func(s *sql.Selector) {
+		step := newTenantStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRuns applies the HasEdge predicate on the "runs" edge.
+func HasRuns() predicate.ScanSchedule {
+	return predicate.ScanSchedule(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RunsTable, RunsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRunsWith applies the HasEdge predicate on the "runs" edge with a given conditions (other predicates).
+func HasRunsWith(preds ...predicate.ScanScheduleRun) predicate.ScanSchedule {
+	return predicate.ScanSchedule(func(s *sql.Selector) {
+		step := newRunsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ScanSchedule) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ScanSchedule) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ScanSchedule) predicate.ScanSchedule {
+	return predicate.ScanSchedule(sql.NotPredicates(p))
+}
@@ -0,0 +1,521 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/mcmx/duplynx/ent/scanschedule"
+	"github.com/mcmx/duplynx/ent/scanschedulerun"
+	"github.com/mcmx/duplynx/ent/tenant"
+)
+
+// ScanScheduleCreate is the builder for creating a ScanSchedule entity.
+type ScanScheduleCreate struct {
+	config
+	mutation *ScanScheduleMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *ScanScheduleCreate) SetCreateTime(v time.Time) *ScanScheduleCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *ScanScheduleCreate) SetNillableCreateTime(v *time.Time) *ScanScheduleCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *ScanScheduleCreate) SetUpdateTime(v time.Time) *ScanScheduleCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *ScanScheduleCreate) SetNillableUpdateTime(v *time.Time) *ScanScheduleCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *ScanScheduleCreate) SetTenantID(v uuid.UUID) *ScanScheduleCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *ScanScheduleCreate) SetName(v string) *ScanScheduleCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetCron sets the "cron" field.
+func (_c *ScanScheduleCreate) SetCron(v string) *ScanScheduleCreate {
+	_c.mutation.SetCron(v)
+	return _c
+}
+
+// SetTimezone sets the "timezone" field.
+func (_c *ScanScheduleCreate) SetTimezone(v string) *ScanScheduleCreate {
+	_c.mutation.SetTimezone(v)
+	return _c
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_c *ScanScheduleCreate) SetNillableTimezone(v *string) *ScanScheduleCreate {
+	if v != nil {
+		_c.SetTimezone(*v)
+	}
+	return _c
+}
+
+// SetMachineIds sets the "machine_ids" field.
+func (_c *ScanScheduleCreate) SetMachineIds(v []string) *ScanScheduleCreate {
+	_c.mutation.SetMachineIds(v)
+	return _c
+}
+
+// SetRoots sets the "roots" field.
+func (_c *ScanScheduleCreate) SetRoots(v []string) *ScanScheduleCreate {
+	_c.mutation.SetRoots(v)
+	return _c
+}
+
+// SetExclusions sets the "exclusions" field.
+func (_c *ScanScheduleCreate) SetExclusions(v []string) *ScanScheduleCreate {
+	_c.mutation.SetExclusions(v)
+	return _c
+}
+
+// SetDeadlineSeconds sets the "deadline_seconds" field.
+func (_c *ScanScheduleCreate) SetDeadlineSeconds(v int) *ScanScheduleCreate {
+	_c.mutation.SetDeadlineSeconds(v)
+	return _c
+}
+
+// SetNillableDeadlineSeconds sets the "deadline_seconds" field if the given value is not nil.
+func (_c *ScanScheduleCreate) SetNillableDeadlineSeconds(v *int) *ScanScheduleCreate {
+	if v != nil {
+		_c.SetDeadlineSeconds(*v)
+	}
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *ScanScheduleCreate) SetEnabled(v bool) *ScanScheduleCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *ScanScheduleCreate) SetNillableEnabled(v *bool) *ScanScheduleCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (_c *ScanScheduleCreate) SetNextRunAt(v time.Time) *ScanScheduleCreate {
+	_c.mutation.SetNextRunAt(v)
+	return _c
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (_c *ScanScheduleCreate) SetLastRunAt(v time.Time) *ScanScheduleCreate {
+	_c.mutation.SetLastRunAt(v)
+	return _c
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (_c *ScanScheduleCreate) SetNillableLastRunAt(v *time.Time) *ScanScheduleCreate {
+	if v != nil {
+		_c.SetLastRunAt(*v)
+	}
+	return _c
+}
+
+// SetLastScanID sets the "last_scan_id" field.
+func (_c *ScanScheduleCreate) SetLastScanID(v uuid.UUID) *ScanScheduleCreate {
+	_c.mutation.SetLastScanID(v)
+	return _c
+}
+
+// SetNillableLastScanID sets the "last_scan_id" field if the given value is not nil.
+func (_c *ScanScheduleCreate) SetNillableLastScanID(v *uuid.UUID) *ScanScheduleCreate {
+	if v != nil {
+		_c.SetLastScanID(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ScanScheduleCreate) SetID(v uuid.UUID) *ScanScheduleCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ScanScheduleCreate) SetNillableID(v *uuid.UUID) *ScanScheduleCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_c *ScanScheduleCreate) SetTenant(v *Tenant) *ScanScheduleCreate {
+	return _c.SetTenantID(v.ID)
+}
+
+// AddRunIDs adds the "runs" edge to the ScanScheduleRun entity by IDs.
+func (_c *ScanScheduleCreate) AddRunIDs(ids ...uuid.UUID) *ScanScheduleCreate {
+	_c.mutation.AddRunIDs(ids...)
+	return _c
+}
+
+// AddRuns adds the "runs" edges to the ScanScheduleRun entity.
+func (_c *ScanScheduleCreate) AddRuns(v ...*ScanScheduleRun) *ScanScheduleCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRunIDs(ids...)
+}
+
+// Mutation returns the ScanScheduleMutation object of the builder.
+func (_c *ScanScheduleCreate) Mutation() *ScanScheduleMutation {
+	return _c.mutation
+}
+
+// Save creates the ScanSchedule in the database.
+func (_c *ScanScheduleCreate) Save(ctx context.Context) (*ScanSchedule, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ScanScheduleCreate) SaveX(ctx context.Context) *ScanSchedule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ScanScheduleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ScanScheduleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ScanScheduleCreate) defaults() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		if scanschedule.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized scanschedule.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
+		v := scanschedule.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		if scanschedule.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized scanschedule.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := scanschedule.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.Timezone(); !ok {
+		v := scanschedule.DefaultTimezone
+		_c.mutation.SetTimezone(v)
+	}
+	if _, ok := _c.mutation.DeadlineSeconds(); !ok {
+		v := scanschedule.DefaultDeadlineSeconds
+		_c.mutation.SetDeadlineSeconds(v)
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := scanschedule.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if scanschedule.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized scanschedule.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := scanschedule.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ScanScheduleCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "ScanSchedule.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "ScanSchedule.update_time"`)}
+	}
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "ScanSchedule.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "ScanSchedule.name"`)}
+	}
+	if _, ok := _c.mutation.Cron(); !ok {
+		return &ValidationError{Name: "cron", err: errors.New(`ent: missing required field "ScanSchedule.cron"`)}
+	}
+	if _, ok := _c.mutation.Timezone(); !ok {
+		return &ValidationError{Name: "timezone", err: errors.New(`ent: missing required field "ScanSchedule.timezone"`)}
+	}
+	if _, ok := _c.mutation.MachineIds(); !ok {
+		return &ValidationError{Name: "machine_ids", err: errors.New(`ent: missing required field "ScanSchedule.machine_ids"`)}
+	}
+	if _, ok := _c.mutation.Roots(); !ok {
+		return &ValidationError{Name: "roots", err: errors.New(`ent: missing required field "ScanSchedule.roots"`)}
+	}
+	if _, ok := _c.mutation.DeadlineSeconds(); !ok {
+		return &ValidationError{Name: "deadline_seconds", err: errors.New(`ent: missing required field "ScanSchedule.deadline_seconds"`)}
+	}
+	if v, ok := _c.mutation.DeadlineSeconds(); ok {
+		if err := scanschedule.DeadlineSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "deadline_seconds", err: fmt.Errorf(`ent: validator failed for field "ScanSchedule.deadline_seconds": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "ScanSchedule.enabled"`)}
+	}
+	if _, ok := _c.mutation.NextRunAt(); !ok {
+		return &ValidationError{Name: "next_run_at", err: errors.New(`ent: missing required field "ScanSchedule.next_run_at"`)}
+	}
+	if len(_c.mutation.TenantIDs()) == 0 {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required edge "ScanSchedule.tenant"`)}
+	}
+	return nil
+}
+
+func (_c *ScanScheduleCreate) sqlSave(ctx context.Context) (*ScanSchedule, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ScanScheduleCreate) createSpec() (*ScanSchedule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ScanSchedule{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(scanschedule.Table, sqlgraph.NewFieldSpec(scanschedule.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(scanschedule.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(scanschedule.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(scanschedule.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Cron(); ok {
+		_spec.SetField(scanschedule.FieldCron, field.TypeString, value)
+		_node.Cron = value
+	}
+	if value, ok := _c.mutation.Timezone(); ok {
+		_spec.SetField(scanschedule.FieldTimezone, field.TypeString, value)
+		_node.Timezone = value
+	}
+	if value, ok := _c.mutation.MachineIds(); ok {
+		_spec.SetField(scanschedule.FieldMachineIds, field.TypeJSON, value)
+		_node.MachineIds = value
+	}
+	if value, ok := _c.mutation.Roots(); ok {
+		_spec.SetField(scanschedule.FieldRoots, field.TypeJSON, value)
+		_node.Roots = value
+	}
+	if value, ok := _c.mutation.Exclusions(); ok {
+		_spec.SetField(scanschedule.FieldExclusions, field.TypeJSON, value)
+		_node.Exclusions = value
+	}
+	if value, ok := _c.mutation.DeadlineSeconds(); ok {
+		_spec.SetField(scanschedule.FieldDeadlineSeconds, field.TypeInt, value)
+		_node.DeadlineSeconds = value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(scanschedule.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := _c.mutation.NextRunAt(); ok {
+		_spec.SetField(scanschedule.FieldNextRunAt, field.TypeTime, value)
+		_node.NextRunAt = value
+	}
+	if value, ok := _c.mutation.LastRunAt(); ok {
+		_spec.SetField(scanschedule.FieldLastRunAt, field.TypeTime, value)
+		_node.LastRunAt = value
+	}
+	if value, ok := _c.mutation.LastScanID(); ok {
+		_spec.SetField(scanschedule.FieldLastScanID, field.TypeUUID, value)
+		_node.LastScanID = value
+	}
+	if nodes := _c.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scanschedule.TenantTable,
+			Columns: []string{scanschedule.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TenantID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   scanschedule.RunsTable,
+			Columns: []string{scanschedule.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scanschedulerun.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ScanScheduleCreateBulk is the builder for creating many ScanSchedule entities in bulk.
+type ScanScheduleCreateBulk struct {
+	config
+	err      error
+	builders []*ScanScheduleCreate
+}
+
+// Save creates the ScanSchedule entities in the database.
+func (_c *ScanScheduleCreateBulk) Save(ctx context.Context) ([]*ScanSchedule, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ScanSchedule, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ScanScheduleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ScanScheduleCreateBulk) SaveX(ctx context.Context) []*ScanSchedule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ScanScheduleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ScanScheduleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
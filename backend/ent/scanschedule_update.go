@@ -0,0 +1,996 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/mcmx/duplynx/ent/predicate"
+	"github.com/mcmx/duplynx/ent/scanschedule"
+	"github.com/mcmx/duplynx/ent/scanschedulerun"
+	"github.com/mcmx/duplynx/ent/tenant"
+)
+
+// ScanScheduleUpdate is the builder for updating ScanSchedule entities.
+type ScanScheduleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ScanScheduleMutation
+}
+
+// Where appends a list predicates to the ScanScheduleUpdate builder.
+func (_u *ScanScheduleUpdate) Where(ps ...predicate.ScanSchedule) *ScanScheduleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ScanScheduleUpdate) SetUpdateTime(v time.Time) *ScanScheduleUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *ScanScheduleUpdate) SetTenantID(v uuid.UUID) *ScanScheduleUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *ScanScheduleUpdate) SetNillableTenantID(v *uuid.UUID) *ScanScheduleUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ScanScheduleUpdate) SetName(v string) *ScanScheduleUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ScanScheduleUpdate) SetNillableName(v *string) *ScanScheduleUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetCron sets the "cron" field.
+func (_u *ScanScheduleUpdate) SetCron(v string) *ScanScheduleUpdate {
+	_u.mutation.SetCron(v)
+	return _u
+}
+
+// SetNillableCron sets the "cron" field if the given value is not nil.
+func (_u *ScanScheduleUpdate) SetNillableCron(v *string) *ScanScheduleUpdate {
+	if v != nil {
+		_u.SetCron(*v)
+	}
+	return _u
+}
+
+// SetTimezone sets the "timezone" field.
+func (_u *ScanScheduleUpdate) SetTimezone(v string) *ScanScheduleUpdate {
+	_u.mutation.SetTimezone(v)
+	return _u
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_u *ScanScheduleUpdate) SetNillableTimezone(v *string) *ScanScheduleUpdate {
+	if v != nil {
+		_u.SetTimezone(*v)
+	}
+	return _u
+}
+
+// SetMachineIds sets the "machine_ids" field.
+func (_u *ScanScheduleUpdate) SetMachineIds(v []string) *ScanScheduleUpdate {
+	_u.mutation.SetMachineIds(v)
+	return _u
+}
+
+// AppendMachineIds appends value to the "machine_ids" field.
+func (_u *ScanScheduleUpdate) AppendMachineIds(v []string) *ScanScheduleUpdate {
+	_u.mutation.AppendMachineIds(v)
+	return _u
+}
+
+// SetRoots sets the "roots" field.
+func (_u *ScanScheduleUpdate) SetRoots(v []string) *ScanScheduleUpdate {
+	_u.mutation.SetRoots(v)
+	return _u
+}
+
+// AppendRoots appends value to the "roots" field.
+func (_u *ScanScheduleUpdate) AppendRoots(v []string) *ScanScheduleUpdate {
+	_u.mutation.AppendRoots(v)
+	return _u
+}
+
+// SetExclusions sets the "exclusions" field.
+func (_u *ScanScheduleUpdate) SetExclusions(v []string) *ScanScheduleUpdate {
+	_u.mutation.SetExclusions(v)
+	return _u
+}
+
+// AppendExclusions appends value to the "exclusions" field.
+func (_u *ScanScheduleUpdate) AppendExclusions(v []string) *ScanScheduleUpdate {
+	_u.mutation.AppendExclusions(v)
+	return _u
+}
+
+// ClearExclusions clears the value of the "exclusions" field.
+func (_u *ScanScheduleUpdate) ClearExclusions() *ScanScheduleUpdate {
+	_u.mutation.ClearExclusions()
+	return _u
+}
+
+// SetDeadlineSeconds sets the "deadline_seconds" field.
+func (_u *ScanScheduleUpdate) SetDeadlineSeconds(v int) *ScanScheduleUpdate {
+	_u.mutation.ResetDeadlineSeconds()
+	_u.mutation.SetDeadlineSeconds(v)
+	return _u
+}
+
+// SetNillableDeadlineSeconds sets the "deadline_seconds" field if the given value is not nil.
+func (_u *ScanScheduleUpdate) SetNillableDeadlineSeconds(v *int) *ScanScheduleUpdate {
+	if v != nil {
+		_u.SetDeadlineSeconds(*v)
+	}
+	return _u
+}
+
+// AddDeadlineSeconds adds value to the "deadline_seconds" field.
+func (_u *ScanScheduleUpdate) AddDeadlineSeconds(v int) *ScanScheduleUpdate {
+	_u.mutation.AddDeadlineSeconds(v)
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *ScanScheduleUpdate) SetEnabled(v bool) *ScanScheduleUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *ScanScheduleUpdate) SetNillableEnabled(v *bool) *ScanScheduleUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (_u *ScanScheduleUpdate) SetNextRunAt(v time.Time) *ScanScheduleUpdate {
+	_u.mutation.SetNextRunAt(v)
+	return _u
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (_u *ScanScheduleUpdate) SetNillableNextRunAt(v *time.Time) *ScanScheduleUpdate {
+	if v != nil {
+		_u.SetNextRunAt(*v)
+	}
+	return _u
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (_u *ScanScheduleUpdate) SetLastRunAt(v time.Time) *ScanScheduleUpdate {
+	_u.mutation.SetLastRunAt(v)
+	return _u
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (_u *ScanScheduleUpdate) SetNillableLastRunAt(v *time.Time) *ScanScheduleUpdate {
+	if v != nil {
+		_u.SetLastRunAt(*v)
+	}
+	return _u
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (_u *ScanScheduleUpdate) ClearLastRunAt() *ScanScheduleUpdate {
+	_u.mutation.ClearLastRunAt()
+	return _u
+}
+
+// SetLastScanID sets the "last_scan_id" field.
+func (_u *ScanScheduleUpdate) SetLastScanID(v uuid.UUID) *ScanScheduleUpdate {
+	_u.mutation.SetLastScanID(v)
+	return _u
+}
+
+// SetNillableLastScanID sets the "last_scan_id" field if the given value is not nil.
+func (_u *ScanScheduleUpdate) SetNillableLastScanID(v *uuid.UUID) *ScanScheduleUpdate {
+	if v != nil {
+		_u.SetLastScanID(*v)
+	}
+	return _u
+}
+
+// ClearLastScanID clears the value of the "last_scan_id" field.
+func (_u *ScanScheduleUpdate) ClearLastScanID() *ScanScheduleUpdate {
+	_u.mutation.ClearLastScanID()
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *ScanScheduleUpdate) SetTenant(v *Tenant) *ScanScheduleUpdate {
+	return _u.SetTenantID(v.ID)
+}
+
+// AddRunIDs adds the "runs" edge to the ScanScheduleRun entity by IDs.
+func (_u *ScanScheduleUpdate) AddRunIDs(ids ...uuid.UUID) *ScanScheduleUpdate {
+	_u.mutation.AddRunIDs(ids...)
+	return _u
+}
+
+// AddRuns adds the "runs" edges to the ScanScheduleRun entity.
+func (_u *ScanScheduleUpdate) AddRuns(v ...*ScanScheduleRun) *ScanScheduleUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRunIDs(ids...)
+}
+
+// Mutation returns the ScanScheduleMutation object of the builder.
+func (_u *ScanScheduleUpdate) Mutation() *ScanScheduleMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *ScanScheduleUpdate) ClearTenant() *ScanScheduleUpdate {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearRuns clears all "runs" edges to the ScanScheduleRun entity.
+func (_u *ScanScheduleUpdate) ClearRuns() *ScanScheduleUpdate {
+	_u.mutation.ClearRuns()
+	return _u
+}
+
+// RemoveRunIDs removes the "runs" edge to ScanScheduleRun entities by IDs.
+func (_u *ScanScheduleUpdate) RemoveRunIDs(ids ...uuid.UUID) *ScanScheduleUpdate {
+	_u.mutation.RemoveRunIDs(ids...)
+	return _u
+}
+
+// RemoveRuns removes "runs" edges to ScanScheduleRun entities.
+func (_u *ScanScheduleUpdate) RemoveRuns(v ...*ScanScheduleRun) *ScanScheduleUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRunIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ScanScheduleUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ScanScheduleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ScanScheduleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ScanScheduleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ScanScheduleUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if scanschedule.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized scanschedule.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := scanschedule.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ScanScheduleUpdate) check() error {
+	if v, ok := _u.mutation.DeadlineSeconds(); ok {
+		if err := scanschedule.DeadlineSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "deadline_seconds", err: fmt.Errorf(`ent: validator failed for field "ScanSchedule.deadline_seconds": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ScanSchedule.tenant"`)
+	}
+	return nil
+}
+
+func (_u *ScanScheduleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(scanschedule.Table, scanschedule.Columns, sqlgraph.NewFieldSpec(scanschedule.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(scanschedule.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(scanschedule.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Cron(); ok {
+		_spec.SetField(scanschedule.FieldCron, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Timezone(); ok {
+		_spec.SetField(scanschedule.FieldTimezone, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MachineIds(); ok {
+		_spec.SetField(scanschedule.FieldMachineIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedMachineIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, scanschedule.FieldMachineIds, value)
+		})
+	}
+	if value, ok := _u.mutation.Roots(); ok {
+		_spec.SetField(scanschedule.FieldRoots, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRoots(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, scanschedule.FieldRoots, value)
+		})
+	}
+	if value, ok := _u.mutation.Exclusions(); ok {
+		_spec.SetField(scanschedule.FieldExclusions, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedExclusions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, scanschedule.FieldExclusions, value)
+		})
+	}
+	if _u.mutation.ExclusionsCleared() {
+		_spec.ClearField(scanschedule.FieldExclusions, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.DeadlineSeconds(); ok {
+		_spec.SetField(scanschedule.FieldDeadlineSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDeadlineSeconds(); ok {
+		_spec.AddField(scanschedule.FieldDeadlineSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(scanschedule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.NextRunAt(); ok {
+		_spec.SetField(scanschedule.FieldNextRunAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LastRunAt(); ok {
+		_spec.SetField(scanschedule.FieldLastRunAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastRunAtCleared() {
+		_spec.ClearField(scanschedule.FieldLastRunAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastScanID(); ok {
+		_spec.SetField(scanschedule.FieldLastScanID, field.TypeUUID, value)
+	}
+	if _u.mutation.LastScanIDCleared() {
+		_spec.ClearField(scanschedule.FieldLastScanID, field.TypeUUID)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scanschedule.TenantTable,
+			Columns: []string{scanschedule.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scanschedule.TenantTable,
+			Columns: []string{scanschedule.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   scanschedule.RunsTable,
+			Columns: []string{scanschedule.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scanschedulerun.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRunsIDs(); len(nodes) > 0 && !_u.mutation.RunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   scanschedule.RunsTable,
+			Columns: []string{scanschedule.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scanschedulerun.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   scanschedule.RunsTable,
+			Columns: []string{scanschedule.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scanschedulerun.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scanschedule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ScanScheduleUpdateOne is the builder for updating a single ScanSchedule entity.
+type ScanScheduleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ScanScheduleMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ScanScheduleUpdateOne) SetUpdateTime(v time.Time) *ScanScheduleUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *ScanScheduleUpdateOne) SetTenantID(v uuid.UUID) *ScanScheduleUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *ScanScheduleUpdateOne) SetNillableTenantID(v *uuid.UUID) *ScanScheduleUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ScanScheduleUpdateOne) SetName(v string) *ScanScheduleUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ScanScheduleUpdateOne) SetNillableName(v *string) *ScanScheduleUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetCron sets the "cron" field.
+func (_u *ScanScheduleUpdateOne) SetCron(v string) *ScanScheduleUpdateOne {
+	_u.mutation.SetCron(v)
+	return _u
+}
+
+// SetNillableCron sets the "cron" field if the given value is not nil.
+func (_u *ScanScheduleUpdateOne) SetNillableCron(v *string) *ScanScheduleUpdateOne {
+	if v != nil {
+		_u.SetCron(*v)
+	}
+	return _u
+}
+
+// SetTimezone sets the "timezone" field.
+func (_u *ScanScheduleUpdateOne) SetTimezone(v string) *ScanScheduleUpdateOne {
+	_u.mutation.SetTimezone(v)
+	return _u
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_u *ScanScheduleUpdateOne) SetNillableTimezone(v *string) *ScanScheduleUpdateOne {
+	if v != nil {
+		_u.SetTimezone(*v)
+	}
+	return _u
+}
+
+// SetMachineIds sets the "machine_ids" field.
+func (_u *ScanScheduleUpdateOne) SetMachineIds(v []string) *ScanScheduleUpdateOne {
+	_u.mutation.SetMachineIds(v)
+	return _u
+}
+
+// AppendMachineIds appends value to the "machine_ids" field.
+func (_u *ScanScheduleUpdateOne) AppendMachineIds(v []string) *ScanScheduleUpdateOne {
+	_u.mutation.AppendMachineIds(v)
+	return _u
+}
+
+// SetRoots sets the "roots" field.
+func (_u *ScanScheduleUpdateOne) SetRoots(v []string) *ScanScheduleUpdateOne {
+	_u.mutation.SetRoots(v)
+	return _u
+}
+
+// AppendRoots appends value to the "roots" field.
+func (_u *ScanScheduleUpdateOne) AppendRoots(v []string) *ScanScheduleUpdateOne {
+	_u.mutation.AppendRoots(v)
+	return _u
+}
+
+// SetExclusions sets the "exclusions" field.
+func (_u *ScanScheduleUpdateOne) SetExclusions(v []string) *ScanScheduleUpdateOne {
+	_u.mutation.SetExclusions(v)
+	return _u
+}
+
+// AppendExclusions appends value to the "exclusions" field.
+func (_u *ScanScheduleUpdateOne) AppendExclusions(v []string) *ScanScheduleUpdateOne {
+	_u.mutation.AppendExclusions(v)
+	return _u
+}
+
+// ClearExclusions clears the value of the "exclusions" field.
+func (_u *ScanScheduleUpdateOne) ClearExclusions() *ScanScheduleUpdateOne {
+	_u.mutation.ClearExclusions()
+	return _u
+}
+
+// SetDeadlineSeconds sets the "deadline_seconds" field.
+func (_u *ScanScheduleUpdateOne) SetDeadlineSeconds(v int) *ScanScheduleUpdateOne {
+	_u.mutation.ResetDeadlineSeconds()
+	_u.mutation.SetDeadlineSeconds(v)
+	return _u
+}
+
+// SetNillableDeadlineSeconds sets the "deadline_seconds" field if the given value is not nil.
+func (_u *ScanScheduleUpdateOne) SetNillableDeadlineSeconds(v *int) *ScanScheduleUpdateOne {
+	if v != nil {
+		_u.SetDeadlineSeconds(*v)
+	}
+	return _u
+}
+
+// AddDeadlineSeconds adds value to the "deadline_seconds" field.
+func (_u *ScanScheduleUpdateOne) AddDeadlineSeconds(v int) *ScanScheduleUpdateOne {
+	_u.mutation.AddDeadlineSeconds(v)
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *ScanScheduleUpdateOne) SetEnabled(v bool) *ScanScheduleUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *ScanScheduleUpdateOne) SetNillableEnabled(v *bool) *ScanScheduleUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (_u *ScanScheduleUpdateOne) SetNextRunAt(v time.Time) *ScanScheduleUpdateOne {
+	_u.mutation.SetNextRunAt(v)
+	return _u
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (_u *ScanScheduleUpdateOne) SetNillableNextRunAt(v *time.Time) *ScanScheduleUpdateOne {
+	if v != nil {
+		_u.SetNextRunAt(*v)
+	}
+	return _u
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (_u *ScanScheduleUpdateOne) SetLastRunAt(v time.Time) *ScanScheduleUpdateOne {
+	_u.mutation.SetLastRunAt(v)
+	return _u
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (_u *ScanScheduleUpdateOne) SetNillableLastRunAt(v *time.Time) *ScanScheduleUpdateOne {
+	if v != nil {
+		_u.SetLastRunAt(*v)
+	}
+	return _u
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (_u *ScanScheduleUpdateOne) ClearLastRunAt() *ScanScheduleUpdateOne {
+	_u.mutation.ClearLastRunAt()
+	return _u
+}
+
+// SetLastScanID sets the "last_scan_id" field.
+func (_u *ScanScheduleUpdateOne) SetLastScanID(v uuid.UUID) *ScanScheduleUpdateOne {
+	_u.mutation.SetLastScanID(v)
+	return _u
+}
+
+// SetNillableLastScanID sets the "last_scan_id" field if the given value is not nil.
+func (_u *ScanScheduleUpdateOne) SetNillableLastScanID(v *uuid.UUID) *ScanScheduleUpdateOne {
+	if v != nil {
+		_u.SetLastScanID(*v)
+	}
+	return _u
+}
+
+// ClearLastScanID clears the value of the "last_scan_id" field.
+func (_u *ScanScheduleUpdateOne) ClearLastScanID() *ScanScheduleUpdateOne {
+	_u.mutation.ClearLastScanID()
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *ScanScheduleUpdateOne) SetTenant(v *Tenant) *ScanScheduleUpdateOne {
+	return _u.SetTenantID(v.ID)
+}
+
+// AddRunIDs adds the "runs" edge to the ScanScheduleRun entity by IDs.
+func (_u *ScanScheduleUpdateOne) AddRunIDs(ids ...uuid.UUID) *ScanScheduleUpdateOne {
+	_u.mutation.AddRunIDs(ids...)
+	return _u
+}
+
+// AddRuns adds the "runs" edges to the ScanScheduleRun entity.
+func (_u *ScanScheduleUpdateOne) AddRuns(v ...*ScanScheduleRun) *ScanScheduleUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRunIDs(ids...)
+}
+
+// Mutation returns the ScanScheduleMutation object of the builder.
+func (_u *ScanScheduleUpdateOne) Mutation() *ScanScheduleMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *ScanScheduleUpdateOne) ClearTenant() *ScanScheduleUpdateOne {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearRuns clears all "runs" edges to the ScanScheduleRun entity.
+func (_u *ScanScheduleUpdateOne) ClearRuns() *ScanScheduleUpdateOne {
+	_u.mutation.ClearRuns()
+	return _u
+}
+
+// RemoveRunIDs removes the "runs" edge to ScanScheduleRun entities by IDs.
+func (_u *ScanScheduleUpdateOne) RemoveRunIDs(ids ...uuid.UUID) *ScanScheduleUpdateOne {
+	_u.mutation.RemoveRunIDs(ids...)
+	return _u
+}
+
+// RemoveRuns removes "runs" edges to ScanScheduleRun entities.
+func (_u *ScanScheduleUpdateOne) RemoveRuns(v ...*ScanScheduleRun) *ScanScheduleUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRunIDs(ids...)
+}
+
+// Where appends a list predicates to the ScanScheduleUpdate builder.
+func (_u *ScanScheduleUpdateOne) Where(ps ...predicate.ScanSchedule) *ScanScheduleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ScanScheduleUpdateOne) Select(field string, fields ...string) *ScanScheduleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ScanSchedule entity.
+func (_u *ScanScheduleUpdateOne) Save(ctx context.Context) (*ScanSchedule, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ScanScheduleUpdateOne) SaveX(ctx context.Context) *ScanSchedule {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ScanScheduleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ScanScheduleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ScanScheduleUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if scanschedule.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized scanschedule.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := scanschedule.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ScanScheduleUpdateOne) check() error {
+	if v, ok := _u.mutation.DeadlineSeconds(); ok {
+		if err := scanschedule.DeadlineSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "deadline_seconds", err: fmt.Errorf(`ent: validator failed for field "ScanSchedule.deadline_seconds": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ScanSchedule.tenant"`)
+	}
+	return nil
+}
+
+func (_u *ScanScheduleUpdateOne) sqlSave(ctx context.Context) (_node *ScanSchedule, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(scanschedule.Table, scanschedule.Columns, sqlgraph.NewFieldSpec(scanschedule.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ScanSchedule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, scanschedule.FieldID)
+		for _, f := range fields {
+			if !scanschedule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != scanschedule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(scanschedule.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(scanschedule.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Cron(); ok {
+		_spec.SetField(scanschedule.FieldCron, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Timezone(); ok {
+		_spec.SetField(scanschedule.FieldTimezone, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MachineIds(); ok {
+		_spec.SetField(scanschedule.FieldMachineIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedMachineIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, scanschedule.FieldMachineIds, value)
+		})
+	}
+	if value, ok := _u.mutation.Roots(); ok {
+		_spec.SetField(scanschedule.FieldRoots, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRoots(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, scanschedule.FieldRoots, value)
+		})
+	}
+	if value, ok := _u.mutation.Exclusions(); ok {
+		_spec.SetField(scanschedule.FieldExclusions, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedExclusions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, scanschedule.FieldExclusions, value)
+		})
+	}
+	if _u.mutation.ExclusionsCleared() {
+		_spec.ClearField(scanschedule.FieldExclusions, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.DeadlineSeconds(); ok {
+		_spec.SetField(scanschedule.FieldDeadlineSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDeadlineSeconds(); ok {
+		_spec.AddField(scanschedule.FieldDeadlineSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(scanschedule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.NextRunAt(); ok {
+		_spec.SetField(scanschedule.FieldNextRunAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LastRunAt(); ok {
+		_spec.SetField(scanschedule.FieldLastRunAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastRunAtCleared() {
+		_spec.ClearField(scanschedule.FieldLastRunAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastScanID(); ok {
+		_spec.SetField(scanschedule.FieldLastScanID, field.TypeUUID, value)
+	}
+	if _u.mutation.LastScanIDCleared() {
+		_spec.ClearField(scanschedule.FieldLastScanID, field.TypeUUID)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scanschedule.TenantTable,
+			Columns: []string{scanschedule.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scanschedule.TenantTable,
+			Columns: []string{scanschedule.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   scanschedule.RunsTable,
+			Columns: []string{scanschedule.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scanschedulerun.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRunsIDs(); len(nodes) > 0 && !_u.mutation.RunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   scanschedule.RunsTable,
+			Columns: []string{scanschedule.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scanschedulerun.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   scanschedule.RunsTable,
+			Columns: []string{scanschedule.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scanschedulerun.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ScanSchedule{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scanschedule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
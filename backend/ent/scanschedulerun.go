@@ -0,0 +1,232 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/mcmx/duplynx/ent/scanschedule"
+	"github.com/mcmx/duplynx/ent/scanschedulerun"
+	"github.com/mcmx/duplynx/ent/tenant"
+)
+
+// ScanScheduleRun is the model entity for the ScanScheduleRun schema.
+type ScanScheduleRun struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// ScheduleID holds the value of the "schedule_id" field.
+	ScheduleID uuid.UUID `json:"schedule_id,omitempty"`
+	// ScheduledFor holds the value of the "scheduled_for" field.
+	ScheduledFor time.Time `json:"scheduled_for,omitempty"`
+	// Status holds the value of the "status" field.
+	Status scanschedulerun.Status `json:"status,omitempty"`
+	// ScanID holds the value of the "scan_id" field.
+	ScanID uuid.UUID `json:"scan_id,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ScanScheduleRunQuery when eager-loading is set.
+	Edges        ScanScheduleRunEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ScanScheduleRunEdges holds the relations/edges for other nodes in the graph.
+type ScanScheduleRunEdges struct {
+	// Tenant holds the value of the tenant edge.
+	Tenant *Tenant `json:"tenant,omitempty"`
+	// Schedule holds the value of the schedule edge.
+	Schedule *ScanSchedule `json:"schedule,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// TenantOrErr returns the Tenant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ScanScheduleRunEdges) TenantOrErr() (*Tenant, error) {
+	if e.Tenant != nil {
+		return e.Tenant, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: tenant.Label}
+	}
+	return nil, &NotLoadedError{edge: "tenant"}
+}
+
+// ScheduleOrErr returns the Schedule value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ScanScheduleRunEdges) ScheduleOrErr() (*ScanSchedule, error) {
+	if e.Schedule != nil {
+		return e.Schedule, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: scanschedule.Label}
+	}
+	return nil, &NotLoadedError{edge: "schedule"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ScanScheduleRun) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case scanschedulerun.FieldStatus, scanschedulerun.FieldReason:
+			values[i] = new(sql.NullString)
+		case scanschedulerun.FieldCreateTime, scanschedulerun.FieldUpdateTime, scanschedulerun.FieldScheduledFor:
+			values[i] = new(sql.NullTime)
+		case scanschedulerun.FieldID, scanschedulerun.FieldTenantID, scanschedulerun.FieldScheduleID, scanschedulerun.FieldScanID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ScanScheduleRun fields.
+func (_m *ScanScheduleRun) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case scanschedulerun.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case scanschedulerun.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case scanschedulerun.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = value.Time
+			}
+		case scanschedulerun.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case scanschedulerun.FieldScheduleID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field schedule_id", values[i])
+			} else if value != nil {
+				_m.ScheduleID = *value
+			}
+		case scanschedulerun.FieldScheduledFor:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field scheduled_for", values[i])
+			} else if value.Valid {
+				_m.ScheduledFor = value.Time
+			}
+		case scanschedulerun.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = scanschedulerun.Status(value.String)
+			}
+		case scanschedulerun.FieldScanID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field scan_id", values[i])
+			} else if value != nil {
+				_m.ScanID = *value
+			}
+		case scanschedulerun.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ScanScheduleRun.
+// This includes values selected through modifiers, order, etc.
+func (_m *ScanScheduleRun) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTenant queries the "tenant" edge of the ScanScheduleRun entity.
+func (_m *ScanScheduleRun) QueryTenant() *TenantQuery {
+	return NewScanScheduleRunClient(_m.config).QueryTenant(_m)
+}
+
+// QuerySchedule queries the "schedule" edge of the ScanScheduleRun entity.
+func (_m *ScanScheduleRun) QuerySchedule() *ScanScheduleQuery {
+	return NewScanScheduleRunClient(_m.config).QuerySchedule(_m)
+}
+
+// Update returns a builder for updating this ScanScheduleRun.
+// Note that you need to call ScanScheduleRun.Unwrap() before calling this method if this ScanScheduleRun
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ScanScheduleRun) Update() *ScanScheduleRunUpdateOne {
+	return NewScanScheduleRunClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ScanScheduleRun entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ScanScheduleRun) Unwrap() *ScanScheduleRun {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ScanScheduleRun is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ScanScheduleRun) String() string {
+	var builder strings.Builder
+	builder.WriteString("ScanScheduleRun(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("schedule_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ScheduleID))
+	builder.WriteString(", ")
+	builder.WriteString("scheduled_for=")
+	builder.WriteString(_m.ScheduledFor.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("scan_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ScanID))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ScanScheduleRuns is a parsable slice of ScanScheduleRun.
+type ScanScheduleRuns []*ScanScheduleRun
@@ -0,0 +1,458 @@
+// Code generated by ent, DO NOT EDIT.
+
+package scanschedulerun
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/mcmx/duplynx/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldEQ(FieldTenantID, v))
+}
+
+// ScheduleID applies equality check predicate on the "schedule_id" field. It's identical to ScheduleIDEQ.
+func ScheduleID(v uuid.UUID) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldEQ(FieldScheduleID, v))
+}
+
+// ScheduledFor applies equality check predicate on the "scheduled_for" field. It's identical to ScheduledForEQ.
+func ScheduledFor(v time.Time) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldEQ(FieldScheduledFor, v))
+}
+
+// ScanID applies equality check predicate on the "scan_id" field. It's identical to ScanIDEQ.
+func ScanID(v uuid.UUID) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldEQ(FieldScanID, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldEQ(FieldReason, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// ScheduleIDEQ applies the EQ predicate on the "schedule_id" field.
+func ScheduleIDEQ(v uuid.UUID) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldEQ(FieldScheduleID, v))
+}
+
+// ScheduleIDNEQ applies the NEQ predicate on the "schedule_id" field.
+func ScheduleIDNEQ(v uuid.UUID) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldNEQ(FieldScheduleID, v))
+}
+
+// ScheduleIDIn applies the In predicate on the "schedule_id" field.
+func ScheduleIDIn(vs ...uuid.UUID) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldIn(FieldScheduleID, vs...))
+}
+
+// ScheduleIDNotIn applies the NotIn predicate on the "schedule_id" field.
+func ScheduleIDNotIn(vs ...uuid.UUID) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldNotIn(FieldScheduleID, vs...))
+}
+
+// ScheduledForEQ applies the EQ predicate on the "scheduled_for" field.
+func ScheduledForEQ(v time.Time) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldEQ(FieldScheduledFor, v))
+}
+
+// ScheduledForNEQ applies the NEQ predicate on the "scheduled_for" field.
+func ScheduledForNEQ(v time.Time) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldNEQ(FieldScheduledFor, v))
+}
+
+// ScheduledForIn applies the In predicate on the "scheduled_for" field.
+func ScheduledForIn(vs ...time.Time) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldIn(FieldScheduledFor, vs...))
+}
+
+// ScheduledForNotIn applies the NotIn predicate on the "scheduled_for" field.
+func ScheduledForNotIn(vs ...time.Time) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldNotIn(FieldScheduledFor, vs...))
+}
+
+// ScheduledForGT applies the GT predicate on the "scheduled_for" field.
+func ScheduledForGT(v time.Time) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldGT(FieldScheduledFor, v))
+}
+
+// ScheduledForGTE applies the GTE predicate on the "scheduled_for" field.
+func ScheduledForGTE(v time.Time) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldGTE(FieldScheduledFor, v))
+}
+
+// ScheduledForLT applies the LT predicate on the "scheduled_for" field.
+func ScheduledForLT(v time.Time) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldLT(FieldScheduledFor, v))
+}
+
+// ScheduledForLTE applies the LTE predicate on the "scheduled_for" field.
+func ScheduledForLTE(v time.Time) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldLTE(FieldScheduledFor, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ScanIDEQ applies the EQ predicate on the "scan_id" field.
+func ScanIDEQ(v uuid.UUID) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldEQ(FieldScanID, v))
+}
+
+// ScanIDNEQ applies the NEQ predicate on the "scan_id" field.
+func ScanIDNEQ(v uuid.UUID) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldNEQ(FieldScanID, v))
+}
+
+// ScanIDIn applies the In predicate on the "scan_id" field.
+func ScanIDIn(vs ...uuid.UUID) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldIn(FieldScanID, vs...))
+}
+
+// ScanIDNotIn applies the NotIn predicate on the "scan_id" field.
+func ScanIDNotIn(vs ...uuid.UUID) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldNotIn(FieldScanID, vs...))
+}
+
+// ScanIDGT applies the GT predicate on the "scan_id" field.
+func ScanIDGT(v uuid.UUID) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldGT(FieldScanID, v))
+}
+
+// ScanIDGTE applies the GTE predicate on the "scan_id" field.
+func ScanIDGTE(v uuid.UUID) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldGTE(FieldScanID, v))
+}
+
+// ScanIDLT applies the LT predicate on the "scan_id" field.
+func ScanIDLT(v uuid.UUID) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldLT(FieldScanID, v))
+}
+
+// ScanIDLTE applies the LTE predicate on the "scan_id" field.
+func ScanIDLTE(v uuid.UUID) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldLTE(FieldScanID, v))
+}
+
+// ScanIDIsNil applies the IsNil predicate on the "scan_id" field.
+func ScanIDIsNil() predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldIsNull(FieldScanID))
+}
+
+// ScanIDNotNil applies the NotNil predicate on the "scan_id" field.
+func ScanIDNotNil() predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldNotNull(FieldScanID))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonIsNil applies the IsNil predicate on the "reason" field.
+func ReasonIsNil() predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldIsNull(FieldReason))
+}
+
+// ReasonNotNil applies the NotNil predicate on the "reason" field.
+func ReasonNotNil() predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldNotNull(FieldReason))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.FieldContainsFold(FieldReason, v))
+}
+
+// HasTenant applies the HasEdge predicate on the "tenant" edge.
+func HasTenant() predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TenantTable, TenantColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTenantWith applies the HasEdge predicate on the "tenant" edge with a given conditions (other predicates).
+func HasTenantWith(preds ...predicate.Tenant) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(func(s *sql.Selector) {
+		step := newTenantStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSchedule applies the HasEdge predicate on the "schedule" edge.
+func HasSchedule() predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ScheduleTable, ScheduleColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasScheduleWith applies the HasEdge predicate on the "schedule" edge with a given conditions (other predicates).
+func HasScheduleWith(preds ...predicate.ScanSchedule) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(func(s *sql.Selector) {
+		step := newScheduleStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ScanScheduleRun) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ScanScheduleRun) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ScanScheduleRun) predicate.ScanScheduleRun {
+	return predicate.ScanScheduleRun(sql.NotPredicates(p))
+}
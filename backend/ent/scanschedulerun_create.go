@@ -0,0 +1,400 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/mcmx/duplynx/ent/scanschedule"
+	"github.com/mcmx/duplynx/ent/scanschedulerun"
+	"github.com/mcmx/duplynx/ent/tenant"
+)
+
+// ScanScheduleRunCreate is the builder for creating a ScanScheduleRun entity.
+type ScanScheduleRunCreate struct {
+	config
+	mutation *ScanScheduleRunMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *ScanScheduleRunCreate) SetCreateTime(v time.Time) *ScanScheduleRunCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *ScanScheduleRunCreate) SetNillableCreateTime(v *time.Time) *ScanScheduleRunCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *ScanScheduleRunCreate) SetUpdateTime(v time.Time) *ScanScheduleRunCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *ScanScheduleRunCreate) SetNillableUpdateTime(v *time.Time) *ScanScheduleRunCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *ScanScheduleRunCreate) SetTenantID(v uuid.UUID) *ScanScheduleRunCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetScheduleID sets the "schedule_id" field.
+func (_c *ScanScheduleRunCreate) SetScheduleID(v uuid.UUID) *ScanScheduleRunCreate {
+	_c.mutation.SetScheduleID(v)
+	return _c
+}
+
+// SetScheduledFor sets the "scheduled_for" field.
+func (_c *ScanScheduleRunCreate) SetScheduledFor(v time.Time) *ScanScheduleRunCreate {
+	_c.mutation.SetScheduledFor(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ScanScheduleRunCreate) SetStatus(v scanschedulerun.Status) *ScanScheduleRunCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetScanID sets the "scan_id" field.
+func (_c *ScanScheduleRunCreate) SetScanID(v uuid.UUID) *ScanScheduleRunCreate {
+	_c.mutation.SetScanID(v)
+	return _c
+}
+
+// SetNillableScanID sets the "scan_id" field if the given value is not nil.
+func (_c *ScanScheduleRunCreate) SetNillableScanID(v *uuid.UUID) *ScanScheduleRunCreate {
+	if v != nil {
+		_c.SetScanID(*v)
+	}
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *ScanScheduleRunCreate) SetReason(v string) *ScanScheduleRunCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *ScanScheduleRunCreate) SetNillableReason(v *string) *ScanScheduleRunCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ScanScheduleRunCreate) SetID(v uuid.UUID) *ScanScheduleRunCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ScanScheduleRunCreate) SetNillableID(v *uuid.UUID) *ScanScheduleRunCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_c *ScanScheduleRunCreate) SetTenant(v *Tenant) *ScanScheduleRunCreate {
+	return _c.SetTenantID(v.ID)
+}
+
+// SetSchedule sets the "schedule" edge to the ScanSchedule entity.
+func (_c *ScanScheduleRunCreate) SetSchedule(v *ScanSchedule) *ScanScheduleRunCreate {
+	return _c.SetScheduleID(v.ID)
+}
+
+// Mutation returns the ScanScheduleRunMutation object of the builder.
+func (_c *ScanScheduleRunCreate) Mutation() *ScanScheduleRunMutation {
+	return _c.mutation
+}
+
+// Save creates the ScanScheduleRun in the database.
+func (_c *ScanScheduleRunCreate) Save(ctx context.Context) (*ScanScheduleRun, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ScanScheduleRunCreate) SaveX(ctx context.Context) *ScanScheduleRun {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ScanScheduleRunCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ScanScheduleRunCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ScanScheduleRunCreate) defaults() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		if scanschedulerun.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized scanschedulerun.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
+		v := scanschedulerun.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		if scanschedulerun.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized scanschedulerun.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := scanschedulerun.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if scanschedulerun.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized scanschedulerun.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := scanschedulerun.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ScanScheduleRunCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "ScanScheduleRun.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "ScanScheduleRun.update_time"`)}
+	}
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "ScanScheduleRun.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.ScheduleID(); !ok {
+		return &ValidationError{Name: "schedule_id", err: errors.New(`ent: missing required field "ScanScheduleRun.schedule_id"`)}
+	}
+	if _, ok := _c.mutation.ScheduledFor(); !ok {
+		return &ValidationError{Name: "scheduled_for", err: errors.New(`ent: missing required field "ScanScheduleRun.scheduled_for"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ScanScheduleRun.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := scanschedulerun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ScanScheduleRun.status": %w`, err)}
+		}
+	}
+	if len(_c.mutation.TenantIDs()) == 0 {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required edge "ScanScheduleRun.tenant"`)}
+	}
+	if len(_c.mutation.ScheduleIDs()) == 0 {
+		return &ValidationError{Name: "schedule", err: errors.New(`ent: missing required edge "ScanScheduleRun.schedule"`)}
+	}
+	return nil
+}
+
+func (_c *ScanScheduleRunCreate) sqlSave(ctx context.Context) (*ScanScheduleRun, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ScanScheduleRunCreate) createSpec() (*ScanScheduleRun, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ScanScheduleRun{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(scanschedulerun.Table, sqlgraph.NewFieldSpec(scanschedulerun.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(scanschedulerun.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(scanschedulerun.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.ScheduledFor(); ok {
+		_spec.SetField(scanschedulerun.FieldScheduledFor, field.TypeTime, value)
+		_node.ScheduledFor = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(scanschedulerun.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.ScanID(); ok {
+		_spec.SetField(scanschedulerun.FieldScanID, field.TypeUUID, value)
+		_node.ScanID = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(scanschedulerun.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if nodes := _c.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scanschedulerun.TenantTable,
+			Columns: []string{scanschedulerun.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TenantID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ScheduleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scanschedulerun.ScheduleTable,
+			Columns: []string{scanschedulerun.ScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scanschedule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ScheduleID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ScanScheduleRunCreateBulk is the builder for creating many ScanScheduleRun entities in bulk.
+type ScanScheduleRunCreateBulk struct {
+	config
+	err      error
+	builders []*ScanScheduleRunCreate
+}
+
+// Save creates the ScanScheduleRun entities in the database.
+func (_c *ScanScheduleRunCreateBulk) Save(ctx context.Context) ([]*ScanScheduleRun, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ScanScheduleRun, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ScanScheduleRunMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ScanScheduleRunCreateBulk) SaveX(ctx context.Context) []*ScanScheduleRun {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ScanScheduleRunCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ScanScheduleRunCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
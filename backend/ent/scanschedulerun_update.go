@@ -0,0 +1,653 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/mcmx/duplynx/ent/predicate"
+	"github.com/mcmx/duplynx/ent/scanschedule"
+	"github.com/mcmx/duplynx/ent/scanschedulerun"
+	"github.com/mcmx/duplynx/ent/tenant"
+)
+
+// ScanScheduleRunUpdate is the builder for updating ScanScheduleRun entities.
+type ScanScheduleRunUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ScanScheduleRunMutation
+}
+
+// Where appends a list predicates to the ScanScheduleRunUpdate builder.
+func (_u *ScanScheduleRunUpdate) Where(ps ...predicate.ScanScheduleRun) *ScanScheduleRunUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ScanScheduleRunUpdate) SetUpdateTime(v time.Time) *ScanScheduleRunUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *ScanScheduleRunUpdate) SetTenantID(v uuid.UUID) *ScanScheduleRunUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *ScanScheduleRunUpdate) SetNillableTenantID(v *uuid.UUID) *ScanScheduleRunUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetScheduleID sets the "schedule_id" field.
+func (_u *ScanScheduleRunUpdate) SetScheduleID(v uuid.UUID) *ScanScheduleRunUpdate {
+	_u.mutation.SetScheduleID(v)
+	return _u
+}
+
+// SetNillableScheduleID sets the "schedule_id" field if the given value is not nil.
+func (_u *ScanScheduleRunUpdate) SetNillableScheduleID(v *uuid.UUID) *ScanScheduleRunUpdate {
+	if v != nil {
+		_u.SetScheduleID(*v)
+	}
+	return _u
+}
+
+// SetScheduledFor sets the "scheduled_for" field.
+func (_u *ScanScheduleRunUpdate) SetScheduledFor(v time.Time) *ScanScheduleRunUpdate {
+	_u.mutation.SetScheduledFor(v)
+	return _u
+}
+
+// SetNillableScheduledFor sets the "scheduled_for" field if the given value is not nil.
+func (_u *ScanScheduleRunUpdate) SetNillableScheduledFor(v *time.Time) *ScanScheduleRunUpdate {
+	if v != nil {
+		_u.SetScheduledFor(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ScanScheduleRunUpdate) SetStatus(v scanschedulerun.Status) *ScanScheduleRunUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ScanScheduleRunUpdate) SetNillableStatus(v *scanschedulerun.Status) *ScanScheduleRunUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetScanID sets the "scan_id" field.
+func (_u *ScanScheduleRunUpdate) SetScanID(v uuid.UUID) *ScanScheduleRunUpdate {
+	_u.mutation.SetScanID(v)
+	return _u
+}
+
+// SetNillableScanID sets the "scan_id" field if the given value is not nil.
+func (_u *ScanScheduleRunUpdate) SetNillableScanID(v *uuid.UUID) *ScanScheduleRunUpdate {
+	if v != nil {
+		_u.SetScanID(*v)
+	}
+	return _u
+}
+
+// ClearScanID clears the value of the "scan_id" field.
+func (_u *ScanScheduleRunUpdate) ClearScanID() *ScanScheduleRunUpdate {
+	_u.mutation.ClearScanID()
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *ScanScheduleRunUpdate) SetReason(v string) *ScanScheduleRunUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *ScanScheduleRunUpdate) SetNillableReason(v *string) *ScanScheduleRunUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *ScanScheduleRunUpdate) ClearReason() *ScanScheduleRunUpdate {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *ScanScheduleRunUpdate) SetTenant(v *Tenant) *ScanScheduleRunUpdate {
+	return _u.SetTenantID(v.ID)
+}
+
+// SetSchedule sets the "schedule" edge to the ScanSchedule entity.
+func (_u *ScanScheduleRunUpdate) SetSchedule(v *ScanSchedule) *ScanScheduleRunUpdate {
+	return _u.SetScheduleID(v.ID)
+}
+
+// Mutation returns the ScanScheduleRunMutation object of the builder.
+func (_u *ScanScheduleRunUpdate) Mutation() *ScanScheduleRunMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *ScanScheduleRunUpdate) ClearTenant() *ScanScheduleRunUpdate {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearSchedule clears the "schedule" edge to the ScanSchedule entity.
+func (_u *ScanScheduleRunUpdate) ClearSchedule() *ScanScheduleRunUpdate {
+	_u.mutation.ClearSchedule()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ScanScheduleRunUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ScanScheduleRunUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ScanScheduleRunUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ScanScheduleRunUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ScanScheduleRunUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if scanschedulerun.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized scanschedulerun.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := scanschedulerun.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ScanScheduleRunUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := scanschedulerun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ScanScheduleRun.status": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ScanScheduleRun.tenant"`)
+	}
+	if _u.mutation.ScheduleCleared() && len(_u.mutation.ScheduleIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ScanScheduleRun.schedule"`)
+	}
+	return nil
+}
+
+func (_u *ScanScheduleRunUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(scanschedulerun.Table, scanschedulerun.Columns, sqlgraph.NewFieldSpec(scanschedulerun.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(scanschedulerun.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ScheduledFor(); ok {
+		_spec.SetField(scanschedulerun.FieldScheduledFor, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(scanschedulerun.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ScanID(); ok {
+		_spec.SetField(scanschedulerun.FieldScanID, field.TypeUUID, value)
+	}
+	if _u.mutation.ScanIDCleared() {
+		_spec.ClearField(scanschedulerun.FieldScanID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(scanschedulerun.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(scanschedulerun.FieldReason, field.TypeString)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scanschedulerun.TenantTable,
+			Columns: []string{scanschedulerun.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scanschedulerun.TenantTable,
+			Columns: []string{scanschedulerun.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ScheduleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scanschedulerun.ScheduleTable,
+			Columns: []string{scanschedulerun.ScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scanschedule.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ScheduleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scanschedulerun.ScheduleTable,
+			Columns: []string{scanschedulerun.ScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scanschedule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scanschedulerun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ScanScheduleRunUpdateOne is the builder for updating a single ScanScheduleRun entity.
+type ScanScheduleRunUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ScanScheduleRunMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ScanScheduleRunUpdateOne) SetUpdateTime(v time.Time) *ScanScheduleRunUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *ScanScheduleRunUpdateOne) SetTenantID(v uuid.UUID) *ScanScheduleRunUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *ScanScheduleRunUpdateOne) SetNillableTenantID(v *uuid.UUID) *ScanScheduleRunUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetScheduleID sets the "schedule_id" field.
+func (_u *ScanScheduleRunUpdateOne) SetScheduleID(v uuid.UUID) *ScanScheduleRunUpdateOne {
+	_u.mutation.SetScheduleID(v)
+	return _u
+}
+
+// SetNillableScheduleID sets the "schedule_id" field if the given value is not nil.
+func (_u *ScanScheduleRunUpdateOne) SetNillableScheduleID(v *uuid.UUID) *ScanScheduleRunUpdateOne {
+	if v != nil {
+		_u.SetScheduleID(*v)
+	}
+	return _u
+}
+
+// SetScheduledFor sets the "scheduled_for" field.
+func (_u *ScanScheduleRunUpdateOne) SetScheduledFor(v time.Time) *ScanScheduleRunUpdateOne {
+	_u.mutation.SetScheduledFor(v)
+	return _u
+}
+
+// SetNillableScheduledFor sets the "scheduled_for" field if the given value is not nil.
+func (_u *ScanScheduleRunUpdateOne) SetNillableScheduledFor(v *time.Time) *ScanScheduleRunUpdateOne {
+	if v != nil {
+		_u.SetScheduledFor(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ScanScheduleRunUpdateOne) SetStatus(v scanschedulerun.Status) *ScanScheduleRunUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ScanScheduleRunUpdateOne) SetNillableStatus(v *scanschedulerun.Status) *ScanScheduleRunUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetScanID sets the "scan_id" field.
+func (_u *ScanScheduleRunUpdateOne) SetScanID(v uuid.UUID) *ScanScheduleRunUpdateOne {
+	_u.mutation.SetScanID(v)
+	return _u
+}
+
+// SetNillableScanID sets the "scan_id" field if the given value is not nil.
+func (_u *ScanScheduleRunUpdateOne) SetNillableScanID(v *uuid.UUID) *ScanScheduleRunUpdateOne {
+	if v != nil {
+		_u.SetScanID(*v)
+	}
+	return _u
+}
+
+// ClearScanID clears the value of the "scan_id" field.
+func (_u *ScanScheduleRunUpdateOne) ClearScanID() *ScanScheduleRunUpdateOne {
+	_u.mutation.ClearScanID()
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *ScanScheduleRunUpdateOne) SetReason(v string) *ScanScheduleRunUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *ScanScheduleRunUpdateOne) SetNillableReason(v *string) *ScanScheduleRunUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *ScanScheduleRunUpdateOne) ClearReason() *ScanScheduleRunUpdateOne {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *ScanScheduleRunUpdateOne) SetTenant(v *Tenant) *ScanScheduleRunUpdateOne {
+	return _u.SetTenantID(v.ID)
+}
+
+// SetSchedule sets the "schedule" edge to the ScanSchedule entity.
+func (_u *ScanScheduleRunUpdateOne) SetSchedule(v *ScanSchedule) *ScanScheduleRunUpdateOne {
+	return _u.SetScheduleID(v.ID)
+}
+
+// Mutation returns the ScanScheduleRunMutation object of the builder.
+func (_u *ScanScheduleRunUpdateOne) Mutation() *ScanScheduleRunMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *ScanScheduleRunUpdateOne) ClearTenant() *ScanScheduleRunUpdateOne {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearSchedule clears the "schedule" edge to the ScanSchedule entity.
+func (_u *ScanScheduleRunUpdateOne) ClearSchedule() *ScanScheduleRunUpdateOne {
+	_u.mutation.ClearSchedule()
+	return _u
+}
+
+// Where appends a list predicates to the ScanScheduleRunUpdate builder.
+func (_u *ScanScheduleRunUpdateOne) Where(ps ...predicate.ScanScheduleRun) *ScanScheduleRunUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ScanScheduleRunUpdateOne) Select(field string, fields ...string) *ScanScheduleRunUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ScanScheduleRun entity.
+func (_u *ScanScheduleRunUpdateOne) Save(ctx context.Context) (*ScanScheduleRun, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ScanScheduleRunUpdateOne) SaveX(ctx context.Context) *ScanScheduleRun {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ScanScheduleRunUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ScanScheduleRunUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ScanScheduleRunUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if scanschedulerun.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized scanschedulerun.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := scanschedulerun.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ScanScheduleRunUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := scanschedulerun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ScanScheduleRun.status": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ScanScheduleRun.tenant"`)
+	}
+	if _u.mutation.ScheduleCleared() && len(_u.mutation.ScheduleIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ScanScheduleRun.schedule"`)
+	}
+	return nil
+}
+
+func (_u *ScanScheduleRunUpdateOne) sqlSave(ctx context.Context) (_node *ScanScheduleRun, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(scanschedulerun.Table, scanschedulerun.Columns, sqlgraph.NewFieldSpec(scanschedulerun.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ScanScheduleRun.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, scanschedulerun.FieldID)
+		for _, f := range fields {
+			if !scanschedulerun.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != scanschedulerun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(scanschedulerun.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ScheduledFor(); ok {
+		_spec.SetField(scanschedulerun.FieldScheduledFor, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(scanschedulerun.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ScanID(); ok {
+		_spec.SetField(scanschedulerun.FieldScanID, field.TypeUUID, value)
+	}
+	if _u.mutation.ScanIDCleared() {
+		_spec.ClearField(scanschedulerun.FieldScanID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(scanschedulerun.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(scanschedulerun.FieldReason, field.TypeString)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scanschedulerun.TenantTable,
+			Columns: []string{scanschedulerun.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scanschedulerun.TenantTable,
+			Columns: []string{scanschedulerun.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ScheduleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scanschedulerun.ScheduleTable,
+			Columns: []string{scanschedulerun.ScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scanschedule.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ScheduleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scanschedulerun.ScheduleTable,
+			Columns: []string{scanschedulerun.ScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scanschedule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ScanScheduleRun{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scanschedulerun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,291 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/mcmx/duplynx/ent/machine"
+	"github.com/mcmx/duplynx/ent/scan"
+	"github.com/mcmx/duplynx/ent/scantarget"
+	"github.com/mcmx/duplynx/ent/tenant"
+)
+
+// ScanTarget is the model entity for the ScanTarget schema.
+type ScanTarget struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// ScanID holds the value of the "scan_id" field.
+	ScanID uuid.UUID `json:"scan_id,omitempty"`
+	// MachineID holds the value of the "machine_id" field.
+	MachineID uuid.UUID `json:"machine_id,omitempty"`
+	// Roots holds the value of the "roots" field.
+	Roots []string `json:"roots,omitempty"`
+	// Exclusions holds the value of the "exclusions" field.
+	Exclusions []string `json:"exclusions,omitempty"`
+	// Status holds the value of the "status" field.
+	Status scantarget.Status `json:"status,omitempty"`
+	// StatusMessage holds the value of the "status_message" field.
+	StatusMessage string `json:"status_message,omitempty"`
+	// ClaimedAt holds the value of the "claimed_at" field.
+	ClaimedAt time.Time `json:"claimed_at,omitempty"`
+	// ReportedAt holds the value of the "reported_at" field.
+	ReportedAt time.Time `json:"reported_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ScanTargetQuery when eager-loading is set.
+	Edges        ScanTargetEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ScanTargetEdges holds the relations/edges for other nodes in the graph.
+type ScanTargetEdges struct {
+	// Tenant holds the value of the tenant edge.
+	Tenant *Tenant `json:"tenant,omitempty"`
+	// Scan holds the value of the scan edge.
+	Scan *Scan `json:"scan,omitempty"`
+	// Machine holds the value of the machine edge.
+	Machine *Machine `json:"machine,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// TenantOrErr returns the Tenant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ScanTargetEdges) TenantOrErr() (*Tenant, error) {
+	if e.Tenant != nil {
+		return e.Tenant, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: tenant.Label}
+	}
+	return nil, &NotLoadedError{edge: "tenant"}
+}
+
+// ScanOrErr returns the Scan value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ScanTargetEdges) ScanOrErr() (*Scan, error) {
+	if e.Scan != nil {
+		return e.Scan, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: scan.Label}
+	}
+	return nil, &NotLoadedError{edge: "scan"}
+}
+
+// MachineOrErr returns the Machine value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ScanTargetEdges) MachineOrErr() (*Machine, error) {
+	if e.Machine != nil {
+		return e.Machine, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: machine.Label}
+	}
+	return nil, &NotLoadedError{edge: "machine"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ScanTarget) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case scantarget.FieldRoots, scantarget.FieldExclusions:
+			values[i] = new([]byte)
+		case scantarget.FieldStatus, scantarget.FieldStatusMessage:
+			values[i] = new(sql.NullString)
+		case scantarget.FieldCreateTime, scantarget.FieldUpdateTime, scantarget.FieldClaimedAt, scantarget.FieldReportedAt:
+			values[i] = new(sql.NullTime)
+		case scantarget.FieldID, scantarget.FieldTenantID, scantarget.FieldScanID, scantarget.FieldMachineID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ScanTarget fields.
+func (_m *ScanTarget) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case scantarget.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case scantarget.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case scantarget.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = value.Time
+			}
+		case scantarget.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case scantarget.FieldScanID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field scan_id", values[i])
+			} else if value != nil {
+				_m.ScanID = *value
+			}
+		case scantarget.FieldMachineID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field machine_id", values[i])
+			} else if value != nil {
+				_m.MachineID = *value
+			}
+		case scantarget.FieldRoots:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field roots", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Roots); err != nil {
+					return fmt.Errorf("unmarshal field roots: %w", err)
+				}
+			}
+		case scantarget.FieldExclusions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field exclusions", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Exclusions); err != nil {
+					return fmt.Errorf("unmarshal field exclusions: %w", err)
+				}
+			}
+		case scantarget.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = scantarget.Status(value.String)
+			}
+		case scantarget.FieldStatusMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status_message", values[i])
+			} else if value.Valid {
+				_m.StatusMessage = value.String
+			}
+		case scantarget.FieldClaimedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field claimed_at", values[i])
+			} else if value.Valid {
+				_m.ClaimedAt = value.Time
+			}
+		case scantarget.FieldReportedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reported_at", values[i])
+			} else if value.Valid {
+				_m.ReportedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ScanTarget.
+// This includes values selected through modifiers, order, etc.
+func (_m *ScanTarget) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTenant queries the "tenant" edge of the ScanTarget entity.
+func (_m *ScanTarget) QueryTenant() *TenantQuery {
+	return NewScanTargetClient(_m.config).QueryTenant(_m)
+}
+
+// QueryScan queries the "scan" edge of the ScanTarget entity.
+func (_m *ScanTarget) QueryScan() *ScanQuery {
+	return NewScanTargetClient(_m.config).QueryScan(_m)
+}
+
+// QueryMachine queries the "machine" edge of the ScanTarget entity.
+func (_m *ScanTarget) QueryMachine() *MachineQuery {
+	return NewScanTargetClient(_m.config).QueryMachine(_m)
+}
+
+// Update returns a builder for updating this ScanTarget.
+// Note that you need to call ScanTarget.Unwrap() before calling this method if this ScanTarget
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ScanTarget) Update() *ScanTargetUpdateOne {
+	return NewScanTargetClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ScanTarget entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ScanTarget) Unwrap() *ScanTarget {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ScanTarget is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ScanTarget) String() string {
+	var builder strings.Builder
+	builder.WriteString("ScanTarget(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("scan_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ScanID))
+	builder.WriteString(", ")
+	builder.WriteString("machine_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MachineID))
+	builder.WriteString(", ")
+	builder.WriteString("roots=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Roots))
+	builder.WriteString(", ")
+	builder.WriteString("exclusions=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Exclusions))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("status_message=")
+	builder.WriteString(_m.StatusMessage)
+	builder.WriteString(", ")
+	builder.WriteString("claimed_at=")
+	builder.WriteString(_m.ClaimedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("reported_at=")
+	builder.WriteString(_m.ReportedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ScanTargets is a parsable slice of ScanTarget.
+type ScanTargets []*ScanTarget
@@ -0,0 +1,475 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/mcmx/duplynx/ent/machine"
+	"github.com/mcmx/duplynx/ent/scan"
+	"github.com/mcmx/duplynx/ent/scantarget"
+	"github.com/mcmx/duplynx/ent/tenant"
+)
+
+// ScanTargetCreate is the builder for creating a ScanTarget entity.
+type ScanTargetCreate struct {
+	config
+	mutation *ScanTargetMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *ScanTargetCreate) SetCreateTime(v time.Time) *ScanTargetCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *ScanTargetCreate) SetNillableCreateTime(v *time.Time) *ScanTargetCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *ScanTargetCreate) SetUpdateTime(v time.Time) *ScanTargetCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *ScanTargetCreate) SetNillableUpdateTime(v *time.Time) *ScanTargetCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *ScanTargetCreate) SetTenantID(v uuid.UUID) *ScanTargetCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetScanID sets the "scan_id" field.
+func (_c *ScanTargetCreate) SetScanID(v uuid.UUID) *ScanTargetCreate {
+	_c.mutation.SetScanID(v)
+	return _c
+}
+
+// SetMachineID sets the "machine_id" field.
+func (_c *ScanTargetCreate) SetMachineID(v uuid.UUID) *ScanTargetCreate {
+	_c.mutation.SetMachineID(v)
+	return _c
+}
+
+// SetRoots sets the "roots" field.
+func (_c *ScanTargetCreate) SetRoots(v []string) *ScanTargetCreate {
+	_c.mutation.SetRoots(v)
+	return _c
+}
+
+// SetExclusions sets the "exclusions" field.
+func (_c *ScanTargetCreate) SetExclusions(v []string) *ScanTargetCreate {
+	_c.mutation.SetExclusions(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ScanTargetCreate) SetStatus(v scantarget.Status) *ScanTargetCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ScanTargetCreate) SetNillableStatus(v *scantarget.Status) *ScanTargetCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetStatusMessage sets the "status_message" field.
+func (_c *ScanTargetCreate) SetStatusMessage(v string) *ScanTargetCreate {
+	_c.mutation.SetStatusMessage(v)
+	return _c
+}
+
+// SetNillableStatusMessage sets the "status_message" field if the given value is not nil.
+func (_c *ScanTargetCreate) SetNillableStatusMessage(v *string) *ScanTargetCreate {
+	if v != nil {
+		_c.SetStatusMessage(*v)
+	}
+	return _c
+}
+
+// SetClaimedAt sets the "claimed_at" field.
+func (_c *ScanTargetCreate) SetClaimedAt(v time.Time) *ScanTargetCreate {
+	_c.mutation.SetClaimedAt(v)
+	return _c
+}
+
+// SetNillableClaimedAt sets the "claimed_at" field if the given value is not nil.
+func (_c *ScanTargetCreate) SetNillableClaimedAt(v *time.Time) *ScanTargetCreate {
+	if v != nil {
+		_c.SetClaimedAt(*v)
+	}
+	return _c
+}
+
+// SetReportedAt sets the "reported_at" field.
+func (_c *ScanTargetCreate) SetReportedAt(v time.Time) *ScanTargetCreate {
+	_c.mutation.SetReportedAt(v)
+	return _c
+}
+
+// SetNillableReportedAt sets the "reported_at" field if the given value is not nil.
+func (_c *ScanTargetCreate) SetNillableReportedAt(v *time.Time) *ScanTargetCreate {
+	if v != nil {
+		_c.SetReportedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ScanTargetCreate) SetID(v uuid.UUID) *ScanTargetCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ScanTargetCreate) SetNillableID(v *uuid.UUID) *ScanTargetCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_c *ScanTargetCreate) SetTenant(v *Tenant) *ScanTargetCreate {
+	return _c.SetTenantID(v.ID)
+}
+
+// SetScan sets the "scan" edge to the Scan entity.
+func (_c *ScanTargetCreate) SetScan(v *Scan) *ScanTargetCreate {
+	return _c.SetScanID(v.ID)
+}
+
+// SetMachine sets the "machine" edge to the Machine entity.
+func (_c *ScanTargetCreate) SetMachine(v *Machine) *ScanTargetCreate {
+	return _c.SetMachineID(v.ID)
+}
+
+// Mutation returns the ScanTargetMutation object of the builder.
+func (_c *ScanTargetCreate) Mutation() *ScanTargetMutation {
+	return _c.mutation
+}
+
+// Save creates the ScanTarget in the database.
+func (_c *ScanTargetCreate) Save(ctx context.Context) (*ScanTarget, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ScanTargetCreate) SaveX(ctx context.Context) *ScanTarget {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ScanTargetCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ScanTargetCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ScanTargetCreate) defaults() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		if scantarget.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized scantarget.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
+		v := scantarget.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		if scantarget.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized scantarget.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := scantarget.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := scantarget.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if scantarget.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized scantarget.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := scantarget.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ScanTargetCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "ScanTarget.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "ScanTarget.update_time"`)}
+	}
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "ScanTarget.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.ScanID(); !ok {
+		return &ValidationError{Name: "scan_id", err: errors.New(`ent: missing required field "ScanTarget.scan_id"`)}
+	}
+	if _, ok := _c.mutation.MachineID(); !ok {
+		return &ValidationError{Name: "machine_id", err: errors.New(`ent: missing required field "ScanTarget.machine_id"`)}
+	}
+	if _, ok := _c.mutation.Roots(); !ok {
+		return &ValidationError{Name: "roots", err: errors.New(`ent: missing required field "ScanTarget.roots"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ScanTarget.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := scantarget.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ScanTarget.status": %w`, err)}
+		}
+	}
+	if len(_c.mutation.TenantIDs()) == 0 {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required edge "ScanTarget.tenant"`)}
+	}
+	if len(_c.mutation.ScanIDs()) == 0 {
+		return &ValidationError{Name: "scan", err: errors.New(`ent: missing required edge "ScanTarget.scan"`)}
+	}
+	if len(_c.mutation.MachineIDs()) == 0 {
+		return &ValidationError{Name: "machine", err: errors.New(`ent: missing required edge "ScanTarget.machine"`)}
+	}
+	return nil
+}
+
+func (_c *ScanTargetCreate) sqlSave(ctx context.Context) (*ScanTarget, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ScanTargetCreate) createSpec() (*ScanTarget, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ScanTarget{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(scantarget.Table, sqlgraph.NewFieldSpec(scantarget.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(scantarget.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(scantarget.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.Roots(); ok {
+		_spec.SetField(scantarget.FieldRoots, field.TypeJSON, value)
+		_node.Roots = value
+	}
+	if value, ok := _c.mutation.Exclusions(); ok {
+		_spec.SetField(scantarget.FieldExclusions, field.TypeJSON, value)
+		_node.Exclusions = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(scantarget.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.StatusMessage(); ok {
+		_spec.SetField(scantarget.FieldStatusMessage, field.TypeString, value)
+		_node.StatusMessage = value
+	}
+	if value, ok := _c.mutation.ClaimedAt(); ok {
+		_spec.SetField(scantarget.FieldClaimedAt, field.TypeTime, value)
+		_node.ClaimedAt = value
+	}
+	if value, ok := _c.mutation.ReportedAt(); ok {
+		_spec.SetField(scantarget.FieldReportedAt, field.TypeTime, value)
+		_node.ReportedAt = value
+	}
+	if nodes := _c.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scantarget.TenantTable,
+			Columns: []string{scantarget.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TenantID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ScanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scantarget.ScanTable,
+			Columns: []string{scantarget.ScanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scan.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ScanID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MachineIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scantarget.MachineTable,
+			Columns: []string{scantarget.MachineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(machine.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MachineID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ScanTargetCreateBulk is the builder for creating many ScanTarget entities in bulk.
+type ScanTargetCreateBulk struct {
+	config
+	err      error
+	builders []*ScanTargetCreate
+}
+
+// Save creates the ScanTarget entities in the database.
+func (_c *ScanTargetCreateBulk) Save(ctx context.Context) ([]*ScanTarget, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ScanTarget, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ScanTargetMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ScanTargetCreateBulk) SaveX(ctx context.Context) []*ScanTarget {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ScanTargetCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ScanTargetCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
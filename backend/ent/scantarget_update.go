@@ -0,0 +1,885 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/mcmx/duplynx/ent/machine"
+	"github.com/mcmx/duplynx/ent/predicate"
+	"github.com/mcmx/duplynx/ent/scan"
+	"github.com/mcmx/duplynx/ent/scantarget"
+	"github.com/mcmx/duplynx/ent/tenant"
+)
+
+// ScanTargetUpdate is the builder for updating ScanTarget entities.
+type ScanTargetUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ScanTargetMutation
+}
+
+// Where appends a list predicates to the ScanTargetUpdate builder.
+func (_u *ScanTargetUpdate) Where(ps ...predicate.ScanTarget) *ScanTargetUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ScanTargetUpdate) SetUpdateTime(v time.Time) *ScanTargetUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *ScanTargetUpdate) SetTenantID(v uuid.UUID) *ScanTargetUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *ScanTargetUpdate) SetNillableTenantID(v *uuid.UUID) *ScanTargetUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetScanID sets the "scan_id" field.
+func (_u *ScanTargetUpdate) SetScanID(v uuid.UUID) *ScanTargetUpdate {
+	_u.mutation.SetScanID(v)
+	return _u
+}
+
+// SetNillableScanID sets the "scan_id" field if the given value is not nil.
+func (_u *ScanTargetUpdate) SetNillableScanID(v *uuid.UUID) *ScanTargetUpdate {
+	if v != nil {
+		_u.SetScanID(*v)
+	}
+	return _u
+}
+
+// SetMachineID sets the "machine_id" field.
+func (_u *ScanTargetUpdate) SetMachineID(v uuid.UUID) *ScanTargetUpdate {
+	_u.mutation.SetMachineID(v)
+	return _u
+}
+
+// SetNillableMachineID sets the "machine_id" field if the given value is not nil.
+func (_u *ScanTargetUpdate) SetNillableMachineID(v *uuid.UUID) *ScanTargetUpdate {
+	if v != nil {
+		_u.SetMachineID(*v)
+	}
+	return _u
+}
+
+// SetRoots sets the "roots" field.
+func (_u *ScanTargetUpdate) SetRoots(v []string) *ScanTargetUpdate {
+	_u.mutation.SetRoots(v)
+	return _u
+}
+
+// AppendRoots appends value to the "roots" field.
+func (_u *ScanTargetUpdate) AppendRoots(v []string) *ScanTargetUpdate {
+	_u.mutation.AppendRoots(v)
+	return _u
+}
+
+// SetExclusions sets the "exclusions" field.
+func (_u *ScanTargetUpdate) SetExclusions(v []string) *ScanTargetUpdate {
+	_u.mutation.SetExclusions(v)
+	return _u
+}
+
+// AppendExclusions appends value to the "exclusions" field.
+func (_u *ScanTargetUpdate) AppendExclusions(v []string) *ScanTargetUpdate {
+	_u.mutation.AppendExclusions(v)
+	return _u
+}
+
+// ClearExclusions clears the value of the "exclusions" field.
+func (_u *ScanTargetUpdate) ClearExclusions() *ScanTargetUpdate {
+	_u.mutation.ClearExclusions()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ScanTargetUpdate) SetStatus(v scantarget.Status) *ScanTargetUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ScanTargetUpdate) SetNillableStatus(v *scantarget.Status) *ScanTargetUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetStatusMessage sets the "status_message" field.
+func (_u *ScanTargetUpdate) SetStatusMessage(v string) *ScanTargetUpdate {
+	_u.mutation.SetStatusMessage(v)
+	return _u
+}
+
+// SetNillableStatusMessage sets the "status_message" field if the given value is not nil.
+func (_u *ScanTargetUpdate) SetNillableStatusMessage(v *string) *ScanTargetUpdate {
+	if v != nil {
+		_u.SetStatusMessage(*v)
+	}
+	return _u
+}
+
+// ClearStatusMessage clears the value of the "status_message" field.
+func (_u *ScanTargetUpdate) ClearStatusMessage() *ScanTargetUpdate {
+	_u.mutation.ClearStatusMessage()
+	return _u
+}
+
+// SetClaimedAt sets the "claimed_at" field.
+func (_u *ScanTargetUpdate) SetClaimedAt(v time.Time) *ScanTargetUpdate {
+	_u.mutation.SetClaimedAt(v)
+	return _u
+}
+
+// SetNillableClaimedAt sets the "claimed_at" field if the given value is not nil.
+func (_u *ScanTargetUpdate) SetNillableClaimedAt(v *time.Time) *ScanTargetUpdate {
+	if v != nil {
+		_u.SetClaimedAt(*v)
+	}
+	return _u
+}
+
+// ClearClaimedAt clears the value of the "claimed_at" field.
+func (_u *ScanTargetUpdate) ClearClaimedAt() *ScanTargetUpdate {
+	_u.mutation.ClearClaimedAt()
+	return _u
+}
+
+// SetReportedAt sets the "reported_at" field.
+func (_u *ScanTargetUpdate) SetReportedAt(v time.Time) *ScanTargetUpdate {
+	_u.mutation.SetReportedAt(v)
+	return _u
+}
+
+// SetNillableReportedAt sets the "reported_at" field if the given value is not nil.
+func (_u *ScanTargetUpdate) SetNillableReportedAt(v *time.Time) *ScanTargetUpdate {
+	if v != nil {
+		_u.SetReportedAt(*v)
+	}
+	return _u
+}
+
+// ClearReportedAt clears the value of the "reported_at" field.
+func (_u *ScanTargetUpdate) ClearReportedAt() *ScanTargetUpdate {
+	_u.mutation.ClearReportedAt()
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *ScanTargetUpdate) SetTenant(v *Tenant) *ScanTargetUpdate {
+	return _u.SetTenantID(v.ID)
+}
+
+// SetScan sets the "scan" edge to the Scan entity.
+func (_u *ScanTargetUpdate) SetScan(v *Scan) *ScanTargetUpdate {
+	return _u.SetScanID(v.ID)
+}
+
+// SetMachine sets the "machine" edge to the Machine entity.
+func (_u *ScanTargetUpdate) SetMachine(v *Machine) *ScanTargetUpdate {
+	return _u.SetMachineID(v.ID)
+}
+
+// Mutation returns the ScanTargetMutation object of the builder.
+func (_u *ScanTargetUpdate) Mutation() *ScanTargetMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *ScanTargetUpdate) ClearTenant() *ScanTargetUpdate {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearScan clears the "scan" edge to the Scan entity.
+func (_u *ScanTargetUpdate) ClearScan() *ScanTargetUpdate {
+	_u.mutation.ClearScan()
+	return _u
+}
+
+// ClearMachine clears the "machine" edge to the Machine entity.
+func (_u *ScanTargetUpdate) ClearMachine() *ScanTargetUpdate {
+	_u.mutation.ClearMachine()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ScanTargetUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ScanTargetUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ScanTargetUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ScanTargetUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ScanTargetUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if scantarget.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized scantarget.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := scantarget.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ScanTargetUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := scantarget.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ScanTarget.status": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ScanTarget.tenant"`)
+	}
+	if _u.mutation.ScanCleared() && len(_u.mutation.ScanIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ScanTarget.scan"`)
+	}
+	if _u.mutation.MachineCleared() && len(_u.mutation.MachineIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ScanTarget.machine"`)
+	}
+	return nil
+}
+
+func (_u *ScanTargetUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(scantarget.Table, scantarget.Columns, sqlgraph.NewFieldSpec(scantarget.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(scantarget.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Roots(); ok {
+		_spec.SetField(scantarget.FieldRoots, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRoots(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, scantarget.FieldRoots, value)
+		})
+	}
+	if value, ok := _u.mutation.Exclusions(); ok {
+		_spec.SetField(scantarget.FieldExclusions, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedExclusions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, scantarget.FieldExclusions, value)
+		})
+	}
+	if _u.mutation.ExclusionsCleared() {
+		_spec.ClearField(scantarget.FieldExclusions, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(scantarget.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.StatusMessage(); ok {
+		_spec.SetField(scantarget.FieldStatusMessage, field.TypeString, value)
+	}
+	if _u.mutation.StatusMessageCleared() {
+		_spec.ClearField(scantarget.FieldStatusMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.ClaimedAt(); ok {
+		_spec.SetField(scantarget.FieldClaimedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClaimedAtCleared() {
+		_spec.ClearField(scantarget.FieldClaimedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ReportedAt(); ok {
+		_spec.SetField(scantarget.FieldReportedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReportedAtCleared() {
+		_spec.ClearField(scantarget.FieldReportedAt, field.TypeTime)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scantarget.TenantTable,
+			Columns: []string{scantarget.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scantarget.TenantTable,
+			Columns: []string{scantarget.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ScanCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scantarget.ScanTable,
+			Columns: []string{scantarget.ScanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scan.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ScanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scantarget.ScanTable,
+			Columns: []string{scantarget.ScanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scan.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MachineCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scantarget.MachineTable,
+			Columns: []string{scantarget.MachineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(machine.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MachineIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scantarget.MachineTable,
+			Columns: []string{scantarget.MachineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(machine.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scantarget.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ScanTargetUpdateOne is the builder for updating a single ScanTarget entity.
+type ScanTargetUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ScanTargetMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ScanTargetUpdateOne) SetUpdateTime(v time.Time) *ScanTargetUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *ScanTargetUpdateOne) SetTenantID(v uuid.UUID) *ScanTargetUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *ScanTargetUpdateOne) SetNillableTenantID(v *uuid.UUID) *ScanTargetUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetScanID sets the "scan_id" field.
+func (_u *ScanTargetUpdateOne) SetScanID(v uuid.UUID) *ScanTargetUpdateOne {
+	_u.mutation.SetScanID(v)
+	return _u
+}
+
+// SetNillableScanID sets the "scan_id" field if the given value is not nil.
+func (_u *ScanTargetUpdateOne) SetNillableScanID(v *uuid.UUID) *ScanTargetUpdateOne {
+	if v != nil {
+		_u.SetScanID(*v)
+	}
+	return _u
+}
+
+// SetMachineID sets the "machine_id" field.
+func (_u *ScanTargetUpdateOne) SetMachineID(v uuid.UUID) *ScanTargetUpdateOne {
+	_u.mutation.SetMachineID(v)
+	return _u
+}
+
+// SetNillableMachineID sets the "machine_id" field if the given value is not nil.
+func (_u *ScanTargetUpdateOne) SetNillableMachineID(v *uuid.UUID) *ScanTargetUpdateOne {
+	if v != nil {
+		_u.SetMachineID(*v)
+	}
+	return _u
+}
+
+// SetRoots sets the "roots" field.
+func (_u *ScanTargetUpdateOne) SetRoots(v []string) *ScanTargetUpdateOne {
+	_u.mutation.SetRoots(v)
+	return _u
+}
+
+// AppendRoots appends value to the "roots" field.
+func (_u *ScanTargetUpdateOne) AppendRoots(v []string) *ScanTargetUpdateOne {
+	_u.mutation.AppendRoots(v)
+	return _u
+}
+
+// SetExclusions sets the "exclusions" field.
+func (_u *ScanTargetUpdateOne) SetExclusions(v []string) *ScanTargetUpdateOne {
+	_u.mutation.SetExclusions(v)
+	return _u
+}
+
+// AppendExclusions appends value to the "exclusions" field.
+func (_u *ScanTargetUpdateOne) AppendExclusions(v []string) *ScanTargetUpdateOne {
+	_u.mutation.AppendExclusions(v)
+	return _u
+}
+
+// ClearExclusions clears the value of the "exclusions" field.
+func (_u *ScanTargetUpdateOne) ClearExclusions() *ScanTargetUpdateOne {
+	_u.mutation.ClearExclusions()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ScanTargetUpdateOne) SetStatus(v scantarget.Status) *ScanTargetUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ScanTargetUpdateOne) SetNillableStatus(v *scantarget.Status) *ScanTargetUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetStatusMessage sets the "status_message" field.
+func (_u *ScanTargetUpdateOne) SetStatusMessage(v string) *ScanTargetUpdateOne {
+	_u.mutation.SetStatusMessage(v)
+	return _u
+}
+
+// SetNillableStatusMessage sets the "status_message" field if the given value is not nil.
+func (_u *ScanTargetUpdateOne) SetNillableStatusMessage(v *string) *ScanTargetUpdateOne {
+	if v != nil {
+		_u.SetStatusMessage(*v)
+	}
+	return _u
+}
+
+// ClearStatusMessage clears the value of the "status_message" field.
+func (_u *ScanTargetUpdateOne) ClearStatusMessage() *ScanTargetUpdateOne {
+	_u.mutation.ClearStatusMessage()
+	return _u
+}
+
+// SetClaimedAt sets the "claimed_at" field.
+func (_u *ScanTargetUpdateOne) SetClaimedAt(v time.Time) *ScanTargetUpdateOne {
+	_u.mutation.SetClaimedAt(v)
+	return _u
+}
+
+// SetNillableClaimedAt sets the "claimed_at" field if the given value is not nil.
+func (_u *ScanTargetUpdateOne) SetNillableClaimedAt(v *time.Time) *ScanTargetUpdateOne {
+	if v != nil {
+		_u.SetClaimedAt(*v)
+	}
+	return _u
+}
+
+// ClearClaimedAt clears the value of the "claimed_at" field.
+func (_u *ScanTargetUpdateOne) ClearClaimedAt() *ScanTargetUpdateOne {
+	_u.mutation.ClearClaimedAt()
+	return _u
+}
+
+// SetReportedAt sets the "reported_at" field.
+func (_u *ScanTargetUpdateOne) SetReportedAt(v time.Time) *ScanTargetUpdateOne {
+	_u.mutation.SetReportedAt(v)
+	return _u
+}
+
+// SetNillableReportedAt sets the "reported_at" field if the given value is not nil.
+func (_u *ScanTargetUpdateOne) SetNillableReportedAt(v *time.Time) *ScanTargetUpdateOne {
+	if v != nil {
+		_u.SetReportedAt(*v)
+	}
+	return _u
+}
+
+// ClearReportedAt clears the value of the "reported_at" field.
+func (_u *ScanTargetUpdateOne) ClearReportedAt() *ScanTargetUpdateOne {
+	_u.mutation.ClearReportedAt()
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *ScanTargetUpdateOne) SetTenant(v *Tenant) *ScanTargetUpdateOne {
+	return _u.SetTenantID(v.ID)
+}
+
+// SetScan sets the "scan" edge to the Scan entity.
+func (_u *ScanTargetUpdateOne) SetScan(v *Scan) *ScanTargetUpdateOne {
+	return _u.SetScanID(v.ID)
+}
+
+// SetMachine sets the "machine" edge to the Machine entity.
+func (_u *ScanTargetUpdateOne) SetMachine(v *Machine) *ScanTargetUpdateOne {
+	return _u.SetMachineID(v.ID)
+}
+
+// Mutation returns the ScanTargetMutation object of the builder.
+func (_u *ScanTargetUpdateOne) Mutation() *ScanTargetMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *ScanTargetUpdateOne) ClearTenant() *ScanTargetUpdateOne {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearScan clears the "scan" edge to the Scan entity.
+func (_u *ScanTargetUpdateOne) ClearScan() *ScanTargetUpdateOne {
+	_u.mutation.ClearScan()
+	return _u
+}
+
+// ClearMachine clears the "machine" edge to the Machine entity.
+func (_u *ScanTargetUpdateOne) ClearMachine() *ScanTargetUpdateOne {
+	_u.mutation.ClearMachine()
+	return _u
+}
+
+// Where appends a list predicates to the ScanTargetUpdate builder.
+func (_u *ScanTargetUpdateOne) Where(ps ...predicate.ScanTarget) *ScanTargetUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ScanTargetUpdateOne) Select(field string, fields ...string) *ScanTargetUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ScanTarget entity.
+func (_u *ScanTargetUpdateOne) Save(ctx context.Context) (*ScanTarget, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ScanTargetUpdateOne) SaveX(ctx context.Context) *ScanTarget {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ScanTargetUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ScanTargetUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ScanTargetUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if scantarget.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized scantarget.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := scantarget.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ScanTargetUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := scantarget.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ScanTarget.status": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ScanTarget.tenant"`)
+	}
+	if _u.mutation.ScanCleared() && len(_u.mutation.ScanIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ScanTarget.scan"`)
+	}
+	if _u.mutation.MachineCleared() && len(_u.mutation.MachineIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ScanTarget.machine"`)
+	}
+	return nil
+}
+
+func (_u *ScanTargetUpdateOne) sqlSave(ctx context.Context) (_node *ScanTarget, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(scantarget.Table, scantarget.Columns, sqlgraph.NewFieldSpec(scantarget.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ScanTarget.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, scantarget.FieldID)
+		for _, f := range fields {
+			if !scantarget.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != scantarget.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(scantarget.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Roots(); ok {
+		_spec.SetField(scantarget.FieldRoots, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRoots(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, scantarget.FieldRoots, value)
+		})
+	}
+	if value, ok := _u.mutation.Exclusions(); ok {
+		_spec.SetField(scantarget.FieldExclusions, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedExclusions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, scantarget.FieldExclusions, value)
+		})
+	}
+	if _u.mutation.ExclusionsCleared() {
+		_spec.ClearField(scantarget.FieldExclusions, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(scantarget.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.StatusMessage(); ok {
+		_spec.SetField(scantarget.FieldStatusMessage, field.TypeString, value)
+	}
+	if _u.mutation.StatusMessageCleared() {
+		_spec.ClearField(scantarget.FieldStatusMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.ClaimedAt(); ok {
+		_spec.SetField(scantarget.FieldClaimedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClaimedAtCleared() {
+		_spec.ClearField(scantarget.FieldClaimedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ReportedAt(); ok {
+		_spec.SetField(scantarget.FieldReportedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReportedAtCleared() {
+		_spec.ClearField(scantarget.FieldReportedAt, field.TypeTime)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scantarget.TenantTable,
+			Columns: []string{scantarget.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scantarget.TenantTable,
+			Columns: []string{scantarget.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ScanCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scantarget.ScanTable,
+			Columns: []string{scantarget.ScanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scan.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ScanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scantarget.ScanTable,
+			Columns: []string{scantarget.ScanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scan.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MachineCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scantarget.MachineTable,
+			Columns: []string{scantarget.MachineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(machine.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MachineIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scantarget.MachineTable,
+			Columns: []string{scantarget.MachineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(machine.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ScanTarget{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scantarget.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -17,7 +17,7 @@ type ActionAudit struct {
 }
 
 func (ActionAudit) Mixin() []ent.Mixin {
-	return []ent.Mixin{mixin.Time{}}
+	return []ent.Mixin{mixin.Time{}, TenantScope{}}
 }
 
 func (ActionAudit) Fields() []ent.Field {
@@ -27,7 +27,7 @@ func (ActionAudit) Fields() []ent.Field {
 		field.UUID("duplicate_group_id", uuid.UUID{}),
 		field.String("actor").Default("system"),
 		field.Enum("action_type").
-			Values("assign_keeper", "delete_copies", "create_hardlinks", "quarantine", "retry", "note"),
+			Values("assign_keeper", "delete_copies", "create_hardlinks", "quarantine", "retry", "note", "action_queued", "action_failed"),
 		field.JSON("payload", map[string]any{}).Optional(),
 		field.Time("performed_at").Default(time.Now),
 		field.Bool("stubbed").Default(false),
@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"github.com/google/uuid"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// ActionJob hands one machine its part of an action on a duplicate group.
+// The agent on that machine claims the job by polling, acts on its own copies
+// and reports the outcome.
+type ActionJob struct {
+	ent.Schema
+}
+
+func (ActionJob) Mixin() []ent.Mixin {
+	return []ent.Mixin{mixin.Time{}, TenantScope{}}
+}
+
+func (ActionJob) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(func() uuid.UUID { return uuid.New() }),
+		field.UUID("tenant_id", uuid.UUID{}),
+		// action_id is shared by the jobs one request queued across machines.
+		field.UUID("action_id", uuid.UUID{}),
+		field.UUID("duplicate_group_id", uuid.UUID{}),
+		field.UUID("machine_id", uuid.UUID{}),
+		field.Enum("action").
+			Values("delete_copies", "create_hardlinks", "quarantine"),
+		field.String("hash"),
+		// keep_path is the copy on the machine that stays in place, if any.
+		field.String("keep_path").Optional(),
+		field.Strings("paths"),
+		field.String("actor").Default("system"),
+		field.Enum("status").
+			Values("pending", "claimed", "succeeded", "failed").
+			Default("pending"),
+		field.String("status_message").Optional(),
+		// done_paths lists the paths the agent acted on.
+		field.Strings("done_paths").Optional(),
+		field.Time("claimed_at").Optional(),
+		field.Time("finished_at").Optional(),
+	}
+}
+
+func (ActionJob) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tenant", Tenant.Type).
+			Ref("action_jobs").
+			Field("tenant_id").
+			Required().
+			Unique(),
+		edge.From("duplicate_group", DuplicateGroup.Type).
+			Ref("action_jobs").
+			Field("duplicate_group_id").
+			Required().
+			Unique(),
+		edge.From("machine", Machine.Type).
+			Ref("action_jobs").
+			Field("machine_id").
+			Required().
+			Unique(),
+	}
+}
+
+func (ActionJob) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("machine_id", "status"),
+		index.Fields("action_id"),
+	}
+}
@@ -0,0 +1,65 @@
+package schema
+
+import (
+	"github.com/google/uuid"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// ContentEvent records a copy of a content identity appearing in, or
+// disappearing from, a scan. Events outlive pruned file instances.
+type ContentEvent struct {
+	ent.Schema
+}
+
+func (ContentEvent) Mixin() []ent.Mixin {
+	return []ent.Mixin{mixin.Time{}, TenantScope{}}
+}
+
+func (ContentEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(func() uuid.UUID { return uuid.New() }),
+		field.UUID("tenant_id", uuid.UUID{}),
+		field.UUID("content_identity_id", uuid.UUID{}),
+		field.UUID("scan_id", uuid.UUID{}),
+		field.UUID("machine_id", uuid.UUID{}),
+		field.String("path"),
+		field.Enum("kind").Values("appeared", "disappeared"),
+		field.Time("occurred_at"),
+	}
+}
+
+func (ContentEvent) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tenant", Tenant.Type).
+			Ref("content_events").
+			Field("tenant_id").
+			Required().
+			Unique(),
+		edge.From("content_identity", ContentIdentity.Type).
+			Ref("events").
+			Field("content_identity_id").
+			Required().
+			Unique(),
+		edge.From("scan", Scan.Type).
+			Ref("content_events").
+			Field("scan_id").
+			Required().
+			Unique(),
+		edge.From("machine", Machine.Type).
+			Ref("content_events").
+			Field("machine_id").
+			Required().
+			Unique(),
+	}
+}
+
+func (ContentEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("content_identity_id", "occurred_at"),
+	}
+}
@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"github.com/google/uuid"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// ContentIdentity is one tenant's file content, keyed by hash, linking the
+// duplicate groups every scan found for it and the decisions made about it.
+type ContentIdentity struct {
+	ent.Schema
+}
+
+func (ContentIdentity) Mixin() []ent.Mixin {
+	return []ent.Mixin{mixin.Time{}, TenantScope{}}
+}
+
+func (ContentIdentity) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(func() uuid.UUID { return uuid.New() }),
+		field.UUID("tenant_id", uuid.UUID{}),
+		field.String("hash").NotEmpty(),
+		// keeper_machine_id and resolved_at carry the latest decision into new scans.
+		field.UUID("keeper_machine_id", uuid.UUID{}).Optional(),
+		field.Time("resolved_at").Optional(),
+		field.Time("first_seen_at"),
+		field.Time("last_seen_at"),
+	}
+}
+
+func (ContentIdentity) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tenant", Tenant.Type).
+			Ref("content_identities").
+			Field("tenant_id").
+			Required().
+			Unique(),
+		edge.From("keeper_machine", Machine.Type).
+			Ref("kept_identities").
+			Field("keeper_machine_id").
+			Unique(),
+		edge.To("duplicate_groups", DuplicateGroup.Type),
+		edge.To("events", ContentEvent.Type),
+	}
+}
+
+func (ContentIdentity) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "hash").Unique(),
+	}
+}
@@ -15,7 +15,7 @@ type DuplicateGroup struct {
 }
 
 func (DuplicateGroup) Mixin() []ent.Mixin {
-	return []ent.Mixin{mixin.Time{}}
+	return []ent.Mixin{mixin.Time{}, TenantScope{}}
 }
 
 func (DuplicateGroup) Fields() []ent.Field {
@@ -24,6 +24,7 @@ func (DuplicateGroup) Fields() []ent.Field {
 		field.UUID("tenant_id", uuid.UUID{}),
 		field.UUID("scan_id", uuid.UUID{}),
 		field.UUID("keeper_machine_id", uuid.UUID{}).Optional(),
+		field.UUID("content_identity_id", uuid.UUID{}).Optional(),
 		field.String("hash"),
 		field.Enum("status").Values("review", "action_needed", "resolved", "archived").Default("review"),
 		field.Int("file_count").Min(2),
@@ -47,7 +48,12 @@ func (DuplicateGroup) Edges() []ent.Edge {
 			Ref("keeper_groups").
 			Field("keeper_machine_id").
 			Unique(),
+		edge.From("content_identity", ContentIdentity.Type).
+			Ref("duplicate_groups").
+			Field("content_identity_id").
+			Unique(),
 		edge.To("file_instances", FileInstance.Type),
 		edge.To("action_audits", ActionAudit.Type),
+		edge.To("action_jobs", ActionJob.Type),
 	}
 }
@@ -17,12 +17,13 @@ type FileInstance struct {
 }
 
 func (FileInstance) Mixin() []ent.Mixin {
-	return []ent.Mixin{mixin.Time{}}
+	return []ent.Mixin{mixin.Time{}, TenantScope{}}
 }
 
 func (FileInstance) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).Default(func() uuid.UUID { return uuid.New() }),
+		field.UUID("tenant_id", uuid.UUID{}),
 		field.UUID("duplicate_group_id", uuid.UUID{}),
 		field.UUID("machine_id", uuid.UUID{}),
 		field.String("path"),
@@ -35,6 +36,11 @@ func (FileInstance) Fields() []ent.Field {
 
 func (FileInstance) Edges() []ent.Edge {
 	return []ent.Edge{
+		edge.From("tenant", Tenant.Type).
+			Ref("file_instances").
+			Field("tenant_id").
+			Required().
+			Unique(),
 		edge.From("duplicate_group", DuplicateGroup.Type).
 			Ref("file_instances").
 			Field("duplicate_group_id").
@@ -15,7 +15,7 @@ type Machine struct {
 }
 
 func (Machine) Mixin() []ent.Mixin {
-	return []ent.Mixin{mixin.Time{}}
+	return []ent.Mixin{mixin.Time{}, TenantScope{}}
 }
 
 func (Machine) Fields() []ent.Field {
@@ -23,10 +23,14 @@ func (Machine) Fields() []ent.Field {
 		field.UUID("id", uuid.UUID{}).Default(func() uuid.UUID { return uuid.New() }),
 		field.UUID("tenant_id", uuid.UUID{}),
 		field.String("name"),
-		field.Enum("category").Values("personal_laptop", "server"),
+		field.Enum("category").
+			Values("personal_laptop", "server", "workstation", "nas", "vm", "container", "cloud_bucket"),
 		field.String("hostname").Optional(),
 		field.String("role").Optional(),
 		field.Time("last_scan_at").Optional(),
+		// last_seen_at is stamped whenever the machine's agent polls for jobs.
+		field.Time("last_seen_at").Optional(),
+		field.Time("archived_at").Optional(),
 	}
 }
 
@@ -40,5 +44,10 @@ func (Machine) Edges() []ent.Edge {
 		edge.To("keeper_groups", DuplicateGroup.Type),
 		edge.To("file_instances", FileInstance.Type),
 		edge.To("initiated_scans", Scan.Type),
+		edge.To("kept_identities", ContentIdentity.Type),
+		edge.To("content_events", ContentEvent.Type),
+		edge.To("scan_targets", ScanTarget.Type),
+		edge.To("secrets", TenantSecret.Type),
+		edge.To("action_jobs", ActionJob.Type),
 	}
 }
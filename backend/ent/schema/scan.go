@@ -15,7 +15,7 @@ type Scan struct {
 }
 
 func (Scan) Mixin() []ent.Mixin {
-	return []ent.Mixin{mixin.Time{}}
+	return []ent.Mixin{mixin.Time{}, TenantScope{}}
 }
 
 func (Scan) Fields() []ent.Field {
@@ -28,6 +28,22 @@ func (Scan) Fields() []ent.Field {
 		field.Time("started_at"),
 		field.Time("completed_at").Optional(),
 		field.Int("duplicate_group_count").NonNegative(),
+		// files_pruned_at is set once retention dropped the scan's file
+		// instances; its groups keep their file counts and sizes.
+		field.Time("files_pruned_at").Optional(),
+		// status follows the agent's heartbeats; see scans.Status for the lifecycle.
+		field.Enum("status").
+			Values("pending", "running", "ingesting", "grouping", "completed", "partial", "failed", "cancelled").
+			Default("pending"),
+		// status_message explains a failure or cancellation.
+		field.String("status_message").Optional(),
+		field.Int("files_seen").NonNegative().Default(0),
+		field.Int64("bytes_hashed").NonNegative().Default(0),
+		field.Int("machines_reported").NonNegative().Default(0),
+		field.Time("last_heartbeat_at").Optional(),
+		// deadline bounds a multi-machine scan: targets that have not reported
+		// by then are marked missed and the scan ends partial or failed.
+		field.Time("deadline").Optional(),
 	}
 }
 
@@ -43,5 +59,7 @@ func (Scan) Edges() []ent.Edge {
 			Field("initiated_machine_id").
 			Unique(),
 		edge.To("duplicate_groups", DuplicateGroup.Type),
+		edge.To("content_events", ContentEvent.Type),
+		edge.To("targets", ScanTarget.Type),
 	}
 }
@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"github.com/google/uuid"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// ScanSchedule starts a multi-machine scan whenever its cron expression
+// fires in its timezone. Every target machine walks the same roots.
+type ScanSchedule struct {
+	ent.Schema
+}
+
+func (ScanSchedule) Mixin() []ent.Mixin {
+	return []ent.Mixin{mixin.Time{}, TenantScope{}}
+}
+
+func (ScanSchedule) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(func() uuid.UUID { return uuid.New() }),
+		field.UUID("tenant_id", uuid.UUID{}),
+		field.String("name"),
+		field.String("cron"),
+		field.String("timezone").Default("UTC"),
+		field.Strings("machine_ids"),
+		field.Strings("roots"),
+		field.Strings("exclusions").Optional(),
+		// deadline_seconds bounds each run; zero leaves runs without a deadline.
+		field.Int("deadline_seconds").NonNegative().Default(0),
+		field.Bool("enabled").Default(true),
+		field.Time("next_run_at"),
+		field.Time("last_run_at").Optional(),
+		field.UUID("last_scan_id", uuid.UUID{}).Optional(),
+	}
+}
+
+func (ScanSchedule) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tenant", Tenant.Type).
+			Ref("scan_schedules").
+			Field("tenant_id").
+			Required().
+			Unique(),
+		edge.To("runs", ScanScheduleRun.Type),
+	}
+}
+
+func (ScanSchedule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("enabled", "next_run_at"),
+	}
+}
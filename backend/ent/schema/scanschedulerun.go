@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"github.com/google/uuid"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// ScanScheduleRun records what happened at one of a schedule's fire times:
+// a scan was started, or the run was skipped, missed or failed to start.
+type ScanScheduleRun struct {
+	ent.Schema
+}
+
+func (ScanScheduleRun) Mixin() []ent.Mixin {
+	return []ent.Mixin{mixin.Time{}, TenantScope{}}
+}
+
+func (ScanScheduleRun) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(func() uuid.UUID { return uuid.New() }),
+		field.UUID("tenant_id", uuid.UUID{}),
+		field.UUID("schedule_id", uuid.UUID{}),
+		field.Time("scheduled_for"),
+		field.Enum("status").Values("started", "skipped", "missed", "failed"),
+		// scan_id is set for started runs.
+		field.UUID("scan_id", uuid.UUID{}).Optional(),
+		field.String("reason").Optional(),
+	}
+}
+
+func (ScanScheduleRun) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tenant", Tenant.Type).
+			Ref("scan_schedule_runs").
+			Field("tenant_id").
+			Required().
+			Unique(),
+		edge.From("schedule", ScanSchedule.Type).
+			Ref("runs").
+			Field("schedule_id").
+			Required().
+			Unique(),
+	}
+}
+
+func (ScanScheduleRun) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("schedule_id", "scheduled_for"),
+	}
+}
@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"github.com/google/uuid"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// ScanTarget assigns one machine, and the roots it should walk, to a
+// multi-machine scan. Agents claim their targets by polling and report once
+// their part of the scan is done.
+type ScanTarget struct {
+	ent.Schema
+}
+
+func (ScanTarget) Mixin() []ent.Mixin {
+	return []ent.Mixin{mixin.Time{}, TenantScope{}}
+}
+
+func (ScanTarget) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(func() uuid.UUID { return uuid.New() }),
+		field.UUID("tenant_id", uuid.UUID{}),
+		field.UUID("scan_id", uuid.UUID{}),
+		field.UUID("machine_id", uuid.UUID{}),
+		field.Strings("roots"),
+		field.Strings("exclusions").Optional(),
+		// status is pending until the machine's agent claims the target, and
+		// missed when the scan's deadline passed before it reported.
+		field.Enum("status").
+			Values("pending", "claimed", "reported", "failed", "missed").
+			Default("pending"),
+		field.String("status_message").Optional(),
+		field.Time("claimed_at").Optional(),
+		field.Time("reported_at").Optional(),
+	}
+}
+
+func (ScanTarget) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tenant", Tenant.Type).
+			Ref("scan_targets").
+			Field("tenant_id").
+			Required().
+			Unique(),
+		edge.From("scan", Scan.Type).
+			Ref("targets").
+			Field("scan_id").
+			Required().
+			Unique(),
+		edge.From("machine", Machine.Type).
+			Ref("scan_targets").
+			Field("machine_id").
+			Required().
+			Unique(),
+	}
+}
+
+func (ScanTarget) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("scan_id", "machine_id").Unique(),
+		index.Fields("machine_id", "status"),
+	}
+}
@@ -15,7 +15,7 @@ type Tenant struct {
 }
 
 func (Tenant) Mixin() []ent.Mixin {
-	return []ent.Mixin{mixin.Time{}}
+	return []ent.Mixin{mixin.Time{}, TenantScope{Field: "id"}}
 }
 
 func (Tenant) Fields() []ent.Field {
@@ -25,6 +25,16 @@ func (Tenant) Fields() []ent.Field {
 		field.String("name"),
 		field.String("description").Optional(),
 		field.String("primary_contact").Optional(),
+		field.Time("archived_at").Optional(),
+		// Quota overrides; nil inherits the server default and zero disables the limit.
+		field.Int64("quota_manifest_bytes").Optional().Nillable(),
+		field.Int("quota_ingest_per_minute").Optional().Nillable(),
+		field.Int("quota_machines").Optional().Nillable(),
+		field.Int("quota_retained_scans").Optional().Nillable(),
+		field.Int("quota_concurrent_actions").Optional().Nillable(),
+		// Retention overrides; nil inherits the server default and zero disables the rule.
+		field.Int("retention_keep_scans").Optional().Nillable(),
+		field.Int("retention_archive_after_days").Optional().Nillable(),
 	}
 }
 
@@ -34,5 +44,13 @@ func (Tenant) Edges() []ent.Edge {
 		edge.To("scans", Scan.Type),
 		edge.To("duplicate_groups", DuplicateGroup.Type),
 		edge.To("action_audits", ActionAudit.Type),
+		edge.To("secrets", TenantSecret.Type),
+		edge.To("file_instances", FileInstance.Type),
+		edge.To("content_identities", ContentIdentity.Type),
+		edge.To("content_events", ContentEvent.Type),
+		edge.To("scan_targets", ScanTarget.Type),
+		edge.To("scan_schedules", ScanSchedule.Type),
+		edge.To("scan_schedule_runs", ScanScheduleRun.Type),
+		edge.To("action_jobs", ActionJob.Type),
 	}
 }
@@ -0,0 +1,86 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/mixin"
+
+	"github.com/mcmx/duplynx/ent/intercept"
+	"github.com/mcmx/duplynx/internal/tenancy/isolation"
+)
+
+// TenantScope confines every query and mutation on the mixed-in schema to the
+// tenant carried by the context (see internal/tenancy/isolation). Operations
+// without a scope fail closed unless the context is marked as system.
+type TenantScope struct {
+	mixin.Schema
+	// Field names the column holding the tenant ID; defaults to tenant_id.
+	Field string
+}
+
+func (m TenantScope) column() string {
+	if m.Field == "" {
+		return "tenant_id"
+	}
+	return m.Field
+}
+
+type wherePredicater interface {
+	WhereP(...func(*sql.Selector))
+}
+
+func (m TenantScope) Interceptors() []ent.Interceptor {
+	column := m.column()
+	return []ent.Interceptor{
+		intercept.TraverseFunc(func(ctx context.Context, q intercept.Query) error {
+			if isolation.IsSystem(ctx) {
+				return nil
+			}
+			tenantID, ok := isolation.TenantID(ctx)
+			if !ok {
+				return isolation.ErrUnscoped
+			}
+			q.WhereP(sql.FieldEQ(column, tenantID))
+			return nil
+		}),
+	}
+}
+
+func (m TenantScope) Hooks() []ent.Hook {
+	column := m.column()
+	return []ent.Hook{
+		func(next ent.Mutator) ent.Mutator {
+			return ent.MutateFunc(func(ctx context.Context, mutation ent.Mutation) (ent.Value, error) {
+				if isolation.IsSystem(ctx) {
+					return next.Mutate(ctx, mutation)
+				}
+				tenantID, ok := isolation.TenantID(ctx)
+				if !ok {
+					return nil, isolation.ErrUnscoped
+				}
+
+				if value, set := mutation.Field(column); set && value != tenantID {
+					return nil, isolation.ErrCrossTenant
+				}
+				if mutation.Op().Is(ent.OpCreate) {
+					if _, set := mutation.Field(column); !set {
+						if err := mutation.SetField(column, tenantID); err != nil {
+							return nil, err
+						}
+					}
+					return next.Mutate(ctx, mutation)
+				}
+
+				w, ok := mutation.(wherePredicater)
+				if !ok {
+					return nil, fmt.Errorf("tenant isolation: unexpected mutation type %T", mutation)
+				}
+				w.WhereP(sql.FieldEQ(column, tenantID))
+				return next.Mutate(ctx, mutation)
+			})
+		},
+	}
+}
@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// TenantSecret stores a versioned HMAC secret used to sign ingestion payloads.
+type TenantSecret struct {
+	ent.Schema
+}
+
+func (TenantSecret) Mixin() []ent.Mixin {
+	return []ent.Mixin{mixin.Time{}, TenantScope{}}
+}
+
+func (TenantSecret) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(func() uuid.UUID { return uuid.New() }),
+		field.UUID("tenant_id", uuid.UUID{}),
+		// machine_id binds the version to one machine's agent; tenant-wide versions leave it unset.
+		field.UUID("machine_id", uuid.UUID{}).Optional(),
+		field.String("key_id").NotEmpty(),
+		field.String("secret").NotEmpty().Sensitive(),
+		field.Time("not_before").Default(time.Now),
+		field.Time("expires_at").Optional(),
+		field.Time("revoked_at").Optional(),
+	}
+}
+
+func (TenantSecret) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tenant", Tenant.Type).
+			Ref("secrets").
+			Field("tenant_id").
+			Required().
+			Unique(),
+		edge.From("machine", Machine.Type).
+			Ref("secrets").
+			Field("machine_id").
+			Unique(),
+	}
+}
+
+func (TenantSecret) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "key_id").Unique(),
+	}
+}
@@ -0,0 +1,33 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+)
+
+// TenantTombstone records that a tenant's data was purged. It outlives the
+// tenant, so it carries no tenant edge and is not tenant scoped.
+type TenantTombstone struct {
+	ent.Schema
+}
+
+func (TenantTombstone) Mixin() []ent.Mixin {
+	return []ent.Mixin{mixin.Time{}}
+}
+
+func (TenantTombstone) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(func() uuid.UUID { return uuid.New() }),
+		field.UUID("tenant_id", uuid.UUID{}).Immutable(),
+		field.String("tenant_slug").Immutable(),
+		field.String("tenant_name").Immutable(),
+		field.String("actor").Default("system").Immutable(),
+		field.Time("purged_at").Default(time.Now).Immutable(),
+		field.JSON("deleted_rows", map[string]int{}).Optional().Immutable(),
+	}
+}
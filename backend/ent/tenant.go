@@ -30,6 +30,22 @@ type Tenant struct {
 	Description string `json:"description,omitempty"`
 	// PrimaryContact holds the value of the "primary_contact" field.
 	PrimaryContact string `json:"primary_contact,omitempty"`
+	// ArchivedAt holds the value of the "archived_at" field.
+	ArchivedAt time.Time `json:"archived_at,omitempty"`
+	// QuotaManifestBytes holds the value of the "quota_manifest_bytes" field.
+	QuotaManifestBytes *int64 `json:"quota_manifest_bytes,omitempty"`
+	// QuotaIngestPerMinute holds the value of the "quota_ingest_per_minute" field.
+	QuotaIngestPerMinute *int `json:"quota_ingest_per_minute,omitempty"`
+	// QuotaMachines holds the value of the "quota_machines" field.
+	QuotaMachines *int `json:"quota_machines,omitempty"`
+	// QuotaRetainedScans holds the value of the "quota_retained_scans" field.
+	QuotaRetainedScans *int `json:"quota_retained_scans,omitempty"`
+	// QuotaConcurrentActions holds the value of the "quota_concurrent_actions" field.
+	QuotaConcurrentActions *int `json:"quota_concurrent_actions,omitempty"`
+	// RetentionKeepScans holds the value of the "retention_keep_scans" field.
+	RetentionKeepScans *int `json:"retention_keep_scans,omitempty"`
+	// RetentionArchiveAfterDays holds the value of the "retention_archive_after_days" field.
+	RetentionArchiveAfterDays *int `json:"retention_archive_after_days,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TenantQuery when eager-loading is set.
 	Edges        TenantEdges `json:"edges"`
@@ -46,9 +62,25 @@ type TenantEdges struct {
 	DuplicateGroups []*DuplicateGroup `json:"duplicate_groups,omitempty"`
 	// ActionAudits holds the value of the action_audits edge.
 	ActionAudits []*ActionAudit `json:"action_audits,omitempty"`
+	// Secrets holds the value of the secrets edge.
+	Secrets []*TenantSecret `json:"secrets,omitempty"`
+	// FileInstances holds the value of the file_instances edge.
+	FileInstances []*FileInstance `json:"file_instances,omitempty"`
+	// ContentIdentities holds the value of the content_identities edge.
+	ContentIdentities []*ContentIdentity `json:"content_identities,omitempty"`
+	// ContentEvents holds the value of the content_events edge.
+	ContentEvents []*ContentEvent `json:"content_events,omitempty"`
+	// ScanTargets holds the value of the scan_targets edge.
+	ScanTargets []*ScanTarget `json:"scan_targets,omitempty"`
+	// ScanSchedules holds the value of the scan_schedules edge.
+	ScanSchedules []*ScanSchedule `json:"scan_schedules,omitempty"`
+	// ScanScheduleRuns holds the value of the scan_schedule_runs edge.
+	ScanScheduleRuns []*ScanScheduleRun `json:"scan_schedule_runs,omitempty"`
+	// ActionJobs holds the value of the action_jobs edge.
+	ActionJobs []*ActionJob `json:"action_jobs,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [12]bool
 }
 
 // MachinesOrErr returns the Machines value or an error if the edge
@@ -87,14 +119,88 @@ func (e TenantEdges) ActionAuditsOrErr() ([]*ActionAudit, error) {
 	return nil, &NotLoadedError{edge: "action_audits"}
 }
 
+// SecretsOrErr returns the Secrets value or an error if the edge
+// was not loaded in eager-loading.
+func (e TenantEdges) SecretsOrErr() ([]*TenantSecret, error) {
+	if e.loadedTypes[4] {
+		return e.Secrets, nil
+	}
+	return nil, &NotLoadedError{edge: "secrets"}
+}
+
+// FileInstancesOrErr returns the FileInstances value or an error if the edge
+// was not loaded in eager-loading.
+func (e TenantEdges) FileInstancesOrErr() ([]*FileInstance, error) {
+	if e.loadedTypes[5] {
+		return e.FileInstances, nil
+	}
+	return nil, &NotLoadedError{edge: "file_instances"}
+}
+
+// ContentIdentitiesOrErr returns the ContentIdentities value or an error if the edge
+// was not loaded in eager-loading.
+func (e TenantEdges) ContentIdentitiesOrErr() ([]*ContentIdentity, error) {
+	if e.loadedTypes[6] {
+		return e.ContentIdentities, nil
+	}
+	return nil, &NotLoadedError{edge: "content_identities"}
+}
+
+// ContentEventsOrErr returns the ContentEvents value or an error if the edge
+// was not loaded in eager-loading.
+func (e TenantEdges) ContentEventsOrErr() ([]*ContentEvent, error) {
+	if e.loadedTypes[7] {
+		return e.ContentEvents, nil
+	}
+	return nil, &NotLoadedError{edge: "content_events"}
+}
+
+// ScanTargetsOrErr returns the ScanTargets value or an error if the edge
+// was not loaded in eager-loading.
+func (e TenantEdges) ScanTargetsOrErr() ([]*ScanTarget, error) {
+	if e.loadedTypes[8] {
+		return e.ScanTargets, nil
+	}
+	return nil, &NotLoadedError{edge: "scan_targets"}
+}
+
+// ScanSchedulesOrErr returns the ScanSchedules value or an error if the edge
+// was not loaded in eager-loading.
+func (e TenantEdges) ScanSchedulesOrErr() ([]*ScanSchedule, error) {
+	if e.loadedTypes[9] {
+		return e.ScanSchedules, nil
+	}
+	return nil, &NotLoadedError{edge: "scan_schedules"}
+}
+
+// ScanScheduleRunsOrErr returns the ScanScheduleRuns value or an error if the edge
+// was not loaded in eager-loading.
+func (e TenantEdges) ScanScheduleRunsOrErr() ([]*ScanScheduleRun, error) {
+	if e.loadedTypes[10] {
+		return e.ScanScheduleRuns, nil
+	}
+	return nil, &NotLoadedError{edge: "scan_schedule_runs"}
+}
+
+// ActionJobsOrErr returns the ActionJobs value or an error if the edge
+// was not loaded in eager-loading.
+func (e TenantEdges) ActionJobsOrErr() ([]*ActionJob, error) {
+	if e.loadedTypes[11] {
+		return e.ActionJobs, nil
+	}
+	return nil, &NotLoadedError{edge: "action_jobs"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Tenant) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case tenant.FieldQuotaManifestBytes, tenant.FieldQuotaIngestPerMinute, tenant.FieldQuotaMachines, tenant.FieldQuotaRetainedScans, tenant.FieldQuotaConcurrentActions, tenant.FieldRetentionKeepScans, tenant.FieldRetentionArchiveAfterDays:
+			values[i] = new(sql.NullInt64)
 		case tenant.FieldSlug, tenant.FieldName, tenant.FieldDescription, tenant.FieldPrimaryContact:
 			values[i] = new(sql.NullString)
-		case tenant.FieldCreateTime, tenant.FieldUpdateTime:
+		case tenant.FieldCreateTime, tenant.FieldUpdateTime, tenant.FieldArchivedAt:
 			values[i] = new(sql.NullTime)
 		case tenant.FieldID:
 			values[i] = new(uuid.UUID)
@@ -155,6 +261,61 @@ func (_m *Tenant) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.PrimaryContact = value.String
 			}
+		case tenant.FieldArchivedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field archived_at", values[i])
+			} else if value.Valid {
+				_m.ArchivedAt = value.Time
+			}
+		case tenant.FieldQuotaManifestBytes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field quota_manifest_bytes", values[i])
+			} else if value.Valid {
+				_m.QuotaManifestBytes = new(int64)
+				*_m.QuotaManifestBytes = value.Int64
+			}
+		case tenant.FieldQuotaIngestPerMinute:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field quota_ingest_per_minute", values[i])
+			} else if value.Valid {
+				_m.QuotaIngestPerMinute = new(int)
+				*_m.QuotaIngestPerMinute = int(value.Int64)
+			}
+		case tenant.FieldQuotaMachines:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field quota_machines", values[i])
+			} else if value.Valid {
+				_m.QuotaMachines = new(int)
+				*_m.QuotaMachines = int(value.Int64)
+			}
+		case tenant.FieldQuotaRetainedScans:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field quota_retained_scans", values[i])
+			} else if value.Valid {
+				_m.QuotaRetainedScans = new(int)
+				*_m.QuotaRetainedScans = int(value.Int64)
+			}
+		case tenant.FieldQuotaConcurrentActions:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field quota_concurrent_actions", values[i])
+			} else if value.Valid {
+				_m.QuotaConcurrentActions = new(int)
+				*_m.QuotaConcurrentActions = int(value.Int64)
+			}
+		case tenant.FieldRetentionKeepScans:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field retention_keep_scans", values[i])
+			} else if value.Valid {
+				_m.RetentionKeepScans = new(int)
+				*_m.RetentionKeepScans = int(value.Int64)
+			}
+		case tenant.FieldRetentionArchiveAfterDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field retention_archive_after_days", values[i])
+			} else if value.Valid {
+				_m.RetentionArchiveAfterDays = new(int)
+				*_m.RetentionArchiveAfterDays = int(value.Int64)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -188,6 +349,46 @@ func (_m *Tenant) QueryActionAudits() *ActionAuditQuery {
 	return NewTenantClient(_m.config).QueryActionAudits(_m)
 }
 
+// QuerySecrets queries the "secrets" edge of the Tenant entity.
+func (_m *Tenant) QuerySecrets() *TenantSecretQuery {
+	return NewTenantClient(_m.config).QuerySecrets(_m)
+}
+
+// QueryFileInstances queries the "file_instances" edge of the Tenant entity.
+func (_m *Tenant) QueryFileInstances() *FileInstanceQuery {
+	return NewTenantClient(_m.config).QueryFileInstances(_m)
+}
+
+// QueryContentIdentities queries the "content_identities" edge of the Tenant entity.
+func (_m *Tenant) QueryContentIdentities() *ContentIdentityQuery {
+	return NewTenantClient(_m.config).QueryContentIdentities(_m)
+}
+
+// QueryContentEvents queries the "content_events" edge of the Tenant entity.
+func (_m *Tenant) QueryContentEvents() *ContentEventQuery {
+	return NewTenantClient(_m.config).QueryContentEvents(_m)
+}
+
+// QueryScanTargets queries the "scan_targets" edge of the Tenant entity.
+func (_m *Tenant) QueryScanTargets() *ScanTargetQuery {
+	return NewTenantClient(_m.config).QueryScanTargets(_m)
+}
+
+// QueryScanSchedules queries the "scan_schedules" edge of the Tenant entity.
+func (_m *Tenant) QueryScanSchedules() *ScanScheduleQuery {
+	return NewTenantClient(_m.config).QueryScanSchedules(_m)
+}
+
+// QueryScanScheduleRuns queries the "scan_schedule_runs" edge of the Tenant entity.
+func (_m *Tenant) QueryScanScheduleRuns() *ScanScheduleRunQuery {
+	return NewTenantClient(_m.config).QueryScanScheduleRuns(_m)
+}
+
+// QueryActionJobs queries the "action_jobs" edge of the Tenant entity.
+func (_m *Tenant) QueryActionJobs() *ActionJobQuery {
+	return NewTenantClient(_m.config).QueryActionJobs(_m)
+}
+
 // Update returns a builder for updating this Tenant.
 // Note that you need to call Tenant.Unwrap() before calling this method if this Tenant
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -228,6 +429,44 @@ func (_m *Tenant) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("primary_contact=")
 	builder.WriteString(_m.PrimaryContact)
+	builder.WriteString(", ")
+	builder.WriteString("archived_at=")
+	builder.WriteString(_m.ArchivedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.QuotaManifestBytes; v != nil {
+		builder.WriteString("quota_manifest_bytes=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.QuotaIngestPerMinute; v != nil {
+		builder.WriteString("quota_ingest_per_minute=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.QuotaMachines; v != nil {
+		builder.WriteString("quota_machines=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.QuotaRetainedScans; v != nil {
+		builder.WriteString("quota_retained_scans=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.QuotaConcurrentActions; v != nil {
+		builder.WriteString("quota_concurrent_actions=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.RetentionKeepScans; v != nil {
+		builder.WriteString("retention_keep_scans=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.RetentionArchiveAfterDays; v != nil {
+		builder.WriteString("retention_archive_after_days=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -5,6 +5,7 @@ package tenant
 import (
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
@@ -27,6 +28,22 @@ const (
 	FieldDescription = "description"
 	// FieldPrimaryContact holds the string denoting the primary_contact field in the database.
 	FieldPrimaryContact = "primary_contact"
+	// FieldArchivedAt holds the string denoting the archived_at field in the database.
+	FieldArchivedAt = "archived_at"
+	// FieldQuotaManifestBytes holds the string denoting the quota_manifest_bytes field in the database.
+	FieldQuotaManifestBytes = "quota_manifest_bytes"
+	// FieldQuotaIngestPerMinute holds the string denoting the quota_ingest_per_minute field in the database.
+	FieldQuotaIngestPerMinute = "quota_ingest_per_minute"
+	// FieldQuotaMachines holds the string denoting the quota_machines field in the database.
+	FieldQuotaMachines = "quota_machines"
+	// FieldQuotaRetainedScans holds the string denoting the quota_retained_scans field in the database.
+	FieldQuotaRetainedScans = "quota_retained_scans"
+	// FieldQuotaConcurrentActions holds the string denoting the quota_concurrent_actions field in the database.
+	FieldQuotaConcurrentActions = "quota_concurrent_actions"
+	// FieldRetentionKeepScans holds the string denoting the retention_keep_scans field in the database.
+	FieldRetentionKeepScans = "retention_keep_scans"
+	// FieldRetentionArchiveAfterDays holds the string denoting the retention_archive_after_days field in the database.
+	FieldRetentionArchiveAfterDays = "retention_archive_after_days"
 	// EdgeMachines holds the string denoting the machines edge name in mutations.
 	EdgeMachines = "machines"
 	// EdgeScans holds the string denoting the scans edge name in mutations.
@@ -35,6 +52,22 @@ const (
 	EdgeDuplicateGroups = "duplicate_groups"
 	// EdgeActionAudits holds the string denoting the action_audits edge name in mutations.
 	EdgeActionAudits = "action_audits"
+	// EdgeSecrets holds the string denoting the secrets edge name in mutations.
+	EdgeSecrets = "secrets"
+	// EdgeFileInstances holds the string denoting the file_instances edge name in mutations.
+	EdgeFileInstances = "file_instances"
+	// EdgeContentIdentities holds the string denoting the content_identities edge name in mutations.
+	EdgeContentIdentities = "content_identities"
+	// EdgeContentEvents holds the string denoting the content_events edge name in mutations.
+	EdgeContentEvents = "content_events"
+	// EdgeScanTargets holds the string denoting the scan_targets edge name in mutations.
+	EdgeScanTargets = "scan_targets"
+	// EdgeScanSchedules holds the string denoting the scan_schedules edge name in mutations.
+	EdgeScanSchedules = "scan_schedules"
+	// EdgeScanScheduleRuns holds the string denoting the scan_schedule_runs edge name in mutations.
+	EdgeScanScheduleRuns = "scan_schedule_runs"
+	// EdgeActionJobs holds the string denoting the action_jobs edge name in mutations.
+	EdgeActionJobs = "action_jobs"
 	// Table holds the table name of the tenant in the database.
 	Table = "tenants"
 	// MachinesTable is the table that holds the machines relation/edge.
@@ -65,6 +98,62 @@ const (
 	ActionAuditsInverseTable = "action_audits"
 	// ActionAuditsColumn is the table column denoting the action_audits relation/edge.
 	ActionAuditsColumn = "tenant_id"
+	// SecretsTable is the table that holds the secrets relation/edge.
+	SecretsTable = "tenant_secrets"
+	// SecretsInverseTable is the table name for the TenantSecret entity.
+	// It exists in this package in order to avoid circular dependency with the "tenantsecret" package.
+	SecretsInverseTable = "tenant_secrets"
+	// SecretsColumn is the table column denoting the secrets relation/edge.
+	SecretsColumn = "tenant_id"
+	// FileInstancesTable is the table that holds the file_instances relation/edge.
+	FileInstancesTable = "file_instances"
+	// FileInstancesInverseTable is the table name for the FileInstance entity.
+	// It exists in this package in order to avoid circular dependency with the "fileinstance" package.
+	FileInstancesInverseTable = "file_instances"
+	// FileInstancesColumn is the table column denoting the file_instances relation/edge.
+	FileInstancesColumn = "tenant_id"
+	// ContentIdentitiesTable is the table that holds the content_identities relation/edge.
+	ContentIdentitiesTable = "content_identities"
+	// ContentIdentitiesInverseTable is the table name for the ContentIdentity entity.
+	// It exists in this package in order to avoid circular dependency with the "contentidentity" package.
+	ContentIdentitiesInverseTable = "content_identities"
+	// ContentIdentitiesColumn is the table column denoting the content_identities relation/edge.
+	ContentIdentitiesColumn = "tenant_id"
+	// ContentEventsTable is the table that holds the content_events relation/edge.
+	ContentEventsTable = "content_events"
+	// ContentEventsInverseTable is the table name for the ContentEvent entity.
+	// It exists in this package in order to avoid circular dependency with the "contentevent" package.
+	ContentEventsInverseTable = "content_events"
+	// ContentEventsColumn is the table column denoting the content_events relation/edge.
+	ContentEventsColumn = "tenant_id"
+	// ScanTargetsTable is the table that holds the scan_targets relation/edge.
+	ScanTargetsTable = "scan_targets"
+	// ScanTargetsInverseTable is the table name for the ScanTarget entity.
+	// It exists in this package in order to avoid circular dependency with the "scantarget" package.
+	ScanTargetsInverseTable = "scan_targets"
+	// ScanTargetsColumn is the table column denoting the scan_targets relation/edge.
+	ScanTargetsColumn = "tenant_id"
+	// ScanSchedulesTable is the table that holds the scan_schedules relation/edge.
+	ScanSchedulesTable = "scan_schedules"
+	// ScanSchedulesInverseTable is the table name for the ScanSchedule entity.
+	// It exists in this package in order to avoid circular dependency with the "scanschedule" package.
+	ScanSchedulesInverseTable = "scan_schedules"
+	// ScanSchedulesColumn is the table column denoting the scan_schedules relation/edge.
+	ScanSchedulesColumn = "tenant_id"
+	// ScanScheduleRunsTable is the table that holds the scan_schedule_runs relation/edge.
+	ScanScheduleRunsTable = "scan_schedule_runs"
+	// ScanScheduleRunsInverseTable is the table name for the ScanScheduleRun entity.
+	// It exists in this package in order to avoid circular dependency with the "scanschedulerun" package.
+	ScanScheduleRunsInverseTable = "scan_schedule_runs"
+	// ScanScheduleRunsColumn is the table column denoting the scan_schedule_runs relation/edge.
+	ScanScheduleRunsColumn = "tenant_id"
+	// ActionJobsTable is the table that holds the action_jobs relation/edge.
+	ActionJobsTable = "action_jobs"
+	// ActionJobsInverseTable is the table name for the ActionJob entity.
+	// It exists in this package in order to avoid circular dependency with the "actionjob" package.
+	ActionJobsInverseTable = "action_jobs"
+	// ActionJobsColumn is the table column denoting the action_jobs relation/edge.
+	ActionJobsColumn = "tenant_id"
 )
 
 // Columns holds all SQL columns for tenant fields.
@@ -76,6 +165,14 @@ var Columns = []string{
 	FieldName,
 	FieldDescription,
 	FieldPrimaryContact,
+	FieldArchivedAt,
+	FieldQuotaManifestBytes,
+	FieldQuotaIngestPerMinute,
+	FieldQuotaMachines,
+	FieldQuotaRetainedScans,
+	FieldQuotaConcurrentActions,
+	FieldRetentionKeepScans,
+	FieldRetentionArchiveAfterDays,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -88,7 +185,14 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/mcmx/duplynx/ent/runtime"
 var (
+	Hooks        [1]ent.Hook
+	Interceptors [1]ent.Interceptor
 	// DefaultCreateTime holds the default value on creation for the "create_time" field.
 	DefaultCreateTime func() time.Time
 	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
@@ -137,6 +241,46 @@ func ByPrimaryContact(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPrimaryContact, opts...).ToFunc()
 }
 
+// ByArchivedAt orders the results by the archived_at field.
+func ByArchivedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldArchivedAt, opts...).ToFunc()
+}
+
+// ByQuotaManifestBytes orders the results by the quota_manifest_bytes field.
+func ByQuotaManifestBytes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuotaManifestBytes, opts...).ToFunc()
+}
+
+// ByQuotaIngestPerMinute orders the results by the quota_ingest_per_minute field.
+func ByQuotaIngestPerMinute(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuotaIngestPerMinute, opts...).ToFunc()
+}
+
+// ByQuotaMachines orders the results by the quota_machines field.
+func ByQuotaMachines(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuotaMachines, opts...).ToFunc()
+}
+
+// ByQuotaRetainedScans orders the results by the quota_retained_scans field.
+func ByQuotaRetainedScans(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuotaRetainedScans, opts...).ToFunc()
+}
+
+// ByQuotaConcurrentActions orders the results by the quota_concurrent_actions field.
+func ByQuotaConcurrentActions(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuotaConcurrentActions, opts...).ToFunc()
+}
+
+// ByRetentionKeepScans orders the results by the retention_keep_scans field.
+func ByRetentionKeepScans(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRetentionKeepScans, opts...).ToFunc()
+}
+
+// ByRetentionArchiveAfterDays orders the results by the retention_archive_after_days field.
+func ByRetentionArchiveAfterDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRetentionArchiveAfterDays, opts...).ToFunc()
+}
+
 // ByMachinesCount orders the results by machines count.
 func ByMachinesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -192,6 +336,118 @@ func ByActionAudits(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newActionAuditsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// BySecretsCount orders the results by secrets count.
+func BySecretsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSecretsStep(), opts...)
+	}
+}
+
+// BySecrets orders the results by secrets terms.
+func BySecrets(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSecretsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByFileInstancesCount orders the results by file_instances count.
+func ByFileInstancesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newFileInstancesStep(), opts...)
+	}
+}
+
+// ByFileInstances orders the results by file_instances terms.
+func ByFileInstances(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFileInstancesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByContentIdentitiesCount orders the results by content_identities count.
+func ByContentIdentitiesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newContentIdentitiesStep(), opts...)
+	}
+}
+
+// ByContentIdentities orders the results by content_identities terms.
+func ByContentIdentities(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newContentIdentitiesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByContentEventsCount orders the results by content_events count.
+func ByContentEventsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newContentEventsStep(), opts...)
+	}
+}
+
+// ByContentEvents orders the results by content_events terms.
+func ByContentEvents(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newContentEventsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByScanTargetsCount orders the results by scan_targets count.
+func ByScanTargetsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newScanTargetsStep(), opts...)
+	}
+}
+
+// ByScanTargets orders the results by scan_targets terms.
+func ByScanTargets(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newScanTargetsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByScanSchedulesCount orders the results by scan_schedules count.
+func ByScanSchedulesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newScanSchedulesStep(), opts...)
+	}
+}
+
+// ByScanSchedules orders the results by scan_schedules terms.
+func ByScanSchedules(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newScanSchedulesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByScanScheduleRunsCount orders the results by scan_schedule_runs count.
+func ByScanScheduleRunsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newScanScheduleRunsStep(), opts...)
+	}
+}
+
+// ByScanScheduleRuns orders the results by scan_schedule_runs terms.
+func ByScanScheduleRuns(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newScanScheduleRunsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByActionJobsCount orders the results by action_jobs count.
+func ByActionJobsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newActionJobsStep(), opts...)
+	}
+}
+
+// ByActionJobs orders the results by action_jobs terms.
+func ByActionJobs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newActionJobsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newMachinesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -220,3 +476,59 @@ func newActionAuditsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ActionAuditsTable, ActionAuditsColumn),
 	)
 }
+func newSecretsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SecretsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SecretsTable, SecretsColumn),
+	)
+}
+func newFileInstancesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FileInstancesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, FileInstancesTable, FileInstancesColumn),
+	)
+}
+func newContentIdentitiesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ContentIdentitiesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ContentIdentitiesTable, ContentIdentitiesColumn),
+	)
+}
+func newContentEventsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ContentEventsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ContentEventsTable, ContentEventsColumn),
+	)
+}
+func newScanTargetsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ScanTargetsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ScanTargetsTable, ScanTargetsColumn),
+	)
+}
+func newScanSchedulesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ScanSchedulesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ScanSchedulesTable, ScanSchedulesColumn),
+	)
+}
+func newScanScheduleRunsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ScanScheduleRunsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ScanScheduleRunsTable, ScanScheduleRunsColumn),
+	)
+}
+func newActionJobsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ActionJobsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ActionJobsTable, ActionJobsColumn),
+	)
+}
@@ -86,6 +86,46 @@ func PrimaryContact(v string) predicate.Tenant {
 	return predicate.Tenant(sql.FieldEQ(FieldPrimaryContact, v))
 }
 
+// ArchivedAt applies equality check predicate on the "archived_at" field. It's identical to ArchivedAtEQ.
+func ArchivedAt(v time.Time) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEQ(FieldArchivedAt, v))
+}
+
+// QuotaManifestBytes applies equality check predicate on the "quota_manifest_bytes" field. It's identical to QuotaManifestBytesEQ.
+func QuotaManifestBytes(v int64) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEQ(FieldQuotaManifestBytes, v))
+}
+
+// QuotaIngestPerMinute applies equality check predicate on the "quota_ingest_per_minute" field. It's identical to QuotaIngestPerMinuteEQ.
+func QuotaIngestPerMinute(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEQ(FieldQuotaIngestPerMinute, v))
+}
+
+// QuotaMachines applies equality check predicate on the "quota_machines" field. It's identical to QuotaMachinesEQ.
+func QuotaMachines(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEQ(FieldQuotaMachines, v))
+}
+
+// QuotaRetainedScans applies equality check predicate on the "quota_retained_scans" field. It's identical to QuotaRetainedScansEQ.
+func QuotaRetainedScans(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEQ(FieldQuotaRetainedScans, v))
+}
+
+// QuotaConcurrentActions applies equality check predicate on the "quota_concurrent_actions" field. It's identical to QuotaConcurrentActionsEQ.
+func QuotaConcurrentActions(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEQ(FieldQuotaConcurrentActions, v))
+}
+
+// RetentionKeepScans applies equality check predicate on the "retention_keep_scans" field. It's identical to RetentionKeepScansEQ.
+func RetentionKeepScans(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEQ(FieldRetentionKeepScans, v))
+}
+
+// RetentionArchiveAfterDays applies equality check predicate on the "retention_archive_after_days" field. It's identical to RetentionArchiveAfterDaysEQ.
+func RetentionArchiveAfterDays(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEQ(FieldRetentionArchiveAfterDays, v))
+}
+
 // CreateTimeEQ applies the EQ predicate on the "create_time" field.
 func CreateTimeEQ(v time.Time) predicate.Tenant {
 	return predicate.Tenant(sql.FieldEQ(FieldCreateTime, v))
@@ -446,6 +486,406 @@ func PrimaryContactContainsFold(v string) predicate.Tenant {
 	return predicate.Tenant(sql.FieldContainsFold(FieldPrimaryContact, v))
 }
 
+// ArchivedAtEQ applies the EQ predicate on the "archived_at" field.
+func ArchivedAtEQ(v time.Time) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEQ(FieldArchivedAt, v))
+}
+
+// ArchivedAtNEQ applies the NEQ predicate on the "archived_at" field.
+func ArchivedAtNEQ(v time.Time) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNEQ(FieldArchivedAt, v))
+}
+
+// ArchivedAtIn applies the In predicate on the "archived_at" field.
+func ArchivedAtIn(vs ...time.Time) predicate.Tenant {
+	return predicate.Tenant(sql.FieldIn(FieldArchivedAt, vs...))
+}
+
+// ArchivedAtNotIn applies the NotIn predicate on the "archived_at" field.
+func ArchivedAtNotIn(vs ...time.Time) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNotIn(FieldArchivedAt, vs...))
+}
+
+// ArchivedAtGT applies the GT predicate on the "archived_at" field.
+func ArchivedAtGT(v time.Time) predicate.Tenant {
+	return predicate.Tenant(sql.FieldGT(FieldArchivedAt, v))
+}
+
+// ArchivedAtGTE applies the GTE predicate on the "archived_at" field.
+func ArchivedAtGTE(v time.Time) predicate.Tenant {
+	return predicate.Tenant(sql.FieldGTE(FieldArchivedAt, v))
+}
+
+// ArchivedAtLT applies the LT predicate on the "archived_at" field.
+func ArchivedAtLT(v time.Time) predicate.Tenant {
+	return predicate.Tenant(sql.FieldLT(FieldArchivedAt, v))
+}
+
+// ArchivedAtLTE applies the LTE predicate on the "archived_at" field.
+func ArchivedAtLTE(v time.Time) predicate.Tenant {
+	return predicate.Tenant(sql.FieldLTE(FieldArchivedAt, v))
+}
+
+// ArchivedAtIsNil applies the IsNil predicate on the "archived_at" field.
+func ArchivedAtIsNil() predicate.Tenant {
+	return predicate.Tenant(sql.FieldIsNull(FieldArchivedAt))
+}
+
+// ArchivedAtNotNil applies the NotNil predicate on the "archived_at" field.
+func ArchivedAtNotNil() predicate.Tenant {
+	return predicate.Tenant(sql.FieldNotNull(FieldArchivedAt))
+}
+
+// QuotaManifestBytesEQ applies the EQ predicate on the "quota_manifest_bytes" field.
+func QuotaManifestBytesEQ(v int64) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEQ(FieldQuotaManifestBytes, v))
+}
+
+// QuotaManifestBytesNEQ applies the NEQ predicate on the "quota_manifest_bytes" field.
+func QuotaManifestBytesNEQ(v int64) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNEQ(FieldQuotaManifestBytes, v))
+}
+
+// QuotaManifestBytesIn applies the In predicate on the "quota_manifest_bytes" field.
+func QuotaManifestBytesIn(vs ...int64) predicate.Tenant {
+	return predicate.Tenant(sql.FieldIn(FieldQuotaManifestBytes, vs...))
+}
+
+// QuotaManifestBytesNotIn applies the NotIn predicate on the "quota_manifest_bytes" field.
+func QuotaManifestBytesNotIn(vs ...int64) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNotIn(FieldQuotaManifestBytes, vs...))
+}
+
+// QuotaManifestBytesGT applies the GT predicate on the "quota_manifest_bytes" field.
+func QuotaManifestBytesGT(v int64) predicate.Tenant {
+	return predicate.Tenant(sql.FieldGT(FieldQuotaManifestBytes, v))
+}
+
+// QuotaManifestBytesGTE applies the GTE predicate on the "quota_manifest_bytes" field.
+func QuotaManifestBytesGTE(v int64) predicate.Tenant {
+	return predicate.Tenant(sql.FieldGTE(FieldQuotaManifestBytes, v))
+}
+
+// QuotaManifestBytesLT applies the LT predicate on the "quota_manifest_bytes" field.
+func QuotaManifestBytesLT(v int64) predicate.Tenant {
+	return predicate.Tenant(sql.FieldLT(FieldQuotaManifestBytes, v))
+}
+
+// QuotaManifestBytesLTE applies the LTE predicate on the "quota_manifest_bytes" field.
+func QuotaManifestBytesLTE(v int64) predicate.Tenant {
+	return predicate.Tenant(sql.FieldLTE(FieldQuotaManifestBytes, v))
+}
+
+// QuotaManifestBytesIsNil applies the IsNil predicate on the "quota_manifest_bytes" field.
+func QuotaManifestBytesIsNil() predicate.Tenant {
+	return predicate.Tenant(sql.FieldIsNull(FieldQuotaManifestBytes))
+}
+
+// QuotaManifestBytesNotNil applies the NotNil predicate on the "quota_manifest_bytes" field.
+func QuotaManifestBytesNotNil() predicate.Tenant {
+	return predicate.Tenant(sql.FieldNotNull(FieldQuotaManifestBytes))
+}
+
+// QuotaIngestPerMinuteEQ applies the EQ predicate on the "quota_ingest_per_minute" field.
+func QuotaIngestPerMinuteEQ(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEQ(FieldQuotaIngestPerMinute, v))
+}
+
+// QuotaIngestPerMinuteNEQ applies the NEQ predicate on the "quota_ingest_per_minute" field.
+func QuotaIngestPerMinuteNEQ(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNEQ(FieldQuotaIngestPerMinute, v))
+}
+
+// QuotaIngestPerMinuteIn applies the In predicate on the "quota_ingest_per_minute" field.
+func QuotaIngestPerMinuteIn(vs ...int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldIn(FieldQuotaIngestPerMinute, vs...))
+}
+
+// QuotaIngestPerMinuteNotIn applies the NotIn predicate on the "quota_ingest_per_minute" field.
+func QuotaIngestPerMinuteNotIn(vs ...int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNotIn(FieldQuotaIngestPerMinute, vs...))
+}
+
+// QuotaIngestPerMinuteGT applies the GT predicate on the "quota_ingest_per_minute" field.
+func QuotaIngestPerMinuteGT(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldGT(FieldQuotaIngestPerMinute, v))
+}
+
+// QuotaIngestPerMinuteGTE applies the GTE predicate on the "quota_ingest_per_minute" field.
+func QuotaIngestPerMinuteGTE(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldGTE(FieldQuotaIngestPerMinute, v))
+}
+
+// QuotaIngestPerMinuteLT applies the LT predicate on the "quota_ingest_per_minute" field.
+func QuotaIngestPerMinuteLT(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldLT(FieldQuotaIngestPerMinute, v))
+}
+
+// QuotaIngestPerMinuteLTE applies the LTE predicate on the "quota_ingest_per_minute" field.
+func QuotaIngestPerMinuteLTE(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldLTE(FieldQuotaIngestPerMinute, v))
+}
+
+// QuotaIngestPerMinuteIsNil applies the IsNil predicate on the "quota_ingest_per_minute" field.
+func QuotaIngestPerMinuteIsNil() predicate.Tenant {
+	return predicate.Tenant(sql.FieldIsNull(FieldQuotaIngestPerMinute))
+}
+
+// QuotaIngestPerMinuteNotNil applies the NotNil predicate on the "quota_ingest_per_minute" field.
+func QuotaIngestPerMinuteNotNil() predicate.Tenant {
+	return predicate.Tenant(sql.FieldNotNull(FieldQuotaIngestPerMinute))
+}
+
+// QuotaMachinesEQ applies the EQ predicate on the "quota_machines" field.
+func QuotaMachinesEQ(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEQ(FieldQuotaMachines, v))
+}
+
+// QuotaMachinesNEQ applies the NEQ predicate on the "quota_machines" field.
+func QuotaMachinesNEQ(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNEQ(FieldQuotaMachines, v))
+}
+
+// QuotaMachinesIn applies the In predicate on the "quota_machines" field.
+func QuotaMachinesIn(vs ...int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldIn(FieldQuotaMachines, vs...))
+}
+
+// QuotaMachinesNotIn applies the NotIn predicate on the "quota_machines" field.
+func QuotaMachinesNotIn(vs ...int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNotIn(FieldQuotaMachines, vs...))
+}
+
+// QuotaMachinesGT applies the GT predicate on the "quota_machines" field.
+func QuotaMachinesGT(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldGT(FieldQuotaMachines, v))
+}
+
+// QuotaMachinesGTE applies the GTE predicate on the "quota_machines" field.
+func QuotaMachinesGTE(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldGTE(FieldQuotaMachines, v))
+}
+
+// QuotaMachinesLT applies the LT predicate on the "quota_machines" field.
+func QuotaMachinesLT(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldLT(FieldQuotaMachines, v))
+}
+
+// QuotaMachinesLTE applies the LTE predicate on the "quota_machines" field.
+func QuotaMachinesLTE(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldLTE(FieldQuotaMachines, v))
+}
+
+// QuotaMachinesIsNil applies the IsNil predicate on the "quota_machines" field.
+func QuotaMachinesIsNil() predicate.Tenant {
+	return predicate.Tenant(sql.FieldIsNull(FieldQuotaMachines))
+}
+
+// QuotaMachinesNotNil applies the NotNil predicate on the "quota_machines" field.
+func QuotaMachinesNotNil() predicate.Tenant {
+	return predicate.Tenant(sql.FieldNotNull(FieldQuotaMachines))
+}
+
+// QuotaRetainedScansEQ applies the EQ predicate on the "quota_retained_scans" field.
+func QuotaRetainedScansEQ(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEQ(FieldQuotaRetainedScans, v))
+}
+
+// QuotaRetainedScansNEQ applies the NEQ predicate on the "quota_retained_scans" field.
+func QuotaRetainedScansNEQ(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNEQ(FieldQuotaRetainedScans, v))
+}
+
+// QuotaRetainedScansIn applies the In predicate on the "quota_retained_scans" field.
+func QuotaRetainedScansIn(vs ...int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldIn(FieldQuotaRetainedScans, vs...))
+}
+
+// QuotaRetainedScansNotIn applies the NotIn predicate on the "quota_retained_scans" field.
+func QuotaRetainedScansNotIn(vs ...int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNotIn(FieldQuotaRetainedScans, vs...))
+}
+
+// QuotaRetainedScansGT applies the GT predicate on the "quota_retained_scans" field.
+func QuotaRetainedScansGT(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldGT(FieldQuotaRetainedScans, v))
+}
+
+// QuotaRetainedScansGTE applies the GTE predicate on the "quota_retained_scans" field.
+func QuotaRetainedScansGTE(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldGTE(FieldQuotaRetainedScans, v))
+}
+
+// QuotaRetainedScansLT applies the LT predicate on the "quota_retained_scans" field.
+func QuotaRetainedScansLT(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldLT(FieldQuotaRetainedScans, v))
+}
+
+// QuotaRetainedScansLTE applies the LTE predicate on the "quota_retained_scans" field.
+func QuotaRetainedScansLTE(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldLTE(FieldQuotaRetainedScans, v))
+}
+
+// QuotaRetainedScansIsNil applies the IsNil predicate on the "quota_retained_scans" field.
+func QuotaRetainedScansIsNil() predicate.Tenant {
+	return predicate.Tenant(sql.FieldIsNull(FieldQuotaRetainedScans))
+}
+
+// QuotaRetainedScansNotNil applies the NotNil predicate on the "quota_retained_scans" field.
+func QuotaRetainedScansNotNil() predicate.Tenant {
+	return predicate.Tenant(sql.FieldNotNull(FieldQuotaRetainedScans))
+}
+
+// QuotaConcurrentActionsEQ applies the EQ predicate on the "quota_concurrent_actions" field.
+func QuotaConcurrentActionsEQ(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEQ(FieldQuotaConcurrentActions, v))
+}
+
+// QuotaConcurrentActionsNEQ applies the NEQ predicate on the "quota_concurrent_actions" field.
+func QuotaConcurrentActionsNEQ(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNEQ(FieldQuotaConcurrentActions, v))
+}
+
+// QuotaConcurrentActionsIn applies the In predicate on the "quota_concurrent_actions" field.
+func QuotaConcurrentActionsIn(vs ...int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldIn(FieldQuotaConcurrentActions, vs...))
+}
+
+// QuotaConcurrentActionsNotIn applies the NotIn predicate on the "quota_concurrent_actions" field.
+func QuotaConcurrentActionsNotIn(vs ...int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNotIn(FieldQuotaConcurrentActions, vs...))
+}
+
+// QuotaConcurrentActionsGT applies the GT predicate on the "quota_concurrent_actions" field.
+func QuotaConcurrentActionsGT(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldGT(FieldQuotaConcurrentActions, v))
+}
+
+// QuotaConcurrentActionsGTE applies the GTE predicate on the "quota_concurrent_actions" field.
+func QuotaConcurrentActionsGTE(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldGTE(FieldQuotaConcurrentActions, v))
+}
+
+// QuotaConcurrentActionsLT applies the LT predicate on the "quota_concurrent_actions" field.
+func QuotaConcurrentActionsLT(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldLT(FieldQuotaConcurrentActions, v))
+}
+
+// QuotaConcurrentActionsLTE applies the LTE predicate on the "quota_concurrent_actions" field.
+func QuotaConcurrentActionsLTE(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldLTE(FieldQuotaConcurrentActions, v))
+}
+
+// QuotaConcurrentActionsIsNil applies the IsNil predicate on the "quota_concurrent_actions" field.
+func QuotaConcurrentActionsIsNil() predicate.Tenant {
+	return predicate.Tenant(sql.FieldIsNull(FieldQuotaConcurrentActions))
+}
+
+// QuotaConcurrentActionsNotNil applies the NotNil predicate on the "quota_concurrent_actions" field.
+func QuotaConcurrentActionsNotNil() predicate.Tenant {
+	return predicate.Tenant(sql.FieldNotNull(FieldQuotaConcurrentActions))
+}
+
+// RetentionKeepScansEQ applies the EQ predicate on the "retention_keep_scans" field.
+func RetentionKeepScansEQ(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEQ(FieldRetentionKeepScans, v))
+}
+
+// RetentionKeepScansNEQ applies the NEQ predicate on the "retention_keep_scans" field.
+func RetentionKeepScansNEQ(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNEQ(FieldRetentionKeepScans, v))
+}
+
+// RetentionKeepScansIn applies the In predicate on the "retention_keep_scans" field.
+func RetentionKeepScansIn(vs ...int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldIn(FieldRetentionKeepScans, vs...))
+}
+
+// RetentionKeepScansNotIn applies the NotIn predicate on the "retention_keep_scans" field.
+func RetentionKeepScansNotIn(vs ...int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNotIn(FieldRetentionKeepScans, vs...))
+}
+
+// RetentionKeepScansGT applies the GT predicate on the "retention_keep_scans" field.
+func RetentionKeepScansGT(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldGT(FieldRetentionKeepScans, v))
+}
+
+// RetentionKeepScansGTE applies the GTE predicate on the "retention_keep_scans" field.
+func RetentionKeepScansGTE(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldGTE(FieldRetentionKeepScans, v))
+}
+
+// RetentionKeepScansLT applies the LT predicate on the "retention_keep_scans" field.
+func RetentionKeepScansLT(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldLT(FieldRetentionKeepScans, v))
+}
+
+// RetentionKeepScansLTE applies the LTE predicate on the "retention_keep_scans" field.
+func RetentionKeepScansLTE(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldLTE(FieldRetentionKeepScans, v))
+}
+
+// RetentionKeepScansIsNil applies the IsNil predicate on the "retention_keep_scans" field.
+func RetentionKeepScansIsNil() predicate.Tenant {
+	return predicate.Tenant(sql.FieldIsNull(FieldRetentionKeepScans))
+}
+
+// RetentionKeepScansNotNil applies the NotNil predicate on the "retention_keep_scans" field.
+func RetentionKeepScansNotNil() predicate.Tenant {
+	return predicate.Tenant(sql.FieldNotNull(FieldRetentionKeepScans))
+}
+
+// RetentionArchiveAfterDaysEQ applies the EQ predicate on the "retention_archive_after_days" field.
+func RetentionArchiveAfterDaysEQ(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEQ(FieldRetentionArchiveAfterDays, v))
+}
+
+// RetentionArchiveAfterDaysNEQ applies the NEQ predicate on the "retention_archive_after_days" field.
+func RetentionArchiveAfterDaysNEQ(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNEQ(FieldRetentionArchiveAfterDays, v))
+}
+
+// RetentionArchiveAfterDaysIn applies the In predicate on the "retention_archive_after_days" field.
+func RetentionArchiveAfterDaysIn(vs ...int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldIn(FieldRetentionArchiveAfterDays, vs...))
+}
+
+// RetentionArchiveAfterDaysNotIn applies the NotIn predicate on the "retention_archive_after_days" field.
+func RetentionArchiveAfterDaysNotIn(vs ...int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNotIn(FieldRetentionArchiveAfterDays, vs...))
+}
+
+// RetentionArchiveAfterDaysGT applies the GT predicate on the "retention_archive_after_days" field.
+func RetentionArchiveAfterDaysGT(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldGT(FieldRetentionArchiveAfterDays, v))
+}
+
+// RetentionArchiveAfterDaysGTE applies the GTE predicate on the "retention_archive_after_days" field.
+func RetentionArchiveAfterDaysGTE(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldGTE(FieldRetentionArchiveAfterDays, v))
+}
+
+// RetentionArchiveAfterDaysLT applies the LT predicate on the "retention_archive_after_days" field.
+func RetentionArchiveAfterDaysLT(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldLT(FieldRetentionArchiveAfterDays, v))
+}
+
+// RetentionArchiveAfterDaysLTE applies the LTE predicate on the "retention_archive_after_days" field.
+func RetentionArchiveAfterDaysLTE(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldLTE(FieldRetentionArchiveAfterDays, v))
+}
+
+// RetentionArchiveAfterDaysIsNil applies the IsNil predicate on the "retention_archive_after_days" field.
+func RetentionArchiveAfterDaysIsNil() predicate.Tenant {
+	return predicate.Tenant(sql.FieldIsNull(FieldRetentionArchiveAfterDays))
+}
+
+// RetentionArchiveAfterDaysNotNil applies the NotNil predicate on the "retention_archive_after_days" field.
+func RetentionArchiveAfterDaysNotNil() predicate.Tenant {
+	return predicate.Tenant(sql.FieldNotNull(FieldRetentionArchiveAfterDays))
+}
+
 // HasMachines applies the HasEdge predicate on the "machines" edge.
 func HasMachines() predicate.Tenant {
 	return predicate.Tenant(func(s *sql.Selector) {
@@ -538,6 +978,190 @@ func HasActionAuditsWith(preds ...predicate.ActionAudit) predicate.Tenant {
 	})
 }
 
+// HasSecrets applies the HasEdge predicate on the "secrets" edge.
+func HasSecrets() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SecretsTable, SecretsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSecretsWith applies the HasEdge predicate on the "secrets" edge with a given conditions (other predicates).
+func HasSecretsWith(preds ...predicate.TenantSecret) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := newSecretsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasFileInstances applies the HasEdge predicate on the "file_instances" edge.
+func HasFileInstances() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, FileInstancesTable, FileInstancesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFileInstancesWith applies the HasEdge predicate on the "file_instances" edge with a given conditions (other predicates).
+func HasFileInstancesWith(preds ...predicate.FileInstance) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := newFileInstancesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasContentIdentities applies the HasEdge predicate on the "content_identities" edge.
+func HasContentIdentities() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ContentIdentitiesTable, ContentIdentitiesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasContentIdentitiesWith applies the HasEdge predicate on the "content_identities" edge with a given conditions (other predicates).
+func HasContentIdentitiesWith(preds ...predicate.ContentIdentity) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := newContentIdentitiesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasContentEvents applies the HasEdge predicate on the "content_events" edge.
+func HasContentEvents() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ContentEventsTable, ContentEventsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasContentEventsWith applies the HasEdge predicate on the "content_events" edge with a given conditions (other predicates).
+func HasContentEventsWith(preds ...predicate.ContentEvent) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := newContentEventsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasScanTargets applies the HasEdge predicate on the "scan_targets" edge.
+func HasScanTargets() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ScanTargetsTable, ScanTargetsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasScanTargetsWith applies the HasEdge predicate on the "scan_targets" edge with a given conditions (other predicates).
+func HasScanTargetsWith(preds ...predicate.ScanTarget) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := newScanTargetsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasScanSchedules applies the HasEdge predicate on the "scan_schedules" edge.
+func HasScanSchedules() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ScanSchedulesTable, ScanSchedulesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasScanSchedulesWith applies the HasEdge predicate on the "scan_schedules" edge with a given conditions (other predicates).
+func HasScanSchedulesWith(preds ...predicate.ScanSchedule) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := newScanSchedulesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasScanScheduleRuns applies the HasEdge predicate on the "scan_schedule_runs" edge.
+func HasScanScheduleRuns() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ScanScheduleRunsTable, ScanScheduleRunsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasScanScheduleRunsWith applies the HasEdge predicate on the "scan_schedule_runs" edge with a given conditions (other predicates).
+func HasScanScheduleRunsWith(preds ...predicate.ScanScheduleRun) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := newScanScheduleRunsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasActionJobs applies the HasEdge predicate on the "action_jobs" edge.
+func HasActionJobs() predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ActionJobsTable, ActionJobsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasActionJobsWith applies the HasEdge predicate on the "action_jobs" edge with a given conditions (other predicates).
+func HasActionJobsWith(preds ...predicate.ActionJob) predicate.Tenant {
+	return predicate.Tenant(func(s *sql.Selector) {
+		step := newActionJobsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Tenant) predicate.Tenant {
 	return predicate.Tenant(sql.AndPredicates(predicates...))
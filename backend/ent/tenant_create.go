@@ -12,10 +12,18 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/mcmx/duplynx/ent/actionaudit"
+	"github.com/mcmx/duplynx/ent/actionjob"
+	"github.com/mcmx/duplynx/ent/contentevent"
+	"github.com/mcmx/duplynx/ent/contentidentity"
 	"github.com/mcmx/duplynx/ent/duplicategroup"
+	"github.com/mcmx/duplynx/ent/fileinstance"
 	"github.com/mcmx/duplynx/ent/machine"
 	"github.com/mcmx/duplynx/ent/scan"
+	"github.com/mcmx/duplynx/ent/scanschedule"
+	"github.com/mcmx/duplynx/ent/scanschedulerun"
+	"github.com/mcmx/duplynx/ent/scantarget"
 	"github.com/mcmx/duplynx/ent/tenant"
+	"github.com/mcmx/duplynx/ent/tenantsecret"
 )
 
 // TenantCreate is the builder for creating a Tenant entity.
@@ -93,6 +101,118 @@ func (_c *TenantCreate) SetNillablePrimaryContact(v *string) *TenantCreate {
 	return _c
 }
 
+// SetArchivedAt sets the "archived_at" field.
+func (_c *TenantCreate) SetArchivedAt(v time.Time) *TenantCreate {
+	_c.mutation.SetArchivedAt(v)
+	return _c
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (_c *TenantCreate) SetNillableArchivedAt(v *time.Time) *TenantCreate {
+	if v != nil {
+		_c.SetArchivedAt(*v)
+	}
+	return _c
+}
+
+// SetQuotaManifestBytes sets the "quota_manifest_bytes" field.
+func (_c *TenantCreate) SetQuotaManifestBytes(v int64) *TenantCreate {
+	_c.mutation.SetQuotaManifestBytes(v)
+	return _c
+}
+
+// SetNillableQuotaManifestBytes sets the "quota_manifest_bytes" field if the given value is not nil.
+func (_c *TenantCreate) SetNillableQuotaManifestBytes(v *int64) *TenantCreate {
+	if v != nil {
+		_c.SetQuotaManifestBytes(*v)
+	}
+	return _c
+}
+
+// SetQuotaIngestPerMinute sets the "quota_ingest_per_minute" field.
+func (_c *TenantCreate) SetQuotaIngestPerMinute(v int) *TenantCreate {
+	_c.mutation.SetQuotaIngestPerMinute(v)
+	return _c
+}
+
+// SetNillableQuotaIngestPerMinute sets the "quota_ingest_per_minute" field if the given value is not nil.
+func (_c *TenantCreate) SetNillableQuotaIngestPerMinute(v *int) *TenantCreate {
+	if v != nil {
+		_c.SetQuotaIngestPerMinute(*v)
+	}
+	return _c
+}
+
+// SetQuotaMachines sets the "quota_machines" field.
+func (_c *TenantCreate) SetQuotaMachines(v int) *TenantCreate {
+	_c.mutation.SetQuotaMachines(v)
+	return _c
+}
+
+// SetNillableQuotaMachines sets the "quota_machines" field if the given value is not nil.
+func (_c *TenantCreate) SetNillableQuotaMachines(v *int) *TenantCreate {
+	if v != nil {
+		_c.SetQuotaMachines(*v)
+	}
+	return _c
+}
+
+// SetQuotaRetainedScans sets the "quota_retained_scans" field.
+func (_c *TenantCreate) SetQuotaRetainedScans(v int) *TenantCreate {
+	_c.mutation.SetQuotaRetainedScans(v)
+	return _c
+}
+
+// SetNillableQuotaRetainedScans sets the "quota_retained_scans" field if the given value is not nil.
+func (_c *TenantCreate) SetNillableQuotaRetainedScans(v *int) *TenantCreate {
+	if v != nil {
+		_c.SetQuotaRetainedScans(*v)
+	}
+	return _c
+}
+
+// SetQuotaConcurrentActions sets the "quota_concurrent_actions" field.
+func (_c *TenantCreate) SetQuotaConcurrentActions(v int) *TenantCreate {
+	_c.mutation.SetQuotaConcurrentActions(v)
+	return _c
+}
+
+// SetNillableQuotaConcurrentActions sets the "quota_concurrent_actions" field if the given value is not nil.
+func (_c *TenantCreate) SetNillableQuotaConcurrentActions(v *int) *TenantCreate {
+	if v != nil {
+		_c.SetQuotaConcurrentActions(*v)
+	}
+	return _c
+}
+
+// SetRetentionKeepScans sets the "retention_keep_scans" field.
+func (_c *TenantCreate) SetRetentionKeepScans(v int) *TenantCreate {
+	_c.mutation.SetRetentionKeepScans(v)
+	return _c
+}
+
+// SetNillableRetentionKeepScans sets the "retention_keep_scans" field if the given value is not nil.
+func (_c *TenantCreate) SetNillableRetentionKeepScans(v *int) *TenantCreate {
+	if v != nil {
+		_c.SetRetentionKeepScans(*v)
+	}
+	return _c
+}
+
+// SetRetentionArchiveAfterDays sets the "retention_archive_after_days" field.
+func (_c *TenantCreate) SetRetentionArchiveAfterDays(v int) *TenantCreate {
+	_c.mutation.SetRetentionArchiveAfterDays(v)
+	return _c
+}
+
+// SetNillableRetentionArchiveAfterDays sets the "retention_archive_after_days" field if the given value is not nil.
+func (_c *TenantCreate) SetNillableRetentionArchiveAfterDays(v *int) *TenantCreate {
+	if v != nil {
+		_c.SetRetentionArchiveAfterDays(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *TenantCreate) SetID(v uuid.UUID) *TenantCreate {
 	_c.mutation.SetID(v)
@@ -167,6 +287,126 @@ func (_c *TenantCreate) AddActionAudits(v ...*ActionAudit) *TenantCreate {
 	return _c.AddActionAuditIDs(ids...)
 }
 
+// AddSecretIDs adds the "secrets" edge to the TenantSecret entity by IDs.
+func (_c *TenantCreate) AddSecretIDs(ids ...uuid.UUID) *TenantCreate {
+	_c.mutation.AddSecretIDs(ids...)
+	return _c
+}
+
+// AddSecrets adds the "secrets" edges to the TenantSecret entity.
+func (_c *TenantCreate) AddSecrets(v ...*TenantSecret) *TenantCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSecretIDs(ids...)
+}
+
+// AddFileInstanceIDs adds the "file_instances" edge to the FileInstance entity by IDs.
+func (_c *TenantCreate) AddFileInstanceIDs(ids ...uuid.UUID) *TenantCreate {
+	_c.mutation.AddFileInstanceIDs(ids...)
+	return _c
+}
+
+// AddFileInstances adds the "file_instances" edges to the FileInstance entity.
+func (_c *TenantCreate) AddFileInstances(v ...*FileInstance) *TenantCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddFileInstanceIDs(ids...)
+}
+
+// AddContentIdentityIDs adds the "content_identities" edge to the ContentIdentity entity by IDs.
+func (_c *TenantCreate) AddContentIdentityIDs(ids ...uuid.UUID) *TenantCreate {
+	_c.mutation.AddContentIdentityIDs(ids...)
+	return _c
+}
+
+// AddContentIdentities adds the "content_identities" edges to the ContentIdentity entity.
+func (_c *TenantCreate) AddContentIdentities(v ...*ContentIdentity) *TenantCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddContentIdentityIDs(ids...)
+}
+
+// AddContentEventIDs adds the "content_events" edge to the ContentEvent entity by IDs.
+func (_c *TenantCreate) AddContentEventIDs(ids ...uuid.UUID) *TenantCreate {
+	_c.mutation.AddContentEventIDs(ids...)
+	return _c
+}
+
+// AddContentEvents adds the "content_events" edges to the ContentEvent entity.
+func (_c *TenantCreate) AddContentEvents(v ...*ContentEvent) *TenantCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddContentEventIDs(ids...)
+}
+
+// AddScanTargetIDs adds the "scan_targets" edge to the ScanTarget entity by IDs.
+func (_c *TenantCreate) AddScanTargetIDs(ids ...uuid.UUID) *TenantCreate {
+	_c.mutation.AddScanTargetIDs(ids...)
+	return _c
+}
+
+// AddScanTargets adds the "scan_targets" edges to the ScanTarget entity.
+func (_c *TenantCreate) AddScanTargets(v ...*ScanTarget) *TenantCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddScanTargetIDs(ids...)
+}
+
+// AddScanScheduleIDs adds the "scan_schedules" edge to the ScanSchedule entity by IDs.
+func (_c *TenantCreate) AddScanScheduleIDs(ids ...uuid.UUID) *TenantCreate {
+	_c.mutation.AddScanScheduleIDs(ids...)
+	return _c
+}
+
+// AddScanSchedules adds the "scan_schedules" edges to the ScanSchedule entity.
+func (_c *TenantCreate) AddScanSchedules(v ...*ScanSchedule) *TenantCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddScanScheduleIDs(ids...)
+}
+
+// AddScanScheduleRunIDs adds the "scan_schedule_runs" edge to the ScanScheduleRun entity by IDs.
+func (_c *TenantCreate) AddScanScheduleRunIDs(ids ...uuid.UUID) *TenantCreate {
+	_c.mutation.AddScanScheduleRunIDs(ids...)
+	return _c
+}
+
+// AddScanScheduleRuns adds the "scan_schedule_runs" edges to the ScanScheduleRun entity.
+func (_c *TenantCreate) AddScanScheduleRuns(v ...*ScanScheduleRun) *TenantCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddScanScheduleRunIDs(ids...)
+}
+
+// AddActionJobIDs adds the "action_jobs" edge to the ActionJob entity by IDs.
+func (_c *TenantCreate) AddActionJobIDs(ids ...uuid.UUID) *TenantCreate {
+	_c.mutation.AddActionJobIDs(ids...)
+	return _c
+}
+
+// AddActionJobs adds the "action_jobs" edges to the ActionJob entity.
+func (_c *TenantCreate) AddActionJobs(v ...*ActionJob) *TenantCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddActionJobIDs(ids...)
+}
+
 // Mutation returns the TenantMutation object of the builder.
 func (_c *TenantCreate) Mutation() *TenantMutation {
 	return _c.mutation
@@ -174,7 +414,9 @@ func (_c *TenantCreate) Mutation() *TenantMutation {
 
 // Save creates the Tenant in the database.
 func (_c *TenantCreate) Save(ctx context.Context) (*Tenant, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -201,19 +443,29 @@ func (_c *TenantCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *TenantCreate) defaults() {
+func (_c *TenantCreate) defaults() error {
 	if _, ok := _c.mutation.CreateTime(); !ok {
+		if tenant.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized tenant.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
 		v := tenant.DefaultCreateTime()
 		_c.mutation.SetCreateTime(v)
 	}
 	if _, ok := _c.mutation.UpdateTime(); !ok {
+		if tenant.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized tenant.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
 		v := tenant.DefaultUpdateTime()
 		_c.mutation.SetUpdateTime(v)
 	}
 	if _, ok := _c.mutation.ID(); !ok {
+		if tenant.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized tenant.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := tenant.DefaultID()
 		_c.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -289,6 +541,38 @@ func (_c *TenantCreate) createSpec() (*Tenant, *sqlgraph.CreateSpec) {
 		_spec.SetField(tenant.FieldPrimaryContact, field.TypeString, value)
 		_node.PrimaryContact = value
 	}
+	if value, ok := _c.mutation.ArchivedAt(); ok {
+		_spec.SetField(tenant.FieldArchivedAt, field.TypeTime, value)
+		_node.ArchivedAt = value
+	}
+	if value, ok := _c.mutation.QuotaManifestBytes(); ok {
+		_spec.SetField(tenant.FieldQuotaManifestBytes, field.TypeInt64, value)
+		_node.QuotaManifestBytes = &value
+	}
+	if value, ok := _c.mutation.QuotaIngestPerMinute(); ok {
+		_spec.SetField(tenant.FieldQuotaIngestPerMinute, field.TypeInt, value)
+		_node.QuotaIngestPerMinute = &value
+	}
+	if value, ok := _c.mutation.QuotaMachines(); ok {
+		_spec.SetField(tenant.FieldQuotaMachines, field.TypeInt, value)
+		_node.QuotaMachines = &value
+	}
+	if value, ok := _c.mutation.QuotaRetainedScans(); ok {
+		_spec.SetField(tenant.FieldQuotaRetainedScans, field.TypeInt, value)
+		_node.QuotaRetainedScans = &value
+	}
+	if value, ok := _c.mutation.QuotaConcurrentActions(); ok {
+		_spec.SetField(tenant.FieldQuotaConcurrentActions, field.TypeInt, value)
+		_node.QuotaConcurrentActions = &value
+	}
+	if value, ok := _c.mutation.RetentionKeepScans(); ok {
+		_spec.SetField(tenant.FieldRetentionKeepScans, field.TypeInt, value)
+		_node.RetentionKeepScans = &value
+	}
+	if value, ok := _c.mutation.RetentionArchiveAfterDays(); ok {
+		_spec.SetField(tenant.FieldRetentionArchiveAfterDays, field.TypeInt, value)
+		_node.RetentionArchiveAfterDays = &value
+	}
 	if nodes := _c.mutation.MachinesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -353,6 +637,134 @@ func (_c *TenantCreate) createSpec() (*Tenant, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.SecretsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.SecretsTable,
+			Columns: []string{tenant.SecretsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenantsecret.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.FileInstancesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.FileInstancesTable,
+			Columns: []string{tenant.FileInstancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fileinstance.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ContentIdentitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ContentIdentitiesTable,
+			Columns: []string{tenant.ContentIdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentidentity.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ContentEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ContentEventsTable,
+			Columns: []string{tenant.ContentEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ScanTargetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ScanTargetsTable,
+			Columns: []string{tenant.ScanTargetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scantarget.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ScanSchedulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ScanSchedulesTable,
+			Columns: []string{tenant.ScanSchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scanschedule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ScanScheduleRunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ScanScheduleRunsTable,
+			Columns: []string{tenant.ScanScheduleRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scanschedulerun.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ActionJobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ActionJobsTable,
+			Columns: []string{tenant.ActionJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(actionjob.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -14,24 +14,40 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/mcmx/duplynx/ent/actionaudit"
+	"github.com/mcmx/duplynx/ent/actionjob"
+	"github.com/mcmx/duplynx/ent/contentevent"
+	"github.com/mcmx/duplynx/ent/contentidentity"
 	"github.com/mcmx/duplynx/ent/duplicategroup"
+	"github.com/mcmx/duplynx/ent/fileinstance"
 	"github.com/mcmx/duplynx/ent/machine"
 	"github.com/mcmx/duplynx/ent/predicate"
 	"github.com/mcmx/duplynx/ent/scan"
+	"github.com/mcmx/duplynx/ent/scanschedule"
+	"github.com/mcmx/duplynx/ent/scanschedulerun"
+	"github.com/mcmx/duplynx/ent/scantarget"
 	"github.com/mcmx/duplynx/ent/tenant"
+	"github.com/mcmx/duplynx/ent/tenantsecret"
 )
 
 // TenantQuery is the builder for querying Tenant entities.
 type TenantQuery struct {
 	config
-	ctx                 *QueryContext
-	order               []tenant.OrderOption
-	inters              []Interceptor
-	predicates          []predicate.Tenant
-	withMachines        *MachineQuery
-	withScans           *ScanQuery
-	withDuplicateGroups *DuplicateGroupQuery
-	withActionAudits    *ActionAuditQuery
+	ctx                   *QueryContext
+	order                 []tenant.OrderOption
+	inters                []Interceptor
+	predicates            []predicate.Tenant
+	withMachines          *MachineQuery
+	withScans             *ScanQuery
+	withDuplicateGroups   *DuplicateGroupQuery
+	withActionAudits      *ActionAuditQuery
+	withSecrets           *TenantSecretQuery
+	withFileInstances     *FileInstanceQuery
+	withContentIdentities *ContentIdentityQuery
+	withContentEvents     *ContentEventQuery
+	withScanTargets       *ScanTargetQuery
+	withScanSchedules     *ScanScheduleQuery
+	withScanScheduleRuns  *ScanScheduleRunQuery
+	withActionJobs        *ActionJobQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -156,6 +172,182 @@ func (_q *TenantQuery) QueryActionAudits() *ActionAuditQuery {
 	return query
 }
 
+// QuerySecrets chains the current query on the "secrets" edge.
+func (_q *TenantQuery) QuerySecrets() *TenantSecretQuery {
+	query := (&TenantSecretClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, selector),
+			sqlgraph.To(tenantsecret.Table, tenantsecret.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.SecretsTable, tenant.SecretsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryFileInstances chains the current query on the "file_instances" edge.
+func (_q *TenantQuery) QueryFileInstances() *FileInstanceQuery {
+	query := (&FileInstanceClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, selector),
+			sqlgraph.To(fileinstance.Table, fileinstance.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.FileInstancesTable, tenant.FileInstancesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryContentIdentities chains the current query on the "content_identities" edge.
+func (_q *TenantQuery) QueryContentIdentities() *ContentIdentityQuery {
+	query := (&ContentIdentityClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, selector),
+			sqlgraph.To(contentidentity.Table, contentidentity.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.ContentIdentitiesTable, tenant.ContentIdentitiesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryContentEvents chains the current query on the "content_events" edge.
+func (_q *TenantQuery) QueryContentEvents() *ContentEventQuery {
+	query := (&ContentEventClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, selector),
+			sqlgraph.To(contentevent.Table, contentevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.ContentEventsTable, tenant.ContentEventsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryScanTargets chains the current query on the "scan_targets" edge.
+func (_q *TenantQuery) QueryScanTargets() *ScanTargetQuery {
+	query := (&ScanTargetClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, selector),
+			sqlgraph.To(scantarget.Table, scantarget.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.ScanTargetsTable, tenant.ScanTargetsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryScanSchedules chains the current query on the "scan_schedules" edge.
+func (_q *TenantQuery) QueryScanSchedules() *ScanScheduleQuery {
+	query := (&ScanScheduleClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, selector),
+			sqlgraph.To(scanschedule.Table, scanschedule.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.ScanSchedulesTable, tenant.ScanSchedulesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryScanScheduleRuns chains the current query on the "scan_schedule_runs" edge.
+func (_q *TenantQuery) QueryScanScheduleRuns() *ScanScheduleRunQuery {
+	query := (&ScanScheduleRunClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, selector),
+			sqlgraph.To(scanschedulerun.Table, scanschedulerun.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.ScanScheduleRunsTable, tenant.ScanScheduleRunsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryActionJobs chains the current query on the "action_jobs" edge.
+func (_q *TenantQuery) QueryActionJobs() *ActionJobQuery {
+	query := (&ActionJobClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, selector),
+			sqlgraph.To(actionjob.Table, actionjob.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.ActionJobsTable, tenant.ActionJobsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Tenant entity from the query.
 // Returns a *NotFoundError when no Tenant was found.
 func (_q *TenantQuery) First(ctx context.Context) (*Tenant, error) {
@@ -343,15 +535,23 @@ func (_q *TenantQuery) Clone() *TenantQuery {
 		return nil
 	}
 	return &TenantQuery{
-		config:              _q.config,
-		ctx:                 _q.ctx.Clone(),
-		order:               append([]tenant.OrderOption{}, _q.order...),
-		inters:              append([]Interceptor{}, _q.inters...),
-		predicates:          append([]predicate.Tenant{}, _q.predicates...),
-		withMachines:        _q.withMachines.Clone(),
-		withScans:           _q.withScans.Clone(),
-		withDuplicateGroups: _q.withDuplicateGroups.Clone(),
-		withActionAudits:    _q.withActionAudits.Clone(),
+		config:                _q.config,
+		ctx:                   _q.ctx.Clone(),
+		order:                 append([]tenant.OrderOption{}, _q.order...),
+		inters:                append([]Interceptor{}, _q.inters...),
+		predicates:            append([]predicate.Tenant{}, _q.predicates...),
+		withMachines:          _q.withMachines.Clone(),
+		withScans:             _q.withScans.Clone(),
+		withDuplicateGroups:   _q.withDuplicateGroups.Clone(),
+		withActionAudits:      _q.withActionAudits.Clone(),
+		withSecrets:           _q.withSecrets.Clone(),
+		withFileInstances:     _q.withFileInstances.Clone(),
+		withContentIdentities: _q.withContentIdentities.Clone(),
+		withContentEvents:     _q.withContentEvents.Clone(),
+		withScanTargets:       _q.withScanTargets.Clone(),
+		withScanSchedules:     _q.withScanSchedules.Clone(),
+		withScanScheduleRuns:  _q.withScanScheduleRuns.Clone(),
+		withActionJobs:        _q.withActionJobs.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -402,6 +602,94 @@ func (_q *TenantQuery) WithActionAudits(opts ...func(*ActionAuditQuery)) *Tenant
 	return _q
 }
 
+// WithSecrets tells the query-builder to eager-load the nodes that are connected to
+// the "secrets" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithSecrets(opts ...func(*TenantSecretQuery)) *TenantQuery {
+	query := (&TenantSecretClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSecrets = query
+	return _q
+}
+
+// WithFileInstances tells the query-builder to eager-load the nodes that are connected to
+// the "file_instances" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithFileInstances(opts ...func(*FileInstanceQuery)) *TenantQuery {
+	query := (&FileInstanceClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withFileInstances = query
+	return _q
+}
+
+// WithContentIdentities tells the query-builder to eager-load the nodes that are connected to
+// the "content_identities" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithContentIdentities(opts ...func(*ContentIdentityQuery)) *TenantQuery {
+	query := (&ContentIdentityClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withContentIdentities = query
+	return _q
+}
+
+// WithContentEvents tells the query-builder to eager-load the nodes that are connected to
+// the "content_events" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithContentEvents(opts ...func(*ContentEventQuery)) *TenantQuery {
+	query := (&ContentEventClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withContentEvents = query
+	return _q
+}
+
+// WithScanTargets tells the query-builder to eager-load the nodes that are connected to
+// the "scan_targets" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithScanTargets(opts ...func(*ScanTargetQuery)) *TenantQuery {
+	query := (&ScanTargetClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withScanTargets = query
+	return _q
+}
+
+// WithScanSchedules tells the query-builder to eager-load the nodes that are connected to
+// the "scan_schedules" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithScanSchedules(opts ...func(*ScanScheduleQuery)) *TenantQuery {
+	query := (&ScanScheduleClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withScanSchedules = query
+	return _q
+}
+
+// WithScanScheduleRuns tells the query-builder to eager-load the nodes that are connected to
+// the "scan_schedule_runs" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithScanScheduleRuns(opts ...func(*ScanScheduleRunQuery)) *TenantQuery {
+	query := (&ScanScheduleRunClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withScanScheduleRuns = query
+	return _q
+}
+
+// WithActionJobs tells the query-builder to eager-load the nodes that are connected to
+// the "action_jobs" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithActionJobs(opts ...func(*ActionJobQuery)) *TenantQuery {
+	query := (&ActionJobClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withActionJobs = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -480,11 +768,19 @@ func (_q *TenantQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Tenan
 	var (
 		nodes       = []*Tenant{}
 		_spec       = _q.querySpec()
-		loadedTypes = [4]bool{
+		loadedTypes = [12]bool{
 			_q.withMachines != nil,
 			_q.withScans != nil,
 			_q.withDuplicateGroups != nil,
 			_q.withActionAudits != nil,
+			_q.withSecrets != nil,
+			_q.withFileInstances != nil,
+			_q.withContentIdentities != nil,
+			_q.withContentEvents != nil,
+			_q.withScanTargets != nil,
+			_q.withScanSchedules != nil,
+			_q.withScanScheduleRuns != nil,
+			_q.withActionJobs != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -533,6 +829,62 @@ func (_q *TenantQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Tenan
 			return nil, err
 		}
 	}
+	if query := _q.withSecrets; query != nil {
+		if err := _q.loadSecrets(ctx, query, nodes,
+			func(n *Tenant) { n.Edges.Secrets = []*TenantSecret{} },
+			func(n *Tenant, e *TenantSecret) { n.Edges.Secrets = append(n.Edges.Secrets, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withFileInstances; query != nil {
+		if err := _q.loadFileInstances(ctx, query, nodes,
+			func(n *Tenant) { n.Edges.FileInstances = []*FileInstance{} },
+			func(n *Tenant, e *FileInstance) { n.Edges.FileInstances = append(n.Edges.FileInstances, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withContentIdentities; query != nil {
+		if err := _q.loadContentIdentities(ctx, query, nodes,
+			func(n *Tenant) { n.Edges.ContentIdentities = []*ContentIdentity{} },
+			func(n *Tenant, e *ContentIdentity) { n.Edges.ContentIdentities = append(n.Edges.ContentIdentities, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withContentEvents; query != nil {
+		if err := _q.loadContentEvents(ctx, query, nodes,
+			func(n *Tenant) { n.Edges.ContentEvents = []*ContentEvent{} },
+			func(n *Tenant, e *ContentEvent) { n.Edges.ContentEvents = append(n.Edges.ContentEvents, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withScanTargets; query != nil {
+		if err := _q.loadScanTargets(ctx, query, nodes,
+			func(n *Tenant) { n.Edges.ScanTargets = []*ScanTarget{} },
+			func(n *Tenant, e *ScanTarget) { n.Edges.ScanTargets = append(n.Edges.ScanTargets, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withScanSchedules; query != nil {
+		if err := _q.loadScanSchedules(ctx, query, nodes,
+			func(n *Tenant) { n.Edges.ScanSchedules = []*ScanSchedule{} },
+			func(n *Tenant, e *ScanSchedule) { n.Edges.ScanSchedules = append(n.Edges.ScanSchedules, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withScanScheduleRuns; query != nil {
+		if err := _q.loadScanScheduleRuns(ctx, query, nodes,
+			func(n *Tenant) { n.Edges.ScanScheduleRuns = []*ScanScheduleRun{} },
+			func(n *Tenant, e *ScanScheduleRun) { n.Edges.ScanScheduleRuns = append(n.Edges.ScanScheduleRuns, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withActionJobs; query != nil {
+		if err := _q.loadActionJobs(ctx, query, nodes,
+			func(n *Tenant) { n.Edges.ActionJobs = []*ActionJob{} },
+			func(n *Tenant, e *ActionJob) { n.Edges.ActionJobs = append(n.Edges.ActionJobs, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -656,6 +1008,246 @@ func (_q *TenantQuery) loadActionAudits(ctx context.Context, query *ActionAuditQ
 	}
 	return nil
 }
+func (_q *TenantQuery) loadSecrets(ctx context.Context, query *TenantSecretQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *TenantSecret)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Tenant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(tenantsecret.FieldTenantID)
+	}
+	query.Where(predicate.TenantSecret(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(tenant.SecretsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TenantID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "tenant_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *TenantQuery) loadFileInstances(ctx context.Context, query *FileInstanceQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *FileInstance)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Tenant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(fileinstance.FieldTenantID)
+	}
+	query.Where(predicate.FileInstance(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(tenant.FileInstancesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TenantID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "tenant_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *TenantQuery) loadContentIdentities(ctx context.Context, query *ContentIdentityQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *ContentIdentity)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Tenant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(contentidentity.FieldTenantID)
+	}
+	query.Where(predicate.ContentIdentity(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(tenant.ContentIdentitiesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TenantID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "tenant_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *TenantQuery) loadContentEvents(ctx context.Context, query *ContentEventQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *ContentEvent)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Tenant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(contentevent.FieldTenantID)
+	}
+	query.Where(predicate.ContentEvent(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(tenant.ContentEventsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TenantID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "tenant_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *TenantQuery) loadScanTargets(ctx context.Context, query *ScanTargetQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *ScanTarget)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Tenant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(scantarget.FieldTenantID)
+	}
+	query.Where(predicate.ScanTarget(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(tenant.ScanTargetsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TenantID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "tenant_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *TenantQuery) loadScanSchedules(ctx context.Context, query *ScanScheduleQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *ScanSchedule)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Tenant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(scanschedule.FieldTenantID)
+	}
+	query.Where(predicate.ScanSchedule(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(tenant.ScanSchedulesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TenantID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "tenant_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *TenantQuery) loadScanScheduleRuns(ctx context.Context, query *ScanScheduleRunQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *ScanScheduleRun)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Tenant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(scanschedulerun.FieldTenantID)
+	}
+	query.Where(predicate.ScanScheduleRun(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(tenant.ScanScheduleRunsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TenantID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "tenant_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *TenantQuery) loadActionJobs(ctx context.Context, query *ActionJobQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *ActionJob)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Tenant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(actionjob.FieldTenantID)
+	}
+	query.Where(predicate.ActionJob(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(tenant.ActionJobsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TenantID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "tenant_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *TenantQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -13,11 +13,19 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/mcmx/duplynx/ent/actionaudit"
+	"github.com/mcmx/duplynx/ent/actionjob"
+	"github.com/mcmx/duplynx/ent/contentevent"
+	"github.com/mcmx/duplynx/ent/contentidentity"
 	"github.com/mcmx/duplynx/ent/duplicategroup"
+	"github.com/mcmx/duplynx/ent/fileinstance"
 	"github.com/mcmx/duplynx/ent/machine"
 	"github.com/mcmx/duplynx/ent/predicate"
 	"github.com/mcmx/duplynx/ent/scan"
+	"github.com/mcmx/duplynx/ent/scanschedule"
+	"github.com/mcmx/duplynx/ent/scanschedulerun"
+	"github.com/mcmx/duplynx/ent/scantarget"
 	"github.com/mcmx/duplynx/ent/tenant"
+	"github.com/mcmx/duplynx/ent/tenantsecret"
 )
 
 // TenantUpdate is the builder for updating Tenant entities.
@@ -93,6 +101,215 @@ func (_u *TenantUpdate) ClearPrimaryContact() *TenantUpdate {
 	return _u
 }
 
+// SetArchivedAt sets the "archived_at" field.
+func (_u *TenantUpdate) SetArchivedAt(v time.Time) *TenantUpdate {
+	_u.mutation.SetArchivedAt(v)
+	return _u
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (_u *TenantUpdate) SetNillableArchivedAt(v *time.Time) *TenantUpdate {
+	if v != nil {
+		_u.SetArchivedAt(*v)
+	}
+	return _u
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (_u *TenantUpdate) ClearArchivedAt() *TenantUpdate {
+	_u.mutation.ClearArchivedAt()
+	return _u
+}
+
+// SetQuotaManifestBytes sets the "quota_manifest_bytes" field.
+func (_u *TenantUpdate) SetQuotaManifestBytes(v int64) *TenantUpdate {
+	_u.mutation.ResetQuotaManifestBytes()
+	_u.mutation.SetQuotaManifestBytes(v)
+	return _u
+}
+
+// SetNillableQuotaManifestBytes sets the "quota_manifest_bytes" field if the given value is not nil.
+func (_u *TenantUpdate) SetNillableQuotaManifestBytes(v *int64) *TenantUpdate {
+	if v != nil {
+		_u.SetQuotaManifestBytes(*v)
+	}
+	return _u
+}
+
+// AddQuotaManifestBytes adds value to the "quota_manifest_bytes" field.
+func (_u *TenantUpdate) AddQuotaManifestBytes(v int64) *TenantUpdate {
+	_u.mutation.AddQuotaManifestBytes(v)
+	return _u
+}
+
+// ClearQuotaManifestBytes clears the value of the "quota_manifest_bytes" field.
+func (_u *TenantUpdate) ClearQuotaManifestBytes() *TenantUpdate {
+	_u.mutation.ClearQuotaManifestBytes()
+	return _u
+}
+
+// SetQuotaIngestPerMinute sets the "quota_ingest_per_minute" field.
+func (_u *TenantUpdate) SetQuotaIngestPerMinute(v int) *TenantUpdate {
+	_u.mutation.ResetQuotaIngestPerMinute()
+	_u.mutation.SetQuotaIngestPerMinute(v)
+	return _u
+}
+
+// SetNillableQuotaIngestPerMinute sets the "quota_ingest_per_minute" field if the given value is not nil.
+func (_u *TenantUpdate) SetNillableQuotaIngestPerMinute(v *int) *TenantUpdate {
+	if v != nil {
+		_u.SetQuotaIngestPerMinute(*v)
+	}
+	return _u
+}
+
+// AddQuotaIngestPerMinute adds value to the "quota_ingest_per_minute" field.
+func (_u *TenantUpdate) AddQuotaIngestPerMinute(v int) *TenantUpdate {
+	_u.mutation.AddQuotaIngestPerMinute(v)
+	return _u
+}
+
+// ClearQuotaIngestPerMinute clears the value of the "quota_ingest_per_minute" field.
+func (_u *TenantUpdate) ClearQuotaIngestPerMinute() *TenantUpdate {
+	_u.mutation.ClearQuotaIngestPerMinute()
+	return _u
+}
+
+// SetQuotaMachines sets the "quota_machines" field.
+func (_u *TenantUpdate) SetQuotaMachines(v int) *TenantUpdate {
+	_u.mutation.ResetQuotaMachines()
+	_u.mutation.SetQuotaMachines(v)
+	return _u
+}
+
+// SetNillableQuotaMachines sets the "quota_machines" field if the given value is not nil.
+func (_u *TenantUpdate) SetNillableQuotaMachines(v *int) *TenantUpdate {
+	if v != nil {
+		_u.SetQuotaMachines(*v)
+	}
+	return _u
+}
+
+// AddQuotaMachines adds value to the "quota_machines" field.
+func (_u *TenantUpdate) AddQuotaMachines(v int) *TenantUpdate {
+	_u.mutation.AddQuotaMachines(v)
+	return _u
+}
+
+// ClearQuotaMachines clears the value of the "quota_machines" field.
+func (_u *TenantUpdate) ClearQuotaMachines() *TenantUpdate {
+	_u.mutation.ClearQuotaMachines()
+	return _u
+}
+
+// SetQuotaRetainedScans sets the "quota_retained_scans" field.
+func (_u *TenantUpdate) SetQuotaRetainedScans(v int) *TenantUpdate {
+	_u.mutation.ResetQuotaRetainedScans()
+	_u.mutation.SetQuotaRetainedScans(v)
+	return _u
+}
+
+// SetNillableQuotaRetainedScans sets the "quota_retained_scans" field if the given value is not nil.
+func (_u *TenantUpdate) SetNillableQuotaRetainedScans(v *int) *TenantUpdate {
+	if v != nil {
+		_u.SetQuotaRetainedScans(*v)
+	}
+	return _u
+}
+
+// AddQuotaRetainedScans adds value to the "quota_retained_scans" field.
+func (_u *TenantUpdate) AddQuotaRetainedScans(v int) *TenantUpdate {
+	_u.mutation.AddQuotaRetainedScans(v)
+	return _u
+}
+
+// ClearQuotaRetainedScans clears the value of the "quota_retained_scans" field.
+func (_u *TenantUpdate) ClearQuotaRetainedScans() *TenantUpdate {
+	_u.mutation.ClearQuotaRetainedScans()
+	return _u
+}
+
+// SetQuotaConcurrentActions sets the "quota_concurrent_actions" field.
+func (_u *TenantUpdate) SetQuotaConcurrentActions(v int) *TenantUpdate {
+	_u.mutation.ResetQuotaConcurrentActions()
+	_u.mutation.SetQuotaConcurrentActions(v)
+	return _u
+}
+
+// SetNillableQuotaConcurrentActions sets the "quota_concurrent_actions" field if the given value is not nil.
+func (_u *TenantUpdate) SetNillableQuotaConcurrentActions(v *int) *TenantUpdate {
+	if v != nil {
+		_u.SetQuotaConcurrentActions(*v)
+	}
+	return _u
+}
+
+// AddQuotaConcurrentActions adds value to the "quota_concurrent_actions" field.
+func (_u *TenantUpdate) AddQuotaConcurrentActions(v int) *TenantUpdate {
+	_u.mutation.AddQuotaConcurrentActions(v)
+	return _u
+}
+
+// ClearQuotaConcurrentActions clears the value of the "quota_concurrent_actions" field.
+func (_u *TenantUpdate) ClearQuotaConcurrentActions() *TenantUpdate {
+	_u.mutation.ClearQuotaConcurrentActions()
+	return _u
+}
+
+// SetRetentionKeepScans sets the "retention_keep_scans" field.
+func (_u *TenantUpdate) SetRetentionKeepScans(v int) *TenantUpdate {
+	_u.mutation.ResetRetentionKeepScans()
+	_u.mutation.SetRetentionKeepScans(v)
+	return _u
+}
+
+// SetNillableRetentionKeepScans sets the "retention_keep_scans" field if the given value is not nil.
+func (_u *TenantUpdate) SetNillableRetentionKeepScans(v *int) *TenantUpdate {
+	if v != nil {
+		_u.SetRetentionKeepScans(*v)
+	}
+	return _u
+}
+
+// AddRetentionKeepScans adds value to the "retention_keep_scans" field.
+func (_u *TenantUpdate) AddRetentionKeepScans(v int) *TenantUpdate {
+	_u.mutation.AddRetentionKeepScans(v)
+	return _u
+}
+
+// ClearRetentionKeepScans clears the value of the "retention_keep_scans" field.
+func (_u *TenantUpdate) ClearRetentionKeepScans() *TenantUpdate {
+	_u.mutation.ClearRetentionKeepScans()
+	return _u
+}
+
+// SetRetentionArchiveAfterDays sets the "retention_archive_after_days" field.
+func (_u *TenantUpdate) SetRetentionArchiveAfterDays(v int) *TenantUpdate {
+	_u.mutation.ResetRetentionArchiveAfterDays()
+	_u.mutation.SetRetentionArchiveAfterDays(v)
+	return _u
+}
+
+// SetNillableRetentionArchiveAfterDays sets the "retention_archive_after_days" field if the given value is not nil.
+func (_u *TenantUpdate) SetNillableRetentionArchiveAfterDays(v *int) *TenantUpdate {
+	if v != nil {
+		_u.SetRetentionArchiveAfterDays(*v)
+	}
+	return _u
+}
+
+// AddRetentionArchiveAfterDays adds value to the "retention_archive_after_days" field.
+func (_u *TenantUpdate) AddRetentionArchiveAfterDays(v int) *TenantUpdate {
+	_u.mutation.AddRetentionArchiveAfterDays(v)
+	return _u
+}
+
+// ClearRetentionArchiveAfterDays clears the value of the "retention_archive_after_days" field.
+func (_u *TenantUpdate) ClearRetentionArchiveAfterDays() *TenantUpdate {
+	_u.mutation.ClearRetentionArchiveAfterDays()
+	return _u
+}
+
 // AddMachineIDs adds the "machines" edge to the Machine entity by IDs.
 func (_u *TenantUpdate) AddMachineIDs(ids ...uuid.UUID) *TenantUpdate {
 	_u.mutation.AddMachineIDs(ids...)
@@ -153,6 +370,126 @@ func (_u *TenantUpdate) AddActionAudits(v ...*ActionAudit) *TenantUpdate {
 	return _u.AddActionAuditIDs(ids...)
 }
 
+// AddSecretIDs adds the "secrets" edge to the TenantSecret entity by IDs.
+func (_u *TenantUpdate) AddSecretIDs(ids ...uuid.UUID) *TenantUpdate {
+	_u.mutation.AddSecretIDs(ids...)
+	return _u
+}
+
+// AddSecrets adds the "secrets" edges to the TenantSecret entity.
+func (_u *TenantUpdate) AddSecrets(v ...*TenantSecret) *TenantUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSecretIDs(ids...)
+}
+
+// AddFileInstanceIDs adds the "file_instances" edge to the FileInstance entity by IDs.
+func (_u *TenantUpdate) AddFileInstanceIDs(ids ...uuid.UUID) *TenantUpdate {
+	_u.mutation.AddFileInstanceIDs(ids...)
+	return _u
+}
+
+// AddFileInstances adds the "file_instances" edges to the FileInstance entity.
+func (_u *TenantUpdate) AddFileInstances(v ...*FileInstance) *TenantUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddFileInstanceIDs(ids...)
+}
+
+// AddContentIdentityIDs adds the "content_identities" edge to the ContentIdentity entity by IDs.
+func (_u *TenantUpdate) AddContentIdentityIDs(ids ...uuid.UUID) *TenantUpdate {
+	_u.mutation.AddContentIdentityIDs(ids...)
+	return _u
+}
+
+// AddContentIdentities adds the "content_identities" edges to the ContentIdentity entity.
+func (_u *TenantUpdate) AddContentIdentities(v ...*ContentIdentity) *TenantUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddContentIdentityIDs(ids...)
+}
+
+// AddContentEventIDs adds the "content_events" edge to the ContentEvent entity by IDs.
+func (_u *TenantUpdate) AddContentEventIDs(ids ...uuid.UUID) *TenantUpdate {
+	_u.mutation.AddContentEventIDs(ids...)
+	return _u
+}
+
+// AddContentEvents adds the "content_events" edges to the ContentEvent entity.
+func (_u *TenantUpdate) AddContentEvents(v ...*ContentEvent) *TenantUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddContentEventIDs(ids...)
+}
+
+// AddScanTargetIDs adds the "scan_targets" edge to the ScanTarget entity by IDs.
+func (_u *TenantUpdate) AddScanTargetIDs(ids ...uuid.UUID) *TenantUpdate {
+	_u.mutation.AddScanTargetIDs(ids...)
+	return _u
+}
+
+// AddScanTargets adds the "scan_targets" edges to the ScanTarget entity.
+func (_u *TenantUpdate) AddScanTargets(v ...*ScanTarget) *TenantUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddScanTargetIDs(ids...)
+}
+
+// AddScanScheduleIDs adds the "scan_schedules" edge to the ScanSchedule entity by IDs.
+func (_u *TenantUpdate) AddScanScheduleIDs(ids ...uuid.UUID) *TenantUpdate {
+	_u.mutation.AddScanScheduleIDs(ids...)
+	return _u
+}
+
+// AddScanSchedules adds the "scan_schedules" edges to the ScanSchedule entity.
+func (_u *TenantUpdate) AddScanSchedules(v ...*ScanSchedule) *TenantUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddScanScheduleIDs(ids...)
+}
+
+// AddScanScheduleRunIDs adds the "scan_schedule_runs" edge to the ScanScheduleRun entity by IDs.
+func (_u *TenantUpdate) AddScanScheduleRunIDs(ids ...uuid.UUID) *TenantUpdate {
+	_u.mutation.AddScanScheduleRunIDs(ids...)
+	return _u
+}
+
+// AddScanScheduleRuns adds the "scan_schedule_runs" edges to the ScanScheduleRun entity.
+func (_u *TenantUpdate) AddScanScheduleRuns(v ...*ScanScheduleRun) *TenantUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddScanScheduleRunIDs(ids...)
+}
+
+// AddActionJobIDs adds the "action_jobs" edge to the ActionJob entity by IDs.
+func (_u *TenantUpdate) AddActionJobIDs(ids ...uuid.UUID) *TenantUpdate {
+	_u.mutation.AddActionJobIDs(ids...)
+	return _u
+}
+
+// AddActionJobs adds the "action_jobs" edges to the ActionJob entity.
+func (_u *TenantUpdate) AddActionJobs(v ...*ActionJob) *TenantUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddActionJobIDs(ids...)
+}
+
 // Mutation returns the TenantMutation object of the builder.
 func (_u *TenantUpdate) Mutation() *TenantMutation {
 	return _u.mutation
@@ -242,55 +579,229 @@ func (_u *TenantUpdate) RemoveActionAudits(v ...*ActionAudit) *TenantUpdate {
 	return _u.RemoveActionAuditIDs(ids...)
 }
 
-// Save executes the query and returns the number of nodes affected by the update operation.
-func (_u *TenantUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
-	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+// ClearSecrets clears all "secrets" edges to the TenantSecret entity.
+func (_u *TenantUpdate) ClearSecrets() *TenantUpdate {
+	_u.mutation.ClearSecrets()
+	return _u
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (_u *TenantUpdate) SaveX(ctx context.Context) int {
-	affected, err := _u.Save(ctx)
-	if err != nil {
-		panic(err)
+// RemoveSecretIDs removes the "secrets" edge to TenantSecret entities by IDs.
+func (_u *TenantUpdate) RemoveSecretIDs(ids ...uuid.UUID) *TenantUpdate {
+	_u.mutation.RemoveSecretIDs(ids...)
+	return _u
+}
+
+// RemoveSecrets removes "secrets" edges to TenantSecret entities.
+func (_u *TenantUpdate) RemoveSecrets(v ...*TenantSecret) *TenantUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return affected
+	return _u.RemoveSecretIDs(ids...)
 }
 
-// Exec executes the query.
-func (_u *TenantUpdate) Exec(ctx context.Context) error {
-	_, err := _u.Save(ctx)
-	return err
+// ClearFileInstances clears all "file_instances" edges to the FileInstance entity.
+func (_u *TenantUpdate) ClearFileInstances() *TenantUpdate {
+	_u.mutation.ClearFileInstances()
+	return _u
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (_u *TenantUpdate) ExecX(ctx context.Context) {
-	if err := _u.Exec(ctx); err != nil {
-		panic(err)
+// RemoveFileInstanceIDs removes the "file_instances" edge to FileInstance entities by IDs.
+func (_u *TenantUpdate) RemoveFileInstanceIDs(ids ...uuid.UUID) *TenantUpdate {
+	_u.mutation.RemoveFileInstanceIDs(ids...)
+	return _u
+}
+
+// RemoveFileInstances removes "file_instances" edges to FileInstance entities.
+func (_u *TenantUpdate) RemoveFileInstances(v ...*FileInstance) *TenantUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
+	return _u.RemoveFileInstanceIDs(ids...)
 }
 
-// defaults sets the default values of the builder before save.
-func (_u *TenantUpdate) defaults() {
-	if _, ok := _u.mutation.UpdateTime(); !ok {
-		v := tenant.UpdateDefaultUpdateTime()
-		_u.mutation.SetUpdateTime(v)
+// ClearContentIdentities clears all "content_identities" edges to the ContentIdentity entity.
+func (_u *TenantUpdate) ClearContentIdentities() *TenantUpdate {
+	_u.mutation.ClearContentIdentities()
+	return _u
+}
+
+// RemoveContentIdentityIDs removes the "content_identities" edge to ContentIdentity entities by IDs.
+func (_u *TenantUpdate) RemoveContentIdentityIDs(ids ...uuid.UUID) *TenantUpdate {
+	_u.mutation.RemoveContentIdentityIDs(ids...)
+	return _u
+}
+
+// RemoveContentIdentities removes "content_identities" edges to ContentIdentity entities.
+func (_u *TenantUpdate) RemoveContentIdentities(v ...*ContentIdentity) *TenantUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
+	return _u.RemoveContentIdentityIDs(ids...)
 }
 
-func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
-	_spec := sqlgraph.NewUpdateSpec(tenant.Table, tenant.Columns, sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeUUID))
-	if ps := _u.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
-		}
+// ClearContentEvents clears all "content_events" edges to the ContentEvent entity.
+func (_u *TenantUpdate) ClearContentEvents() *TenantUpdate {
+	_u.mutation.ClearContentEvents()
+	return _u
+}
+
+// RemoveContentEventIDs removes the "content_events" edge to ContentEvent entities by IDs.
+func (_u *TenantUpdate) RemoveContentEventIDs(ids ...uuid.UUID) *TenantUpdate {
+	_u.mutation.RemoveContentEventIDs(ids...)
+	return _u
+}
+
+// RemoveContentEvents removes "content_events" edges to ContentEvent entities.
+func (_u *TenantUpdate) RemoveContentEvents(v ...*ContentEvent) *TenantUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if value, ok := _u.mutation.UpdateTime(); ok {
-		_spec.SetField(tenant.FieldUpdateTime, field.TypeTime, value)
+	return _u.RemoveContentEventIDs(ids...)
+}
+
+// ClearScanTargets clears all "scan_targets" edges to the ScanTarget entity.
+func (_u *TenantUpdate) ClearScanTargets() *TenantUpdate {
+	_u.mutation.ClearScanTargets()
+	return _u
+}
+
+// RemoveScanTargetIDs removes the "scan_targets" edge to ScanTarget entities by IDs.
+func (_u *TenantUpdate) RemoveScanTargetIDs(ids ...uuid.UUID) *TenantUpdate {
+	_u.mutation.RemoveScanTargetIDs(ids...)
+	return _u
+}
+
+// RemoveScanTargets removes "scan_targets" edges to ScanTarget entities.
+func (_u *TenantUpdate) RemoveScanTargets(v ...*ScanTarget) *TenantUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if value, ok := _u.mutation.Name(); ok {
+	return _u.RemoveScanTargetIDs(ids...)
+}
+
+// ClearScanSchedules clears all "scan_schedules" edges to the ScanSchedule entity.
+func (_u *TenantUpdate) ClearScanSchedules() *TenantUpdate {
+	_u.mutation.ClearScanSchedules()
+	return _u
+}
+
+// RemoveScanScheduleIDs removes the "scan_schedules" edge to ScanSchedule entities by IDs.
+func (_u *TenantUpdate) RemoveScanScheduleIDs(ids ...uuid.UUID) *TenantUpdate {
+	_u.mutation.RemoveScanScheduleIDs(ids...)
+	return _u
+}
+
+// RemoveScanSchedules removes "scan_schedules" edges to ScanSchedule entities.
+func (_u *TenantUpdate) RemoveScanSchedules(v ...*ScanSchedule) *TenantUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveScanScheduleIDs(ids...)
+}
+
+// ClearScanScheduleRuns clears all "scan_schedule_runs" edges to the ScanScheduleRun entity.
+func (_u *TenantUpdate) ClearScanScheduleRuns() *TenantUpdate {
+	_u.mutation.ClearScanScheduleRuns()
+	return _u
+}
+
+// RemoveScanScheduleRunIDs removes the "scan_schedule_runs" edge to ScanScheduleRun entities by IDs.
+func (_u *TenantUpdate) RemoveScanScheduleRunIDs(ids ...uuid.UUID) *TenantUpdate {
+	_u.mutation.RemoveScanScheduleRunIDs(ids...)
+	return _u
+}
+
+// RemoveScanScheduleRuns removes "scan_schedule_runs" edges to ScanScheduleRun entities.
+func (_u *TenantUpdate) RemoveScanScheduleRuns(v ...*ScanScheduleRun) *TenantUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveScanScheduleRunIDs(ids...)
+}
+
+// ClearActionJobs clears all "action_jobs" edges to the ActionJob entity.
+func (_u *TenantUpdate) ClearActionJobs() *TenantUpdate {
+	_u.mutation.ClearActionJobs()
+	return _u
+}
+
+// RemoveActionJobIDs removes the "action_jobs" edge to ActionJob entities by IDs.
+func (_u *TenantUpdate) RemoveActionJobIDs(ids ...uuid.UUID) *TenantUpdate {
+	_u.mutation.RemoveActionJobIDs(ids...)
+	return _u
+}
+
+// RemoveActionJobs removes "action_jobs" edges to ActionJob entities.
+func (_u *TenantUpdate) RemoveActionJobs(v ...*ActionJob) *TenantUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveActionJobIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TenantUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TenantUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TenantUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TenantUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TenantUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if tenant.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized tenant.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := tenant.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(tenant.Table, tenant.Columns, sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(tenant.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
 		_spec.SetField(tenant.FieldName, field.TypeString, value)
 	}
 	if value, ok := _u.mutation.Description(); ok {
@@ -305,6 +816,75 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.PrimaryContactCleared() {
 		_spec.ClearField(tenant.FieldPrimaryContact, field.TypeString)
 	}
+	if value, ok := _u.mutation.ArchivedAt(); ok {
+		_spec.SetField(tenant.FieldArchivedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ArchivedAtCleared() {
+		_spec.ClearField(tenant.FieldArchivedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.QuotaManifestBytes(); ok {
+		_spec.SetField(tenant.FieldQuotaManifestBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedQuotaManifestBytes(); ok {
+		_spec.AddField(tenant.FieldQuotaManifestBytes, field.TypeInt64, value)
+	}
+	if _u.mutation.QuotaManifestBytesCleared() {
+		_spec.ClearField(tenant.FieldQuotaManifestBytes, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.QuotaIngestPerMinute(); ok {
+		_spec.SetField(tenant.FieldQuotaIngestPerMinute, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedQuotaIngestPerMinute(); ok {
+		_spec.AddField(tenant.FieldQuotaIngestPerMinute, field.TypeInt, value)
+	}
+	if _u.mutation.QuotaIngestPerMinuteCleared() {
+		_spec.ClearField(tenant.FieldQuotaIngestPerMinute, field.TypeInt)
+	}
+	if value, ok := _u.mutation.QuotaMachines(); ok {
+		_spec.SetField(tenant.FieldQuotaMachines, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedQuotaMachines(); ok {
+		_spec.AddField(tenant.FieldQuotaMachines, field.TypeInt, value)
+	}
+	if _u.mutation.QuotaMachinesCleared() {
+		_spec.ClearField(tenant.FieldQuotaMachines, field.TypeInt)
+	}
+	if value, ok := _u.mutation.QuotaRetainedScans(); ok {
+		_spec.SetField(tenant.FieldQuotaRetainedScans, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedQuotaRetainedScans(); ok {
+		_spec.AddField(tenant.FieldQuotaRetainedScans, field.TypeInt, value)
+	}
+	if _u.mutation.QuotaRetainedScansCleared() {
+		_spec.ClearField(tenant.FieldQuotaRetainedScans, field.TypeInt)
+	}
+	if value, ok := _u.mutation.QuotaConcurrentActions(); ok {
+		_spec.SetField(tenant.FieldQuotaConcurrentActions, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedQuotaConcurrentActions(); ok {
+		_spec.AddField(tenant.FieldQuotaConcurrentActions, field.TypeInt, value)
+	}
+	if _u.mutation.QuotaConcurrentActionsCleared() {
+		_spec.ClearField(tenant.FieldQuotaConcurrentActions, field.TypeInt)
+	}
+	if value, ok := _u.mutation.RetentionKeepScans(); ok {
+		_spec.SetField(tenant.FieldRetentionKeepScans, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRetentionKeepScans(); ok {
+		_spec.AddField(tenant.FieldRetentionKeepScans, field.TypeInt, value)
+	}
+	if _u.mutation.RetentionKeepScansCleared() {
+		_spec.ClearField(tenant.FieldRetentionKeepScans, field.TypeInt)
+	}
+	if value, ok := _u.mutation.RetentionArchiveAfterDays(); ok {
+		_spec.SetField(tenant.FieldRetentionArchiveAfterDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRetentionArchiveAfterDays(); ok {
+		_spec.AddField(tenant.FieldRetentionArchiveAfterDays, field.TypeInt, value)
+	}
+	if _u.mutation.RetentionArchiveAfterDaysCleared() {
+		_spec.ClearField(tenant.FieldRetentionArchiveAfterDays, field.TypeInt)
+	}
 	if _u.mutation.MachinesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -485,350 +1065,1642 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{tenant.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if _u.mutation.SecretsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.SecretsTable,
+			Columns: []string{tenant.SecretsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenantsecret.FieldID, field.TypeUUID),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	_u.mutation.done = true
-	return _node, nil
-}
-
-// TenantUpdateOne is the builder for updating a single Tenant entity.
-type TenantUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *TenantMutation
-}
-
-// SetUpdateTime sets the "update_time" field.
-func (_u *TenantUpdateOne) SetUpdateTime(v time.Time) *TenantUpdateOne {
-	_u.mutation.SetUpdateTime(v)
-	return _u
-}
-
-// SetName sets the "name" field.
-func (_u *TenantUpdateOne) SetName(v string) *TenantUpdateOne {
-	_u.mutation.SetName(v)
-	return _u
-}
-
-// SetNillableName sets the "name" field if the given value is not nil.
-func (_u *TenantUpdateOne) SetNillableName(v *string) *TenantUpdateOne {
-	if v != nil {
-		_u.SetName(*v)
+	if nodes := _u.mutation.RemovedSecretsIDs(); len(nodes) > 0 && !_u.mutation.SecretsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.SecretsTable,
+			Columns: []string{tenant.SecretsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenantsecret.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// SetDescription sets the "description" field.
-func (_u *TenantUpdateOne) SetDescription(v string) *TenantUpdateOne {
-	_u.mutation.SetDescription(v)
-	return _u
-}
-
-// SetNillableDescription sets the "description" field if the given value is not nil.
-func (_u *TenantUpdateOne) SetNillableDescription(v *string) *TenantUpdateOne {
-	if v != nil {
-		_u.SetDescription(*v)
+	if nodes := _u.mutation.SecretsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.SecretsTable,
+			Columns: []string{tenant.SecretsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenantsecret.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u
-}
-
-// ClearDescription clears the value of the "description" field.
-func (_u *TenantUpdateOne) ClearDescription() *TenantUpdateOne {
-	_u.mutation.ClearDescription()
-	return _u
-}
-
-// SetPrimaryContact sets the "primary_contact" field.
-func (_u *TenantUpdateOne) SetPrimaryContact(v string) *TenantUpdateOne {
-	_u.mutation.SetPrimaryContact(v)
-	return _u
-}
-
-// SetNillablePrimaryContact sets the "primary_contact" field if the given value is not nil.
-func (_u *TenantUpdateOne) SetNillablePrimaryContact(v *string) *TenantUpdateOne {
-	if v != nil {
-		_u.SetPrimaryContact(*v)
+	if _u.mutation.FileInstancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.FileInstancesTable,
+			Columns: []string{tenant.FileInstancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fileinstance.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// ClearPrimaryContact clears the value of the "primary_contact" field.
-func (_u *TenantUpdateOne) ClearPrimaryContact() *TenantUpdateOne {
-	_u.mutation.ClearPrimaryContact()
-	return _u
-}
-
-// AddMachineIDs adds the "machines" edge to the Machine entity by IDs.
-func (_u *TenantUpdateOne) AddMachineIDs(ids ...uuid.UUID) *TenantUpdateOne {
-	_u.mutation.AddMachineIDs(ids...)
-	return _u
-}
-
-// AddMachines adds the "machines" edges to the Machine entity.
-func (_u *TenantUpdateOne) AddMachines(v ...*Machine) *TenantUpdateOne {
-	ids := make([]uuid.UUID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedFileInstancesIDs(); len(nodes) > 0 && !_u.mutation.FileInstancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.FileInstancesTable,
+			Columns: []string{tenant.FileInstancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fileinstance.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddMachineIDs(ids...)
-}
-
-// AddScanIDs adds the "scans" edge to the Scan entity by IDs.
-func (_u *TenantUpdateOne) AddScanIDs(ids ...uuid.UUID) *TenantUpdateOne {
-	_u.mutation.AddScanIDs(ids...)
-	return _u
-}
-
-// AddScans adds the "scans" edges to the Scan entity.
-func (_u *TenantUpdateOne) AddScans(v ...*Scan) *TenantUpdateOne {
-	ids := make([]uuid.UUID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.FileInstancesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.FileInstancesTable,
+			Columns: []string{tenant.FileInstancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fileinstance.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.AddScanIDs(ids...)
+	if _u.mutation.ContentIdentitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ContentIdentitiesTable,
+			Columns: []string{tenant.ContentIdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentidentity.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedContentIdentitiesIDs(); len(nodes) > 0 && !_u.mutation.ContentIdentitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ContentIdentitiesTable,
+			Columns: []string{tenant.ContentIdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentidentity.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ContentIdentitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ContentIdentitiesTable,
+			Columns: []string{tenant.ContentIdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentidentity.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ContentEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ContentEventsTable,
+			Columns: []string{tenant.ContentEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentevent.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedContentEventsIDs(); len(nodes) > 0 && !_u.mutation.ContentEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ContentEventsTable,
+			Columns: []string{tenant.ContentEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ContentEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ContentEventsTable,
+			Columns: []string{tenant.ContentEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ScanTargetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ScanTargetsTable,
+			Columns: []string{tenant.ScanTargetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scantarget.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedScanTargetsIDs(); len(nodes) > 0 && !_u.mutation.ScanTargetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ScanTargetsTable,
+			Columns: []string{tenant.ScanTargetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scantarget.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ScanTargetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ScanTargetsTable,
+			Columns: []string{tenant.ScanTargetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scantarget.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ScanSchedulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ScanSchedulesTable,
+			Columns: []string{tenant.ScanSchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scanschedule.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedScanSchedulesIDs(); len(nodes) > 0 && !_u.mutation.ScanSchedulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ScanSchedulesTable,
+			Columns: []string{tenant.ScanSchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scanschedule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ScanSchedulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ScanSchedulesTable,
+			Columns: []string{tenant.ScanSchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scanschedule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ScanScheduleRunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ScanScheduleRunsTable,
+			Columns: []string{tenant.ScanScheduleRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scanschedulerun.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedScanScheduleRunsIDs(); len(nodes) > 0 && !_u.mutation.ScanScheduleRunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ScanScheduleRunsTable,
+			Columns: []string{tenant.ScanScheduleRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scanschedulerun.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ScanScheduleRunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ScanScheduleRunsTable,
+			Columns: []string{tenant.ScanScheduleRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scanschedulerun.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ActionJobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ActionJobsTable,
+			Columns: []string{tenant.ActionJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(actionjob.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedActionJobsIDs(); len(nodes) > 0 && !_u.mutation.ActionJobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ActionJobsTable,
+			Columns: []string{tenant.ActionJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(actionjob.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ActionJobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ActionJobsTable,
+			Columns: []string{tenant.ActionJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(actionjob.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tenant.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
 }
 
-// AddDuplicateGroupIDs adds the "duplicate_groups" edge to the DuplicateGroup entity by IDs.
-func (_u *TenantUpdateOne) AddDuplicateGroupIDs(ids ...uuid.UUID) *TenantUpdateOne {
-	_u.mutation.AddDuplicateGroupIDs(ids...)
+// TenantUpdateOne is the builder for updating a single Tenant entity.
+type TenantUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TenantMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *TenantUpdateOne) SetUpdateTime(v time.Time) *TenantUpdateOne {
+	_u.mutation.SetUpdateTime(v)
 	return _u
 }
 
-// AddDuplicateGroups adds the "duplicate_groups" edges to the DuplicateGroup entity.
-func (_u *TenantUpdateOne) AddDuplicateGroups(v ...*DuplicateGroup) *TenantUpdateOne {
-	ids := make([]uuid.UUID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
-	}
-	return _u.AddDuplicateGroupIDs(ids...)
+// SetName sets the "name" field.
+func (_u *TenantUpdateOne) SetName(v string) *TenantUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
 }
 
-// AddActionAuditIDs adds the "action_audits" edge to the ActionAudit entity by IDs.
-func (_u *TenantUpdateOne) AddActionAuditIDs(ids ...uuid.UUID) *TenantUpdateOne {
-	_u.mutation.AddActionAuditIDs(ids...)
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *TenantUpdateOne) SetNillableName(v *string) *TenantUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
 	return _u
 }
 
-// AddActionAudits adds the "action_audits" edges to the ActionAudit entity.
-func (_u *TenantUpdateOne) AddActionAudits(v ...*ActionAudit) *TenantUpdateOne {
+// SetDescription sets the "description" field.
+func (_u *TenantUpdateOne) SetDescription(v string) *TenantUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *TenantUpdateOne) SetNillableDescription(v *string) *TenantUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *TenantUpdateOne) ClearDescription() *TenantUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetPrimaryContact sets the "primary_contact" field.
+func (_u *TenantUpdateOne) SetPrimaryContact(v string) *TenantUpdateOne {
+	_u.mutation.SetPrimaryContact(v)
+	return _u
+}
+
+// SetNillablePrimaryContact sets the "primary_contact" field if the given value is not nil.
+func (_u *TenantUpdateOne) SetNillablePrimaryContact(v *string) *TenantUpdateOne {
+	if v != nil {
+		_u.SetPrimaryContact(*v)
+	}
+	return _u
+}
+
+// ClearPrimaryContact clears the value of the "primary_contact" field.
+func (_u *TenantUpdateOne) ClearPrimaryContact() *TenantUpdateOne {
+	_u.mutation.ClearPrimaryContact()
+	return _u
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (_u *TenantUpdateOne) SetArchivedAt(v time.Time) *TenantUpdateOne {
+	_u.mutation.SetArchivedAt(v)
+	return _u
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (_u *TenantUpdateOne) SetNillableArchivedAt(v *time.Time) *TenantUpdateOne {
+	if v != nil {
+		_u.SetArchivedAt(*v)
+	}
+	return _u
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (_u *TenantUpdateOne) ClearArchivedAt() *TenantUpdateOne {
+	_u.mutation.ClearArchivedAt()
+	return _u
+}
+
+// SetQuotaManifestBytes sets the "quota_manifest_bytes" field.
+func (_u *TenantUpdateOne) SetQuotaManifestBytes(v int64) *TenantUpdateOne {
+	_u.mutation.ResetQuotaManifestBytes()
+	_u.mutation.SetQuotaManifestBytes(v)
+	return _u
+}
+
+// SetNillableQuotaManifestBytes sets the "quota_manifest_bytes" field if the given value is not nil.
+func (_u *TenantUpdateOne) SetNillableQuotaManifestBytes(v *int64) *TenantUpdateOne {
+	if v != nil {
+		_u.SetQuotaManifestBytes(*v)
+	}
+	return _u
+}
+
+// AddQuotaManifestBytes adds value to the "quota_manifest_bytes" field.
+func (_u *TenantUpdateOne) AddQuotaManifestBytes(v int64) *TenantUpdateOne {
+	_u.mutation.AddQuotaManifestBytes(v)
+	return _u
+}
+
+// ClearQuotaManifestBytes clears the value of the "quota_manifest_bytes" field.
+func (_u *TenantUpdateOne) ClearQuotaManifestBytes() *TenantUpdateOne {
+	_u.mutation.ClearQuotaManifestBytes()
+	return _u
+}
+
+// SetQuotaIngestPerMinute sets the "quota_ingest_per_minute" field.
+func (_u *TenantUpdateOne) SetQuotaIngestPerMinute(v int) *TenantUpdateOne {
+	_u.mutation.ResetQuotaIngestPerMinute()
+	_u.mutation.SetQuotaIngestPerMinute(v)
+	return _u
+}
+
+// SetNillableQuotaIngestPerMinute sets the "quota_ingest_per_minute" field if the given value is not nil.
+func (_u *TenantUpdateOne) SetNillableQuotaIngestPerMinute(v *int) *TenantUpdateOne {
+	if v != nil {
+		_u.SetQuotaIngestPerMinute(*v)
+	}
+	return _u
+}
+
+// AddQuotaIngestPerMinute adds value to the "quota_ingest_per_minute" field.
+func (_u *TenantUpdateOne) AddQuotaIngestPerMinute(v int) *TenantUpdateOne {
+	_u.mutation.AddQuotaIngestPerMinute(v)
+	return _u
+}
+
+// ClearQuotaIngestPerMinute clears the value of the "quota_ingest_per_minute" field.
+func (_u *TenantUpdateOne) ClearQuotaIngestPerMinute() *TenantUpdateOne {
+	_u.mutation.ClearQuotaIngestPerMinute()
+	return _u
+}
+
+// SetQuotaMachines sets the "quota_machines" field.
+func (_u *TenantUpdateOne) SetQuotaMachines(v int) *TenantUpdateOne {
+	_u.mutation.ResetQuotaMachines()
+	_u.mutation.SetQuotaMachines(v)
+	return _u
+}
+
+// SetNillableQuotaMachines sets the "quota_machines" field if the given value is not nil.
+func (_u *TenantUpdateOne) SetNillableQuotaMachines(v *int) *TenantUpdateOne {
+	if v != nil {
+		_u.SetQuotaMachines(*v)
+	}
+	return _u
+}
+
+// AddQuotaMachines adds value to the "quota_machines" field.
+func (_u *TenantUpdateOne) AddQuotaMachines(v int) *TenantUpdateOne {
+	_u.mutation.AddQuotaMachines(v)
+	return _u
+}
+
+// ClearQuotaMachines clears the value of the "quota_machines" field.
+func (_u *TenantUpdateOne) ClearQuotaMachines() *TenantUpdateOne {
+	_u.mutation.ClearQuotaMachines()
+	return _u
+}
+
+// SetQuotaRetainedScans sets the "quota_retained_scans" field.
+func (_u *TenantUpdateOne) SetQuotaRetainedScans(v int) *TenantUpdateOne {
+	_u.mutation.ResetQuotaRetainedScans()
+	_u.mutation.SetQuotaRetainedScans(v)
+	return _u
+}
+
+// SetNillableQuotaRetainedScans sets the "quota_retained_scans" field if the given value is not nil.
+func (_u *TenantUpdateOne) SetNillableQuotaRetainedScans(v *int) *TenantUpdateOne {
+	if v != nil {
+		_u.SetQuotaRetainedScans(*v)
+	}
+	return _u
+}
+
+// AddQuotaRetainedScans adds value to the "quota_retained_scans" field.
+func (_u *TenantUpdateOne) AddQuotaRetainedScans(v int) *TenantUpdateOne {
+	_u.mutation.AddQuotaRetainedScans(v)
+	return _u
+}
+
+// ClearQuotaRetainedScans clears the value of the "quota_retained_scans" field.
+func (_u *TenantUpdateOne) ClearQuotaRetainedScans() *TenantUpdateOne {
+	_u.mutation.ClearQuotaRetainedScans()
+	return _u
+}
+
+// SetQuotaConcurrentActions sets the "quota_concurrent_actions" field.
+func (_u *TenantUpdateOne) SetQuotaConcurrentActions(v int) *TenantUpdateOne {
+	_u.mutation.ResetQuotaConcurrentActions()
+	_u.mutation.SetQuotaConcurrentActions(v)
+	return _u
+}
+
+// SetNillableQuotaConcurrentActions sets the "quota_concurrent_actions" field if the given value is not nil.
+func (_u *TenantUpdateOne) SetNillableQuotaConcurrentActions(v *int) *TenantUpdateOne {
+	if v != nil {
+		_u.SetQuotaConcurrentActions(*v)
+	}
+	return _u
+}
+
+// AddQuotaConcurrentActions adds value to the "quota_concurrent_actions" field.
+func (_u *TenantUpdateOne) AddQuotaConcurrentActions(v int) *TenantUpdateOne {
+	_u.mutation.AddQuotaConcurrentActions(v)
+	return _u
+}
+
+// ClearQuotaConcurrentActions clears the value of the "quota_concurrent_actions" field.
+func (_u *TenantUpdateOne) ClearQuotaConcurrentActions() *TenantUpdateOne {
+	_u.mutation.ClearQuotaConcurrentActions()
+	return _u
+}
+
+// SetRetentionKeepScans sets the "retention_keep_scans" field.
+func (_u *TenantUpdateOne) SetRetentionKeepScans(v int) *TenantUpdateOne {
+	_u.mutation.ResetRetentionKeepScans()
+	_u.mutation.SetRetentionKeepScans(v)
+	return _u
+}
+
+// SetNillableRetentionKeepScans sets the "retention_keep_scans" field if the given value is not nil.
+func (_u *TenantUpdateOne) SetNillableRetentionKeepScans(v *int) *TenantUpdateOne {
+	if v != nil {
+		_u.SetRetentionKeepScans(*v)
+	}
+	return _u
+}
+
+// AddRetentionKeepScans adds value to the "retention_keep_scans" field.
+func (_u *TenantUpdateOne) AddRetentionKeepScans(v int) *TenantUpdateOne {
+	_u.mutation.AddRetentionKeepScans(v)
+	return _u
+}
+
+// ClearRetentionKeepScans clears the value of the "retention_keep_scans" field.
+func (_u *TenantUpdateOne) ClearRetentionKeepScans() *TenantUpdateOne {
+	_u.mutation.ClearRetentionKeepScans()
+	return _u
+}
+
+// SetRetentionArchiveAfterDays sets the "retention_archive_after_days" field.
+func (_u *TenantUpdateOne) SetRetentionArchiveAfterDays(v int) *TenantUpdateOne {
+	_u.mutation.ResetRetentionArchiveAfterDays()
+	_u.mutation.SetRetentionArchiveAfterDays(v)
+	return _u
+}
+
+// SetNillableRetentionArchiveAfterDays sets the "retention_archive_after_days" field if the given value is not nil.
+func (_u *TenantUpdateOne) SetNillableRetentionArchiveAfterDays(v *int) *TenantUpdateOne {
+	if v != nil {
+		_u.SetRetentionArchiveAfterDays(*v)
+	}
+	return _u
+}
+
+// AddRetentionArchiveAfterDays adds value to the "retention_archive_after_days" field.
+func (_u *TenantUpdateOne) AddRetentionArchiveAfterDays(v int) *TenantUpdateOne {
+	_u.mutation.AddRetentionArchiveAfterDays(v)
+	return _u
+}
+
+// ClearRetentionArchiveAfterDays clears the value of the "retention_archive_after_days" field.
+func (_u *TenantUpdateOne) ClearRetentionArchiveAfterDays() *TenantUpdateOne {
+	_u.mutation.ClearRetentionArchiveAfterDays()
+	return _u
+}
+
+// AddMachineIDs adds the "machines" edge to the Machine entity by IDs.
+func (_u *TenantUpdateOne) AddMachineIDs(ids ...uuid.UUID) *TenantUpdateOne {
+	_u.mutation.AddMachineIDs(ids...)
+	return _u
+}
+
+// AddMachines adds the "machines" edges to the Machine entity.
+func (_u *TenantUpdateOne) AddMachines(v ...*Machine) *TenantUpdateOne {
 	ids := make([]uuid.UUID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.AddActionAuditIDs(ids...)
+	return _u.AddMachineIDs(ids...)
 }
 
-// Mutation returns the TenantMutation object of the builder.
-func (_u *TenantUpdateOne) Mutation() *TenantMutation {
-	return _u.mutation
+// AddScanIDs adds the "scans" edge to the Scan entity by IDs.
+func (_u *TenantUpdateOne) AddScanIDs(ids ...uuid.UUID) *TenantUpdateOne {
+	_u.mutation.AddScanIDs(ids...)
+	return _u
 }
 
-// ClearMachines clears all "machines" edges to the Machine entity.
-func (_u *TenantUpdateOne) ClearMachines() *TenantUpdateOne {
-	_u.mutation.ClearMachines()
+// AddScans adds the "scans" edges to the Scan entity.
+func (_u *TenantUpdateOne) AddScans(v ...*Scan) *TenantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddScanIDs(ids...)
+}
+
+// AddDuplicateGroupIDs adds the "duplicate_groups" edge to the DuplicateGroup entity by IDs.
+func (_u *TenantUpdateOne) AddDuplicateGroupIDs(ids ...uuid.UUID) *TenantUpdateOne {
+	_u.mutation.AddDuplicateGroupIDs(ids...)
 	return _u
 }
 
-// RemoveMachineIDs removes the "machines" edge to Machine entities by IDs.
-func (_u *TenantUpdateOne) RemoveMachineIDs(ids ...uuid.UUID) *TenantUpdateOne {
-	_u.mutation.RemoveMachineIDs(ids...)
+// AddDuplicateGroups adds the "duplicate_groups" edges to the DuplicateGroup entity.
+func (_u *TenantUpdateOne) AddDuplicateGroups(v ...*DuplicateGroup) *TenantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDuplicateGroupIDs(ids...)
+}
+
+// AddActionAuditIDs adds the "action_audits" edge to the ActionAudit entity by IDs.
+func (_u *TenantUpdateOne) AddActionAuditIDs(ids ...uuid.UUID) *TenantUpdateOne {
+	_u.mutation.AddActionAuditIDs(ids...)
 	return _u
 }
 
-// RemoveMachines removes "machines" edges to Machine entities.
-func (_u *TenantUpdateOne) RemoveMachines(v ...*Machine) *TenantUpdateOne {
+// AddActionAudits adds the "action_audits" edges to the ActionAudit entity.
+func (_u *TenantUpdateOne) AddActionAudits(v ...*ActionAudit) *TenantUpdateOne {
 	ids := make([]uuid.UUID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveMachineIDs(ids...)
+	return _u.AddActionAuditIDs(ids...)
 }
 
-// ClearScans clears all "scans" edges to the Scan entity.
-func (_u *TenantUpdateOne) ClearScans() *TenantUpdateOne {
-	_u.mutation.ClearScans()
+// AddSecretIDs adds the "secrets" edge to the TenantSecret entity by IDs.
+func (_u *TenantUpdateOne) AddSecretIDs(ids ...uuid.UUID) *TenantUpdateOne {
+	_u.mutation.AddSecretIDs(ids...)
 	return _u
 }
 
-// RemoveScanIDs removes the "scans" edge to Scan entities by IDs.
-func (_u *TenantUpdateOne) RemoveScanIDs(ids ...uuid.UUID) *TenantUpdateOne {
-	_u.mutation.RemoveScanIDs(ids...)
+// AddSecrets adds the "secrets" edges to the TenantSecret entity.
+func (_u *TenantUpdateOne) AddSecrets(v ...*TenantSecret) *TenantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSecretIDs(ids...)
+}
+
+// AddFileInstanceIDs adds the "file_instances" edge to the FileInstance entity by IDs.
+func (_u *TenantUpdateOne) AddFileInstanceIDs(ids ...uuid.UUID) *TenantUpdateOne {
+	_u.mutation.AddFileInstanceIDs(ids...)
 	return _u
 }
 
-// RemoveScans removes "scans" edges to Scan entities.
-func (_u *TenantUpdateOne) RemoveScans(v ...*Scan) *TenantUpdateOne {
+// AddFileInstances adds the "file_instances" edges to the FileInstance entity.
+func (_u *TenantUpdateOne) AddFileInstances(v ...*FileInstance) *TenantUpdateOne {
 	ids := make([]uuid.UUID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveScanIDs(ids...)
+	return _u.AddFileInstanceIDs(ids...)
 }
 
-// ClearDuplicateGroups clears all "duplicate_groups" edges to the DuplicateGroup entity.
-func (_u *TenantUpdateOne) ClearDuplicateGroups() *TenantUpdateOne {
-	_u.mutation.ClearDuplicateGroups()
+// AddContentIdentityIDs adds the "content_identities" edge to the ContentIdentity entity by IDs.
+func (_u *TenantUpdateOne) AddContentIdentityIDs(ids ...uuid.UUID) *TenantUpdateOne {
+	_u.mutation.AddContentIdentityIDs(ids...)
 	return _u
 }
 
-// RemoveDuplicateGroupIDs removes the "duplicate_groups" edge to DuplicateGroup entities by IDs.
-func (_u *TenantUpdateOne) RemoveDuplicateGroupIDs(ids ...uuid.UUID) *TenantUpdateOne {
-	_u.mutation.RemoveDuplicateGroupIDs(ids...)
+// AddContentIdentities adds the "content_identities" edges to the ContentIdentity entity.
+func (_u *TenantUpdateOne) AddContentIdentities(v ...*ContentIdentity) *TenantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddContentIdentityIDs(ids...)
+}
+
+// AddContentEventIDs adds the "content_events" edge to the ContentEvent entity by IDs.
+func (_u *TenantUpdateOne) AddContentEventIDs(ids ...uuid.UUID) *TenantUpdateOne {
+	_u.mutation.AddContentEventIDs(ids...)
 	return _u
 }
 
-// RemoveDuplicateGroups removes "duplicate_groups" edges to DuplicateGroup entities.
-func (_u *TenantUpdateOne) RemoveDuplicateGroups(v ...*DuplicateGroup) *TenantUpdateOne {
+// AddContentEvents adds the "content_events" edges to the ContentEvent entity.
+func (_u *TenantUpdateOne) AddContentEvents(v ...*ContentEvent) *TenantUpdateOne {
 	ids := make([]uuid.UUID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveDuplicateGroupIDs(ids...)
+	return _u.AddContentEventIDs(ids...)
 }
 
-// ClearActionAudits clears all "action_audits" edges to the ActionAudit entity.
-func (_u *TenantUpdateOne) ClearActionAudits() *TenantUpdateOne {
-	_u.mutation.ClearActionAudits()
+// AddScanTargetIDs adds the "scan_targets" edge to the ScanTarget entity by IDs.
+func (_u *TenantUpdateOne) AddScanTargetIDs(ids ...uuid.UUID) *TenantUpdateOne {
+	_u.mutation.AddScanTargetIDs(ids...)
 	return _u
 }
 
-// RemoveActionAuditIDs removes the "action_audits" edge to ActionAudit entities by IDs.
-func (_u *TenantUpdateOne) RemoveActionAuditIDs(ids ...uuid.UUID) *TenantUpdateOne {
-	_u.mutation.RemoveActionAuditIDs(ids...)
+// AddScanTargets adds the "scan_targets" edges to the ScanTarget entity.
+func (_u *TenantUpdateOne) AddScanTargets(v ...*ScanTarget) *TenantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddScanTargetIDs(ids...)
+}
+
+// AddScanScheduleIDs adds the "scan_schedules" edge to the ScanSchedule entity by IDs.
+func (_u *TenantUpdateOne) AddScanScheduleIDs(ids ...uuid.UUID) *TenantUpdateOne {
+	_u.mutation.AddScanScheduleIDs(ids...)
 	return _u
 }
 
-// RemoveActionAudits removes "action_audits" edges to ActionAudit entities.
-func (_u *TenantUpdateOne) RemoveActionAudits(v ...*ActionAudit) *TenantUpdateOne {
+// AddScanSchedules adds the "scan_schedules" edges to the ScanSchedule entity.
+func (_u *TenantUpdateOne) AddScanSchedules(v ...*ScanSchedule) *TenantUpdateOne {
 	ids := make([]uuid.UUID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveActionAuditIDs(ids...)
-}
-
-// Where appends a list predicates to the TenantUpdate builder.
-func (_u *TenantUpdateOne) Where(ps ...predicate.Tenant) *TenantUpdateOne {
-	_u.mutation.Where(ps...)
-	return _u
-}
-
-// Select allows selecting one or more fields (columns) of the returned entity.
-// The default is selecting all fields defined in the entity schema.
-func (_u *TenantUpdateOne) Select(field string, fields ...string) *TenantUpdateOne {
-	_u.fields = append([]string{field}, fields...)
-	return _u
-}
-
-// Save executes the query and returns the updated Tenant entity.
-func (_u *TenantUpdateOne) Save(ctx context.Context) (*Tenant, error) {
-	_u.defaults()
-	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
-}
-
-// SaveX is like Save, but panics if an error occurs.
-func (_u *TenantUpdateOne) SaveX(ctx context.Context) *Tenant {
-	node, err := _u.Save(ctx)
-	if err != nil {
-		panic(err)
+	return _u.AddScanScheduleIDs(ids...)
+}
+
+// AddScanScheduleRunIDs adds the "scan_schedule_runs" edge to the ScanScheduleRun entity by IDs.
+func (_u *TenantUpdateOne) AddScanScheduleRunIDs(ids ...uuid.UUID) *TenantUpdateOne {
+	_u.mutation.AddScanScheduleRunIDs(ids...)
+	return _u
+}
+
+// AddScanScheduleRuns adds the "scan_schedule_runs" edges to the ScanScheduleRun entity.
+func (_u *TenantUpdateOne) AddScanScheduleRuns(v ...*ScanScheduleRun) *TenantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddScanScheduleRunIDs(ids...)
+}
+
+// AddActionJobIDs adds the "action_jobs" edge to the ActionJob entity by IDs.
+func (_u *TenantUpdateOne) AddActionJobIDs(ids ...uuid.UUID) *TenantUpdateOne {
+	_u.mutation.AddActionJobIDs(ids...)
+	return _u
+}
+
+// AddActionJobs adds the "action_jobs" edges to the ActionJob entity.
+func (_u *TenantUpdateOne) AddActionJobs(v ...*ActionJob) *TenantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddActionJobIDs(ids...)
+}
+
+// Mutation returns the TenantMutation object of the builder.
+func (_u *TenantUpdateOne) Mutation() *TenantMutation {
+	return _u.mutation
+}
+
+// ClearMachines clears all "machines" edges to the Machine entity.
+func (_u *TenantUpdateOne) ClearMachines() *TenantUpdateOne {
+	_u.mutation.ClearMachines()
+	return _u
+}
+
+// RemoveMachineIDs removes the "machines" edge to Machine entities by IDs.
+func (_u *TenantUpdateOne) RemoveMachineIDs(ids ...uuid.UUID) *TenantUpdateOne {
+	_u.mutation.RemoveMachineIDs(ids...)
+	return _u
+}
+
+// RemoveMachines removes "machines" edges to Machine entities.
+func (_u *TenantUpdateOne) RemoveMachines(v ...*Machine) *TenantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMachineIDs(ids...)
+}
+
+// ClearScans clears all "scans" edges to the Scan entity.
+func (_u *TenantUpdateOne) ClearScans() *TenantUpdateOne {
+	_u.mutation.ClearScans()
+	return _u
+}
+
+// RemoveScanIDs removes the "scans" edge to Scan entities by IDs.
+func (_u *TenantUpdateOne) RemoveScanIDs(ids ...uuid.UUID) *TenantUpdateOne {
+	_u.mutation.RemoveScanIDs(ids...)
+	return _u
+}
+
+// RemoveScans removes "scans" edges to Scan entities.
+func (_u *TenantUpdateOne) RemoveScans(v ...*Scan) *TenantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveScanIDs(ids...)
+}
+
+// ClearDuplicateGroups clears all "duplicate_groups" edges to the DuplicateGroup entity.
+func (_u *TenantUpdateOne) ClearDuplicateGroups() *TenantUpdateOne {
+	_u.mutation.ClearDuplicateGroups()
+	return _u
+}
+
+// RemoveDuplicateGroupIDs removes the "duplicate_groups" edge to DuplicateGroup entities by IDs.
+func (_u *TenantUpdateOne) RemoveDuplicateGroupIDs(ids ...uuid.UUID) *TenantUpdateOne {
+	_u.mutation.RemoveDuplicateGroupIDs(ids...)
+	return _u
+}
+
+// RemoveDuplicateGroups removes "duplicate_groups" edges to DuplicateGroup entities.
+func (_u *TenantUpdateOne) RemoveDuplicateGroups(v ...*DuplicateGroup) *TenantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDuplicateGroupIDs(ids...)
+}
+
+// ClearActionAudits clears all "action_audits" edges to the ActionAudit entity.
+func (_u *TenantUpdateOne) ClearActionAudits() *TenantUpdateOne {
+	_u.mutation.ClearActionAudits()
+	return _u
+}
+
+// RemoveActionAuditIDs removes the "action_audits" edge to ActionAudit entities by IDs.
+func (_u *TenantUpdateOne) RemoveActionAuditIDs(ids ...uuid.UUID) *TenantUpdateOne {
+	_u.mutation.RemoveActionAuditIDs(ids...)
+	return _u
+}
+
+// RemoveActionAudits removes "action_audits" edges to ActionAudit entities.
+func (_u *TenantUpdateOne) RemoveActionAudits(v ...*ActionAudit) *TenantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveActionAuditIDs(ids...)
+}
+
+// ClearSecrets clears all "secrets" edges to the TenantSecret entity.
+func (_u *TenantUpdateOne) ClearSecrets() *TenantUpdateOne {
+	_u.mutation.ClearSecrets()
+	return _u
+}
+
+// RemoveSecretIDs removes the "secrets" edge to TenantSecret entities by IDs.
+func (_u *TenantUpdateOne) RemoveSecretIDs(ids ...uuid.UUID) *TenantUpdateOne {
+	_u.mutation.RemoveSecretIDs(ids...)
+	return _u
+}
+
+// RemoveSecrets removes "secrets" edges to TenantSecret entities.
+func (_u *TenantUpdateOne) RemoveSecrets(v ...*TenantSecret) *TenantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSecretIDs(ids...)
+}
+
+// ClearFileInstances clears all "file_instances" edges to the FileInstance entity.
+func (_u *TenantUpdateOne) ClearFileInstances() *TenantUpdateOne {
+	_u.mutation.ClearFileInstances()
+	return _u
+}
+
+// RemoveFileInstanceIDs removes the "file_instances" edge to FileInstance entities by IDs.
+func (_u *TenantUpdateOne) RemoveFileInstanceIDs(ids ...uuid.UUID) *TenantUpdateOne {
+	_u.mutation.RemoveFileInstanceIDs(ids...)
+	return _u
+}
+
+// RemoveFileInstances removes "file_instances" edges to FileInstance entities.
+func (_u *TenantUpdateOne) RemoveFileInstances(v ...*FileInstance) *TenantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveFileInstanceIDs(ids...)
+}
+
+// ClearContentIdentities clears all "content_identities" edges to the ContentIdentity entity.
+func (_u *TenantUpdateOne) ClearContentIdentities() *TenantUpdateOne {
+	_u.mutation.ClearContentIdentities()
+	return _u
+}
+
+// RemoveContentIdentityIDs removes the "content_identities" edge to ContentIdentity entities by IDs.
+func (_u *TenantUpdateOne) RemoveContentIdentityIDs(ids ...uuid.UUID) *TenantUpdateOne {
+	_u.mutation.RemoveContentIdentityIDs(ids...)
+	return _u
+}
+
+// RemoveContentIdentities removes "content_identities" edges to ContentIdentity entities.
+func (_u *TenantUpdateOne) RemoveContentIdentities(v ...*ContentIdentity) *TenantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveContentIdentityIDs(ids...)
+}
+
+// ClearContentEvents clears all "content_events" edges to the ContentEvent entity.
+func (_u *TenantUpdateOne) ClearContentEvents() *TenantUpdateOne {
+	_u.mutation.ClearContentEvents()
+	return _u
+}
+
+// RemoveContentEventIDs removes the "content_events" edge to ContentEvent entities by IDs.
+func (_u *TenantUpdateOne) RemoveContentEventIDs(ids ...uuid.UUID) *TenantUpdateOne {
+	_u.mutation.RemoveContentEventIDs(ids...)
+	return _u
+}
+
+// RemoveContentEvents removes "content_events" edges to ContentEvent entities.
+func (_u *TenantUpdateOne) RemoveContentEvents(v ...*ContentEvent) *TenantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveContentEventIDs(ids...)
+}
+
+// ClearScanTargets clears all "scan_targets" edges to the ScanTarget entity.
+func (_u *TenantUpdateOne) ClearScanTargets() *TenantUpdateOne {
+	_u.mutation.ClearScanTargets()
+	return _u
+}
+
+// RemoveScanTargetIDs removes the "scan_targets" edge to ScanTarget entities by IDs.
+func (_u *TenantUpdateOne) RemoveScanTargetIDs(ids ...uuid.UUID) *TenantUpdateOne {
+	_u.mutation.RemoveScanTargetIDs(ids...)
+	return _u
+}
+
+// RemoveScanTargets removes "scan_targets" edges to ScanTarget entities.
+func (_u *TenantUpdateOne) RemoveScanTargets(v ...*ScanTarget) *TenantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveScanTargetIDs(ids...)
+}
+
+// ClearScanSchedules clears all "scan_schedules" edges to the ScanSchedule entity.
+func (_u *TenantUpdateOne) ClearScanSchedules() *TenantUpdateOne {
+	_u.mutation.ClearScanSchedules()
+	return _u
+}
+
+// RemoveScanScheduleIDs removes the "scan_schedules" edge to ScanSchedule entities by IDs.
+func (_u *TenantUpdateOne) RemoveScanScheduleIDs(ids ...uuid.UUID) *TenantUpdateOne {
+	_u.mutation.RemoveScanScheduleIDs(ids...)
+	return _u
+}
+
+// RemoveScanSchedules removes "scan_schedules" edges to ScanSchedule entities.
+func (_u *TenantUpdateOne) RemoveScanSchedules(v ...*ScanSchedule) *TenantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveScanScheduleIDs(ids...)
+}
+
+// ClearScanScheduleRuns clears all "scan_schedule_runs" edges to the ScanScheduleRun entity.
+func (_u *TenantUpdateOne) ClearScanScheduleRuns() *TenantUpdateOne {
+	_u.mutation.ClearScanScheduleRuns()
+	return _u
+}
+
+// RemoveScanScheduleRunIDs removes the "scan_schedule_runs" edge to ScanScheduleRun entities by IDs.
+func (_u *TenantUpdateOne) RemoveScanScheduleRunIDs(ids ...uuid.UUID) *TenantUpdateOne {
+	_u.mutation.RemoveScanScheduleRunIDs(ids...)
+	return _u
+}
+
+// RemoveScanScheduleRuns removes "scan_schedule_runs" edges to ScanScheduleRun entities.
+func (_u *TenantUpdateOne) RemoveScanScheduleRuns(v ...*ScanScheduleRun) *TenantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveScanScheduleRunIDs(ids...)
+}
+
+// ClearActionJobs clears all "action_jobs" edges to the ActionJob entity.
+func (_u *TenantUpdateOne) ClearActionJobs() *TenantUpdateOne {
+	_u.mutation.ClearActionJobs()
+	return _u
+}
+
+// RemoveActionJobIDs removes the "action_jobs" edge to ActionJob entities by IDs.
+func (_u *TenantUpdateOne) RemoveActionJobIDs(ids ...uuid.UUID) *TenantUpdateOne {
+	_u.mutation.RemoveActionJobIDs(ids...)
+	return _u
+}
+
+// RemoveActionJobs removes "action_jobs" edges to ActionJob entities.
+func (_u *TenantUpdateOne) RemoveActionJobs(v ...*ActionJob) *TenantUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveActionJobIDs(ids...)
+}
+
+// Where appends a list predicates to the TenantUpdate builder.
+func (_u *TenantUpdateOne) Where(ps ...predicate.Tenant) *TenantUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TenantUpdateOne) Select(field string, fields ...string) *TenantUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Tenant entity.
+func (_u *TenantUpdateOne) Save(ctx context.Context) (*Tenant, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TenantUpdateOne) SaveX(ctx context.Context) *Tenant {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TenantUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TenantUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TenantUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if tenant.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized tenant.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := tenant.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err error) {
+	_spec := sqlgraph.NewUpdateSpec(tenant.Table, tenant.Columns, sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Tenant.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tenant.FieldID)
+		for _, f := range fields {
+			if !tenant.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tenant.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(tenant.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(tenant.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(tenant.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(tenant.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.PrimaryContact(); ok {
+		_spec.SetField(tenant.FieldPrimaryContact, field.TypeString, value)
+	}
+	if _u.mutation.PrimaryContactCleared() {
+		_spec.ClearField(tenant.FieldPrimaryContact, field.TypeString)
+	}
+	if value, ok := _u.mutation.ArchivedAt(); ok {
+		_spec.SetField(tenant.FieldArchivedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ArchivedAtCleared() {
+		_spec.ClearField(tenant.FieldArchivedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.QuotaManifestBytes(); ok {
+		_spec.SetField(tenant.FieldQuotaManifestBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedQuotaManifestBytes(); ok {
+		_spec.AddField(tenant.FieldQuotaManifestBytes, field.TypeInt64, value)
+	}
+	if _u.mutation.QuotaManifestBytesCleared() {
+		_spec.ClearField(tenant.FieldQuotaManifestBytes, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.QuotaIngestPerMinute(); ok {
+		_spec.SetField(tenant.FieldQuotaIngestPerMinute, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedQuotaIngestPerMinute(); ok {
+		_spec.AddField(tenant.FieldQuotaIngestPerMinute, field.TypeInt, value)
+	}
+	if _u.mutation.QuotaIngestPerMinuteCleared() {
+		_spec.ClearField(tenant.FieldQuotaIngestPerMinute, field.TypeInt)
+	}
+	if value, ok := _u.mutation.QuotaMachines(); ok {
+		_spec.SetField(tenant.FieldQuotaMachines, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedQuotaMachines(); ok {
+		_spec.AddField(tenant.FieldQuotaMachines, field.TypeInt, value)
+	}
+	if _u.mutation.QuotaMachinesCleared() {
+		_spec.ClearField(tenant.FieldQuotaMachines, field.TypeInt)
+	}
+	if value, ok := _u.mutation.QuotaRetainedScans(); ok {
+		_spec.SetField(tenant.FieldQuotaRetainedScans, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedQuotaRetainedScans(); ok {
+		_spec.AddField(tenant.FieldQuotaRetainedScans, field.TypeInt, value)
+	}
+	if _u.mutation.QuotaRetainedScansCleared() {
+		_spec.ClearField(tenant.FieldQuotaRetainedScans, field.TypeInt)
+	}
+	if value, ok := _u.mutation.QuotaConcurrentActions(); ok {
+		_spec.SetField(tenant.FieldQuotaConcurrentActions, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedQuotaConcurrentActions(); ok {
+		_spec.AddField(tenant.FieldQuotaConcurrentActions, field.TypeInt, value)
+	}
+	if _u.mutation.QuotaConcurrentActionsCleared() {
+		_spec.ClearField(tenant.FieldQuotaConcurrentActions, field.TypeInt)
+	}
+	if value, ok := _u.mutation.RetentionKeepScans(); ok {
+		_spec.SetField(tenant.FieldRetentionKeepScans, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRetentionKeepScans(); ok {
+		_spec.AddField(tenant.FieldRetentionKeepScans, field.TypeInt, value)
+	}
+	if _u.mutation.RetentionKeepScansCleared() {
+		_spec.ClearField(tenant.FieldRetentionKeepScans, field.TypeInt)
+	}
+	if value, ok := _u.mutation.RetentionArchiveAfterDays(); ok {
+		_spec.SetField(tenant.FieldRetentionArchiveAfterDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRetentionArchiveAfterDays(); ok {
+		_spec.AddField(tenant.FieldRetentionArchiveAfterDays, field.TypeInt, value)
+	}
+	if _u.mutation.RetentionArchiveAfterDaysCleared() {
+		_spec.ClearField(tenant.FieldRetentionArchiveAfterDays, field.TypeInt)
+	}
+	if _u.mutation.MachinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.MachinesTable,
+			Columns: []string{tenant.MachinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(machine.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMachinesIDs(); len(nodes) > 0 && !_u.mutation.MachinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.MachinesTable,
+			Columns: []string{tenant.MachinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(machine.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MachinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.MachinesTable,
+			Columns: []string{tenant.MachinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(machine.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ScansCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ScansTable,
+			Columns: []string{tenant.ScansColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scan.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedScansIDs(); len(nodes) > 0 && !_u.mutation.ScansCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ScansTable,
+			Columns: []string{tenant.ScansColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scan.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ScansIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ScansTable,
+			Columns: []string{tenant.ScansColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scan.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DuplicateGroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.DuplicateGroupsTable,
+			Columns: []string{tenant.DuplicateGroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(duplicategroup.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDuplicateGroupsIDs(); len(nodes) > 0 && !_u.mutation.DuplicateGroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.DuplicateGroupsTable,
+			Columns: []string{tenant.DuplicateGroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(duplicategroup.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DuplicateGroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.DuplicateGroupsTable,
+			Columns: []string{tenant.DuplicateGroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(duplicategroup.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ActionAuditsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ActionAuditsTable,
+			Columns: []string{tenant.ActionAuditsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(actionaudit.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedActionAuditsIDs(); len(nodes) > 0 && !_u.mutation.ActionAuditsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ActionAuditsTable,
+			Columns: []string{tenant.ActionAuditsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(actionaudit.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ActionAuditsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ActionAuditsTable,
+			Columns: []string{tenant.ActionAuditsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(actionaudit.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SecretsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.SecretsTable,
+			Columns: []string{tenant.SecretsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenantsecret.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return node
-}
-
-// Exec executes the query on the entity.
-func (_u *TenantUpdateOne) Exec(ctx context.Context) error {
-	_, err := _u.Save(ctx)
-	return err
-}
-
-// ExecX is like Exec, but panics if an error occurs.
-func (_u *TenantUpdateOne) ExecX(ctx context.Context) {
-	if err := _u.Exec(ctx); err != nil {
-		panic(err)
+	if nodes := _u.mutation.RemovedSecretsIDs(); len(nodes) > 0 && !_u.mutation.SecretsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.SecretsTable,
+			Columns: []string{tenant.SecretsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenantsecret.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-}
-
-// defaults sets the default values of the builder before save.
-func (_u *TenantUpdateOne) defaults() {
-	if _, ok := _u.mutation.UpdateTime(); !ok {
-		v := tenant.UpdateDefaultUpdateTime()
-		_u.mutation.SetUpdateTime(v)
+	if nodes := _u.mutation.SecretsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.SecretsTable,
+			Columns: []string{tenant.SecretsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenantsecret.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-}
-
-func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err error) {
-	_spec := sqlgraph.NewUpdateSpec(tenant.Table, tenant.Columns, sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeUUID))
-	id, ok := _u.mutation.ID()
-	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Tenant.id" for update`)}
+	if _u.mutation.FileInstancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.FileInstancesTable,
+			Columns: []string{tenant.FileInstancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fileinstance.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	_spec.Node.ID.Value = id
-	if fields := _u.fields; len(fields) > 0 {
-		_spec.Node.Columns = make([]string, 0, len(fields))
-		_spec.Node.Columns = append(_spec.Node.Columns, tenant.FieldID)
-		for _, f := range fields {
-			if !tenant.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
-			}
-			if f != tenant.FieldID {
-				_spec.Node.Columns = append(_spec.Node.Columns, f)
-			}
+	if nodes := _u.mutation.RemovedFileInstancesIDs(); len(nodes) > 0 && !_u.mutation.FileInstancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.FileInstancesTable,
+			Columns: []string{tenant.FileInstancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fileinstance.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if ps := _u.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
+	if nodes := _u.mutation.FileInstancesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.FileInstancesTable,
+			Columns: []string{tenant.FileInstancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fileinstance.FieldID, field.TypeUUID),
+			},
 		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := _u.mutation.UpdateTime(); ok {
-		_spec.SetField(tenant.FieldUpdateTime, field.TypeTime, value)
+	if _u.mutation.ContentIdentitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ContentIdentitiesTable,
+			Columns: []string{tenant.ContentIdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentidentity.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.Name(); ok {
-		_spec.SetField(tenant.FieldName, field.TypeString, value)
+	if nodes := _u.mutation.RemovedContentIdentitiesIDs(); len(nodes) > 0 && !_u.mutation.ContentIdentitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ContentIdentitiesTable,
+			Columns: []string{tenant.ContentIdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentidentity.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.Description(); ok {
-		_spec.SetField(tenant.FieldDescription, field.TypeString, value)
+	if nodes := _u.mutation.ContentIdentitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ContentIdentitiesTable,
+			Columns: []string{tenant.ContentIdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentidentity.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.DescriptionCleared() {
-		_spec.ClearField(tenant.FieldDescription, field.TypeString)
+	if _u.mutation.ContentEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ContentEventsTable,
+			Columns: []string{tenant.ContentEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentevent.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.PrimaryContact(); ok {
-		_spec.SetField(tenant.FieldPrimaryContact, field.TypeString, value)
+	if nodes := _u.mutation.RemovedContentEventsIDs(); len(nodes) > 0 && !_u.mutation.ContentEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ContentEventsTable,
+			Columns: []string{tenant.ContentEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if _u.mutation.PrimaryContactCleared() {
-		_spec.ClearField(tenant.FieldPrimaryContact, field.TypeString)
+	if nodes := _u.mutation.ContentEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ContentEventsTable,
+			Columns: []string{tenant.ContentEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contentevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.MachinesCleared() {
+	if _u.mutation.ScanTargetsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.MachinesTable,
-			Columns: []string{tenant.MachinesColumn},
+			Table:   tenant.ScanTargetsTable,
+			Columns: []string{tenant.ScanTargetsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(machine.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(scantarget.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedMachinesIDs(); len(nodes) > 0 && !_u.mutation.MachinesCleared() {
+	if nodes := _u.mutation.RemovedScanTargetsIDs(); len(nodes) > 0 && !_u.mutation.ScanTargetsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.MachinesTable,
-			Columns: []string{tenant.MachinesColumn},
+			Table:   tenant.ScanTargetsTable,
+			Columns: []string{tenant.ScanTargetsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(machine.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(scantarget.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -836,15 +2708,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.MachinesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.ScanTargetsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.MachinesTable,
-			Columns: []string{tenant.MachinesColumn},
+			Table:   tenant.ScanTargetsTable,
+			Columns: []string{tenant.ScanTargetsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(machine.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(scantarget.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -852,28 +2724,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.ScansCleared() {
+	if _u.mutation.ScanSchedulesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.ScansTable,
-			Columns: []string{tenant.ScansColumn},
+			Table:   tenant.ScanSchedulesTable,
+			Columns: []string{tenant.ScanSchedulesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(scan.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(scanschedule.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedScansIDs(); len(nodes) > 0 && !_u.mutation.ScansCleared() {
+	if nodes := _u.mutation.RemovedScanSchedulesIDs(); len(nodes) > 0 && !_u.mutation.ScanSchedulesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.ScansTable,
-			Columns: []string{tenant.ScansColumn},
+			Table:   tenant.ScanSchedulesTable,
+			Columns: []string{tenant.ScanSchedulesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(scan.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(scanschedule.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -881,15 +2753,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.ScansIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.ScanSchedulesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.ScansTable,
-			Columns: []string{tenant.ScansColumn},
+			Table:   tenant.ScanSchedulesTable,
+			Columns: []string{tenant.ScanSchedulesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(scan.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(scanschedule.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -897,28 +2769,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.DuplicateGroupsCleared() {
+	if _u.mutation.ScanScheduleRunsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.DuplicateGroupsTable,
-			Columns: []string{tenant.DuplicateGroupsColumn},
+			Table:   tenant.ScanScheduleRunsTable,
+			Columns: []string{tenant.ScanScheduleRunsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(duplicategroup.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(scanschedulerun.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedDuplicateGroupsIDs(); len(nodes) > 0 && !_u.mutation.DuplicateGroupsCleared() {
+	if nodes := _u.mutation.RemovedScanScheduleRunsIDs(); len(nodes) > 0 && !_u.mutation.ScanScheduleRunsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.DuplicateGroupsTable,
-			Columns: []string{tenant.DuplicateGroupsColumn},
+			Table:   tenant.ScanScheduleRunsTable,
+			Columns: []string{tenant.ScanScheduleRunsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(duplicategroup.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(scanschedulerun.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -926,15 +2798,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.DuplicateGroupsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.ScanScheduleRunsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.DuplicateGroupsTable,
-			Columns: []string{tenant.DuplicateGroupsColumn},
+			Table:   tenant.ScanScheduleRunsTable,
+			Columns: []string{tenant.ScanScheduleRunsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(duplicategroup.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(scanschedulerun.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -942,28 +2814,28 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.ActionAuditsCleared() {
+	if _u.mutation.ActionJobsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.ActionAuditsTable,
-			Columns: []string{tenant.ActionAuditsColumn},
+			Table:   tenant.ActionJobsTable,
+			Columns: []string{tenant.ActionJobsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(actionaudit.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(actionjob.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedActionAuditsIDs(); len(nodes) > 0 && !_u.mutation.ActionAuditsCleared() {
+	if nodes := _u.mutation.RemovedActionJobsIDs(); len(nodes) > 0 && !_u.mutation.ActionJobsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.ActionAuditsTable,
-			Columns: []string{tenant.ActionAuditsColumn},
+			Table:   tenant.ActionJobsTable,
+			Columns: []string{tenant.ActionJobsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(actionaudit.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(actionjob.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -971,15 +2843,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.ActionAuditsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.ActionJobsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   tenant.ActionAuditsTable,
-			Columns: []string{tenant.ActionAuditsColumn},
+			Table:   tenant.ActionJobsTable,
+			Columns: []string{tenant.ActionJobsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(actionaudit.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(actionjob.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -0,0 +1,435 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/mcmx/duplynx/ent/machine"
+	"github.com/mcmx/duplynx/ent/tenant"
+	"github.com/mcmx/duplynx/ent/tenantsecret"
+)
+
+// TenantSecretCreate is the builder for creating a TenantSecret entity.
+type TenantSecretCreate struct {
+	config
+	mutation *TenantSecretMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *TenantSecretCreate) SetCreateTime(v time.Time) *TenantSecretCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *TenantSecretCreate) SetNillableCreateTime(v *time.Time) *TenantSecretCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *TenantSecretCreate) SetUpdateTime(v time.Time) *TenantSecretCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *TenantSecretCreate) SetNillableUpdateTime(v *time.Time) *TenantSecretCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *TenantSecretCreate) SetTenantID(v uuid.UUID) *TenantSecretCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetMachineID sets the "machine_id" field.
+func (_c *TenantSecretCreate) SetMachineID(v uuid.UUID) *TenantSecretCreate {
+	_c.mutation.SetMachineID(v)
+	return _c
+}
+
+// SetNillableMachineID sets the "machine_id" field if the given value is not nil.
+func (_c *TenantSecretCreate) SetNillableMachineID(v *uuid.UUID) *TenantSecretCreate {
+	if v != nil {
+		_c.SetMachineID(*v)
+	}
+	return _c
+}
+
+// SetKeyID sets the "key_id" field.
+func (_c *TenantSecretCreate) SetKeyID(v string) *TenantSecretCreate {
+	_c.mutation.SetKeyID(v)
+	return _c
+}
+
+// SetSecret sets the "secret" field.
+func (_c *TenantSecretCreate) SetSecret(v string) *TenantSecretCreate {
+	_c.mutation.SetSecret(v)
+	return _c
+}
+
+// SetNotBefore sets the "not_before" field.
+func (_c *TenantSecretCreate) SetNotBefore(v time.Time) *TenantSecretCreate {
+	_c.mutation.SetNotBefore(v)
+	return _c
+}
+
+// SetNillableNotBefore sets the "not_before" field if the given value is not nil.
+func (_c *TenantSecretCreate) SetNillableNotBefore(v *time.Time) *TenantSecretCreate {
+	if v != nil {
+		_c.SetNotBefore(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *TenantSecretCreate) SetExpiresAt(v time.Time) *TenantSecretCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_c *TenantSecretCreate) SetNillableExpiresAt(v *time.Time) *TenantSecretCreate {
+	if v != nil {
+		_c.SetExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_c *TenantSecretCreate) SetRevokedAt(v time.Time) *TenantSecretCreate {
+	_c.mutation.SetRevokedAt(v)
+	return _c
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_c *TenantSecretCreate) SetNillableRevokedAt(v *time.Time) *TenantSecretCreate {
+	if v != nil {
+		_c.SetRevokedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TenantSecretCreate) SetID(v uuid.UUID) *TenantSecretCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *TenantSecretCreate) SetNillableID(v *uuid.UUID) *TenantSecretCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_c *TenantSecretCreate) SetTenant(v *Tenant) *TenantSecretCreate {
+	return _c.SetTenantID(v.ID)
+}
+
+// SetMachine sets the "machine" edge to the Machine entity.
+func (_c *TenantSecretCreate) SetMachine(v *Machine) *TenantSecretCreate {
+	return _c.SetMachineID(v.ID)
+}
+
+// Mutation returns the TenantSecretMutation object of the builder.
+func (_c *TenantSecretCreate) Mutation() *TenantSecretMutation {
+	return _c.mutation
+}
+
+// Save creates the TenantSecret in the database.
+func (_c *TenantSecretCreate) Save(ctx context.Context) (*TenantSecret, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TenantSecretCreate) SaveX(ctx context.Context) *TenantSecret {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TenantSecretCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TenantSecretCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TenantSecretCreate) defaults() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		if tenantsecret.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized tenantsecret.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
+		v := tenantsecret.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		if tenantsecret.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized tenantsecret.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := tenantsecret.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.NotBefore(); !ok {
+		if tenantsecret.DefaultNotBefore == nil {
+			return fmt.Errorf("ent: uninitialized tenantsecret.DefaultNotBefore (forgotten import ent/runtime?)")
+		}
+		v := tenantsecret.DefaultNotBefore()
+		_c.mutation.SetNotBefore(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if tenantsecret.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized tenantsecret.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := tenantsecret.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TenantSecretCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "TenantSecret.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "TenantSecret.update_time"`)}
+	}
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "TenantSecret.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.KeyID(); !ok {
+		return &ValidationError{Name: "key_id", err: errors.New(`ent: missing required field "TenantSecret.key_id"`)}
+	}
+	if v, ok := _c.mutation.KeyID(); ok {
+		if err := tenantsecret.KeyIDValidator(v); err != nil {
+			return &ValidationError{Name: "key_id", err: fmt.Errorf(`ent: validator failed for field "TenantSecret.key_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Secret(); !ok {
+		return &ValidationError{Name: "secret", err: errors.New(`ent: missing required field "TenantSecret.secret"`)}
+	}
+	if v, ok := _c.mutation.Secret(); ok {
+		if err := tenantsecret.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`ent: validator failed for field "TenantSecret.secret": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.NotBefore(); !ok {
+		return &ValidationError{Name: "not_before", err: errors.New(`ent: missing required field "TenantSecret.not_before"`)}
+	}
+	if len(_c.mutation.TenantIDs()) == 0 {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required edge "TenantSecret.tenant"`)}
+	}
+	return nil
+}
+
+func (_c *TenantSecretCreate) sqlSave(ctx context.Context) (*TenantSecret, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TenantSecretCreate) createSpec() (*TenantSecret, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TenantSecret{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(tenantsecret.Table, sqlgraph.NewFieldSpec(tenantsecret.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(tenantsecret.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(tenantsecret.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.KeyID(); ok {
+		_spec.SetField(tenantsecret.FieldKeyID, field.TypeString, value)
+		_node.KeyID = value
+	}
+	if value, ok := _c.mutation.Secret(); ok {
+		_spec.SetField(tenantsecret.FieldSecret, field.TypeString, value)
+		_node.Secret = value
+	}
+	if value, ok := _c.mutation.NotBefore(); ok {
+		_spec.SetField(tenantsecret.FieldNotBefore, field.TypeTime, value)
+		_node.NotBefore = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(tenantsecret.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.RevokedAt(); ok {
+		_spec.SetField(tenantsecret.FieldRevokedAt, field.TypeTime, value)
+		_node.RevokedAt = value
+	}
+	if nodes := _c.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tenantsecret.TenantTable,
+			Columns: []string{tenantsecret.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TenantID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MachineIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tenantsecret.MachineTable,
+			Columns: []string{tenantsecret.MachineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(machine.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MachineID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TenantSecretCreateBulk is the builder for creating many TenantSecret entities in bulk.
+type TenantSecretCreateBulk struct {
+	config
+	err      error
+	builders []*TenantSecretCreate
+}
+
+// Save creates the TenantSecret entities in the database.
+func (_c *TenantSecretCreateBulk) Save(ctx context.Context) ([]*TenantSecret, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TenantSecret, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TenantSecretMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TenantSecretCreateBulk) SaveX(ctx context.Context) []*TenantSecret {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TenantSecretCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TenantSecretCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,703 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/mcmx/duplynx/ent/machine"
+	"github.com/mcmx/duplynx/ent/predicate"
+	"github.com/mcmx/duplynx/ent/tenant"
+	"github.com/mcmx/duplynx/ent/tenantsecret"
+)
+
+// TenantSecretUpdate is the builder for updating TenantSecret entities.
+type TenantSecretUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TenantSecretMutation
+}
+
+// Where appends a list predicates to the TenantSecretUpdate builder.
+func (_u *TenantSecretUpdate) Where(ps ...predicate.TenantSecret) *TenantSecretUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *TenantSecretUpdate) SetUpdateTime(v time.Time) *TenantSecretUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *TenantSecretUpdate) SetTenantID(v uuid.UUID) *TenantSecretUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *TenantSecretUpdate) SetNillableTenantID(v *uuid.UUID) *TenantSecretUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetMachineID sets the "machine_id" field.
+func (_u *TenantSecretUpdate) SetMachineID(v uuid.UUID) *TenantSecretUpdate {
+	_u.mutation.SetMachineID(v)
+	return _u
+}
+
+// SetNillableMachineID sets the "machine_id" field if the given value is not nil.
+func (_u *TenantSecretUpdate) SetNillableMachineID(v *uuid.UUID) *TenantSecretUpdate {
+	if v != nil {
+		_u.SetMachineID(*v)
+	}
+	return _u
+}
+
+// ClearMachineID clears the value of the "machine_id" field.
+func (_u *TenantSecretUpdate) ClearMachineID() *TenantSecretUpdate {
+	_u.mutation.ClearMachineID()
+	return _u
+}
+
+// SetKeyID sets the "key_id" field.
+func (_u *TenantSecretUpdate) SetKeyID(v string) *TenantSecretUpdate {
+	_u.mutation.SetKeyID(v)
+	return _u
+}
+
+// SetNillableKeyID sets the "key_id" field if the given value is not nil.
+func (_u *TenantSecretUpdate) SetNillableKeyID(v *string) *TenantSecretUpdate {
+	if v != nil {
+		_u.SetKeyID(*v)
+	}
+	return _u
+}
+
+// SetSecret sets the "secret" field.
+func (_u *TenantSecretUpdate) SetSecret(v string) *TenantSecretUpdate {
+	_u.mutation.SetSecret(v)
+	return _u
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (_u *TenantSecretUpdate) SetNillableSecret(v *string) *TenantSecretUpdate {
+	if v != nil {
+		_u.SetSecret(*v)
+	}
+	return _u
+}
+
+// SetNotBefore sets the "not_before" field.
+func (_u *TenantSecretUpdate) SetNotBefore(v time.Time) *TenantSecretUpdate {
+	_u.mutation.SetNotBefore(v)
+	return _u
+}
+
+// SetNillableNotBefore sets the "not_before" field if the given value is not nil.
+func (_u *TenantSecretUpdate) SetNillableNotBefore(v *time.Time) *TenantSecretUpdate {
+	if v != nil {
+		_u.SetNotBefore(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *TenantSecretUpdate) SetExpiresAt(v time.Time) *TenantSecretUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *TenantSecretUpdate) SetNillableExpiresAt(v *time.Time) *TenantSecretUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *TenantSecretUpdate) ClearExpiresAt() *TenantSecretUpdate {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_u *TenantSecretUpdate) SetRevokedAt(v time.Time) *TenantSecretUpdate {
+	_u.mutation.SetRevokedAt(v)
+	return _u
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_u *TenantSecretUpdate) SetNillableRevokedAt(v *time.Time) *TenantSecretUpdate {
+	if v != nil {
+		_u.SetRevokedAt(*v)
+	}
+	return _u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (_u *TenantSecretUpdate) ClearRevokedAt() *TenantSecretUpdate {
+	_u.mutation.ClearRevokedAt()
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *TenantSecretUpdate) SetTenant(v *Tenant) *TenantSecretUpdate {
+	return _u.SetTenantID(v.ID)
+}
+
+// SetMachine sets the "machine" edge to the Machine entity.
+func (_u *TenantSecretUpdate) SetMachine(v *Machine) *TenantSecretUpdate {
+	return _u.SetMachineID(v.ID)
+}
+
+// Mutation returns the TenantSecretMutation object of the builder.
+func (_u *TenantSecretUpdate) Mutation() *TenantSecretMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *TenantSecretUpdate) ClearTenant() *TenantSecretUpdate {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearMachine clears the "machine" edge to the Machine entity.
+func (_u *TenantSecretUpdate) ClearMachine() *TenantSecretUpdate {
+	_u.mutation.ClearMachine()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TenantSecretUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TenantSecretUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TenantSecretUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TenantSecretUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TenantSecretUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if tenantsecret.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized tenantsecret.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := tenantsecret.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TenantSecretUpdate) check() error {
+	if v, ok := _u.mutation.KeyID(); ok {
+		if err := tenantsecret.KeyIDValidator(v); err != nil {
+			return &ValidationError{Name: "key_id", err: fmt.Errorf(`ent: validator failed for field "TenantSecret.key_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Secret(); ok {
+		if err := tenantsecret.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`ent: validator failed for field "TenantSecret.secret": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TenantSecret.tenant"`)
+	}
+	return nil
+}
+
+func (_u *TenantSecretUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tenantsecret.Table, tenantsecret.Columns, sqlgraph.NewFieldSpec(tenantsecret.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(tenantsecret.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.KeyID(); ok {
+		_spec.SetField(tenantsecret.FieldKeyID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Secret(); ok {
+		_spec.SetField(tenantsecret.FieldSecret, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.NotBefore(); ok {
+		_spec.SetField(tenantsecret.FieldNotBefore, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(tenantsecret.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(tenantsecret.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RevokedAt(); ok {
+		_spec.SetField(tenantsecret.FieldRevokedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RevokedAtCleared() {
+		_spec.ClearField(tenantsecret.FieldRevokedAt, field.TypeTime)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tenantsecret.TenantTable,
+			Columns: []string{tenantsecret.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tenantsecret.TenantTable,
+			Columns: []string{tenantsecret.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MachineCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tenantsecret.MachineTable,
+			Columns: []string{tenantsecret.MachineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(machine.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MachineIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tenantsecret.MachineTable,
+			Columns: []string{tenantsecret.MachineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(machine.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tenantsecret.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TenantSecretUpdateOne is the builder for updating a single TenantSecret entity.
+type TenantSecretUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TenantSecretMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *TenantSecretUpdateOne) SetUpdateTime(v time.Time) *TenantSecretUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *TenantSecretUpdateOne) SetTenantID(v uuid.UUID) *TenantSecretUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *TenantSecretUpdateOne) SetNillableTenantID(v *uuid.UUID) *TenantSecretUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetMachineID sets the "machine_id" field.
+func (_u *TenantSecretUpdateOne) SetMachineID(v uuid.UUID) *TenantSecretUpdateOne {
+	_u.mutation.SetMachineID(v)
+	return _u
+}
+
+// SetNillableMachineID sets the "machine_id" field if the given value is not nil.
+func (_u *TenantSecretUpdateOne) SetNillableMachineID(v *uuid.UUID) *TenantSecretUpdateOne {
+	if v != nil {
+		_u.SetMachineID(*v)
+	}
+	return _u
+}
+
+// ClearMachineID clears the value of the "machine_id" field.
+func (_u *TenantSecretUpdateOne) ClearMachineID() *TenantSecretUpdateOne {
+	_u.mutation.ClearMachineID()
+	return _u
+}
+
+// SetKeyID sets the "key_id" field.
+func (_u *TenantSecretUpdateOne) SetKeyID(v string) *TenantSecretUpdateOne {
+	_u.mutation.SetKeyID(v)
+	return _u
+}
+
+// SetNillableKeyID sets the "key_id" field if the given value is not nil.
+func (_u *TenantSecretUpdateOne) SetNillableKeyID(v *string) *TenantSecretUpdateOne {
+	if v != nil {
+		_u.SetKeyID(*v)
+	}
+	return _u
+}
+
+// SetSecret sets the "secret" field.
+func (_u *TenantSecretUpdateOne) SetSecret(v string) *TenantSecretUpdateOne {
+	_u.mutation.SetSecret(v)
+	return _u
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (_u *TenantSecretUpdateOne) SetNillableSecret(v *string) *TenantSecretUpdateOne {
+	if v != nil {
+		_u.SetSecret(*v)
+	}
+	return _u
+}
+
+// SetNotBefore sets the "not_before" field.
+func (_u *TenantSecretUpdateOne) SetNotBefore(v time.Time) *TenantSecretUpdateOne {
+	_u.mutation.SetNotBefore(v)
+	return _u
+}
+
+// SetNillableNotBefore sets the "not_before" field if the given value is not nil.
+func (_u *TenantSecretUpdateOne) SetNillableNotBefore(v *time.Time) *TenantSecretUpdateOne {
+	if v != nil {
+		_u.SetNotBefore(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *TenantSecretUpdateOne) SetExpiresAt(v time.Time) *TenantSecretUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *TenantSecretUpdateOne) SetNillableExpiresAt(v *time.Time) *TenantSecretUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *TenantSecretUpdateOne) ClearExpiresAt() *TenantSecretUpdateOne {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_u *TenantSecretUpdateOne) SetRevokedAt(v time.Time) *TenantSecretUpdateOne {
+	_u.mutation.SetRevokedAt(v)
+	return _u
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_u *TenantSecretUpdateOne) SetNillableRevokedAt(v *time.Time) *TenantSecretUpdateOne {
+	if v != nil {
+		_u.SetRevokedAt(*v)
+	}
+	return _u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (_u *TenantSecretUpdateOne) ClearRevokedAt() *TenantSecretUpdateOne {
+	_u.mutation.ClearRevokedAt()
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *TenantSecretUpdateOne) SetTenant(v *Tenant) *TenantSecretUpdateOne {
+	return _u.SetTenantID(v.ID)
+}
+
+// SetMachine sets the "machine" edge to the Machine entity.
+func (_u *TenantSecretUpdateOne) SetMachine(v *Machine) *TenantSecretUpdateOne {
+	return _u.SetMachineID(v.ID)
+}
+
+// Mutation returns the TenantSecretMutation object of the builder.
+func (_u *TenantSecretUpdateOne) Mutation() *TenantSecretMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *TenantSecretUpdateOne) ClearTenant() *TenantSecretUpdateOne {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearMachine clears the "machine" edge to the Machine entity.
+func (_u *TenantSecretUpdateOne) ClearMachine() *TenantSecretUpdateOne {
+	_u.mutation.ClearMachine()
+	return _u
+}
+
+// Where appends a list predicates to the TenantSecretUpdate builder.
+func (_u *TenantSecretUpdateOne) Where(ps ...predicate.TenantSecret) *TenantSecretUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TenantSecretUpdateOne) Select(field string, fields ...string) *TenantSecretUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TenantSecret entity.
+func (_u *TenantSecretUpdateOne) Save(ctx context.Context) (*TenantSecret, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TenantSecretUpdateOne) SaveX(ctx context.Context) *TenantSecret {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TenantSecretUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TenantSecretUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TenantSecretUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if tenantsecret.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized tenantsecret.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := tenantsecret.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TenantSecretUpdateOne) check() error {
+	if v, ok := _u.mutation.KeyID(); ok {
+		if err := tenantsecret.KeyIDValidator(v); err != nil {
+			return &ValidationError{Name: "key_id", err: fmt.Errorf(`ent: validator failed for field "TenantSecret.key_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Secret(); ok {
+		if err := tenantsecret.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`ent: validator failed for field "TenantSecret.secret": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TenantSecret.tenant"`)
+	}
+	return nil
+}
+
+func (_u *TenantSecretUpdateOne) sqlSave(ctx context.Context) (_node *TenantSecret, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tenantsecret.Table, tenantsecret.Columns, sqlgraph.NewFieldSpec(tenantsecret.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TenantSecret.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tenantsecret.FieldID)
+		for _, f := range fields {
+			if !tenantsecret.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tenantsecret.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(tenantsecret.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.KeyID(); ok {
+		_spec.SetField(tenantsecret.FieldKeyID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Secret(); ok {
+		_spec.SetField(tenantsecret.FieldSecret, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.NotBefore(); ok {
+		_spec.SetField(tenantsecret.FieldNotBefore, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(tenantsecret.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(tenantsecret.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RevokedAt(); ok {
+		_spec.SetField(tenantsecret.FieldRevokedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RevokedAtCleared() {
+		_spec.ClearField(tenantsecret.FieldRevokedAt, field.TypeTime)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tenantsecret.TenantTable,
+			Columns: []string{tenantsecret.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tenantsecret.TenantTable,
+			Columns: []string{tenantsecret.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MachineCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tenantsecret.MachineTable,
+			Columns: []string{tenantsecret.MachineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(machine.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MachineIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tenantsecret.MachineTable,
+			Columns: []string{tenantsecret.MachineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(machine.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TenantSecret{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tenantsecret.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
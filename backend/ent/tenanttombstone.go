@@ -0,0 +1,189 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/mcmx/duplynx/ent/tenanttombstone"
+)
+
+// TenantTombstone is the model entity for the TenantTombstone schema.
+type TenantTombstone struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// TenantSlug holds the value of the "tenant_slug" field.
+	TenantSlug string `json:"tenant_slug,omitempty"`
+	// TenantName holds the value of the "tenant_name" field.
+	TenantName string `json:"tenant_name,omitempty"`
+	// Actor holds the value of the "actor" field.
+	Actor string `json:"actor,omitempty"`
+	// PurgedAt holds the value of the "purged_at" field.
+	PurgedAt time.Time `json:"purged_at,omitempty"`
+	// DeletedRows holds the value of the "deleted_rows" field.
+	DeletedRows  map[string]int `json:"deleted_rows,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TenantTombstone) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case tenanttombstone.FieldDeletedRows:
+			values[i] = new([]byte)
+		case tenanttombstone.FieldTenantSlug, tenanttombstone.FieldTenantName, tenanttombstone.FieldActor:
+			values[i] = new(sql.NullString)
+		case tenanttombstone.FieldCreateTime, tenanttombstone.FieldUpdateTime, tenanttombstone.FieldPurgedAt:
+			values[i] = new(sql.NullTime)
+		case tenanttombstone.FieldID, tenanttombstone.FieldTenantID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TenantTombstone fields.
+func (_m *TenantTombstone) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case tenanttombstone.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case tenanttombstone.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case tenanttombstone.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = value.Time
+			}
+		case tenanttombstone.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case tenanttombstone.FieldTenantSlug:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_slug", values[i])
+			} else if value.Valid {
+				_m.TenantSlug = value.String
+			}
+		case tenanttombstone.FieldTenantName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_name", values[i])
+			} else if value.Valid {
+				_m.TenantName = value.String
+			}
+		case tenanttombstone.FieldActor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field actor", values[i])
+			} else if value.Valid {
+				_m.Actor = value.String
+			}
+		case tenanttombstone.FieldPurgedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field purged_at", values[i])
+			} else if value.Valid {
+				_m.PurgedAt = value.Time
+			}
+		case tenanttombstone.FieldDeletedRows:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_rows", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.DeletedRows); err != nil {
+					return fmt.Errorf("unmarshal field deleted_rows: %w", err)
+				}
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TenantTombstone.
+// This includes values selected through modifiers, order, etc.
+func (_m *TenantTombstone) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TenantTombstone.
+// Note that you need to call TenantTombstone.Unwrap() before calling this method if this TenantTombstone
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TenantTombstone) Update() *TenantTombstoneUpdateOne {
+	return NewTenantTombstoneClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TenantTombstone entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TenantTombstone) Unwrap() *TenantTombstone {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TenantTombstone is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TenantTombstone) String() string {
+	var builder strings.Builder
+	builder.WriteString("TenantTombstone(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("tenant_slug=")
+	builder.WriteString(_m.TenantSlug)
+	builder.WriteString(", ")
+	builder.WriteString("tenant_name=")
+	builder.WriteString(_m.TenantName)
+	builder.WriteString(", ")
+	builder.WriteString("actor=")
+	builder.WriteString(_m.Actor)
+	builder.WriteString(", ")
+	builder.WriteString("purged_at=")
+	builder.WriteString(_m.PurgedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_rows=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DeletedRows))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TenantTombstones is a parsable slice of TenantTombstone.
+type TenantTombstones []*TenantTombstone
@@ -0,0 +1,471 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tenanttombstone
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/mcmx/duplynx/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantSlug applies equality check predicate on the "tenant_slug" field. It's identical to TenantSlugEQ.
+func TenantSlug(v string) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldEQ(FieldTenantSlug, v))
+}
+
+// TenantName applies equality check predicate on the "tenant_name" field. It's identical to TenantNameEQ.
+func TenantName(v string) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldEQ(FieldTenantName, v))
+}
+
+// Actor applies equality check predicate on the "actor" field. It's identical to ActorEQ.
+func Actor(v string) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldEQ(FieldActor, v))
+}
+
+// PurgedAt applies equality check predicate on the "purged_at" field. It's identical to PurgedAtEQ.
+func PurgedAt(v time.Time) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldEQ(FieldPurgedAt, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uuid.UUID) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uuid.UUID) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uuid.UUID) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uuid.UUID) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantSlugEQ applies the EQ predicate on the "tenant_slug" field.
+func TenantSlugEQ(v string) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldEQ(FieldTenantSlug, v))
+}
+
+// TenantSlugNEQ applies the NEQ predicate on the "tenant_slug" field.
+func TenantSlugNEQ(v string) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldNEQ(FieldTenantSlug, v))
+}
+
+// TenantSlugIn applies the In predicate on the "tenant_slug" field.
+func TenantSlugIn(vs ...string) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldIn(FieldTenantSlug, vs...))
+}
+
+// TenantSlugNotIn applies the NotIn predicate on the "tenant_slug" field.
+func TenantSlugNotIn(vs ...string) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldNotIn(FieldTenantSlug, vs...))
+}
+
+// TenantSlugGT applies the GT predicate on the "tenant_slug" field.
+func TenantSlugGT(v string) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldGT(FieldTenantSlug, v))
+}
+
+// TenantSlugGTE applies the GTE predicate on the "tenant_slug" field.
+func TenantSlugGTE(v string) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldGTE(FieldTenantSlug, v))
+}
+
+// TenantSlugLT applies the LT predicate on the "tenant_slug" field.
+func TenantSlugLT(v string) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldLT(FieldTenantSlug, v))
+}
+
+// TenantSlugLTE applies the LTE predicate on the "tenant_slug" field.
+func TenantSlugLTE(v string) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldLTE(FieldTenantSlug, v))
+}
+
+// TenantSlugContains applies the Contains predicate on the "tenant_slug" field.
+func TenantSlugContains(v string) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldContains(FieldTenantSlug, v))
+}
+
+// TenantSlugHasPrefix applies the HasPrefix predicate on the "tenant_slug" field.
+func TenantSlugHasPrefix(v string) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldHasPrefix(FieldTenantSlug, v))
+}
+
+// TenantSlugHasSuffix applies the HasSuffix predicate on the "tenant_slug" field.
+func TenantSlugHasSuffix(v string) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldHasSuffix(FieldTenantSlug, v))
+}
+
+// TenantSlugEqualFold applies the EqualFold predicate on the "tenant_slug" field.
+func TenantSlugEqualFold(v string) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldEqualFold(FieldTenantSlug, v))
+}
+
+// TenantSlugContainsFold applies the ContainsFold predicate on the "tenant_slug" field.
+func TenantSlugContainsFold(v string) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldContainsFold(FieldTenantSlug, v))
+}
+
+// TenantNameEQ applies the EQ predicate on the "tenant_name" field.
+func TenantNameEQ(v string) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldEQ(FieldTenantName, v))
+}
+
+// TenantNameNEQ applies the NEQ predicate on the "tenant_name" field.
+func TenantNameNEQ(v string) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldNEQ(FieldTenantName, v))
+}
+
+// TenantNameIn applies the In predicate on the "tenant_name" field.
+func TenantNameIn(vs ...string) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldIn(FieldTenantName, vs...))
+}
+
+// TenantNameNotIn applies the NotIn predicate on the "tenant_name" field.
+func TenantNameNotIn(vs ...string) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldNotIn(FieldTenantName, vs...))
+}
+
+// TenantNameGT applies the GT predicate on the "tenant_name" field.
+func TenantNameGT(v string) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldGT(FieldTenantName, v))
+}
+
+// TenantNameGTE applies the GTE predicate on the "tenant_name" field.
+func TenantNameGTE(v string) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldGTE(FieldTenantName, v))
+}
+
+// TenantNameLT applies the LT predicate on the "tenant_name" field.
+func TenantNameLT(v string) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldLT(FieldTenantName, v))
+}
+
+// TenantNameLTE applies the LTE predicate on the "tenant_name" field.
+func TenantNameLTE(v string) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldLTE(FieldTenantName, v))
+}
+
+// TenantNameContains applies the Contains predicate on the "tenant_name" field.
+func TenantNameContains(v string) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldContains(FieldTenantName, v))
+}
+
+// TenantNameHasPrefix applies the HasPrefix predicate on the "tenant_name" field.
+func TenantNameHasPrefix(v string) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldHasPrefix(FieldTenantName, v))
+}
+
+// TenantNameHasSuffix applies the HasSuffix predicate on the "tenant_name" field.
+func TenantNameHasSuffix(v string) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldHasSuffix(FieldTenantName, v))
+}
+
+// TenantNameEqualFold applies the EqualFold predicate on the "tenant_name" field.
+func TenantNameEqualFold(v string) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldEqualFold(FieldTenantName, v))
+}
+
+// TenantNameContainsFold applies the ContainsFold predicate on the "tenant_name" field.
+func TenantNameContainsFold(v string) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldContainsFold(FieldTenantName, v))
+}
+
+// ActorEQ applies the EQ predicate on the "actor" field.
+func ActorEQ(v string) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldEQ(FieldActor, v))
+}
+
+// ActorNEQ applies the NEQ predicate on the "actor" field.
+func ActorNEQ(v string) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldNEQ(FieldActor, v))
+}
+
+// ActorIn applies the In predicate on the "actor" field.
+func ActorIn(vs ...string) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldIn(FieldActor, vs...))
+}
+
+// ActorNotIn applies the NotIn predicate on the "actor" field.
+func ActorNotIn(vs ...string) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldNotIn(FieldActor, vs...))
+}
+
+// ActorGT applies the GT predicate on the "actor" field.
+func ActorGT(v string) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldGT(FieldActor, v))
+}
+
+// ActorGTE applies the GTE predicate on the "actor" field.
+func ActorGTE(v string) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldGTE(FieldActor, v))
+}
+
+// ActorLT applies the LT predicate on the "actor" field.
+func ActorLT(v string) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldLT(FieldActor, v))
+}
+
+// ActorLTE applies the LTE predicate on the "actor" field.
+func ActorLTE(v string) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldLTE(FieldActor, v))
+}
+
+// ActorContains applies the Contains predicate on the "actor" field.
+func ActorContains(v string) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldContains(FieldActor, v))
+}
+
+// ActorHasPrefix applies the HasPrefix predicate on the "actor" field.
+func ActorHasPrefix(v string) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldHasPrefix(FieldActor, v))
+}
+
+// ActorHasSuffix applies the HasSuffix predicate on the "actor" field.
+func ActorHasSuffix(v string) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldHasSuffix(FieldActor, v))
+}
+
+// ActorEqualFold applies the EqualFold predicate on the "actor" field.
+func ActorEqualFold(v string) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldEqualFold(FieldActor, v))
+}
+
+// ActorContainsFold applies the ContainsFold predicate on the "actor" field.
+func ActorContainsFold(v string) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldContainsFold(FieldActor, v))
+}
+
+// PurgedAtEQ applies the EQ predicate on the "purged_at" field.
+func PurgedAtEQ(v time.Time) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldEQ(FieldPurgedAt, v))
+}
+
+// PurgedAtNEQ applies the NEQ predicate on the "purged_at" field.
+func PurgedAtNEQ(v time.Time) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldNEQ(FieldPurgedAt, v))
+}
+
+// PurgedAtIn applies the In predicate on the "purged_at" field.
+func PurgedAtIn(vs ...time.Time) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldIn(FieldPurgedAt, vs...))
+}
+
+// PurgedAtNotIn applies the NotIn predicate on the "purged_at" field.
+func PurgedAtNotIn(vs ...time.Time) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldNotIn(FieldPurgedAt, vs...))
+}
+
+// PurgedAtGT applies the GT predicate on the "purged_at" field.
+func PurgedAtGT(v time.Time) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldGT(FieldPurgedAt, v))
+}
+
+// PurgedAtGTE applies the GTE predicate on the "purged_at" field.
+func PurgedAtGTE(v time.Time) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldGTE(FieldPurgedAt, v))
+}
+
+// PurgedAtLT applies the LT predicate on the "purged_at" field.
+func PurgedAtLT(v time.Time) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldLT(FieldPurgedAt, v))
+}
+
+// PurgedAtLTE applies the LTE predicate on the "purged_at" field.
+func PurgedAtLTE(v time.Time) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldLTE(FieldPurgedAt, v))
+}
+
+// DeletedRowsIsNil applies the IsNil predicate on the "deleted_rows" field.
+func DeletedRowsIsNil() predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldIsNull(FieldDeletedRows))
+}
+
+// DeletedRowsNotNil applies the NotNil predicate on the "deleted_rows" field.
+func DeletedRowsNotNil() predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.FieldNotNull(FieldDeletedRows))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TenantTombstone) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TenantTombstone) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TenantTombstone) predicate.TenantTombstone {
+	return predicate.TenantTombstone(sql.NotPredicates(p))
+}
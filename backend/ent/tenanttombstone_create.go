@@ -0,0 +1,350 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/mcmx/duplynx/ent/tenanttombstone"
+)
+
+// TenantTombstoneCreate is the builder for creating a TenantTombstone entity.
+type TenantTombstoneCreate struct {
+	config
+	mutation *TenantTombstoneMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *TenantTombstoneCreate) SetCreateTime(v time.Time) *TenantTombstoneCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *TenantTombstoneCreate) SetNillableCreateTime(v *time.Time) *TenantTombstoneCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *TenantTombstoneCreate) SetUpdateTime(v time.Time) *TenantTombstoneCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *TenantTombstoneCreate) SetNillableUpdateTime(v *time.Time) *TenantTombstoneCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *TenantTombstoneCreate) SetTenantID(v uuid.UUID) *TenantTombstoneCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetTenantSlug sets the "tenant_slug" field.
+func (_c *TenantTombstoneCreate) SetTenantSlug(v string) *TenantTombstoneCreate {
+	_c.mutation.SetTenantSlug(v)
+	return _c
+}
+
+// SetTenantName sets the "tenant_name" field.
+func (_c *TenantTombstoneCreate) SetTenantName(v string) *TenantTombstoneCreate {
+	_c.mutation.SetTenantName(v)
+	return _c
+}
+
+// SetActor sets the "actor" field.
+func (_c *TenantTombstoneCreate) SetActor(v string) *TenantTombstoneCreate {
+	_c.mutation.SetActor(v)
+	return _c
+}
+
+// SetNillableActor sets the "actor" field if the given value is not nil.
+func (_c *TenantTombstoneCreate) SetNillableActor(v *string) *TenantTombstoneCreate {
+	if v != nil {
+		_c.SetActor(*v)
+	}
+	return _c
+}
+
+// SetPurgedAt sets the "purged_at" field.
+func (_c *TenantTombstoneCreate) SetPurgedAt(v time.Time) *TenantTombstoneCreate {
+	_c.mutation.SetPurgedAt(v)
+	return _c
+}
+
+// SetNillablePurgedAt sets the "purged_at" field if the given value is not nil.
+func (_c *TenantTombstoneCreate) SetNillablePurgedAt(v *time.Time) *TenantTombstoneCreate {
+	if v != nil {
+		_c.SetPurgedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedRows sets the "deleted_rows" field.
+func (_c *TenantTombstoneCreate) SetDeletedRows(v map[string]int) *TenantTombstoneCreate {
+	_c.mutation.SetDeletedRows(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TenantTombstoneCreate) SetID(v uuid.UUID) *TenantTombstoneCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *TenantTombstoneCreate) SetNillableID(v *uuid.UUID) *TenantTombstoneCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the TenantTombstoneMutation object of the builder.
+func (_c *TenantTombstoneCreate) Mutation() *TenantTombstoneMutation {
+	return _c.mutation
+}
+
+// Save creates the TenantTombstone in the database.
+func (_c *TenantTombstoneCreate) Save(ctx context.Context) (*TenantTombstone, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TenantTombstoneCreate) SaveX(ctx context.Context) *TenantTombstone {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TenantTombstoneCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TenantTombstoneCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TenantTombstoneCreate) defaults() {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		v := tenanttombstone.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		v := tenanttombstone.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.Actor(); !ok {
+		v := tenanttombstone.DefaultActor
+		_c.mutation.SetActor(v)
+	}
+	if _, ok := _c.mutation.PurgedAt(); !ok {
+		v := tenanttombstone.DefaultPurgedAt()
+		_c.mutation.SetPurgedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := tenanttombstone.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TenantTombstoneCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "TenantTombstone.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "TenantTombstone.update_time"`)}
+	}
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "TenantTombstone.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.TenantSlug(); !ok {
+		return &ValidationError{Name: "tenant_slug", err: errors.New(`ent: missing required field "TenantTombstone.tenant_slug"`)}
+	}
+	if _, ok := _c.mutation.TenantName(); !ok {
+		return &ValidationError{Name: "tenant_name", err: errors.New(`ent: missing required field "TenantTombstone.tenant_name"`)}
+	}
+	if _, ok := _c.mutation.Actor(); !ok {
+		return &ValidationError{Name: "actor", err: errors.New(`ent: missing required field "TenantTombstone.actor"`)}
+	}
+	if _, ok := _c.mutation.PurgedAt(); !ok {
+		return &ValidationError{Name: "purged_at", err: errors.New(`ent: missing required field "TenantTombstone.purged_at"`)}
+	}
+	return nil
+}
+
+func (_c *TenantTombstoneCreate) sqlSave(ctx context.Context) (*TenantTombstone, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TenantTombstoneCreate) createSpec() (*TenantTombstone, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TenantTombstone{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(tenanttombstone.Table, sqlgraph.NewFieldSpec(tenanttombstone.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(tenanttombstone.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(tenanttombstone.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(tenanttombstone.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.TenantSlug(); ok {
+		_spec.SetField(tenanttombstone.FieldTenantSlug, field.TypeString, value)
+		_node.TenantSlug = value
+	}
+	if value, ok := _c.mutation.TenantName(); ok {
+		_spec.SetField(tenanttombstone.FieldTenantName, field.TypeString, value)
+		_node.TenantName = value
+	}
+	if value, ok := _c.mutation.Actor(); ok {
+		_spec.SetField(tenanttombstone.FieldActor, field.TypeString, value)
+		_node.Actor = value
+	}
+	if value, ok := _c.mutation.PurgedAt(); ok {
+		_spec.SetField(tenanttombstone.FieldPurgedAt, field.TypeTime, value)
+		_node.PurgedAt = value
+	}
+	if value, ok := _c.mutation.DeletedRows(); ok {
+		_spec.SetField(tenanttombstone.FieldDeletedRows, field.TypeJSON, value)
+		_node.DeletedRows = value
+	}
+	return _node, _spec
+}
+
+// TenantTombstoneCreateBulk is the builder for creating many TenantTombstone entities in bulk.
+type TenantTombstoneCreateBulk struct {
+	config
+	err      error
+	builders []*TenantTombstoneCreate
+}
+
+// Save creates the TenantTombstone entities in the database.
+func (_c *TenantTombstoneCreateBulk) Save(ctx context.Context) ([]*TenantTombstone, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TenantTombstone, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TenantTombstoneMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TenantTombstoneCreateBulk) SaveX(ctx context.Context) []*TenantTombstone {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TenantTombstoneCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TenantTombstoneCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
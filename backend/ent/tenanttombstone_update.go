@@ -0,0 +1,218 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mcmx/duplynx/ent/predicate"
+	"github.com/mcmx/duplynx/ent/tenanttombstone"
+)
+
+// TenantTombstoneUpdate is the builder for updating TenantTombstone entities.
+type TenantTombstoneUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TenantTombstoneMutation
+}
+
+// Where appends a list predicates to the TenantTombstoneUpdate builder.
+func (_u *TenantTombstoneUpdate) Where(ps ...predicate.TenantTombstone) *TenantTombstoneUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *TenantTombstoneUpdate) SetUpdateTime(v time.Time) *TenantTombstoneUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// Mutation returns the TenantTombstoneMutation object of the builder.
+func (_u *TenantTombstoneUpdate) Mutation() *TenantTombstoneMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TenantTombstoneUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TenantTombstoneUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TenantTombstoneUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TenantTombstoneUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TenantTombstoneUpdate) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := tenanttombstone.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+func (_u *TenantTombstoneUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(tenanttombstone.Table, tenanttombstone.Columns, sqlgraph.NewFieldSpec(tenanttombstone.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(tenanttombstone.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedRowsCleared() {
+		_spec.ClearField(tenanttombstone.FieldDeletedRows, field.TypeJSON)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tenanttombstone.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TenantTombstoneUpdateOne is the builder for updating a single TenantTombstone entity.
+type TenantTombstoneUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TenantTombstoneMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *TenantTombstoneUpdateOne) SetUpdateTime(v time.Time) *TenantTombstoneUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// Mutation returns the TenantTombstoneMutation object of the builder.
+func (_u *TenantTombstoneUpdateOne) Mutation() *TenantTombstoneMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TenantTombstoneUpdate builder.
+func (_u *TenantTombstoneUpdateOne) Where(ps ...predicate.TenantTombstone) *TenantTombstoneUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TenantTombstoneUpdateOne) Select(field string, fields ...string) *TenantTombstoneUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TenantTombstone entity.
+func (_u *TenantTombstoneUpdateOne) Save(ctx context.Context) (*TenantTombstone, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TenantTombstoneUpdateOne) SaveX(ctx context.Context) *TenantTombstone {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TenantTombstoneUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TenantTombstoneUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TenantTombstoneUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := tenanttombstone.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+func (_u *TenantTombstoneUpdateOne) sqlSave(ctx context.Context) (_node *TenantTombstone, err error) {
+	_spec := sqlgraph.NewUpdateSpec(tenanttombstone.Table, tenanttombstone.Columns, sqlgraph.NewFieldSpec(tenanttombstone.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TenantTombstone.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tenanttombstone.FieldID)
+		for _, f := range fields {
+			if !tenanttombstone.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tenanttombstone.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(tenanttombstone.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedRowsCleared() {
+		_spec.ClearField(tenanttombstone.FieldDeletedRows, field.TypeJSON)
+	}
+	_node = &TenantTombstone{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tenanttombstone.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -4,6 +4,8 @@ package ent
 
 import (
 	"context"
+	stdsql "database/sql"
+	"fmt"
 	"sync"
 
 	"entgo.io/ent/dialect"
@@ -14,6 +16,12 @@ type Tx struct {
 	config
 	// ActionAudit is the client for interacting with the ActionAudit builders.
 	ActionAudit *ActionAuditClient
+	// ActionJob is the client for interacting with the ActionJob builders.
+	ActionJob *ActionJobClient
+	// ContentEvent is the client for interacting with the ContentEvent builders.
+	ContentEvent *ContentEventClient
+	// ContentIdentity is the client for interacting with the ContentIdentity builders.
+	ContentIdentity *ContentIdentityClient
 	// DuplicateGroup is the client for interacting with the DuplicateGroup builders.
 	DuplicateGroup *DuplicateGroupClient
 	// FileInstance is the client for interacting with the FileInstance builders.
@@ -22,8 +30,18 @@ type Tx struct {
 	Machine *MachineClient
 	// Scan is the client for interacting with the Scan builders.
 	Scan *ScanClient
+	// ScanSchedule is the client for interacting with the ScanSchedule builders.
+	ScanSchedule *ScanScheduleClient
+	// ScanScheduleRun is the client for interacting with the ScanScheduleRun builders.
+	ScanScheduleRun *ScanScheduleRunClient
+	// ScanTarget is the client for interacting with the ScanTarget builders.
+	ScanTarget *ScanTargetClient
 	// Tenant is the client for interacting with the Tenant builders.
 	Tenant *TenantClient
+	// TenantSecret is the client for interacting with the TenantSecret builders.
+	TenantSecret *TenantSecretClient
+	// TenantTombstone is the client for interacting with the TenantTombstone builders.
+	TenantTombstone *TenantTombstoneClient
 
 	// lazily loaded.
 	client     *Client
@@ -156,11 +174,19 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.ActionAudit = NewActionAuditClient(tx.config)
+	tx.ActionJob = NewActionJobClient(tx.config)
+	tx.ContentEvent = NewContentEventClient(tx.config)
+	tx.ContentIdentity = NewContentIdentityClient(tx.config)
 	tx.DuplicateGroup = NewDuplicateGroupClient(tx.config)
 	tx.FileInstance = NewFileInstanceClient(tx.config)
 	tx.Machine = NewMachineClient(tx.config)
 	tx.Scan = NewScanClient(tx.config)
+	tx.ScanSchedule = NewScanScheduleClient(tx.config)
+	tx.ScanScheduleRun = NewScanScheduleRunClient(tx.config)
+	tx.ScanTarget = NewScanTargetClient(tx.config)
 	tx.Tenant = NewTenantClient(tx.config)
+	tx.TenantSecret = NewTenantSecretClient(tx.config)
+	tx.TenantTombstone = NewTenantTombstoneClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -223,3 +249,27 @@ func (tx *txDriver) Query(ctx context.Context, query string, args, v any) error
 }
 
 var _ dialect.Driver = (*txDriver)(nil)
+
+// ExecContext allows calling the underlying ExecContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.ExecContext for more information.
+func (tx *txDriver) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := tx.tx.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.QueryContext for more information.
+func (tx *txDriver) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := tx.tx.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
@@ -0,0 +1,416 @@
+package actions
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+
+	"github.com/mcmx/duplynx/ent"
+	entduplicategroup "github.com/mcmx/duplynx/ent/duplicategroup"
+	entfileinstance "github.com/mcmx/duplynx/ent/fileinstance"
+	"github.com/mcmx/duplynx/ent/predicate"
+)
+
+var (
+	ErrInvalidCursor     = errors.New("invalid page cursor")
+	ErrInvalidBoardQuery = errors.New("invalid board query")
+)
+
+// SortKey orders the duplicate groups within a board lane.
+type SortKey string
+
+const (
+	// SortReclaimable orders by the bytes freed by keeping a single copy.
+	SortReclaimable SortKey = "reclaimable"
+	SortFileCount   SortKey = "files"
+	SortHash        SortKey = "hash"
+)
+
+const (
+	// DefaultLaneLimit is the number of groups shown per lane when no limit is requested.
+	DefaultLaneLimit = 50
+	// MaxLaneLimit caps the lane page size.
+	MaxLaneLimit = 200
+)
+
+// BoardFilter narrows the duplicate groups shown on a scan board. Zero values
+// leave the corresponding dimension unfiltered.
+type BoardFilter struct {
+	// MinSizeBytes keeps groups whose files are at least this large.
+	MinSizeBytes int64
+	// MachineID keeps groups with a copy on the machine.
+	MachineID string
+	// PathPrefix keeps groups with a copy under the path.
+	PathPrefix string
+	// KeeperSet keeps groups with (true) or without (false) a keeper.
+	KeeperSet *bool
+	// Quarantined keeps groups with (true) or without (false) a quarantined copy.
+	Quarantined *bool
+}
+
+// BoardQuery describes one view of a scan board: its filters, sort order,
+// page size and the cursor of each lane. It round-trips through URL query
+// parameters so board views can be shared.
+type BoardQuery struct {
+	Filter     BoardFilter
+	Sort       SortKey
+	Descending bool
+	Limit      int
+	// Cursors maps a lane status to the cursor of the page to show.
+	Cursors map[string]string
+}
+
+// DefaultBoardQuery shows the largest savings first.
+func DefaultBoardQuery() BoardQuery {
+	return BoardQuery{Sort: SortReclaimable, Descending: true, Limit: DefaultLaneLimit}
+}
+
+// ParseBoardQuery reads a board view from URL query parameters.
+func ParseBoardQuery(values url.Values) (BoardQuery, error) {
+	q := DefaultBoardQuery()
+	invalid := func(param string) (BoardQuery, error) {
+		return BoardQuery{}, fmt.Errorf("%w: %s=%q", ErrInvalidBoardQuery, param, values.Get(param))
+	}
+
+	if raw := values.Get("min_size"); raw != "" {
+		size, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil || size < 0 {
+			return invalid("min_size")
+		}
+		q.Filter.MinSizeBytes = size
+	}
+	if raw := values.Get("machine"); raw != "" {
+		if _, err := uuid.Parse(raw); err != nil {
+			return invalid("machine")
+		}
+		q.Filter.MachineID = raw
+	}
+	q.Filter.PathPrefix = values.Get("path_prefix")
+	switch values.Get("keeper") {
+	case "":
+	case "set":
+		q.Filter.KeeperSet = boolPtr(true)
+	case "unset":
+		q.Filter.KeeperSet = boolPtr(false)
+	default:
+		return invalid("keeper")
+	}
+	switch values.Get("quarantined") {
+	case "":
+	case "true":
+		q.Filter.Quarantined = boolPtr(true)
+	case "false":
+		q.Filter.Quarantined = boolPtr(false)
+	default:
+		return invalid("quarantined")
+	}
+
+	if raw := values.Get("sort"); raw != "" {
+		switch SortKey(raw) {
+		case SortReclaimable, SortFileCount:
+			q.Sort, q.Descending = SortKey(raw), true
+		case SortHash:
+			q.Sort, q.Descending = SortHash, false
+		default:
+			return invalid("sort")
+		}
+	}
+	switch values.Get("order") {
+	case "":
+	case "asc":
+		q.Descending = false
+	case "desc":
+		q.Descending = true
+	default:
+		return invalid("order")
+	}
+	if raw := values.Get("limit"); raw != "" {
+		limit, err := strconv.Atoi(raw)
+		if err != nil || limit < 1 {
+			return invalid("limit")
+		}
+		q.Limit = min(limit, MaxLaneLimit)
+	}
+
+	for key, vals := range values {
+		status, ok := strings.CutPrefix(key, "cursor_")
+		if !ok || len(vals) == 0 || vals[0] == "" {
+			continue
+		}
+		if q.Cursors == nil {
+			q.Cursors = make(map[string]string)
+		}
+		q.Cursors[status] = vals[0]
+	}
+	return q, nil
+}
+
+// Values encodes the view as URL query parameters, omitting defaults.
+func (q BoardQuery) Values() url.Values {
+	values := url.Values{}
+	if q.Filter.MinSizeBytes > 0 {
+		values.Set("min_size", strconv.FormatInt(q.Filter.MinSizeBytes, 10))
+	}
+	if q.Filter.MachineID != "" {
+		values.Set("machine", q.Filter.MachineID)
+	}
+	if q.Filter.PathPrefix != "" {
+		values.Set("path_prefix", q.Filter.PathPrefix)
+	}
+	if q.Filter.KeeperSet != nil {
+		if *q.Filter.KeeperSet {
+			values.Set("keeper", "set")
+		} else {
+			values.Set("keeper", "unset")
+		}
+	}
+	if q.Filter.Quarantined != nil {
+		values.Set("quarantined", strconv.FormatBool(*q.Filter.Quarantined))
+	}
+	sort := q.Sort
+	if sort == "" {
+		sort = SortReclaimable
+	}
+	if sort != SortReclaimable {
+		values.Set("sort", string(sort))
+	}
+	if q.Descending != (sort != SortHash) {
+		if q.Descending {
+			values.Set("order", "desc")
+		} else {
+			values.Set("order", "asc")
+		}
+	}
+	if q.Limit > 0 && q.Limit != DefaultLaneLimit {
+		values.Set("limit", strconv.Itoa(q.Limit))
+	}
+	for status, cursor := range q.Cursors {
+		if cursor != "" {
+			values.Set("cursor_"+status, cursor)
+		}
+	}
+	return values
+}
+
+// WithCursor returns a copy of the view showing the lane's page at cursor;
+// an empty cursor returns the lane to its first page.
+func (q BoardQuery) WithCursor(status, cursor string) BoardQuery {
+	cursors := make(map[string]string, len(q.Cursors)+1)
+	for key, value := range q.Cursors {
+		cursors[key] = value
+	}
+	if cursor == "" {
+		delete(cursors, status)
+	} else {
+		cursors[status] = cursor
+	}
+	q.Cursors = cursors
+	return q
+}
+
+// LanePage is one page of a board lane.
+type LanePage struct {
+	Status string
+	Groups []DuplicateGroup
+	// Total counts the lane's groups matching the filter across all pages.
+	Total int
+	// NextCursor fetches the following page; it is empty on the last page.
+	NextCursor string
+}
+
+// ListLane returns one page of a scan's duplicate groups with the given status.
+func (r *Repository) ListLane(ctx context.Context, scanID uuid.UUID, status string, q BoardQuery) (LanePage, error) {
+	if r == nil || r.client == nil {
+		return LanePage{}, errors.New("actions repository not configured")
+	}
+	limit := q.Limit
+	if limit <= 0 {
+		limit = DefaultLaneLimit
+	}
+	limit = min(limit, MaxLaneLimit)
+	sortKey := q.Sort
+	if sortKey == "" {
+		sortKey = SortReclaimable
+	}
+
+	preds := []predicate.DuplicateGroup{
+		entduplicategroup.ScanID(scanID),
+		entduplicategroup.StatusEQ(entduplicategroup.Status(status)),
+	}
+	filterPreds, err := q.Filter.predicates()
+	if err != nil {
+		return LanePage{}, err
+	}
+	preds = append(preds, filterPreds...)
+
+	total, err := r.client.DuplicateGroup.Query().Where(preds...).Count(ctx)
+	if err != nil {
+		return LanePage{}, fmt.Errorf("count duplicate groups: %w", err)
+	}
+
+	query := r.client.DuplicateGroup.
+		Query().
+		Where(preds...).
+		WithTenant().
+		WithKeeperMachine().
+		WithFileInstances(func(q *ent.FileInstanceQuery) {
+			q.WithMachine().Order(entfileinstance.ByPath())
+		}).
+		Order(func(s *sql.Selector) {
+			direction := " ASC"
+			if q.Descending {
+				direction = " DESC"
+			}
+			s.OrderExpr(sql.Expr(sortExpr(s, sortKey) + direction))
+			s.OrderBy(sql.Asc(s.C(entduplicategroup.FieldID)))
+		}).
+		Limit(limit + 1)
+	if raw := q.Cursors[status]; raw != "" {
+		key, id, err := decodeCursor(raw)
+		if err != nil {
+			return LanePage{}, err
+		}
+		arg, err := cursorArg(sortKey, key)
+		if err != nil {
+			return LanePage{}, err
+		}
+		query.Where(func(s *sql.Selector) {
+			expr, cmp := sortExpr(s, sortKey), ">"
+			if q.Descending {
+				cmp = "<"
+			}
+			// Builder arguments get the dialect's placeholders ($n on Postgres); ExprP keeps "?".
+			s.Where(sql.P(func(b *sql.Builder) {
+				b.WriteString("((" + expr + " " + cmp + " ").Arg(arg).
+					WriteString(") OR (" + expr + " = ").Arg(arg).
+					WriteString(" AND " + s.C(entduplicategroup.FieldID) + " > ").Arg(id).
+					WriteString("))")
+			}))
+		})
+	}
+
+	records, err := query.All(ctx)
+	if err != nil {
+		return LanePage{}, fmt.Errorf("list duplicate groups: %w", err)
+	}
+	page := LanePage{Status: status, Total: total}
+	if len(records) > limit {
+		records = records[:limit]
+		last := records[len(records)-1]
+		page.NextCursor = encodeCursor(cursorKey(sortKey, last), last.ID)
+	}
+	page.Groups = make([]DuplicateGroup, 0, len(records))
+	for _, record := range records {
+		page.Groups = append(page.Groups, convertDuplicateGroup(record))
+	}
+	return page, nil
+}
+
+// ReclaimableBytes is the space freed by keeping a single copy of the group.
+func ReclaimableBytes(totalSizeBytes int64, fileCount int) int64 {
+	if fileCount <= 1 {
+		return 0
+	}
+	return totalSizeBytes - totalSizeBytes/int64(fileCount)
+}
+
+func (f BoardFilter) predicates() ([]predicate.DuplicateGroup, error) {
+	var preds []predicate.DuplicateGroup
+	if f.MinSizeBytes > 0 {
+		preds = append(preds, entduplicategroup.HasFileInstancesWith(entfileinstance.SizeBytesGTE(f.MinSizeBytes)))
+	}
+	if f.MachineID != "" {
+		id, err := uuid.Parse(f.MachineID)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidMachineID, err)
+		}
+		preds = append(preds, entduplicategroup.HasFileInstancesWith(entfileinstance.MachineIDEQ(id)))
+	}
+	if f.PathPrefix != "" {
+		preds = append(preds, entduplicategroup.HasFileInstancesWith(entfileinstance.PathHasPrefix(f.PathPrefix)))
+	}
+	if f.KeeperSet != nil {
+		if *f.KeeperSet {
+			preds = append(preds, entduplicategroup.KeeperMachineIDNotNil())
+		} else {
+			preds = append(preds, entduplicategroup.KeeperMachineIDIsNil())
+		}
+	}
+	if f.Quarantined != nil {
+		quarantined := entduplicategroup.HasFileInstancesWith(entfileinstance.QuarantinedEQ(true))
+		if *f.Quarantined {
+			preds = append(preds, quarantined)
+		} else {
+			preds = append(preds, entduplicategroup.Not(quarantined))
+		}
+	}
+	return preds, nil
+}
+
+// sortExpr is the SQL expression ordering a lane; reclaimable bytes mirror ReclaimableBytes.
+func sortExpr(s *sql.Selector, key SortKey) string {
+	switch key {
+	case SortFileCount:
+		return s.C(entduplicategroup.FieldFileCount)
+	case SortHash:
+		return s.C(entduplicategroup.FieldHash)
+	default:
+		total, count := s.C(entduplicategroup.FieldTotalSizeBytes), s.C(entduplicategroup.FieldFileCount)
+		return fmt.Sprintf("(%s - %s / %s)", total, total, count)
+	}
+}
+
+func cursorKey(key SortKey, record *ent.DuplicateGroup) string {
+	switch key {
+	case SortFileCount:
+		return strconv.Itoa(record.FileCount)
+	case SortHash:
+		return record.Hash
+	default:
+		return strconv.FormatInt(ReclaimableBytes(record.TotalSizeBytes, record.FileCount), 10)
+	}
+}
+
+func cursorArg(key SortKey, raw string) (any, error) {
+	if key == SortHash {
+		return raw, nil
+	}
+	n, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+	}
+	return n, nil
+}
+
+// Cursors are opaque to clients: base64url of "<sort key value>|<group id>".
+func encodeCursor(key string, id uuid.UUID) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(key + "|" + id.String()))
+}
+
+func decodeCursor(raw string) (string, uuid.UUID, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return "", uuid.Nil, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+	}
+	text := string(decoded)
+	sep := strings.LastIndex(text, "|")
+	if sep < 0 {
+		return "", uuid.Nil, ErrInvalidCursor
+	}
+	id, err := uuid.Parse(text[sep+1:])
+	if err != nil {
+		return "", uuid.Nil, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+	}
+	return text[:sep], id, nil
+}
+
+func boolPtr(v bool) *bool {
+	return &v
+}
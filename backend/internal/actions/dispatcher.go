@@ -6,6 +6,8 @@ import (
 	"fmt"
 
 	"github.com/google/uuid"
+
+	"github.com/mcmx/duplynx/internal/events"
 )
 
 var (
@@ -13,12 +15,25 @@ var (
 	ErrKeeperMachineID  = errors.New("keeper machine id required")
 	ErrInvalidGroupID   = errors.New("invalid duplicate group id")
 	ErrInvalidMachineID = errors.New("invalid machine id")
+	ErrInvalidAction    = errors.New("unsupported action type")
 )
 
+// ActionLimiter reserves a tenant's concurrent action slot; release frees it again.
+type ActionLimiter interface {
+	AcquireAction(ctx context.Context, tenantSlug string) (release func(), err error)
+}
+
 // Dispatcher coordinates keeper assignments and duplicate actions.
 type Dispatcher struct {
 	Repo  *Repository
 	Audit *AuditLogger
+	// Quotas caps how many actions a tenant may run at once; nil disables the limit.
+	Quotas ActionLimiter
+	// Events is notified after each change so live boards can refresh; nil disables it.
+	Events events.Publisher
+	// RunOnAgents queues actions as jobs for the agents on the machines holding
+	// the copies; when false, actions are only recorded as stubbed.
+	RunOnAgents bool
 }
 
 // NewDispatcher constructs a dispatcher backed by the supplied repository and audit logger.
@@ -59,20 +74,31 @@ func (d *Dispatcher) AssignKeeper(ctx context.Context, groupID, tenantSlug, mach
 	if group.TenantSlug != tenantSlug {
 		return ErrGroupNotFound
 	}
+	if !holdsCopy(group, mid.String()) {
+		return fmt.Errorf("%w: machine %s holds no copy in group %s", ErrInvalidMachineID, machineID, groupID)
+	}
 
 	if err := repo.UpdateKeeper(ctx, gid, mid); err != nil {
 		return err
 	}
 
-	if d.Audit != nil {
-		d.Audit.Log(AuditEntry{
-			Type:            "assign_keeper",
-			GroupID:         groupID,
-			TenantSlug:      tenantSlug,
-			KeeperMachineID: machineID,
-		})
+	return d.audit(ctx, group, AuditEntry{
+		Type:            "assign_keeper",
+		GroupID:         groupID,
+		TenantSlug:      tenantSlug,
+		KeeperMachineID: machineID,
+	})
+}
+
+// holdsCopy reports whether the machine holds one of the group's copies, the
+// only machines a keeper may be picked from.
+func holdsCopy(group DuplicateGroup, machineID string) bool {
+	for _, holder := range group.CopyHolders() {
+		if holder.ID == machineID {
+			return true
+		}
 	}
-	return nil
+	return false
 }
 
 // ActionType enumerates supported duplicate actions.
@@ -84,8 +110,22 @@ const (
 	ActionQuarantine ActionType = "quarantine"
 )
 
-// PerformAction executes the duplicate action within the tenant scope.
+// Valid reports whether the action type is one the dispatcher can perform.
+func (a ActionType) Valid() bool {
+	switch a {
+	case ActionDelete, ActionHardlink, ActionQuarantine:
+		return true
+	default:
+		return false
+	}
+}
+
+// PerformAction executes the duplicate action within the tenant scope. With
+// RunOnAgents set it queues the action for the agents holding the copies.
 func (d *Dispatcher) PerformAction(ctx context.Context, groupID, tenantSlug, actor string, action ActionType, payload map[string]any) error {
+	if !action.Valid() {
+		return fmt.Errorf("%w: %q", ErrInvalidAction, action)
+	}
 	repo, err := d.repository()
 	if err != nil {
 		return err
@@ -104,21 +144,57 @@ func (d *Dispatcher) PerformAction(ctx context.Context, groupID, tenantSlug, act
 		return ErrGroupNotFound
 	}
 
+	if d.Quotas != nil {
+		release, err := d.Quotas.AcquireAction(ctx, tenantSlug)
+		if err != nil {
+			return err
+		}
+		defer release()
+	}
+
+	if d.RunOnAgents {
+		return d.queueAction(ctx, group, actor, action, payload)
+	}
 	if action == ActionQuarantine {
 		if err := repo.QuarantineFiles(ctx, gid); err != nil {
 			return err
 		}
 	}
 
+	return d.audit(ctx, group, AuditEntry{
+		Type:       string(action),
+		GroupID:    groupID,
+		TenantSlug: tenantSlug,
+		Actor:      actor,
+		Payload:    payload,
+		Stubbed:    true,
+	})
+}
+
+// audit logs the entry, persists it to the group's timeline and announces the change.
+func (d *Dispatcher) audit(ctx context.Context, group DuplicateGroup, entry AuditEntry) error {
 	if d.Audit != nil {
-		d.Audit.Log(AuditEntry{
-			Type:       string(action),
-			GroupID:    groupID,
-			TenantSlug: tenantSlug,
-			Actor:      actor,
-			Payload:    payload,
-			Stubbed:    true,
-		})
+		d.Audit.Log(entry)
 	}
+	gid, err := uuid.Parse(group.ID)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidGroupID, err)
+	}
+	if _, err := d.Repo.RecordAudit(ctx, gid, entry); err != nil {
+		return err
+	}
+	d.publish(group)
 	return nil
 }
+
+// publish announces a change to the group.
+func (d *Dispatcher) publish(group DuplicateGroup) {
+	if d.Events != nil {
+		d.Events.Publish(events.Event{
+			Type:       events.TypeGroupChanged,
+			TenantSlug: group.TenantSlug,
+			ScanID:     group.ScanID,
+			GroupID:    group.ID,
+		})
+	}
+}
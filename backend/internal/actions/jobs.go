@@ -0,0 +1,397 @@
+package actions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/mcmx/duplynx/ent"
+	entactionjob "github.com/mcmx/duplynx/ent/actionjob"
+	entduplicategroup "github.com/mcmx/duplynx/ent/duplicategroup"
+	entfileinstance "github.com/mcmx/duplynx/ent/fileinstance"
+	entmachine "github.com/mcmx/duplynx/ent/machine"
+	enttenant "github.com/mcmx/duplynx/ent/tenant"
+	"github.com/mcmx/duplynx/internal/tenancy/isolation"
+)
+
+var (
+	// ErrNothingToRun is returned when no machine holds copies the action could change.
+	ErrNothingToRun = errors.New("no copies left for agents to act on")
+	// ErrMachineNotFound is returned when an agent polls for a machine outside its tenant.
+	ErrMachineNotFound = errors.New("machine not found")
+	// ErrJobNotFound is returned for reports on jobs the machine was not handed.
+	ErrJobNotFound = errors.New("action job not found")
+	// ErrJobFinished is returned for reports on jobs that already succeeded or failed.
+	ErrJobFinished = errors.New("action job already finished")
+)
+
+// Audit types recorded while agents run an action. The action's own type is
+// recorded once every machine's job succeeded.
+const (
+	AuditActionQueued = "action_queued"
+	AuditActionFailed = "action_failed"
+)
+
+// AgentJob is one machine's share of a duplicate action.
+type AgentJob struct {
+	ID string
+	// ActionID is shared by the jobs one request queued across machines.
+	ActionID  string
+	GroupID   string
+	MachineID string
+	Action    ActionType
+	Hash      string
+	// KeepPath is the copy on the machine that stays in place, if any.
+	KeepPath string
+	Paths    []string
+	Actor    string
+	Status   string
+	// Claimed is set when this poll claimed the job rather than handing it out again.
+	Claimed bool
+}
+
+// JobReport is an agent's outcome for an action job. Done lists the paths it
+// acted on; a failed job may still have acted on some of them.
+type JobReport struct {
+	Failed  bool
+	Message string
+	Done    []string
+}
+
+// planJobs splits the action into each holder machine's share of the copies,
+// skipping copies already quarantined. Deleting keeps one copy, on the keeper
+// machine when one is assigned; hardlinking keeps the first copy on each
+// machine and links the others to it; quarantine moves every copy.
+func planJobs(group DuplicateGroup, action ActionType) []AgentJob {
+	var files []DuplicateFile
+	for _, file := range group.Files {
+		if !file.Quarantined {
+			files = append(files, file)
+		}
+	}
+	var kept *DuplicateFile
+	if action == ActionDelete && len(files) > 0 {
+		kept = &files[0]
+		for i := range files {
+			if files[i].MachineID == group.KeeperMachineID {
+				kept = &files[i]
+				break
+			}
+		}
+	}
+
+	var jobs []AgentJob
+	byMachine := make(map[string]int)
+	for i := range files {
+		file := files[i]
+		index, ok := byMachine[file.MachineID]
+		if !ok {
+			index = len(jobs)
+			byMachine[file.MachineID] = index
+			jobs = append(jobs, AgentJob{GroupID: group.ID, MachineID: file.MachineID, Action: action, Hash: group.Hash})
+		}
+		job := &jobs[index]
+		switch {
+		case kept != nil && file.ID == kept.ID,
+			action == ActionHardlink && job.KeepPath == "":
+			job.KeepPath = file.Path
+		default:
+			job.Paths = append(job.Paths, file.Path)
+		}
+	}
+
+	out := jobs[:0]
+	for _, job := range jobs {
+		if len(job.Paths) > 0 {
+			out = append(out, job)
+		}
+	}
+	return out
+}
+
+// queueAction records one job per machine holding copies the action changes.
+// The action's audit entry is recorded once the agents report success.
+func (d *Dispatcher) queueAction(ctx context.Context, group DuplicateGroup, actor string, action ActionType, payload map[string]any) error {
+	jobs := planJobs(group, action)
+	if len(jobs) == 0 {
+		return fmt.Errorf("%w: group %s", ErrNothingToRun, group.ID)
+	}
+	actionID, err := d.Repo.QueueJobs(ctx, group, actor, jobs)
+	if err != nil {
+		return err
+	}
+	queued := make(map[string]any, len(payload)+3)
+	for key, value := range payload {
+		queued[key] = value
+	}
+	queued["action"] = string(action)
+	queued["actionId"] = actionID.String()
+	queued["jobs"] = len(jobs)
+	return d.audit(ctx, group, AuditEntry{
+		Type:       AuditActionQueued,
+		GroupID:    group.ID,
+		TenantSlug: group.TenantSlug,
+		Actor:      actor,
+		Payload:    queued,
+	})
+}
+
+// ClaimActions claims the machine's pending action jobs and hands out the ones
+// it claimed earlier but has not reported yet.
+func (d *Dispatcher) ClaimActions(ctx context.Context, tenantSlug string, machineID uuid.UUID) ([]AgentJob, error) {
+	repo, err := d.repository()
+	if err != nil {
+		return nil, err
+	}
+	ctx, err = repo.machineContext(ctx, tenantSlug, machineID)
+	if err != nil {
+		return nil, err
+	}
+	return repo.ClaimJobs(ctx, machineID)
+}
+
+// CompleteAction records an agent's report for one of its action jobs. A
+// failed job is audited straight away; the action itself is audited once the
+// jobs on every machine succeeded.
+func (d *Dispatcher) CompleteAction(ctx context.Context, tenantSlug string, jobID, machineID uuid.UUID, report JobReport) error {
+	repo, err := d.repository()
+	if err != nil {
+		return err
+	}
+	ctx, err = repo.machineContext(ctx, tenantSlug, machineID)
+	if err != nil {
+		return err
+	}
+	job, siblings, err := repo.FinishJob(ctx, jobID, machineID, report)
+	if err != nil {
+		return err
+	}
+	gid, err := uuid.Parse(job.GroupID)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidGroupID, err)
+	}
+	group, err := repo.Get(ctx, gid)
+	if err != nil {
+		return err
+	}
+
+	if report.Failed {
+		return d.audit(ctx, group, AuditEntry{
+			Type:       AuditActionFailed,
+			GroupID:    group.ID,
+			TenantSlug: group.TenantSlug,
+			Actor:      job.Actor,
+			Payload: map[string]any{
+				"action":    string(job.Action),
+				"actionId":  job.ActionID,
+				"machineId": job.MachineID,
+				"message":   report.Message,
+				"done":      report.Done,
+			},
+		})
+	}
+	done := 0
+	for _, sibling := range siblings {
+		if sibling.Status != entactionjob.StatusSucceeded.String() {
+			d.publish(group)
+			return nil
+		}
+		done += len(sibling.Paths)
+	}
+	return d.audit(ctx, group, AuditEntry{
+		Type:       string(job.Action),
+		GroupID:    group.ID,
+		TenantSlug: group.TenantSlug,
+		Actor:      job.Actor,
+		Payload: map[string]any{
+			"actionId": job.ActionID,
+			"jobs":     len(siblings),
+			"paths":    done,
+		},
+	})
+}
+
+// machineContext confines ctx to the tenant owning the machine.
+func (r *Repository) machineContext(ctx context.Context, tenantSlug string, machineID uuid.UUID) (context.Context, error) {
+	if r == nil || r.client == nil {
+		return nil, errors.New("actions repository not configured")
+	}
+	machine, err := r.client.Machine.Query().
+		Where(entmachine.IDEQ(machineID), entmachine.HasTenantWith(enttenant.SlugEQ(tenantSlug))).
+		Only(isolation.WithSystem(ctx))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrMachineNotFound
+		}
+		return nil, fmt.Errorf("load machine: %w", err)
+	}
+	return isolation.WithTenant(ctx, machine.TenantID), nil
+}
+
+// QueueJobs stores the planned jobs under a new action ID.
+func (r *Repository) QueueJobs(ctx context.Context, group DuplicateGroup, actor string, jobs []AgentJob) (uuid.UUID, error) {
+	if r == nil || r.client == nil {
+		return uuid.Nil, errors.New("actions repository not configured")
+	}
+	gid, err := uuid.Parse(group.ID)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%w: %v", ErrInvalidGroupID, err)
+	}
+	record, err := r.client.DuplicateGroup.Query().Where(entduplicategroup.IDEQ(gid)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return uuid.Nil, ErrGroupNotFound
+		}
+		return uuid.Nil, fmt.Errorf("load duplicate group: %w", err)
+	}
+	actionID := uuid.New()
+	builders := make([]*ent.ActionJobCreate, 0, len(jobs))
+	for _, job := range jobs {
+		mid, err := uuid.Parse(job.MachineID)
+		if err != nil {
+			return uuid.Nil, fmt.Errorf("%w: %v", ErrInvalidMachineID, err)
+		}
+		builder := r.client.ActionJob.Create().
+			SetTenantID(record.TenantID).
+			SetActionID(actionID).
+			SetDuplicateGroupID(gid).
+			SetMachineID(mid).
+			SetAction(entactionjob.Action(job.Action)).
+			SetHash(job.Hash).
+			SetPaths(job.Paths)
+		if job.KeepPath != "" {
+			builder.SetKeepPath(job.KeepPath)
+		}
+		if actor != "" {
+			builder.SetActor(actor)
+		}
+		builders = append(builders, builder)
+	}
+	if err := r.client.ActionJob.CreateBulk(builders...).Exec(ctx); err != nil {
+		return uuid.Nil, fmt.Errorf("queue action jobs: %w", err)
+	}
+	return actionID, nil
+}
+
+// ClaimJobs marks the machine's pending jobs claimed and returns them with the
+// jobs it claimed earlier, oldest first.
+func (r *Repository) ClaimJobs(ctx context.Context, machineID uuid.UUID) ([]AgentJob, error) {
+	if r == nil || r.client == nil {
+		return nil, errors.New("actions repository not configured")
+	}
+	records, err := r.client.ActionJob.Query().
+		Where(
+			entactionjob.MachineID(machineID),
+			entactionjob.StatusIn(entactionjob.StatusPending, entactionjob.StatusClaimed),
+		).
+		Order(entactionjob.ByCreateTime(), entactionjob.ByID()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list action jobs: %w", err)
+	}
+	now := time.Now().UTC()
+	out := make([]AgentJob, 0, len(records))
+	for _, record := range records {
+		job := convertJob(record)
+		if record.Status == entactionjob.StatusPending {
+			if err := r.client.ActionJob.UpdateOne(record).
+				SetStatus(entactionjob.StatusClaimed).
+				SetClaimedAt(now).
+				Exec(ctx); err != nil {
+				return nil, fmt.Errorf("claim action job: %w", err)
+			}
+			job.Status, job.Claimed = entactionjob.StatusClaimed.String(), true
+		}
+		out = append(out, job)
+	}
+	return out, nil
+}
+
+// FinishJob settles the machine's job with the agent's report, marks the
+// copies a quarantine moved, and returns the job with every job of its action.
+func (r *Repository) FinishJob(ctx context.Context, jobID, machineID uuid.UUID, report JobReport) (AgentJob, []AgentJob, error) {
+	if r == nil || r.client == nil {
+		return AgentJob{}, nil, errors.New("actions repository not configured")
+	}
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return AgentJob{}, nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	record, err := tx.ActionJob.Query().
+		Where(entactionjob.IDEQ(jobID), entactionjob.MachineID(machineID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return AgentJob{}, nil, ErrJobNotFound
+		}
+		return AgentJob{}, nil, fmt.Errorf("load action job: %w", err)
+	}
+	if record.Status == entactionjob.StatusSucceeded || record.Status == entactionjob.StatusFailed {
+		return AgentJob{}, nil, ErrJobFinished
+	}
+	status := entactionjob.StatusSucceeded
+	if report.Failed {
+		status = entactionjob.StatusFailed
+	}
+	update := tx.ActionJob.UpdateOne(record).
+		SetStatus(status).
+		SetFinishedAt(time.Now().UTC())
+	if report.Message != "" {
+		update.SetStatusMessage(report.Message)
+	}
+	if len(report.Done) > 0 {
+		update.SetDonePaths(report.Done)
+	}
+	if record, err = update.Save(ctx); err != nil {
+		return AgentJob{}, nil, fmt.Errorf("finish action job: %w", err)
+	}
+	if record.Action == entactionjob.ActionQuarantine && len(report.Done) > 0 {
+		if _, err := tx.FileInstance.Update().
+			Where(
+				entfileinstance.DuplicateGroupID(record.DuplicateGroupID),
+				entfileinstance.MachineID(machineID),
+				entfileinstance.PathIn(report.Done...),
+			).
+			SetQuarantined(true).
+			Save(ctx); err != nil {
+			return AgentJob{}, nil, fmt.Errorf("quarantine duplicate files: %w", err)
+		}
+	}
+	siblings, err := tx.ActionJob.Query().
+		Where(entactionjob.ActionID(record.ActionID)).
+		Order(entactionjob.ByCreateTime(), entactionjob.ByID()).
+		All(ctx)
+	if err != nil {
+		return AgentJob{}, nil, fmt.Errorf("list action jobs: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return AgentJob{}, nil, fmt.Errorf("commit transaction: %w", err)
+	}
+	out := make([]AgentJob, 0, len(siblings))
+	for _, sibling := range siblings {
+		out = append(out, convertJob(sibling))
+	}
+	return convertJob(record), out, nil
+}
+
+func convertJob(record *ent.ActionJob) AgentJob {
+	return AgentJob{
+		ID:        record.ID.String(),
+		ActionID:  record.ActionID.String(),
+		GroupID:   record.DuplicateGroupID.String(),
+		MachineID: record.MachineID.String(),
+		Action:    ActionType(record.Action),
+		Hash:      record.Hash,
+		KeepPath:  record.KeepPath,
+		Paths:     record.Paths,
+		Actor:     record.Actor,
+		Status:    record.Status.String(),
+	}
+}
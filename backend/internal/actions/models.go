@@ -1,5 +1,10 @@
 package actions
 
+import (
+	"strings"
+	"time"
+)
+
 // DuplicateGroup represents a collection of duplicate files detected by a scan.
 type DuplicateGroup struct {
 	ID              string
@@ -7,15 +12,88 @@ type DuplicateGroup struct {
 	TenantSlug      string
 	Status          string
 	KeeperMachineID string
-	Hash            string
-	Files           []DuplicateFile
+	// KeeperMachineName is the display name of the keeper machine, if assigned.
+	KeeperMachineName string
+	Hash              string
+	Files             []DuplicateFile
+	// FilesPrunedAt is set when retention dropped the scan's file instances.
+	FilesPrunedAt time.Time
+}
+
+// CopyHolders lists each machine holding a copy of the duplicate, in file order.
+func (g DuplicateGroup) CopyHolders() []DuplicateMachine {
+	seen := make(map[string]bool, len(g.Files))
+	out := make([]DuplicateMachine, 0, len(g.Files))
+	for _, file := range g.Files {
+		if seen[file.MachineID] {
+			continue
+		}
+		seen[file.MachineID] = true
+		out = append(out, DuplicateMachine{
+			ID:       file.MachineID,
+			Name:     file.MachineName,
+			Category: file.MachineCategory,
+			Hostname: file.MachineHostname,
+		})
+	}
+	return out
+}
+
+// KeeperLabel returns the keeper's display name, falling back to its ID.
+func (g DuplicateGroup) KeeperLabel() string {
+	if g.KeeperMachineName != "" {
+		return g.KeeperMachineName
+	}
+	return g.KeeperMachineID
 }
 
 // DuplicateFile describes an instance of the duplicate within a machine.
 type DuplicateFile struct {
+	ID              string
+	MachineID       string
+	MachineName     string
+	MachineCategory string
+	MachineHostname string
+	Path            string
+	SizeBytes       int64
+	LastSeenAt      time.Time
+	Quarantined     bool
+}
+
+// DuplicateMachine describes a machine that holds a copy of a duplicate.
+type DuplicateMachine struct {
+	ID       string
+	Name     string
+	Category string
+	Hostname string
+}
+
+// Label renders the machine for pickers, e.g. "Helios-Server-02 (server · helios.local)".
+func (m DuplicateMachine) Label() string {
+	name := m.Name
+	if name == "" {
+		name = m.ID
+	}
+	var details []string
+	if m.Category != "" {
+		details = append(details, m.Category)
+	}
+	if m.Hostname != "" {
+		details = append(details, m.Hostname)
+	}
+	if len(details) == 0 {
+		return name
+	}
+	return name + " (" + strings.Join(details, " · ") + ")"
+}
+
+// TimelineEntry is a persisted action audit shown on the duplicate group page.
+type TimelineEntry struct {
 	ID          string
-	MachineID   string
-	Path        string
-	SizeBytes   int64
-	Quarantined bool
+	GroupID     string
+	Type        string
+	Actor       string
+	PerformedAt time.Time
+	Payload     map[string]any
+	Stubbed     bool
 }
@@ -0,0 +1,210 @@
+package actions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/mcmx/duplynx/ent"
+	entactionaudit "github.com/mcmx/duplynx/ent/actionaudit"
+)
+
+var (
+	ErrNoteNotFound     = errors.New("note not found")
+	ErrNoteBodyRequired = errors.New("note body required")
+	ErrNoteTooLong      = errors.New("note too long")
+)
+
+// ActionNote is the audit type recorded for steward notes. Notes are not
+// dispatched as actions; each create or edit appends one audit row.
+const ActionNote ActionType = "note"
+
+// MaxNoteLength bounds the size of a single note revision, in bytes.
+const MaxNoteLength = 4000
+
+// Note is a free-text steward note on a duplicate group, folded from its
+// audit revisions.
+type Note struct {
+	ID        string
+	GroupID   string
+	Body      string
+	Author    string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	// Revisions lists every version of the note, oldest first.
+	Revisions []NoteRevision
+}
+
+// Edited reports whether the note was changed after it was written.
+func (n Note) Edited() bool {
+	return len(n.Revisions) > 1
+}
+
+// NoteRevision is one saved version of a note.
+type NoteRevision struct {
+	AuditID string
+	Body    string
+	Actor   string
+	At      time.Time
+}
+
+// Notes returns the group's notes, oldest first.
+func (r *Repository) Notes(ctx context.Context, id uuid.UUID) ([]Note, error) {
+	if r == nil || r.client == nil {
+		return nil, errors.New("actions repository not configured")
+	}
+	records, err := r.noteQuery().Where(entactionaudit.DuplicateGroupIDEQ(id)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list notes: %w", err)
+	}
+	return foldNotes(records), nil
+}
+
+// SearchNotes returns the notes in scope whose current text contains query,
+// ignoring case, most recently updated first.
+func (r *Repository) SearchNotes(ctx context.Context, query string) ([]Note, error) {
+	if r == nil || r.client == nil {
+		return nil, errors.New("actions repository not configured")
+	}
+	query = strings.ToLower(strings.TrimSpace(query))
+	records, err := r.noteQuery().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("search notes: %w", err)
+	}
+	notes := foldNotes(records)
+	out := notes[:0]
+	for _, note := range notes {
+		if query == "" || strings.Contains(strings.ToLower(note.Body), query) {
+			out = append(out, note)
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].UpdatedAt.After(out[j].UpdatedAt) })
+	return out, nil
+}
+
+func (r *Repository) noteQuery() *ent.ActionAuditQuery {
+	return r.client.ActionAudit.
+		Query().
+		Where(entactionaudit.ActionTypeEQ(entactionaudit.ActionTypeNote)).
+		Order(entactionaudit.ByPerformedAt(), entactionaudit.ByCreateTime())
+}
+
+// foldNotes groups note revisions by note ID; records must be ordered oldest first.
+func foldNotes(records []*ent.ActionAudit) []Note {
+	index := make(map[string]int)
+	var out []Note
+	for _, record := range records {
+		noteID, _ := record.Payload["noteId"].(string)
+		body, _ := record.Payload["body"].(string)
+		if noteID == "" {
+			noteID = record.ID.String()
+		}
+		revision := NoteRevision{
+			AuditID: record.ID.String(),
+			Body:    body,
+			Actor:   record.Actor,
+			At:      record.PerformedAt,
+		}
+		i, ok := index[noteID]
+		if !ok {
+			index[noteID] = len(out)
+			out = append(out, Note{
+				ID:        noteID,
+				GroupID:   record.DuplicateGroupID.String(),
+				Author:    record.Actor,
+				CreatedAt: record.PerformedAt,
+			})
+			i = len(out) - 1
+		}
+		out[i].Body = body
+		out[i].UpdatedAt = record.PerformedAt
+		out[i].Revisions = append(out[i].Revisions, revision)
+	}
+	return out
+}
+
+// AddNote records a new steward note on the duplicate group.
+func (d *Dispatcher) AddNote(ctx context.Context, groupID, tenantSlug, actor, body string) (Note, error) {
+	return d.saveNote(ctx, groupID, tenantSlug, actor, uuid.NewString(), body, false)
+}
+
+// EditNote appends a revision to an existing note; earlier versions stay in its history.
+func (d *Dispatcher) EditNote(ctx context.Context, groupID, tenantSlug, actor, noteID, body string) (Note, error) {
+	return d.saveNote(ctx, groupID, tenantSlug, actor, noteID, body, true)
+}
+
+func (d *Dispatcher) saveNote(ctx context.Context, groupID, tenantSlug, actor, noteID, body string, existing bool) (Note, error) {
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return Note{}, ErrNoteBodyRequired
+	}
+	if len(body) > MaxNoteLength {
+		return Note{}, fmt.Errorf("%w: notes are limited to %d bytes", ErrNoteTooLong, MaxNoteLength)
+	}
+	repo, err := d.repository()
+	if err != nil {
+		return Note{}, err
+	}
+	gid, err := uuid.Parse(groupID)
+	if err != nil {
+		return Note{}, fmt.Errorf("%w: %v", ErrInvalidGroupID, err)
+	}
+	group, err := repo.Get(ctx, gid)
+	if err != nil {
+		return Note{}, err
+	}
+	if group.TenantSlug != tenantSlug {
+		return Note{}, ErrGroupNotFound
+	}
+
+	notes, err := repo.Notes(ctx, gid)
+	if err != nil {
+		return Note{}, err
+	}
+	revision := 1
+	if existing {
+		current, ok := findNote(notes, noteID)
+		if !ok {
+			return Note{}, ErrNoteNotFound
+		}
+		if current.Body == body {
+			return current, nil
+		}
+		revision = len(current.Revisions) + 1
+	}
+
+	if err := d.audit(ctx, group, AuditEntry{
+		Type:       string(ActionNote),
+		GroupID:    groupID,
+		TenantSlug: tenantSlug,
+		Actor:      actor,
+		Payload: map[string]any{
+			"noteId":   noteID,
+			"body":     body,
+			"revision": revision,
+		},
+	}); err != nil {
+		return Note{}, err
+	}
+
+	notes, err = repo.Notes(ctx, gid)
+	if err != nil {
+		return Note{}, err
+	}
+	note, _ := findNote(notes, noteID)
+	return note, nil
+}
+
+func findNote(notes []Note, id string) (Note, bool) {
+	for _, note := range notes {
+		if note.ID == id {
+			return note, true
+		}
+	}
+	return Note{}, false
+}
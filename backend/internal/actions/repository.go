@@ -8,6 +8,8 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/mcmx/duplynx/ent"
+	entactionaudit "github.com/mcmx/duplynx/ent/actionaudit"
+	entcontentidentity "github.com/mcmx/duplynx/ent/contentidentity"
 	entduplicategroup "github.com/mcmx/duplynx/ent/duplicategroup"
 	entfileinstance "github.com/mcmx/duplynx/ent/fileinstance"
 )
@@ -34,8 +36,9 @@ func (r *Repository) ListByScan(ctx context.Context, scanID uuid.UUID) ([]Duplic
 		Query().
 		Where(entduplicategroup.ScanID(scanID)).
 		WithTenant().
+		WithKeeperMachine().
 		WithFileInstances(func(q *ent.FileInstanceQuery) {
-			q.Order(entfileinstance.ByPath())
+			q.WithMachine().Order(entfileinstance.ByPath())
 		}).
 		Order(
 			entduplicategroup.ByStatus(),
@@ -62,8 +65,10 @@ func (r *Repository) Get(ctx context.Context, id uuid.UUID) (DuplicateGroup, err
 		Query().
 		Where(entduplicategroup.IDEQ(id)).
 		WithTenant().
+		WithScan().
+		WithKeeperMachine().
 		WithFileInstances(func(q *ent.FileInstanceQuery) {
-			q.Order(entfileinstance.ByPath())
+			q.WithMachine().Order(entfileinstance.ByPath())
 		}).
 		Only(ctx)
 	if err != nil {
@@ -92,6 +97,17 @@ func (r *Repository) UpdateKeeper(ctx context.Context, id uuid.UUID, machineID u
 		}
 		return fmt.Errorf("update keeper machine: %w", err)
 	}
+	// The content identity remembers the choice for later scans of the same hash.
+	identity := r.client.ContentIdentity.Update().
+		Where(entcontentidentity.HasDuplicateGroupsWith(entduplicategroup.IDEQ(id)))
+	if machineID == uuid.Nil {
+		identity = identity.ClearKeeperMachineID()
+	} else {
+		identity = identity.SetKeeperMachineID(machineID)
+	}
+	if _, err := identity.Save(ctx); err != nil {
+		return fmt.Errorf("update content identity keeper: %w", err)
+	}
 	return nil
 }
 
@@ -111,6 +127,78 @@ func (r *Repository) QuarantineFiles(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
+// RecordAudit persists an audit entry against the duplicate group so it shows
+// up in the group's timeline.
+func (r *Repository) RecordAudit(ctx context.Context, id uuid.UUID, entry AuditEntry) (TimelineEntry, error) {
+	if r == nil || r.client == nil {
+		return TimelineEntry{}, errors.New("actions repository not configured")
+	}
+	group, err := r.client.DuplicateGroup.Query().Where(entduplicategroup.IDEQ(id)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return TimelineEntry{}, ErrGroupNotFound
+		}
+		return TimelineEntry{}, fmt.Errorf("load duplicate group: %w", err)
+	}
+
+	payload := entry.Payload
+	if entry.KeeperMachineID != "" {
+		payload = make(map[string]any, len(entry.Payload)+1)
+		for key, value := range entry.Payload {
+			payload[key] = value
+		}
+		payload["keeperMachineId"] = entry.KeeperMachineID
+	}
+	builder := r.client.ActionAudit.Create().
+		SetTenantID(group.TenantID).
+		SetDuplicateGroupID(id).
+		SetActionType(entactionaudit.ActionType(entry.Type)).
+		SetStubbed(entry.Stubbed)
+	if entry.Actor != "" {
+		builder.SetActor(entry.Actor)
+	}
+	if payload != nil {
+		builder.SetPayload(payload)
+	}
+	record, err := builder.Save(ctx)
+	if err != nil {
+		return TimelineEntry{}, fmt.Errorf("record action audit: %w", err)
+	}
+	return convertAudit(record), nil
+}
+
+// Timeline returns the duplicate group's action audits, oldest first.
+func (r *Repository) Timeline(ctx context.Context, id uuid.UUID) ([]TimelineEntry, error) {
+	if r == nil || r.client == nil {
+		return nil, errors.New("actions repository not configured")
+	}
+	records, err := r.client.ActionAudit.
+		Query().
+		Where(entactionaudit.DuplicateGroupIDEQ(id)).
+		Order(entactionaudit.ByPerformedAt(), entactionaudit.ByCreateTime()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list action audits: %w", err)
+	}
+	out := make([]TimelineEntry, 0, len(records))
+	for _, record := range records {
+		out = append(out, convertAudit(record))
+	}
+	return out, nil
+}
+
+func convertAudit(record *ent.ActionAudit) TimelineEntry {
+	return TimelineEntry{
+		ID:          record.ID.String(),
+		GroupID:     record.DuplicateGroupID.String(),
+		Type:        string(record.ActionType),
+		Actor:       record.Actor,
+		PerformedAt: record.PerformedAt,
+		Payload:     record.Payload,
+		Stubbed:     record.Stubbed,
+	}
+}
+
 func convertDuplicateGroup(record *ent.DuplicateGroup) DuplicateGroup {
 	if record == nil {
 		return DuplicateGroup{}
@@ -120,29 +208,44 @@ func convertDuplicateGroup(record *ent.DuplicateGroup) DuplicateGroup {
 		tenantSlug = record.Edges.Tenant.Slug
 	}
 
-	var keeperMachineID string
+	var keeperMachineID, keeperMachineName string
 	if record.KeeperMachineID != uuid.Nil {
 		keeperMachineID = record.KeeperMachineID.String()
 	}
+	if record.Edges.KeeperMachine != nil {
+		keeperMachineName = record.Edges.KeeperMachine.Name
+	}
 
 	files := make([]DuplicateFile, 0, len(record.Edges.FileInstances))
 	for _, file := range record.Edges.FileInstances {
-		files = append(files, DuplicateFile{
+		converted := DuplicateFile{
 			ID:          file.ID.String(),
 			MachineID:   file.MachineID.String(),
 			Path:        file.Path,
 			SizeBytes:   file.SizeBytes,
+			LastSeenAt:  file.LastSeenAt,
 			Quarantined: file.Quarantined,
-		})
+		}
+		if machine := file.Edges.Machine; machine != nil {
+			converted.MachineName = machine.Name
+			converted.MachineCategory = string(machine.Category)
+			converted.MachineHostname = machine.Hostname
+		}
+		files = append(files, converted)
 	}
 
-	return DuplicateGroup{
-		ID:              record.ID.String(),
-		ScanID:          record.ScanID.String(),
-		TenantSlug:      tenantSlug,
-		Status:          string(record.Status),
-		KeeperMachineID: keeperMachineID,
-		Hash:            record.Hash,
-		Files:           files,
-	}
+	group := DuplicateGroup{
+		ID:                record.ID.String(),
+		ScanID:            record.ScanID.String(),
+		TenantSlug:        tenantSlug,
+		Status:            string(record.Status),
+		KeeperMachineID:   keeperMachineID,
+		KeeperMachineName: keeperMachineName,
+		Hash:              record.Hash,
+		Files:             files,
+	}
+	if record.Edges.Scan != nil {
+		group.FilesPrunedAt = record.Edges.Scan.FilesPrunedAt
+	}
+	return group
 }
@@ -0,0 +1,169 @@
+package agent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/mcmx/duplynx/internal/actions"
+	"github.com/mcmx/duplynx/internal/ingestion"
+)
+
+// QuarantineDirName is the directory next to each copy that quarantined
+// copies move into when the agent has no quarantine directory. Scans skip it.
+const QuarantineDirName = ".duplynx-quarantine"
+
+// errContentChanged marks copies whose content no longer matches the group.
+var errContentChanged = errors.New("content no longer matches the duplicate group")
+
+// runAction runs one action job on the machine's copies and reports which
+// paths it acted on. Copies whose content changed since the scan are left
+// alone and fail the job.
+func (a *Agent) runAction(ctx context.Context, job ingestion.ActionPayload) {
+	result := JobResult{JobID: job.ID, Action: job.Action}
+	done, err := a.act(ctx, job)
+	if ctx.Err() != nil {
+		// Shutting down: the job stays claimed and is handed out again; copies
+		// already acted on count as done then.
+		return
+	}
+	result.Done = len(done)
+	report := ingestion.ActionReportRequest{JobID: job.ID, MachineID: a.MachineID, Status: ingestion.ActionSucceeded, Done: done}
+	result.Outcome = JobReported
+	if err != nil {
+		report.Status, report.Message = ingestion.ActionFailed, err.Error()
+		result.Outcome, result.Err = JobFailed, err
+	} else {
+		report.Message = fmt.Sprintf("%s on %d copies", job.Action, len(done))
+	}
+	if _, reportErr := a.Client.Post(ctx, "/ingest/action-report", report, nil); reportErr != nil && result.Err == nil {
+		result.Outcome, result.Err = JobFailed, fmt.Errorf("report: %w", reportErr)
+	}
+	if a.OnJob != nil {
+		a.OnJob(result)
+	}
+}
+
+// act applies the job to each of its paths, stopping at the first error.
+func (a *Agent) act(ctx context.Context, job ingestion.ActionPayload) ([]string, error) {
+	if job.KeepPath != "" {
+		if err := matches(job.KeepPath, job.Hash); err != nil {
+			return nil, fmt.Errorf("kept copy %s: %w", job.KeepPath, err)
+		}
+	}
+	var done, changed []string
+	for _, path := range job.Paths {
+		if err := ctx.Err(); err != nil {
+			return done, err
+		}
+		var err error
+		switch actions.ActionType(job.Action) {
+		case actions.ActionDelete:
+			err = deleteCopy(path, job.Hash)
+		case actions.ActionHardlink:
+			err = linkCopy(path, job.KeepPath, job.Hash)
+		case actions.ActionQuarantine:
+			err = a.quarantineCopy(path, job.Hash)
+		default:
+			return done, fmt.Errorf("unsupported action %q", job.Action)
+		}
+		switch {
+		case errors.Is(err, errContentChanged):
+			changed = append(changed, path)
+		case err != nil:
+			return done, fmt.Errorf("%s: %w", path, err)
+		default:
+			done = append(done, path)
+		}
+	}
+	if len(changed) > 0 {
+		return done, fmt.Errorf("%w, left in place: %s", errContentChanged, strings.Join(changed, ", "))
+	}
+	return done, nil
+}
+
+// deleteCopy removes the copy; a copy that is already gone counts as deleted.
+func deleteCopy(path, hash string) error {
+	if err := matches(path, hash); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+	return os.Remove(path)
+}
+
+// linkCopy replaces the copy with a hard link to the kept copy, linking to a
+// temporary name first so the path never goes missing.
+func linkCopy(path, keepPath, hash string) error {
+	if keepPath == "" {
+		return errors.New("hardlink job has no kept copy")
+	}
+	keep, err := os.Stat(keepPath)
+	if err != nil {
+		return err
+	}
+	if info, err := os.Stat(path); err == nil && os.SameFile(keep, info) {
+		return nil
+	}
+	if err := matches(path, hash); err != nil {
+		return err
+	}
+	tmp := path + ".duplynx-link"
+	_ = os.Remove(tmp)
+	if err := os.Link(keepPath, tmp); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		_ = os.Remove(tmp)
+		return err
+	}
+	return nil
+}
+
+// quarantineCopy moves the copy into quarantine; a copy already moved there
+// counts as quarantined.
+func (a *Agent) quarantineCopy(path, hash string) error {
+	dest, err := a.quarantinePath(path)
+	if err != nil {
+		return err
+	}
+	if err := matches(path, hash); err != nil {
+		if _, statErr := os.Stat(dest); errors.Is(err, os.ErrNotExist) && statErr == nil {
+			return nil
+		}
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(dest), 0o700); err != nil {
+		return err
+	}
+	return os.Rename(path, dest)
+}
+
+// quarantinePath mirrors the copy's absolute path under QuarantineDir, or
+// places it in a QuarantineDirName directory beside the copy.
+func (a *Agent) quarantinePath(path string) (string, error) {
+	if a.QuarantineDir == "" {
+		return filepath.Join(filepath.Dir(path), QuarantineDirName, filepath.Base(path)), nil
+	}
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(a.QuarantineDir, strings.TrimPrefix(abs, filepath.VolumeName(abs))), nil
+}
+
+// matches returns errContentChanged unless the file's SHA-256 equals hash.
+func matches(path, hash string) error {
+	got, err := hashFile(path)
+	if err != nil {
+		return err
+	}
+	if got != hash {
+		return errContentChanged
+	}
+	return nil
+}
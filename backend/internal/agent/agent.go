@@ -0,0 +1,324 @@
+// Package agent implements the long-running daemon that scans a machine on
+// the server's behalf: it long-polls /ingest/jobs, runs scan and action jobs
+// up to a concurrency limit, streams heartbeats while scans run and reports
+// each job when it is done.
+package agent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/mcmx/duplynx/internal/ingestion"
+	"github.com/mcmx/duplynx/internal/scans"
+)
+
+const (
+	// DefaultConcurrency is how many jobs an agent runs at once.
+	DefaultConcurrency = 1
+	// DefaultPollWait is how long each job poll is held open by the server.
+	DefaultPollWait = ingestion.MaxJobWait
+	// DefaultHeartbeatInterval is how often running jobs report progress.
+	DefaultHeartbeatInterval = 15 * time.Second
+	// DefaultMinBackoff and DefaultMaxBackoff bound the reconnect delay.
+	DefaultMinBackoff = time.Second
+	DefaultMaxBackoff = time.Minute
+)
+
+// Job outcomes passed to OnJob.
+const (
+	JobReported  = "reported"
+	JobFailed    = "failed"
+	JobCancelled = "cancelled"
+)
+
+// JobResult describes a finished job. Scan jobs set ScanID, Name, Progress
+// and Groups; action jobs set JobID, Action and Done, the copies acted on.
+type JobResult struct {
+	ScanID   string
+	Name     string
+	JobID    string
+	Action   string
+	Outcome  string
+	Progress Progress
+	Groups   int
+	Done     int
+	Err      error
+}
+
+// Agent polls the server for one machine's jobs.
+type Agent struct {
+	Client    *Client
+	MachineID string
+	// Concurrency limits how many jobs run at once; DefaultConcurrency when zero.
+	Concurrency int
+	// PollWait, HeartbeatInterval and the backoff bounds fall back to their defaults when zero.
+	PollWait          time.Duration
+	HeartbeatInterval time.Duration
+	MinBackoff        time.Duration
+	MaxBackoff        time.Duration
+	// QuarantineDir receives quarantined copies under their absolute path; when
+	// empty they move into a QuarantineDirName directory beside each copy.
+	QuarantineDir string
+	// OnJob, when set, observes every job the agent finishes.
+	OnJob func(JobResult)
+	// OnDisconnect, when set, observes failed polls and the delay before the next one.
+	OnDisconnect func(err error, retryIn time.Duration)
+
+	mu      sync.Mutex
+	running map[string]bool
+}
+
+// Run polls for jobs until ctx is done, then waits for running jobs to stop.
+// Jobs interrupted by shutdown are not reported; the server hands them out
+// again on the agent's next poll. Run returns an error only when the server
+// rejects the agent's credentials or machine.
+func (a *Agent) Run(ctx context.Context) error {
+	if a.Client == nil || a.MachineID == "" {
+		return errors.New("agent requires a client and a machine ID")
+	}
+	a.mu.Lock()
+	a.running = make(map[string]bool)
+	a.mu.Unlock()
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	finished := make(chan struct{}, 1)
+	backoff := a.minBackoff()
+	for ctx.Err() == nil {
+		running := a.runningIDs()
+		capacity := a.concurrency() - len(running)
+		req := ingestion.JobsRequest{MachineID: a.MachineID, Running: running, Capacity: capacity}
+		if capacity > 0 {
+			req.WaitSeconds = int(a.pollWait() / time.Second)
+		}
+
+		var resp ingestion.JobsResponse
+		status, err := a.Client.Post(ctx, "/ingest/jobs", req, &resp)
+		switch {
+		case ctx.Err() != nil:
+			return nil
+		case errors.Is(err, ErrUnauthorized), status == http.StatusNotFound, status == http.StatusNotImplemented:
+			return fmt.Errorf("poll jobs: %w", err)
+		case err != nil:
+			delay := backoff/2 + rand.N(backoff/2+1)
+			if a.OnDisconnect != nil {
+				a.OnDisconnect(err, delay)
+			}
+			if !sleep(ctx, delay) {
+				return nil
+			}
+			backoff = min(backoff*2, a.maxBackoff())
+			continue
+		}
+		backoff = a.minBackoff()
+
+		for _, job := range resp.Jobs {
+			var run func()
+			switch {
+			case job.Type == ingestion.JobScan && job.Scan != nil:
+				assignment := *job.Scan
+				run = func() { a.runScan(ctx, assignment) }
+			case job.Type == ingestion.JobAction && job.Action != nil:
+				action := *job.Action
+				run = func() { a.runAction(ctx, action) }
+			}
+			// Job types this agent does not know are left for newer agents.
+			if run == nil || !a.claim(job.ID) {
+				continue
+			}
+			wg.Add(1)
+			go func(id string) {
+				defer wg.Done()
+				run()
+				a.release(id)
+				select {
+				case finished <- struct{}{}:
+				default:
+				}
+			}(job.ID)
+		}
+		if capacity <= 0 {
+			// At capacity the poll is only a heartbeat; poll again when a job
+			// finishes so the slot is refilled promptly.
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-finished:
+			case <-time.After(a.heartbeatInterval()):
+			}
+		}
+	}
+	return nil
+}
+
+// runScan walks one assignment's roots, streaming heartbeats, then uploads the
+// manifest and reports the machine's part of the scan.
+func (a *Agent) runScan(ctx context.Context, assignment ingestion.AssignmentPayload) {
+	jobCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	if assignment.Deadline != nil {
+		var cancelDeadline context.CancelFunc
+		jobCtx, cancelDeadline = context.WithDeadline(jobCtx, *assignment.Deadline)
+		defer cancelDeadline()
+	}
+
+	var (
+		mu        sync.Mutex
+		progress  Progress
+		cancelled bool
+	)
+	beat := func(message string) {
+		mu.Lock()
+		hb := ingestion.HeartbeatRequest{ScanID: assignment.ScanID, FilesSeen: progress.FilesSeen, BytesHashed: progress.BytesHashed, Message: message}
+		mu.Unlock()
+		var resp ingestion.HeartbeatResponse
+		status, err := a.Client.Post(ctx, "/ingest/heartbeat", hb, &resp)
+		// A finished or cancelled scan stops the job; other failures are retried on the next beat.
+		if err == nil && (status == http.StatusConflict || resp.Cancelled) {
+			mu.Lock()
+			cancelled = true
+			mu.Unlock()
+			cancel()
+		}
+	}
+	stopBeats := make(chan struct{})
+	beatsDone := make(chan struct{})
+	go func() {
+		defer close(beatsDone)
+		ticker := time.NewTicker(a.heartbeatInterval())
+		defer ticker.Stop()
+		beat("")
+		for {
+			select {
+			case <-stopBeats:
+				return
+			case <-jobCtx.Done():
+				return
+			case <-ticker.C:
+				beat("")
+			}
+		}
+	}()
+
+	manifest, counts, err := Scan(jobCtx, assignment.Roots, assignment.Exclusions, func(p Progress) {
+		mu.Lock()
+		progress = p
+		mu.Unlock()
+	})
+	close(stopBeats)
+	<-beatsDone
+
+	result := JobResult{ScanID: assignment.ScanID, Name: assignment.Name, Progress: counts, Groups: len(manifest.Groups)}
+	mu.Lock()
+	wasCancelled := cancelled
+	mu.Unlock()
+	switch {
+	case wasCancelled:
+		result.Outcome = JobCancelled
+	case ctx.Err() != nil:
+		// Shutting down: leave the target claimed for the next run.
+		return
+	case err == nil:
+		manifest.ScanID, manifest.MachineID = assignment.ScanID, a.MachineID
+		if _, err = a.Client.Post(ctx, "/ingest", manifest, nil); err != nil {
+			err = fmt.Errorf("upload manifest: %w", err)
+		}
+	}
+	if result.Outcome == "" {
+		report := ingestion.ReportRequest{ScanID: assignment.ScanID, MachineID: a.MachineID, Status: scans.TargetReported}
+		result.Outcome = JobReported
+		if err != nil {
+			report.Status, report.Message = scans.TargetFailed, err.Error()
+			result.Outcome, result.Err = JobFailed, err
+		} else {
+			// Send the final counters before the report settles the target.
+			beat("")
+			report.Message = fmt.Sprintf("%d duplicate groups in %d files", len(manifest.Groups), counts.FilesSeen)
+		}
+		if _, reportErr := a.Client.Post(ctx, "/ingest/report", report, &ingestion.HeartbeatResponse{}); reportErr != nil && result.Err == nil {
+			result.Outcome, result.Err = JobFailed, fmt.Errorf("report: %w", reportErr)
+		}
+	}
+	if a.OnJob != nil {
+		a.OnJob(result)
+	}
+}
+
+func (a *Agent) claim(jobID string) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.running[jobID] || len(a.running) >= a.concurrency() {
+		return false
+	}
+	a.running[jobID] = true
+	return true
+}
+
+func (a *Agent) release(jobID string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	delete(a.running, jobID)
+}
+
+func (a *Agent) runningIDs() []string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	ids := make([]string, 0, len(a.running))
+	for id := range a.running {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func (a *Agent) concurrency() int {
+	if a.Concurrency > 0 {
+		return a.Concurrency
+	}
+	return DefaultConcurrency
+}
+
+func (a *Agent) pollWait() time.Duration {
+	if a.PollWait > 0 {
+		return a.PollWait
+	}
+	return DefaultPollWait
+}
+
+func (a *Agent) heartbeatInterval() time.Duration {
+	if a.HeartbeatInterval > 0 {
+		return a.HeartbeatInterval
+	}
+	return DefaultHeartbeatInterval
+}
+
+func (a *Agent) minBackoff() time.Duration {
+	if a.MinBackoff > 0 {
+		return a.MinBackoff
+	}
+	return DefaultMinBackoff
+}
+
+func (a *Agent) maxBackoff() time.Duration {
+	if a.MaxBackoff > 0 {
+		return a.MaxBackoff
+	}
+	return DefaultMaxBackoff
+}
+
+func sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
@@ -0,0 +1,93 @@
+package agent
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/mcmx/duplynx/internal/ingestion"
+)
+
+// ErrUnauthorized is returned when the server rejects the agent's tenant or signature.
+var ErrUnauthorized = errors.New("server rejected the agent's credentials")
+
+// defaultHTTP outlasts the longest job poll so a dead connection is noticed.
+var defaultHTTP = &http.Client{Timeout: ingestion.MaxJobWait + 30*time.Second}
+
+// Client signs agent requests with one of the tenant's ingestion secrets.
+type Client struct {
+	// BaseURL is the server's root URL, e.g. https://duplynx.example.com.
+	BaseURL string
+	Tenant  string
+	Secret  string
+	// KeyID names the secret version; empty lets the server try every active version.
+	KeyID string
+	// HTTP sends the requests; a client with a timeout longer than a job poll is used when nil.
+	HTTP *http.Client
+}
+
+// StatusError is returned for responses the caller did not expect.
+type StatusError struct {
+	Path   string
+	Status int
+	Body   string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("%s: server answered %d: %s", e.Path, e.Status, e.Body)
+}
+
+// Post signs in and sends it to path. JSON responses are decoded into out,
+// including 409 answers for finished scans, whose status code is returned
+// alongside a nil error so callers can stop work.
+func (c *Client) Post(ctx context.Context, path string, in, out any) (int, error) {
+	payload, err := json.Marshal(in)
+	if err != nil {
+		return 0, fmt.Errorf("encode %s: %w", path, err)
+	}
+	mac := hmac.New(sha256.New, []byte(c.Secret))
+	mac.Write(payload)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimRight(c.BaseURL, "/")+path, bytes.NewReader(payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(ingestion.HeaderTenant, c.Tenant)
+	req.Header.Set(ingestion.HeaderSignature, hex.EncodeToString(mac.Sum(nil)))
+	if c.KeyID != "" {
+		req.Header.Set(ingestion.HeaderKeyID, c.KeyID)
+	}
+	httpClient := c.HTTP
+	if httpClient == nil {
+		httpClient = defaultHTTP
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusForbidden:
+		return resp.StatusCode, ErrUnauthorized
+	case resp.StatusCode >= 300 && resp.StatusCode != http.StatusConflict:
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return resp.StatusCode, &StatusError{Path: path, Status: resp.StatusCode, Body: strings.TrimSpace(string(body))}
+	}
+	if out != nil && strings.HasPrefix(resp.Header.Get("Content-Type"), "application/json") {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			return resp.StatusCode, fmt.Errorf("decode %s: %w", path, err)
+		}
+	}
+	return resp.StatusCode, nil
+}
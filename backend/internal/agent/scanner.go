@@ -0,0 +1,132 @@
+package agent
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/mcmx/duplynx/internal/ingestion"
+)
+
+// Progress counts what a scan has covered so far.
+type Progress struct {
+	FilesSeen   int
+	BytesHashed int64
+}
+
+// Manifest is the duplicate report an agent posts to /ingest once its roots are walked.
+type Manifest = ingestion.ManifestRequest
+
+// ManifestGroup lists files on the machine that share a content hash.
+type ManifestGroup = ingestion.ManifestGroup
+
+// Scan walks roots, hashing regular files with SHA-256, and groups files with
+// identical content. Paths whose base name or full path match one of the
+// exclusion patterns (filepath.Match syntax) are skipped, including whole
+// directories, as are QuarantineDirName directories. Unreadable files are
+// skipped; progress is called after every file.
+func Scan(ctx context.Context, roots, exclusions []string, progress func(Progress)) (Manifest, Progress, error) {
+	var counts Progress
+	bySize := make(map[int64][]string)
+	for _, root := range roots {
+		err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return ctxErr
+			}
+			if err != nil {
+				if path == root {
+					return err
+				}
+				return nil
+			}
+			if excluded(path, exclusions) || (d.IsDir() && d.Name() == QuarantineDirName) {
+				if d.IsDir() {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if !d.Type().IsRegular() {
+				return nil
+			}
+			info, err := d.Info()
+			if err != nil {
+				return nil
+			}
+			counts.FilesSeen++
+			bySize[info.Size()] = append(bySize[info.Size()], path)
+			if progress != nil {
+				progress(counts)
+			}
+			return nil
+		})
+		if err != nil {
+			return Manifest{}, counts, err
+		}
+	}
+
+	// Only files that share a size can be duplicates, so only those are hashed.
+	var manifest Manifest
+	for size, paths := range bySize {
+		if len(paths) < 2 || size == 0 {
+			continue
+		}
+		byHash := make(map[string][]string)
+		for _, path := range paths {
+			if err := ctx.Err(); err != nil {
+				return Manifest{}, counts, err
+			}
+			hash, err := hashFile(path)
+			if err != nil {
+				continue
+			}
+			counts.BytesHashed += size
+			byHash[hash] = append(byHash[hash], path)
+			if progress != nil {
+				progress(counts)
+			}
+		}
+		for hash, same := range byHash {
+			if len(same) < 2 {
+				continue
+			}
+			sort.Strings(same)
+			manifest.Groups = append(manifest.Groups, ManifestGroup{Hash: hash, SizeBytes: size, Paths: same})
+		}
+	}
+	sort.Slice(manifest.Groups, func(i, j int) bool {
+		return manifest.Groups[i].Hash < manifest.Groups[j].Hash
+	})
+	manifest.FilesSeen, manifest.BytesHashed = counts.FilesSeen, counts.BytesHashed
+	return manifest, counts, nil
+}
+
+func excluded(path string, patterns []string) bool {
+	base := filepath.Base(path)
+	for _, pattern := range patterns {
+		if ok, _ := filepath.Match(pattern, base); ok {
+			return true
+		}
+		if ok, _ := filepath.Match(pattern, path); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func hashFile(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -2,10 +2,17 @@ package app
 
 import (
 	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
+
+	"github.com/mcmx/duplynx/internal/assets"
+	"github.com/mcmx/duplynx/web"
 )
 
+// tailwindBundle is the stylesheet every served bundle must contain.
+const tailwindBundle = "tailwind.css"
+
 // ValidateAssetDirectory ensures the supplied directory exists and contains the expected Tailwind bundle.
 func ValidateAssetDirectory(root string) error {
 	if root == "" {
@@ -21,10 +28,31 @@ func ValidateAssetDirectory(root string) error {
 		return fmt.Errorf("assets path %q is not a directory", root)
 	}
 
-	bundle := filepath.Join(root, "tailwind.css")
+	bundle := filepath.Join(root, tailwindBundle)
 	if stat, err := os.Stat(bundle); err != nil || stat.IsDir() {
 		return fmt.Errorf("tailwind bundle missing at %q; run `npm run build:tailwind` before serving", bundle)
 	}
 
 	return nil
 }
+
+// ResolveAssets picks the static bundle to serve. A non-empty dir overrides
+// the embedded bundle, which is convenient while iterating on styles;
+// otherwise the assets compiled into the binary are used unless embedStatic
+// is false.
+func ResolveAssets(dir string, embedStatic bool) (*assets.Bundle, error) {
+	if dir != "" {
+		if err := ValidateAssetDirectory(dir); err != nil {
+			return nil, err
+		}
+		return assets.Load(os.DirFS(dir), dir)
+	}
+	if !embedStatic {
+		return nil, fmt.Errorf("assets directory must be provided when DUPLYNX_EMBED_STATIC=false")
+	}
+	dist := web.Dist()
+	if stat, err := fs.Stat(dist, tailwindBundle); err != nil || stat.IsDir() {
+		return nil, fmt.Errorf("tailwind bundle missing from the embedded assets; run `npm run build:tailwind` before `go build`, or pass --assets-dir")
+	}
+	return assets.Load(dist, "embedded")
+}
@@ -26,7 +26,7 @@ func LoadConfig() Config {
 	}
 
 	if len(cfg.TenantSecrets) == 0 {
-		log.Println("warning: no tenant HMAC secrets configured; ingestion endpoints will reject unsigned payloads")
+		log.Println("warning: no legacy tenant HMAC secrets configured; ingestion only accepts payloads signed with rotated secrets (see `duplynx secrets rotate`)")
 	}
 
 	return cfg
@@ -0,0 +1,85 @@
+// Package assets fingerprints the static bundle so pages can reference
+// assets by content hash and browsers can cache them indefinitely.
+package assets
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+)
+
+// hashLength is the number of hex characters of the SHA-256 digest kept in URLs.
+const hashLength = 12
+
+// Bundle is a read-only set of static assets with their content hashes.
+type Bundle struct {
+	fsys   fs.FS
+	source string
+	hashes map[string]string
+}
+
+// Load hashes every file in fsys. Source describes where the files came from,
+// e.g. a directory path or "embedded", for logs and errors.
+func Load(fsys fs.FS, source string) (*Bundle, error) {
+	b := &Bundle{fsys: fsys, source: source, hashes: make(map[string]string)}
+	err := fs.WalkDir(fsys, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || strings.HasPrefix(d.Name(), ".") {
+			return nil
+		}
+		f, err := fsys.Open(name)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		h := sha256.New()
+		if _, err := io.Copy(h, f); err != nil {
+			return err
+		}
+		b.hashes[name] = hex.EncodeToString(h.Sum(nil))[:hashLength]
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("hash assets from %s: %w", source, err)
+	}
+	return b, nil
+}
+
+// Source reports where the bundle was loaded from.
+func (b *Bundle) Source() string {
+	if b == nil {
+		return ""
+	}
+	return b.source
+}
+
+// FS exposes the bundle to http.FileServer.
+func (b *Bundle) FS() http.FileSystem {
+	return http.FS(b.fsys)
+}
+
+// Hash returns the content hash of the named asset, or "" when it is not in the bundle.
+func (b *Bundle) Hash(name string) string {
+	if b == nil {
+		return ""
+	}
+	return b.hashes[strings.TrimPrefix(path.Clean("/"+name), "/")]
+}
+
+// URL returns the asset's path under /static/, versioned with its content
+// hash so a new build changes the URL. Unknown assets get an unversioned path.
+func (b *Bundle) URL(name string) string {
+	u := "/static/" + name
+	if hash := b.Hash(name); hash != "" {
+		u += "?" + url.Values{"v": {hash}}.Encode()
+	}
+	return u
+}
@@ -0,0 +1,347 @@
+// Package backup copies a live SQLite database to a file and restores such
+// copies, checking their integrity and schema version.
+package backup
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect"
+
+	"github.com/mcmx/duplynx/ent"
+	"github.com/mcmx/duplynx/internal/data"
+	"github.com/mcmx/duplynx/internal/search"
+)
+
+var (
+	// ErrUnsupportedDriver is returned for Postgres, which is backed up with pg_dump.
+	ErrUnsupportedDriver = errors.New("backups are only built in for sqlite; use pg_dump for postgres")
+	// ErrIntegrity means PRAGMA integrity_check found a problem in the copy.
+	ErrIntegrity = errors.New("database copy failed its integrity check")
+	// ErrOutputExists guards against overwriting an earlier backup.
+	ErrOutputExists = errors.New("backup file already exists")
+	// ErrIncompatibleBackup means the backup's schema cannot be served by this build.
+	ErrIncompatibleBackup = errors.New("backup schema is incompatible with this build")
+)
+
+// gzipMagic starts every gzip stream; restore uses it to detect compressed backups.
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// Options controls how a backup is written.
+type Options struct {
+	// Compress gzips the copy. It is implied by an output path ending in .gz.
+	Compress bool
+	// Verify runs PRAGMA integrity_check on the copy before it is kept.
+	Verify bool
+}
+
+// Result describes a written backup.
+type Result struct {
+	Path          string
+	Bytes         int64
+	SchemaVersion string
+	Compressed    bool
+	Verified      bool
+	Duration      time.Duration
+}
+
+// Backup writes a consistent copy of the client's database to out with
+// VACUUM INTO, which reads inside one transaction and so is safe while other
+// connections, including a running serve, keep writing.
+func Backup(ctx context.Context, client *ent.Client, out string, opts Options) (Result, error) {
+	start := time.Now()
+	if client == nil {
+		return Result{}, errors.New("ent client is nil")
+	}
+	if client.Dialect() != dialect.SQLite {
+		return Result{}, ErrUnsupportedDriver
+	}
+	if strings.TrimSpace(out) == "" {
+		return Result{}, errors.New("backup output path must not be empty")
+	}
+	if _, err := os.Stat(out); err == nil {
+		return Result{}, fmt.Errorf("%w: %s", ErrOutputExists, out)
+	}
+	if err := os.MkdirAll(filepath.Dir(out), 0o755); err != nil {
+		return Result{}, err
+	}
+	result := Result{Path: out, Compressed: opts.Compress || strings.HasSuffix(out, ".gz")}
+
+	// VACUUM INTO refuses to overwrite, so the copy goes to a fresh temp name
+	// next to the output and is only renamed into place once it is checked.
+	tmp := fmt.Sprintf("%s.tmp-%d", out, start.UnixNano())
+	defer removeDatabaseFiles(tmp)
+	if _, err := client.ExecContext(ctx, "VACUUM INTO ?", tmp); err != nil {
+		return Result{}, fmt.Errorf("copy database: %w", err)
+	}
+	version, err := inspect(ctx, tmp, opts.Verify)
+	if err != nil {
+		return Result{}, err
+	}
+	result.SchemaVersion, result.Verified = version, opts.Verify
+
+	if result.Compressed {
+		err = compressFile(tmp, out)
+	} else if err = os.Rename(tmp, out); err == nil {
+		// Backups hold tenant secrets; match the compressed copies' permissions.
+		err = os.Chmod(out, 0o600)
+	}
+	if err != nil {
+		return Result{}, fmt.Errorf("write backup: %w", err)
+	}
+	info, err := os.Stat(out)
+	if err != nil {
+		return Result{}, err
+	}
+	result.Bytes = info.Size()
+	result.Duration = time.Since(start)
+	return result, nil
+}
+
+// RestoreOptions controls a restore.
+type RestoreOptions struct {
+	// KeepPrevious renames the database being replaced to
+	// <db>.pre-restore-<timestamp> instead of deleting it.
+	KeepPrevious bool
+}
+
+// RestoreResult describes a completed restore.
+type RestoreResult struct {
+	SchemaVersion string
+	// Behind reports that the backup predates this build's latest migration;
+	// run `duplynx db migrate up` before serving it.
+	Behind bool
+	// Previous is where the replaced database was kept, if anywhere.
+	Previous string
+}
+
+// Restore replaces the SQLite database at dbFile with the backup at src.
+// The backup is unpacked next to dbFile and checked first: it must pass
+// PRAGMA integrity_check and carry a migration history this build knows.
+// serve must be stopped: Restore takes the database lock exclusively and
+// fails with data.ErrDatabaseInUse while serve holds it.
+func Restore(ctx context.Context, src, dbFile string, opts RestoreOptions) (result RestoreResult, err error) {
+	if strings.TrimSpace(dbFile) == "" {
+		return RestoreResult{}, errors.New("database path must not be empty")
+	}
+	if err := os.MkdirAll(filepath.Dir(dbFile), 0o755); err != nil {
+		return RestoreResult{}, err
+	}
+	lock, err := data.LockDatabase(dbFile, true)
+	if err != nil {
+		return RestoreResult{}, err
+	}
+	defer func() {
+		if releaseErr := lock.Release(); err == nil {
+			err = releaseErr
+		}
+	}()
+	tmp := fmt.Sprintf("%s.restore-%d", dbFile, time.Now().UnixNano())
+	defer removeDatabaseFiles(tmp)
+	if err := unpack(src, tmp); err != nil {
+		return RestoreResult{}, err
+	}
+
+	client, err := data.OpenSQLite(ctx, sqliteDSN(tmp))
+	if err != nil {
+		return RestoreResult{}, err
+	}
+	result, err = validate(ctx, client)
+	if err == nil {
+		// VACUUM INTO renumbers rowids, which the FTS5 index is keyed by.
+		err = search.Rebuild(ctx, client)
+	}
+	if closeErr := client.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return RestoreResult{}, err
+	}
+
+	if _, statErr := os.Stat(dbFile); statErr == nil && opts.KeepPrevious {
+		// The kept copy must not depend on a WAL that is deleted below.
+		if err := checkpoint(ctx, dbFile); err != nil {
+			return RestoreResult{}, fmt.Errorf("keep previous database: %w", err)
+		}
+		result.Previous = fmt.Sprintf("%s.pre-restore-%s", dbFile, time.Now().UTC().Format("20060102T150405Z"))
+		if err := os.Rename(dbFile, result.Previous); err != nil {
+			return RestoreResult{}, fmt.Errorf("keep previous database: %w", err)
+		}
+	}
+	// A stale WAL would be replayed into the restored file.
+	for _, suffix := range []string{"-wal", "-shm", "-journal"} {
+		_ = os.Remove(dbFile + suffix)
+	}
+	if err := os.Rename(tmp, dbFile); err != nil {
+		return RestoreResult{}, fmt.Errorf("swap in restored database: %w", err)
+	}
+	return result, nil
+}
+
+// checkpoint copies every frame of dbFile's write-ahead log into the
+// database file and truncates the log. Databases without a WAL are left alone.
+func checkpoint(ctx context.Context, dbFile string) error {
+	if _, err := os.Stat(dbFile + "-wal"); errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	client, err := data.OpenSQLite(ctx, sqliteDSN(dbFile))
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	rows, err := client.QueryContext(ctx, "PRAGMA wal_checkpoint(TRUNCATE)")
+	if err != nil {
+		return fmt.Errorf("checkpoint write-ahead log: %w", err)
+	}
+	defer rows.Close()
+	var busy, frames, checkpointed int
+	if rows.Next() {
+		if err := rows.Scan(&busy, &frames, &checkpointed); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("checkpoint write-ahead log: %w", err)
+	}
+	if busy != 0 {
+		return fmt.Errorf("checkpoint write-ahead log: %w: %s", data.ErrDatabaseInUse, dbFile)
+	}
+	return nil
+}
+
+func validate(ctx context.Context, client *ent.Client) (RestoreResult, error) {
+	if err := integrityCheck(ctx, client); err != nil {
+		return RestoreResult{}, err
+	}
+	status, err := data.SchemaStatus(ctx, client)
+	if err != nil {
+		return RestoreResult{}, err
+	}
+	if err := status.Err(); err != nil && !errors.Is(err, data.ErrSchemaBehind) {
+		return RestoreResult{}, fmt.Errorf("%w: %v", ErrIncompatibleBackup, err)
+	}
+	if status.Current() == "" {
+		return RestoreResult{}, fmt.Errorf("%w: no migration history", ErrIncompatibleBackup)
+	}
+	return RestoreResult{SchemaVersion: status.Current(), Behind: len(status.Pending) > 0}, nil
+}
+
+// inspect opens a fresh copy, optionally checks its integrity, and returns its schema version.
+func inspect(ctx context.Context, path string, verify bool) (string, error) {
+	client, err := data.OpenSQLite(ctx, sqliteDSN(path))
+	if err != nil {
+		return "", err
+	}
+	defer client.Close()
+	if verify {
+		if err := integrityCheck(ctx, client); err != nil {
+			return "", err
+		}
+	}
+	status, err := data.SchemaStatus(ctx, client)
+	if err != nil {
+		return "", err
+	}
+	return status.Current(), nil
+}
+
+func integrityCheck(ctx context.Context, client *ent.Client) error {
+	rows, err := client.QueryContext(ctx, "PRAGMA integrity_check")
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrIntegrity, err)
+	}
+	defer rows.Close()
+	var problems []string
+	for rows.Next() {
+		var line string
+		if err := rows.Scan(&line); err != nil {
+			return err
+		}
+		if line != "ok" {
+			problems = append(problems, line)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("%w: %v", ErrIntegrity, err)
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("%w: %s", ErrIntegrity, strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+func sqliteDSN(path string) string {
+	return "file:" + filepath.ToSlash(path) + "?_busy_timeout=5000&_foreign_keys=1"
+}
+
+func compressFile(src, dst string) (err error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := out.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			_ = os.Remove(dst)
+		}
+	}()
+	zw := gzip.NewWriter(out)
+	zw.Name = filepath.Base(strings.TrimSuffix(dst, ".gz"))
+	if _, err := io.Copy(zw, in); err != nil {
+		return err
+	}
+	return zw.Close()
+}
+
+// unpack copies src to dst, decompressing it when it is gzipped.
+func unpack(src, dst string) (err error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return fmt.Errorf("open backup: %w", err)
+	}
+	defer in.Close()
+	reader := bufio.NewReader(in)
+	var body io.Reader = reader
+	if magic, _ := reader.Peek(len(gzipMagic)); string(magic) == string(gzipMagic) {
+		zr, err := gzip.NewReader(reader)
+		if err != nil {
+			return fmt.Errorf("read compressed backup: %w", err)
+		}
+		defer zr.Close()
+		body = zr
+	}
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := out.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+	if _, err := io.Copy(out, body); err != nil {
+		return fmt.Errorf("unpack backup: %w", err)
+	}
+	return nil
+}
+
+func removeDatabaseFiles(path string) {
+	for _, suffix := range []string{"", "-wal", "-shm", "-journal"} {
+		_ = os.Remove(path + suffix)
+	}
+}
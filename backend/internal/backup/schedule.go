@@ -0,0 +1,105 @@
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mcmx/duplynx/ent"
+)
+
+// DefaultKeep is the number of scheduled backups retained when unset.
+const DefaultKeep = 7
+
+// filePrefix names scheduled backups; pruning only ever touches files with it.
+const filePrefix = "duplynx-"
+
+// Scheduler writes a backup into Dir every Interval and keeps the newest Keep.
+type Scheduler struct {
+	Client   *ent.Client
+	Dir      string
+	Interval time.Duration
+	Keep     int
+	Options  Options
+	// OnBackup, when set, observes every attempt.
+	OnBackup func(Result, []string, error)
+	// Now defaults to time.Now.
+	Now func() time.Time
+}
+
+// Run backs up on every tick until ctx is done. The first backup is taken one
+// Interval after start, so restarts do not pile up copies.
+func (s *Scheduler) Run(ctx context.Context) {
+	if s == nil || s.Interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(s.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			result, pruned, err := s.Tick(ctx)
+			if s.OnBackup != nil {
+				s.OnBackup(result, pruned, err)
+			}
+		}
+	}
+}
+
+// Tick writes one backup and prunes old ones, returning the removed paths.
+func (s *Scheduler) Tick(ctx context.Context) (Result, []string, error) {
+	if strings.TrimSpace(s.Dir) == "" {
+		return Result{}, nil, errors.New("backup directory must not be empty")
+	}
+	now := time.Now
+	if s.Now != nil {
+		now = s.Now
+	}
+	name := filePrefix + now().UTC().Format("20060102T150405.000Z") + ".db"
+	if s.Options.Compress {
+		name += ".gz"
+	}
+	result, err := Backup(ctx, s.Client, filepath.Join(s.Dir, name), s.Options)
+	if err != nil {
+		return result, nil, err
+	}
+	pruned, err := s.prune()
+	return result, pruned, err
+}
+
+// prune removes the oldest scheduled backups beyond Keep. Names sort by time.
+func (s *Scheduler) prune() ([]string, error) {
+	keep := s.Keep
+	if keep <= 0 {
+		keep = DefaultKeep
+	}
+	entries, err := os.ReadDir(s.Dir)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.Type().IsRegular() && strings.HasPrefix(name, filePrefix) && (strings.HasSuffix(name, ".db") || strings.HasSuffix(name, ".db.gz")) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	var pruned []string
+	for len(names) > keep {
+		path := filepath.Join(s.Dir, names[0])
+		if err := os.Remove(path); err != nil {
+			return pruned, fmt.Errorf("prune backup: %w", err)
+		}
+		pruned = append(pruned, path)
+		names = names[1:]
+	}
+	return pruned, nil
+}
@@ -14,7 +14,12 @@ const envPrefix = "DUPLYNX"
 
 // RuntimeConfig captures the shared configuration required by DupLynx CLI commands.
 type RuntimeConfig struct {
-	DBFile    string
+	// DBDriver selects the store: "sqlite" (default) or "postgres".
+	DBDriver string
+	// DBDSN is the Postgres connection string; for SQLite it overrides the DSN built from DBFile.
+	DBDSN  string
+	DBFile string
+	// AssetsDir overrides the embedded static bundle when set.
 	AssetsDir string
 	Addr      string
 	LogLevel  string
@@ -23,10 +28,10 @@ type RuntimeConfig struct {
 // DefaultRuntimeConfig returns the baseline configuration before flags or environment overrides.
 func DefaultRuntimeConfig() RuntimeConfig {
 	return RuntimeConfig{
-		DBFile:    "var/duplynx.db",
-		AssetsDir: "backend/web/dist",
-		Addr:      "0.0.0.0:8080",
-		LogLevel:  "info",
+		DBDriver: "sqlite",
+		DBFile:   "var/duplynx.db",
+		Addr:     "0.0.0.0:8080",
+		LogLevel: "info",
 	}
 }
 
@@ -36,8 +41,10 @@ func BindRuntimeFlags(flagSet *pflag.FlagSet, cfg *RuntimeConfig) {
 		return
 	}
 
+	flagSet.StringVar(&cfg.DBDriver, "db-driver", cfg.DBDriver, "Database driver (sqlite or postgres)")
+	flagSet.StringVar(&cfg.DBDSN, "db-dsn", cfg.DBDSN, "Database connection string; required for postgres")
 	flagSet.StringVar(&cfg.DBFile, "db-file", cfg.DBFile, "Path to the SQLite database file")
-	flagSet.StringVar(&cfg.AssetsDir, "assets-dir", cfg.AssetsDir, "Directory containing precompiled static assets")
+	flagSet.StringVar(&cfg.AssetsDir, "assets-dir", cfg.AssetsDir, "Directory of built static assets to serve instead of the embedded bundle")
 	flagSet.StringVar(&cfg.Addr, "addr", cfg.Addr, "Address for the HTTP server to bind")
 	flagSet.StringVar(&cfg.LogLevel, "log-level", cfg.LogLevel, "Logging level for CLI output (debug, info, warn, error)")
 }
@@ -60,12 +67,26 @@ func (cfg *RuntimeConfig) ApplyEnvOverrides(flagSet *pflag.FlagSet) {
 		}
 	}
 
+	apply("db-driver", "DB_DRIVER", &cfg.DBDriver)
+	apply("db-dsn", "DB_DSN", &cfg.DBDSN)
 	apply("db-file", "DB_FILE", &cfg.DBFile)
 	apply("assets-dir", "ASSETS_DIR", &cfg.AssetsDir)
 	apply("addr", "ADDR", &cfg.Addr)
 	apply("log-level", "LOG_LEVEL", &cfg.LogLevel)
 }
 
+// SQLiteFile reports the SQLite database file the DSN is built from. It is
+// false for Postgres and for an explicit DSN.
+func (cfg RuntimeConfig) SQLiteFile() (string, bool) {
+	if strings.TrimSpace(cfg.DBDSN) != "" || strings.EqualFold(strings.TrimSpace(cfg.DBDriver), "postgres") {
+		return "", false
+	}
+	if strings.TrimSpace(cfg.DBFile) == "" {
+		return DefaultRuntimeConfig().DBFile, true
+	}
+	return cfg.DBFile, true
+}
+
 // SQLiteDSN constructs the SQLite DSN with sensible defaults.
 func (cfg RuntimeConfig) SQLiteDSN() string {
 	path := cfg.DBFile
@@ -76,6 +97,17 @@ func (cfg RuntimeConfig) SQLiteDSN() string {
 	return fmt.Sprintf("file:%s?_busy_timeout=5000&_foreign_keys=1", path)
 }
 
+// DatabaseDSN returns the connection string for the configured driver.
+func (cfg RuntimeConfig) DatabaseDSN() string {
+	if dsn := strings.TrimSpace(cfg.DBDSN); dsn != "" {
+		return dsn
+	}
+	if strings.EqualFold(strings.TrimSpace(cfg.DBDriver), "postgres") {
+		return ""
+	}
+	return cfg.SQLiteDSN()
+}
+
 type contextKey struct{}
 
 // WithRuntimeConfig embeds the configuration into a context so subcommands can retrieve it.
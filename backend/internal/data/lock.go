@@ -0,0 +1,51 @@
+package data
+
+import (
+	"errors"
+	"fmt"
+	"os"
+)
+
+// ErrDatabaseInUse means another process holds the SQLite database's lock
+// file, usually a running serve.
+var ErrDatabaseInUse = errors.New("database is in use by another duplynx process")
+
+// DatabaseLock is an advisory lock on <db>.lock next to a SQLite database.
+// serve holds it shared for as long as it runs, so several read-only
+// replicas can share a file, and `db restore` takes it exclusively before
+// replacing the database. SQLite's own locks cannot serve this purpose
+// because an idle connection holds none.
+type DatabaseLock struct {
+	file *os.File
+}
+
+// LockDatabase takes the lock for the SQLite database at dbFile without
+// waiting. It returns ErrDatabaseInUse when a conflicting lock is held. On
+// platforms without flock the lock always succeeds.
+func LockDatabase(dbFile string, exclusive bool) (*DatabaseLock, error) {
+	if err := ensureDirectory(dbFile); err != nil {
+		return nil, err
+	}
+	file, err := os.OpenFile(dbFile+".lock", os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("open database lock: %w", err)
+	}
+	if err := lockFile(file, exclusive); err != nil {
+		_ = file.Close()
+		if errors.Is(err, errLockHeld) {
+			return nil, fmt.Errorf("%w: %s", ErrDatabaseInUse, dbFile)
+		}
+		return nil, fmt.Errorf("lock database: %w", err)
+	}
+	return &DatabaseLock{file: file}, nil
+}
+
+// Release drops the lock. The lock file is left in place for the next holder.
+func (l *DatabaseLock) Release() error {
+	if l == nil || l.file == nil {
+		return nil
+	}
+	err := l.file.Close()
+	l.file = nil
+	return err
+}
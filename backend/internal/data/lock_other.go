@@ -0,0 +1,15 @@
+//go:build !(darwin || dragonfly || freebsd || linux || netbsd || openbsd)
+
+package data
+
+import (
+	"errors"
+	"os"
+)
+
+var errLockHeld = errors.New("lock held")
+
+// lockFile is a no-op where flock is unavailable; stop serve by hand before a restore.
+func lockFile(*os.File, bool) error {
+	return nil
+}
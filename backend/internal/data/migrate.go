@@ -0,0 +1,417 @@
+package data
+
+import (
+	"context"
+	"crypto/sha256"
+	stdsql "database/sql"
+	"embed"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	atlas "ariga.io/atlas/sql/migrate"
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+
+	"github.com/mcmx/duplynx/ent"
+)
+
+// Versioned migrations are generated from the Ent schema by
+// ent/migrate/main.go, one directory per dialect in golang-migrate layout
+// (<version>_<name>.up.sql and .down.sql) with an Atlas atlas.sum file.
+//
+//go:embed migrations/sqlite migrations/postgres
+var migrationFiles embed.FS
+
+// historyTable records the migrations applied to a database.
+const historyTable = "schema_migrations"
+
+var (
+	// ErrSchemaBehind means migrations shipped with this build have not been applied.
+	ErrSchemaBehind = errors.New("database schema is behind this build; run `duplynx db migrate up`")
+	// ErrSchemaAhead means the database has migrations this build does not know about.
+	ErrSchemaAhead = errors.New("database schema is ahead of this build; upgrade duplynx or run `duplynx db migrate down` with the newer build")
+	// ErrChecksumMismatch means an applied migration differs from its file.
+	ErrChecksumMismatch = errors.New("applied migration does not match its file")
+	// ErrUnversionedSchema means the database has tables but no migration history.
+	ErrUnversionedSchema = errors.New("database has tables but no migration history; run `duplynx db migrate up --baseline <version>`")
+	// ErrUnknownMigration is returned when a baseline names a version this build does not ship.
+	ErrUnknownMigration = errors.New("unknown migration version")
+)
+
+// Migration is one versioned schema change.
+type Migration struct {
+	Version string
+	Name    string
+	Up      string
+	Down    string
+	// Checksum is the hex SHA-256 of Up, recorded when the migration is applied.
+	Checksum string
+}
+
+// AppliedMigration is a row of the migration history.
+type AppliedMigration struct {
+	Version   string
+	Name      string
+	Checksum  string
+	AppliedAt time.Time
+}
+
+// MigrationStatus compares a database's history with the migrations in this build.
+type MigrationStatus struct {
+	Dialect string
+	Applied []AppliedMigration
+	Pending []Migration
+	// Unknown lists applied versions this build has no file for.
+	Unknown []AppliedMigration
+	// Modified lists applied versions whose recorded checksum differs from the file.
+	Modified []AppliedMigration
+	// Unversioned reports application tables without any migration history.
+	Unversioned bool
+	Latest      string
+}
+
+// Current returns the last applied version, or "" for an empty database.
+func (s MigrationStatus) Current() string {
+	if len(s.Applied) == 0 {
+		return ""
+	}
+	return s.Applied[len(s.Applied)-1].Version
+}
+
+// Err explains why the database cannot be served by this build, or returns nil
+// when every migration is applied unchanged.
+func (s MigrationStatus) Err() error {
+	switch {
+	case len(s.Modified) > 0:
+		return fmt.Errorf("%w: %s", ErrChecksumMismatch, s.Modified[0].Version)
+	case len(s.Unknown) > 0:
+		return fmt.Errorf("%w (at %s, this build ends at %s)", ErrSchemaAhead, s.Current(), s.Latest)
+	case s.Unversioned:
+		return ErrUnversionedSchema
+	case len(s.Pending) > 0:
+		return fmt.Errorf("%w (%d pending, latest %s)", ErrSchemaBehind, len(s.Pending), s.Latest)
+	}
+	return nil
+}
+
+// Migrations returns the dialect's migrations in version order after checking
+// the directory against its atlas.sum, so hand-edited files are caught.
+func Migrations(dialectName string) ([]Migration, error) {
+	dir := "migrations/sqlite"
+	if dialectName == dialect.Postgres {
+		dir = "migrations/postgres"
+	}
+	sub, err := fs.Sub(migrationFiles, dir)
+	if err != nil {
+		return nil, err
+	}
+	names, err := fs.Glob(sub, "*.sql")
+	if err != nil {
+		return nil, err
+	}
+	mem := &atlas.MemDir{}
+	byVersion := make(map[string]*Migration)
+	for _, name := range append(names, atlas.HashFileName) {
+		content, err := fs.ReadFile(sub, name)
+		if err != nil {
+			return nil, fmt.Errorf("read migration %s: %w", name, err)
+		}
+		if err := mem.WriteFile(name, content); err != nil {
+			return nil, err
+		}
+		if name == atlas.HashFileName {
+			continue
+		}
+		base, direction := strings.TrimSuffix(name, ".sql"), ""
+		if ext := path.Ext(base); ext == ".up" || ext == ".down" {
+			base, direction = strings.TrimSuffix(base, ext), ext
+		}
+		version, label, _ := strings.Cut(base, "_")
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: label}
+			byVersion[version] = m
+		}
+		switch direction {
+		case ".up":
+			m.Up = string(content)
+			sum := sha256.Sum256(content)
+			m.Checksum = hex.EncodeToString(sum[:])
+		case ".down":
+			m.Down = string(content)
+		default:
+			return nil, fmt.Errorf("migration %s is neither .up.sql nor .down.sql", name)
+		}
+	}
+	if err := atlas.Validate(mem); err != nil {
+		return nil, fmt.Errorf("verify %s migrations: %w", dir, err)
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" {
+			return nil, fmt.Errorf("migration %s has no up file", m.Version)
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// SchemaStatus reads the migration history and compares it with this build.
+func SchemaStatus(ctx context.Context, client *ent.Client) (MigrationStatus, error) {
+	if client == nil {
+		return MigrationStatus{}, errors.New("ent client is nil")
+	}
+	status := MigrationStatus{Dialect: client.Dialect()}
+	migrations, err := Migrations(status.Dialect)
+	if err != nil {
+		return status, err
+	}
+	if len(migrations) > 0 {
+		status.Latest = migrations[len(migrations)-1].Version
+	}
+	if err := ensureHistory(ctx, client); err != nil {
+		return status, err
+	}
+	status.Applied, err = appliedMigrations(ctx, client)
+	if err != nil {
+		return status, err
+	}
+
+	applied := make(map[string]AppliedMigration, len(status.Applied))
+	for _, row := range status.Applied {
+		applied[row.Version] = row
+	}
+	known := make(map[string]bool, len(migrations))
+	for _, m := range migrations {
+		known[m.Version] = true
+		row, ok := applied[m.Version]
+		switch {
+		case !ok:
+			status.Pending = append(status.Pending, m)
+		case row.Checksum != m.Checksum:
+			status.Modified = append(status.Modified, row)
+		}
+	}
+	for _, row := range status.Applied {
+		if !known[row.Version] {
+			status.Unknown = append(status.Unknown, row)
+		}
+	}
+	if len(status.Applied) == 0 {
+		status.Unversioned, err = hasApplicationTables(ctx, client)
+		if err != nil {
+			return status, err
+		}
+	}
+	return status, nil
+}
+
+// CheckSchema returns an error unless the database is exactly at this build's
+// latest migration. serve and the maintenance commands refuse to run otherwise.
+func CheckSchema(ctx context.Context, client *ent.Client) error {
+	status, err := SchemaStatus(ctx, client)
+	if err != nil {
+		return err
+	}
+	return status.Err()
+}
+
+// MigrateUp applies up to steps pending migrations (all when steps <= 0) in
+// order and returns the ones applied. It refuses databases that are ahead,
+// modified, or unversioned.
+func MigrateUp(ctx context.Context, client *ent.Client, steps int) ([]Migration, error) {
+	status, err := SchemaStatus(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	if err := status.Err(); err != nil && !errors.Is(err, ErrSchemaBehind) {
+		return nil, err
+	}
+	pending := status.Pending
+	if steps > 0 && steps < len(pending) {
+		pending = pending[:steps]
+	}
+	for i, m := range pending {
+		if err := applyMigration(ctx, client, m.Up, func(exec execer) error { return recordMigration(ctx, exec, client.Dialect(), m) }); err != nil {
+			return pending[:i], fmt.Errorf("apply migration %s_%s: %w", m.Version, m.Name, err)
+		}
+	}
+	return pending, nil
+}
+
+// MigrateDown reverts the last steps applied migrations (at least one) and
+// returns them, newest first.
+func MigrateDown(ctx context.Context, client *ent.Client, steps int) ([]Migration, error) {
+	status, err := SchemaStatus(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	if len(status.Modified) > 0 || len(status.Unknown) > 0 {
+		// Reverting needs the down files the database was migrated with.
+		return nil, status.Err()
+	}
+	migrations, err := Migrations(status.Dialect)
+	if err != nil {
+		return nil, err
+	}
+	byVersion := make(map[string]Migration, len(migrations))
+	for _, m := range migrations {
+		byVersion[m.Version] = m
+	}
+	if steps <= 0 {
+		steps = 1
+	}
+	var reverted []Migration
+	for i := len(status.Applied) - 1; i >= 0 && len(reverted) < steps; i-- {
+		m := byVersion[status.Applied[i].Version]
+		if strings.TrimSpace(m.Down) == "" {
+			return reverted, fmt.Errorf("migration %s_%s has no down file", m.Version, m.Name)
+		}
+		if err := applyMigration(ctx, client, m.Down, func(exec execer) error { return forgetMigration(ctx, exec, client.Dialect(), m.Version) }); err != nil {
+			return reverted, fmt.Errorf("revert migration %s_%s: %w", m.Version, m.Name, err)
+		}
+		reverted = append(reverted, m)
+	}
+	return reverted, nil
+}
+
+// BaselineMigrations records every migration up to and including version as
+// applied without running it. It adopts databases created before versioned
+// migrations, whose tables already match that version.
+func BaselineMigrations(ctx context.Context, client *ent.Client, version string) ([]Migration, error) {
+	status, err := SchemaStatus(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	if len(status.Applied) > 0 {
+		return nil, fmt.Errorf("database already has migration history (at %s)", status.Current())
+	}
+	index := -1
+	for i, m := range status.Pending {
+		if m.Version == version {
+			index = i
+		}
+	}
+	if index < 0 {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownMigration, version)
+	}
+	baseline := status.Pending[:index+1]
+	for _, m := range baseline {
+		if err := recordMigration(ctx, client, client.Dialect(), m); err != nil {
+			return nil, err
+		}
+	}
+	return baseline, nil
+}
+
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error)
+}
+
+// applyMigration runs a migration script and its history update in one
+// transaction. SQLite ignores PRAGMA foreign_keys inside a transaction, so
+// Atlas's table rebuilds that toggle it run on the connection directly.
+func applyMigration(ctx context.Context, client *ent.Client, script string, record func(execer) error) error {
+	if client.Dialect() == dialect.SQLite && strings.Contains(script, "PRAGMA foreign_keys") {
+		if _, err := client.ExecContext(ctx, script); err != nil {
+			return err
+		}
+		return record(client)
+	}
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, script); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if err := record(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func ensureHistory(ctx context.Context, client *ent.Client) error {
+	_, err := client.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS `+historyTable+` (
+		version VARCHAR(32) NOT NULL PRIMARY KEY,
+		name VARCHAR(255) NOT NULL,
+		checksum VARCHAR(64) NOT NULL,
+		applied_at VARCHAR(64) NOT NULL
+	)`)
+	if err != nil {
+		return fmt.Errorf("create migration history: %w", err)
+	}
+	return nil
+}
+
+func appliedMigrations(ctx context.Context, client *ent.Client) ([]AppliedMigration, error) {
+	query, args := entsql.Dialect(client.Dialect()).
+		Select("version", "name", "checksum", "applied_at").
+		From(entsql.Table(historyTable)).
+		OrderBy("version").
+		Query()
+	rows, err := client.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("read migration history: %w", err)
+	}
+	defer rows.Close()
+	var applied []AppliedMigration
+	for rows.Next() {
+		var row AppliedMigration
+		var appliedAt string
+		if err := rows.Scan(&row.Version, &row.Name, &row.Checksum, &appliedAt); err != nil {
+			return nil, fmt.Errorf("scan migration history: %w", err)
+		}
+		row.AppliedAt, _ = time.Parse(time.RFC3339Nano, appliedAt)
+		applied = append(applied, row)
+	}
+	return applied, rows.Err()
+}
+
+func recordMigration(ctx context.Context, exec execer, dialectName string, m Migration) error {
+	query, args := entsql.Dialect(dialectName).
+		Insert(historyTable).
+		Columns("version", "name", "checksum", "applied_at").
+		Values(m.Version, m.Name, m.Checksum, time.Now().UTC().Format(time.RFC3339Nano)).
+		Query()
+	if _, err := exec.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("record migration %s: %w", m.Version, err)
+	}
+	return nil
+}
+
+func forgetMigration(ctx context.Context, exec execer, dialectName, version string) error {
+	query, args := entsql.Dialect(dialectName).
+		Delete(historyTable).
+		Where(entsql.EQ("version", version)).
+		Query()
+	if _, err := exec.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("forget migration %s: %w", version, err)
+	}
+	return nil
+}
+
+// hasApplicationTables reports whether the tenants table exists, i.e. the
+// schema was created before migrations were versioned.
+func hasApplicationTables(ctx context.Context, client *ent.Client) (bool, error) {
+	query := `SELECT 1 FROM sqlite_master WHERE type = 'table' AND name = 'tenants'`
+	if client.Dialect() == dialect.Postgres {
+		query = `SELECT 1 FROM information_schema.tables WHERE table_schema = current_schema() AND table_name = 'tenants'`
+	}
+	rows, err := client.QueryContext(ctx, query)
+	if err != nil {
+		return false, fmt.Errorf("inspect schema: %w", err)
+	}
+	defer rows.Close()
+	return rows.Next(), rows.Err()
+}
@@ -0,0 +1,20 @@
+-- create "tenants" table
+CREATE TABLE "tenants" ("id" uuid NOT NULL, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "slug" character varying NOT NULL, "name" character varying NOT NULL, "description" character varying NULL, "primary_contact" character varying NULL, "archived_at" timestamptz NULL, "quota_manifest_bytes" bigint NULL, "quota_ingest_per_minute" bigint NULL, "quota_machines" bigint NULL, "quota_retained_scans" bigint NULL, "quota_concurrent_actions" bigint NULL, PRIMARY KEY ("id"));
+-- create index "tenants_slug_key" to table: "tenants"
+CREATE UNIQUE INDEX "tenants_slug_key" ON "tenants" ("slug");
+-- create "tenant_tombstones" table
+CREATE TABLE "tenant_tombstones" ("id" uuid NOT NULL, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "tenant_id" uuid NOT NULL, "tenant_slug" character varying NOT NULL, "tenant_name" character varying NOT NULL, "actor" character varying NOT NULL DEFAULT 'system', "purged_at" timestamptz NOT NULL, "deleted_rows" jsonb NULL, PRIMARY KEY ("id"));
+-- create "machines" table
+CREATE TABLE "machines" ("id" uuid NOT NULL, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "name" character varying NOT NULL, "category" character varying NOT NULL, "hostname" character varying NULL, "role" character varying NULL, "last_scan_at" timestamptz NULL, "archived_at" timestamptz NULL, "tenant_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "machines_tenants_machines" FOREIGN KEY ("tenant_id") REFERENCES "tenants" ("id") ON DELETE NO ACTION);
+-- create "scans" table
+CREATE TABLE "scans" ("id" uuid NOT NULL, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "name" character varying NOT NULL, "description" character varying NULL, "started_at" timestamptz NOT NULL, "completed_at" timestamptz NULL, "duplicate_group_count" bigint NOT NULL, "initiated_machine_id" uuid NULL, "tenant_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "scans_machines_initiated_scans" FOREIGN KEY ("initiated_machine_id") REFERENCES "machines" ("id") ON DELETE SET NULL, CONSTRAINT "scans_tenants_scans" FOREIGN KEY ("tenant_id") REFERENCES "tenants" ("id") ON DELETE NO ACTION);
+-- create "duplicate_groups" table
+CREATE TABLE "duplicate_groups" ("id" uuid NOT NULL, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "hash" character varying NOT NULL, "status" character varying NOT NULL DEFAULT 'review', "file_count" bigint NOT NULL, "total_size_bytes" bigint NOT NULL, "keeper_machine_id" uuid NULL, "scan_id" uuid NOT NULL, "tenant_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "duplicate_groups_machines_keeper_groups" FOREIGN KEY ("keeper_machine_id") REFERENCES "machines" ("id") ON DELETE SET NULL, CONSTRAINT "duplicate_groups_scans_duplicate_groups" FOREIGN KEY ("scan_id") REFERENCES "scans" ("id") ON DELETE NO ACTION, CONSTRAINT "duplicate_groups_tenants_duplicate_groups" FOREIGN KEY ("tenant_id") REFERENCES "tenants" ("id") ON DELETE NO ACTION);
+-- create "action_audits" table
+CREATE TABLE "action_audits" ("id" uuid NOT NULL, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "actor" character varying NOT NULL DEFAULT 'system', "action_type" character varying NOT NULL, "payload" jsonb NULL, "performed_at" timestamptz NOT NULL, "stubbed" boolean NOT NULL DEFAULT false, "duplicate_group_id" uuid NOT NULL, "tenant_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "action_audits_duplicate_groups_action_audits" FOREIGN KEY ("duplicate_group_id") REFERENCES "duplicate_groups" ("id") ON DELETE NO ACTION, CONSTRAINT "action_audits_tenants_action_audits" FOREIGN KEY ("tenant_id") REFERENCES "tenants" ("id") ON DELETE NO ACTION);
+-- create "file_instances" table
+CREATE TABLE "file_instances" ("id" uuid NOT NULL, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "path" character varying NOT NULL, "size_bytes" bigint NOT NULL, "checksum" character varying NOT NULL, "last_seen_at" timestamptz NOT NULL, "quarantined" boolean NOT NULL DEFAULT false, "duplicate_group_id" uuid NOT NULL, "machine_id" uuid NOT NULL, "tenant_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "file_instances_duplicate_groups_file_instances" FOREIGN KEY ("duplicate_group_id") REFERENCES "duplicate_groups" ("id") ON DELETE NO ACTION, CONSTRAINT "file_instances_machines_file_instances" FOREIGN KEY ("machine_id") REFERENCES "machines" ("id") ON DELETE NO ACTION, CONSTRAINT "file_instances_tenants_file_instances" FOREIGN KEY ("tenant_id") REFERENCES "tenants" ("id") ON DELETE NO ACTION);
+-- create "tenant_secrets" table
+CREATE TABLE "tenant_secrets" ("id" uuid NOT NULL, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "key_id" character varying NOT NULL, "secret" character varying NOT NULL, "not_before" timestamptz NOT NULL, "expires_at" timestamptz NULL, "revoked_at" timestamptz NULL, "tenant_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "tenant_secrets_tenants_secrets" FOREIGN KEY ("tenant_id") REFERENCES "tenants" ("id") ON DELETE NO ACTION);
+-- create index "tenantsecret_tenant_id_key_id" to table: "tenant_secrets"
+CREATE UNIQUE INDEX "tenantsecret_tenant_id_key_id" ON "tenant_secrets" ("tenant_id", "key_id");
@@ -0,0 +1,4 @@
+-- reverse: modify "tenants" table
+ALTER TABLE "tenants" DROP COLUMN "retention_archive_after_days", DROP COLUMN "retention_keep_scans";
+-- reverse: modify "scans" table
+ALTER TABLE "scans" DROP COLUMN "files_pruned_at";
@@ -0,0 +1,4 @@
+-- modify "scans" table
+ALTER TABLE "scans" ADD COLUMN "files_pruned_at" timestamptz NULL;
+-- modify "tenants" table
+ALTER TABLE "tenants" ADD COLUMN "retention_keep_scans" bigint NULL, ADD COLUMN "retention_archive_after_days" bigint NULL;
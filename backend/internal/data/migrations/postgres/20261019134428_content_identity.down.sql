@@ -0,0 +1,10 @@
+-- reverse: modify "duplicate_groups" table
+ALTER TABLE "duplicate_groups" DROP CONSTRAINT "duplicate_groups_content_identities_duplicate_groups", DROP COLUMN "content_identity_id";
+-- reverse: create index "contentevent_content_identity_id_occurred_at" to table: "content_events"
+DROP INDEX "contentevent_content_identity_id_occurred_at";
+-- reverse: create "content_events" table
+DROP TABLE "content_events";
+-- reverse: create index "contentidentity_tenant_id_hash" to table: "content_identities"
+DROP INDEX "contentidentity_tenant_id_hash";
+-- reverse: create "content_identities" table
+DROP TABLE "content_identities";
@@ -0,0 +1,10 @@
+-- create "content_identities" table
+CREATE TABLE "content_identities" ("id" uuid NOT NULL, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "hash" character varying NOT NULL, "resolved_at" timestamptz NULL, "first_seen_at" timestamptz NOT NULL, "last_seen_at" timestamptz NOT NULL, "keeper_machine_id" uuid NULL, "tenant_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "content_identities_machines_kept_identities" FOREIGN KEY ("keeper_machine_id") REFERENCES "machines" ("id") ON DELETE SET NULL, CONSTRAINT "content_identities_tenants_content_identities" FOREIGN KEY ("tenant_id") REFERENCES "tenants" ("id") ON DELETE NO ACTION);
+-- create index "contentidentity_tenant_id_hash" to table: "content_identities"
+CREATE UNIQUE INDEX "contentidentity_tenant_id_hash" ON "content_identities" ("tenant_id", "hash");
+-- create "content_events" table
+CREATE TABLE "content_events" ("id" uuid NOT NULL, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "path" character varying NOT NULL, "kind" character varying NOT NULL, "occurred_at" timestamptz NOT NULL, "content_identity_id" uuid NOT NULL, "machine_id" uuid NOT NULL, "scan_id" uuid NOT NULL, "tenant_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "content_events_content_identities_events" FOREIGN KEY ("content_identity_id") REFERENCES "content_identities" ("id") ON DELETE NO ACTION, CONSTRAINT "content_events_machines_content_events" FOREIGN KEY ("machine_id") REFERENCES "machines" ("id") ON DELETE NO ACTION, CONSTRAINT "content_events_scans_content_events" FOREIGN KEY ("scan_id") REFERENCES "scans" ("id") ON DELETE NO ACTION, CONSTRAINT "content_events_tenants_content_events" FOREIGN KEY ("tenant_id") REFERENCES "tenants" ("id") ON DELETE NO ACTION);
+-- create index "contentevent_content_identity_id_occurred_at" to table: "content_events"
+CREATE INDEX "contentevent_content_identity_id_occurred_at" ON "content_events" ("content_identity_id", "occurred_at");
+-- modify "duplicate_groups" table
+ALTER TABLE "duplicate_groups" ADD COLUMN "content_identity_id" uuid NULL, ADD CONSTRAINT "duplicate_groups_content_identities_duplicate_groups" FOREIGN KEY ("content_identity_id") REFERENCES "content_identities" ("id") ON DELETE SET NULL;
@@ -0,0 +1,2 @@
+-- reverse: modify "scans" table
+ALTER TABLE "scans" DROP COLUMN "last_heartbeat_at", DROP COLUMN "machines_reported", DROP COLUMN "bytes_hashed", DROP COLUMN "files_seen", DROP COLUMN "status_message", DROP COLUMN "status";
@@ -0,0 +1,4 @@
+-- modify "scans" table
+ALTER TABLE "scans" ADD COLUMN "status" character varying NOT NULL DEFAULT 'pending', ADD COLUMN "status_message" character varying NULL, ADD COLUMN "files_seen" bigint NOT NULL DEFAULT 0, ADD COLUMN "bytes_hashed" bigint NOT NULL DEFAULT 0, ADD COLUMN "machines_reported" bigint NOT NULL DEFAULT 0, ADD COLUMN "last_heartbeat_at" timestamptz NULL;
+-- scans recorded before lifecycle tracking either finished or are still running
+UPDATE "scans" SET "status" = CASE WHEN "completed_at" IS NULL THEN 'running' ELSE 'completed' END;
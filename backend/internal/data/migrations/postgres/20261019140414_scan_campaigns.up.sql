@@ -0,0 +1,8 @@
+-- modify "scans" table
+ALTER TABLE "scans" ADD COLUMN "deadline" timestamptz NULL;
+-- create "scan_targets" table
+CREATE TABLE "scan_targets" ("id" uuid NOT NULL, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "roots" jsonb NOT NULL, "status" character varying NOT NULL DEFAULT 'pending', "status_message" character varying NULL, "claimed_at" timestamptz NULL, "reported_at" timestamptz NULL, "machine_id" uuid NOT NULL, "scan_id" uuid NOT NULL, "tenant_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "scan_targets_machines_scan_targets" FOREIGN KEY ("machine_id") REFERENCES "machines" ("id") ON DELETE NO ACTION, CONSTRAINT "scan_targets_scans_targets" FOREIGN KEY ("scan_id") REFERENCES "scans" ("id") ON DELETE NO ACTION, CONSTRAINT "scan_targets_tenants_scan_targets" FOREIGN KEY ("tenant_id") REFERENCES "tenants" ("id") ON DELETE NO ACTION);
+-- create index "scantarget_scan_id_machine_id" to table: "scan_targets"
+CREATE UNIQUE INDEX "scantarget_scan_id_machine_id" ON "scan_targets" ("scan_id", "machine_id");
+-- create index "scantarget_machine_id_status" to table: "scan_targets"
+CREATE INDEX "scantarget_machine_id_status" ON "scan_targets" ("machine_id", "status");
@@ -0,0 +1,10 @@
+-- reverse: create index "scanschedulerun_schedule_id_scheduled_for" to table: "scan_schedule_runs"
+DROP INDEX "scanschedulerun_schedule_id_scheduled_for";
+-- reverse: create "scan_schedule_runs" table
+DROP TABLE "scan_schedule_runs";
+-- reverse: create index "scanschedule_enabled_next_run_at" to table: "scan_schedules"
+DROP INDEX "scanschedule_enabled_next_run_at";
+-- reverse: create "scan_schedules" table
+DROP TABLE "scan_schedules";
+-- reverse: modify "scan_targets" table
+ALTER TABLE "scan_targets" DROP COLUMN "exclusions";
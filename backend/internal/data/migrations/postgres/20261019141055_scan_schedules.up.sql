@@ -0,0 +1,10 @@
+-- modify "scan_targets" table
+ALTER TABLE "scan_targets" ADD COLUMN "exclusions" jsonb NULL;
+-- create "scan_schedules" table
+CREATE TABLE "scan_schedules" ("id" uuid NOT NULL, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "name" character varying NOT NULL, "cron" character varying NOT NULL, "timezone" character varying NOT NULL DEFAULT 'UTC', "machine_ids" jsonb NOT NULL, "roots" jsonb NOT NULL, "exclusions" jsonb NULL, "deadline_seconds" bigint NOT NULL DEFAULT 0, "enabled" boolean NOT NULL DEFAULT true, "next_run_at" timestamptz NOT NULL, "last_run_at" timestamptz NULL, "last_scan_id" uuid NULL, "tenant_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "scan_schedules_tenants_scan_schedules" FOREIGN KEY ("tenant_id") REFERENCES "tenants" ("id") ON DELETE NO ACTION);
+-- create index "scanschedule_enabled_next_run_at" to table: "scan_schedules"
+CREATE INDEX "scanschedule_enabled_next_run_at" ON "scan_schedules" ("enabled", "next_run_at");
+-- create "scan_schedule_runs" table
+CREATE TABLE "scan_schedule_runs" ("id" uuid NOT NULL, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "scheduled_for" timestamptz NOT NULL, "status" character varying NOT NULL, "scan_id" uuid NULL, "reason" character varying NULL, "schedule_id" uuid NOT NULL, "tenant_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "scan_schedule_runs_scan_schedules_runs" FOREIGN KEY ("schedule_id") REFERENCES "scan_schedules" ("id") ON DELETE NO ACTION, CONSTRAINT "scan_schedule_runs_tenants_scan_schedule_runs" FOREIGN KEY ("tenant_id") REFERENCES "tenants" ("id") ON DELETE NO ACTION);
+-- create index "scanschedulerun_schedule_id_scheduled_for" to table: "scan_schedule_runs"
+CREATE INDEX "scanschedulerun_schedule_id_scheduled_for" ON "scan_schedule_runs" ("schedule_id", "scheduled_for");
@@ -0,0 +1,2 @@
+-- reverse: modify "machines" table
+ALTER TABLE "machines" DROP COLUMN "last_seen_at";
@@ -0,0 +1,2 @@
+-- modify "machines" table
+ALTER TABLE "machines" ADD COLUMN "last_seen_at" timestamptz NULL;
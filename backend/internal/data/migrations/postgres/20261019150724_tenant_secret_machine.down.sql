@@ -0,0 +1,2 @@
+-- reverse: modify "tenant_secrets" table
+ALTER TABLE "tenant_secrets" DROP CONSTRAINT "tenant_secrets_machines_secrets", DROP COLUMN "machine_id";
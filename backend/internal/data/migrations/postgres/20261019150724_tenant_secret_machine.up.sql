@@ -0,0 +1,2 @@
+-- modify "tenant_secrets" table
+ALTER TABLE "tenant_secrets" ADD COLUMN "machine_id" uuid NULL, ADD CONSTRAINT "tenant_secrets_machines_secrets" FOREIGN KEY ("machine_id") REFERENCES "machines" ("id") ON DELETE SET NULL;
@@ -0,0 +1,6 @@
+-- reverse: create index "actionjob_action_id" to table: "action_jobs"
+DROP INDEX "actionjob_action_id";
+-- reverse: create index "actionjob_machine_id_status" to table: "action_jobs"
+DROP INDEX "actionjob_machine_id_status";
+-- reverse: create "action_jobs" table
+DROP TABLE "action_jobs";
@@ -0,0 +1,20 @@
+-- reverse: create index "tenantsecret_tenant_id_key_id" to table: "tenant_secrets"
+DROP INDEX `tenantsecret_tenant_id_key_id`;
+-- reverse: create "tenant_secrets" table
+DROP TABLE `tenant_secrets`;
+-- reverse: create "file_instances" table
+DROP TABLE `file_instances`;
+-- reverse: create "action_audits" table
+DROP TABLE `action_audits`;
+-- reverse: create "duplicate_groups" table
+DROP TABLE `duplicate_groups`;
+-- reverse: create "scans" table
+DROP TABLE `scans`;
+-- reverse: create "machines" table
+DROP TABLE `machines`;
+-- reverse: create "tenant_tombstones" table
+DROP TABLE `tenant_tombstones`;
+-- reverse: create index "tenants_slug_key" to table: "tenants"
+DROP INDEX `tenants_slug_key`;
+-- reverse: create "tenants" table
+DROP TABLE `tenants`;
@@ -0,0 +1,20 @@
+-- create "action_audits" table
+CREATE TABLE `action_audits` (`id` uuid NOT NULL, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `actor` text NOT NULL DEFAULT ('system'), `action_type` text NOT NULL, `payload` json NULL, `performed_at` datetime NOT NULL, `stubbed` bool NOT NULL DEFAULT (false), `duplicate_group_id` uuid NOT NULL, `tenant_id` uuid NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `action_audits_duplicate_groups_action_audits` FOREIGN KEY (`duplicate_group_id`) REFERENCES `duplicate_groups` (`id`) ON DELETE NO ACTION, CONSTRAINT `action_audits_tenants_action_audits` FOREIGN KEY (`tenant_id`) REFERENCES `tenants` (`id`) ON DELETE NO ACTION);
+-- create "duplicate_groups" table
+CREATE TABLE `duplicate_groups` (`id` uuid NOT NULL, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `hash` text NOT NULL, `status` text NOT NULL DEFAULT ('review'), `file_count` integer NOT NULL, `total_size_bytes` integer NOT NULL, `keeper_machine_id` uuid NULL, `scan_id` uuid NOT NULL, `tenant_id` uuid NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `duplicate_groups_machines_keeper_groups` FOREIGN KEY (`keeper_machine_id`) REFERENCES `machines` (`id`) ON DELETE SET NULL, CONSTRAINT `duplicate_groups_scans_duplicate_groups` FOREIGN KEY (`scan_id`) REFERENCES `scans` (`id`) ON DELETE NO ACTION, CONSTRAINT `duplicate_groups_tenants_duplicate_groups` FOREIGN KEY (`tenant_id`) REFERENCES `tenants` (`id`) ON DELETE NO ACTION);
+-- create "file_instances" table
+CREATE TABLE `file_instances` (`id` uuid NOT NULL, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `path` text NOT NULL, `size_bytes` integer NOT NULL, `checksum` text NOT NULL, `last_seen_at` datetime NOT NULL, `quarantined` bool NOT NULL DEFAULT (false), `duplicate_group_id` uuid NOT NULL, `machine_id` uuid NOT NULL, `tenant_id` uuid NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `file_instances_duplicate_groups_file_instances` FOREIGN KEY (`duplicate_group_id`) REFERENCES `duplicate_groups` (`id`) ON DELETE NO ACTION, CONSTRAINT `file_instances_machines_file_instances` FOREIGN KEY (`machine_id`) REFERENCES `machines` (`id`) ON DELETE NO ACTION, CONSTRAINT `file_instances_tenants_file_instances` FOREIGN KEY (`tenant_id`) REFERENCES `tenants` (`id`) ON DELETE NO ACTION);
+-- create "machines" table
+CREATE TABLE `machines` (`id` uuid NOT NULL, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `name` text NOT NULL, `category` text NOT NULL, `hostname` text NULL, `role` text NULL, `last_scan_at` datetime NULL, `archived_at` datetime NULL, `tenant_id` uuid NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `machines_tenants_machines` FOREIGN KEY (`tenant_id`) REFERENCES `tenants` (`id`) ON DELETE NO ACTION);
+-- create "scans" table
+CREATE TABLE `scans` (`id` uuid NOT NULL, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `name` text NOT NULL, `description` text NULL, `started_at` datetime NOT NULL, `completed_at` datetime NULL, `duplicate_group_count` integer NOT NULL, `initiated_machine_id` uuid NULL, `tenant_id` uuid NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `scans_machines_initiated_scans` FOREIGN KEY (`initiated_machine_id`) REFERENCES `machines` (`id`) ON DELETE SET NULL, CONSTRAINT `scans_tenants_scans` FOREIGN KEY (`tenant_id`) REFERENCES `tenants` (`id`) ON DELETE NO ACTION);
+-- create "tenants" table
+CREATE TABLE `tenants` (`id` uuid NOT NULL, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `slug` text NOT NULL, `name` text NOT NULL, `description` text NULL, `primary_contact` text NULL, `archived_at` datetime NULL, `quota_manifest_bytes` integer NULL, `quota_ingest_per_minute` integer NULL, `quota_machines` integer NULL, `quota_retained_scans` integer NULL, `quota_concurrent_actions` integer NULL, PRIMARY KEY (`id`));
+-- create index "tenants_slug_key" to table: "tenants"
+CREATE UNIQUE INDEX `tenants_slug_key` ON `tenants` (`slug`);
+-- create "tenant_secrets" table
+CREATE TABLE `tenant_secrets` (`id` uuid NOT NULL, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `key_id` text NOT NULL, `secret` text NOT NULL, `not_before` datetime NOT NULL, `expires_at` datetime NULL, `revoked_at` datetime NULL, `tenant_id` uuid NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `tenant_secrets_tenants_secrets` FOREIGN KEY (`tenant_id`) REFERENCES `tenants` (`id`) ON DELETE NO ACTION);
+-- create index "tenantsecret_tenant_id_key_id" to table: "tenant_secrets"
+CREATE UNIQUE INDEX `tenantsecret_tenant_id_key_id` ON `tenant_secrets` (`tenant_id`, `key_id`);
+-- create "tenant_tombstones" table
+CREATE TABLE `tenant_tombstones` (`id` uuid NOT NULL, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `tenant_id` uuid NOT NULL, `tenant_slug` text NOT NULL, `tenant_name` text NOT NULL, `actor` text NOT NULL DEFAULT ('system'), `purged_at` datetime NOT NULL, `deleted_rows` json NULL, PRIMARY KEY (`id`));
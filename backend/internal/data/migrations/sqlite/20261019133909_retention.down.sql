@@ -0,0 +1,6 @@
+-- reverse: add column "retention_archive_after_days" to table: "tenants"
+ALTER TABLE `tenants` DROP COLUMN `retention_archive_after_days`;
+-- reverse: add column "retention_keep_scans" to table: "tenants"
+ALTER TABLE `tenants` DROP COLUMN `retention_keep_scans`;
+-- reverse: add column "files_pruned_at" to table: "scans"
+ALTER TABLE `scans` DROP COLUMN `files_pruned_at`;
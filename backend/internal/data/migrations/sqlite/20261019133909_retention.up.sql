@@ -0,0 +1,6 @@
+-- add column "files_pruned_at" to table: "scans"
+ALTER TABLE `scans` ADD COLUMN `files_pruned_at` datetime NULL;
+-- add column "retention_keep_scans" to table: "tenants"
+ALTER TABLE `tenants` ADD COLUMN `retention_keep_scans` integer NULL;
+-- add column "retention_archive_after_days" to table: "tenants"
+ALTER TABLE `tenants` ADD COLUMN `retention_archive_after_days` integer NULL;
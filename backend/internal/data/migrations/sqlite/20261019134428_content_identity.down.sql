@@ -0,0 +1,17 @@
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- reverse: create "new_duplicate_groups" table
+CREATE TABLE `new_duplicate_groups` (`id` uuid NOT NULL, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `hash` text NOT NULL, `status` text NOT NULL DEFAULT ('review'), `file_count` integer NOT NULL, `total_size_bytes` integer NOT NULL, `keeper_machine_id` uuid NULL, `scan_id` uuid NOT NULL, `tenant_id` uuid NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `duplicate_groups_machines_keeper_groups` FOREIGN KEY (`keeper_machine_id`) REFERENCES `machines` (`id`) ON DELETE SET NULL, CONSTRAINT `duplicate_groups_scans_duplicate_groups` FOREIGN KEY (`scan_id`) REFERENCES `scans` (`id`) ON DELETE NO ACTION, CONSTRAINT `duplicate_groups_tenants_duplicate_groups` FOREIGN KEY (`tenant_id`) REFERENCES `tenants` (`id`) ON DELETE NO ACTION);
+INSERT INTO `new_duplicate_groups` (`id`, `create_time`, `update_time`, `hash`, `status`, `file_count`, `total_size_bytes`, `keeper_machine_id`, `scan_id`, `tenant_id`) SELECT `id`, `create_time`, `update_time`, `hash`, `status`, `file_count`, `total_size_bytes`, `keeper_machine_id`, `scan_id`, `tenant_id` FROM `duplicate_groups`;
+DROP TABLE `duplicate_groups`;
+ALTER TABLE `new_duplicate_groups` RENAME TO `duplicate_groups`;
+-- reverse: create index "contentevent_content_identity_id_occurred_at" to table: "content_events"
+DROP INDEX `contentevent_content_identity_id_occurred_at`;
+-- reverse: create "content_events" table
+DROP TABLE `content_events`;
+-- reverse: create index "contentidentity_tenant_id_hash" to table: "content_identities"
+DROP INDEX `contentidentity_tenant_id_hash`;
+-- reverse: create "content_identities" table
+DROP TABLE `content_identities`;
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
@@ -0,0 +1,20 @@
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- create "new_duplicate_groups" table
+CREATE TABLE `new_duplicate_groups` (`id` uuid NOT NULL, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `hash` text NOT NULL, `status` text NOT NULL DEFAULT ('review'), `file_count` integer NOT NULL, `total_size_bytes` integer NOT NULL, `content_identity_id` uuid NULL, `keeper_machine_id` uuid NULL, `scan_id` uuid NOT NULL, `tenant_id` uuid NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `duplicate_groups_content_identities_duplicate_groups` FOREIGN KEY (`content_identity_id`) REFERENCES `content_identities` (`id`) ON DELETE SET NULL, CONSTRAINT `duplicate_groups_machines_keeper_groups` FOREIGN KEY (`keeper_machine_id`) REFERENCES `machines` (`id`) ON DELETE SET NULL, CONSTRAINT `duplicate_groups_scans_duplicate_groups` FOREIGN KEY (`scan_id`) REFERENCES `scans` (`id`) ON DELETE NO ACTION, CONSTRAINT `duplicate_groups_tenants_duplicate_groups` FOREIGN KEY (`tenant_id`) REFERENCES `tenants` (`id`) ON DELETE NO ACTION);
+-- copy rows from old table "duplicate_groups" to new temporary table "new_duplicate_groups"
+INSERT INTO `new_duplicate_groups` (`id`, `create_time`, `update_time`, `hash`, `status`, `file_count`, `total_size_bytes`, `keeper_machine_id`, `scan_id`, `tenant_id`) SELECT `id`, `create_time`, `update_time`, `hash`, `status`, `file_count`, `total_size_bytes`, `keeper_machine_id`, `scan_id`, `tenant_id` FROM `duplicate_groups`;
+-- drop "duplicate_groups" table after copying rows
+DROP TABLE `duplicate_groups`;
+-- rename temporary table "new_duplicate_groups" to "duplicate_groups"
+ALTER TABLE `new_duplicate_groups` RENAME TO `duplicate_groups`;
+-- create "content_events" table
+CREATE TABLE `content_events` (`id` uuid NOT NULL, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `path` text NOT NULL, `kind` text NOT NULL, `occurred_at` datetime NOT NULL, `content_identity_id` uuid NOT NULL, `machine_id` uuid NOT NULL, `scan_id` uuid NOT NULL, `tenant_id` uuid NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `content_events_content_identities_events` FOREIGN KEY (`content_identity_id`) REFERENCES `content_identities` (`id`) ON DELETE NO ACTION, CONSTRAINT `content_events_machines_content_events` FOREIGN KEY (`machine_id`) REFERENCES `machines` (`id`) ON DELETE NO ACTION, CONSTRAINT `content_events_scans_content_events` FOREIGN KEY (`scan_id`) REFERENCES `scans` (`id`) ON DELETE NO ACTION, CONSTRAINT `content_events_tenants_content_events` FOREIGN KEY (`tenant_id`) REFERENCES `tenants` (`id`) ON DELETE NO ACTION);
+-- create index "contentevent_content_identity_id_occurred_at" to table: "content_events"
+CREATE INDEX `contentevent_content_identity_id_occurred_at` ON `content_events` (`content_identity_id`, `occurred_at`);
+-- create "content_identities" table
+CREATE TABLE `content_identities` (`id` uuid NOT NULL, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `hash` text NOT NULL, `resolved_at` datetime NULL, `first_seen_at` datetime NOT NULL, `last_seen_at` datetime NOT NULL, `keeper_machine_id` uuid NULL, `tenant_id` uuid NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `content_identities_machines_kept_identities` FOREIGN KEY (`keeper_machine_id`) REFERENCES `machines` (`id`) ON DELETE SET NULL, CONSTRAINT `content_identities_tenants_content_identities` FOREIGN KEY (`tenant_id`) REFERENCES `tenants` (`id`) ON DELETE NO ACTION);
+-- create index "contentidentity_tenant_id_hash" to table: "content_identities"
+CREATE UNIQUE INDEX `contentidentity_tenant_id_hash` ON `content_identities` (`tenant_id`, `hash`);
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
@@ -0,0 +1,9 @@
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- reverse: create "new_scans" table
+CREATE TABLE `new_scans` (`id` uuid NOT NULL, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `name` text NOT NULL, `description` text NULL, `started_at` datetime NOT NULL, `completed_at` datetime NULL, `duplicate_group_count` integer NOT NULL, `initiated_machine_id` uuid NULL, `tenant_id` uuid NOT NULL, `files_pruned_at` datetime NULL, PRIMARY KEY (`id`), CONSTRAINT `scans_machines_initiated_scans` FOREIGN KEY (`initiated_machine_id`) REFERENCES `machines` (`id`) ON DELETE SET NULL, CONSTRAINT `scans_tenants_scans` FOREIGN KEY (`tenant_id`) REFERENCES `tenants` (`id`) ON DELETE NO ACTION);
+INSERT INTO `new_scans` (`id`, `create_time`, `update_time`, `name`, `description`, `started_at`, `completed_at`, `duplicate_group_count`, `initiated_machine_id`, `tenant_id`, `files_pruned_at`) SELECT `id`, `create_time`, `update_time`, `name`, `description`, `started_at`, `completed_at`, `duplicate_group_count`, `initiated_machine_id`, `tenant_id`, `files_pruned_at` FROM `scans`;
+DROP TABLE `scans`;
+ALTER TABLE `new_scans` RENAME TO `scans`;
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
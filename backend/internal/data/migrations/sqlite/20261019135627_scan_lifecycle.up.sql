@@ -0,0 +1,14 @@
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- create "new_scans" table
+CREATE TABLE `new_scans` (`id` uuid NOT NULL, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `name` text NOT NULL, `description` text NULL, `started_at` datetime NOT NULL, `completed_at` datetime NULL, `duplicate_group_count` integer NOT NULL, `files_pruned_at` datetime NULL, `status` text NOT NULL DEFAULT ('pending'), `status_message` text NULL, `files_seen` integer NOT NULL DEFAULT (0), `bytes_hashed` integer NOT NULL DEFAULT (0), `machines_reported` integer NOT NULL DEFAULT (0), `last_heartbeat_at` datetime NULL, `initiated_machine_id` uuid NULL, `tenant_id` uuid NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `scans_machines_initiated_scans` FOREIGN KEY (`initiated_machine_id`) REFERENCES `machines` (`id`) ON DELETE SET NULL, CONSTRAINT `scans_tenants_scans` FOREIGN KEY (`tenant_id`) REFERENCES `tenants` (`id`) ON DELETE NO ACTION);
+-- copy rows from old table "scans" to new temporary table "new_scans"
+INSERT INTO `new_scans` (`id`, `create_time`, `update_time`, `name`, `description`, `started_at`, `completed_at`, `duplicate_group_count`, `files_pruned_at`, `initiated_machine_id`, `tenant_id`) SELECT `id`, `create_time`, `update_time`, `name`, `description`, `started_at`, `completed_at`, `duplicate_group_count`, `files_pruned_at`, `initiated_machine_id`, `tenant_id` FROM `scans`;
+-- scans recorded before lifecycle tracking either finished or are still running
+UPDATE `new_scans` SET `status` = CASE WHEN `completed_at` IS NULL THEN 'running' ELSE 'completed' END;
+-- drop "scans" table after copying rows
+DROP TABLE `scans`;
+-- rename temporary table "new_scans" to "scans"
+ALTER TABLE `new_scans` RENAME TO `scans`;
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
@@ -0,0 +1,8 @@
+-- reverse: create index "scantarget_machine_id_status" to table: "scan_targets"
+DROP INDEX `scantarget_machine_id_status`;
+-- reverse: create index "scantarget_scan_id_machine_id" to table: "scan_targets"
+DROP INDEX `scantarget_scan_id_machine_id`;
+-- reverse: create "scan_targets" table
+DROP TABLE `scan_targets`;
+-- reverse: add column "deadline" to table: "scans"
+ALTER TABLE `scans` DROP COLUMN `deadline`;
@@ -0,0 +1,8 @@
+-- add column "deadline" to table: "scans"
+ALTER TABLE `scans` ADD COLUMN `deadline` datetime NULL;
+-- create "scan_targets" table
+CREATE TABLE `scan_targets` (`id` uuid NOT NULL, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `roots` json NOT NULL, `status` text NOT NULL DEFAULT ('pending'), `status_message` text NULL, `claimed_at` datetime NULL, `reported_at` datetime NULL, `machine_id` uuid NOT NULL, `scan_id` uuid NOT NULL, `tenant_id` uuid NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `scan_targets_machines_scan_targets` FOREIGN KEY (`machine_id`) REFERENCES `machines` (`id`) ON DELETE NO ACTION, CONSTRAINT `scan_targets_scans_targets` FOREIGN KEY (`scan_id`) REFERENCES `scans` (`id`) ON DELETE NO ACTION, CONSTRAINT `scan_targets_tenants_scan_targets` FOREIGN KEY (`tenant_id`) REFERENCES `tenants` (`id`) ON DELETE NO ACTION);
+-- create index "scantarget_scan_id_machine_id" to table: "scan_targets"
+CREATE UNIQUE INDEX `scantarget_scan_id_machine_id` ON `scan_targets` (`scan_id`, `machine_id`);
+-- create index "scantarget_machine_id_status" to table: "scan_targets"
+CREATE INDEX `scantarget_machine_id_status` ON `scan_targets` (`machine_id`, `status`);
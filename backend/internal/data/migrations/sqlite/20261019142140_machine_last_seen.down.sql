@@ -0,0 +1,2 @@
+-- reverse: add column "last_seen_at" to table: "machines"
+ALTER TABLE `machines` DROP COLUMN `last_seen_at`;
@@ -0,0 +1,2 @@
+-- add column "last_seen_at" to table: "machines"
+ALTER TABLE `machines` ADD COLUMN `last_seen_at` datetime NULL;
@@ -0,0 +1,10 @@
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- reverse: create "new_tenant_secrets" table
+CREATE TABLE `new_tenant_secrets` (`id` uuid NOT NULL, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `key_id` text NOT NULL, `secret` text NOT NULL, `not_before` datetime NOT NULL, `expires_at` datetime NULL, `revoked_at` datetime NULL, `tenant_id` uuid NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `tenant_secrets_tenants_secrets` FOREIGN KEY (`tenant_id`) REFERENCES `tenants` (`id`) ON DELETE NO ACTION);
+INSERT INTO `new_tenant_secrets` (`id`, `create_time`, `update_time`, `key_id`, `secret`, `not_before`, `expires_at`, `revoked_at`, `tenant_id`) SELECT `id`, `create_time`, `update_time`, `key_id`, `secret`, `not_before`, `expires_at`, `revoked_at`, `tenant_id` FROM `tenant_secrets`;
+DROP TABLE `tenant_secrets`;
+ALTER TABLE `new_tenant_secrets` RENAME TO `tenant_secrets`;
+CREATE UNIQUE INDEX `tenantsecret_tenant_id_key_id` ON `tenant_secrets` (`tenant_id`, `key_id`);
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
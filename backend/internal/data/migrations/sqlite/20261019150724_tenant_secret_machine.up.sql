@@ -0,0 +1,14 @@
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- create "new_tenant_secrets" table
+CREATE TABLE `new_tenant_secrets` (`id` uuid NOT NULL, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `key_id` text NOT NULL, `secret` text NOT NULL, `not_before` datetime NOT NULL, `expires_at` datetime NULL, `revoked_at` datetime NULL, `machine_id` uuid NULL, `tenant_id` uuid NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `tenant_secrets_machines_secrets` FOREIGN KEY (`machine_id`) REFERENCES `machines` (`id`) ON DELETE SET NULL, CONSTRAINT `tenant_secrets_tenants_secrets` FOREIGN KEY (`tenant_id`) REFERENCES `tenants` (`id`) ON DELETE NO ACTION);
+-- copy rows from old table "tenant_secrets" to new temporary table "new_tenant_secrets"
+INSERT INTO `new_tenant_secrets` (`id`, `create_time`, `update_time`, `key_id`, `secret`, `not_before`, `expires_at`, `revoked_at`, `tenant_id`) SELECT `id`, `create_time`, `update_time`, `key_id`, `secret`, `not_before`, `expires_at`, `revoked_at`, `tenant_id` FROM `tenant_secrets`;
+-- drop "tenant_secrets" table after copying rows
+DROP TABLE `tenant_secrets`;
+-- rename temporary table "new_tenant_secrets" to "tenant_secrets"
+ALTER TABLE `new_tenant_secrets` RENAME TO `tenant_secrets`;
+-- create index "tenantsecret_tenant_id_key_id" to table: "tenant_secrets"
+CREATE UNIQUE INDEX `tenantsecret_tenant_id_key_id` ON `tenant_secrets` (`tenant_id`, `key_id`);
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
@@ -0,0 +1,6 @@
+-- create "action_jobs" table
+CREATE TABLE `action_jobs` (`id` uuid NOT NULL, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `action_id` uuid NOT NULL, `action` text NOT NULL, `hash` text NOT NULL, `keep_path` text NULL, `paths` json NOT NULL, `actor` text NOT NULL DEFAULT ('system'), `status` text NOT NULL DEFAULT ('pending'), `status_message` text NULL, `done_paths` json NULL, `claimed_at` datetime NULL, `finished_at` datetime NULL, `duplicate_group_id` uuid NOT NULL, `machine_id` uuid NOT NULL, `tenant_id` uuid NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `action_jobs_duplicate_groups_action_jobs` FOREIGN KEY (`duplicate_group_id`) REFERENCES `duplicate_groups` (`id`) ON DELETE NO ACTION, CONSTRAINT `action_jobs_machines_action_jobs` FOREIGN KEY (`machine_id`) REFERENCES `machines` (`id`) ON DELETE NO ACTION, CONSTRAINT `action_jobs_tenants_action_jobs` FOREIGN KEY (`tenant_id`) REFERENCES `tenants` (`id`) ON DELETE NO ACTION);
+-- create index "actionjob_machine_id_status" to table: "action_jobs"
+CREATE INDEX `actionjob_machine_id_status` ON `action_jobs` (`machine_id`, `status`);
+-- create index "actionjob_action_id" to table: "action_jobs"
+CREATE INDEX `actionjob_action_id` ON `action_jobs` (`action_id`);
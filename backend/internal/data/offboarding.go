@@ -0,0 +1,581 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/mcmx/duplynx/ent"
+	"github.com/mcmx/duplynx/ent/actionaudit"
+	"github.com/mcmx/duplynx/ent/actionjob"
+	"github.com/mcmx/duplynx/ent/contentevent"
+	"github.com/mcmx/duplynx/ent/contentidentity"
+	"github.com/mcmx/duplynx/ent/duplicategroup"
+	"github.com/mcmx/duplynx/ent/fileinstance"
+	"github.com/mcmx/duplynx/ent/machine"
+	"github.com/mcmx/duplynx/ent/scan"
+	"github.com/mcmx/duplynx/ent/scanschedule"
+	"github.com/mcmx/duplynx/ent/scanschedulerun"
+	"github.com/mcmx/duplynx/ent/scantarget"
+	"github.com/mcmx/duplynx/ent/tenant"
+	"github.com/mcmx/duplynx/ent/tenantsecret"
+	"github.com/mcmx/duplynx/internal/tenancy/isolation"
+)
+
+var (
+	// ErrTenantNotFound is returned when exporting or purging an unknown tenant slug.
+	ErrTenantNotFound = errors.New("tenant not found")
+	// ErrPurgeIncomplete is returned when rows remain after a purge; the transaction is rolled back.
+	ErrPurgeIncomplete = errors.New("tenant purge left rows behind")
+	// ErrUnsupportedFormat is returned for export formats other than json and ndjson.
+	ErrUnsupportedFormat = errors.New("unsupported export format")
+)
+
+// Export formats accepted by ExportTenant.
+const (
+	ExportJSON   = "json"
+	ExportNDJSON = "ndjson"
+)
+
+// ExportVersion identifies the archive layout written by ExportTenant.
+const ExportVersion = 1
+
+// exportPageSize bounds how many file instances are loaded per query.
+const exportPageSize = 1000
+
+// RowCounts reports how many rows of each entity belong to a tenant.
+type RowCounts struct {
+	Machines        int
+	Scans           int
+	DuplicateGroups int
+	FileInstances   int
+	ActionAudits    int
+	Secrets         int
+	// ContentIdentities and ContentEvents hold the tenant's cross-scan history.
+	ContentIdentities int
+	ContentEvents     int
+	// ScanTargets assign machines to multi-machine scans.
+	ScanTargets int
+	// ScanSchedules and ScanScheduleRuns hold recurring scans and their run history.
+	ScanSchedules    int
+	ScanScheduleRuns int
+	// ActionJobs hand duplicate actions to the agents holding the copies.
+	ActionJobs int
+}
+
+// Total sums every entity count.
+func (c RowCounts) Total() int {
+	return c.Machines + c.Scans + c.DuplicateGroups + c.FileInstances + c.ActionAudits + c.Secrets +
+		c.ContentIdentities + c.ContentEvents + c.ScanTargets +
+		c.ScanSchedules + c.ScanScheduleRuns + c.ActionJobs
+}
+
+func (c RowCounts) asMap() map[string]int {
+	return map[string]int{
+		"machines":           c.Machines,
+		"scans":              c.Scans,
+		"duplicate_groups":   c.DuplicateGroups,
+		"file_instances":     c.FileInstances,
+		"action_audits":      c.ActionAudits,
+		"secrets":            c.Secrets,
+		"content_identities": c.ContentIdentities,
+		"content_events":     c.ContentEvents,
+		"scan_targets":       c.ScanTargets,
+		"scan_schedules":     c.ScanSchedules,
+		"scan_schedule_runs": c.ScanScheduleRuns,
+		"action_jobs":        c.ActionJobs,
+	}
+}
+
+// ExportHeader describes an export archive.
+type ExportHeader struct {
+	Format     string    `json:"format"`
+	Version    int       `json:"version"`
+	ExportedAt time.Time `json:"exportedAt"`
+	TenantSlug string    `json:"tenantSlug"`
+}
+
+// ExportTenant streams every machine, scan, scan target, scan schedule and run,
+// duplicate group, file instance, action audit, action job, content identity,
+// and content event owned by the tenant to w.
+// JSON writes a single document keyed by entity; NDJSON writes one
+// {"type": ..., "data": ...} record per line. Secret values are never exported.
+func ExportTenant(ctx context.Context, client *ent.Client, slug, format string, w io.Writer) (RowCounts, error) {
+	if client == nil {
+		return RowCounts{}, errors.New("ent client is nil")
+	}
+	var out exportWriter
+	switch strings.ToLower(format) {
+	case "", ExportJSON:
+		out = &jsonExportWriter{w: w}
+	case ExportNDJSON:
+		out = &ndjsonExportWriter{enc: json.NewEncoder(w)}
+	default:
+		return RowCounts{}, fmt.Errorf("%w: %s", ErrUnsupportedFormat, format)
+	}
+
+	record, err := lookupTenant(ctx, client, slug)
+	if err != nil {
+		return RowCounts{}, err
+	}
+	ctx = isolation.WithTenant(ctx, record.ID)
+
+	header := ExportHeader{
+		Format:     "duplynx-tenant-export",
+		Version:    ExportVersion,
+		ExportedAt: time.Now().UTC(),
+		TenantSlug: record.Slug,
+	}
+	if err := out.begin(header, record); err != nil {
+		return RowCounts{}, err
+	}
+
+	var counts RowCounts
+	machines, err := client.Machine.Query().Order(machine.ByID()).All(ctx)
+	if err != nil {
+		return RowCounts{}, fmt.Errorf("export machines: %w", err)
+	}
+	if counts.Machines, err = writeSection(out, "machines", "machine", machines); err != nil {
+		return RowCounts{}, err
+	}
+
+	scans, err := client.Scan.Query().Order(scan.ByID()).All(ctx)
+	if err != nil {
+		return RowCounts{}, fmt.Errorf("export scans: %w", err)
+	}
+	if counts.Scans, err = writeSection(out, "scans", "scan", scans); err != nil {
+		return RowCounts{}, err
+	}
+
+	targets, err := client.ScanTarget.Query().Order(scantarget.ByScanID(), scantarget.ByID()).All(ctx)
+	if err != nil {
+		return RowCounts{}, fmt.Errorf("export scan targets: %w", err)
+	}
+	if counts.ScanTargets, err = writeSection(out, "scanTargets", "scan_target", targets); err != nil {
+		return RowCounts{}, err
+	}
+
+	schedules, err := client.ScanSchedule.Query().Order(scanschedule.ByID()).All(ctx)
+	if err != nil {
+		return RowCounts{}, fmt.Errorf("export scan schedules: %w", err)
+	}
+	if counts.ScanSchedules, err = writeSection(out, "scanSchedules", "scan_schedule", schedules); err != nil {
+		return RowCounts{}, err
+	}
+
+	runs, err := client.ScanScheduleRun.Query().Order(scanschedulerun.ByScheduledFor(), scanschedulerun.ByID()).All(ctx)
+	if err != nil {
+		return RowCounts{}, fmt.Errorf("export scan schedule runs: %w", err)
+	}
+	if counts.ScanScheduleRuns, err = writeSection(out, "scanScheduleRuns", "scan_schedule_run", runs); err != nil {
+		return RowCounts{}, err
+	}
+
+	groups, err := client.DuplicateGroup.Query().Order(duplicategroup.ByID()).All(ctx)
+	if err != nil {
+		return RowCounts{}, fmt.Errorf("export duplicate groups: %w", err)
+	}
+	if counts.DuplicateGroups, err = writeSection(out, "duplicateGroups", "duplicate_group", groups); err != nil {
+		return RowCounts{}, err
+	}
+
+	if err := out.beginSection("fileInstances"); err != nil {
+		return RowCounts{}, err
+	}
+	var after uuid.UUID
+	for {
+		page, err := client.FileInstance.Query().
+			Where(fileinstance.IDGT(after)).
+			Order(fileinstance.ByID()).
+			Limit(exportPageSize).
+			All(ctx)
+		if err != nil {
+			return RowCounts{}, fmt.Errorf("export file instances: %w", err)
+		}
+		for _, file := range page {
+			if err := out.item("file_instance", file); err != nil {
+				return RowCounts{}, err
+			}
+		}
+		counts.FileInstances += len(page)
+		if len(page) < exportPageSize {
+			break
+		}
+		after = page[len(page)-1].ID
+	}
+	if err := out.endSection(); err != nil {
+		return RowCounts{}, err
+	}
+
+	audits, err := client.ActionAudit.Query().Order(actionaudit.ByPerformedAt(), actionaudit.ByID()).All(ctx)
+	if err != nil {
+		return RowCounts{}, fmt.Errorf("export action audits: %w", err)
+	}
+	if counts.ActionAudits, err = writeSection(out, "actionAudits", "action_audit", audits); err != nil {
+		return RowCounts{}, err
+	}
+
+	jobs, err := client.ActionJob.Query().Order(actionjob.ByCreateTime(), actionjob.ByID()).All(ctx)
+	if err != nil {
+		return RowCounts{}, fmt.Errorf("export action jobs: %w", err)
+	}
+	if counts.ActionJobs, err = writeSection(out, "actionJobs", "action_job", jobs); err != nil {
+		return RowCounts{}, err
+	}
+
+	identities, err := client.ContentIdentity.Query().Order(contentidentity.ByHash()).All(ctx)
+	if err != nil {
+		return RowCounts{}, fmt.Errorf("export content identities: %w", err)
+	}
+	if counts.ContentIdentities, err = writeSection(out, "contentIdentities", "content_identity", identities); err != nil {
+		return RowCounts{}, err
+	}
+
+	contentEvents, err := client.ContentEvent.Query().Order(contentevent.ByOccurredAt(), contentevent.ByID()).All(ctx)
+	if err != nil {
+		return RowCounts{}, fmt.Errorf("export content events: %w", err)
+	}
+	if counts.ContentEvents, err = writeSection(out, "contentEvents", "content_event", contentEvents); err != nil {
+		return RowCounts{}, err
+	}
+
+	return counts, out.end()
+}
+
+// PurgeReport summarises a completed tenant purge.
+type PurgeReport struct {
+	TenantID    uuid.UUID
+	TenantSlug  string
+	Deleted     RowCounts
+	TombstoneID uuid.UUID
+}
+
+// CountTenantRows reports how many rows a purge of the tenant would delete.
+func CountTenantRows(ctx context.Context, client *ent.Client, slug string) (RowCounts, error) {
+	if client == nil {
+		return RowCounts{}, errors.New("ent client is nil")
+	}
+	record, err := lookupTenant(ctx, client, slug)
+	if err != nil {
+		return RowCounts{}, err
+	}
+	return countTenantRows(isolation.WithSystem(ctx), client.Machine, client.Scan, client.DuplicateGroup,
+		client.FileInstance, client.ActionAudit, client.TenantSecret, client.ContentIdentity, client.ContentEvent, client.ScanTarget, client.ScanSchedule, client.ScanScheduleRun, client.ActionJob, record.ID)
+}
+
+// PurgeTenant deletes every row owned by the tenant, including the tenant itself,
+// in one transaction. It verifies nothing remains before writing a tombstone and
+// committing; any failure rolls the whole purge back.
+func PurgeTenant(ctx context.Context, client *ent.Client, slug, actor string) (PurgeReport, error) {
+	if client == nil {
+		return PurgeReport{}, errors.New("ent client is nil")
+	}
+	record, err := lookupTenant(ctx, client, slug)
+	if err != nil {
+		return PurgeReport{}, err
+	}
+	if actor == "" {
+		actor = "system"
+	}
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return PurgeReport{}, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	// Deletes run confined to the tenant, so isolation backs up the explicit predicates.
+	tenantCtx := isolation.WithTenant(ctx, record.ID)
+	deleted, err := deleteRows(tenantCtx, tx, &record.ID)
+	if err != nil {
+		return PurgeReport{}, err
+	}
+
+	systemCtx := isolation.WithSystem(ctx)
+	remaining, err := countTenantRows(systemCtx, tx.Machine, tx.Scan, tx.DuplicateGroup,
+		tx.FileInstance, tx.ActionAudit, tx.TenantSecret, tx.ContentIdentity, tx.ContentEvent, tx.ScanTarget, tx.ScanSchedule, tx.ScanScheduleRun, tx.ActionJob, record.ID)
+	if err != nil {
+		return PurgeReport{}, err
+	}
+	tenantLeft, err := tx.Tenant.Query().Where(tenant.IDEQ(record.ID)).Exist(systemCtx)
+	if err != nil {
+		return PurgeReport{}, fmt.Errorf("verify tenant purge: %w", err)
+	}
+	if remaining.Total() > 0 || tenantLeft {
+		return PurgeReport{}, fmt.Errorf("%w: %+v", ErrPurgeIncomplete, remaining)
+	}
+
+	tombstone, err := tx.TenantTombstone.Create().
+		SetTenantID(record.ID).
+		SetTenantSlug(record.Slug).
+		SetTenantName(record.Name).
+		SetActor(actor).
+		SetDeletedRows(deleted.asMap()).
+		Save(systemCtx)
+	if err != nil {
+		return PurgeReport{}, fmt.Errorf("write tombstone: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return PurgeReport{}, fmt.Errorf("commit transaction: %w", err)
+	}
+	return PurgeReport{
+		TenantID:    record.ID,
+		TenantSlug:  record.Slug,
+		Deleted:     deleted,
+		TombstoneID: tombstone.ID,
+	}, nil
+}
+
+// deleteRows removes rows children-first so foreign keys hold. A nil tenantID
+// clears every tenant.
+func deleteRows(ctx context.Context, tx *ent.Tx, tenantID *uuid.UUID) (RowCounts, error) {
+	var counts RowCounts
+	var err error
+
+	audits := tx.ActionAudit.Delete()
+	jobs := tx.ActionJob.Delete()
+	files := tx.FileInstance.Delete()
+	contentEvents := tx.ContentEvent.Delete()
+	groups := tx.DuplicateGroup.Delete()
+	identities := tx.ContentIdentity.Delete()
+	targets := tx.ScanTarget.Delete()
+	runs := tx.ScanScheduleRun.Delete()
+	schedules := tx.ScanSchedule.Delete()
+	scans := tx.Scan.Delete()
+	secrets := tx.TenantSecret.Delete()
+	machines := tx.Machine.Delete()
+	tenants := tx.Tenant.Delete()
+	if tenantID != nil {
+		audits.Where(actionaudit.TenantID(*tenantID))
+		jobs.Where(actionjob.TenantID(*tenantID))
+		files.Where(fileinstance.TenantID(*tenantID))
+		contentEvents.Where(contentevent.TenantID(*tenantID))
+		groups.Where(duplicategroup.TenantID(*tenantID))
+		identities.Where(contentidentity.TenantID(*tenantID))
+		targets.Where(scantarget.TenantID(*tenantID))
+		runs.Where(scanschedulerun.TenantID(*tenantID))
+		schedules.Where(scanschedule.TenantID(*tenantID))
+		scans.Where(scan.TenantID(*tenantID))
+		secrets.Where(tenantsecret.TenantID(*tenantID))
+		machines.Where(machine.TenantID(*tenantID))
+		tenants.Where(tenant.ID(*tenantID))
+	}
+
+	if counts.ActionAudits, err = audits.Exec(ctx); err != nil {
+		return RowCounts{}, fmt.Errorf("clear action audits: %w", err)
+	}
+	if counts.ActionJobs, err = jobs.Exec(ctx); err != nil {
+		return RowCounts{}, fmt.Errorf("clear action jobs: %w", err)
+	}
+	if counts.FileInstances, err = files.Exec(ctx); err != nil {
+		return RowCounts{}, fmt.Errorf("clear file instances: %w", err)
+	}
+	if counts.ContentEvents, err = contentEvents.Exec(ctx); err != nil {
+		return RowCounts{}, fmt.Errorf("clear content events: %w", err)
+	}
+	if counts.DuplicateGroups, err = groups.Exec(ctx); err != nil {
+		return RowCounts{}, fmt.Errorf("clear duplicate groups: %w", err)
+	}
+	if counts.ContentIdentities, err = identities.Exec(ctx); err != nil {
+		return RowCounts{}, fmt.Errorf("clear content identities: %w", err)
+	}
+	if counts.ScanTargets, err = targets.Exec(ctx); err != nil {
+		return RowCounts{}, fmt.Errorf("clear scan targets: %w", err)
+	}
+	if counts.ScanScheduleRuns, err = runs.Exec(ctx); err != nil {
+		return RowCounts{}, fmt.Errorf("clear scan schedule runs: %w", err)
+	}
+	if counts.ScanSchedules, err = schedules.Exec(ctx); err != nil {
+		return RowCounts{}, fmt.Errorf("clear scan schedules: %w", err)
+	}
+	if counts.Scans, err = scans.Exec(ctx); err != nil {
+		return RowCounts{}, fmt.Errorf("clear scans: %w", err)
+	}
+	if counts.Secrets, err = secrets.Exec(ctx); err != nil {
+		return RowCounts{}, fmt.Errorf("clear tenant secrets: %w", err)
+	}
+	if counts.Machines, err = machines.Exec(ctx); err != nil {
+		return RowCounts{}, fmt.Errorf("clear machines: %w", err)
+	}
+	if _, err = tenants.Exec(ctx); err != nil {
+		return RowCounts{}, fmt.Errorf("clear tenants: %w", err)
+	}
+	return counts, nil
+}
+
+func countTenantRows(
+	ctx context.Context,
+	machines *ent.MachineClient,
+	scans *ent.ScanClient,
+	groups *ent.DuplicateGroupClient,
+	files *ent.FileInstanceClient,
+	audits *ent.ActionAuditClient,
+	secrets *ent.TenantSecretClient,
+	identities *ent.ContentIdentityClient,
+	contentEvents *ent.ContentEventClient,
+	targets *ent.ScanTargetClient,
+	schedules *ent.ScanScheduleClient,
+	runs *ent.ScanScheduleRunClient,
+	jobs *ent.ActionJobClient,
+	tenantID uuid.UUID,
+) (RowCounts, error) {
+	var counts RowCounts
+	var err error
+	if counts.Machines, err = machines.Query().Where(machine.TenantID(tenantID)).Count(ctx); err != nil {
+		return RowCounts{}, fmt.Errorf("count machines: %w", err)
+	}
+	if counts.Scans, err = scans.Query().Where(scan.TenantID(tenantID)).Count(ctx); err != nil {
+		return RowCounts{}, fmt.Errorf("count scans: %w", err)
+	}
+	if counts.DuplicateGroups, err = groups.Query().Where(duplicategroup.TenantID(tenantID)).Count(ctx); err != nil {
+		return RowCounts{}, fmt.Errorf("count duplicate groups: %w", err)
+	}
+	if counts.FileInstances, err = files.Query().Where(fileinstance.TenantID(tenantID)).Count(ctx); err != nil {
+		return RowCounts{}, fmt.Errorf("count file instances: %w", err)
+	}
+	if counts.ActionAudits, err = audits.Query().Where(actionaudit.TenantID(tenantID)).Count(ctx); err != nil {
+		return RowCounts{}, fmt.Errorf("count action audits: %w", err)
+	}
+	if counts.Secrets, err = secrets.Query().Where(tenantsecret.TenantID(tenantID)).Count(ctx); err != nil {
+		return RowCounts{}, fmt.Errorf("count tenant secrets: %w", err)
+	}
+	if counts.ContentIdentities, err = identities.Query().Where(contentidentity.TenantID(tenantID)).Count(ctx); err != nil {
+		return RowCounts{}, fmt.Errorf("count content identities: %w", err)
+	}
+	if counts.ContentEvents, err = contentEvents.Query().Where(contentevent.TenantID(tenantID)).Count(ctx); err != nil {
+		return RowCounts{}, fmt.Errorf("count content events: %w", err)
+	}
+	if counts.ScanTargets, err = targets.Query().Where(scantarget.TenantID(tenantID)).Count(ctx); err != nil {
+		return RowCounts{}, fmt.Errorf("count scan targets: %w", err)
+	}
+	if counts.ScanSchedules, err = schedules.Query().Where(scanschedule.TenantID(tenantID)).Count(ctx); err != nil {
+		return RowCounts{}, fmt.Errorf("count scan schedules: %w", err)
+	}
+	if counts.ScanScheduleRuns, err = runs.Query().Where(scanschedulerun.TenantID(tenantID)).Count(ctx); err != nil {
+		return RowCounts{}, fmt.Errorf("count scan schedule runs: %w", err)
+	}
+	if counts.ActionJobs, err = jobs.Query().Where(actionjob.TenantID(tenantID)).Count(ctx); err != nil {
+		return RowCounts{}, fmt.Errorf("count action jobs: %w", err)
+	}
+	return counts, nil
+}
+
+// lookupTenant resolves a tenant by slug, including archived tenants.
+func lookupTenant(ctx context.Context, client *ent.Client, slug string) (*ent.Tenant, error) {
+	record, err := client.Tenant.Query().
+		Where(tenant.SlugEQ(slug)).
+		Only(isolation.WithSystem(ctx))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: %s", ErrTenantNotFound, slug)
+		}
+		return nil, fmt.Errorf("load tenant: %w", err)
+	}
+	return record, nil
+}
+
+// exportWriter abstracts the JSON and NDJSON archive layouts.
+type exportWriter interface {
+	begin(header ExportHeader, tenant *ent.Tenant) error
+	beginSection(name string) error
+	item(kind string, v any) error
+	endSection() error
+	end() error
+}
+
+func writeSection[T any](out exportWriter, section, kind string, items []T) (int, error) {
+	if err := out.beginSection(section); err != nil {
+		return 0, err
+	}
+	for _, item := range items {
+		if err := out.item(kind, item); err != nil {
+			return 0, err
+		}
+	}
+	return len(items), out.endSection()
+}
+
+// jsonExportWriter streams a single JSON document without buffering sections.
+type jsonExportWriter struct {
+	w     io.Writer
+	first bool
+}
+
+func (j *jsonExportWriter) begin(header ExportHeader, tenant *ent.Tenant) error {
+	headerJSON, err := json.Marshal(header)
+	if err != nil {
+		return err
+	}
+	tenantJSON, err := json.Marshal(tenant)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(j.w, "{\"header\":%s,\n\"tenant\":%s", headerJSON, tenantJSON)
+	return err
+}
+
+func (j *jsonExportWriter) beginSection(name string) error {
+	j.first = true
+	_, err := fmt.Fprintf(j.w, ",\n%q:[", name)
+	return err
+}
+
+func (j *jsonExportWriter) item(_ string, v any) error {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	sep := ",\n"
+	if j.first {
+		sep = "\n"
+		j.first = false
+	}
+	_, err = fmt.Fprintf(j.w, "%s%s", sep, raw)
+	return err
+}
+
+func (j *jsonExportWriter) endSection() error {
+	_, err := io.WriteString(j.w, "]")
+	return err
+}
+
+func (j *jsonExportWriter) end() error {
+	_, err := io.WriteString(j.w, "}\n")
+	return err
+}
+
+// ndjsonExportWriter writes one typed record per line.
+type ndjsonExportWriter struct {
+	enc *json.Encoder
+}
+
+type ndjsonRecord struct {
+	Type string `json:"type"`
+	Data any    `json:"data"`
+}
+
+func (n *ndjsonExportWriter) begin(header ExportHeader, tenant *ent.Tenant) error {
+	if err := n.enc.Encode(ndjsonRecord{Type: "header", Data: header}); err != nil {
+		return err
+	}
+	return n.enc.Encode(ndjsonRecord{Type: "tenant", Data: tenant})
+}
+
+func (n *ndjsonExportWriter) beginSection(string) error { return nil }
+
+func (n *ndjsonExportWriter) item(kind string, v any) error {
+	return n.enc.Encode(ndjsonRecord{Type: kind, Data: v})
+}
+
+func (n *ndjsonExportWriter) endSection() error { return nil }
+
+func (n *ndjsonExportWriter) end() error { return nil }
@@ -0,0 +1,31 @@
+// Package rawsql holds helpers for the hand-written SQL that packages run
+// next to Ent queries. It lives apart from internal/data, which imports the
+// packages that use it.
+package rawsql
+
+import (
+	"strconv"
+	"strings"
+
+	"entgo.io/ent/dialect"
+)
+
+// Rebind numbers "?" placeholders as $1, $2, ... when the dialect is
+// Postgres and returns other dialects' queries unchanged. Callers pass every
+// value as an argument, so "?" never appears in a literal.
+func Rebind(dialectName, query string) string {
+	if dialectName != dialect.Postgres {
+		return query
+	}
+	var b strings.Builder
+	n := 0
+	for _, r := range query {
+		if r == '?' {
+			n++
+			b.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -12,7 +12,9 @@ import (
 	"github.com/mcmx/duplynx/ent/actionaudit"
 	"github.com/mcmx/duplynx/ent/duplicategroup"
 	"github.com/mcmx/duplynx/ent/machine"
-	"github.com/mcmx/duplynx/ent/migrate"
+	"github.com/mcmx/duplynx/ent/scan"
+	"github.com/mcmx/duplynx/internal/identity"
+	"github.com/mcmx/duplynx/internal/tenancy/isolation"
 )
 
 // SeedReport captures aggregate counts after seeding completes.
@@ -40,14 +42,13 @@ func SeedDemoDataset(ctx context.Context, client *ent.Client, dataset DemoDatase
 	if ctx == nil {
 		ctx = context.Background()
 	}
+	// Seeding spans every tenant.
+	ctx = isolation.WithSystem(ctx)
 
 	migrateCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
-	if err := client.Schema.Create(migrateCtx,
-		migrate.WithDropIndex(true),
-		migrate.WithDropColumn(true),
-	); err != nil {
+	if err := Migrate(migrateCtx, client); err != nil {
 		return SeedReport{}, fmt.Errorf("apply migrations: %w", err)
 	}
 
@@ -75,7 +76,7 @@ func SeedDemoDataset(ctx context.Context, client *ent.Client, dataset DemoDatase
 	if err := insertDuplicateGroups(ctx, tx, dataset.DuplicateGroups); err != nil {
 		return SeedReport{}, err
 	}
-	if err := insertFileInstances(ctx, tx, dataset.FileInstances); err != nil {
+	if err := insertFileInstances(ctx, tx, dataset.FileInstances, groupTenants(dataset.DuplicateGroups)); err != nil {
 		return SeedReport{}, err
 	}
 	if err := insertActionAudits(ctx, tx, dataset.ActionAudits); err != nil {
@@ -85,6 +86,9 @@ func SeedDemoDataset(ctx context.Context, client *ent.Client, dataset DemoDatase
 	if err := tx.Commit(); err != nil {
 		return SeedReport{}, fmt.Errorf("commit transaction: %w", err)
 	}
+	if _, err := identity.NewRepositoryFromClient(client).LinkPending(ctx); err != nil {
+		return SeedReport{}, fmt.Errorf("link content identities: %w", err)
+	}
 
 	return SeedReport{
 		Tenants:         len(dataset.Tenants),
@@ -97,25 +101,8 @@ func SeedDemoDataset(ctx context.Context, client *ent.Client, dataset DemoDatase
 }
 
 func clearExisting(ctx context.Context, tx *ent.Tx) error {
-	if _, err := tx.ActionAudit.Delete().Exec(ctx); err != nil {
-		return fmt.Errorf("clear action audits: %w", err)
-	}
-	if _, err := tx.FileInstance.Delete().Exec(ctx); err != nil {
-		return fmt.Errorf("clear file instances: %w", err)
-	}
-	if _, err := tx.DuplicateGroup.Delete().Exec(ctx); err != nil {
-		return fmt.Errorf("clear duplicate groups: %w", err)
-	}
-	if _, err := tx.Scan.Delete().Exec(ctx); err != nil {
-		return fmt.Errorf("clear scans: %w", err)
-	}
-	if _, err := tx.Machine.Delete().Exec(ctx); err != nil {
-		return fmt.Errorf("clear machines: %w", err)
-	}
-	if _, err := tx.Tenant.Delete().Exec(ctx); err != nil {
-		return fmt.Errorf("clear tenants: %w", err)
-	}
-	return nil
+	_, err := deleteRows(ctx, tx, nil)
+	return err
 }
 
 func insertTenants(ctx context.Context, tx *ent.Tx, tenants []TenantFixture) error {
@@ -183,7 +170,7 @@ func insertScans(ctx context.Context, tx *ent.Tx, scans []ScanFixture) error {
 			builder.SetDescription(scanFixture.Description)
 		}
 		if !scanFixture.CompletedAt.IsZero() {
-			builder.SetCompletedAt(scanFixture.CompletedAt)
+			builder.SetCompletedAt(scanFixture.CompletedAt).SetStatus(scan.StatusCompleted)
 		}
 		if scanFixture.InitiatedMachineID != uuid.Nil {
 			builder.SetInitiatedMachineID(scanFixture.InitiatedMachineID)
@@ -220,10 +207,23 @@ func insertDuplicateGroups(ctx context.Context, tx *ent.Tx, groups []DuplicateGr
 	return nil
 }
 
-func insertFileInstances(ctx context.Context, tx *ent.Tx, files []FileInstanceFixture) error {
+func groupTenants(groups []DuplicateGroupFixture) map[uuid.UUID]uuid.UUID {
+	out := make(map[uuid.UUID]uuid.UUID, len(groups))
+	for _, group := range groups {
+		out[group.ID] = group.TenantID
+	}
+	return out
+}
+
+func insertFileInstances(ctx context.Context, tx *ent.Tx, files []FileInstanceFixture, tenants map[uuid.UUID]uuid.UUID) error {
 	for _, file := range files {
+		tenantID, ok := tenants[file.DuplicateGroupID]
+		if !ok {
+			return fmt.Errorf("insert file instance %s: unknown duplicate group %s", file.ID, file.DuplicateGroupID)
+		}
 		builder := tx.FileInstance.Create().
 			SetID(file.ID).
+			SetTenantID(tenantID).
 			SetDuplicateGroupID(file.DuplicateGroupID).
 			SetMachineID(file.MachineID).
 			SetPath(file.Path).
@@ -9,11 +9,58 @@ import (
 	"strings"
 	"time"
 
+	"entgo.io/ent/dialect"
+	_ "github.com/lib/pq"
 	_ "github.com/mattn/go-sqlite3"
 
 	"github.com/mcmx/duplynx/ent"
+	// Registers schema hooks, interceptors, and defaults.
+	_ "github.com/mcmx/duplynx/ent/runtime"
+	"github.com/mcmx/duplynx/internal/search"
 )
 
+// Supported values for the --db-driver flag.
+const (
+	DriverSQLite   = "sqlite"
+	DriverPostgres = "postgres"
+)
+
+// ErrUnknownDriver is returned by Open for drivers other than sqlite and postgres.
+var ErrUnknownDriver = errors.New("unknown database driver")
+
+// Open opens an Ent client for the named driver. An empty driver means SQLite.
+func Open(ctx context.Context, driver, dsn string) (*ent.Client, error) {
+	switch strings.ToLower(strings.TrimSpace(driver)) {
+	case "", DriverSQLite, "sqlite3":
+		return OpenSQLite(ctx, dsn)
+	case DriverPostgres, "postgresql":
+		return OpenPostgres(ctx, dsn)
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownDriver, driver)
+	}
+}
+
+// OpenPostgres connects to the Postgres database at dsn, a URL or key=value
+// connection string, and verifies the server is reachable.
+func OpenPostgres(ctx context.Context, dsn string) (*ent.Client, error) {
+	if strings.TrimSpace(dsn) == "" {
+		return nil, errors.New("postgres dsn must not be empty")
+	}
+
+	client, err := ent.Open(dialect.Postgres, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("open postgres database: %w", err)
+	}
+	pingCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	if _, err := client.ExecContext(pingCtx, "SELECT 1"); err != nil {
+		_ = client.Close()
+		return nil, fmt.Errorf("connect to postgres database: %w", err)
+	}
+
+	return client, nil
+}
+
 // OpenSQLite opens (and creates if necessary) a SQLite-backed Ent client using the provided DSN.
 func OpenSQLite(ctx context.Context, dsn string) (*ent.Client, error) {
 	if strings.TrimSpace(dsn) == "" {
@@ -32,14 +79,22 @@ func OpenSQLite(ctx context.Context, dsn string) (*ent.Client, error) {
 	return client, nil
 }
 
-// Migrate runs Ent schema migrations for the supplied client.
+// Migrate applies every pending versioned migration and ensures the search
+// index. It is for fresh or disposable databases (seeding, tests); serve only
+// checks the schema with CheckSchema.
 func Migrate(ctx context.Context, client *ent.Client) error {
 	if client == nil {
 		return errors.New("ent client is nil")
 	}
 	ctx, cancel := context.WithTimeout(ctx, 15*time.Second)
 	defer cancel()
-	return client.Schema.Create(ctx)
+	if _, err := MigrateUp(ctx, client, 0); err != nil {
+		return err
+	}
+	if _, err := search.EnsureIndex(ctx, client); err != nil {
+		return err
+	}
+	return nil
 }
 
 // Close releases database resources.
@@ -0,0 +1,151 @@
+// Package events fans out board changes to live subscribers within one server process.
+package events
+
+import (
+	"sync"
+	"time"
+)
+
+// Event types published on the bus.
+const (
+	// TypeGroupChanged is published when a duplicate group's keeper, files,
+	// status or notes change.
+	TypeGroupChanged = "group.changed"
+	// TypeScanIngested is published when a signed scan manifest is accepted.
+	TypeScanIngested = "scan.ingested"
+	// TypeScanProgress is published when a scan's status or progress
+	// counters change, through agent heartbeats or a cancellation.
+	TypeScanProgress = "scan.progress"
+)
+
+// DefaultBuffer is the number of events queued per subscriber before new
+// events are dropped for it.
+const DefaultBuffer = 64
+
+// Event describes a change visible on a scan board.
+type Event struct {
+	Type       string
+	TenantSlug string
+	// ScanID is empty for tenant-wide events, such as manifests that do not name a scan.
+	ScanID  string
+	GroupID string
+	At      time.Time
+}
+
+// Publisher accepts events; *Bus implements it.
+type Publisher interface {
+	Publish(Event)
+}
+
+// Filter selects the events a subscriber receives.
+type Filter struct {
+	// TenantSlug is required; subscribers never see other tenants' events.
+	TenantSlug string
+	// ScanID limits delivery to one scan plus tenant-wide events; empty receives all of the tenant's events.
+	ScanID string
+}
+
+func (f Filter) matches(e Event) bool {
+	if f.TenantSlug == "" || e.TenantSlug != f.TenantSlug {
+		return false
+	}
+	return f.ScanID == "" || e.ScanID == "" || e.ScanID == f.ScanID
+}
+
+type subscriber struct {
+	filter Filter
+	ch     chan Event
+}
+
+// Bus is an in-process publish/subscribe hub. Publishing never blocks: a
+// subscriber whose buffer is full misses the event.
+type Bus struct {
+	buffer int
+	// Now stamps events published without a time, primarily for tests.
+	Now func() time.Time
+
+	mu     sync.RWMutex
+	nextID int
+	subs   map[int]subscriber
+	closed bool
+}
+
+// NewBus constructs a bus that buffers up to buffer events per subscriber.
+func NewBus(buffer int) *Bus {
+	if buffer <= 0 {
+		buffer = DefaultBuffer
+	}
+	return &Bus{buffer: buffer, subs: make(map[int]subscriber)}
+}
+
+// Publish delivers the event to every matching subscriber. A nil bus discards it.
+func (b *Bus) Publish(e Event) {
+	if b == nil {
+		return
+	}
+	if e.At.IsZero() {
+		if b.Now != nil {
+			e.At = b.Now()
+		} else {
+			e.At = time.Now().UTC()
+		}
+	}
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for _, sub := range b.subs {
+		if !sub.filter.matches(e) {
+			continue
+		}
+		select {
+		case sub.ch <- e:
+		default:
+		}
+	}
+}
+
+// Subscribe registers a subscriber. The returned cancel function unsubscribes
+// and closes the channel; it is safe to call more than once.
+func (b *Bus) Subscribe(filter Filter) (<-chan Event, func()) {
+	ch := make(chan Event, b.buffer)
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		close(ch)
+		return ch, func() {}
+	}
+	id := b.nextID
+	b.nextID++
+	b.subs[id] = subscriber{filter: filter, ch: ch}
+	b.mu.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+			if _, ok := b.subs[id]; ok {
+				delete(b.subs, id)
+				close(ch)
+			}
+		})
+	}
+}
+
+// Close ends every subscription so streaming handlers return, letting a
+// graceful server shutdown complete. Later subscriptions are closed immediately.
+func (b *Bus) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.closed = true
+	for id, sub := range b.subs {
+		delete(b.subs, id)
+		close(sub.ch)
+	}
+}
+
+// Subscribers reports the number of active subscriptions.
+func (b *Bus) Subscribers() int {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return len(b.subs)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/go-chi/chi/v5"
 
 	"github.com/mcmx/duplynx/internal/actions"
+	"github.com/mcmx/duplynx/internal/quota"
 	"github.com/mcmx/duplynx/internal/tenancy"
 )
 
@@ -28,7 +29,12 @@ func (h KeeperHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 
 	var req keeperRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+	if err := decodeInput(r, &req, func(form func(string) string) {
+		req = keeperRequest{
+			TenantSlug:      form("tenantSlug"),
+			KeeperMachineID: form("keeperMachineId"),
+		}
+	}); err != nil {
 		http.Error(w, "invalid payload", http.StatusBadRequest)
 		return
 	}
@@ -43,7 +49,12 @@ func (h KeeperHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 	tenantSlug := scope.TenantSlug
 	groupID := chi.URLParam(r, "groupId")
-	if err := h.Dispatcher.AssignKeeper(r.Context(), groupID, tenantSlug, req.KeeperMachineID); err != nil {
+	err := h.Dispatcher.AssignKeeper(r.Context(), groupID, tenantSlug, req.KeeperMachineID)
+	if isFormPost(r) {
+		respondWithCard(w, r, h.Dispatcher, groupID, err, cardKeeper, "Keeper assigned")
+		return
+	}
+	if err != nil {
 		status := statusFromActionsError(err)
 		http.Error(w, err.Error(), status)
 		return
@@ -77,7 +88,14 @@ func (h ActionHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 
 	var req actionRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+	if err := decodeInput(r, &req, func(form func(string) string) {
+		req = actionRequest{
+			TenantSlug:    form("tenantSlug"),
+			ActionType:    actions.ActionType(form("actionType")),
+			TargetFileIDs: r.PostForm["targetFileIds"],
+			Notes:         form("notes"),
+		}
+	}); err != nil {
 		http.Error(w, "invalid payload", http.StatusBadRequest)
 		return
 	}
@@ -90,16 +108,25 @@ func (h ActionHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, "tenant scope violation", http.StatusNotFound)
 		return
 	}
+	groupID := chi.URLParam(r, "groupId")
 	if req.ActionType == "" {
+		if isFormPost(r) {
+			respondWithCard(w, r, h.Dispatcher, groupID, errActionTypeRequired, cardAction, "")
+			return
+		}
 		http.Error(w, "actionType required", http.StatusBadRequest)
 		return
 	}
-	groupID := chi.URLParam(r, "groupId")
 	payload := map[string]any{
 		"targetFileIds": req.TargetFileIDs,
 		"notes":         req.Notes,
 	}
-	if err := h.Dispatcher.PerformAction(r.Context(), groupID, scope.TenantSlug, "system", req.ActionType, payload); err != nil {
+	err := h.Dispatcher.PerformAction(r.Context(), groupID, scope.TenantSlug, "system", req.ActionType, payload)
+	if isFormPost(r) {
+		respondWithCard(w, r, h.Dispatcher, groupID, err, cardAction, actionNotice(req.ActionType, h.Dispatcher.RunOnAgents))
+		return
+	}
+	if err != nil {
 		status := statusFromActionsError(err)
 		http.Error(w, err.Error(), status)
 		return
@@ -121,8 +148,12 @@ func statusFromActionsError(err error) int {
 		return http.StatusNotFound
 	case errors.Is(err, actions.ErrKeeperMachineID),
 		errors.Is(err, actions.ErrInvalidGroupID),
-		errors.Is(err, actions.ErrInvalidMachineID):
+		errors.Is(err, actions.ErrInvalidMachineID),
+		errors.Is(err, actions.ErrInvalidAction),
+		errors.Is(err, actions.ErrNothingToRun):
 		return http.StatusBadRequest
+	case errors.Is(err, quota.ErrRateLimited), errors.Is(err, quota.ErrQuotaExceeded):
+		return http.StatusTooManyRequests
 	default:
 		return http.StatusInternalServerError
 	}
@@ -1,17 +1,102 @@
 package handlers
 
 import (
-	"fmt"
+	"errors"
 	"net/http"
 
-	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/mcmx/duplynx/internal/actions"
+	"github.com/mcmx/duplynx/internal/templ/components"
+)
+
+var errActionTypeRequired = errors.New("choose an action to run")
+
+// cardForm identifies which card form produced an error.
+type cardForm int
+
+const (
+	cardKeeper cardForm = iota
+	cardAction
 )
 
-// ActionHTMXHandler returns a simple acknowledgement fragment for htmx updates.
-func ActionHTMXHandler(w http.ResponseWriter, r *http.Request) {
-	groupID := chi.URLParam(r, "groupId")
-	fragment := fmt.Sprintf(`<div class="text-xs text-emerald-400">Action accepted for group %s</div>`, groupID)
+// ActionHTMXHandler runs the action posted from a board card and always answers
+// with the re-rendered card fragment.
+type ActionHTMXHandler struct {
+	Dispatcher *actions.Dispatcher
+}
+
+func (h ActionHTMXHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	r.Header.Set("HX-Request", "true")
+	if !isFormPost(r) {
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+	ActionHandler(h).ServeHTTP(w, r)
+}
+
+// respondWithCard answers a card form post. htmx requests receive the card
+// fragment, with form errors rendered inline and a 422 status; plain form posts
+// are redirected back to the board on success.
+func respondWithCard(w http.ResponseWriter, r *http.Request, dispatcher *actions.Dispatcher, groupID string, actionErr error, form cardForm, notice string) {
+	status := http.StatusOK
+	var feedback components.CardFeedback
+	if actionErr != nil {
+		status = statusFromActionsError(actionErr)
+		switch {
+		case errors.Is(actionErr, errActionTypeRequired):
+			status = http.StatusUnprocessableEntity
+		case status == http.StatusBadRequest:
+			status = http.StatusUnprocessableEntity
+		case status != http.StatusTooManyRequests:
+			http.Error(w, actionErr.Error(), status)
+			return
+		}
+		if form == cardKeeper {
+			feedback.KeeperError = actionErr.Error()
+		} else {
+			feedback.ActionError = actionErr.Error()
+		}
+	} else {
+		feedback.Notice = notice
+	}
+
+	gid, err := uuid.Parse(groupID)
+	if err != nil {
+		http.Error(w, actions.ErrInvalidGroupID.Error(), http.StatusBadRequest)
+		return
+	}
+	group, err := dispatcher.Repo.Get(r.Context(), gid)
+	if err != nil {
+		http.Error(w, err.Error(), statusFromActionsError(err))
+		return
+	}
+
+	card := components.DuplicateCardWithFeedback(group, feedback)
+	if r.Header.Get("HX-Request") == "true" {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(card))
+		return
+	}
+	if actionErr == nil {
+		http.Redirect(w, r, "/scans/"+group.ScanID, http.StatusSeeOther)
+		return
+	}
+	writeHTML(w, status, "Duplicate group", group.TenantSlug, card)
+}
 
-	w.Header().Set("Content-Type", "text/html; charset=utf-8")
-	_, _ = w.Write([]byte(fragment))
+func actionNotice(action actions.ActionType, onAgents bool) string {
+	switch action {
+	case actions.ActionQuarantine:
+		if onAgents {
+			return "Quarantine queued"
+		}
+		return "Copies quarantined"
+	case actions.ActionDelete:
+		return "Delete copies queued"
+	case actions.ActionHardlink:
+		return "Hardlink creation queued"
+	default:
+		return "Action accepted"
+	}
 }
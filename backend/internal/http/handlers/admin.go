@@ -0,0 +1,342 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"html/template"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/mcmx/duplynx/internal/quota"
+	"github.com/mcmx/duplynx/internal/templ"
+	"github.com/mcmx/duplynx/internal/tenancy"
+)
+
+// AdminHandler serves tenant and machine administration as JSON and HTML forms.
+type AdminHandler struct {
+	Repo *tenancy.Repository
+	// Quotas enforces the per-tenant machine limit; nil disables it.
+	Quotas *quota.Enforcer
+}
+
+// ListTenants renders every tenant, including archived ones.
+func (h AdminHandler) ListTenants(w http.ResponseWriter, r *http.Request) {
+	tenants, err := h.Repo.ListTenants(r.Context(), tenancy.IncludeArchived())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if wantsJSON(r) {
+		resp := struct {
+			Tenants []AdminTenant `json:"tenants"`
+		}{Tenants: make([]AdminTenant, 0, len(tenants))}
+		for _, tenant := range tenants {
+			resp.Tenants = append(resp.Tenants, adminTenant(tenant))
+		}
+		writeJSON(w, http.StatusOK, resp)
+		return
+	}
+	writeHTML(w, http.StatusOK, "Tenant administration", "", templ.AdminTenantsPage(tenants, tenancy.TenantInput{}, nil))
+}
+
+// CreateTenant registers a tenant from a JSON body or form post.
+func (h AdminHandler) CreateTenant(w http.ResponseWriter, r *http.Request) {
+	var in tenancy.TenantInput
+	if err := decodeInput(r, &in, func(form func(string) string) {
+		in = tenancy.TenantInput{
+			Slug:           form("slug"),
+			Name:           form("name"),
+			Description:    form("description"),
+			PrimaryContact: form("primaryContact"),
+		}
+	}); err != nil {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+
+	tenant, err := h.Repo.CreateTenant(r.Context(), in)
+	if err != nil {
+		if isFormPost(r) && errors.As(err, new(tenancy.ValidationErrors)) {
+			tenants, listErr := h.Repo.ListTenants(r.Context(), tenancy.IncludeArchived())
+			if listErr != nil {
+				http.Error(w, listErr.Error(), http.StatusInternalServerError)
+				return
+			}
+			writeHTML(w, http.StatusUnprocessableEntity, "Tenant administration", "",
+				templ.AdminTenantsPage(tenants, in, validationErrors(err)))
+			return
+		}
+		writeAdminError(w, err)
+		return
+	}
+	if isFormPost(r) {
+		http.Redirect(w, r, "/admin/tenants/"+tenant.Slug, http.StatusSeeOther)
+		return
+	}
+	writeJSON(w, http.StatusCreated, adminTenant(tenant))
+}
+
+// ShowTenant renders a tenant with all of its machines.
+func (h AdminHandler) ShowTenant(w http.ResponseWriter, r *http.Request) {
+	h.renderTenant(w, r, http.StatusOK, templ.AdminTenantForms{})
+}
+
+// UpdateTenant edits a tenant's descriptive attributes.
+func (h AdminHandler) UpdateTenant(w http.ResponseWriter, r *http.Request) {
+	slug := chi.URLParam(r, "tenantSlug")
+	var in tenancy.TenantInput
+	if err := decodeInput(r, &in, func(form func(string) string) {
+		in = tenancy.TenantInput{
+			Name:           form("name"),
+			Description:    form("description"),
+			PrimaryContact: form("primaryContact"),
+		}
+	}); err != nil {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+
+	tenant, err := h.Repo.UpdateTenant(r.Context(), slug, in)
+	if err != nil {
+		if isFormPost(r) && errors.As(err, new(tenancy.ValidationErrors)) {
+			h.renderTenant(w, r, http.StatusUnprocessableEntity, templ.AdminTenantForms{Tenant: &in, TenantErrors: validationErrors(err)})
+			return
+		}
+		writeAdminError(w, err)
+		return
+	}
+	if isFormPost(r) {
+		http.Redirect(w, r, "/admin/tenants/"+slug, http.StatusSeeOther)
+		return
+	}
+	writeJSON(w, http.StatusOK, adminTenant(tenant))
+}
+
+// ArchiveTenant hides a tenant from the launch flow.
+func (h AdminHandler) ArchiveTenant(w http.ResponseWriter, r *http.Request) {
+	slug := chi.URLParam(r, "tenantSlug")
+	if err := h.Repo.ArchiveTenant(r.Context(), slug); err != nil {
+		writeAdminError(w, err)
+		return
+	}
+	if isFormPost(r) {
+		http.Redirect(w, r, "/admin/tenants/"+slug, http.StatusSeeOther)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// CreateMachine registers a machine for the tenant.
+func (h AdminHandler) CreateMachine(w http.ResponseWriter, r *http.Request) {
+	slug := chi.URLParam(r, "tenantSlug")
+	var in tenancy.MachineInput
+	if err := decodeInput(r, &in, func(form func(string) string) {
+		in = machineInputFromForm(form)
+	}); err != nil {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+
+	if h.Quotas != nil {
+		if err := h.Quotas.CheckMachines(r.Context(), slug); err != nil {
+			writeAdminError(w, err)
+			return
+		}
+	}
+
+	machine, err := h.Repo.CreateMachine(r.Context(), slug, in)
+	if err != nil {
+		if isFormPost(r) && errors.As(err, new(tenancy.ValidationErrors)) {
+			h.renderTenant(w, r, http.StatusUnprocessableEntity, templ.AdminTenantForms{NewMachine: in, NewMachineErrors: validationErrors(err)})
+			return
+		}
+		writeAdminError(w, err)
+		return
+	}
+	if isFormPost(r) {
+		http.Redirect(w, r, "/admin/tenants/"+slug, http.StatusSeeOther)
+		return
+	}
+	writeJSON(w, http.StatusCreated, adminMachine(machine))
+}
+
+// UpdateMachine edits a machine's attributes.
+func (h AdminHandler) UpdateMachine(w http.ResponseWriter, r *http.Request) {
+	slug := chi.URLParam(r, "tenantSlug")
+	var in tenancy.MachineInput
+	if err := decodeInput(r, &in, func(form func(string) string) {
+		in = machineInputFromForm(form)
+	}); err != nil {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+
+	machineID := chi.URLParam(r, "machineID")
+	machine, err := h.Repo.UpdateMachine(r.Context(), slug, machineID, in)
+	if err != nil {
+		if isFormPost(r) && errors.As(err, new(tenancy.ValidationErrors)) {
+			h.renderTenant(w, r, http.StatusUnprocessableEntity, templ.AdminTenantForms{
+				EditedMachineID:     machineID,
+				EditedMachine:       in,
+				EditedMachineErrors: validationErrors(err),
+			})
+			return
+		}
+		writeAdminError(w, err)
+		return
+	}
+	if isFormPost(r) {
+		http.Redirect(w, r, "/admin/tenants/"+slug, http.StatusSeeOther)
+		return
+	}
+	writeJSON(w, http.StatusOK, adminMachine(machine))
+}
+
+// ArchiveMachine hides a machine from pickers.
+func (h AdminHandler) ArchiveMachine(w http.ResponseWriter, r *http.Request) {
+	slug := chi.URLParam(r, "tenantSlug")
+	if err := h.Repo.ArchiveMachine(r.Context(), slug, chi.URLParam(r, "machineID")); err != nil {
+		writeAdminError(w, err)
+		return
+	}
+	if isFormPost(r) {
+		http.Redirect(w, r, "/admin/tenants/"+slug, http.StatusSeeOther)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// renderTenant writes the tenant page; forms re-fills a rejected form post.
+func (h AdminHandler) renderTenant(w http.ResponseWriter, r *http.Request, status int, forms templ.AdminTenantForms) {
+	if forms.NewMachine.Category == "" {
+		forms.NewMachine.Category = "server"
+	}
+	slug := chi.URLParam(r, "tenantSlug")
+	tenants, err := h.Repo.ListTenants(r.Context(), tenancy.IncludeArchived())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	for _, tenant := range tenants {
+		if tenant.Slug != slug {
+			continue
+		}
+		if wantsJSON(r) {
+			writeJSON(w, status, adminTenant(tenant))
+			return
+		}
+		writeHTML(w, status, tenant.Name+" administration", tenant.Name,
+			templ.AdminTenantPage(tenant, tenant.Machines, forms))
+		return
+	}
+	http.Error(w, tenancy.ErrTenantNotFound.Error(), http.StatusNotFound)
+}
+
+// AdminTenant is the JSON representation returned by the admin API.
+type AdminTenant struct {
+	Slug           string         `json:"slug"`
+	Name           string         `json:"name"`
+	Description    string         `json:"description"`
+	PrimaryContact string         `json:"primaryContact"`
+	Archived       bool           `json:"archived"`
+	Machines       []AdminMachine `json:"machines"`
+}
+
+// AdminMachine is the JSON representation of a machine in the admin API.
+type AdminMachine struct {
+	MachineSummary
+	Archived bool `json:"archived"`
+}
+
+func adminTenant(tenant tenancy.Tenant) AdminTenant {
+	out := AdminTenant{
+		Slug:           tenant.Slug,
+		Name:           tenant.Name,
+		Description:    tenant.Description,
+		PrimaryContact: tenant.PrimaryContact,
+		Archived:       tenant.Archived(),
+		Machines:       make([]AdminMachine, 0, len(tenant.Machines)),
+	}
+	for _, machine := range tenant.Machines {
+		out.Machines = append(out.Machines, adminMachine(machine))
+	}
+	return out
+}
+
+func adminMachine(machine tenancy.Machine) AdminMachine {
+	return AdminMachine{
+		MachineSummary: summarizeMachine(machine, time.Now()),
+		Archived:       machine.Archived(),
+	}
+}
+
+func machineInputFromForm(form func(string) string) tenancy.MachineInput {
+	return tenancy.MachineInput{
+		Name:     form("name"),
+		Category: form("category"),
+		Hostname: form("hostname"),
+		Role:     form("role"),
+	}
+}
+
+func writeAdminError(w http.ResponseWriter, err error) {
+	var verrs tenancy.ValidationErrors
+	switch {
+	case errors.As(err, &verrs):
+		writeJSON(w, http.StatusUnprocessableEntity, map[string]any{
+			"error":  "validation failed",
+			"fields": verrs,
+		})
+	case errors.Is(err, tenancy.ErrTenantNotFound), errors.Is(err, tenancy.ErrMachineNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, tenancy.ErrTenantExists):
+		http.Error(w, err.Error(), http.StatusConflict)
+	case errors.Is(err, quota.ErrQuotaExceeded):
+		http.Error(w, err.Error(), http.StatusTooManyRequests)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+func validationErrors(err error) tenancy.ValidationErrors {
+	var verrs tenancy.ValidationErrors
+	if errors.As(err, &verrs) {
+		return verrs
+	}
+	return nil
+}
+
+// decodeInput decodes JSON bodies into v and hands form posts to fromForm.
+func decodeInput(r *http.Request, v any, fromForm func(form func(string) string)) error {
+	if isFormPost(r) {
+		if err := r.ParseForm(); err != nil {
+			return err
+		}
+		fromForm(r.PostForm.Get)
+		return nil
+	}
+	return json.NewDecoder(r.Body).Decode(v)
+}
+
+func isFormPost(r *http.Request) bool {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return false
+	}
+	return mediaType == "application/x-www-form-urlencoded" || mediaType == "multipart/form-data"
+}
+
+func wantsJSON(r *http.Request) bool {
+	return strings.Contains(r.Header.Get("Accept"), "application/json")
+}
+
+func writeHTML(w http.ResponseWriter, status int, title, tenantLabel string, body template.HTML) {
+	markup := templ.RenderLayout(title, tenantLabel, "", body)
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	_, _ = w.Write([]byte(markup))
+}
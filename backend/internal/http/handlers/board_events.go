@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/mcmx/duplynx/internal/actions"
+	"github.com/mcmx/duplynx/internal/events"
+	"github.com/mcmx/duplynx/internal/scans"
+	"github.com/mcmx/duplynx/internal/templ"
+	"github.com/mcmx/duplynx/internal/templ/components"
+	"github.com/mcmx/duplynx/internal/tenancy"
+)
+
+// DefaultEventHeartbeat keeps idle event streams open through proxies.
+const DefaultEventHeartbeat = 25 * time.Second
+
+// BoardEventsHandler streams a scan board's changes as Server-Sent Events.
+// Each changed group is sent as a "card-<id>" event carrying the re-rendered
+// card, which the board's htmx SSE extension swaps in place; accepted
+// manifests are announced with a "scan-updated" event and lifecycle changes
+// with a "scan-progress" event carrying the re-rendered progress bar.
+type BoardEventsHandler struct {
+	Service scans.Service
+	Actions *actions.Repository
+	Bus     *events.Bus
+	// Heartbeat is the interval between keep-alive comments; DefaultEventHeartbeat when zero.
+	Heartbeat time.Duration
+}
+
+func (h BoardEventsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	scope, ok := tenancy.ScopeFromContext(r.Context())
+	if !ok {
+		http.Error(w, "tenant scope missing", http.StatusBadRequest)
+		return
+	}
+	scoped := tenancy.NewScopedRepository(scope, h.Service.Repo, nil)
+	summary, err := scoped.GetScan(r.Context(), chi.URLParam(r, "scanID"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok || h.Bus == nil {
+		http.Error(w, "event streaming unavailable", http.StatusNotImplemented)
+		return
+	}
+	// The server's write timeout would otherwise cut the stream.
+	_ = http.NewResponseController(w).SetWriteDeadline(time.Time{})
+
+	stream, cancel := h.Bus.Subscribe(events.Filter{TenantSlug: scope.TenantSlug, ScanID: summary.ID})
+	defer cancel()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	_, _ = io.WriteString(w, ": connected\n\n")
+	flusher.Flush()
+
+	heartbeat := h.Heartbeat
+	if heartbeat <= 0 {
+		heartbeat = DefaultEventHeartbeat
+	}
+	ticker := time.NewTicker(heartbeat)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-ticker.C:
+			if _, err := io.WriteString(w, ": heartbeat\n\n"); err != nil {
+				return
+			}
+		case event, open := <-stream:
+			if !open {
+				return
+			}
+			name, data, ok := h.render(r, event)
+			if !ok {
+				continue
+			}
+			if err := writeEvent(w, name, data); err != nil {
+				return
+			}
+		}
+		flusher.Flush()
+	}
+}
+
+// render turns a bus event into an SSE event name and HTML payload. Groups
+// that no longer load in the request's tenant scope are skipped.
+func (h BoardEventsHandler) render(r *http.Request, event events.Event) (string, string, bool) {
+	switch event.Type {
+	case events.TypeGroupChanged:
+		if h.Actions == nil {
+			return "", "", false
+		}
+		gid, err := uuid.Parse(event.GroupID)
+		if err != nil {
+			return "", "", false
+		}
+		group, err := h.Actions.Get(r.Context(), gid)
+		if err != nil || group.TenantSlug != event.TenantSlug {
+			return "", "", false
+		}
+		return "card-" + group.ID, string(components.DuplicateCard(group)), true
+	case events.TypeScanIngested:
+		return "scan-updated", string(templ.ScanUpdatedNotice(event.At)), true
+	case events.TypeScanProgress:
+		scope, ok := tenancy.ScopeFromContext(r.Context())
+		if !ok {
+			return "", "", false
+		}
+		summary, err := tenancy.NewScopedRepository(scope, h.Service.Repo, nil).GetScan(r.Context(), event.ScanID)
+		if err != nil {
+			return "", "", false
+		}
+		return "scan-progress", string(templ.ScanProgress(summary)), true
+	}
+	return "", "", false
+}
+
+// writeEvent writes one SSE event, splitting multi-line data across data fields.
+func writeEvent(w io.Writer, name, data string) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "event: %s\n", name)
+	for _, line := range strings.Split(data, "\n") {
+		b.WriteString("data: " + line + "\n")
+	}
+	b.WriteString("\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
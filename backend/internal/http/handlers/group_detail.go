@@ -0,0 +1,348 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/mcmx/duplynx/internal/actions"
+	"github.com/mcmx/duplynx/internal/identity"
+	"github.com/mcmx/duplynx/internal/templ"
+	"github.com/mcmx/duplynx/internal/tenancy"
+)
+
+// defaultNoteAuthor is recorded when a note is written without naming its author.
+const defaultNoteAuthor = "steward"
+
+// GroupDetailHandler serves the duplicate group page and its steward notes.
+type GroupDetailHandler struct {
+	Dispatcher *actions.Dispatcher
+	// Identities adds the group's history across scans when set.
+	Identities *identity.Repository
+}
+
+// Show renders the group's files, keeper, timeline and notes, or JSON when asked.
+func (h GroupDetailHandler) Show(w http.ResponseWriter, r *http.Request) {
+	h.render(w, r, http.StatusOK, templ.NoteFeedback{})
+}
+
+// CreateNote adds a steward note to the group.
+func (h GroupDetailHandler) CreateNote(w http.ResponseWriter, r *http.Request) {
+	h.saveNote(w, r, "")
+}
+
+// UpdateNote records a new revision of an existing note.
+func (h GroupDetailHandler) UpdateNote(w http.ResponseWriter, r *http.Request) {
+	h.saveNote(w, r, chi.URLParam(r, "noteId"))
+}
+
+// SearchNotes finds the scoped tenant's notes containing the q parameter.
+func (h GroupDetailHandler) SearchNotes(w http.ResponseWriter, r *http.Request) {
+	scope, ok := tenancy.ScopeFromContext(r.Context())
+	if !ok {
+		http.Error(w, "tenant scope missing", http.StatusBadRequest)
+		return
+	}
+	query := r.URL.Query().Get("q")
+	notes, err := h.Dispatcher.Repo.SearchNotes(r.Context(), query)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if wantsHTML(r) {
+		writePage(w, r, http.StatusOK, "Note search", templ.NoteSearchPage(scope.TenantSlug, query, notes))
+		return
+	}
+	resp := struct {
+		Query string         `json:"query"`
+		Notes []NoteResponse `json:"notes"`
+	}{Query: query, Notes: make([]NoteResponse, 0, len(notes))}
+	for _, note := range notes {
+		resp.Notes = append(resp.Notes, noteResponse(note))
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (h GroupDetailHandler) saveNote(w http.ResponseWriter, r *http.Request, noteID string) {
+	scope, ok := tenancy.ScopeFromContext(r.Context())
+	if !ok {
+		http.Error(w, "tenant scope missing", http.StatusBadRequest)
+		return
+	}
+	var in struct {
+		Body   string `json:"body"`
+		Author string `json:"author"`
+	}
+	if err := decodeInput(r, &in, func(form func(string) string) {
+		in.Body = form("body")
+		in.Author = form("author")
+	}); err != nil {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+	if in.Author == "" {
+		in.Author = defaultNoteAuthor
+	}
+
+	groupID := chi.URLParam(r, "groupId")
+	var (
+		note actions.Note
+		err  error
+	)
+	if noteID == "" {
+		note, err = h.Dispatcher.AddNote(r.Context(), groupID, scope.TenantSlug, in.Author, in.Body)
+	} else {
+		note, err = h.Dispatcher.EditNote(r.Context(), groupID, scope.TenantSlug, in.Author, noteID, in.Body)
+	}
+
+	if isFormPost(r) {
+		if err != nil && isNoteInputError(err) {
+			h.render(w, r, http.StatusUnprocessableEntity, templ.NoteFeedback{NoteID: noteID, Draft: in.Body, Error: err.Error()})
+			return
+		}
+		if err != nil {
+			http.Error(w, err.Error(), statusFromNoteError(err))
+			return
+		}
+		http.Redirect(w, r, "/duplicate-groups/"+url.PathEscape(groupID)+"#notes", http.StatusSeeOther)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), statusFromNoteError(err))
+		return
+	}
+	status := http.StatusOK
+	if noteID == "" {
+		status = http.StatusCreated
+	}
+	writeJSON(w, status, noteResponse(note))
+}
+
+func (h GroupDetailHandler) render(w http.ResponseWriter, r *http.Request, status int, feedback templ.NoteFeedback) {
+	scope, ok := tenancy.ScopeFromContext(r.Context())
+	if !ok {
+		http.Error(w, "tenant scope missing", http.StatusBadRequest)
+		return
+	}
+	repo := h.Dispatcher.Repo
+	gid, err := uuid.Parse(chi.URLParam(r, "groupId"))
+	if err != nil {
+		http.Error(w, actions.ErrGroupNotFound.Error(), http.StatusNotFound)
+		return
+	}
+	group, err := repo.Get(r.Context(), gid)
+	if err == nil && group.TenantSlug != scope.TenantSlug {
+		err = actions.ErrGroupNotFound
+	}
+	if err != nil {
+		http.Error(w, err.Error(), statusFromNoteError(err))
+		return
+	}
+	timeline, err := repo.Timeline(r.Context(), gid)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	notes, err := repo.Notes(r.Context(), gid)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var history *identity.History
+	if h.Identities != nil {
+		found, ok, err := h.Identities.HistoryForGroup(r.Context(), gid)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if ok {
+			history = &found
+		}
+	}
+
+	if wantsJSON(r) {
+		writeJSON(w, status, groupDetailResponse(group, history, timeline, notes))
+		return
+	}
+	writePage(w, r, status, "Duplicate group "+group.Hash, templ.GroupDetailPage(group, history, timeline, notes, feedback))
+}
+
+func isNoteInputError(err error) bool {
+	return errors.Is(err, actions.ErrNoteBodyRequired) || errors.Is(err, actions.ErrNoteTooLong)
+}
+
+func statusFromNoteError(err error) int {
+	switch {
+	case errors.Is(err, actions.ErrNoteNotFound):
+		return http.StatusNotFound
+	case isNoteInputError(err):
+		return http.StatusUnprocessableEntity
+	default:
+		return statusFromActionsError(err)
+	}
+}
+
+// GroupDetailResponse is the JSON representation of the duplicate group page.
+type GroupDetailResponse struct {
+	ID              string             `json:"id"`
+	ScanID          string             `json:"scanId"`
+	Hash            string             `json:"hash"`
+	Status          string             `json:"status"`
+	KeeperMachineID string             `json:"keeperMachineId,omitempty"`
+	Files           []GroupFile        `json:"files"`
+	History         *HistoryResponse   `json:"history,omitempty"`
+	Timeline        []TimelineResponse `json:"timeline"`
+	Notes           []NoteResponse     `json:"notes"`
+}
+
+// GroupFile describes one file instance of a duplicate group.
+type GroupFile struct {
+	ID          string    `json:"id"`
+	MachineID   string    `json:"machineId"`
+	MachineName string    `json:"machineName"`
+	Path        string    `json:"path"`
+	SizeBytes   int64     `json:"sizeBytes"`
+	LastSeenAt  time.Time `json:"lastSeenAt"`
+	Quarantined bool      `json:"quarantined"`
+}
+
+// HistoryResponse follows the group's content across the tenant's scans.
+type HistoryResponse struct {
+	FirstSeenAt       time.Time          `json:"firstSeenAt"`
+	LastSeenAt        time.Time          `json:"lastSeenAt"`
+	KeeperMachineName string             `json:"keeperMachineName,omitempty"`
+	ResolvedAt        *time.Time         `json:"resolvedAt,omitempty"`
+	Sightings         []SightingResponse `json:"sightings"`
+	Events            []CopyEvent        `json:"events"`
+}
+
+// SightingResponse is the group one scan created for the same content.
+type SightingResponse struct {
+	GroupID   string    `json:"groupId"`
+	ScanID    string    `json:"scanId"`
+	ScanName  string    `json:"scanName"`
+	SeenAt    time.Time `json:"seenAt"`
+	Status    string    `json:"status"`
+	FileCount int       `json:"fileCount"`
+	Current   bool      `json:"current"`
+}
+
+// CopyEvent is a copy appearing in or disappearing from a scan.
+type CopyEvent struct {
+	Kind        string    `json:"kind"`
+	ScanName    string    `json:"scanName"`
+	MachineName string    `json:"machineName"`
+	Path        string    `json:"path"`
+	OccurredAt  time.Time `json:"occurredAt"`
+}
+
+// TimelineResponse is one action audit on a duplicate group.
+type TimelineResponse struct {
+	ID          string         `json:"id"`
+	Type        string         `json:"type"`
+	Actor       string         `json:"actor"`
+	PerformedAt time.Time      `json:"performedAt"`
+	Payload     map[string]any `json:"payload,omitempty"`
+	Stubbed     bool           `json:"stubbed"`
+}
+
+// NoteResponse is a steward note with its revision history.
+type NoteResponse struct {
+	ID        string                 `json:"id"`
+	GroupID   string                 `json:"groupId"`
+	Body      string                 `json:"body"`
+	Author    string                 `json:"author"`
+	CreatedAt time.Time              `json:"createdAt"`
+	UpdatedAt time.Time              `json:"updatedAt"`
+	Revisions []NoteRevisionResponse `json:"revisions"`
+}
+
+// NoteRevisionResponse is one saved version of a note.
+type NoteRevisionResponse struct {
+	Body  string    `json:"body"`
+	Actor string    `json:"actor"`
+	At    time.Time `json:"at"`
+}
+
+func groupDetailResponse(group actions.DuplicateGroup, history *identity.History, timeline []actions.TimelineEntry, notes []actions.Note) GroupDetailResponse {
+	out := GroupDetailResponse{
+		ID:              group.ID,
+		ScanID:          group.ScanID,
+		Hash:            group.Hash,
+		Status:          group.Status,
+		KeeperMachineID: group.KeeperMachineID,
+		Files:           make([]GroupFile, 0, len(group.Files)),
+		Timeline:        make([]TimelineResponse, 0, len(timeline)),
+		Notes:           make([]NoteResponse, 0, len(notes)),
+	}
+	for _, file := range group.Files {
+		out.Files = append(out.Files, GroupFile{
+			ID:          file.ID,
+			MachineID:   file.MachineID,
+			MachineName: file.MachineName,
+			Path:        file.Path,
+			SizeBytes:   file.SizeBytes,
+			LastSeenAt:  file.LastSeenAt,
+			Quarantined: file.Quarantined,
+		})
+	}
+	if history != nil {
+		out.History = historyResponse(*history)
+	}
+	for _, entry := range timeline {
+		out.Timeline = append(out.Timeline, TimelineResponse{
+			ID:          entry.ID,
+			Type:        entry.Type,
+			Actor:       entry.Actor,
+			PerformedAt: entry.PerformedAt,
+			Payload:     entry.Payload,
+			Stubbed:     entry.Stubbed,
+		})
+	}
+	for _, note := range notes {
+		out.Notes = append(out.Notes, noteResponse(note))
+	}
+	return out
+}
+
+func historyResponse(history identity.History) *HistoryResponse {
+	out := &HistoryResponse{
+		FirstSeenAt:       history.FirstSeenAt,
+		LastSeenAt:        history.LastSeenAt,
+		KeeperMachineName: history.KeeperMachineName,
+		Sightings:         make([]SightingResponse, 0, len(history.Sightings)),
+		Events:            make([]CopyEvent, 0, len(history.Events)),
+	}
+	if !history.ResolvedAt.IsZero() {
+		resolvedAt := history.ResolvedAt
+		out.ResolvedAt = &resolvedAt
+	}
+	for _, sighting := range history.Sightings {
+		out.Sightings = append(out.Sightings, SightingResponse(sighting))
+	}
+	for _, event := range history.Events {
+		out.Events = append(out.Events, CopyEvent(event))
+	}
+	return out
+}
+
+func noteResponse(note actions.Note) NoteResponse {
+	out := NoteResponse{
+		ID:        note.ID,
+		GroupID:   note.GroupID,
+		Body:      note.Body,
+		Author:    note.Author,
+		CreatedAt: note.CreatedAt,
+		UpdatedAt: note.UpdatedAt,
+		Revisions: make([]NoteRevisionResponse, 0, len(note.Revisions)),
+	}
+	for _, revision := range note.Revisions {
+		out.Revisions = append(out.Revisions, NoteRevisionResponse{Body: revision.Body, Actor: revision.Actor, At: revision.At})
+	}
+	return out
+}
@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"errors"
+	"html/template"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/mcmx/duplynx/internal/http/session"
+	"github.com/mcmx/duplynx/internal/templ"
+	"github.com/mcmx/duplynx/internal/tenancy"
+)
+
+// LaunchHandler renders the tenant picker that starts the launch flow.
+type LaunchHandler struct {
+	Repo *tenancy.Repository
+}
+
+func (h LaunchHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	tenants, err := h.Repo.ListTenants(r.Context())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writePage(w, r, http.StatusOK, "DupLynx", templ.LaunchPage(tenants))
+}
+
+// MachineSelectHandler records the machine picked in the launch flow and
+// remembers it in the session for the breadcrumb.
+type MachineSelectHandler struct {
+	Repo *tenancy.Repository
+}
+
+func (h MachineSelectHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	scope, ok := tenancy.ScopeFromContext(r.Context())
+	if !ok {
+		http.Error(w, "tenant scope missing", http.StatusBadRequest)
+		return
+	}
+
+	var in struct {
+		MachineID string `json:"machineId"`
+	}
+	if err := decodeInput(r, &in, func(form func(string) string) {
+		in.MachineID = form("machineId")
+	}); err != nil {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+	if strings.TrimSpace(in.MachineID) == "" {
+		http.Error(w, "machineId is required", http.StatusBadRequest)
+		return
+	}
+
+	machine, err := h.Repo.FindMachine(r.Context(), scope.TenantSlug, in.MachineID)
+	if err != nil {
+		if errors.Is(err, tenancy.ErrMachineNotFound) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	h.Repo.LogMachineSelection(scope.TenantSlug, machine)
+
+	sess := rememberTenant(r, h.Repo, scope)
+	sess.MachineID = machine.ID
+	sess.MachineName = machine.Name
+	session.Save(w, r, sess)
+
+	if isFormPost(r) {
+		http.Redirect(w, r, "/tenants/"+url.PathEscape(scope.TenantSlug)+"/scans", http.StatusSeeOther)
+		return
+	}
+	writeJSON(w, http.StatusOK, summarizeMachine(machine, time.Now()))
+}
+
+// rememberTenant switches the caller's session to the scoped tenant and returns
+// it; the caller is responsible for saving any further changes.
+func rememberTenant(r *http.Request, repo *tenancy.Repository, scope tenancy.Scope) session.Session {
+	sess := session.FromContext(r.Context())
+	name := scope.TenantSlug
+	if repo != nil {
+		if tenant, ok := repo.Tenant(scope.TenantSlug); ok {
+			name = tenant.Name
+		}
+	}
+	sess.SelectTenant(scope.TenantSlug, name)
+	return sess
+}
+
+// wantsHTML reports whether the client asked for a page rather than the JSON
+// API; browsers send text/html, API clients typically send nothing.
+func wantsHTML(r *http.Request) bool {
+	return strings.Contains(r.Header.Get("Accept"), "text/html") && !wantsJSON(r)
+}
+
+// writePage renders a full page whose breadcrumb comes from the caller's
+// session. When the session belongs to a different tenant than the request's
+// scope, the scoped tenant slug is shown instead.
+func writePage(w http.ResponseWriter, r *http.Request, status int, title string, body template.HTML) {
+	sess := session.FromContext(r.Context())
+	tenantLabel, machineLabel := sess.TenantName, sess.MachineName
+	if scope, ok := tenancy.ScopeFromContext(r.Context()); ok && scope.TenantSlug != sess.TenantSlug {
+		tenantLabel, machineLabel = scope.TenantSlug, ""
+	}
+	markup := templ.RenderLayout(title, tenantLabel, machineLabel, body)
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	_, _ = w.Write([]byte(markup))
+}
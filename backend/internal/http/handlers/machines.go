@@ -3,11 +3,16 @@ package handlers
 import (
 	"encoding/json"
 	"net/http"
+	"time"
 
+	"github.com/mcmx/duplynx/internal/http/session"
+	"github.com/mcmx/duplynx/internal/templ"
 	"github.com/mcmx/duplynx/internal/tenancy"
 )
 
 // MachinesHandler lists machines for a tenant and records selection analytics.
+// Browsers get the machine picker page, which also makes the tenant the
+// session's active tenant.
 type MachinesHandler struct {
 	Repo *tenancy.Repository
 }
@@ -37,18 +42,21 @@ func (h MachinesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	if wantsHTML(r) {
+		sess := rememberTenant(r, h.Repo, scope)
+		session.Save(w, r, sess)
+		tenant := tenancy.Tenant{Slug: scope.TenantSlug, Name: sess.TenantName}
+		writePage(w, r, http.StatusOK, sess.TenantName+" machines", templ.MachinePickerPage(tenant, machines, sess.MachineID, time.Now()))
+		return
+	}
+
 	resp := struct {
 		Machines []MachineSummary `json:"machines"`
 	}{}
 
+	now := time.Now()
 	for _, machine := range machines {
-		resp.Machines = append(resp.Machines, MachineSummary{
-			ID:       machine.ID,
-			Name:     machine.Name,
-			Category: machine.Category,
-			Hostname: machine.Hostname,
-			Role:     machine.Role,
-		})
+		resp.Machines = append(resp.Machines, summarizeMachine(machine, now))
 	}
 
 	w.Header().Set("Content-Type", "application/json")
@@ -58,10 +66,34 @@ func (h MachinesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 }
 
 // MachineSummary is exposed to clients selecting keepers and running scans.
+// Online is true while the machine's agent is polling for jobs.
 type MachineSummary struct {
-	ID       string `json:"id"`
-	Name     string `json:"name"`
-	Category string `json:"category"`
-	Hostname string `json:"hostname"`
-	Role     string `json:"role"`
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Category   string     `json:"category"`
+	Hostname   string     `json:"hostname"`
+	Role       string     `json:"role"`
+	Online     bool       `json:"online"`
+	LastSeenAt *time.Time `json:"lastSeenAt,omitempty"`
+	LastScanAt *time.Time `json:"lastScanAt,omitempty"`
+}
+
+func summarizeMachine(machine tenancy.Machine, now time.Time) MachineSummary {
+	summary := MachineSummary{
+		ID:       machine.ID,
+		Name:     machine.Name,
+		Category: machine.Category,
+		Hostname: machine.Hostname,
+		Role:     machine.Role,
+		Online:   machine.Online(now),
+	}
+	if !machine.LastSeenAt.IsZero() {
+		seen := machine.LastSeenAt
+		summary.LastSeenAt = &seen
+	}
+	if !machine.LastScanAt.IsZero() {
+		scanned := machine.LastScanAt
+		summary.LastScanAt = &scanned
+	}
+	return summary
 }
@@ -0,0 +1,156 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/mcmx/duplynx/internal/quota"
+	"github.com/mcmx/duplynx/internal/tenancy"
+)
+
+// QuotaHandler reports tenant usage and manages per-tenant quota overrides.
+type QuotaHandler struct {
+	Quotas *quota.Enforcer
+}
+
+// QuotaLimits is the JSON representation of effective or overridden limits.
+type QuotaLimits struct {
+	ManifestBytes     *int64 `json:"manifestBytes"`
+	IngestPerMinute   *int   `json:"ingestPerMinute"`
+	Machines          *int   `json:"machines"`
+	RetainedScans     *int   `json:"retainedScans"`
+	ConcurrentActions *int   `json:"concurrentActions"`
+}
+
+// QuotaUsage pairs a tenant's consumption with its effective limits; a zero limit is unlimited.
+type QuotaUsage struct {
+	TenantSlug string      `json:"tenantSlug"`
+	Limits     QuotaLimits `json:"limits"`
+	Usage      struct {
+		Machines          int `json:"machines"`
+		RetainedScans     int `json:"retainedScans"`
+		IngestLastMinute  int `json:"ingestLastMinute"`
+		ConcurrentActions int `json:"concurrentActions"`
+	} `json:"usage"`
+	Overrides *QuotaLimits `json:"overrides,omitempty"`
+}
+
+// ListUsage responds with every active tenant's usage.
+func (h QuotaHandler) ListUsage(w http.ResponseWriter, r *http.Request) {
+	if !h.configured(w) {
+		return
+	}
+	usages, err := h.Quotas.UsageAll(r.Context())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	resp := struct {
+		Tenants []QuotaUsage `json:"tenants"`
+	}{Tenants: make([]QuotaUsage, 0, len(usages))}
+	for _, usage := range usages {
+		resp.Tenants = append(resp.Tenants, quotaUsage(usage))
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// TenantUsage responds with the scoped tenant's usage.
+func (h QuotaHandler) TenantUsage(w http.ResponseWriter, r *http.Request) {
+	if !h.configured(w) {
+		return
+	}
+	scope, ok := tenancy.ScopeFromContext(r.Context())
+	if !ok {
+		http.Error(w, "tenant scope missing", http.StatusBadRequest)
+		return
+	}
+	usage, err := h.Quotas.Usage(r.Context(), scope.TenantSlug)
+	if err != nil {
+		writeAdminError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, quotaUsage(usage))
+}
+
+// ShowTenant responds with a tenant's usage and its stored overrides.
+func (h QuotaHandler) ShowTenant(w http.ResponseWriter, r *http.Request) {
+	if !h.configured(w) {
+		return
+	}
+	h.writeTenant(w, r, chi.URLParam(r, "tenantSlug"))
+}
+
+// UpdateTenant replaces a tenant's overrides; omitted or null limits inherit the defaults.
+func (h QuotaHandler) UpdateTenant(w http.ResponseWriter, r *http.Request) {
+	if !h.configured(w) {
+		return
+	}
+	var req QuotaLimits
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+	slug := chi.URLParam(r, "tenantSlug")
+	if _, err := h.Quotas.SetOverrides(r.Context(), slug, quota.Overrides{
+		ManifestBytes:     req.ManifestBytes,
+		IngestPerMinute:   req.IngestPerMinute,
+		Machines:          req.Machines,
+		RetainedScans:     req.RetainedScans,
+		ConcurrentActions: req.ConcurrentActions,
+	}); err != nil {
+		writeAdminError(w, err)
+		return
+	}
+	h.writeTenant(w, r, slug)
+}
+
+func (h QuotaHandler) writeTenant(w http.ResponseWriter, r *http.Request, slug string) {
+	usage, err := h.Quotas.Usage(r.Context(), slug)
+	if err != nil {
+		writeAdminError(w, err)
+		return
+	}
+	overrides, err := h.Quotas.Overrides(r.Context(), slug)
+	if err != nil {
+		writeAdminError(w, err)
+		return
+	}
+	resp := quotaUsage(usage)
+	resp.Overrides = &QuotaLimits{
+		ManifestBytes:     overrides.ManifestBytes,
+		IngestPerMinute:   overrides.IngestPerMinute,
+		Machines:          overrides.Machines,
+		RetainedScans:     overrides.RetainedScans,
+		ConcurrentActions: overrides.ConcurrentActions,
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (h QuotaHandler) configured(w http.ResponseWriter) bool {
+	if h.Quotas == nil {
+		http.Error(w, "quota enforcement not configured", http.StatusServiceUnavailable)
+		return false
+	}
+	return true
+}
+
+func quotaUsage(usage quota.Usage) QuotaUsage {
+	limits := usage.Limits
+	out := QuotaUsage{
+		TenantSlug: usage.TenantSlug,
+		Limits: QuotaLimits{
+			ManifestBytes:     &limits.ManifestBytes,
+			IngestPerMinute:   &limits.IngestPerMinute,
+			Machines:          &limits.Machines,
+			RetainedScans:     &limits.RetainedScans,
+			ConcurrentActions: &limits.ConcurrentActions,
+		},
+	}
+	out.Usage.Machines = usage.Machines
+	out.Usage.RetainedScans = usage.RetainedScans
+	out.Usage.IngestLastMinute = usage.IngestLastMinute
+	out.Usage.ConcurrentActions = usage.ConcurrentActions
+	return out
+}
@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/mcmx/duplynx/internal/reclaim"
+	"github.com/mcmx/duplynx/internal/templ"
+	"github.com/mcmx/duplynx/internal/tenancy"
+)
+
+// maxTopDirectories bounds the top query parameter.
+const maxTopDirectories = 100
+
+// ReclaimHandler serves the scoped tenant's reclaimable space dashboard.
+// JSON clients get the report; browsers get the rendered page.
+type ReclaimHandler struct {
+	Repo *reclaim.Repository
+}
+
+func (h ReclaimHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	scope, ok := tenancy.ScopeFromContext(r.Context())
+	if !ok {
+		http.Error(w, "tenant scope missing", http.StatusBadRequest)
+		return
+	}
+	top := reclaim.DefaultTopDirectories
+	if raw := r.URL.Query().Get("top"); raw != "" {
+		n, err := strconv.Atoi(raw)
+		if err != nil || n < 1 || n > maxTopDirectories {
+			http.Error(w, "top must be between 1 and 100", http.StatusBadRequest)
+			return
+		}
+		top = n
+	}
+	report, err := h.Repo.Report(r.Context(), top)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if wantsHTML(r) {
+		writePage(w, r, http.StatusOK, "Reclaimable space", templ.ReclaimPage(scope.TenantSlug, report))
+		return
+	}
+	writeJSON(w, http.StatusOK, reclaimResponse(scope.TenantSlug, report))
+}
+
+// ReclaimResponse is the JSON representation of the reclaimable space dashboard.
+type ReclaimResponse struct {
+	TenantSlug        string             `json:"tenantSlug"`
+	ReclaimableBytes  int64              `json:"reclaimableBytes"`
+	ReclaimableGroups int                `json:"reclaimableGroups"`
+	ReclaimedBytes    int64              `json:"reclaimedBytes"`
+	ReclaimedGroups   int                `json:"reclaimedGroups"`
+	SimulatedBytes    int64              `json:"simulatedBytes"`
+	ByMachine         []ReclaimBreakdown `json:"byMachine"`
+	ByScan            []ReclaimBreakdown `json:"byScan"`
+	ByStatus          []ReclaimBreakdown `json:"byStatus"`
+	TopDirectories    []ReclaimBreakdown `json:"topDirectories"`
+}
+
+// ReclaimBreakdown is the reclaimable space attributed to one machine, scan, status or directory.
+type ReclaimBreakdown struct {
+	Key    string `json:"key"`
+	Label  string `json:"label"`
+	Groups int    `json:"groups"`
+	Files  int    `json:"files,omitempty"`
+	Bytes  int64  `json:"bytes"`
+}
+
+func reclaimResponse(tenantSlug string, report reclaim.Report) ReclaimResponse {
+	return ReclaimResponse{
+		TenantSlug:        tenantSlug,
+		ReclaimableBytes:  report.ReclaimableBytes,
+		ReclaimableGroups: report.ReclaimableGroups,
+		ReclaimedBytes:    report.ReclaimedBytes,
+		ReclaimedGroups:   report.ReclaimedGroups,
+		SimulatedBytes:    report.SimulatedBytes,
+		ByMachine:         reclaimBreakdowns(report.ByMachine),
+		ByScan:            reclaimBreakdowns(report.ByScan),
+		ByStatus:          reclaimBreakdowns(report.ByStatus),
+		TopDirectories:    reclaimBreakdowns(report.TopDirectories),
+	}
+}
+
+func reclaimBreakdowns(rows []reclaim.Breakdown) []ReclaimBreakdown {
+	out := make([]ReclaimBreakdown, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, ReclaimBreakdown{Key: row.Key, Label: row.Label, Groups: row.Groups, Files: row.Files, Bytes: row.Bytes})
+	}
+	return out
+}
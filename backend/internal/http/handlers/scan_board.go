@@ -2,19 +2,31 @@ package handlers
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
 
+	"github.com/mcmx/duplynx/internal/actions"
 	"github.com/mcmx/duplynx/internal/scans"
+	"github.com/mcmx/duplynx/internal/templ"
 	"github.com/mcmx/duplynx/internal/tenancy"
 )
 
-// ScanBoardHandler returns the scan summary with duplicate status counts.
+// ScanBoardHandler renders the scan board, or the scan summary with duplicate
+// status counts when the client asks for JSON. Filters, sort order and lane
+// cursors come from the query string (see actions.ParseBoardQuery).
 type ScanBoardHandler struct {
 	Service scans.Service
+	Actions *actions.Repository
+	// Tenants lists the machines offered by the board's machine filter.
+	Tenants *tenancy.Repository
 }
 
+// boardLanes are the duplicate group statuses shown as board lanes, in order.
+var boardLanes = []string{"review", "action_needed", "resolved", "archived"}
+
 func (h ScanBoardHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	scope, ok := tenancy.ScopeFromContext(r.Context())
 	if !ok {
@@ -30,8 +42,47 @@ func (h ScanBoardHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-	if err := json.NewEncoder(w).Encode(summary); err != nil {
-		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+	if wantsJSON(r) {
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(summary); err != nil {
+			http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	view := templ.BoardView{Lanes: make(map[string]actions.LanePage, len(boardLanes))}
+	if h.Actions != nil {
+		id, err := uuid.Parse(summary.ID)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		view.Query, err = actions.ParseBoardQuery(r.URL.Query())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		for _, status := range boardLanes {
+			page, err := h.Actions.ListLane(r.Context(), id, status, view.Query)
+			if err != nil {
+				if errors.Is(err, actions.ErrInvalidCursor) || errors.Is(err, actions.ErrInvalidMachineID) {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			view.Lanes[status] = page
+		}
+	}
+	if h.Tenants != nil {
+		machines, err := h.Tenants.ListMachines(r.Context(), scope.TenantSlug)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		view.Machines = machines
 	}
+
+	writePage(w, r, http.StatusOK, summary.Name, templ.PagedBoardPage(summary, view))
 }
@@ -4,13 +4,17 @@ import (
 	"encoding/json"
 	"net/http"
 
+	"github.com/mcmx/duplynx/internal/http/session"
 	"github.com/mcmx/duplynx/internal/scans"
+	"github.com/mcmx/duplynx/internal/templ"
 	"github.com/mcmx/duplynx/internal/tenancy"
 )
 
-// ScanListHandler lists scans for a tenant.
+// ScanListHandler lists scans for a tenant, rendering the scan catalog for browsers.
 type ScanListHandler struct {
 	Service scans.Service
+	// Tenants resolves tenant names for the catalog page and breadcrumb.
+	Tenants *tenancy.Repository
 }
 
 func (h ScanListHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
@@ -27,6 +31,14 @@ func (h ScanListHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if wantsHTML(r) {
+		sess := rememberTenant(r, h.Tenants, scope)
+		session.Save(w, r, sess)
+		tenant := tenancy.Tenant{Slug: scope.TenantSlug, Name: sess.TenantName}
+		writePage(w, r, http.StatusOK, sess.TenantName+" scans", templ.ScanCatalogPage(tenant, scanSummaries))
+		return
+	}
+
 	w.Header().Set("Content-Type", "application/json")
 	if err := json.NewEncoder(w).Encode(struct {
 		Scans []scans.ScanSummary `json:"scans"`
@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/mcmx/duplynx/internal/scans"
+	"github.com/mcmx/duplynx/internal/tenancy"
+)
+
+// ScanCampaignHandler creates scans that span several of the scoped tenant's
+// machines. Each machine's agent picks up its roots by polling /ingest/assignments.
+type ScanCampaignHandler struct {
+	Repo *scans.Repository
+}
+
+type campaignRequest struct {
+	Name        string                  `json:"name"`
+	Description string                  `json:"description"`
+	Deadline    *time.Time              `json:"deadline"`
+	Targets     []campaignTargetRequest `json:"targets"`
+}
+
+type campaignTargetRequest struct {
+	MachineID  string   `json:"machineId"`
+	Roots      []string `json:"roots"`
+	Exclusions []string `json:"exclusions"`
+}
+
+func (h ScanCampaignHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if _, ok := tenancy.ScopeFromContext(r.Context()); !ok {
+		http.Error(w, "tenant scope missing", http.StatusBadRequest)
+		return
+	}
+	var req campaignRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+
+	in := scans.CampaignInput{Name: req.Name, Description: req.Description}
+	if req.Deadline != nil {
+		in.Deadline = *req.Deadline
+	}
+	for _, target := range req.Targets {
+		machineID, err := uuid.Parse(target.MachineID)
+		if err != nil {
+			http.Error(w, "machineId must be a machine ID", http.StatusBadRequest)
+			return
+		}
+		in.Targets = append(in.Targets, scans.TargetInput{MachineID: machineID, Roots: target.Roots, Exclusions: target.Exclusions})
+	}
+
+	summary, err := h.Repo.CreateCampaign(r.Context(), in)
+	switch {
+	case errors.Is(err, scans.ErrInvalidCampaign):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	case err != nil:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writeJSON(w, http.StatusCreated, summary)
+}
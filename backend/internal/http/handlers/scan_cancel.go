@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/mcmx/duplynx/internal/events"
+	"github.com/mcmx/duplynx/internal/scans"
+	"github.com/mcmx/duplynx/internal/tenancy"
+)
+
+// ScanCancelHandler cancels one of the scoped tenant's scans. The scan's
+// agent stops when its next heartbeat is answered with the cancellation.
+// Forms are redirected back to the board; JSON clients get the scan summary.
+type ScanCancelHandler struct {
+	Repo *scans.Repository
+	// Events announces the cancellation to live boards; nil disables it.
+	Events events.Publisher
+}
+
+func (h ScanCancelHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	scope, ok := tenancy.ScopeFromContext(r.Context())
+	if !ok {
+		http.Error(w, "tenant scope missing", http.StatusBadRequest)
+		return
+	}
+	var in struct {
+		Reason string `json:"reason"`
+	}
+	if err := decodeInput(r, &in, func(form func(string) string) {
+		in.Reason = form("reason")
+	}); err != nil && !errors.Is(err, io.EOF) {
+		// An empty body cancels without a reason.
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+
+	scanID := chi.URLParam(r, "scanID")
+	summary, err := h.Repo.Cancel(r.Context(), scanID, in.Reason)
+	if err == nil && summary.TenantSlug != scope.TenantSlug {
+		err = scans.ErrScanNotFound
+	}
+	switch {
+	case errors.Is(err, scans.ErrScanNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	case errors.Is(err, scans.ErrScanFinished):
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	case err != nil:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if h.Events != nil {
+		h.Events.Publish(events.Event{Type: events.TypeScanProgress, TenantSlug: scope.TenantSlug, ScanID: summary.ID})
+	}
+
+	if isFormPost(r) {
+		http.Redirect(w, r, "/scans/"+url.PathEscape(summary.ID), http.StatusSeeOther)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(summary); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+	}
+}
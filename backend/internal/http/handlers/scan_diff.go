@@ -0,0 +1,164 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/mcmx/duplynx/internal/scandiff"
+	"github.com/mcmx/duplynx/internal/scans"
+	"github.com/mcmx/duplynx/internal/templ"
+	"github.com/mcmx/duplynx/internal/tenancy"
+)
+
+var errDiffScansRequired = errors.New("from and to must be scan IDs")
+
+// ScanDiffHandler compares two of the scoped tenant's scans, chosen with the
+// from and to query parameters.
+type ScanDiffHandler struct {
+	Repo *scandiff.Repository
+	// Service lists the tenant's scans for the page's pickers.
+	Service scans.Service
+}
+
+func (h ScanDiffHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	scope, ok := tenancy.ScopeFromContext(r.Context())
+	if !ok {
+		http.Error(w, "tenant scope missing", http.StatusBadRequest)
+		return
+	}
+	params := r.URL.Query()
+	fromRaw, toRaw := params.Get("from"), params.Get("to")
+
+	var (
+		diff *scandiff.Diff
+		err  error
+	)
+	// Browsers opening the page without both scans just get the pickers.
+	if (fromRaw != "" && toRaw != "") || !wantsHTML(r) {
+		var found scandiff.Diff
+		found, err = h.diff(r, fromRaw, toRaw)
+		if err == nil {
+			diff = &found
+		}
+	}
+	if wantsHTML(r) {
+		listed, listErr := tenancy.NewScopedRepository(scope, h.Service.Repo, nil).ListScans(r.Context())
+		if listErr != nil {
+			http.Error(w, listErr.Error(), http.StatusInternalServerError)
+			return
+		}
+		// Only scans that finished can be compared.
+		summaries := make([]scans.ScanSummary, 0, len(listed))
+		for _, summary := range listed {
+			if lifecycle := summary.Lifecycle(); lifecycle == scans.StatusCompleted || lifecycle == scans.StatusPartial {
+				summaries = append(summaries, summary)
+			}
+		}
+		status, msg := http.StatusOK, ""
+		if err != nil {
+			status, msg = scanDiffErrorStatus(err), err.Error()
+		}
+		writePage(w, r, status, "Compare scans", templ.ScanDiffPage(scope.TenantSlug, summaries, fromRaw, toRaw, diff, msg))
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), scanDiffErrorStatus(err))
+		return
+	}
+	writeJSON(w, http.StatusOK, scanDiffResponse(*diff))
+}
+
+func (h ScanDiffHandler) diff(r *http.Request, fromRaw, toRaw string) (scandiff.Diff, error) {
+	fromID, fromErr := uuid.Parse(fromRaw)
+	toID, toErr := uuid.Parse(toRaw)
+	if fromErr != nil || toErr != nil {
+		return scandiff.Diff{}, errDiffScansRequired
+	}
+	return h.Repo.Diff(r.Context(), fromID, toID)
+}
+
+func scanDiffErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, scandiff.ErrScanNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, scandiff.ErrSameScan), errors.Is(err, scandiff.ErrScanOrder),
+		errors.Is(err, scandiff.ErrScanUnfinished), errors.Is(err, errDiffScansRequired):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// ScanDiffResponse is the JSON representation of a scan-to-scan diff.
+type ScanDiffResponse struct {
+	From                ScanDiffSide    `json:"from"`
+	To                  ScanDiffSide    `json:"to"`
+	RedundantBytesDelta int64           `json:"redundantBytesDelta"`
+	New                 []ScanDiffGroup `json:"new"`
+	Removed             []ScanDiffGroup `json:"removed"`
+	Changed             []ScanDiffGroup `json:"changed"`
+	Moves               []ScanDiffMove  `json:"moves"`
+	// Uncovered names machines only one scan looked at; their copies are not compared.
+	Uncovered []string `json:"uncovered,omitempty"`
+}
+
+// ScanDiffSide summarises one of the compared scans.
+type ScanDiffSide struct {
+	ID             string    `json:"id"`
+	Name           string    `json:"name"`
+	StartedAt      time.Time `json:"startedAt"`
+	Groups         int       `json:"groups"`
+	Files          int       `json:"files"`
+	Bytes          int64     `json:"bytes"`
+	RedundantBytes int64     `json:"redundantBytes"`
+	FilesPruned    bool      `json:"filesPruned,omitempty"`
+}
+
+// ScanDiffGroup is a hash that is new, removed or changed between the scans.
+type ScanDiffGroup struct {
+	Hash        string `json:"hash"`
+	FromGroupID string `json:"fromGroupId,omitempty"`
+	ToGroupID   string `json:"toGroupId,omitempty"`
+	FromFiles   int    `json:"fromFiles"`
+	ToFiles     int    `json:"toFiles"`
+	FromBytes   int64  `json:"fromBytes"`
+	ToBytes     int64  `json:"toBytes"`
+}
+
+// ScanDiffMove is a copy that moved from one machine to another.
+type ScanDiffMove struct {
+	Hash        string `json:"hash"`
+	ToGroupID   string `json:"toGroupId"`
+	FromMachine string `json:"fromMachine"`
+	FromPath    string `json:"fromPath"`
+	ToMachine   string `json:"toMachine"`
+	ToPath      string `json:"toPath"`
+}
+
+func scanDiffResponse(diff scandiff.Diff) ScanDiffResponse {
+	out := ScanDiffResponse{
+		From:                ScanDiffSide(diff.From),
+		To:                  ScanDiffSide(diff.To),
+		RedundantBytesDelta: diff.RedundantBytesDelta(),
+		New:                 scanDiffGroups(diff.New),
+		Removed:             scanDiffGroups(diff.Removed),
+		Changed:             scanDiffGroups(diff.Changed),
+		Moves:               make([]ScanDiffMove, 0, len(diff.Moves)),
+		Uncovered:           diff.Uncovered,
+	}
+	for _, move := range diff.Moves {
+		out.Moves = append(out.Moves, ScanDiffMove(move))
+	}
+	return out
+}
+
+func scanDiffGroups(groups []scandiff.Group) []ScanDiffGroup {
+	out := make([]ScanDiffGroup, 0, len(groups))
+	for _, group := range groups {
+		out = append(out, ScanDiffGroup(group))
+	}
+	return out
+}
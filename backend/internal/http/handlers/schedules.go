@@ -0,0 +1,228 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/mcmx/duplynx/internal/schedule"
+	"github.com/mcmx/duplynx/internal/tenancy"
+)
+
+// ScheduleHandler manages the scoped tenant's recurring scans.
+type ScheduleHandler struct {
+	Repo *schedule.Repository
+}
+
+type scheduleRequest struct {
+	Name       string   `json:"name"`
+	Cron       string   `json:"cron"`
+	Timezone   string   `json:"timezone"`
+	MachineIDs []string `json:"machineIds"`
+	Roots      []string `json:"roots"`
+	Exclusions []string `json:"exclusions"`
+	// Deadline is a Go duration such as "2h"; empty leaves runs without a deadline.
+	Deadline string `json:"deadline"`
+	// Enabled defaults to true when omitted.
+	Enabled *bool `json:"enabled"`
+}
+
+// ScheduleSummary describes a scan schedule.
+type ScheduleSummary struct {
+	ID         string               `json:"id"`
+	Name       string               `json:"name"`
+	Cron       string               `json:"cron"`
+	Timezone   string               `json:"timezone"`
+	MachineIDs []string             `json:"machineIds"`
+	Roots      []string             `json:"roots"`
+	Exclusions []string             `json:"exclusions,omitempty"`
+	Deadline   string               `json:"deadline,omitempty"`
+	Enabled    bool                 `json:"enabled"`
+	NextRunAt  time.Time            `json:"nextRunAt"`
+	LastRunAt  *time.Time           `json:"lastRunAt,omitempty"`
+	LastScanID string               `json:"lastScanId,omitempty"`
+	Runs       []ScheduleRunSummary `json:"runs,omitempty"`
+}
+
+// ScheduleRunSummary describes one fire time of a schedule.
+type ScheduleRunSummary struct {
+	ScheduledFor time.Time `json:"scheduledFor"`
+	Status       string    `json:"status"`
+	ScanID       string    `json:"scanId,omitempty"`
+	Reason       string    `json:"reason,omitempty"`
+}
+
+// List responds with the scoped tenant's schedules.
+func (h ScheduleHandler) List(w http.ResponseWriter, r *http.Request) {
+	scope, ok := h.scope(w, r)
+	if !ok {
+		return
+	}
+	schedules, err := h.Repo.List(r.Context(), scope.TenantSlug)
+	if err != nil {
+		writeScheduleError(w, err)
+		return
+	}
+	resp := struct {
+		Schedules []ScheduleSummary `json:"schedules"`
+	}{Schedules: make([]ScheduleSummary, 0, len(schedules))}
+	for _, s := range schedules {
+		resp.Schedules = append(resp.Schedules, summarizeSchedule(s))
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// Create stores a new schedule; its first run is the next matching minute.
+func (h ScheduleHandler) Create(w http.ResponseWriter, r *http.Request) {
+	scope, ok := h.scope(w, r)
+	if !ok {
+		return
+	}
+	in, ok := decodeSchedule(w, r)
+	if !ok {
+		return
+	}
+	created, err := h.Repo.Create(r.Context(), scope.TenantSlug, in)
+	if err != nil {
+		writeScheduleError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, summarizeSchedule(created))
+}
+
+// Show responds with a schedule and its most recent runs.
+func (h ScheduleHandler) Show(w http.ResponseWriter, r *http.Request) {
+	scope, ok := h.scope(w, r)
+	if !ok {
+		return
+	}
+	found, err := h.Repo.Get(r.Context(), scope.TenantSlug, chi.URLParam(r, "scheduleID"))
+	if err != nil {
+		writeScheduleError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, summarizeSchedule(found))
+}
+
+// Update replaces a schedule's settings.
+func (h ScheduleHandler) Update(w http.ResponseWriter, r *http.Request) {
+	scope, ok := h.scope(w, r)
+	if !ok {
+		return
+	}
+	in, ok := decodeSchedule(w, r)
+	if !ok {
+		return
+	}
+	updated, err := h.Repo.Update(r.Context(), scope.TenantSlug, chi.URLParam(r, "scheduleID"), in)
+	if err != nil {
+		writeScheduleError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, summarizeSchedule(updated))
+}
+
+// Delete removes a schedule and its run history.
+func (h ScheduleHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	scope, ok := h.scope(w, r)
+	if !ok {
+		return
+	}
+	if err := h.Repo.Delete(r.Context(), scope.TenantSlug, chi.URLParam(r, "scheduleID")); err != nil {
+		writeScheduleError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h ScheduleHandler) scope(w http.ResponseWriter, r *http.Request) (tenancy.Scope, bool) {
+	if h.Repo == nil {
+		http.Error(w, "scan schedules not configured", http.StatusServiceUnavailable)
+		return tenancy.Scope{}, false
+	}
+	scope, ok := tenancy.ScopeFromContext(r.Context())
+	if !ok {
+		http.Error(w, "tenant scope missing", http.StatusBadRequest)
+		return tenancy.Scope{}, false
+	}
+	return scope, true
+}
+
+func decodeSchedule(w http.ResponseWriter, r *http.Request) (schedule.Input, bool) {
+	var req scheduleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return schedule.Input{}, false
+	}
+	in := schedule.Input{
+		Name:       req.Name,
+		Cron:       req.Cron,
+		Timezone:   req.Timezone,
+		Roots:      req.Roots,
+		Exclusions: req.Exclusions,
+		Enabled:    req.Enabled == nil || *req.Enabled,
+	}
+	if req.Deadline != "" {
+		d, err := time.ParseDuration(req.Deadline)
+		if err != nil {
+			http.Error(w, "invalid deadline duration", http.StatusBadRequest)
+			return schedule.Input{}, false
+		}
+		in.Deadline = d
+	}
+	for _, raw := range req.MachineIDs {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			http.Error(w, "machineIds must be machine IDs", http.StatusBadRequest)
+			return schedule.Input{}, false
+		}
+		in.MachineIDs = append(in.MachineIDs, id)
+	}
+	return in, true
+}
+
+func writeScheduleError(w http.ResponseWriter, err error) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, schedule.ErrInvalidSchedule):
+		status = http.StatusBadRequest
+	case errors.Is(err, schedule.ErrScheduleNotFound), errors.Is(err, schedule.ErrTenantNotFound):
+		status = http.StatusNotFound
+	}
+	http.Error(w, err.Error(), status)
+}
+
+func summarizeSchedule(s schedule.Schedule) ScheduleSummary {
+	summary := ScheduleSummary{
+		ID:         s.ID,
+		Name:       s.Name,
+		Cron:       s.Cron,
+		Timezone:   s.Timezone,
+		MachineIDs: s.MachineIDs,
+		Roots:      s.Roots,
+		Exclusions: s.Exclusions,
+		Enabled:    s.Enabled,
+		NextRunAt:  s.NextRunAt,
+		LastScanID: s.LastScanID,
+	}
+	if s.Deadline > 0 {
+		summary.Deadline = s.Deadline.String()
+	}
+	if !s.LastRunAt.IsZero() {
+		last := s.LastRunAt
+		summary.LastRunAt = &last
+	}
+	for _, run := range s.Runs {
+		summary.Runs = append(summary.Runs, ScheduleRunSummary{
+			ScheduledFor: run.ScheduledFor,
+			Status:       run.Status,
+			ScanID:       run.ScanID,
+			Reason:       run.Reason,
+		})
+	}
+	return summary
+}
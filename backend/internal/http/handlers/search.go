@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/mcmx/duplynx/internal/search"
+	"github.com/mcmx/duplynx/internal/templ"
+	"github.com/mcmx/duplynx/internal/tenancy"
+)
+
+var errInvalidSearchLimit = errors.New("limit must be between 1 and 500")
+
+// SearchHandler finds the scoped tenant's files by checksum prefix, file
+// name, path substring or glob across all scans and machines.
+type SearchHandler struct {
+	Repo *search.Repository
+}
+
+func (h SearchHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	scope, ok := tenancy.ScopeFromContext(r.Context())
+	if !ok {
+		http.Error(w, "tenant scope missing", http.StatusBadRequest)
+		return
+	}
+	params := r.URL.Query()
+	query := params.Get("q")
+	kind, err := search.ParseKind(params.Get("kind"))
+	var limit int
+	if raw := params.Get("limit"); err == nil && raw != "" {
+		if limit, err = strconv.Atoi(raw); err != nil || limit < 1 || limit > search.MaxLimit {
+			err = errInvalidSearchLimit
+		}
+	}
+
+	var result search.Result
+	// Browsers opening the page without a query just get the form.
+	if err == nil && (query != "" || !wantsHTML(r)) {
+		result, err = h.Repo.Search(r.Context(), search.Query{Text: query, Kind: kind, Limit: limit})
+	}
+	if wantsHTML(r) {
+		status, msg := http.StatusOK, ""
+		if err != nil {
+			status, msg = searchErrorStatus(err), err.Error()
+		}
+		writePage(w, r, status, "Search", templ.SearchPage(scope.TenantSlug, query, kind, result, msg))
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), searchErrorStatus(err))
+		return
+	}
+	writeJSON(w, http.StatusOK, searchResponse(result))
+}
+
+func searchErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, search.ErrQueryTooShort), errors.Is(err, search.ErrInvalidKind), errors.Is(err, errInvalidSearchLimit):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// SearchResponse is the JSON representation of search results.
+type SearchResponse struct {
+	Query     string           `json:"query"`
+	Kind      string           `json:"kind"`
+	Files     int              `json:"files"`
+	Truncated bool             `json:"truncated"`
+	Indexed   bool             `json:"indexed"`
+	Groups    []SearchGroupHit `json:"groups"`
+}
+
+// SearchGroupHit is a duplicate group with the files that matched.
+type SearchGroupHit struct {
+	ID       string          `json:"id"`
+	ScanID   string          `json:"scanId"`
+	ScanName string          `json:"scanName"`
+	Hash     string          `json:"hash"`
+	Status   string          `json:"status"`
+	Files    []SearchFileHit `json:"files"`
+}
+
+// SearchFileHit is a matching file instance.
+type SearchFileHit struct {
+	ID          string `json:"id"`
+	MachineID   string `json:"machineId"`
+	MachineName string `json:"machineName"`
+	Path        string `json:"path"`
+	SizeBytes   int64  `json:"sizeBytes"`
+	Checksum    string `json:"checksum"`
+	MatchedBy   string `json:"matchedBy"`
+}
+
+func searchResponse(result search.Result) SearchResponse {
+	out := SearchResponse{
+		Query:     result.Query,
+		Kind:      string(result.Kind),
+		Files:     result.Files,
+		Truncated: result.Truncated,
+		Indexed:   result.Indexed,
+		Groups:    make([]SearchGroupHit, 0, len(result.Groups)),
+	}
+	for _, group := range result.Groups {
+		hit := SearchGroupHit{
+			ID:       group.ID,
+			ScanID:   group.ScanID,
+			ScanName: group.ScanName,
+			Hash:     group.Hash,
+			Status:   group.Status,
+			Files:    make([]SearchFileHit, 0, len(group.Files)),
+		}
+		for _, file := range group.Files {
+			hit.Files = append(hit.Files, SearchFileHit{
+				ID:          file.ID,
+				MachineID:   file.MachineID,
+				MachineName: file.MachineName,
+				Path:        file.Path,
+				SizeBytes:   file.SizeBytes,
+				Checksum:    file.Checksum,
+				MatchedBy:   file.MatchedBy,
+			})
+		}
+		out.Groups = append(out.Groups, hit)
+	}
+	return out
+}
@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/mcmx/duplynx/internal/ingestion"
+	"github.com/mcmx/duplynx/internal/tenancy"
+)
+
+// SecretsHandler lists tenant ingestion secret versions without their values.
+// Rotation and revocation mint or withdraw signing keys, so they are only
+// available to operators through the duplynx secrets CLI.
+type SecretsHandler struct {
+	Repo *ingestion.SecretRepository
+}
+
+// SecretSummary describes a secret version without exposing its value.
+// MachineID is set for versions bound to one machine's agent.
+type SecretSummary struct {
+	KeyID     string     `json:"keyId"`
+	MachineID string     `json:"machineId,omitempty"`
+	NotBefore time.Time  `json:"notBefore"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	RevokedAt *time.Time `json:"revokedAt,omitempty"`
+	Active    bool       `json:"active"`
+}
+
+// List responds with every secret version for the scoped tenant.
+func (h SecretsHandler) List(w http.ResponseWriter, r *http.Request) {
+	scope, ok := h.scope(w, r)
+	if !ok {
+		return
+	}
+	versions, err := h.Repo.List(r.Context(), scope.TenantSlug)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	now := time.Now()
+	resp := struct {
+		Secrets []SecretSummary `json:"secrets"`
+	}{Secrets: make([]SecretSummary, 0, len(versions))}
+	for _, version := range versions {
+		resp.Secrets = append(resp.Secrets, summarizeSecret(version, now))
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (h SecretsHandler) scope(w http.ResponseWriter, r *http.Request) (tenancy.Scope, bool) {
+	if h.Repo == nil {
+		http.Error(w, "secret repository not configured", http.StatusServiceUnavailable)
+		return tenancy.Scope{}, false
+	}
+	scope, ok := tenancy.ScopeFromContext(r.Context())
+	if !ok {
+		http.Error(w, "tenant scope missing", http.StatusBadRequest)
+		return tenancy.Scope{}, false
+	}
+	return scope, true
+}
+
+func summarizeSecret(version ingestion.SecretVersion, now time.Time) SecretSummary {
+	summary := SecretSummary{
+		KeyID:     version.KeyID,
+		NotBefore: version.NotBefore,
+		Active:    version.ActiveAt(now),
+	}
+	if version.MachineID != uuid.Nil {
+		summary.MachineID = version.MachineID.String()
+	}
+	if !version.ExpiresAt.IsZero() {
+		expires := version.ExpiresAt
+		summary.ExpiresAt = &expires
+	}
+	if !version.RevokedAt.IsZero() {
+		revoked := version.RevokedAt
+		summary.RevokedAt = &revoked
+	}
+	return summary
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -2,17 +2,30 @@ package handlers
 
 import (
 	"net/http"
+	"strings"
 
+	"github.com/mcmx/duplynx/internal/assets"
 	"github.com/mcmx/duplynx/internal/tenancy"
 )
 
+// immutableCacheControl lets browsers keep content-hashed assets until the URL changes.
+const immutableCacheControl = "public, max-age=31536000, immutable"
+
 // StaticHandler serves static assets while preserving tenant headers for caches and clients.
 type StaticHandler struct {
 	Root http.FileSystem
+	// Assets, when set, is served instead of Root. Requests whose v parameter
+	// matches the asset's content hash are cached indefinitely; other requests
+	// are revalidated against the hash as an ETag.
+	Assets *assets.Bundle
 }
 
 func (h StaticHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	if h.Root == nil {
+	root := h.Root
+	if h.Assets != nil {
+		root = h.Assets.FS()
+	}
+	if root == nil {
 		http.NotFound(w, r)
 		return
 	}
@@ -22,6 +35,12 @@ func (h StaticHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 	w.Header().Add("Vary", tenancy.HeaderTenantSlug)
 	w.Header().Set("Cache-Control", "public, max-age=300")
+	if hash := h.Assets.Hash(strings.TrimPrefix(r.URL.Path, "/static/")); hash != "" {
+		w.Header().Set("ETag", `"`+hash+`"`)
+		if r.URL.Query().Get("v") == hash {
+			w.Header().Set("Cache-Control", immutableCacheControl)
+		}
+	}
 
-	http.StripPrefix("/static/", http.FileServer(h.Root)).ServeHTTP(w, r)
+	http.StripPrefix("/static/", http.FileServer(root)).ServeHTTP(w, r)
 }
@@ -6,10 +6,19 @@ import (
 	"github.com/go-chi/chi/v5"
 
 	"github.com/mcmx/duplynx/internal/actions"
+	"github.com/mcmx/duplynx/internal/assets"
+	"github.com/mcmx/duplynx/internal/events"
 	"github.com/mcmx/duplynx/internal/http/handlers"
 	appmiddleware "github.com/mcmx/duplynx/internal/http/middleware"
+	"github.com/mcmx/duplynx/internal/http/session"
+	"github.com/mcmx/duplynx/internal/identity"
+	"github.com/mcmx/duplynx/internal/ingestion"
+	"github.com/mcmx/duplynx/internal/quota"
+	"github.com/mcmx/duplynx/internal/reclaim"
+	"github.com/mcmx/duplynx/internal/scandiff"
 	"github.com/mcmx/duplynx/internal/scans"
-	"github.com/mcmx/duplynx/internal/templ"
+	"github.com/mcmx/duplynx/internal/schedule"
+	"github.com/mcmx/duplynx/internal/search"
 	templerrors "github.com/mcmx/duplynx/internal/templ/errors"
 	"github.com/mcmx/duplynx/internal/tenancy"
 )
@@ -21,6 +30,28 @@ type Dependencies struct {
 	ActionsRepo       *actions.Repository
 	ActionsDispatcher *actions.Dispatcher
 	StaticFS          http.FileSystem
+	// Assets is the fingerprinted static bundle; it takes precedence over StaticFS.
+	Assets     *assets.Bundle
+	SecretRepo *ingestion.SecretRepository
+	// LegacyTenantSecrets holds single per-tenant secrets from DUPLYNX_TENANT_SECRETS.
+	LegacyTenantSecrets map[string]string
+	// Quotas enforces per-tenant limits; nil leaves tenants unlimited.
+	Quotas *quota.Enforcer
+	// Sessions remembers each browser's launch-flow context; a private
+	// in-memory store is used when nil.
+	Sessions *session.Store
+	// Reclaim reports reclaimable space; the dashboard is not served when nil.
+	Reclaim *reclaim.Repository
+	// Search backs tenant-wide file search; the endpoint is not served when nil.
+	Search *search.Repository
+	// Identities adds cross-scan history to group pages when set.
+	Identities *identity.Repository
+	// ScanDiff compares two scans; the endpoint is not served when nil.
+	ScanDiff *scandiff.Repository
+	// Schedules stores recurring scans; the schedule API is not served when nil.
+	Schedules *schedule.Repository
+	// Events feeds live board updates; without it the board's event stream is not served.
+	Events *events.Bus
 }
 
 // NewRouter wires baseline routes and middleware; handlers attach in feature phases.
@@ -28,6 +59,12 @@ func NewRouter(deps Dependencies) *chi.Mux {
 	r := chi.NewRouter()
 	r.Use(appmiddleware.Instrumentation)
 
+	sessions := deps.Sessions
+	if sessions == nil {
+		sessions = session.NewStore(session.DefaultTTL)
+	}
+	r.Use(sessions.Middleware)
+
 	r.Get("/healthz", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
 		_, _ = w.Write([]byte("ok"))
@@ -37,20 +74,33 @@ func NewRouter(deps Dependencies) *chi.Mux {
 	if staticFS == nil {
 		staticFS = http.Dir("web/static")
 	}
-	r.Handle("/static/*", handlers.StaticHandler{Root: staticFS})
+	r.Handle("/static/*", handlers.StaticHandler{Root: staticFS, Assets: deps.Assets})
+
+	if deps.SecretRepo != nil || len(deps.LegacyTenantSecrets) > 0 {
+		ingest := ingestion.Handler{TenantSecrets: deps.LegacyTenantSecrets, Quotas: deps.Quotas}
+		if deps.Events != nil {
+			ingest.Events = deps.Events
+		}
+		if deps.SecretRepo != nil {
+			ingest.Secrets = deps.SecretRepo
+		}
+		if deps.ScanRepo != nil {
+			ingest.Progress = deps.ScanRepo
+			ingest.Campaigns = deps.ScanRepo
+		}
+		if deps.ActionsDispatcher != nil {
+			ingest.Actions = deps.ActionsDispatcher
+		}
+		r.Post("/ingest", ingest.ServeHTTP)
+		r.Post("/ingest/heartbeat", ingest.Heartbeat)
+		r.Post("/ingest/assignments", ingest.Assignments)
+		r.Post("/ingest/jobs", ingest.Jobs)
+		r.Post("/ingest/report", ingest.Report)
+		r.Post("/ingest/action-report", ingest.ActionReport)
+	}
 
 	if deps.TenancyRepo != nil {
-		r.Get("/", func(w http.ResponseWriter, r *http.Request) {
-			tenants, err := deps.TenancyRepo.ListTenants(r.Context())
-			if err != nil {
-				http.Error(w, err.Error(), http.StatusInternalServerError)
-				return
-			}
-			body := templ.LaunchPage(tenants)
-			markup := templ.RenderLayout("DupLynx", "", "", body)
-			w.Header().Set("Content-Type", "text/html; charset=utf-8")
-			_, _ = w.Write([]byte(markup))
-		})
+		r.Get("/", handlers.LaunchHandler{Repo: deps.TenancyRepo}.ServeHTTP)
 
 		tenantsHandler := handlers.TenantsHandler{Repo: deps.TenancyRepo}
 		machinesHandler := handlers.MachinesHandler{Repo: deps.TenancyRepo}
@@ -58,15 +108,68 @@ func NewRouter(deps Dependencies) *chi.Mux {
 		scopeMiddleware := tenancy.RequireTenantScope(deps.TenancyRepo, scopeRenderer)
 
 		r.Get("/tenants", tenantsHandler.ServeHTTP)
+
+		adminHandler := handlers.AdminHandler{Repo: deps.TenancyRepo, Quotas: deps.Quotas}
+		quotaHandler := handlers.QuotaHandler{Quotas: deps.Quotas}
+		r.Route("/admin/tenants", func(r chi.Router) {
+			r.Get("/", adminHandler.ListTenants)
+			r.Post("/", adminHandler.CreateTenant)
+			r.Get("/{tenantSlug}", adminHandler.ShowTenant)
+			r.Post("/{tenantSlug}", adminHandler.UpdateTenant)
+			r.Put("/{tenantSlug}", adminHandler.UpdateTenant)
+			r.Post("/{tenantSlug}/archive", adminHandler.ArchiveTenant)
+			r.Post("/{tenantSlug}/machines", adminHandler.CreateMachine)
+			r.Post("/{tenantSlug}/machines/{machineID}", adminHandler.UpdateMachine)
+			r.Put("/{tenantSlug}/machines/{machineID}", adminHandler.UpdateMachine)
+			r.Post("/{tenantSlug}/machines/{machineID}/archive", adminHandler.ArchiveMachine)
+			r.Get("/{tenantSlug}/quotas", quotaHandler.ShowTenant)
+			r.Put("/{tenantSlug}/quotas", quotaHandler.UpdateTenant)
+		})
+		r.Get("/admin/quotas", quotaHandler.ListUsage)
 		r.With(scopeMiddleware).Get("/tenants/{tenantSlug}/machines", machinesHandler.ServeHTTP)
+		r.With(scopeMiddleware).Post("/tenants/{tenantSlug}/machines/select", handlers.MachineSelectHandler{Repo: deps.TenancyRepo}.ServeHTTP)
+		r.With(scopeMiddleware).Get("/tenants/{tenantSlug}/usage", quotaHandler.TenantUsage)
+		if deps.Search != nil {
+			r.With(scopeMiddleware).Get("/tenants/{tenantSlug}/search", handlers.SearchHandler{Repo: deps.Search}.ServeHTTP)
+		}
+		if deps.Reclaim != nil {
+			r.With(scopeMiddleware).Get("/tenants/{tenantSlug}/reclaimable", handlers.ReclaimHandler{Repo: deps.Reclaim}.ServeHTTP)
+		}
+
+		if deps.SecretRepo != nil {
+			secretsHandler := handlers.SecretsHandler{Repo: deps.SecretRepo}
+			r.With(scopeMiddleware).Get("/tenants/{tenantSlug}/secrets", secretsHandler.List)
+		}
 
 		if deps.ScanRepo != nil {
 			service := scans.Service{Repo: deps.ScanRepo}
-			scanListHandler := handlers.ScanListHandler{Service: service}
-			scanBoardHandler := handlers.ScanBoardHandler{Service: service}
+			scanListHandler := handlers.ScanListHandler{Service: service, Tenants: deps.TenancyRepo}
+			scanBoardHandler := handlers.ScanBoardHandler{Service: service, Actions: deps.ActionsRepo, Tenants: deps.TenancyRepo}
 
 			r.With(scopeMiddleware).Get("/tenants/{tenantSlug}/scans", scanListHandler.ServeHTTP)
+			r.With(scopeMiddleware).Post("/tenants/{tenantSlug}/scans", handlers.ScanCampaignHandler{Repo: deps.ScanRepo}.ServeHTTP)
 			r.With(scopeMiddleware).Get("/scans/{scanID}", scanBoardHandler.ServeHTTP)
+			scanCancelHandler := handlers.ScanCancelHandler{Repo: deps.ScanRepo}
+			if deps.Events != nil {
+				scanCancelHandler.Events = deps.Events
+			}
+			r.With(scopeMiddleware).Post("/scans/{scanID}/cancel", scanCancelHandler.ServeHTTP)
+			if deps.ScanDiff != nil {
+				r.With(scopeMiddleware).Get("/tenants/{tenantSlug}/scans/diff", handlers.ScanDiffHandler{Repo: deps.ScanDiff, Service: service}.ServeHTTP)
+			}
+			if deps.Events != nil {
+				boardEvents := handlers.BoardEventsHandler{Service: service, Actions: deps.ActionsRepo, Bus: deps.Events}
+				r.With(scopeMiddleware).Get("/scans/{scanID}/events", boardEvents.ServeHTTP)
+			}
+		}
+
+		if deps.Schedules != nil {
+			scheduleHandler := handlers.ScheduleHandler{Repo: deps.Schedules}
+			r.With(scopeMiddleware).Get("/tenants/{tenantSlug}/schedules", scheduleHandler.List)
+			r.With(scopeMiddleware).Post("/tenants/{tenantSlug}/schedules", scheduleHandler.Create)
+			r.With(scopeMiddleware).Get("/tenants/{tenantSlug}/schedules/{scheduleID}", scheduleHandler.Show)
+			r.With(scopeMiddleware).Put("/tenants/{tenantSlug}/schedules/{scheduleID}", scheduleHandler.Update)
+			r.With(scopeMiddleware).Delete("/tenants/{tenantSlug}/schedules/{scheduleID}", scheduleHandler.Delete)
 		}
 
 		if deps.ActionsDispatcher != nil && deps.ActionsRepo != nil {
@@ -75,7 +178,14 @@ func NewRouter(deps Dependencies) *chi.Mux {
 
 			r.With(scopeMiddleware).Post("/duplicate-groups/{groupId}/keeper", keeperHandler.ServeHTTP)
 			r.With(scopeMiddleware).Post("/duplicate-groups/{groupId}/actions", actionHandler.ServeHTTP)
-			r.With(scopeMiddleware).Post("/duplicate-groups/{groupId}/htmx", handlers.ActionHTMXHandler)
+			r.With(scopeMiddleware).Post("/duplicate-groups/{groupId}/htmx", handlers.ActionHTMXHandler{Dispatcher: deps.ActionsDispatcher}.ServeHTTP)
+
+			groupHandler := handlers.GroupDetailHandler{Dispatcher: deps.ActionsDispatcher, Identities: deps.Identities}
+			r.With(scopeMiddleware).Get("/duplicate-groups/{groupId}", groupHandler.Show)
+			r.With(scopeMiddleware).Post("/duplicate-groups/{groupId}/notes", groupHandler.CreateNote)
+			r.With(scopeMiddleware).Post("/duplicate-groups/{groupId}/notes/{noteId}", groupHandler.UpdateNote)
+			r.With(scopeMiddleware).Put("/duplicate-groups/{groupId}/notes/{noteId}", groupHandler.UpdateNote)
+			r.With(scopeMiddleware).Get("/tenants/{tenantSlug}/notes", groupHandler.SearchNotes)
 		}
 	}
 
@@ -0,0 +1,160 @@
+// Package session keeps per-browser navigation context (selected tenant and
+// machine) on the server, keyed by an opaque cookie.
+package session
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/mcmx/duplynx/internal/tenancy"
+)
+
+// CookieName is the cookie carrying the session ID.
+const CookieName = "duplynx_session"
+
+// DefaultTTL is how long an idle session is kept.
+const DefaultTTL = 12 * time.Hour
+
+// Session holds the launch-flow context shown in the breadcrumb.
+type Session struct {
+	TenantSlug  string
+	TenantName  string
+	MachineID   string
+	MachineName string
+}
+
+// SelectTenant switches the session to a tenant, clearing the machine when the tenant changes.
+func (s *Session) SelectTenant(slug, name string) {
+	if s.TenantSlug != slug {
+		s.MachineID = ""
+		s.MachineName = ""
+	}
+	s.TenantSlug = slug
+	s.TenantName = name
+}
+
+type entry struct {
+	session  Session
+	lastSeen time.Time
+}
+
+// Store keeps sessions in memory; they do not survive a restart.
+type Store struct {
+	ttl time.Duration
+	// Now overrides the clock used for expiry, primarily for tests.
+	Now func() time.Time
+
+	mu       sync.Mutex
+	sessions map[string]*entry
+}
+
+// NewStore constructs a store that forgets sessions idle for longer than ttl.
+func NewStore(ttl time.Duration) *Store {
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+	return &Store{ttl: ttl, sessions: make(map[string]*entry)}
+}
+
+type handle struct {
+	store   *Store
+	id      string
+	session Session
+}
+
+type contextKey struct{}
+
+// Middleware loads the caller's session into the request context and offers its
+// tenant to the tenant scope middleware as a fallback.
+func (s *Store) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		h := &handle{store: s}
+		if cookie, err := r.Cookie(CookieName); err == nil {
+			if sess, ok := s.load(cookie.Value); ok {
+				h.id = cookie.Value
+				h.session = sess
+			}
+		}
+		ctx := context.WithValue(r.Context(), contextKey{}, h)
+		if h.session.TenantSlug != "" {
+			ctx = tenancy.WithSessionTenant(ctx, h.session.TenantSlug)
+		}
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// FromContext returns the session attached by Middleware, or an empty session.
+func FromContext(ctx context.Context) Session {
+	if h, ok := ctx.Value(contextKey{}).(*handle); ok {
+		return h.session
+	}
+	return Session{}
+}
+
+// Save stores the session, issuing a cookie on first use. It is a no-op
+// outside Middleware.
+func Save(w http.ResponseWriter, r *http.Request, sess Session) {
+	h, ok := r.Context().Value(contextKey{}).(*handle)
+	if !ok || h.store == nil {
+		return
+	}
+	if h.id == "" {
+		h.id = newID()
+		http.SetCookie(w, &http.Cookie{
+			Name:     CookieName,
+			Value:    h.id,
+			Path:     "/",
+			HttpOnly: true,
+			SameSite: http.SameSiteLaxMode,
+		})
+	}
+	h.session = sess
+	h.store.save(h.id, sess)
+}
+
+func (s *Store) load(id string) (Session, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.sessions[id]
+	if !ok {
+		return Session{}, false
+	}
+	now := s.now()
+	if now.Sub(e.lastSeen) > s.ttl {
+		delete(s.sessions, id)
+		return Session{}, false
+	}
+	e.lastSeen = now
+	return e.session, true
+}
+
+func (s *Store) save(id string, sess Session) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	for key, e := range s.sessions {
+		if now.Sub(e.lastSeen) > s.ttl {
+			delete(s.sessions, key)
+		}
+	}
+	s.sessions[id] = &entry{session: sess, lastSeen: now}
+}
+
+func (s *Store) now() time.Time {
+	if s.Now != nil {
+		return s.Now()
+	}
+	return time.Now()
+}
+
+func newID() string {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		panic("session: crypto/rand unavailable: " + err.Error())
+	}
+	return hex.EncodeToString(buf)
+}
@@ -0,0 +1,139 @@
+package identity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/mcmx/duplynx/ent"
+	entcontentevent "github.com/mcmx/duplynx/ent/contentevent"
+	entduplicategroup "github.com/mcmx/duplynx/ent/duplicategroup"
+)
+
+// History follows one content identity across the tenant's scans.
+type History struct {
+	Hash        string
+	FirstSeenAt time.Time
+	LastSeenAt  time.Time
+	// KeeperMachineName is the keeper carried into new scans, if any.
+	KeeperMachineName string
+	// ResolvedAt is set while the content stays resolved across scans.
+	ResolvedAt time.Time
+	Sightings  []Sighting
+	Events     []Event
+}
+
+// Sighting is the group one scan created for the content.
+type Sighting struct {
+	GroupID   string
+	ScanID    string
+	ScanName  string
+	SeenAt    time.Time
+	Status    string
+	FileCount int
+	// Current marks the group the history was requested for.
+	Current bool
+}
+
+// Event is a copy appearing in or disappearing from a scan.
+type Event struct {
+	Kind        string
+	ScanName    string
+	MachineName string
+	Path        string
+	OccurredAt  time.Time
+}
+
+// HistoryForGroup returns the history of the group's content identity. It
+// reports false when the group is not linked yet. The context must carry the
+// caller's tenant scope.
+func (r *Repository) HistoryForGroup(ctx context.Context, groupID uuid.UUID) (History, bool, error) {
+	if r == nil || r.client == nil {
+		return History{}, false, errors.New("identity repository not configured")
+	}
+	group, err := r.client.DuplicateGroup.Query().
+		Where(entduplicategroup.IDEQ(groupID)).
+		WithContentIdentity(func(q *ent.ContentIdentityQuery) {
+			q.WithKeeperMachine()
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return History{}, false, nil
+		}
+		return History{}, false, fmt.Errorf("load duplicate group: %w", err)
+	}
+	identity := group.Edges.ContentIdentity
+	if identity == nil {
+		return History{}, false, nil
+	}
+
+	history := History{
+		Hash:        identity.Hash,
+		FirstSeenAt: identity.FirstSeenAt,
+		LastSeenAt:  identity.LastSeenAt,
+		ResolvedAt:  identity.ResolvedAt,
+	}
+	if keeper := identity.Edges.KeeperMachine; keeper != nil {
+		history.KeeperMachineName = keeper.Name
+	}
+
+	groups, err := r.client.DuplicateGroup.Query().
+		Where(entduplicategroup.ContentIdentityID(identity.ID)).
+		WithScan().
+		All(ctx)
+	if err != nil {
+		return History{}, false, fmt.Errorf("load sightings: %w", err)
+	}
+	for _, record := range groups {
+		sighting := Sighting{
+			GroupID:   record.ID.String(),
+			ScanID:    record.ScanID.String(),
+			Status:    string(record.Status),
+			FileCount: record.FileCount,
+			Current:   record.ID == groupID,
+		}
+		if scan := record.Edges.Scan; scan != nil {
+			sighting.ScanName = scan.Name
+			sighting.SeenAt = scan.StartedAt
+		}
+		history.Sightings = append(history.Sightings, sighting)
+	}
+	sortSightings(history.Sightings)
+
+	events, err := r.client.ContentEvent.Query().
+		Where(entcontentevent.ContentIdentityID(identity.ID)).
+		WithScan().
+		WithMachine().
+		Order(entcontentevent.ByOccurredAt(), entcontentevent.ByKind(), entcontentevent.ByPath()).
+		All(ctx)
+	if err != nil {
+		return History{}, false, fmt.Errorf("load copy events: %w", err)
+	}
+	for _, record := range events {
+		event := Event{
+			Kind:       string(record.Kind),
+			Path:       record.Path,
+			OccurredAt: record.OccurredAt,
+		}
+		if scan := record.Edges.Scan; scan != nil {
+			event.ScanName = scan.Name
+		}
+		if machine := record.Edges.Machine; machine != nil {
+			event.MachineName = machine.Name
+		}
+		history.Events = append(history.Events, event)
+	}
+	return history, true, nil
+}
+
+func sortSightings(sightings []Sighting) {
+	for i := 1; i < len(sightings); i++ {
+		for j := i; j > 0 && sightings[j].SeenAt.Before(sightings[j-1].SeenAt); j-- {
+			sightings[j], sightings[j-1] = sightings[j-1], sightings[j]
+		}
+	}
+}
@@ -0,0 +1,469 @@
+// Package identity links each scan's duplicate groups to a tenant-level
+// content identity keyed by hash, so decisions made about a file's content
+// in one scan carry into the next and its copies can be followed over time.
+package identity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+
+	"github.com/mcmx/duplynx/ent"
+	entcontentevent "github.com/mcmx/duplynx/ent/contentevent"
+	entcontentidentity "github.com/mcmx/duplynx/ent/contentidentity"
+	entduplicategroup "github.com/mcmx/duplynx/ent/duplicategroup"
+	entfileinstance "github.com/mcmx/duplynx/ent/fileinstance"
+	entscan "github.com/mcmx/duplynx/ent/scan"
+	entscantarget "github.com/mcmx/duplynx/ent/scantarget"
+	"github.com/mcmx/duplynx/internal/tenancy/isolation"
+)
+
+// Copy is one location holding the content.
+type Copy struct {
+	MachineID uuid.UUID
+	Path      string
+}
+
+// LinkReport summarises linking one scan's groups.
+type LinkReport struct {
+	ScanID uuid.UUID
+	// Linked counts groups attached to an identity; Created counts identities
+	// seen for the first time.
+	Linked  int
+	Created int
+	// Appeared and Disappeared count the copy events recorded.
+	Appeared    int
+	Disappeared int
+	// KeepersCarried and ResolvedCarried count groups that inherited an
+	// earlier scan's keeper or resolved status.
+	KeepersCarried  int
+	ResolvedCarried int
+}
+
+// Repository links groups to content identities and reads their history.
+type Repository struct {
+	client *ent.Client
+}
+
+// NewRepositoryFromClient constructs a repository using the supplied Ent client.
+func NewRepositoryFromClient(client *ent.Client) *Repository {
+	if client == nil {
+		return nil
+	}
+	return &Repository{client: client}
+}
+
+// LinkPending links every scan that still has unlinked groups, oldest first,
+// across all tenants. Seeding and `duplynx identity link` call it.
+func (r *Repository) LinkPending(ctx context.Context) ([]LinkReport, error) {
+	if r == nil || r.client == nil {
+		return nil, errors.New("identity repository not configured")
+	}
+	ids, err := r.client.Scan.Query().
+		Where(entscan.HasDuplicateGroupsWith(entduplicategroup.ContentIdentityIDIsNil())).
+		Order(entscan.ByStartedAt(), entscan.ByID()).
+		IDs(isolation.WithSystem(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("list unlinked scans: %w", err)
+	}
+	reports := make([]LinkReport, 0, len(ids))
+	for _, id := range ids {
+		report, err := r.LinkScan(ctx, id)
+		if err != nil {
+			return reports, err
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
+
+// LinkScan attaches the scan's unlinked groups to their content identities
+// in one transaction. Scans must be linked in the order they ran, since each
+// one is compared with the copies the identity had before it. Only copies on
+// machines the scan covered can disappear: the targets that reported for a
+// multi-machine scan, otherwise the machines its files were found on. Copies
+// of content the scan found no group for, such as a file cleaned down to one
+// copy, disappear from those machines too.
+// Scans call it when they complete; see scans.Repository.
+//
+// A group inherits the identity's keeper while that machine still holds a
+// copy, and its resolved status when no new copies appeared. A group that
+// already has a keeper or is resolved passes that decision on instead.
+func (r *Repository) LinkScan(ctx context.Context, scanID uuid.UUID) (LinkReport, error) {
+	if r == nil || r.client == nil {
+		return LinkReport{}, errors.New("identity repository not configured")
+	}
+	report := LinkReport{ScanID: scanID}
+	scan, err := r.client.Scan.Get(isolation.WithSystem(ctx), scanID)
+	if err != nil {
+		return report, fmt.Errorf("load scan: %w", err)
+	}
+	// Everything below runs confined to the scan's tenant.
+	ctx = isolation.WithTenant(ctx, scan.TenantID)
+
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return report, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	groups, err := tx.DuplicateGroup.Query().
+		Where(entduplicategroup.ScanID(scanID), entduplicategroup.ContentIdentityIDIsNil()).
+		WithFileInstances().
+		Order(entduplicategroup.ByHash(), entduplicategroup.ByID()).
+		All(ctx)
+	if err != nil {
+		return report, fmt.Errorf("list scan groups: %w", err)
+	}
+	covered, err := CoveredMachines(ctx, tx.Client(), scan)
+	if err != nil {
+		return report, err
+	}
+	seenAt := scan.StartedAt
+	for _, group := range groups {
+		if err := linkGroup(ctx, tx, scan, covered, group, seenAt, &report); err != nil {
+			return report, fmt.Errorf("link group %s: %w", group.ID, err)
+		}
+	}
+	if scan.FilesPrunedAt.IsZero() {
+		if err := recordVanished(ctx, tx, scan, covered, seenAt, &report); err != nil {
+			return report, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return report, fmt.Errorf("commit transaction: %w", err)
+	}
+	return report, nil
+}
+
+// CoveredMachines returns the machines whose disks the scan looked at: the
+// targets that reported for a multi-machine scan, otherwise the machines its
+// files were found on and the machine that started it.
+func CoveredMachines(ctx context.Context, client *ent.Client, scan *ent.Scan) (map[uuid.UUID]bool, error) {
+	targets, err := client.ScanTarget.Query().Where(entscantarget.ScanID(scan.ID)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("load scan targets: %w", err)
+	}
+	covered := make(map[uuid.UUID]bool)
+	if len(targets) > 0 {
+		for _, target := range targets {
+			if target.Status == entscantarget.StatusReported {
+				covered[target.MachineID] = true
+			}
+		}
+		return covered, nil
+	}
+	var rows []struct {
+		MachineID uuid.UUID `json:"machine_id"`
+	}
+	if err := client.FileInstance.Query().
+		Where(entfileinstance.HasDuplicateGroupWith(entduplicategroup.ScanID(scan.ID))).
+		Unique(true).
+		Select(entfileinstance.FieldMachineID).
+		Scan(ctx, &rows); err != nil {
+		return nil, fmt.Errorf("list scanned machines: %w", err)
+	}
+	for _, row := range rows {
+		covered[row.MachineID] = true
+	}
+	if scan.InitiatedMachineID != uuid.Nil {
+		covered[scan.InitiatedMachineID] = true
+	}
+	return covered, nil
+}
+
+// recordVanished records the disappearance of held copies on the scan's
+// covered machines whose content the scan no longer found duplicated.
+func recordVanished(ctx context.Context, tx *ent.Tx, scan *ent.Scan, covered map[uuid.UUID]bool, seenAt time.Time, report *LinkReport) error {
+	if len(covered) == 0 {
+		return nil
+	}
+	var linked []struct {
+		IdentityID uuid.UUID `json:"content_identity_id"`
+	}
+	if err := tx.DuplicateGroup.Query().
+		Where(entduplicategroup.ScanID(scan.ID), entduplicategroup.ContentIdentityIDNotNil()).
+		Unique(true).
+		Select(entduplicategroup.FieldContentIdentityID).
+		Scan(ctx, &linked); err != nil {
+		return fmt.Errorf("list linked identities: %w", err)
+	}
+	found := make(map[uuid.UUID]bool, len(linked))
+	for _, row := range linked {
+		found[row.IdentityID] = true
+	}
+	machines := make([]uuid.UUID, 0, len(covered))
+	for machineID := range covered {
+		machines = append(machines, machineID)
+	}
+
+	// Replaying the covered machines' events yields each identity's copies there.
+	events, err := tx.ContentEvent.Query().
+		Where(entcontentevent.MachineIDIn(machines...)).
+		Order(entcontentevent.ByOccurredAt(), entcontentevent.ByCreateTime()).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("load copy events: %w", err)
+	}
+	held := make(map[uuid.UUID]map[Copy]bool)
+	var order []uuid.UUID
+	for _, event := range events {
+		if found[event.ContentIdentityID] {
+			continue
+		}
+		copies, ok := held[event.ContentIdentityID]
+		if !ok {
+			copies = make(map[Copy]bool)
+			held[event.ContentIdentityID] = copies
+			order = append(order, event.ContentIdentityID)
+		}
+		c := Copy{MachineID: event.MachineID, Path: event.Path}
+		if event.Kind == entcontentevent.KindAppeared {
+			copies[c] = true
+		} else {
+			delete(copies, c)
+		}
+	}
+
+	var vanished []*ent.ContentEventCreate
+	for _, identityID := range order {
+		copies := make([]Copy, 0, len(held[identityID]))
+		for c := range held[identityID] {
+			copies = append(copies, c)
+		}
+		sortCopies(copies)
+		for _, c := range copies {
+			vanished = append(vanished, copyEvent(tx, scan, identityID, c, entcontentevent.KindDisappeared, seenAt))
+		}
+	}
+	for start := 0; start < len(vanished); start += eventBatch {
+		if err := tx.ContentEvent.CreateBulk(vanished[start:min(start+eventBatch, len(vanished))]...).Exec(ctx); err != nil {
+			return fmt.Errorf("record vanished copies: %w", err)
+		}
+	}
+	report.Disappeared += len(vanished)
+	return nil
+}
+
+// eventBatch bounds how many copy events one insert carries.
+const eventBatch = 500
+
+func copyEvent(tx *ent.Tx, scan *ent.Scan, identityID uuid.UUID, c Copy, kind entcontentevent.Kind, at time.Time) *ent.ContentEventCreate {
+	return tx.ContentEvent.Create().
+		SetTenantID(scan.TenantID).
+		SetContentIdentityID(identityID).
+		SetScanID(scan.ID).
+		SetMachineID(c.MachineID).
+		SetPath(c.Path).
+		SetKind(kind).
+		SetOccurredAt(at)
+}
+
+func linkGroup(ctx context.Context, tx *ent.Tx, scan *ent.Scan, covered map[uuid.UUID]bool, group *ent.DuplicateGroup, seenAt time.Time, report *LinkReport) error {
+	identity, err := tx.ContentIdentity.Query().
+		Where(entcontentidentity.TenantID(group.TenantID), entcontentidentity.Hash(group.Hash)).
+		Only(ctx)
+	switch {
+	case ent.IsNotFound(err):
+		identity, err = tx.ContentIdentity.Create().
+			SetTenantID(group.TenantID).
+			SetHash(group.Hash).
+			SetFirstSeenAt(seenAt).
+			SetLastSeenAt(seenAt).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("create content identity: %w", err)
+		}
+		report.Created++
+	case err != nil:
+		return fmt.Errorf("load content identity: %w", err)
+	}
+
+	// A pruned scan no longer knows its copies, so it only extends the identity's span.
+	var appeared, disappeared []Copy
+	current := copiesOf(group)
+	if scan.FilesPrunedAt.IsZero() {
+		held, err := currentCopies(ctx, tx, identity.ID)
+		if err != nil {
+			return err
+		}
+		// Copies on machines this scan did not look at are still held.
+		previous := make([]Copy, 0, len(held))
+		for _, c := range held {
+			if covered[c.MachineID] {
+				previous = append(previous, c)
+			}
+		}
+		appeared, disappeared = diffCopies(previous, current)
+		events := make([]*ent.ContentEventCreate, 0, len(appeared)+len(disappeared))
+		for _, batch := range []struct {
+			kind   entcontentevent.Kind
+			copies []Copy
+		}{
+			{entcontentevent.KindAppeared, appeared},
+			{entcontentevent.KindDisappeared, disappeared},
+		} {
+			for _, c := range batch.copies {
+				events = append(events, copyEvent(tx, scan, identity.ID, c, batch.kind, seenAt))
+			}
+		}
+		if len(events) > 0 {
+			if err := tx.ContentEvent.CreateBulk(events...).Exec(ctx); err != nil {
+				return fmt.Errorf("record copy events: %w", err)
+			}
+		}
+		report.Appeared += len(appeared)
+		report.Disappeared += len(disappeared)
+	}
+
+	groupUpdate := tx.DuplicateGroup.UpdateOne(group).SetContentIdentityID(identity.ID)
+	identityUpdate := tx.ContentIdentity.UpdateOne(identity)
+	if seenAt.After(identity.LastSeenAt) {
+		identityUpdate.SetLastSeenAt(seenAt)
+	}
+
+	switch {
+	case group.KeeperMachineID != uuid.Nil:
+		identityUpdate.SetKeeperMachineID(group.KeeperMachineID)
+	case identity.KeeperMachineID != uuid.Nil && holdsCopy(current, identity.KeeperMachineID):
+		groupUpdate.SetKeeperMachineID(identity.KeeperMachineID)
+		report.KeepersCarried++
+	}
+
+	resolvedAt, err := resolvedSince(ctx, tx, identity)
+	if err != nil {
+		return err
+	}
+	switch {
+	case isResolved(group.Status):
+		if resolvedAt.IsZero() {
+			resolvedAt = seenAt
+		}
+		identityUpdate.SetResolvedAt(resolvedAt)
+	case resolvedAt.IsZero():
+	case len(appeared) > 0:
+		// New copies need a fresh decision.
+		identityUpdate.ClearResolvedAt()
+	case group.Status == entduplicategroup.StatusReview:
+		groupUpdate.SetStatus(entduplicategroup.StatusResolved)
+		identityUpdate.SetResolvedAt(resolvedAt)
+		report.ResolvedCarried++
+	}
+
+	if err := groupUpdate.Exec(ctx); err != nil {
+		return fmt.Errorf("link duplicate group: %w", err)
+	}
+	if err := identityUpdate.Exec(ctx); err != nil {
+		return fmt.Errorf("update content identity: %w", err)
+	}
+	report.Linked++
+	return nil
+}
+
+// resolvedSince returns when the identity was resolved, falling back to the
+// scan of its latest group when that group was resolved after being linked.
+func resolvedSince(ctx context.Context, tx *ent.Tx, identity *ent.ContentIdentity) (time.Time, error) {
+	if !identity.ResolvedAt.IsZero() {
+		return identity.ResolvedAt, nil
+	}
+	latest, err := tx.DuplicateGroup.Query().
+		Where(entduplicategroup.ContentIdentityID(identity.ID)).
+		WithScan().
+		Order(entduplicategroup.ByScanField(entscan.FieldStartedAt, sql.OrderDesc())).
+		First(ctx)
+	switch {
+	case ent.IsNotFound(err):
+		return time.Time{}, nil
+	case err != nil:
+		return time.Time{}, fmt.Errorf("load latest sighting: %w", err)
+	}
+	if !isResolved(latest.Status) || latest.Edges.Scan == nil {
+		return time.Time{}, nil
+	}
+	return latest.Edges.Scan.StartedAt, nil
+}
+
+func isResolved(status entduplicategroup.Status) bool {
+	return status == entduplicategroup.StatusResolved || status == entduplicategroup.StatusArchived
+}
+
+// currentCopies replays the identity's events into the copies it holds now.
+func currentCopies(ctx context.Context, tx *ent.Tx, identityID uuid.UUID) ([]Copy, error) {
+	events, err := tx.ContentEvent.Query().
+		Where(entcontentevent.ContentIdentityID(identityID)).
+		Order(entcontentevent.ByOccurredAt(), entcontentevent.ByCreateTime()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("load copy events: %w", err)
+	}
+	held := make(map[Copy]bool)
+	for _, event := range events {
+		c := Copy{MachineID: event.MachineID, Path: event.Path}
+		if event.Kind == entcontentevent.KindAppeared {
+			held[c] = true
+		} else {
+			delete(held, c)
+		}
+	}
+	out := make([]Copy, 0, len(held))
+	for c := range held {
+		out = append(out, c)
+	}
+	sortCopies(out)
+	return out, nil
+}
+
+func copiesOf(group *ent.DuplicateGroup) []Copy {
+	out := make([]Copy, 0, len(group.Edges.FileInstances))
+	for _, file := range group.Edges.FileInstances {
+		out = append(out, Copy{MachineID: file.MachineID, Path: file.Path})
+	}
+	sortCopies(out)
+	return out
+}
+
+// diffCopies returns the copies in current but not previous, and the reverse.
+func diffCopies(previous, current []Copy) (appeared, disappeared []Copy) {
+	before := make(map[Copy]bool, len(previous))
+	for _, c := range previous {
+		before[c] = true
+	}
+	now := make(map[Copy]bool, len(current))
+	for _, c := range current {
+		now[c] = true
+		if !before[c] {
+			appeared = append(appeared, c)
+		}
+	}
+	for _, c := range previous {
+		if !now[c] {
+			disappeared = append(disappeared, c)
+		}
+	}
+	return appeared, disappeared
+}
+
+func holdsCopy(copies []Copy, machineID uuid.UUID) bool {
+	for _, c := range copies {
+		if c.MachineID == machineID {
+			return true
+		}
+	}
+	return false
+}
+
+func sortCopies(copies []Copy) {
+	sort.Slice(copies, func(i, j int) bool {
+		if copies[i].Path != copies[j].Path {
+			return copies[i].Path < copies[j].Path
+		}
+		return copies[i].MachineID.String() < copies[j].MachineID.String()
+	})
+}
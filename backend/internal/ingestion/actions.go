@@ -0,0 +1,112 @@
+package ingestion
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/mcmx/duplynx/internal/actions"
+)
+
+// ActionCoordinator hands duplicate actions to the agents holding the copies
+// and records their outcome; *actions.Dispatcher implements it.
+type ActionCoordinator interface {
+	ClaimActions(ctx context.Context, tenantSlug string, machineID uuid.UUID) ([]actions.AgentJob, error)
+	CompleteAction(ctx context.Context, tenantSlug string, jobID, machineID uuid.UUID, report actions.JobReport) error
+}
+
+// Action job outcomes an agent posts to /ingest/action-report.
+const (
+	ActionSucceeded = "succeeded"
+	ActionFailed    = "failed"
+)
+
+// ActionPayload is one machine's share of a duplicate action. The agent acts
+// only on Paths whose content still matches Hash; KeepPath, when set, is the
+// copy on the machine that stays in place.
+type ActionPayload struct {
+	ID       string   `json:"id"`
+	GroupID  string   `json:"groupId"`
+	Action   string   `json:"action"`
+	Hash     string   `json:"hash"`
+	KeepPath string   `json:"keepPath,omitempty"`
+	Paths    []string `json:"paths"`
+}
+
+// ActionReportRequest is the signed body an agent posts to
+// /ingest/action-report once it ran an action job. Status is "succeeded" or
+// "failed"; Done lists the paths the agent acted on either way.
+type ActionReportRequest struct {
+	JobID     string   `json:"jobId"`
+	MachineID string   `json:"machineId"`
+	Status    string   `json:"status"`
+	Message   string   `json:"message,omitempty"`
+	Done      []string `json:"done,omitempty"`
+}
+
+// ActionReport records an agent's outcome for one of its machine's action
+// jobs. Reports for a job that was already settled answer 409.
+func (h Handler) ActionReport(w http.ResponseWriter, r *http.Request) {
+	if h.Actions == nil {
+		http.Error(w, "agent actions unavailable", http.StatusNotImplemented)
+		return
+	}
+	req, ok := h.authenticate(w, r)
+	if !ok {
+		return
+	}
+	var in ActionReportRequest
+	if err := json.Unmarshal(req.payload, &in); err != nil {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+	jobID, err := uuid.Parse(in.JobID)
+	if err != nil {
+		http.Error(w, "jobId must be an action job ID", http.StatusBadRequest)
+		return
+	}
+	machineID, ok := req.machine(w, in.MachineID)
+	if !ok {
+		return
+	}
+	report := actions.JobReport{Message: in.Message, Done: in.Done}
+	switch in.Status {
+	case ActionSucceeded:
+	case ActionFailed:
+		report.Failed = true
+	default:
+		http.Error(w, `status must be "succeeded" or "failed"`, http.StatusBadRequest)
+		return
+	}
+
+	err = h.Actions.CompleteAction(r.Context(), req.tenant, jobID, machineID, report)
+	switch {
+	case errors.Is(err, actions.ErrJobFinished):
+		http.Error(w, err.Error(), http.StatusConflict)
+	case errors.Is(err, actions.ErrJobNotFound), errors.Is(err, actions.ErrMachineNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case err != nil:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	default:
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// actionJob converts a claimed action job into the job handed to the agent.
+func actionJob(job actions.AgentJob) Job {
+	return Job{
+		ID:   job.ID,
+		Type: JobAction,
+		Action: &ActionPayload{
+			ID:       job.ID,
+			GroupID:  job.GroupID,
+			Action:   string(job.Action),
+			Hash:     job.Hash,
+			KeepPath: job.KeepPath,
+			Paths:    job.Paths,
+		},
+	}
+}
@@ -0,0 +1,151 @@
+package ingestion
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/mcmx/duplynx/internal/events"
+	"github.com/mcmx/duplynx/internal/scans"
+)
+
+// CampaignCoordinator hands multi-machine scan targets to agents, records
+// their manifests and reports and tracks which agents are polling; *scans.Repository implements it.
+type CampaignCoordinator interface {
+	MarkSeen(ctx context.Context, tenantSlug string, machineID uuid.UUID) error
+	ClaimAssignments(ctx context.Context, tenantSlug string, machineID uuid.UUID) ([]scans.Assignment, error)
+	ReportTarget(ctx context.Context, tenantSlug string, scanID, machineID uuid.UUID, report scans.TargetReport) (scans.ScanSummary, error)
+	RecordManifest(ctx context.Context, tenantSlug string, scanID, machineID uuid.UUID, manifest scans.Manifest) (scans.ScanSummary, error)
+}
+
+// AssignmentsRequest is the signed body an agent posts to /ingest/assignments.
+type AssignmentsRequest struct {
+	MachineID string `json:"machineId"`
+}
+
+// AssignmentsResponse lists the scans the machine should take part in.
+type AssignmentsResponse struct {
+	Assignments []AssignmentPayload `json:"assignments"`
+}
+
+// AssignmentPayload is one scan target handed to an agent.
+type AssignmentPayload struct {
+	ScanID     string     `json:"scanId"`
+	Name       string     `json:"name"`
+	Roots      []string   `json:"roots"`
+	Exclusions []string   `json:"exclusions,omitempty"`
+	Deadline   *time.Time `json:"deadline,omitempty"`
+}
+
+// ReportRequest is the signed body an agent posts to /ingest/report once its
+// part of a scan is done. Status is "reported" or "failed".
+type ReportRequest struct {
+	ScanID    string `json:"scanId"`
+	MachineID string `json:"machineId"`
+	Status    string `json:"status"`
+	Message   string `json:"message,omitempty"`
+}
+
+// Assignments claims and returns the polling machine's unfinished scan targets.
+func (h Handler) Assignments(w http.ResponseWriter, r *http.Request) {
+	if h.Campaigns == nil {
+		http.Error(w, "scan campaigns unavailable", http.StatusNotImplemented)
+		return
+	}
+	req, ok := h.authenticate(w, r)
+	if !ok {
+		return
+	}
+	var in AssignmentsRequest
+	if err := json.Unmarshal(req.payload, &in); err != nil {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+	machineID, ok := req.machine(w, in.MachineID)
+	if !ok {
+		return
+	}
+
+	assignments, err := h.Campaigns.ClaimAssignments(r.Context(), req.tenant, machineID)
+	switch {
+	case errors.Is(err, scans.ErrTargetNotFound):
+		http.Error(w, "machine not found", http.StatusNotFound)
+		return
+	case err != nil:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	out := AssignmentsResponse{Assignments: make([]AssignmentPayload, 0, len(assignments))}
+	for _, assignment := range assignments {
+		payload := AssignmentPayload{ScanID: assignment.ScanID, Name: assignment.Name, Roots: assignment.Roots, Exclusions: assignment.Exclusions}
+		if !assignment.Deadline.IsZero() {
+			deadline := assignment.Deadline.UTC()
+			payload.Deadline = &deadline
+		}
+		out.Assignments = append(out.Assignments, payload)
+		if assignment.Claimed && h.Events != nil {
+			h.Events.Publish(events.Event{Type: events.TypeScanProgress, TenantSlug: req.tenant, ScanID: assignment.ScanID})
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(out)
+}
+
+// Report records a machine's final report for its part of a scan. Like
+// heartbeats, reports for a finished scan answer 409 with its final status.
+func (h Handler) Report(w http.ResponseWriter, r *http.Request) {
+	if h.Campaigns == nil {
+		http.Error(w, "scan campaigns unavailable", http.StatusNotImplemented)
+		return
+	}
+	req, ok := h.authenticate(w, r)
+	if !ok {
+		return
+	}
+	var in ReportRequest
+	if err := json.Unmarshal(req.payload, &in); err != nil {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+	scanID, err := uuid.Parse(in.ScanID)
+	if err != nil {
+		http.Error(w, "scanId must be a scan ID", http.StatusBadRequest)
+		return
+	}
+	machineID, ok := req.machine(w, in.MachineID)
+	if !ok {
+		return
+	}
+	report := scans.TargetReport{Message: in.Message}
+	switch in.Status {
+	case scans.TargetReported:
+	case scans.TargetFailed:
+		report.Failed = true
+	default:
+		http.Error(w, `status must be "reported" or "failed"`, http.StatusBadRequest)
+		return
+	}
+
+	summary, err := h.Campaigns.ReportTarget(r.Context(), req.tenant, scanID, machineID, report)
+	status := http.StatusOK
+	switch {
+	case errors.Is(err, scans.ErrScanFinished):
+		status = http.StatusConflict
+	case errors.Is(err, scans.ErrScanNotFound), errors.Is(err, scans.ErrTargetNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	case err != nil:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	default:
+		if h.Events != nil {
+			h.Events.Publish(events.Event{Type: events.TypeScanProgress, TenantSlug: req.tenant, ScanID: summary.ID})
+		}
+	}
+	writeScanStatus(w, status, summary)
+}
@@ -4,49 +4,301 @@ import (
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"io"
 	"log"
+	"math"
 	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/mcmx/duplynx/internal/events"
+	"github.com/mcmx/duplynx/internal/quota"
+	"github.com/mcmx/duplynx/internal/scans"
+	"github.com/mcmx/duplynx/internal/tenancy"
 )
 
-// Handler validates signed scan manifests before queuing processing work.
+const (
+	// HeaderTenant identifies the tenant that signed the payload.
+	HeaderTenant = "X-Duplynx-Tenant"
+	// HeaderSignature carries the hex-encoded HMAC-SHA256 of the payload.
+	HeaderSignature = "X-Duplynx-Signature"
+	// HeaderKeyID names the secret version used to compute the signature.
+	HeaderKeyID = "X-Duplynx-Key-Id"
+)
+
+// ManifestRequest is the signed body an agent posts to /ingest once its part
+// of a scan is walked: the groups of files on the machine that share a hash.
+type ManifestRequest struct {
+	ScanID      string          `json:"scanId"`
+	MachineID   string          `json:"machineId"`
+	FilesSeen   int             `json:"filesSeen"`
+	BytesHashed int64           `json:"bytesHashed"`
+	Groups      []ManifestGroup `json:"groups"`
+}
+
+// ManifestGroup lists files on the machine that share a content hash.
+type ManifestGroup struct {
+	Hash      string   `json:"hash"`
+	SizeBytes int64    `json:"sizeBytes"`
+	Paths     []string `json:"paths"`
+}
+
+// Handler validates signed scan manifests and stores them under their scan.
 type Handler struct {
+	// TenantSecrets holds legacy single secrets configured via DUPLYNX_TENANT_SECRETS.
 	TenantSecrets map[string]string
+	// Secrets resolves versioned secrets stored in the database.
+	Secrets SecretStore
+	// Now overrides the clock used to evaluate secret validity windows.
+	Now func() time.Time
+	// Quotas enforces per-tenant manifest size, request rate, and retained scan limits.
+	Quotas *quota.Enforcer
+	// Events announces accepted manifests and scan progress to live boards; nil disables it.
+	Events events.Publisher
+	// Progress records agent heartbeats; /ingest/heartbeat answers 501 when nil.
+	Progress ProgressRecorder
+	// Campaigns serves /ingest/assignments, /ingest/jobs, /ingest/report and manifests naming a scan; they answer 501 when nil.
+	Campaigns CampaignCoordinator
+	// Actions hands duplicate actions out with /ingest/jobs and serves
+	// /ingest/action-report; jobs carry only scans and reports answer 501 when nil.
+	Actions ActionCoordinator
+	// JobPoll is how often a waiting /ingest/jobs request checks for new work; DefaultJobPoll when zero.
+	JobPoll time.Duration
 }
 
+// ServeHTTP stores an agent's manifest under the scan it names, replacing the
+// machine's earlier upload. Manifests for a finished scan answer 409 with its
+// final status, as do manifests sent after the machine already reported.
 func (h Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	tenant := r.Header.Get("X-Duplynx-Tenant")
+	req, ok := h.authenticate(w, r)
+	if !ok {
+		return
+	}
+
+	if h.Quotas != nil {
+		if err := h.Quotas.AllowIngest(r.Context(), req.tenant, req.limits); err != nil {
+			writeQuotaError(w, err)
+			return
+		}
+	}
+
+	var in ManifestRequest
+	if err := json.Unmarshal(req.payload, &in); err != nil {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+	if in.ScanID == "" {
+		// Manifests that name no scan predate agent-run scans; there is nothing to store them under.
+		log.Printf("ingestion accepted tenant=%s key_id=%s bytes=%d", req.tenant, req.keyID, len(req.payload))
+		w.WriteHeader(http.StatusAccepted)
+		return
+	}
+	if h.Campaigns == nil {
+		http.Error(w, "scan manifests unavailable", http.StatusNotImplemented)
+		return
+	}
+	scanID, err := uuid.Parse(in.ScanID)
+	if err != nil {
+		http.Error(w, "scanId must be a scan ID", http.StatusBadRequest)
+		return
+	}
+	machineID, ok := req.machine(w, in.MachineID)
+	if !ok {
+		return
+	}
+	manifest := scans.Manifest{Groups: make([]scans.ManifestGroup, 0, len(in.Groups))}
+	for _, group := range in.Groups {
+		manifest.Groups = append(manifest.Groups, scans.ManifestGroup{Hash: group.Hash, SizeBytes: group.SizeBytes, Paths: group.Paths})
+	}
+
+	summary, err := h.Campaigns.RecordManifest(r.Context(), req.tenant, scanID, machineID, manifest)
+	switch {
+	case errors.Is(err, scans.ErrScanFinished):
+		writeScanStatus(w, http.StatusConflict, summary)
+		return
+	case errors.Is(err, scans.ErrTargetSettled):
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	case errors.Is(err, scans.ErrScanNotFound), errors.Is(err, scans.ErrTargetNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	case errors.Is(err, scans.ErrInvalidManifest):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	case err != nil:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if h.Events != nil {
+		h.Events.Publish(events.Event{
+			Type:       events.TypeScanIngested,
+			TenantSlug: req.tenant,
+			ScanID:     summary.ID,
+		})
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// signedRequest is an agent request whose payload matched one of the tenant's
+// secrets. machineID is set when that secret is bound to a machine.
+type signedRequest struct {
+	tenant    string
+	keyID     string
+	machineID uuid.UUID
+	payload   []byte
+	limits    quota.Limits
+}
+
+// authenticate reads the request body and checks its signature against the
+// tenant's secrets, writing the error response and reporting false on failure.
+func (h Handler) authenticate(w http.ResponseWriter, r *http.Request) (signedRequest, bool) {
+	tenant := r.Header.Get(HeaderTenant)
 	if tenant == "" {
 		http.Error(w, "missing tenant header", http.StatusBadRequest)
-		return
+		return signedRequest{}, false
 	}
 
-	secret, ok := h.TenantSecrets[tenant]
-	if !ok || secret == "" {
+	secrets, err := h.candidateSecrets(r, tenant)
+	if err != nil {
+		http.Error(w, "failed to resolve tenant secrets", http.StatusInternalServerError)
+		return signedRequest{}, false
+	}
+	if len(secrets) == 0 {
 		http.Error(w, "tenant not allowed", http.StatusForbidden)
-		return
+		return signedRequest{}, false
 	}
 
-	signature := r.Header.Get("X-Duplynx-Signature")
+	signature := r.Header.Get(HeaderSignature)
 	if signature == "" {
 		http.Error(w, "missing signature", http.StatusBadRequest)
-		return
+		return signedRequest{}, false
+	}
+
+	limits, err := h.limits(r, tenant)
+	if err != nil {
+		http.Error(w, "failed to resolve tenant quotas", http.StatusInternalServerError)
+		return signedRequest{}, false
+	}
+	body := r.Body
+	if limits.ManifestBytes > 0 {
+		if err := h.Quotas.CheckManifestSize(limits, tenant, r.ContentLength); err != nil {
+			writeQuotaError(w, err)
+			return signedRequest{}, false
+		}
+		body = http.MaxBytesReader(w, r.Body, limits.ManifestBytes)
 	}
 
-	payload, err := io.ReadAll(r.Body)
+	payload, err := io.ReadAll(body)
 	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			writeQuotaError(w, h.Quotas.CheckManifestSize(limits, tenant, tooLarge.Limit+1))
+			return signedRequest{}, false
+		}
 		http.Error(w, "failed to read payload", http.StatusInternalServerError)
-		return
+		return signedRequest{}, false
 	}
 
-	if !validateSignature(secret, payload, signature) {
+	version, ok := matchSignature(secrets, payload, signature)
+	if !ok {
 		http.Error(w, "invalid signature", http.StatusForbidden)
-		return
+		return signedRequest{}, false
 	}
+	return signedRequest{tenant: tenant, keyID: version.KeyID, machineID: version.MachineID, payload: payload, limits: limits}, true
+}
 
-	// TODO: enqueue payload for processing in Phase 3+.
-	log.Printf("ingestion accepted tenant=%s bytes=%d", tenant, len(payload))
-	w.WriteHeader(http.StatusAccepted)
+// machine parses the machine an agent request speaks for. Only that machine's
+// own secret may sign it: tenant-wide secrets and other machines' secrets are
+// refused, so one leaked secret cannot act for the whole fleet.
+func (req signedRequest) machine(w http.ResponseWriter, raw string) (uuid.UUID, bool) {
+	machineID, err := uuid.Parse(raw)
+	if err != nil {
+		http.Error(w, "machineId must be a machine ID", http.StatusBadRequest)
+		return uuid.Nil, false
+	}
+	switch req.machineID {
+	case machineID:
+		return machineID, true
+	case uuid.Nil:
+		http.Error(w, "machine requests must be signed with the machine's secret", http.StatusForbidden)
+	default:
+		http.Error(w, "secret belongs to another machine", http.StatusForbidden)
+	}
+	return uuid.Nil, false
+}
+
+// limits resolves the tenant's quotas. Tenants that only exist in the legacy
+// secret configuration fall back to the server defaults.
+func (h Handler) limits(r *http.Request, tenant string) (quota.Limits, error) {
+	if h.Quotas == nil {
+		return quota.Limits{}, nil
+	}
+	limits, err := h.Quotas.Limits(r.Context(), tenant)
+	if errors.Is(err, tenancy.ErrTenantNotFound) {
+		return h.Quotas.Defaults(), nil
+	}
+	return limits, err
+}
+
+// writeQuotaError maps quota failures to 413 for oversized manifests and 429 otherwise.
+func writeQuotaError(w http.ResponseWriter, err error) {
+	var limitErr *quota.LimitError
+	if errors.As(err, &limitErr) && limitErr.RetryAfter > 0 {
+		seconds := int(math.Ceil(limitErr.RetryAfter.Seconds()))
+		w.Header().Set("Retry-After", strconv.Itoa(seconds))
+	}
+	switch {
+	case errors.Is(err, quota.ErrManifestTooLarge):
+		http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+	case errors.Is(err, quota.ErrRateLimited), errors.Is(err, quota.ErrQuotaExceeded):
+		http.Error(w, err.Error(), http.StatusTooManyRequests)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// candidateSecrets lists the secrets a signature may be checked against. When the
+// agent names a key ID only that version is considered; otherwise every active
+// version is tried so agents that predate key IDs keep working during rotation.
+func (h Handler) candidateSecrets(r *http.Request, tenant string) ([]SecretVersion, error) {
+	keyID := r.Header.Get(HeaderKeyID)
+
+	var out []SecretVersion
+	if h.Secrets != nil {
+		now := time.Now()
+		if h.Now != nil {
+			now = h.Now()
+		}
+		versions, err := h.Secrets.ActiveSecrets(r.Context(), tenant, now)
+		if err != nil {
+			return nil, err
+		}
+		for _, version := range versions {
+			if keyID == "" || version.KeyID == keyID {
+				out = append(out, version)
+			}
+		}
+	}
+
+	if keyID == "" {
+		if secret, ok := h.TenantSecrets[tenant]; ok && secret != "" {
+			out = append(out, SecretVersion{TenantSlug: tenant, Secret: secret})
+		}
+	}
+	return out, nil
+}
+
+func matchSignature(secrets []SecretVersion, payload []byte, signature string) (SecretVersion, bool) {
+	for _, secret := range secrets {
+		if validateSignature(secret.Secret, payload, signature) {
+			return secret, true
+		}
+	}
+	return SecretVersion{}, false
 }
 
 func validateSignature(secret string, payload []byte, signature string) bool {
@@ -0,0 +1,111 @@
+package ingestion
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/mcmx/duplynx/internal/events"
+	"github.com/mcmx/duplynx/internal/scans"
+)
+
+// ProgressRecorder applies agent heartbeats; *scans.Repository implements it.
+type ProgressRecorder interface {
+	RecordHeartbeat(ctx context.Context, tenantSlug string, scanID uuid.UUID, hb scans.Heartbeat) (scans.ScanSummary, error)
+}
+
+// HeartbeatRequest is the signed body an agent posts to /ingest/heartbeat.
+type HeartbeatRequest struct {
+	ScanID           string `json:"scanId"`
+	Status           string `json:"status,omitempty"`
+	FilesSeen        int    `json:"filesSeen"`
+	BytesHashed      int64  `json:"bytesHashed"`
+	MachinesReported int    `json:"machinesReported"`
+	Message          string `json:"message,omitempty"`
+}
+
+// HeartbeatResponse tells the agent the scan's current status; reports get the same answer. Cancelled is
+// set when a steward cancelled the scan and the agent should stop.
+type HeartbeatResponse struct {
+	ScanID        string `json:"scanId"`
+	Status        string `json:"status"`
+	StatusMessage string `json:"statusMessage,omitempty"`
+	Cancelled     bool   `json:"cancelled"`
+}
+
+// Heartbeat records a signed progress report for one of the tenant's scans.
+// Scans that already finished answer 409 with their final status. A machine's
+// secret only reports progress for scans that machine takes part in.
+func (h Handler) Heartbeat(w http.ResponseWriter, r *http.Request) {
+	if h.Progress == nil {
+		http.Error(w, "heartbeats unavailable", http.StatusNotImplemented)
+		return
+	}
+	req, ok := h.authenticate(w, r)
+	if !ok {
+		return
+	}
+	var in HeartbeatRequest
+	if err := json.Unmarshal(req.payload, &in); err != nil {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+	scanID, err := uuid.Parse(in.ScanID)
+	if err != nil {
+		http.Error(w, "scanId must be a scan ID", http.StatusBadRequest)
+		return
+	}
+	hb := scans.Heartbeat{
+		FilesSeen:        in.FilesSeen,
+		BytesHashed:      in.BytesHashed,
+		MachinesReported: in.MachinesReported,
+		Message:          in.Message,
+		MachineID:        req.machineID,
+	}
+	if in.Status != "" {
+		if hb.Status, err = scans.ParseStatus(in.Status); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	summary, err := h.Progress.RecordHeartbeat(r.Context(), req.tenant, scanID, hb)
+	status := http.StatusOK
+	switch {
+	case errors.Is(err, scans.ErrScanFinished):
+		status = http.StatusConflict
+	case errors.Is(err, scans.ErrScanNotFound), errors.Is(err, scans.ErrTargetNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	case errors.Is(err, scans.ErrInvalidTransition):
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	case errors.Is(err, scans.ErrInvalidProgress):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	case err != nil:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	default:
+		if h.Events != nil {
+			h.Events.Publish(events.Event{Type: events.TypeScanProgress, TenantSlug: req.tenant, ScanID: summary.ID})
+		}
+	}
+
+	writeScanStatus(w, status, summary)
+}
+
+// writeScanStatus answers an agent with the scan's current status.
+func writeScanStatus(w http.ResponseWriter, status int, summary scans.ScanSummary) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(HeartbeatResponse{
+		ScanID:        summary.ID,
+		Status:        summary.Status,
+		StatusMessage: summary.StatusMessage,
+		Cancelled:     summary.Lifecycle() == scans.StatusCancelled,
+	})
+}
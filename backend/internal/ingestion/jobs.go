@@ -0,0 +1,145 @@
+package ingestion
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/mcmx/duplynx/internal/events"
+	"github.com/mcmx/duplynx/internal/scans"
+)
+
+// Job types handed to agents. JobScan walks the assigned roots and reports
+// the scan target; JobAction runs the machine's share of a duplicate action
+// and reports it to /ingest/action-report. Agents skip job types they do not
+// know.
+const (
+	JobScan   = "scan"
+	JobAction = "action"
+)
+
+const (
+	// MaxJobWait caps how long /ingest/jobs holds a request open, staying
+	// under the server's 30s write timeout.
+	MaxJobWait = 25 * time.Second
+	// DefaultJobPoll is how often a waiting /ingest/jobs request checks for new work.
+	DefaultJobPoll = time.Second
+)
+
+// JobsRequest is the signed body an agent daemon posts to /ingest/jobs. Every
+// poll counts as a heartbeat for the machine. Running lists the IDs of the
+// jobs the agent is already working on; Capacity is how many more jobs it can
+// start, and zero only records the heartbeat. WaitSeconds, capped at MaxJobWait,
+// holds the request open until a job arrives.
+type JobsRequest struct {
+	MachineID   string   `json:"machineId"`
+	Running     []string `json:"running,omitempty"`
+	Capacity    int      `json:"capacity"`
+	WaitSeconds int      `json:"waitSeconds,omitempty"`
+}
+
+// JobsResponse lists the jobs the agent should start.
+type JobsResponse struct {
+	Jobs []Job `json:"jobs"`
+}
+
+// Job is one unit of work for an agent; Scan is set for JobScan jobs and
+// Action for JobAction jobs.
+type Job struct {
+	ID     string             `json:"id"`
+	Type   string             `json:"type"`
+	Scan   *AssignmentPayload `json:"scan,omitempty"`
+	Action *ActionPayload     `json:"action,omitempty"`
+}
+
+// Jobs long-polls for the machine's next jobs: scan targets first, then the
+// duplicate actions queued for the machine. It answers as soon as there is
+// work the agent is not already running, or with no jobs once the wait is over.
+func (h Handler) Jobs(w http.ResponseWriter, r *http.Request) {
+	if h.Campaigns == nil {
+		http.Error(w, "agent jobs unavailable", http.StatusNotImplemented)
+		return
+	}
+	req, ok := h.authenticate(w, r)
+	if !ok {
+		return
+	}
+	var in JobsRequest
+	if err := json.Unmarshal(req.payload, &in); err != nil {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+	machineID, ok := req.machine(w, in.MachineID)
+	if !ok {
+		return
+	}
+	if err := h.Campaigns.MarkSeen(r.Context(), req.tenant, machineID); err != nil {
+		if errors.Is(err, scans.ErrTargetNotFound) {
+			http.Error(w, "machine not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	running := make(map[string]bool, len(in.Running))
+	for _, jobID := range in.Running {
+		running[jobID] = true
+	}
+	wait := min(max(time.Duration(in.WaitSeconds)*time.Second, 0), MaxJobWait)
+	poll := h.JobPoll
+	if poll <= 0 {
+		poll = DefaultJobPoll
+	}
+	deadline := time.Now().Add(wait)
+
+	out := JobsResponse{Jobs: []Job{}}
+	for in.Capacity > 0 {
+		assignments, err := h.Campaigns.ClaimAssignments(r.Context(), req.tenant, machineID)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		for _, assignment := range assignments {
+			if assignment.Claimed && h.Events != nil {
+				h.Events.Publish(events.Event{Type: events.TypeScanProgress, TenantSlug: req.tenant, ScanID: assignment.ScanID})
+			}
+			// Claimed targets beyond the agent's capacity are handed out on a later poll.
+			if running[assignment.ScanID] || len(out.Jobs) == in.Capacity {
+				continue
+			}
+			payload := AssignmentPayload{ScanID: assignment.ScanID, Name: assignment.Name, Roots: assignment.Roots, Exclusions: assignment.Exclusions}
+			if !assignment.Deadline.IsZero() {
+				deadline := assignment.Deadline.UTC()
+				payload.Deadline = &deadline
+			}
+			out.Jobs = append(out.Jobs, Job{ID: assignment.ScanID, Type: JobScan, Scan: &payload})
+		}
+		if h.Actions != nil && len(out.Jobs) < in.Capacity {
+			jobs, err := h.Actions.ClaimActions(r.Context(), req.tenant, machineID)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			for _, job := range jobs {
+				// Like scan targets, claimed jobs beyond the capacity wait for a later poll.
+				if running[job.ID] || len(out.Jobs) == in.Capacity {
+					continue
+				}
+				out.Jobs = append(out.Jobs, actionJob(job))
+			}
+		}
+		remaining := time.Until(deadline)
+		if len(out.Jobs) > 0 || remaining <= 0 {
+			break
+		}
+		select {
+		case <-r.Context().Done():
+			return
+		case <-time.After(min(poll, remaining)):
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(out)
+}
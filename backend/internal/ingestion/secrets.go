@@ -0,0 +1,261 @@
+package ingestion
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/mcmx/duplynx/ent"
+	entmachine "github.com/mcmx/duplynx/ent/machine"
+	enttenant "github.com/mcmx/duplynx/ent/tenant"
+	enttenantsecret "github.com/mcmx/duplynx/ent/tenantsecret"
+	"github.com/mcmx/duplynx/internal/tenancy/isolation"
+)
+
+var (
+	// ErrSecretNotFound is returned when a key ID does not resolve for the tenant.
+	ErrSecretNotFound = errors.New("tenant secret not found")
+	// ErrTenantNotFound is returned when rotating secrets for an unknown tenant.
+	ErrTenantNotFound = errors.New("tenant not found")
+	// ErrMachineNotFound is returned when issuing a machine secret for a machine the tenant does not have.
+	ErrMachineNotFound = errors.New("machine not found")
+)
+
+// DefaultRotationOverlap is how long previously active secrets remain valid after a rotation.
+const DefaultRotationOverlap = 24 * time.Hour
+
+// SecretVersion describes one version of a tenant's ingestion signing secret.
+// A version bound to a machine only signs requests for that machine.
+type SecretVersion struct {
+	TenantSlug string
+	MachineID  uuid.UUID
+	KeyID      string
+	Secret     string
+	NotBefore  time.Time
+	ExpiresAt  time.Time
+	RevokedAt  time.Time
+}
+
+// ActiveAt reports whether the version may be used to verify signatures at the supplied time.
+func (v SecretVersion) ActiveAt(at time.Time) bool {
+	if !v.RevokedAt.IsZero() {
+		return false
+	}
+	if at.Before(v.NotBefore) {
+		return false
+	}
+	if !v.ExpiresAt.IsZero() && !at.Before(v.ExpiresAt) {
+		return false
+	}
+	return true
+}
+
+// SecretStore resolves the secret versions that are currently valid for a tenant.
+type SecretStore interface {
+	ActiveSecrets(ctx context.Context, tenantSlug string, at time.Time) ([]SecretVersion, error)
+}
+
+// RotateOptions control how a new secret version overlaps with existing ones.
+type RotateOptions struct {
+	// Overlap keeps previously active versions valid for this long after the rotation.
+	Overlap time.Duration
+	// ValidFor schedules an expiry for the new version; zero means no scheduled expiry.
+	ValidFor time.Duration
+	// MachineID issues a version bound to one of the tenant's active machines,
+	// replacing only that machine's versions; uuid.Nil rotates the tenant-wide ones.
+	MachineID uuid.UUID
+	// Now overrides the rotation timestamp, primarily for tests.
+	Now time.Time
+}
+
+// SecretRepository persists tenant secret versions via Ent.
+type SecretRepository struct {
+	client *ent.Client
+}
+
+// NewSecretRepositoryFromClient constructs a secret repository using the supplied Ent client.
+func NewSecretRepositoryFromClient(client *ent.Client) *SecretRepository {
+	if client == nil {
+		return nil
+	}
+	return &SecretRepository{client: client}
+}
+
+// ActiveSecrets returns the versions valid at the supplied time, newest first.
+// Signatures are verified before a tenant scope exists, so the lookup runs
+// with system privileges and is keyed by the claimed tenant slug.
+func (r *SecretRepository) ActiveSecrets(ctx context.Context, tenantSlug string, at time.Time) ([]SecretVersion, error) {
+	versions, err := r.List(isolation.WithSystem(ctx), tenantSlug)
+	if err != nil {
+		return nil, err
+	}
+	out := versions[:0]
+	for _, version := range versions {
+		if version.ActiveAt(at) {
+			out = append(out, version)
+		}
+	}
+	return out, nil
+}
+
+// List returns every secret version for the tenant, newest first.
+func (r *SecretRepository) List(ctx context.Context, tenantSlug string) ([]SecretVersion, error) {
+	if r == nil || r.client == nil {
+		return nil, errors.New("secret repository not configured")
+	}
+	records, err := r.client.TenantSecret.
+		Query().
+		Where(enttenantsecret.HasTenantWith(enttenant.SlugEQ(tenantSlug))).
+		Order(ent.Desc(enttenantsecret.FieldNotBefore)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list tenant secrets: %w", err)
+	}
+	out := make([]SecretVersion, 0, len(records))
+	for _, record := range records {
+		out = append(out, convertSecret(tenantSlug, record))
+	}
+	return out, nil
+}
+
+// Rotate creates a new secret version and schedules expiry of the versions it replaces.
+func (r *SecretRepository) Rotate(ctx context.Context, tenantSlug string, opts RotateOptions) (SecretVersion, error) {
+	if r == nil || r.client == nil {
+		return SecretVersion{}, errors.New("secret repository not configured")
+	}
+	now := opts.Now
+	if now.IsZero() {
+		now = time.Now().UTC()
+	}
+	overlap := opts.Overlap
+	if overlap < 0 {
+		overlap = 0
+	}
+
+	tenant, err := r.client.Tenant.Query().Where(enttenant.SlugEQ(tenantSlug)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return SecretVersion{}, ErrTenantNotFound
+		}
+		return SecretVersion{}, fmt.Errorf("load tenant: %w", err)
+	}
+	bound := enttenantsecret.MachineIDIsNil()
+	if opts.MachineID != uuid.Nil {
+		active, err := r.client.Machine.Query().
+			Where(entmachine.IDEQ(opts.MachineID), entmachine.TenantIDEQ(tenant.ID), entmachine.ArchivedAtIsNil()).
+			Exist(ctx)
+		if err != nil {
+			return SecretVersion{}, fmt.Errorf("load machine: %w", err)
+		}
+		if !active {
+			return SecretVersion{}, ErrMachineNotFound
+		}
+		bound = enttenantsecret.MachineIDEQ(opts.MachineID)
+	}
+
+	secret, err := randomHex(32)
+	if err != nil {
+		return SecretVersion{}, err
+	}
+	suffix, err := randomHex(4)
+	if err != nil {
+		return SecretVersion{}, err
+	}
+	keyID := now.Format("20060102") + "-" + suffix
+
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return SecretVersion{}, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	// Retire the versions this one replaces at the end of the overlap window
+	// unless they already expire sooner.
+	retireAt := now.Add(overlap)
+	if _, err := tx.TenantSecret.
+		Update().
+		Where(
+			enttenantsecret.TenantIDEQ(tenant.ID),
+			bound,
+			enttenantsecret.RevokedAtIsNil(),
+			enttenantsecret.Or(
+				enttenantsecret.ExpiresAtIsNil(),
+				enttenantsecret.ExpiresAtGT(retireAt),
+			),
+		).
+		SetExpiresAt(retireAt).
+		Save(ctx); err != nil {
+		return SecretVersion{}, fmt.Errorf("schedule secret expiry: %w", err)
+	}
+
+	builder := tx.TenantSecret.Create().
+		SetTenantID(tenant.ID).
+		SetKeyID(keyID).
+		SetSecret(secret).
+		SetNotBefore(now)
+	if opts.MachineID != uuid.Nil {
+		builder.SetMachineID(opts.MachineID)
+	}
+	if opts.ValidFor > 0 {
+		builder.SetExpiresAt(now.Add(opts.ValidFor))
+	}
+	record, err := builder.Save(ctx)
+	if err != nil {
+		return SecretVersion{}, fmt.Errorf("create tenant secret: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return SecretVersion{}, fmt.Errorf("commit transaction: %w", err)
+	}
+	return convertSecret(tenantSlug, record), nil
+}
+
+// Revoke immediately invalidates a secret version.
+func (r *SecretRepository) Revoke(ctx context.Context, tenantSlug, keyID string) error {
+	if r == nil || r.client == nil {
+		return errors.New("secret repository not configured")
+	}
+	affected, err := r.client.TenantSecret.
+		Update().
+		Where(
+			enttenantsecret.KeyIDEQ(keyID),
+			enttenantsecret.HasTenantWith(enttenant.SlugEQ(tenantSlug)),
+			enttenantsecret.RevokedAtIsNil(),
+		).
+		SetRevokedAt(time.Now().UTC()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("revoke tenant secret: %w", err)
+	}
+	if affected == 0 {
+		return ErrSecretNotFound
+	}
+	return nil
+}
+
+func convertSecret(tenantSlug string, record *ent.TenantSecret) SecretVersion {
+	return SecretVersion{
+		TenantSlug: tenantSlug,
+		MachineID:  record.MachineID,
+		KeyID:      record.KeyID,
+		Secret:     record.Secret,
+		NotBefore:  record.NotBefore,
+		ExpiresAt:  record.ExpiresAt,
+		RevokedAt:  record.RevokedAt,
+	}
+}
+
+func randomHex(n int) (string, error) {
+	buf := make([]byte, n)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("generate secret: %w", err)
+	}
+	return hex.EncodeToString(buf), nil
+}
@@ -0,0 +1,347 @@
+package quota
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/mcmx/duplynx/ent"
+	entmachine "github.com/mcmx/duplynx/ent/machine"
+	entscan "github.com/mcmx/duplynx/ent/scan"
+	enttenant "github.com/mcmx/duplynx/ent/tenant"
+	"github.com/mcmx/duplynx/internal/tenancy"
+	"github.com/mcmx/duplynx/internal/tenancy/isolation"
+)
+
+// rateWindow is the period covered by IngestPerMinute.
+const rateWindow = time.Minute
+
+// Usage reports a tenant's consumption against its effective limits.
+type Usage struct {
+	TenantSlug        string
+	Limits            Limits
+	Machines          int
+	RetainedScans     int
+	IngestLastMinute  int
+	ConcurrentActions int
+}
+
+// Enforcer resolves tenant quotas and tracks in-memory rate and concurrency counters.
+// Counters are per process; limits and resource counts come from the database.
+type Enforcer struct {
+	client   *ent.Client
+	defaults Limits
+	// Now overrides the clock used for rate limiting, primarily for tests.
+	Now func() time.Time
+
+	mu      sync.Mutex
+	ingests map[string][]time.Time
+	actions map[string]int
+}
+
+// NewEnforcerFromClient constructs an enforcer that applies defaults to tenants without overrides.
+func NewEnforcerFromClient(client *ent.Client, defaults Limits) *Enforcer {
+	if client == nil {
+		return nil
+	}
+	return &Enforcer{
+		client:   client,
+		defaults: defaults,
+		ingests:  make(map[string][]time.Time),
+		actions:  make(map[string]int),
+	}
+}
+
+// Defaults returns the limits applied to tenants without overrides.
+func (e *Enforcer) Defaults() Limits {
+	return e.defaults
+}
+
+// Limits returns the effective limits for a tenant.
+func (e *Enforcer) Limits(ctx context.Context, tenantSlug string) (Limits, error) {
+	record, err := e.tenant(ctx, tenantSlug)
+	if err != nil {
+		return Limits{}, err
+	}
+	return overridesFromRecord(record).Apply(e.defaults), nil
+}
+
+// Overrides returns the stored per-tenant overrides.
+func (e *Enforcer) Overrides(ctx context.Context, tenantSlug string) (Overrides, error) {
+	record, err := e.tenant(ctx, tenantSlug)
+	if err != nil {
+		return Overrides{}, err
+	}
+	return overridesFromRecord(record), nil
+}
+
+// SetOverrides replaces the tenant's overrides and returns the resulting limits.
+func (e *Enforcer) SetOverrides(ctx context.Context, tenantSlug string, overrides Overrides) (Limits, error) {
+	if err := overrides.Validate(); err != nil {
+		return Limits{}, err
+	}
+	record, err := e.tenant(ctx, tenantSlug)
+	if err != nil {
+		return Limits{}, err
+	}
+	update := e.client.Tenant.UpdateOne(record).
+		SetNillableQuotaManifestBytes(overrides.ManifestBytes).
+		SetNillableQuotaIngestPerMinute(overrides.IngestPerMinute).
+		SetNillableQuotaMachines(overrides.Machines).
+		SetNillableQuotaRetainedScans(overrides.RetainedScans).
+		SetNillableQuotaConcurrentActions(overrides.ConcurrentActions)
+	if overrides.ManifestBytes == nil {
+		update.ClearQuotaManifestBytes()
+	}
+	if overrides.IngestPerMinute == nil {
+		update.ClearQuotaIngestPerMinute()
+	}
+	if overrides.Machines == nil {
+		update.ClearQuotaMachines()
+	}
+	if overrides.RetainedScans == nil {
+		update.ClearQuotaRetainedScans()
+	}
+	if overrides.ConcurrentActions == nil {
+		update.ClearQuotaConcurrentActions()
+	}
+	record, err = update.Save(isolation.WithSystem(ctx))
+	if err != nil {
+		return Limits{}, fmt.Errorf("update tenant quotas: %w", err)
+	}
+	return overridesFromRecord(record).Apply(e.defaults), nil
+}
+
+// CheckManifestSize rejects manifests larger than the tenant allows.
+func (e *Enforcer) CheckManifestSize(limits Limits, tenantSlug string, size int64) error {
+	if limits.ManifestBytes > 0 && size > limits.ManifestBytes {
+		return &LimitError{
+			TenantSlug: tenantSlug,
+			Resource:   ResourceManifestBytes,
+			Limit:      limits.ManifestBytes,
+			Used:       size,
+			err:        ErrManifestTooLarge,
+		}
+	}
+	return nil
+}
+
+// AllowIngest records an ingestion request, rejecting it when the tenant is over its
+// request rate or already retains as many scans as it may.
+func (e *Enforcer) AllowIngest(ctx context.Context, tenantSlug string, limits Limits) error {
+	if limits.RetainedScans > 0 {
+		retained, err := e.countScans(ctx, tenantSlug)
+		if err != nil {
+			return err
+		}
+		if retained >= limits.RetainedScans {
+			return &LimitError{
+				TenantSlug: tenantSlug,
+				Resource:   ResourceRetainedScans,
+				Limit:      int64(limits.RetainedScans),
+				Used:       int64(retained),
+				err:        ErrQuotaExceeded,
+			}
+		}
+	}
+
+	now := e.now()
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	recent := e.recentIngests(tenantSlug, now)
+	if limits.IngestPerMinute > 0 && len(recent) >= limits.IngestPerMinute {
+		return &LimitError{
+			TenantSlug: tenantSlug,
+			Resource:   ResourceIngestPerMinute,
+			Limit:      int64(limits.IngestPerMinute),
+			Used:       int64(len(recent)),
+			RetryAfter: recent[0].Add(rateWindow).Sub(now),
+			err:        ErrRateLimited,
+		}
+	}
+	e.ingests[tenantSlug] = append(recent, now)
+	return nil
+}
+
+// CheckMachines rejects registering another machine once the tenant is at its limit.
+// Archived machines do not count.
+func (e *Enforcer) CheckMachines(ctx context.Context, tenantSlug string) error {
+	limits, err := e.Limits(ctx, tenantSlug)
+	if err != nil {
+		return err
+	}
+	if limits.Machines == 0 {
+		return nil
+	}
+	count, err := e.countMachines(ctx, tenantSlug)
+	if err != nil {
+		return err
+	}
+	if count >= limits.Machines {
+		return &LimitError{
+			TenantSlug: tenantSlug,
+			Resource:   ResourceMachines,
+			Limit:      int64(limits.Machines),
+			Used:       int64(count),
+			err:        ErrQuotaExceeded,
+		}
+	}
+	return nil
+}
+
+// AcquireAction reserves one of the tenant's concurrent action slots. Callers must
+// invoke the returned release function once the action completes.
+func (e *Enforcer) AcquireAction(ctx context.Context, tenantSlug string) (func(), error) {
+	limits, err := e.Limits(ctx, tenantSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	running := e.actions[tenantSlug]
+	if limits.ConcurrentActions > 0 && running >= limits.ConcurrentActions {
+		return nil, &LimitError{
+			TenantSlug: tenantSlug,
+			Resource:   ResourceConcurrentActions,
+			Limit:      int64(limits.ConcurrentActions),
+			Used:       int64(running),
+			err:        ErrRateLimited,
+		}
+	}
+	e.actions[tenantSlug] = running + 1
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			e.mu.Lock()
+			defer e.mu.Unlock()
+			if e.actions[tenantSlug] <= 1 {
+				delete(e.actions, tenantSlug)
+				return
+			}
+			e.actions[tenantSlug]--
+		})
+	}, nil
+}
+
+// Usage reports the tenant's consumption against its limits.
+func (e *Enforcer) Usage(ctx context.Context, tenantSlug string) (Usage, error) {
+	limits, err := e.Limits(ctx, tenantSlug)
+	if err != nil {
+		return Usage{}, err
+	}
+	machines, err := e.countMachines(ctx, tenantSlug)
+	if err != nil {
+		return Usage{}, err
+	}
+	scans, err := e.countScans(ctx, tenantSlug)
+	if err != nil {
+		return Usage{}, err
+	}
+
+	now := e.now()
+	e.mu.Lock()
+	recent := e.recentIngests(tenantSlug, now)
+	running := e.actions[tenantSlug]
+	e.mu.Unlock()
+
+	return Usage{
+		TenantSlug:        tenantSlug,
+		Limits:            limits,
+		Machines:          machines,
+		RetainedScans:     scans,
+		IngestLastMinute:  len(recent),
+		ConcurrentActions: running,
+	}, nil
+}
+
+// UsageAll reports usage for every tenant that is not archived, ordered by slug.
+func (e *Enforcer) UsageAll(ctx context.Context) ([]Usage, error) {
+	if e == nil || e.client == nil {
+		return nil, errors.New("quota enforcer not configured")
+	}
+	slugs, err := e.client.Tenant.Query().
+		Where(enttenant.ArchivedAtIsNil()).
+		Order(enttenant.BySlug()).
+		Select(enttenant.FieldSlug).
+		Strings(isolation.WithSystem(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("list tenants: %w", err)
+	}
+	out := make([]Usage, 0, len(slugs))
+	for _, slug := range slugs {
+		usage, err := e.Usage(ctx, slug)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, usage)
+	}
+	return out, nil
+}
+
+// recentIngests prunes and returns the tenant's requests inside the rate window.
+// Callers must hold e.mu.
+func (e *Enforcer) recentIngests(tenantSlug string, now time.Time) []time.Time {
+	stamps := e.ingests[tenantSlug]
+	cutoff := now.Add(-rateWindow)
+	idx := 0
+	for idx < len(stamps) && !stamps[idx].After(cutoff) {
+		idx++
+	}
+	stamps = stamps[idx:]
+	if len(stamps) == 0 {
+		delete(e.ingests, tenantSlug)
+		return nil
+	}
+	e.ingests[tenantSlug] = stamps
+	return stamps
+}
+
+func (e *Enforcer) tenant(ctx context.Context, tenantSlug string) (*ent.Tenant, error) {
+	if e == nil || e.client == nil {
+		return nil, errors.New("quota enforcer not configured")
+	}
+	record, err := e.client.Tenant.Query().
+		Where(enttenant.SlugEQ(tenantSlug)).
+		Only(isolation.WithSystem(ctx))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, tenancy.ErrTenantNotFound
+		}
+		return nil, fmt.Errorf("load tenant quotas: %w", err)
+	}
+	return record, nil
+}
+
+func (e *Enforcer) countMachines(ctx context.Context, tenantSlug string) (int, error) {
+	count, err := e.client.Machine.Query().
+		Where(
+			entmachine.HasTenantWith(enttenant.SlugEQ(tenantSlug)),
+			entmachine.ArchivedAtIsNil(),
+		).
+		Count(isolation.WithSystem(ctx))
+	if err != nil {
+		return 0, fmt.Errorf("count machines: %w", err)
+	}
+	return count, nil
+}
+
+func (e *Enforcer) countScans(ctx context.Context, tenantSlug string) (int, error) {
+	count, err := e.client.Scan.Query().
+		Where(entscan.HasTenantWith(enttenant.SlugEQ(tenantSlug))).
+		Count(isolation.WithSystem(ctx))
+	if err != nil {
+		return 0, fmt.Errorf("count scans: %w", err)
+	}
+	return count, nil
+}
+
+func (e *Enforcer) now() time.Time {
+	if e.Now != nil {
+		return e.Now()
+	}
+	return time.Now()
+}
@@ -0,0 +1,129 @@
+package quota
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mcmx/duplynx/ent"
+	"github.com/mcmx/duplynx/internal/tenancy"
+)
+
+var (
+	// ErrQuotaExceeded is returned when a tenant already holds as many resources as its quota allows.
+	ErrQuotaExceeded = errors.New("tenant quota exceeded")
+	// ErrRateLimited is returned when a tenant sends requests faster than its rate limit.
+	ErrRateLimited = errors.New("tenant rate limit exceeded")
+	// ErrManifestTooLarge is returned when an ingestion manifest exceeds the tenant's size limit.
+	ErrManifestTooLarge = errors.New("manifest exceeds tenant size limit")
+)
+
+// Resource names used in LimitError and usage reports.
+const (
+	ResourceManifestBytes     = "manifest_bytes"
+	ResourceIngestPerMinute   = "ingest_per_minute"
+	ResourceMachines          = "machines"
+	ResourceRetainedScans     = "retained_scans"
+	ResourceConcurrentActions = "concurrent_actions"
+)
+
+// Limits holds the effective quotas for a tenant. Zero disables a limit.
+type Limits struct {
+	ManifestBytes     int64
+	IngestPerMinute   int
+	Machines          int
+	RetainedScans     int
+	ConcurrentActions int
+}
+
+// DefaultLimits returns the quotas applied to tenants without overrides.
+func DefaultLimits() Limits {
+	return Limits{
+		ManifestBytes:     64 << 20,
+		IngestPerMinute:   120,
+		Machines:          50,
+		RetainedScans:     100,
+		ConcurrentActions: 4,
+	}
+}
+
+// Overrides are per-tenant quota settings; nil fields inherit the defaults.
+type Overrides struct {
+	ManifestBytes     *int64
+	IngestPerMinute   *int
+	Machines          *int
+	RetainedScans     *int
+	ConcurrentActions *int
+}
+
+// Validate rejects negative limits; field names match the admin API payload.
+func (o Overrides) Validate() error {
+	errs := tenancy.ValidationErrors{}
+	if o.ManifestBytes != nil && *o.ManifestBytes < 0 {
+		errs["manifestBytes"] = "must be zero or greater"
+	}
+	for name, value := range map[string]*int{
+		"ingestPerMinute":   o.IngestPerMinute,
+		"machines":          o.Machines,
+		"retainedScans":     o.RetainedScans,
+		"concurrentActions": o.ConcurrentActions,
+	} {
+		if value != nil && *value < 0 {
+			errs[name] = "must be zero or greater"
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// Apply layers the overrides on top of the supplied defaults.
+func (o Overrides) Apply(defaults Limits) Limits {
+	out := defaults
+	if o.ManifestBytes != nil {
+		out.ManifestBytes = *o.ManifestBytes
+	}
+	if o.IngestPerMinute != nil {
+		out.IngestPerMinute = *o.IngestPerMinute
+	}
+	if o.Machines != nil {
+		out.Machines = *o.Machines
+	}
+	if o.RetainedScans != nil {
+		out.RetainedScans = *o.RetainedScans
+	}
+	if o.ConcurrentActions != nil {
+		out.ConcurrentActions = *o.ConcurrentActions
+	}
+	return out
+}
+
+func overridesFromRecord(record *ent.Tenant) Overrides {
+	return Overrides{
+		ManifestBytes:     record.QuotaManifestBytes,
+		IngestPerMinute:   record.QuotaIngestPerMinute,
+		Machines:          record.QuotaMachines,
+		RetainedScans:     record.QuotaRetainedScans,
+		ConcurrentActions: record.QuotaConcurrentActions,
+	}
+}
+
+// LimitError describes which quota a request exceeded.
+type LimitError struct {
+	TenantSlug string
+	Resource   string
+	Limit      int64
+	Used       int64
+	// RetryAfter hints when a rate-limited request may be retried.
+	RetryAfter time.Duration
+	err        error
+}
+
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("%s: tenant %s %s limit is %d (used %d)", e.err, e.TenantSlug, e.Resource, e.Limit, e.Used)
+}
+
+func (e *LimitError) Unwrap() error {
+	return e.err
+}
@@ -0,0 +1,279 @@
+// Package reclaim reports how much disk a tenant can free by removing
+// duplicate copies, and how much its actions have already freed.
+package reclaim
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"entgo.io/ent/dialect"
+
+	"github.com/mcmx/duplynx/ent"
+	entactionaudit "github.com/mcmx/duplynx/ent/actionaudit"
+	"github.com/mcmx/duplynx/internal/data/rawsql"
+	"github.com/mcmx/duplynx/internal/tenancy/isolation"
+)
+
+// DefaultTopDirectories is the number of directories listed when the caller does not choose.
+const DefaultTopDirectories = 10
+
+// Breakdown is the reclaimable space attributed to one machine, scan, status or directory.
+type Breakdown struct {
+	Key    string
+	Label  string
+	Groups int
+	Files  int
+	Bytes  int64
+}
+
+// Report summarises a tenant's reclaimable and reclaimed space. Content seen
+// by several scans is counted once: each machine contributes the copies found
+// by its latest scan, and the content's latest group supplies the keeper,
+// status and scan it is reported under.
+type Report struct {
+	// ReclaimableBytes is the size of every copy except the one that is
+	// kept: the keeper machine's copy when one is assigned, otherwise the
+	// first copy by path.
+	ReclaimableBytes  int64
+	ReclaimableGroups int
+	// ReclaimedBytes counts content whose copies were deleted or hardlinked,
+	// as recorded by the latest group acted on. That content is left out of
+	// the reclaimable totals and breakdowns.
+	ReclaimedBytes  int64
+	ReclaimedGroups int
+	// SimulatedBytes is the part of ReclaimedBytes recorded by stubbed actions,
+	// which did not touch the disk.
+	SimulatedBytes int64
+
+	ByMachine      []Breakdown
+	ByScan         []Breakdown
+	ByStatus       []Breakdown
+	TopDirectories []Breakdown
+}
+
+// Repository computes reports for the tenant scope carried by the context.
+type Repository struct {
+	client *ent.Client
+}
+
+// NewRepositoryFromClient constructs a repository using the supplied Ent client.
+func NewRepositoryFromClient(client *ent.Client) *Repository {
+	if client == nil {
+		return nil
+	}
+	return &Repository{client: client}
+}
+
+// freeingActions are the action types whose results release disk space.
+var freeingActions = []any{
+	string(entactionaudit.ActionTypeDeleteCopies),
+	string(entactionaudit.ActionTypeCreateHardlinks),
+}
+
+// actedHashes lists the content a freeing action was recorded for.
+const actedHashes = `acted AS (
+	SELECT DISTINCT g.hash
+	FROM action_audits a
+	JOIN duplicate_groups g ON g.id = a.duplicate_group_id
+	WHERE a.tenant_id = ? AND a.action_type IN (?, ?)
+)`
+
+// reclaimableSQL ranks the copies each machine's latest scan found for every
+// content hash that has not been acted on, keeper copy first, and aggregates
+// the ones after the first into totals and breakdowns. %s is the directory
+// of r.path, with a trailing slash.
+const reclaimableSQL = `WITH machine_scans AS (
+	SELECT DISTINCT fi.machine_id, g.scan_id, s.started_at
+	FROM file_instances fi
+	JOIN duplicate_groups g ON g.id = fi.duplicate_group_id
+	JOIN scans s ON s.id = g.scan_id
+	WHERE fi.tenant_id = ?
+), latest_scans AS (
+	SELECT machine_id, scan_id FROM (
+		SELECT machine_id, scan_id,
+			ROW_NUMBER() OVER (PARTITION BY machine_id ORDER BY started_at DESC, scan_id DESC) AS scan_rank
+		FROM machine_scans
+	) ranked WHERE scan_rank = 1
+), ` + actedHashes + `, current_groups AS (
+	SELECT id, hash, scan_id, status, keeper_machine_id FROM (
+		SELECT g.id, g.hash, g.scan_id, g.status, g.keeper_machine_id,
+			ROW_NUMBER() OVER (PARTITION BY g.hash ORDER BY s.started_at DESC, g.id DESC) AS group_rank
+		FROM duplicate_groups g
+		JOIN scans s ON s.id = g.scan_id
+		WHERE g.tenant_id = ? AND g.hash NOT IN (SELECT hash FROM acted)
+	) ranked WHERE group_rank = 1
+), copies AS (
+	SELECT cg.id AS group_id, cg.scan_id, cg.status, fi.machine_id, fi.path, fi.size_bytes,
+		ROW_NUMBER() OVER (
+			PARTITION BY cg.id
+			ORDER BY CASE WHEN fi.machine_id = cg.keeper_machine_id THEN 0 ELSE 1 END, fi.path, fi.id
+		) AS copy_rank
+	FROM file_instances fi
+	JOIN duplicate_groups g ON g.id = fi.duplicate_group_id
+	JOIN latest_scans ls ON ls.machine_id = fi.machine_id AND ls.scan_id = g.scan_id
+	JOIN current_groups cg ON cg.hash = g.hash
+	WHERE fi.tenant_id = ?
+), removable AS (
+	SELECT group_id, scan_id, status, machine_id, path, size_bytes FROM copies WHERE copy_rank > 1
+)
+SELECT 'total', '', '', COUNT(DISTINCT group_id), COUNT(*), CAST(COALESCE(SUM(size_bytes), 0) AS BIGINT)
+FROM removable
+UNION ALL
+SELECT 'scan', CAST(r.scan_id AS TEXT), s.name, COUNT(DISTINCT r.group_id), COUNT(*), CAST(SUM(r.size_bytes) AS BIGINT)
+FROM removable r JOIN scans s ON s.id = r.scan_id
+GROUP BY r.scan_id, s.name
+UNION ALL
+SELECT 'status', r.status, r.status, COUNT(DISTINCT r.group_id), COUNT(*), CAST(SUM(r.size_bytes) AS BIGINT)
+FROM removable r
+GROUP BY r.status
+UNION ALL
+SELECT 'machine', CAST(r.machine_id AS TEXT), m.name, COUNT(DISTINCT r.group_id), COUNT(*), CAST(SUM(r.size_bytes) AS BIGINT)
+FROM removable r JOIN machines m ON m.id = r.machine_id
+GROUP BY r.machine_id, m.name
+UNION ALL
+SELECT 'directory', dir, dir, group_count, file_count, byte_count FROM (
+	SELECT dir, COUNT(DISTINCT group_id) AS group_count, COUNT(*) AS file_count, CAST(SUM(size_bytes) AS BIGINT) AS byte_count
+	FROM (SELECT group_id, size_bytes, %s AS dir FROM removable r) dirs
+	GROUP BY dir
+	ORDER BY byte_count DESC, dir
+	LIMIT ?
+) top_directories`
+
+// reclaimedSQL sizes the latest acted-on group of each content hash the way
+// reclaimableSQL sizes a group, falling back to an average copy once
+// retention dropped the group's files. A hash counts as simulated only while
+// every freeing action on that group was stubbed.
+const reclaimedSQL = `WITH acted AS (
+	SELECT duplicate_group_id, MIN(CASE WHEN stubbed THEN 1 ELSE 0 END) AS stubbed
+	FROM action_audits
+	WHERE tenant_id = ? AND action_type IN (?, ?)
+	GROUP BY duplicate_group_id
+), latest AS (
+	SELECT g.id, g.keeper_machine_id, g.file_count, g.total_size_bytes, acted.stubbed,
+		ROW_NUMBER() OVER (PARTITION BY g.hash ORDER BY s.started_at DESC, g.id DESC) AS group_rank
+	FROM duplicate_groups g
+	JOIN acted ON acted.duplicate_group_id = g.id
+	JOIN scans s ON s.id = g.scan_id
+	WHERE g.tenant_id = ?
+), kept AS (
+	SELECT fi.duplicate_group_id, fi.size_bytes,
+		ROW_NUMBER() OVER (
+			PARTITION BY fi.duplicate_group_id
+			ORDER BY CASE WHEN fi.machine_id = l.keeper_machine_id THEN 0 ELSE 1 END, fi.path, fi.id
+		) AS copy_rank
+	FROM file_instances fi
+	JOIN latest l ON l.id = fi.duplicate_group_id AND l.group_rank = 1
+), freed AS (
+	SELECT l.stubbed, l.total_size_bytes - COALESCE(k.size_bytes, l.total_size_bytes / l.file_count) AS bytes
+	FROM latest l
+	LEFT JOIN kept k ON k.duplicate_group_id = l.id AND k.copy_rank = 1
+	WHERE l.group_rank = 1
+)
+SELECT COUNT(*),
+	CAST(COALESCE(SUM(CASE WHEN bytes > 0 THEN bytes ELSE 0 END), 0) AS BIGINT),
+	CAST(COALESCE(SUM(CASE WHEN stubbed = 1 AND bytes > 0 THEN bytes ELSE 0 END), 0) AS BIGINT)
+FROM freed`
+
+// Report aggregates the tenant in ctx's scope. topDirectories limits the
+// directory breakdown; zero uses DefaultTopDirectories.
+func (r *Repository) Report(ctx context.Context, topDirectories int) (Report, error) {
+	if r == nil || r.client == nil {
+		return Report{}, errors.New("reclaim repository not configured")
+	}
+	tenantID, ok := isolation.TenantID(ctx)
+	if !ok {
+		return Report{}, isolation.ErrUnscoped
+	}
+	if topDirectories <= 0 {
+		topDirectories = DefaultTopDirectories
+	}
+	d := r.client.Dialect()
+
+	var report Report
+	args := append(append([]any{tenantID}, freeingActions...), tenantID)
+	err := r.query(ctx, d, reclaimedSQL, args, func(scan func(...any) error) error {
+		return scan(&report.ReclaimedGroups, &report.ReclaimedBytes, &report.SimulatedBytes)
+	})
+	if err != nil {
+		return Report{}, fmt.Errorf("sum reclaimed space: %w", err)
+	}
+
+	args = append(append([]any{tenantID, tenantID}, freeingActions...), tenantID, tenantID, topDirectories)
+	err = r.query(ctx, d, fmt.Sprintf(reclaimableSQL, directorySQL(d, "r.path")), args, func(scan func(...any) error) error {
+		var kind string
+		var row Breakdown
+		if err := scan(&kind, &row.Key, &row.Label, &row.Groups, &row.Files, &row.Bytes); err != nil {
+			return err
+		}
+		switch kind {
+		case "total":
+			report.ReclaimableGroups, report.ReclaimableBytes = row.Groups, row.Bytes
+		case "scan":
+			report.ByScan = append(report.ByScan, row)
+		case "status":
+			report.ByStatus = append(report.ByStatus, row)
+		case "machine":
+			report.ByMachine = append(report.ByMachine, row)
+		case "directory":
+			row.Key = cleanDirectory(row.Key)
+			row.Label = row.Key
+			report.TopDirectories = append(report.TopDirectories, row)
+		}
+		return nil
+	})
+	if err != nil {
+		return Report{}, fmt.Errorf("sum reclaimable space: %w", err)
+	}
+
+	for _, rows := range [][]Breakdown{report.ByMachine, report.ByScan, report.ByStatus, report.TopDirectories} {
+		sortBreakdowns(rows)
+	}
+	return report, nil
+}
+
+// query runs a "?"-placeholder statement and calls fn for each row.
+func (r *Repository) query(ctx context.Context, d, query string, args []any, fn func(scan func(...any) error) error) error {
+	rows, err := r.client.QueryContext(ctx, rawsql.Rebind(d, query), args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		if err := fn(rows.Scan); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// directorySQL is the part of column up to and including its last slash.
+func directorySQL(d, column string) string {
+	if d == dialect.Postgres {
+		return fmt.Sprintf("regexp_replace(%s, '[^/]*$', '')", column)
+	}
+	return fmt.Sprintf("rtrim(%[1]s, replace(%[1]s, '/', ''))", column)
+}
+
+// cleanDirectory turns a directorySQL prefix into the form path.Dir returns.
+func cleanDirectory(prefix string) string {
+	switch {
+	case prefix == "":
+		return "."
+	case prefix == "/":
+		return prefix
+	}
+	return strings.TrimSuffix(prefix, "/")
+}
+
+// sortBreakdowns orders rows by bytes, largest first.
+func sortBreakdowns(rows []Breakdown) {
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].Bytes != rows[j].Bytes {
+			return rows[i].Bytes > rows[j].Bytes
+		}
+		return rows[i].Label < rows[j].Label
+	})
+}
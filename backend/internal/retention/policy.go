@@ -0,0 +1,64 @@
+// Package retention prunes what tenants keep from old scans: file instances
+// of scans superseded by newer ones on the same machine, and resolved groups
+// nobody has touched for a while, which move to the archived lane.
+package retention
+
+import (
+	"github.com/mcmx/duplynx/ent"
+	"github.com/mcmx/duplynx/internal/tenancy"
+)
+
+// Policy holds the effective retention rules for a tenant. Zero disables a rule.
+type Policy struct {
+	// KeepScans is how many completed scans per machine keep their file
+	// instances. Older scans keep only their groups' counts and sizes.
+	KeepScans int
+	// ArchiveAfterDays moves resolved groups unchanged for this many days to archived.
+	ArchiveAfterDays int
+}
+
+// DefaultPolicy returns the rules applied to tenants without overrides. Both
+// are off: pruning drops data, so each tenant opts in.
+func DefaultPolicy() Policy {
+	return Policy{}
+}
+
+// Overrides are per-tenant retention settings; nil fields inherit the defaults.
+type Overrides struct {
+	KeepScans        *int
+	ArchiveAfterDays *int
+}
+
+// Validate rejects negative values; field names match the CLI flags.
+func (o Overrides) Validate() error {
+	errs := tenancy.ValidationErrors{}
+	if o.KeepScans != nil && *o.KeepScans < 0 {
+		errs["keep-scans"] = "must be zero or greater"
+	}
+	if o.ArchiveAfterDays != nil && *o.ArchiveAfterDays < 0 {
+		errs["archive-after-days"] = "must be zero or greater"
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// Apply layers the overrides on top of the supplied defaults.
+func (o Overrides) Apply(defaults Policy) Policy {
+	out := defaults
+	if o.KeepScans != nil {
+		out.KeepScans = *o.KeepScans
+	}
+	if o.ArchiveAfterDays != nil {
+		out.ArchiveAfterDays = *o.ArchiveAfterDays
+	}
+	return out
+}
+
+func overridesFromRecord(record *ent.Tenant) Overrides {
+	return Overrides{
+		KeepScans:        record.RetentionKeepScans,
+		ArchiveAfterDays: record.RetentionArchiveAfterDays,
+	}
+}
@@ -0,0 +1,304 @@
+package retention
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/mcmx/duplynx/ent"
+	entduplicategroup "github.com/mcmx/duplynx/ent/duplicategroup"
+	entfileinstance "github.com/mcmx/duplynx/ent/fileinstance"
+	"github.com/mcmx/duplynx/ent/predicate"
+	entscan "github.com/mcmx/duplynx/ent/scan"
+	entscantarget "github.com/mcmx/duplynx/ent/scantarget"
+	enttenant "github.com/mcmx/duplynx/ent/tenant"
+	"github.com/mcmx/duplynx/internal/tenancy"
+	"github.com/mcmx/duplynx/internal/tenancy/isolation"
+)
+
+// ScanPrune describes a superseded scan whose file instances are dropped.
+type ScanPrune struct {
+	ID        uuid.UUID
+	Name      string
+	MachineID uuid.UUID
+	StartedAt time.Time
+	Groups    int
+	Files     int
+}
+
+// TenantReport lists what retention pruned, or would prune, for one tenant.
+type TenantReport struct {
+	TenantSlug     string
+	Policy         Policy
+	Scans          []ScanPrune
+	Files          int
+	ArchivedGroups int
+}
+
+// Report summarises a retention run across tenants.
+type Report struct {
+	// DryRun reports that nothing was changed.
+	DryRun  bool
+	Tenants []TenantReport
+}
+
+// Totals returns the superseded scans, dropped file instances, and archived
+// groups across every tenant.
+func (r Report) Totals() (scans, files, groups int) {
+	for _, tenant := range r.Tenants {
+		scans += len(tenant.Scans)
+		files += tenant.Files
+		groups += tenant.ArchivedGroups
+	}
+	return scans, files, groups
+}
+
+// Options selects what a run covers.
+type Options struct {
+	// TenantSlug limits the run to one tenant; empty covers every tenant that
+	// is not archived.
+	TenantSlug string
+	// DryRun reports what would be pruned without changing anything.
+	DryRun bool
+}
+
+// Pruner applies each tenant's retention policy.
+type Pruner struct {
+	client   *ent.Client
+	defaults Policy
+	// Now overrides the clock used for archive cut-offs, primarily for tests.
+	Now func() time.Time
+}
+
+// NewPrunerFromClient constructs a pruner that applies defaults to tenants without overrides.
+func NewPrunerFromClient(client *ent.Client, defaults Policy) *Pruner {
+	if client == nil {
+		return nil
+	}
+	return &Pruner{client: client, defaults: defaults}
+}
+
+// Defaults returns the policy applied to tenants without overrides.
+func (p *Pruner) Defaults() Policy {
+	return p.defaults
+}
+
+// Policy returns the effective policy for a tenant.
+func (p *Pruner) Policy(ctx context.Context, tenantSlug string) (Policy, error) {
+	record, err := p.tenant(ctx, tenantSlug)
+	if err != nil {
+		return Policy{}, err
+	}
+	return overridesFromRecord(record).Apply(p.defaults), nil
+}
+
+// Overrides returns the stored per-tenant overrides.
+func (p *Pruner) Overrides(ctx context.Context, tenantSlug string) (Overrides, error) {
+	record, err := p.tenant(ctx, tenantSlug)
+	if err != nil {
+		return Overrides{}, err
+	}
+	return overridesFromRecord(record), nil
+}
+
+// SetOverrides replaces the tenant's overrides and returns the resulting policy.
+func (p *Pruner) SetOverrides(ctx context.Context, tenantSlug string, overrides Overrides) (Policy, error) {
+	if err := overrides.Validate(); err != nil {
+		return Policy{}, err
+	}
+	record, err := p.tenant(ctx, tenantSlug)
+	if err != nil {
+		return Policy{}, err
+	}
+	update := p.client.Tenant.UpdateOne(record).
+		SetNillableRetentionKeepScans(overrides.KeepScans).
+		SetNillableRetentionArchiveAfterDays(overrides.ArchiveAfterDays)
+	if overrides.KeepScans == nil {
+		update.ClearRetentionKeepScans()
+	}
+	if overrides.ArchiveAfterDays == nil {
+		update.ClearRetentionArchiveAfterDays()
+	}
+	record, err = update.Save(isolation.WithSystem(ctx))
+	if err != nil {
+		return Policy{}, fmt.Errorf("update tenant retention: %w", err)
+	}
+	return overridesFromRecord(record).Apply(p.defaults), nil
+}
+
+// Run applies retention to the selected tenants. Each tenant is pruned in
+// its own transaction, so a failure leaves earlier tenants pruned.
+func (p *Pruner) Run(ctx context.Context, opts Options) (Report, error) {
+	if p == nil || p.client == nil {
+		return Report{}, errors.New("retention pruner not configured")
+	}
+	var tenants []*ent.Tenant
+	if opts.TenantSlug != "" {
+		record, err := p.tenant(ctx, opts.TenantSlug)
+		if err != nil {
+			return Report{}, err
+		}
+		tenants = append(tenants, record)
+	} else {
+		records, err := p.client.Tenant.Query().
+			Where(enttenant.ArchivedAtIsNil()).
+			Order(enttenant.BySlug()).
+			All(isolation.WithSystem(ctx))
+		if err != nil {
+			return Report{}, fmt.Errorf("list tenants: %w", err)
+		}
+		tenants = records
+	}
+
+	report := Report{DryRun: opts.DryRun}
+	for _, record := range tenants {
+		policy := overridesFromRecord(record).Apply(p.defaults)
+		tenantReport, err := p.pruneTenant(ctx, record, policy, opts.DryRun)
+		if err != nil {
+			return report, fmt.Errorf("prune tenant %s: %w", record.Slug, err)
+		}
+		report.Tenants = append(report.Tenants, tenantReport)
+	}
+	return report, nil
+}
+
+func (p *Pruner) pruneTenant(ctx context.Context, record *ent.Tenant, policy Policy, dryRun bool) (TenantReport, error) {
+	report := TenantReport{TenantSlug: record.Slug, Policy: policy}
+	tx, err := p.client.Tx(ctx)
+	if err != nil {
+		return report, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+	// Everything below runs confined to the tenant, so isolation backs up the explicit predicates.
+	ctx = isolation.WithTenant(ctx, record.ID)
+	now := p.now()
+
+	if policy.KeepScans > 0 {
+		superseded, err := supersededScans(ctx, tx, record.ID, policy.KeepScans)
+		if err != nil {
+			return report, err
+		}
+		for _, scan := range superseded {
+			prune := ScanPrune{ID: scan.ID, Name: scan.Name, MachineID: scan.InitiatedMachineID, StartedAt: scan.StartedAt}
+			files := []predicate.FileInstance{
+				entfileinstance.TenantID(record.ID),
+				entfileinstance.HasDuplicateGroupWith(entduplicategroup.ScanID(scan.ID)),
+			}
+			if prune.Groups, err = tx.DuplicateGroup.Query().Where(entduplicategroup.ScanID(scan.ID)).Count(ctx); err != nil {
+				return report, fmt.Errorf("count scan groups: %w", err)
+			}
+			if dryRun {
+				prune.Files, err = tx.FileInstance.Query().Where(files...).Count(ctx)
+			} else {
+				prune.Files, err = tx.FileInstance.Delete().Where(files...).Exec(ctx)
+				if err == nil {
+					err = tx.Scan.UpdateOneID(scan.ID).SetFilesPrunedAt(now).Exec(ctx)
+				}
+			}
+			if err != nil {
+				return report, fmt.Errorf("prune scan %s: %w", scan.ID, err)
+			}
+			report.Scans = append(report.Scans, prune)
+			report.Files += prune.Files
+		}
+	}
+
+	if policy.ArchiveAfterDays > 0 {
+		stale := []predicate.DuplicateGroup{
+			entduplicategroup.TenantID(record.ID),
+			entduplicategroup.StatusEQ(entduplicategroup.StatusResolved),
+			entduplicategroup.UpdateTimeLT(now.AddDate(0, 0, -policy.ArchiveAfterDays)),
+		}
+		if dryRun {
+			report.ArchivedGroups, err = tx.DuplicateGroup.Query().Where(stale...).Count(ctx)
+		} else {
+			report.ArchivedGroups, err = tx.DuplicateGroup.Update().Where(stale...).
+				SetStatus(entduplicategroup.StatusArchived).
+				Save(ctx)
+		}
+		if err != nil {
+			return report, fmt.Errorf("archive resolved groups: %w", err)
+		}
+	}
+
+	if dryRun {
+		return report, nil
+	}
+	if err := tx.Commit(); err != nil {
+		return report, fmt.Errorf("commit transaction: %w", err)
+	}
+	return report, nil
+}
+
+// supersededScans returns completed or partial scans that still have their
+// file instances but are not among the newest keep such scans of any machine
+// they cover. Failed and cancelled scans also carry a completion time, but
+// never supersede a usable scan nor get pruned. A multi-machine scan covers the targets that reported; other
+// scans cover their initiating machine, and scans without one are ranked together.
+func supersededScans(ctx context.Context, tx *ent.Tx, tenantID uuid.UUID, keep int) ([]*ent.Scan, error) {
+	scans, err := tx.Scan.Query().
+		Where(entscan.TenantID(tenantID), entscan.StatusIn(entscan.StatusCompleted, entscan.StatusPartial)).
+		WithTargets(func(q *ent.ScanTargetQuery) {
+			q.Where(entscantarget.StatusEQ(entscantarget.StatusReported))
+		}).
+		Order(ent.Desc(entscan.FieldStartedAt), ent.Desc(entscan.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list scans: %w", err)
+	}
+	newer := make(map[uuid.UUID]int)
+	var out []*ent.Scan
+	for _, scan := range scans {
+		machines := []uuid.UUID{scan.InitiatedMachineID}
+		if targets := scan.Edges.Targets; len(targets) > 0 {
+			machines = machines[:0]
+			for _, target := range targets {
+				machines = append(machines, target.MachineID)
+			}
+		}
+		// The scan is kept while any of its machines still counts it among the newest.
+		superseded := true
+		for _, machineID := range machines {
+			if newer[machineID] < keep {
+				superseded = false
+			}
+			newer[machineID]++
+		}
+		if superseded && scan.FilesPrunedAt.IsZero() {
+			out = append(out, scan)
+		}
+	}
+	// Oldest first reads naturally in reports.
+	for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+		out[i], out[j] = out[j], out[i]
+	}
+	return out, nil
+}
+
+func (p *Pruner) tenant(ctx context.Context, tenantSlug string) (*ent.Tenant, error) {
+	if p == nil || p.client == nil {
+		return nil, errors.New("retention pruner not configured")
+	}
+	record, err := p.client.Tenant.Query().
+		Where(enttenant.SlugEQ(tenantSlug)).
+		Only(isolation.WithSystem(ctx))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, tenancy.ErrTenantNotFound
+		}
+		return nil, fmt.Errorf("load tenant retention: %w", err)
+	}
+	return record, nil
+}
+
+func (p *Pruner) now() time.Time {
+	if p.Now != nil {
+		return p.Now()
+	}
+	return time.Now()
+}
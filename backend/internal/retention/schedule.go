@@ -0,0 +1,38 @@
+package retention
+
+import (
+	"context"
+	"time"
+)
+
+// DefaultInterval is how often serve applies retention unless configured otherwise.
+const DefaultInterval = time.Hour
+
+// Scheduler applies retention to every tenant each Interval.
+type Scheduler struct {
+	Pruner   *Pruner
+	Interval time.Duration
+	// OnRun, when set, observes every run.
+	OnRun func(Report, error)
+}
+
+// Run prunes on every tick until ctx is done. The first run happens one
+// Interval after start, so a restart never prunes before the server is up.
+func (s *Scheduler) Run(ctx context.Context) {
+	if s == nil || s.Pruner == nil || s.Interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(s.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			report, err := s.Pruner.Run(ctx, Options{})
+			if s.OnRun != nil {
+				s.OnRun(report, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,400 @@
+// Package scandiff compares two scans of the same tenant by content hash:
+// which duplicate groups are new, which disappeared from disk, which changed
+// copy count or size, and which copies moved between machines.
+package scandiff
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/mcmx/duplynx/ent"
+	entduplicategroup "github.com/mcmx/duplynx/ent/duplicategroup"
+	entfileinstance "github.com/mcmx/duplynx/ent/fileinstance"
+	entmachine "github.com/mcmx/duplynx/ent/machine"
+	entscan "github.com/mcmx/duplynx/ent/scan"
+	"github.com/mcmx/duplynx/internal/identity"
+)
+
+var (
+	// ErrScanNotFound is returned when either scan is missing or outside the caller's tenant.
+	ErrScanNotFound = errors.New("scan not found")
+	// ErrSameScan is returned when a scan is compared with itself.
+	ErrSameScan = errors.New("choose two different scans")
+	// ErrScanOrder is returned when the earlier scan did not start before the later one.
+	ErrScanOrder = errors.New("the earlier scan must start before the later one")
+	// ErrScanUnfinished is returned for scans that did not complete; their
+	// missing groups say nothing about the disk.
+	ErrScanUnfinished = errors.New("only completed or partial scans can be compared")
+)
+
+// Scan summarises one side of the diff.
+type Scan struct {
+	ID        string
+	Name      string
+	StartedAt time.Time
+	Groups    int
+	Files     int
+	Bytes     int64
+	// RedundantBytes is the size of every copy beyond the first, i.e. what
+	// deduplicating the scan's groups would free.
+	RedundantBytes int64
+	// FilesPruned is set when retention dropped the scan's file instances;
+	// moves cannot be detected for it.
+	FilesPruned bool
+}
+
+// Group is one hash present in either scan. From and To are zero on the side
+// the hash is missing from.
+type Group struct {
+	Hash        string
+	FromGroupID string
+	ToGroupID   string
+	FromFiles   int
+	ToFiles     int
+	FromBytes   int64
+	ToBytes     int64
+}
+
+// Move is a copy that left one machine and showed up on another.
+type Move struct {
+	Hash        string
+	ToGroupID   string
+	FromMachine string
+	FromPath    string
+	ToMachine   string
+	ToPath      string
+}
+
+// Diff is the comparison of scan From against the later scan To.
+type Diff struct {
+	From Scan
+	To   Scan
+	// New groups exist only in To; Removed groups only in From, meaning the
+	// duplication was cleaned up on disk.
+	New     []Group
+	Removed []Group
+	// Changed groups exist in both scans with a different copy count or size.
+	Changed []Group
+	Moves   []Move
+	// Uncovered names the machines only one of the scans looked at. Groups
+	// and moves are only reported from machines both scans covered, so
+	// copies there neither appear, disappear nor change.
+	Uncovered []string
+}
+
+// RedundantBytesDelta is how much redundant space changed from From to To;
+// negative means cleanup reduced duplication.
+func (d Diff) RedundantBytesDelta() int64 {
+	return d.To.RedundantBytes - d.From.RedundantBytes
+}
+
+// Repository compares scans visible in the caller's tenant scope.
+type Repository struct {
+	client *ent.Client
+}
+
+// NewRepositoryFromClient constructs a repository using the supplied Ent client.
+func NewRepositoryFromClient(client *ent.Client) *Repository {
+	if client == nil {
+		return nil
+	}
+	return &Repository{client: client}
+}
+
+// Diff compares scan from against scan to. Both must belong to the tenant in
+// ctx, be completed or partial, and from must have started first. Only
+// machines covered by both scans are compared; see identity.CoveredMachines.
+func (r *Repository) Diff(ctx context.Context, fromID, toID uuid.UUID) (Diff, error) {
+	if r == nil || r.client == nil {
+		return Diff{}, errors.New("scan diff repository not configured")
+	}
+	if fromID == toID {
+		return Diff{}, ErrSameScan
+	}
+	from, err := r.load(ctx, fromID)
+	if err != nil {
+		return Diff{}, err
+	}
+	to, err := r.load(ctx, toID)
+	if err != nil {
+		return Diff{}, err
+	}
+	if !from.scan.StartedAt.Before(to.scan.StartedAt) {
+		return Diff{}, ErrScanOrder
+	}
+
+	diff := Diff{From: from.scan, To: to.scan}
+	common := make(map[uuid.UUID]bool, len(from.covered))
+	var uncovered []uuid.UUID
+	for machineID := range from.covered {
+		if to.covered[machineID] {
+			common[machineID] = true
+		} else {
+			uncovered = append(uncovered, machineID)
+		}
+	}
+	for machineID := range to.covered {
+		if !from.covered[machineID] {
+			uncovered = append(uncovered, machineID)
+		}
+	}
+	if diff.Uncovered, err = r.machineNames(ctx, uncovered); err != nil {
+		return Diff{}, err
+	}
+	// Without a coverage gap whole groups compare; otherwise only their copies
+	// on common machines do, which needs the file lists retention may have pruned.
+	whole := len(uncovered) == 0
+	listed := !from.scan.FilesPruned && !to.scan.FilesPruned
+
+	for hash, after := range to.groups {
+		before, ok := from.groups[hash]
+		if !ok {
+			if whole || (!to.scan.FilesPruned && within(after, common)) {
+				diff.New = append(diff.New, pair(nil, after))
+			}
+			continue
+		}
+		switch {
+		case whole:
+			if before.FileCount != after.FileCount || before.TotalSizeBytes != after.TotalSizeBytes {
+				diff.Changed = append(diff.Changed, pair(before, after))
+			}
+		case listed:
+			if changed := pairOn(before, after, common); changed.FromFiles != changed.ToFiles || changed.FromBytes != changed.ToBytes {
+				diff.Changed = append(diff.Changed, changed)
+			}
+		}
+		if listed {
+			diff.Moves = append(diff.Moves, moves(before, after, common)...)
+		}
+	}
+	for hash, before := range from.groups {
+		if _, ok := to.groups[hash]; ok {
+			continue
+		}
+		if whole || (!from.scan.FilesPruned && within(before, common)) {
+			diff.Removed = append(diff.Removed, pair(before, nil))
+		}
+	}
+	for _, groups := range [][]Group{diff.New, diff.Removed, diff.Changed} {
+		sortGroups(groups)
+	}
+	sort.Slice(diff.Moves, func(i, j int) bool {
+		if diff.Moves[i].Hash != diff.Moves[j].Hash {
+			return diff.Moves[i].Hash < diff.Moves[j].Hash
+		}
+		return diff.Moves[i].ToPath < diff.Moves[j].ToPath
+	})
+	return diff, nil
+}
+
+// side is one loaded scan: its summary, groups by hash and covered machines.
+type side struct {
+	scan    Scan
+	groups  map[string]*ent.DuplicateGroup
+	covered map[uuid.UUID]bool
+}
+
+func (r *Repository) load(ctx context.Context, scanID uuid.UUID) (side, error) {
+	record, err := r.client.Scan.Get(ctx, scanID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return side{}, ErrScanNotFound
+		}
+		return side{}, fmt.Errorf("load scan: %w", err)
+	}
+	if record.Status != entscan.StatusCompleted && record.Status != entscan.StatusPartial {
+		return side{}, fmt.Errorf("%w: %s is %s", ErrScanUnfinished, record.Name, record.Status)
+	}
+	groups, err := r.client.DuplicateGroup.Query().
+		Where(entduplicategroup.ScanID(scanID)).
+		WithFileInstances(func(q *ent.FileInstanceQuery) {
+			q.WithMachine().Order(entfileinstance.ByPath())
+		}).
+		All(ctx)
+	if err != nil {
+		return side{}, fmt.Errorf("list duplicate groups: %w", err)
+	}
+	covered, err := identity.CoveredMachines(ctx, r.client, record)
+	if err != nil {
+		return side{}, err
+	}
+	out := side{
+		scan: Scan{
+			ID:          record.ID.String(),
+			Name:        record.Name,
+			StartedAt:   record.StartedAt,
+			FilesPruned: !record.FilesPrunedAt.IsZero(),
+		},
+		groups:  make(map[string]*ent.DuplicateGroup, len(groups)),
+		covered: covered,
+	}
+	for _, group := range groups {
+		out.groups[group.Hash] = group
+		out.scan.Groups++
+		out.scan.Files += group.FileCount
+		out.scan.Bytes += group.TotalSizeBytes
+		out.scan.RedundantBytes += redundantBytes(group)
+	}
+	return out, nil
+}
+
+// machineNames returns the machines' names in order, falling back to their IDs.
+func (r *Repository) machineNames(ctx context.Context, ids []uuid.UUID) ([]string, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	machines, err := r.client.Machine.Query().Where(entmachine.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("load machines: %w", err)
+	}
+	names := make(map[uuid.UUID]string, len(machines))
+	for _, machine := range machines {
+		names[machine.ID] = machine.Name
+	}
+	out := make([]string, 0, len(ids))
+	for _, id := range ids {
+		name := names[id]
+		if name == "" {
+			name = id.String()
+		}
+		out = append(out, name)
+	}
+	sort.Strings(out)
+	return out, nil
+}
+
+// within reports whether every copy of the group is on a machine in machines.
+func within(group *ent.DuplicateGroup, machines map[uuid.UUID]bool) bool {
+	for _, file := range group.Edges.FileInstances {
+		if !machines[file.MachineID] {
+			return false
+		}
+	}
+	return true
+}
+
+func redundantBytes(group *ent.DuplicateGroup) int64 {
+	if group.FileCount <= 1 {
+		return 0
+	}
+	return group.TotalSizeBytes - group.TotalSizeBytes/int64(group.FileCount)
+}
+
+// pairOn is pair counting only the copies on the given machines.
+func pairOn(before, after *ent.DuplicateGroup, machines map[uuid.UUID]bool) Group {
+	out := Group{Hash: after.Hash, FromGroupID: before.ID.String(), ToGroupID: after.ID.String()}
+	for _, file := range before.Edges.FileInstances {
+		if machines[file.MachineID] {
+			out.FromFiles++
+			out.FromBytes += file.SizeBytes
+		}
+	}
+	for _, file := range after.Edges.FileInstances {
+		if machines[file.MachineID] {
+			out.ToFiles++
+			out.ToBytes += file.SizeBytes
+		}
+	}
+	return out
+}
+
+func pair(before, after *ent.DuplicateGroup) Group {
+	var out Group
+	if before != nil {
+		out.Hash = before.Hash
+		out.FromGroupID = before.ID.String()
+		out.FromFiles = before.FileCount
+		out.FromBytes = before.TotalSizeBytes
+	}
+	if after != nil {
+		out.Hash = after.Hash
+		out.ToGroupID = after.ID.String()
+		out.ToFiles = after.FileCount
+		out.ToBytes = after.TotalSizeBytes
+	}
+	return out
+}
+
+// moves pairs copies that vanished from one machine with copies that
+// appeared on another, both among machines. Copies with the same file name
+// pair up first.
+func moves(before, after *ent.DuplicateGroup, machines map[uuid.UUID]bool) []Move {
+	type copyAt struct {
+		machineID uuid.UUID
+		machine   string
+		path      string
+	}
+	key := func(file *ent.FileInstance) copyAt {
+		c := copyAt{machineID: file.MachineID, path: file.Path, machine: file.MachineID.String()}
+		if file.Edges.Machine != nil {
+			c.machine = file.Edges.Machine.Name
+		}
+		return c
+	}
+	seen := func(files []*ent.FileInstance) map[[2]string]bool {
+		out := make(map[[2]string]bool, len(files))
+		for _, file := range files {
+			out[[2]string{file.MachineID.String(), file.Path}] = true
+		}
+		return out
+	}
+	inBefore, inAfter := seen(before.Edges.FileInstances), seen(after.Edges.FileInstances)
+	var gone, arrived []copyAt
+	for _, file := range before.Edges.FileInstances {
+		if machines[file.MachineID] && !inAfter[[2]string{file.MachineID.String(), file.Path}] {
+			gone = append(gone, key(file))
+		}
+	}
+	for _, file := range after.Edges.FileInstances {
+		if machines[file.MachineID] && !inBefore[[2]string{file.MachineID.String(), file.Path}] {
+			arrived = append(arrived, key(file))
+		}
+	}
+
+	var out []Move
+	used := make([]bool, len(arrived))
+	match := func(src copyAt, sameName bool) bool {
+		for i, dst := range arrived {
+			if used[i] || dst.machineID == src.machineID {
+				continue
+			}
+			if sameName && path.Base(dst.path) != path.Base(src.path) {
+				continue
+			}
+			used[i] = true
+			out = append(out, Move{
+				Hash:        after.Hash,
+				ToGroupID:   after.ID.String(),
+				FromMachine: src.machine,
+				FromPath:    src.path,
+				ToMachine:   dst.machine,
+				ToPath:      dst.path,
+			})
+			return true
+		}
+		return false
+	}
+	var unmatched []copyAt
+	for _, src := range gone {
+		if !match(src, true) {
+			unmatched = append(unmatched, src)
+		}
+	}
+	for _, src := range unmatched {
+		match(src, false)
+	}
+	return out
+}
+
+func sortGroups(groups []Group) {
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].Hash < groups[j].Hash
+	})
+}
@@ -0,0 +1,421 @@
+package scans
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/mcmx/duplynx/ent"
+	entmachine "github.com/mcmx/duplynx/ent/machine"
+	entscan "github.com/mcmx/duplynx/ent/scan"
+	entscantarget "github.com/mcmx/duplynx/ent/scantarget"
+	enttenant "github.com/mcmx/duplynx/ent/tenant"
+	"github.com/mcmx/duplynx/internal/tenancy/isolation"
+)
+
+// Target statuses. A target is pending until its machine's agent claims it,
+// and missed when the scan's deadline passed before the machine reported.
+const (
+	TargetPending  = "pending"
+	TargetClaimed  = "claimed"
+	TargetReported = "reported"
+	TargetFailed   = "failed"
+	TargetMissed   = "missed"
+)
+
+var (
+	ErrInvalidCampaign = errors.New("invalid scan campaign")
+	// ErrTargetNotFound is returned when a machine reports for a scan it is not a target of.
+	ErrTargetNotFound = errors.New("machine is not a target of this scan")
+)
+
+// CampaignInput describes a scan spanning several machines.
+type CampaignInput struct {
+	Name        string
+	Description string
+	// Deadline, when set, ends the scan even if some targets never report.
+	Deadline time.Time
+	Targets  []TargetInput
+}
+
+// TargetInput names a participating machine, the roots it should walk and
+// the paths or patterns under them it should skip.
+type TargetInput struct {
+	MachineID  uuid.UUID
+	Roots      []string
+	Exclusions []string
+}
+
+// Assignment is a target handed to a polling agent.
+type Assignment struct {
+	ScanID     string
+	Name       string
+	Roots      []string
+	Exclusions []string
+	Deadline   time.Time
+	// Claimed is set when this poll claimed the target.
+	Claimed bool
+}
+
+// TargetReport is a machine's final word on its part of a scan.
+type TargetReport struct {
+	Failed  bool
+	Message string
+}
+
+// CreateCampaign creates a pending scan with one target per machine in the
+// caller's tenant scope. Machines must be active and listed once, each with
+// at least one root.
+func (r *Repository) CreateCampaign(ctx context.Context, in CampaignInput) (ScanSummary, error) {
+	if r.client == nil {
+		return ScanSummary{}, errors.New("scan campaigns require a database")
+	}
+	tenantID, ok := isolation.TenantID(ctx)
+	if !ok {
+		return ScanSummary{}, isolation.ErrUnscoped
+	}
+	in.Name = strings.TrimSpace(in.Name)
+	if in.Name == "" {
+		return ScanSummary{}, fmt.Errorf("%w: name is required", ErrInvalidCampaign)
+	}
+	if len(in.Targets) == 0 {
+		return ScanSummary{}, fmt.Errorf("%w: at least one target machine is required", ErrInvalidCampaign)
+	}
+	now := r.now()
+	if !in.Deadline.IsZero() && !in.Deadline.After(now) {
+		return ScanSummary{}, fmt.Errorf("%w: deadline must be in the future", ErrInvalidCampaign)
+	}
+
+	machineIDs := make([]uuid.UUID, 0, len(in.Targets))
+	seen := make(map[uuid.UUID]bool, len(in.Targets))
+	for i, target := range in.Targets {
+		if seen[target.MachineID] {
+			return ScanSummary{}, fmt.Errorf("%w: machine %s is listed twice", ErrInvalidCampaign, target.MachineID)
+		}
+		seen[target.MachineID] = true
+		machineIDs = append(machineIDs, target.MachineID)
+
+		roots := make([]string, 0, len(target.Roots))
+		for _, root := range target.Roots {
+			if root = strings.TrimSpace(root); root != "" {
+				roots = append(roots, root)
+			}
+		}
+		if len(roots) == 0 {
+			return ScanSummary{}, fmt.Errorf("%w: machine %s needs at least one root", ErrInvalidCampaign, target.MachineID)
+		}
+		in.Targets[i].Roots = roots
+	}
+	active, err := r.client.Machine.Query().
+		Where(entmachine.IDIn(machineIDs...), entmachine.ArchivedAtIsNil()).
+		Count(ctx)
+	if err != nil {
+		return ScanSummary{}, fmt.Errorf("load target machines: %w", err)
+	}
+	if active != len(machineIDs) {
+		return ScanSummary{}, fmt.Errorf("%w: every target must be an active machine of the tenant", ErrInvalidCampaign)
+	}
+
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return ScanSummary{}, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+	create := tx.Scan.Create().
+		SetTenantID(tenantID).
+		SetName(in.Name).
+		SetStartedAt(now).
+		SetDuplicateGroupCount(0).
+		SetStatus(entscan.StatusPending)
+	if in.Description != "" {
+		create.SetDescription(in.Description)
+	}
+	if !in.Deadline.IsZero() {
+		create.SetDeadline(in.Deadline)
+	}
+	record, err := create.Save(ctx)
+	if err != nil {
+		return ScanSummary{}, fmt.Errorf("create scan: %w", err)
+	}
+	builders := make([]*ent.ScanTargetCreate, 0, len(in.Targets))
+	for _, target := range in.Targets {
+		builders = append(builders, tx.ScanTarget.Create().
+			SetTenantID(tenantID).
+			SetScanID(record.ID).
+			SetMachineID(target.MachineID).
+			SetRoots(target.Roots).
+			SetExclusions(target.Exclusions))
+	}
+	if err := tx.ScanTarget.CreateBulk(builders...).Exec(ctx); err != nil {
+		return ScanSummary{}, fmt.Errorf("create scan targets: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return ScanSummary{}, fmt.Errorf("commit transaction: %w", err)
+	}
+	return r.getFromClient(ctx, record.ID.String())
+}
+
+// ClaimAssignments returns the unfinished targets of one of tenantSlug's
+// machines, marking pending ones claimed. The first claim moves a pending scan
+// to running.
+func (r *Repository) ClaimAssignments(ctx context.Context, tenantSlug string, machineID uuid.UUID) ([]Assignment, error) {
+	if r.client == nil {
+		return nil, errors.New("scan campaigns require a database")
+	}
+	machine, err := r.client.Machine.Query().
+		Where(entmachine.IDEQ(machineID), entmachine.HasTenantWith(enttenant.SlugEQ(tenantSlug))).
+		Only(isolation.WithSystem(ctx))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrTargetNotFound
+		}
+		return nil, fmt.Errorf("load machine: %w", err)
+	}
+	ctx = isolation.WithTenant(ctx, machine.TenantID)
+
+	targets, err := r.client.ScanTarget.Query().
+		Where(
+			entscantarget.MachineID(machineID),
+			entscantarget.StatusIn(entscantarget.StatusPending, entscantarget.StatusClaimed),
+			entscantarget.HasScanWith(entscan.StatusNotIn(finalStatuses...)),
+		).
+		WithScan().
+		Order(entscantarget.ByCreateTime()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list scan targets: %w", err)
+	}
+
+	now := r.now()
+	out := make([]Assignment, 0, len(targets))
+	for _, target := range targets {
+		scan := target.Edges.Scan
+		if target.Status == entscantarget.StatusPending {
+			if err := r.client.ScanTarget.UpdateOne(target).
+				SetStatus(entscantarget.StatusClaimed).
+				SetClaimedAt(now).
+				Exec(ctx); err != nil {
+				return nil, fmt.Errorf("claim scan target: %w", err)
+			}
+			if _, err := r.client.Scan.Update().
+				Where(entscan.IDEQ(scan.ID), entscan.StatusEQ(entscan.StatusPending)).
+				SetStatus(entscan.StatusRunning).
+				Save(ctx); err != nil {
+				return nil, fmt.Errorf("start scan: %w", err)
+			}
+		}
+		out = append(out, Assignment{
+			ScanID:     scan.ID.String(),
+			Name:       scan.Name,
+			Roots:      target.Roots,
+			Exclusions: target.Exclusions,
+			Deadline:   scan.Deadline,
+			Claimed:    target.Status == entscantarget.StatusPending,
+		})
+	}
+	return out, nil
+}
+
+// MarkSeen stamps the machine's last_seen_at, which the machines list uses to
+// show whether its agent is online. Archived machines are not found.
+func (r *Repository) MarkSeen(ctx context.Context, tenantSlug string, machineID uuid.UUID) error {
+	if r.client == nil {
+		return errors.New("scan campaigns require a database")
+	}
+	machine, err := r.client.Machine.Query().
+		Where(entmachine.IDEQ(machineID), entmachine.ArchivedAtIsNil(), entmachine.HasTenantWith(enttenant.SlugEQ(tenantSlug))).
+		Only(isolation.WithSystem(ctx))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ErrTargetNotFound
+		}
+		return fmt.Errorf("load machine: %w", err)
+	}
+	if err := r.client.Machine.UpdateOne(machine).
+		SetLastSeenAt(r.now()).
+		Exec(isolation.WithTenant(ctx, machine.TenantID)); err != nil {
+		return fmt.Errorf("mark machine seen: %w", err)
+	}
+	return nil
+}
+
+// ReportTarget records that a machine finished, or gave up on, its part of
+// one of tenantSlug's scans. The scan completes once every target reported;
+// when some failed it ends partial, or failed if none reported. Repeated
+// reports leave the target unchanged.
+func (r *Repository) ReportTarget(ctx context.Context, tenantSlug string, scanID, machineID uuid.UUID, report TargetReport) (ScanSummary, error) {
+	if r.client == nil {
+		return ScanSummary{}, errors.New("scan campaigns require a database")
+	}
+	record, err := r.client.Scan.Query().
+		Where(entscan.IDEQ(scanID), entscan.HasTenantWith(enttenant.SlugEQ(tenantSlug))).
+		Only(isolation.WithSystem(ctx))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ScanSummary{}, ErrScanNotFound
+		}
+		return ScanSummary{}, fmt.Errorf("load scan: %w", err)
+	}
+	ctx = isolation.WithTenant(ctx, record.TenantID)
+	if Status(record.Status).Final() {
+		summary, err := r.getFromClient(ctx, scanID.String())
+		if err != nil {
+			return ScanSummary{}, err
+		}
+		return summary, ErrScanFinished
+	}
+
+	target, err := r.client.ScanTarget.Query().
+		Where(entscantarget.ScanID(scanID), entscantarget.MachineID(machineID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ScanSummary{}, ErrTargetNotFound
+		}
+		return ScanSummary{}, fmt.Errorf("load scan target: %w", err)
+	}
+
+	now := r.now()
+	if target.Status == entscantarget.StatusPending || target.Status == entscantarget.StatusClaimed {
+		status := entscantarget.StatusReported
+		if report.Failed {
+			status = entscantarget.StatusFailed
+		}
+		update := r.client.ScanTarget.UpdateOne(target).
+			SetStatus(status).
+			SetReportedAt(now)
+		if target.ClaimedAt.IsZero() {
+			update.SetClaimedAt(now)
+		}
+		if report.Message != "" {
+			update.SetStatusMessage(report.Message)
+		}
+		if err := update.Exec(ctx); err != nil {
+			return ScanSummary{}, fmt.Errorf("report scan target: %w", err)
+		}
+		if !report.Failed {
+			if err := r.client.Machine.UpdateOneID(machineID).SetLastScanAt(now).Exec(ctx); err != nil {
+				return ScanSummary{}, fmt.Errorf("stamp machine: %w", err)
+			}
+		}
+	}
+	if err := r.settleCampaign(ctx, scanID, now); err != nil {
+		return ScanSummary{}, err
+	}
+	return r.getFromClient(ctx, scanID.String())
+}
+
+// ExpireCampaigns ends unfinished scans whose deadline passed before now,
+// across all tenants. Targets that never reported are marked missed.
+func (r *Repository) ExpireCampaigns(ctx context.Context, now time.Time) ([]ScanSummary, error) {
+	if r.client == nil {
+		return nil, errors.New("scan campaigns require a database")
+	}
+	ctx = isolation.WithSystem(ctx)
+	ids, err := r.client.Scan.Query().
+		Where(entscan.DeadlineLT(now), entscan.StatusNotIn(finalStatuses...), entscan.HasTargets()).
+		IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list overdue scans: %w", err)
+	}
+	var out []ScanSummary
+	for _, id := range ids {
+		if _, err := r.client.ScanTarget.Update().
+			Where(
+				entscantarget.ScanID(id),
+				entscantarget.StatusIn(entscantarget.StatusPending, entscantarget.StatusClaimed),
+			).
+			SetStatus(entscantarget.StatusMissed).
+			SetStatusMessage("no report before the deadline").
+			Save(ctx); err != nil {
+			return out, fmt.Errorf("mark missed targets: %w", err)
+		}
+		if err := r.settleCampaign(ctx, id, now); err != nil {
+			return out, err
+		}
+		summary, err := r.getFromClient(ctx, id.String())
+		if err != nil {
+			return out, err
+		}
+		out = append(out, summary)
+	}
+	return out, nil
+}
+
+// settleCampaign refreshes the scan's reported machine count and finishes the
+// scan once none of its targets is outstanding, linking a completed or
+// partial scan's groups to their content identities.
+func (r *Repository) settleCampaign(ctx context.Context, scanID uuid.UUID, now time.Time) error {
+	targets, err := r.client.ScanTarget.Query().Where(entscantarget.ScanID(scanID)).All(ctx)
+	if err != nil {
+		return fmt.Errorf("load scan targets: %w", err)
+	}
+	reported, outstanding := 0, 0
+	for _, target := range targets {
+		switch target.Status {
+		case entscantarget.StatusReported:
+			reported++
+		case entscantarget.StatusPending, entscantarget.StatusClaimed:
+			outstanding++
+		}
+	}
+
+	update := r.client.Scan.Update().
+		Where(entscan.IDEQ(scanID), entscan.StatusNotIn(finalStatuses...)).
+		SetMachinesReported(reported)
+	if outstanding == 0 {
+		status := entscan.StatusCompleted
+		switch {
+		case reported == 0:
+			status = entscan.StatusFailed
+		case reported < len(targets):
+			status = entscan.StatusPartial
+		}
+		update.SetStatus(status).SetCompletedAt(now)
+		if status != entscan.StatusCompleted {
+			update.SetStatusMessage(fmt.Sprintf("%d of %d machines reported", reported, len(targets)))
+		}
+	}
+	settled, err := update.Save(ctx)
+	if err != nil {
+		return fmt.Errorf("settle scan: %w", err)
+	}
+	if settled > 0 && outstanding == 0 && reported > 0 {
+		return r.linkCompleted(ctx, scanID)
+	}
+	return nil
+}
+
+// DeadlineWatcher ends multi-machine scans whose deadline passed.
+type DeadlineWatcher struct {
+	Repo     *Repository
+	Interval time.Duration
+	// OnExpire, when set, observes every check that ended scans or errored.
+	OnExpire func([]ScanSummary, error)
+}
+
+// Run checks every Interval until ctx is done.
+func (w *DeadlineWatcher) Run(ctx context.Context) {
+	if w == nil || w.Repo == nil || w.Interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(w.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			expired, err := w.Repo.ExpireCampaigns(ctx, w.Repo.now())
+			if w.OnExpire != nil && (len(expired) > 0 || err != nil) {
+				w.OnExpire(expired, err)
+			}
+		}
+	}
+}
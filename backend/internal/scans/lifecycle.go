@@ -0,0 +1,308 @@
+package scans
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/mcmx/duplynx/ent"
+	entscan "github.com/mcmx/duplynx/ent/scan"
+	entscantarget "github.com/mcmx/duplynx/ent/scantarget"
+	enttenant "github.com/mcmx/duplynx/ent/tenant"
+	"github.com/mcmx/duplynx/internal/tenancy/isolation"
+)
+
+// Status is a scan's lifecycle state. Agents move a scan forward through
+// pending, running, ingesting and grouping to completed; failed and
+// cancelled can be reached from any state that is not yet final. Partial
+// ends a multi-machine scan whose deadline passed with some targets missing.
+type Status string
+
+const (
+	StatusPending   Status = "pending"
+	StatusRunning   Status = "running"
+	StatusIngesting Status = "ingesting"
+	StatusGrouping  Status = "grouping"
+	StatusCompleted Status = "completed"
+	StatusPartial   Status = "partial"
+	StatusFailed    Status = "failed"
+	StatusCancelled Status = "cancelled"
+)
+
+var (
+	ErrInvalidStatus     = errors.New("unknown scan status")
+	ErrInvalidTransition = errors.New("scan cannot move back to an earlier status")
+	ErrInvalidProgress   = errors.New("progress counters must not be negative")
+	// ErrScanFinished is returned for heartbeats and cancellations of a scan
+	// that already completed, partially completed, failed or was cancelled.
+	ErrScanFinished = errors.New("scan already finished")
+)
+
+// phases orders the states a scan moves through; final states share the last rank.
+var phases = map[Status]int{
+	StatusPending:   0,
+	StatusRunning:   1,
+	StatusIngesting: 2,
+	StatusGrouping:  3,
+	StatusCompleted: 4,
+	StatusPartial:   4,
+	StatusFailed:    4,
+	StatusCancelled: 4,
+}
+
+// ParseStatus validates a status sent by an agent.
+func ParseStatus(raw string) (Status, error) {
+	status := Status(raw)
+	if _, ok := phases[status]; !ok {
+		return "", fmt.Errorf("%w %q", ErrInvalidStatus, raw)
+	}
+	return status, nil
+}
+
+// Final reports whether the scan accepts no further heartbeats.
+func (s Status) Final() bool {
+	return s == StatusCompleted || s == StatusPartial || s == StatusFailed || s == StatusCancelled
+}
+
+// finalStatuses lists the final states for queries that skip finished scans.
+var finalStatuses = []entscan.Status{entscan.StatusCompleted, entscan.StatusPartial, entscan.StatusFailed, entscan.StatusCancelled}
+
+// Active reports whether an agent is working on the scan and should be heartbeating.
+func (s Status) Active() bool {
+	return s == StatusRunning || s == StatusIngesting || s == StatusGrouping
+}
+
+// Heartbeat is an agent's progress report. Counters are running totals, so a
+// repeated or reordered heartbeat never lowers them. An empty Status keeps
+// the current one, moving a pending scan to running.
+type Heartbeat struct {
+	Status           Status
+	FilesSeen        int
+	BytesHashed      int64
+	MachinesReported int
+	// Message explains a failure; it is ignored for other statuses.
+	Message string
+	// MachineID, when set, limits the heartbeat to scans the machine takes
+	// part in, as a target or as the machine that initiated it.
+	MachineID uuid.UUID
+}
+
+func (r *Repository) now() time.Time {
+	if r.Now != nil {
+		return r.Now()
+	}
+	return time.Now()
+}
+
+// RecordHeartbeat applies an agent's heartbeat to one of tenantSlug's scans.
+// It returns ErrScanFinished, along with the scan, once the scan is final so
+// the agent knows to stop.
+func (r *Repository) RecordHeartbeat(ctx context.Context, tenantSlug string, scanID uuid.UUID, hb Heartbeat) (ScanSummary, error) {
+	if r.client == nil {
+		return ScanSummary{}, errors.New("scan lifecycle requires a database")
+	}
+	record, err := r.client.Scan.Query().
+		Where(entscan.IDEQ(scanID), entscan.HasTenantWith(enttenant.SlugEQ(tenantSlug))).
+		Only(isolation.WithSystem(ctx))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ScanSummary{}, ErrScanNotFound
+		}
+		return ScanSummary{}, fmt.Errorf("load scan: %w", err)
+	}
+	ctx = isolation.WithTenant(ctx, record.TenantID)
+	if hb.MachineID != uuid.Nil && hb.MachineID != record.InitiatedMachineID {
+		target, err := r.client.ScanTarget.Query().
+			Where(entscantarget.ScanID(scanID), entscantarget.MachineID(hb.MachineID)).
+			Exist(ctx)
+		if err != nil {
+			return ScanSummary{}, fmt.Errorf("load scan target: %w", err)
+		}
+		if !target {
+			return ScanSummary{}, ErrTargetNotFound
+		}
+	}
+
+	current := Status(record.Status)
+	if current.Final() {
+		summary, err := r.getFromClient(ctx, scanID.String())
+		if err != nil {
+			return ScanSummary{}, err
+		}
+		return summary, ErrScanFinished
+	}
+	next := hb.Status
+	switch {
+	case next == "" && current == StatusPending:
+		next = StatusRunning
+	case next == "":
+		next = current
+	case phases[next] < phases[current]:
+		return ScanSummary{}, fmt.Errorf("%w: %s to %s", ErrInvalidTransition, current, next)
+	case next == StatusCompleted || next == StatusPartial:
+		targets, err := r.client.ScanTarget.Query().Where(entscantarget.ScanID(scanID)).Exist(ctx)
+		if err != nil {
+			return ScanSummary{}, fmt.Errorf("load scan targets: %w", err)
+		}
+		if targets || next == StatusPartial {
+			// Multi-machine scans finish once every target reported or the deadline passed.
+			return ScanSummary{}, fmt.Errorf("%w: %s is decided by the scan's targets", ErrInvalidTransition, next)
+		}
+	}
+	if hb.FilesSeen < 0 || hb.BytesHashed < 0 || hb.MachinesReported < 0 {
+		return ScanSummary{}, ErrInvalidProgress
+	}
+
+	now := r.now()
+	update := r.client.Scan.UpdateOneID(scanID).
+		// A cancellation that lands first wins; the agent learns about it on its next heartbeat.
+		Where(entscan.StatusEQ(entscan.Status(current))).
+		SetStatus(entscan.Status(next)).
+		SetFilesSeen(max(record.FilesSeen, hb.FilesSeen)).
+		SetBytesHashed(max(record.BytesHashed, hb.BytesHashed)).
+		SetMachinesReported(max(record.MachinesReported, hb.MachinesReported)).
+		SetLastHeartbeatAt(now)
+	if next.Final() {
+		update.SetCompletedAt(now)
+		if next == StatusFailed && hb.Message != "" {
+			update.SetStatusMessage(hb.Message)
+		}
+	}
+	if err := update.Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return r.RecordHeartbeat(ctx, tenantSlug, scanID, hb)
+		}
+		return ScanSummary{}, fmt.Errorf("record heartbeat: %w", err)
+	}
+	if next == StatusCompleted {
+		if err := r.linkCompleted(ctx, scanID); err != nil {
+			return ScanSummary{}, err
+		}
+	}
+	return r.getFromClient(ctx, scanID.String())
+}
+
+// linkCompleted carries earlier decisions into a scan that finished with
+// usable results. Failed and cancelled scans stay unlinked, so their partial
+// file lists cannot make copies look deleted. `duplynx identity link` links
+// whatever a failure here left behind.
+func (r *Repository) linkCompleted(ctx context.Context, scanID uuid.UUID) error {
+	if r.identities == nil {
+		return nil
+	}
+	if _, err := r.identities.LinkScan(ctx, scanID); err != nil {
+		return fmt.Errorf("link content identities: %w", err)
+	}
+	return nil
+}
+
+// Cancel stops a scan visible in the caller's tenant scope. Its agent sees
+// the cancellation in the response to its next heartbeat.
+func (r *Repository) Cancel(ctx context.Context, scanID, reason string) (ScanSummary, error) {
+	if r.client == nil {
+		return ScanSummary{}, errors.New("scan lifecycle requires a database")
+	}
+	id, err := uuid.Parse(scanID)
+	if err != nil {
+		return ScanSummary{}, ErrScanNotFound
+	}
+	now := r.now()
+	update := r.client.Scan.Update().
+		Where(entscan.IDEQ(id), entscan.StatusNotIn(finalStatuses...)).
+		SetStatus(entscan.StatusCancelled).
+		SetCompletedAt(now)
+	if reason != "" {
+		update.SetStatusMessage(reason)
+	}
+	updated, err := update.Save(ctx)
+	if err != nil {
+		return ScanSummary{}, fmt.Errorf("cancel scan: %w", err)
+	}
+	summary, err := r.getFromClient(ctx, scanID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ScanSummary{}, ErrScanNotFound
+		}
+		return ScanSummary{}, err
+	}
+	if updated == 0 {
+		return summary, ErrScanFinished
+	}
+	return summary, nil
+}
+
+// FailStale marks active scans whose agent has not sent a heartbeat since
+// cutoff as failed, across all tenants, and returns them. Scans with a
+// deadline are left to ExpireCampaigns.
+func (r *Repository) FailStale(ctx context.Context, cutoff time.Time) ([]ScanSummary, error) {
+	if r.client == nil {
+		return nil, errors.New("scan lifecycle requires a database")
+	}
+	ctx = isolation.WithSystem(ctx)
+	ids, err := r.client.Scan.Query().
+		Where(
+			entscan.StatusIn(entscan.StatusRunning, entscan.StatusIngesting, entscan.StatusGrouping),
+			entscan.DeadlineIsNil(),
+			entscan.Or(
+				entscan.LastHeartbeatAtLT(cutoff),
+				entscan.And(entscan.LastHeartbeatAtIsNil(), entscan.StartedAtLT(cutoff)),
+			),
+		).
+		IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list stale scans: %w", err)
+	}
+	var out []ScanSummary
+	for _, id := range ids {
+		n, err := r.client.Scan.Update().
+			Where(entscan.IDEQ(id), entscan.StatusIn(entscan.StatusRunning, entscan.StatusIngesting, entscan.StatusGrouping)).
+			SetStatus(entscan.StatusFailed).
+			SetStatusMessage("no heartbeat since " + cutoff.UTC().Format(time.RFC3339)).
+			SetCompletedAt(r.now()).
+			Save(ctx)
+		if err != nil {
+			return out, fmt.Errorf("fail stale scan: %w", err)
+		}
+		if n == 0 {
+			continue
+		}
+		summary, err := r.getFromClient(ctx, id.String())
+		if err != nil {
+			return out, err
+		}
+		out = append(out, summary)
+	}
+	return out, nil
+}
+
+// StaleWatcher fails scans whose agent stopped sending heartbeats.
+type StaleWatcher struct {
+	Repo *Repository
+	// Timeout is how long an active scan may go without a heartbeat.
+	Timeout time.Duration
+	// OnFail, when set, observes every check that failed scans or errored.
+	OnFail func([]ScanSummary, error)
+}
+
+// Run checks every Timeout/2 until ctx is done.
+func (w *StaleWatcher) Run(ctx context.Context) {
+	if w == nil || w.Repo == nil || w.Timeout <= 0 {
+		return
+	}
+	ticker := time.NewTicker(w.Timeout / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			failed, err := w.Repo.FailStale(ctx, w.Repo.now().Add(-w.Timeout))
+			if w.OnFail != nil && (len(failed) > 0 || err != nil) {
+				w.OnFail(failed, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,263 @@
+package scans
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/mcmx/duplynx/ent"
+	entactionjob "github.com/mcmx/duplynx/ent/actionjob"
+	entduplicategroup "github.com/mcmx/duplynx/ent/duplicategroup"
+	entfileinstance "github.com/mcmx/duplynx/ent/fileinstance"
+	entscan "github.com/mcmx/duplynx/ent/scan"
+	entscantarget "github.com/mcmx/duplynx/ent/scantarget"
+	enttenant "github.com/mcmx/duplynx/ent/tenant"
+	"github.com/mcmx/duplynx/internal/tenancy/isolation"
+)
+
+var (
+	// ErrInvalidManifest is returned for manifests with unusable groups.
+	ErrInvalidManifest = errors.New("invalid scan manifest")
+	// ErrTargetSettled is returned for manifests sent after the machine reported its part of the scan.
+	ErrTargetSettled = errors.New("machine already reported its part of this scan")
+)
+
+// manifestBatch bounds how many rows one insert or IN list carries, keeping
+// large manifests under the database's bound parameter limit.
+const manifestBatch = 500
+
+// Manifest lists the duplicate groups one machine found for its part of a scan.
+type Manifest struct {
+	Groups []ManifestGroup
+}
+
+// ManifestGroup is a set of files on the machine that share a content hash.
+type ManifestGroup struct {
+	Hash      string
+	SizeBytes int64
+	Paths     []string
+}
+
+// RecordManifest stores a machine's manifest as the scan's duplicate groups
+// and file instances. Groups with the same hash on several machines are
+// merged, and a repeated upload replaces the machine's earlier files. Only
+// targets that have not reported yet, or the initiating machine of a scan
+// without targets, may upload, so the groups are in place before the scan
+// completes and its groups are linked to their content identities.
+func (r *Repository) RecordManifest(ctx context.Context, tenantSlug string, scanID, machineID uuid.UUID, manifest Manifest) (ScanSummary, error) {
+	if r.client == nil {
+		return ScanSummary{}, errors.New("scan manifests require a database")
+	}
+	groups, err := normalizeManifest(manifest)
+	if err != nil {
+		return ScanSummary{}, err
+	}
+	record, err := r.client.Scan.Query().
+		Where(entscan.IDEQ(scanID), entscan.HasTenantWith(enttenant.SlugEQ(tenantSlug))).
+		Only(isolation.WithSystem(ctx))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ScanSummary{}, ErrScanNotFound
+		}
+		return ScanSummary{}, fmt.Errorf("load scan: %w", err)
+	}
+	ctx = isolation.WithTenant(ctx, record.TenantID)
+	if Status(record.Status).Final() {
+		summary, err := r.getFromClient(ctx, scanID.String())
+		if err != nil {
+			return ScanSummary{}, err
+		}
+		return summary, ErrScanFinished
+	}
+	if err := r.checkUploader(ctx, record, machineID); err != nil {
+		return ScanSummary{}, err
+	}
+
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return ScanSummary{}, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	// Groups the machine's earlier upload contributed to are recounted below.
+	touched, err := tx.DuplicateGroup.Query().
+		Where(entduplicategroup.ScanID(scanID), entduplicategroup.HasFileInstancesWith(entfileinstance.MachineID(machineID))).
+		IDs(ctx)
+	if err != nil {
+		return ScanSummary{}, fmt.Errorf("list previous groups: %w", err)
+	}
+	if _, err := tx.FileInstance.Delete().
+		Where(entfileinstance.MachineID(machineID), entfileinstance.HasDuplicateGroupWith(entduplicategroup.ScanID(scanID))).
+		Exec(ctx); err != nil {
+		return ScanSummary{}, fmt.Errorf("replace previous files: %w", err)
+	}
+	existing, err := tx.DuplicateGroup.Query().Where(entduplicategroup.ScanID(scanID)).All(ctx)
+	if err != nil {
+		return ScanSummary{}, fmt.Errorf("list scan groups: %w", err)
+	}
+	byHash := make(map[string]uuid.UUID, len(existing))
+	for _, group := range existing {
+		byHash[group.Hash] = group.ID
+	}
+
+	var (
+		newGroups []*ent.DuplicateGroupCreate
+		files     []*ent.FileInstanceCreate
+	)
+	for _, group := range groups {
+		groupID, ok := byHash[group.Hash]
+		if ok {
+			touched = append(touched, groupID)
+		} else {
+			groupID = uuid.New()
+			byHash[group.Hash] = groupID
+			newGroups = append(newGroups, tx.DuplicateGroup.Create().
+				SetID(groupID).
+				SetTenantID(record.TenantID).
+				SetScanID(scanID).
+				SetHash(group.Hash).
+				SetFileCount(len(group.Paths)).
+				SetTotalSizeBytes(group.SizeBytes*int64(len(group.Paths))))
+		}
+		for _, path := range group.Paths {
+			files = append(files, tx.FileInstance.Create().
+				SetTenantID(record.TenantID).
+				SetDuplicateGroupID(groupID).
+				SetMachineID(machineID).
+				SetPath(path).
+				SetSizeBytes(group.SizeBytes).
+				SetChecksum(group.Hash))
+		}
+	}
+	for start := 0; start < len(newGroups); start += manifestBatch {
+		if err := tx.DuplicateGroup.CreateBulk(newGroups[start:min(start+manifestBatch, len(newGroups))]...).Exec(ctx); err != nil {
+			return ScanSummary{}, fmt.Errorf("create duplicate groups: %w", err)
+		}
+	}
+	for start := 0; start < len(files); start += manifestBatch {
+		if err := tx.FileInstance.CreateBulk(files[start:min(start+manifestBatch, len(files))]...).Exec(ctx); err != nil {
+			return ScanSummary{}, fmt.Errorf("create file instances: %w", err)
+		}
+	}
+	if err := recountGroups(ctx, tx, touched); err != nil {
+		return ScanSummary{}, err
+	}
+
+	count, err := tx.DuplicateGroup.Query().Where(entduplicategroup.ScanID(scanID)).Count(ctx)
+	if err != nil {
+		return ScanSummary{}, fmt.Errorf("count duplicate groups: %w", err)
+	}
+	if err := tx.Scan.UpdateOneID(scanID).SetDuplicateGroupCount(count).Exec(ctx); err != nil {
+		return ScanSummary{}, fmt.Errorf("update scan: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return ScanSummary{}, fmt.Errorf("commit transaction: %w", err)
+	}
+	return r.getFromClient(ctx, scanID.String())
+}
+
+// checkUploader returns ErrTargetNotFound unless the machine may upload a
+// manifest for the scan, and ErrTargetSettled once it already reported.
+func (r *Repository) checkUploader(ctx context.Context, record *ent.Scan, machineID uuid.UUID) error {
+	targets, err := r.client.ScanTarget.Query().Where(entscantarget.ScanID(record.ID)).All(ctx)
+	if err != nil {
+		return fmt.Errorf("load scan targets: %w", err)
+	}
+	if len(targets) == 0 {
+		if machineID != record.InitiatedMachineID {
+			return ErrTargetNotFound
+		}
+		return nil
+	}
+	for _, target := range targets {
+		if target.MachineID != machineID {
+			continue
+		}
+		if target.Status != entscantarget.StatusPending && target.Status != entscantarget.StatusClaimed {
+			return ErrTargetSettled
+		}
+		return nil
+	}
+	return ErrTargetNotFound
+}
+
+// recountGroups refreshes the file count and size of groups whose files
+// changed, removing groups no machine reports any more.
+func recountGroups(ctx context.Context, tx *ent.Tx, ids []uuid.UUID) error {
+	for start := 0; start < len(ids); start += manifestBatch {
+		batch := ids[start:min(start+manifestBatch, len(ids))]
+		var totals []struct {
+			GroupID uuid.UUID `json:"duplicate_group_id"`
+			Files   int       `json:"count"`
+			Bytes   int64     `json:"sum"`
+		}
+		if err := tx.FileInstance.Query().
+			Where(entfileinstance.DuplicateGroupIDIn(batch...)).
+			GroupBy(entfileinstance.FieldDuplicateGroupID).
+			Aggregate(ent.Count(), ent.Sum(entfileinstance.FieldSizeBytes)).
+			Scan(ctx, &totals); err != nil {
+			return fmt.Errorf("count group files: %w", err)
+		}
+		empty := make(map[uuid.UUID]bool, len(batch))
+		for _, id := range batch {
+			empty[id] = true
+		}
+		for _, total := range totals {
+			delete(empty, total.GroupID)
+			if err := tx.DuplicateGroup.UpdateOneID(total.GroupID).
+				SetFileCount(total.Files).
+				SetTotalSizeBytes(total.Bytes).
+				Exec(ctx); err != nil {
+				return fmt.Errorf("update duplicate group: %w", err)
+			}
+		}
+		for id := range empty {
+			if _, err := tx.ActionJob.Delete().Where(entactionjob.DuplicateGroupID(id)).Exec(ctx); err != nil {
+				return fmt.Errorf("remove action jobs of empty group: %w", err)
+			}
+			if err := tx.DuplicateGroup.DeleteOneID(id).Exec(ctx); err != nil && !ent.IsNotFound(err) {
+				return fmt.Errorf("remove empty duplicate group: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// normalizeManifest trims the manifest's groups, dropping repeated paths, and
+// rejects groups that are not duplicates.
+func normalizeManifest(manifest Manifest) ([]ManifestGroup, error) {
+	seen := make(map[string]bool, len(manifest.Groups))
+	out := make([]ManifestGroup, 0, len(manifest.Groups))
+	for _, group := range manifest.Groups {
+		group.Hash = strings.TrimSpace(group.Hash)
+		if group.Hash == "" {
+			return nil, fmt.Errorf("%w: every group needs a hash", ErrInvalidManifest)
+		}
+		if seen[group.Hash] {
+			return nil, fmt.Errorf("%w: hash %s is listed twice", ErrInvalidManifest, group.Hash)
+		}
+		seen[group.Hash] = true
+		if group.SizeBytes < 0 {
+			return nil, fmt.Errorf("%w: group %s has a negative size", ErrInvalidManifest, group.Hash)
+		}
+		paths := make([]string, 0, len(group.Paths))
+		unique := make(map[string]bool, len(group.Paths))
+		for _, path := range group.Paths {
+			if path = strings.TrimSpace(path); path != "" && !unique[path] {
+				unique[path] = true
+				paths = append(paths, path)
+			}
+		}
+		if len(paths) < 2 {
+			return nil, fmt.Errorf("%w: group %s needs at least two paths", ErrInvalidManifest, group.Hash)
+		}
+		group.Paths = paths
+		out = append(out, group)
+	}
+	return out, nil
+}
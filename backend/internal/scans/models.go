@@ -12,6 +12,35 @@ type ScanSummary struct {
 	CompletedAt         time.Time
 	DuplicateGroupCount int
 	StatusCounts        map[string]int
+	// Status is the scan's lifecycle state; StatusCounts counts its groups by review status.
+	Status        string
+	StatusMessage string
+	// FilesSeen, BytesHashed and MachinesReported are the agent's latest progress.
+	FilesSeen        int
+	BytesHashed      int64
+	MachinesReported int
+	LastHeartbeatAt  time.Time
+	// Deadline and Targets are set for multi-machine scans; Targets is only
+	// loaded for a single scan, not for tenant listings.
+	Deadline time.Time
+	Targets  []ScanTarget
+}
+
+// ScanTarget is one machine's share of a multi-machine scan.
+type ScanTarget struct {
+	MachineID     string
+	MachineName   string
+	Roots         []string
+	Exclusions    []string
+	Status        string
+	StatusMessage string
+	ClaimedAt     time.Time
+	ReportedAt    time.Time
+}
+
+// Lifecycle returns the scan's lifecycle state.
+func (s ScanSummary) Lifecycle() Status {
+	return Status(s.Status)
 }
 
 // DuplicateGroupSummary outlines aggregate data for a duplicate group.
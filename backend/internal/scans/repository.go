@@ -3,6 +3,7 @@ package scans
 import (
 	"context"
 	"errors"
+	"sort"
 	"time"
 
 	"github.com/google/uuid"
@@ -10,6 +11,7 @@ import (
 	"github.com/mcmx/duplynx/ent"
 	entscan "github.com/mcmx/duplynx/ent/scan"
 	enttenant "github.com/mcmx/duplynx/ent/tenant"
+	"github.com/mcmx/duplynx/internal/identity"
 )
 
 var (
@@ -20,6 +22,10 @@ var (
 type Repository struct {
 	scans  map[string]ScanSummary
 	client *ent.Client
+	// identities links a scan's groups to their content identities once it completes.
+	identities *identity.Repository
+	// Now stamps heartbeats and cancellations; tests override it.
+	Now func() time.Time
 }
 
 // NewRepository constructs a repository with immutable scan data.
@@ -33,7 +39,7 @@ func NewRepository(scans []ScanSummary) *Repository {
 
 // NewRepositoryFromClient constructs a repository backed by Ent.
 func NewRepositoryFromClient(client *ent.Client) *Repository {
-	return &Repository{client: client}
+	return &Repository{client: client, identities: identity.NewRepositoryFromClient(client)}
 }
 
 // ListByTenant returns scan summaries for a tenant.
@@ -103,6 +109,9 @@ func (r *Repository) getFromClient(ctx context.Context, scanID string) (ScanSumm
 		WithDuplicateGroups(func(q *ent.DuplicateGroupQuery) {
 			q.WithKeeperMachine()
 		}).
+		WithTargets(func(q *ent.ScanTargetQuery) {
+			q.WithMachine()
+		}).
 		Only(ctx)
 	if err != nil {
 		return ScanSummary{}, err
@@ -123,7 +132,31 @@ func convertScan(record *ent.Scan) ScanSummary {
 		CompletedAt:         record.CompletedAt,
 		DuplicateGroupCount: record.DuplicateGroupCount,
 		StatusCounts:        map[string]int{},
+		Status:              string(record.Status),
+		StatusMessage:       record.StatusMessage,
+		FilesSeen:           record.FilesSeen,
+		BytesHashed:         record.BytesHashed,
+		MachinesReported:    record.MachinesReported,
+		LastHeartbeatAt:     record.LastHeartbeatAt,
+		Deadline:            record.Deadline,
+	}
+
+	for _, target := range record.Edges.Targets {
+		converted := ScanTarget{
+			MachineID:     target.MachineID.String(),
+			Roots:         target.Roots,
+			Exclusions:    target.Exclusions,
+			Status:        string(target.Status),
+			StatusMessage: target.StatusMessage,
+			ClaimedAt:     target.ClaimedAt,
+			ReportedAt:    target.ReportedAt,
+		}
+		if target.Edges.Machine != nil {
+			converted.MachineName = target.Edges.Machine.Name
+		}
+		summary.Targets = append(summary.Targets, converted)
 	}
+	sort.Slice(summary.Targets, func(i, j int) bool { return summary.Targets[i].MachineName < summary.Targets[j].MachineName })
 
 	if record.Edges.Tenant != nil {
 		summary.TenantSlug = record.Edges.Tenant.Slug
@@ -0,0 +1,377 @@
+// Package schedule starts recurring multi-machine scans from cron expressions
+// stored per tenant, recording every run that was started, skipped, missed or
+// failed to start.
+package schedule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+
+	"github.com/mcmx/duplynx/ent"
+	entmachine "github.com/mcmx/duplynx/ent/machine"
+	entschedule "github.com/mcmx/duplynx/ent/scanschedule"
+	entrun "github.com/mcmx/duplynx/ent/scanschedulerun"
+	enttenant "github.com/mcmx/duplynx/ent/tenant"
+	"github.com/mcmx/duplynx/internal/scans"
+	"github.com/mcmx/duplynx/internal/tenancy/isolation"
+)
+
+var (
+	ErrScheduleNotFound = errors.New("scan schedule not found")
+	ErrTenantNotFound   = errors.New("tenant not found")
+	ErrInvalidSchedule  = errors.New("invalid scan schedule")
+)
+
+// Run statuses.
+const (
+	RunStarted = "started"
+	RunSkipped = "skipped"
+	RunMissed  = "missed"
+	RunFailed  = "failed"
+)
+
+// recentRuns is how many runs Get returns with a schedule.
+const recentRuns = 20
+
+// Schedule is a tenant's recurring scan.
+type Schedule struct {
+	ID         string
+	TenantSlug string
+	Name       string
+	Cron       string
+	Timezone   string
+	MachineIDs []string
+	Roots      []string
+	Exclusions []string
+	// Deadline bounds each run's scan; zero leaves runs without a deadline.
+	Deadline   time.Duration
+	Enabled    bool
+	NextRunAt  time.Time
+	LastRunAt  time.Time
+	LastScanID string
+	// Runs lists the most recent runs, newest first; only Get fills it.
+	Runs []Run
+}
+
+// Run records one fire time of a schedule.
+type Run struct {
+	ScheduleID   string
+	TenantSlug   string
+	ScheduledFor time.Time
+	Status       string
+	ScanID       string
+	Reason       string
+}
+
+// Input creates or replaces a schedule.
+type Input struct {
+	Name       string
+	Cron       string
+	Timezone   string
+	MachineIDs []uuid.UUID
+	Roots      []string
+	Exclusions []string
+	Deadline   time.Duration
+	Enabled    bool
+}
+
+// Repository stores schedules and starts their scans.
+type Repository struct {
+	client *ent.Client
+	scans  *scans.Repository
+	// Now overrides the clock, primarily for tests.
+	Now func() time.Time
+	// Grace is how late a run may start before it is recorded as missed;
+	// DefaultGrace when zero.
+	Grace time.Duration
+}
+
+// DefaultGrace lets a run start up to an hour late, e.g. after a restart.
+const DefaultGrace = time.Hour
+
+// NewRepositoryFromClient constructs a repository backed by Ent.
+func NewRepositoryFromClient(client *ent.Client) *Repository {
+	if client == nil {
+		return nil
+	}
+	r := &Repository{client: client, scans: scans.NewRepositoryFromClient(client)}
+	// Started scans share the repository's clock.
+	r.scans.Now = r.now
+	return r
+}
+
+// Create stores a new schedule for the tenant.
+func (r *Repository) Create(ctx context.Context, tenantSlug string, in Input) (Schedule, error) {
+	tenantID, err := r.tenant(ctx, tenantSlug)
+	if err != nil {
+		return Schedule{}, err
+	}
+	ctx = isolation.WithTenant(ctx, tenantID)
+	cron, loc, err := r.validate(ctx, &in)
+	if err != nil {
+		return Schedule{}, err
+	}
+	create := r.client.ScanSchedule.Create().
+		SetTenantID(tenantID).
+		SetName(in.Name).
+		SetCron(cron.String()).
+		SetTimezone(loc.String()).
+		SetMachineIds(uuidStrings(in.MachineIDs)).
+		SetRoots(in.Roots).
+		SetExclusions(in.Exclusions).
+		SetDeadlineSeconds(int(in.Deadline / time.Second)).
+		SetEnabled(in.Enabled).
+		SetNextRunAt(cron.Next(r.now(), loc))
+	record, err := create.Save(ctx)
+	if err != nil {
+		return Schedule{}, fmt.Errorf("create scan schedule: %w", err)
+	}
+	return convert(record, tenantSlug), nil
+}
+
+// Update replaces a schedule's settings. The next run is recomputed from now.
+func (r *Repository) Update(ctx context.Context, tenantSlug, id string, in Input) (Schedule, error) {
+	record, ctx, err := r.load(ctx, tenantSlug, id)
+	if err != nil {
+		return Schedule{}, err
+	}
+	cron, loc, err := r.validate(ctx, &in)
+	if err != nil {
+		return Schedule{}, err
+	}
+	record, err = r.client.ScanSchedule.UpdateOne(record).
+		SetName(in.Name).
+		SetCron(cron.String()).
+		SetTimezone(loc.String()).
+		SetMachineIds(uuidStrings(in.MachineIDs)).
+		SetRoots(in.Roots).
+		SetExclusions(in.Exclusions).
+		SetDeadlineSeconds(int(in.Deadline / time.Second)).
+		SetEnabled(in.Enabled).
+		SetNextRunAt(cron.Next(r.now(), loc)).
+		Save(ctx)
+	if err != nil {
+		return Schedule{}, fmt.Errorf("update scan schedule: %w", err)
+	}
+	return convert(record, tenantSlug), nil
+}
+
+// Delete removes a schedule and its run history. Scans it started are kept.
+func (r *Repository) Delete(ctx context.Context, tenantSlug, id string) error {
+	record, ctx, err := r.load(ctx, tenantSlug, id)
+	if err != nil {
+		return err
+	}
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+	if _, err := tx.ScanScheduleRun.Delete().Where(entrun.ScheduleID(record.ID)).Exec(ctx); err != nil {
+		return fmt.Errorf("delete scan schedule runs: %w", err)
+	}
+	if err := tx.ScanSchedule.DeleteOne(record).Exec(ctx); err != nil {
+		return fmt.Errorf("delete scan schedule: %w", err)
+	}
+	return tx.Commit()
+}
+
+// List returns the tenant's schedules by name.
+func (r *Repository) List(ctx context.Context, tenantSlug string) ([]Schedule, error) {
+	tenantID, err := r.tenant(ctx, tenantSlug)
+	if err != nil {
+		return nil, err
+	}
+	records, err := r.client.ScanSchedule.Query().
+		Order(entschedule.ByName(), entschedule.ByID()).
+		All(isolation.WithTenant(ctx, tenantID))
+	if err != nil {
+		return nil, fmt.Errorf("list scan schedules: %w", err)
+	}
+	out := make([]Schedule, 0, len(records))
+	for _, record := range records {
+		out = append(out, convert(record, tenantSlug))
+	}
+	return out, nil
+}
+
+// Get returns a schedule with its most recent runs.
+func (r *Repository) Get(ctx context.Context, tenantSlug, id string) (Schedule, error) {
+	record, ctx, err := r.load(ctx, tenantSlug, id)
+	if err != nil {
+		return Schedule{}, err
+	}
+	runs, err := r.client.ScanScheduleRun.Query().
+		Where(entrun.ScheduleID(record.ID)).
+		Order(entrun.ByScheduledFor(sql.OrderDesc()), entrun.ByCreateTime(sql.OrderDesc())).
+		Limit(recentRuns).
+		All(ctx)
+	if err != nil {
+		return Schedule{}, fmt.Errorf("list scan schedule runs: %w", err)
+	}
+	schedule := convert(record, tenantSlug)
+	for _, run := range runs {
+		schedule.Runs = append(schedule.Runs, convertRun(run, tenantSlug))
+	}
+	return schedule, nil
+}
+
+// validate normalises in and checks its cron expression, timezone and machines.
+func (r *Repository) validate(ctx context.Context, in *Input) (Cron, *time.Location, error) {
+	in.Name = strings.TrimSpace(in.Name)
+	if in.Name == "" {
+		return Cron{}, nil, fmt.Errorf("%w: name is required", ErrInvalidSchedule)
+	}
+	cron, err := ParseCron(in.Cron)
+	if err != nil {
+		return Cron{}, nil, fmt.Errorf("%w: %v", ErrInvalidSchedule, err)
+	}
+	if in.Timezone == "" {
+		in.Timezone = "UTC"
+	}
+	// Local would follow whatever zone the server runs in.
+	loc, err := time.LoadLocation(in.Timezone)
+	if err != nil || in.Timezone == "Local" {
+		return Cron{}, nil, fmt.Errorf("%w: unknown timezone %q", ErrInvalidSchedule, in.Timezone)
+	}
+	if in.Deadline < 0 {
+		return Cron{}, nil, fmt.Errorf("%w: deadline must not be negative", ErrInvalidSchedule)
+	}
+	in.Roots = trimmed(in.Roots)
+	in.Exclusions = trimmed(in.Exclusions)
+	if len(in.Roots) == 0 {
+		return Cron{}, nil, fmt.Errorf("%w: at least one root is required", ErrInvalidSchedule)
+	}
+	if len(in.MachineIDs) == 0 {
+		return Cron{}, nil, fmt.Errorf("%w: at least one target machine is required", ErrInvalidSchedule)
+	}
+	seen := make(map[uuid.UUID]bool, len(in.MachineIDs))
+	for _, id := range in.MachineIDs {
+		if seen[id] {
+			return Cron{}, nil, fmt.Errorf("%w: machine %s is listed twice", ErrInvalidSchedule, id)
+		}
+		seen[id] = true
+	}
+	active, err := r.client.Machine.Query().
+		Where(entmachine.IDIn(in.MachineIDs...), entmachine.ArchivedAtIsNil()).
+		Count(ctx)
+	if err != nil {
+		return Cron{}, nil, fmt.Errorf("load target machines: %w", err)
+	}
+	if active != len(in.MachineIDs) {
+		return Cron{}, nil, fmt.Errorf("%w: every target must be an active machine of the tenant", ErrInvalidSchedule)
+	}
+	return cron, loc, nil
+}
+
+// load resolves one of the tenant's schedules, returning a context scoped to the tenant.
+func (r *Repository) load(ctx context.Context, tenantSlug, id string) (*ent.ScanSchedule, context.Context, error) {
+	tenantID, err := r.tenant(ctx, tenantSlug)
+	if err != nil {
+		return nil, ctx, err
+	}
+	ctx = isolation.WithTenant(ctx, tenantID)
+	scheduleID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, ctx, ErrScheduleNotFound
+	}
+	record, err := r.client.ScanSchedule.Get(ctx, scheduleID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ctx, ErrScheduleNotFound
+		}
+		return nil, ctx, fmt.Errorf("load scan schedule: %w", err)
+	}
+	return record, ctx, nil
+}
+
+func (r *Repository) tenant(ctx context.Context, tenantSlug string) (uuid.UUID, error) {
+	if r == nil || r.client == nil {
+		return uuid.Nil, errors.New("scan schedules require a database")
+	}
+	record, err := r.client.Tenant.Query().
+		Where(enttenant.SlugEQ(tenantSlug)).
+		Only(isolation.WithSystem(ctx))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return uuid.Nil, fmt.Errorf("%w: %s", ErrTenantNotFound, tenantSlug)
+		}
+		return uuid.Nil, fmt.Errorf("load tenant: %w", err)
+	}
+	return record.ID, nil
+}
+
+func (r *Repository) now() time.Time {
+	if r.Now != nil {
+		return r.Now()
+	}
+	return time.Now()
+}
+
+func (r *Repository) grace() time.Duration {
+	if r.Grace > 0 {
+		return r.Grace
+	}
+	return DefaultGrace
+}
+
+func convert(record *ent.ScanSchedule, tenantSlug string) Schedule {
+	schedule := Schedule{
+		ID:         record.ID.String(),
+		TenantSlug: tenantSlug,
+		Name:       record.Name,
+		Cron:       record.Cron,
+		Timezone:   record.Timezone,
+		MachineIDs: record.MachineIds,
+		Roots:      record.Roots,
+		Exclusions: record.Exclusions,
+		Deadline:   time.Duration(record.DeadlineSeconds) * time.Second,
+		Enabled:    record.Enabled,
+		NextRunAt:  record.NextRunAt,
+		LastRunAt:  record.LastRunAt,
+	}
+	if record.LastScanID != uuid.Nil {
+		schedule.LastScanID = record.LastScanID.String()
+	}
+	return schedule
+}
+
+func convertRun(record *ent.ScanScheduleRun, tenantSlug string) Run {
+	run := Run{
+		ScheduleID:   record.ScheduleID.String(),
+		TenantSlug:   tenantSlug,
+		ScheduledFor: record.ScheduledFor,
+		Status:       string(record.Status),
+		Reason:       record.Reason,
+	}
+	if record.ScanID != uuid.Nil {
+		run.ScanID = record.ScanID.String()
+	}
+	return run
+}
+
+func trimmed(values []string) []string {
+	var out []string
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			out = append(out, value)
+		}
+	}
+	return out
+}
+
+func uuidStrings(ids []uuid.UUID) []string {
+	out := make([]string, 0, len(ids))
+	for _, id := range ids {
+		out = append(out, id.String())
+	}
+	return out
+}
@@ -0,0 +1,211 @@
+package schedule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/mcmx/duplynx/ent"
+	entschedule "github.com/mcmx/duplynx/ent/scanschedule"
+	entrun "github.com/mcmx/duplynx/ent/scanschedulerun"
+	"github.com/mcmx/duplynx/internal/scans"
+	"github.com/mcmx/duplynx/internal/tenancy/isolation"
+)
+
+// DefaultInterval is how often serve looks for due schedules unless configured otherwise.
+const DefaultInterval = time.Minute
+
+// maxMissed bounds how many missed fire times one check records per schedule,
+// so a schedule that fires every minute does not flood its history after a long outage.
+const maxMissed = 100
+
+// RunDue starts a scan for every enabled schedule whose next run is due,
+// across all tenants, and returns the runs it recorded. A fire time more than
+// the grace period in the past is recorded as missed, as is every earlier
+// fire time that passed while no scheduler was running. A run is skipped
+// while the schedule's previous scan is still going. A schedule that fails is
+// reported in the joined error without holding up the others.
+func (r *Repository) RunDue(ctx context.Context) ([]Run, error) {
+	if r == nil || r.client == nil {
+		return nil, errors.New("scan schedules require a database")
+	}
+	now := r.now()
+	records, err := r.client.ScanSchedule.Query().
+		Where(entschedule.Enabled(true), entschedule.NextRunAtLTE(now)).
+		WithTenant().
+		All(isolation.WithSystem(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("list due scan schedules: %w", err)
+	}
+	var (
+		out  []Run
+		errs []error
+	)
+	for _, record := range records {
+		runs, err := r.runSchedule(isolation.WithTenant(ctx, record.TenantID), record, now)
+		out = append(out, runs...)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return out, errors.Join(errs...)
+}
+
+func (r *Repository) runSchedule(ctx context.Context, record *ent.ScanSchedule, now time.Time) ([]Run, error) {
+	tenantSlug := record.Edges.Tenant.Slug
+	cron, err := ParseCron(record.Cron)
+	if err != nil {
+		return nil, fmt.Errorf("schedule %s: %w", record.ID, err)
+	}
+	loc, err := time.LoadLocation(record.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("schedule %s: load timezone: %w", record.ID, err)
+	}
+
+	// Every fire time up to now; only the latest may still start a scan.
+	due := []time.Time{record.NextRunAt}
+	for {
+		next := cron.Next(due[len(due)-1], loc)
+		if next.IsZero() || next.After(now) {
+			break
+		}
+		due = append(due, next)
+		if len(due) > maxMissed+1 {
+			due = due[1:]
+		}
+	}
+	latest := due[len(due)-1]
+	missed := due[:len(due)-1]
+
+	// Claim the fire time before starting anything: only the check that moves
+	// next_run_at on from the value it read goes on, so several serve processes
+	// never start the same run twice, and a failure below cannot refire it.
+	claimed, err := r.client.ScanSchedule.Update().
+		Where(entschedule.ID(record.ID), entschedule.NextRunAtEQ(record.NextRunAt)).
+		SetNextRunAt(cron.Next(now, loc)).
+		SetLastRunAt(latest).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("schedule %s: claim run: %w", record.ID, err)
+	}
+	if claimed != 1 {
+		return nil, nil
+	}
+
+	var out []Run
+	for _, at := range missed {
+		out = append(out, Run{ScheduledFor: at, Status: RunMissed, Reason: "no scheduler was running"})
+	}
+	run := Run{ScheduledFor: latest}
+	switch {
+	case now.Sub(latest) > r.grace():
+		run.Status, run.Reason = RunMissed, "no scheduler was running"
+	default:
+		run = r.start(ctx, record, latest)
+	}
+	out = append(out, run)
+
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return out, fmt.Errorf("schedule %s: begin transaction: %w", record.ID, err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+	builders := make([]*ent.ScanScheduleRunCreate, 0, len(out))
+	for i := range out {
+		out[i].ScheduleID = record.ID.String()
+		out[i].TenantSlug = tenantSlug
+		create := tx.ScanScheduleRun.Create().
+			SetTenantID(record.TenantID).
+			SetScheduleID(record.ID).
+			SetScheduledFor(out[i].ScheduledFor).
+			SetStatus(entrun.Status(out[i].Status))
+		if out[i].ScanID != "" {
+			create.SetScanID(uuid.MustParse(out[i].ScanID))
+		}
+		if out[i].Reason != "" {
+			create.SetReason(out[i].Reason)
+		}
+		builders = append(builders, create)
+	}
+	if err := tx.ScanScheduleRun.CreateBulk(builders...).Exec(ctx); err != nil {
+		return out, fmt.Errorf("schedule %s: record runs: %w", record.ID, err)
+	}
+	if run.ScanID != "" {
+		if err := tx.ScanSchedule.UpdateOneID(record.ID).SetLastScanID(uuid.MustParse(run.ScanID)).Exec(ctx); err != nil {
+			return out, fmt.Errorf("schedule %s: remember scan: %w", record.ID, err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return out, fmt.Errorf("schedule %s: commit transaction: %w", record.ID, err)
+	}
+	return out, nil
+}
+
+// start creates the run's scan campaign unless the previous one is still going.
+func (r *Repository) start(ctx context.Context, record *ent.ScanSchedule, at time.Time) Run {
+	run := Run{ScheduledFor: at}
+	if record.LastScanID != uuid.Nil {
+		previous, err := r.scans.Get(ctx, record.LastScanID.String())
+		if err == nil && !previous.Lifecycle().Final() {
+			run.Status, run.Reason = RunSkipped, "previous scan "+previous.ID+" is still "+previous.Status
+			return run
+		}
+	}
+
+	loc, _ := time.LoadLocation(record.Timezone)
+	in := scans.CampaignInput{
+		Name:        record.Name + " " + at.In(loc).Format("2006-01-02 15:04"),
+		Description: "Started by schedule " + record.Name,
+	}
+	if record.DeadlineSeconds > 0 {
+		in.Deadline = at.Add(time.Duration(record.DeadlineSeconds) * time.Second)
+	}
+	for _, raw := range record.MachineIds {
+		machineID, err := uuid.Parse(raw)
+		if err != nil {
+			run.Status, run.Reason = RunFailed, fmt.Sprintf("bad machine ID %q", raw)
+			return run
+		}
+		in.Targets = append(in.Targets, scans.TargetInput{MachineID: machineID, Roots: record.Roots, Exclusions: record.Exclusions})
+	}
+	summary, err := r.scans.CreateCampaign(ctx, in)
+	if err != nil {
+		run.Status, run.Reason = RunFailed, err.Error()
+		return run
+	}
+	run.Status, run.ScanID = RunStarted, summary.ID
+	return run
+}
+
+// Scheduler starts due scheduled scans every Interval.
+type Scheduler struct {
+	Repo     *Repository
+	Interval time.Duration
+	// OnRun, when set, observes every check that recorded runs or errored.
+	OnRun func([]Run, error)
+}
+
+// Run checks for due schedules on every tick until ctx is done.
+func (s *Scheduler) Run(ctx context.Context) {
+	if s == nil || s.Repo == nil || s.Interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(s.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			runs, err := s.Repo.RunDue(ctx)
+			if s.OnRun != nil && (len(runs) > 0 || err != nil) {
+				s.OnRun(runs, err)
+			}
+		}
+	}
+}
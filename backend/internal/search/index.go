@@ -0,0 +1,143 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect"
+
+	"github.com/mcmx/duplynx/ent"
+)
+
+// indexTable is the FTS5 table mirroring file_instances. Its rowid is the
+// file instance's rowid and the trigram tokenizer lets LIKE and GLOB
+// patterns use the index for substring matches.
+const indexTable = "file_search"
+
+// trigramIndexes are the pg_trgm GIN indexes that serve the same patterns on Postgres.
+var trigramIndexes = map[string]string{
+	"file_instances_path_trgm":     "path",
+	"file_instances_checksum_trgm": "checksum",
+}
+
+// basenameSQL extracts the part of a path after its last slash; SQLite has no built-in for it.
+func basenameSQL(dialectName, column string) string {
+	if dialectName == dialect.Postgres {
+		return fmt.Sprintf("regexp_replace(%s, '^.*/', '')", column)
+	}
+	return fmt.Sprintf("replace(%[1]s, rtrim(%[1]s, replace(%[1]s, '/', '')), '')", column)
+}
+
+// EnsureIndex creates the full-text index and the triggers that keep it in
+// step with file_instances, filling it from existing rows when it is new. It
+// reports false without error when SQLite was built without FTS5 (mattn's
+// driver needs the sqlite_fts5 build tag); searches then scan file_instances.
+// On Postgres it creates pg_trgm indexes instead, when the extension is available.
+func EnsureIndex(ctx context.Context, client *ent.Client) (bool, error) {
+	if client.Dialect() == dialect.Postgres {
+		return ensureTrigramIndexes(ctx, client)
+	}
+	exists, err := indexExists(ctx, client)
+	if err != nil {
+		return false, err
+	}
+	if !exists {
+		_, err := client.ExecContext(ctx, `CREATE VIRTUAL TABLE `+indexTable+` USING fts5(path, basename, checksum, tenant_id UNINDEXED, tokenize = 'trigram')`)
+		if err != nil {
+			if strings.Contains(err.Error(), "no such module: fts5") {
+				return false, nil
+			}
+			return false, fmt.Errorf("create search index: %w", err)
+		}
+	}
+
+	insert := `INSERT INTO ` + indexTable + `(rowid, path, basename, checksum, tenant_id) VALUES (new.rowid, new.path, ` + basenameSQL(dialect.SQLite, "new.path") + `, new.checksum, new.tenant_id);`
+	remove := `DELETE FROM ` + indexTable + ` WHERE rowid = old.rowid;`
+	triggers := []string{
+		`CREATE TRIGGER IF NOT EXISTS file_search_insert AFTER INSERT ON file_instances BEGIN ` + insert + ` END`,
+		`CREATE TRIGGER IF NOT EXISTS file_search_delete AFTER DELETE ON file_instances BEGIN ` + remove + ` END`,
+		`CREATE TRIGGER IF NOT EXISTS file_search_update AFTER UPDATE OF path, checksum, tenant_id ON file_instances BEGIN ` + remove + ` ` + insert + ` END`,
+	}
+	for _, stmt := range triggers {
+		if _, err := client.ExecContext(ctx, stmt); err != nil {
+			return false, fmt.Errorf("create search trigger: %w", err)
+		}
+	}
+	if !exists {
+		if err := Rebuild(ctx, client); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// ensureTrigramIndexes creates the pg_trgm indexes. Creating the extension
+// needs privileges the application role may lack; without it searches scan
+// file_instances, as on SQLite without FTS5.
+func ensureTrigramIndexes(ctx context.Context, client *ent.Client) (bool, error) {
+	if _, err := client.ExecContext(ctx, `CREATE EXTENSION IF NOT EXISTS pg_trgm`); err != nil {
+		return false, nil
+	}
+	// The extension may live in another schema than file_instances, so qualify its operator class.
+	rows, err := client.QueryContext(ctx, `SELECT n.nspname FROM pg_extension e JOIN pg_namespace n ON n.oid = e.extnamespace WHERE e.extname = 'pg_trgm'`)
+	if err != nil {
+		return false, fmt.Errorf("inspect pg_trgm: %w", err)
+	}
+	var schema string
+	if rows.Next() {
+		err = rows.Scan(&schema)
+	}
+	rows.Close()
+	if err != nil || schema == "" {
+		return false, err
+	}
+	for name, column := range trigramIndexes {
+		stmt := fmt.Sprintf(`CREATE INDEX IF NOT EXISTS %s ON file_instances USING gin (%s %s.gin_trgm_ops)`, name, column, quoteIdent(schema))
+		if _, err := client.ExecContext(ctx, stmt); err != nil {
+			return false, fmt.Errorf("create search index: %w", err)
+		}
+	}
+	return true, nil
+}
+
+// Rebuild refills the index from file_instances. Run it after anything that
+// may renumber rowids, such as restoring a VACUUM INTO copy. Postgres keeps
+// its indexes itself, so this is a no-op there.
+func Rebuild(ctx context.Context, client *ent.Client) error {
+	if client.Dialect() == dialect.Postgres {
+		return nil
+	}
+	exists, err := indexExists(ctx, client)
+	if err != nil || !exists {
+		return err
+	}
+	if _, err := client.ExecContext(ctx, `DELETE FROM `+indexTable); err != nil {
+		return fmt.Errorf("clear search index: %w", err)
+	}
+	_, err = client.ExecContext(ctx, `INSERT INTO `+indexTable+`(rowid, path, basename, checksum, tenant_id) SELECT rowid, path, `+basenameSQL(dialect.SQLite, "path")+`, checksum, tenant_id FROM file_instances`)
+	if err != nil {
+		return fmt.Errorf("fill search index: %w", err)
+	}
+	return nil
+}
+
+// indexExists reports whether the FTS5 table, or on Postgres the path trigram index, exists.
+func indexExists(ctx context.Context, client *ent.Client) (bool, error) {
+	query := `SELECT 1 FROM sqlite_master WHERE type = 'table' AND name = ?`
+	arg := indexTable
+	if client.Dialect() == dialect.Postgres {
+		query = `SELECT 1 FROM pg_indexes WHERE schemaname = current_schema() AND indexname = $1`
+		arg = "file_instances_path_trgm"
+	}
+	rows, err := client.QueryContext(ctx, query, arg)
+	if err != nil {
+		return false, fmt.Errorf("inspect search index: %w", err)
+	}
+	defer rows.Close()
+	return rows.Next(), rows.Err()
+}
+
+func quoteIdent(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
@@ -0,0 +1,389 @@
+// Package search finds a tenant's duplicate groups and file instances by
+// checksum prefix, file name, or path across every scan and machine.
+package search
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"entgo.io/ent/dialect"
+	"github.com/google/uuid"
+
+	"github.com/mcmx/duplynx/ent"
+	entfileinstance "github.com/mcmx/duplynx/ent/fileinstance"
+	"github.com/mcmx/duplynx/internal/data/rawsql"
+	"github.com/mcmx/duplynx/internal/tenancy/isolation"
+)
+
+var (
+	ErrQueryTooShort = errors.New("search query must be at least 3 characters")
+	ErrInvalidKind   = errors.New("unsupported search kind")
+)
+
+// Kind selects how the query is matched.
+type Kind string
+
+const (
+	// KindAuto guesses from the query: glob characters mean a path glob, a
+	// hex string a checksum prefix, a slash a path substring, and anything
+	// else a file name or path substring.
+	KindAuto Kind = ""
+	// KindHash matches checksums starting with the query, with or without
+	// the algorithm prefix (e.g. "sha256:").
+	KindHash Kind = "hash"
+	// KindName matches file names starting with the query, ignoring case.
+	KindName Kind = "name"
+	// KindPath matches paths containing the query, or the glob when it has *, ? or [.
+	KindPath Kind = "path"
+)
+
+// ParseKind validates a kind from a query string.
+func ParseKind(raw string) (Kind, error) {
+	switch k := Kind(strings.ToLower(strings.TrimSpace(raw))); k {
+	case KindAuto, KindHash, KindName, KindPath:
+		return k, nil
+	case "auto":
+		return KindAuto, nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrInvalidKind, raw)
+	}
+}
+
+const (
+	DefaultLimit = 100
+	MaxLimit     = 500
+	minQueryLen  = 3
+)
+
+var checksumPattern = regexp.MustCompile(`^([a-z0-9]+:)?[0-9a-fA-F]{6,}$`)
+
+// Query is a search request.
+type Query struct {
+	Text  string
+	Kind  Kind
+	Limit int
+}
+
+// FileHit is a file instance matching the query.
+type FileHit struct {
+	ID          string
+	MachineID   string
+	MachineName string
+	Path        string
+	SizeBytes   int64
+	Checksum    string
+	// MatchedBy is "hash", "name" or "path".
+	MatchedBy string
+}
+
+// GroupHit is a duplicate group with its matching file instances.
+type GroupHit struct {
+	ID       string
+	ScanID   string
+	ScanName string
+	Hash     string
+	Status   string
+	Files    []FileHit
+}
+
+// Result lists matches grouped by duplicate group, in path order of their first match.
+type Result struct {
+	Query string
+	// Kind is the kind actually used, after resolving KindAuto.
+	Kind   Kind
+	Groups []GroupHit
+	Files  int
+	// Truncated reports that more than Limit files matched.
+	Truncated bool
+	// Indexed reports whether a trigram index served the query: FTS5 on
+	// SQLite, pg_trgm on Postgres.
+	Indexed bool
+}
+
+// Repository runs searches in the tenant scope carried by the context.
+type Repository struct {
+	client *ent.Client
+}
+
+// NewRepositoryFromClient constructs a repository using the supplied Ent client.
+func NewRepositoryFromClient(client *ent.Client) *Repository {
+	if client == nil {
+		return nil
+	}
+	return &Repository{client: client}
+}
+
+// Search finds file instances matching q among the scoped tenant's scans.
+func (r *Repository) Search(ctx context.Context, q Query) (Result, error) {
+	if r == nil || r.client == nil {
+		return Result{}, errors.New("search repository not configured")
+	}
+	tenantID, ok := isolation.TenantID(ctx)
+	if !ok {
+		return Result{}, isolation.ErrUnscoped
+	}
+	text := strings.TrimSpace(q.Text)
+	if len(text) < minQueryLen {
+		return Result{}, ErrQueryTooShort
+	}
+	kind := q.Kind
+	if kind == KindAuto {
+		kind = guessKind(text)
+	}
+	limit := q.Limit
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+	if limit > MaxLimit {
+		limit = MaxLimit
+	}
+
+	indexed, err := indexExists(ctx, r.client)
+	if err != nil {
+		return Result{}, err
+	}
+	ids, err := r.matchingIDs(ctx, tenantID, text, q.Kind, kind, indexed, limit+1)
+	if err != nil {
+		return Result{}, err
+	}
+	result := Result{Query: text, Kind: kind, Indexed: indexed}
+	if len(ids) > limit {
+		ids, result.Truncated = ids[:limit], true
+	}
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	// Loading through Ent re-applies the tenant interceptors to the raw matches.
+	files, err := r.client.FileInstance.Query().
+		Where(entfileinstance.IDIn(ids...)).
+		WithMachine().
+		WithDuplicateGroup(func(gq *ent.DuplicateGroupQuery) { gq.WithScan() }).
+		Order(entfileinstance.ByPath(), entfileinstance.ByID()).
+		All(ctx)
+	if err != nil {
+		return Result{}, fmt.Errorf("load search matches: %w", err)
+	}
+	index := make(map[uuid.UUID]int)
+	for _, file := range files {
+		group := file.Edges.DuplicateGroup
+		if group == nil {
+			continue
+		}
+		pos, ok := index[group.ID]
+		if !ok {
+			hit := GroupHit{ID: group.ID.String(), ScanID: group.ScanID.String(), Hash: group.Hash, Status: string(group.Status)}
+			if group.Edges.Scan != nil {
+				hit.ScanName = group.Edges.Scan.Name
+			}
+			pos = len(result.Groups)
+			index[group.ID] = pos
+			result.Groups = append(result.Groups, hit)
+		}
+		hit := FileHit{
+			ID:        file.ID.String(),
+			MachineID: file.MachineID.String(),
+			Path:      file.Path,
+			SizeBytes: file.SizeBytes,
+			Checksum:  file.Checksum,
+			MatchedBy: matchedBy(text, q.Kind, kind, file),
+		}
+		if file.Edges.Machine != nil {
+			hit.MachineName = file.Edges.Machine.Name
+		}
+		result.Groups[pos].Files = append(result.Groups[pos].Files, hit)
+		result.Files++
+	}
+	return result, nil
+}
+
+// matchingIDs selects matching file instance IDs in path order. requested is
+// the caller's kind; an automatic text query also matches file names.
+func (r *Repository) matchingIDs(ctx context.Context, tenantID uuid.UUID, text string, requested, kind Kind, indexed bool, limit int) ([]uuid.UUID, error) {
+	d := r.client.Dialect()
+	cols := columns{dialect: d, path: "fi.path", basename: basenameSQL(d, "fi.path"), checksum: "fi.checksum"}
+	from := "file_instances fi"
+	if indexed && d != dialect.Postgres {
+		cols = columns{dialect: d, fts: true, path: "s.path", basename: "s.basename", checksum: "s.checksum"}
+		from = indexTable + " s JOIN file_instances fi ON fi.rowid = s.rowid"
+	}
+	cond, args := condition(cols, text, requested, kind)
+	query := "SELECT fi.id FROM " + from + " WHERE fi.tenant_id = ? AND (" + cond + ") ORDER BY fi.path, fi.id LIMIT ?"
+	args = append([]any{tenantID}, append(args, limit)...)
+
+	rows, err := r.client.QueryContext(ctx, rawsql.Rebind(d, query), args...)
+	if err != nil {
+		return nil, fmt.Errorf("search file instances: %w", err)
+	}
+	defer rows.Close()
+	var ids []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("scan search match: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+type columns struct {
+	dialect string
+	// fts marks columns of the FTS5 table, whose trigram index only serves
+	// LIKE patterns without an ESCAPE clause.
+	fts                      bool
+	path, basename, checksum string
+}
+
+// like is SQLite's LIKE, which ignores ASCII case; Postgres needs ILIKE for the same matches.
+func (c columns) like() string {
+	if c.dialect == dialect.Postgres {
+		return "ILIKE"
+	}
+	return "LIKE"
+}
+
+// glob matches column against a GLOB pattern; Postgres has no GLOB, so the
+// pattern becomes an anchored regular expression.
+func (c columns) glob(column, pattern string) (string, []any) {
+	if c.dialect == dialect.Postgres {
+		return column + " ~ ?", []any{globRegexp(pattern)}
+	}
+	return column + " GLOB ?", []any{pattern}
+}
+
+// match is a condition for column starting with text, or containing it
+// when prefix is false, ignoring ASCII case. Outside FTS5, LIKE wildcards in
+// text are escaped. FTS5 gets the text as an unescaped pattern instead, so
+// the index still narrows the rows, plus an exact instr() check when a % or
+// _ in text would match more than itself.
+func (c columns) match(column, text string, prefix bool) (string, []any) {
+	pattern := text + "%"
+	if !prefix {
+		pattern = "%" + pattern
+	}
+	if !c.fts {
+		like, escape := likeLiteral(text)
+		if !prefix {
+			like = "%" + like
+		}
+		return fmt.Sprintf("%s %s ?%s", column, c.like(), escape), []any{like + "%"}
+	}
+	if !strings.ContainsAny(text, "%_") {
+		return column + " LIKE ?", []any{pattern}
+	}
+	exact := "instr(lower(%[1]s), lower(?)) > 0"
+	if prefix {
+		exact = "instr(lower(%[1]s), lower(?)) = 1"
+	}
+	return fmt.Sprintf("(%[1]s LIKE ? AND "+exact+")", column), []any{pattern, text}
+}
+
+func condition(cols columns, text string, requested, kind Kind) (string, []any) {
+	switch {
+	case kind == KindHash:
+		prefixCond, prefixArgs := cols.match(cols.checksum, text, true)
+		algoCond, algoArgs := cols.match(cols.checksum, ":"+text, false)
+		return prefixCond + " OR " + algoCond, append(prefixArgs, algoArgs...)
+	case requested == KindName:
+		return cols.match(cols.basename, text, true)
+	}
+	if isGlob(text) {
+		if strings.Contains(text, "/") {
+			pattern := text
+			if !strings.HasPrefix(pattern, "/") && !strings.HasPrefix(pattern, "*") {
+				pattern = "*" + pattern
+			}
+			return cols.glob(cols.path, pattern)
+		}
+		return cols.glob(cols.basename, text)
+	}
+	pathCond, pathArgs := cols.match(cols.path, text, false)
+	if requested == KindAuto && !strings.Contains(text, "/") {
+		nameCond, nameArgs := cols.match(cols.basename, text, true)
+		return nameCond + " OR " + pathCond, append(nameArgs, pathArgs...)
+	}
+	return pathCond, pathArgs
+}
+
+// globRegexp translates a SQLite GLOB pattern (*, ? and [...] classes) into
+// an equivalent anchored POSIX regular expression.
+func globRegexp(pattern string) string {
+	var b strings.Builder
+	b.WriteString("^")
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; c {
+		case '*':
+			b.WriteString(".*")
+		case '?':
+			b.WriteString(".")
+		case '[':
+			end := strings.IndexByte(pattern[i+1:], ']')
+			if end == 0 && i+2 < len(pattern) {
+				// A leading ] is part of the class, as in GLOB.
+				end = strings.IndexByte(pattern[i+2:], ']') + 1
+			}
+			if end <= 0 {
+				b.WriteString(`\[`)
+				continue
+			}
+			class := pattern[i+1 : i+1+end]
+			b.WriteString("[" + strings.ReplaceAll(class, `\`, `\\`) + "]")
+			i += end + 1
+		default:
+			if strings.IndexByte(`.+()|{}^$\`, c) >= 0 {
+				b.WriteByte('\\')
+			}
+			b.WriteByte(c)
+		}
+	}
+	b.WriteString("$")
+	return b.String()
+}
+
+// likeLiteral escapes LIKE wildcards in text, adding an ESCAPE clause only when needed.
+func likeLiteral(text string) (string, string) {
+	if !strings.ContainsAny(text, `%_\`) {
+		return text, ""
+	}
+	r := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+	return r.Replace(text), ` ESCAPE '\'`
+}
+
+func isGlob(text string) bool {
+	return strings.ContainsAny(text, "*?[")
+}
+
+func guessKind(text string) Kind {
+	switch {
+	case isGlob(text):
+		return KindPath
+	case checksumPattern.MatchString(text):
+		return KindHash
+	case strings.Contains(text, "/"):
+		return KindPath
+	default:
+		return KindName
+	}
+}
+
+// matchedBy reports which criterion selected the file, for display.
+func matchedBy(text string, requested, kind Kind, file *ent.FileInstance) string {
+	if kind == KindHash {
+		return string(KindHash)
+	}
+	if kind == KindName {
+		base := path.Base(file.Path)
+		if requested == KindName || strings.HasPrefix(strings.ToLower(base), strings.ToLower(text)) {
+			return string(KindName)
+		}
+	}
+	if kind == KindPath && isGlob(text) && !strings.Contains(text, "/") {
+		return string(KindName)
+	}
+	return string(KindPath)
+}
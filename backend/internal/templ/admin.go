@@ -0,0 +1,155 @@
+package templ
+
+import (
+	"fmt"
+	"html/template"
+	"strings"
+
+	"github.com/mcmx/duplynx/internal/tenancy"
+)
+
+// AdminTenantsPage renders the tenant administration list with a creation form.
+func AdminTenantsPage(tenants []tenancy.Tenant, form tenancy.TenantInput, errs tenancy.ValidationErrors) template.HTML {
+	var b strings.Builder
+	b.WriteString(`<section class="space-y-6">`)
+	b.WriteString(`<h2 class="text-lg font-semibold">Tenants</h2>`)
+
+	b.WriteString(`<table class="w-full text-sm"><thead><tr class="text-left text-slate-400">`)
+	b.WriteString(`<th class="py-2">Name</th><th>Slug</th><th>Machines</th><th>Status</th></tr></thead><tbody>`)
+	for _, tenant := range tenants {
+		b.WriteString(`<tr class="border-t border-slate-800">`)
+		b.WriteString(`<td class="py-2"><a class="text-emerald-400 hover:text-emerald-300" href="/admin/tenants/` + template.HTMLEscapeString(tenant.Slug) + `">` + template.HTMLEscapeString(tenant.Name) + `</a></td>`)
+		b.WriteString(`<td class="font-mono text-xs">` + template.HTMLEscapeString(tenant.Slug) + `</td>`)
+		b.WriteString(`<td>` + fmt.Sprint(len(tenant.Machines)) + `</td>`)
+		b.WriteString(`<td>` + archivedLabel(tenant.Archived()) + `</td>`)
+		b.WriteString(`</tr>`)
+	}
+	b.WriteString(`</tbody></table>`)
+
+	b.WriteString(`<form method="post" action="/admin/tenants" class="space-y-3 border border-slate-700 rounded-lg p-4">`)
+	b.WriteString(`<h3 class="text-sm font-semibold uppercase tracking-wide">New tenant</h3>`)
+	writeTextField(&b, "slug", "Slug", form.Slug, errs)
+	writeTextField(&b, "name", "Name", form.Name, errs)
+	writeTextField(&b, "description", "Description", form.Description, errs)
+	writeTextField(&b, "primaryContact", "Primary contact", form.PrimaryContact, errs)
+	b.WriteString(`<button type="submit" class="text-xs px-3 py-1 bg-emerald-600 rounded">Create tenant</button>`)
+	b.WriteString(`</form>`)
+
+	b.WriteString(`</section>`)
+	return template.HTML(b.String())
+}
+
+// AdminTenantForms carries rejected form posts back into the tenant page so
+// each form shows the submitted values next to its validation errors.
+type AdminTenantForms struct {
+	// Tenant, when set, replaces the stored values in the tenant settings form.
+	Tenant       *tenancy.TenantInput
+	TenantErrors tenancy.ValidationErrors
+	// EditedMachineID names the machine whose edit form shows EditedMachine.
+	EditedMachineID     string
+	EditedMachine       tenancy.MachineInput
+	EditedMachineErrors tenancy.ValidationErrors
+	NewMachine          tenancy.MachineInput
+	NewMachineErrors    tenancy.ValidationErrors
+}
+
+// AdminTenantPage renders a tenant's settings and its machines with edit and archive controls.
+func AdminTenantPage(tenant tenancy.Tenant, machines []tenancy.Machine, forms AdminTenantForms) template.HTML {
+	slug := template.HTMLEscapeString(tenant.Slug)
+	settings := tenancy.TenantInput{Name: tenant.Name, Description: tenant.Description, PrimaryContact: tenant.PrimaryContact}
+	if forms.Tenant != nil {
+		settings = *forms.Tenant
+	}
+
+	var b strings.Builder
+	b.WriteString(`<section class="space-y-6">`)
+	b.WriteString(`<a class="text-sm text-emerald-400 hover:text-emerald-300" href="/admin/tenants">All tenants</a>`)
+
+	b.WriteString(`<form method="post" action="/admin/tenants/` + slug + `" class="space-y-3 border border-slate-700 rounded-lg p-4">`)
+	b.WriteString(`<h2 class="text-lg font-semibold">` + template.HTMLEscapeString(tenant.Name) + ` ` + archivedLabel(tenant.Archived()) + `</h2>`)
+	writeTextField(&b, "name", "Name", settings.Name, forms.TenantErrors)
+	writeTextField(&b, "description", "Description", settings.Description, forms.TenantErrors)
+	writeTextField(&b, "primaryContact", "Primary contact", settings.PrimaryContact, forms.TenantErrors)
+	b.WriteString(`<button type="submit" class="text-xs px-3 py-1 bg-emerald-600 rounded">Save tenant</button>`)
+	b.WriteString(`</form>`)
+	if !tenant.Archived() {
+		b.WriteString(`<form method="post" action="/admin/tenants/` + slug + `/archive">`)
+		b.WriteString(`<button type="submit" class="text-xs px-3 py-1 bg-rose-700 rounded">Archive tenant</button>`)
+		b.WriteString(`</form>`)
+	}
+
+	b.WriteString(`<h3 class="text-sm font-semibold uppercase tracking-wide">Machines</h3>`)
+	b.WriteString(`<ul class="space-y-3">`)
+	for _, machine := range machines {
+		action := `/admin/tenants/` + slug + `/machines/` + template.HTMLEscapeString(machine.ID)
+		b.WriteString(`<li class="border border-slate-800 rounded p-3 space-y-2">`)
+		b.WriteString(`<form method="post" action="` + action + `" class="flex flex-wrap gap-2 items-end">`)
+		values := tenancy.MachineInput{Name: machine.Name, Category: machine.Category, Hostname: machine.Hostname, Role: machine.Role}
+		var errs tenancy.ValidationErrors
+		if machine.ID == forms.EditedMachineID {
+			values, errs = forms.EditedMachine, forms.EditedMachineErrors
+		}
+		writeTextField(&b, "name", "Name", values.Name, errs)
+		writeCategorySelect(&b, values.Category, errs)
+		writeTextField(&b, "hostname", "Hostname", values.Hostname, errs)
+		writeTextField(&b, "role", "Role", values.Role, errs)
+		b.WriteString(`<button type="submit" class="text-xs px-2 py-1 bg-emerald-600 rounded">Save</button>`)
+		b.WriteString(`</form>`)
+		if machine.Archived() {
+			b.WriteString(`<p class="text-xs text-slate-500">` + archivedLabel(true) + `</p>`)
+		} else {
+			b.WriteString(`<form method="post" action="` + action + `/archive">`)
+			b.WriteString(`<button type="submit" class="text-xs px-2 py-1 bg-rose-700 rounded">Archive machine</button>`)
+			b.WriteString(`</form>`)
+		}
+		b.WriteString(`</li>`)
+	}
+	b.WriteString(`</ul>`)
+
+	b.WriteString(`<form method="post" action="/admin/tenants/` + slug + `/machines" class="space-y-3 border border-slate-700 rounded-lg p-4">`)
+	b.WriteString(`<h3 class="text-sm font-semibold uppercase tracking-wide">New machine</h3>`)
+	writeTextField(&b, "name", "Name", forms.NewMachine.Name, forms.NewMachineErrors)
+	writeCategorySelect(&b, forms.NewMachine.Category, forms.NewMachineErrors)
+	writeTextField(&b, "hostname", "Hostname", forms.NewMachine.Hostname, forms.NewMachineErrors)
+	writeTextField(&b, "role", "Role", forms.NewMachine.Role, forms.NewMachineErrors)
+	b.WriteString(`<button type="submit" class="text-xs px-3 py-1 bg-emerald-600 rounded">Add machine</button>`)
+	b.WriteString(`</form>`)
+
+	b.WriteString(`</section>`)
+	return template.HTML(b.String())
+}
+
+func writeTextField(b *strings.Builder, name, label, value string, errs tenancy.ValidationErrors) {
+	b.WriteString(`<label class="flex flex-col gap-1 text-xs text-slate-400">` + template.HTMLEscapeString(label))
+	b.WriteString(`<input type="text" name="` + name + `" value="` + template.HTMLEscapeString(value) + `" class="bg-slate-900 border border-slate-600 rounded px-2 py-1 text-sm text-slate-100">`)
+	writeFieldError(b, name, errs)
+	b.WriteString(`</label>`)
+}
+
+func writeCategorySelect(b *strings.Builder, selected string, errs tenancy.ValidationErrors) {
+	b.WriteString(`<label class="flex flex-col gap-1 text-xs text-slate-400">Category`)
+	b.WriteString(`<select name="category" class="bg-slate-900 border border-slate-600 rounded px-2 py-1 text-sm text-slate-100">`)
+	for _, category := range tenancy.MachineCategories {
+		attr := ""
+		if category == selected {
+			attr = " selected"
+		}
+		b.WriteString(`<option value="` + category + `"` + attr + `>` + strings.ReplaceAll(category, "_", " ") + `</option>`)
+	}
+	b.WriteString(`</select>`)
+	writeFieldError(b, "category", errs)
+	b.WriteString(`</label>`)
+}
+
+func writeFieldError(b *strings.Builder, name string, errs tenancy.ValidationErrors) {
+	if msg, ok := errs[name]; ok {
+		b.WriteString(`<span class="text-xs text-rose-400" role="alert">` + template.HTMLEscapeString(msg) + `</span>`)
+	}
+}
+
+func archivedLabel(archived bool) string {
+	if archived {
+		return `<span class="text-xs uppercase tracking-wide text-slate-500">archived</span>`
+	}
+	return `<span class="text-xs uppercase tracking-wide text-emerald-400">active</span>`
+}
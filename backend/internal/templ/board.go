@@ -3,46 +3,233 @@ package templ
 import (
 	"fmt"
 	"html/template"
+	"net/url"
 	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/mcmx/duplynx/internal/actions"
 	"github.com/mcmx/duplynx/internal/scans"
+	"github.com/mcmx/duplynx/internal/templ/components"
+	"github.com/mcmx/duplynx/internal/tenancy"
 )
 
 var statusOrder = []string{"review", "action_needed", "resolved", "archived"}
 
+// BucketByStatus groups duplicate groups into board lanes keyed by status.
+func BucketByStatus(groups []actions.DuplicateGroup) map[string][]actions.DuplicateGroup {
+	lanes := make(map[string][]actions.DuplicateGroup, len(statusOrder))
+	for _, group := range groups {
+		lanes[group.Status] = append(lanes[group.Status], group)
+	}
+	return lanes
+}
+
 // BoardPage renders the board columns for a scan.
 func BoardPage(summary scans.ScanSummary, groups map[string][]actions.DuplicateGroup) template.HTML {
 	var b strings.Builder
+	writeBoardHeader(&b, summary)
 	b.WriteString(`<div class="grid grid-cols-1 md:grid-cols-2 xl:grid-cols-4 gap-4">`)
-
 	for _, status := range statusOrder {
-		b.WriteString(`<section class="bg-slate-800 border border-slate-700 rounded-lg">`)
-		b.WriteString(`<header class="flex items-center justify-between px-4 py-3 border-b border-slate-700">`)
-		b.WriteString(`<h2 class="text-sm font-semibold uppercase tracking-wide">` + template.HTMLEscapeString(strings.ReplaceAll(status, "_", " ")) + `</h2>`)
-		b.WriteString(`<span class="text-xs text-slate-400">` + fmt.Sprint(summary.StatusCounts[status]) + `</span>`)
-		b.WriteString(`</header>`)
-
-		b.WriteString(`<ul class="divide-y divide-slate-700">`)
 		list := groups[status]
 		sort.SliceStable(list, func(i, j int) bool { return list[i].Hash < list[j].Hash })
-		for _, group := range list {
-			var totalSize int64
-			for _, file := range group.Files {
-				totalSize += file.SizeBytes
-			}
-			fileCount := len(group.Files)
-
-			b.WriteString(`<li class="px-4 py-3">`)
-			b.WriteString(`<p class="font-mono text-xs text-slate-300">` + template.HTMLEscapeString(group.Hash) + `</p>`)
-			b.WriteString(`<p class="text-sm text-white">` + fmt.Sprintf("%d files • %d bytes", fileCount, totalSize) + `</p>`)
-			b.WriteString(`</li>`)
+		writeLane(&b, status, fmt.Sprint(summary.StatusCounts[status]), list, "")
+	}
+	b.WriteString(`</div>`)
+	return template.HTML(b.String())
+}
+
+// BoardView is one page of each lane of a scan board, along with the query
+// that produced it.
+type BoardView struct {
+	Query actions.BoardQuery
+	Lanes map[string]actions.LanePage
+	// Machines populates the machine filter.
+	Machines []tenancy.Machine
+}
+
+// PagedBoardPage renders the board with its filter form and per-lane paging links.
+func PagedBoardPage(summary scans.ScanSummary, view BoardView) template.HTML {
+	var b strings.Builder
+	writeBoardHeader(&b, summary)
+	writeBoardFilters(&b, summary.ID, view)
+	// Cards subscribe to their own "card-<id>" events through the htmx SSE extension.
+	b.WriteString(`<div hx-ext="sse" sse-connect="/scans/` + template.HTMLEscapeString(url.PathEscape(summary.ID)) + `/events">`)
+	b.WriteString(`<div sse-swap="scan-updated" aria-live="polite"></div>`)
+	b.WriteString(`<div id="scan-progress" sse-swap="scan-progress">` + string(ScanProgress(summary)) + `</div>`)
+	b.WriteString(`<div class="grid grid-cols-1 md:grid-cols-2 xl:grid-cols-4 gap-4">`)
+	for _, status := range statusOrder {
+		page := view.Lanes[status]
+		count := fmt.Sprint(page.Total)
+		if page.Total != summary.StatusCounts[status] {
+			count = fmt.Sprintf("%d of %d", page.Total, summary.StatusCounts[status])
 		}
-		b.WriteString(`</ul>`)
-		b.WriteString(`</section>`)
+		var more string
+		if page.NextCursor != "" {
+			more = boardURL(summary.ID, view.Query.WithCursor(status, page.NextCursor))
+		}
+		b.WriteString(`<div class="flex flex-col gap-2">`)
+		writeLane(&b, status, count, page.Groups, more)
+		if view.Query.Cursors[status] != "" {
+			b.WriteString(`<a class="text-xs underline text-center" href="` + template.HTMLEscapeString(boardURL(summary.ID, view.Query.WithCursor(status, ""))) + `">Back to first page</a>`)
+		}
+		b.WriteString(`</div>`)
 	}
+	b.WriteString(`</div></div>`)
+	return template.HTML(b.String())
+}
 
+// ScanUpdatedNotice tells board viewers that a new manifest arrived for the scan.
+func ScanUpdatedNotice(at time.Time) template.HTML {
+	return template.HTML(`<p class="mb-4 text-xs text-sky-300" role="status">New scan results arrived at ` +
+		template.HTMLEscapeString(formatTime(at)) + `. <a class="underline" href="">Reload the board</a></p>`)
+}
+
+// ScanProgress renders a scan's lifecycle status and its agent's latest
+// progress, with a cancel button while the scan is still running.
+func ScanProgress(summary scans.ScanSummary) template.HTML {
+	var b strings.Builder
+	status := summary.Lifecycle()
+	b.WriteString(`<div class="mb-4 flex flex-wrap items-center gap-4 text-sm" data-scan-status="` + template.HTMLEscapeString(string(status)) + `">`)
+	b.WriteString(`<span class="px-2 py-0.5 rounded bg-slate-700 text-xs uppercase tracking-wide">` + template.HTMLEscapeString(string(status)) + `</span>`)
+	b.WriteString(`<span class="text-slate-400">` + fmt.Sprintf("%d files seen · %s hashed · %d machines reported", summary.FilesSeen, FormatBytes(summary.BytesHashed), summary.MachinesReported) + `</span>`)
+	b.WriteString(`<span class="text-xs text-slate-500">Last heartbeat ` + template.HTMLEscapeString(formatTime(summary.LastHeartbeatAt)) + `</span>`)
+	if summary.StatusMessage != "" {
+		b.WriteString(`<span class="text-xs text-rose-400">` + template.HTMLEscapeString(summary.StatusMessage) + `</span>`)
+	}
+	if !summary.Deadline.IsZero() {
+		b.WriteString(`<span class="text-xs text-slate-500">Deadline ` + template.HTMLEscapeString(formatTime(summary.Deadline)) + `</span>`)
+	}
+	if status != "" && !status.Final() {
+		b.WriteString(`<form method="post" action="/scans/` + template.HTMLEscapeString(url.PathEscape(summary.ID)) + `/cancel">`)
+		b.WriteString(`<button type="submit" class="px-2 py-0.5 rounded bg-rose-800 text-xs">Cancel scan</button></form>`)
+	}
 	b.WriteString(`</div>`)
+	if len(summary.Targets) > 0 {
+		writeScanTargets(&b, summary.Targets)
+	}
 	return template.HTML(b.String())
 }
+
+// writeScanTargets lists each machine's share of a multi-machine scan.
+func writeScanTargets(b *strings.Builder, targets []scans.ScanTarget) {
+	b.WriteString(`<table class="mb-4 w-full text-sm" aria-label="Scan targets"><thead><tr class="text-left text-xs text-slate-400"><th>Machine</th><th>Roots</th><th>Status</th><th>Reported</th></tr></thead><tbody>`)
+	for _, target := range targets {
+		b.WriteString(`<tr class="border-t border-slate-800" data-target="` + template.HTMLEscapeString(target.MachineID) + `" data-target-status="` + template.HTMLEscapeString(target.Status) + `">`)
+		b.WriteString(`<td class="py-1">` + template.HTMLEscapeString(target.MachineName) + `</td>`)
+		b.WriteString(`<td class="font-mono text-xs">` + template.HTMLEscapeString(strings.Join(target.Roots, ", ")) + `</td>`)
+		b.WriteString(`<td>` + template.HTMLEscapeString(target.Status))
+		if target.StatusMessage != "" {
+			b.WriteString(` <span class="text-xs text-slate-500">` + template.HTMLEscapeString(target.StatusMessage) + `</span>`)
+		}
+		b.WriteString(`</td>`)
+		b.WriteString(`<td class="text-xs text-slate-500">` + template.HTMLEscapeString(formatTime(target.ReportedAt)) + `</td>`)
+		b.WriteString(`</tr>`)
+	}
+	b.WriteString(`</tbody></table>`)
+}
+
+func writeBoardHeader(b *strings.Builder, summary scans.ScanSummary) {
+	b.WriteString(`<header class="mb-6">`)
+	b.WriteString(`<h2 class="text-lg font-semibold">` + template.HTMLEscapeString(summary.Name) + `</h2>`)
+	b.WriteString(`<p class="text-sm text-slate-400">` + fmt.Sprintf("%d duplicate groups", summary.DuplicateGroupCount) + `</p>`)
+	b.WriteString(`</header>`)
+}
+
+// writeLane renders one status column; moreURL links to the lane's next page when set.
+func writeLane(b *strings.Builder, status, count string, list []actions.DuplicateGroup, moreURL string) {
+	b.WriteString(`<section class="bg-slate-800 border border-slate-700 rounded-lg" data-lane="` + status + `">`)
+	b.WriteString(`<header class="flex items-center justify-between px-4 py-3 border-b border-slate-700">`)
+	b.WriteString(`<h2 class="text-sm font-semibold uppercase tracking-wide">` + template.HTMLEscapeString(strings.ReplaceAll(status, "_", " ")) + `</h2>`)
+	b.WriteString(`<span class="text-xs text-slate-400">` + template.HTMLEscapeString(count) + `</span>`)
+	b.WriteString(`</header>`)
+
+	b.WriteString(`<ul class="space-y-3 p-3">`)
+	if len(list) == 0 {
+		b.WriteString(`<li class="text-xs text-slate-500">No duplicate groups</li>`)
+	}
+	for _, group := range list {
+		var totalSize int64
+		for _, file := range group.Files {
+			totalSize += file.SizeBytes
+		}
+
+		b.WriteString(`<li>`)
+		b.WriteString(`<p class="text-xs text-slate-400 mb-1">` + fmt.Sprintf("%d files • %d bytes", len(group.Files), totalSize) + `</p>`)
+		b.WriteString(string(components.DuplicateCard(group)))
+		b.WriteString(`</li>`)
+	}
+	b.WriteString(`</ul>`)
+	if moreURL != "" {
+		b.WriteString(`<a class="block px-4 py-2 text-xs underline border-t border-slate-700" rel="next" href="` + template.HTMLEscapeString(moreURL) + `">Next page</a>`)
+	}
+	b.WriteString(`</section>`)
+}
+
+// writeBoardFilters renders the filter and sort form; submitting it resets every lane to its first page.
+func writeBoardFilters(b *strings.Builder, scanID string, view BoardView) {
+	q, f := view.Query, view.Query.Filter
+	b.WriteString(`<form method="get" action="/scans/` + template.HTMLEscapeString(url.PathEscape(scanID)) + `" class="mb-6 flex flex-wrap items-end gap-3 text-xs" aria-label="Board filters">`)
+
+	minSize := ""
+	if f.MinSizeBytes > 0 {
+		minSize = strconv.FormatInt(f.MinSizeBytes, 10)
+	}
+	b.WriteString(`<label class="flex flex-col gap-1">Min size (bytes)<input type="number" min="0" name="min_size" value="` + minSize + `" class="bg-slate-900 border border-slate-600 rounded px-2 py-1"></label>`)
+
+	b.WriteString(`<label class="flex flex-col gap-1">Machine<select name="machine" class="bg-slate-900 border border-slate-600 rounded px-2 py-1">`)
+	writeOption(b, "", "Any machine", f.MachineID == "")
+	for _, machine := range view.Machines {
+		writeOption(b, machine.ID, machine.Name, f.MachineID == machine.ID)
+	}
+	b.WriteString(`</select></label>`)
+
+	b.WriteString(`<label class="flex flex-col gap-1">Path prefix<input type="text" name="path_prefix" value="` + template.HTMLEscapeString(f.PathPrefix) + `" class="bg-slate-900 border border-slate-600 rounded px-2 py-1"></label>`)
+
+	b.WriteString(`<label class="flex flex-col gap-1">Keeper<select name="keeper" class="bg-slate-900 border border-slate-600 rounded px-2 py-1">`)
+	writeOption(b, "", "Any", f.KeeperSet == nil)
+	writeOption(b, "set", "Assigned", f.KeeperSet != nil && *f.KeeperSet)
+	writeOption(b, "unset", "Unassigned", f.KeeperSet != nil && !*f.KeeperSet)
+	b.WriteString(`</select></label>`)
+
+	b.WriteString(`<label class="flex flex-col gap-1">Quarantined<select name="quarantined" class="bg-slate-900 border border-slate-600 rounded px-2 py-1">`)
+	writeOption(b, "", "Any", f.Quarantined == nil)
+	writeOption(b, "true", "With quarantined copies", f.Quarantined != nil && *f.Quarantined)
+	writeOption(b, "false", "Without quarantined copies", f.Quarantined != nil && !*f.Quarantined)
+	b.WriteString(`</select></label>`)
+
+	b.WriteString(`<label class="flex flex-col gap-1">Sort<select name="sort" class="bg-slate-900 border border-slate-600 rounded px-2 py-1">`)
+	writeOption(b, string(actions.SortReclaimable), "Bytes reclaimable", q.Sort == actions.SortReclaimable)
+	writeOption(b, string(actions.SortFileCount), "File count", q.Sort == actions.SortFileCount)
+	writeOption(b, string(actions.SortHash), "Hash", q.Sort == actions.SortHash)
+	b.WriteString(`</select></label>`)
+
+	b.WriteString(`<label class="flex flex-col gap-1">Order<select name="order" class="bg-slate-900 border border-slate-600 rounded px-2 py-1">`)
+	writeOption(b, "desc", "Descending", q.Descending)
+	writeOption(b, "asc", "Ascending", !q.Descending)
+	b.WriteString(`</select></label>`)
+
+	if q.Limit > 0 && q.Limit != actions.DefaultLaneLimit {
+		b.WriteString(`<input type="hidden" name="limit" value="` + strconv.Itoa(q.Limit) + `">`)
+	}
+	b.WriteString(`<button type="submit" class="px-3 py-1 bg-sky-700 rounded">Apply</button>`)
+	b.WriteString(`<a class="underline" href="/scans/` + template.HTMLEscapeString(url.PathEscape(scanID)) + `">Reset</a>`)
+	b.WriteString(`</form>`)
+}
+
+func writeOption(b *strings.Builder, value, label string, selected bool) {
+	attr := ""
+	if selected {
+		attr = " selected"
+	}
+	b.WriteString(`<option value="` + template.HTMLEscapeString(value) + `"` + attr + `>` + template.HTMLEscapeString(label) + `</option>`)
+}
+
+func boardURL(scanID string, q actions.BoardQuery) string {
+	u := "/scans/" + url.PathEscape(scanID)
+	if encoded := q.Values().Encode(); encoded != "" {
+		u += "?" + encoded
+	}
+	return u
+}
@@ -7,24 +7,48 @@ import (
 	"github.com/mcmx/duplynx/internal/actions"
 )
 
+// CardFeedback carries the outcome of the last card form submission.
+type CardFeedback struct {
+	// Notice confirms a successful keeper assignment or action.
+	Notice string
+	// KeeperError and ActionError are shown inline beneath the matching form.
+	KeeperError string
+	ActionError string
+}
+
 // DuplicateCard renders a duplicate group card with htmx-enabled keeper/action controls.
 func DuplicateCard(group actions.DuplicateGroup) template.HTML {
+	return DuplicateCardWithFeedback(group, CardFeedback{})
+}
+
+// DuplicateCardWithFeedback renders the card along with inline form feedback.
+// Forms swap the whole card, so the fragment returned by the handlers replaces it in place.
+func DuplicateCardWithFeedback(group actions.DuplicateGroup, feedback CardFeedback) template.HTML {
+	id := template.HTMLEscapeString(group.ID)
+	tenantHeaders := template.HTMLEscapeString(`{"X-Duplynx-Tenant": "` + template.JSEscapeString(group.TenantSlug) + `"}`)
+
 	var b strings.Builder
-	b.WriteString(`<div class="flex flex-col gap-2 border border-slate-700 rounded-lg p-3 bg-slate-800">`)
+	// On a live board the card replaces itself with the fragment streamed as its "card-<id>" event.
+	b.WriteString(`<div id="card-` + id + `" class="duplicate-card flex flex-col gap-2 border border-slate-700 rounded-lg p-3 bg-slate-800" hx-headers="` + tenantHeaders + `" sse-swap="card-` + id + `" hx-swap="outerHTML">`)
 	b.WriteString(`<div class="flex items-center justify-between">`)
 	b.WriteString(`<span class="font-mono text-xs text-slate-300">` + template.HTMLEscapeString(group.Hash) + `</span>`)
 	if group.KeeperMachineID != "" {
-		b.WriteString(`<span class="text-xs text-emerald-400">Keeper: ` + template.HTMLEscapeString(group.KeeperMachineID) + `</span>`)
+		b.WriteString(`<span class="text-xs text-emerald-400">Keeper: ` + template.HTMLEscapeString(group.KeeperLabel()) + `</span>`)
 	}
+	b.WriteString(`<a href="/duplicate-groups/` + id + `" class="text-xs underline">Details</a>`)
 	b.WriteString(`</div>`)
+	if feedback.Notice != "" {
+		b.WriteString(`<p class="text-xs text-emerald-400" role="status">` + template.HTMLEscapeString(feedback.Notice) + `</p>`)
+	}
 
-	b.WriteString(`<form hx-post="/duplicate-groups/` + template.HTMLEscapeString(group.ID) + `/keeper" hx-target="closest .duplicate-card" class="flex gap-2 items-center">`)
+	b.WriteString(`<form hx-post="/duplicate-groups/` + id + `/keeper" hx-target="closest .duplicate-card" hx-swap="outerHTML" class="flex gap-2 items-center">`)
 	b.WriteString(`<input type="hidden" name="tenantSlug" value="` + template.HTMLEscapeString(group.TenantSlug) + `">`)
-	b.WriteString(`<input type="text" name="keeperMachineId" class="bg-slate-900 border border-slate-600 rounded px-2 py-1 text-xs" placeholder="Keeper machine">`)
+	writeKeeperSelect(&b, group)
 	b.WriteString(`<button type="submit" class="text-xs px-2 py-1 bg-emerald-600 rounded">Assign keeper</button>`)
 	b.WriteString(`</form>`)
+	writeCardError(&b, feedback.KeeperError)
 
-	b.WriteString(`<form hx-post="/duplicate-groups/` + template.HTMLEscapeString(group.ID) + `/actions" hx-target="closest .duplicate-card" class="flex gap-2 items-center">`)
+	b.WriteString(`<form hx-post="/duplicate-groups/` + id + `/actions" hx-target="closest .duplicate-card" hx-swap="outerHTML" class="flex gap-2 items-center">`)
 	b.WriteString(`<input type="hidden" name="tenantSlug" value="` + template.HTMLEscapeString(group.TenantSlug) + `">`)
 	b.WriteString(`<select name="actionType" class="bg-slate-900 border border-slate-600 rounded px-2 py-1 text-xs">`)
 	for _, action := range []string{"delete_copies", "create_hardlinks", "quarantine"} {
@@ -33,6 +57,7 @@ func DuplicateCard(group actions.DuplicateGroup) template.HTML {
 	b.WriteString(`</select>`)
 	b.WriteString(`<button type="submit" class="text-xs px-2 py-1 bg-amber-600 rounded">Run action</button>`)
 	b.WriteString(`</form>`)
+	writeCardError(&b, feedback.ActionError)
 
 	b.WriteString(`<ul class="space-y-1 text-xs text-slate-400">`)
 	for _, file := range group.Files {
@@ -40,10 +65,35 @@ func DuplicateCard(group actions.DuplicateGroup) template.HTML {
 		if file.Quarantined {
 			status = " (quarantined)"
 		}
-		b.WriteString(`<li>` + template.HTMLEscapeString(file.MachineID+": "+file.Path) + status + `</li>`)
+		machine := file.MachineName
+		if machine == "" {
+			machine = file.MachineID
+		}
+		b.WriteString(`<li>` + template.HTMLEscapeString(machine+": "+file.Path) + status + `</li>`)
 	}
 	b.WriteString(`</ul>`)
 
 	b.WriteString(`</div>`)
 	return template.HTML(b.String())
 }
+
+// writeKeeperSelect offers only the machines that hold a copy of the duplicate.
+func writeKeeperSelect(b *strings.Builder, group actions.DuplicateGroup) {
+	b.WriteString(`<select name="keeperMachineId" aria-label="Keeper machine" class="bg-slate-900 border border-slate-600 rounded px-2 py-1 text-xs">`)
+	b.WriteString(`<option value="">Choose keeper…</option>`)
+	for _, machine := range group.CopyHolders() {
+		selected := ""
+		if machine.ID == group.KeeperMachineID {
+			selected = ` selected`
+		}
+		b.WriteString(`<option value="` + template.HTMLEscapeString(machine.ID) + `"` + selected + `>` + template.HTMLEscapeString(machine.Label()) + `</option>`)
+	}
+	b.WriteString(`</select>`)
+}
+
+func writeCardError(b *strings.Builder, message string) {
+	if message == "" {
+		return
+	}
+	b.WriteString(`<p class="text-xs text-rose-400" role="alert">` + template.HTMLEscapeString(message) + `</p>`)
+}
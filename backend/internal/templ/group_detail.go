@@ -0,0 +1,284 @@
+package templ
+
+import (
+	"fmt"
+	"html/template"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mcmx/duplynx/internal/actions"
+	"github.com/mcmx/duplynx/internal/identity"
+)
+
+const timestampLayout = "2006-01-02 15:04 MST"
+
+// NoteFeedback carries a rejected note submission back into the page.
+type NoteFeedback struct {
+	// NoteID is empty for the new-note form, otherwise the note being edited.
+	NoteID string
+	Draft  string
+	Error  string
+}
+
+// GroupDetailPage renders a duplicate group with its files, action timeline and
+// steward notes. history is nil when the group is not linked to a content identity.
+func GroupDetailPage(group actions.DuplicateGroup, history *identity.History, timeline []actions.TimelineEntry, notes []actions.Note, feedback NoteFeedback) template.HTML {
+	id := template.HTMLEscapeString(group.ID)
+	var b strings.Builder
+
+	b.WriteString(`<header class="mb-6 space-y-1">`)
+	b.WriteString(`<h2 class="text-lg font-semibold font-mono">` + template.HTMLEscapeString(group.Hash) + `</h2>`)
+	b.WriteString(`<p class="text-sm text-slate-400"><span data-status="` + template.HTMLEscapeString(group.Status) + `">` + template.HTMLEscapeString(strings.ReplaceAll(group.Status, "_", " ")) + `</span>`)
+	if group.KeeperMachineID != "" {
+		b.WriteString(` · <span class="text-emerald-400">Keeper: ` + template.HTMLEscapeString(group.KeeperLabel()) + `</span>`)
+	} else {
+		b.WriteString(` · No keeper assigned`)
+	}
+	b.WriteString(`</p>`)
+	b.WriteString(`<p class="text-sm"><a class="underline" href="/scans/` + template.HTMLEscapeString(url.PathEscape(group.ScanID)) + `">Back to scan board</a></p>`)
+	b.WriteString(`</header>`)
+
+	writeFileTable(&b, group)
+	if history != nil {
+		writeHistory(&b, *history)
+	}
+	writeTimeline(&b, timeline)
+	writeNotes(&b, id, notes, feedback)
+	return template.HTML(b.String())
+}
+
+func writeFileTable(b *strings.Builder, group actions.DuplicateGroup) {
+	b.WriteString(`<section class="mb-8" aria-label="Files">`)
+	b.WriteString(`<h3 class="text-sm font-semibold uppercase tracking-wide mb-2">Files</h3>`)
+	if !group.FilesPrunedAt.IsZero() {
+		b.WriteString(`<p class="text-sm text-slate-400" data-files-pruned>A newer scan superseded this one, so its file list was pruned on ` + formatTime(group.FilesPrunedAt) + `. The group keeps its file count and size.</p>`)
+		b.WriteString(`</section>`)
+		return
+	}
+	b.WriteString(`<table class="w-full text-sm"><thead class="text-left text-xs text-slate-400"><tr>`)
+	b.WriteString(`<th class="py-1">Machine</th><th>Path</th><th class="text-right">Size</th><th>Last seen</th><th>Quarantined</th>`)
+	b.WriteString(`</tr></thead><tbody>`)
+	for _, file := range group.Files {
+		machine := file.MachineName
+		if machine == "" {
+			machine = file.MachineID
+		}
+		if file.MachineID == group.KeeperMachineID {
+			machine += " (keeper)"
+		}
+		quarantined := "no"
+		if file.Quarantined {
+			quarantined = "yes"
+		}
+		b.WriteString(`<tr class="border-t border-slate-800" data-file="` + template.HTMLEscapeString(file.ID) + `">`)
+		b.WriteString(`<td class="py-1">` + template.HTMLEscapeString(machine) + `</td>`)
+		b.WriteString(`<td class="font-mono text-xs">` + template.HTMLEscapeString(file.Path) + `</td>`)
+		b.WriteString(`<td class="text-right">` + fmt.Sprintf("%d bytes", file.SizeBytes) + `</td>`)
+		b.WriteString(`<td>` + formatTime(file.LastSeenAt) + `</td>`)
+		b.WriteString(`<td>` + quarantined + `</td>`)
+		b.WriteString(`</tr>`)
+	}
+	b.WriteString(`</tbody></table>`)
+	b.WriteString(`</section>`)
+}
+
+func writeHistory(b *strings.Builder, history identity.History) {
+	b.WriteString(`<section class="mb-8" aria-label="Across scans" data-history>`)
+	b.WriteString(`<h3 class="text-sm font-semibold uppercase tracking-wide mb-2">Across scans</h3>`)
+	b.WriteString(`<p class="text-sm text-slate-400">First seen ` + formatTime(history.FirstSeenAt) + ` · last seen ` + formatTime(history.LastSeenAt))
+	if history.KeeperMachineName != "" {
+		b.WriteString(` · keeper carried forward: ` + template.HTMLEscapeString(history.KeeperMachineName))
+	}
+	if !history.ResolvedAt.IsZero() {
+		b.WriteString(` · resolved since ` + formatTime(history.ResolvedAt))
+	}
+	b.WriteString(`</p>`)
+
+	b.WriteString(`<table class="w-full text-sm mt-2"><thead class="text-left text-xs text-slate-400"><tr>`)
+	b.WriteString(`<th class="py-1">Scan</th><th>Started</th><th>Status</th><th class="text-right">Files</th>`)
+	b.WriteString(`</tr></thead><tbody>`)
+	for _, sighting := range history.Sightings {
+		b.WriteString(`<tr class="border-t border-slate-800" data-sighting="` + template.HTMLEscapeString(sighting.GroupID) + `">`)
+		name := template.HTMLEscapeString(sighting.ScanName)
+		if sighting.Current {
+			b.WriteString(`<td class="py-1 font-medium">` + name + ` (this scan)</td>`)
+		} else {
+			b.WriteString(`<td class="py-1"><a class="underline" href="/duplicate-groups/` + template.HTMLEscapeString(url.PathEscape(sighting.GroupID)) + `">` + name + `</a></td>`)
+		}
+		b.WriteString(`<td>` + formatTime(sighting.SeenAt) + `</td>`)
+		b.WriteString(`<td>` + template.HTMLEscapeString(strings.ReplaceAll(sighting.Status, "_", " ")) + `</td>`)
+		b.WriteString(`<td class="text-right">` + fmt.Sprint(sighting.FileCount) + `</td>`)
+		b.WriteString(`</tr>`)
+	}
+	b.WriteString(`</tbody></table>`)
+
+	if len(history.Events) == 0 {
+		b.WriteString(`</section>`)
+		return
+	}
+	b.WriteString(`<ol class="mt-3 space-y-1 text-xs">`)
+	for _, event := range history.Events {
+		verb := "appeared on "
+		if event.Kind == "disappeared" {
+			verb = "disappeared from "
+		}
+		b.WriteString(`<li data-copy-event="` + template.HTMLEscapeString(event.Kind) + `">`)
+		b.WriteString(`<span class="text-slate-500">` + formatTime(event.OccurredAt) + ` · ` + template.HTMLEscapeString(event.ScanName) + `</span> `)
+		b.WriteString(`<span class="font-mono">` + template.HTMLEscapeString(event.Path) + `</span> ` + verb + template.HTMLEscapeString(event.MachineName))
+		b.WriteString(`</li>`)
+	}
+	b.WriteString(`</ol>`)
+	b.WriteString(`</section>`)
+}
+
+func writeTimeline(b *strings.Builder, timeline []actions.TimelineEntry) {
+	b.WriteString(`<section class="mb-8" aria-label="Timeline">`)
+	b.WriteString(`<h3 class="text-sm font-semibold uppercase tracking-wide mb-2">Timeline</h3>`)
+	if len(timeline) == 0 {
+		b.WriteString(`<p class="text-sm text-slate-500">No actions recorded yet.</p>`)
+	}
+	b.WriteString(`<ol class="space-y-2 text-sm">`)
+	for _, entry := range timeline {
+		b.WriteString(`<li class="border-l-2 border-slate-700 pl-3" data-audit="` + template.HTMLEscapeString(entry.Type) + `">`)
+		b.WriteString(`<span class="text-xs text-slate-500">` + formatTime(entry.PerformedAt) + `</span> `)
+		b.WriteString(`<span class="font-medium">` + template.HTMLEscapeString(strings.ReplaceAll(entry.Type, "_", " ")) + `</span>`)
+		b.WriteString(` <span class="text-slate-400">by ` + template.HTMLEscapeString(entry.Actor) + `</span>`)
+		if entry.Stubbed {
+			b.WriteString(` <span class="text-xs text-amber-400">stubbed</span>`)
+		}
+		if summary := timelineSummary(entry); summary != "" {
+			b.WriteString(`<p class="text-xs text-slate-400">` + template.HTMLEscapeString(summary) + `</p>`)
+		}
+		b.WriteString(`</li>`)
+	}
+	b.WriteString(`</ol>`)
+	b.WriteString(`</section>`)
+}
+
+// timelineSummary picks the payload details worth showing for an audit entry.
+func timelineSummary(entry actions.TimelineEntry) string {
+	switch entry.Type {
+	case "assign_keeper":
+		if id, ok := entry.Payload["keeperMachineId"].(string); ok {
+			return "keeper machine " + id
+		}
+	case string(actions.ActionNote):
+		body, _ := entry.Payload["body"].(string)
+		if revision, ok := entry.Payload["revision"].(float64); ok && revision > 1 {
+			return fmt.Sprintf("edited (revision %d): %s", int(revision), body)
+		}
+		return body
+	}
+	var parts []string
+	keys := make([]string, 0, len(entry.Payload))
+	for key := range entry.Payload {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		value := fmt.Sprint(entry.Payload[key])
+		if value == "" || value == "[]" {
+			continue
+		}
+		parts = append(parts, key+": "+value)
+	}
+	return strings.Join(parts, ", ")
+}
+
+func writeNotes(b *strings.Builder, groupID string, notes []actions.Note, feedback NoteFeedback) {
+	b.WriteString(`<section id="notes" aria-label="Notes">`)
+	b.WriteString(`<h3 class="text-sm font-semibold uppercase tracking-wide mb-2">Steward notes</h3>`)
+	if len(notes) == 0 {
+		b.WriteString(`<p class="text-sm text-slate-500">No notes yet.</p>`)
+	}
+	b.WriteString(`<ul class="space-y-4">`)
+	for _, note := range notes {
+		noteID := template.HTMLEscapeString(note.ID)
+		b.WriteString(`<li class="border border-slate-700 rounded p-3" data-note="` + noteID + `">`)
+		b.WriteString(`<p class="whitespace-pre-wrap">` + template.HTMLEscapeString(note.Body) + `</p>`)
+		b.WriteString(`<p class="text-xs text-slate-500">` + template.HTMLEscapeString(note.Author) + ` · ` + formatTime(note.CreatedAt))
+		if note.Edited() {
+			b.WriteString(` · edited ` + formatTime(note.UpdatedAt))
+		}
+		b.WriteString(`</p>`)
+		if note.Edited() {
+			b.WriteString(`<details class="mt-2 text-xs"><summary>History (` + fmt.Sprint(len(note.Revisions)) + ` versions)</summary><ol class="mt-1 space-y-1">`)
+			for i := len(note.Revisions) - 1; i >= 0; i-- {
+				revision := note.Revisions[i]
+				b.WriteString(`<li><span class="text-slate-500">` + formatTime(revision.At) + ` · ` + template.HTMLEscapeString(revision.Actor) + `</span> ` + template.HTMLEscapeString(revision.Body) + `</li>`)
+			}
+			b.WriteString(`</ol></details>`)
+		}
+		draft := note.Body
+		var errMsg string
+		if feedback.NoteID == note.ID {
+			draft, errMsg = feedback.Draft, feedback.Error
+		}
+		b.WriteString(`<details class="mt-2 text-xs"` + openAttr(errMsg != "") + `><summary>Edit</summary>`)
+		writeNoteForm(b, "/duplicate-groups/"+groupID+"/notes/"+noteID, draft, "Save note", errMsg)
+		b.WriteString(`</details>`)
+		b.WriteString(`</li>`)
+	}
+	b.WriteString(`</ul>`)
+
+	var draft, errMsg string
+	if feedback.NoteID == "" {
+		draft, errMsg = feedback.Draft, feedback.Error
+	}
+	b.WriteString(`<div class="mt-4">`)
+	writeNoteForm(b, "/duplicate-groups/"+groupID+"/notes", draft, "Add note", errMsg)
+	b.WriteString(`</div>`)
+	b.WriteString(`</section>`)
+}
+
+func writeNoteForm(b *strings.Builder, action, draft, label, errMsg string) {
+	b.WriteString(`<form method="post" action="` + action + `" class="flex flex-col gap-2">`)
+	b.WriteString(`<textarea name="body" rows="3" maxlength="` + fmt.Sprint(actions.MaxNoteLength) + `" class="bg-slate-900 border border-slate-600 rounded px-2 py-1 text-sm">` + template.HTMLEscapeString(draft) + `</textarea>`)
+	if errMsg != "" {
+		b.WriteString(`<p class="text-xs text-rose-400" role="alert">` + template.HTMLEscapeString(errMsg) + `</p>`)
+	}
+	b.WriteString(`<button type="submit" class="self-start text-xs px-2 py-1 bg-sky-700 rounded">` + label + `</button>`)
+	b.WriteString(`</form>`)
+}
+
+// NoteSearchPage renders steward notes matching a search, linking each to its duplicate group.
+func NoteSearchPage(tenantSlug, query string, notes []actions.Note) template.HTML {
+	var b strings.Builder
+	b.WriteString(`<section class="space-y-4" aria-label="Note search">`)
+	b.WriteString(`<form method="get" action="/tenants/` + template.HTMLEscapeString(url.PathEscape(tenantSlug)) + `/notes" class="flex gap-2">`)
+	b.WriteString(`<input type="search" name="q" value="` + template.HTMLEscapeString(query) + `" placeholder="Search notes" class="flex-1 bg-slate-900 border border-slate-600 rounded px-2 py-1 text-sm">`)
+	b.WriteString(`<button type="submit" class="text-sm px-3 py-1 bg-sky-700 rounded">Search</button>`)
+	b.WriteString(`</form>`)
+	if len(notes) == 0 {
+		b.WriteString(`<p class="text-sm text-slate-500">No matching notes.</p>`)
+	}
+	b.WriteString(`<ul class="space-y-2">`)
+	for _, note := range notes {
+		href := "/duplicate-groups/" + url.PathEscape(note.GroupID) + "#notes"
+		b.WriteString(`<li class="border border-slate-800 rounded px-3 py-2" data-note="` + template.HTMLEscapeString(note.ID) + `">`)
+		b.WriteString(`<a class="block" href="` + template.HTMLEscapeString(href) + `">`)
+		b.WriteString(`<p class="whitespace-pre-wrap">` + template.HTMLEscapeString(note.Body) + `</p>`)
+		b.WriteString(`<p class="text-xs text-slate-500">` + template.HTMLEscapeString(note.Author) + ` · ` + formatTime(note.UpdatedAt) + `</p>`)
+		b.WriteString(`</a>`)
+		b.WriteString(`</li>`)
+	}
+	b.WriteString(`</ul>`)
+	b.WriteString(`</section>`)
+	return template.HTML(b.String())
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return "—"
+	}
+	return t.UTC().Format(timestampLayout)
+}
+
+func openAttr(open bool) string {
+	if open {
+		return " open"
+	}
+	return ""
+}
@@ -2,31 +2,117 @@ package templ
 
 import (
 	"html/template"
+	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/mcmx/duplynx/internal/scans"
 	"github.com/mcmx/duplynx/internal/tenancy"
 )
 
-// LaunchPage renders tenant and machine selection cards.
+// LaunchPage renders the tenant picker; each card opens the tenant's machine picker.
 func LaunchPage(tenants []tenancy.Tenant) template.HTML {
 	var b strings.Builder
-	b.WriteString(`<section class="space-y-6">`)
+	b.WriteString(`<section class="space-y-6" aria-label="Tenants">`)
+	if len(tenants) == 0 {
+		b.WriteString(`<p class="text-sm text-slate-400">No tenants are registered yet.</p>`)
+	}
 	for _, tenant := range tenants {
-		b.WriteString(`<article class="border border-slate-700 rounded-lg p-4">`)
+		href := "/tenants/" + url.PathEscape(tenant.Slug) + "/machines"
+		b.WriteString(`<a class="block border border-slate-700 rounded-lg p-4 hover:border-slate-500" href="` + template.HTMLEscapeString(href) + `" data-tenant="` + template.HTMLEscapeString(tenant.Slug) + `">`)
 		b.WriteString(`<h2 class="text-lg font-semibold">` + template.HTMLEscapeString(tenant.Name) + `</h2>`)
 		if tenant.Description != "" {
 			b.WriteString(`<p class="text-sm text-slate-400">` + template.HTMLEscapeString(tenant.Description) + `</p>`)
 		}
-		b.WriteString(`<ul class="mt-3 space-y-2">`)
-		for _, machine := range tenant.Machines {
-			b.WriteString(`<li class="flex items-center justify-between border border-slate-800 rounded px-3 py-2">`)
-			b.WriteString(`<span>` + template.HTMLEscapeString(machine.Name) + `</span>`)
-			b.WriteString(`<span class="text-xs uppercase tracking-wide text-slate-500">` + template.HTMLEscapeString(machine.Category) + `</span>`)
-			b.WriteString(`</li>`)
+		b.WriteString(`<p class="mt-2 text-xs uppercase tracking-wide text-slate-500">` + machineCount(len(tenant.Machines)) + `</p>`)
+		b.WriteString(`</a>`)
+	}
+	b.WriteString(`</section>`)
+	return template.HTML(b.String())
+}
+
+// MachinePickerPage renders a tenant's machines; choosing one records the
+// selection and continues to the scan catalog. Each machine shows whether its
+// agent is online at now and when it last reported a scan.
+func MachinePickerPage(tenant tenancy.Tenant, machines []tenancy.Machine, selectedID string, now time.Time) template.HTML {
+	action := "/tenants/" + url.PathEscape(tenant.Slug) + "/machines/select"
+	var b strings.Builder
+	b.WriteString(`<section class="space-y-4" aria-label="Machines">`)
+	b.WriteString(`<h2 class="text-lg font-semibold">Choose a machine</h2>`)
+	if len(machines) == 0 {
+		b.WriteString(`<p class="text-sm text-slate-400">` + template.HTMLEscapeString(tenant.Name) + ` has no machines yet.</p>`)
+	}
+	b.WriteString(`<ul class="space-y-2">`)
+	for _, machine := range machines {
+		b.WriteString(`<li class="border border-slate-800 rounded px-3 py-2" data-machine="` + template.HTMLEscapeString(machine.ID) + `">`)
+		b.WriteString(`<form method="post" action="` + template.HTMLEscapeString(action) + `" class="flex items-center justify-between gap-4">`)
+		b.WriteString(`<input type="hidden" name="machineId" value="` + template.HTMLEscapeString(machine.ID) + `">`)
+		b.WriteString(`<span><span class="font-medium">` + template.HTMLEscapeString(machine.Name) + `</span>`)
+		if machine.Hostname != "" {
+			b.WriteString(` <span class="text-xs text-slate-500">` + template.HTMLEscapeString(machine.Hostname) + `</span>`)
+		}
+		b.WriteString(`</span>`)
+		b.WriteString(`<span class="text-xs uppercase tracking-wide text-slate-500">` + template.HTMLEscapeString(machine.Category) + `</span>`)
+		writeMachineStatus(&b, machine, now)
+		label := "Select"
+		if machine.ID == selectedID {
+			label = "Selected"
 		}
-		b.WriteString(`</ul>`)
-		b.WriteString(`</article>`)
+		b.WriteString(`<button type="submit" class="text-sm underline">` + label + `</button>`)
+		b.WriteString(`</form>`)
+		b.WriteString(`</li>`)
 	}
+	b.WriteString(`</ul>`)
+	b.WriteString(`<p class="text-sm"><a class="underline" href="/">Switch tenant</a></p>`)
 	b.WriteString(`</section>`)
 	return template.HTML(b.String())
 }
+
+// writeMachineStatus renders the agent's online badge and the last scan time.
+func writeMachineStatus(b *strings.Builder, machine tenancy.Machine, now time.Time) {
+	status, class := "offline", "text-slate-500"
+	if machine.Online(now) {
+		status, class = "online", "text-emerald-400"
+	}
+	b.WriteString(`<span class="text-xs" data-machine-status="` + status + `">`)
+	b.WriteString(`<span class="` + class + `">` + status + `</span>`)
+	b.WriteString(` <span class="text-slate-500">last scan ` + formatTime(machine.LastScanAt) + `</span>`)
+	b.WriteString(`</span>`)
+}
+
+// ScanCatalogPage lists a tenant's scans, each linking to its board.
+func ScanCatalogPage(tenant tenancy.Tenant, summaries []scans.ScanSummary) template.HTML {
+	var b strings.Builder
+	b.WriteString(`<section class="space-y-4" aria-label="Scans">`)
+	b.WriteString(`<h2 class="text-lg font-semibold">Scans</h2>`)
+	if len(summaries) == 0 {
+		b.WriteString(`<p class="text-sm text-slate-400">No scans have been ingested for ` + template.HTMLEscapeString(tenant.Name) + ` yet.</p>`)
+	}
+	b.WriteString(`<ul class="space-y-2">`)
+	for _, summary := range summaries {
+		href := "/scans/" + url.PathEscape(summary.ID)
+		b.WriteString(`<li class="border border-slate-800 rounded px-3 py-2" data-scan="` + template.HTMLEscapeString(summary.ID) + `">`)
+		b.WriteString(`<a class="flex items-center justify-between" href="` + template.HTMLEscapeString(href) + `">`)
+		b.WriteString(`<span class="font-medium">` + template.HTMLEscapeString(summary.Name) + `</span>`)
+		b.WriteString(`<span class="text-xs text-slate-500">`)
+		if !summary.StartedAt.IsZero() {
+			b.WriteString(summary.StartedAt.UTC().Format("2006-01-02 15:04") + ` · `)
+		}
+		b.WriteString(strconv.Itoa(summary.DuplicateGroupCount) + ` duplicate groups</span>`)
+		b.WriteString(`</a>`)
+		b.WriteString(`</li>`)
+	}
+	b.WriteString(`</ul>`)
+	tenantPath := "/tenants/" + template.HTMLEscapeString(url.PathEscape(tenant.Slug))
+	b.WriteString(`<p class="text-sm flex gap-4"><a class="underline" href="` + tenantPath + `/machines">Change machine</a><a class="underline" href="` + tenantPath + `/scans/diff">Compare scans</a><a class="underline" href="` + tenantPath + `/reclaimable">Reclaimable space</a><a class="underline" href="` + tenantPath + `/search">Find a file</a></p>`)
+	b.WriteString(`</section>`)
+	return template.HTML(b.String())
+}
+
+func machineCount(n int) string {
+	if n == 1 {
+		return "1 machine"
+	}
+	return strconv.Itoa(n) + " machines"
+}
@@ -4,8 +4,25 @@ import (
 	"fmt"
 	"html/template"
 	"strings"
+	"sync/atomic"
+
+	"github.com/mcmx/duplynx/internal/assets"
 )
 
+// layoutAssets is the bundle whose hashed URLs the layout references.
+var layoutAssets atomic.Pointer[assets.Bundle]
+
+// UseAssets makes every page reference the bundle's content-hashed asset
+// URLs. Until it is called, pages use unversioned /static/ paths.
+func UseAssets(bundle *assets.Bundle) {
+	layoutAssets.Store(bundle)
+}
+
+// AssetURL returns the URL the layout uses for a static asset.
+func AssetURL(name string) string {
+	return layoutAssets.Load().URL(name)
+}
+
 // Breadcrumb builds the tenant/machine breadcrumb string.
 func Breadcrumb(tenantLabel, machineLabel string) string {
 	tenantLabel = strings.TrimSpace(tenantLabel)
@@ -31,7 +48,18 @@ func RenderLayout(title, tenantLabel, machineLabel string, body template.HTML) t
     <meta charset="utf-8">
     <meta name="viewport" content="width=device-width, initial-scale=1">
     <title>%s</title>
-    <link rel="stylesheet" href="/static/app.css">
+    <link rel="stylesheet" href="%s">
+    <script src="%s" defer></script>
+    <script src="%s" defer></script>
+    <script>
+      // Card forms answer validation and quota errors with a fragment to swap in place.
+      document.addEventListener("htmx:beforeSwap", function (evt) {
+        if (evt.detail.xhr.status === 422 || evt.detail.xhr.status === 429) {
+          evt.detail.shouldSwap = true;
+          evt.detail.isError = false;
+        }
+      });
+    </script>
   </head>
   <body class="min-h-screen bg-slate-900 text-slate-100">
     <header class="border-b border-slate-700 py-4">
@@ -46,6 +74,9 @@ func RenderLayout(title, tenantLabel, machineLabel string, body template.HTML) t
   </body>
 </html>`,
 		template.HTMLEscapeString(title),
+		template.HTMLEscapeString(AssetURL("tailwind.css")),
+		template.HTMLEscapeString(AssetURL("htmx.min.js")),
+		template.HTMLEscapeString(AssetURL("sse.js")),
 		template.HTMLEscapeString(breadcrumb),
 		body))
 }
@@ -0,0 +1,85 @@
+package templ
+
+import (
+	"fmt"
+	"html/template"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/mcmx/duplynx/internal/reclaim"
+)
+
+// ReclaimPage renders a tenant's reclaimable space dashboard.
+func ReclaimPage(tenantSlug string, report reclaim.Report) template.HTML {
+	var b strings.Builder
+	b.WriteString(`<section class="space-y-6" aria-label="Reclaimable space">`)
+	b.WriteString(`<h2 class="text-lg font-semibold">Reclaimable space</h2>`)
+
+	b.WriteString(`<dl class="grid grid-cols-1 md:grid-cols-2 gap-4">`)
+	writeReclaimStat(&b, "reclaimable", "Reclaimable", report.ReclaimableBytes, fmt.Sprintf("%d groups", report.ReclaimableGroups))
+	reclaimedNote := fmt.Sprintf("%d groups", report.ReclaimedGroups)
+	if report.SimulatedBytes > 0 {
+		reclaimedNote += " · " + FormatBytes(report.SimulatedBytes) + " from stubbed actions"
+	}
+	writeReclaimStat(&b, "reclaimed", "Reclaimed", report.ReclaimedBytes, reclaimedNote)
+	b.WriteString(`</dl>`)
+
+	writeBreakdown(&b, "machine", "By machine", report.ByMachine, nil)
+	writeBreakdown(&b, "scan", "By scan", report.ByScan, func(row reclaim.Breakdown) string { return "/scans/" + url.PathEscape(row.Key) })
+	writeBreakdown(&b, "status", "By status", report.ByStatus, nil)
+	writeBreakdown(&b, "directory", "Top directories", report.TopDirectories, nil)
+
+	b.WriteString(`<p class="text-sm"><a class="underline" href="/tenants/` + template.HTMLEscapeString(url.PathEscape(tenantSlug)) + `/scans">Back to scans</a></p>`)
+	b.WriteString(`</section>`)
+	return template.HTML(b.String())
+}
+
+func writeReclaimStat(b *strings.Builder, key, label string, bytes int64, note string) {
+	b.WriteString(`<div class="bg-slate-800 border border-slate-700 rounded-lg p-4" data-stat="` + key + `">`)
+	b.WriteString(`<dt class="text-xs uppercase tracking-wide text-slate-400">` + template.HTMLEscapeString(label) + `</dt>`)
+	b.WriteString(`<dd class="text-2xl font-semibold" title="` + strconv.FormatInt(bytes, 10) + ` bytes">` + FormatBytes(bytes) + `</dd>`)
+	b.WriteString(`<dd class="text-xs text-slate-500">` + template.HTMLEscapeString(note) + `</dd>`)
+	b.WriteString(`</div>`)
+}
+
+// writeBreakdown renders one table; when href is set, each row links to the URL it returns.
+func writeBreakdown(b *strings.Builder, kind, title string, rows []reclaim.Breakdown, href func(reclaim.Breakdown) string) {
+	b.WriteString(`<section aria-label="` + template.HTMLEscapeString(title) + `">`)
+	b.WriteString(`<h3 class="text-sm font-semibold uppercase tracking-wide mb-2">` + template.HTMLEscapeString(title) + `</h3>`)
+	if len(rows) == 0 {
+		b.WriteString(`<p class="text-xs text-slate-500">Nothing left to reclaim.</p></section>`)
+		return
+	}
+	b.WriteString(`<table class="w-full text-sm"><thead><tr class="text-left text-xs text-slate-400"><th>` + template.HTMLEscapeString(strings.ToUpper(kind[:1])+kind[1:]) + `</th><th>Groups</th><th class="text-right">Reclaimable</th></tr></thead><tbody>`)
+	for _, row := range rows {
+		label := row.Label
+		if kind == "status" {
+			label = strings.ReplaceAll(label, "_", " ")
+		}
+		label = template.HTMLEscapeString(label)
+		if href != nil {
+			label = `<a class="underline" href="` + template.HTMLEscapeString(href(row)) + `">` + label + `</a>`
+		}
+		b.WriteString(`<tr class="border-t border-slate-800" data-` + kind + `="` + template.HTMLEscapeString(row.Key) + `">`)
+		b.WriteString(`<td class="py-1">` + label + `</td>`)
+		b.WriteString(`<td>` + strconv.Itoa(row.Groups) + `</td>`)
+		b.WriteString(`<td class="text-right" title="` + strconv.FormatInt(row.Bytes, 10) + ` bytes">` + FormatBytes(row.Bytes) + `</td>`)
+		b.WriteString(`</tr>`)
+	}
+	b.WriteString(`</tbody></table></section>`)
+}
+
+// FormatBytes renders a byte count with a binary unit, e.g. "1.5 GiB".
+func FormatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return strconv.FormatInt(n, 10) + " B"
+	}
+	div, exp := int64(unit), 0
+	for v := n / unit; v >= unit; v /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,124 @@
+package templ
+
+import (
+	"fmt"
+	"html/template"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/mcmx/duplynx/internal/scandiff"
+	"github.com/mcmx/duplynx/internal/scans"
+)
+
+// ScanDiffPage renders the scan pickers and, once two scans are chosen, what
+// changed between them. errMsg is shown in place of the diff when the choice was rejected.
+func ScanDiffPage(tenantSlug string, summaries []scans.ScanSummary, fromID, toID string, diff *scandiff.Diff, errMsg string) template.HTML {
+	var b strings.Builder
+	tenantPath := "/tenants/" + url.PathEscape(tenantSlug)
+	b.WriteString(`<section class="space-y-6" aria-label="Compare scans">`)
+	b.WriteString(`<h2 class="text-lg font-semibold">Compare scans</h2>`)
+	b.WriteString(`<form method="get" action="` + template.HTMLEscapeString(tenantPath+"/scans/diff") + `" class="flex flex-wrap gap-2 text-sm">`)
+	for _, picker := range []struct{ name, label, selected string }{
+		{"from", "Earlier scan", fromID},
+		{"to", "Later scan", toID},
+	} {
+		b.WriteString(`<select name="` + picker.name + `" class="bg-slate-900 border border-slate-600 rounded px-2 py-1" aria-label="` + picker.label + `">`)
+		writeOption(&b, "", picker.label, picker.selected == "")
+		for _, summary := range summaries {
+			writeOption(&b, summary.ID, summary.Name+" ("+summary.StartedAt.UTC().Format("2006-01-02")+")", summary.ID == picker.selected)
+		}
+		b.WriteString(`</select>`)
+	}
+	b.WriteString(`<button type="submit" class="px-3 py-1 bg-sky-700 rounded">Compare</button>`)
+	b.WriteString(`</form>`)
+
+	switch {
+	case errMsg != "":
+		b.WriteString(`<p class="text-sm text-rose-400" role="alert">` + template.HTMLEscapeString(errMsg) + `</p>`)
+	case diff != nil:
+		writeDiff(&b, *diff)
+	}
+
+	b.WriteString(`<p class="text-sm"><a class="underline" href="` + template.HTMLEscapeString(tenantPath+"/scans") + `">Back to scans</a></p>`)
+	b.WriteString(`</section>`)
+	return template.HTML(b.String())
+}
+
+func writeDiff(b *strings.Builder, diff scandiff.Diff) {
+	b.WriteString(`<dl class="grid grid-cols-1 md:grid-cols-3 gap-4">`)
+	writeDiffSide(b, "from", diff.From)
+	writeDiffSide(b, "to", diff.To)
+	delta := diff.RedundantBytesDelta()
+	verdict := "Duplication grew by " + FormatBytes(delta)
+	if delta <= 0 {
+		verdict = "Duplication shrank by " + FormatBytes(-delta)
+	}
+	b.WriteString(`<div class="bg-slate-800 border border-slate-700 rounded-lg p-4" data-stat="delta">`)
+	b.WriteString(`<dt class="text-xs uppercase tracking-wide text-slate-400">Change</dt>`)
+	b.WriteString(`<dd class="text-2xl font-semibold" title="` + strconv.FormatInt(delta, 10) + ` bytes">` + template.HTMLEscapeString(verdict) + `</dd>`)
+	b.WriteString(`<dd class="text-xs text-slate-500">` + fmt.Sprintf("%d new · %d removed · %d changed · %d moved", len(diff.New), len(diff.Removed), len(diff.Changed), len(diff.Moves)) + `</dd>`)
+	b.WriteString(`</div>`)
+	b.WriteString(`</dl>`)
+	if len(diff.Uncovered) > 0 {
+		b.WriteString(`<p class="text-xs text-amber-300" data-uncovered>Only one of the scans looked at ` + template.HTMLEscapeString(strings.Join(diff.Uncovered, ", ")) + `, so copies there are not compared.</p>`)
+	}
+
+	writeDiffGroups(b, "new", "New duplicate groups", diff.New)
+	writeDiffGroups(b, "removed", "Removed from disk", diff.Removed)
+	writeDiffGroups(b, "changed", "Changed copy count or size", diff.Changed)
+
+	b.WriteString(`<section aria-label="Moved between machines">`)
+	b.WriteString(`<h3 class="text-sm font-semibold uppercase tracking-wide mb-2">Moved between machines</h3>`)
+	if diff.From.FilesPruned || diff.To.FilesPruned {
+		b.WriteString(`<p class="text-xs text-slate-500" data-files-pruned>Retention pruned a scan's file list, so moves cannot be detected.</p></section>`)
+		return
+	}
+	if len(diff.Moves) == 0 {
+		b.WriteString(`<p class="text-xs text-slate-500">No copies moved.</p></section>`)
+		return
+	}
+	b.WriteString(`<table class="w-full text-sm"><thead><tr class="text-left text-xs text-slate-400"><th>Hash</th><th>From</th><th>To</th></tr></thead><tbody>`)
+	for _, move := range diff.Moves {
+		b.WriteString(`<tr class="border-t border-slate-800" data-move="` + template.HTMLEscapeString(move.Hash) + `">`)
+		b.WriteString(`<td class="py-1 font-mono text-xs">` + groupLink(move.ToGroupID, move.Hash) + `</td>`)
+		b.WriteString(`<td>` + template.HTMLEscapeString(move.FromMachine) + ` <span class="font-mono text-xs">` + template.HTMLEscapeString(move.FromPath) + `</span></td>`)
+		b.WriteString(`<td>` + template.HTMLEscapeString(move.ToMachine) + ` <span class="font-mono text-xs">` + template.HTMLEscapeString(move.ToPath) + `</span></td>`)
+		b.WriteString(`</tr>`)
+	}
+	b.WriteString(`</tbody></table></section>`)
+}
+
+func writeDiffSide(b *strings.Builder, key string, scan scandiff.Scan) {
+	b.WriteString(`<div class="bg-slate-800 border border-slate-700 rounded-lg p-4" data-stat="` + key + `">`)
+	b.WriteString(`<dt class="text-xs uppercase tracking-wide text-slate-400"><a class="underline" href="/scans/` + template.HTMLEscapeString(url.PathEscape(scan.ID)) + `">` + template.HTMLEscapeString(scan.Name) + `</a></dt>`)
+	b.WriteString(`<dd class="text-2xl font-semibold" title="` + strconv.FormatInt(scan.RedundantBytes, 10) + ` bytes">` + FormatBytes(scan.RedundantBytes) + ` redundant</dd>`)
+	b.WriteString(`<dd class="text-xs text-slate-500">` + fmt.Sprintf("%s · %d groups · %d files · %s", formatTime(scan.StartedAt), scan.Groups, scan.Files, FormatBytes(scan.Bytes)) + `</dd>`)
+	b.WriteString(`</div>`)
+}
+
+func writeDiffGroups(b *strings.Builder, kind, title string, groups []scandiff.Group) {
+	b.WriteString(`<section aria-label="` + template.HTMLEscapeString(title) + `">`)
+	b.WriteString(`<h3 class="text-sm font-semibold uppercase tracking-wide mb-2">` + template.HTMLEscapeString(title) + `</h3>`)
+	if len(groups) == 0 {
+		b.WriteString(`<p class="text-xs text-slate-500">None.</p></section>`)
+		return
+	}
+	b.WriteString(`<table class="w-full text-sm"><thead><tr class="text-left text-xs text-slate-400"><th>Hash</th><th class="text-right">Copies</th><th class="text-right">Size</th></tr></thead><tbody>`)
+	for _, group := range groups {
+		groupID := group.ToGroupID
+		if groupID == "" {
+			groupID = group.FromGroupID
+		}
+		b.WriteString(`<tr class="border-t border-slate-800" data-` + kind + `="` + template.HTMLEscapeString(group.Hash) + `">`)
+		b.WriteString(`<td class="py-1 font-mono text-xs">` + groupLink(groupID, group.Hash) + `</td>`)
+		b.WriteString(`<td class="text-right">` + fmt.Sprintf("%d → %d", group.FromFiles, group.ToFiles) + `</td>`)
+		b.WriteString(`<td class="text-right">` + FormatBytes(group.FromBytes) + ` → ` + FormatBytes(group.ToBytes) + `</td>`)
+		b.WriteString(`</tr>`)
+	}
+	b.WriteString(`</tbody></table></section>`)
+}
+
+func groupLink(groupID, hash string) string {
+	return `<a class="underline" href="/duplicate-groups/` + template.HTMLEscapeString(url.PathEscape(groupID)) + `">` + template.HTMLEscapeString(hash) + `</a>`
+}
@@ -0,0 +1,66 @@
+package templ
+
+import (
+	"fmt"
+	"html/template"
+	"net/url"
+	"strings"
+
+	"github.com/mcmx/duplynx/internal/search"
+)
+
+// SearchPage renders the tenant-wide search form and its results. errMsg is
+// shown in place of results when the query was rejected.
+func SearchPage(tenantSlug, query string, kind search.Kind, result search.Result, errMsg string) template.HTML {
+	var b strings.Builder
+	action := "/tenants/" + url.PathEscape(tenantSlug) + "/search"
+	b.WriteString(`<section class="space-y-4" aria-label="Search">`)
+	b.WriteString(`<h2 class="text-lg font-semibold">Find a file</h2>`)
+	b.WriteString(`<form method="get" action="` + template.HTMLEscapeString(action) + `" class="flex flex-wrap gap-2 text-sm" role="search">`)
+	b.WriteString(`<input type="search" name="q" value="` + template.HTMLEscapeString(query) + `" placeholder="Checksum, file name, path or glob" class="flex-1 bg-slate-900 border border-slate-600 rounded px-2 py-1" aria-label="Search query">`)
+	b.WriteString(`<select name="kind" class="bg-slate-900 border border-slate-600 rounded px-2 py-1" aria-label="Match">`)
+	writeOption(&b, "", "Anything", kind == search.KindAuto)
+	writeOption(&b, string(search.KindHash), "Checksum prefix", kind == search.KindHash)
+	writeOption(&b, string(search.KindName), "File name", kind == search.KindName)
+	writeOption(&b, string(search.KindPath), "Path or glob", kind == search.KindPath)
+	b.WriteString(`</select>`)
+	b.WriteString(`<button type="submit" class="px-3 py-1 bg-sky-700 rounded">Search</button>`)
+	b.WriteString(`</form>`)
+
+	switch {
+	case errMsg != "":
+		b.WriteString(`<p class="text-sm text-rose-400" role="alert">` + template.HTMLEscapeString(errMsg) + `</p>`)
+	case query == "":
+	case len(result.Groups) == 0:
+		b.WriteString(`<p class="text-sm text-slate-400">No files match.</p>`)
+	default:
+		summary := fmt.Sprintf("%d files in %d duplicate groups", result.Files, len(result.Groups))
+		if result.Truncated {
+			summary = "First " + summary + "; refine the query to see the rest"
+		}
+		b.WriteString(`<p class="text-xs text-slate-400">` + template.HTMLEscapeString(summary) + `</p>`)
+		b.WriteString(`<ul class="space-y-3">`)
+		for _, group := range result.Groups {
+			b.WriteString(`<li class="border border-slate-700 rounded-lg p-3" data-group="` + template.HTMLEscapeString(group.ID) + `">`)
+			b.WriteString(`<div class="flex items-center justify-between text-xs">`)
+			b.WriteString(`<a class="font-mono underline" href="/duplicate-groups/` + template.HTMLEscapeString(url.PathEscape(group.ID)) + `">` + template.HTMLEscapeString(group.Hash) + `</a>`)
+			b.WriteString(`<a class="text-slate-400 underline" href="/scans/` + template.HTMLEscapeString(url.PathEscape(group.ScanID)) + `">` + template.HTMLEscapeString(group.ScanName) + `</a>`)
+			b.WriteString(`</div>`)
+			b.WriteString(`<ul class="mt-2 space-y-1 text-xs">`)
+			for _, file := range group.Files {
+				machine := file.MachineName
+				if machine == "" {
+					machine = file.MachineID
+				}
+				b.WriteString(`<li data-file="` + template.HTMLEscapeString(file.ID) + `" data-matched-by="` + template.HTMLEscapeString(file.MatchedBy) + `">`)
+				b.WriteString(template.HTMLEscapeString(machine) + `: <span class="font-mono">` + template.HTMLEscapeString(file.Path) + `</span>`)
+				b.WriteString(` <span class="text-slate-500">` + FormatBytes(file.SizeBytes) + `</span>`)
+				b.WriteString(`</li>`)
+			}
+			b.WriteString(`</ul></li>`)
+		}
+		b.WriteString(`</ul>`)
+	}
+	b.WriteString(`</section>`)
+	return template.HTML(b.String())
+}
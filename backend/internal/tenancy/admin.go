@@ -0,0 +1,293 @@
+package tenancy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/mcmx/duplynx/ent"
+	entmachine "github.com/mcmx/duplynx/ent/machine"
+	enttenant "github.com/mcmx/duplynx/ent/tenant"
+	"github.com/mcmx/duplynx/internal/tenancy/isolation"
+)
+
+var (
+	// ErrMachineNotFound is returned when a machine ID cannot be resolved within a tenant.
+	ErrMachineNotFound = errors.New("machine not found")
+	// ErrTenantExists is returned when creating a tenant with a slug that is already taken.
+	ErrTenantExists = errors.New("tenant slug already exists")
+	// ErrReadOnlyRepository is returned when writing through a repository built from seed data.
+	ErrReadOnlyRepository = errors.New("tenant administration requires a database-backed repository")
+)
+
+// Tenant and machine administration spans tenants, so the write methods below
+// run with system privileges rather than a request's tenant scope.
+
+// TenantInput carries the editable tenant attributes.
+type TenantInput struct {
+	Slug           string `json:"slug"`
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+	PrimaryContact string `json:"primaryContact"`
+}
+
+// Normalize trims surrounding whitespace from every field.
+func (in TenantInput) Normalize() TenantInput {
+	in.Slug = strings.ToLower(strings.TrimSpace(in.Slug))
+	in.Name = strings.TrimSpace(in.Name)
+	in.Description = strings.TrimSpace(in.Description)
+	in.PrimaryContact = strings.TrimSpace(in.PrimaryContact)
+	return in
+}
+
+// Validate reports field-level problems; the slug is only checked when creating a tenant.
+func (in TenantInput) Validate(creating bool) error {
+	errs := ValidationErrors{}
+	if creating {
+		if err := ValidateSlug(in.Slug); err != nil {
+			errs["slug"] = err.Error()
+		}
+	}
+	if in.Name == "" {
+		errs["name"] = "is required"
+	}
+	return errs.orNil()
+}
+
+// MachineInput carries the editable machine attributes.
+type MachineInput struct {
+	Name     string `json:"name"`
+	Category string `json:"category"`
+	Hostname string `json:"hostname"`
+	Role     string `json:"role"`
+}
+
+// Normalize trims surrounding whitespace and lowercases the hostname.
+func (in MachineInput) Normalize() MachineInput {
+	in.Name = strings.TrimSpace(in.Name)
+	in.Category = strings.TrimSpace(in.Category)
+	in.Hostname = strings.ToLower(strings.TrimSpace(in.Hostname))
+	in.Role = strings.TrimSpace(in.Role)
+	return in
+}
+
+// Validate reports field-level problems with the machine input.
+func (in MachineInput) Validate() error {
+	errs := ValidationErrors{}
+	if in.Name == "" {
+		errs["name"] = "is required"
+	}
+	if err := ValidateCategory(in.Category); err != nil {
+		errs["category"] = err.Error()
+	}
+	if in.Hostname != "" {
+		if err := ValidateHostname(in.Hostname); err != nil {
+			errs["hostname"] = err.Error()
+		}
+	} else if in.Category != "cloud_bucket" {
+		errs["hostname"] = "is required for non-bucket machines"
+	}
+	return errs.orNil()
+}
+
+// CreateTenant registers a new tenant.
+func (r *Repository) CreateTenant(ctx context.Context, in TenantInput) (Tenant, error) {
+	if r.client == nil {
+		return Tenant{}, ErrReadOnlyRepository
+	}
+	ctx = isolation.WithSystem(ctx)
+	in = in.Normalize()
+	if err := in.Validate(true); err != nil {
+		return Tenant{}, err
+	}
+
+	exists, err := r.client.Tenant.Query().Where(enttenant.SlugEQ(in.Slug)).Exist(ctx)
+	if err != nil {
+		return Tenant{}, fmt.Errorf("check tenant slug: %w", err)
+	}
+	if exists {
+		return Tenant{}, ErrTenantExists
+	}
+
+	builder := r.client.Tenant.Create().
+		SetSlug(in.Slug).
+		SetName(in.Name)
+	if in.Description != "" {
+		builder.SetDescription(in.Description)
+	}
+	if in.PrimaryContact != "" {
+		builder.SetPrimaryContact(in.PrimaryContact)
+	}
+	record, err := builder.Save(ctx)
+	if err != nil {
+		return Tenant{}, fmt.Errorf("create tenant: %w", err)
+	}
+	return convertTenant(record), nil
+}
+
+// UpdateTenant changes the descriptive attributes of a tenant; the slug is immutable.
+func (r *Repository) UpdateTenant(ctx context.Context, slug string, in TenantInput) (Tenant, error) {
+	if r.client == nil {
+		return Tenant{}, ErrReadOnlyRepository
+	}
+	ctx = isolation.WithSystem(ctx)
+	in = in.Normalize()
+	if err := in.Validate(false); err != nil {
+		return Tenant{}, err
+	}
+	record, err := r.tenantRecord(ctx, slug)
+	if err != nil {
+		return Tenant{}, err
+	}
+
+	updated, err := record.Update().
+		SetName(in.Name).
+		SetDescription(in.Description).
+		SetPrimaryContact(in.PrimaryContact).
+		Save(ctx)
+	if err != nil {
+		return Tenant{}, fmt.Errorf("update tenant: %w", err)
+	}
+	return convertTenant(updated), nil
+}
+
+// ArchiveTenant hides a tenant from the launch flow and scope resolution without deleting data.
+func (r *Repository) ArchiveTenant(ctx context.Context, slug string) error {
+	if r.client == nil {
+		return ErrReadOnlyRepository
+	}
+	ctx = isolation.WithSystem(ctx)
+	record, err := r.tenantRecord(ctx, slug)
+	if err != nil {
+		return err
+	}
+	if !record.ArchivedAt.IsZero() {
+		return nil
+	}
+	if err := record.Update().SetArchivedAt(time.Now().UTC()).Exec(ctx); err != nil {
+		return fmt.Errorf("archive tenant: %w", err)
+	}
+	return nil
+}
+
+// CreateMachine registers a machine for the tenant.
+func (r *Repository) CreateMachine(ctx context.Context, tenantSlug string, in MachineInput) (Machine, error) {
+	if r.client == nil {
+		return Machine{}, ErrReadOnlyRepository
+	}
+	ctx = isolation.WithSystem(ctx)
+	in = in.Normalize()
+	if err := in.Validate(); err != nil {
+		return Machine{}, err
+	}
+	tenant, err := r.tenantRecord(ctx, tenantSlug)
+	if err != nil {
+		return Machine{}, err
+	}
+
+	builder := r.client.Machine.Create().
+		SetTenantID(tenant.ID).
+		SetName(in.Name).
+		SetCategory(entmachine.Category(in.Category))
+	if in.Hostname != "" {
+		builder.SetHostname(in.Hostname)
+	}
+	if in.Role != "" {
+		builder.SetRole(in.Role)
+	}
+	record, err := builder.Save(ctx)
+	if err != nil {
+		return Machine{}, fmt.Errorf("create machine: %w", err)
+	}
+	machine := convertMachine(record)
+	machine.TenantSlug = tenant.Slug
+	return machine, nil
+}
+
+// UpdateMachine changes a machine's attributes.
+func (r *Repository) UpdateMachine(ctx context.Context, tenantSlug, machineID string, in MachineInput) (Machine, error) {
+	if r.client == nil {
+		return Machine{}, ErrReadOnlyRepository
+	}
+	ctx = isolation.WithSystem(ctx)
+	in = in.Normalize()
+	if err := in.Validate(); err != nil {
+		return Machine{}, err
+	}
+	record, err := r.machineRecord(ctx, tenantSlug, machineID)
+	if err != nil {
+		return Machine{}, err
+	}
+
+	update := record.Update().
+		SetName(in.Name).
+		SetCategory(entmachine.Category(in.Category)).
+		SetRole(in.Role)
+	if in.Hostname != "" {
+		update.SetHostname(in.Hostname)
+	} else {
+		update.ClearHostname()
+	}
+	updated, err := update.Save(ctx)
+	if err != nil {
+		return Machine{}, fmt.Errorf("update machine: %w", err)
+	}
+	machine := convertMachine(updated)
+	machine.TenantSlug = tenantSlug
+	return machine, nil
+}
+
+// ArchiveMachine hides a machine from pickers while keeping its scan history.
+func (r *Repository) ArchiveMachine(ctx context.Context, tenantSlug, machineID string) error {
+	if r.client == nil {
+		return ErrReadOnlyRepository
+	}
+	ctx = isolation.WithSystem(ctx)
+	record, err := r.machineRecord(ctx, tenantSlug, machineID)
+	if err != nil {
+		return err
+	}
+	if !record.ArchivedAt.IsZero() {
+		return nil
+	}
+	if err := record.Update().SetArchivedAt(time.Now().UTC()).Exec(ctx); err != nil {
+		return fmt.Errorf("archive machine: %w", err)
+	}
+	return nil
+}
+
+func (r *Repository) tenantRecord(ctx context.Context, slug string) (*ent.Tenant, error) {
+	record, err := r.client.Tenant.Query().Where(enttenant.SlugEQ(slug)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrTenantNotFound
+		}
+		return nil, fmt.Errorf("load tenant: %w", err)
+	}
+	return record, nil
+}
+
+func (r *Repository) machineRecord(ctx context.Context, tenantSlug, machineID string) (*ent.Machine, error) {
+	id, err := uuid.Parse(machineID)
+	if err != nil {
+		return nil, ErrMachineNotFound
+	}
+	record, err := r.client.Machine.
+		Query().
+		Where(
+			entmachine.IDEQ(id),
+			entmachine.HasTenantWith(enttenant.SlugEQ(tenantSlug)),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrMachineNotFound
+		}
+		return nil, fmt.Errorf("load machine: %w", err)
+	}
+	return record, nil
+}
@@ -0,0 +1,57 @@
+// Package isolation carries the row-level tenant scope consulted by the Ent
+// interceptors and hooks declared in ent/schema. It deliberately has no
+// dependency on the generated Ent client so the schema package can import it.
+package isolation
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrUnscoped is returned when a query or mutation runs without a tenant scope.
+	ErrUnscoped = errors.New("tenant isolation: operation has no tenant scope")
+	// ErrCrossTenant is returned when a mutation targets a tenant other than the scoped one.
+	ErrCrossTenant = errors.New("tenant isolation: operation targets another tenant")
+)
+
+type scopeKey struct{}
+
+type scope struct {
+	tenantID uuid.UUID
+	system   bool
+}
+
+// WithTenant confines Ent operations executed with the returned context to a single tenant.
+func WithTenant(ctx context.Context, tenantID uuid.UUID) context.Context {
+	return context.WithValue(ctx, scopeKey{}, scope{tenantID: tenantID})
+}
+
+// WithSystem marks the context as privileged so Ent operations span every tenant.
+// Reserve it for seeding, migrations, CLI maintenance, and the tenant directory.
+func WithSystem(ctx context.Context) context.Context {
+	return context.WithValue(ctx, scopeKey{}, scope{system: true})
+}
+
+// TenantID returns the tenant the context is confined to, if any.
+func TenantID(ctx context.Context) (uuid.UUID, bool) {
+	if ctx == nil {
+		return uuid.Nil, false
+	}
+	s, ok := ctx.Value(scopeKey{}).(scope)
+	if !ok || s.system || s.tenantID == uuid.Nil {
+		return uuid.Nil, false
+	}
+	return s.tenantID, true
+}
+
+// IsSystem reports whether the context carries system privileges.
+func IsSystem(ctx context.Context) bool {
+	if ctx == nil {
+		return false
+	}
+	s, ok := ctx.Value(scopeKey{}).(scope)
+	return ok && s.system
+}
@@ -6,15 +6,39 @@ import (
 	"strings"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/mcmx/duplynx/internal/tenancy/isolation"
 )
 
 const HeaderTenantSlug = "X-Duplynx-Tenant"
 
 type scopeKey struct{}
 
+type sessionTenantKey struct{}
+
+// WithSessionTenant records the tenant remembered by the caller's session. The
+// scope middleware falls back to it when neither the header nor the path names a tenant.
+func WithSessionTenant(ctx context.Context, slug string) context.Context {
+	return context.WithValue(ctx, sessionTenantKey{}, slug)
+}
+
 // Scope captures the tenant context for a request lifecycle.
 type Scope struct {
 	TenantSlug string
+	// TenantID is the database identifier used for row-level isolation; it is
+	// zero for repositories built from in-memory seed data.
+	TenantID uuid.UUID
+}
+
+// WithScope attaches the scope to the context, including the row-level
+// isolation scope enforced by the Ent layer.
+func WithScope(ctx context.Context, scope Scope) context.Context {
+	ctx = context.WithValue(ctx, scopeKey{}, scope)
+	if scope.TenantID != uuid.Nil {
+		ctx = isolation.WithTenant(ctx, scope.TenantID)
+	}
+	return ctx
 }
 
 // ScopeViolation describes a mismatch between the active scope and a requested resource.
@@ -62,8 +86,7 @@ func RequireTenantScope(repo *Repository, renderer ScopeViolationRenderer) func(
 			}
 
 			w.Header().Set(HeaderTenantSlug, scope.TenantSlug)
-			ctx := context.WithValue(r.Context(), scopeKey{}, scope)
-			next.ServeHTTP(w, r.WithContext(ctx))
+			next.ServeHTTP(w, r.WithContext(WithScope(r.Context(), scope)))
 		})
 	}
 }
@@ -82,6 +105,9 @@ func resolveScope(r *http.Request, repo *Repository) (Scope, *scopeError) {
 	if tenantSlug == "" {
 		tenantSlug = pathSlug
 	}
+	if tenantSlug == "" {
+		tenantSlug, _ = r.Context().Value(sessionTenantKey{}).(string)
+	}
 
 	if tenantSlug == "" {
 		return Scope{}, &scopeError{
@@ -90,8 +116,10 @@ func resolveScope(r *http.Request, repo *Repository) (Scope, *scopeError) {
 		}
 	}
 
+	var tenantID uuid.UUID
 	if repo != nil {
-		if _, ok := repo.Tenant(tenantSlug); !ok {
+		tenant, ok := repo.Tenant(tenantSlug)
+		if !ok {
 			return Scope{}, &scopeError{
 				status:  http.StatusNotFound,
 				message: "tenant not found",
@@ -102,6 +130,7 @@ func resolveScope(r *http.Request, repo *Repository) (Scope, *scopeError) {
 				},
 			}
 		}
+		tenantID = tenant.ID
 	}
 
 	if pathSlug != "" && pathSlug != tenantSlug {
@@ -116,5 +145,5 @@ func resolveScope(r *http.Request, repo *Repository) (Scope, *scopeError) {
 		}
 	}
 
-	return Scope{TenantSlug: tenantSlug}, nil
+	return Scope{TenantSlug: tenantSlug, TenantID: tenantID}, nil
 }
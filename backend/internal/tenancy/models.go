@@ -1,11 +1,25 @@
 package tenancy
 
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
 // Tenant represents an organization with associated machines.
 type Tenant struct {
-	Slug        string
-	Name        string
-	Description string
-	Machines    []Machine
+	ID             uuid.UUID
+	Slug           string
+	Name           string
+	Description    string
+	PrimaryContact string
+	ArchivedAt     time.Time
+	Machines       []Machine
+}
+
+// Archived reports whether the tenant has been archived.
+func (t Tenant) Archived() bool {
+	return !t.ArchivedAt.IsZero()
 }
 
 // Machine represents a predefined host that can participate in scans.
@@ -16,4 +30,33 @@ type Machine struct {
 	Category   string
 	Hostname   string
 	Role       string
+	ArchivedAt time.Time
+	// LastScanAt is when the machine last reported its part of a scan.
+	LastScanAt time.Time
+	// LastSeenAt is when the machine's agent last polled for jobs.
+	LastSeenAt time.Time
+}
+
+// OnlineWindow is how recently an agent must have polled for its machine to count as online.
+const OnlineWindow = 2 * time.Minute
+
+// Archived reports whether the machine has been archived.
+func (m Machine) Archived() bool {
+	return !m.ArchivedAt.IsZero()
+}
+
+// Online reports whether the machine's agent polled within OnlineWindow of now.
+func (m Machine) Online(now time.Time) bool {
+	return !m.LastSeenAt.IsZero() && now.Sub(m.LastSeenAt) <= OnlineWindow
+}
+
+// MachineCategories lists the machine categories accepted by the admin API, in display order.
+var MachineCategories = []string{
+	"personal_laptop",
+	"workstation",
+	"server",
+	"nas",
+	"vm",
+	"container",
+	"cloud_bucket",
 }
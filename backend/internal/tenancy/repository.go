@@ -11,6 +11,7 @@ import (
 	"github.com/mcmx/duplynx/ent"
 	entmachine "github.com/mcmx/duplynx/ent/machine"
 	enttenant "github.com/mcmx/duplynx/ent/tenant"
+	"github.com/mcmx/duplynx/internal/tenancy/isolation"
 )
 
 var (
@@ -45,25 +46,52 @@ func NewRepositoryFromClient(client *ent.Client, audit *AuditLogger) *Repository
 	}
 }
 
-// ListTenants returns tenants sorted by seed order.
-func (r *Repository) ListTenants(ctx context.Context) ([]Tenant, error) {
+// ListOption adjusts tenant and machine listings.
+type ListOption func(*listOptions)
+
+type listOptions struct {
+	includeArchived bool
+}
+
+// IncludeArchived makes listings return archived tenants and machines as well.
+func IncludeArchived() ListOption {
+	return func(o *listOptions) {
+		o.includeArchived = true
+	}
+}
+
+func collectListOptions(opts []ListOption) listOptions {
+	var o listOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// ListTenants returns tenants sorted by seed order, skipping archived tenants by default.
+func (r *Repository) ListTenants(ctx context.Context, opts ...ListOption) ([]Tenant, error) {
+	o := collectListOptions(opts)
 	if r.client != nil {
-		return r.listTenantsFromClient(ctx)
+		return r.listTenantsFromClient(ctx, o)
 	}
 
 	out := make([]Tenant, 0, len(r.tenants))
 	for _, slug := range r.tenantOrder {
 		if tenant, ok := r.tenants[slug]; ok {
+			if tenant.Archived() && !o.includeArchived {
+				continue
+			}
 			out = append(out, tenant)
 		}
 	}
 	return out, nil
 }
 
-// ListMachines returns machines for a tenant, logging the selection.
-func (r *Repository) ListMachines(ctx context.Context, tenantSlug string) ([]Machine, error) {
+// ListMachines returns machines for a tenant, logging the selection. Archived machines are skipped by default.
+func (r *Repository) ListMachines(ctx context.Context, tenantSlug string, opts ...ListOption) ([]Machine, error) {
+	o := collectListOptions(opts)
 	if r.client != nil {
-		machines, err := r.listMachinesFromClient(ctx, tenantSlug)
+		machines, err := r.listMachinesFromClient(ctx, tenantSlug, o)
 		if err != nil {
 			return nil, err
 		}
@@ -81,8 +109,13 @@ func (r *Repository) ListMachines(ctx context.Context, tenantSlug string) ([]Mac
 		r.audit.LogTenantSelection(tenantSlug)
 	}
 
-	machines := make([]Machine, len(tenant.Machines))
-	copy(machines, tenant.Machines)
+	machines := make([]Machine, 0, len(tenant.Machines))
+	for _, machine := range tenant.Machines {
+		if machine.Archived() && !o.includeArchived {
+			continue
+		}
+		machines = append(machines, machine)
+	}
 
 	sort.SliceStable(machines, func(i, j int) bool {
 		return machines[i].Name < machines[j].Name
@@ -106,7 +139,7 @@ func (r *Repository) FindMachine(ctx context.Context, tenantSlug, machineID stri
 			return m, nil
 		}
 	}
-	return Machine{}, errors.New("machine not found")
+	return Machine{}, ErrMachineNotFound
 }
 
 // LogMachineSelection delegates to the audit logger for tracking.
@@ -121,45 +154,56 @@ func (r *Repository) Audit() *AuditLogger {
 	return r.audit
 }
 
-// Tenant returns a tenant by slug if it exists.
+// Tenant returns a tenant by slug if it exists and has not been archived.
+// Scope resolution happens before a tenant is known, so the lookup runs with
+// system privileges.
 func (r *Repository) Tenant(slug string) (Tenant, bool) {
 	if r.client != nil {
-		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		ctx, cancel := context.WithTimeout(isolation.WithSystem(context.Background()), 5*time.Second)
 		defer cancel()
 
 		record, err := r.client.Tenant.
 			Query().
-			Where(enttenant.SlugEQ(slug)).
+			Where(
+				enttenant.SlugEQ(slug),
+				enttenant.ArchivedAtIsNil(),
+			).
 			Only(ctx)
 		if err != nil {
 			return Tenant{}, false
 		}
-		return Tenant{
-			Slug:        record.Slug,
-			Name:        record.Name,
-			Description: record.Description,
-		}, true
+		return convertTenant(record), true
 	}
 
 	tenant, ok := r.tenants[slug]
+	if ok && tenant.Archived() {
+		return Tenant{}, false
+	}
 	return tenant, ok
 }
 
-func (r *Repository) listTenantsFromClient(ctx context.Context) ([]Tenant, error) {
+func (r *Repository) listTenantsFromClient(ctx context.Context, o listOptions) ([]Tenant, error) {
 	if r.client == nil {
 		return nil, errors.New("ent client not configured")
 	}
-	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	// The tenant directory feeds the launch flow and admin pages, which span tenants.
+	ctx, cancel := context.WithTimeout(isolation.WithSystem(ctx), 10*time.Second)
 	defer cancel()
 
-	records, err := r.client.Tenant.
+	query := r.client.Tenant.
 		Query().
 		WithMachines(func(mq *ent.MachineQuery) {
+			if !o.includeArchived {
+				mq.Where(entmachine.ArchivedAtIsNil())
+			}
 			mq.Order(entmachine.ByName()).
 				WithTenant()
 		}).
-		Order(enttenant.ByName()).
-		All(ctx)
+		Order(enttenant.ByName())
+	if !o.includeArchived {
+		query = query.Where(enttenant.ArchivedAtIsNil())
+	}
+	records, err := query.All(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -171,18 +215,21 @@ func (r *Repository) listTenantsFromClient(ctx context.Context) ([]Tenant, error
 	return out, nil
 }
 
-func (r *Repository) listMachinesFromClient(ctx context.Context, tenantSlug string) ([]Machine, error) {
+func (r *Repository) listMachinesFromClient(ctx context.Context, tenantSlug string, o listOptions) ([]Machine, error) {
 	if r.client == nil {
 		return nil, errors.New("ent client not configured")
 	}
 	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
-	records, err := r.client.Machine.
+	query := r.client.Machine.
 		Query().
 		Where(entmachine.HasTenantWith(enttenant.SlugEQ(tenantSlug))).
-		Order(entmachine.ByName()).
-		All(ctx)
+		Order(entmachine.ByName())
+	if !o.includeArchived {
+		query = query.Where(entmachine.ArchivedAtIsNil())
+	}
+	records, err := query.All(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -200,7 +247,7 @@ func (r *Repository) findMachineFromClient(ctx context.Context, tenantSlug, mach
 	}
 	u, err := uuid.Parse(machineID)
 	if err != nil {
-		return Machine{}, err
+		return Machine{}, ErrMachineNotFound
 	}
 	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
@@ -211,10 +258,13 @@ func (r *Repository) findMachineFromClient(ctx context.Context, tenantSlug, mach
 		WithTenant().
 		Only(ctx)
 	if err != nil {
+		if ent.IsNotFound(err) {
+			return Machine{}, ErrMachineNotFound
+		}
 		return Machine{}, err
 	}
 	if record.Edges.Tenant == nil || record.Edges.Tenant.Slug != tenantSlug {
-		return Machine{}, errors.New("machine not found")
+		return Machine{}, ErrMachineNotFound
 	}
 	return convertMachine(record), nil
 }
@@ -224,9 +274,12 @@ func convertTenant(record *ent.Tenant) Tenant {
 		return Tenant{}
 	}
 	tenant := Tenant{
-		Slug:        record.Slug,
-		Name:        record.Name,
-		Description: record.Description,
+		ID:             record.ID,
+		Slug:           record.Slug,
+		Name:           record.Name,
+		Description:    record.Description,
+		PrimaryContact: record.PrimaryContact,
+		ArchivedAt:     record.ArchivedAt,
 	}
 	for _, machine := range record.Edges.Machines {
 		m := convertMachine(machine)
@@ -253,5 +306,8 @@ func convertMachine(record *ent.Machine) Machine {
 		Category:   string(record.Category),
 		Hostname:   record.Hostname,
 		Role:       record.Role,
+		ArchivedAt: record.ArchivedAt,
+		LastScanAt: record.LastScanAt,
+		LastSeenAt: record.LastSeenAt,
 	}
 }
@@ -8,6 +8,7 @@ import (
 
 	"github.com/mcmx/duplynx/internal/actions"
 	"github.com/mcmx/duplynx/internal/scans"
+	"github.com/mcmx/duplynx/internal/tenancy/isolation"
 )
 
 // ScopedRepository enforces tenant boundaries when interacting with shared stores.
@@ -26,12 +27,20 @@ func NewScopedRepository(scope Scope, scanRepo *scans.Repository, actionsRepo *a
 	}
 }
 
+// context confines Ent operations to the scoped tenant.
+func (s *ScopedRepository) context(ctx context.Context) context.Context {
+	if s.scope.TenantID == uuid.Nil {
+		return ctx
+	}
+	return isolation.WithTenant(ctx, s.scope.TenantID)
+}
+
 // ListScans returns scans belonging to the scoped tenant.
 func (s *ScopedRepository) ListScans(ctx context.Context) ([]scans.ScanSummary, error) {
 	if s.scanRepo == nil {
 		return nil, nil
 	}
-	return s.scanRepo.ListByTenant(ctx, s.scope.TenantSlug)
+	return s.scanRepo.ListByTenant(s.context(ctx), s.scope.TenantSlug)
 }
 
 // GetScan retrieves a scan by ID, returning ErrScanNotFound when outside the scope.
@@ -39,7 +48,7 @@ func (s *ScopedRepository) GetScan(ctx context.Context, scanID string) (scans.Sc
 	if s.scanRepo == nil {
 		return scans.ScanSummary{}, scans.ErrScanNotFound
 	}
-	scan, err := s.scanRepo.Get(ctx, scanID)
+	scan, err := s.scanRepo.Get(s.context(ctx), scanID)
 	if err != nil {
 		return scans.ScanSummary{}, err
 	}
@@ -60,7 +69,7 @@ func (s *ScopedRepository) DuplicateGroups(scanID string) []actions.DuplicateGro
 		return nil
 	}
 
-	groups, err := s.actionsRepo.ListByScan(context.Background(), id)
+	groups, err := s.actionsRepo.ListByScan(s.context(context.Background()), id)
 	if err != nil {
 		return nil
 	}
@@ -83,7 +92,7 @@ func (s *ScopedRepository) GetDuplicateGroup(groupID string) (*actions.Duplicate
 		return nil, fmt.Errorf("%w: %v", actions.ErrInvalidGroupID, err)
 	}
 
-	group, err := s.actionsRepo.Get(context.Background(), id)
+	group, err := s.actionsRepo.Get(s.context(context.Background()), id)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,77 @@
+package tenancy
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// ErrInvalidInput is wrapped by every ValidationErrors value.
+var ErrInvalidInput = errors.New("invalid input")
+
+var (
+	slugPattern          = regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)
+	hostnameLabelPattern = regexp.MustCompile(`^[A-Za-z0-9](?:[A-Za-z0-9-]{0,61}[A-Za-z0-9])?$`)
+)
+
+// ValidationErrors maps input field names to human readable problems.
+type ValidationErrors map[string]string
+
+func (v ValidationErrors) Error() string {
+	fields := make([]string, 0, len(v))
+	for field := range v {
+		fields = append(fields, field)
+	}
+	slices.Sort(fields)
+	parts := make([]string, 0, len(fields))
+	for _, field := range fields {
+		parts = append(parts, fmt.Sprintf("%s: %s", field, v[field]))
+	}
+	return "invalid input: " + strings.Join(parts, "; ")
+}
+
+// Is lets callers match validation failures with errors.Is(err, ErrInvalidInput).
+func (v ValidationErrors) Is(target error) bool {
+	return target == ErrInvalidInput
+}
+
+func (v ValidationErrors) orNil() error {
+	if len(v) == 0 {
+		return nil
+	}
+	return v
+}
+
+// ValidateSlug checks that a tenant slug is lowercase, hyphen separated, and 3-63 characters long.
+func ValidateSlug(slug string) error {
+	switch {
+	case len(slug) < 3 || len(slug) > 63:
+		return errors.New("must be between 3 and 63 characters")
+	case !slugPattern.MatchString(slug):
+		return errors.New("must contain lowercase letters, digits, and single hyphens only")
+	}
+	return nil
+}
+
+// ValidateHostname checks a hostname against RFC 1123 label rules.
+func ValidateHostname(hostname string) error {
+	if len(hostname) > 253 {
+		return errors.New("must be at most 253 characters")
+	}
+	for _, label := range strings.Split(strings.TrimSuffix(hostname, "."), ".") {
+		if !hostnameLabelPattern.MatchString(label) {
+			return fmt.Errorf("label %q is not a valid hostname label", label)
+		}
+	}
+	return nil
+}
+
+// ValidateCategory checks that the machine category is supported.
+func ValidateCategory(category string) error {
+	if !slices.Contains(MachineCategories, category) {
+		return fmt.Errorf("must be one of %s", strings.Join(MachineCategories, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,22 @@
+// Package web holds the front-end sources and the built bundle compiled into the binary.
+package web
+
+import (
+	"embed"
+	"io/fs"
+)
+
+// dist is populated by `npm run build:tailwind` and `npm run build:htmx`
+// before `go build`; .gitkeep keeps the directory embeddable in a clean checkout.
+//
+//go:embed all:dist
+var dist embed.FS
+
+// Dist returns the embedded build output rooted at the dist directory.
+func Dist() fs.FS {
+	sub, err := fs.Sub(dist, "dist")
+	if err != nil {
+		panic(err)
+	}
+	return sub
+}
@@ -127,7 +127,7 @@ trap 'handle_signal_exit 143' TERM
 
 # Build the CLI once so follow-up commands use a direct binary (no lingering go run wrapper).
 build_cli() {
-  (cd backend && go build -o "$CLI_BIN" ./cmd/duplynx)
+  (cd backend && go build -tags sqlite_fts5 -o "$CLI_BIN" ./cmd/duplynx)
 }
 
 now_ns() {
@@ -0,0 +1,43 @@
+#!/usr/bin/env bash
+# Runs the Go test suite against a throwaway PostgreSQL server started from
+# the local initdb/pg_ctl binaries (set PG_BIN to their directory if they are
+# not on PATH). Extra arguments are passed to `go test`.
+set -euo pipefail
+
+SCRIPT_DIR=$(cd "$(dirname "${BASH_SOURCE[0]}")" && pwd)
+REPO_ROOT=$(cd "${SCRIPT_DIR}/.." && pwd)
+PG_BIN="${PG_BIN:-}"
+PG_PORT="${PG_PORT:-55432}"
+
+if [[ -z "$PG_BIN" ]]; then
+  if command -v pg_ctl >/dev/null 2>&1; then
+    PG_BIN=$(dirname "$(command -v pg_ctl)")
+  else
+    PG_BIN=$(ls -d /usr/lib/postgresql/*/bin 2>/dev/null | sort -V | tail -n1 || true)
+  fi
+fi
+if [[ -z "$PG_BIN" || ! -x "${PG_BIN}/initdb" ]]; then
+  echo "initdb/pg_ctl not found; install PostgreSQL or set PG_BIN." >&2
+  exit 1
+fi
+if [[ "$(id -u)" -eq 0 ]]; then
+  echo "PostgreSQL refuses to run as root; run this script as an unprivileged user." >&2
+  exit 1
+fi
+
+DATA_DIR=$(mktemp -d)
+cleanup() {
+  "${PG_BIN}/pg_ctl" -D "$DATA_DIR" -m immediate stop >/dev/null 2>&1 || true
+  rm -rf "$DATA_DIR"
+}
+trap cleanup EXIT
+
+"${PG_BIN}/initdb" -D "$DATA_DIR" -U duplynx --auth=trust >/dev/null
+"${PG_BIN}/pg_ctl" -D "$DATA_DIR" -l "${DATA_DIR}/server.log" -w \
+  -o "-p ${PG_PORT} -k ${DATA_DIR} -c listen_addresses=127.0.0.1" start >/dev/null
+
+export DUPLYNX_TEST_DB_DRIVER=postgres
+export DUPLYNX_TEST_POSTGRES_DSN="postgres://duplynx@127.0.0.1:${PG_PORT}/postgres?sslmode=disable"
+
+cd "${REPO_ROOT}/tests"
+go test -count=1 "$@" ./...
@@ -2,15 +2,19 @@ package contract_test
 
 import (
 	"bytes"
-	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
 	"github.com/mcmx/duplynx/internal/actions"
+	"github.com/mcmx/duplynx/internal/events"
 	apphttp "github.com/mcmx/duplynx/internal/http"
+	"github.com/mcmx/duplynx/internal/identity"
+	"github.com/mcmx/duplynx/internal/reclaim"
+	"github.com/mcmx/duplynx/internal/scandiff"
 	"github.com/mcmx/duplynx/internal/scans"
+	"github.com/mcmx/duplynx/internal/search"
 	"github.com/mcmx/duplynx/internal/tenancy"
 	"github.com/mcmx/duplynx/tests/testutil"
 )
@@ -20,6 +24,7 @@ type actionsHarness struct {
 	audit   *actions.AuditLogger
 	dataset testutil.SeededClient
 	repo    *actions.Repository
+	bus     *events.Bus
 }
 
 func setupActionsRouter(t *testing.T) actionsHarness {
@@ -29,12 +34,19 @@ func setupActionsRouter(t *testing.T) actionsHarness {
 	audit := &actions.AuditLogger{}
 	actionsRepo := actions.NewRepositoryFromClient(seed.Client)
 	dispatcher := actions.NewDispatcher(actionsRepo, audit)
+	bus := events.NewBus(events.DefaultBuffer)
+	dispatcher.Events = bus
 
 	router := apphttp.NewRouter(apphttp.Dependencies{
 		TenancyRepo:       tenancy.NewRepositoryFromClient(seed.Client, &tenancy.AuditLogger{}),
 		ScanRepo:          scans.NewRepositoryFromClient(seed.Client),
 		ActionsRepo:       actionsRepo,
 		ActionsDispatcher: dispatcher,
+		Events:            bus,
+		Reclaim:           reclaim.NewRepositoryFromClient(seed.Client),
+		Search:            search.NewRepositoryFromClient(seed.Client),
+		Identities:        identity.NewRepositoryFromClient(seed.Client),
+		ScanDiff:          scandiff.NewRepositoryFromClient(seed.Client),
 	})
 
 	server := httptest.NewServer(router)
@@ -45,6 +57,7 @@ func setupActionsRouter(t *testing.T) actionsHarness {
 		audit:   audit,
 		dataset: seed,
 		repo:    actionsRepo,
+		bus:     bus,
 	}
 }
 
@@ -83,7 +96,7 @@ func TestAssignKeeperContract(t *testing.T) {
 		t.Fatalf("expected assign_keeper audit entry, got %#v", harness.audit.Entries())
 	}
 
-	updated, err := harness.repo.Get(context.Background(), group.ID)
+	updated, err := harness.repo.Get(testutil.TenantContext(group.TenantID), group.ID)
 	if err != nil {
 		t.Fatalf("reload duplicate group: %v", err)
 	}
@@ -129,7 +142,7 @@ func TestActionEndpointContract(t *testing.T) {
 		t.Fatalf("expected quarantine audit entry, got %#v", harness.audit.Entries())
 	}
 
-	updated, err := harness.repo.Get(context.Background(), group.ID)
+	updated, err := harness.repo.Get(testutil.TenantContext(group.TenantID), group.ID)
 	if err != nil {
 		t.Fatalf("reload duplicate group: %v", err)
 	}
@@ -0,0 +1,184 @@
+package contract_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	apphttp "github.com/mcmx/duplynx/internal/http"
+	"github.com/mcmx/duplynx/internal/tenancy"
+	"github.com/mcmx/duplynx/tests/testutil"
+)
+
+func setupAdminRouter(t *testing.T) (*httptest.Server, *tenancy.Repository) {
+	t.Helper()
+	seed := testutil.NewSeededClient(t)
+	repo := tenancy.NewRepositoryFromClient(seed.Client, &tenancy.AuditLogger{})
+	server := httptest.NewServer(apphttp.NewRouter(apphttp.Dependencies{TenancyRepo: repo}))
+	t.Cleanup(server.Close)
+	return server, repo
+}
+
+func postJSON(t *testing.T, url string, body any) *http.Response {
+	t.Helper()
+	payload, _ := json.Marshal(body)
+	resp, err := http.Post(url, "application/json", bytes.NewReader(payload))
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	t.Cleanup(func() { _ = resp.Body.Close() })
+	return resp
+}
+
+func TestAdminTenantLifecycle(t *testing.T) {
+	server, repo := setupAdminRouter(t)
+
+	resp := postJSON(t, server.URL+"/admin/tenants", map[string]string{"slug": "Bad Slug!", "name": ""})
+	if resp.StatusCode != http.StatusUnprocessableEntity {
+		t.Fatalf("expected 422 for invalid tenant, got %d", resp.StatusCode)
+	}
+	var verr struct {
+		Fields map[string]string `json:"fields"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&verr); err != nil {
+		t.Fatalf("decode validation error: %v", err)
+	}
+	if verr.Fields["slug"] == "" || verr.Fields["name"] == "" {
+		t.Fatalf("expected slug and name errors, got %#v", verr.Fields)
+	}
+
+	resp = postJSON(t, server.URL+"/admin/tenants", map[string]string{"slug": "vega-labs", "name": "Vega Labs"})
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", resp.StatusCode)
+	}
+	resp = postJSON(t, server.URL+"/admin/tenants", map[string]string{"slug": "vega-labs", "name": "Vega Labs"})
+	if resp.StatusCode != http.StatusConflict {
+		t.Fatalf("expected 409 for duplicate slug, got %d", resp.StatusCode)
+	}
+
+	resp = postJSON(t, server.URL+"/admin/tenants/vega-labs/machines", map[string]string{
+		"name": "Vega NAS", "category": "nas", "hostname": "bad_host!.vega.test",
+	})
+	if resp.StatusCode != http.StatusUnprocessableEntity {
+		t.Fatalf("expected 422 for invalid hostname, got %d", resp.StatusCode)
+	}
+
+	resp = postJSON(t, server.URL+"/admin/tenants/vega-labs/machines", map[string]string{
+		"name": "Vega NAS", "category": "nas", "hostname": "nas-01.vega.test",
+	})
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected 201 for machine, got %d", resp.StatusCode)
+	}
+	var created struct {
+		ID       string `json:"id"`
+		Category string `json:"category"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&created); err != nil {
+		t.Fatalf("decode machine: %v", err)
+	}
+	if created.Category != "nas" {
+		t.Fatalf("expected nas category, got %q", created.Category)
+	}
+
+	tenant, ok := repo.Tenant("vega-labs")
+	if !ok {
+		t.Fatalf("expected vega-labs to resolve")
+	}
+	ctx := tenancy.WithScope(context.Background(), tenancy.Scope{TenantSlug: tenant.Slug, TenantID: tenant.ID})
+	machines, err := repo.ListMachines(ctx, "vega-labs")
+	if err != nil || len(machines) != 1 {
+		t.Fatalf("expected one machine via tenancy repository, got %d (%v)", len(machines), err)
+	}
+
+	resp = postJSON(t, server.URL+"/admin/tenants/vega-labs/machines/"+created.ID+"/archive", nil)
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("expected 204 archiving machine, got %d", resp.StatusCode)
+	}
+	machines, _ = repo.ListMachines(ctx, "vega-labs")
+	if len(machines) != 0 {
+		t.Fatalf("expected archived machine to be hidden, got %d", len(machines))
+	}
+
+	resp = postJSON(t, server.URL+"/admin/tenants/vega-labs/archive", nil)
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("expected 204 archiving tenant, got %d", resp.StatusCode)
+	}
+	if _, ok := repo.Tenant("vega-labs"); ok {
+		t.Fatalf("expected archived tenant to be excluded from scope resolution")
+	}
+	all, _ := repo.ListTenants(ctx, tenancy.IncludeArchived())
+	var found bool
+	for _, tenant := range all {
+		if tenant.Slug == "vega-labs" && tenant.Archived() {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("expected archived tenant when including archived")
+	}
+}
+
+func TestAdminFormRendersInlineErrors(t *testing.T) {
+	server, _ := setupAdminRouter(t)
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}
+
+	form := url.Values{"slug": {"x"}, "name": {"X"}}
+	resp, err := client.PostForm(server.URL+"/admin/tenants", form)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+	var body bytes.Buffer
+	_, _ = body.ReadFrom(resp.Body)
+	if resp.StatusCode != http.StatusUnprocessableEntity || !strings.Contains(body.String(), `role="alert"`) {
+		t.Fatalf("expected inline validation error, got %d", resp.StatusCode)
+	}
+
+	form = url.Values{"slug": {"lyra-studio"}, "name": {"Lyra Studio"}}
+	resp, err = client.PostForm(server.URL+"/admin/tenants", form)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusSeeOther || resp.Header.Get("Location") != "/admin/tenants/lyra-studio" {
+		t.Fatalf("expected redirect to tenant page, got %d %s", resp.StatusCode, resp.Header.Get("Location"))
+	}
+}
+
+func TestAdminEditFormsRenderInlineErrors(t *testing.T) {
+	server, repo := setupAdminRouter(t)
+	tenants, err := repo.ListTenants(context.Background())
+	if err != nil || len(tenants) == 0 || len(tenants[0].Machines) == 0 {
+		t.Fatalf("expected seeded tenants with machines: %v", err)
+	}
+	tenant := tenants[0]
+	machine := tenant.Machines[0]
+
+	for _, tc := range []struct {
+		path string
+		form url.Values
+		want string
+	}{
+		{"/admin/tenants/" + tenant.Slug, url.Values{"name": {""}, "description": {"kept description"}}, "kept description"},
+		{"/admin/tenants/" + tenant.Slug + "/machines/" + machine.ID, url.Values{"name": {""}, "category": {"server"}, "hostname": {"kept-host"}}, "kept-host"},
+	} {
+		resp, err := http.PostForm(server.URL+tc.path, tc.form)
+		if err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+		var body bytes.Buffer
+		_, _ = body.ReadFrom(resp.Body)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusUnprocessableEntity || !strings.HasPrefix(resp.Header.Get("Content-Type"), "text/html") {
+			t.Fatalf("%s: expected the HTML page back, got %d %s", tc.path, resp.StatusCode, resp.Header.Get("Content-Type"))
+		}
+		if !strings.Contains(body.String(), `role="alert"`) || !strings.Contains(body.String(), tc.want) {
+			t.Fatalf("%s: expected inline errors next to the submitted values", tc.path)
+		}
+	}
+}
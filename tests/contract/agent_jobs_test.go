@@ -0,0 +1,270 @@
+package contract_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/mcmx/duplynx/internal/actions"
+	apphttp "github.com/mcmx/duplynx/internal/http"
+	"github.com/mcmx/duplynx/internal/http/handlers"
+	"github.com/mcmx/duplynx/internal/ingestion"
+	"github.com/mcmx/duplynx/internal/scans"
+	"github.com/mcmx/duplynx/internal/tenancy"
+	"github.com/mcmx/duplynx/tests/testutil"
+)
+
+func TestAgentJobsContract(t *testing.T) {
+	seed := testutil.NewSeededClient(t)
+	tenant := seed.Dataset.Tenants[0]
+	secretRepo := ingestion.NewSecretRepositoryFromClient(seed.Client)
+	router := apphttp.NewRouter(apphttp.Dependencies{
+		TenancyRepo:         tenancy.NewRepositoryFromClient(seed.Client, &tenancy.AuditLogger{}),
+		ScanRepo:            scans.NewRepositoryFromClient(seed.Client),
+		SecretRepo:          secretRepo,
+		LegacyTenantSecrets: map[string]string{tenant.Slug: lifecycleSecret},
+	})
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+
+	machines := testutil.MachineIDsForTenant(seed.Dataset, tenant.ID)
+	if online := machineStatus(t, server.URL, tenant.Slug, machines[0].String()); online.Online || online.LastSeenAt != nil {
+		t.Fatalf("expected a machine without an agent to be offline, got %+v", online)
+	}
+
+	secret := machineSecret(t, secretRepo, tenant.Slug, machines[0])
+	var polled ingestion.JobsResponse
+	if status := postSigned(t, server.URL+"/ingest/jobs", tenant.Slug, ingestion.JobsRequest{MachineID: machines[0].String(), Capacity: 1}, &polled); status != http.StatusForbidden {
+		t.Fatalf("expected the tenant-wide secret to be refused, got %d", status)
+	}
+	if status := postSignedWith(t, server.URL+"/ingest/jobs", tenant.Slug, secret, ingestion.JobsRequest{MachineID: machines[1].String(), Capacity: 1}, &polled); status != http.StatusForbidden {
+		t.Fatalf("expected a poll for another machine to be refused, got %d", status)
+	}
+	if online := machineStatus(t, server.URL, tenant.Slug, machines[1].String()); online.LastSeenAt != nil {
+		t.Fatalf("expected a refused poll not to mark the machine seen, got %+v", online)
+	}
+
+	// A poll at capacity is only a heartbeat.
+	if status := postSignedWith(t, server.URL+"/ingest/jobs", tenant.Slug, secret, ingestion.JobsRequest{MachineID: machines[0].String()}, &polled); status != http.StatusOK || len(polled.Jobs) != 0 {
+		t.Fatalf("expected an empty heartbeat poll, got %d %+v", status, polled)
+	}
+	if online := machineStatus(t, server.URL, tenant.Slug, machines[0].String()); !online.Online || online.LastSeenAt == nil {
+		t.Fatalf("expected the polling machine to be online, got %+v", online)
+	}
+
+	status, created := createCampaign(t, server.URL, tenant.Slug, map[string]any{
+		"name": "Agent Sweep",
+		"targets": []map[string]any{
+			{"machineId": machines[0].String(), "roots": []string{"/srv"}, "exclusions": []string{"*.tmp"}},
+		},
+	})
+	if status != http.StatusCreated {
+		t.Fatalf("expected campaign to be created, got %d", status)
+	}
+
+	start := time.Now()
+	if status := postSignedWith(t, server.URL+"/ingest/jobs", tenant.Slug, secret, ingestion.JobsRequest{MachineID: machines[0].String(), Capacity: 2, WaitSeconds: 5}, &polled); status != http.StatusOK {
+		t.Fatalf("expected jobs, got %d", status)
+	}
+	if time.Since(start) > 3*time.Second {
+		t.Fatalf("a poll with pending work should answer at once, took %s", time.Since(start))
+	}
+	if len(polled.Jobs) != 1 || polled.Jobs[0].Type != ingestion.JobScan || polled.Jobs[0].Scan.ScanID != created.ID || polled.Jobs[0].Scan.Exclusions[0] != "*.tmp" {
+		t.Fatalf("unexpected jobs %+v", polled)
+	}
+
+	// Jobs the agent is running are not handed out again; the wait runs out instead.
+	polled = ingestion.JobsResponse{}
+	start = time.Now()
+	if status := postSignedWith(t, server.URL+"/ingest/jobs", tenant.Slug, secret, ingestion.JobsRequest{MachineID: machines[0].String(), Capacity: 1, Running: []string{created.ID}, WaitSeconds: 1}, &polled); status != http.StatusOK || len(polled.Jobs) != 0 {
+		t.Fatalf("expected no new jobs, got %d %+v", status, polled)
+	}
+	if waited := time.Since(start); waited < 900*time.Millisecond {
+		t.Fatalf("expected the poll to wait, answered after %s", waited)
+	}
+
+	// A machine's secret only reports progress for scans the machine is part of.
+	intruder := machineSecret(t, secretRepo, tenant.Slug, machines[1])
+	var beat ingestion.HeartbeatResponse
+	if status := postSignedWith(t, server.URL+"/ingest/heartbeat", tenant.Slug, intruder, ingestion.HeartbeatRequest{ScanID: created.ID, FilesSeen: 1}, &beat); status != http.StatusNotFound {
+		t.Fatalf("expected a heartbeat from a machine outside the scan to 404, got %d", status)
+	}
+	if status := postSignedWith(t, server.URL+"/ingest/heartbeat", tenant.Slug, secret, ingestion.HeartbeatRequest{ScanID: created.ID, FilesSeen: 1}, &beat); status != http.StatusOK {
+		t.Fatalf("expected the target's heartbeat to be accepted, got %d", status)
+	}
+
+	var reported ingestion.HeartbeatResponse
+	report := ingestion.ReportRequest{ScanID: created.ID, MachineID: machines[0].String(), Status: scans.TargetReported}
+	if status := postSignedWith(t, server.URL+"/ingest/report", tenant.Slug, secret, report, &reported); status != http.StatusOK || reported.Status != string(scans.StatusCompleted) {
+		t.Fatalf("expected the scan to complete, got %d %+v", status, reported)
+	}
+	if online := machineStatus(t, server.URL, tenant.Slug, machines[0].String()); online.LastScanAt == nil {
+		t.Fatalf("expected the machine list to show the last scan, got %+v", online)
+	}
+}
+
+func machineStatus(t *testing.T, baseURL, tenantSlug, machineID string) handlers.MachineSummary {
+	t.Helper()
+	req, _ := http.NewRequest(http.MethodGet, baseURL+"/tenants/"+tenantSlug+"/machines", nil)
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set(tenancy.HeaderTenantSlug, tenantSlug)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("list machines: %v", err)
+	}
+	defer resp.Body.Close()
+	var payload struct {
+		Machines []handlers.MachineSummary `json:"machines"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
+		t.Fatalf("decode machines: %v", err)
+	}
+	for _, machine := range payload.Machines {
+		if machine.ID == machineID {
+			return machine
+		}
+	}
+	t.Fatalf("machine %s not listed", machineID)
+	return handlers.MachineSummary{}
+}
+
+func TestAgentActionJobsContract(t *testing.T) {
+	seed := testutil.NewSeededClient(t)
+	tenant := seed.Dataset.Tenants[0]
+	ctx := testutil.TenantContext(tenant.ID)
+	secretRepo := ingestion.NewSecretRepositoryFromClient(seed.Client)
+	actionsRepo := actions.NewRepositoryFromClient(seed.Client)
+	dispatcher := actions.NewDispatcher(actionsRepo, &actions.AuditLogger{})
+	dispatcher.RunOnAgents = true
+	server := httptest.NewServer(apphttp.NewRouter(apphttp.Dependencies{
+		TenancyRepo:         tenancy.NewRepositoryFromClient(seed.Client, &tenancy.AuditLogger{}),
+		ScanRepo:            scans.NewRepositoryFromClient(seed.Client),
+		ActionsRepo:         actionsRepo,
+		ActionsDispatcher:   dispatcher,
+		SecretRepo:          secretRepo,
+		LegacyTenantSecrets: map[string]string{tenant.Slug: lifecycleSecret},
+	}))
+	t.Cleanup(server.Close)
+
+	var groupID uuid.UUID
+	for _, fixture := range seed.Dataset.DuplicateGroups {
+		if fixture.TenantID == tenant.ID {
+			groupID = fixture.ID
+			break
+		}
+	}
+	group, err := actionsRepo.Get(ctx, groupID)
+	if err != nil {
+		t.Fatalf("load duplicate group: %v", err)
+	}
+	holders := group.CopyHolders()
+	if len(holders) < 2 {
+		t.Fatalf("expected copies on several machines, got %+v", holders)
+	}
+	holder := uuid.MustParse(holders[len(holders)-1].ID)
+	other := uuid.MustParse(holders[0].ID)
+	var holderPaths []string
+	for _, file := range group.Files {
+		if file.MachineID == holder.String() {
+			holderPaths = append(holderPaths, file.Path)
+		}
+	}
+
+	if err := dispatcher.PerformAction(ctx, group.ID, tenant.Slug, "ops@example.com", actions.ActionQuarantine, nil); err != nil {
+		t.Fatalf("queue quarantine: %v", err)
+	}
+	if files, err := actionsRepo.Get(ctx, groupID); err != nil || files.Files[0].Quarantined {
+		t.Fatalf("expected copies to stay in place until an agent reports, got %+v (%v)", files.Files, err)
+	}
+
+	secret := machineSecret(t, secretRepo, tenant.Slug, holder)
+	var polled ingestion.JobsResponse
+	if status := postSignedWith(t, server.URL+"/ingest/jobs", tenant.Slug, secret, ingestion.JobsRequest{MachineID: other.String(), Capacity: 1}, &polled); status != http.StatusForbidden {
+		t.Fatalf("expected a poll for another machine to be refused, got %d", status)
+	}
+	if status := postSignedWith(t, server.URL+"/ingest/jobs", tenant.Slug, secret, ingestion.JobsRequest{MachineID: holder.String(), Capacity: 2}, &polled); status != http.StatusOK {
+		t.Fatalf("expected jobs, got %d", status)
+	}
+	if len(polled.Jobs) != 1 || polled.Jobs[0].Type != ingestion.JobAction || polled.Jobs[0].Action == nil {
+		t.Fatalf("expected the holder machine to receive one action job, got %+v", polled)
+	}
+	job := polled.Jobs[0]
+	if job.Action.GroupID != group.ID || job.Action.Action != string(actions.ActionQuarantine) || job.Action.Hash != group.Hash ||
+		strings.Join(job.Action.Paths, ",") != strings.Join(holderPaths, ",") {
+		t.Fatalf("expected the holder's own copies, got %+v (want %v)", job.Action, holderPaths)
+	}
+
+	// A job the agent is running is not handed out again.
+	polled = ingestion.JobsResponse{}
+	if status := postSignedWith(t, server.URL+"/ingest/jobs", tenant.Slug, secret, ingestion.JobsRequest{MachineID: holder.String(), Capacity: 1, Running: []string{job.ID}}, &polled); status != http.StatusOK || len(polled.Jobs) != 0 {
+		t.Fatalf("expected no jobs while the action runs, got %d %+v", status, polled)
+	}
+
+	report := ingestion.ActionReportRequest{JobID: job.ID, MachineID: holder.String(), Status: ingestion.ActionSucceeded, Done: holderPaths}
+	if status := postSigned(t, server.URL+"/ingest/action-report", tenant.Slug, report, nil); status != http.StatusForbidden {
+		t.Fatalf("expected the tenant-wide secret to be refused, got %d", status)
+	}
+	otherSecret := machineSecret(t, secretRepo, tenant.Slug, other)
+	stolen := report
+	stolen.MachineID = other.String()
+	if status := postSignedWith(t, server.URL+"/ingest/action-report", tenant.Slug, otherSecret, stolen, nil); status != http.StatusNotFound {
+		t.Fatalf("expected another machine's report on the job to be refused, got %d", status)
+	}
+	if status := postSignedWith(t, server.URL+"/ingest/action-report", tenant.Slug, secret, report, nil); status != http.StatusNoContent {
+		t.Fatalf("expected the report to be accepted, got %d", status)
+	}
+	if status := postSignedWith(t, server.URL+"/ingest/action-report", tenant.Slug, secret, report, nil); status != http.StatusConflict {
+		t.Fatalf("expected a repeated report to conflict, got %d", status)
+	}
+
+	updated, err := actionsRepo.Get(ctx, groupID)
+	if err != nil {
+		t.Fatalf("reload duplicate group: %v", err)
+	}
+	for _, file := range updated.Files {
+		if file.Quarantined != (file.MachineID == holder.String()) {
+			t.Fatalf("expected only the reporting machine's copies quarantined, got %+v", updated.Files)
+		}
+	}
+	timeline, err := actionsRepo.Timeline(ctx, groupID)
+	if err != nil {
+		t.Fatalf("load timeline: %v", err)
+	}
+	types := make([]string, 0, len(timeline))
+	for _, entry := range timeline {
+		types = append(types, entry.Type)
+		if entry.Type == string(actions.ActionQuarantine) {
+			t.Fatalf("expected the action to be audited only once every machine reported, got %+v", timeline)
+		}
+	}
+	if len(types) == 0 || types[len(types)-1] != actions.AuditActionQueued {
+		t.Fatalf("expected the queued action on the timeline, got %v", types)
+	}
+
+	// Once the remaining holders report, the action itself is audited.
+	for _, machine := range holders[:len(holders)-1] {
+		machineID := uuid.MustParse(machine.ID)
+		holderSecret := machineSecret(t, secretRepo, tenant.Slug, machineID)
+		polled = ingestion.JobsResponse{}
+		if status := postSignedWith(t, server.URL+"/ingest/jobs", tenant.Slug, holderSecret, ingestion.JobsRequest{MachineID: machine.ID, Capacity: 1}, &polled); status != http.StatusOK || len(polled.Jobs) != 1 {
+			t.Fatalf("expected %s to receive its action job, got %d %+v", machine.Name, status, polled)
+		}
+		done := ingestion.ActionReportRequest{JobID: polled.Jobs[0].ID, MachineID: machine.ID, Status: ingestion.ActionSucceeded, Done: polled.Jobs[0].Action.Paths}
+		if status := postSignedWith(t, server.URL+"/ingest/action-report", tenant.Slug, holderSecret, done, nil); status != http.StatusNoContent {
+			t.Fatalf("expected %s's report to be accepted, got %d", machine.Name, status)
+		}
+	}
+	timeline, err = actionsRepo.Timeline(ctx, groupID)
+	if err != nil {
+		t.Fatalf("load timeline: %v", err)
+	}
+	last := timeline[len(timeline)-1]
+	if last.Type != string(actions.ActionQuarantine) || last.Stubbed || last.Actor != "ops@example.com" {
+		t.Fatalf("expected the finished quarantine audited for its actor, got %+v", last)
+	}
+}
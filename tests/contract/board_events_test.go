@@ -0,0 +1,106 @@
+package contract_test
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mcmx/duplynx/internal/tenancy"
+	"github.com/mcmx/duplynx/tests/testutil"
+)
+
+// openBoardEvents connects to a scan's event stream and waits until the
+// subscription is registered on the bus.
+func openBoardEvents(t *testing.T, harness actionsHarness, scanID, tenantSlug string) (*http.Response, *bufio.Reader) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, harness.server.URL+"/scans/"+scanID+"/events", nil)
+	req.Header.Set(tenancy.HeaderTenantSlug, tenantSlug)
+	before := harness.bus.Subscribers()
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	t.Cleanup(func() { _ = resp.Body.Close() })
+	if resp.StatusCode == http.StatusOK {
+		for deadline := time.Now().Add(2 * time.Second); harness.bus.Subscribers() == before; {
+			if time.Now().After(deadline) {
+				t.Fatalf("event stream never subscribed")
+			}
+			time.Sleep(5 * time.Millisecond)
+		}
+	}
+	return resp, bufio.NewReader(resp.Body)
+}
+
+// nextEvent reads SSE lines until a named event is complete, skipping comments.
+func nextEvent(t *testing.T, reader *bufio.Reader) (string, string) {
+	t.Helper()
+	var name string
+	var data []string
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			t.Fatalf("read event stream: %v", err)
+		}
+		line = strings.TrimRight(line, "\n")
+		switch {
+		case line == "" && name != "":
+			return name, strings.Join(data, "\n")
+		case strings.HasPrefix(line, "event: "):
+			name = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			data = append(data, strings.TrimPrefix(line, "data: "))
+		}
+	}
+}
+
+func TestBoardEventsStreamChangedCards(t *testing.T) {
+	harness := setupActionsRouter(t)
+	group := harness.dataset.Dataset.DuplicateGroups[0]
+	tenantSlug := testutil.TenantSlugFor(t, harness.dataset.Dataset, group.TenantID)
+
+	resp, reader := openBoardEvents(t, harness, group.ScanID.String(), tenantSlug)
+	if resp.StatusCode != http.StatusOK || resp.Header.Get("Content-Type") != "text/event-stream" {
+		t.Fatalf("expected an event stream, got %d %q", resp.StatusCode, resp.Header.Get("Content-Type"))
+	}
+
+	form := url.Values{"actionType": {"quarantine"}}
+	if resp, body := postCardForm(t, harness, "/duplicate-groups/"+group.ID.String()+"/actions", tenantSlug, form, true); resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected action to succeed, got %d: %s", resp.StatusCode, body)
+	}
+
+	name, data := nextEvent(t, reader)
+	if name != "card-"+group.ID.String() {
+		t.Fatalf("expected card event for %s, got %q", group.ID, name)
+	}
+	if !strings.Contains(data, `id="card-`+group.ID.String()+`"`) || !strings.Contains(data, "(quarantined)") {
+		t.Fatalf("expected the re-rendered card, got %s", data)
+	}
+}
+
+func TestBoardEventsRejectForeignScan(t *testing.T) {
+	harness := setupActionsRouter(t)
+	dataset := harness.dataset.Dataset
+	group := dataset.DuplicateGroups[0]
+	var foreignSlug string
+	for _, tenant := range dataset.Tenants {
+		if tenant.ID != group.TenantID {
+			foreignSlug = tenant.Slug
+			break
+		}
+	}
+
+	resp, _ := openBoardEvents(t, harness, group.ScanID.String(), foreignSlug)
+	if resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected 404 for another tenant's scan, got %d", resp.StatusCode)
+	}
+	if harness.bus.Subscribers() != 0 {
+		t.Fatalf("expected no subscription for a rejected stream")
+	}
+}
@@ -0,0 +1,128 @@
+package contract_test
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	entmachine "github.com/mcmx/duplynx/ent/machine"
+	"github.com/mcmx/duplynx/internal/tenancy"
+	"github.com/mcmx/duplynx/tests/testutil"
+)
+
+func postCardForm(t *testing.T, harness actionsHarness, path, tenantSlug string, form url.Values, htmx bool) (*http.Response, string) {
+	t.Helper()
+	req, _ := http.NewRequest(http.MethodPost, harness.server.URL+path, strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set(tenancy.HeaderTenantSlug, tenantSlug)
+	if htmx {
+		req.Header.Set("HX-Request", "true")
+	}
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	t.Cleanup(func() { _ = resp.Body.Close() })
+	body, _ := io.ReadAll(resp.Body)
+	return resp, string(body)
+}
+
+func TestKeeperFormReturnsCardFragment(t *testing.T) {
+	harness := setupActionsRouter(t)
+	group := harness.dataset.Dataset.DuplicateGroups[0]
+	tenantSlug := testutil.TenantSlugFor(t, harness.dataset.Dataset, group.TenantID)
+	holders := map[string]bool{}
+	var keeper string
+	for _, file := range harness.dataset.Dataset.FileInstances {
+		if file.DuplicateGroupID == group.ID {
+			holders[file.MachineID.String()] = true
+			keeper = file.MachineID.String()
+		}
+	}
+	var keeperName string
+	for _, machine := range harness.dataset.Dataset.Machines {
+		if machine.ID.String() == keeper {
+			keeperName = machine.Name
+		}
+	}
+	path := "/duplicate-groups/" + group.ID.String() + "/keeper"
+
+	resp, body := postCardForm(t, harness, path, tenantSlug, url.Values{"keeperMachineId": {keeper}}, true)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", resp.StatusCode, body)
+	}
+	if !strings.Contains(body, `class="duplicate-card`) || strings.Contains(body, "<html") {
+		t.Fatalf("expected a bare card fragment, got %s", body)
+	}
+	if !strings.Contains(body, "Keeper: "+keeperName) || !strings.Contains(body, "Keeper assigned") {
+		t.Fatalf("expected re-rendered card with keeper name, got %s", body)
+	}
+	if !strings.Contains(body, `<option value="`+keeper+`" selected>`) {
+		t.Fatalf("expected keeper picker to preselect the keeper, got %s", body)
+	}
+	for _, machine := range harness.dataset.Dataset.Machines {
+		if !holders[machine.ID.String()] && strings.Contains(body, `value="`+machine.ID.String()+`"`) {
+			t.Fatalf("keeper picker offered machine %s that holds no copy", machine.Name)
+		}
+	}
+
+	resp, body = postCardForm(t, harness, path, tenantSlug, url.Values{"keeperMachineId": {""}}, true)
+	if resp.StatusCode != http.StatusUnprocessableEntity {
+		t.Fatalf("expected 422 for missing keeper, got %d", resp.StatusCode)
+	}
+	if !strings.Contains(body, `role="alert"`) || !strings.Contains(body, "keeper machine id required") {
+		t.Fatalf("expected inline validation error, got %s", body)
+	}
+
+	// A machine of the same tenant that holds no copy cannot keep the file.
+	outsider, err := harness.dataset.Client.Machine.Create().
+		SetName("Spare-Workstation").
+		SetCategory(entmachine.CategoryWorkstation).
+		Save(testutil.TenantContext(group.TenantID))
+	if err != nil {
+		t.Fatalf("create machine: %v", err)
+	}
+	resp, body = postCardForm(t, harness, path, tenantSlug, url.Values{"keeperMachineId": {outsider.ID.String()}}, true)
+	if resp.StatusCode != http.StatusUnprocessableEntity || !strings.Contains(body, "holds no copy") {
+		t.Fatalf("expected 422 for a keeper that holds no copy, got %d: %s", resp.StatusCode, body)
+	}
+
+	resp, _ = postCardForm(t, harness, path, tenantSlug, url.Values{"keeperMachineId": {keeper}}, false)
+	if resp.StatusCode != http.StatusSeeOther || resp.Header.Get("Location") != "/scans/"+group.ScanID.String() {
+		t.Fatalf("expected redirect to the board for plain form posts, got %d %q", resp.StatusCode, resp.Header.Get("Location"))
+	}
+}
+
+func TestActionFormRunsDispatcher(t *testing.T) {
+	harness := setupActionsRouter(t)
+	group := harness.dataset.Dataset.DuplicateGroups[1]
+	tenantSlug := testutil.TenantSlugFor(t, harness.dataset.Dataset, group.TenantID)
+
+	resp, body := postCardForm(t, harness, "/duplicate-groups/"+group.ID.String()+"/actions", tenantSlug,
+		url.Values{"actionType": {"bogus"}}, true)
+	if resp.StatusCode != http.StatusUnprocessableEntity || !strings.Contains(body, "unsupported action type") {
+		t.Fatalf("expected inline error for unknown action, got %d: %s", resp.StatusCode, body)
+	}
+
+	resp, body = postCardForm(t, harness, "/duplicate-groups/"+group.ID.String()+"/htmx", tenantSlug,
+		url.Values{"actionType": {"quarantine"}}, false)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200 from htmx endpoint, got %d: %s", resp.StatusCode, body)
+	}
+	if !strings.Contains(body, "(quarantined)") || !strings.Contains(body, "Copies quarantined") {
+		t.Fatalf("expected quarantined files in re-rendered card, got %s", body)
+	}
+
+	updated, err := harness.repo.Get(testutil.TenantContext(group.TenantID), group.ID)
+	if err != nil {
+		t.Fatalf("reload group: %v", err)
+	}
+	for _, file := range updated.Files {
+		if !file.Quarantined {
+			t.Fatalf("expected dispatcher to quarantine %s", file.ID)
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package contract_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/mcmx/duplynx/internal/http/handlers"
+	"github.com/mcmx/duplynx/internal/tenancy"
+	"github.com/mcmx/duplynx/tests/testutil"
+)
+
+func getGroupPage(t *testing.T, harness actionsHarness, path, tenantSlug, accept string) (*http.Response, string) {
+	t.Helper()
+	req, _ := http.NewRequest(http.MethodGet, harness.server.URL+path, nil)
+	req.Header.Set(tenancy.HeaderTenantSlug, tenantSlug)
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	t.Cleanup(func() { _ = resp.Body.Close() })
+	body, _ := io.ReadAll(resp.Body)
+	return resp, string(body)
+}
+
+func sendNoteJSON(t *testing.T, harness actionsHarness, method, path, tenantSlug string, payload map[string]any) (*http.Response, handlers.NoteResponse) {
+	t.Helper()
+	raw, _ := json.Marshal(payload)
+	req, _ := http.NewRequest(method, harness.server.URL+path, bytes.NewReader(raw))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(tenancy.HeaderTenantSlug, tenantSlug)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+	var note handlers.NoteResponse
+	if resp.StatusCode < 300 {
+		if err := json.NewDecoder(resp.Body).Decode(&note); err != nil {
+			t.Fatalf("decode note: %v", err)
+		}
+	}
+	return resp, note
+}
+
+func TestGroupDetailPageShowsFilesAndTimeline(t *testing.T) {
+	harness := setupActionsRouter(t)
+	group := harness.dataset.Dataset.DuplicateGroups[0]
+	tenantSlug := testutil.TenantSlugFor(t, harness.dataset.Dataset, group.TenantID)
+	path := "/duplicate-groups/" + group.ID.String()
+
+	resp, body := getGroupPage(t, harness, path, tenantSlug, "text/html")
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", resp.StatusCode, body)
+	}
+	for _, file := range harness.dataset.Dataset.FileInstances {
+		if file.DuplicateGroupID == group.ID && !strings.Contains(body, `data-file="`+file.ID.String()+`"`) {
+			t.Fatalf("expected file %s in the file table", file.ID)
+		}
+	}
+	for _, audit := range harness.dataset.Dataset.ActionAudits {
+		if audit.DuplicateGroupID == group.ID && !strings.Contains(body, `data-audit="`+audit.ActionType+`"`) {
+			t.Fatalf("expected seeded %s audit in the timeline", audit.ActionType)
+		}
+	}
+	if !strings.Contains(body, `data-sighting="`+group.ID.String()+`"`) {
+		t.Fatal("expected the seeded group's content history on the page")
+	}
+
+	// Actions taken from the board land in the timeline.
+	form := url.Values{"actionType": {"quarantine"}}
+	if resp, body := postCardForm(t, harness, path+"/actions", tenantSlug, form, true); resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected action to succeed, got %d: %s", resp.StatusCode, body)
+	}
+	resp, body = getGroupPage(t, harness, path, tenantSlug, "application/json")
+	var detail handlers.GroupDetailResponse
+	if err := json.Unmarshal([]byte(body), &detail); err != nil {
+		t.Fatalf("expected JSON detail: %v", err)
+	}
+	if detail.History == nil || len(detail.History.Sightings) != 1 || !detail.History.Sightings[0].Current {
+		t.Fatalf("expected one current sighting in the JSON history, got %+v", detail.History)
+	}
+	last := detail.Timeline[len(detail.Timeline)-1]
+	if last.Type != "quarantine" || !last.Stubbed {
+		t.Fatalf("expected quarantine at the end of the timeline, got %+v", last)
+	}
+	for _, file := range detail.Files {
+		if !file.Quarantined {
+			t.Fatalf("expected file %s to be quarantined", file.ID)
+		}
+	}
+}
+
+func TestGroupNotesKeepEditHistory(t *testing.T) {
+	harness := setupActionsRouter(t)
+	group := harness.dataset.Dataset.DuplicateGroups[0]
+	tenantSlug := testutil.TenantSlugFor(t, harness.dataset.Dataset, group.TenantID)
+	notesPath := "/duplicate-groups/" + group.ID.String() + "/notes"
+
+	resp, note := sendNoteJSON(t, harness, http.MethodPost, notesPath, tenantSlug, map[string]any{
+		"body": "Keep the NAS copy until finance signs off", "author": "dana",
+	})
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", resp.StatusCode)
+	}
+
+	resp, edited := sendNoteJSON(t, harness, http.MethodPut, notesPath+"/"+note.ID, tenantSlug, map[string]any{
+		"body": "Finance signed off; safe to hardlink", "author": "lee",
+	})
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200 on edit, got %d", resp.StatusCode)
+	}
+	if edited.ID != note.ID || edited.Author != "dana" || len(edited.Revisions) != 2 {
+		t.Fatalf("expected two revisions of the same note, got %+v", edited)
+	}
+	if edited.Revisions[0].Body != "Keep the NAS copy until finance signs off" || edited.Revisions[1].Actor != "lee" {
+		t.Fatalf("unexpected revision history %+v", edited.Revisions)
+	}
+
+	_, body := getGroupPage(t, harness, "/duplicate-groups/"+group.ID.String(), tenantSlug, "text/html")
+	if !strings.Contains(body, "Finance signed off; safe to hardlink") || !strings.Contains(body, "History (2 versions)") {
+		t.Fatalf("expected edited note with history on the page")
+	}
+
+	if resp, _ := sendNoteJSON(t, harness, http.MethodPut, notesPath+"/missing", tenantSlug, map[string]any{"body": "x"}); resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected 404 editing an unknown note, got %d", resp.StatusCode)
+	}
+}
+
+func TestGroupNoteFormRejectsEmptyBody(t *testing.T) {
+	harness := setupActionsRouter(t)
+	group := harness.dataset.Dataset.DuplicateGroups[0]
+	tenantSlug := testutil.TenantSlugFor(t, harness.dataset.Dataset, group.TenantID)
+	notesPath := "/duplicate-groups/" + group.ID.String() + "/notes"
+
+	resp, body := postCardForm(t, harness, notesPath, tenantSlug, url.Values{"body": {"   "}}, false)
+	if resp.StatusCode != http.StatusUnprocessableEntity || !strings.Contains(body, `role="alert"`) {
+		t.Fatalf("expected 422 page with inline error, got %d", resp.StatusCode)
+	}
+
+	resp, _ = postCardForm(t, harness, notesPath, tenantSlug, url.Values{"body": {"Checked with the owner"}}, false)
+	if resp.StatusCode != http.StatusSeeOther || resp.Header.Get("Location") != "/duplicate-groups/"+group.ID.String()+"#notes" {
+		t.Fatalf("expected redirect back to the notes, got %d %q", resp.StatusCode, resp.Header.Get("Location"))
+	}
+}
+
+func TestNoteSearchIsTenantScoped(t *testing.T) {
+	harness := setupActionsRouter(t)
+	dataset := harness.dataset.Dataset
+	first := dataset.DuplicateGroups[0]
+	firstSlug := testutil.TenantSlugFor(t, dataset, first.TenantID)
+	var foreign = first
+	for _, group := range dataset.DuplicateGroups {
+		if group.TenantID != first.TenantID {
+			foreign = group
+			break
+		}
+	}
+	foreignSlug := testutil.TenantSlugFor(t, dataset, foreign.TenantID)
+
+	sendNoteJSON(t, harness, http.MethodPost, "/duplicate-groups/"+first.ID.String()+"/notes", firstSlug, map[string]any{"body": "Archive-Candidate from 2019"})
+	sendNoteJSON(t, harness, http.MethodPost, "/duplicate-groups/"+foreign.ID.String()+"/notes", foreignSlug, map[string]any{"body": "archive-candidate elsewhere"})
+
+	_, body := getGroupPage(t, harness, "/tenants/"+firstSlug+"/notes?q=archive-candidate", firstSlug, "")
+	var resp struct {
+		Notes []handlers.NoteResponse `json:"notes"`
+	}
+	if err := json.Unmarshal([]byte(body), &resp); err != nil {
+		t.Fatalf("decode search: %v", err)
+	}
+	if len(resp.Notes) != 1 || resp.Notes[0].GroupID != first.ID.String() {
+		t.Fatalf("expected only the scoped tenant's note, got %+v", resp.Notes)
+	}
+
+	_, page := getGroupPage(t, harness, "/tenants/"+firstSlug+"/notes?q=2019", firstSlug, "text/html")
+	if !strings.Contains(page, `href="/duplicate-groups/`+first.ID.String()+`#notes"`) {
+		t.Fatalf("expected search result linking to the group")
+	}
+}
@@ -0,0 +1,196 @@
+package contract_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/mcmx/duplynx/internal/actions"
+	apphttp "github.com/mcmx/duplynx/internal/http"
+	"github.com/mcmx/duplynx/internal/http/handlers"
+	"github.com/mcmx/duplynx/internal/scans"
+	"github.com/mcmx/duplynx/internal/tenancy"
+	"github.com/mcmx/duplynx/tests/testutil"
+)
+
+type launchHarness struct {
+	server *httptest.Server
+	client *http.Client
+	audit  *tenancy.AuditLogger
+	seed   testutil.SeededClient
+}
+
+func setupLaunchFlow(t *testing.T) launchHarness {
+	t.Helper()
+	seed := testutil.NewSeededClient(t)
+	audit := &tenancy.AuditLogger{}
+	router := apphttp.NewRouter(apphttp.Dependencies{
+		TenancyRepo: tenancy.NewRepositoryFromClient(seed.Client, audit),
+		ScanRepo:    scans.NewRepositoryFromClient(seed.Client),
+		ActionsRepo: actions.NewRepositoryFromClient(seed.Client),
+	})
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		t.Fatalf("cookie jar: %v", err)
+	}
+	client := &http.Client{
+		Jar: jar,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	return launchHarness{server: server, client: client, audit: audit, seed: seed}
+}
+
+func (h launchHarness) getPage(t *testing.T, path string) (int, string) {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodGet, h.server.URL+path, nil)
+	if err != nil {
+		t.Fatalf("build request: %v", err)
+	}
+	req.Header.Set("Accept", "text/html")
+	resp, err := h.client.Do(req)
+	if err != nil {
+		t.Fatalf("GET %s: %v", path, err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	return resp.StatusCode, string(body)
+}
+
+func TestLaunchFlowCarriesContextInSession(t *testing.T) {
+	h := setupLaunchFlow(t)
+	tenant := h.seed.Dataset.Tenants[0]
+	var machineID, machineName string
+	for _, machine := range h.seed.Dataset.Machines {
+		if machine.TenantID == tenant.ID {
+			machineID, machineName = machine.ID.String(), machine.Name
+			break
+		}
+	}
+	var scanID string
+	for _, scan := range h.seed.Dataset.Scans {
+		if scan.TenantID == tenant.ID {
+			scanID = scan.ID.String()
+			break
+		}
+	}
+
+	status, body := h.getPage(t, "/")
+	if status != http.StatusOK || !strings.Contains(body, `href="/tenants/`+tenant.Slug+`/machines"`) {
+		t.Fatalf("expected tenant card link on launch page, got %d:\n%s", status, body)
+	}
+
+	status, body = h.getPage(t, "/tenants/"+tenant.Slug+"/machines")
+	if status != http.StatusOK {
+		t.Fatalf("expected machine picker, got %d", status)
+	}
+	if !strings.Contains(body, `value="`+machineID+`"`) || !strings.Contains(body, `action="/tenants/`+tenant.Slug+`/machines/select"`) {
+		t.Fatalf("expected machine picker form for %s:\n%s", machineID, body)
+	}
+
+	resp, err := h.client.PostForm(h.server.URL+"/tenants/"+tenant.Slug+"/machines/select", url.Values{"machineId": {machineID}})
+	if err != nil {
+		t.Fatalf("select machine: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusSeeOther || resp.Header.Get("Location") != "/tenants/"+tenant.Slug+"/scans" {
+		t.Fatalf("expected redirect to scan catalog, got %d %q", resp.StatusCode, resp.Header.Get("Location"))
+	}
+	var logged bool
+	for _, entry := range h.audit.Entries() {
+		if entry.Type == "machine_selection" && entry.MachineID == machineID {
+			logged = true
+		}
+	}
+	if !logged {
+		t.Fatalf("expected machine_selection audit entry for %s", machineID)
+	}
+
+	status, body = h.getPage(t, "/tenants/"+tenant.Slug+"/scans")
+	if status != http.StatusOK || !strings.Contains(body, `href="/scans/`+scanID+`"`) {
+		t.Fatalf("expected scan catalog linking %s, got %d:\n%s", scanID, status, body)
+	}
+	breadcrumb := tenant.Name + " / " + machineName
+	if !strings.Contains(body, breadcrumb) {
+		t.Fatalf("expected breadcrumb %q in catalog", breadcrumb)
+	}
+
+	// The board resolves the tenant from the session, without a scope header.
+	status, body = h.getPage(t, "/scans/"+scanID)
+	if status != http.StatusOK || !strings.Contains(body, breadcrumb) {
+		t.Fatalf("expected board with breadcrumb %q, got %d", breadcrumb, status)
+	}
+}
+
+func TestSelectingTenantClearsMachine(t *testing.T) {
+	h := setupLaunchFlow(t)
+	first, second := h.seed.Dataset.Tenants[0], h.seed.Dataset.Tenants[1]
+	var machineID string
+	for _, machine := range h.seed.Dataset.Machines {
+		if machine.TenantID == first.ID {
+			machineID = machine.ID.String()
+			break
+		}
+	}
+
+	resp, err := h.client.PostForm(h.server.URL+"/tenants/"+first.Slug+"/machines/select", url.Values{"machineId": {machineID}})
+	if err != nil {
+		t.Fatalf("select machine: %v", err)
+	}
+	resp.Body.Close()
+
+	_, body := h.getPage(t, "/tenants/"+second.Slug+"/machines")
+	if !strings.Contains(body, `aria-label="Current context">`+second.Name+`</p>`) {
+		t.Fatalf("expected breadcrumb to show only %q after switching tenants:\n%s", second.Name, body)
+	}
+}
+
+func TestMachineSelectRejectsForeignMachine(t *testing.T) {
+	h := setupLaunchFlow(t)
+	first, second := h.seed.Dataset.Tenants[0], h.seed.Dataset.Tenants[1]
+	var foreignID string
+	for _, machine := range h.seed.Dataset.Machines {
+		if machine.TenantID == second.ID {
+			foreignID = machine.ID.String()
+			break
+		}
+	}
+
+	resp, err := h.client.PostForm(h.server.URL+"/tenants/"+first.Slug+"/machines/select", url.Values{"machineId": {foreignID}})
+	if err != nil {
+		t.Fatalf("select machine: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected 404 for another tenant's machine, got %d", resp.StatusCode)
+	}
+}
+
+func TestMachinesEndpointStaysJSONForAPIClients(t *testing.T) {
+	h := setupLaunchFlow(t)
+	tenant := h.seed.Dataset.Tenants[0]
+
+	resp, err := h.client.Get(h.server.URL + "/tenants/" + tenant.Slug + "/machines")
+	if err != nil {
+		t.Fatalf("list machines: %v", err)
+	}
+	defer resp.Body.Close()
+	var payload struct {
+		Machines []handlers.MachineSummary `json:"machines"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
+		t.Fatalf("expected JSON without an Accept header: %v", err)
+	}
+	if len(payload.Machines) == 0 {
+		t.Fatalf("expected machines for %s", tenant.Slug)
+	}
+}
@@ -0,0 +1,104 @@
+package contract_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apphttp "github.com/mcmx/duplynx/internal/http"
+	"github.com/mcmx/duplynx/internal/quota"
+	"github.com/mcmx/duplynx/internal/tenancy"
+	"github.com/mcmx/duplynx/tests/testutil"
+)
+
+type quotaUsageResponse struct {
+	TenantSlug string          `json:"tenantSlug"`
+	Limits     map[string]int  `json:"limits"`
+	Usage      map[string]int  `json:"usage"`
+	Overrides  map[string]*int `json:"overrides"`
+}
+
+func TestQuotaEndpoints(t *testing.T) {
+	seed := testutil.NewSeededClient(t)
+	repo := tenancy.NewRepositoryFromClient(seed.Client, &tenancy.AuditLogger{})
+	server := httptest.NewServer(apphttp.NewRouter(apphttp.Dependencies{
+		TenancyRepo: repo,
+		Quotas:      quota.NewEnforcerFromClient(seed.Client, quota.DefaultLimits()),
+	}))
+	t.Cleanup(server.Close)
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/tenants/orion-analytics/usage", nil)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("usage request: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+	var usage quotaUsageResponse
+	if err := json.NewDecoder(resp.Body).Decode(&usage); err != nil {
+		t.Fatalf("decode usage: %v", err)
+	}
+	machines := usage.Usage["machines"]
+	if machines == 0 || usage.Limits["machines"] != quota.DefaultLimits().Machines {
+		t.Fatalf("unexpected usage payload: %+v", usage)
+	}
+
+	body, _ := json.Marshal(map[string]any{"machines": machines, "ingestPerMinute": 0})
+	req, _ = http.NewRequest(http.MethodPut, server.URL+"/admin/tenants/orion-analytics/quotas", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err = http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("update quotas: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200 updating quotas, got %d", resp.StatusCode)
+	}
+	var updated quotaUsageResponse
+	if err := json.NewDecoder(resp.Body).Decode(&updated); err != nil {
+		t.Fatalf("decode updated quotas: %v", err)
+	}
+	if updated.Limits["machines"] != machines || updated.Limits["ingestPerMinute"] != 0 {
+		t.Fatalf("expected overrides to apply, got %+v", updated.Limits)
+	}
+	if updated.Overrides["retainedScans"] != nil {
+		t.Fatalf("expected unset override to inherit default, got %v", *updated.Overrides["retainedScans"])
+	}
+
+	resp = postJSON(t, server.URL+"/admin/tenants/orion-analytics/machines", map[string]string{
+		"name": "Orion Overflow", "category": "server", "hostname": "overflow.orion.local",
+	})
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("expected 429 when machine quota is full, got %d", resp.StatusCode)
+	}
+
+	body, _ = json.Marshal(map[string]any{"machines": -1})
+	req, _ = http.NewRequest(http.MethodPut, server.URL+"/admin/tenants/orion-analytics/quotas", bytes.NewReader(body))
+	resp, err = http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("invalid quota update: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusUnprocessableEntity {
+		t.Fatalf("expected 422 for negative quota, got %d", resp.StatusCode)
+	}
+
+	resp, err = http.Get(server.URL + "/admin/quotas")
+	if err != nil {
+		t.Fatalf("list usage: %v", err)
+	}
+	defer resp.Body.Close()
+	var all struct {
+		Tenants []quotaUsageResponse `json:"tenants"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&all); err != nil {
+		t.Fatalf("decode usage list: %v", err)
+	}
+	if len(all.Tenants) != len(seed.Dataset.Tenants) {
+		t.Fatalf("expected usage for %d tenants, got %d", len(seed.Dataset.Tenants), len(all.Tenants))
+	}
+}
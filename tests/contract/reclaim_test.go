@@ -0,0 +1,86 @@
+package contract_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/mcmx/duplynx/internal/http/handlers"
+	"github.com/mcmx/duplynx/tests/testutil"
+)
+
+func getReclaimReport(t *testing.T, harness actionsHarness, tenantSlug string) handlers.ReclaimResponse {
+	t.Helper()
+	resp, body := getGroupPage(t, harness, "/tenants/"+tenantSlug+"/reclaimable", tenantSlug, "")
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", resp.StatusCode, body)
+	}
+	var report handlers.ReclaimResponse
+	if err := json.Unmarshal([]byte(body), &report); err != nil {
+		t.Fatalf("decode report: %v", err)
+	}
+	return report
+}
+
+func sumBreakdown(rows []handlers.ReclaimBreakdown) int64 {
+	var total int64
+	for _, row := range rows {
+		total += row.Bytes
+	}
+	return total
+}
+
+func TestReclaimDashboardTracksActions(t *testing.T) {
+	harness := setupActionsRouter(t)
+	dataset := harness.dataset.Dataset
+	group := dataset.DuplicateGroups[0]
+	tenantSlug := testutil.TenantSlugFor(t, dataset, group.TenantID)
+	var foreignSlug string
+	for _, tenant := range dataset.Tenants {
+		if tenant.ID != group.TenantID {
+			foreignSlug = tenant.Slug
+			break
+		}
+	}
+
+	before := getReclaimReport(t, harness, tenantSlug)
+	foreignBefore := getReclaimReport(t, harness, foreignSlug)
+	if before.ReclaimableBytes <= 0 || before.ReclaimedBytes != 0 {
+		t.Fatalf("expected only reclaimable space before any action, got %+v", before)
+	}
+	for name, rows := range map[string][]handlers.ReclaimBreakdown{"scan": before.ByScan, "status": before.ByStatus} {
+		if sum := sumBreakdown(rows); sum != before.ReclaimableBytes {
+			t.Fatalf("%s breakdown sums to %d, expected %d", name, sum, before.ReclaimableBytes)
+		}
+	}
+	if len(before.ByMachine) == 0 || len(before.TopDirectories) == 0 {
+		t.Fatalf("expected machine and directory breakdowns, got %+v", before)
+	}
+
+	form := url.Values{"actionType": {"delete_copies"}}
+	if resp, body := postCardForm(t, harness, "/duplicate-groups/"+group.ID.String()+"/actions", tenantSlug, form, true); resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected action to succeed, got %d: %s", resp.StatusCode, body)
+	}
+
+	after := getReclaimReport(t, harness, tenantSlug)
+	freed := after.ReclaimedBytes
+	if freed <= 0 || after.ReclaimedGroups != 1 || after.SimulatedBytes != freed {
+		t.Fatalf("expected one simulated reclaimed group, got %+v", after)
+	}
+	if after.ReclaimableBytes != before.ReclaimableBytes-freed || after.ReclaimableGroups != before.ReclaimableGroups-1 {
+		t.Fatalf("expected reclaimed space to leave the reclaimable totals: before %+v after %+v", before, after)
+	}
+	if foreignAfter := getReclaimReport(t, harness, foreignSlug); foreignAfter.ReclaimableBytes != foreignBefore.ReclaimableBytes || foreignAfter.ReclaimedBytes != 0 {
+		t.Fatalf("expected the other tenant's report to be unaffected, got %+v", foreignAfter)
+	}
+
+	_, page := getGroupPage(t, harness, "/tenants/"+tenantSlug+"/reclaimable", tenantSlug, "text/html")
+	if !strings.Contains(page, `data-stat="reclaimed"`) || !strings.Contains(page, "from stubbed actions") {
+		t.Fatalf("expected the rendered dashboard")
+	}
+	if resp, _ := getGroupPage(t, harness, "/tenants/"+tenantSlug+"/reclaimable?top=0", tenantSlug, ""); resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected 400 for an invalid top parameter, got %d", resp.StatusCode)
+	}
+}
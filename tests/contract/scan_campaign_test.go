@@ -0,0 +1,288 @@
+package contract_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	entduplicategroup "github.com/mcmx/duplynx/ent/duplicategroup"
+	apphttp "github.com/mcmx/duplynx/internal/http"
+	"github.com/mcmx/duplynx/internal/ingestion"
+	"github.com/mcmx/duplynx/internal/scans"
+	"github.com/mcmx/duplynx/internal/tenancy"
+	"github.com/mcmx/duplynx/tests/testutil"
+)
+
+func TestScanCampaignContract(t *testing.T) {
+	seed := testutil.NewSeededClient(t)
+	tenant := seed.Dataset.Tenants[0]
+	scanRepo := scans.NewRepositoryFromClient(seed.Client)
+	secretRepo := ingestion.NewSecretRepositoryFromClient(seed.Client)
+	router := apphttp.NewRouter(apphttp.Dependencies{
+		TenancyRepo:         tenancy.NewRepositoryFromClient(seed.Client, &tenancy.AuditLogger{}),
+		ScanRepo:            scanRepo,
+		SecretRepo:          secretRepo,
+		LegacyTenantSecrets: map[string]string{tenant.Slug: lifecycleSecret},
+	})
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+
+	machines := testutil.MachineIDsForTenant(seed.Dataset, tenant.ID)
+	if len(machines) < 2 {
+		t.Fatalf("expected at least two machines for %s", tenant.Slug)
+	}
+	other := testutil.MachineIDsForTenant(seed.Dataset, seed.Dataset.Tenants[1].ID)[0]
+
+	if status, _ := createCampaign(t, server.URL, tenant.Slug, map[string]any{
+		"name":    "Cross-tenant Sweep",
+		"targets": []map[string]any{{"machineId": other.String(), "roots": []string{"/srv"}}},
+	}); status != http.StatusBadRequest {
+		t.Fatalf("expected another tenant's machine to be rejected, got %d", status)
+	}
+	if status, _ := createCampaign(t, server.URL, tenant.Slug, map[string]any{
+		"name":    "Rootless Sweep",
+		"targets": []map[string]any{{"machineId": machines[0].String(), "roots": []string{" "}}},
+	}); status != http.StatusBadRequest {
+		t.Fatalf("expected a target without roots to be rejected, got %d", status)
+	}
+
+	status, created := createCampaign(t, server.URL, tenant.Slug, map[string]any{
+		"name":     "Fleet Sweep",
+		"deadline": time.Now().Add(time.Hour).UTC().Format(time.RFC3339),
+		"targets": []map[string]any{
+			{"machineId": machines[0].String(), "roots": []string{"/home", "/srv/media"}},
+			{"machineId": machines[1].String(), "roots": []string{"/data"}},
+		},
+	})
+	if status != http.StatusCreated {
+		t.Fatalf("expected campaign to be created, got %d", status)
+	}
+	if created.Lifecycle() != scans.StatusPending || len(created.Targets) != 2 || created.Deadline.IsZero() {
+		t.Fatalf("unexpected campaign %+v", created)
+	}
+
+	first := machineSecret(t, secretRepo, tenant.Slug, machines[0])
+	second := machineSecret(t, secretRepo, tenant.Slug, machines[1])
+	var polled ingestion.AssignmentsResponse
+	if status := postSignedWith(t, server.URL+"/ingest/assignments", tenant.Slug, first, ingestion.AssignmentsRequest{MachineID: machines[0].String()}, &polled); status != http.StatusOK {
+		t.Fatalf("expected assignments, got %d", status)
+	}
+	if len(polled.Assignments) != 1 || polled.Assignments[0].ScanID != created.ID || len(polled.Assignments[0].Roots) != 2 || polled.Assignments[0].Deadline == nil {
+		t.Fatalf("unexpected assignments %+v", polled)
+	}
+	// Machine requests need that machine's own secret.
+	if status := postSigned(t, server.URL+"/ingest/assignments", tenant.Slug, ingestion.AssignmentsRequest{MachineID: machines[0].String()}, &polled); status != http.StatusForbidden {
+		t.Fatalf("expected the tenant-wide secret to be refused, got %d", status)
+	}
+	if status := postSignedWith(t, server.URL+"/ingest/assignments", tenant.Slug, second, ingestion.AssignmentsRequest{MachineID: machines[0].String()}, &polled); status != http.StatusForbidden {
+		t.Fatalf("expected another machine's secret to be refused, got %d", status)
+	}
+
+	var reported ingestion.HeartbeatResponse
+	report := ingestion.ReportRequest{ScanID: created.ID, MachineID: machines[0].String(), Status: "reported"}
+	if status := postSignedWith(t, server.URL+"/ingest/report", tenant.Slug, second, report, &reported); status != http.StatusForbidden {
+		t.Fatalf("expected a report for another machine to be refused, got %d", status)
+	}
+	if status := postSignedWith(t, server.URL+"/ingest/report", tenant.Slug, first, report, &reported); status != http.StatusOK {
+		t.Fatalf("expected report to be accepted, got %d", status)
+	}
+	if reported.Status != string(scans.StatusRunning) {
+		t.Fatalf("scan should keep running until every target reports, got %s", reported.Status)
+	}
+
+	board := getScanSummary(t, server.URL, uuid.MustParse(created.ID), tenant.Slug)
+	statuses := map[string]string{}
+	for _, target := range board.Targets {
+		statuses[target.MachineID] = target.Status
+	}
+	if statuses[machines[0].String()] != scans.TargetReported || statuses[machines[1].String()] != scans.TargetPending || board.MachinesReported != 1 {
+		t.Fatalf("unexpected per-machine status %+v (machines reported %d)", statuses, board.MachinesReported)
+	}
+
+	expired, err := scanRepo.ExpireCampaigns(testutil.SystemContext(), time.Now().Add(2*time.Hour))
+	if err != nil {
+		t.Fatalf("expire campaigns: %v", err)
+	}
+	if len(expired) != 1 || expired[0].Lifecycle() != scans.StatusPartial {
+		t.Fatalf("expected the campaign to end partial, got %+v", expired)
+	}
+	for _, target := range expired[0].Targets {
+		if target.MachineID == machines[1].String() && target.Status != scans.TargetMissed {
+			t.Fatalf("expected the silent machine to be missed, got %s", target.Status)
+		}
+	}
+
+	report.MachineID = machines[1].String()
+	if status := postSignedWith(t, server.URL+"/ingest/report", tenant.Slug, second, report, &reported); status != http.StatusConflict || reported.Status != string(scans.StatusPartial) {
+		t.Fatalf("late report: status %d, scan %s", status, reported.Status)
+	}
+}
+
+func TestScanCampaignCompletesWhenEveryTargetReports(t *testing.T) {
+	seed := testutil.NewSeededClient(t)
+	tenant := seed.Dataset.Tenants[0]
+	repo := scans.NewRepositoryFromClient(seed.Client)
+	machines := testutil.MachineIDsForTenant(seed.Dataset, tenant.ID)
+
+	campaign, err := repo.CreateCampaign(testutil.TenantContext(tenant.ID), scans.CampaignInput{
+		Name: "Nightly Fleet Sweep",
+		Targets: []scans.TargetInput{
+			{MachineID: machines[0], Roots: []string{"/home"}},
+			{MachineID: machines[1], Roots: []string{"/home"}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("create campaign: %v", err)
+	}
+	scanID := uuid.MustParse(campaign.ID)
+
+	if _, err := repo.RecordHeartbeat(testutil.SystemContext(), tenant.Slug, scanID, scans.Heartbeat{Status: scans.StatusCompleted}); err == nil {
+		t.Fatalf("a heartbeat must not complete a multi-machine scan")
+	}
+	if _, err := repo.ReportTarget(testutil.SystemContext(), tenant.Slug, scanID, machines[0], scans.TargetReport{}); err != nil {
+		t.Fatalf("report first target: %v", err)
+	}
+	summary, err := repo.ReportTarget(testutil.SystemContext(), tenant.Slug, scanID, machines[1], scans.TargetReport{})
+	if err != nil {
+		t.Fatalf("report second target: %v", err)
+	}
+	if summary.Lifecycle() != scans.StatusCompleted || summary.MachinesReported != 2 || summary.CompletedAt.IsZero() {
+		t.Fatalf("expected a completed scan, got %+v", summary)
+	}
+}
+
+func TestScanManifestContract(t *testing.T) {
+	seed := testutil.NewSeededClient(t)
+	tenant := seed.Dataset.Tenants[0]
+	scanRepo := scans.NewRepositoryFromClient(seed.Client)
+	secretRepo := ingestion.NewSecretRepositoryFromClient(seed.Client)
+	server := httptest.NewServer(apphttp.NewRouter(apphttp.Dependencies{
+		TenancyRepo:         tenancy.NewRepositoryFromClient(seed.Client, &tenancy.AuditLogger{}),
+		ScanRepo:            scanRepo,
+		SecretRepo:          secretRepo,
+		LegacyTenantSecrets: map[string]string{tenant.Slug: lifecycleSecret},
+	}))
+	t.Cleanup(server.Close)
+
+	machines := testutil.MachineIDsForTenant(seed.Dataset, tenant.ID)
+	if len(machines) < 3 {
+		t.Fatalf("expected at least three machines for %s", tenant.Slug)
+	}
+	ctx := testutil.TenantContext(tenant.ID)
+	campaign, err := scanRepo.CreateCampaign(ctx, scans.CampaignInput{
+		Name: "Manifest Sweep",
+		Targets: []scans.TargetInput{
+			{MachineID: machines[0], Roots: []string{"/home"}},
+			{MachineID: machines[1], Roots: []string{"/home"}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("create campaign: %v", err)
+	}
+	scanID := uuid.MustParse(campaign.ID)
+	first := machineSecret(t, secretRepo, tenant.Slug, machines[0])
+	second := machineSecret(t, secretRepo, tenant.Slug, machines[1])
+	outsider := machineSecret(t, secretRepo, tenant.Slug, machines[2])
+	upload := func(secret ingestion.SecretVersion, machineID uuid.UUID, groups ...ingestion.ManifestGroup) int {
+		t.Helper()
+		manifest := ingestion.ManifestRequest{ScanID: campaign.ID, MachineID: machineID.String(), Groups: groups}
+		return postSignedWith(t, server.URL+"/ingest", tenant.Slug, secret, manifest, &ingestion.HeartbeatResponse{})
+	}
+	files := func() map[string]int {
+		t.Helper()
+		groups, err := seed.Client.DuplicateGroup.Query().Where(entduplicategroup.ScanID(scanID)).WithFileInstances().All(ctx)
+		if err != nil {
+			t.Fatalf("list duplicate groups: %v", err)
+		}
+		out := make(map[string]int, len(groups))
+		for _, group := range groups {
+			if group.FileCount != len(group.Edges.FileInstances) {
+				t.Fatalf("group %s counts %d files but has %d", group.Hash, group.FileCount, len(group.Edges.FileInstances))
+			}
+			out[group.Hash] = group.FileCount
+		}
+		return out
+	}
+	report := ingestion.ManifestGroup{Hash: "sha256:report", SizeBytes: 100, Paths: []string{"/home/a.pdf", "/home/b.pdf"}}
+	photo := ingestion.ManifestGroup{Hash: "sha256:photo", SizeBytes: 50, Paths: []string{"/home/1.jpg", "/home/2.jpg"}}
+
+	if status := postSigned(t, server.URL+"/ingest", tenant.Slug, ingestion.ManifestRequest{ScanID: campaign.ID, MachineID: machines[0].String(), Groups: []ingestion.ManifestGroup{report}}, nil); status != http.StatusForbidden {
+		t.Fatalf("expected the tenant-wide secret to be refused, got %d", status)
+	}
+	if status := upload(second, machines[0], report); status != http.StatusForbidden {
+		t.Fatalf("expected another machine's secret to be refused, got %d", status)
+	}
+	if status := upload(outsider, machines[2], report); status != http.StatusNotFound {
+		t.Fatalf("expected a machine outside the scan to be refused, got %d", status)
+	}
+	if status := upload(first, machines[0], ingestion.ManifestGroup{Hash: "sha256:single", SizeBytes: 10, Paths: []string{"/home/only.txt"}}); status != http.StatusBadRequest {
+		t.Fatalf("expected a group with one file to be rejected, got %d", status)
+	}
+
+	if status := upload(first, machines[0], report, photo); status != http.StatusAccepted {
+		t.Fatalf("expected the manifest to be stored, got %d", status)
+	}
+	// A repeated upload replaces the machine's earlier files.
+	report.Paths = append(report.Paths, "/home/c.pdf")
+	if status := upload(first, machines[0], report); status != http.StatusAccepted {
+		t.Fatalf("expected the manifest to be replaced, got %d", status)
+	}
+	if got := files(); len(got) != 1 || got["sha256:report"] != 3 {
+		t.Fatalf("expected only the replacement's group, got %v", got)
+	}
+	// The same content on another machine joins the scan's existing group.
+	if status := upload(second, machines[1], report, photo); status != http.StatusAccepted {
+		t.Fatalf("expected the second manifest to be stored, got %d", status)
+	}
+	if got := files(); len(got) != 2 || got["sha256:report"] != 6 || got["sha256:photo"] != 2 {
+		t.Fatalf("expected both machines' copies to be merged, got %v", got)
+	}
+
+	if _, err := scanRepo.ReportTarget(testutil.SystemContext(), tenant.Slug, scanID, machines[0], scans.TargetReport{}); err != nil {
+		t.Fatalf("report first target: %v", err)
+	}
+	if status := upload(first, machines[0], photo); status != http.StatusConflict {
+		t.Fatalf("expected a manifest after the machine reported to be refused, got %d", status)
+	}
+	summary, err := scanRepo.ReportTarget(testutil.SystemContext(), tenant.Slug, scanID, machines[1], scans.TargetReport{})
+	if err != nil {
+		t.Fatalf("report second target: %v", err)
+	}
+	if summary.Lifecycle() != scans.StatusCompleted || summary.DuplicateGroupCount != 2 {
+		t.Fatalf("expected a completed scan with two groups, got %+v", summary)
+	}
+	linked, err := seed.Client.DuplicateGroup.Query().
+		Where(entduplicategroup.ScanID(scanID), entduplicategroup.ContentIdentityIDNotNil()).
+		Count(ctx)
+	if err != nil {
+		t.Fatalf("count linked groups: %v", err)
+	}
+	if linked != 2 {
+		t.Fatalf("expected the completed scan's groups to be linked to content identities, got %d", linked)
+	}
+}
+
+func createCampaign(t *testing.T, baseURL, tenantSlug string, body map[string]any) (int, scans.ScanSummary) {
+	t.Helper()
+	payload, _ := json.Marshal(body)
+	req, _ := http.NewRequest(http.MethodPost, baseURL+"/tenants/"+tenantSlug+"/scans", bytes.NewReader(payload))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(tenancy.HeaderTenantSlug, tenantSlug)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("create campaign: %v", err)
+	}
+	defer resp.Body.Close()
+	var summary scans.ScanSummary
+	if resp.StatusCode == http.StatusCreated {
+		if err := json.NewDecoder(resp.Body).Decode(&summary); err != nil {
+			t.Fatalf("decode campaign: %v", err)
+		}
+	}
+	return resp.StatusCode, summary
+}
@@ -0,0 +1,242 @@
+package contract_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	entduplicategroup "github.com/mcmx/duplynx/ent/duplicategroup"
+	entscan "github.com/mcmx/duplynx/ent/scan"
+	entscantarget "github.com/mcmx/duplynx/ent/scantarget"
+	"github.com/mcmx/duplynx/internal/http/handlers"
+	"github.com/mcmx/duplynx/tests/testutil"
+)
+
+// addFollowUpScan records a scan after the seeded baseline in which the
+// finance group gained a copy and had one moved to another machine, the
+// media group was cleaned up, and one new group appeared.
+func addFollowUpScan(t *testing.T, harness actionsHarness) (baseline, followUp uuid.UUID) {
+	t.Helper()
+	client := harness.dataset.Client
+	dataset := harness.dataset.Dataset
+	ctx := testutil.SystemContext()
+	finance := dataset.DuplicateGroups[0]
+	tenantID := finance.TenantID
+	machines := testutil.MachineIDsForTenant(dataset, tenantID)
+
+	scan := client.Scan.Create().
+		SetTenantID(tenantID).
+		SetInitiatedMachineID(machines[0]).
+		SetName("Archive Sync").
+		SetStartedAt(time.Date(2025, 11, 4, 8, 0, 0, 0, time.UTC)).
+		SetCompletedAt(time.Date(2025, 11, 4, 8, 30, 0, 0, time.UTC)).
+		SetStatus(entscan.StatusCompleted).
+		SetDuplicateGroupCount(2).
+		SaveX(ctx)
+	files := client.DuplicateGroup.GetX(ctx, finance.ID).QueryFileInstances().AllX(ctx)
+	group := client.DuplicateGroup.Create().
+		SetTenantID(tenantID).
+		SetScanID(scan.ID).
+		SetHash(finance.Hash).
+		SetStatus(entduplicategroup.StatusReview).
+		SetFileCount(len(files) + 1).
+		SetTotalSizeBytes(finance.TotalSizeBytes + files[0].SizeBytes).
+		SaveX(ctx)
+	for i, file := range files {
+		machineID := file.MachineID
+		if i == 0 {
+			// The first copy now lives on a different machine under the same name.
+			for _, candidate := range machines {
+				if candidate != file.MachineID {
+					machineID = candidate
+					break
+				}
+			}
+		}
+		client.FileInstance.Create().SetTenantID(tenantID).SetDuplicateGroupID(group.ID).
+			SetMachineID(machineID).SetPath(file.Path).SetSizeBytes(file.SizeBytes).SetChecksum(finance.Hash).SaveX(ctx)
+	}
+	client.FileInstance.Create().SetTenantID(tenantID).SetDuplicateGroupID(group.ID).
+		SetMachineID(files[0].MachineID).SetPath("/mnt/extra/copy.pdf").SetSizeBytes(files[0].SizeBytes).SetChecksum(finance.Hash).SaveX(ctx)
+
+	fresh := client.DuplicateGroup.Create().
+		SetTenantID(tenantID).
+		SetScanID(scan.ID).
+		SetHash("hash:archive-sync-only").
+		SetStatus(entduplicategroup.StatusReview).
+		SetFileCount(2).
+		SetTotalSizeBytes(2048).
+		SaveX(ctx)
+	for _, path := range []string{"/a/only.bin", "/b/only.bin"} {
+		client.FileInstance.Create().SetTenantID(tenantID).SetDuplicateGroupID(fresh.ID).
+			SetMachineID(machines[0]).SetPath(path).SetSizeBytes(1024).SetChecksum("hash:archive-sync-only").SaveX(ctx)
+	}
+	return finance.ScanID, scan.ID
+}
+
+func TestScanDiffReportsChangesBetweenScans(t *testing.T) {
+	harness := setupActionsRouter(t)
+	dataset := harness.dataset.Dataset
+	tenantSlug := dataset.Tenants[0].Slug
+	baseline, followUp := addFollowUpScan(t, harness)
+	path := "/tenants/" + tenantSlug + "/scans/diff?from=" + baseline.String() + "&to=" + followUp.String()
+
+	resp, body := getGroupPage(t, harness, path, tenantSlug, "application/json")
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", resp.StatusCode, body)
+	}
+	var diff handlers.ScanDiffResponse
+	if err := json.Unmarshal([]byte(body), &diff); err != nil {
+		t.Fatalf("decode diff: %v", err)
+	}
+	if len(diff.New) != 1 || diff.New[0].Hash != "hash:archive-sync-only" || diff.New[0].FromFiles != 0 {
+		t.Fatalf("expected one new group, got %+v", diff.New)
+	}
+	if len(diff.Removed) != 1 || diff.Removed[0].Hash != "hash:media-latest-cut" || diff.Removed[0].ToFiles != 0 {
+		t.Fatalf("expected the media group removed, got %+v", diff.Removed)
+	}
+	if len(diff.Changed) != 1 || diff.Changed[0].ToFiles != diff.Changed[0].FromFiles+1 {
+		t.Fatalf("expected the finance group to gain a copy, got %+v", diff.Changed)
+	}
+	if len(diff.Moves) != 1 || diff.Moves[0].FromPath != diff.Moves[0].ToPath || diff.Moves[0].FromMachine == diff.Moves[0].ToMachine {
+		t.Fatalf("expected one copy moved between machines, got %+v", diff.Moves)
+	}
+	if diff.RedundantBytesDelta != diff.To.RedundantBytes-diff.From.RedundantBytes || diff.From.Name != "Baseline Sweep October" {
+		t.Fatalf("unexpected scan summaries %+v", diff)
+	}
+
+	resp, body = getGroupPage(t, harness, path, tenantSlug, "text/html")
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200 for the page, got %d", resp.StatusCode)
+	}
+	for _, marker := range []string{`data-new="hash:archive-sync-only"`, `data-removed="hash:media-latest-cut"`, `data-move="hash:finance-q4-plan"`, `data-stat="delta"`} {
+		if !strings.Contains(body, marker) {
+			t.Fatalf("expected %s on the diff page", marker)
+		}
+	}
+
+	// The other tenant cannot compare Orion's scans.
+	foreign := dataset.Tenants[1].Slug
+	foreignPath := "/tenants/" + foreign + "/scans/diff?from=" + baseline.String() + "&to=" + followUp.String()
+	if resp, _ := getGroupPage(t, harness, foreignPath, foreign, "application/json"); resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected 404 across tenants, got %d", resp.StatusCode)
+	}
+	same := "/tenants/" + tenantSlug + "/scans/diff?from=" + baseline.String() + "&to=" + baseline.String()
+	if resp, _ := getGroupPage(t, harness, same, tenantSlug, "application/json"); resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected 400 comparing a scan with itself, got %d", resp.StatusCode)
+	}
+	if resp, body := getGroupPage(t, harness, "/tenants/"+tenantSlug+"/scans/diff", tenantSlug, "text/html"); resp.StatusCode != http.StatusOK || !strings.Contains(body, `name="from"`) {
+		t.Fatalf("expected the picker page without a selection, got %d", resp.StatusCode)
+	}
+}
+
+// diffCopy is one file of a synthetic scan.
+type diffCopy struct {
+	machineID uuid.UUID
+	path      string
+}
+
+// addCoveredScan records a scan whose reported targets are covered, holding
+// one group per hash with the given copies.
+func addCoveredScan(t *testing.T, harness actionsHarness, tenantID uuid.UUID, name string, started time.Time, status entscan.Status, covered []uuid.UUID, groups map[string][]diffCopy) uuid.UUID {
+	t.Helper()
+	client := harness.dataset.Client
+	ctx := testutil.SystemContext()
+	scan := client.Scan.Create().
+		SetTenantID(tenantID).
+		SetName(name).
+		SetStartedAt(started).
+		SetCompletedAt(started.Add(time.Hour)).
+		SetStatus(status).
+		SetDuplicateGroupCount(len(groups)).
+		SaveX(ctx)
+	for _, machineID := range covered {
+		client.ScanTarget.Create().SetTenantID(tenantID).SetScanID(scan.ID).SetMachineID(machineID).
+			SetRoots([]string{"/"}).SetStatus(entscantarget.StatusReported).SaveX(ctx)
+	}
+	for hash, copies := range groups {
+		group := client.DuplicateGroup.Create().SetTenantID(tenantID).SetScanID(scan.ID).SetHash(hash).
+			SetFileCount(len(copies)).SetTotalSizeBytes(int64(len(copies)) * 100).SaveX(ctx)
+		for _, c := range copies {
+			client.FileInstance.Create().SetTenantID(tenantID).SetDuplicateGroupID(group.ID).
+				SetMachineID(c.machineID).SetPath(c.path).SetSizeBytes(100).SetChecksum(hash).SaveX(ctx)
+		}
+	}
+	return scan.ID
+}
+
+func TestScanDiffOnlyComparesMachinesBothScansCovered(t *testing.T) {
+	harness := setupActionsRouter(t)
+	dataset := harness.dataset.Dataset
+	tenant := dataset.Tenants[0]
+	machines := testutil.MachineIDsForTenant(dataset, tenant.ID)
+	nas, laptop := machines[0], machines[1]
+	base := time.Date(2025, 12, 1, 8, 0, 0, 0, time.UTC)
+
+	fleet := addCoveredScan(t, harness, tenant.ID, "Fleet Sweep", base, entscan.StatusCompleted, []uuid.UUID{nas, laptop}, map[string][]diffCopy{
+		"hash:cleaned-on-nas":  {{nas, "/a/x.bin"}, {nas, "/b/x.bin"}},
+		"hash:only-on-laptop":  {{laptop, "/a/y.bin"}, {laptop, "/b/y.bin"}},
+		"hash:across-machines": {{nas, "/a/z.bin"}, {laptop, "/a/z.bin"}},
+		"hash:laptop-copy":     {{nas, "/a/w.bin"}, {nas, "/b/w.bin"}, {laptop, "/a/w.bin"}},
+	})
+	nasOnly := addCoveredScan(t, harness, tenant.ID, "NAS Sweep", base.Add(24*time.Hour), entscan.StatusCompleted, []uuid.UUID{nas}, map[string][]diffCopy{
+		"hash:laptop-copy": {{nas, "/a/w.bin"}, {nas, "/b/w.bin"}},
+	})
+
+	path := "/tenants/" + tenant.Slug + "/scans/diff?from=" + fleet.String() + "&to=" + nasOnly.String()
+	resp, body := getGroupPage(t, harness, path, tenant.Slug, "application/json")
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", resp.StatusCode, body)
+	}
+	var diff handlers.ScanDiffResponse
+	if err := json.Unmarshal([]byte(body), &diff); err != nil {
+		t.Fatalf("decode diff: %v", err)
+	}
+	// Only the group held entirely on the NAS can have been cleaned up.
+	if len(diff.Removed) != 1 || diff.Removed[0].Hash != "hash:cleaned-on-nas" {
+		t.Fatalf("expected only the NAS group removed, got %+v", diff.Removed)
+	}
+	// The laptop copy was not looked at, so the group did not shrink.
+	if len(diff.Changed) != 0 || len(diff.New) != 0 || len(diff.Moves) != 0 {
+		t.Fatalf("expected no changes on the NAS, got %+v", diff)
+	}
+	if len(diff.Uncovered) != 1 || diff.Uncovered[0] != "Orion Laptop 01" {
+		t.Fatalf("expected the laptop named as uncovered, got %v", diff.Uncovered)
+	}
+	if resp, body := getGroupPage(t, harness, path, tenant.Slug, "text/html"); resp.StatusCode != http.StatusOK || !strings.Contains(body, "data-uncovered") {
+		t.Fatalf("expected the page to flag the coverage gap, got %d", resp.StatusCode)
+	}
+
+	// The scans must be given oldest first.
+	swapped := "/tenants/" + tenant.Slug + "/scans/diff?from=" + nasOnly.String() + "&to=" + fleet.String()
+	if resp, _ := getGroupPage(t, harness, swapped, tenant.Slug, "application/json"); resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected 400 when the earlier scan is later, got %d", resp.StatusCode)
+	}
+}
+
+func TestScanDiffRejectsScansThatDidNotComplete(t *testing.T) {
+	harness := setupActionsRouter(t)
+	dataset := harness.dataset.Dataset
+	tenant := dataset.Tenants[0]
+	nas := testutil.MachineIDsForTenant(dataset, tenant.ID)[0]
+	base := time.Date(2025, 12, 1, 8, 0, 0, 0, time.UTC)
+
+	good := addCoveredScan(t, harness, tenant.ID, "Good Sweep", base, entscan.StatusCompleted, []uuid.UUID{nas}, map[string][]diffCopy{
+		"hash:still-there": {{nas, "/a/x.bin"}, {nas, "/b/x.bin"}},
+	})
+	// The failed scan found nothing, which must not read as a cleanup.
+	failed := addCoveredScan(t, harness, tenant.ID, "Failed Sweep", base.Add(24*time.Hour), entscan.StatusFailed, nil, nil)
+
+	path := "/tenants/" + tenant.Slug + "/scans/diff?from=" + good.String() + "&to=" + failed.String()
+	resp, body := getGroupPage(t, harness, path, tenant.Slug, "application/json")
+	if resp.StatusCode != http.StatusBadRequest || !strings.Contains(body, "only completed or partial scans") {
+		t.Fatalf("expected 400 for a failed scan, got %d: %s", resp.StatusCode, body)
+	}
+	if _, body := getGroupPage(t, harness, "/tenants/"+tenant.Slug+"/scans/diff", tenant.Slug, "text/html"); strings.Contains(body, failed.String()) {
+		t.Fatalf("expected the pickers to leave out the failed scan")
+	}
+}
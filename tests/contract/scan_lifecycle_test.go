@@ -0,0 +1,180 @@
+package contract_test
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	apphttp "github.com/mcmx/duplynx/internal/http"
+	"github.com/mcmx/duplynx/internal/ingestion"
+	"github.com/mcmx/duplynx/internal/scans"
+	"github.com/mcmx/duplynx/internal/templ"
+	"github.com/mcmx/duplynx/internal/tenancy"
+	"github.com/mcmx/duplynx/tests/testutil"
+)
+
+const lifecycleSecret = "lifecycle-secret"
+
+func TestScanHeartbeatAndCancelContract(t *testing.T) {
+	seed := testutil.NewSeededClient(t)
+	tenant := seed.Dataset.Tenants[0]
+	router := apphttp.NewRouter(apphttp.Dependencies{
+		TenancyRepo:         tenancy.NewRepositoryFromClient(seed.Client, &tenancy.AuditLogger{}),
+		ScanRepo:            scans.NewRepositoryFromClient(seed.Client),
+		LegacyTenantSecrets: map[string]string{tenant.Slug: lifecycleSecret},
+	})
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+
+	scan, err := seed.Client.Scan.Create().
+		SetTenantID(tenant.ID).
+		SetName("Lifecycle Sweep").
+		SetStartedAt(time.Now()).
+		SetDuplicateGroupCount(0).
+		Save(testutil.TenantContext(tenant.ID))
+	if err != nil {
+		t.Fatalf("create scan: %v", err)
+	}
+
+	status, out := postHeartbeat(t, server.URL, tenant.Slug, ingestion.HeartbeatRequest{ScanID: scan.ID.String(), FilesSeen: 120, BytesHashed: 4096, MachinesReported: 1})
+	if status != http.StatusOK || out.Status != string(scans.StatusRunning) {
+		t.Fatalf("first heartbeat: status %d, scan %q; want 200 running", status, out.Status)
+	}
+	status, out = postHeartbeat(t, server.URL, tenant.Slug, ingestion.HeartbeatRequest{ScanID: scan.ID.String(), Status: "ingesting", FilesSeen: 80, BytesHashed: 8192, MachinesReported: 2})
+	if status != http.StatusOK || out.Status != string(scans.StatusIngesting) {
+		t.Fatalf("ingesting heartbeat: status %d, scan %q", status, out.Status)
+	}
+	if status, _ = postHeartbeat(t, server.URL, tenant.Slug, ingestion.HeartbeatRequest{ScanID: scan.ID.String(), Status: "running"}); status != http.StatusConflict {
+		t.Fatalf("expected 409 moving back to running, got %d", status)
+	}
+
+	board := getScanSummary(t, server.URL, scan.ID, tenant.Slug)
+	if board.FilesSeen != 120 || board.BytesHashed != 8192 || board.MachinesReported != 2 {
+		t.Fatalf("counters should keep their highest values, got %+v", board)
+	}
+	if board.LastHeartbeatAt.IsZero() {
+		t.Fatalf("expected last heartbeat to be recorded")
+	}
+	page := templ.ScanProgress(board)
+	if !strings.Contains(string(page), `data-scan-status="ingesting"`) || !strings.Contains(string(page), "/cancel") {
+		t.Fatalf("expected progress bar with cancel form, got %s", page)
+	}
+
+	req, _ := http.NewRequest(http.MethodPost, server.URL+"/scans/"+scan.ID.String()+"/cancel", strings.NewReader(`{"reason":"wrong roots"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(tenancy.HeaderTenantSlug, seed.Dataset.Tenants[1].Slug)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("cancel request: %v", err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected another tenant's cancel to 404, got %d", resp.StatusCode)
+	}
+
+	req, _ = http.NewRequest(http.MethodPost, server.URL+"/scans/"+scan.ID.String()+"/cancel", strings.NewReader(`{"reason":"wrong roots"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(tenancy.HeaderTenantSlug, tenant.Slug)
+	resp, err = http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("cancel request: %v", err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected cancel to succeed, got %d", resp.StatusCode)
+	}
+
+	status, out = postHeartbeat(t, server.URL, tenant.Slug, ingestion.HeartbeatRequest{ScanID: scan.ID.String(), FilesSeen: 500})
+	if status != http.StatusConflict || !out.Cancelled || out.StatusMessage != "wrong roots" {
+		t.Fatalf("heartbeat after cancel: status %d, body %+v", status, out)
+	}
+
+	req, _ = http.NewRequest(http.MethodPost, server.URL+"/scans/"+scan.ID.String()+"/cancel", nil)
+	req.Header.Set(tenancy.HeaderTenantSlug, tenant.Slug)
+	resp, err = http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("cancel request: %v", err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusConflict {
+		t.Fatalf("expected a second cancel to 409, got %d", resp.StatusCode)
+	}
+}
+
+func postHeartbeat(t *testing.T, baseURL, tenantSlug string, in ingestion.HeartbeatRequest) (int, ingestion.HeartbeatResponse) {
+	t.Helper()
+	var out ingestion.HeartbeatResponse
+	status := postSigned(t, baseURL+"/ingest/heartbeat", tenantSlug, in, &out)
+	return status, out
+}
+
+// postSigned posts in as JSON signed with lifecycleSecret, decoding JSON responses into out.
+func postSigned(t *testing.T, url, tenantSlug string, in, out any) int {
+	t.Helper()
+	return postSignedWith(t, url, tenantSlug, ingestion.SecretVersion{Secret: lifecycleSecret}, in, out)
+}
+
+// postSignedWith posts in signed with secret, naming its key ID when it has one.
+func postSignedWith(t *testing.T, url, tenantSlug string, secret ingestion.SecretVersion, in, out any) int {
+	t.Helper()
+	payload, _ := json.Marshal(in)
+	mac := hmac.New(sha256.New, []byte(secret.Secret))
+	mac.Write(payload)
+
+	req, _ := http.NewRequest(http.MethodPost, url, bytes.NewReader(payload))
+	req.Header.Set(ingestion.HeaderTenant, tenantSlug)
+	req.Header.Set(ingestion.HeaderSignature, hex.EncodeToString(mac.Sum(nil)))
+	if secret.KeyID != "" {
+		req.Header.Set(ingestion.HeaderKeyID, secret.KeyID)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("signed request: %v", err)
+	}
+	defer resp.Body.Close()
+	if strings.HasPrefix(resp.Header.Get("Content-Type"), "application/json") {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+	}
+	return resp.StatusCode
+}
+
+// machineSecret issues a secret bound to one of the tenant's machines, as agents use.
+func machineSecret(t *testing.T, repo *ingestion.SecretRepository, tenantSlug string, machineID uuid.UUID) ingestion.SecretVersion {
+	t.Helper()
+	version, err := repo.Rotate(testutil.SystemContext(), tenantSlug, ingestion.RotateOptions{MachineID: machineID})
+	if err != nil {
+		t.Fatalf("issue machine secret: %v", err)
+	}
+	return version
+}
+
+func getScanSummary(t *testing.T, baseURL string, scanID uuid.UUID, tenantSlug string) scans.ScanSummary {
+	t.Helper()
+	req, _ := http.NewRequest(http.MethodGet, baseURL+"/scans/"+scanID.String(), nil)
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set(tenancy.HeaderTenantSlug, tenantSlug)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("board request: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected board 200, got %d", resp.StatusCode)
+	}
+	var summary scans.ScanSummary
+	if err := json.NewDecoder(resp.Body).Decode(&summary); err != nil {
+		t.Fatalf("decode board: %v", err)
+	}
+	return summary
+}
@@ -1,12 +1,15 @@
 package contract_test
 
 import (
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/go-chi/chi/v5"
 
+	"github.com/mcmx/duplynx/internal/actions"
 	apphttp "github.com/mcmx/duplynx/internal/http"
 	"github.com/mcmx/duplynx/internal/scans"
 	"github.com/mcmx/duplynx/internal/tenancy"
@@ -27,6 +30,7 @@ func setupBoardRouter(t *testing.T) boardHarness {
 	router := apphttp.NewRouter(apphttp.Dependencies{
 		TenancyRepo: tenancyRepo,
 		ScanRepo:    scanRepo,
+		ActionsRepo: actions.NewRepositoryFromClient(seed.Client),
 	})
 
 	return boardHarness{router: router, seed: seed}
@@ -62,3 +66,122 @@ func TestScanBoardContract(t *testing.T) {
 		t.Fatalf("expected 200, got %d", rec.Code)
 	}
 }
+
+func TestScanBoardRendersLanesAndCards(t *testing.T) {
+	harness := setupBoardRouter(t)
+	scan := harness.seed.Dataset.Scans[0]
+	tenantSlug := testutil.TenantSlugFor(t, harness.seed.Dataset, scan.TenantID)
+
+	req := httptest.NewRequest(http.MethodGet, "/scans/"+scan.ID.String(), nil)
+	req.Header.Set(tenancy.HeaderTenantSlug, tenantSlug)
+	req.Header.Set("Accept", "text/html")
+	rec := httptest.NewRecorder()
+	harness.router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+	if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/html") {
+		t.Fatalf("expected HTML board, got %q", ct)
+	}
+	body := rec.Body.String()
+	for _, lane := range []string{"review", "action_needed", "resolved", "archived"} {
+		if !strings.Contains(body, `data-lane="`+lane+`"`) {
+			t.Fatalf("expected lane %s in board markup", lane)
+		}
+	}
+	for _, group := range harness.seed.Dataset.DuplicateGroups {
+		if group.ScanID != scan.ID {
+			continue
+		}
+		if !strings.Contains(body, group.Hash) {
+			t.Fatalf("expected card for group %s", group.Hash)
+		}
+		if !strings.Contains(body, "/duplicate-groups/"+group.ID.String()+"/keeper") {
+			t.Fatalf("expected keeper form for group %s", group.ID)
+		}
+	}
+}
+
+func TestScanBoardJSONNegotiation(t *testing.T) {
+	harness := setupBoardRouter(t)
+	scan := harness.seed.Dataset.Scans[0]
+	tenantSlug := testutil.TenantSlugFor(t, harness.seed.Dataset, scan.TenantID)
+
+	req := httptest.NewRequest(http.MethodGet, "/scans/"+scan.ID.String(), nil)
+	req.Header.Set(tenancy.HeaderTenantSlug, tenantSlug)
+	req.Header.Set("Accept", "application/json")
+	rec := httptest.NewRecorder()
+	harness.router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+	var summary scans.ScanSummary
+	if err := json.NewDecoder(rec.Body).Decode(&summary); err != nil {
+		t.Fatalf("expected JSON summary: %v", err)
+	}
+	if summary.ID != scan.ID.String() || summary.DuplicateGroupCount != scan.DuplicateGroupCount {
+		t.Fatalf("unexpected summary: %+v", summary)
+	}
+}
+
+func TestScanBoardPagesLanesThroughQueryParams(t *testing.T) {
+	harness := setupBoardRouter(t)
+	scan := harness.seed.Dataset.Scans[0]
+	tenantSlug := testutil.TenantSlugFor(t, harness.seed.Dataset, scan.TenantID)
+	var reviewGroups int
+	for _, group := range harness.seed.Dataset.DuplicateGroups {
+		if group.ScanID == scan.ID && group.Status == "review" {
+			reviewGroups++
+		}
+	}
+	if reviewGroups == 0 {
+		t.Fatalf("expected seeded review groups in scan %s", scan.ID)
+	}
+
+	get := func(target string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, target, nil)
+		req.Header.Set(tenancy.HeaderTenantSlug, tenantSlug)
+		req.Header.Set("Accept", "text/html")
+		rec := httptest.NewRecorder()
+		harness.router.ServeHTTP(rec, req)
+		return rec
+	}
+
+	// A view with no matches still renders every lane with the filter applied.
+	rec := get("/scans/" + scan.ID.String() + "?sort=hash&keeper=unset&limit=1")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	body := rec.Body.String()
+	if !strings.Contains(body, `<option value="hash" selected>`) || !strings.Contains(body, `<option value="unset" selected>`) {
+		t.Fatalf("expected filter form to reflect the query parameters")
+	}
+
+	rec = get("/scans/" + scan.ID.String() + "?sort=hash&limit=1")
+	body = rec.Body.String()
+	if strings.Count(body, `class="duplicate-card`) > 4 {
+		t.Fatalf("expected at most one card per lane with limit=1")
+	}
+	if reviewGroups > 1 {
+		start := strings.Index(body, `rel="next" href="`)
+		if start < 0 {
+			t.Fatalf("expected a next page link for the review lane")
+		}
+		next := body[start+len(`rel="next" href="`):]
+		next = strings.ReplaceAll(next[:strings.Index(next, `"`)], "&amp;", "&")
+		if !strings.Contains(next, "sort=hash") || !strings.Contains(next, "cursor_") {
+			t.Fatalf("expected next link to carry the view and a lane cursor, got %s", next)
+		}
+		if rec := get(next); rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), "Back to first page") {
+			t.Fatalf("expected second page to render, got %d", rec.Code)
+		}
+	}
+
+	for _, bad := range []string{"sort=size", "keeper=maybe", "min_size=-1", "cursor_review=%21%21"} {
+		if rec := get("/scans/" + scan.ID.String() + "?" + bad); rec.Code != http.StatusBadRequest {
+			t.Fatalf("expected 400 for %s, got %d", bad, rec.Code)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package contract_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apphttp "github.com/mcmx/duplynx/internal/http"
+	"github.com/mcmx/duplynx/internal/http/handlers"
+	"github.com/mcmx/duplynx/internal/schedule"
+	"github.com/mcmx/duplynx/internal/tenancy"
+	"github.com/mcmx/duplynx/tests/testutil"
+)
+
+func TestScheduleCRUDContract(t *testing.T) {
+	seed := testutil.NewSeededClient(t)
+	tenant := seed.Dataset.Tenants[0]
+	other := seed.Dataset.Tenants[1]
+	router := apphttp.NewRouter(apphttp.Dependencies{
+		TenancyRepo: tenancy.NewRepositoryFromClient(seed.Client, &tenancy.AuditLogger{}),
+		Schedules:   schedule.NewRepositoryFromClient(seed.Client),
+	})
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+
+	machines := testutil.MachineIDsForTenant(seed.Dataset, tenant.ID)
+	base := server.URL + "/tenants/" + tenant.Slug + "/schedules"
+
+	if status := sendSchedule(t, http.MethodPost, base, tenant.Slug, map[string]any{
+		"name": "Broken", "cron": "61 * * * *", "machineIds": []string{machines[0].String()}, "roots": []string{"/srv"},
+	}, nil); status != http.StatusBadRequest {
+		t.Fatalf("expected an invalid cron expression to be rejected, got %d", status)
+	}
+
+	var created handlers.ScheduleSummary
+	status := sendSchedule(t, http.MethodPost, base, tenant.Slug, map[string]any{
+		"name":       "Weeknights",
+		"cron":       "0 22 * * mon-fri",
+		"timezone":   "America/New_York",
+		"machineIds": []string{machines[0].String(), machines[1].String()},
+		"roots":      []string{"/home"},
+		"exclusions": []string{"node_modules"},
+		"deadline":   "4h",
+	}, &created)
+	if status != http.StatusCreated {
+		t.Fatalf("expected schedule to be created, got %d", status)
+	}
+	if created.ID == "" || !created.Enabled || created.Deadline != "4h0m0s" || created.NextRunAt.IsZero() || len(created.MachineIDs) != 2 {
+		t.Fatalf("unexpected schedule %+v", created)
+	}
+
+	var listed struct {
+		Schedules []handlers.ScheduleSummary `json:"schedules"`
+	}
+	if status := sendSchedule(t, http.MethodGet, base, tenant.Slug, nil, &listed); status != http.StatusOK || len(listed.Schedules) != 1 {
+		t.Fatalf("expected one listed schedule, got %d %+v", status, listed)
+	}
+	otherBase := server.URL + "/tenants/" + other.Slug + "/schedules/" + created.ID
+	if status := sendSchedule(t, http.MethodGet, otherBase, other.Slug, nil, nil); status != http.StatusNotFound {
+		t.Fatalf("expected the schedule to be hidden from another tenant, got %d", status)
+	}
+
+	var updated handlers.ScheduleSummary
+	if status := sendSchedule(t, http.MethodPut, base+"/"+created.ID, tenant.Slug, map[string]any{
+		"name":       "Weeknights",
+		"cron":       "@daily",
+		"machineIds": []string{machines[0].String()},
+		"roots":      []string{"/home", "/srv"},
+		"enabled":    false,
+	}, &updated); status != http.StatusOK {
+		t.Fatalf("expected schedule to be updated, got %d", status)
+	}
+	if updated.Enabled || updated.Cron != "@daily" || updated.Timezone != "UTC" || len(updated.Roots) != 2 || updated.Deadline != "" {
+		t.Fatalf("unexpected updated schedule %+v", updated)
+	}
+
+	var shown handlers.ScheduleSummary
+	if status := sendSchedule(t, http.MethodGet, base+"/"+created.ID, tenant.Slug, nil, &shown); status != http.StatusOK || shown.ID != created.ID || len(shown.Runs) != 0 {
+		t.Fatalf("unexpected shown schedule %d %+v", status, shown)
+	}
+
+	if status := sendSchedule(t, http.MethodDelete, base+"/"+created.ID, tenant.Slug, nil, nil); status != http.StatusNoContent {
+		t.Fatalf("expected schedule to be deleted, got %d", status)
+	}
+	if status := sendSchedule(t, http.MethodGet, base+"/"+created.ID, tenant.Slug, nil, nil); status != http.StatusNotFound {
+		t.Fatalf("expected deleted schedule to 404, got %d", status)
+	}
+}
+
+func sendSchedule(t *testing.T, method, url, tenantSlug string, body, out any) int {
+	t.Helper()
+	var payload bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&payload).Encode(body); err != nil {
+			t.Fatalf("encode body: %v", err)
+		}
+	}
+	req, err := http.NewRequest(method, url, &payload)
+	if err != nil {
+		t.Fatalf("build request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(tenancy.HeaderTenantSlug, tenantSlug)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("%s %s: %v", method, url, err)
+	}
+	defer resp.Body.Close()
+	if out != nil && resp.StatusCode < 300 {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+	}
+	return resp.StatusCode
+}
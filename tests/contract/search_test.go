@@ -0,0 +1,44 @@
+package contract_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/mcmx/duplynx/internal/http/handlers"
+	"github.com/mcmx/duplynx/tests/testutil"
+)
+
+func TestSearchEndpoint(t *testing.T) {
+	harness := setupActionsRouter(t)
+	group := harness.dataset.Dataset.DuplicateGroups[0]
+	tenantSlug := testutil.TenantSlugFor(t, harness.dataset.Dataset, group.TenantID)
+	base := "/tenants/" + tenantSlug + "/search"
+
+	resp, body := getGroupPage(t, harness, base+"?q=Q4-plan", tenantSlug, "")
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", resp.StatusCode, body)
+	}
+	var result handlers.SearchResponse
+	if err := json.Unmarshal([]byte(body), &result); err != nil {
+		t.Fatalf("decode search: %v", err)
+	}
+	if result.Kind != "name" || len(result.Groups) != 1 || result.Groups[0].ID != group.ID.String() || result.Files != 3 {
+		t.Fatalf("expected the finance group's three copies, got %+v", result)
+	}
+
+	_, page := getGroupPage(t, harness, base+"?q=*.pptx", tenantSlug, "text/html")
+	if !strings.Contains(page, `data-group="`+group.ID.String()+`"`) || !strings.Contains(page, `data-matched-by="name"`) {
+		t.Fatalf("expected rendered matches linking to the group")
+	}
+	if resp, page := getGroupPage(t, harness, base, tenantSlug, "text/html"); resp.StatusCode != http.StatusOK || !strings.Contains(page, `role="search"`) {
+		t.Fatalf("expected the empty search form, got %d", resp.StatusCode)
+	}
+
+	for _, query := range []string{"?q=ab", "?q=plan&kind=fuzzy", "?q=plan&limit=0"} {
+		if resp, _ := getGroupPage(t, harness, base+query, tenantSlug, ""); resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("%s: expected 400, got %d", query, resp.StatusCode)
+		}
+	}
+}
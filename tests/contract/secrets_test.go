@@ -0,0 +1,54 @@
+package contract_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apphttp "github.com/mcmx/duplynx/internal/http"
+	"github.com/mcmx/duplynx/internal/http/handlers"
+	"github.com/mcmx/duplynx/internal/ingestion"
+	"github.com/mcmx/duplynx/internal/tenancy"
+	"github.com/mcmx/duplynx/tests/testutil"
+)
+
+func TestSecretsAPIIsReadOnly(t *testing.T) {
+	seed := testutil.NewSeededClient(t)
+	tenant := seed.Dataset.Tenants[1]
+	secretRepo := ingestion.NewSecretRepositoryFromClient(seed.Client)
+	version, err := secretRepo.Rotate(testutil.SystemContext(), tenant.Slug, ingestion.RotateOptions{})
+	if err != nil {
+		t.Fatalf("rotate: %v", err)
+	}
+	router := apphttp.NewRouter(apphttp.Dependencies{
+		TenancyRepo: tenancy.NewRepositoryFromClient(seed.Client, &tenancy.AuditLogger{}),
+		SecretRepo:  secretRepo,
+	})
+
+	for _, tc := range []struct{ method, path string }{
+		{http.MethodPost, "/tenants/" + tenant.Slug + "/secrets/rotate"},
+		{http.MethodDelete, "/tenants/" + tenant.Slug + "/secrets/" + version.KeyID},
+	} {
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(tc.method, tc.path, nil))
+		if rec.Code < 400 {
+			t.Fatalf("%s %s must not be served, got %d: %s", tc.method, tc.path, rec.Code, rec.Body.String())
+		}
+	}
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/tenants/"+tenant.Slug+"/secrets", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected secrets to be listed, got %d", rec.Code)
+	}
+	var listed struct {
+		Secrets []handlers.SecretSummary `json:"secrets"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &listed); err != nil {
+		t.Fatalf("decode secrets: %v", err)
+	}
+	if len(listed.Secrets) != 1 || listed.Secrets[0].KeyID != version.KeyID || listed.Secrets[0].RevokedAt != nil {
+		t.Fatalf("expected the one untouched version, got %+v", listed.Secrets)
+	}
+}
@@ -2,7 +2,6 @@ package integration_test
 
 import (
 	"bytes"
-	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -53,7 +52,7 @@ func TestActionLoggingPipeline(t *testing.T) {
 	if len(entries) == 0 || entries[0].Type != "assign_keeper" {
 		t.Fatalf("expected assign_keeper audit entry, got %#v", entries)
 	}
-	updated, err := actionsRepo.Get(context.Background(), group.ID)
+	updated, err := actionsRepo.Get(testutil.TenantContext(group.TenantID), group.ID)
 	if err != nil {
 		t.Fatalf("reload duplicate group: %v", err)
 	}
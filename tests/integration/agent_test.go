@@ -0,0 +1,188 @@
+package integration_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	entduplicategroup "github.com/mcmx/duplynx/ent/duplicategroup"
+	"github.com/mcmx/duplynx/internal/actions"
+	"github.com/mcmx/duplynx/internal/agent"
+	apphttp "github.com/mcmx/duplynx/internal/http"
+	"github.com/mcmx/duplynx/internal/ingestion"
+	"github.com/mcmx/duplynx/internal/scans"
+	"github.com/mcmx/duplynx/internal/tenancy"
+	"github.com/mcmx/duplynx/tests/testutil"
+)
+
+func TestAgentDaemonRunsCampaignScans(t *testing.T) {
+	seed := testutil.NewSeededClient(t)
+	tenant := seed.Dataset.Tenants[0]
+	scanRepo := scans.NewRepositoryFromClient(seed.Client)
+	secretRepo := ingestion.NewSecretRepositoryFromClient(seed.Client)
+	actionsRepo := actions.NewRepositoryFromClient(seed.Client)
+	dispatcher := actions.NewDispatcher(actionsRepo, &actions.AuditLogger{})
+	dispatcher.RunOnAgents = true
+	server := httptest.NewServer(apphttp.NewRouter(apphttp.Dependencies{
+		TenancyRepo:       tenancy.NewRepositoryFromClient(seed.Client, &tenancy.AuditLogger{}),
+		ScanRepo:          scanRepo,
+		ActionsRepo:       actionsRepo,
+		ActionsDispatcher: dispatcher,
+		SecretRepo:        secretRepo,
+	}))
+	t.Cleanup(server.Close)
+	machineID := testutil.MachineIDsForTenant(seed.Dataset, tenant.ID)[0]
+	secret, err := secretRepo.Rotate(testutil.SystemContext(), tenant.Slug, ingestion.RotateOptions{MachineID: machineID})
+	if err != nil {
+		t.Fatalf("issue machine secret: %v", err)
+	}
+
+	root := t.TempDir()
+	write := func(name, body string) {
+		t.Helper()
+		path := filepath.Join(root, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(body), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("a/report.pdf", "same bytes")
+	write("b/report-copy.pdf", "same bytes")
+	write("c/other.pdf", "other byte")
+	write("cache/report.pdf", "same bytes")
+
+	results := make(chan agent.JobResult, 4)
+	daemon := &agent.Agent{
+		Client:            &agent.Client{BaseURL: server.URL, Tenant: tenant.Slug, Secret: secret.Secret, KeyID: secret.KeyID},
+		MachineID:         machineID.String(),
+		Concurrency:       2,
+		PollWait:          2 * time.Second,
+		HeartbeatInterval: 50 * time.Millisecond,
+		OnJob:             func(result agent.JobResult) { results <- result },
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan error, 1)
+	go func() { stopped <- daemon.Run(ctx) }()
+
+	// The agent is already waiting on its long poll when the scan is created.
+	time.Sleep(200 * time.Millisecond)
+	campaign, err := scanRepo.CreateCampaign(testutil.TenantContext(tenant.ID), scans.CampaignInput{
+		Name:    "Agent Sweep",
+		Targets: []scans.TargetInput{{MachineID: machineID, Roots: []string{root}, Exclusions: []string{"cache"}}},
+	})
+	if err != nil {
+		t.Fatalf("create campaign: %v", err)
+	}
+
+	select {
+	case result := <-results:
+		if result.ScanID != campaign.ID || result.Outcome != agent.JobReported || result.Err != nil {
+			t.Fatalf("unexpected job result %+v", result)
+		}
+		if result.Groups != 1 || result.Progress.FilesSeen != 3 {
+			t.Fatalf("expected one duplicate group in three files, got %+v", result)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("agent did not finish the scan")
+	}
+
+	summary, err := scanRepo.Get(testutil.TenantContext(tenant.ID), campaign.ID)
+	if err != nil {
+		t.Fatalf("get scan: %v", err)
+	}
+	if summary.Lifecycle() != scans.StatusCompleted || summary.FilesSeen != 3 {
+		t.Fatalf("expected the agent to complete the scan, got %+v", summary)
+	}
+	groups, err := seed.Client.DuplicateGroup.Query().
+		Where(entduplicategroup.ScanID(uuid.MustParse(campaign.ID))).
+		WithFileInstances().
+		All(testutil.TenantContext(tenant.ID))
+	if err != nil {
+		t.Fatalf("list duplicate groups: %v", err)
+	}
+	if len(groups) != 1 || summary.DuplicateGroupCount != 1 {
+		t.Fatalf("expected the manifest's one group to be stored, got %d groups and summary %+v", len(groups), summary)
+	}
+	if files := groups[0].Edges.FileInstances; len(files) != 2 || files[0].MachineID != machineID || files[1].MachineID != machineID {
+		t.Fatalf("expected both copies on the agent's machine, got %+v", files)
+	}
+	if groups[0].ContentIdentityID == uuid.Nil {
+		t.Fatalf("expected the completed scan's group to be linked to its content identity, got %+v", groups[0])
+	}
+	machine, err := seed.Client.Machine.Get(testutil.TenantContext(tenant.ID), machineID)
+	if err != nil {
+		t.Fatalf("get machine: %v", err)
+	}
+	if machine.LastScanAt.IsZero() || machine.LastSeenAt.IsZero() {
+		t.Fatalf("expected last_scan_at and last_seen_at to be stamped, got %+v", machine)
+	}
+
+	// The agent replaces the second copy with a hard link to the first.
+	if err := dispatcher.PerformAction(testutil.TenantContext(tenant.ID), groups[0].ID.String(), tenant.Slug, "system", actions.ActionHardlink, nil); err != nil {
+		t.Fatalf("queue hardlink: %v", err)
+	}
+	select {
+	case result := <-results:
+		if result.Action != string(actions.ActionHardlink) || result.Outcome != agent.JobReported || result.Done != 1 || result.Err != nil {
+			t.Fatalf("unexpected action result %+v", result)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("agent did not run the action")
+	}
+	first, err := os.Stat(filepath.Join(root, "a/report.pdf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := os.Stat(filepath.Join(root, "b/report-copy.pdf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !os.SameFile(first, second) {
+		t.Fatal("expected the copies to be hard linked")
+	}
+	timeline, err := actionsRepo.Timeline(testutil.TenantContext(tenant.ID), groups[0].ID)
+	if err != nil {
+		t.Fatalf("load timeline: %v", err)
+	}
+	if last := timeline[len(timeline)-1]; last.Type != string(actions.ActionHardlink) || last.Stubbed {
+		t.Fatalf("expected the hardlink to be audited once the agent reported, got %+v", timeline)
+	}
+
+	cancel()
+	select {
+	case err := <-stopped:
+		if err != nil {
+			t.Fatalf("agent stopped with %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("agent did not stop")
+	}
+}
+
+func TestAgentStopsWhenCredentialsAreRejected(t *testing.T) {
+	seed := testutil.NewSeededClient(t)
+	tenant := seed.Dataset.Tenants[0]
+	server := httptest.NewServer(apphttp.NewRouter(apphttp.Dependencies{
+		TenancyRepo:         tenancy.NewRepositoryFromClient(seed.Client, &tenancy.AuditLogger{}),
+		ScanRepo:            scans.NewRepositoryFromClient(seed.Client),
+		LegacyTenantSecrets: map[string]string{tenant.Slug: "right"},
+	}))
+	t.Cleanup(server.Close)
+
+	daemon := &agent.Agent{
+		Client:    &agent.Client{BaseURL: server.URL, Tenant: tenant.Slug, Secret: "wrong"},
+		MachineID: testutil.MachineIDsForTenant(seed.Dataset, tenant.ID)[0].String(),
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := daemon.Run(ctx); err == nil || ctx.Err() != nil {
+		t.Fatalf("expected a credential error before the timeout, got %v", err)
+	}
+}
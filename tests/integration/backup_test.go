@@ -0,0 +1,218 @@
+package integration_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mcmx/duplynx/ent"
+	"github.com/mcmx/duplynx/internal/backup"
+	"github.com/mcmx/duplynx/internal/data"
+	"github.com/mcmx/duplynx/tests/testutil"
+)
+
+// openSeededSQLiteFile seeds a file-backed database, since backups copy files.
+func openSeededSQLiteFile(t *testing.T, path string) *ent.Client {
+	t.Helper()
+	ctx := context.Background()
+	client, err := data.OpenSQLite(ctx, "file:"+path+"?_fk=1&_busy_timeout=5000")
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+	if _, err := data.SeedDemoDataset(ctx, client, data.CanonicalDemoDataset()); err != nil {
+		t.Fatalf("seed demo dataset: %v", err)
+	}
+	return client
+}
+
+func TestBackupAndRestoreRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	client := openSeededSQLiteFile(t, filepath.Join(dir, "live.db"))
+	ctx := testutil.SystemContext()
+
+	tenants, err := client.Tenant.Query().Count(ctx)
+	if err != nil {
+		t.Fatalf("count tenants: %v", err)
+	}
+
+	for _, compress := range []bool{false, true} {
+		out := filepath.Join(dir, "copy.db")
+		if compress {
+			out += ".gz"
+		}
+		// The live client stays open throughout, as it would under serve.
+		result, err := backup.Backup(ctx, client, out, backup.Options{Compress: compress, Verify: true})
+		if err != nil {
+			t.Fatalf("backup (compress=%v): %v", compress, err)
+		}
+		if !result.Verified || result.Compressed != compress || result.Bytes == 0 || result.SchemaVersion == "" {
+			t.Fatalf("unexpected backup result: %+v", result)
+		}
+		if _, err := backup.Backup(ctx, client, out, backup.Options{}); !errors.Is(err, backup.ErrOutputExists) {
+			t.Fatalf("expected ErrOutputExists, got %v", err)
+		}
+
+		target := filepath.Join(dir, "restored.db")
+		if err := os.WriteFile(target, []byte("previous"), 0o600); err != nil {
+			t.Fatalf("write previous database: %v", err)
+		}
+		restored, err := backup.Restore(context.Background(), out, target, backup.RestoreOptions{KeepPrevious: true})
+		if err != nil {
+			t.Fatalf("restore (compress=%v): %v", compress, err)
+		}
+		if restored.SchemaVersion != result.SchemaVersion || restored.Behind {
+			t.Fatalf("unexpected restore result: %+v", restored)
+		}
+		if previous, err := os.ReadFile(restored.Previous); err != nil || string(previous) != "previous" {
+			t.Fatalf("expected the replaced database to be kept, got %q (%v)", previous, err)
+		}
+
+		copyClient, err := data.OpenSQLite(ctx, "file:"+target+"?_fk=1")
+		if err != nil {
+			t.Fatalf("open restored database: %v", err)
+		}
+		if err := data.CheckSchema(ctx, copyClient); err != nil {
+			t.Fatalf("restored schema not current: %v", err)
+		}
+		got, err := copyClient.Tenant.Query().Count(ctx)
+		_ = copyClient.Close()
+		if err != nil || got != tenants {
+			t.Fatalf("expected %d restored tenants, got %d (%v)", tenants, got, err)
+		}
+		for _, path := range []string{target, restored.Previous} {
+			_ = os.Remove(path)
+		}
+	}
+}
+
+func TestRestoreKeepsWALDataAndRefusesWhileServing(t *testing.T) {
+	dir := t.TempDir()
+	ctx := testutil.SystemContext()
+	source := openSeededSQLiteFile(t, filepath.Join(dir, "source.db"))
+	out := filepath.Join(dir, "source-backup.db")
+	if _, err := backup.Backup(ctx, source, out, backup.Options{Verify: true}); err != nil {
+		t.Fatalf("backup: %v", err)
+	}
+
+	target := filepath.Join(dir, "live.db")
+	live, err := data.OpenSQLite(ctx, "file:"+target+"?_fk=1&_busy_timeout=5000&_journal_mode=WAL")
+	if err != nil {
+		t.Fatalf("open live database: %v", err)
+	}
+	t.Cleanup(func() { _ = live.Close() })
+	if err := data.Migrate(ctx, live); err != nil {
+		t.Fatalf("migrate live database: %v", err)
+	}
+	if _, err := live.Tenant.Create().SetSlug("wal-only").SetName("Only In The WAL").Save(ctx); err != nil {
+		t.Fatalf("create tenant: %v", err)
+	}
+	if info, err := os.Stat(target + "-wal"); err != nil || info.Size() == 0 {
+		t.Fatalf("expected the live database to have a write-ahead log (%v)", err)
+	}
+
+	serving, err := data.LockDatabase(target, false)
+	if err != nil {
+		t.Fatalf("take the serve lock: %v", err)
+	}
+	if _, err := backup.Restore(ctx, out, target, backup.RestoreOptions{KeepPrevious: true}); !errors.Is(err, data.ErrDatabaseInUse) {
+		t.Fatalf("expected ErrDatabaseInUse while serve holds the lock, got %v", err)
+	}
+	if err := serving.Release(); err != nil {
+		t.Fatalf("release the serve lock: %v", err)
+	}
+
+	restored, err := backup.Restore(ctx, out, target, backup.RestoreOptions{KeepPrevious: true})
+	if err != nil {
+		t.Fatalf("restore: %v", err)
+	}
+	previous, err := data.OpenSQLite(ctx, "file:"+restored.Previous+"?_fk=1")
+	if err != nil {
+		t.Fatalf("open kept database: %v", err)
+	}
+	defer previous.Close()
+	if n, err := previous.Tenant.Query().Count(ctx); err != nil || n != 1 {
+		t.Fatalf("expected the kept database to include the WAL's tenant, got %d (%v)", n, err)
+	}
+}
+
+func TestRestoreRejectsIncompatibleBackups(t *testing.T) {
+	dir := t.TempDir()
+	ctx := testutil.SystemContext()
+	target := filepath.Join(dir, "target.db")
+
+	garbage := filepath.Join(dir, "garbage.db")
+	if err := os.WriteFile(garbage, []byte("not a database"), 0o600); err != nil {
+		t.Fatalf("write garbage: %v", err)
+	}
+	if _, err := backup.Restore(ctx, garbage, target, backup.RestoreOptions{}); err == nil {
+		t.Fatal("expected a non-database file to be rejected")
+	}
+
+	client := openSeededSQLiteFile(t, filepath.Join(dir, "future.db"))
+	if _, err := client.ExecContext(ctx, "INSERT INTO schema_migrations (version, name, checksum, applied_at) VALUES ('99990101000000', 'from_the_future', 'x', ?)", time.Now().UTC().Format(time.RFC3339)); err != nil {
+		t.Fatalf("record future migration: %v", err)
+	}
+	out := filepath.Join(dir, "future-backup.db")
+	if _, err := backup.Backup(ctx, client, out, backup.Options{Verify: true}); err != nil {
+		t.Fatalf("backup: %v", err)
+	}
+	if _, err := backup.Restore(ctx, out, target, backup.RestoreOptions{}); !errors.Is(err, backup.ErrIncompatibleBackup) {
+		t.Fatalf("expected ErrIncompatibleBackup, got %v", err)
+	}
+	if _, err := os.Stat(target); !os.IsNotExist(err) {
+		t.Fatalf("expected no database to be swapped in, stat err %v", err)
+	}
+}
+
+func TestSchedulerPrunesToRetention(t *testing.T) {
+	dir := t.TempDir()
+	client := openSeededSQLiteFile(t, filepath.Join(dir, "live.db"))
+	backups := filepath.Join(dir, "backups")
+	if err := os.MkdirAll(backups, 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	unrelated := filepath.Join(backups, "keep-me.db")
+	if err := os.WriteFile(unrelated, nil, 0o600); err != nil {
+		t.Fatalf("write unrelated file: %v", err)
+	}
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	scheduler := &backup.Scheduler{
+		Client:  client,
+		Dir:     backups,
+		Keep:    2,
+		Options: backup.Options{Compress: true, Verify: true},
+		Now:     func() time.Time { return now },
+	}
+	var pruned []string
+	for i := 0; i < 4; i++ {
+		_, removed, err := scheduler.Tick(testutil.SystemContext())
+		if err != nil {
+			t.Fatalf("tick %d: %v", i, err)
+		}
+		pruned = append(pruned, removed...)
+		now = now.Add(time.Hour)
+	}
+	if len(pruned) != 2 || !strings.Contains(pruned[0], "20250101T000000") {
+		t.Fatalf("expected the two oldest backups pruned, got %v", pruned)
+	}
+	entries, err := os.ReadDir(backups)
+	if err != nil {
+		t.Fatalf("read backups: %v", err)
+	}
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	want := []string{"duplynx-20250101T020000.000Z.db.gz", "duplynx-20250101T030000.000Z.db.gz", "keep-me.db"}
+	if strings.Join(names, ",") != strings.Join(want, ",") {
+		t.Fatalf("expected %v, got %v", want, names)
+	}
+}
@@ -0,0 +1,229 @@
+package integration_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/mcmx/duplynx/internal/actions"
+	"github.com/mcmx/duplynx/tests/testutil"
+)
+
+type boardFixture struct {
+	seed     testutil.SeededClient
+	repo     *actions.Repository
+	tenantID uuid.UUID
+	scanID   uuid.UUID
+	machines []uuid.UUID
+}
+
+// setupBoardFixture adds twelve review groups to the first seeded scan: group i
+// has i%3+2 copies of an (i+1) KiB file, every fourth group has a keeper, and
+// every fifth group has a quarantined copy under /quarantine.
+func setupBoardFixture(t *testing.T) boardFixture {
+	t.Helper()
+	seed := testutil.NewSeededClient(t)
+	scan := seed.Dataset.Scans[0]
+	fx := boardFixture{
+		seed:     seed,
+		repo:     actions.NewRepositoryFromClient(seed.Client),
+		tenantID: scan.TenantID,
+		scanID:   scan.ID,
+		machines: testutil.MachineIDsForTenant(seed.Dataset, scan.TenantID),
+	}
+	ctx := testutil.SystemContext()
+	for i := 0; i < 12; i++ {
+		copies := i%3 + 2
+		size := int64(i+1) * 1024
+		builder := seed.Client.DuplicateGroup.Create().
+			SetTenantID(fx.tenantID).
+			SetScanID(fx.scanID).
+			SetHash(fmt.Sprintf("hash:paged-%02d", i)).
+			SetStatus("review").
+			SetFileCount(copies).
+			SetTotalSizeBytes(size * int64(copies))
+		if i%4 == 0 {
+			builder.SetKeeperMachineID(fx.machines[0])
+		}
+		group, err := builder.Save(ctx)
+		if err != nil {
+			t.Fatalf("create group: %v", err)
+		}
+		for c := 0; c < copies; c++ {
+			dir := "/data"
+			if i%5 == 0 && c == 0 {
+				dir = "/quarantine"
+			}
+			if _, err := seed.Client.FileInstance.Create().
+				SetTenantID(fx.tenantID).
+				SetDuplicateGroupID(group.ID).
+				SetMachineID(fx.machines[c%len(fx.machines)]).
+				SetPath(fmt.Sprintf("%s/paged-%02d-%d.bin", dir, i, c)).
+				SetSizeBytes(size).
+				SetChecksum(group.Hash).
+				SetQuarantined(dir == "/quarantine").
+				Save(ctx); err != nil {
+				t.Fatalf("create file: %v", err)
+			}
+		}
+	}
+	return fx
+}
+
+// walkLane follows the lane's cursors to the end and returns every group in order.
+func (fx boardFixture) walkLane(t *testing.T, q actions.BoardQuery) []actions.DuplicateGroup {
+	t.Helper()
+	ctx := testutil.TenantContext(fx.tenantID)
+	var out []actions.DuplicateGroup
+	for pages := 0; ; pages++ {
+		if pages > 50 {
+			t.Fatalf("pagination did not terminate")
+		}
+		page, err := fx.repo.ListLane(ctx, fx.scanID, "review", q)
+		if err != nil {
+			t.Fatalf("list lane: %v", err)
+		}
+		if len(page.Groups) > q.Limit {
+			t.Fatalf("page of %d exceeds limit %d", len(page.Groups), q.Limit)
+		}
+		out = append(out, page.Groups...)
+		if page.NextCursor == "" {
+			if len(out) != page.Total {
+				t.Fatalf("walked %d groups, lane total is %d", len(out), page.Total)
+			}
+			return out
+		}
+		q = q.WithCursor("review", page.NextCursor)
+	}
+}
+
+func reclaimable(group actions.DuplicateGroup) int64 {
+	var total int64
+	for _, file := range group.Files {
+		total += file.SizeBytes
+	}
+	return actions.ReclaimableBytes(total, len(group.Files))
+}
+
+func TestLanePaginationVisitsEveryGroupOnceInOrder(t *testing.T) {
+	fx := setupBoardFixture(t)
+
+	for _, sortKey := range []actions.SortKey{actions.SortReclaimable, actions.SortFileCount, actions.SortHash} {
+		for _, desc := range []bool{true, false} {
+			q := actions.DefaultBoardQuery()
+			q.Sort, q.Descending, q.Limit = sortKey, desc, 5
+			groups := fx.walkLane(t, q)
+
+			seen := map[string]bool{}
+			for i, group := range groups {
+				if seen[group.ID] {
+					t.Fatalf("%s desc=%v: group %s returned twice", sortKey, desc, group.ID)
+				}
+				seen[group.ID] = true
+				if i == 0 {
+					continue
+				}
+				prev := groups[i-1]
+				var cmp int
+				switch sortKey {
+				case actions.SortReclaimable:
+					cmp = compareInt(reclaimable(prev), reclaimable(group))
+				case actions.SortFileCount:
+					cmp = compareInt(int64(len(prev.Files)), int64(len(group.Files)))
+				case actions.SortHash:
+					cmp = compareString(prev.Hash, group.Hash)
+				}
+				if (desc && cmp < 0) || (!desc && cmp > 0) {
+					t.Fatalf("%s desc=%v: groups out of order at %d (%s before %s)", sortKey, desc, i, prev.Hash, group.Hash)
+				}
+			}
+		}
+	}
+}
+
+func TestLaneFilters(t *testing.T) {
+	fx := setupBoardFixture(t)
+	yes, no := true, false
+
+	cases := []struct {
+		name   string
+		filter actions.BoardFilter
+		want   func(actions.DuplicateGroup) bool
+	}{
+		{"min size", actions.BoardFilter{MinSizeBytes: 10 * 1024}, func(g actions.DuplicateGroup) bool {
+			return g.Files[0].SizeBytes >= 10*1024
+		}},
+		{"path prefix", actions.BoardFilter{PathPrefix: "/quarantine/"}, func(g actions.DuplicateGroup) bool {
+			return hasFile(g, func(f actions.DuplicateFile) bool { return len(f.Path) > 12 && f.Path[:12] == "/quarantine/" })
+		}},
+		{"machine", actions.BoardFilter{MachineID: fx.machines[2%len(fx.machines)].String()}, func(g actions.DuplicateGroup) bool {
+			return hasFile(g, func(f actions.DuplicateFile) bool { return f.MachineID == fx.machines[2%len(fx.machines)].String() })
+		}},
+		{"keeper set", actions.BoardFilter{KeeperSet: &yes}, func(g actions.DuplicateGroup) bool { return g.KeeperMachineID != "" }},
+		{"keeper unset", actions.BoardFilter{KeeperSet: &no}, func(g actions.DuplicateGroup) bool { return g.KeeperMachineID == "" }},
+		{"quarantined", actions.BoardFilter{Quarantined: &yes}, func(g actions.DuplicateGroup) bool {
+			return hasFile(g, func(f actions.DuplicateFile) bool { return f.Quarantined })
+		}},
+		{"not quarantined", actions.BoardFilter{Quarantined: &no}, func(g actions.DuplicateGroup) bool {
+			return !hasFile(g, func(f actions.DuplicateFile) bool { return f.Quarantined })
+		}},
+	}
+
+	all := fx.walkLane(t, actions.BoardQuery{Sort: actions.SortHash, Limit: 100})
+	for _, tc := range cases {
+		q := actions.BoardQuery{Filter: tc.filter, Sort: actions.SortHash, Limit: 3}
+		got := fx.walkLane(t, q)
+		var want int
+		for _, group := range all {
+			if tc.want(group) {
+				want++
+			}
+		}
+		if len(got) != want || want == 0 {
+			t.Fatalf("%s: expected %d groups, got %d", tc.name, want, len(got))
+		}
+		for _, group := range got {
+			if !tc.want(group) {
+				t.Fatalf("%s: group %s should have been filtered out", tc.name, group.Hash)
+			}
+		}
+	}
+}
+
+func TestLaneRejectsTamperedCursor(t *testing.T) {
+	fx := setupBoardFixture(t)
+	q := actions.DefaultBoardQuery().WithCursor("review", "not-a-cursor")
+	if _, err := fx.repo.ListLane(testutil.TenantContext(fx.tenantID), fx.scanID, "review", q); err == nil {
+		t.Fatalf("expected an invalid cursor error")
+	}
+}
+
+func hasFile(group actions.DuplicateGroup, match func(actions.DuplicateFile) bool) bool {
+	for _, file := range group.Files {
+		if match(file) {
+			return true
+		}
+	}
+	return false
+}
+
+func compareInt(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func compareString(a, b string) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,328 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/mcmx/duplynx/ent"
+	entcontentevent "github.com/mcmx/duplynx/ent/contentevent"
+	entcontentidentity "github.com/mcmx/duplynx/ent/contentidentity"
+	entduplicategroup "github.com/mcmx/duplynx/ent/duplicategroup"
+	entscantarget "github.com/mcmx/duplynx/ent/scantarget"
+	"github.com/mcmx/duplynx/internal/actions"
+	"github.com/mcmx/duplynx/internal/identity"
+	"github.com/mcmx/duplynx/internal/scans"
+	"github.com/mcmx/duplynx/tests/testutil"
+)
+
+// copyAt is one file of a synthetic scan.
+type copyAt struct {
+	machineID uuid.UUID
+	path      string
+}
+
+// addHashScan records a completed scan with one group under review holding the given copies.
+func addHashScan(t *testing.T, client *ent.Client, tenantID uuid.UUID, name, hash string, started time.Time, copies ...copyAt) *ent.DuplicateGroup {
+	t.Helper()
+	ctx := testutil.SystemContext()
+	scan := client.Scan.Create().
+		SetTenantID(tenantID).
+		SetInitiatedMachineID(copies[0].machineID).
+		SetName(name).
+		SetStartedAt(started).
+		SetCompletedAt(started.Add(time.Minute)).
+		SetDuplicateGroupCount(1).
+		SaveX(ctx)
+	group := client.DuplicateGroup.Create().
+		SetTenantID(tenantID).
+		SetScanID(scan.ID).
+		SetHash(hash).
+		SetStatus(entduplicategroup.StatusReview).
+		SetFileCount(len(copies)).
+		SetTotalSizeBytes(int64(len(copies)) * 4096).
+		SaveX(ctx)
+	for _, c := range copies {
+		client.FileInstance.Create().
+			SetTenantID(tenantID).
+			SetDuplicateGroupID(group.ID).
+			SetMachineID(c.machineID).
+			SetPath(c.path).
+			SetSizeBytes(4096).
+			SetChecksum(hash).
+			SaveX(ctx)
+	}
+	return group
+}
+
+// coverMachines records reported targets, marking the machines as scanned
+// even where the scan found none of their files.
+func coverMachines(t *testing.T, client *ent.Client, scanID uuid.UUID, machineIDs ...uuid.UUID) {
+	t.Helper()
+	ctx := testutil.SystemContext()
+	scan := client.Scan.GetX(ctx, scanID)
+	for _, machineID := range machineIDs {
+		client.ScanTarget.Create().
+			SetTenantID(scan.TenantID).
+			SetScanID(scanID).
+			SetMachineID(machineID).
+			SetRoots([]string{"/"}).
+			SetStatus(entscantarget.StatusReported).
+			SaveX(ctx)
+	}
+}
+
+func linkPending(t *testing.T, repo *identity.Repository) identity.LinkReport {
+	t.Helper()
+	reports, err := repo.LinkPending(context.Background())
+	if err != nil {
+		t.Fatalf("link: %v", err)
+	}
+	var total identity.LinkReport
+	for _, report := range reports {
+		total.Linked += report.Linked
+		total.Created += report.Created
+		total.Appeared += report.Appeared
+		total.Disappeared += report.Disappeared
+		total.KeepersCarried += report.KeepersCarried
+		total.ResolvedCarried += report.ResolvedCarried
+	}
+	return total
+}
+
+func TestContentIdentityCarriesDecisionsAcrossScans(t *testing.T) {
+	seed := testutil.NewSeededClient(t)
+	client := seed.Client
+	tenant := seed.Dataset.Tenants[0]
+	machines := testutil.MachineIDsForTenant(seed.Dataset, tenant.ID)
+	nas, laptop := machines[0], machines[1]
+	tenantCtx := testutil.TenantContext(tenant.ID)
+	repo := identity.NewRepositoryFromClient(client)
+	actionsRepo := actions.NewRepositoryFromClient(client)
+	const hash = "sha256:ubuntu-24.04.iso"
+	base := time.Now().Add(-48 * time.Hour)
+
+	first := addHashScan(t, client, tenant.ID, "Baseline Sweep", hash, base,
+		copyAt{nas, "/isos/ubuntu.iso"}, copyAt{laptop, "/Downloads/ubuntu.iso"})
+	if report := linkPending(t, repo); report.Linked != 1 || report.Created != 1 || report.Appeared != 2 {
+		t.Fatalf("expected one new identity with two copies, got %+v", report)
+	}
+	if err := actionsRepo.UpdateKeeper(tenantCtx, first.ID, nas); err != nil {
+		t.Fatalf("assign keeper: %v", err)
+	}
+	client.DuplicateGroup.UpdateOneID(first.ID).SetStatus(entduplicategroup.StatusResolved).ExecX(testutil.SystemContext())
+
+	// The laptop copy was deleted; the next scan inherits the decision.
+	second := addHashScan(t, client, tenant.ID, "Archive Sync", hash, base.Add(24*time.Hour),
+		copyAt{nas, "/isos/ubuntu.iso"}, copyAt{nas, "/backup/ubuntu.iso"})
+	coverMachines(t, client, second.ScanID, nas, laptop)
+	report := linkPending(t, repo)
+	if report.Linked != 1 || report.Created != 0 || report.Appeared != 1 || report.Disappeared != 1 {
+		t.Fatalf("expected the second scan linked to the existing identity, got %+v", report)
+	}
+	if report.KeepersCarried != 1 {
+		t.Fatalf("expected the keeper carried forward, got %+v", report)
+	}
+	linked := client.DuplicateGroup.GetX(testutil.SystemContext(), second.ID)
+	if linked.KeeperMachineID != nas {
+		t.Fatalf("expected keeper %s, got %s", nas, linked.KeeperMachineID)
+	}
+	// A new copy appeared, so the resolution needs another look.
+	if linked.Status != entduplicategroup.StatusReview {
+		t.Fatalf("expected the group back in review after a new copy, got %s", linked.Status)
+	}
+
+	client.DuplicateGroup.UpdateOneID(second.ID).SetStatus(entduplicategroup.StatusResolved).ExecX(testutil.SystemContext())
+	third := addHashScan(t, client, tenant.ID, "Weekly Sweep", hash, base.Add(36*time.Hour),
+		copyAt{nas, "/isos/ubuntu.iso"}, copyAt{nas, "/backup/ubuntu.iso"})
+	if report := linkPending(t, repo); report.ResolvedCarried != 1 || report.Appeared != 0 {
+		t.Fatalf("expected the resolution carried into an unchanged scan, got %+v", report)
+	}
+	if got := client.DuplicateGroup.GetX(testutil.SystemContext(), third.ID).Status; got != entduplicategroup.StatusResolved {
+		t.Fatalf("expected the third scan's group resolved, got %s", got)
+	}
+
+	history, ok, err := repo.HistoryForGroup(tenantCtx, third.ID)
+	if err != nil || !ok {
+		t.Fatalf("expected history for the linked group (%v)", err)
+	}
+	if len(history.Sightings) != 3 || history.Sightings[0].ScanName != "Baseline Sweep" || !history.Sightings[2].Current {
+		t.Fatalf("expected three sightings oldest first, got %+v", history.Sightings)
+	}
+	if history.ResolvedAt.IsZero() || history.KeeperMachineName == "" {
+		t.Fatalf("expected keeper and resolution on the identity, got %+v", history)
+	}
+	kinds := map[string]int{}
+	for _, event := range history.Events {
+		kinds[event.Kind]++
+	}
+	if kinds["appeared"] != 3 || kinds["disappeared"] != 1 {
+		t.Fatalf("expected three appearances and one disappearance, got %v", kinds)
+	}
+
+	// Other tenants neither see the history nor share the identity.
+	other := seed.Dataset.Tenants[1]
+	if _, ok, err := repo.HistoryForGroup(testutil.TenantContext(other.ID), third.ID); err != nil || ok {
+		t.Fatalf("expected no history across tenants, got ok=%v (%v)", ok, err)
+	}
+	otherMachine := testutil.MachineIDsForTenant(seed.Dataset, other.ID)[0]
+	addHashScan(t, client, other.ID, "Selene Sweep", hash, base,
+		copyAt{otherMachine, "/isos/ubuntu.iso"}, copyAt{otherMachine, "/tmp/ubuntu.iso"})
+	if report := linkPending(t, repo); report.Created != 1 || report.KeepersCarried != 0 {
+		t.Fatalf("expected a separate identity for the other tenant, got %+v", report)
+	}
+	events, err := client.ContentEvent.Query().Where(entcontentevent.TenantID(other.ID), entcontentevent.HasContentIdentityWith(entcontentidentity.Hash(hash))).Count(testutil.SystemContext())
+	if err != nil || events != 2 {
+		t.Fatalf("expected two events for the other tenant, got %d (%v)", events, err)
+	}
+}
+
+func TestLinkingIgnoresMachinesTheScanDidNotCover(t *testing.T) {
+	seed := testutil.NewSeededClient(t)
+	client := seed.Client
+	tenant := seed.Dataset.Tenants[0]
+	machines := testutil.MachineIDsForTenant(seed.Dataset, tenant.ID)
+	nas, laptop := machines[0], machines[1]
+	repo := identity.NewRepositoryFromClient(client)
+	const hash = "sha256:partial-coverage"
+	base := time.Now().Add(-48 * time.Hour)
+
+	first := addHashScan(t, client, tenant.ID, "Baseline Sweep", hash, base,
+		copyAt{nas, "/isos/debian.iso"}, copyAt{laptop, "/Downloads/debian.iso"})
+	linkPending(t, repo)
+	client.DuplicateGroup.UpdateOneID(first.ID).SetStatus(entduplicategroup.StatusResolved).ExecX(testutil.SystemContext())
+
+	// Only the NAS was scanned, so the laptop's copy is unknown rather than gone.
+	second := addHashScan(t, client, tenant.ID, "NAS Only", hash, base.Add(24*time.Hour),
+		copyAt{nas, "/isos/debian.iso"}, copyAt{nas, "/backup/debian.iso"})
+	coverMachines(t, client, second.ScanID, nas)
+	if report := linkPending(t, repo); report.Appeared != 1 || report.Disappeared != 0 {
+		t.Fatalf("expected one appearance and no disappearance, got %+v", report)
+	}
+
+	// A later scan of the laptop alone finds its copy where it was.
+	third := addHashScan(t, client, tenant.ID, "Laptop Only", hash, base.Add(36*time.Hour),
+		copyAt{laptop, "/Downloads/debian.iso"}, copyAt{laptop, "/Desktop/debian.iso"})
+	if report := linkPending(t, repo); report.Appeared != 1 || report.Disappeared != 0 {
+		t.Fatalf("expected only the new laptop copy to appear, got %+v", report)
+	}
+	history, _, err := repo.HistoryForGroup(testutil.TenantContext(tenant.ID), third.ID)
+	if err != nil {
+		t.Fatalf("history: %v", err)
+	}
+	for _, event := range history.Events {
+		if event.Kind == "disappeared" {
+			t.Fatalf("expected no disappearances, got %+v", history.Events)
+		}
+	}
+}
+
+func TestLinkingRecordsCopiesOfContentNoLongerDuplicated(t *testing.T) {
+	seed := testutil.NewSeededClient(t)
+	client := seed.Client
+	tenant := seed.Dataset.Tenants[0]
+	machines := testutil.MachineIDsForTenant(seed.Dataset, tenant.ID)
+	nas, laptop := machines[0], machines[1]
+	repo := identity.NewRepositoryFromClient(client)
+	const hash = "sha256:cleaned-up"
+	base := time.Now().Add(-48 * time.Hour)
+
+	first := addHashScan(t, client, tenant.ID, "Baseline Sweep", hash, base,
+		copyAt{nas, "/isos/arch.iso"}, copyAt{nas, "/backup/arch.iso"}, copyAt{laptop, "/Downloads/arch.iso"})
+	linkPending(t, repo)
+
+	// The NAS copies were cleaned down to one, so the next NAS scan has no
+	// group for the hash at all; the laptop was not scanned.
+	second := addHashScan(t, client, tenant.ID, "NAS Only", "sha256:unrelated", base.Add(24*time.Hour),
+		copyAt{nas, "/photos/a.jpg"}, copyAt{nas, "/photos/b.jpg"})
+	coverMachines(t, client, second.ScanID, nas)
+	if report := linkPending(t, repo); report.Disappeared != 2 {
+		t.Fatalf("expected both NAS copies of the cleaned content to disappear, got %+v", report)
+	}
+	if report, err := repo.LinkScan(context.Background(), second.ScanID); err != nil || report.Disappeared != 0 {
+		t.Fatalf("expected relinking to record nothing new, got %+v (%v)", report, err)
+	}
+
+	history, _, err := repo.HistoryForGroup(testutil.TenantContext(tenant.ID), first.ID)
+	if err != nil {
+		t.Fatalf("history: %v", err)
+	}
+	gone := map[string]bool{}
+	for _, event := range history.Events {
+		if event.Kind == "disappeared" {
+			gone[event.Path] = true
+		}
+	}
+	if len(gone) != 2 || !gone["/isos/arch.iso"] || !gone["/backup/arch.iso"] {
+		t.Fatalf("expected the NAS copies to disappear and the laptop's to stay, got %+v", history.Events)
+	}
+}
+
+func TestCompletedCampaignInheritsKeeperWithoutLinkCommand(t *testing.T) {
+	seed := testutil.NewSeededClient(t)
+	client := seed.Client
+	tenant := seed.Dataset.Tenants[0]
+	machines := testutil.MachineIDsForTenant(seed.Dataset, tenant.ID)
+	nas, laptop := machines[0], machines[1]
+	ctx := testutil.TenantContext(tenant.ID)
+	now := time.Now().Add(-time.Hour)
+	scanRepo := scans.NewRepositoryFromClient(client)
+	scanRepo.Now = func() time.Time {
+		now = now.Add(time.Minute)
+		return now
+	}
+	const hash = "sha256:campaign-keeper"
+
+	// runCampaign scans both machines, stores the group their manifests
+	// produced and reports each target, as agents do.
+	runCampaign := func(name string) *ent.DuplicateGroup {
+		t.Helper()
+		summary, err := scanRepo.CreateCampaign(ctx, scans.CampaignInput{
+			Name: name,
+			Targets: []scans.TargetInput{
+				{MachineID: nas, Roots: []string{"/isos"}},
+				{MachineID: laptop, Roots: []string{"/Downloads"}},
+			},
+		})
+		if err != nil {
+			t.Fatalf("create campaign: %v", err)
+		}
+		scanID := uuid.MustParse(summary.ID)
+		group := client.DuplicateGroup.Create().
+			SetScanID(scanID).
+			SetHash(hash).
+			SetFileCount(2).
+			SetTotalSizeBytes(8192).
+			SaveX(ctx)
+		for _, c := range []copyAt{{nas, "/isos/fedora.iso"}, {laptop, "/Downloads/fedora.iso"}} {
+			client.FileInstance.Create().
+				SetDuplicateGroupID(group.ID).
+				SetMachineID(c.machineID).
+				SetPath(c.path).
+				SetSizeBytes(4096).
+				SetChecksum(hash).
+				SaveX(ctx)
+		}
+		for _, machineID := range []uuid.UUID{nas, laptop} {
+			if _, err := scanRepo.ReportTarget(ctx, tenant.Slug, scanID, machineID, scans.TargetReport{}); err != nil {
+				t.Fatalf("report target: %v", err)
+			}
+		}
+		return client.DuplicateGroup.GetX(ctx, group.ID)
+	}
+
+	first := runCampaign("Monday Sweep")
+	if first.ContentIdentityID == uuid.Nil {
+		t.Fatalf("expected the completed scan's group to be linked")
+	}
+	if err := actions.NewRepositoryFromClient(client).UpdateKeeper(ctx, first.ID, laptop); err != nil {
+		t.Fatalf("assign keeper: %v", err)
+	}
+
+	second := runCampaign("Tuesday Sweep")
+	if second.ContentIdentityID != first.ContentIdentityID || second.KeeperMachineID != laptop {
+		t.Fatalf("expected the second scan to inherit keeper %s, got %+v", laptop, second)
+	}
+}
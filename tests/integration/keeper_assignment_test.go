@@ -1,7 +1,6 @@
 package integration_test
 
 import (
-	"context"
 	"testing"
 
 	"github.com/mcmx/duplynx/internal/actions"
@@ -14,7 +13,8 @@ func TestKeeperAssignmentDoesNotChangeStatus(t *testing.T) {
 	dispatcher := actions.NewDispatcher(repo, &actions.AuditLogger{})
 
 	groupFixture := seed.Dataset.DuplicateGroups[0]
-	groupBefore, err := repo.Get(context.Background(), groupFixture.ID)
+	ctx := testutil.TenantContext(groupFixture.TenantID)
+	groupBefore, err := repo.Get(ctx, groupFixture.ID)
 	if err != nil {
 		t.Fatalf("load duplicate group: %v", err)
 	}
@@ -25,11 +25,11 @@ func TestKeeperAssignmentDoesNotChangeStatus(t *testing.T) {
 		t.Fatal("expected machines for tenant")
 	}
 
-	if err := dispatcher.AssignKeeper(context.Background(), groupBefore.ID, groupBefore.TenantSlug, machines[0].String()); err != nil {
+	if err := dispatcher.AssignKeeper(ctx, groupBefore.ID, groupBefore.TenantSlug, machines[0].String()); err != nil {
 		t.Fatalf("assign keeper failed: %v", err)
 	}
 
-	groupAfter, err := repo.Get(context.Background(), groupFixture.ID)
+	groupAfter, err := repo.Get(ctx, groupFixture.ID)
 	if err != nil {
 		t.Fatalf("reload duplicate group: %v", err)
 	}
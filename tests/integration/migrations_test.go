@@ -0,0 +1,147 @@
+package integration_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/mcmx/duplynx/ent"
+	"github.com/mcmx/duplynx/ent/enttest"
+	"github.com/mcmx/duplynx/internal/data"
+	"github.com/mcmx/duplynx/tests/testutil"
+)
+
+func openEmptySQLite(t *testing.T) *ent.Client {
+	t.Helper()
+	client, err := data.OpenSQLite(context.Background(), "file:migrations-"+uuid.NewString()+"?mode=memory&cache=shared&_fk=1")
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+	return client
+}
+
+func TestMigrationsMatchTheEntSchema(t *testing.T) {
+	client := testutil.OpenTestClient(t)
+	ctx := context.Background()
+	if err := data.CheckSchema(ctx, client); err != nil {
+		t.Fatalf("expected a current schema after migrating, got %v", err)
+	}
+	// Auto-migration would print DDL for anything the migration files miss.
+	var pending bytes.Buffer
+	if err := client.Schema.WriteTo(ctx, &pending); err != nil {
+		t.Fatalf("diff schema: %v", err)
+	}
+	if ddl := strings.TrimSpace(strings.NewReplacer("BEGIN;", "", "COMMIT;", "").Replace(pending.String())); ddl != "" {
+		t.Fatalf("ent schema has changes without a migration; run ent/migrate/main.go:\n%s", ddl)
+	}
+
+	sqlite, err := data.Migrations("sqlite3")
+	if err != nil {
+		t.Fatalf("load sqlite migrations: %v", err)
+	}
+	postgres, err := data.Migrations("postgres")
+	if err != nil {
+		t.Fatalf("load postgres migrations: %v", err)
+	}
+	if len(sqlite) == 0 || len(sqlite) != len(postgres) {
+		t.Fatalf("expected matching migration sets, got %d sqlite and %d postgres", len(sqlite), len(postgres))
+	}
+	for i := range sqlite {
+		if sqlite[i].Version != postgres[i].Version {
+			t.Fatalf("migration %d differs between dialects: %s vs %s", i, sqlite[i].Version, postgres[i].Version)
+		}
+	}
+}
+
+func TestMigrateUpDownAndStatus(t *testing.T) {
+	client := openEmptySQLite(t)
+	ctx := context.Background()
+
+	if err := data.CheckSchema(ctx, client); !errors.Is(err, data.ErrSchemaBehind) {
+		t.Fatalf("expected an empty database to be behind, got %v", err)
+	}
+	applied, err := data.MigrateUp(ctx, client, 0)
+	if err != nil || len(applied) == 0 {
+		t.Fatalf("expected migrations to apply, got %d (%v)", len(applied), err)
+	}
+	status, err := data.SchemaStatus(ctx, client)
+	if err != nil || status.Err() != nil || status.Current() != status.Latest {
+		t.Fatalf("expected a current schema, got %+v (%v)", status, err)
+	}
+	if again, err := data.MigrateUp(ctx, client, 0); err != nil || len(again) != 0 {
+		t.Fatalf("expected nothing left to apply, got %d (%v)", len(again), err)
+	}
+
+	reverted, err := data.MigrateDown(ctx, client, 1)
+	if err != nil || len(reverted) != 1 || reverted[0].Version != status.Latest {
+		t.Fatalf("expected the latest migration to be reverted, got %+v (%v)", reverted, err)
+	}
+	if err := data.CheckSchema(ctx, client); !errors.Is(err, data.ErrSchemaBehind) {
+		t.Fatalf("expected the database to be behind after reverting, got %v", err)
+	}
+	if _, err := data.MigrateUp(ctx, client, 0); err != nil {
+		t.Fatalf("reapply migrations: %v", err)
+	}
+	if _, err := data.SeedDemoDataset(ctx, client, data.CanonicalDemoDataset()); err != nil {
+		t.Fatalf("seed migrated database: %v", err)
+	}
+}
+
+func TestCheckSchemaRejectsAheadAndModifiedHistory(t *testing.T) {
+	client := testutil.OpenTestClient(t)
+	ctx := context.Background()
+
+	if _, err := client.ExecContext(ctx, `UPDATE schema_migrations SET checksum = 'edited'`); err != nil {
+		t.Fatalf("edit history: %v", err)
+	}
+	if err := data.CheckSchema(ctx, client); !errors.Is(err, data.ErrChecksumMismatch) {
+		t.Fatalf("expected a checksum mismatch, got %v", err)
+	}
+	if _, err := data.MigrateUp(ctx, client, 0); !errors.Is(err, data.ErrChecksumMismatch) {
+		t.Fatalf("expected migrate up to refuse modified history, got %v", err)
+	}
+
+	other := testutil.OpenTestClient(t)
+	if _, err := other.ExecContext(ctx, `INSERT INTO schema_migrations (version, name, checksum, applied_at) VALUES ('99991231000000', 'future', 'x', '2099-01-01T00:00:00Z')`); err != nil {
+		t.Fatalf("record future migration: %v", err)
+	}
+	if err := data.CheckSchema(ctx, other); !errors.Is(err, data.ErrSchemaAhead) {
+		t.Fatalf("expected the database to be ahead, got %v", err)
+	}
+}
+
+func TestBaselineAdoptsAutoMigratedDatabase(t *testing.T) {
+	// Databases created before versioned migrations were built by Schema.Create.
+	client := enttest.Open(t, "sqlite3", "file:baseline-"+uuid.NewString()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+	ctx := context.Background()
+
+	if err := data.CheckSchema(ctx, client); !errors.Is(err, data.ErrUnversionedSchema) {
+		t.Fatalf("expected an unversioned schema, got %v", err)
+	}
+	if _, err := data.MigrateUp(ctx, client, 0); !errors.Is(err, data.ErrUnversionedSchema) {
+		t.Fatalf("expected migrate up to refuse an unversioned schema, got %v", err)
+	}
+	if _, err := data.BaselineMigrations(ctx, client, "19700101000000"); !errors.Is(err, data.ErrUnknownMigration) {
+		t.Fatalf("expected an unknown baseline version to be rejected, got %v", err)
+	}
+	migrations, err := data.Migrations(client.Dialect())
+	if err != nil {
+		t.Fatalf("load migrations: %v", err)
+	}
+	if _, err := data.BaselineMigrations(ctx, client, migrations[len(migrations)-1].Version); err != nil {
+		t.Fatalf("baseline: %v", err)
+	}
+	if err := data.CheckSchema(ctx, client); err != nil {
+		t.Fatalf("expected a current schema after the baseline, got %v", err)
+	}
+}
@@ -0,0 +1,97 @@
+package integration_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mcmx/duplynx/internal/actions"
+	"github.com/mcmx/duplynx/internal/data"
+	"github.com/mcmx/duplynx/internal/reclaim"
+	"github.com/mcmx/duplynx/internal/search"
+	"github.com/mcmx/duplynx/tests/testutil"
+)
+
+func TestOpenRejectsUnknownDriversAndMissingDSN(t *testing.T) {
+	ctx := context.Background()
+	if _, err := data.Open(ctx, "mysql", "root@/duplynx"); !errors.Is(err, data.ErrUnknownDriver) {
+		t.Fatalf("expected ErrUnknownDriver, got %v", err)
+	}
+	if _, err := data.Open(ctx, data.DriverPostgres, " "); err == nil {
+		t.Fatalf("expected an empty postgres dsn to be rejected")
+	}
+}
+
+// TestPostgresStoreRunsSeedBoardAndSearch covers the queries that bypass
+// Ent's builders: lane cursors, raw search SQL, the trigram index, and the
+// reclaim report's aggregation.
+func TestPostgresStoreRunsSeedBoardAndSearch(t *testing.T) {
+	client := testutil.OpenPostgresClient(t)
+	dataset := data.CanonicalDemoDataset()
+	report, err := data.SeedDemoDataset(context.Background(), client, dataset)
+	if err != nil {
+		t.Fatalf("seed postgres: %v", err)
+	}
+	if report.FileInstances != len(dataset.FileInstances) {
+		t.Fatalf("expected %d file instances, seeded %d", len(dataset.FileInstances), report.FileInstances)
+	}
+	if _, err := data.SeedDemoDataset(context.Background(), client, dataset); err != nil {
+		t.Fatalf("reseed postgres: %v", err)
+	}
+
+	scan := dataset.Scans[0]
+	ctx := testutil.TenantContext(scan.TenantID)
+	// A second review group makes the one-group pages follow a cursor.
+	if _, err := client.DuplicateGroup.Create().
+		SetTenantID(scan.TenantID).
+		SetScanID(scan.ID).
+		SetHash("sha256:postgres-cursor").
+		SetStatus("review").
+		SetFileCount(2).
+		SetTotalSizeBytes(2048).
+		Save(ctx); err != nil {
+		t.Fatalf("create group: %v", err)
+	}
+	repo := actions.NewRepositoryFromClient(client)
+	for _, sortKey := range []actions.SortKey{actions.SortReclaimable, actions.SortFileCount, actions.SortHash} {
+		q := actions.DefaultBoardQuery()
+		q.Sort, q.Limit = sortKey, 1
+		seen := 0
+		for pages := 0; ; pages++ {
+			page, err := repo.ListLane(ctx, scan.ID, "review", q)
+			if err != nil {
+				t.Fatalf("%s: list lane: %v", sortKey, err)
+			}
+			seen += len(page.Groups)
+			if page.NextCursor == "" {
+				if seen != page.Total || pages == 0 {
+					t.Fatalf("%s: walked %d groups of %d", sortKey, seen, page.Total)
+				}
+				break
+			}
+			if pages > 20 {
+				t.Fatalf("%s: pagination did not terminate", sortKey)
+			}
+			q = q.WithCursor("review", page.NextCursor)
+		}
+	}
+
+	searches := search.NewRepositoryFromClient(client)
+	for text, files := range map[string]int{"q4-PLAN": 3, "9adcc6e5": 3, "*.zip": 2, "/srv/*/finance/*": 2, "batch-219": 0} {
+		result, err := searches.Search(ctx, search.Query{Text: text})
+		if err != nil {
+			t.Fatalf("%q: search failed: %v", text, err)
+		}
+		if result.Files != files {
+			t.Fatalf("%q: expected %d matches, got %d", text, files, result.Files)
+		}
+	}
+
+	reclaimable, err := reclaim.NewRepositoryFromClient(client).Report(ctx, 0)
+	if err != nil {
+		t.Fatalf("reclaim report: %v", err)
+	}
+	if reclaimable.ReclaimableBytes <= 0 || len(reclaimable.ByMachine) == 0 || len(reclaimable.TopDirectories) == 0 {
+		t.Fatalf("expected reclaimable space with breakdowns, got %+v", reclaimable)
+	}
+}
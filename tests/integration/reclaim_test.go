@@ -0,0 +1,118 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/mcmx/duplynx/ent"
+	entmachine "github.com/mcmx/duplynx/ent/machine"
+	"github.com/mcmx/duplynx/internal/reclaim"
+	"github.com/mcmx/duplynx/tests/testutil"
+)
+
+type reclaimCopy struct {
+	machine uuid.UUID
+	path    string
+}
+
+// recordReclaimScan stores a scan that found one group of 100-byte copies.
+func recordReclaimScan(t *testing.T, client *ent.Client, ctx context.Context, name, hash string, startedAt time.Time, copies ...reclaimCopy) *ent.DuplicateGroup {
+	t.Helper()
+	scan, err := client.Scan.Create().
+		SetName(name).
+		SetStartedAt(startedAt).
+		SetCompletedAt(startedAt.Add(time.Minute)).
+		SetStatus("completed").
+		SetDuplicateGroupCount(1).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("create scan %s: %v", name, err)
+	}
+	group, err := client.DuplicateGroup.Create().
+		SetScanID(scan.ID).
+		SetHash(hash).
+		SetFileCount(len(copies)).
+		SetTotalSizeBytes(int64(100 * len(copies))).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("create group in %s: %v", name, err)
+	}
+	for _, c := range copies {
+		if _, err := client.FileInstance.Create().
+			SetDuplicateGroupID(group.ID).
+			SetMachineID(c.machine).
+			SetPath(c.path).
+			SetSizeBytes(100).
+			SetChecksum(hash).
+			Save(ctx); err != nil {
+			t.Fatalf("create copy %s: %v", c.path, err)
+		}
+	}
+	return group
+}
+
+func TestReclaimReportCountsContentOncePerMachine(t *testing.T) {
+	client := testutil.OpenTestClient(t)
+	tenant, err := client.Tenant.Create().SetSlug("reclaim-once").SetName("Reclaim Once").Save(testutil.SystemContext())
+	if err != nil {
+		t.Fatalf("create tenant: %v", err)
+	}
+	ctx := testutil.TenantContext(tenant.ID)
+	var machines []uuid.UUID
+	for _, name := range []string{"alpha", "beta"} {
+		machine, err := client.Machine.Create().SetName(name).SetCategory(entmachine.CategoryServer).Save(ctx)
+		if err != nil {
+			t.Fatalf("create machine %s: %v", name, err)
+		}
+		machines = append(machines, machine.ID)
+	}
+	alpha, beta := machines[0], machines[1]
+	repo := reclaim.NewRepositoryFromClient(client)
+	report := func() reclaim.Report {
+		t.Helper()
+		r, err := repo.Report(ctx, 0)
+		if err != nil {
+			t.Fatalf("report: %v", err)
+		}
+		return r
+	}
+
+	start := time.Date(2026, 10, 1, 2, 0, 0, 0, time.UTC)
+	recordReclaimScan(t, client, ctx, "nightly-1", "sha256:reclaim-once", start,
+		reclaimCopy{alpha, "/data/a.bin"}, reclaimCopy{beta, "/data/b.bin"})
+	if got := report(); got.ReclaimableBytes != 100 || got.ReclaimableGroups != 1 {
+		t.Fatalf("expected one removable copy after the first scan, got %+v", got)
+	}
+
+	// Rescanning both machines finds the same copies; they must not count twice.
+	latest := recordReclaimScan(t, client, ctx, "nightly-2", "sha256:reclaim-once", start.Add(24*time.Hour),
+		reclaimCopy{alpha, "/data/a.bin"}, reclaimCopy{beta, "/data/b.bin"})
+	got := report()
+	if got.ReclaimableBytes != 100 || got.ReclaimableGroups != 1 {
+		t.Fatalf("expected a rescan to leave the totals unchanged, got %+v", got)
+	}
+	if len(got.ByScan) != 1 || got.ByScan[0].Key != latest.ScanID.String() {
+		t.Fatalf("expected the content to be reported under its latest scan, got %+v", got.ByScan)
+	}
+
+	// A scan of alpha alone replaces alpha's copies and keeps beta's from its latest scan.
+	recordReclaimScan(t, client, ctx, "alpha-only", "sha256:reclaim-once", start.Add(48*time.Hour),
+		reclaimCopy{alpha, "/data/a.bin"}, reclaimCopy{alpha, "/data/a-copy.bin"})
+	got = report()
+	if got.ReclaimableBytes != 200 || got.ReclaimableGroups != 1 {
+		t.Fatalf("expected beta's copy to survive an alpha-only scan, got %+v", got)
+	}
+	var files int
+	for _, row := range got.ByMachine {
+		files += row.Files
+	}
+	if files != 2 {
+		t.Fatalf("expected two removable copies across machines, got %+v", got.ByMachine)
+	}
+	if len(got.TopDirectories) != 1 || got.TopDirectories[0].Key != "/data" || got.TopDirectories[0].Bytes != 200 {
+		t.Fatalf("expected the copies under /data, got %+v", got.TopDirectories)
+	}
+}
@@ -0,0 +1,276 @@
+package integration_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/mcmx/duplynx/ent"
+	entduplicategroup "github.com/mcmx/duplynx/ent/duplicategroup"
+	entfileinstance "github.com/mcmx/duplynx/ent/fileinstance"
+	entscan "github.com/mcmx/duplynx/ent/scan"
+	"github.com/mcmx/duplynx/internal/actions"
+	"github.com/mcmx/duplynx/internal/retention"
+	"github.com/mcmx/duplynx/internal/scans"
+	"github.com/mcmx/duplynx/tests/testutil"
+)
+
+// addScan records a completed scan on machineID with one resolved group of two copies.
+func addScan(t *testing.T, client *ent.Client, tenantID, machineID uuid.UUID, name string, started time.Time) *ent.Scan {
+	t.Helper()
+	ctx := testutil.SystemContext()
+	scan := client.Scan.Create().
+		SetTenantID(tenantID).
+		SetInitiatedMachineID(machineID).
+		SetName(name).
+		SetStartedAt(started).
+		SetCompletedAt(started.Add(time.Minute)).
+		SetStatus(entscan.StatusCompleted).
+		SetDuplicateGroupCount(1).
+		SaveX(ctx)
+	group := client.DuplicateGroup.Create().
+		SetTenantID(tenantID).
+		SetScanID(scan.ID).
+		SetHash("sha256:" + name).
+		SetStatus(entduplicategroup.StatusResolved).
+		SetFileCount(2).
+		SetTotalSizeBytes(200).
+		SaveX(ctx)
+	for i := 0; i < 2; i++ {
+		client.FileInstance.Create().
+			SetTenantID(tenantID).
+			SetDuplicateGroupID(group.ID).
+			SetMachineID(machineID).
+			SetPath(fmt.Sprintf("/data/%s/%d.bin", name, i)).
+			SetSizeBytes(100).
+			SetChecksum("sha256:" + name).
+			SaveX(ctx)
+	}
+	return scan
+}
+
+func scanFiles(t *testing.T, client *ent.Client, scanID uuid.UUID) int {
+	t.Helper()
+	count, err := client.FileInstance.Query().
+		Where(entfileinstance.HasDuplicateGroupWith(entduplicategroup.ScanID(scanID))).
+		Count(testutil.SystemContext())
+	if err != nil {
+		t.Fatalf("count files: %v", err)
+	}
+	return count
+}
+
+func TestRetentionPrunesSupersededScans(t *testing.T) {
+	seed := testutil.NewSeededClient(t)
+	client := seed.Client
+	tenant := seed.Dataset.Tenants[0]
+	machineID := testutil.MachineIDsForTenant(seed.Dataset, tenant.ID)[0]
+	base := time.Now().Add(-72 * time.Hour)
+	oldest := addScan(t, client, tenant.ID, machineID, "retention-1", base)
+	older := addScan(t, client, tenant.ID, machineID, "retention-2", base.Add(time.Hour))
+	newest := addScan(t, client, tenant.ID, machineID, "retention-3", base.Add(2*time.Hour))
+
+	// Server defaults are disabled, so only the tenant's override prunes anything.
+	pruner := retention.NewPrunerFromClient(client, retention.Policy{})
+	ctx := context.Background()
+	if _, err := pruner.SetOverrides(ctx, tenant.Slug, retention.Overrides{KeepScans: intPtr(1)}); err != nil {
+		t.Fatalf("set overrides: %v", err)
+	}
+
+	dry, err := pruner.Run(ctx, retention.Options{DryRun: true})
+	if err != nil {
+		t.Fatalf("dry run: %v", err)
+	}
+	pruned := map[uuid.UUID]retention.ScanPrune{}
+	for _, report := range dry.Tenants {
+		if report.TenantSlug != tenant.Slug && len(report.Scans) > 0 {
+			t.Fatalf("tenant %s without a policy reported scans: %+v", report.TenantSlug, report.Scans)
+		}
+		for _, scan := range report.Scans {
+			pruned[scan.ID] = scan
+		}
+	}
+	if pruned[oldest.ID].Files != 2 || pruned[older.ID].Files != 2 {
+		t.Fatalf("expected both superseded scans reported with their files, got %+v", pruned)
+	}
+	if _, ok := pruned[newest.ID]; ok {
+		t.Fatal("the newest scan must be kept")
+	}
+	if got := scanFiles(t, client, oldest.ID); got != 2 {
+		t.Fatalf("dry run changed data: %d files left", got)
+	}
+
+	report, err := pruner.Run(ctx, retention.Options{TenantSlug: tenant.Slug})
+	if err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	if _, files, _ := report.Totals(); files < 4 {
+		t.Fatalf("expected at least 4 file instances pruned, got %d", files)
+	}
+	for _, scan := range []*ent.Scan{oldest, older} {
+		if got := scanFiles(t, client, scan.ID); got != 0 {
+			t.Fatalf("expected superseded scan %s pruned, %d files left", scan.Name, got)
+		}
+		record := client.Scan.GetX(testutil.SystemContext(), scan.ID)
+		if record.FilesPrunedAt.IsZero() {
+			t.Fatalf("expected scan %s marked as pruned", scan.Name)
+		}
+		group := client.DuplicateGroup.Query().Where(entduplicategroup.ScanID(scan.ID)).OnlyX(testutil.SystemContext())
+		if group.FileCount != 2 || group.TotalSizeBytes != 200 {
+			t.Fatalf("expected aggregate stats kept, got %d files and %d bytes", group.FileCount, group.TotalSizeBytes)
+		}
+		detail, err := actions.NewRepositoryFromClient(client).Get(testutil.TenantContext(tenant.ID), group.ID)
+		if err != nil || detail.FilesPrunedAt.IsZero() {
+			t.Fatalf("expected the group detail to report pruned files, got %+v (%v)", detail, err)
+		}
+	}
+	if got := scanFiles(t, client, newest.ID); got != 2 {
+		t.Fatalf("expected the newest scan untouched, %d files left", got)
+	}
+
+	again, err := pruner.Run(ctx, retention.Options{TenantSlug: tenant.Slug})
+	if err != nil {
+		t.Fatalf("second run: %v", err)
+	}
+	if scans, files, _ := again.Totals(); scans != 0 || files != 0 {
+		t.Fatalf("expected nothing left to prune, got %d scans and %d files", scans, files)
+	}
+}
+
+func TestRetentionKeepsTheLastGoodScanWhenTheNewestFailed(t *testing.T) {
+	seed := testutil.NewSeededClient(t)
+	client := seed.Client
+	tenant := seed.Dataset.Tenants[0]
+	machineID := testutil.MachineIDsForTenant(seed.Dataset, tenant.ID)[0]
+	base := time.Now().Add(-72 * time.Hour)
+	older := addScan(t, client, tenant.ID, machineID, "good-1", base)
+	good := addScan(t, client, tenant.ID, machineID, "good-2", base.Add(time.Hour))
+	// Failed scans are stamped with a completion time too.
+	failed := addScan(t, client, tenant.ID, machineID, "failed-3", base.Add(2*time.Hour))
+	client.Scan.UpdateOneID(failed.ID).SetStatus(entscan.StatusFailed).ExecX(testutil.SystemContext())
+
+	pruner := retention.NewPrunerFromClient(client, retention.Policy{})
+	if _, err := pruner.SetOverrides(context.Background(), tenant.Slug, retention.Overrides{KeepScans: intPtr(1)}); err != nil {
+		t.Fatalf("set overrides: %v", err)
+	}
+	report, err := pruner.Run(context.Background(), retention.Options{DryRun: true, TenantSlug: tenant.Slug})
+	if err != nil {
+		t.Fatalf("dry run: %v", err)
+	}
+	pruned := map[uuid.UUID]bool{}
+	for _, tenantReport := range report.Tenants {
+		for _, scan := range tenantReport.Scans {
+			pruned[scan.ID] = true
+		}
+	}
+	if !pruned[older.ID] {
+		t.Fatalf("expected the older good scan pruned, got %+v", pruned)
+	}
+	if pruned[good.ID] || pruned[failed.ID] {
+		t.Fatalf("expected the failed scan not to supersede the last good one, got %+v", pruned)
+	}
+}
+
+func TestRetentionRanksCampaignScansByTargetMachine(t *testing.T) {
+	seed := testutil.NewSeededClient(t)
+	client := seed.Client
+	tenant := seed.Dataset.Tenants[0]
+	machines := testutil.MachineIDsForTenant(seed.Dataset, tenant.ID)
+	nas, laptop := machines[0], machines[1]
+	ctx := testutil.TenantContext(tenant.ID)
+	now := time.Now().Add(-time.Hour)
+	scanRepo := scans.NewRepositoryFromClient(client)
+	scanRepo.Now = func() time.Time {
+		now = now.Add(time.Minute)
+		return now
+	}
+
+	// runCampaign scans a single machine and reports its target, as agents do.
+	runCampaign := func(name string, machineID uuid.UUID) uuid.UUID {
+		t.Helper()
+		summary, err := scanRepo.CreateCampaign(ctx, scans.CampaignInput{
+			Name:    name,
+			Targets: []scans.TargetInput{{MachineID: machineID, Roots: []string{"/data"}}},
+		})
+		if err != nil {
+			t.Fatalf("create campaign %s: %v", name, err)
+		}
+		scanID := uuid.MustParse(summary.ID)
+		if _, err := scanRepo.ReportTarget(ctx, tenant.Slug, scanID, machineID, scans.TargetReport{}); err != nil {
+			t.Fatalf("report target for %s: %v", name, err)
+		}
+		return scanID
+	}
+	nasOld := runCampaign("nas-1", nas)
+	nasNew := runCampaign("nas-2", nas)
+	// The laptop's only campaign is newer than both of the NAS's.
+	laptopOnly := runCampaign("laptop-1", laptop)
+
+	pruner := retention.NewPrunerFromClient(client, retention.Policy{})
+	if _, err := pruner.SetOverrides(context.Background(), tenant.Slug, retention.Overrides{KeepScans: intPtr(1)}); err != nil {
+		t.Fatalf("set overrides: %v", err)
+	}
+	report, err := pruner.Run(context.Background(), retention.Options{DryRun: true, TenantSlug: tenant.Slug})
+	if err != nil {
+		t.Fatalf("dry run: %v", err)
+	}
+	pruned := map[uuid.UUID]bool{}
+	for _, tenantReport := range report.Tenants {
+		for _, scan := range tenantReport.Scans {
+			pruned[scan.ID] = true
+		}
+	}
+	if !pruned[nasOld] {
+		t.Fatalf("expected the NAS's superseded campaign pruned, got %+v", pruned)
+	}
+	if pruned[nasNew] || pruned[laptopOnly] {
+		t.Fatalf("expected each machine's newest campaign kept, got %+v", pruned)
+	}
+}
+
+func TestRetentionArchivesStaleResolvedGroups(t *testing.T) {
+	seed := testutil.NewSeededClient(t)
+	client := seed.Client
+	tenant := seed.Dataset.Tenants[0]
+	machineID := testutil.MachineIDsForTenant(seed.Dataset, tenant.ID)[0]
+	scan := addScan(t, client, tenant.ID, machineID, "archive-1", time.Now().Add(-time.Hour))
+
+	pruner := retention.NewPrunerFromClient(client, retention.Policy{})
+	ctx := context.Background()
+	if _, err := pruner.SetOverrides(ctx, tenant.Slug, retention.Overrides{ArchiveAfterDays: intPtr(30)}); err != nil {
+		t.Fatalf("set overrides: %v", err)
+	}
+	group := func() *ent.DuplicateGroup {
+		return client.DuplicateGroup.Query().Where(entduplicategroup.ScanID(scan.ID)).OnlyX(testutil.SystemContext())
+	}
+
+	if _, err := pruner.Run(ctx, retention.Options{TenantSlug: tenant.Slug}); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	if got := group().Status; got != entduplicategroup.StatusResolved {
+		t.Fatalf("expected a freshly resolved group left alone, got %s", got)
+	}
+
+	pruner.Now = func() time.Time { return time.Now().AddDate(0, 0, 31) }
+	review, err := client.DuplicateGroup.Query().
+		Where(entduplicategroup.TenantID(tenant.ID), entduplicategroup.StatusEQ(entduplicategroup.StatusReview)).
+		Count(testutil.SystemContext())
+	if err != nil {
+		t.Fatalf("count review groups: %v", err)
+	}
+	if _, err := pruner.Run(ctx, retention.Options{TenantSlug: tenant.Slug}); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	if got := group().Status; got != entduplicategroup.StatusArchived {
+		t.Fatalf("expected the stale resolved group archived, got %s", got)
+	}
+	after, err := client.DuplicateGroup.Query().
+		Where(entduplicategroup.TenantID(tenant.ID), entduplicategroup.StatusEQ(entduplicategroup.StatusReview)).
+		Count(testutil.SystemContext())
+	if err != nil || after != review {
+		t.Fatalf("expected groups under review untouched, %d before and %d after (%v)", review, after, err)
+	}
+}
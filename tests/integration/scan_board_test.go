@@ -1,7 +1,6 @@
 package integration_test
 
 import (
-	"context"
 	"testing"
 
 	"github.com/mcmx/duplynx/internal/scans"
@@ -13,7 +12,7 @@ func TestListTenantScans(t *testing.T) {
 	repo := scans.NewRepositoryFromClient(seed.Client)
 	svc := scans.Service{Repo: repo}
 	tenantSlug := seed.Dataset.Tenants[0].Slug
-	items, err := svc.ListTenantScans(context.Background(), tenantSlug)
+	items, err := svc.ListTenantScans(testutil.TenantContext(seed.Dataset.Tenants[0].ID), tenantSlug)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -0,0 +1,64 @@
+package integration_test
+
+import (
+	"testing"
+	"time"
+
+	entscan "github.com/mcmx/duplynx/ent/scan"
+	"github.com/mcmx/duplynx/internal/scans"
+	"github.com/mcmx/duplynx/tests/testutil"
+)
+
+func TestFailStaleFailsOnlyScansWithoutRecentHeartbeats(t *testing.T) {
+	seed := testutil.NewSeededClient(t)
+	tenant := seed.Dataset.Tenants[0]
+	ctx := testutil.TenantContext(tenant.ID)
+	now := time.Now()
+
+	create := func(name string, heartbeat time.Time) string {
+		t.Helper()
+		scan, err := seed.Client.Scan.Create().
+			SetTenantID(tenant.ID).
+			SetName(name).
+			SetStartedAt(now.Add(-2 * time.Hour)).
+			SetDuplicateGroupCount(0).
+			SetStatus(entscan.StatusIngesting).
+			SetLastHeartbeatAt(heartbeat).
+			Save(ctx)
+		if err != nil {
+			t.Fatalf("create scan %s: %v", name, err)
+		}
+		return scan.ID.String()
+	}
+	stale := create("Stale Sweep", now.Add(-time.Hour))
+	fresh := create("Fresh Sweep", now.Add(-time.Minute))
+
+	repo := scans.NewRepositoryFromClient(seed.Client)
+	failed, err := repo.FailStale(testutil.SystemContext(), now.Add(-10*time.Minute))
+	if err != nil {
+		t.Fatalf("fail stale: %v", err)
+	}
+	if len(failed) != 1 || failed[0].ID != stale {
+		t.Fatalf("expected only %s to fail, got %+v", stale, failed)
+	}
+	if failed[0].Lifecycle() != scans.StatusFailed || failed[0].StatusMessage == "" || failed[0].CompletedAt.IsZero() {
+		t.Fatalf("expected a failed scan with a reason, got %+v", failed[0])
+	}
+
+	summary, err := repo.Get(ctx, fresh)
+	if err != nil {
+		t.Fatalf("get fresh scan: %v", err)
+	}
+	if summary.Lifecycle() != scans.StatusIngesting {
+		t.Fatalf("fresh scan should keep ingesting, got %s", summary.Status)
+	}
+	for _, seeded := range seed.Dataset.Scans {
+		summary, err := repo.Get(testutil.TenantContext(seeded.TenantID), seeded.ID.String())
+		if err != nil {
+			t.Fatalf("get seeded scan: %v", err)
+		}
+		if summary.Lifecycle() == scans.StatusFailed {
+			t.Fatalf("seeded scan %s should not be failed", seeded.Name)
+		}
+	}
+}
@@ -0,0 +1,258 @@
+package integration_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/mcmx/duplynx/ent"
+	"github.com/mcmx/duplynx/ent/hook"
+	entscan "github.com/mcmx/duplynx/ent/scan"
+	"github.com/mcmx/duplynx/internal/scans"
+	"github.com/mcmx/duplynx/internal/schedule"
+	"github.com/mcmx/duplynx/tests/testutil"
+)
+
+func TestRunDueStartsSkipsAndRecordsMissedRuns(t *testing.T) {
+	seed := testutil.NewSeededClient(t)
+	tenant := seed.Dataset.Tenants[0]
+	machines := testutil.MachineIDsForTenant(seed.Dataset, tenant.ID)
+	ctx := testutil.SystemContext()
+
+	now := time.Date(2026, 10, 19, 9, 30, 0, 0, time.UTC)
+	repo := schedule.NewRepositoryFromClient(seed.Client)
+	repo.Now = func() time.Time { return now }
+
+	if _, err := repo.Create(ctx, tenant.Slug, schedule.Input{
+		Name: "Bad Zone", Cron: "@hourly", Timezone: "Mars/Olympus", MachineIDs: machines[:1], Roots: []string{"/srv"},
+	}); !errors.Is(err, schedule.ErrInvalidSchedule) {
+		t.Fatalf("expected an unknown timezone to be rejected, got %v", err)
+	}
+	other := testutil.MachineIDsForTenant(seed.Dataset, seed.Dataset.Tenants[1].ID)[0]
+	if _, err := repo.Create(ctx, tenant.Slug, schedule.Input{
+		Name: "Foreign", Cron: "@hourly", MachineIDs: []uuid.UUID{other}, Roots: []string{"/srv"},
+	}); !errors.Is(err, schedule.ErrInvalidSchedule) {
+		t.Fatalf("expected another tenant's machine to be rejected, got %v", err)
+	}
+
+	created, err := repo.Create(ctx, tenant.Slug, schedule.Input{
+		Name:       "Nightly",
+		Cron:       "0 * * * *",
+		Timezone:   "UTC",
+		MachineIDs: machines[:2],
+		Roots:      []string{"/home"},
+		Exclusions: []string{"*.tmp"},
+		Deadline:   30 * time.Minute,
+		Enabled:    true,
+	})
+	if err != nil {
+		t.Fatalf("create schedule: %v", err)
+	}
+	if want := time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC); !created.NextRunAt.Equal(want) {
+		t.Fatalf("expected first run at %s, got %s", want, created.NextRunAt)
+	}
+
+	if runs, err := repo.RunDue(ctx); err != nil || len(runs) != 0 {
+		t.Fatalf("expected nothing due yet, got %+v, %v", runs, err)
+	}
+
+	now = time.Date(2026, 10, 19, 10, 1, 0, 0, time.UTC)
+	runs, err := repo.RunDue(ctx)
+	if err != nil {
+		t.Fatalf("run due: %v", err)
+	}
+	if len(runs) != 1 || runs[0].Status != schedule.RunStarted || runs[0].ScanID == "" {
+		t.Fatalf("expected one started run, got %+v", runs)
+	}
+	scanRepo := scans.NewRepositoryFromClient(seed.Client)
+	started, err := scanRepo.Get(testutil.TenantContext(tenant.ID), runs[0].ScanID)
+	if err != nil {
+		t.Fatalf("get started scan: %v", err)
+	}
+	if len(started.Targets) != 2 || started.Targets[0].Exclusions[0] != "*.tmp" || !started.Deadline.Equal(time.Date(2026, 10, 19, 10, 30, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected scheduled scan %+v", started)
+	}
+
+	// The previous scan is still pending at the next fire time.
+	now = time.Date(2026, 10, 19, 11, 0, 30, 0, time.UTC)
+	runs, err = repo.RunDue(ctx)
+	if err != nil {
+		t.Fatalf("run due: %v", err)
+	}
+	if len(runs) != 1 || runs[0].Status != schedule.RunSkipped || runs[0].Reason == "" {
+		t.Fatalf("expected a skipped run, got %+v", runs)
+	}
+
+	// After an outage, earlier fire times are missed and only the latest starts.
+	if _, err := scanRepo.Cancel(testutil.TenantContext(tenant.ID), started.ID, "done"); err != nil {
+		t.Fatalf("cancel scan: %v", err)
+	}
+	now = time.Date(2026, 10, 19, 15, 5, 0, 0, time.UTC)
+	runs, err = repo.RunDue(ctx)
+	if err != nil {
+		t.Fatalf("run due: %v", err)
+	}
+	statuses := make([]string, 0, len(runs))
+	for _, run := range runs {
+		statuses = append(statuses, run.Status)
+	}
+	if len(runs) != 4 || statuses[0] != schedule.RunMissed || statuses[2] != schedule.RunMissed || statuses[3] != schedule.RunStarted {
+		t.Fatalf("expected three missed runs and one started, got %v", statuses)
+	}
+
+	// Archiving a target makes the next run fail to start.
+	if err := seed.Client.Machine.UpdateOneID(machines[1]).SetArchivedAt(now).Exec(testutil.TenantContext(tenant.ID)); err != nil {
+		t.Fatalf("archive machine: %v", err)
+	}
+	if _, err := scanRepo.Cancel(testutil.TenantContext(tenant.ID), runs[3].ScanID, "done"); err != nil {
+		t.Fatalf("cancel scan: %v", err)
+	}
+	now = time.Date(2026, 10, 19, 16, 0, 0, 0, time.UTC)
+	runs, err = repo.RunDue(ctx)
+	if err != nil {
+		t.Fatalf("run due: %v", err)
+	}
+	if len(runs) != 1 || runs[0].Status != schedule.RunFailed || runs[0].Reason == "" {
+		t.Fatalf("expected a failed run, got %+v", runs)
+	}
+
+	got, err := repo.Get(ctx, tenant.Slug, created.ID)
+	if err != nil {
+		t.Fatalf("get schedule: %v", err)
+	}
+	if len(got.Runs) != 7 || got.Runs[0].Status != schedule.RunFailed || !got.NextRunAt.Equal(time.Date(2026, 10, 19, 17, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected run history %+v", got)
+	}
+	var lastStarted string
+	for _, run := range got.Runs {
+		if run.Status == schedule.RunStarted {
+			lastStarted = run.ScanID
+			break
+		}
+	}
+	if lastStarted == "" || got.LastScanID != lastStarted {
+		t.Fatalf("expected the last started scan %s to be remembered, got %s", lastStarted, got.LastScanID)
+	}
+}
+
+// dueSchedule creates an enabled hourly schedule on the tenant's first machine
+// and returns a clock just past its first fire time.
+func dueSchedule(t *testing.T, repo *schedule.Repository, seed testutil.SeededClient, name string) (schedule.Schedule, time.Time) {
+	t.Helper()
+	tenant := seed.Dataset.Tenants[0]
+	created, err := repo.Create(testutil.SystemContext(), tenant.Slug, schedule.Input{
+		Name:       name,
+		Cron:       "@hourly",
+		MachineIDs: testutil.MachineIDsForTenant(seed.Dataset, tenant.ID)[:1],
+		Roots:      []string{"/srv"},
+		Enabled:    true,
+	})
+	if err != nil {
+		t.Fatalf("create schedule %s: %v", name, err)
+	}
+	return created, created.NextRunAt.Add(time.Minute)
+}
+
+func scansNamed(t *testing.T, client *ent.Client, prefix string) int {
+	t.Helper()
+	count, err := client.Scan.Query().Where(entscan.NameHasPrefix(prefix)).Count(testutil.SystemContext())
+	if err != nil {
+		t.Fatalf("count scans: %v", err)
+	}
+	return count
+}
+
+func TestRunDueStartsEachFireTimeOnceAcrossReplicas(t *testing.T) {
+	seed := testutil.NewSeededClient(t)
+	now := time.Date(2026, 10, 19, 9, 30, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+	first := schedule.NewRepositoryFromClient(seed.Client)
+	first.Now = clock
+	second := schedule.NewRepositoryFromClient(seed.Client)
+	second.Now = clock
+	_, now = dueSchedule(t, first, seed, "Replicated")
+
+	// The second replica has already read the schedule as due when the first
+	// one runs it from start to finish.
+	raced := false
+	seed.Client.ScanSchedule.Use(func(next ent.Mutator) ent.Mutator {
+		return hook.ScanScheduleFunc(func(ctx context.Context, m *ent.ScanScheduleMutation) (ent.Value, error) {
+			if !raced && m.Op().Is(ent.OpUpdate) {
+				raced = true
+				if runs, err := first.RunDue(testutil.SystemContext()); err != nil || len(runs) != 1 {
+					t.Errorf("expected the first replica to start the run, got %+v, %v", runs, err)
+				}
+			}
+			return next.Mutate(ctx, m)
+		})
+	})
+	runs, err := second.RunDue(testutil.SystemContext())
+	if err != nil {
+		t.Fatalf("run due: %v", err)
+	}
+	if !raced || len(runs) != 0 {
+		t.Fatalf("expected the second replica to lose the fire time, got %+v", runs)
+	}
+	if got := scansNamed(t, seed.Client, "Replicated "); got != 1 {
+		t.Fatalf("expected one scan for the fire time, got %d", got)
+	}
+}
+
+func TestRunDueDoesNotRefireWhenRecordingFails(t *testing.T) {
+	seed := testutil.NewSeededClient(t)
+	now := time.Date(2026, 10, 19, 9, 30, 0, 0, time.UTC)
+	repo := schedule.NewRepositoryFromClient(seed.Client)
+	repo.Now = func() time.Time { return now }
+	created, due := dueSchedule(t, repo, seed, "Flaky")
+	now = due
+
+	failed := false
+	seed.Client.ScanScheduleRun.Use(func(next ent.Mutator) ent.Mutator {
+		return hook.ScanScheduleRunFunc(func(ctx context.Context, m *ent.ScanScheduleRunMutation) (ent.Value, error) {
+			if !failed {
+				failed = true
+				return nil, errors.New("disk full")
+			}
+			return next.Mutate(ctx, m)
+		})
+	})
+	if _, err := repo.RunDue(testutil.SystemContext()); err == nil {
+		t.Fatal("expected the failed recording to be reported")
+	}
+	if runs, err := repo.RunDue(testutil.SystemContext()); err != nil || len(runs) != 0 {
+		t.Fatalf("expected the claimed fire time not to run again, got %+v, %v", runs, err)
+	}
+	if got := scansNamed(t, seed.Client, "Flaky "); got != 1 {
+		t.Fatalf("expected one scan for the fire time, got %d", got)
+	}
+	got, err := repo.Get(testutil.SystemContext(), created.TenantSlug, created.ID)
+	if err != nil {
+		t.Fatalf("get schedule: %v", err)
+	}
+	if !got.NextRunAt.After(now) {
+		t.Fatalf("expected the next run moved past %s, got %s", now, got.NextRunAt)
+	}
+}
+
+func TestRunDueContinuesPastAFailingSchedule(t *testing.T) {
+	seed := testutil.NewSeededClient(t)
+	now := time.Date(2026, 10, 19, 9, 30, 0, 0, time.UTC)
+	repo := schedule.NewRepositoryFromClient(seed.Client)
+	repo.Now = func() time.Time { return now }
+	broken, _ := dueSchedule(t, repo, seed, "Broken")
+	_, now = dueSchedule(t, repo, seed, "Healthy")
+	if err := seed.Client.ScanSchedule.UpdateOneID(uuid.MustParse(broken.ID)).SetCron("not a cron").Exec(testutil.SystemContext()); err != nil {
+		t.Fatalf("corrupt schedule: %v", err)
+	}
+
+	runs, err := repo.RunDue(testutil.SystemContext())
+	if err == nil {
+		t.Fatal("expected the broken schedule to be reported")
+	}
+	if len(runs) != 1 || runs[0].Status != schedule.RunStarted || runs[0].ScheduleID == broken.ID {
+		t.Fatalf("expected the healthy schedule to start anyway, got %+v", runs)
+	}
+}
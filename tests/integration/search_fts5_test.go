@@ -0,0 +1,32 @@
+//go:build sqlite_fts5
+
+package integration_test
+
+import (
+	"testing"
+
+	"github.com/mcmx/duplynx/internal/data"
+	"github.com/mcmx/duplynx/internal/search"
+	"github.com/mcmx/duplynx/tests/testutil"
+)
+
+// TestSearchUsesTheFTS5Index runs in builds with -tags sqlite_fts5, which
+// `make test` uses; without the tag SQLite falls back to scanning file_instances.
+func TestSearchUsesTheFTS5Index(t *testing.T) {
+	if testutil.TestDriver() != data.DriverSQLite {
+		t.Skip("the FTS5 index is SQLite's")
+	}
+	seed := testutil.NewSeededClient(t)
+	repo := search.NewRepositoryFromClient(seed.Client)
+	ctx := testutil.TenantContext(seed.Dataset.Scans[0].TenantID)
+
+	for _, text := range []string{"q4-PLAN", "9adcc6e5", "/srv/media", "*.zip", "q4_plan", "100%"} {
+		result, err := repo.Search(ctx, search.Query{Text: text})
+		if err != nil {
+			t.Fatalf("%q: search failed: %v", text, err)
+		}
+		if !result.Indexed {
+			t.Fatalf("%q: expected the FTS5 index to serve the search", text)
+		}
+	}
+}
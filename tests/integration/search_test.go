@@ -0,0 +1,159 @@
+package integration_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mcmx/duplynx/internal/search"
+	"github.com/mcmx/duplynx/internal/tenancy/isolation"
+	"github.com/mcmx/duplynx/tests/testutil"
+)
+
+func TestSearchMatchesChecksumNameAndPath(t *testing.T) {
+	seed := testutil.NewSeededClient(t)
+	repo := search.NewRepositoryFromClient(seed.Client)
+	orion := seed.Dataset.Scans[0].TenantID
+	ctx := testutil.TenantContext(orion)
+
+	cases := []struct {
+		query     search.Query
+		kind      search.Kind
+		files     int
+		matchedBy string
+	}{
+		{search.Query{Text: "q4-PLAN"}, search.KindName, 3, "name"},
+		{search.Query{Text: "9adcc6e5"}, search.KindHash, 3, "hash"},
+		{search.Query{Text: "sha256:be93b9"}, search.KindHash, 2, "hash"},
+		{search.Query{Text: "/srv/media"}, search.KindPath, 2, "path"},
+		{search.Query{Text: "roadmap"}, search.KindName, 1, "path"},
+		{search.Query{Text: "*.zip"}, search.KindPath, 2, "name"},
+		{search.Query{Text: "/srv/*/finance/*"}, search.KindPath, 2, "path"},
+		{search.Query{Text: "package", Kind: search.KindPath}, search.KindPath, 2, "path"},
+		{search.Query{Text: "batch-219"}, search.KindName, 0, ""},
+	}
+	for _, tc := range cases {
+		result, err := repo.Search(ctx, tc.query)
+		if err != nil {
+			t.Fatalf("%q: search failed: %v", tc.query.Text, err)
+		}
+		if result.Kind != tc.kind || result.Files != tc.files {
+			t.Fatalf("%q: expected %d %s matches, got %d %s", tc.query.Text, tc.files, tc.kind, result.Files, result.Kind)
+		}
+		for _, group := range result.Groups {
+			for _, file := range group.Files {
+				if file.MatchedBy != tc.matchedBy {
+					t.Fatalf("%q: expected %s match for %s, got %s", tc.query.Text, tc.matchedBy, file.Path, file.MatchedBy)
+				}
+			}
+		}
+	}
+
+	// The telemetry duplicate belongs to the other tenant.
+	var selene = orion
+	for _, tenant := range seed.Dataset.Tenants {
+		if tenant.ID != orion {
+			selene = tenant.ID
+		}
+	}
+	result, err := repo.Search(testutil.TenantContext(selene), search.Query{Text: "batch-219"})
+	if err != nil || result.Files != 2 || len(result.Groups) != 1 {
+		t.Fatalf("expected the other tenant to find its own files, got %+v (%v)", result, err)
+	}
+}
+
+func TestSearchLimitsAndValidation(t *testing.T) {
+	seed := testutil.NewSeededClient(t)
+	repo := search.NewRepositoryFromClient(seed.Client)
+	ctx := testutil.TenantContext(seed.Dataset.Scans[0].TenantID)
+
+	result, err := repo.Search(ctx, search.Query{Text: "/srv/", Limit: 1})
+	if err != nil || result.Files != 1 || !result.Truncated {
+		t.Fatalf("expected one truncated match, got %+v (%v)", result, err)
+	}
+	if _, err := repo.Search(ctx, search.Query{Text: "ab"}); !errors.Is(err, search.ErrQueryTooShort) {
+		t.Fatalf("expected ErrQueryTooShort, got %v", err)
+	}
+	if _, err := repo.Search(context.Background(), search.Query{Text: "plan"}); !errors.Is(err, isolation.ErrUnscoped) {
+		t.Fatalf("expected unscoped searches to be rejected, got %v", err)
+	}
+}
+
+func TestSearchSeesFilesAddedAfterSeeding(t *testing.T) {
+	seed := testutil.NewSeededClient(t)
+	repo := search.NewRepositoryFromClient(seed.Client)
+	group := seed.Dataset.DuplicateGroups[0]
+	machines := testutil.MachineIDsForTenant(seed.Dataset, group.TenantID)
+	ctx := testutil.TenantContext(group.TenantID)
+
+	file, err := seed.Client.FileInstance.Create().
+		SetTenantID(group.TenantID).
+		SetDuplicateGroupID(group.ID).
+		SetMachineID(machines[0]).
+		SetPath("/mnt/backup/Q4-plan-copy.pptx").
+		SetSizeBytes(1).
+		SetChecksum(group.Hash).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("create file: %v", err)
+	}
+	result, err := repo.Search(ctx, search.Query{Text: "plan-copy"})
+	if err != nil || result.Files != 1 {
+		t.Fatalf("expected the new file to be found, got %+v (%v)", result, err)
+	}
+
+	if err := seed.Client.FileInstance.UpdateOne(file).SetPath("/mnt/backup/renamed.pptx").Exec(ctx); err != nil {
+		t.Fatalf("rename file: %v", err)
+	}
+	if result, _ := repo.Search(ctx, search.Query{Text: "plan-copy"}); result.Files != 0 {
+		t.Fatalf("expected the old name to be gone after a rename, got %d matches", result.Files)
+	}
+	if result, _ := repo.Search(ctx, search.Query{Text: "renamed"}); result.Files != 1 {
+		t.Fatalf("expected the new name to be found, got %d matches", result.Files)
+	}
+}
+
+func TestSearchTreatsLikeWildcardsLiterally(t *testing.T) {
+	seed := testutil.NewSeededClient(t)
+	repo := search.NewRepositoryFromClient(seed.Client)
+	group := seed.Dataset.DuplicateGroups[0]
+	machines := testutil.MachineIDsForTenant(seed.Dataset, group.TenantID)
+	ctx := testutil.TenantContext(group.TenantID)
+
+	for _, path := range []string{
+		"/mnt/exports/Budget_2026_final.xlsx",
+		"/mnt/exports/Budget-2026-final.xlsx",
+		"/mnt/exports/growth-100%-target.csv",
+		"/mnt/exports/growth-1000-target.csv",
+	} {
+		if _, err := seed.Client.FileInstance.Create().
+			SetTenantID(group.TenantID).
+			SetDuplicateGroupID(group.ID).
+			SetMachineID(machines[0]).
+			SetPath(path).
+			SetSizeBytes(1).
+			SetChecksum(group.Hash).
+			Save(ctx); err != nil {
+			t.Fatalf("create %s: %v", path, err)
+		}
+	}
+
+	cases := []struct {
+		query search.Query
+		path  string
+	}{
+		{search.Query{Text: "budget_2026"}, "/mnt/exports/Budget_2026_final.xlsx"},
+		{search.Query{Text: "budget_2026", Kind: search.KindName}, "/mnt/exports/Budget_2026_final.xlsx"},
+		{search.Query{Text: "exports/budget_"}, "/mnt/exports/Budget_2026_final.xlsx"},
+		{search.Query{Text: "100%-t"}, "/mnt/exports/growth-100%-target.csv"},
+	}
+	for _, tc := range cases {
+		result, err := repo.Search(ctx, tc.query)
+		if err != nil {
+			t.Fatalf("%q: search failed: %v", tc.query.Text, err)
+		}
+		if result.Files != 1 || result.Groups[0].Files[0].Path != tc.path {
+			t.Fatalf("%q: expected only %s, got %+v", tc.query.Text, tc.path, result.Groups)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package integration_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/mcmx/duplynx/internal/ingestion"
+	"github.com/mcmx/duplynx/tests/testutil"
+)
+
+func TestSecretRotationKeepsOverlapWindow(t *testing.T) {
+	seed := testutil.NewSeededClient(t)
+	ctx := testutil.TenantContext(seed.Dataset.Tenants[0].ID)
+	repo := ingestion.NewSecretRepositoryFromClient(seed.Client)
+	tenantSlug := seed.Dataset.Tenants[0].Slug
+
+	start := time.Date(2025, 11, 1, 12, 0, 0, 0, time.UTC)
+	first, err := repo.Rotate(ctx, tenantSlug, ingestion.RotateOptions{Now: start})
+	if err != nil {
+		t.Fatalf("initial rotate: %v", err)
+	}
+	second, err := repo.Rotate(ctx, tenantSlug, ingestion.RotateOptions{Now: start.Add(time.Hour), Overlap: 2 * time.Hour})
+	if err != nil {
+		t.Fatalf("second rotate: %v", err)
+	}
+
+	payload := `{"files":[]}`
+	cases := []struct {
+		name   string
+		at     time.Time
+		secret ingestion.SecretVersion
+		keyID  string
+		want   int
+	}{
+		{name: "old key during overlap", at: start.Add(2 * time.Hour), secret: first, keyID: first.KeyID, want: http.StatusAccepted},
+		{name: "new key during overlap", at: start.Add(2 * time.Hour), secret: second, keyID: second.KeyID, want: http.StatusAccepted},
+		{name: "old key without key id", at: start.Add(2 * time.Hour), secret: first, want: http.StatusAccepted},
+		{name: "old key after overlap", at: start.Add(4 * time.Hour), secret: first, keyID: first.KeyID, want: http.StatusForbidden},
+		{name: "new key after overlap", at: start.Add(4 * time.Hour), secret: second, keyID: second.KeyID, want: http.StatusAccepted},
+		{name: "mismatched key id", at: start.Add(2 * time.Hour), secret: first, keyID: second.KeyID, want: http.StatusForbidden},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			at := tc.at
+			h := ingestion.Handler{Secrets: repo, Now: func() time.Time { return at }}
+			req := httptest.NewRequest(http.MethodPost, "/ingest", strings.NewReader(payload))
+			req.Header.Set(ingestion.HeaderTenant, tenantSlug)
+			req.Header.Set(ingestion.HeaderSignature, sign(tc.secret.Secret, payload))
+			if tc.keyID != "" {
+				req.Header.Set(ingestion.HeaderKeyID, tc.keyID)
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+			if rec.Code != tc.want {
+				t.Fatalf("expected %d, got %d", tc.want, rec.Code)
+			}
+		})
+	}
+
+	if err := repo.Revoke(ctx, tenantSlug, second.KeyID); err != nil {
+		t.Fatalf("revoke: %v", err)
+	}
+	active, err := repo.ActiveSecrets(ctx, tenantSlug, start.Add(4*time.Hour))
+	if err != nil {
+		t.Fatalf("active secrets: %v", err)
+	}
+	if len(active) != 0 {
+		t.Fatalf("expected no active secrets after revoke, got %d", len(active))
+	}
+}
+
+func TestMachineSecretsRotateSeparatelyFromTenantSecrets(t *testing.T) {
+	seed := testutil.NewSeededClient(t)
+	tenant := seed.Dataset.Tenants[0]
+	ctx := testutil.TenantContext(tenant.ID)
+	repo := ingestion.NewSecretRepositoryFromClient(seed.Client)
+	machines := testutil.MachineIDsForTenant(seed.Dataset, tenant.ID)
+	start := time.Date(2025, 11, 1, 12, 0, 0, 0, time.UTC)
+
+	rotate := func(machineID uuid.UUID, at time.Time) ingestion.SecretVersion {
+		t.Helper()
+		version, err := repo.Rotate(ctx, tenant.Slug, ingestion.RotateOptions{MachineID: machineID, Now: at, Overlap: time.Hour})
+		if err != nil {
+			t.Fatalf("rotate: %v", err)
+		}
+		return version
+	}
+	tenantWide := rotate(uuid.Nil, start)
+	nas := rotate(machines[0], start.Add(time.Minute))
+	laptop := rotate(machines[1], start.Add(2*time.Minute))
+	if nas.MachineID != machines[0] || tenantWide.MachineID != uuid.Nil {
+		t.Fatalf("expected versions bound as issued, got %+v and %+v", nas, tenantWide)
+	}
+	rotate(machines[0], start.Add(3*time.Minute))
+
+	versions, err := repo.List(ctx, tenant.Slug)
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	expiring := map[string]bool{}
+	for _, version := range versions {
+		expiring[version.KeyID] = !version.ExpiresAt.IsZero()
+	}
+	if !expiring[nas.KeyID] || expiring[laptop.KeyID] || expiring[tenantWide.KeyID] {
+		t.Fatalf("expected only the NAS's previous version to be retired, got %v", expiring)
+	}
+
+	other := testutil.MachineIDsForTenant(seed.Dataset, seed.Dataset.Tenants[1].ID)[0]
+	if _, err := repo.Rotate(ctx, tenant.Slug, ingestion.RotateOptions{MachineID: other}); !errors.Is(err, ingestion.ErrMachineNotFound) {
+		t.Fatalf("expected another tenant's machine to be rejected, got %v", err)
+	}
+}
+
+func sign(secret, payload string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(payload))
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -11,6 +11,7 @@ import (
 	enttenant "github.com/mcmx/duplynx/ent/tenant"
 	"github.com/mcmx/duplynx/internal/config"
 	"github.com/mcmx/duplynx/internal/data"
+	"github.com/mcmx/duplynx/internal/tenancy/isolation"
 )
 
 func TestSeedCommandPopulatesCanonicalDataset(t *testing.T) {
@@ -36,7 +37,7 @@ func TestSeedCommandPopulatesCanonicalDataset(t *testing.T) {
 	runSeedCommand(t, backendDir, assetsDir, dbPath)
 	runSeedCommand(t, backendDir, assetsDir, dbPath)
 
-	ctx := context.Background()
+	ctx := isolation.WithSystem(context.Background())
 	cfg := config.RuntimeConfig{DBFile: dbPath}
 
 	client, err := data.OpenSQLite(ctx, cfg.SQLiteDSN())
@@ -0,0 +1,67 @@
+package integration_test
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mcmx/duplynx/internal/data"
+)
+
+func TestServeRefusesDatabaseBehindOrAheadOfSchema(t *testing.T) {
+	repoRoot, err := filepath.Abs("../../..")
+	if err != nil {
+		t.Fatalf("failed to determine repo root: %v", err)
+	}
+	tempDir := t.TempDir()
+	binary := filepath.Join(tempDir, "duplynx")
+	build := exec.Command("go", "build", "-o", binary, "./cmd/duplynx")
+	build.Dir = filepath.Join(repoRoot, "backend")
+	if output, err := build.CombinedOutput(); err != nil {
+		t.Fatalf("build duplynx: %v\n%s", err, output)
+	}
+	assetsDir := filepath.Join(tempDir, "assets")
+	if err := os.MkdirAll(assetsDir, 0o755); err != nil {
+		t.Fatalf("create assets dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(assetsDir, "tailwind.css"), []byte("body{}"), 0o644); err != nil {
+		t.Fatalf("write tailwind bundle: %v", err)
+	}
+	dbPath := filepath.Join(tempDir, "duplynx.db")
+	run := func(args ...string) (string, error) {
+		cmd := exec.Command(binary, append(args, "--db-file", dbPath, "--assets-dir", assetsDir, "--addr", "127.0.0.1:0")...)
+		cmd.Env = os.Environ()
+		output, err := cmd.CombinedOutput()
+		return string(output), err
+	}
+
+	if output, err := run("serve"); err == nil || !strings.Contains(output, "behind") {
+		t.Fatalf("expected serve to refuse an unmigrated database, got %v: %s", err, output)
+	}
+	if output, err := run("db", "migrate", "status"); err == nil || !strings.Contains(output, "pending") {
+		t.Fatalf("expected status to report pending migrations and fail, got %v: %s", err, output)
+	}
+	if output, err := run("db", "migrate", "up"); err != nil || !strings.Contains(output, "Applied") {
+		t.Fatalf("expected migrations to apply, got %v: %s", err, output)
+	}
+	if output, err := run("db", "migrate", "status"); err != nil || strings.Contains(output, "pending") {
+		t.Fatalf("expected a current schema, got %v: %s", err, output)
+	}
+
+	// A newer build's migration makes this database ahead of this build.
+	client, err := data.OpenSQLite(context.Background(), "file:"+dbPath+"?_fk=1")
+	if err != nil {
+		t.Fatalf("open database: %v", err)
+	}
+	_, err = client.ExecContext(context.Background(), `INSERT INTO schema_migrations (version, name, checksum, applied_at) VALUES ('99991231000000', 'future', 'x', '2099-01-01T00:00:00Z')`)
+	_ = client.Close()
+	if err != nil {
+		t.Fatalf("record future migration: %v", err)
+	}
+	if output, err := run("serve"); err == nil || !strings.Contains(output, "ahead") {
+		t.Fatalf("expected serve to refuse a database ahead of its schema, got %v: %s", err, output)
+	}
+}
@@ -8,20 +8,17 @@ import (
 
 	"github.com/google/uuid"
 
-	"github.com/mcmx/duplynx/ent/enttest"
 	"github.com/mcmx/duplynx/internal/data"
 	apphttp "github.com/mcmx/duplynx/internal/http"
 	"github.com/mcmx/duplynx/internal/scans"
 	"github.com/mcmx/duplynx/internal/tenancy"
+	"github.com/mcmx/duplynx/tests/testutil"
 )
 
 func TestTenantGuardBlocksCrossTenantAccess(t *testing.T) {
 	ctx := context.Background()
 
-	client := enttest.Open(t, "sqlite3", "file:tenant-guard?mode=memory&cache=shared&_fk=1")
-	t.Cleanup(func() {
-		_ = client.Close()
-	})
+	client := testutil.OpenTestClient(t)
 
 	dataset := data.CanonicalDemoDataset()
 	if _, err := data.SeedDemoDataset(ctx, client, dataset); err != nil {
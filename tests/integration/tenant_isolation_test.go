@@ -0,0 +1,211 @@
+package integration_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/mcmx/duplynx/ent"
+	entduplicategroup "github.com/mcmx/duplynx/ent/duplicategroup"
+	entfileinstance "github.com/mcmx/duplynx/ent/fileinstance"
+	entmachine "github.com/mcmx/duplynx/ent/machine"
+	entscan "github.com/mcmx/duplynx/ent/scan"
+	"github.com/mcmx/duplynx/internal/actions"
+	"github.com/mcmx/duplynx/internal/data"
+	"github.com/mcmx/duplynx/internal/tenancy/isolation"
+	"github.com/mcmx/duplynx/tests/testutil"
+)
+
+type isolationFixture struct {
+	client      *ent.Client
+	orion       uuid.UUID
+	selene      uuid.UUID
+	seleneGroup data.DuplicateGroupFixture
+	seleneScan  uuid.UUID
+	seleneHost  uuid.UUID
+}
+
+func newIsolationFixture(t *testing.T) isolationFixture {
+	t.Helper()
+	seed := testutil.NewSeededClient(t)
+	fx := isolationFixture{client: seed.Client}
+	for _, tenant := range seed.Dataset.Tenants {
+		switch tenant.Slug {
+		case "orion-analytics":
+			fx.orion = tenant.ID
+		case "selene-research":
+			fx.selene = tenant.ID
+		}
+	}
+	for _, group := range seed.Dataset.DuplicateGroups {
+		if group.TenantID == fx.selene {
+			fx.seleneGroup = group
+			fx.seleneScan = group.ScanID
+			break
+		}
+	}
+	for _, machine := range seed.Dataset.Machines {
+		if machine.TenantID == fx.selene {
+			fx.seleneHost = machine.ID
+			break
+		}
+	}
+	if fx.orion == uuid.Nil || fx.selene == uuid.Nil || fx.seleneGroup.ID == uuid.Nil || fx.seleneHost == uuid.Nil {
+		t.Fatalf("canonical dataset missing isolation fixtures")
+	}
+	return fx
+}
+
+func TestUnscopedOperationsFailClosed(t *testing.T) {
+	fx := newIsolationFixture(t)
+	ctx := context.Background()
+
+	if _, err := fx.client.DuplicateGroup.Query().All(ctx); !errors.Is(err, isolation.ErrUnscoped) {
+		t.Fatalf("expected unscoped query to fail, got %v", err)
+	}
+	if _, err := fx.client.FileInstance.Update().SetQuarantined(true).Save(ctx); !errors.Is(err, isolation.ErrUnscoped) {
+		t.Fatalf("expected unscoped update to fail, got %v", err)
+	}
+	if _, err := fx.client.Machine.Delete().Exec(ctx); !errors.Is(err, isolation.ErrUnscoped) {
+		t.Fatalf("expected unscoped delete to fail, got %v", err)
+	}
+
+	count, err := fx.client.DuplicateGroup.Query().Count(isolation.WithSystem(ctx))
+	if err != nil || count == 0 {
+		t.Fatalf("expected system context to see every group, got %d (%v)", count, err)
+	}
+}
+
+func TestCrossTenantReadsAreFiltered(t *testing.T) {
+	fx := newIsolationFixture(t)
+	ctx := testutil.TenantContext(fx.orion)
+
+	groups, err := fx.client.DuplicateGroup.Query().All(ctx)
+	if err != nil {
+		t.Fatalf("scoped query: %v", err)
+	}
+	for _, group := range groups {
+		if group.TenantID != fx.orion {
+			t.Fatalf("scoped query leaked group %s from tenant %s", group.ID, group.TenantID)
+		}
+	}
+
+	if _, err := fx.client.DuplicateGroup.Get(ctx, fx.seleneGroup.ID); !ent.IsNotFound(err) {
+		t.Fatalf("expected not found reading another tenant's group, got %v", err)
+	}
+
+	repo := actions.NewRepositoryFromClient(fx.client)
+	listed, err := repo.ListByScan(ctx, fx.seleneScan)
+	if err != nil {
+		t.Fatalf("list by scan: %v", err)
+	}
+	if len(listed) != 0 {
+		t.Fatalf("expected no groups for another tenant's scan, got %d", len(listed))
+	}
+	if _, err := repo.Get(ctx, fx.seleneGroup.ID); !errors.Is(err, actions.ErrGroupNotFound) {
+		t.Fatalf("expected ErrGroupNotFound, got %v", err)
+	}
+
+	// Traversals and eager loads are filtered as well.
+	files, err := fx.client.Scan.Query().
+		Where(entscan.IDEQ(fx.seleneScan)).
+		QueryDuplicateGroups().
+		QueryFileInstances().
+		All(isolation.WithSystem(context.Background()))
+	if err != nil || len(files) == 0 {
+		t.Fatalf("expected system traversal to reach file instances, got %d (%v)", len(files), err)
+	}
+	files, err = fx.client.DuplicateGroup.Query().
+		Where(entduplicategroup.IDEQ(fx.seleneGroup.ID)).
+		QueryFileInstances().
+		All(ctx)
+	if err != nil {
+		t.Fatalf("scoped traversal: %v", err)
+	}
+	if len(files) != 0 {
+		t.Fatalf("expected scoped traversal to hide another tenant's files, got %d", len(files))
+	}
+}
+
+func TestCrossTenantWritesAreRejected(t *testing.T) {
+	fx := newIsolationFixture(t)
+	ctx := testutil.TenantContext(fx.orion)
+
+	err := fx.client.DuplicateGroup.UpdateOneID(fx.seleneGroup.ID).SetStatus(entduplicategroup.StatusArchived).Exec(ctx)
+	if !ent.IsNotFound(err) {
+		t.Fatalf("expected not found updating another tenant's group, got %v", err)
+	}
+
+	affected, err := fx.client.FileInstance.Update().
+		Where(entfileinstance.DuplicateGroupIDEQ(fx.seleneGroup.ID)).
+		SetQuarantined(true).
+		Save(ctx)
+	if err != nil || affected != 0 {
+		t.Fatalf("expected bulk update to skip another tenant's files, got %d (%v)", affected, err)
+	}
+
+	seleneCtx := testutil.TenantContext(fx.selene)
+	before, err := fx.client.Machine.Query().Count(seleneCtx)
+	if err != nil || before == 0 {
+		t.Fatalf("count selene machines: %d (%v)", before, err)
+	}
+	if _, err := fx.client.Machine.Delete().
+		Where(entmachine.IDEQ(fx.seleneHost)).
+		Exec(ctx); err != nil {
+		t.Fatalf("scoped delete: %v", err)
+	}
+	after, err := fx.client.Machine.Query().Count(seleneCtx)
+	if err != nil {
+		t.Fatalf("count selene machines: %v", err)
+	}
+	if after != before {
+		t.Fatalf("scoped delete removed another tenant's machine: %d -> %d", before, after)
+	}
+
+	_, err = fx.client.Machine.Create().
+		SetTenantID(fx.selene).
+		SetName("Intruder").
+		SetCategory("server").
+		Save(ctx)
+	if !errors.Is(err, isolation.ErrCrossTenant) {
+		t.Fatalf("expected ErrCrossTenant creating a machine for another tenant, got %v", err)
+	}
+
+	created, err := fx.client.Machine.Create().
+		SetName("Orion Edge 01").
+		SetCategory("vm").
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("create machine without explicit tenant: %v", err)
+	}
+	if created.TenantID != fx.orion {
+		t.Fatalf("expected machine to inherit scoped tenant, got %s", created.TenantID)
+	}
+
+	err = fx.client.DuplicateGroup.UpdateOneID(fx.seleneGroup.ID).
+		SetTenantID(fx.orion).
+		Exec(testutil.TenantContext(fx.selene))
+	if !errors.Is(err, isolation.ErrCrossTenant) {
+		t.Fatalf("expected ErrCrossTenant moving a group between tenants, got %v", err)
+	}
+}
+
+func TestDispatcherRejectsForeignKeeperMachine(t *testing.T) {
+	fx := newIsolationFixture(t)
+	repo := actions.NewRepositoryFromClient(fx.client)
+	dispatcher := actions.NewDispatcher(repo, &actions.AuditLogger{})
+
+	var orionGroup *ent.DuplicateGroup
+	groups, err := fx.client.DuplicateGroup.Query().Limit(1).All(testutil.TenantContext(fx.orion))
+	if err != nil || len(groups) == 0 {
+		t.Fatalf("load orion group: %v", err)
+	}
+	orionGroup = groups[0]
+
+	err = dispatcher.AssignKeeper(testutil.TenantContext(fx.orion), orionGroup.ID.String(), "orion-analytics", fx.seleneHost.String())
+	if !errors.Is(err, actions.ErrInvalidMachineID) {
+		t.Fatalf("expected ErrInvalidMachineID for another tenant's machine, got %v", err)
+	}
+}
@@ -0,0 +1,116 @@
+package integration_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/mcmx/duplynx/ent/tenanttombstone"
+	"github.com/mcmx/duplynx/internal/data"
+	"github.com/mcmx/duplynx/tests/testutil"
+)
+
+func TestTenantExportFormats(t *testing.T) {
+	seed := testutil.NewSeededClient(t)
+	ctx := testutil.SystemContext()
+
+	var buf bytes.Buffer
+	counts, err := data.ExportTenant(ctx, seed.Client, "orion-analytics", data.ExportJSON, &buf)
+	if err != nil {
+		t.Fatalf("export json: %v", err)
+	}
+	var doc struct {
+		Header        data.ExportHeader `json:"header"`
+		Tenant        map[string]any    `json:"tenant"`
+		Machines      []map[string]any  `json:"machines"`
+		Scans         []map[string]any  `json:"scans"`
+		FileInstances []map[string]any  `json:"fileInstances"`
+		ActionAudits  []map[string]any  `json:"actionAudits"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatalf("export is not valid JSON: %v", err)
+	}
+	if doc.Header.TenantSlug != "orion-analytics" || doc.Tenant["slug"] != "orion-analytics" {
+		t.Fatalf("unexpected header/tenant: %+v %v", doc.Header, doc.Tenant["slug"])
+	}
+	if len(doc.Machines) != counts.Machines || len(doc.FileInstances) != counts.FileInstances || len(doc.Scans) != counts.Scans {
+		t.Fatalf("document sections do not match counts %+v", counts)
+	}
+	orionID := doc.Tenant["id"]
+	for _, file := range doc.FileInstances {
+		if file["tenant_id"] != orionID {
+			t.Fatalf("export leaked file instance from tenant %v", file["tenant_id"])
+		}
+	}
+
+	buf.Reset()
+	if _, err := data.ExportTenant(ctx, seed.Client, "orion-analytics", data.ExportNDJSON, &buf); err != nil {
+		t.Fatalf("export ndjson: %v", err)
+	}
+	types := map[string]int{}
+	scanner := bufio.NewScanner(&buf)
+	for scanner.Scan() {
+		var record struct {
+			Type string `json:"type"`
+		}
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatalf("invalid ndjson line %q: %v", scanner.Text(), err)
+		}
+		types[record.Type]++
+	}
+	if types["header"] != 1 || types["tenant"] != 1 || types["file_instance"] != counts.FileInstances || types["action_audit"] != counts.ActionAudits {
+		t.Fatalf("unexpected ndjson record types %v for counts %+v", types, counts)
+	}
+
+	if _, err := data.ExportTenant(ctx, seed.Client, "orion-analytics", "xml", &buf); !errors.Is(err, data.ErrUnsupportedFormat) {
+		t.Fatalf("expected ErrUnsupportedFormat, got %v", err)
+	}
+	if _, err := data.ExportTenant(ctx, seed.Client, "missing-tenant", data.ExportJSON, &buf); !errors.Is(err, data.ErrTenantNotFound) {
+		t.Fatalf("expected ErrTenantNotFound, got %v", err)
+	}
+}
+
+func TestTenantPurgeRemovesOnlyThatTenant(t *testing.T) {
+	seed := testutil.NewSeededClient(t)
+	ctx := testutil.SystemContext()
+
+	before, err := data.CountTenantRows(ctx, seed.Client, "orion-analytics")
+	if err != nil {
+		t.Fatalf("count orion rows: %v", err)
+	}
+	seleneBefore, err := data.CountTenantRows(ctx, seed.Client, "selene-research")
+	if err != nil {
+		t.Fatalf("count selene rows: %v", err)
+	}
+
+	report, err := data.PurgeTenant(ctx, seed.Client, "orion-analytics", "offboarding-test")
+	if err != nil {
+		t.Fatalf("purge: %v", err)
+	}
+	if report.Deleted != before {
+		t.Fatalf("expected deleted rows %+v, got %+v", before, report.Deleted)
+	}
+
+	if _, err := data.CountTenantRows(ctx, seed.Client, "orion-analytics"); !errors.Is(err, data.ErrTenantNotFound) {
+		t.Fatalf("expected purged tenant to be gone, got %v", err)
+	}
+	seleneAfter, err := data.CountTenantRows(ctx, seed.Client, "selene-research")
+	if err != nil {
+		t.Fatalf("count selene rows after purge: %v", err)
+	}
+	if seleneAfter != seleneBefore {
+		t.Fatalf("purge touched another tenant: %+v -> %+v", seleneBefore, seleneAfter)
+	}
+
+	tombstone, err := seed.Client.TenantTombstone.Query().
+		Where(tenanttombstone.TenantSlug("orion-analytics")).
+		Only(ctx)
+	if err != nil {
+		t.Fatalf("load tombstone: %v", err)
+	}
+	if tombstone.ID != report.TombstoneID || tombstone.Actor != "offboarding-test" || tombstone.DeletedRows["file_instances"] != before.FileInstances {
+		t.Fatalf("unexpected tombstone: %+v", tombstone)
+	}
+}
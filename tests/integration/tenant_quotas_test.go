@@ -0,0 +1,136 @@
+package integration_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mcmx/duplynx/internal/actions"
+	"github.com/mcmx/duplynx/internal/ingestion"
+	"github.com/mcmx/duplynx/internal/quota"
+	"github.com/mcmx/duplynx/tests/testutil"
+)
+
+func intPtr(v int) *int { return &v }
+
+func TestIngestionEnforcesTenantQuotas(t *testing.T) {
+	seed := testutil.NewSeededClient(t)
+	ctx := context.Background()
+	enforcer := quota.NewEnforcerFromClient(seed.Client, quota.DefaultLimits())
+	now := time.Date(2025, 11, 1, 12, 0, 0, 0, time.UTC)
+	enforcer.Now = func() time.Time { return now }
+
+	manifestLimit := int64(32)
+	if _, err := enforcer.SetOverrides(ctx, "orion-analytics", quota.Overrides{
+		ManifestBytes:   &manifestLimit,
+		IngestPerMinute: intPtr(2),
+	}); err != nil {
+		t.Fatalf("set overrides: %v", err)
+	}
+
+	h := ingestion.Handler{
+		TenantSecrets: map[string]string{"orion-analytics": "orion-secret", "selene-research": "selene-secret"},
+		Quotas:        enforcer,
+	}
+	send := func(tenant, secret, payload string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/ingest", strings.NewReader(payload))
+		req.Header.Set(ingestion.HeaderTenant, tenant)
+		req.Header.Set(ingestion.HeaderSignature, sign(secret, payload))
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec
+	}
+
+	large := `{"files":["` + strings.Repeat("a", 64) + `"]}`
+	if rec := send("orion-analytics", "orion-secret", large); rec.Code != http.StatusRequestEntityTooLarge {
+		t.Fatalf("expected 413 for oversized manifest, got %d", rec.Code)
+	}
+
+	small := `{"files":[]}`
+	for i := 0; i < 2; i++ {
+		if rec := send("orion-analytics", "orion-secret", small); rec.Code != http.StatusAccepted {
+			t.Fatalf("request %d: expected 202, got %d", i, rec.Code)
+		}
+	}
+	rec := send("orion-analytics", "orion-secret", small)
+	if rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected 429 once the rate limit is reached, got %d", rec.Code)
+	}
+	if rec.Header().Get("Retry-After") != "60" {
+		t.Fatalf("expected Retry-After of 60 seconds, got %q", rec.Header().Get("Retry-After"))
+	}
+
+	// Other tenants keep their own budget.
+	if rec := send("selene-research", "selene-secret", large); rec.Code != http.StatusAccepted {
+		t.Fatalf("expected selene to be unaffected, got %d", rec.Code)
+	}
+
+	now = now.Add(time.Minute)
+	if rec := send("orion-analytics", "orion-secret", small); rec.Code != http.StatusAccepted {
+		t.Fatalf("expected rate window to reset, got %d", rec.Code)
+	}
+
+	usage, err := enforcer.Usage(ctx, "orion-analytics")
+	if err != nil {
+		t.Fatalf("usage: %v", err)
+	}
+	if usage.IngestLastMinute != 1 || usage.Limits.IngestPerMinute != 2 || usage.Limits.ManifestBytes != manifestLimit {
+		t.Fatalf("unexpected usage: %+v", usage)
+	}
+
+	if _, err := enforcer.SetOverrides(ctx, "orion-analytics", quota.Overrides{RetainedScans: intPtr(usage.RetainedScans)}); err != nil {
+		t.Fatalf("set retained scans: %v", err)
+	}
+	if rec := send("orion-analytics", "orion-secret", small); rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected 429 when retained scan quota is full, got %d", rec.Code)
+	}
+}
+
+func TestDispatcherEnforcesConcurrentActionQuota(t *testing.T) {
+	seed := testutil.NewSeededClient(t)
+	enforcer := quota.NewEnforcerFromClient(seed.Client, quota.DefaultLimits())
+	if _, err := enforcer.SetOverrides(context.Background(), "orion-analytics", quota.Overrides{ConcurrentActions: intPtr(1)}); err != nil {
+		t.Fatalf("set overrides: %v", err)
+	}
+
+	repo := actions.NewRepositoryFromClient(seed.Client)
+	dispatcher := actions.NewDispatcher(repo, &actions.AuditLogger{})
+	dispatcher.Quotas = enforcer
+
+	var tenantGroup string
+	for _, group := range seed.Dataset.DuplicateGroups {
+		if testutil.TenantSlugFor(t, seed.Dataset, group.TenantID) == "orion-analytics" {
+			tenantGroup = group.ID.String()
+			ctx := testutil.TenantContext(group.TenantID)
+
+			release, err := enforcer.AcquireAction(ctx, "orion-analytics")
+			if err != nil {
+				t.Fatalf("acquire slot: %v", err)
+			}
+			err = dispatcher.PerformAction(ctx, tenantGroup, "orion-analytics", "tester", actions.ActionQuarantine, nil)
+			if !errors.Is(err, quota.ErrRateLimited) {
+				t.Fatalf("expected ErrRateLimited while the slot is held, got %v", err)
+			}
+			release()
+
+			if err := dispatcher.PerformAction(ctx, tenantGroup, "orion-analytics", "tester", actions.ActionQuarantine, nil); err != nil {
+				t.Fatalf("expected action to run after release: %v", err)
+			}
+			usage, err := enforcer.Usage(ctx, "orion-analytics")
+			if err != nil {
+				t.Fatalf("usage: %v", err)
+			}
+			if usage.ConcurrentActions != 0 {
+				t.Fatalf("expected slots to be released, got %d", usage.ConcurrentActions)
+			}
+			break
+		}
+	}
+	if tenantGroup == "" {
+		t.Fatal("no orion duplicate group in dataset")
+	}
+}
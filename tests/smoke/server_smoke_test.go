@@ -32,9 +32,6 @@ func TestSmokeSeedServe(t *testing.T) {
 	if err := os.WriteFile(filepath.Join(assetsDir, "tailwind.css"), cssContent, 0o644); err != nil {
 		t.Fatalf("failed to scaffold tailwind bundle: %v", err)
 	}
-	if err := os.WriteFile(filepath.Join(assetsDir, "app.css"), cssContent, 0o644); err != nil {
-		t.Fatalf("failed to scaffold app.css bundle: %v", err)
-	}
 
 	env := append(os.Environ(),
 		"GOMODCACHE="+filepath.Join(repoRoot, ".cache", "go-mod"),
@@ -100,7 +97,7 @@ func TestSmokeSeedServe(t *testing.T) {
 	}
 
 	html := string(body)
-	if !strings.Contains(html, "/static/app.css") {
+	if !strings.Contains(html, "/static/tailwind.css?v=") {
 		_ = cmd.Process.Signal(os.Interrupt)
 		t.Fatalf("expected Tailwind bundle link in markup.\nReceived HTML:\n%s", truncate(html, 512))
 	}
@@ -0,0 +1,116 @@
+package testutil
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/mcmx/duplynx/ent"
+	"github.com/mcmx/duplynx/internal/data"
+)
+
+// Environment variables selecting the database the suite runs against.
+// DUPLYNX_TEST_DB_DRIVER=postgres runs every seeded client on the Postgres
+// server at DUPLYNX_TEST_POSTGRES_DSN, each test in a schema of its own.
+const (
+	EnvTestDriver      = "DUPLYNX_TEST_DB_DRIVER"
+	EnvTestPostgresDSN = "DUPLYNX_TEST_POSTGRES_DSN"
+)
+
+// TestDriver returns the driver the suite runs against, SQLite unless overridden.
+func TestDriver() string {
+	if driver := strings.TrimSpace(os.Getenv(EnvTestDriver)); driver != "" {
+		return strings.ToLower(driver)
+	}
+	return data.DriverSQLite
+}
+
+// OpenTestClient opens a migrated, empty client for the driver selected by
+// TestDriver and closes it when the test ends.
+func OpenTestClient(t *testing.T) *ent.Client {
+	t.Helper()
+	if TestDriver() == data.DriverPostgres {
+		return OpenPostgresClient(t)
+	}
+	ctx := context.Background()
+	// A named shared-cache database lives as long as a connection to it; the
+	// unique name keeps tests from seeing each other's rows.
+	dsn := "file:duplynx-test-" + uuid.NewString() + "?mode=memory&cache=shared&_fk=1"
+	client, err := data.OpenSQLite(ctx, dsn)
+	if err != nil {
+		t.Fatalf("open sqlite client: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+	if err := data.Migrate(ctx, client); err != nil {
+		t.Fatalf("migrate sqlite schema: %v", err)
+	}
+	return client
+}
+
+// OpenPostgresClient opens a client on a fresh schema of the Postgres server
+// at DUPLYNX_TEST_POSTGRES_DSN, dropping the schema when the test ends. The
+// test is skipped when no server is configured.
+func OpenPostgresClient(t *testing.T) *ent.Client {
+	t.Helper()
+	dsn := strings.TrimSpace(os.Getenv(EnvTestPostgresDSN))
+	if dsn == "" {
+		t.Skipf("%s is not set", EnvTestPostgresDSN)
+	}
+
+	admin, err := sql.Open("postgres", dsn)
+	if err != nil {
+		t.Fatalf("open postgres: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = admin.Close()
+	})
+	ctx := context.Background()
+	// Tests share pg_trgm in public, so dropping a test schema never drops the extension.
+	_, _ = admin.ExecContext(ctx, `CREATE EXTENSION IF NOT EXISTS pg_trgm SCHEMA public`)
+	schema := "duplynx_test_" + strings.ReplaceAll(uuid.NewString(), "-", "")
+	if _, err := admin.ExecContext(ctx, "CREATE SCHEMA "+schema); err != nil {
+		t.Fatalf("create test schema: %v", err)
+	}
+	t.Cleanup(func() {
+		_, _ = admin.ExecContext(context.Background(), "DROP SCHEMA "+schema+" CASCADE")
+	})
+
+	scoped, err := withSearchPath(dsn, schema+",public")
+	if err != nil {
+		t.Fatalf("postgres dsn: %v", err)
+	}
+	client, err := data.OpenPostgres(ctx, scoped)
+	if err != nil {
+		t.Fatalf("open postgres client: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+	if err := data.Migrate(ctx, client); err != nil {
+		t.Fatalf("migrate postgres schema: %v", err)
+	}
+	return client
+}
+
+// withSearchPath adds a search_path run-time parameter to a URL or key=value DSN.
+func withSearchPath(dsn, searchPath string) (string, error) {
+	if strings.HasPrefix(dsn, "postgres://") || strings.HasPrefix(dsn, "postgresql://") {
+		u, err := url.Parse(dsn)
+		if err != nil {
+			return "", err
+		}
+		query := u.Query()
+		query.Set("search_path", searchPath)
+		u.RawQuery = query.Encode()
+		return u.String(), nil
+	}
+	return fmt.Sprintf("%s search_path='%s'", dsn, searchPath), nil
+}
@@ -7,8 +7,8 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/mcmx/duplynx/ent"
-	"github.com/mcmx/duplynx/ent/enttest"
 	"github.com/mcmx/duplynx/internal/data"
+	"github.com/mcmx/duplynx/internal/tenancy/isolation"
 )
 
 // SeededClient bundles an Ent client with the canonical demo dataset.
@@ -17,14 +17,12 @@ type SeededClient struct {
 	Dataset data.DemoDataset
 }
 
-// NewSeededClient opens an in-memory Ent client, seeds the canonical dataset, and registers cleanup.
+// NewSeededClient opens a test client (in-memory SQLite unless DUPLYNX_TEST_DB_DRIVER
+// selects Postgres), seeds the canonical dataset, and registers cleanup.
 func NewSeededClient(t *testing.T) SeededClient {
 	t.Helper()
 
-	client := enttest.Open(t, "sqlite3", "file:duplynx-test?mode=memory&cache=shared&_fk=1")
-	t.Cleanup(func() {
-		_ = client.Close()
-	})
+	client := OpenTestClient(t)
 
 	dataset := data.CanonicalDemoDataset()
 	if _, err := data.SeedDemoDataset(context.Background(), client, dataset); err != nil {
@@ -59,3 +57,13 @@ func MachineIDsForTenant(dataset data.DemoDataset, tenantID uuid.UUID) []uuid.UU
 	}
 	return ids
 }
+
+// TenantContext returns a background context confined to the tenant by row-level isolation.
+func TenantContext(tenantID uuid.UUID) context.Context {
+	return isolation.WithTenant(context.Background(), tenantID)
+}
+
+// SystemContext returns a background context allowed to span every tenant.
+func SystemContext() context.Context {
+	return isolation.WithSystem(context.Background())
+}
@@ -1,7 +1,6 @@
 package unit_test
 
 import (
-	"context"
 	"testing"
 
 	"github.com/mcmx/duplynx/internal/actions"
@@ -23,11 +22,12 @@ func TestAssignKeeperUpdatesGroupAndLogs(t *testing.T) {
 	}
 	machineID := machineIDs[0].String()
 
-	if err := d.AssignKeeper(context.Background(), groupID, tenantSlug, machineID); err != nil {
+	ctx := testutil.TenantContext(groupFixture.TenantID)
+	if err := d.AssignKeeper(ctx, groupID, tenantSlug, machineID); err != nil {
 		t.Fatalf("assign keeper failed: %v", err)
 	}
 
-	updated, err := repo.Get(context.Background(), groupFixture.ID)
+	updated, err := repo.Get(ctx, groupFixture.ID)
 	if err != nil {
 		t.Fatalf("reload duplicate group: %v", err)
 	}
@@ -50,7 +50,8 @@ func TestPerformActionCreatesStubbedAudit(t *testing.T) {
 	groupID := groupFixture.ID.String()
 	tenantSlug := testutil.TenantSlugFor(t, seed.Dataset, groupFixture.TenantID)
 
-	if err := d.PerformAction(context.Background(), groupID, tenantSlug, "system", actions.ActionQuarantine, map[string]any{"targetFileIds": []string{}}); err != nil {
+	ctx := testutil.TenantContext(groupFixture.TenantID)
+	if err := d.PerformAction(ctx, groupID, tenantSlug, "system", actions.ActionQuarantine, map[string]any{"targetFileIds": []string{}}); err != nil {
 		t.Fatalf("perform action failed: %v", err)
 	}
 
@@ -65,7 +66,7 @@ func TestPerformActionCreatesStubbedAudit(t *testing.T) {
 		t.Fatalf("expected stubbed quarantine audit entry")
 	}
 
-	updated, err := repo.Get(context.Background(), groupFixture.ID)
+	updated, err := repo.Get(ctx, groupFixture.ID)
 	if err != nil {
 		t.Fatalf("reload duplicate group: %v", err)
 	}
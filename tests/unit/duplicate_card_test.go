@@ -0,0 +1,38 @@
+package unit_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mcmx/duplynx/internal/actions"
+	"github.com/mcmx/duplynx/internal/templ/components"
+)
+
+func TestDuplicateCardKeeperPicker(t *testing.T) {
+	group := actions.DuplicateGroup{
+		ID:                "dg-001",
+		TenantSlug:        "sample-tenant-a",
+		Hash:              "hash-a1",
+		KeeperMachineID:   "m-2",
+		KeeperMachineName: "Helios-Server-02",
+		Files: []actions.DuplicateFile{
+			{MachineID: "m-1", MachineName: "Ares Laptop", MachineCategory: "personal_laptop", MachineHostname: "ares.local", Path: "/a"},
+			{MachineID: "m-2", MachineName: "Helios-Server-02", MachineCategory: "server", MachineHostname: "helios.local", Path: "/b"},
+			{MachineID: "m-2", MachineName: "Helios-Server-02", MachineCategory: "server", MachineHostname: "helios.local", Path: "/c"},
+		},
+	}
+
+	markup := string(components.DuplicateCard(group))
+	if !strings.Contains(markup, "Keeper: Helios-Server-02") {
+		t.Fatalf("expected keeper name on card, got %s", markup)
+	}
+	if strings.Count(markup, `<option value="m-`) != 2 {
+		t.Fatalf("expected one option per machine holding a copy, got %s", markup)
+	}
+	if !strings.Contains(markup, `<option value="m-2" selected>Helios-Server-02 (server · helios.local)</option>`) {
+		t.Fatalf("expected current keeper preselected with category and hostname, got %s", markup)
+	}
+	if strings.Contains(markup, `type="text" name="keeperMachineId"`) {
+		t.Fatalf("expected the free-text keeper input to be gone")
+	}
+}
@@ -0,0 +1,56 @@
+package unit_test
+
+import (
+	"testing"
+
+	"github.com/mcmx/duplynx/internal/events"
+)
+
+func TestEventBusFiltersByTenantAndScan(t *testing.T) {
+	bus := events.NewBus(4)
+	scanA, cancelA := bus.Subscribe(events.Filter{TenantSlug: "tenant-a", ScanID: "scan-1"})
+	defer cancelA()
+	tenantB, cancelB := bus.Subscribe(events.Filter{TenantSlug: "tenant-b"})
+	defer cancelB()
+
+	bus.Publish(events.Event{Type: events.TypeGroupChanged, TenantSlug: "tenant-a", ScanID: "scan-2", GroupID: "g-other"})
+	bus.Publish(events.Event{Type: events.TypeGroupChanged, TenantSlug: "tenant-a", ScanID: "scan-1", GroupID: "g-1"})
+	bus.Publish(events.Event{Type: events.TypeScanIngested, TenantSlug: "tenant-a"})
+
+	first := <-scanA
+	if first.GroupID != "g-1" || first.At.IsZero() {
+		t.Fatalf("expected the scan-1 change stamped with a time, got %+v", first)
+	}
+	if second := <-scanA; second.Type != events.TypeScanIngested {
+		t.Fatalf("expected the tenant-wide ingest event, got %+v", second)
+	}
+	select {
+	case e := <-tenantB:
+		t.Fatalf("tenant-b received tenant-a event %+v", e)
+	default:
+	}
+}
+
+func TestEventBusDropsWhenSubscriberIsFullAndClosesOnShutdown(t *testing.T) {
+	bus := events.NewBus(1)
+	stream, cancel := bus.Subscribe(events.Filter{TenantSlug: "tenant-a"})
+	defer cancel()
+
+	bus.Publish(events.Event{Type: events.TypeGroupChanged, TenantSlug: "tenant-a", GroupID: "g-1"})
+	bus.Publish(events.Event{Type: events.TypeGroupChanged, TenantSlug: "tenant-a", GroupID: "g-2"})
+	if e := <-stream; e.GroupID != "g-1" {
+		t.Fatalf("expected the buffered event, got %+v", e)
+	}
+
+	bus.Close()
+	if _, open := <-stream; open {
+		t.Fatalf("expected the stream to close with the bus")
+	}
+	if bus.Subscribers() != 0 {
+		t.Fatalf("expected no subscribers after close, got %d", bus.Subscribers())
+	}
+	late, _ := bus.Subscribe(events.Filter{TenantSlug: "tenant-a"})
+	if _, open := <-late; open {
+		t.Fatalf("expected subscriptions after close to be closed")
+	}
+}
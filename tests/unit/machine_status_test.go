@@ -0,0 +1,30 @@
+package unit_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mcmx/duplynx/internal/templ"
+	"github.com/mcmx/duplynx/internal/tenancy"
+)
+
+func TestMachinePickerShowsAgentStatus(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	machines := []tenancy.Machine{
+		{ID: "m-online", Name: "Polling", Category: "server", LastSeenAt: now.Add(-30 * time.Second), LastScanAt: now.Add(-time.Hour)},
+		{ID: "m-stale", Name: "Quiet", Category: "nas", LastSeenAt: now.Add(-tenancy.OnlineWindow - time.Second)},
+		{ID: "m-never", Name: "Fresh", Category: "vm"},
+	}
+	html := string(templ.MachinePickerPage(tenancy.Tenant{Slug: "orion", Name: "Orion"}, machines, "", now))
+
+	if got := strings.Count(html, `data-machine-status="online"`); got != 1 {
+		t.Fatalf("expected one online machine, got %d in %s", got, html)
+	}
+	if got := strings.Count(html, `data-machine-status="offline"`); got != 2 {
+		t.Fatalf("expected two offline machines, got %d", got)
+	}
+	if !strings.Contains(html, "last scan 2026-10-19") {
+		t.Fatalf("expected the last scan time to be shown, got %s", html)
+	}
+}
@@ -0,0 +1,47 @@
+package unit_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/mcmx/duplynx/internal/schedule"
+)
+
+func TestCronNextMatchesFieldsInTimezone(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("timezone data unavailable: %v", err)
+	}
+	cases := []struct {
+		spec  string
+		loc   *time.Location
+		after time.Time
+		want  time.Time
+	}{
+		{"*/15 * * * *", time.UTC, time.Date(2026, 3, 1, 10, 7, 30, 0, time.UTC), time.Date(2026, 3, 1, 10, 15, 0, 0, time.UTC)},
+		{"0 2 * * *", berlin, time.Date(2026, 7, 1, 0, 30, 0, 0, time.UTC), time.Date(2026, 7, 2, 0, 0, 0, 0, time.UTC)},
+		{"30 9 * * mon-fri", time.UTC, time.Date(2026, 10, 16, 10, 0, 0, 0, time.UTC), time.Date(2026, 10, 19, 9, 30, 0, 0, time.UTC)},
+		{"0 0 13 * 5", time.UTC, time.Date(2026, 10, 10, 0, 0, 0, 0, time.UTC), time.Date(2026, 10, 13, 0, 0, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.UTC, time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC), time.Date(2026, 10, 25, 0, 0, 0, 0, time.UTC)},
+		{"@monthly", time.UTC, time.Date(2026, 12, 15, 0, 0, 0, 0, time.UTC), time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 feb *", time.UTC, time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tc := range cases {
+		cron, err := schedule.ParseCron(tc.spec)
+		if err != nil {
+			t.Fatalf("parse %q: %v", tc.spec, err)
+		}
+		if got := cron.Next(tc.after, tc.loc); !got.Equal(tc.want) {
+			t.Errorf("%q after %s: got %s, want %s", tc.spec, tc.after, got.UTC(), tc.want)
+		}
+	}
+}
+
+func TestParseCronRejectsMalformedExpressions(t *testing.T) {
+	for _, spec := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "0 0 0 * *", "*/0 * * * *", "5-1 * * * *", "0 0 * foo *"} {
+		if _, err := schedule.ParseCron(spec); !errors.Is(err, schedule.ErrInvalidCron) {
+			t.Errorf("expected %q to be rejected, got %v", spec, err)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package unit_test
+
+import (
+	"html/template"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/mcmx/duplynx/internal/app"
+	"github.com/mcmx/duplynx/internal/assets"
+	"github.com/mcmx/duplynx/internal/http/handlers"
+	"github.com/mcmx/duplynx/internal/templ"
+)
+
+func loadTestBundle(t *testing.T, css string) *assets.Bundle {
+	t.Helper()
+	bundle, err := assets.Load(fstest.MapFS{
+		"app.css":      {Data: []byte(css)},
+		"tailwind.css": {Data: []byte("/* tailwind */")},
+		"htmx.min.js":  {Data: []byte("/* htmx */")},
+		".gitkeep":     {},
+	}, "test")
+	if err != nil {
+		t.Fatalf("load bundle: %v", err)
+	}
+	return bundle
+}
+
+func TestAssetURLsChangeWithContent(t *testing.T) {
+	first := loadTestBundle(t, "body{color:red}")
+	second := loadTestBundle(t, "body{color:blue}")
+
+	if first.URL("app.css") == second.URL("app.css") {
+		t.Fatalf("expected different URLs for different content, got %s", first.URL("app.css"))
+	}
+	if !strings.HasPrefix(first.URL("app.css"), "/static/app.css?v=") {
+		t.Fatalf("unexpected asset URL %s", first.URL("app.css"))
+	}
+	if got := first.URL("missing.js"); got != "/static/missing.js" {
+		t.Fatalf("expected an unversioned URL for unknown assets, got %s", got)
+	}
+	if first.Hash(".gitkeep") != "" {
+		t.Fatalf("expected dotfiles to be left out of the bundle")
+	}
+
+	templ.UseAssets(first)
+	t.Cleanup(func() { templ.UseAssets(nil) })
+	html := string(templ.RenderLayout("DupLynx", "", "", template.HTML("")))
+	for _, name := range []string{"tailwind.css", "htmx.min.js"} {
+		if !strings.Contains(html, template.HTMLEscapeString(first.URL(name))) {
+			t.Fatalf("expected the layout to reference the hashed %s: %s", name, html)
+		}
+	}
+}
+
+func TestStaticHandlerCachesHashedAssetsIndefinitely(t *testing.T) {
+	bundle := loadTestBundle(t, "body{}")
+	handler := handlers.StaticHandler{Assets: bundle}
+
+	cases := []struct {
+		target string
+		cache  string
+	}{
+		{bundle.URL("app.css"), "public, max-age=31536000, immutable"},
+		{"/static/app.css", "public, max-age=300"},
+		{"/static/app.css?v=stale", "public, max-age=300"},
+	}
+	for _, tc := range cases {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tc.target, nil))
+		if rec.Code != http.StatusOK || rec.Body.String() != "body{}" {
+			t.Fatalf("%s: expected the asset, got %d %q", tc.target, rec.Code, rec.Body.String())
+		}
+		if got := rec.Header().Get("Cache-Control"); got != tc.cache {
+			t.Fatalf("%s: expected Cache-Control %q, got %q", tc.target, tc.cache, got)
+		}
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/static/app.css", nil)
+	req.Header.Set("If-None-Match", `"`+bundle.Hash("app.css")+`"`)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusNotModified {
+		t.Fatalf("expected 304 for a matching ETag, got %d", rec.Code)
+	}
+}
+
+func TestResolveAssetsPrefersDirectoryOverride(t *testing.T) {
+	if _, err := app.ResolveAssets(t.TempDir(), true); err == nil || !strings.Contains(err.Error(), "tailwind bundle missing") {
+		t.Fatalf("expected an override without a tailwind bundle to be rejected, got %v", err)
+	}
+	if _, err := app.ResolveAssets("", false); err == nil {
+		t.Fatalf("expected an error when embedding is disabled and no directory is given")
+	}
+}